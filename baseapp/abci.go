@@ -185,6 +185,8 @@ func (app *BaseApp) BeginBlock(req abci.RequestBeginBlock) (res abci.ResponseBeg
 		res.Events = sdk.MarkEventsToIndex(res.Events, app.indexEvents)
 	}
 
+	app.listenBeginBlock(req, res)
+
 	return res
 }
 
@@ -205,6 +207,8 @@ func (app *BaseApp) EndBlock(req abci.RequestEndBlock) (res abci.ResponseEndBloc
 		res.ConsensusParamUpdates = cp
 	}
 
+	app.listenEndBlock(req, res)
+
 	return res
 }
 
@@ -231,15 +235,12 @@ func (app *BaseApp) CheckTxSync(req abci.RequestCheckTx) abci.ResponseCheckTx {
 	app.checkAccountWGs.Wait(waits)
 	defer app.checkAccountWGs.Done(signals)
 
-	gInfo, err := app.checkTx(req.Tx, tx, req.Type == abci.CheckTxType_Recheck)
+	gInfo, priority, sender, err := app.checkTxWithPriority(req.Tx, tx, req.Type == abci.CheckTxType_Recheck)
 	if err != nil {
 		return sdkerrors.ResponseCheckTx(err, gInfo.GasWanted, gInfo.GasUsed, app.trace)
 	}
 
-	return abci.ResponseCheckTx{
-		GasWanted: int64(gInfo.GasWanted), // TODO: Should type accept unsigned ints?
-		GasUsed:   int64(gInfo.GasUsed),   // TODO: Should type accept unsigned ints?
-	}
+	return app.checkTxResponse(gInfo, priority, sender)
 }
 
 func (app *BaseApp) CheckTxAsync(req abci.RequestCheckTx, callback abci.CheckTxCallback) {
@@ -275,7 +276,7 @@ func (app *BaseApp) EndRecheckTx(req abci.RequestEndRecheckTx) abci.ResponseEndR
 // Otherwise, the ResponseDeliverTx will contain releveant error information.
 // Regardless of tx execution outcome, the ResponseDeliverTx will contain relevant
 // gas execution context.
-func (app *BaseApp) DeliverTx(req abci.RequestDeliverTx) abci.ResponseDeliverTx {
+func (app *BaseApp) DeliverTx(req abci.RequestDeliverTx) (res abci.ResponseDeliverTx) {
 	defer telemetry.MeasureSince(time.Now(), "abci", "deliver_tx")
 
 	gInfo := sdk.GasInfo{}
@@ -288,6 +289,10 @@ func (app *BaseApp) DeliverTx(req abci.RequestDeliverTx) abci.ResponseDeliverTx
 		telemetry.SetGauge(float32(gInfo.GasWanted), "tx", "gas", "wanted")
 	}()
 
+	defer func() {
+		app.listenDeliverTx(req, res)
+	}()
+
 	tx, err := app.txDecoder(req.Tx)
 	if err != nil {
 		return sdkerrors.ResponseDeliverTx(err, 0, 0, app.trace)
@@ -296,6 +301,14 @@ func (app *BaseApp) DeliverTx(req abci.RequestDeliverTx) abci.ResponseDeliverTx
 	gInfo, result, err := app.runTx(req.Tx, tx, false)
 	if err != nil {
 		resultStr = "failed"
+	}
+
+	return app.deliverTxResponse(gInfo, result, err)
+}
+
+// deliverTxResponse builds the ResponseDeliverTx of an executed tx.
+func (app *BaseApp) deliverTxResponse(gInfo sdk.GasInfo, result *sdk.Result, err error) abci.ResponseDeliverTx {
+	if err != nil {
 		return sdkerrors.ResponseDeliverTx(err, gInfo.GasWanted, gInfo.GasUsed, app.trace)
 	}
 
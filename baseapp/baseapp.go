@@ -74,9 +74,24 @@ type BaseApp struct { // nolint: maligned
 	checkAccountWGs *AccountWGs
 	chCheckTx       chan *RequestCheckTxAsync
 
+	// number of workers executing the txs of a block concurrently in DeliverTxs;
+	// zero or one keeps DeliverTx execution serial
+	deliverTxWorkers int
+	// returns the store keys a tx is declared to access, used to schedule DeliverTxs
+	txStoreAccess TxStoreAccessFunc
+	// declares the keys txs only add to, whose writes are merged by DeliverTxs
+	txAccumulator TxAccumulator
+
+	// returns the mempool priority and the sender key of a tx in CheckTx
+	txPriority TxPriorityFunc
+
 	// an inter-block write-through cache provided to the context during deliverState
 	interBlockCache sdk.MultiStorePersistentCache
 
+	// abciListeners for hooking into the ABCI message processing of the BaseApp
+	// and exposing the requests and responses to external consumers
+	abciListeners []ABCIListener
+
 	// absent validators from begin block
 	voteInfos []abci.VoteInfo
 
@@ -314,6 +329,10 @@ func (app *BaseApp) setInterBlockCache(cache sdk.MultiStorePersistentCache) {
 	app.interBlockCache = cache
 }
 
+func (app *BaseApp) setDeliverTxWorkers(workers int) {
+	app.deliverTxWorkers = workers
+}
+
 func (app *BaseApp) setTrace(trace bool) {
 	app.trace = trace
 }
@@ -370,7 +389,7 @@ func (app *BaseApp) setCheckState(header ostproto.Header) {
 // and provided header. It is set on InitChain and BeginBlock and set to nil on
 // Commit.
 func (app *BaseApp) setDeliverState(header ostproto.Header) {
-	ms := app.cms.CacheMultiStore()
+	ms := app.cms.CacheMultiStoreWithListeners()
 	app.deliverState = &state{
 		ms:  ms,
 		ctx: sdk.NewContext(ms, header, false, app.logger),
@@ -562,7 +581,12 @@ func (app *BaseApp) preCheckTx(txBytes []byte) (tx sdk.Tx, err error) {
 }
 
 func (app *BaseApp) checkTx(txBytes []byte, tx sdk.Tx, recheck bool) (gInfo sdk.GasInfo, err error) {
-	ctx := app.getCheckContextForTx(txBytes, recheck)
+	return app.checkTxWithContext(app.getCheckContextForTx(txBytes, recheck), txBytes, tx)
+}
+
+// checkTxWithContext is checkTx on an explicitly given Context. The AnteHandler
+// writes are persisted to the multi-store of the provided Context.
+func (app *BaseApp) checkTxWithContext(ctx sdk.Context, txBytes []byte, tx sdk.Tx) (gInfo sdk.GasInfo, err error) {
 	gasCtx := &ctx
 
 	defer func() {
@@ -614,7 +638,12 @@ func (app *BaseApp) anteTx(ctx sdk.Context, txBytes []byte, tx sdk.Tx, simulate
 // returned if the tx does not run out of gas and if all the messages are valid
 // and execute successfully. An error is returned otherwise.
 func (app *BaseApp) runTx(txBytes []byte, tx sdk.Tx, simulate bool) (gInfo sdk.GasInfo, result *sdk.Result, err error) {
-	ctx := app.getRunContextForTx(txBytes, simulate)
+	return app.runTxWithContext(app.getRunContextForTx(txBytes, simulate), txBytes, tx, simulate)
+}
+
+// runTxWithContext is runTx on an explicitly given Context. All state transitions
+// are written to the multi-store of the provided Context.
+func (app *BaseApp) runTxWithContext(ctx sdk.Context, txBytes []byte, tx sdk.Tx, simulate bool) (gInfo sdk.GasInfo, result *sdk.Result, err error) {
 	ms := ctx.MultiStore()
 
 	// only run the tx if there is block gas remaining
@@ -630,7 +659,7 @@ func (app *BaseApp) runTx(txBytes []byte, tx sdk.Tx, simulate bool) (gInfo sdk.G
 
 	defer func() {
 		if r := recover(); r != nil {
-			recoveryMW := newOutOfGasRecoveryMiddleware(ctx.GasMeter().Limit(), ctx, app.runTxRecoveryMiddleware)
+			recoveryMW := newOutOfGasRecoveryMiddleware(ctx.GasMeter().Limit(), ctx.GasMeter().GasConsumed(), app.runTxRecoveryMiddleware)
 			err, result = processRecovery(r, recoveryMW), nil
 		}
 
@@ -0,0 +1,63 @@
+package baseapp
+
+import (
+	"strconv"
+
+	abci "github.com/line/ostracon/abci/types"
+
+	sdk "github.com/line/lfb-sdk/types"
+)
+
+// Mempool event which CheckTx attaches to the responses of accepted txs.
+const (
+	EventTypeMempool = "mempool"
+
+	AttributeKeyPriority = "priority"
+	AttributeKeySender   = "sender"
+)
+
+// TxPriorityFunc returns the mempool priority of a tx and the key of the
+// sender slot it occupies, e.g. its signer and sequence. An empty sender key
+// means the tx has no sender slot.
+//
+// NOTE: the app only reports them to the mempool and neither orders nor
+// replaces pending txs: the Ostracon mempool keeps the txs in their order of
+// arrival and has no way to evict a pending tx. A tx taking the sender slot
+// of a pending one is checked like any other tx, so with account sequences
+// it fails CheckTx on the sequence already used by the pending tx.
+type TxPriorityFunc func(ctx sdk.Context, tx sdk.Tx) (priority int64, sender string)
+
+// checkTxWithPriority runs checkTx and computes the mempool priority and the
+// sender key of the tx.
+func (app *BaseApp) checkTxWithPriority(
+	txBytes []byte, tx sdk.Tx, recheck bool,
+) (gInfo sdk.GasInfo, priority int64, sender string, err error) {
+	ctx := app.getCheckContextForTx(txBytes, recheck)
+	if app.txPriority != nil {
+		priority, sender = app.txPriority(ctx, tx)
+	}
+
+	gInfo, err = app.checkTxWithContext(ctx, txBytes, tx)
+	return gInfo, priority, sender, err
+}
+
+// checkTxResponse returns the response of a tx accepted by CheckTx. The
+// priority and the sender key are carried by a mempool event.
+func (app *BaseApp) checkTxResponse(gInfo sdk.GasInfo, priority int64, sender string) abci.ResponseCheckTx {
+	res := abci.ResponseCheckTx{
+		GasWanted: int64(gInfo.GasWanted), // TODO: Should type accept unsigned ints?
+		GasUsed:   int64(gInfo.GasUsed),   // TODO: Should type accept unsigned ints?
+	}
+
+	if app.txPriority != nil {
+		res.Events = sdk.Events{
+			sdk.NewEvent(
+				EventTypeMempool,
+				sdk.NewAttribute(AttributeKeyPriority, strconv.FormatInt(priority, 10)),
+				sdk.NewAttribute(AttributeKeySender, sender),
+			),
+		}.ToABCIEvents()
+	}
+
+	return res
+}
@@ -0,0 +1,111 @@
+package baseapp
+
+import (
+	"fmt"
+	"testing"
+
+	abci "github.com/line/ostracon/abci/types"
+	ostproto "github.com/line/ostracon/proto/ostracon/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/line/lfb-sdk/codec"
+	sdk "github.com/line/lfb-sdk/types"
+	sdkerrors "github.com/line/lfb-sdk/types/errors"
+)
+
+// txPriorityTest prioritizes txs by their number of msgs. The tx counter acts
+// as the sequence of a single sender.
+func txPriorityTest(ctx sdk.Context, tx sdk.Tx) (int64, string) {
+	txTest := tx.(txTest)
+	return int64(len(txTest.Msgs)), fmt.Sprintf("sender/%d", txTest.Counter)
+}
+
+// anteHandlerSequence accepts a tx only if its counter is the stored one and
+// increments it, as an account sequence.
+func anteHandlerSequence(capKey sdk.StoreKey, storeKey []byte) sdk.AnteHandler {
+	return func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+		store := ctx.KVStore(capKey)
+		counter := tx.(txTest).Counter
+		if stored := getIntFromStore(store, storeKey); stored != counter {
+			return ctx, sdkerrors.Wrapf(sdkerrors.ErrWrongSequence, "expected %d, got %d", stored, counter)
+		}
+		setIntOnStore(store, storeKey, counter+1)
+		return ctx, nil
+	}
+}
+
+func TestCheckTxPriority(t *testing.T) {
+	counterKey := []byte("counter-key")
+	anteOpt := func(bapp *BaseApp) { bapp.SetAnteHandler(anteHandlerSequence(capKey1, counterKey)) }
+	routerOpt := func(bapp *BaseApp) {
+		bapp.Router().AddRoute(sdk.NewRoute(routeMsgCounter, func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+			return &sdk.Result{}, nil
+		}))
+	}
+
+	app := setupBaseApp(t, anteOpt, routerOpt, SetTxPriority(txPriorityTest))
+	app.InitChain(abci.RequestInitChain{})
+
+	cdc := codec.NewLegacyAmino()
+	registerTestCodec(cdc)
+	marshal := func(tx *txTest) []byte {
+		txBytes, err := cdc.MarshalBinaryBare(tx)
+		require.NoError(t, err)
+		return txBytes
+	}
+	checkTx := func(txBytes []byte, checkType abci.CheckTxType) abci.ResponseCheckTx {
+		return app.CheckTxSync(abci.RequestCheckTx{Tx: txBytes, Type: checkType})
+	}
+	mempoolEvents := func(priority, sender string) []abci.Event {
+		return sdk.Events{
+			sdk.NewEvent(
+				EventTypeMempool,
+				sdk.NewAttribute(AttributeKeyPriority, priority),
+				sdk.NewAttribute(AttributeKeySender, sender),
+			),
+		}.ToABCIEvents()
+	}
+
+	first, second := marshal(newTxCounter(0, 0)), marshal(newTxCounter(1, 0))
+	res := checkTx(first, abci.CheckTxType_New)
+	require.True(t, res.IsOK(), fmt.Sprintf("%v", res))
+	require.Equal(t, mempoolEvents("1", "sender/0"), res.Events)
+
+	// a tx of the same sender key doesn't replace the pending one, even with a
+	// higher priority, and fails on the used sequence
+	higher := marshal(newTxCounter(0, 0, 1))
+	res = checkTx(higher, abci.CheckTxType_New)
+	require.Equal(t, sdkerrors.ErrWrongSequence.ABCICode(), res.Code)
+
+	res = checkTx(second, abci.CheckTxType_New)
+	require.True(t, res.IsOK(), fmt.Sprintf("%v", res))
+	require.Equal(t, mempoolEvents("1", "sender/1"), res.Events)
+
+	// the first tx is included in a block
+	header := ostproto.Header{Height: 1}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+	deliverRes := app.DeliverTx(abci.RequestDeliverTx{Tx: first})
+	require.True(t, deliverRes.IsOK(), fmt.Sprintf("%v", deliverRes))
+	app.EndBlock(abci.RequestEndBlock{})
+	app.Commit()
+
+	// the recheck of the remaining pending tx reports its priority again
+	app.BeginRecheckTx(abci.RequestBeginRecheckTx{Header: header})
+	res = checkTx(second, abci.CheckTxType_Recheck)
+	require.True(t, res.IsOK(), fmt.Sprintf("%v", res))
+	require.Equal(t, mempoolEvents("1", "sender/1"), res.Events)
+	app.EndRecheckTx(abci.RequestEndRecheckTx{})
+
+	res = checkTx(higher, abci.CheckTxType_New)
+	require.Equal(t, sdkerrors.ErrWrongSequence.ABCICode(), res.Code)
+
+	header = ostproto.Header{Height: 2}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+	deliverRes = app.DeliverTx(abci.RequestDeliverTx{Tx: second})
+	require.True(t, deliverRes.IsOK(), fmt.Sprintf("%v", deliverRes))
+	deliverRes = app.DeliverTx(abci.RequestDeliverTx{Tx: higher})
+	require.Equal(t, sdkerrors.ErrWrongSequence.ABCICode(), deliverRes.Code)
+	app.EndBlock(abci.RequestEndBlock{})
+	app.Commit()
+	require.Equal(t, int64(2), getIntFromStore(app.cms.GetKVStore(capKey1), counterKey))
+}
@@ -40,6 +40,9 @@ func TestRegisterMsgService(t *testing.T) {
 			testdata.MsgServerImpl{},
 		)
 	})
+	require.NotNil(t, app.MsgServiceRouter().Handler("/testdata.Msg/CreateDog"))
+	require.NotNil(t, app.MsgServiceRouter().HandlerByTypeURL("/testdata.MsgCreateDog"))
+	require.Nil(t, app.MsgServiceRouter().HandlerByTypeURL("/testdata.Msg/CreateDog"))
 }
 
 func TestRegisterMsgServiceTwice(t *testing.T) {
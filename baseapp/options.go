@@ -11,6 +11,7 @@ import (
 	"github.com/line/lfb-sdk/codec/types"
 	"github.com/line/lfb-sdk/snapshots"
 	"github.com/line/lfb-sdk/store"
+	storetypes "github.com/line/lfb-sdk/store/types"
 	sdk "github.com/line/lfb-sdk/types"
 )
 
@@ -54,6 +55,19 @@ func SetTrace(trace bool) func(*BaseApp) {
 	return func(app *BaseApp) { app.setTrace(trace) }
 }
 
+// SetDeliverTxWorkers returns a BaseApp option function that sets the number of
+// workers used to execute non-conflicting txs of a block concurrently through
+// DeliverTxs. A value of zero or one keeps execution serial.
+func SetDeliverTxWorkers(workers int) func(*BaseApp) {
+	return func(app *BaseApp) { app.setDeliverTxWorkers(workers) }
+}
+
+// SetTxPriority returns a BaseApp option function that sets the function
+// computing the mempool priority and the sender key of a tx in CheckTx.
+func SetTxPriority(fn TxPriorityFunc) func(*BaseApp) {
+	return func(app *BaseApp) { app.SetTxPriority(fn) }
+}
+
 // SetIndexEvents provides a BaseApp option function that sets the events to index.
 func SetIndexEvents(ie []string) func(*BaseApp) {
 	return func(app *BaseApp) { app.setIndexEvents(ie) }
@@ -91,6 +105,11 @@ func SetSnapshotStore(snapshotStore *snapshots.Store) func(*BaseApp) {
 	return func(app *BaseApp) { app.SetSnapshotStore(snapshotStore) }
 }
 
+// SetSnapshotCompression sets the compression of a store in snapshots.
+func SetSnapshotCompression(storeName string, compression storetypes.SnapshotCompression) func(*BaseApp) {
+	return func(app *BaseApp) { app.cms.SetSnapshotCompression(storeName, compression) }
+}
+
 func (app *BaseApp) SetName(name string) {
 	if app.sealed {
 		panic("SetName() on sealed BaseApp")
@@ -240,6 +259,34 @@ func (app *BaseApp) SetSnapshotKeepRecent(snapshotKeepRecent uint32) {
 	app.snapshotKeepRecent = snapshotKeepRecent
 }
 
+// SetTxStoreAccess sets the function declaring the store keys a tx accesses.
+// DeliverTxs uses it to avoid running txs touching the same stores concurrently.
+func (app *BaseApp) SetTxStoreAccess(fn TxStoreAccessFunc) {
+	if app.sealed {
+		panic("SetTxStoreAccess() on sealed BaseApp")
+	}
+	app.txStoreAccess = fn
+}
+
+// SetTxPriority sets the function computing the mempool priority and the sender
+// key of a tx in CheckTx.
+func (app *BaseApp) SetTxPriority(fn TxPriorityFunc) {
+	if app.sealed {
+		panic("SetTxPriority() on sealed BaseApp")
+	}
+	app.txPriority = fn
+}
+
+// SetTxAccumulator sets the accumulator declaring the keys txs only add to.
+// DeliverTxs merges the writes of concurrent txs to these keys instead of
+// executing the txs again.
+func (app *BaseApp) SetTxAccumulator(accumulator TxAccumulator) {
+	if app.sealed {
+		panic("SetTxAccumulator() on sealed BaseApp")
+	}
+	app.txAccumulator = accumulator
+}
+
 // SetInterfaceRegistry sets the InterfaceRegistry.
 func (app *BaseApp) SetInterfaceRegistry(registry types.InterfaceRegistry) {
 	app.interfaceRegistry = registry
@@ -0,0 +1,479 @@
+package baseapp
+
+import (
+	"bytes"
+	"io"
+	"sync"
+	"time"
+
+	abci "github.com/line/ostracon/abci/types"
+
+	"github.com/line/lfb-sdk/store/cachekv"
+	"github.com/line/lfb-sdk/store/tracekv"
+	"github.com/line/lfb-sdk/telemetry"
+	sdk "github.com/line/lfb-sdk/types"
+	sdkerrors "github.com/line/lfb-sdk/types/errors"
+)
+
+// TxStoreAccessFunc returns the names of the stores a tx is declared to access
+// besides the accounts of its signers. Txs declaring a common store are never
+// scheduled in the same DeliverTxs batch.
+type TxStoreAccessFunc func(tx sdk.Tx) []string
+
+// TxAccumulator declares the keys which txs only add to, such as the balance of
+// the fee collector which every fee paying tx adds its fee to. The writes of the
+// txs of a DeliverTxs batch to these keys are merged instead of being treated
+// as conflicts, so that the txs can still be executed concurrently.
+//
+// A tx branch sees the values of the accumulated keys at the start of the batch.
+// The gas of a read or write depends on the length of the value, so the tx is
+// executed again on the merged state unless the values it saw and wrote have
+// the length of those of a serial execution. The length of an encoded value
+// must therefore never decrease when the value is added to.
+type TxAccumulator interface {
+	// IsAccumulated returns true if txs only add to the key of the store.
+	IsAccumulated(storeKey sdk.StoreKey, key []byte) bool
+	// Merge adds the amount a tx added to a key, from original to written, to
+	// its current value. It returns an error if written is not an addition to
+	// original.
+	Merge(original, written, current []byte) ([]byte, error)
+}
+
+// accessBrancher is implemented by multi-stores which can be branched while
+// observing the accesses of the branch, e.g. the cachemulti.Store.
+type accessBrancher interface {
+	CacheMultiStoreWithWrapper(wrap func(sdk.StoreKey, sdk.KVStore) sdk.KVStore) sdk.CacheMultiStore
+}
+
+// DeliverTxs executes the txs of a block in the given order and returns their
+// responses.
+//
+// When deliver tx workers are configured, txs are split into batches of
+// consecutive txs which neither share a signer, a fee granter nor a declared
+// store, and the txs of a batch are executed concurrently, each on its own
+// branch of the deliver state. The branches are then merged in block order. A tx which read
+// state written by a preceding tx of its batch is executed again on the merged
+// state, so the results are always the same as those of serial DeliverTx calls.
+// The additions of the txs to the keys declared by the TxAccumulator are not
+// conflicts, they are added to the merged state.
+func (app *BaseApp) DeliverTxs(reqs []abci.RequestDeliverTx) []abci.ResponseDeliverTx {
+	res := make([]abci.ResponseDeliverTx, len(reqs))
+
+	brancher, ok := app.deliverState.ms.(accessBrancher)
+	if app.deliverTxWorkers <= 1 || !ok {
+		for i, req := range reqs {
+			res[i] = app.DeliverTx(req)
+		}
+		return res
+	}
+
+	txs := make([]*parallelTx, len(reqs))
+	for i, req := range reqs {
+		txs[i] = &parallelTx{txBytes: req.Tx}
+		txs[i].tx, txs[i].err = app.txDecoder(req.Tx)
+	}
+
+	for start := 0; start < len(txs); {
+		end := app.nextTxBatch(txs, start)
+		app.executeTxBatch(brancher, txs[start:end])
+
+		written := make(map[sdk.StoreKey]map[string]struct{})
+		for i := start; i < end; i++ {
+			res[i] = app.mergeTx(brancher, txs[i], written)
+			app.listenDeliverTx(reqs[i], res[i])
+		}
+		start = end
+	}
+
+	return res
+}
+
+// parallelTx is a tx of a DeliverTxs batch with its execution results.
+type parallelTx struct {
+	txBytes []byte
+	tx      sdk.Tx
+
+	ms       sdk.CacheMultiStore
+	access   *txAccess
+	blockGas sdk.GasMeter
+
+	gInfo  sdk.GasInfo
+	result *sdk.Result
+	err    error
+}
+
+// nextTxBatch returns the end of the batch starting at start. The batch ends
+// before the first tx sharing a signer, a fee granter or a declared store with
+// a former tx of the batch.
+func (app *BaseApp) nextTxBatch(txs []*parallelTx, start int) int {
+	seen := make(map[string]bool)
+	for i := start; i < len(txs); i++ {
+		if txs[i].tx == nil {
+			continue
+		}
+
+		keys := app.txAccessKeys(txs[i].tx)
+		for _, key := range keys {
+			if seen[key] {
+				return i
+			}
+		}
+		for _, key := range keys {
+			seen[key] = true
+		}
+	}
+
+	return len(txs)
+}
+
+func (app *BaseApp) txAccessKeys(tx sdk.Tx) []string {
+	signers := getUniqSigners(tx)
+	keys := make([]string, 0, len(signers))
+	for _, signer := range signers {
+		keys = append(keys, "acc/"+signer)
+	}
+	if feeTx, ok := tx.(sdk.FeeTx); ok && len(feeTx.FeeGranter()) != 0 {
+		keys = append(keys, "acc/"+string(feeTx.FeeGranter()))
+	}
+
+	if app.txStoreAccess != nil {
+		for _, name := range app.txStoreAccess(tx) {
+			keys = append(keys, "store/"+name)
+		}
+	}
+
+	return keys
+}
+
+// executeTxBatch executes the decoded txs of a batch concurrently.
+func (app *BaseApp) executeTxBatch(brancher accessBrancher, txs []*parallelTx) {
+	ch := make(chan *parallelTx)
+	wg := sync.WaitGroup{}
+	for i := 0; i < app.deliverTxWorkers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for ptx := range ch {
+				app.executeTx(brancher, ptx)
+			}
+		}()
+	}
+
+	for _, ptx := range txs {
+		if ptx.tx != nil {
+			ch <- ptx
+		}
+	}
+	close(ch)
+	wg.Wait()
+}
+
+// executeTx runs a tx on a new branch of the deliver state. The block gas meter
+// of the tx is a private one, the block gas is charged when the tx is merged.
+func (app *BaseApp) executeTx(brancher accessBrancher, ptx *parallelTx) {
+	ptx.access = newTxAccess(app.txAccumulator)
+	ptx.ms = brancher.CacheMultiStoreWithWrapper(ptx.access.wrap)
+	ptx.blockGas = sdk.NewInfiniteGasMeter()
+
+	ctx := app.deliverState.ctx.
+		WithMultiStore(ptx.ms).
+		WithTxBytes(ptx.txBytes).
+		WithGasMeter(sdk.NewInfiniteGasMeter()).
+		WithBlockGasMeter(ptx.blockGas).
+		WithEventManager(sdk.NewEventManager())
+
+	ptx.gInfo, ptx.result, ptx.err = app.runTxWithContext(ctx, ptx.txBytes, ptx.tx, false)
+}
+
+// mergeTx writes the branch of an executed tx to the deliver state, charges its
+// gas to the block gas meter and returns its response. written holds the keys
+// written by the former txs of the batch and is updated with the tx's writes.
+func (app *BaseApp) mergeTx(brancher accessBrancher, ptx *parallelTx, written map[sdk.StoreKey]map[string]struct{}) abci.ResponseDeliverTx {
+	defer telemetry.MeasureSince(time.Now(), "abci", "deliver_tx")
+
+	if ptx.tx == nil {
+		return sdkerrors.ResponseDeliverTx(ptx.err, 0, 0, app.trace)
+	}
+
+	gInfo, result, err := app.commitTx(brancher, ptx, written)
+
+	resultStr := "successful"
+	if err != nil {
+		resultStr = "failed"
+	}
+	telemetry.IncrCounter(1, "tx", "count")
+	telemetry.IncrCounter(1, "tx", resultStr)
+	telemetry.SetGauge(float32(gInfo.GasUsed), "tx", "gas", "used")
+	telemetry.SetGauge(float32(gInfo.GasWanted), "tx", "gas", "wanted")
+
+	return app.deliverTxResponse(gInfo, result, err)
+}
+
+func (app *BaseApp) commitTx(
+	brancher accessBrancher, ptx *parallelTx, written map[sdk.StoreKey]map[string]struct{},
+) (gInfo sdk.GasInfo, result *sdk.Result, err error) {
+	blockGasMeter := app.deliverState.ctx.BlockGasMeter()
+
+	// only run the tx if there is block gas remaining
+	if blockGasMeter.IsOutOfGas() {
+		gInfo = sdk.GasInfo{GasUsed: blockGasMeter.GasConsumed()}
+		return gInfo, nil, sdkerrors.Wrap(sdkerrors.ErrOutOfGas, "no block gas left to run tx")
+	}
+
+	// The tx has seen a state which misses the writes of the former txs of the
+	// batch. Its result is only valid if it hasn't read any of them, and if its
+	// writes to accumulated keys can be added to the merged state.
+	if ptx.access.readsAny(written) || !ptx.access.mergeAccumulated(ptx.ms, app.txAccumulator) {
+		app.executeTx(brancher, ptx)
+		ptx.access.mergeAccumulated(ptx.ms, app.txAccumulator)
+	}
+
+	startingGas := blockGasMeter.GasConsumed()
+	gInfo, result, err = ptx.gInfo, ptx.result, ptx.err
+
+	ptx.ms.Write()
+	ptx.access.addWrites(written)
+
+	defer func() {
+		if r := recover(); r != nil {
+			recoveryMW := newOutOfGasRecoveryMiddleware(gInfo.GasWanted, gInfo.GasUsed, app.runTxRecoveryMiddleware)
+			err, result = processRecovery(r, recoveryMW), nil
+		}
+	}()
+
+	blockGasMeter.ConsumeGas(ptx.blockGas.GasConsumed(), "block gas meter")
+	if blockGasMeter.GasConsumed() < startingGas {
+		panic(sdk.ErrorGasOverflow{Descriptor: "tx gas summation"})
+	}
+
+	return gInfo, result, err
+}
+
+// txAccess records the keys a tx branch reads from and writes to the stores it
+// was branched from.
+type txAccess struct {
+	stores      map[sdk.StoreKey]*accessStore
+	accumulator TxAccumulator
+}
+
+func newTxAccess(accumulator TxAccumulator) *txAccess {
+	return &txAccess{
+		stores:      make(map[sdk.StoreKey]*accessStore),
+		accumulator: accumulator,
+	}
+}
+
+func (ta *txAccess) wrap(key sdk.StoreKey, parent sdk.KVStore) sdk.KVStore {
+	store := &accessStore{
+		parent:    parent,
+		reads:     make(map[string]struct{}),
+		writes:    make(map[string]struct{}),
+		originals: make(map[string][]byte),
+	}
+	if ta.accumulator != nil && key != nil {
+		store.isAccumulated = func(k []byte) bool {
+			return ta.accumulator.IsAccumulated(key, k)
+		}
+	}
+	ta.stores[key] = store
+	return store
+}
+
+// mergeAccumulated computes the values the accumulated keys accessed by the tx
+// take once its additions are applied to the current state, and returns false
+// if the tx has to be executed again on the current state: if one of them can't
+// be merged, or if the tx saw or wrote a value whose length differs from the
+// one of a serial execution, which would change the gas it used. The values are
+// written when ms is written.
+func (ta *txAccess) mergeAccumulated(ms sdk.MultiStore, accumulator TxAccumulator) bool {
+	for storeKey, store := range ta.stores {
+		store.merged = make(map[string][]byte)
+		for key, original := range store.originals {
+			current := store.parent.Get([]byte(key))
+			if bytes.Equal(original, current) {
+				continue
+			}
+			if len(original) != len(current) {
+				return false
+			}
+
+			// the tx didn't add to the key, the current value is kept
+			written := ms.GetKVStore(storeKey).Get([]byte(key))
+			if bytes.Equal(original, written) {
+				store.merged[key] = current
+				continue
+			}
+
+			// the values the tx saw lie between original and written, and those of
+			// a serial execution between current and merged. They all have the same
+			// length if these do, as the length of a value never decreases.
+			merged, err := accumulator.Merge(original, written, current)
+			if err != nil || len(written) != len(original) || len(merged) != len(current) {
+				return false
+			}
+			store.merged[key] = merged
+		}
+	}
+
+	return true
+}
+
+// readsAny returns true if the tx read one of the given keys, directly or
+// through an iterator. The values of the accumulated keys read directly are
+// checked when they are merged instead.
+func (ta *txAccess) readsAny(keys map[sdk.StoreKey]map[string]struct{}) bool {
+	for storeKey, store := range ta.stores {
+		written := keys[storeKey]
+		if len(written) == 0 {
+			continue
+		}
+
+		for key := range store.reads {
+			if _, ok := written[key]; ok {
+				return true
+			}
+		}
+
+		for _, r := range store.ranges {
+			for key := range written {
+				if r.contains([]byte(key)) {
+					return true
+				}
+			}
+		}
+	}
+
+	return false
+}
+
+// addWrites adds the keys written by the tx, including the accumulated ones, to
+// keys.
+func (ta *txAccess) addWrites(keys map[sdk.StoreKey]map[string]struct{}) {
+	for storeKey, store := range ta.stores {
+		if len(store.writes) == 0 && len(store.originals) == 0 {
+			continue
+		}
+
+		if keys[storeKey] == nil {
+			keys[storeKey] = make(map[string]struct{})
+		}
+		for key := range store.writes {
+			keys[storeKey][key] = struct{}{}
+		}
+		// an iterator over an accumulated key must see its merged value
+		for key := range store.originals {
+			keys[storeKey][key] = struct{}{}
+		}
+	}
+}
+
+type keyRange struct {
+	start, end []byte
+}
+
+func (r keyRange) contains(key []byte) bool {
+	return (r.start == nil || bytes.Compare(key, r.start) >= 0) &&
+		(r.end == nil || bytes.Compare(key, r.end) < 0)
+}
+
+// accessStore is a KVStore recording the keys read from and written to its
+// parent. The accumulated keys are not recorded as read or written, their
+// values before the tx are recorded instead. A tx is executed by a single
+// goroutine, so it doesn't need locking.
+type accessStore struct {
+	parent sdk.KVStore
+	reads  map[string]struct{}
+	ranges []keyRange
+	writes map[string]struct{}
+
+	isAccumulated func(key []byte) bool
+	originals     map[string][]byte
+	merged        map[string][]byte
+}
+
+// accumulate records the value of the key before the tx if it is accumulated.
+func (s *accessStore) accumulate(key []byte) bool {
+	if s.isAccumulated == nil || !s.isAccumulated(key) {
+		return false
+	}
+
+	if _, ok := s.originals[string(key)]; !ok {
+		s.originals[string(key)] = s.parent.Get(key)
+	}
+	return true
+}
+
+var _ sdk.KVStore = (*accessStore)(nil)
+
+// GetStoreType implements Store.
+func (s *accessStore) GetStoreType() sdk.StoreType {
+	return s.parent.GetStoreType()
+}
+
+// CacheWrap implements CacheWrapper.
+func (s *accessStore) CacheWrap() sdk.CacheWrap {
+	return cachekv.NewStore(s)
+}
+
+// CacheWrapWithTrace implements the CacheWrapper interface.
+func (s *accessStore) CacheWrapWithTrace(w io.Writer, tc sdk.TraceContext) sdk.CacheWrap {
+	return cachekv.NewStore(tracekv.NewStore(s, w, tc))
+}
+
+// Get implements KVStore.
+func (s *accessStore) Get(key []byte) []byte {
+	if !s.accumulate(key) {
+		s.reads[string(key)] = struct{}{}
+	}
+	return s.parent.Get(key)
+}
+
+// Has implements KVStore.
+func (s *accessStore) Has(key []byte) bool {
+	if !s.accumulate(key) {
+		s.reads[string(key)] = struct{}{}
+	}
+	return s.parent.Has(key)
+}
+
+// Set implements KVStore.
+func (s *accessStore) Set(key, value []byte) {
+	if !s.accumulate(key) {
+		s.writes[string(key)] = struct{}{}
+	} else if merged, ok := s.merged[string(key)]; ok {
+		s.setMerged(key, merged)
+		return
+	}
+	s.parent.Set(key, value)
+}
+
+// Delete implements KVStore.
+func (s *accessStore) Delete(key []byte) {
+	if !s.accumulate(key) {
+		s.writes[string(key)] = struct{}{}
+	} else if merged, ok := s.merged[string(key)]; ok {
+		s.setMerged(key, merged)
+		return
+	}
+	s.parent.Delete(key)
+}
+
+func (s *accessStore) setMerged(key, value []byte) {
+	if value == nil {
+		s.parent.Delete(key)
+		return
+	}
+	s.parent.Set(key, value)
+}
+
+// Iterator implements KVStore.
+func (s *accessStore) Iterator(start, end []byte) sdk.Iterator {
+	s.ranges = append(s.ranges, keyRange{start: start, end: end})
+	return s.parent.Iterator(start, end)
+}
+
+// ReverseIterator implements KVStore.
+func (s *accessStore) ReverseIterator(start, end []byte) sdk.Iterator {
+	s.ranges = append(s.ranges, keyRange{start: start, end: end})
+	return s.parent.ReverseIterator(start, end)
+}
@@ -0,0 +1,346 @@
+package baseapp
+
+import (
+	"fmt"
+	"strconv"
+	"sync"
+	"testing"
+
+	abci "github.com/line/ostracon/abci/types"
+	ostproto "github.com/line/ostracon/proto/ostracon/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/line/lfb-sdk/codec"
+	sdk "github.com/line/lfb-sdk/types"
+	sdkerrors "github.com/line/lfb-sdk/types/errors"
+)
+
+// handlerKeyValueAppend appends the msg value to the value stored under the
+// msg key, so the result depends on the order the msgs are executed in.
+func handlerKeyValueAppend(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+	kv := msg.(*msgKeyValue)
+	store := ctx.KVStore(capKey1)
+	if string(kv.Value) == "fail" {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "message handler failure")
+	}
+
+	value := append(append([]byte{}, store.Get(kv.Key)...), kv.Value...)
+	store.Set(kv.Key, value)
+	ctx.GasMeter().ConsumeGas(uint64(len(value)), "append")
+
+	return &sdk.Result{Data: value}, nil
+}
+
+func setupParallelTestApp(t *testing.T, options ...func(*BaseApp)) *BaseApp {
+	routerOpt := func(bapp *BaseApp) {
+		bapp.Router().AddRoute(sdk.NewRoute(routeMsgKeyValue, handlerKeyValueAppend))
+	}
+	anteOpt := func(bapp *BaseApp) {
+		bapp.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+			newCtx := ctx.WithGasMeter(sdk.NewGasMeter(100000))
+			store := newCtx.KVStore(capKey2)
+			store.Set([]byte(fmt.Sprintf("ante-%d", tx.(txTest).Counter)), []byte{1})
+			return newCtx, nil
+		})
+	}
+
+	app := setupBaseApp(t, append(options, routerOpt, anteOpt)...)
+	app.InitChain(abci.RequestInitChain{})
+	return app
+}
+
+func TestDeliverTxsMatchesDeliverTx(t *testing.T) {
+	cdc := codec.NewLegacyAmino()
+	registerTestCodec(cdc)
+
+	var reqs []abci.RequestDeliverTx
+	for i := 0; i < 50; i++ {
+		// every third tx appends to a shared key, the others to their own key
+		key := fmt.Sprintf("key-%d", i)
+		if i%3 == 0 {
+			key = "shared"
+		}
+		value := fmt.Sprintf("%d,", i)
+		if i%7 == 0 {
+			value = "fail"
+		}
+
+		tx := txTest{Msgs: []sdk.Msg{msgKeyValue{Key: []byte(key), Value: []byte(value)}}, Counter: int64(i)}
+		txBytes, err := cdc.MarshalBinaryBare(tx)
+		require.NoError(t, err)
+		reqs = append(reqs, abci.RequestDeliverTx{Tx: txBytes})
+	}
+	// a tx which can't be decoded
+	reqs = append(reqs, abci.RequestDeliverTx{Tx: []byte{}})
+
+	serialApp := setupParallelTestApp(t)
+	parallelApp := setupParallelTestApp(t, SetDeliverTxWorkers(4))
+
+	for height := int64(1); height <= 2; height++ {
+		header := ostproto.Header{Height: height}
+		serialApp.BeginBlock(abci.RequestBeginBlock{Header: header})
+		parallelApp.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+		var expected []abci.ResponseDeliverTx
+		for _, req := range reqs {
+			expected = append(expected, serialApp.DeliverTx(req))
+		}
+		require.Equal(t, expected, parallelApp.DeliverTxs(reqs))
+
+		serialApp.EndBlock(abci.RequestEndBlock{Height: height})
+		parallelApp.EndBlock(abci.RequestEndBlock{Height: height})
+		require.Equal(t, serialApp.Commit(), parallelApp.Commit())
+	}
+
+	store := parallelApp.cms.GetCommitKVStore(capKey1)
+	require.Equal(t, "3,6,9,12,15,18,24,27,30,33,36,39,45,48,3,6,9,12,15,18,24,27,30,33,36,39,45,48,", string(store.Get([]byte("shared"))))
+}
+
+func TestDeliverTxsDeclaredStoreAccess(t *testing.T) {
+	cdc := codec.NewLegacyAmino()
+	registerTestCodec(cdc)
+
+	var txs []*parallelTx
+	for i := 0; i < 4; i++ {
+		tx := txTest{Msgs: []sdk.Msg{msgKeyValue{Key: []byte("key"), Value: []byte("value")}}, Counter: int64(i)}
+		txs = append(txs, &parallelTx{tx: tx})
+	}
+
+	app := setupParallelTestApp(t, SetDeliverTxWorkers(4))
+	require.Equal(t, len(txs), app.nextTxBatch(txs, 0))
+
+	app = newBaseApp(t.Name(), SetDeliverTxWorkers(4))
+	app.SetTxStoreAccess(func(tx sdk.Tx) []string {
+		if tx.(txTest).Counter%2 == 0 {
+			return []string{capKey1.Name()}
+		}
+		return []string{capKey2.Name()}
+	})
+	require.Equal(t, 2, app.nextTxBatch(txs, 0))
+	require.Equal(t, 4, app.nextTxBatch(txs, 2))
+}
+
+func TestDeliverTxsOutOfBlockGas(t *testing.T) {
+	cdc := codec.NewLegacyAmino()
+	registerTestCodec(cdc)
+
+	var reqs []abci.RequestDeliverTx
+	for i := 0; i < 10; i++ {
+		tx := txTest{Msgs: []sdk.Msg{msgKeyValue{Key: []byte(fmt.Sprintf("key-%d", i)), Value: []byte("0123456789")}}, Counter: int64(i)}
+		txBytes, err := cdc.MarshalBinaryBare(tx)
+		require.NoError(t, err)
+		reqs = append(reqs, abci.RequestDeliverTx{Tx: txBytes})
+	}
+
+	serialApp := setupParallelTestApp(t)
+	parallelApp := setupParallelTestApp(t, SetDeliverTxWorkers(4))
+
+	header := ostproto.Header{Height: 1}
+	blockGasMeter := func() sdk.GasMeter { return sdk.NewGasMeter(17000) }
+
+	serialApp.BeginBlock(abci.RequestBeginBlock{Header: header})
+	serialApp.deliverState.ctx = serialApp.deliverState.ctx.WithBlockGasMeter(blockGasMeter())
+	parallelApp.BeginBlock(abci.RequestBeginBlock{Header: header})
+	parallelApp.deliverState.ctx = parallelApp.deliverState.ctx.WithBlockGasMeter(blockGasMeter())
+
+	var expected []abci.ResponseDeliverTx
+	for _, req := range reqs {
+		expected = append(expected, serialApp.DeliverTx(req))
+	}
+	res := parallelApp.DeliverTxs(reqs)
+	require.Equal(t, expected, res)
+	require.True(t, res[0].IsOK())
+	require.False(t, res[len(res)-1].IsOK())
+
+	serialApp.EndBlock(abci.RequestEndBlock{Height: 1})
+	parallelApp.EndBlock(abci.RequestEndBlock{Height: 1})
+	require.Equal(t, serialApp.Commit(), parallelApp.Commit())
+}
+
+// counterAccumulator accumulates the big endian counter stored under the
+// counter key of capKey2.
+type counterAccumulator struct{}
+
+func (counterAccumulator) IsAccumulated(storeKey sdk.StoreKey, key []byte) bool {
+	return storeKey == capKey2 && string(key) == "counter"
+}
+
+func (counterAccumulator) Merge(original, written, current []byte) ([]byte, error) {
+	o, w, c := counterValue(original), counterValue(written), counterValue(current)
+	if w < o {
+		return nil, fmt.Errorf("counter decreased from %d to %d", o, w)
+	}
+	return sdk.Uint64ToBigEndian(c + w - o), nil
+}
+
+func counterValue(bz []byte) uint64 {
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+func TestDeliverTxsAccumulator(t *testing.T) {
+	cdc := codec.NewLegacyAmino()
+	registerTestCodec(cdc)
+
+	var reqs []abci.RequestDeliverTx
+	for i := 0; i < 20; i++ {
+		tx := txTest{Msgs: []sdk.Msg{msgKeyValue{Key: []byte(fmt.Sprintf("key-%d", i)), Value: []byte("value")}}, Counter: int64(i)}
+		txBytes, err := cdc.MarshalBinaryBare(tx)
+		require.NoError(t, err)
+		reqs = append(reqs, abci.RequestDeliverTx{Tx: txBytes})
+	}
+
+	// every tx increments the counter like fee paying txs credit the fee collector
+	var executions int
+	var mtx sync.Mutex
+	routerOpt := func(bapp *BaseApp) {
+		bapp.Router().AddRoute(sdk.NewRoute(routeMsgKeyValue, handlerKeyValueAppend))
+	}
+	anteOpt := func(bapp *BaseApp) {
+		bapp.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+			mtx.Lock()
+			executions++
+			mtx.Unlock()
+
+			store := ctx.KVStore(capKey2)
+			store.Set([]byte("counter"), sdk.Uint64ToBigEndian(counterValue(store.Get([]byte("counter")))+1))
+			return ctx.WithGasMeter(sdk.NewGasMeter(100000)), nil
+		})
+	}
+	newApp := func(options ...func(*BaseApp)) *BaseApp {
+		app := setupBaseApp(t, append(options, routerOpt, anteOpt)...)
+		app.InitChain(abci.RequestInitChain{})
+		// a missing counter is shorter than a stored one, so it is stored first
+		app.cms.GetCommitKVStore(capKey2).Set([]byte("counter"), sdk.Uint64ToBigEndian(0))
+		return app
+	}
+
+	serialApp := newApp()
+	conflictingApp := newApp(SetDeliverTxWorkers(4))
+	parallelApp := newApp(SetDeliverTxWorkers(4), func(app *BaseApp) { app.SetTxAccumulator(counterAccumulator{}) })
+
+	for height := int64(1); height <= 2; height++ {
+		header := ostproto.Header{Height: height}
+		var expected []abci.ResponseDeliverTx
+		serialApp.BeginBlock(abci.RequestBeginBlock{Header: header})
+		for _, req := range reqs {
+			expected = append(expected, serialApp.DeliverTx(req))
+		}
+		serialApp.EndBlock(abci.RequestEndBlock{Height: height})
+
+		deliverTxs := func(app *BaseApp) int {
+			executions = 0
+			app.BeginBlock(abci.RequestBeginBlock{Header: header})
+			require.Equal(t, expected, app.DeliverTxs(reqs))
+			app.EndBlock(abci.RequestEndBlock{Height: height})
+			return executions
+		}
+
+		// without the accumulator, all the txs but the first one of the batch
+		// are executed again
+		require.Equal(t, 2*len(reqs)-1, deliverTxs(conflictingApp))
+		// with it, the concurrent additions to the counter are merged
+		require.Equal(t, len(reqs), deliverTxs(parallelApp))
+
+		serialHash := serialApp.Commit()
+		require.Equal(t, serialHash, conflictingApp.Commit())
+		require.Equal(t, serialHash, parallelApp.Commit())
+	}
+
+	store := parallelApp.cms.GetCommitKVStore(capKey2)
+	require.Equal(t, uint64(2*len(reqs)), counterValue(store.Get([]byte("counter"))))
+}
+
+// decimalAccumulator accumulates the decimal counter stored under the counter
+// key of capKey2, whose length grows with its value.
+type decimalAccumulator struct{}
+
+func (decimalAccumulator) IsAccumulated(storeKey sdk.StoreKey, key []byte) bool {
+	return storeKey == capKey2 && string(key) == "counter"
+}
+
+func (decimalAccumulator) Merge(original, written, current []byte) ([]byte, error) {
+	o, w, c := decimalValue(original), decimalValue(written), decimalValue(current)
+	if w < o {
+		return nil, fmt.Errorf("counter decreased from %d to %d", o, w)
+	}
+	return []byte(strconv.FormatUint(c+w-o, 10)), nil
+}
+
+func decimalValue(bz []byte) uint64 {
+	if bz == nil {
+		return 0
+	}
+	v, err := strconv.ParseUint(string(bz), 10, 64)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func TestDeliverTxsAccumulatorGas(t *testing.T) {
+	cdc := codec.NewLegacyAmino()
+	registerTestCodec(cdc)
+
+	var reqs []abci.RequestDeliverTx
+	for i := 0; i < 30; i++ {
+		tx := txTest{Msgs: []sdk.Msg{msgKeyValue{Key: []byte(fmt.Sprintf("key-%d", i)), Value: []byte("value")}}, Counter: int64(i)}
+		txBytes, err := cdc.MarshalBinaryBare(tx)
+		require.NoError(t, err)
+		reqs = append(reqs, abci.RequestDeliverTx{Tx: txBytes})
+	}
+
+	// every tx adds to the counter through the gas metered store, so the gas it
+	// uses depends on the length of the counter
+	var executions int
+	var mtx sync.Mutex
+	routerOpt := func(bapp *BaseApp) {
+		bapp.Router().AddRoute(sdk.NewRoute(routeMsgKeyValue, func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+			mtx.Lock()
+			executions++
+			mtx.Unlock()
+
+			store := ctx.KVStore(capKey2)
+			store.Set([]byte("counter"), []byte(strconv.FormatUint(decimalValue(store.Get([]byte("counter")))+1, 10)))
+			return handlerKeyValueAppend(ctx, msg)
+		}))
+	}
+	anteOpt := func(bapp *BaseApp) {
+		bapp.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+			return ctx.WithGasMeter(sdk.NewGasMeter(100000)), nil
+		})
+	}
+	newApp := func(options ...func(*BaseApp)) *BaseApp {
+		app := setupBaseApp(t, append(options, routerOpt, anteOpt)...)
+		app.InitChain(abci.RequestInitChain{})
+		app.cms.GetCommitKVStore(capKey2).Set([]byte("counter"), []byte("0"))
+		return app
+	}
+
+	serialApp := newApp()
+	parallelApp := newApp(SetDeliverTxWorkers(4), func(app *BaseApp) { app.SetTxAccumulator(decimalAccumulator{}) })
+
+	for height := int64(1); height <= 4; height++ {
+		header := ostproto.Header{Height: height}
+		var expected []abci.ResponseDeliverTx
+		serialApp.BeginBlock(abci.RequestBeginBlock{Header: header})
+		for _, req := range reqs {
+			expected = append(expected, serialApp.DeliverTx(req))
+		}
+		serialApp.EndBlock(abci.RequestEndBlock{Height: height})
+
+		executions = 0
+		parallelApp.BeginBlock(abci.RequestBeginBlock{Header: header})
+		require.Equal(t, expected, parallelApp.DeliverTxs(reqs))
+		parallelApp.EndBlock(abci.RequestEndBlock{Height: height})
+		// only the txs which saw a counter of another length are executed again
+		require.Less(t, executions, 2*len(reqs)-1)
+
+		require.Equal(t, serialApp.Commit(), parallelApp.Commit())
+	}
+
+	store := parallelApp.cms.GetCommitKVStore(capKey2)
+	require.Equal(t, uint64(4*len(reqs)), decimalValue(store.Get([]byte("counter"))))
+}
@@ -45,15 +45,12 @@ func (app *BaseApp) checkTxAsync(req *RequestCheckTxAsync, waits []*sync.WaitGro
 	app.checkAccountWGs.Wait(waits)
 	defer app.checkAccountWGs.Done(signals)
 
-	gInfo, err := app.checkTx(req.txBytes, req.tx, req.recheck)
+	gInfo, priority, sender, err := app.checkTxWithPriority(req.txBytes, req.tx, req.recheck)
 
 	if err != nil {
 		req.callback(sdkerrors.ResponseCheckTx(err, gInfo.GasWanted, gInfo.GasUsed, app.trace))
 		return
 	}
 
-	req.callback(abci.ResponseCheckTx{
-		GasWanted: int64(gInfo.GasWanted), // TODO: Should type accept unsigned ints?
-		GasUsed:   int64(gInfo.GasUsed),   // TODO: Should type accept unsigned ints?
-	})
+	req.callback(app.checkTxResponse(gInfo, priority, sender))
 }
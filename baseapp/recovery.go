@@ -45,7 +45,7 @@ func newRecoveryMiddleware(handler RecoveryHandler, next recoveryMiddleware) rec
 }
 
 // newOutOfGasRecoveryMiddleware creates a standard OutOfGas recovery middleware for app.runTx method.
-func newOutOfGasRecoveryMiddleware(gasWanted uint64, ctx sdk.Context, next recoveryMiddleware) recoveryMiddleware {
+func newOutOfGasRecoveryMiddleware(gasWanted, gasUsed uint64, next recoveryMiddleware) recoveryMiddleware {
 	handler := func(recoveryObj interface{}) error {
 		err, ok := recoveryObj.(sdk.ErrorOutOfGas)
 		if !ok {
@@ -55,7 +55,7 @@ func newOutOfGasRecoveryMiddleware(gasWanted uint64, ctx sdk.Context, next recov
 		return sdkerrors.Wrap(
 			sdkerrors.ErrOutOfGas, fmt.Sprintf(
 				"out of gas in location: %v; gasWanted: %d, gasUsed: %d",
-				err.Descriptor, gasWanted, ctx.GasMeter().GasConsumed(),
+				err.Descriptor, gasWanted, gasUsed,
 			),
 		)
 	}
@@ -0,0 +1,70 @@
+package baseapp
+
+import (
+	"io"
+
+	abci "github.com/line/ostracon/abci/types"
+
+	sdk "github.com/line/lfb-sdk/types"
+)
+
+// ABCIListener is the interface used to hook into the ABCI message processing of the BaseApp.
+// The state changes of a message are passed to the WriteListeners before the message is passed
+// to the ABCIListeners.
+type ABCIListener interface {
+	// ListenBeginBlock updates the streaming service with the latest BeginBlock messages
+	ListenBeginBlock(ctx sdk.Context, req abci.RequestBeginBlock, res abci.ResponseBeginBlock) error
+	// ListenEndBlock updates the streaming service with the latest EndBlock messages
+	ListenEndBlock(ctx sdk.Context, req abci.RequestEndBlock, res abci.ResponseEndBlock) error
+	// ListenDeliverTx updates the streaming service with the latest DeliverTx messages
+	ListenDeliverTx(ctx sdk.Context, req abci.RequestDeliverTx, res abci.ResponseDeliverTx) error
+}
+
+// StreamingService is the interface for registering WriteListeners with the BaseApp and updating
+// the service with the ABCI messages using the hooks.
+type StreamingService interface {
+	// Listeners returns the streaming service's listeners for the BaseApp to register
+	Listeners() map[sdk.StoreKey][]sdk.WriteListener
+	// ABCIListener interface for hooking into the ABCI messages from inside the BaseApp
+	ABCIListener
+	// Closer interface
+	io.Closer
+}
+
+// SetStreamingService is used to set a streaming service into the BaseApp hooks and load the listeners
+// into the multistore.
+func (app *BaseApp) SetStreamingService(s StreamingService) {
+	// add the listeners for each StoreKey
+	for key, lis := range s.Listeners() {
+		app.cms.AddListeners(key, lis)
+	}
+	// register the StreamingService within the BaseApp; BaseApp will pass BeginBlock, DeliverTx and
+	// EndBlock requests and responses to the streaming services to update their ABCI context
+	app.abciListeners = append(app.abciListeners, s)
+}
+
+// The listeners must not affect the state machine, so their errors are logged only.
+
+func (app *BaseApp) listenBeginBlock(req abci.RequestBeginBlock, res abci.ResponseBeginBlock) {
+	for _, listener := range app.abciListeners {
+		if err := listener.ListenBeginBlock(app.deliverState.ctx, req, res); err != nil {
+			app.logger.Error("BeginBlock listening hook failed", "height", req.Header.Height, "err", err)
+		}
+	}
+}
+
+func (app *BaseApp) listenEndBlock(req abci.RequestEndBlock, res abci.ResponseEndBlock) {
+	for _, listener := range app.abciListeners {
+		if err := listener.ListenEndBlock(app.deliverState.ctx, req, res); err != nil {
+			app.logger.Error("EndBlock listening hook failed", "height", req.Height, "err", err)
+		}
+	}
+}
+
+func (app *BaseApp) listenDeliverTx(req abci.RequestDeliverTx, res abci.ResponseDeliverTx) {
+	for _, listener := range app.abciListeners {
+		if err := listener.ListenDeliverTx(app.deliverState.ctx, req, res); err != nil {
+			app.logger.Error("DeliverTx listening hook failed", "height", app.deliverState.ctx.BlockHeight(), "err", err)
+		}
+	}
+}
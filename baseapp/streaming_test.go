@@ -0,0 +1,113 @@
+package baseapp
+
+import (
+	"testing"
+
+	abci "github.com/line/ostracon/abci/types"
+	ostproto "github.com/line/ostracon/proto/ostracon/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/line/lfb-sdk/codec"
+	storetypes "github.com/line/lfb-sdk/store/types"
+	sdk "github.com/line/lfb-sdk/types"
+)
+
+// mockStreamingService records the ABCI messages together with the state changes preceding them.
+type mockStreamingService struct {
+	listener *storetypes.MemoryListener
+
+	beginBlocks []abci.RequestBeginBlock
+	deliverTxs  []abci.ResponseDeliverTx
+	endBlocks   []abci.RequestEndBlock
+	changes     [][]*storetypes.StoreKVPair
+}
+
+var _ StreamingService = (*mockStreamingService)(nil)
+
+func (m *mockStreamingService) Listeners() map[sdk.StoreKey][]sdk.WriteListener {
+	return map[sdk.StoreKey][]sdk.WriteListener{capKey1: {m.listener}}
+}
+
+func (m *mockStreamingService) ListenBeginBlock(_ sdk.Context, req abci.RequestBeginBlock, _ abci.ResponseBeginBlock) error {
+	m.beginBlocks = append(m.beginBlocks, req)
+	m.changes = append(m.changes, m.listener.PopStateCache())
+	return nil
+}
+
+func (m *mockStreamingService) ListenDeliverTx(_ sdk.Context, _ abci.RequestDeliverTx, res abci.ResponseDeliverTx) error {
+	m.deliverTxs = append(m.deliverTxs, res)
+	m.changes = append(m.changes, m.listener.PopStateCache())
+	return nil
+}
+
+func (m *mockStreamingService) ListenEndBlock(_ sdk.Context, req abci.RequestEndBlock, _ abci.ResponseEndBlock) error {
+	m.endBlocks = append(m.endBlocks, req)
+	m.changes = append(m.changes, m.listener.PopStateCache())
+	return nil
+}
+
+func (m *mockStreamingService) Close() error { return nil }
+
+func TestStreamingService(t *testing.T) {
+	anteKey := []byte("ante-key")
+	anteOpt := func(bapp *BaseApp) { bapp.SetAnteHandler(anteHandlerTxTest(t, capKey1, anteKey)) }
+
+	deliverKey := []byte("deliver-key")
+	routerOpt := func(bapp *BaseApp) {
+		r := sdk.NewRoute(routeMsgCounter, handlerMsgCounter(t, capKey1, deliverKey))
+		bapp.Router().AddRoute(r)
+	}
+
+	app := setupBaseApp(t, anteOpt, routerOpt)
+	streamer := &mockStreamingService{listener: storetypes.NewMemoryListener()}
+	app.SetStreamingService(streamer)
+	app.InitChain(abci.RequestInitChain{})
+
+	cdc := codec.NewLegacyAmino()
+	registerTestCodec(cdc)
+
+	app.BeginBlock(abci.RequestBeginBlock{Header: ostproto.Header{Height: 1}})
+
+	tx := newTxCounter(0, 0)
+	txBytes, err := cdc.MarshalBinaryBare(tx)
+	require.NoError(t, err)
+	res := app.DeliverTx(abci.RequestDeliverTx{Tx: txBytes})
+	require.True(t, res.IsOK(), res.Log)
+
+	// a failing tx is streamed without state changes
+	tx = newTxCounter(1, 1)
+	tx.setFailOnAnte(true)
+	txBytes, err = cdc.MarshalBinaryBare(tx)
+	require.NoError(t, err)
+	res = app.DeliverTx(abci.RequestDeliverTx{Tx: txBytes})
+	require.False(t, res.IsOK())
+
+	app.EndBlock(abci.RequestEndBlock{Height: 1})
+	app.Commit()
+
+	require.Len(t, streamer.beginBlocks, 1)
+	require.Equal(t, int64(1), streamer.beginBlocks[0].Header.Height)
+	require.Len(t, streamer.deliverTxs, 2)
+	require.True(t, streamer.deliverTxs[0].IsOK())
+	require.False(t, streamer.deliverTxs[1].IsOK())
+	require.Len(t, streamer.endBlocks, 1)
+
+	require.Len(t, streamer.changes, 4)
+	require.Empty(t, streamer.changes[0], "BeginBlock")
+	require.Len(t, streamer.changes[1], 2, "the ante handler and the msg handler write a counter each")
+	for _, change := range streamer.changes[1] {
+		require.Equal(t, capKey1.Name(), change.StoreKey)
+		require.False(t, change.Delete)
+	}
+	require.Equal(t, anteKey, streamer.changes[1][0].Key)
+	require.Equal(t, deliverKey, streamer.changes[1][1].Key)
+	require.Empty(t, streamer.changes[2], "failed DeliverTx")
+	require.Empty(t, streamer.changes[3], "EndBlock")
+
+	// CheckTx state changes are not streamed
+	checkTx := newTxCounter(1, 0)
+	txBytes, err = cdc.MarshalBinaryBare(checkTx)
+	require.NoError(t, err)
+	require.True(t, app.CheckTxSync(abci.RequestCheckTx{Tx: txBytes}).IsOK())
+	require.Empty(t, streamer.listener.PopStateCache())
+}
@@ -64,6 +64,8 @@ func GenerateTx(clientCtx client.Context, txf Factory, msgs ...sdk.Msg) error {
 		return err
 	}
 
+	tx.SetFeeGranter(clientCtx.FeeGranter)
+
 	json, err := clientCtx.TxConfig.TxJSONEncoder()(tx.GetTx())
 	if err != nil {
 		return err
@@ -100,6 +102,8 @@ func BroadcastTx(clientCtx client.Context, txf Factory, msgs ...sdk.Msg) error {
 		return err
 	}
 
+	tx.SetFeeGranter(clientCtx.FeeGranter)
+
 	if !clientCtx.SkipConfirm {
 		out, err := clientCtx.TxConfig.TxJSONEncoder()(tx.GetTx())
 		if err != nil {
@@ -242,9 +246,23 @@ func BuildUnsignedTx(txf Factory, msgs ...sdk.Msg) (client.TxBuilder, error) {
 	tx.SetGasLimit(txf.gas)
 	tx.SetTimeoutHeight(txf.TimeoutHeight())
 
+	if txf.SigBlockHeight() != 0 {
+		heightTx, ok := tx.(sigBlockHeightSetter)
+		if !ok {
+			return nil, fmt.Errorf("%T does not support sig block heights", tx)
+		}
+		heightTx.SetSigBlockHeight(txf.SigBlockHeight())
+	}
+
 	return tx, nil
 }
 
+// sigBlockHeightSetter is implemented by the TxBuilders supporting sig block
+// heights, which the legacy amino StdTx doesn't.
+type sigBlockHeightSetter interface {
+	SetSigBlockHeight(height uint64)
+}
+
 // BuildSimTx creates an unsigned tx with an empty single signature and returns
 // the encoded transaction or an error if the unsigned transaction cannot be
 // built.
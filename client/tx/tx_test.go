@@ -1,6 +1,7 @@
 package tx_test
 
 import (
+	"context"
 	"errors"
 	"testing"
 
@@ -8,6 +9,7 @@ import (
 
 	"github.com/line/lfb-sdk/client"
 	"github.com/line/lfb-sdk/client/tx"
+	"github.com/line/lfb-sdk/codec"
 	"github.com/line/lfb-sdk/crypto/hd"
 	"github.com/line/lfb-sdk/crypto/keyring"
 	cryptotypes "github.com/line/lfb-sdk/crypto/types"
@@ -16,6 +18,7 @@ import (
 	txtypes "github.com/line/lfb-sdk/types/tx"
 	signingtypes "github.com/line/lfb-sdk/types/tx/signing"
 	"github.com/line/lfb-sdk/x/auth/signing"
+	authtx "github.com/line/lfb-sdk/x/auth/tx"
 	banktypes "github.com/line/lfb-sdk/x/bank/types"
 )
 
@@ -230,3 +233,50 @@ func testSigners(require *require.Assertions, tr signing.Tx, pks ...cryptotypes.
 	}
 	return sigs
 }
+
+func TestSignTextual(t *testing.T) {
+	requireT := require.New(t)
+	path := hd.CreateHDPath(118, 0, 0).String()
+	kr, err := keyring.New(t.Name(), "test", t.TempDir(), nil)
+	requireT.NoError(err)
+
+	info, _, err := kr.NewMnemonic("test_key", keyring.English, path, hd.Secp256k1)
+	requireT.NoError(err)
+
+	metadataQueryFn := func(_ context.Context, denom string) (*banktypes.Metadata, error) {
+		return &banktypes.Metadata{
+			DenomUnits: []*banktypes.DenomUnit{{Denom: "stake"}, {Denom: "STAKE", Exponent: 6}},
+			Base:       "stake",
+			Display:    "STAKE",
+		}, nil
+	}
+	encCfg := simapp.MakeTestEncodingConfig()
+	signModes := append(authtx.DefaultSignModes, signingtypes.SignMode_SIGN_MODE_TEXTUAL)
+	txCfg := authtx.NewTxConfigWithTextual(codec.NewProtoCodec(encCfg.InterfaceRegistry), signModes, metadataQueryFn)
+
+	txf := tx.Factory{}.
+		WithTxConfig(txCfg).
+		WithKeybase(kr).
+		WithAccountNumber(50).
+		WithSequence(23).
+		WithFees("50stake").
+		WithMemo("memo").
+		WithChainID("test-chain").
+		WithSignMode(signingtypes.SignMode_SIGN_MODE_TEXTUAL)
+	msg := banktypes.NewMsgSend(info.GetAddress(), sdk.AccAddress("to"), sdk.NewCoins(sdk.NewInt64Coin("stake", 10)))
+	txb, err := tx.BuildUnsignedTx(txf, msg)
+	requireT.NoError(err)
+	requireT.NoError(tx.Sign(txf, "test_key", txb, true))
+
+	sigs := testSigners(requireT, txb.GetTx(), info.GetPubKey())
+	requireT.Equal(signingtypes.SignMode_SIGN_MODE_TEXTUAL, sigs[0].Data.(*signingtypes.SingleSignatureData).SignMode)
+
+	signerData := signing.SignerData{ChainID: "test-chain", AccountNumber: 50, Sequence: 23}
+	err = signing.VerifySignature(context.Background(), info.GetPubKey(), signerData, sigs[0].Data, txCfg.SignModeHandler(), txb.GetTx())
+	requireT.NoError(err)
+
+	// the signature is invalid for a node rendering the coins without metadata
+	noMetadataTxCfg := authtx.NewTxConfigWithTextual(codec.NewProtoCodec(encCfg.InterfaceRegistry), signModes, nil)
+	err = signing.VerifySignature(context.Background(), info.GetPubKey(), signerData, sigs[0].Data, noMetadataTxCfg.SignModeHandler(), txb.GetTx())
+	requireT.Error(err)
+}
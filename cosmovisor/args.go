@@ -6,13 +6,17 @@ import (
 	"net/url"
 	"os"
 	"path/filepath"
+	"strconv"
 )
 
 const (
-	rootName    = "cosmovisor"
-	genesisDir  = "genesis"
-	upgradesDir = "upgrades"
-	currentLink = "current"
+	rootName     = "cosmovisor"
+	genesisDir   = "genesis"
+	upgradesDir  = "upgrades"
+	currentLink  = "current"
+	dataDir      = "data"
+	backupPrefix = "data-backup-"
+	rollbackFile = "rollback.json"
 )
 
 // Config is the information passed in to control the daemon
@@ -21,6 +25,9 @@ type Config struct {
 	Name                  string
 	AllowDownloadBinaries bool
 	RestartAfterUpgrade   bool
+	DataBackupDir         string
+	UnsafeSkipBackup      bool
+	PreUpgradeMaxRetries  int
 }
 
 // Root returns the root directory where all info lives
@@ -44,6 +51,26 @@ func (cfg *Config) UpgradeDir(upgradeName string) string {
 	return filepath.Join(cfg.Root(), upgradesDir, safeName)
 }
 
+// DataDir is the data directory of the daemon, which is backed up before an upgrade
+func (cfg *Config) DataDir() string {
+	return filepath.Join(cfg.Home, dataDir)
+}
+
+// BackupDir is the directory the data is backed up to before the named upgrade
+func (cfg *Config) BackupDir(upgradeName string) string {
+	dir := cfg.DataBackupDir
+	if dir == "" {
+		dir = cfg.Home
+	}
+	safeName := url.PathEscape(upgradeName)
+	return filepath.Join(dir, backupPrefix+safeName)
+}
+
+// RollbackFile is the path to the file recording the upgrade to roll back if the new binary fails
+func (cfg *Config) RollbackFile() string {
+	return filepath.Join(cfg.Root(), rollbackFile)
+}
+
 // Symlink to genesis
 func (cfg *Config) SymLinkToGenesis() (string, error) {
 	genesis := filepath.Join(cfg.Root(), genesisDir)
@@ -99,6 +126,20 @@ func GetConfigFromEnv() (*Config, error) {
 		cfg.RestartAfterUpgrade = true
 	}
 
+	cfg.DataBackupDir = os.Getenv("DAEMON_DATA_BACKUP_DIR")
+
+	if os.Getenv("UNSAFE_SKIP_BACKUP") == "true" {
+		cfg.UnsafeSkipBackup = true
+	}
+
+	if retries := os.Getenv("DAEMON_PREUPGRADE_MAX_RETRIES"); retries != "" {
+		maxRetries, err := strconv.Atoi(retries)
+		if err != nil {
+			return nil, fmt.Errorf("DAEMON_PREUPGRADE_MAX_RETRIES is not a number: %w", err)
+		}
+		cfg.PreUpgradeMaxRetries = maxRetries
+	}
+
 	if err := cfg.validate(); err != nil {
 		return nil, err
 	}
@@ -122,6 +163,14 @@ func (cfg *Config) validate() error {
 		return errors.New("DAEMON_HOME must be an absolute path")
 	}
 
+	if cfg.DataBackupDir != "" && !filepath.IsAbs(cfg.DataBackupDir) {
+		return errors.New("DAEMON_DATA_BACKUP_DIR must be an absolute path")
+	}
+
+	if cfg.PreUpgradeMaxRetries < 0 {
+		return errors.New("DAEMON_PREUPGRADE_MAX_RETRIES cannot be negative")
+	}
+
 	// ensure the root directory exists
 	info, err := os.Stat(cfg.Root())
 	if err != nil {
@@ -23,6 +23,7 @@ func (s *argsTestSuite) TestConfigPaths() {
 		expectRoot    string
 		expectGenesis string
 		expectUpgrade string
+		expectBackup  string
 	}{
 		"simple": {
 			cfg:           Config{Home: "/foo", Name: "myd"},
@@ -30,6 +31,7 @@ func (s *argsTestSuite) TestConfigPaths() {
 			expectRoot:    fmt.Sprintf("/foo/%s", rootName),
 			expectGenesis: fmt.Sprintf("/foo/%s/genesis/bin/myd", rootName),
 			expectUpgrade: fmt.Sprintf("/foo/%s/upgrades/bar/bin/myd", rootName),
+			expectBackup:  "/foo/data-backup-bar",
 		},
 		"handle space": {
 			cfg:           Config{Home: "/longer/prefix/", Name: "yourd"},
@@ -37,6 +39,15 @@ func (s *argsTestSuite) TestConfigPaths() {
 			expectRoot:    fmt.Sprintf("/longer/prefix/%s", rootName),
 			expectGenesis: fmt.Sprintf("/longer/prefix/%s/genesis/bin/yourd", rootName),
 			expectUpgrade: "/longer/prefix/cosmovisor/upgrades/some%20spaces/bin/yourd",
+			expectBackup:  "/longer/prefix/data-backup-some%20spaces",
+		},
+		"backup dir": {
+			cfg:           Config{Home: "/foo", Name: "myd", DataBackupDir: "/backups"},
+			upgradeName:   "bar",
+			expectRoot:    fmt.Sprintf("/foo/%s", rootName),
+			expectGenesis: fmt.Sprintf("/foo/%s/genesis/bin/myd", rootName),
+			expectUpgrade: fmt.Sprintf("/foo/%s/upgrades/bar/bin/myd", rootName),
+			expectBackup:  "/backups/data-backup-bar",
 		},
 	}
 
@@ -44,6 +55,7 @@ func (s *argsTestSuite) TestConfigPaths() {
 		s.Require().Equal(tc.cfg.Root(), filepath.FromSlash(tc.expectRoot))
 		s.Require().Equal(tc.cfg.GenesisBin(), filepath.FromSlash(tc.expectGenesis))
 		s.Require().Equal(tc.cfg.UpgradeBin(tc.upgradeName), filepath.FromSlash(tc.expectUpgrade))
+		s.Require().Equal(tc.cfg.BackupDir(tc.upgradeName), filepath.FromSlash(tc.expectBackup))
 	}
 }
 
@@ -68,6 +80,18 @@ func (s *argsTestSuite) TestValidate() {
 			cfg:   Config{Home: absPath, Name: "bind", AllowDownloadBinaries: true},
 			valid: true,
 		},
+		"happy with backup dir": {
+			cfg:   Config{Home: absPath, Name: "bind", DataBackupDir: absPath, PreUpgradeMaxRetries: 2},
+			valid: true,
+		},
+		"relative backup dir": {
+			cfg:   Config{Home: absPath, Name: "bind", DataBackupDir: relPath},
+			valid: false,
+		},
+		"negative pre-upgrade retries": {
+			cfg:   Config{Home: absPath, Name: "bind", PreUpgradeMaxRetries: -1},
+			valid: false,
+		},
 		"missing home": {
 			cfg:   Config{Name: "bind"},
 			valid: false,
@@ -0,0 +1,112 @@
+package cosmovisor
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/otiai10/copy"
+)
+
+// RollbackInfo is the information needed to undo an upgrade until the new binary
+// commits its first block
+type RollbackInfo struct {
+	// Name is the name of the upgrade
+	Name string `json:"name"`
+	// Previous is the directory the current link pointed to before the upgrade
+	Previous string `json:"previous"`
+	// Backup is the directory the data was backed up to before the upgrade
+	Backup string `json:"backup"`
+}
+
+// BackupData copies the data directory before the named upgrade.
+// It returns the backup directory, or an empty string if the backup is skipped
+// or there is no data directory to back up.
+func (cfg *Config) BackupData(upgradeName string) (string, error) {
+	if cfg.UnsafeSkipBackup {
+		return "", nil
+	}
+
+	if _, err := os.Stat(cfg.DataDir()); os.IsNotExist(err) {
+		return "", nil
+	}
+
+	// a backup left over from a previous attempt of the same upgrade is replaced
+	backup := cfg.BackupDir(upgradeName)
+	if err := os.RemoveAll(backup); err != nil {
+		return "", fmt.Errorf("removing previous backup %s: %w", backup, err)
+	}
+
+	if err := copy.Copy(cfg.DataDir(), backup); err != nil {
+		return "", fmt.Errorf("backing up data to %s: %w", backup, err)
+	}
+
+	return backup, nil
+}
+
+// SetPendingRollback records the upgrade to roll back if the new binary fails before
+// committing its first block
+func (cfg *Config) SetPendingRollback(info RollbackInfo) error {
+	bz, err := json.Marshal(info)
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(cfg.RollbackFile(), bz, 0600)
+}
+
+// PendingRollback returns the upgrade to roll back if the new binary fails,
+// or nil if the last upgrade is already confirmed
+func (cfg *Config) PendingRollback() (*RollbackInfo, error) {
+	bz, err := ioutil.ReadFile(cfg.RollbackFile())
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reading rollback info: %w", err)
+	}
+
+	var info RollbackInfo
+	if err := json.Unmarshal(bz, &info); err != nil {
+		return nil, fmt.Errorf("parsing rollback info: %w", err)
+	}
+
+	return &info, nil
+}
+
+// ClearPendingRollback confirms the last upgrade, so it is never rolled back
+func (cfg *Config) ClearPendingRollback() error {
+	if err := os.Remove(cfg.RollbackFile()); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+// Rollback points the current link back to the binary used before the upgrade
+// and restores the data directory from the backup taken before the upgrade
+func (cfg *Config) Rollback(info *RollbackInfo) error {
+	link := filepath.Join(cfg.Root(), currentLink)
+
+	// remove link if it exists
+	if _, err := os.Lstat(link); err == nil {
+		if err := os.Remove(link); err != nil {
+			return fmt.Errorf("removing current symlink: %w", err)
+		}
+	}
+
+	if err := os.Symlink(info.Previous, link); err != nil {
+		return fmt.Errorf("creating current symlink: %w", err)
+	}
+
+	if err := os.RemoveAll(cfg.DataDir()); err != nil {
+		return fmt.Errorf("removing data dir: %w", err)
+	}
+
+	if err := copy.Copy(info.Backup, cfg.DataDir()); err != nil {
+		return fmt.Errorf("restoring data from %s: %w", info.Backup, err)
+	}
+
+	return cfg.ClearPendingRollback()
+}
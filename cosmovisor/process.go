@@ -2,6 +2,7 @@ package cosmovisor
 
 import (
 	"bufio"
+	"bytes"
 	"fmt"
 	"io"
 	"log"
@@ -10,11 +11,15 @@ import (
 	"os/signal"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"syscall"
 )
 
 // LaunchProcess runs a subprocess and returns when the subprocess exits,
 // either when it dies, or *after* a successful upgrade.
+//
+// If the subprocess is the binary of the last upgrade and dies before committing
+// its first block, the upgrade is rolled back to the previous binary and data.
 func LaunchProcess(cfg *Config, args []string, stdout, stderr io.Writer) (bool, error) {
 	bin, err := cfg.CurrentBin()
 	if err != nil {
@@ -25,6 +30,11 @@ func LaunchProcess(cfg *Config, args []string, stdout, stderr io.Writer) (bool,
 		return false, fmt.Errorf("current binary invalid: %w", err)
 	}
 
+	rollback, err := cfg.PendingRollback()
+	if err != nil {
+		return false, err
+	}
+
 	cmd := exec.Command(bin, args...)
 	outpipe, err := cmd.StdoutPipe()
 	if err != nil {
@@ -36,17 +46,28 @@ func LaunchProcess(cfg *Config, args []string, stdout, stderr io.Writer) (bool,
 		return false, err
 	}
 
-	scanOut := bufio.NewScanner(io.TeeReader(outpipe, stdout))
-	scanErr := bufio.NewScanner(io.TeeReader(errpipe, stderr))
+	// the upgrade is confirmed once the new binary commits its first block
+	commits := &CommitWatcher{}
+	if rollback != nil {
+		commits.OnCommit = func() {
+			_ = cfg.ClearPendingRollback()
+		}
+	}
+
+	scanOut := bufio.NewScanner(io.TeeReader(outpipe, io.MultiWriter(stdout, commits)))
+	scanErr := bufio.NewScanner(io.TeeReader(errpipe, io.MultiWriter(stderr, commits)))
 
 	if err := cmd.Start(); err != nil {
 		return false, fmt.Errorf("launching process %s %s: %w", bin, strings.Join(args, " "), err)
 	}
 
+	// a process stopped by the admin is not a failed upgrade
+	var stopped int32
 	sigs := make(chan os.Signal, 1)
 	signal.Notify(sigs, syscall.SIGQUIT, syscall.SIGTERM)
 	go func() {
 		sig := <-sigs
+		atomic.StoreInt32(&stopped, 1)
 		if err := cmd.Process.Signal(sig); err != nil {
 			log.Fatal(err)
 		}
@@ -55,6 +76,12 @@ func LaunchProcess(cfg *Config, args []string, stdout, stderr io.Writer) (bool,
 	// three ways to exit - command ends, find regexp in scanOut, find regexp in scanErr
 	upgradeInfo, err := WaitForUpgradeOrExit(cmd, scanOut, scanErr)
 	if err != nil {
+		if rollback != nil && !commits.Committed() && atomic.LoadInt32(&stopped) == 0 {
+			if rbErr := cfg.Rollback(rollback); rbErr != nil {
+				return false, fmt.Errorf("upgrade %q failed (%v), and rollback failed: %w", rollback.Name, err, rbErr)
+			}
+			return false, fmt.Errorf("upgrade %q failed before committing a block, rolled back to the previous binary and data: %w", rollback.Name, err)
+		}
 		return false, err
 	}
 
@@ -65,6 +92,55 @@ func LaunchProcess(cfg *Config, args []string, stdout, stderr io.Writer) (bool,
 	return false, nil
 }
 
+// CommitWatcher is an io.Writer looking for the log line of a committed block in
+// the output of the process. It can be shared by several streams.
+type CommitWatcher struct {
+	// OnCommit is called once when the first committed block is seen
+	OnCommit func()
+
+	buf       []byte
+	committed bool
+	mutex     sync.Mutex
+}
+
+// Write scans the complete lines written so far for a committed block
+func (w *CommitWatcher) Write(p []byte) (int, error) {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	if w.committed {
+		return len(p), nil
+	}
+
+	w.buf = append(w.buf, p...)
+	for {
+		i := bytes.IndexByte(w.buf, '\n')
+		if i < 0 {
+			break
+		}
+		line := w.buf[:i]
+		w.buf = w.buf[i+1:]
+
+		if committedRegex.Match(line) {
+			w.committed = true
+			w.buf = nil
+			if w.OnCommit != nil {
+				w.OnCommit()
+			}
+			break
+		}
+	}
+
+	return len(p), nil
+}
+
+// Committed returns true if a committed block was seen
+func (w *CommitWatcher) Committed() bool {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+	return w.committed
+}
+
 // WaitResult is used to wrap feedback on cmd state with some mutex logic.
 // This is needed as multiple go-routines can affect this - two read pipes that can trigger upgrade
 // As well as the command, which can fail
@@ -126,11 +202,20 @@ func WaitForUpgradeOrExit(cmd *exec.Cmd, scanOut, scanErr *bufio.Scanner) (*Upgr
 	}
 
 	// wait for the scanners, which can trigger upgrade and kill cmd
-	go waitScan(scanOut)
-	go waitScan(scanErr)
+	var wg sync.WaitGroup
+	wg.Add(2)
+	for _, scan := range []*bufio.Scanner{scanOut, scanErr} {
+		go func(scan *bufio.Scanner) {
+			defer wg.Done()
+			waitScan(scan)
+		}(scan)
+	}
+
+	// the pipes are closed by Wait, so the output is read to the end before,
+	// or until an upgrade is found, to not miss the last lines of the process
+	wg.Wait()
 
 	// if the command exits normally (eg. short command like `gaiad version`), just return (nil, nil)
-	// we often get broken read pipes if it runs too fast.
 	// if we had upgrade info, we would have killed it, and thus got a non-nil error code
 	err := cmd.Wait()
 	if err == nil {
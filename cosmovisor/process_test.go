@@ -4,6 +4,8 @@ package cosmovisor_test
 
 import (
 	"bytes"
+	"io/ioutil"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/suite"
@@ -57,6 +59,71 @@ func (s *processTestSuite) TestLaunchProcess() {
 	s.Require().Equal(cfg.UpgradeBin("chain2"), currentBin)
 }
 
+// TestLaunchProcessRollback will upgrade to a binary failing before its first block
+// and make sure the previous binary and data are restored
+func (s *processTestSuite) TestLaunchProcessRollback() {
+	home := copyTestData(s.T(), "rollback")
+	cfg := &cosmovisor.Config{Home: home, Name: "dummyd"}
+	statePath := filepath.Join(cfg.DataDir(), "state.txt")
+
+	var stdout, stderr bytes.Buffer
+	doUpgrade, err := cosmovisor.LaunchProcess(cfg, []string{"start"}, &stdout, &stderr)
+	s.Require().NoError(err)
+	s.Require().True(doUpgrade)
+
+	currentBin, err := cfg.CurrentBin()
+	s.Require().NoError(err)
+	s.Require().Equal(cfg.UpgradeBin("broken"), currentBin)
+
+	// the new binary migrates the data and fails
+	s.Require().NoError(ioutil.WriteFile(statePath, []byte("migrated\n"), 0600))
+	stdout.Reset()
+	stderr.Reset()
+	doUpgrade, err = cosmovisor.LaunchProcess(cfg, []string{"start"}, &stdout, &stderr)
+	s.Require().Error(err)
+	s.Require().Contains(err.Error(), "rolled back")
+	s.Require().False(doUpgrade)
+	s.Require().Equal("Upgrade handler panicked\n", stderr.String())
+
+	// the previous binary and data are restored
+	currentBin, err = cfg.CurrentBin()
+	s.Require().NoError(err)
+	s.Require().Equal(cfg.GenesisBin(), currentBin)
+
+	state, err := ioutil.ReadFile(statePath)
+	s.Require().NoError(err)
+	s.Require().Equal("genesis\n", string(state))
+
+	rollback, err := cfg.PendingRollback()
+	s.Require().NoError(err)
+	s.Require().Nil(rollback)
+}
+
+// TestLaunchProcessNoRollbackAfterCommit will upgrade to a binary failing after its first block
+// and make sure the upgrade is kept
+func (s *processTestSuite) TestLaunchProcessNoRollbackAfterCommit() {
+	home := copyTestData(s.T(), "rollback")
+	cfg := &cosmovisor.Config{Home: home, Name: "dummyd"}
+
+	err := cosmovisor.DoUpgrade(cfg, &cosmovisor.UpgradeInfo{Name: "healthy"})
+	s.Require().NoError(err)
+
+	var stdout, stderr bytes.Buffer
+	doUpgrade, err := cosmovisor.LaunchProcess(cfg, []string{"start"}, &stdout, &stderr)
+	s.Require().Error(err)
+	s.Require().NotContains(err.Error(), "rolled back")
+	s.Require().False(doUpgrade)
+
+	// the upgrade was confirmed by the committed block
+	currentBin, err := cfg.CurrentBin()
+	s.Require().NoError(err)
+	s.Require().Equal(cfg.UpgradeBin("healthy"), currentBin)
+
+	rollback, err := cfg.PendingRollback()
+	s.Require().NoError(err)
+	s.Require().Nil(rollback)
+}
+
 // TestLaunchProcess will try running the script a few times and watch upgrades work properly
 // and args are passed through
 func (s *processTestSuite) TestLaunchProcessWithDownloads() {
@@ -16,6 +16,9 @@ import (
 //    return fmt.Sprintf("height: %d", p.Height)
 var upgradeRegex = regexp.MustCompile(`UPGRADE "(.*)" NEEDED at ((height): (\d+)|(time): (\S+)):\s+(\S*)`)
 
+// committedRegex matches the log line of ostracon once the state of a block is committed
+var committedRegex = regexp.MustCompile(`committed state`)
+
 // UpgradeInfo is the details from the regexp
 type UpgradeInfo struct {
 	Name string
@@ -1,12 +1,19 @@
 package cosmovisor
 
 import (
+	"bytes"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"hash"
+	"io"
 	"io/ioutil"
 	"net/url"
 	"os"
+	"os/exec"
 	"path/filepath"
 	"runtime"
 	"strings"
@@ -14,15 +21,68 @@ import (
 	"github.com/hashicorp/go-getter"
 )
 
+// Exit codes of the pre-upgrade command of the new binary
+const (
+	// PreUpgradeNotImplemented is returned if the binary has no pre-upgrade command
+	PreUpgradeNotImplemented = 1
+	// PreUpgradeFailed is returned if the pre-upgrade failed and must not be retried
+	PreUpgradeFailed = 30
+	// PreUpgradeRetry is returned if the pre-upgrade failed and can be retried
+	PreUpgradeRetry = 31
+)
+
 // DoUpgrade will be called after the log message has been parsed and the process has terminated.
 // We can now make any changes to the underlying directory without interference and leave it
 // in a state, so we can make a proper restart
+//
+// The data directory is backed up before switching to the new binary, so the upgrade can be
+// rolled back if the new binary fails before committing its first block
 func DoUpgrade(cfg *Config, info *UpgradeInfo) error {
-	// Simplest case is to switch the link
+	if err := PrepareUpgradeBinary(cfg, info); err != nil {
+		return err
+	}
+
+	if err := VerifyUpgradeBinary(cfg, info); err != nil {
+		return err
+	}
+
+	// the current link is created if it doesn't exist yet
+	currentBin, err := cfg.CurrentBin()
+	if err != nil {
+		return fmt.Errorf("error creating symlink to genesis: %w", err)
+	}
+
+	backup, err := cfg.BackupData(info.Name)
+	if err != nil {
+		return err
+	}
+
+	if err := RunPreUpgrade(cfg, info); err != nil {
+		return err
+	}
+
+	// without a data backup, restoring the previous binary on top of migrated data is unsafe
+	if backup != "" {
+		rollback := RollbackInfo{
+			Name:     info.Name,
+			Previous: filepath.Dir(filepath.Dir(currentBin)),
+			Backup:   backup,
+		}
+		if err := cfg.SetPendingRollback(rollback); err != nil {
+			return fmt.Errorf("recording rollback info: %w", err)
+		}
+	}
+
+	return cfg.SetCurrentUpgrade(info.Name)
+}
+
+// PrepareUpgradeBinary ensures the binary of the upgrade is in place, downloading it if
+// it is missing and downloads are allowed
+func PrepareUpgradeBinary(cfg *Config, info *UpgradeInfo) error {
+	// Simplest case is the binary is already there
 	err := EnsureBinary(cfg.UpgradeBin(info.Name))
 	if err == nil {
-		// we have the binary - do it
-		return cfg.SetCurrentUpgrade(info.Name)
+		return nil
 	}
 	// if auto-download is disabled, we fail
 	if !cfg.AllowDownloadBinaries {
@@ -39,12 +99,110 @@ func DoUpgrade(cfg *Config, info *UpgradeInfo) error {
 		return fmt.Errorf("cannot download binary: %w", err)
 	}
 
-	// and then set the binary again
+	// and then check the binary again
 	if err := EnsureBinary(cfg.UpgradeBin(info.Name)); err != nil {
 		return fmt.Errorf("downloaded binary doesn't check out: %w", err)
 	}
 
-	return cfg.SetCurrentUpgrade(info.Name)
+	return nil
+}
+
+// VerifyUpgradeBinary checks the binary of the upgrade against the checksum declared for
+// the os/architecture in the upgrade info, if any
+func VerifyUpgradeBinary(cfg *Config, info *UpgradeInfo) error {
+	config, err := GetUpgradeConfig(info)
+	if err != nil {
+		// the info doesn't declare any checksum to verify
+		return nil
+	}
+
+	checksum, ok := config.Checksums[OSArch()]
+	if !ok {
+		checksum, ok = config.Checksums["any"]
+	}
+	if !ok {
+		return nil
+	}
+
+	return VerifyChecksum(cfg.UpgradeBin(info.Name), checksum)
+}
+
+// VerifyChecksum checks the file against a checksum with the format <type>:<hex value>,
+// where the type is either sha256 or sha512
+func VerifyChecksum(path, checksum string) error {
+	split := strings.SplitN(checksum, ":", 2)
+	if len(split) != 2 {
+		return fmt.Errorf("checksum %s is not in the format <type>:<value>", checksum)
+	}
+
+	var h hash.Hash
+	switch split[0] {
+	case "sha256":
+		h = sha256.New()
+	case "sha512":
+		h = sha512.New()
+	default:
+		return fmt.Errorf("unsupported checksum type %s", split[0])
+	}
+
+	expected, err := hex.DecodeString(split[1])
+	if err != nil {
+		return fmt.Errorf("invalid checksum value: %w", err)
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("opening binary for checksum: %w", err)
+	}
+	defer f.Close()
+
+	if _, err := io.Copy(h, f); err != nil {
+		return fmt.Errorf("reading binary for checksum: %w", err)
+	}
+
+	if actual := h.Sum(nil); !bytes.Equal(actual, expected) {
+		return fmt.Errorf("checksum of %s doesn't match: expected %x, got %x", path, expected, actual)
+	}
+
+	return nil
+}
+
+// RunPreUpgrade runs the pre-upgrade command of the new binary before switching to it.
+// The binary reports the outcome with its exit code:
+//
+//	0:  the pre-upgrade succeeded
+//	1:  the binary has no pre-upgrade command, which is ignored
+//	30: the pre-upgrade failed and the upgrade is aborted
+//	31: the pre-upgrade failed and is retried up to PreUpgradeMaxRetries times
+//
+// Any other exit code aborts the upgrade
+func RunPreUpgrade(cfg *Config, info *UpgradeInfo) error {
+	bin := cfg.UpgradeBin(info.Name)
+
+	for attempt := 0; ; attempt++ {
+		out, err := exec.Command(bin, "pre-upgrade").CombinedOutput()
+		if err == nil {
+			return nil
+		}
+
+		var exitErr *exec.ExitError
+		if !errors.As(err, &exitErr) {
+			return fmt.Errorf("running pre-upgrade of %s: %w", bin, err)
+		}
+
+		switch exitErr.ExitCode() {
+		case PreUpgradeNotImplemented:
+			return nil
+		case PreUpgradeFailed:
+			return fmt.Errorf("pre-upgrade of %s failed: %s", info.Name, out)
+		case PreUpgradeRetry:
+			if attempt >= cfg.PreUpgradeMaxRetries {
+				return fmt.Errorf("pre-upgrade of %s failed after %d retries: %s", info.Name, attempt, out)
+			}
+		default:
+			return fmt.Errorf("pre-upgrade of %s exited with unexpected code %d: %s", info.Name, exitErr.ExitCode(), out)
+		}
+	}
 }
 
 // DownloadBinary will grab the binary and place it in the proper directory
@@ -87,50 +245,67 @@ func MarkExecutable(path string) error {
 }
 
 // UpgradeConfig is expected format for the info field to allow auto-download
+// and the verification of the binary checksums
 type UpgradeConfig struct {
-	Binaries map[string]string `json:"binaries"`
+	Binaries  map[string]string `json:"binaries"`
+	Checksums map[string]string `json:"checksums,omitempty"`
 }
 
 // GetDownloadURL will check if there is an arch-dependent binary specified in Info
 func GetDownloadURL(info *UpgradeInfo) (string, error) {
+	config, err := GetUpgradeConfig(info)
+	if err != nil {
+		return "", err
+	}
+
+	url, ok := config.Binaries[OSArch()]
+	if !ok {
+		url, ok = config.Binaries["any"]
+	}
+	if !ok {
+		return "", fmt.Errorf("cannot find binary for os/arch: neither %s, nor any", OSArch())
+	}
+
+	return url, nil
+}
+
+// GetUpgradeConfig parses the upgrade config in Info, following it if it is a reference link
+func GetUpgradeConfig(info *UpgradeInfo) (*UpgradeConfig, error) {
 	doc := strings.TrimSpace(info.Info)
+
+	var config UpgradeConfig
+
+	if err := json.Unmarshal([]byte(doc), &config); err == nil {
+		return &config, nil
+	}
+
 	// if this is a url, then we download that and try to get a new doc with the real info
 	if _, err := url.Parse(doc); err == nil {
 		tmpDir, err := ioutil.TempDir("", "upgrade-manager-reference")
 		if err != nil {
-			return "", fmt.Errorf("create tempdir for reference file: %w", err)
+			return nil, fmt.Errorf("create tempdir for reference file: %w", err)
 		}
 		defer os.RemoveAll(tmpDir)
 
 		refPath := filepath.Join(tmpDir, "ref")
 		if err := getter.GetFile(refPath, doc); err != nil {
-			return "", fmt.Errorf("downloading reference link %s: %w", doc, err)
+			return nil, fmt.Errorf("downloading reference link %s: %w", doc, err)
 		}
 
 		refBytes, err := ioutil.ReadFile(refPath)
 		if err != nil {
-			return "", fmt.Errorf("reading downloaded reference: %w", err)
+			return nil, fmt.Errorf("reading downloaded reference: %w", err)
 		}
 		// if download worked properly, then we use this new file as the binary map to parse
 		doc = string(refBytes)
 	}
 
 	// check if it is the upgrade config
-	var config UpgradeConfig
-
 	if err := json.Unmarshal([]byte(doc), &config); err == nil {
-		url, ok := config.Binaries[OSArch()]
-		if !ok {
-			url, ok = config.Binaries["any"]
-		}
-		if !ok {
-			return "", fmt.Errorf("cannot find binary for os/arch: neither %s, nor any", OSArch())
-		}
-
-		return url, nil
+		return &config, nil
 	}
 
-	return "", errors.New("upgrade info doesn't contain binary map")
+	return nil, errors.New("upgrade info doesn't contain binary map")
 }
 
 func OSArch() string {
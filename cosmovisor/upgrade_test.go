@@ -4,6 +4,7 @@ package cosmovisor_test
 
 import (
 	"fmt"
+	"io/ioutil"
 	"os"
 	"path/filepath"
 	"strings"
@@ -122,6 +123,119 @@ func (s *upgradeTestSuite) TestDoUpgradeNoDownloadUrl() {
 	}
 }
 
+func (s *upgradeTestSuite) TestDoUpgradeBackup() {
+	home := copyTestData(s.T(), "rollback")
+	cfg := &cosmovisor.Config{Home: home, Name: "dummyd"}
+
+	err := cosmovisor.DoUpgrade(cfg, &cosmovisor.UpgradeInfo{Name: "healthy"})
+	s.Require().NoError(err)
+
+	// the data is backed up and the upgrade can be rolled back
+	state, err := ioutil.ReadFile(filepath.Join(cfg.BackupDir("healthy"), "state.txt"))
+	s.Require().NoError(err)
+	s.Require().Equal("genesis\n", string(state))
+
+	rollback, err := cfg.PendingRollback()
+	s.Require().NoError(err)
+	s.Require().Equal(&cosmovisor.RollbackInfo{
+		Name:     "healthy",
+		Previous: filepath.Join(cfg.Root(), "genesis"),
+		Backup:   cfg.BackupDir("healthy"),
+	}, rollback)
+
+	// the backup is skipped on demand, and nothing can be rolled back
+	home = copyTestData(s.T(), "rollback")
+	cfg = &cosmovisor.Config{Home: home, Name: "dummyd", UnsafeSkipBackup: true}
+
+	err = cosmovisor.DoUpgrade(cfg, &cosmovisor.UpgradeInfo{Name: "healthy"})
+	s.Require().NoError(err)
+
+	_, err = os.Stat(cfg.BackupDir("healthy"))
+	s.Require().True(os.IsNotExist(err))
+
+	rollback, err = cfg.PendingRollback()
+	s.Require().NoError(err)
+	s.Require().Nil(rollback)
+}
+
+func (s *upgradeTestSuite) TestRunPreUpgrade() {
+	cases := map[string]struct {
+		upgrade    string
+		maxRetries int
+		isErr      bool
+	}{
+		"success":                    {upgrade: "success"},
+		"not implemented":            {upgrade: "notimpl"},
+		"failed":                     {upgrade: "abort", maxRetries: 5, isErr: true},
+		"retry succeeds":             {upgrade: "retry", maxRetries: 1},
+		"retry without retries left": {upgrade: "retry", isErr: true},
+		"retries exhausted":          {upgrade: "retryfail", maxRetries: 2, isErr: true},
+		"unexpected exit code":       {upgrade: "unexpected", maxRetries: 5, isErr: true},
+		"missing binary":             {upgrade: "missing", isErr: true},
+	}
+
+	for name, tc := range cases {
+		home := copyTestData(s.T(), "preupgrade")
+		cfg := &cosmovisor.Config{Home: home, Name: "dummyd", PreUpgradeMaxRetries: tc.maxRetries}
+
+		err := cosmovisor.RunPreUpgrade(cfg, &cosmovisor.UpgradeInfo{Name: tc.upgrade})
+		if tc.isErr {
+			s.Require().Error(err, name)
+		} else {
+			s.Require().NoError(err, name)
+		}
+	}
+}
+
+func (s *upgradeTestSuite) TestDoUpgradePreUpgradeFailed() {
+	home := copyTestData(s.T(), "preupgrade")
+	cfg := &cosmovisor.Config{Home: home, Name: "dummyd"}
+
+	err := cosmovisor.DoUpgrade(cfg, &cosmovisor.UpgradeInfo{Name: "abort"})
+	s.Require().Error(err)
+	s.Require().Contains(err.Error(), "Pre-upgrade abort pre-upgrade")
+
+	// the binary is not switched
+	currentBin, err := cfg.CurrentBin()
+	s.Require().NoError(err)
+	s.Require().Equal(cfg.GenesisBin(), currentBin)
+}
+
+func (s *upgradeTestSuite) TestDoUpgradeChecksum() {
+	// sha256sum ./testdata/validate/cosmovisor/upgrades/chain2/bin/dummyd
+	checksum := "sha256:e6bc7851600a2a9917f7bf88eb7bdee1ec162c671101485690b4deb089077b0d"
+	invalid := "sha256:73e2bd6cbb99261733caf137015d5cc58e3f96248d8b01da68be8564989dd906"
+
+	cases := map[string]struct {
+		info  string
+		isErr bool
+	}{
+		"no checksum":      {info: `{}`},
+		"valid checksum":   {info: fmt.Sprintf(`{"checksums": {"%s": "%s"}}`, cosmovisor.OSArch(), checksum)},
+		"any architecture": {info: fmt.Sprintf(`{"checksums": {"linux/arm": "%s", "any": "%s"}}`, invalid, checksum)},
+		"invalid checksum": {info: fmt.Sprintf(`{"checksums": {"%s": "%s"}}`, cosmovisor.OSArch(), invalid), isErr: true},
+		"unsupported type": {info: fmt.Sprintf(`{"checksums": {"%s": "md5:d41d8cd98f00b204e9800998ecf8427e"}}`, cosmovisor.OSArch()), isErr: true},
+		"malformed":        {info: fmt.Sprintf(`{"checksums": {"%s": "e6bc7851"}}`, cosmovisor.OSArch()), isErr: true},
+	}
+
+	for name, tc := range cases {
+		home := copyTestData(s.T(), "validate")
+		cfg := &cosmovisor.Config{Home: home, Name: "dummyd"}
+
+		err := cosmovisor.DoUpgrade(cfg, &cosmovisor.UpgradeInfo{Name: "chain2", Info: tc.info})
+		currentBin, binErr := cfg.CurrentBin()
+		s.Require().NoError(binErr)
+
+		if tc.isErr {
+			s.Require().Error(err, name)
+			s.Require().Equal(cfg.GenesisBin(), currentBin, name)
+		} else {
+			s.Require().NoError(err, name)
+			s.Require().Equal(cfg.UpgradeBin("chain2"), currentBin, name)
+		}
+	}
+}
+
 func (s *upgradeTestSuite) TestOsArch() {
 	// all download tests will fail if we are not on linux...
 	s.Require().Equal("linux/amd64", cosmovisor.OSArch())
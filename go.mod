@@ -4,7 +4,7 @@ module github.com/line/lfb-sdk
 
 require (
 	github.com/99designs/keyring v1.1.6
-	github.com/DataDog/zstd v1.4.5 // indirect
+	github.com/DataDog/zstd v1.4.5
 	github.com/VictoriaMetrics/fastcache v1.5.8
 	github.com/armon/go-metrics v0.3.6
 	github.com/bgentry/speakeasy v0.1.0
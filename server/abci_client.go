@@ -0,0 +1,137 @@
+package server
+
+import (
+	abcicli "github.com/line/ostracon/abci/client"
+	abci "github.com/line/ostracon/abci/types"
+	tmsync "github.com/line/ostracon/libs/sync"
+	"github.com/line/ostracon/proxy"
+
+	"github.com/line/lfb-sdk/server/types"
+)
+
+// txsDeliverer is implemented by applications which can execute the txs of a
+// block as a batch, e.g. the BaseApp.
+type txsDeliverer interface {
+	DeliverTxs(reqs []abci.RequestDeliverTx) []abci.ResponseDeliverTx
+}
+
+// newClientCreator returns the creator of the local clients Ostracon drives the
+// app through. When the txs of a block are executed by more than one worker,
+// the txs delivered by Ostracon are buffered and executed through DeliverTxs
+// before the block ends.
+func newClientCreator(app types.Application, deliverTxWorkers int) proxy.ClientCreator {
+	deliverer, ok := app.(txsDeliverer)
+	if deliverTxWorkers <= 1 || !ok {
+		return proxy.NewLocalClientCreator(app)
+	}
+
+	return &batchClientCreator{
+		mtx:       new(tmsync.Mutex),
+		app:       app,
+		deliverer: deliverer,
+	}
+}
+
+type batchClientCreator struct {
+	mtx       *tmsync.Mutex
+	app       types.Application
+	deliverer txsDeliverer
+}
+
+func (c *batchClientCreator) NewABCIClient() (abcicli.Client, error) {
+	return &batchClient{
+		Client:    abcicli.NewLocalClient(c.mtx, c.app),
+		mtx:       c.mtx,
+		deliverer: c.deliverer,
+	}, nil
+}
+
+// batchClient is a local client which buffers the DeliverTx requests of a block
+// and executes them as a batch once the responses are needed, i.e. before any
+// other request of the consensus connection is executed. Ostracon only
+// requires the responses of the txs of a block when EndBlock returns.
+type batchClient struct {
+	abcicli.Client
+
+	mtx       *tmsync.Mutex
+	deliverer txsDeliverer
+	pending   []*abcicli.ReqRes
+}
+
+var _ abcicli.Client = (*batchClient)(nil)
+
+func (cli *batchClient) DeliverTxAsync(req abci.RequestDeliverTx, cb abcicli.ResponseCallback) *abcicli.ReqRes {
+	cli.mtx.Lock()
+	defer cli.mtx.Unlock()
+
+	reqRes := abcicli.NewReqRes(abci.ToRequestDeliverTx(req), cb)
+	cli.pending = append(cli.pending, reqRes)
+	return reqRes
+}
+
+func (cli *batchClient) DeliverTxSync(req abci.RequestDeliverTx) (*abci.ResponseDeliverTx, error) {
+	cli.deliverPending()
+	return cli.Client.DeliverTxSync(req)
+}
+
+func (cli *batchClient) BeginBlockAsync(req abci.RequestBeginBlock, cb abcicli.ResponseCallback) *abcicli.ReqRes {
+	cli.deliverPending()
+	return cli.Client.BeginBlockAsync(req, cb)
+}
+
+func (cli *batchClient) BeginBlockSync(req abci.RequestBeginBlock) (*abci.ResponseBeginBlock, error) {
+	cli.deliverPending()
+	return cli.Client.BeginBlockSync(req)
+}
+
+func (cli *batchClient) EndBlockAsync(req abci.RequestEndBlock, cb abcicli.ResponseCallback) *abcicli.ReqRes {
+	cli.deliverPending()
+	return cli.Client.EndBlockAsync(req, cb)
+}
+
+func (cli *batchClient) EndBlockSync(req abci.RequestEndBlock) (*abci.ResponseEndBlock, error) {
+	cli.deliverPending()
+	return cli.Client.EndBlockSync(req)
+}
+
+func (cli *batchClient) CommitAsync(cb abcicli.ResponseCallback) *abcicli.ReqRes {
+	cli.deliverPending()
+	return cli.Client.CommitAsync(cb)
+}
+
+func (cli *batchClient) CommitSync() (*abci.ResponseCommit, error) {
+	cli.deliverPending()
+	return cli.Client.CommitSync()
+}
+
+// deliverPending executes the buffered DeliverTx requests and completes them
+// in the order they were received.
+func (cli *batchClient) deliverPending() {
+	pending, res := cli.executePending()
+
+	globalCb := cli.GetGlobalCallback()
+	for i, reqRes := range pending {
+		response := abci.ToResponseDeliverTx(res[i])
+		if reqRes.SetDone(response) && globalCb != nil {
+			globalCb(reqRes.Request, response)
+		}
+	}
+}
+
+func (cli *batchClient) executePending() ([]*abcicli.ReqRes, []abci.ResponseDeliverTx) {
+	cli.mtx.Lock()
+	defer cli.mtx.Unlock()
+
+	pending := cli.pending
+	cli.pending = nil
+	if len(pending) == 0 {
+		return nil, nil
+	}
+
+	reqs := make([]abci.RequestDeliverTx, len(pending))
+	for i, reqRes := range pending {
+		reqs[i] = *reqRes.Request.GetDeliverTx()
+	}
+
+	return pending, cli.deliverer.DeliverTxs(reqs)
+}
@@ -0,0 +1,73 @@
+package server
+
+import (
+	"testing"
+
+	abcicli "github.com/line/ostracon/abci/client"
+	abci "github.com/line/ostracon/abci/types"
+	tmsync "github.com/line/ostracon/libs/sync"
+	"github.com/stretchr/testify/require"
+)
+
+type mockTxsDeliverer struct {
+	abci.BaseApplication
+
+	batches [][]abci.RequestDeliverTx
+}
+
+func (app *mockTxsDeliverer) DeliverTxs(reqs []abci.RequestDeliverTx) []abci.ResponseDeliverTx {
+	app.batches = append(app.batches, reqs)
+
+	res := make([]abci.ResponseDeliverTx, len(reqs))
+	for i, req := range reqs {
+		res[i] = abci.ResponseDeliverTx{Data: req.Tx}
+	}
+	return res
+}
+
+func TestBatchClientDeliversTxsBeforeEndBlock(t *testing.T) {
+	app := &mockTxsDeliverer{}
+	mtx := new(tmsync.Mutex)
+	cli := &batchClient{
+		Client:    abcicli.NewLocalClient(mtx, app),
+		mtx:       mtx,
+		deliverer: app,
+	}
+
+	var delivered [][]byte
+	cli.SetGlobalCallback(func(req *abci.Request, res *abci.Response) {
+		if r, ok := res.Value.(*abci.Response_DeliverTx); ok {
+			delivered = append(delivered, r.DeliverTx.Data)
+		}
+	})
+
+	_, err := cli.BeginBlockSync(abci.RequestBeginBlock{})
+	require.NoError(t, err)
+
+	txs := [][]byte{[]byte("tx1"), []byte("tx2"), []byte("tx3")}
+	var reqRes []*abcicli.ReqRes
+	for _, tx := range txs {
+		reqRes = append(reqRes, cli.DeliverTxAsync(abci.RequestDeliverTx{Tx: tx}, nil))
+	}
+
+	// the txs are buffered until the end of the block
+	require.Empty(t, app.batches)
+	require.Empty(t, delivered)
+
+	_, err = cli.EndBlockSync(abci.RequestEndBlock{})
+	require.NoError(t, err)
+
+	// they are delivered as a single batch, and completed in order
+	require.Len(t, app.batches, 1)
+	require.Len(t, app.batches[0], len(txs))
+	require.Equal(t, txs, delivered)
+	for i, rr := range reqRes {
+		rr.Wait()
+		require.Equal(t, txs[i], rr.Response.GetDeliverTx().Data)
+	}
+
+	// nothing is left to deliver
+	_, err = cli.CommitSync()
+	require.NoError(t, err)
+	require.Len(t, app.batches, 1)
+}
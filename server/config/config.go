@@ -18,6 +18,9 @@ const (
 
 	// DefaultGRPCAddress is the default address the gRPC server binds to.
 	DefaultGRPCAddress = "0.0.0.0:9090"
+
+	// DefaultStreamingGRPCAddress is the default address the gRPC streaming server binds to.
+	DefaultStreamingGRPCAddress = "0.0.0.0:9092"
 )
 
 // BaseConfig defines the server's basic configuration
@@ -77,6 +80,10 @@ type BaseConfig struct {
 	// Bech32CacheSize is the maximum bytes size of bech32 cache (Default : 1GB)
 	Bech32CacheSize int `mapstructure:"bech32-cache-size"`
 
+	// DeliverTxWorkers is the number of workers executing non-conflicting txs of
+	// a block concurrently. Zero or one keeps the tx execution serial.
+	DeliverTxWorkers int `mapstructure:"deliver-tx-workers"`
+
 	// When true, Prometheus metrics are served under /metrics on prometheus_listen_addr in config.toml.
 	// It works when tendermint's prometheus option (config.toml) is set to true.
 	Prometheus bool `mapstructure:"prometheus"`
@@ -136,6 +143,39 @@ type StateSyncConfig struct {
 	SnapshotKeepRecent uint32 `mapstructure:"snapshot-keep-recent"`
 }
 
+// StreamingConfig defines the state streaming configuration.
+type StreamingConfig struct {
+	// Streamers lists the streaming services to enable, "file" and/or "grpc".
+	Streamers []string `mapstructure:"streamers"`
+
+	// Keys lists the names of the stores whose state changes are streamed.
+	// "*" streams all stores.
+	Keys []string `mapstructure:"keys"`
+
+	File FileStreamingConfig `mapstructure:"file"`
+	GRPC GRPCStreamingConfig `mapstructure:"grpc"`
+}
+
+// FileStreamingConfig defines the configuration of the file streaming service.
+type FileStreamingConfig struct {
+	// WriteDir is the directory the block files are written to. A relative
+	// path is relative to the node home.
+	WriteDir string `mapstructure:"write-dir"`
+
+	// Prefix is prepended to the names of the block files.
+	Prefix string `mapstructure:"prefix"`
+}
+
+// GRPCStreamingConfig defines the configuration of the gRPC streaming service.
+type GRPCStreamingConfig struct {
+	// Address defines the gRPC streaming server address to bind to.
+	Address string `mapstructure:"address"`
+
+	// BufferSize is the number of events buffered for a subscriber before it
+	// is dropped.
+	BufferSize int `mapstructure:"buffer-size"`
+}
+
 // Config defines the server's top level configuration
 type Config struct {
 	BaseConfig `mapstructure:",squash"`
@@ -145,6 +185,7 @@ type Config struct {
 	API       APIConfig        `mapstructure:"api"`
 	GRPC      GRPCConfig       `mapstructure:"grpc"`
 	StateSync StateSyncConfig  `mapstructure:"state-sync"`
+	Streaming StreamingConfig  `mapstructure:"streaming"`
 }
 
 // SetMinGasPrices sets the validator's minimum gas prices.
@@ -212,6 +253,17 @@ func DefaultConfig() *Config {
 			SnapshotInterval:   0,
 			SnapshotKeepRecent: 2,
 		},
+		Streaming: StreamingConfig{
+			Streamers: []string{},
+			Keys:      []string{"*"},
+			File: FileStreamingConfig{
+				WriteDir: "data/streaming",
+			},
+			GRPC: GRPCStreamingConfig{
+				Address:    DefaultStreamingGRPCAddress,
+				BufferSize: 1000,
+			},
+		},
 	}
 }
 
@@ -238,6 +290,7 @@ func GetConfig(v *viper.Viper) Config {
 			HaltTime:          v.GetUint64("halt-time"),
 			IndexEvents:       v.GetStringSlice("index-events"),
 			MinRetainBlocks:   v.GetUint64("min-retain-blocks"),
+			DeliverTxWorkers:  v.GetInt("deliver-tx-workers"),
 		},
 		Telemetry: telemetry.Config{
 			ServiceName:             v.GetString("telemetry.service-name"),
@@ -267,5 +320,17 @@ func GetConfig(v *viper.Viper) Config {
 			SnapshotInterval:   v.GetUint64("state-sync.snapshot-interval"),
 			SnapshotKeepRecent: v.GetUint32("state-sync.snapshot-keep-recent"),
 		},
+		Streaming: StreamingConfig{
+			Streamers: v.GetStringSlice("streaming.streamers"),
+			Keys:      v.GetStringSlice("streaming.keys"),
+			File: FileStreamingConfig{
+				WriteDir: v.GetString("streaming.file.write-dir"),
+				Prefix:   v.GetString("streaming.file.prefix"),
+			},
+			GRPC: GRPCStreamingConfig{
+				Address:    v.GetString("streaming.grpc.address"),
+				BufferSize: v.GetInt("streaming.grpc.buffer-size"),
+			},
+		},
 	}
 }
@@ -72,6 +72,10 @@ iavl-cache-size = {{ .BaseConfig.IAVLCacheSize }}
 # Bech32CacheSize is the maximum bytes size of bech32 cache (Default : 1GB)
 bech32-cache-size = {{ .BaseConfig.Bech32CacheSize }}
 
+# DeliverTxWorkers is the number of workers executing the non-conflicting txs
+# of a block concurrently. Zero or one keeps the tx execution serial.
+deliver-tx-workers = {{ .BaseConfig.DeliverTxWorkers }}
+
 # IndexEvents defines the set of events in the form {eventType}.{attributeKey},
 # which informs Tendermint what to index. If empty, all events will be indexed.
 #
@@ -177,6 +181,38 @@ snapshot-interval = {{ .StateSync.SnapshotInterval }}
 
 # snapshot-keep-recent specifies the number of recent snapshots to keep and serve (0 to keep all).
 snapshot-keep-recent = {{ .StateSync.SnapshotKeepRecent }}
+
+###############################################################################
+###                         Streaming Configuration                         ###
+###############################################################################
+
+# Streaming exposes the BeginBlock, DeliverTx and EndBlock requests and responses of every block
+# together with the state changes they caused.
+[streaming]
+
+# streamers lists the streaming services to enable (empty to disable streaming):
+# "file" writes the events of every block into a file of length-prefixed protobuf messages,
+# "grpc" streams the events to the subscribers of a gRPC server.
+streamers = [{{ range .Streaming.Streamers }}{{ printf "%q, " . }}{{end}}]
+
+# keys lists the names of the stores whose state changes are streamed ("*" for all stores).
+keys = [{{ range .Streaming.Keys }}{{ printf "%q, " . }}{{end}}]
+
+[streaming.file]
+
+# write-dir is the directory the block files are written to, relative to the node home if not absolute.
+write-dir = "{{ .Streaming.File.WriteDir }}"
+
+# prefix is prepended to the names of the block files, which are named {prefix}block-{height}.
+prefix = "{{ .Streaming.File.Prefix }}"
+
+[streaming.grpc]
+
+# address defines the gRPC streaming server address to bind to.
+address = "{{ .Streaming.GRPC.Address }}"
+
+# buffer-size is the number of events buffered for a subscriber. A subscriber falling further behind is dropped.
+buffer-size = {{ .Streaming.GRPC.BufferSize }}
 `
 
 var configTemplate *template.Template
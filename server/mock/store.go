@@ -121,6 +121,22 @@ func (ms multiStore) SetIAVLCacheManager(cacheManager store.CacheManager) {
 	panic("not implemented")
 }
 
+func (ms multiStore) SetSnapshotCompression(storeName string, compression store.SnapshotCompression) {
+	panic("not implemented")
+}
+
+func (ms multiStore) AddListeners(key store.StoreKey, listeners []store.WriteListener) {
+	panic("not implemented")
+}
+
+func (ms multiStore) ListeningEnabled(key store.StoreKey) bool {
+	panic("not implemented")
+}
+
+func (ms multiStore) CacheMultiStoreWithListeners() sdk.CacheMultiStore {
+	panic("not implemented")
+}
+
 var _ sdk.KVStore = kvStore{}
 
 type kvStore struct {
@@ -16,7 +16,6 @@ import (
 	"github.com/line/ostracon/node"
 	"github.com/line/ostracon/p2p"
 	pvm "github.com/line/ostracon/privval"
-	"github.com/line/ostracon/proxy"
 	"github.com/line/ostracon/rpc/client/local"
 	"github.com/spf13/cobra"
 	"google.golang.org/grpc"
@@ -47,6 +46,7 @@ const (
 	FlagUnsafeSkipUpgrades  = "unsafe-skip-upgrades"
 	FlagTrace               = "trace"
 	FlagInvCheckPeriod      = "inv-check-period"
+	FlagDeliverTxWorkers    = "deliver-tx-workers"
 	FlagPrometheus          = "prometheus"
 
 	FlagPruning           = "pruning"
@@ -154,6 +154,7 @@ which accepts a path for the resulting pprof file.
 	cmd.Flags().Uint64(FlagPruningInterval, 0, "Height interval at which pruned heights are removed from disk (ignored if pruning is not 'custom')")
 	cmd.Flags().Uint(FlagInvCheckPeriod, 0, "Assert registered invariants every N blocks")
 	cmd.Flags().Uint64(FlagMinRetainBlocks, 0, "Minimum block height offset during ABCI commit to prune Ostracon blocks")
+	cmd.Flags().Int(FlagDeliverTxWorkers, 0, "Number of workers executing the non-conflicting txs of a block concurrently (0 or 1 executes them serially)")
 
 	cmd.Flags().Bool(flagGRPCEnable, true, "Define if the gRPC server should be enabled")
 	cmd.Flags().String(flagGRPCAddress, config.DefaultGRPCAddress, "the gRPC server address to listen on")
@@ -202,6 +203,8 @@ func startStandAlone(ctx *Context, appCreator types.AppCreator) error {
 		if err = svr.Stop(); err != nil {
 			ostos.Exit(err.Error())
 		}
+
+		closeApp(ctx, app)
 	}()
 
 	// Wait for SIGINT or SIGTERM signal
@@ -255,7 +258,7 @@ func startInProcess(ctx *Context, clientCtx client.Context, appCreator types.App
 		cfg,
 		pvm.LoadOrGenFilePV(cfg.PrivValidatorKeyFile(), cfg.PrivValidatorStateFile()),
 		nodeKey,
-		proxy.NewLocalClientCreator(app),
+		newClientCreator(app, ctx.Viper.GetInt(FlagDeliverTxWorkers)),
 		genDocProvider,
 		node.DefaultDBProvider,
 		node.DefaultMetricsProvider(cfg.Instrumentation),
@@ -337,6 +340,8 @@ func startInProcess(ctx *Context, clientCtx client.Context, appCreator types.App
 			grpcSrv.Stop()
 		}
 
+		closeApp(ctx, app)
+
 		ctx.Logger.Info("exiting...")
 	}()
 
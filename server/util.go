@@ -383,3 +383,14 @@ func openTraceWriter(traceWriterFile string) (w io.Writer, err error) {
 		0666,
 	)
 }
+
+// closeApp closes the app if it holds resources to release on shutdown, such as its streaming services.
+func closeApp(ctx *Context, app types.Application) {
+	closer, ok := app.(io.Closer)
+	if !ok {
+		return
+	}
+	if err := closer.Close(); err != nil {
+		ctx.Logger.Error("failed to close the app", "err", err)
+	}
+}
@@ -26,9 +26,11 @@ import (
 	"github.com/line/lfb-sdk/server/config"
 	servertypes "github.com/line/lfb-sdk/server/types"
 	simappparams "github.com/line/lfb-sdk/simapp/params"
+	"github.com/line/lfb-sdk/streaming"
 	"github.com/line/lfb-sdk/testutil/testdata"
 	sdk "github.com/line/lfb-sdk/types"
 	"github.com/line/lfb-sdk/types/module"
+	signingtypes "github.com/line/lfb-sdk/types/tx/signing"
 	"github.com/line/lfb-sdk/version"
 	"github.com/line/lfb-sdk/x/auth"
 	"github.com/line/lfb-sdk/x/auth/ante"
@@ -36,8 +38,12 @@ import (
 	authkeeper "github.com/line/lfb-sdk/x/auth/keeper"
 	authsims "github.com/line/lfb-sdk/x/auth/simulation"
 	authtx "github.com/line/lfb-sdk/x/auth/tx"
+	"github.com/line/lfb-sdk/x/auth/tx/textual"
 	authtypes "github.com/line/lfb-sdk/x/auth/types"
 	"github.com/line/lfb-sdk/x/auth/vesting"
+	"github.com/line/lfb-sdk/x/authz"
+	authzkeeper "github.com/line/lfb-sdk/x/authz/keeper"
+	authztypes "github.com/line/lfb-sdk/x/authz/types"
 	"github.com/line/lfb-sdk/x/bank"
 	bankkeeper "github.com/line/lfb-sdk/x/bank/keeper"
 	banktypes "github.com/line/lfb-sdk/x/bank/types"
@@ -54,11 +60,33 @@ import (
 	"github.com/line/lfb-sdk/x/evidence"
 	evidencekeeper "github.com/line/lfb-sdk/x/evidence/keeper"
 	evidencetypes "github.com/line/lfb-sdk/x/evidence/types"
+	"github.com/line/lfb-sdk/x/feegrant"
+	feegrantante "github.com/line/lfb-sdk/x/feegrant/ante"
+	feegrantkeeper "github.com/line/lfb-sdk/x/feegrant/keeper"
+	feegranttypes "github.com/line/lfb-sdk/x/feegrant/types"
+	"github.com/line/lfb-sdk/x/feemarket"
+	feemarketante "github.com/line/lfb-sdk/x/feemarket/ante"
+	feemarketkeeper "github.com/line/lfb-sdk/x/feemarket/keeper"
+	feemarkettypes "github.com/line/lfb-sdk/x/feemarket/types"
 	"github.com/line/lfb-sdk/x/genutil"
 	genutiltypes "github.com/line/lfb-sdk/x/genutil/types"
 	"github.com/line/lfb-sdk/x/gov"
 	govkeeper "github.com/line/lfb-sdk/x/gov/keeper"
 	govtypes "github.com/line/lfb-sdk/x/gov/types"
+	"github.com/line/lfb-sdk/x/group"
+	groupkeeper "github.com/line/lfb-sdk/x/group/keeper"
+	grouptypes "github.com/line/lfb-sdk/x/group/types"
+	ibcfee "github.com/line/lfb-sdk/x/ibc/applications/fee"
+	ibcfeekeeper "github.com/line/lfb-sdk/x/ibc/applications/fee/keeper"
+	ibcfeetypes "github.com/line/lfb-sdk/x/ibc/applications/fee/types"
+	ica "github.com/line/lfb-sdk/x/ibc/applications/interchain-accounts"
+	icacontroller "github.com/line/lfb-sdk/x/ibc/applications/interchain-accounts/controller"
+	icacontrollerkeeper "github.com/line/lfb-sdk/x/ibc/applications/interchain-accounts/controller/keeper"
+	icacontrollertypes "github.com/line/lfb-sdk/x/ibc/applications/interchain-accounts/controller/types"
+	icahost "github.com/line/lfb-sdk/x/ibc/applications/interchain-accounts/host"
+	icahostkeeper "github.com/line/lfb-sdk/x/ibc/applications/interchain-accounts/host/keeper"
+	icahosttypes "github.com/line/lfb-sdk/x/ibc/applications/interchain-accounts/host/types"
+	icatypes "github.com/line/lfb-sdk/x/ibc/applications/interchain-accounts/types"
 	transfer "github.com/line/lfb-sdk/x/ibc/applications/transfer"
 	ibctransferkeeper "github.com/line/lfb-sdk/x/ibc/applications/transfer/keeper"
 	ibctransfertypes "github.com/line/lfb-sdk/x/ibc/applications/transfer/types"
@@ -82,6 +110,9 @@ import (
 	"github.com/line/lfb-sdk/x/staking"
 	stakingkeeper "github.com/line/lfb-sdk/x/staking/keeper"
 	stakingtypes "github.com/line/lfb-sdk/x/staking/types"
+	"github.com/line/lfb-sdk/x/tokenfactory"
+	tokenfactorykeeper "github.com/line/lfb-sdk/x/tokenfactory/keeper"
+	tokenfactorytypes "github.com/line/lfb-sdk/x/tokenfactory/types"
 	"github.com/line/lfb-sdk/x/upgrade"
 	upgradeclient "github.com/line/lfb-sdk/x/upgrade/client"
 	upgradekeeper "github.com/line/lfb-sdk/x/upgrade/keeper"
@@ -117,7 +148,14 @@ var (
 		ibc.AppModuleBasic{},
 		upgrade.AppModuleBasic{},
 		evidence.AppModuleBasic{},
+		feegrant.AppModuleBasic{},
+		authz.AppModuleBasic{},
+		group.AppModuleBasic{},
+		tokenfactory.AppModuleBasic{},
+		feemarket.AppModuleBasic{},
 		transfer.AppModuleBasic{},
+		ica.AppModuleBasic{},
+		ibcfee.AppModuleBasic{},
 		vesting.AppModuleBasic{},
 	)
 
@@ -128,8 +166,12 @@ var (
 		minttypes.ModuleName:           {authtypes.Minter},
 		stakingtypes.BondedPoolName:    {authtypes.Burner, authtypes.Staking},
 		stakingtypes.NotBondedPoolName: {authtypes.Burner, authtypes.Staking},
+		stakingtypes.ModuleName:        {authtypes.Minter, authtypes.Burner},
 		govtypes.ModuleName:            {authtypes.Burner},
 		ibctransfertypes.ModuleName:    {authtypes.Minter, authtypes.Burner},
+		feemarkettypes.ModuleName:      {authtypes.Burner},
+		tokenfactorytypes.ModuleName:   {authtypes.Minter, authtypes.Burner},
+		ibcfeetypes.ModuleName:         nil,
 	}
 )
 
@@ -154,31 +196,47 @@ type SimApp struct {
 	memKeys map[string]*sdk.MemoryStoreKey
 
 	// keepers
-	AccountKeeper    authkeeper.AccountKeeper
-	BankKeeper       bankkeeper.Keeper
-	CapabilityKeeper *capabilitykeeper.Keeper
-	StakingKeeper    stakingkeeper.Keeper
-	SlashingKeeper   slashingkeeper.Keeper
-	MintKeeper       mintkeeper.Keeper
-	DistrKeeper      distrkeeper.Keeper
-	GovKeeper        govkeeper.Keeper
-	CrisisKeeper     crisiskeeper.Keeper
-	UpgradeKeeper    upgradekeeper.Keeper
-	ParamsKeeper     paramskeeper.Keeper
-	IBCKeeper        *ibckeeper.Keeper // IBC Keeper must be a pointer in the app, so we can SetRouter on it correctly
-	EvidenceKeeper   evidencekeeper.Keeper
-	TransferKeeper   ibctransferkeeper.Keeper
+	AccountKeeper       authkeeper.AccountKeeper
+	BankKeeper          bankkeeper.Keeper
+	CapabilityKeeper    *capabilitykeeper.Keeper
+	StakingKeeper       stakingkeeper.Keeper
+	SlashingKeeper      slashingkeeper.Keeper
+	MintKeeper          mintkeeper.Keeper
+	DistrKeeper         distrkeeper.Keeper
+	GovKeeper           govkeeper.Keeper
+	CrisisKeeper        crisiskeeper.Keeper
+	UpgradeKeeper       upgradekeeper.Keeper
+	ParamsKeeper        paramskeeper.Keeper
+	IBCKeeper           *ibckeeper.Keeper // IBC Keeper must be a pointer in the app, so we can SetRouter on it correctly
+	EvidenceKeeper      evidencekeeper.Keeper
+	FeeGrantKeeper      feegrantkeeper.Keeper
+	AuthzKeeper         authzkeeper.Keeper
+	GroupKeeper         groupkeeper.Keeper
+	TokenFactoryKeeper  tokenfactorykeeper.Keeper
+	FeeMarketKeeper     feemarketkeeper.Keeper
+	TransferKeeper      ibctransferkeeper.Keeper
+	ICAControllerKeeper icacontrollerkeeper.Keeper
+	ICAHostKeeper       icahostkeeper.Keeper
+	IBCFeeKeeper        ibcfeekeeper.Keeper
 
 	// make scoped keepers public for test purposes
-	ScopedIBCKeeper      capabilitykeeper.ScopedKeeper
-	ScopedTransferKeeper capabilitykeeper.ScopedKeeper
-	ScopedIBCMockKeeper  capabilitykeeper.ScopedKeeper
+	ScopedIBCKeeper           capabilitykeeper.ScopedKeeper
+	ScopedTransferKeeper      capabilitykeeper.ScopedKeeper
+	ScopedICAControllerKeeper capabilitykeeper.ScopedKeeper
+	ScopedICAHostKeeper       capabilitykeeper.ScopedKeeper
+	ScopedIBCMockKeeper       capabilitykeeper.ScopedKeeper
 
 	// the module manager
 	mm *module.Manager
 
+	// the configurator
+	configurator module.Configurator
+
 	// simulation manager
 	sm *module.SimulationManager
+
+	// the state listening services, closed with the app
+	streamingServices []baseapp.StreamingService
 }
 
 func init() {
@@ -212,14 +270,24 @@ func NewSimApp(
 		minttypes.StoreKey, distrtypes.StoreKey, slashingtypes.StoreKey,
 		govtypes.StoreKey, paramstypes.StoreKey, ibchost.StoreKey, upgradetypes.StoreKey,
 		evidencetypes.StoreKey, ibctransfertypes.StoreKey, capabilitytypes.StoreKey,
+		feegranttypes.StoreKey, authztypes.StoreKey, feemarkettypes.StoreKey,
+		grouptypes.StoreKey, tokenfactorytypes.StoreKey,
+		icacontrollertypes.StoreKey, icahosttypes.StoreKey, ibcfeetypes.StoreKey,
 	)
 	memKeys := sdk.NewMemoryStoreKeys(capabilitytypes.MemStoreKey)
 
+	// configure state listening capabilities using AppOptions
+	streamingServices, err := streaming.LoadStreamingServices(bApp, appOpts, appCodec, keys)
+	if err != nil {
+		ostos.Exit(err.Error())
+	}
+
 	app := &SimApp{
 		BaseApp:           bApp,
 		legacyAmino:       legacyAmino,
 		appCodec:          appCodec,
 		interfaceRegistry: interfaceRegistry,
+		streamingServices: streamingServices,
 		invCheckPeriod:    invCheckPeriod,
 		keys:              keys,
 		memKeys:           memKeys,
@@ -234,6 +302,8 @@ func NewSimApp(
 	app.CapabilityKeeper = capabilitykeeper.NewKeeper(appCodec, keys[capabilitytypes.StoreKey], memKeys[capabilitytypes.MemStoreKey])
 	scopedIBCKeeper := app.CapabilityKeeper.ScopeToModule(ibchost.ModuleName)
 	scopedTransferKeeper := app.CapabilityKeeper.ScopeToModule(ibctransfertypes.ModuleName)
+	scopedICAControllerKeeper := app.CapabilityKeeper.ScopeToModule(icacontrollertypes.SubModuleName)
+	scopedICAHostKeeper := app.CapabilityKeeper.ScopeToModule(icahosttypes.SubModuleName)
 	// NOTE: the IBC mock keeper and application module is used only for testing core IBC. Do
 	// note replicate if you do not need to test core IBC or light clients.
 	scopedIBCMockKeeper := app.CapabilityKeeper.ScopeToModule(ibcmock.ModuleName)
@@ -252,6 +322,10 @@ func NewSimApp(
 		appCodec, keys[minttypes.StoreKey], app.GetSubspace(minttypes.ModuleName), &stakingKeeper,
 		app.AccountKeeper, app.BankKeeper, authtypes.FeeCollectorName,
 	)
+	app.FeeMarketKeeper = feemarketkeeper.NewKeeper(
+		appCodec, keys[feemarkettypes.StoreKey], app.GetSubspace(feemarkettypes.ModuleName),
+		app.AccountKeeper, app.BankKeeper, authtypes.FeeCollectorName,
+	)
 	app.DistrKeeper = distrkeeper.NewKeeper(
 		appCodec, keys[distrtypes.StoreKey], app.GetSubspace(distrtypes.ModuleName), app.AccountKeeper, app.BankKeeper,
 		&stakingKeeper, authtypes.FeeCollectorName, app.ModuleAccountAddrs(),
@@ -262,7 +336,10 @@ func NewSimApp(
 	app.CrisisKeeper = crisiskeeper.NewKeeper(
 		app.GetSubspace(crisistypes.ModuleName), invCheckPeriod, app.BankKeeper, authtypes.FeeCollectorName,
 	)
-	app.UpgradeKeeper = upgradekeeper.NewKeeper(skipUpgradeHeights, keys[upgradetypes.StoreKey], appCodec, homePath)
+	app.UpgradeKeeper = upgradekeeper.NewKeeper(
+		skipUpgradeHeights, keys[upgradetypes.StoreKey], appCodec, homePath,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
 
 	// register the staking hooks
 	// NOTE: stakingKeeper above is passed by reference, so that it will contain these hooks
@@ -284,24 +361,48 @@ func NewSimApp(
 		AddRoute(ibchost.RouterKey, ibcclient.NewClientUpdateProposalHandler(app.IBCKeeper.ClientKeeper))
 	app.GovKeeper = govkeeper.NewKeeper(
 		appCodec, keys[govtypes.StoreKey], app.GetSubspace(govtypes.ModuleName), app.AccountKeeper, app.BankKeeper,
-		&stakingKeeper, govRouter,
+		&stakingKeeper, govRouter, app.MsgServiceRouter(),
+	)
+
+	// Create the IBC fee middleware keeper, it sits between the applications and core IBC
+	app.IBCFeeKeeper = ibcfeekeeper.NewKeeper(
+		appCodec, keys[ibcfeetypes.StoreKey],
+		app.IBCKeeper.ChannelKeeper, // the fee middleware is the last one on top of core IBC
+		app.IBCKeeper.ChannelKeeper, app.AccountKeeper, app.BankKeeper,
 	)
+	ibcFeeModule := ibcfee.NewAppModule(app.IBCFeeKeeper)
 
 	// Create Transfer Keepers
 	app.TransferKeeper = ibctransferkeeper.NewKeeper(
 		appCodec, keys[ibctransfertypes.StoreKey], app.GetSubspace(ibctransfertypes.ModuleName),
+		app.IBCFeeKeeper, // ICS4Wrapper
 		app.IBCKeeper.ChannelKeeper, &app.IBCKeeper.PortKeeper,
 		app.AccountKeeper, app.BankKeeper, scopedTransferKeeper,
 	)
 	transferModule := transfer.NewAppModule(app.TransferKeeper)
 
+	// Create Interchain Accounts Keepers
+	app.ICAControllerKeeper = icacontrollerkeeper.NewKeeper(
+		appCodec, keys[icacontrollertypes.StoreKey], app.GetSubspace(icacontrollertypes.SubModuleName),
+		app.IBCKeeper.ChannelKeeper, &app.IBCKeeper.PortKeeper,
+		scopedICAControllerKeeper, app.MsgServiceRouter(),
+	)
+	app.ICAHostKeeper = icahostkeeper.NewKeeper(
+		appCodec, keys[icahosttypes.StoreKey], app.GetSubspace(icahosttypes.SubModuleName),
+		app.IBCKeeper.ChannelKeeper, &app.IBCKeeper.PortKeeper,
+		app.AccountKeeper, scopedICAHostKeeper, app.MsgServiceRouter(),
+	)
+	icaModule := ica.NewAppModule(&app.ICAControllerKeeper, &app.ICAHostKeeper)
+
 	// NOTE: the IBC mock keeper and application module is used only for testing core IBC. Do
 	// note replicate if you do not need to test core IBC or light clients.
 	mockModule := ibcmock.NewAppModule(scopedIBCMockKeeper)
 
-	// Create static IBC router, add transfer route, then set and seal it
+	// Create static IBC router, add transfer route wrapped by the fee middleware, then set and seal it
 	ibcRouter := porttypes.NewRouter()
-	ibcRouter.AddRoute(ibctransfertypes.ModuleName, transferModule)
+	ibcRouter.AddRoute(ibctransfertypes.ModuleName, ibcfee.NewIBCMiddleware(transferModule, app.IBCFeeKeeper))
+	ibcRouter.AddRoute(icacontrollertypes.SubModuleName, icacontroller.NewIBCModule(app.ICAControllerKeeper))
+	ibcRouter.AddRoute(icahosttypes.SubModuleName, icahost.NewIBCModule(app.ICAHostKeeper))
 	ibcRouter.AddRoute(ibcmock.ModuleName, mockModule)
 	app.IBCKeeper.SetRouter(ibcRouter)
 
@@ -312,6 +413,14 @@ func NewSimApp(
 	// If evidence needs to be handled for the app, set routes in router here and seal
 	app.EvidenceKeeper = *evidenceKeeper
 
+	app.FeeGrantKeeper = feegrantkeeper.NewKeeper(appCodec, keys[feegranttypes.StoreKey], app.AccountKeeper)
+	app.AuthzKeeper = authzkeeper.NewKeeper(keys[authztypes.StoreKey], appCodec, app.BaseApp.MsgServiceRouter())
+	app.GroupKeeper = groupkeeper.NewKeeper(keys[grouptypes.StoreKey], appCodec, app.AccountKeeper, app.BaseApp.MsgServiceRouter())
+	app.TokenFactoryKeeper = tokenfactorykeeper.NewKeeper(
+		appCodec, keys[tokenfactorytypes.StoreKey], app.GetSubspace(tokenfactorytypes.ModuleName),
+		app.AccountKeeper, app.BankKeeper, app.DistrKeeper,
+	)
+
 	/****  Module Options ****/
 
 	// NOTE: we may consider parsing `appOpts` inside module constructors. For the moment
@@ -326,7 +435,7 @@ func NewSimApp(
 			encodingConfig.TxConfig,
 		),
 		auth.NewAppModule(appCodec, app.AccountKeeper, authsims.RandomGenesisAccounts),
-		vesting.NewAppModule(app.AccountKeeper, app.BankKeeper),
+		vesting.NewAppModule(app.AccountKeeper, app.BankKeeper, app.StakingKeeper),
 		bank.NewAppModule(appCodec, app.BankKeeper, app.AccountKeeper),
 		capability.NewAppModule(appCodec, *app.CapabilityKeeper),
 		crisis.NewAppModule(&app.CrisisKeeper, skipGenesisInvariants),
@@ -337,9 +446,16 @@ func NewSimApp(
 		staking.NewAppModule(appCodec, app.StakingKeeper, app.AccountKeeper, app.BankKeeper),
 		upgrade.NewAppModule(app.UpgradeKeeper),
 		evidence.NewAppModule(app.EvidenceKeeper),
+		feegrant.NewAppModule(appCodec, app.FeeGrantKeeper),
+		authz.NewAppModule(appCodec, app.AuthzKeeper),
+		group.NewAppModule(appCodec, app.GroupKeeper),
+		tokenfactory.NewAppModule(appCodec, app.TokenFactoryKeeper),
+		feemarket.NewAppModule(appCodec, app.FeeMarketKeeper, app.AccountKeeper),
 		ibc.NewAppModule(app.IBCKeeper),
 		params.NewAppModule(app.ParamsKeeper),
 		transferModule,
+		icaModule,
+		ibcFeeModule,
 	)
 
 	// During begin block slashing happens after distr.BeginBlocker so that
@@ -350,7 +466,9 @@ func NewSimApp(
 		upgradetypes.ModuleName, minttypes.ModuleName, distrtypes.ModuleName, slashingtypes.ModuleName,
 		evidencetypes.ModuleName, stakingtypes.ModuleName, ibchost.ModuleName,
 	)
-	app.mm.SetOrderEndBlockers(crisistypes.ModuleName, govtypes.ModuleName, stakingtypes.ModuleName)
+	app.mm.SetOrderEndBlockers(
+		crisistypes.ModuleName, govtypes.ModuleName, stakingtypes.ModuleName, feemarkettypes.ModuleName, authtypes.ModuleName,
+	)
 
 	// NOTE: The genutils module must occur after staking so that pools are
 	// properly initialized with tokens from genesis accounts.
@@ -361,11 +479,15 @@ func NewSimApp(
 		capabilitytypes.ModuleName, authtypes.ModuleName, banktypes.ModuleName, distrtypes.ModuleName, stakingtypes.ModuleName,
 		slashingtypes.ModuleName, govtypes.ModuleName, minttypes.ModuleName, crisistypes.ModuleName,
 		ibchost.ModuleName, genutiltypes.ModuleName, evidencetypes.ModuleName, ibctransfertypes.ModuleName,
+		feegranttypes.ModuleName, authztypes.ModuleName, feemarkettypes.ModuleName,
+		grouptypes.ModuleName, tokenfactorytypes.ModuleName, icatypes.ModuleName,
+		ibcfeetypes.ModuleName,
 	)
 
 	app.mm.RegisterInvariants(&app.CrisisKeeper)
 	app.mm.RegisterRoutes(app.Router(), app.QueryRouter(), encodingConfig.Amino)
-	app.mm.RegisterServices(module.NewConfigurator(app.MsgServiceRouter(), app.GRPCQueryRouter()))
+	app.configurator = module.NewConfigurator(app.appCodec, app.MsgServiceRouter(), app.GRPCQueryRouter())
+	app.mm.RegisterServices(app.configurator)
 
 	// add test gRPC service for testing gRPC queries in isolation
 	testdata.RegisterQueryServer(app.GRPCQueryRouter(), testdata.QueryImpl{})
@@ -385,6 +507,8 @@ func NewSimApp(
 		slashing.NewAppModule(appCodec, app.SlashingKeeper, app.AccountKeeper, app.BankKeeper, app.StakingKeeper),
 		params.NewAppModule(app.ParamsKeeper),
 		evidence.NewAppModule(app.EvidenceKeeper),
+		feegrant.NewAppModule(appCodec, app.FeeGrantKeeper),
+		feemarket.NewAppModule(appCodec, app.FeeMarketKeeper, app.AccountKeeper),
 		ibc.NewAppModule(app.IBCKeeper),
 		transferModule,
 	)
@@ -395,15 +519,34 @@ func NewSimApp(
 	app.MountKVStores(keys)
 	app.MountMemoryStores(memKeys)
 
+	// SIGN_MODE_TEXTUAL renders the coins with the bank denom metadata, so the
+	// signatures are verified by a handler reading it from the bank keeper
+	signModeHandler := authtx.NewTxConfigWithTextual(
+		codec.NewProtoCodec(interfaceRegistry),
+		append(authtx.DefaultSignModes, signingtypes.SignMode_SIGN_MODE_TEXTUAL),
+		textual.NewBankKeeperCoinMetadataQueryFn(app.BankKeeper),
+	).SignModeHandler()
+
 	// initialize BaseApp
 	app.SetInitChainer(app.InitChainer)
 	app.SetBeginBlocker(app.BeginBlocker)
 	app.SetAnteHandler(
-		ante.NewAnteHandler(
-			app.AccountKeeper, app.BankKeeper, ante.DefaultSigVerificationGasConsumer,
-			encodingConfig.TxConfig.SignModeHandler(),
+		feemarketante.NewAnteHandler(
+			app.FeeMarketKeeper,
+			feegrantante.NewAnteHandler(
+				app.AccountKeeper, app.BankKeeper, app.FeeGrantKeeper, ante.DefaultSigVerificationGasConsumer,
+				signModeHandler, ante.NewIncrementSequenceDecorator(app.AccountKeeper),
+			),
 		),
 	)
+	app.SetTxPriority(ante.FeePerGasTxPriority)
+	// every fee paying tx credits the fee collector, and the base fees to burn
+	// to the feemarket module account, so the writes of the txs of a block to
+	// their balances are merged to execute the txs concurrently
+	app.SetTxAccumulator(app.BankKeeper.BalanceAccumulator(
+		app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName),
+		app.AccountKeeper.GetModuleAddress(feemarkettypes.ModuleName),
+	))
 	app.SetEndBlocker(app.EndBlocker)
 
 	if loadLatest {
@@ -424,6 +567,8 @@ func NewSimApp(
 
 	app.ScopedIBCKeeper = scopedIBCKeeper
 	app.ScopedTransferKeeper = scopedTransferKeeper
+	app.ScopedICAControllerKeeper = scopedICAControllerKeeper
+	app.ScopedICAHostKeeper = scopedICAHostKeeper
 
 	// NOTE: the IBC mock keeper and application module is used only for testing core IBC. Do
 	// note replicate if you do not need to test core IBC or light clients.
@@ -443,6 +588,17 @@ func MakeCodecs() (codec.Marshaler, *codec.LegacyAmino) {
 // Name returns the name of the App
 func (app *SimApp) Name() string { return app.BaseApp.Name() }
 
+// Close closes the streaming services of the app. It returns the first error of the services.
+func (app *SimApp) Close() error {
+	var err error
+	for _, service := range app.streamingServices {
+		if closeErr := service.Close(); closeErr != nil && err == nil {
+			err = closeErr
+		}
+	}
+	return err
+}
+
 // BeginBlocker application updates every begin block
 func (app *SimApp) BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock) abci.ResponseBeginBlock {
 	return app.mm.BeginBlock(ctx, req)
@@ -459,6 +615,7 @@ func (app *SimApp) InitChainer(ctx sdk.Context, req abci.RequestInitChain) abci.
 	if err := ostjson.Unmarshal(req.AppStateBytes, &genesisState); err != nil {
 		panic(err)
 	}
+	app.UpgradeKeeper.SetModuleVersionMap(ctx, app.mm.GetVersionMap())
 	return app.mm.InitGenesis(ctx, app.appCodec, genesisState)
 }
 
@@ -579,7 +736,7 @@ func GetMaccPerms() map[string][]string {
 
 // initParamsKeeper init params keeper and its subspaces
 func initParamsKeeper(appCodec codec.BinaryMarshaler, legacyAmino *codec.LegacyAmino, key sdk.StoreKey) paramskeeper.Keeper {
-	paramsKeeper := paramskeeper.NewKeeper(appCodec, legacyAmino, key)
+	paramsKeeper := paramskeeper.NewKeeper(appCodec, legacyAmino, key, authtypes.NewModuleAddress(govtypes.ModuleName).String())
 
 	paramsKeeper.Subspace(authtypes.ModuleName)
 	paramsKeeper.Subspace(banktypes.ModuleName)
@@ -590,7 +747,11 @@ func initParamsKeeper(appCodec codec.BinaryMarshaler, legacyAmino *codec.LegacyA
 	paramsKeeper.Subspace(govtypes.ModuleName).WithKeyTable(govtypes.ParamKeyTable())
 	paramsKeeper.Subspace(crisistypes.ModuleName)
 	paramsKeeper.Subspace(ibctransfertypes.ModuleName)
+	paramsKeeper.Subspace(icacontrollertypes.SubModuleName)
+	paramsKeeper.Subspace(icahosttypes.SubModuleName)
 	paramsKeeper.Subspace(ibchost.ModuleName)
+	paramsKeeper.Subspace(feemarkettypes.ModuleName)
+	paramsKeeper.Subspace(tokenfactorytypes.ModuleName)
 
 	return paramsKeeper
 }
@@ -2,14 +2,18 @@ package simapp
 
 import (
 	"encoding/json"
+	"net"
 	"os"
 	"testing"
 
 	"github.com/line/ostracon/libs/log"
 	"github.com/line/tm-db/v2/memdb"
+	"github.com/spf13/viper"
 	"github.com/stretchr/testify/require"
 
 	abci "github.com/line/ostracon/abci/types"
+
+	"github.com/line/lfb-sdk/streaming"
 )
 
 func TestSimAppExportAndBlockedAddrs(t *testing.T) {
@@ -48,3 +52,24 @@ func TestGetMaccPerms(t *testing.T) {
 	dup := GetMaccPerms()
 	require.Equal(t, maccPerms, dup, "duplicated module account permissions differed from actual module account permissions")
 }
+
+func TestSimAppCloseStreamingServices(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	address := listener.Addr().String()
+	require.NoError(t, listener.Close())
+
+	appOpts := viper.New()
+	appOpts.Set(streaming.OptStreamers, []string{streaming.StreamerGRPC})
+	appOpts.Set(streaming.OptKeys, []string{"*"})
+	appOpts.Set(streaming.OptGRPCAddress, address)
+	app := NewSimApp(log.NewNopLogger(), memdb.NewDB(), nil, true, map[int64]bool{}, DefaultNodeHome, 0, MakeTestEncodingConfig(), appOpts)
+
+	// the streaming service listens on the address until the app is closed
+	_, err = net.Listen("tcp", address)
+	require.Error(t, err)
+	require.NoError(t, app.Close())
+	listener, err = net.Listen("tcp", address)
+	require.NoError(t, err)
+	require.NoError(t, listener.Close())
+}
@@ -20,10 +20,13 @@ import (
 	sdk "github.com/line/lfb-sdk/types"
 	simtypes "github.com/line/lfb-sdk/types/simulation"
 	authtypes "github.com/line/lfb-sdk/x/auth/types"
+	authztypes "github.com/line/lfb-sdk/x/authz/types"
 	banktypes "github.com/line/lfb-sdk/x/bank/types"
 	capabilitytypes "github.com/line/lfb-sdk/x/capability/types"
 	distrtypes "github.com/line/lfb-sdk/x/distribution/types"
 	evidencetypes "github.com/line/lfb-sdk/x/evidence/types"
+	feegranttypes "github.com/line/lfb-sdk/x/feegrant/types"
+	feemarkettypes "github.com/line/lfb-sdk/x/feemarket/types"
 	govtypes "github.com/line/lfb-sdk/x/gov/types"
 	ibctransfertypes "github.com/line/lfb-sdk/x/ibc/applications/transfer/types"
 	ibchost "github.com/line/lfb-sdk/x/ibc/core/24-host"
@@ -176,6 +179,9 @@ func TestAppImportExport(t *testing.T) {
 		{app.keys[paramtypes.StoreKey], newApp.keys[paramtypes.StoreKey], [][]byte{}},
 		{app.keys[govtypes.StoreKey], newApp.keys[govtypes.StoreKey], [][]byte{}},
 		{app.keys[evidencetypes.StoreKey], newApp.keys[evidencetypes.StoreKey], [][]byte{}},
+		{app.keys[feegranttypes.StoreKey], newApp.keys[feegranttypes.StoreKey], [][]byte{}},
+		{app.keys[authztypes.StoreKey], newApp.keys[authztypes.StoreKey], [][]byte{}},
+		{app.keys[feemarkettypes.StoreKey], newApp.keys[feemarkettypes.StoreKey], [][]byte{}},
 		{app.keys[capabilitytypes.StoreKey], newApp.keys[capabilitytypes.StoreKey], [][]byte{}},
 		{app.keys[ibchost.StoreKey], newApp.keys[ibchost.StoreKey], [][]byte{}},
 		{app.keys[ibctransfertypes.StoreKey], newApp.keys[ibctransfertypes.StoreKey], [][]byte{}},
@@ -1,17 +1,20 @@
 package cmd
 
 import (
+	"context"
 	"errors"
 	"io"
 	"os"
 	"path/filepath"
 
+	gogogrpc "github.com/gogo/protobuf/grpc"
 	ostcli "github.com/line/ostracon/libs/cli"
 	"github.com/line/ostracon/libs/log"
 	tmdb "github.com/line/tm-db/v2"
 	"github.com/spf13/cast"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
+	"google.golang.org/grpc"
 
 	"github.com/line/lfb-sdk/baseapp"
 	"github.com/line/lfb-sdk/client"
@@ -19,6 +22,7 @@ import (
 	"github.com/line/lfb-sdk/client/flags"
 	"github.com/line/lfb-sdk/client/keys"
 	"github.com/line/lfb-sdk/client/rpc"
+	"github.com/line/lfb-sdk/codec"
 	"github.com/line/lfb-sdk/server"
 	servertypes "github.com/line/lfb-sdk/server/types"
 	"github.com/line/lfb-sdk/simapp"
@@ -26,8 +30,11 @@ import (
 	"github.com/line/lfb-sdk/snapshots"
 	"github.com/line/lfb-sdk/store"
 	sdk "github.com/line/lfb-sdk/types"
+	"github.com/line/lfb-sdk/types/tx/signing"
 	authclient "github.com/line/lfb-sdk/x/auth/client"
 	authcmd "github.com/line/lfb-sdk/x/auth/client/cli"
+	authtx "github.com/line/lfb-sdk/x/auth/tx"
+	"github.com/line/lfb-sdk/x/auth/tx/textual"
 	"github.com/line/lfb-sdk/x/auth/types"
 	vestingcli "github.com/line/lfb-sdk/x/auth/vesting/client/cli"
 	banktypes "github.com/line/lfb-sdk/x/bank/types"
@@ -53,7 +60,14 @@ func NewRootCmd() (*cobra.Command, params.EncodingConfig) {
 		Use:   "simd",
 		Short: "simulation app",
 		PersistentPreRunE: func(cmd *cobra.Command, _ []string) error {
-			if err := client.SetCmdClientContextHandler(initClientCtx, cmd); err != nil {
+			// SIGN_MODE_TEXTUAL renders the coins with the bank denom metadata
+			// of the node the command is talking to
+			clientCtx := initClientCtx.WithTxConfig(authtx.NewTxConfigWithTextual(
+				codec.NewProtoCodec(encodingConfig.InterfaceRegistry),
+				append(authtx.DefaultSignModes, signing.SignMode_SIGN_MODE_TEXTUAL),
+				textual.NewGRPCCoinMetadataQueryFn(cmdQueryConn{cmd: cmd}),
+			))
+			if err := client.SetCmdClientContextHandler(clientCtx, cmd); err != nil {
 				return err
 			}
 
@@ -66,6 +80,32 @@ func NewRootCmd() (*cobra.Command, params.EncodingConfig) {
 	return rootCmd, encodingConfig
 }
 
+// cmdQueryConn is a gRPC client connection querying the node set in the flags
+// of a command, read when the query is made.
+type cmdQueryConn struct {
+	cmd *cobra.Command
+}
+
+var _ gogogrpc.ClientConn = cmdQueryConn{}
+
+// Invoke implements gogogrpc.ClientConn.Invoke
+func (c cmdQueryConn) Invoke(ctx context.Context, method string, args, reply interface{}, opts ...grpc.CallOption) error {
+	clientCtx, err := client.GetClientQueryContext(c.cmd)
+	if err != nil {
+		return err
+	}
+	return clientCtx.Invoke(ctx, method, args, reply, opts...)
+}
+
+// NewStream implements gogogrpc.ClientConn.NewStream
+func (c cmdQueryConn) NewStream(ctx context.Context, desc *grpc.StreamDesc, method string, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	clientCtx, err := client.GetClientQueryContext(c.cmd)
+	if err != nil {
+		return nil, err
+	}
+	return clientCtx.NewStream(ctx, desc, method, opts...)
+}
+
 func initRootCmd(rootCmd *cobra.Command, encodingConfig params.EncodingConfig) {
 	authclient.Codec = encodingConfig.Marshaler
 
@@ -203,6 +243,7 @@ func (a appCreator) newApp(logger log.Logger, db tmdb.DB, traceStore io.Writer,
 		baseapp.SetInterBlockCache(cache),
 		baseapp.SetIAVLCacheManager(cast.ToInt(appOpts.Get(server.FlagIAVLCacheSize)), iavlCacheMetricsProvider),
 		baseapp.SetTrace(cast.ToBool(appOpts.Get(server.FlagTrace))),
+		baseapp.SetDeliverTxWorkers(cast.ToInt(appOpts.Get(server.FlagDeliverTxWorkers))),
 		baseapp.SetIndexEvents(cast.ToStringSlice(appOpts.Get(server.FlagIndexEvents))),
 		baseapp.SetSnapshotStore(snapshotStore),
 		baseapp.SetSnapshotInterval(cast.ToUint64(appOpts.Get(server.FlagStateSyncSnapshotInterval))),
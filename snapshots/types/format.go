@@ -1,6 +1,15 @@
 package types
 
+const (
+	// FormatV1 streams all stores serially as a single zlib compressed stream of snapshot items.
+	FormatV1 uint32 = 1
+
+	// FormatV2 exports the stores in parallel. Every chunk belongs to a single store and starts with a
+	// header naming the store and its compression, so that stores can be restored concurrently.
+	FormatV2 uint32 = 2
+)
+
 // CurrentFormat is the currently used format for snapshots. Snapshots using the same format
 // must be identical across all nodes for a given height, so this must be bumped when the binary
 // snapshot output changes.
-const CurrentFormat uint32 = 1
+const CurrentFormat = FormatV2
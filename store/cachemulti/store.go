@@ -8,6 +8,7 @@ import (
 
 	"github.com/line/lfb-sdk/store/cachekv"
 	"github.com/line/lfb-sdk/store/dbadapter"
+	"github.com/line/lfb-sdk/store/listenkv"
 	"github.com/line/lfb-sdk/store/types"
 )
 
@@ -25,6 +26,8 @@ type Store struct {
 
 	traceWriter  io.Writer
 	traceContext types.TraceContext
+
+	listeners map[types.StoreKey][]types.WriteListener
 }
 
 var _ types.CacheMultiStore = Store{}
@@ -42,6 +45,7 @@ func NewFromKVStore(
 		keys:         keys,
 		traceWriter:  traceWriter,
 		traceContext: traceContext,
+		listeners:    make(map[types.StoreKey][]types.WriteListener),
 	}
 
 	for key, store := range stores {
@@ -68,7 +72,7 @@ func NewStore(
 func newCacheMultiStoreFromCMS(cms Store) Store {
 	stores := make(map[types.StoreKey]types.CacheWrapper)
 	for k, v := range cms.stores {
-		stores[k] = v
+		stores[k] = cms.listen(k, v)
 	}
 
 	return NewFromKVStore(cms.db, stores, nil, cms.traceWriter, cms.traceContext)
@@ -102,6 +106,27 @@ func (cms Store) TracingEnabled() bool {
 	return cms.traceWriter != nil
 }
 
+// AddListeners adds listeners for the KVStore belonging to the provided StoreKey.
+// The listeners receive the writes to the store, including the writes of the
+// branches of this multi-store once they are written.
+func (cms Store) AddListeners(key types.StoreKey, listeners []types.WriteListener) {
+	cms.listeners[key] = append(cms.listeners[key], listeners...)
+}
+
+// ListeningEnabled returns if listening is enabled for the KVStore belonging to
+// the provided StoreKey.
+func (cms Store) ListeningEnabled(key types.StoreKey) bool {
+	return len(cms.listeners[key]) != 0
+}
+
+// listen wraps the store with the listeners of its key, if any.
+func (cms Store) listen(key types.StoreKey, store types.CacheWrap) types.KVStore {
+	if listeners := cms.listeners[key]; len(listeners) != 0 {
+		return listenkv.NewStore(store.(types.KVStore), key, listeners)
+	}
+	return store.(types.KVStore)
+}
+
 // GetStoreType returns the type of the store.
 func (cms Store) GetStoreType() types.StoreType {
 	return types.StoreTypeMulti
@@ -130,6 +155,19 @@ func (cms Store) CacheMultiStore() types.CacheMultiStore {
 	return newCacheMultiStoreFromCMS(cms)
 }
 
+// CacheMultiStoreWithWrapper branches the multi-store like CacheMultiStore does,
+// but every underlying store is first passed through wrap. It allows the caller
+// to observe the accesses the new branch makes to this one. The database store
+// is passed to wrap with a nil StoreKey.
+func (cms Store) CacheMultiStoreWithWrapper(wrap func(types.StoreKey, types.KVStore) types.KVStore) types.CacheMultiStore {
+	stores := make(map[types.StoreKey]types.CacheWrapper, len(cms.stores))
+	for k, v := range cms.stores {
+		stores[k] = wrap(k, cms.listen(k, v))
+	}
+
+	return NewFromKVStore(wrap(nil, cms.db), stores, nil, cms.traceWriter, cms.traceContext)
+}
+
 // CacheMultiStoreWithVersion implements the MultiStore interface. It will panic
 // as an already cached multi-store cannot load previous versions.
 //
@@ -141,7 +179,7 @@ func (cms Store) CacheMultiStoreWithVersion(_ int64) (types.CacheMultiStore, err
 
 // GetStore returns an underlying Store by key.
 func (cms Store) GetStore(key types.StoreKey) types.Store {
-	return cms.stores[key].(types.Store)
+	return cms.listen(key, cms.stores[key])
 }
 
 // GetKVStore returns an underlying KVStore by key.
@@ -150,5 +188,5 @@ func (cms Store) GetKVStore(key types.StoreKey) types.KVStore {
 	if store == nil {
 		panic(fmt.Sprintf("kv store with key %v has not been registered in stores", key))
 	}
-	return store.(types.KVStore)
+	return cms.listen(key, store)
 }
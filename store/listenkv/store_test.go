@@ -0,0 +1,121 @@
+package listenkv_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/line/tm-db/v2/memdb"
+
+	"github.com/line/lfb-sdk/store/cachekv"
+	"github.com/line/lfb-sdk/store/dbadapter"
+	"github.com/line/lfb-sdk/store/listenkv"
+	"github.com/line/lfb-sdk/store/types"
+)
+
+func bz(s string) []byte { return []byte(s) }
+
+func keyFmt(i int) []byte { return bz(fmt.Sprintf("key%0.8d", i)) }
+func valFmt(i int) []byte { return bz(fmt.Sprintf("value%0.8d", i)) }
+
+var kvPairs = []types.KVPair{
+	{Key: keyFmt(1), Value: valFmt(1)},
+	{Key: keyFmt(2), Value: valFmt(2)},
+	{Key: keyFmt(3), Value: valFmt(3)},
+}
+
+var testStoreKey = types.NewKVStoreKey("listen_test")
+
+func newListenKVStore(listener types.WriteListener) *listenkv.Store {
+	store := newEmptyListenKVStore(listener)
+
+	for _, kvPair := range kvPairs {
+		store.Set(kvPair.Key, kvPair.Value)
+	}
+
+	return store
+}
+
+func newEmptyListenKVStore(listener types.WriteListener) *listenkv.Store {
+	memDB := dbadapter.Store{DB: memdb.NewDB()}
+	return listenkv.NewStore(memDB, testStoreKey, []types.WriteListener{listener})
+}
+
+func TestListenKVStoreGet(t *testing.T) {
+	listener := types.NewMemoryListener()
+	store := newListenKVStore(listener)
+	listener.PopStateCache()
+
+	require.Equal(t, kvPairs[0].Value, store.Get(kvPairs[0].Key))
+	require.Nil(t, store.Get(bz("does-not-exist")))
+	require.True(t, store.Has(kvPairs[1].Key))
+	require.Empty(t, listener.PopStateCache())
+}
+
+func TestListenKVStoreSet(t *testing.T) {
+	listener := types.NewMemoryListener()
+	store := newEmptyListenKVStore(listener)
+
+	for _, kvPair := range kvPairs {
+		store.Set(kvPair.Key, kvPair.Value)
+		require.Equal(t, []*types.StoreKVPair{
+			{StoreKey: testStoreKey.Name(), Key: kvPair.Key, Value: kvPair.Value},
+		}, listener.PopStateCache())
+	}
+
+	require.Panics(t, func() { store.Set([]byte(""), []byte("value")) }, "setting an empty key should panic")
+	require.Panics(t, func() { store.Set(nil, []byte("value")) }, "setting a nil key should panic")
+	require.Empty(t, listener.PopStateCache())
+}
+
+func TestListenKVStoreDelete(t *testing.T) {
+	listener := types.NewMemoryListener()
+	store := newListenKVStore(listener)
+	listener.PopStateCache()
+
+	store.Delete(kvPairs[0].Key)
+
+	require.False(t, store.Has(kvPairs[0].Key))
+	require.Equal(t, []*types.StoreKVPair{
+		{StoreKey: testStoreKey.Name(), Delete: true, Key: kvPairs[0].Key},
+	}, listener.PopStateCache())
+}
+
+func TestListenKVStoreIterator(t *testing.T) {
+	listener := types.NewMemoryListener()
+	store := newListenKVStore(listener)
+	listener.PopStateCache()
+
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	i := 0
+	for ; iterator.Valid(); iterator.Next() {
+		require.Equal(t, kvPairs[i].Key, iterator.Key())
+		require.Equal(t, kvPairs[i].Value, iterator.Value())
+		i++
+	}
+	require.Equal(t, len(kvPairs), i)
+	require.Empty(t, listener.PopStateCache())
+}
+
+func TestListenKVStoreCacheWrap(t *testing.T) {
+	listener := types.NewMemoryListener()
+	store := newEmptyListenKVStore(listener)
+
+	cache := store.CacheWrap().(*cachekv.Store)
+	cache.Set(kvPairs[0].Key, kvPairs[0].Value)
+	require.Empty(t, listener.PopStateCache(), "writes must be reported when the branch is written")
+
+	cache.Write()
+	require.Equal(t, []*types.StoreKVPair{
+		{StoreKey: testStoreKey.Name(), Key: kvPairs[0].Key, Value: kvPairs[0].Value},
+	}, listener.PopStateCache())
+}
+
+func TestListenKVStoreGetStoreType(t *testing.T) {
+	memDB := dbadapter.Store{DB: memdb.NewDB()}
+	store := newEmptyListenKVStore(nil)
+	require.Equal(t, memDB.GetStoreType(), store.GetStoreType())
+}
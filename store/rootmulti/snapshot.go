@@ -0,0 +1,456 @@
+package rootmulti
+
+import (
+	"bytes"
+	"compress/zlib"
+	"encoding/binary"
+	"io"
+	"io/ioutil"
+	"math"
+	"sync"
+
+	"github.com/DataDog/zstd"
+	protoio "github.com/gogo/protobuf/io"
+	iavltree "github.com/line/iavl/v2"
+	"github.com/pkg/errors"
+
+	"github.com/line/lfb-sdk/snapshots"
+	"github.com/line/lfb-sdk/store/iavl"
+	"github.com/line/lfb-sdk/store/types"
+	sdkerrors "github.com/line/lfb-sdk/types/errors"
+)
+
+const (
+	// snapshotParallelism is the number of stores exported or restored concurrently in snapshot format 2.
+	// It does not change the snapshot output.
+	snapshotParallelism = 4
+	// snapshotStoreChunkBuffer is the number of chunks a store exports ahead of the chunks being consumed.
+	snapshotStoreChunkBuffer = 2
+	// Do not change the compression levels without new snapshot format (must be uniform across nodes)
+	snapshotZlibLevel = 7
+	snapshotZstdLevel = zstd.DefaultCompression
+)
+
+var errSnapshotAborted = errors.New("snapshot aborted")
+
+// namedStore is a store to snapshot together with its name.
+type namedStore struct {
+	*iavl.Store
+	name string
+}
+
+// storeExport holds the chunks of a store exported in the background.
+type storeExport struct {
+	name        string
+	compression types.SnapshotCompression
+	chunks      chan []byte
+	err         error // set before chunks is closed
+}
+
+// snapshotCompressionOf returns the compression of the store in snapshot format 2.
+func (rs *Store) snapshotCompressionOf(storeName string) types.SnapshotCompression {
+	if compression, ok := rs.snapshotCompression[storeName]; ok {
+		return compression
+	}
+	return types.SnapshotCompressionZstd
+}
+
+// snapshotV2 exports the stores of a snapshot in format 2. Each store is exported to its own stream of
+// snapshot items, compressed and split into chunks in the background, while the chunks are emitted store by
+// store in the order of the stores. Every chunk starts with a delimited SnapshotChunkHeader.
+func (rs *Store) snapshotV2(height uint64, stores []namedStore) <-chan io.ReadCloser {
+	exports := make([]*storeExport, len(stores))
+	for i, store := range stores {
+		exports[i] = &storeExport{
+			name:        store.name,
+			compression: rs.snapshotCompressionOf(store.name),
+			chunks:      make(chan []byte, snapshotStoreChunkBuffer),
+		}
+	}
+
+	// Stores are started in order once an earlier store is done, so the store whose chunks are emitted is
+	// always running and at most snapshotParallelism stores hold chunks in memory.
+	abort := make(chan struct{})
+	sem := make(chan struct{}, snapshotParallelism)
+	go func() {
+		for i, store := range stores {
+			select {
+			case sem <- struct{}{}:
+			case <-abort:
+				return
+			}
+			go func(store namedStore, export *storeExport) {
+				defer func() { <-sem }()
+				defer close(export.chunks)
+				chunkWriter := &memChunkWriter{ch: export.chunks, abort: abort}
+				export.err = exportStoreSnapshot(height, store, export.compression, chunkWriter)
+			}(store, exports[i])
+		}
+	}()
+
+	ch := make(chan io.ReadCloser)
+	go func() {
+		defer close(ch)
+		for _, export := range exports {
+			var (
+				index uint32
+				err   error
+			)
+			for chunk := range export.chunks {
+				var header []byte
+				header, err = encodeSnapshotChunkHeader(&types.SnapshotChunkHeader{
+					Store:       export.name,
+					Compression: export.compression,
+					Index:       index,
+				})
+				if err != nil {
+					break
+				}
+				ch <- ioutil.NopCloser(io.MultiReader(bytes.NewReader(header), bytes.NewReader(chunk)))
+				index++
+			}
+			if err == nil {
+				// the chunks are closed once the export is done
+				err = export.err
+			}
+			if err != nil {
+				close(abort)
+				pr, pw := io.Pipe()
+				_ = pw.CloseWithError(sdkerrors.Wrapf(err, "store %q", export.name))
+				ch <- pr
+				return
+			}
+		}
+	}()
+	return ch
+}
+
+// exportStoreSnapshot writes the IAVL nodes of the store as compressed stream of delimited SnapshotItem
+// Protobuf messages.
+func exportStoreSnapshot(height uint64, store namedStore, compression types.SnapshotCompression, chunkWriter *memChunkWriter) error {
+	exporter, err := store.Export(int64(height))
+	if err != nil {
+		return err
+	}
+	defer exporter.Close()
+
+	zWriter, err := newSnapshotCompressor(compression, chunkWriter)
+	if err != nil {
+		return err
+	}
+	protoWriter := protoio.NewDelimitedWriter(zWriter)
+	for {
+		node, err := exporter.Next()
+		if err == iavltree.ExportDone {
+			break
+		} else if err != nil {
+			_ = zWriter.Close()
+			return err
+		}
+		err = protoWriter.WriteMsg(&types.SnapshotItem{
+			Item: &types.SnapshotItem_IAVL{
+				IAVL: &types.SnapshotIAVLItem{
+					Key:     node.Key,
+					Value:   node.Value,
+					Height:  int32(node.Height),
+					Version: node.Version,
+				},
+			},
+		})
+		if err != nil {
+			_ = zWriter.Close()
+			return err
+		}
+	}
+	// some compressors do not return the errors of the underlying writer
+	if err := zWriter.Close(); err != nil {
+		return err
+	}
+	if chunkWriter.err != nil {
+		return chunkWriter.err
+	}
+	return chunkWriter.Close()
+}
+
+// restoreV2 restores a snapshot in format 2. The chunks are dispatched to the store named in their header,
+// so that a store is imported while the chunks of the next stores are received.
+func (rs *Store) restoreV2(height uint64, chunks <-chan io.ReadCloser) (err error) {
+	defer snapshots.DrainChunks(chunks)
+
+	var (
+		wg       sync.WaitGroup
+		sem      = make(chan struct{}, snapshotParallelism)
+		errCh    = make(chan error, len(rs.stores))
+		current  *storeRestore
+		restored []string
+	)
+	defer func() {
+		if current != nil {
+			close(current.chunks)
+		}
+		wg.Wait()
+		if err == nil {
+			select {
+			case err = <-errCh:
+			default:
+			}
+		}
+	}()
+
+	for chunk := range chunks {
+		select {
+		case err := <-errCh:
+			return err
+		default:
+		}
+
+		bz, err := ioutil.ReadAll(chunk)
+		chunk.Close()
+		if err != nil {
+			return err
+		}
+		header, payload, err := decodeSnapshotChunk(bz)
+		if err != nil {
+			return err
+		}
+
+		if current == nil || current.name != header.Store {
+			if current != nil {
+				close(current.chunks)
+				current = nil
+			}
+			if len(restored) > 0 && header.Store <= restored[len(restored)-1] {
+				return sdkerrors.Wrapf(sdkerrors.ErrLogic, "store %q out of order", header.Store)
+			}
+			store, ok := rs.getStoreByName(header.Store).(*iavl.Store)
+			if !ok || store == nil {
+				return sdkerrors.Wrapf(sdkerrors.ErrLogic, "cannot import into non-IAVL store %q", header.Store)
+			}
+			if header.Index != 0 {
+				return sdkerrors.Wrapf(sdkerrors.ErrLogic, "store %q starts with chunk %d", header.Store, header.Index)
+			}
+			restored = append(restored, header.Store)
+			current = &storeRestore{
+				name:        header.Store,
+				compression: header.Compression,
+				chunks:      make(chan io.ReadCloser, snapshotStoreChunkBuffer),
+			}
+			sem <- struct{}{}
+			wg.Add(1)
+			go func(store *iavl.Store, r *storeRestore) {
+				defer wg.Done()
+				defer func() { <-sem }()
+				if err := importStoreSnapshot(height, store, r.compression, r.chunks); err != nil {
+					errCh <- sdkerrors.Wrapf(err, "store %q", r.name)
+					snapshots.DrainChunks(r.chunks)
+				}
+			}(store, current)
+		} else {
+			if header.Index != current.next {
+				return sdkerrors.Wrapf(sdkerrors.ErrLogic, "store %q expected chunk %d, got %d",
+					header.Store, current.next, header.Index)
+			}
+			if header.Compression != current.compression {
+				return sdkerrors.Wrapf(sdkerrors.ErrLogic, "store %q changed compression to %s",
+					header.Store, header.Compression)
+			}
+		}
+		current.next++
+		current.chunks <- ioutil.NopCloser(bytes.NewReader(payload))
+	}
+	return nil
+}
+
+// storeRestore holds the chunks of a store being imported in the background.
+type storeRestore struct {
+	name        string
+	compression types.SnapshotCompression
+	chunks      chan io.ReadCloser
+	next        uint32
+}
+
+// importStoreSnapshot imports the compressed stream of delimited SnapshotItem Protobuf messages into the store.
+func importStoreSnapshot(height uint64, store *iavl.Store, compression types.SnapshotCompression, chunks <-chan io.ReadCloser) error {
+	chunkReader := snapshots.NewChunkReader(chunks)
+	defer chunkReader.Close()
+	zReader, err := newSnapshotDecompressor(compression, chunkReader)
+	if err != nil {
+		return err
+	}
+	// closes the zReader as well
+	protoReader := protoio.NewDelimitedReader(zReader, snapshotMaxItemSize)
+	defer protoReader.Close()
+
+	importer, err := store.Import(int64(height))
+	if err != nil {
+		return sdkerrors.Wrap(err, "import failed")
+	}
+	defer importer.Close()
+
+	for {
+		item := &types.SnapshotItem{}
+		err := protoReader.ReadMsg(item)
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return sdkerrors.Wrap(err, "invalid protobuf message")
+		}
+
+		iavlItem, ok := item.Item.(*types.SnapshotItem_IAVL)
+		if !ok {
+			return sdkerrors.Wrapf(sdkerrors.ErrLogic, "unknown snapshot item %T", item.Item)
+		}
+		node, err := snapshotExportNode(iavlItem.IAVL)
+		if err != nil {
+			return err
+		}
+		if err := importer.Add(node); err != nil {
+			return sdkerrors.Wrap(err, "IAVL node import failed")
+		}
+	}
+	if err := importer.Commit(); err != nil {
+		return sdkerrors.Wrap(err, "IAVL commit failed")
+	}
+	return nil
+}
+
+// snapshotExportNode converts a snapshot item to an IAVL export node.
+func snapshotExportNode(item *types.SnapshotIAVLItem) (*iavltree.ExportNode, error) {
+	if item.Height > math.MaxInt8 {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrLogic, "node height %v cannot exceed %v",
+			item.Height, math.MaxInt8)
+	}
+	node := &iavltree.ExportNode{
+		Key:     item.Key,
+		Value:   item.Value,
+		Height:  int8(item.Height),
+		Version: item.Version,
+	}
+	// Protobuf does not differentiate between []byte{} as nil, but fortunately IAVL does
+	// not allow nil keys nor nil values for leaf nodes, so we can always set them to empty.
+	if node.Key == nil {
+		node.Key = []byte{}
+	}
+	if node.Height == 0 && node.Value == nil {
+		node.Value = []byte{}
+	}
+	return node, nil
+}
+
+// encodeSnapshotChunkHeader encodes the header as length delimited Protobuf message.
+func encodeSnapshotChunkHeader(header *types.SnapshotChunkHeader) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := protoio.NewDelimitedWriter(&buf).WriteMsg(header); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// decodeSnapshotChunk splits a chunk into its header and its payload.
+func decodeSnapshotChunk(chunk []byte) (*types.SnapshotChunkHeader, []byte, error) {
+	size, n := binary.Uvarint(chunk)
+	if n <= 0 || size > uint64(len(chunk)-n) {
+		return nil, nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "invalid snapshot chunk header")
+	}
+	header := &types.SnapshotChunkHeader{}
+	if err := header.Unmarshal(chunk[n : n+int(size)]); err != nil {
+		return nil, nil, sdkerrors.Wrap(err, "invalid snapshot chunk header")
+	}
+	return header, chunk[n+int(size):], nil
+}
+
+func newSnapshotCompressor(compression types.SnapshotCompression, w io.Writer) (io.WriteCloser, error) {
+	switch compression {
+	case types.SnapshotCompressionNone:
+		return nopWriteCloser{w}, nil
+	case types.SnapshotCompressionZlib:
+		zWriter, err := zlib.NewWriterLevel(w, snapshotZlibLevel)
+		if err != nil {
+			return nil, sdkerrors.Wrap(err, "zlib failure")
+		}
+		return zWriter, nil
+	case types.SnapshotCompressionZstd:
+		return zstd.NewWriterLevel(w, snapshotZstdLevel), nil
+	default:
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrLogic, "unknown snapshot compression %s", compression)
+	}
+}
+
+func newSnapshotDecompressor(compression types.SnapshotCompression, r io.Reader) (io.ReadCloser, error) {
+	switch compression {
+	case types.SnapshotCompressionNone:
+		return ioutil.NopCloser(r), nil
+	case types.SnapshotCompressionZlib:
+		zReader, err := zlib.NewReader(r)
+		if err != nil {
+			return nil, sdkerrors.Wrap(err, "zlib failure")
+		}
+		return zReader, nil
+	case types.SnapshotCompressionZstd:
+		return zstd.NewReader(r), nil
+	default:
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrLogic, "unknown snapshot compression %s", compression)
+	}
+}
+
+type nopWriteCloser struct {
+	io.Writer
+}
+
+func (nopWriteCloser) Close() error { return nil }
+
+// memChunkWriter splits a stream into chunks of snapshotChunkSize bytes held in memory, so that a store is
+// exported while the chunks of an earlier store are consumed. Every store has at least one chunk, so that
+// empty stores are restored as well.
+type memChunkWriter struct {
+	ch    chan<- []byte
+	abort <-chan struct{}
+	buf   []byte
+	sent  bool
+	err   error
+}
+
+// Write implements io.Writer.
+func (w *memChunkWriter) Write(data []byte) (int, error) {
+	if w.err != nil {
+		return 0, w.err
+	}
+	n := len(data)
+	for len(data) > 0 {
+		size := int(snapshotChunkSize) - len(w.buf)
+		if size > len(data) {
+			size = len(data)
+		}
+		w.buf = append(w.buf, data[:size]...)
+		data = data[size:]
+		if len(w.buf) == int(snapshotChunkSize) {
+			if err := w.flush(); err != nil {
+				return n - len(data), err
+			}
+		}
+	}
+	return n, nil
+}
+
+// Close sends the last chunk.
+func (w *memChunkWriter) Close() error {
+	if w.err != nil {
+		return w.err
+	}
+	if len(w.buf) > 0 || !w.sent {
+		return w.flush()
+	}
+	return nil
+}
+
+func (w *memChunkWriter) flush() error {
+	select {
+	case w.ch <- w.buf:
+	case <-w.abort:
+		w.err = errSnapshotAborted
+		return w.err
+	}
+	w.buf = nil
+	w.sent = true
+	return nil
+}
@@ -60,6 +60,10 @@ type Store struct {
 
 	interBlockCache  types.MultiStorePersistentCache
 	iavlCacheManager types.CacheManager
+
+	snapshotCompression map[string]types.SnapshotCompression
+
+	listeners map[types.StoreKey][]types.WriteListener
 }
 
 var (
@@ -79,6 +83,9 @@ func NewStore(db tmdb.DB) *Store {
 		stores:       make(map[types.StoreKey]types.CommitKVStore),
 		keysByName:   make(map[string]types.StoreKey),
 		pruneHeights: make([]int64, 0),
+
+		snapshotCompression: make(map[string]types.SnapshotCompression),
+		listeners:           make(map[types.StoreKey][]types.WriteListener),
 	}
 }
 
@@ -103,6 +110,13 @@ func (rs *Store) SetIAVLCacheManager(cacheManager types.CacheManager) {
 	rs.iavlCacheManager = cacheManager
 }
 
+// SetSnapshotCompression sets the compression of a store in snapshots of format 2. Stores use zstd unless
+// set otherwise. The snapshot chunks depend on the compression, so nodes serving the same snapshot to state
+// syncing nodes should use the same settings.
+func (rs *Store) SetSnapshotCompression(storeName string, compression types.SnapshotCompression) {
+	rs.snapshotCompression[storeName] = compression
+}
+
 // GetStoreType implements Store.
 func (rs *Store) GetStoreType() types.StoreType {
 	return types.StoreTypeMulti
@@ -421,6 +435,30 @@ func (rs *Store) CacheMultiStore() types.CacheMultiStore {
 	return cachemulti.NewStore(rs.db, stores, rs.keysByName, rs.traceWriter, rs.traceContext)
 }
 
+// CacheMultiStoreWithListeners is analogous to CacheMultiStore except that the
+// writes to the stores of the returned multi-store, including the writes of its
+// branches once they are written, are passed to the listeners added with
+// AddListeners.
+func (rs *Store) CacheMultiStoreWithListeners() types.CacheMultiStore {
+	cms := rs.CacheMultiStore().(cachemulti.Store)
+	for key, listeners := range rs.listeners {
+		cms.AddListeners(key, listeners)
+	}
+	return cms
+}
+
+// AddListeners adds listeners for the KVStore belonging to the provided StoreKey.
+// They receive the writes to the multi-stores returned by CacheMultiStoreWithListeners.
+func (rs *Store) AddListeners(key types.StoreKey, listeners []types.WriteListener) {
+	rs.listeners[key] = append(rs.listeners[key], listeners...)
+}
+
+// ListeningEnabled returns if listening is enabled for the KVStore belonging to
+// the provided StoreKey.
+func (rs *Store) ListeningEnabled(key types.StoreKey) bool {
+	return len(rs.listeners[key]) != 0
+}
+
 // CacheMultiStoreWithVersion is analogous to CacheMultiStore except that it
 // attempts to load stores at a given version (height). An error is returned if
 // any store cannot be loaded. This should only be used for querying and
@@ -592,7 +630,7 @@ func parsePath(path string) (storeName string, subpath string, err error) {
 // given format changes (at the byte level), the snapshot format must be bumped - see
 // TestMultistoreSnapshot_Checksum test.
 func (rs *Store) Snapshot(height uint64, format uint32) (<-chan io.ReadCloser, error) {
-	if format != snapshottypes.CurrentFormat {
+	if format != snapshottypes.FormatV1 && format != snapshottypes.FormatV2 {
 		return nil, sdkerrors.Wrapf(snapshottypes.ErrUnknownFormat, "format %v", format)
 	}
 	if height == 0 {
@@ -603,10 +641,6 @@ func (rs *Store) Snapshot(height uint64, format uint32) (<-chan io.ReadCloser, e
 	}
 
 	// Collect stores to snapshot (only IAVL stores are supported)
-	type namedStore struct {
-		*iavl.Store
-		name string
-	}
 	stores := []namedStore{}
 	for key := range rs.stores {
 		switch store := rs.GetCommitKVStore(key).(type) {
@@ -623,6 +657,9 @@ func (rs *Store) Snapshot(height uint64, format uint32) (<-chan io.ReadCloser, e
 	sort.Slice(stores, func(i, j int) bool {
 		return strings.Compare(stores[i].name, stores[j].name) == -1
 	})
+	if format == snapshottypes.FormatV2 {
+		return rs.snapshotV2(height, stores), nil
+	}
 
 	// Spawn goroutine to generate snapshot chunks and pass their io.ReadClosers through a channel
 	ch := make(chan io.ReadCloser)
@@ -711,7 +748,7 @@ func (rs *Store) Snapshot(height uint64, format uint32) (<-chan io.ReadCloser, e
 func (rs *Store) Restore(
 	height uint64, format uint32, chunks <-chan io.ReadCloser, ready chan<- struct{},
 ) error {
-	if format != snapshottypes.CurrentFormat {
+	if format != snapshottypes.FormatV1 && format != snapshottypes.FormatV2 {
 		return sdkerrors.Wrapf(snapshottypes.ErrUnknownFormat, "format %v", format)
 	}
 	if height == 0 {
@@ -727,6 +764,13 @@ func (rs *Store) Restore(
 	if ready != nil {
 		close(ready)
 	}
+	if format == snapshottypes.FormatV2 {
+		if err := rs.restoreV2(height, chunks); err != nil {
+			return err
+		}
+		flushMetadata(rs.db, int64(height), rs.buildCommitInfo(int64(height)), []int64{})
+		return rs.LoadLatestVersion()
+	}
 
 	// Set up a restore stream pipeline
 	// chan io.ReadCloser -> chunkReader -> zlib -> delimited Protobuf -> ExportNode
@@ -776,25 +820,11 @@ func (rs *Store) Restore(
 			if importer == nil {
 				return sdkerrors.Wrap(sdkerrors.ErrLogic, "received IAVL node item before store item")
 			}
-			if item.IAVL.Height > math.MaxInt8 {
-				return sdkerrors.Wrapf(sdkerrors.ErrLogic, "node height %v cannot exceed %v",
-					item.IAVL.Height, math.MaxInt8)
-			}
-			node := &iavltree.ExportNode{
-				Key:     item.IAVL.Key,
-				Value:   item.IAVL.Value,
-				Height:  int8(item.IAVL.Height),
-				Version: item.IAVL.Version,
-			}
-			// Protobuf does not differentiate between []byte{} as nil, but fortunately IAVL does
-			// not allow nil keys nor nil values for leaf nodes, so we can always set them to empty.
-			if node.Key == nil {
-				node.Key = []byte{}
-			}
-			if node.Height == 0 && node.Value == nil {
-				node.Value = []byte{}
+			node, err := snapshotExportNode(item.IAVL)
+			if err != nil {
+				return err
 			}
-			err := importer.Add(node)
+			err = importer.Add(node)
 			if err != nil {
 				return sdkerrors.Wrap(err, "IAVL node import failed")
 			}
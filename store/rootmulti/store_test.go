@@ -1,6 +1,7 @@
 package rootmulti
 
 import (
+	"bytes"
 	"crypto/sha256"
 	"encoding/binary"
 	"encoding/hex"
@@ -101,6 +102,39 @@ func TestCacheMultiStoreWithVersion(t *testing.T) {
 	})
 }
 
+func TestCacheMultiStoreWithListeners(t *testing.T) {
+	db := memdb.NewDB()
+	multi := newMultiStoreWithMounts(db, types.PruneNothing)
+	require.NoError(t, multi.LoadLatestVersion())
+
+	key1, key2 := multi.keysByName["store1"], multi.keysByName["store2"]
+	listener := types.NewMemoryListener()
+	multi.AddListeners(key1, []types.WriteListener{listener})
+	require.True(t, multi.ListeningEnabled(key1))
+	require.False(t, multi.ListeningEnabled(key2))
+
+	// writes through the listening branch are reported, writes to other stores are not
+	cms := multi.CacheMultiStoreWithListeners()
+	cms.GetKVStore(key1).Set([]byte("k1"), []byte("v1"))
+	cms.GetKVStore(key2).Set([]byte("k2"), []byte("v2"))
+	require.Equal(t, []*types.StoreKVPair{
+		{StoreKey: "store1", Key: []byte("k1"), Value: []byte("v1")},
+	}, listener.PopStateCache())
+
+	// writes of a nested branch are reported once the branch is written
+	branch := cms.CacheMultiStore()
+	branch.GetKVStore(key1).Delete([]byte("k1"))
+	require.Empty(t, listener.PopStateCache())
+	branch.Write()
+	require.Equal(t, []*types.StoreKVPair{
+		{StoreKey: "store1", Delete: true, Key: []byte("k1")},
+	}, listener.PopStateCache())
+
+	// branches without listeners do not report
+	multi.CacheMultiStore().GetKVStore(key1).Set([]byte("k3"), []byte("v3"))
+	require.Empty(t, listener.PopStateCache())
+}
+
 func TestHashStableWithEmptyCommit(t *testing.T) {
 	var db tmdb.DB = memdb.NewDB()
 	ms := newMultiStoreWithMounts(db, types.PruneNothing)
@@ -561,6 +595,18 @@ func TestMultistoreSnapshot_Checksum(t *testing.T) {
 			"a4a864e6c02c9fca5837ec80dc84f650b25276ed7e4820cf7516ced9f9901b86",
 			"ca2879ac6e7205d257440131ba7e72bef784cd61642e32b847729e543c1928b9",
 		}},
+		{2, []string{
+			"7d12c279ce4c3c45efa1d15f76c92ac270768c9990bd12119279c61b3bc3876f",
+			"fc8c4d5405a9010d0ceb838f3a31bf6da3f6520bd90762a05df03b7673ebb048",
+			"bace3778e8432892a6d6811e16fd685bd77fadd230cede23ee0d59e71773ed8a",
+			"93e3efa17c980aa2d7214e10407d9b6da5444183b231bf6bff3b8058603cbc9a",
+			"826426e9b2421d436576b66e4810d9aa2ed7c56b29ac58c8fedc640a361216ca",
+			"86864ca144f4548fcf4cce00e35f94333ff0f6ad96789e72dea4cab118424a75",
+			"fdcdb5453b160a11acb6766d28c778b3500baa359b8d9cc1e3ad422750df3748",
+			"c930c8f28aa1739f705fae7d37ad73fa2c1919fd8f41fc7a1df1c1cea9b4b78d",
+			"dde079520be23bb84b08bd7d4bd7b8f60d7c4a1c8095a861379f2df7fe7decf3",
+			"d510a9861e6d9509dbed35d9513201231f069794424b1791b8297be22ad2aba3",
+		}},
 	}
 	for _, tc := range testcases {
 		tc := tc
@@ -631,22 +677,110 @@ func TestMultistoreRestore_Errors(t *testing.T) {
 }
 
 func TestMultistoreSnapshotRestore(t *testing.T) {
+	testcases := map[string]struct {
+		format      uint32
+		compression map[string]types.SnapshotCompression
+	}{
+		"format 1": {format: snapshottypes.FormatV1},
+		"format 2": {format: snapshottypes.FormatV2},
+		"format 2 with mixed compression": {
+			format: snapshottypes.FormatV2,
+			compression: map[string]types.SnapshotCompression{
+				"iavl1": types.SnapshotCompressionNone,
+				"iavl2": types.SnapshotCompressionZlib,
+			},
+		},
+	}
+	for name, tc := range testcases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			source := newMultiStoreWithMixedMountsAndBasicData(memdb.NewDB())
+			target := newMultiStoreWithMixedMounts(memdb.NewDB())
+			version := uint64(source.LastCommitID().Version)
+			require.EqualValues(t, 3, version)
+			for storeName, compression := range tc.compression {
+				source.SetSnapshotCompression(storeName, compression)
+			}
+
+			chunks, err := source.Snapshot(version, tc.format)
+			require.NoError(t, err)
+			ready := make(chan struct{})
+			err = target.Restore(version, tc.format, chunks, ready)
+			require.NoError(t, err)
+			assert.EqualValues(t, struct{}{}, <-ready)
+
+			assert.Equal(t, source.LastCommitID(), target.LastCommitID())
+			for key, sourceStore := range source.stores {
+				targetStore := target.getStoreByName(key.Name()).(types.CommitKVStore)
+				assertStoresEqual(t, sourceStore, targetStore, "store %q not equal", key.Name())
+			}
+		})
+	}
+}
+
+func TestMultistoreSnapshotRestore_FormatV2Errors(t *testing.T) {
 	source := newMultiStoreWithMixedMountsAndBasicData(memdb.NewDB())
-	target := newMultiStoreWithMixedMounts(memdb.NewDB())
 	version := uint64(source.LastCommitID().Version)
-	require.EqualValues(t, 3, version)
-
-	chunks, err := source.Snapshot(version, snapshottypes.CurrentFormat)
+	chunks, err := source.Snapshot(version, snapshottypes.FormatV2)
+	require.NoError(t, err)
+	validChunks := [][]byte{}
+	for chunk := range chunks {
+		bz, err := ioutil.ReadAll(chunk)
+		require.NoError(t, err)
+		validChunks = append(validChunks, bz)
+	}
+	// every store fits into a single chunk
+	require.Len(t, validChunks, 3)
+
+	chunk := func(store string, compression types.SnapshotCompression, index uint32, payload []byte) []byte {
+		header, err := encodeSnapshotChunkHeader(&types.SnapshotChunkHeader{
+			Store:       store,
+			Compression: compression,
+			Index:       index,
+		})
+		require.NoError(t, err)
+		return append(header, payload...)
+	}
+	_, payload, err := decodeSnapshotChunk(validChunks[0])
 	require.NoError(t, err)
-	ready := make(chan struct{})
-	err = target.Restore(version, snapshottypes.CurrentFormat, chunks, ready)
+	zReader, err := newSnapshotDecompressor(types.SnapshotCompressionZstd, bytes.NewReader(payload))
 	require.NoError(t, err)
-	assert.EqualValues(t, struct{}{}, <-ready)
-
-	assert.Equal(t, source.LastCommitID(), target.LastCommitID())
-	for key, sourceStore := range source.stores {
-		targetStore := target.getStoreByName(key.Name()).(types.CommitKVStore)
-		assertStoresEqual(t, sourceStore, targetStore, "store %q not equal", key.Name())
+	items, err := ioutil.ReadAll(zReader)
+	require.NoError(t, err)
+	require.NoError(t, zReader.Close())
+	corrupted := append([]byte{}, payload...)
+	corrupted[len(corrupted)/2] ^= 0xff
+
+	testcases := map[string][][]byte{
+		"invalid header":      {{0xff}},
+		"stores out of order": {validChunks[1], validChunks[0]},
+		"store repeated":      {validChunks[0], validChunks[1], validChunks[0]},
+		"unknown store":       {chunk("store9", types.SnapshotCompressionZstd, 0, payload)},
+		"first index not 0":   {chunk("iavl1", types.SnapshotCompressionZstd, 1, payload)},
+		"index skipped": {
+			chunk("iavl1", types.SnapshotCompressionZstd, 0, payload[:1]),
+			chunk("iavl1", types.SnapshotCompressionZstd, 2, payload[1:]),
+		},
+		"compression changed": {
+			chunk("iavl1", types.SnapshotCompressionZstd, 0, payload[:1]),
+			chunk("iavl1", types.SnapshotCompressionZlib, 1, payload[1:]),
+		},
+		"wrong compression": {chunk("iavl1", types.SnapshotCompressionZlib, 0, payload)},
+		"corrupted payload": {chunk("iavl1", types.SnapshotCompressionZstd, 0, corrupted)},
+		"truncated item":    {chunk("iavl1", types.SnapshotCompressionNone, 0, items[:len(items)-1])},
+	}
+	for name, tc := range testcases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			target := newMultiStoreWithMixedMounts(memdb.NewDB())
+			ch := make(chan io.ReadCloser, len(tc))
+			for _, bz := range tc {
+				ch <- ioutil.NopCloser(bytes.NewReader(bz))
+			}
+			close(ch)
+			err := target.Restore(version, snapshottypes.FormatV2, ch, nil)
+			require.Error(t, err)
+		})
 	}
 }
 
@@ -0,0 +1,50 @@
+package types
+
+import (
+	"sync"
+)
+
+// WriteListener receives the writes to the KVStores it listens to.
+type WriteListener interface {
+	// OnWrite is called for every Set and Delete. storeKey indicates the source KVStore, so that the same
+	// WriteListener can be used across separate KVStores. value is nil if delete is true.
+	//
+	// OnWrite must not fail the write, as the state machine must behave the same with and without listeners.
+	OnWrite(storeKey StoreKey, key []byte, value []byte, delete bool)
+}
+
+// MemoryListener is a WriteListener accumulating the writes in memory until they are popped.
+type MemoryListener struct {
+	mtx        sync.Mutex
+	stateCache []*StoreKVPair
+}
+
+var _ WriteListener = (*MemoryListener)(nil)
+
+// NewMemoryListener creates a listener that accumulates the state writes in memory.
+func NewMemoryListener() *MemoryListener {
+	return &MemoryListener{}
+}
+
+// OnWrite implements WriteListener.
+func (fl *MemoryListener) OnWrite(storeKey StoreKey, key []byte, value []byte, delete bool) {
+	fl.mtx.Lock()
+	defer fl.mtx.Unlock()
+
+	fl.stateCache = append(fl.stateCache, &StoreKVPair{
+		StoreKey: storeKey.Name(),
+		Delete:   delete,
+		Key:      key,
+		Value:    value,
+	})
+}
+
+// PopStateCache returns the writes accumulated since the last call and resets the listener.
+func (fl *MemoryListener) PopStateCache() []*StoreKVPair {
+	fl.mtx.Lock()
+	defer fl.mtx.Unlock()
+
+	res := fl.stateCache
+	fl.stateCache = nil
+	return res
+}
@@ -23,6 +23,38 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// SnapshotCompression is the compression of the snapshot items of a store in snapshot format 2.
+type SnapshotCompression int32
+
+const (
+	// SNAPSHOT_COMPRESSION_NONE stores the snapshot items uncompressed.
+	SnapshotCompressionNone SnapshotCompression = 0
+	// SNAPSHOT_COMPRESSION_ZLIB compresses the snapshot items with zlib.
+	SnapshotCompressionZlib SnapshotCompression = 1
+	// SNAPSHOT_COMPRESSION_ZSTD compresses the snapshot items with zstd.
+	SnapshotCompressionZstd SnapshotCompression = 2
+)
+
+var SnapshotCompression_name = map[int32]string{
+	0: "SNAPSHOT_COMPRESSION_NONE",
+	1: "SNAPSHOT_COMPRESSION_ZLIB",
+	2: "SNAPSHOT_COMPRESSION_ZSTD",
+}
+
+var SnapshotCompression_value = map[string]int32{
+	"SNAPSHOT_COMPRESSION_NONE": 0,
+	"SNAPSHOT_COMPRESSION_ZLIB": 1,
+	"SNAPSHOT_COMPRESSION_ZSTD": 2,
+}
+
+func (x SnapshotCompression) String() string {
+	return proto.EnumName(SnapshotCompression_name, int32(x))
+}
+
+func (SnapshotCompression) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_73b71130d50a6897, []int{0}
+}
+
 // SnapshotItem is an item contained in a rootmulti.Store snapshot.
 type SnapshotItem struct {
 	// item is the specific type of snapshot item.
@@ -225,10 +257,77 @@ func (m *SnapshotIAVLItem) GetHeight() int32 {
 	return 0
 }
 
+// SnapshotChunkHeader starts every chunk of a rootmulti.Store snapshot in format 2. Every chunk contains data of a
+// single store only, so the chunk headers mark the boundaries of the stores in the snapshot.
+type SnapshotChunkHeader struct {
+	// store is the name of the store the chunk belongs to.
+	Store string `protobuf:"bytes,1,opt,name=store,proto3" json:"store,omitempty"`
+	// compression is the compression of the snapshot items of the store.
+	Compression SnapshotCompression `protobuf:"varint,2,opt,name=compression,proto3,enum=lfb.base.store.v1beta1.SnapshotCompression" json:"compression,omitempty"`
+	// index is the index of the chunk among the chunks of the store.
+	Index uint32 `protobuf:"varint,3,opt,name=index,proto3" json:"index,omitempty"`
+}
+
+func (m *SnapshotChunkHeader) Reset()         { *m = SnapshotChunkHeader{} }
+func (m *SnapshotChunkHeader) String() string { return proto.CompactTextString(m) }
+func (*SnapshotChunkHeader) ProtoMessage()    {}
+func (*SnapshotChunkHeader) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73b71130d50a6897, []int{3}
+}
+func (m *SnapshotChunkHeader) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SnapshotChunkHeader) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SnapshotChunkHeader.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SnapshotChunkHeader) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SnapshotChunkHeader.Merge(m, src)
+}
+func (m *SnapshotChunkHeader) XXX_Size() int {
+	return m.Size()
+}
+func (m *SnapshotChunkHeader) XXX_DiscardUnknown() {
+	xxx_messageInfo_SnapshotChunkHeader.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SnapshotChunkHeader proto.InternalMessageInfo
+
+func (m *SnapshotChunkHeader) GetStore() string {
+	if m != nil {
+		return m.Store
+	}
+	return ""
+}
+
+func (m *SnapshotChunkHeader) GetCompression() SnapshotCompression {
+	if m != nil {
+		return m.Compression
+	}
+	return SnapshotCompressionNone
+}
+
+func (m *SnapshotChunkHeader) GetIndex() uint32 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
 func init() {
+	proto.RegisterEnum("lfb.base.store.v1beta1.SnapshotCompression", SnapshotCompression_name, SnapshotCompression_value)
 	proto.RegisterType((*SnapshotItem)(nil), "lfb.base.store.v1beta1.SnapshotItem")
 	proto.RegisterType((*SnapshotStoreItem)(nil), "lfb.base.store.v1beta1.SnapshotStoreItem")
 	proto.RegisterType((*SnapshotIAVLItem)(nil), "lfb.base.store.v1beta1.SnapshotIAVLItem")
+	proto.RegisterType((*SnapshotChunkHeader)(nil), "lfb.base.store.v1beta1.SnapshotChunkHeader")
 }
 
 func init() {
@@ -236,28 +335,37 @@ func init() {
 }
 
 var fileDescriptor_73b71130d50a6897 = []byte{
-	// 324 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x91, 0x31, 0x4e, 0xc3, 0x30,
-	0x14, 0x86, 0x63, 0x9a, 0x16, 0x30, 0x1d, 0x8a, 0x55, 0x55, 0x11, 0x43, 0xa8, 0x8a, 0x10, 0x61,
-	0xc0, 0x56, 0x61, 0x66, 0x68, 0xa7, 0x56, 0x62, 0x72, 0x25, 0x06, 0xb6, 0x18, 0xdc, 0xc4, 0xaa,
-	0x13, 0x57, 0xb5, 0x1b, 0xa9, 0xb7, 0xe0, 0x0e, 0x5c, 0x86, 0xb1, 0x23, 0x13, 0x42, 0xe9, 0x45,
-	0x90, 0x9d, 0x74, 0x01, 0x24, 0xb6, 0xf7, 0x5b, 0xdf, 0xff, 0xf9, 0x49, 0x0f, 0x5e, 0xca, 0x39,
-	0x23, 0x2c, 0xd6, 0x9c, 0x68, 0xa3, 0x56, 0x9c, 0x14, 0x43, 0xc6, 0x4d, 0x3c, 0x24, 0x3a, 0x8f,
-	0x97, 0x3a, 0x55, 0x06, 0x2f, 0x57, 0xca, 0x28, 0xd4, 0x93, 0x73, 0x86, 0x2d, 0x86, 0x1d, 0x86,
-	0x6b, 0xec, 0xac, 0x9b, 0xa8, 0x44, 0x39, 0x84, 0xd8, 0xa9, 0xa2, 0x07, 0x6f, 0x00, 0xb6, 0x67,
-	0xb5, 0x60, 0x6a, 0x78, 0x86, 0x46, 0xb0, 0xe9, 0x7a, 0x01, 0xe8, 0x83, 0xe8, 0xe4, 0xf6, 0x1a,
-	0xff, 0xad, 0xc3, 0xfb, 0xd2, 0xcc, 0xbe, 0xda, 0xe6, 0xc4, 0xa3, 0x55, 0x13, 0x4d, 0xa0, 0x2f,
-	0xe2, 0x42, 0x06, 0x07, 0xce, 0x10, 0xfd, 0x67, 0x98, 0x8e, 0x1e, 0x1f, 0xac, 0x60, 0x7c, 0x54,
-	0x7e, 0x9e, 0xfb, 0x36, 0x4d, 0x3c, 0xea, 0x0c, 0xe3, 0x16, 0xf4, 0x85, 0xe1, 0xd9, 0xe0, 0x0a,
-	0x9e, 0xfe, 0xfa, 0x0f, 0x21, 0xe8, 0xe7, 0x71, 0x56, 0x2d, 0x7a, 0x4c, 0xdd, 0x3c, 0x90, 0xb0,
-	0xf3, 0x53, 0x8b, 0x3a, 0xb0, 0xb1, 0xe0, 0x1b, 0x87, 0xb5, 0xa9, 0x1d, 0x51, 0x17, 0x36, 0x8b,
-	0x58, 0xae, 0xb9, 0xdb, 0xb0, 0x4d, 0xab, 0x80, 0x02, 0x78, 0x58, 0xf0, 0x95, 0x16, 0x2a, 0x0f,
-	0x1a, 0x7d, 0x10, 0x35, 0xe8, 0x3e, 0xa2, 0x1e, 0x6c, 0xa5, 0x5c, 0x24, 0xa9, 0x09, 0xfc, 0x3e,
-	0x88, 0x9a, 0xb4, 0x4e, 0xe3, 0xfb, 0xf7, 0x32, 0x04, 0xdb, 0x32, 0x04, 0x5f, 0x65, 0x08, 0x5e,
-	0x77, 0xa1, 0xb7, 0xdd, 0x85, 0xde, 0xc7, 0x2e, 0xf4, 0x9e, 0x2e, 0x12, 0x61, 0xd2, 0x35, 0xc3,
-	0xcf, 0x2a, 0x23, 0x52, 0xe4, 0x9c, 0xc8, 0x39, 0xbb, 0xd1, 0x2f, 0x8b, 0xfa, 0x74, 0x66, 0xb3,
-	0xe4, 0x9a, 0xb5, 0xdc, 0x09, 0xee, 0xbe, 0x03, 0x00, 0x00, 0xff, 0xff, 0xf6, 0x86, 0xcf, 0x0f,
-	0xd9, 0x01, 0x00, 0x00,
+	// 468 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x93, 0xc1, 0x6e, 0xd3, 0x40,
+	0x14, 0x45, 0x3d, 0x8d, 0x13, 0xe8, 0x34, 0x20, 0x33, 0x54, 0xc5, 0x04, 0xc9, 0x44, 0x41, 0x88,
+	0x00, 0xc2, 0x56, 0xcb, 0x0e, 0x89, 0x45, 0x12, 0x2a, 0x39, 0x52, 0xeb, 0x54, 0xe3, 0x8a, 0x45,
+	0x36, 0x95, 0xdd, 0xbc, 0xc4, 0x56, 0x6c, 0x4f, 0xe4, 0x99, 0x44, 0xf4, 0x0f, 0x10, 0xab, 0xfe,
+	0x00, 0xab, 0xfe, 0x0c, 0x3b, 0xba, 0x64, 0x85, 0x50, 0xf2, 0x23, 0x68, 0xc6, 0x89, 0x1a, 0x41,
+	0xa3, 0xee, 0xde, 0xb5, 0xee, 0xb9, 0xf7, 0xe9, 0xc9, 0x83, 0x5f, 0x26, 0xc3, 0xd0, 0x09, 0x03,
+	0x0e, 0x0e, 0x17, 0x2c, 0x07, 0x67, 0xb6, 0x1f, 0x82, 0x08, 0xf6, 0x1d, 0x9e, 0x05, 0x13, 0x1e,
+	0x31, 0x61, 0x4f, 0x72, 0x26, 0x18, 0xd9, 0x4b, 0x86, 0xa1, 0x2d, 0x6d, 0xb6, 0xb2, 0xd9, 0x4b,
+	0x5b, 0x6d, 0x77, 0xc4, 0x46, 0x4c, 0x59, 0x1c, 0x39, 0x15, 0xee, 0xc6, 0x15, 0xc2, 0x55, 0x7f,
+	0x19, 0xd0, 0x15, 0x90, 0x92, 0x16, 0x2e, 0x2b, 0xce, 0x44, 0x75, 0xd4, 0xdc, 0x39, 0x78, 0x6d,
+	0xdf, 0x1e, 0x67, 0xaf, 0x20, 0x5f, 0x7e, 0x95, 0xa4, 0xab, 0xd1, 0x82, 0x24, 0x2e, 0xd6, 0xe3,
+	0x60, 0x96, 0x98, 0x5b, 0x2a, 0xa1, 0x79, 0x57, 0x42, 0xb7, 0xf5, 0xf9, 0x48, 0x06, 0xb4, 0xef,
+	0xcf, 0x7f, 0x3f, 0xd7, 0xa5, 0x72, 0x35, 0xaa, 0x12, 0xda, 0x15, 0xac, 0xc7, 0x02, 0xd2, 0xc6,
+	0x2b, 0xfc, 0xe8, 0xbf, 0x3e, 0x42, 0xb0, 0x9e, 0x05, 0x69, 0xb1, 0xe8, 0x36, 0x55, 0x73, 0x23,
+	0xc1, 0xc6, 0xbf, 0xb1, 0xc4, 0xc0, 0xa5, 0x31, 0x5c, 0x28, 0x5b, 0x95, 0xca, 0x91, 0xec, 0xe2,
+	0xf2, 0x2c, 0x48, 0xa6, 0xa0, 0x36, 0xac, 0xd2, 0x42, 0x10, 0x13, 0xdf, 0x9b, 0x41, 0xce, 0x63,
+	0x96, 0x99, 0xa5, 0x3a, 0x6a, 0x96, 0xe8, 0x4a, 0x92, 0x3d, 0x5c, 0x89, 0x20, 0x1e, 0x45, 0xc2,
+	0xd4, 0xeb, 0xa8, 0x59, 0xa6, 0x4b, 0xd5, 0xb8, 0x44, 0xf8, 0xf1, 0xaa, 0xae, 0x13, 0x4d, 0xb3,
+	0xb1, 0x0b, 0xc1, 0x00, 0x72, 0x99, 0x7f, 0x73, 0xc3, 0xed, 0xd5, 0x59, 0x8e, 0xf1, 0xce, 0x39,
+	0x4b, 0x27, 0x39, 0x70, 0xd5, 0x21, 0xbb, 0x1f, 0x1e, 0xbc, 0xbd, 0xeb, 0x3a, 0x9d, 0x1b, 0x84,
+	0xae, 0xf3, 0xb2, 0x24, 0xce, 0x06, 0xf0, 0x45, 0x2d, 0xfb, 0x80, 0x16, 0xe2, 0xcd, 0xcf, 0xf5,
+	0x95, 0xd6, 0xdc, 0x1f, 0xf0, 0x53, 0xdf, 0x6b, 0x9d, 0xf8, 0x6e, 0xef, 0xf4, 0xac, 0xd3, 0x3b,
+	0x3e, 0xa1, 0x87, 0xbe, 0xdf, 0xed, 0x79, 0x67, 0x5e, 0xcf, 0x3b, 0x34, 0xb4, 0xda, 0xb3, 0x6f,
+	0xdf, 0xeb, 0x4f, 0x6e, 0xe1, 0x3c, 0x96, 0xc1, 0x46, 0xb6, 0x7f, 0xd4, 0x6d, 0x1b, 0x68, 0x23,
+	0xdb, 0x4f, 0xe2, 0x70, 0x33, 0xeb, 0x9f, 0x7e, 0x32, 0xb6, 0x36, 0xb3, 0x5c, 0x0c, 0x6a, 0xfa,
+	0xd7, 0x2b, 0x4b, 0x6b, 0x7f, 0xfc, 0x31, 0xb7, 0xd0, 0xf5, 0xdc, 0x42, 0x7f, 0xe6, 0x16, 0xba,
+	0x5c, 0x58, 0xda, 0xf5, 0xc2, 0xd2, 0x7e, 0x2d, 0x2c, 0xad, 0xff, 0x62, 0x14, 0x8b, 0x68, 0x1a,
+	0xda, 0xe7, 0x2c, 0x75, 0x92, 0x38, 0x03, 0x27, 0x19, 0x86, 0xef, 0xf8, 0x60, 0xbc, 0x7c, 0x1f,
+	0xe2, 0x62, 0x02, 0x3c, 0xac, 0xa8, 0xff, 0xfc, 0xfd, 0xdf, 0x01, 0x00, 0x69, 0x6d, 0x4b, 0xd3,
+	0x3e, 0x03, 0x00, 0x00,
 }
 
 func (m *SnapshotItem) Marshal() (dAtA []byte, err error) {
@@ -411,6 +519,46 @@ func (m *SnapshotIAVLItem) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SnapshotChunkHeader) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SnapshotChunkHeader) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SnapshotChunkHeader) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Index != 0 {
+		i = encodeVarintSnapshot(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Compression != 0 {
+		i = encodeVarintSnapshot(dAtA, i, uint64(m.Compression))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Store) > 0 {
+		i -= len(m.Store)
+		copy(dAtA[i:], m.Store)
+		i = encodeVarintSnapshot(dAtA, i, uint64(len(m.Store)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintSnapshot(dAtA []byte, offset int, v uint64) int {
 	offset -= sovSnapshot(v)
 	base := offset
@@ -494,6 +642,25 @@ func (m *SnapshotIAVLItem) Size() (n int) {
 	return n
 }
 
+func (m *SnapshotChunkHeader) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Store)
+	if l > 0 {
+		n += 1 + l + sovSnapshot(uint64(l))
+	}
+	if m.Compression != 0 {
+		n += 1 + sovSnapshot(uint64(m.Compression))
+	}
+	if m.Index != 0 {
+		n += 1 + sovSnapshot(uint64(m.Index))
+	}
+	return n
+}
+
 func sovSnapshot(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -858,6 +1025,126 @@ func (m *SnapshotIAVLItem) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SnapshotChunkHeader) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSnapshot
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SnapshotChunkHeader: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SnapshotChunkHeader: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Store", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Store = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Compression", wireType)
+			}
+			m.Compression = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Compression |= SnapshotCompression(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSnapshot(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipSnapshot(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
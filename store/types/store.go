@@ -190,6 +190,19 @@ type CommitMultiStore interface {
 	// SetIAVLCacheManager sets the CacheManager that is holding nodedb cache of IAVL tree
 	// If a cacheManager is not set, then IAVL tree does not use cache
 	SetIAVLCacheManager(cacheManager CacheManager)
+
+	// SetSnapshotCompression sets the compression of a store in snapshots of format 2.
+	SetSnapshotCompression(storeName string, compression SnapshotCompression)
+
+	// AddListeners adds WriteListeners for the KVStore belonging to the provided StoreKey.
+	AddListeners(key StoreKey, listeners []WriteListener)
+
+	// ListeningEnabled returns if listening is enabled for the KVStore belonging to the provided StoreKey.
+	ListeningEnabled(key StoreKey) bool
+
+	// CacheMultiStoreWithListeners branches the multi-store like CacheMultiStore,
+	// passing the writes to the branch to the listeners of the stores.
+	CacheMultiStoreWithListeners() CacheMultiStore
 }
 
 //---------subsp-------------------------------
@@ -0,0 +1,138 @@
+package file
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	abci "github.com/line/ostracon/abci/types"
+
+	"github.com/line/lfb-sdk/baseapp"
+	"github.com/line/lfb-sdk/codec"
+	storetypes "github.com/line/lfb-sdk/store/types"
+	"github.com/line/lfb-sdk/streaming/types"
+	sdk "github.com/line/lfb-sdk/types"
+)
+
+var _ baseapp.StreamingService = (*StreamingService)(nil)
+
+// StreamingService writes the events of every block into its own file in a directory. A block file
+// is a sequence of StreamEvent messages, each prefixed with its uvarint encoded length: the
+// BeginBlock event, the DeliverTx events and the EndBlock event.
+//
+// The file of the block at height h is named {prefix}block-{h}. It is written under a temporary
+// name and renamed once the EndBlock event is written, so a block file is complete as soon as it
+// appears in the directory.
+type StreamingService struct {
+	writeDir   string
+	filePrefix string
+	listeners  map[sdk.StoreKey][]sdk.WriteListener
+	memory     *storetypes.MemoryListener
+	marshaller codec.BinaryMarshaler
+
+	file   *os.File // the file of the current block, nil outside of a block
+	height int64
+}
+
+// NewStreamingService creates a StreamingService writing the state changes of the stores with the
+// given keys into writeDir.
+func NewStreamingService(
+	writeDir, filePrefix string, storeKeys []sdk.StoreKey, marshaller codec.BinaryMarshaler,
+) (*StreamingService, error) {
+	if err := os.MkdirAll(writeDir, 0755); err != nil {
+		return nil, err
+	}
+
+	memory := storetypes.NewMemoryListener()
+	listeners := make(map[sdk.StoreKey][]sdk.WriteListener, len(storeKeys))
+	for _, key := range storeKeys {
+		listeners[key] = []sdk.WriteListener{memory}
+	}
+
+	return &StreamingService{
+		writeDir:   writeDir,
+		filePrefix: filePrefix,
+		listeners:  listeners,
+		memory:     memory,
+		marshaller: marshaller,
+	}, nil
+}
+
+// Listeners implements baseapp.StreamingService.
+func (fss *StreamingService) Listeners() map[sdk.StoreKey][]sdk.WriteListener {
+	return fss.listeners
+}
+
+// ListenBeginBlock implements baseapp.ABCIListener. It starts the file of the block.
+func (fss *StreamingService) ListenBeginBlock(_ sdk.Context, req abci.RequestBeginBlock, res abci.ResponseBeginBlock) error {
+	// a block that was not ended, e.g. because the node was stopped, is dropped
+	fss.discard()
+
+	fss.height = req.Header.Height
+	file, err := os.OpenFile(fss.tempFilePath(), os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+	fss.file = file
+
+	return fss.write(types.NewBeginBlockEvent(req, res, fss.memory.PopStateCache()))
+}
+
+// ListenDeliverTx implements baseapp.ABCIListener.
+func (fss *StreamingService) ListenDeliverTx(ctx sdk.Context, req abci.RequestDeliverTx, res abci.ResponseDeliverTx) error {
+	return fss.write(types.NewDeliverTxEvent(ctx.BlockHeight(), req, res, fss.memory.PopStateCache()))
+}
+
+// ListenEndBlock implements baseapp.ABCIListener. It completes the file of the block.
+func (fss *StreamingService) ListenEndBlock(_ sdk.Context, req abci.RequestEndBlock, res abci.ResponseEndBlock) error {
+	if err := fss.write(types.NewEndBlockEvent(req, res, fss.memory.PopStateCache())); err != nil {
+		return err
+	}
+
+	file := fss.file
+	fss.file = nil
+	if err := file.Sync(); err != nil {
+		file.Close()
+		return err
+	}
+	if err := file.Close(); err != nil {
+		return err
+	}
+	return os.Rename(fss.tempFilePath(), fss.FilePath(fss.height))
+}
+
+// Close implements io.Closer. The file of a block that was not ended is removed.
+func (fss *StreamingService) Close() error {
+	fss.discard()
+	return nil
+}
+
+func (fss *StreamingService) write(event *types.StreamEvent) error {
+	if fss.file == nil {
+		return fmt.Errorf("no block file open for the event at height %d", event.BlockHeight)
+	}
+
+	bz, err := fss.marshaller.MarshalBinaryLengthPrefixed(event)
+	if err != nil {
+		return err
+	}
+	_, err = fss.file.Write(bz)
+	return err
+}
+
+func (fss *StreamingService) discard() {
+	if fss.file != nil {
+		fss.file.Close()
+		os.Remove(fss.tempFilePath())
+		fss.file = nil
+	}
+}
+
+// FilePath returns the path of the file of the block at the given height.
+func (fss *StreamingService) FilePath(height int64) string {
+	return filepath.Join(fss.writeDir, fmt.Sprintf("%sblock-%d", fss.filePrefix, height))
+}
+
+func (fss *StreamingService) tempFilePath() string {
+	return fss.FilePath(fss.height) + ".tmp"
+}
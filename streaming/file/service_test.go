@@ -0,0 +1,118 @@
+package file_test
+
+import (
+	"encoding/binary"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	abci "github.com/line/ostracon/abci/types"
+	ostproto "github.com/line/ostracon/proto/ostracon/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/line/lfb-sdk/codec"
+	codectypes "github.com/line/lfb-sdk/codec/types"
+	storetypes "github.com/line/lfb-sdk/store/types"
+	"github.com/line/lfb-sdk/streaming/file"
+	"github.com/line/lfb-sdk/streaming/types"
+	sdk "github.com/line/lfb-sdk/types"
+)
+
+var (
+	mockStoreKey1 = sdk.NewKVStoreKey("mockStore1")
+	mockStoreKey2 = sdk.NewKVStoreKey("mockStore2")
+)
+
+// readEvents reads the length-prefixed StreamEvents of a block file.
+func readEvents(t *testing.T, cdc codec.BinaryMarshaler, path string) []*types.StreamEvent {
+	bz, err := ioutil.ReadFile(path)
+	require.NoError(t, err)
+
+	var events []*types.StreamEvent
+	for len(bz) > 0 {
+		size, n := binary.Uvarint(bz)
+		require.Greater(t, n, 0)
+		event := &types.StreamEvent{}
+		require.NoError(t, cdc.UnmarshalBinaryBare(bz[n:n+int(size)], event))
+		events = append(events, event)
+		bz = bz[n+int(size):]
+	}
+	return events
+}
+
+func TestFileStreamingService(t *testing.T) {
+	dir := t.TempDir()
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+
+	fss, err := file.NewStreamingService(dir, "test-", []sdk.StoreKey{mockStoreKey1}, cdc)
+	require.NoError(t, err)
+	listeners := fss.Listeners()
+	require.Len(t, listeners, 1)
+	listener := listeners[mockStoreKey1][0]
+
+	ctx := sdk.Context{}.WithBlockHeight(1)
+	beginReq := abci.RequestBeginBlock{Header: ostproto.Header{Height: 1}}
+	beginRes := abci.ResponseBeginBlock{Events: []abci.Event{{Type: "begin"}}}
+	deliverReq := abci.RequestDeliverTx{Tx: []byte("tx")}
+	deliverRes := abci.ResponseDeliverTx{Code: 1, Log: "failed"}
+	endReq := abci.RequestEndBlock{Height: 1}
+	endRes := abci.ResponseEndBlock{Events: []abci.Event{{Type: "end"}}}
+
+	listener.OnWrite(mockStoreKey1, []byte("k1"), []byte("v1"), false)
+	require.NoError(t, fss.ListenBeginBlock(ctx, beginReq, beginRes))
+	listener.OnWrite(mockStoreKey1, []byte("k1"), nil, true)
+	require.NoError(t, fss.ListenDeliverTx(ctx, deliverReq, deliverRes))
+
+	// the file is not visible before the block is ended
+	_, err = os.Stat(fss.FilePath(1))
+	require.True(t, os.IsNotExist(err))
+
+	require.NoError(t, fss.ListenEndBlock(ctx, endReq, endRes))
+	require.Equal(t, dir+"/test-block-1", fss.FilePath(1))
+
+	events := readEvents(t, cdc, fss.FilePath(1))
+	require.Equal(t, []*types.StreamEvent{
+		types.NewBeginBlockEvent(beginReq, beginRes, []*storetypes.StoreKVPair{
+			{StoreKey: mockStoreKey1.Name(), Key: []byte("k1"), Value: []byte("v1")},
+		}),
+		types.NewDeliverTxEvent(1, deliverReq, deliverRes, []*storetypes.StoreKVPair{
+			{StoreKey: mockStoreKey1.Name(), Delete: true, Key: []byte("k1")},
+		}),
+		types.NewEndBlockEvent(endReq, endRes, nil),
+	}, events)
+
+	// events outside of a block fail
+	require.Error(t, fss.ListenDeliverTx(ctx, deliverReq, deliverRes))
+
+	// an unfinished block is discarded on close
+	require.NoError(t, fss.ListenBeginBlock(ctx.WithBlockHeight(2), abci.RequestBeginBlock{Header: ostproto.Header{Height: 2}}, beginRes))
+	require.NoError(t, fss.Close())
+	files, err := ioutil.ReadDir(dir)
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+	require.Equal(t, "test-block-1", files[0].Name())
+}
+
+func TestFileStreamingServiceRestartedBlock(t *testing.T) {
+	dir := t.TempDir()
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+
+	fss, err := file.NewStreamingService(dir, "", []sdk.StoreKey{mockStoreKey1, mockStoreKey2}, cdc)
+	require.NoError(t, err)
+	require.Len(t, fss.Listeners(), 2)
+
+	ctx := sdk.Context{}.WithBlockHeight(5)
+	beginReq := abci.RequestBeginBlock{Header: ostproto.Header{Height: 5}}
+	endReq := abci.RequestEndBlock{Height: 5}
+
+	// a block begun again replaces the unfinished one
+	require.NoError(t, fss.ListenBeginBlock(ctx, beginReq, abci.ResponseBeginBlock{}))
+	require.NoError(t, fss.ListenDeliverTx(ctx, abci.RequestDeliverTx{Tx: []byte("tx")}, abci.ResponseDeliverTx{}))
+	require.NoError(t, fss.ListenBeginBlock(ctx, beginReq, abci.ResponseBeginBlock{}))
+	require.NoError(t, fss.ListenEndBlock(ctx, endReq, abci.ResponseEndBlock{}))
+
+	events := readEvents(t, cdc, fss.FilePath(5))
+	require.Len(t, events, 2)
+	require.NotNil(t, events[0].GetBeginBlock())
+	require.NotNil(t, events[1].GetEndBlock())
+}
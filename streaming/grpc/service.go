@@ -0,0 +1,159 @@
+package grpc
+
+import (
+	"net"
+	"sync"
+
+	abci "github.com/line/ostracon/abci/types"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/line/lfb-sdk/baseapp"
+	storetypes "github.com/line/lfb-sdk/store/types"
+	"github.com/line/lfb-sdk/streaming/types"
+	sdk "github.com/line/lfb-sdk/types"
+)
+
+// DefaultBufferSize is the default number of events buffered for a subscriber.
+const DefaultBufferSize = 1000
+
+var (
+	_ baseapp.StreamingService     = (*StreamingService)(nil)
+	_ types.StreamingServiceServer = (*StreamingService)(nil)
+)
+
+// StreamingService serves the events of the blocks to the subscribers of a gRPC StreamingService.
+//
+// The events are published to the subscribers without blocking the state machine: every subscriber
+// has a buffer of events, and a subscriber whose buffer is full is dropped with a ResourceExhausted
+// error. It can subscribe again, but will have missed the events in between.
+type StreamingService struct {
+	listeners  map[sdk.StoreKey][]sdk.WriteListener
+	memory     *storetypes.MemoryListener
+	bufferSize int
+
+	server   *grpc.Server
+	listener net.Listener
+
+	mtx         sync.Mutex
+	subscribers map[*subscriber]struct{}
+}
+
+type subscriber struct {
+	events  chan *types.StreamEvent
+	dropped chan struct{}
+}
+
+// NewStreamingService creates a StreamingService streaming the state changes of the stores with the
+// given keys, and starts its gRPC server on address.
+func NewStreamingService(address string, bufferSize int, storeKeys []sdk.StoreKey) (*StreamingService, error) {
+	listener, err := net.Listen("tcp", address)
+	if err != nil {
+		return nil, err
+	}
+
+	if bufferSize <= 0 {
+		bufferSize = DefaultBufferSize
+	}
+
+	memory := storetypes.NewMemoryListener()
+	listeners := make(map[sdk.StoreKey][]sdk.WriteListener, len(storeKeys))
+	for _, key := range storeKeys {
+		listeners[key] = []sdk.WriteListener{memory}
+	}
+
+	gss := &StreamingService{
+		listeners:   listeners,
+		memory:      memory,
+		bufferSize:  bufferSize,
+		server:      grpc.NewServer(),
+		listener:    listener,
+		subscribers: make(map[*subscriber]struct{}),
+	}
+	types.RegisterStreamingServiceServer(gss.server, gss)
+
+	go gss.server.Serve(listener) //nolint:errcheck
+
+	return gss, nil
+}
+
+// Addr returns the address the gRPC server listens on.
+func (gss *StreamingService) Addr() net.Addr {
+	return gss.listener.Addr()
+}
+
+// Listeners implements baseapp.StreamingService.
+func (gss *StreamingService) Listeners() map[sdk.StoreKey][]sdk.WriteListener {
+	return gss.listeners
+}
+
+// ListenBeginBlock implements baseapp.ABCIListener.
+func (gss *StreamingService) ListenBeginBlock(_ sdk.Context, req abci.RequestBeginBlock, res abci.ResponseBeginBlock) error {
+	gss.publish(types.NewBeginBlockEvent(req, res, gss.memory.PopStateCache()))
+	return nil
+}
+
+// ListenDeliverTx implements baseapp.ABCIListener.
+func (gss *StreamingService) ListenDeliverTx(ctx sdk.Context, req abci.RequestDeliverTx, res abci.ResponseDeliverTx) error {
+	gss.publish(types.NewDeliverTxEvent(ctx.BlockHeight(), req, res, gss.memory.PopStateCache()))
+	return nil
+}
+
+// ListenEndBlock implements baseapp.ABCIListener.
+func (gss *StreamingService) ListenEndBlock(_ sdk.Context, req abci.RequestEndBlock, res abci.ResponseEndBlock) error {
+	gss.publish(types.NewEndBlockEvent(req, res, gss.memory.PopStateCache()))
+	return nil
+}
+
+// Close implements io.Closer. It stops the gRPC server, closing the streams of the subscribers.
+func (gss *StreamingService) Close() error {
+	gss.server.Stop()
+	return nil
+}
+
+// Subscribe implements types.StreamingServiceServer.
+func (gss *StreamingService) Subscribe(_ *types.SubscribeRequest, stream types.StreamingService_SubscribeServer) error {
+	sub := &subscriber{
+		events:  make(chan *types.StreamEvent, gss.bufferSize),
+		dropped: make(chan struct{}),
+	}
+
+	gss.mtx.Lock()
+	gss.subscribers[sub] = struct{}{}
+	gss.mtx.Unlock()
+
+	defer func() {
+		gss.mtx.Lock()
+		delete(gss.subscribers, sub)
+		gss.mtx.Unlock()
+	}()
+
+	for {
+		select {
+		case event := <-sub.events:
+			if err := stream.Send(event); err != nil {
+				return err
+			}
+		case <-sub.dropped:
+			return status.Errorf(codes.ResourceExhausted, "subscriber fell more than %d events behind", gss.bufferSize)
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		}
+	}
+}
+
+// publish sends the event to all subscribers, dropping the ones whose buffer is full.
+func (gss *StreamingService) publish(event *types.StreamEvent) {
+	gss.mtx.Lock()
+	defer gss.mtx.Unlock()
+
+	for sub := range gss.subscribers {
+		select {
+		case sub.events <- event:
+		default:
+			close(sub.dropped)
+			delete(gss.subscribers, sub)
+		}
+	}
+}
@@ -0,0 +1,106 @@
+package grpc_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	abci "github.com/line/ostracon/abci/types"
+	ostproto "github.com/line/ostracon/proto/ostracon/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	storetypes "github.com/line/lfb-sdk/store/types"
+	streaminggrpc "github.com/line/lfb-sdk/streaming/grpc"
+	"github.com/line/lfb-sdk/streaming/types"
+	sdk "github.com/line/lfb-sdk/types"
+)
+
+var mockStoreKey = sdk.NewKVStoreKey("mockStore")
+
+func subscribe(t *testing.T, gss *streaminggrpc.StreamingService) types.StreamingService_SubscribeClient {
+	conn, err := grpc.Dial(gss.Addr().String(), grpc.WithInsecure())
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+
+	stream, err := types.NewStreamingServiceClient(conn).Subscribe(context.Background(), &types.SubscribeRequest{})
+	require.NoError(t, err)
+	return stream
+}
+
+// waitSubscribed publishes blocks until the subscriber receives one, so that it is known to be registered.
+func waitSubscribed(t *testing.T, gss *streaminggrpc.StreamingService, stream types.StreamingService_SubscribeClient) {
+	received := make(chan struct{})
+	go func() {
+		stream.Recv() //nolint:errcheck
+		close(received)
+	}()
+	for {
+		require.NoError(t, gss.ListenEndBlock(sdk.Context{}, abci.RequestEndBlock{}, abci.ResponseEndBlock{}))
+		select {
+		case <-received:
+			return
+		case <-time.After(10 * time.Millisecond):
+		}
+	}
+}
+
+func TestGRPCStreamingService(t *testing.T) {
+	gss, err := streaminggrpc.NewStreamingService("127.0.0.1:0", 0, []sdk.StoreKey{mockStoreKey})
+	require.NoError(t, err)
+	defer gss.Close()
+
+	stream := subscribe(t, gss)
+	waitSubscribed(t, gss, stream)
+
+	listener := gss.Listeners()[mockStoreKey][0]
+	ctx := sdk.Context{}.WithBlockHeight(7)
+	beginReq := abci.RequestBeginBlock{Header: ostproto.Header{Height: 7}}
+	deliverReq := abci.RequestDeliverTx{Tx: []byte("tx")}
+	deliverRes := abci.ResponseDeliverTx{Log: "ok"}
+
+	require.NoError(t, gss.ListenBeginBlock(ctx, beginReq, abci.ResponseBeginBlock{}))
+	listener.OnWrite(mockStoreKey, []byte("k"), []byte("v"), false)
+	require.NoError(t, gss.ListenDeliverTx(ctx, deliverReq, deliverRes))
+
+	var event *types.StreamEvent
+	for {
+		// skip the events published while waiting for the subscription
+		event, err = stream.Recv()
+		require.NoError(t, err)
+		if event.GetEndBlock() == nil {
+			break
+		}
+	}
+	require.Equal(t, types.NewBeginBlockEvent(beginReq, abci.ResponseBeginBlock{}, nil), event)
+
+	event, err = stream.Recv()
+	require.NoError(t, err)
+	require.Equal(t, types.NewDeliverTxEvent(7, deliverReq, deliverRes, []*storetypes.StoreKVPair{
+		{StoreKey: mockStoreKey.Name(), Key: []byte("k"), Value: []byte("v")},
+	}), event)
+}
+
+func TestGRPCStreamingServiceDropsSlowSubscriber(t *testing.T) {
+	gss, err := streaminggrpc.NewStreamingService("127.0.0.1:0", 1, []sdk.StoreKey{mockStoreKey})
+	require.NoError(t, err)
+	defer gss.Close()
+
+	stream := subscribe(t, gss)
+	waitSubscribed(t, gss, stream)
+
+	// publishing never blocks, the subscriber falling behind is dropped instead
+	for i := 0; i < 100; i++ {
+		require.NoError(t, gss.ListenEndBlock(sdk.Context{}, abci.RequestEndBlock{Height: int64(i)}, abci.ResponseEndBlock{}))
+	}
+
+	for {
+		_, err = stream.Recv()
+		if err != nil {
+			break
+		}
+	}
+	require.Equal(t, codes.ResourceExhausted, status.Code(err))
+}
@@ -0,0 +1,124 @@
+package streaming
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cast"
+
+	"github.com/line/lfb-sdk/baseapp"
+	"github.com/line/lfb-sdk/client/flags"
+	"github.com/line/lfb-sdk/codec"
+	servertypes "github.com/line/lfb-sdk/server/types"
+	"github.com/line/lfb-sdk/streaming/file"
+	"github.com/line/lfb-sdk/streaming/grpc"
+	sdk "github.com/line/lfb-sdk/types"
+)
+
+// The app.toml options of the streaming services.
+const (
+	OptStreamers      = "streaming.streamers"
+	OptKeys           = "streaming.keys"
+	OptFileWriteDir   = "streaming.file.write-dir"
+	OptFilePrefix     = "streaming.file.prefix"
+	OptGRPCAddress    = "streaming.grpc.address"
+	OptGRPCBufferSize = "streaming.grpc.buffer-size"
+)
+
+// The names of the built-in streaming services.
+const (
+	StreamerFile = "file"
+	StreamerGRPC = "grpc"
+)
+
+// LoadStreamingServices creates the streaming services enabled in the app options and sets them into
+// the BaseApp. keys are the KVStoreKeys of the app by store name, from which the streamed stores are
+// selected. The services are returned so that the caller can close them on shutdown.
+func LoadStreamingServices(
+	bApp *baseapp.BaseApp, appOpts servertypes.AppOptions, marshaller codec.BinaryMarshaler, keys map[string]*sdk.KVStoreKey,
+) ([]baseapp.StreamingService, error) {
+	streamers := cast.ToStringSlice(appOpts.Get(OptStreamers))
+	if len(streamers) == 0 {
+		return nil, nil
+	}
+
+	storeKeys, err := selectStoreKeys(cast.ToStringSlice(appOpts.Get(OptKeys)), keys)
+	if err != nil {
+		return nil, err
+	}
+
+	services := make([]baseapp.StreamingService, 0, len(streamers))
+	closeAll := func() {
+		for _, s := range services {
+			s.Close()
+		}
+	}
+
+	for _, streamer := range streamers {
+		var (
+			service baseapp.StreamingService
+			err     error
+		)
+
+		switch streamer {
+		case StreamerFile:
+			writeDir := cast.ToString(appOpts.Get(OptFileWriteDir))
+			if writeDir == "" {
+				closeAll()
+				return nil, fmt.Errorf("%s must be set for the %s streamer", OptFileWriteDir, StreamerFile)
+			}
+			if !filepath.IsAbs(writeDir) {
+				writeDir = filepath.Join(cast.ToString(appOpts.Get(flags.FlagHome)), writeDir)
+			}
+			service, err = file.NewStreamingService(writeDir, cast.ToString(appOpts.Get(OptFilePrefix)), storeKeys, marshaller)
+
+		case StreamerGRPC:
+			address := cast.ToString(appOpts.Get(OptGRPCAddress))
+			if address == "" {
+				closeAll()
+				return nil, fmt.Errorf("%s must be set for the %s streamer", OptGRPCAddress, StreamerGRPC)
+			}
+			service, err = grpc.NewStreamingService(address, cast.ToInt(appOpts.Get(OptGRPCBufferSize)), storeKeys)
+
+		default:
+			err = fmt.Errorf("unknown streamer %q", streamer)
+		}
+
+		if err != nil {
+			closeAll()
+			return nil, err
+		}
+
+		bApp.SetStreamingService(service)
+		services = append(services, service)
+	}
+
+	return services, nil
+}
+
+// selectStoreKeys returns the store keys with the given names, or all of them if a name is "*".
+func selectStoreKeys(names []string, keys map[string]*sdk.KVStoreKey) ([]sdk.StoreKey, error) {
+	for _, name := range names {
+		if name == "*" {
+			names = make([]string, 0, len(keys))
+			for name := range keys {
+				names = append(names, name)
+			}
+			sort.Strings(names)
+			break
+		}
+	}
+
+	storeKeys := make([]sdk.StoreKey, 0, len(names))
+	for _, name := range names {
+		key, ok := keys[strings.TrimSpace(name)]
+		if !ok {
+			return nil, fmt.Errorf("unknown store %q to stream", name)
+		}
+		storeKeys = append(storeKeys, key)
+	}
+
+	return storeKeys, nil
+}
@@ -0,0 +1,108 @@
+package streaming_test
+
+import (
+	"testing"
+
+	"github.com/line/ostracon/libs/log"
+	"github.com/line/tm-db/v2/memdb"
+	"github.com/stretchr/testify/require"
+
+	"github.com/line/lfb-sdk/baseapp"
+	"github.com/line/lfb-sdk/client/flags"
+	"github.com/line/lfb-sdk/codec"
+	codectypes "github.com/line/lfb-sdk/codec/types"
+	"github.com/line/lfb-sdk/streaming"
+	"github.com/line/lfb-sdk/streaming/file"
+	sdk "github.com/line/lfb-sdk/types"
+)
+
+type appOptions map[string]interface{}
+
+func (o appOptions) Get(key string) interface{} { return o[key] }
+
+func TestLoadStreamingServices(t *testing.T) {
+	home := t.TempDir()
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	keys := sdk.NewKVStoreKeys("store1", "store2")
+
+	testCases := map[string]struct {
+		opts      appOptions
+		expErr    bool
+		expCount  int
+		expKeys   []sdk.StoreKey
+		expFileIn string
+	}{
+		"disabled": {
+			opts: appOptions{},
+		},
+		"file with all keys": {
+			opts: appOptions{
+				flags.FlagHome:            home,
+				streaming.OptStreamers:    []string{"file"},
+				streaming.OptKeys:         []string{"*"},
+				streaming.OptFileWriteDir: "data/streaming",
+				streaming.OptFilePrefix:   "pre-",
+			},
+			expCount:  1,
+			expKeys:   []sdk.StoreKey{keys["store1"], keys["store2"]},
+			expFileIn: home + "/data/streaming/pre-block-1",
+		},
+		"file with selected keys": {
+			opts: appOptions{
+				streaming.OptStreamers:    []string{"file"},
+				streaming.OptKeys:         []string{"store2"},
+				streaming.OptFileWriteDir: home + "/abs",
+			},
+			expCount:  1,
+			expKeys:   []sdk.StoreKey{keys["store2"]},
+			expFileIn: home + "/abs/block-1",
+		},
+		"unknown store": {
+			opts: appOptions{
+				streaming.OptStreamers:    []string{"file"},
+				streaming.OptKeys:         []string{"store3"},
+				streaming.OptFileWriteDir: home,
+			},
+			expErr: true,
+		},
+		"unknown streamer": {
+			opts: appOptions{
+				streaming.OptStreamers: []string{"kafka"},
+			},
+			expErr: true,
+		},
+		"missing grpc address": {
+			opts: appOptions{
+				streaming.OptStreamers: []string{"grpc"},
+			},
+			expErr: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			bApp := baseapp.NewBaseApp(name, log.NewNopLogger(), memdb.NewDB(), nil)
+
+			services, err := streaming.LoadStreamingServices(bApp, tc.opts, cdc, keys)
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, services, tc.expCount)
+
+			for _, service := range services {
+				listeners := service.Listeners()
+				require.Len(t, listeners, len(tc.expKeys))
+				for _, key := range tc.expKeys {
+					require.Contains(t, listeners, key)
+				}
+				if fss, ok := service.(*file.StreamingService); ok {
+					require.Equal(t, tc.expFileIn, fss.FilePath(1))
+				}
+				require.NoError(t, service.Close())
+			}
+		})
+	}
+}
@@ -0,0 +1,49 @@
+package types
+
+import (
+	abci "github.com/line/ostracon/abci/types"
+
+	storetypes "github.com/line/lfb-sdk/store/types"
+)
+
+// NewBeginBlockEvent returns the StreamEvent of a BeginBlock and its state changes.
+func NewBeginBlockEvent(
+	req abci.RequestBeginBlock, res abci.ResponseBeginBlock, changes []*storetypes.StoreKVPair,
+) *StreamEvent {
+	return &StreamEvent{
+		BlockHeight: req.Header.Height,
+		Event: &StreamEvent_BeginBlock{BeginBlock: &BeginBlockEvent{
+			Request:      &req,
+			Response:     &res,
+			StateChanges: changes,
+		}},
+	}
+}
+
+// NewDeliverTxEvent returns the StreamEvent of a DeliverTx in the block at the given height and its state changes.
+func NewDeliverTxEvent(
+	height int64, req abci.RequestDeliverTx, res abci.ResponseDeliverTx, changes []*storetypes.StoreKVPair,
+) *StreamEvent {
+	return &StreamEvent{
+		BlockHeight: height,
+		Event: &StreamEvent_DeliverTx{DeliverTx: &DeliverTxEvent{
+			Request:      &req,
+			Response:     &res,
+			StateChanges: changes,
+		}},
+	}
+}
+
+// NewEndBlockEvent returns the StreamEvent of an EndBlock and its state changes.
+func NewEndBlockEvent(
+	req abci.RequestEndBlock, res abci.ResponseEndBlock, changes []*storetypes.StoreKVPair,
+) *StreamEvent {
+	return &StreamEvent{
+		BlockHeight: req.Height,
+		Event: &StreamEvent_EndBlock{EndBlock: &EndBlockEvent{
+			Request:      &req,
+			Response:     &res,
+			StateChanges: changes,
+		}},
+	}
+}
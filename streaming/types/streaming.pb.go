@@ -0,0 +1,1745 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: lfb/base/streaming/v1beta1/streaming.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	types1 "github.com/line/lfb-sdk/store/types"
+	types "github.com/line/ostracon/abci/types"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// SubscribeRequest is the request type for the StreamingService.Subscribe RPC method.
+type SubscribeRequest struct {
+}
+
+func (m *SubscribeRequest) Reset()         { *m = SubscribeRequest{} }
+func (m *SubscribeRequest) String() string { return proto.CompactTextString(m) }
+func (*SubscribeRequest) ProtoMessage()    {}
+func (*SubscribeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7c529ba6b12d159b, []int{0}
+}
+func (m *SubscribeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SubscribeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SubscribeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SubscribeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscribeRequest.Merge(m, src)
+}
+func (m *SubscribeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SubscribeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscribeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubscribeRequest proto.InternalMessageInfo
+
+// StreamEvent is an ABCI message of a block together with the state changes it caused.
+type StreamEvent struct {
+	BlockHeight int64 `protobuf:"varint,1,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// Types that are valid to be assigned to Event:
+	//	*StreamEvent_BeginBlock
+	//	*StreamEvent_DeliverTx
+	//	*StreamEvent_EndBlock
+	Event isStreamEvent_Event `protobuf_oneof:"event"`
+}
+
+func (m *StreamEvent) Reset()         { *m = StreamEvent{} }
+func (m *StreamEvent) String() string { return proto.CompactTextString(m) }
+func (*StreamEvent) ProtoMessage()    {}
+func (*StreamEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7c529ba6b12d159b, []int{1}
+}
+func (m *StreamEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StreamEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StreamEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StreamEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamEvent.Merge(m, src)
+}
+func (m *StreamEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *StreamEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StreamEvent proto.InternalMessageInfo
+
+type isStreamEvent_Event interface {
+	isStreamEvent_Event()
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+
+type StreamEvent_BeginBlock struct {
+	BeginBlock *BeginBlockEvent `protobuf:"bytes,2,opt,name=begin_block,json=beginBlock,proto3,oneof" json:"begin_block,omitempty"`
+}
+type StreamEvent_DeliverTx struct {
+	DeliverTx *DeliverTxEvent `protobuf:"bytes,3,opt,name=deliver_tx,json=deliverTx,proto3,oneof" json:"deliver_tx,omitempty"`
+}
+type StreamEvent_EndBlock struct {
+	EndBlock *EndBlockEvent `protobuf:"bytes,4,opt,name=end_block,json=endBlock,proto3,oneof" json:"end_block,omitempty"`
+}
+
+func (*StreamEvent_BeginBlock) isStreamEvent_Event() {}
+func (*StreamEvent_DeliverTx) isStreamEvent_Event()  {}
+func (*StreamEvent_EndBlock) isStreamEvent_Event()   {}
+
+func (m *StreamEvent) GetEvent() isStreamEvent_Event {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
+
+func (m *StreamEvent) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *StreamEvent) GetBeginBlock() *BeginBlockEvent {
+	if x, ok := m.GetEvent().(*StreamEvent_BeginBlock); ok {
+		return x.BeginBlock
+	}
+	return nil
+}
+
+func (m *StreamEvent) GetDeliverTx() *DeliverTxEvent {
+	if x, ok := m.GetEvent().(*StreamEvent_DeliverTx); ok {
+		return x.DeliverTx
+	}
+	return nil
+}
+
+func (m *StreamEvent) GetEndBlock() *EndBlockEvent {
+	if x, ok := m.GetEvent().(*StreamEvent_EndBlock); ok {
+		return x.EndBlock
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*StreamEvent) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*StreamEvent_BeginBlock)(nil),
+		(*StreamEvent_DeliverTx)(nil),
+		(*StreamEvent_EndBlock)(nil),
+	}
+}
+
+// BeginBlockEvent holds a BeginBlock request and response and the state changes of BeginBlock.
+// The state changes of InitChain are reported with the first BeginBlock.
+type BeginBlockEvent struct {
+	Request      *types.RequestBeginBlock  `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
+	Response     *types.ResponseBeginBlock `protobuf:"bytes,2,opt,name=response,proto3" json:"response,omitempty"`
+	StateChanges []*types1.StoreKVPair     `protobuf:"bytes,3,rep,name=state_changes,json=stateChanges,proto3" json:"state_changes,omitempty"`
+}
+
+func (m *BeginBlockEvent) Reset()         { *m = BeginBlockEvent{} }
+func (m *BeginBlockEvent) String() string { return proto.CompactTextString(m) }
+func (*BeginBlockEvent) ProtoMessage()    {}
+func (*BeginBlockEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7c529ba6b12d159b, []int{2}
+}
+func (m *BeginBlockEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BeginBlockEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BeginBlockEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BeginBlockEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BeginBlockEvent.Merge(m, src)
+}
+func (m *BeginBlockEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *BeginBlockEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_BeginBlockEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BeginBlockEvent proto.InternalMessageInfo
+
+func (m *BeginBlockEvent) GetRequest() *types.RequestBeginBlock {
+	if m != nil {
+		return m.Request
+	}
+	return nil
+}
+
+func (m *BeginBlockEvent) GetResponse() *types.ResponseBeginBlock {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (m *BeginBlockEvent) GetStateChanges() []*types1.StoreKVPair {
+	if m != nil {
+		return m.StateChanges
+	}
+	return nil
+}
+
+// DeliverTxEvent holds a DeliverTx request and response and the state changes of the tx.
+type DeliverTxEvent struct {
+	Request      *types.RequestDeliverTx  `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
+	Response     *types.ResponseDeliverTx `protobuf:"bytes,2,opt,name=response,proto3" json:"response,omitempty"`
+	StateChanges []*types1.StoreKVPair    `protobuf:"bytes,3,rep,name=state_changes,json=stateChanges,proto3" json:"state_changes,omitempty"`
+}
+
+func (m *DeliverTxEvent) Reset()         { *m = DeliverTxEvent{} }
+func (m *DeliverTxEvent) String() string { return proto.CompactTextString(m) }
+func (*DeliverTxEvent) ProtoMessage()    {}
+func (*DeliverTxEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7c529ba6b12d159b, []int{3}
+}
+func (m *DeliverTxEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeliverTxEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeliverTxEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DeliverTxEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeliverTxEvent.Merge(m, src)
+}
+func (m *DeliverTxEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeliverTxEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeliverTxEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeliverTxEvent proto.InternalMessageInfo
+
+func (m *DeliverTxEvent) GetRequest() *types.RequestDeliverTx {
+	if m != nil {
+		return m.Request
+	}
+	return nil
+}
+
+func (m *DeliverTxEvent) GetResponse() *types.ResponseDeliverTx {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (m *DeliverTxEvent) GetStateChanges() []*types1.StoreKVPair {
+	if m != nil {
+		return m.StateChanges
+	}
+	return nil
+}
+
+// EndBlockEvent holds an EndBlock request and response and the state changes of EndBlock.
+type EndBlockEvent struct {
+	Request      *types.RequestEndBlock  `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
+	Response     *types.ResponseEndBlock `protobuf:"bytes,2,opt,name=response,proto3" json:"response,omitempty"`
+	StateChanges []*types1.StoreKVPair   `protobuf:"bytes,3,rep,name=state_changes,json=stateChanges,proto3" json:"state_changes,omitempty"`
+}
+
+func (m *EndBlockEvent) Reset()         { *m = EndBlockEvent{} }
+func (m *EndBlockEvent) String() string { return proto.CompactTextString(m) }
+func (*EndBlockEvent) ProtoMessage()    {}
+func (*EndBlockEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7c529ba6b12d159b, []int{4}
+}
+func (m *EndBlockEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EndBlockEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EndBlockEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EndBlockEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EndBlockEvent.Merge(m, src)
+}
+func (m *EndBlockEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *EndBlockEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_EndBlockEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EndBlockEvent proto.InternalMessageInfo
+
+func (m *EndBlockEvent) GetRequest() *types.RequestEndBlock {
+	if m != nil {
+		return m.Request
+	}
+	return nil
+}
+
+func (m *EndBlockEvent) GetResponse() *types.ResponseEndBlock {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (m *EndBlockEvent) GetStateChanges() []*types1.StoreKVPair {
+	if m != nil {
+		return m.StateChanges
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*SubscribeRequest)(nil), "lfb.base.streaming.v1beta1.SubscribeRequest")
+	proto.RegisterType((*StreamEvent)(nil), "lfb.base.streaming.v1beta1.StreamEvent")
+	proto.RegisterType((*BeginBlockEvent)(nil), "lfb.base.streaming.v1beta1.BeginBlockEvent")
+	proto.RegisterType((*DeliverTxEvent)(nil), "lfb.base.streaming.v1beta1.DeliverTxEvent")
+	proto.RegisterType((*EndBlockEvent)(nil), "lfb.base.streaming.v1beta1.EndBlockEvent")
+}
+
+func init() {
+	proto.RegisterFile("lfb/base/streaming/v1beta1/streaming.proto", fileDescriptor_7c529ba6b12d159b)
+}
+
+var fileDescriptor_7c529ba6b12d159b = []byte{
+	// 521 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x94, 0x4d, 0x6f, 0xd3, 0x30,
+	0x18, 0xc7, 0xeb, 0x15, 0xd8, 0xea, 0x6c, 0x30, 0xf9, 0x54, 0x7a, 0xc8, 0xba, 0x22, 0xb1, 0x32,
+	0x20, 0x61, 0xe5, 0xc2, 0xeb, 0x81, 0xc2, 0xa4, 0x4a, 0x93, 0x10, 0x6a, 0x10, 0x07, 0x2e, 0x55,
+	0x9c, 0x3c, 0x4d, 0xad, 0x65, 0x4e, 0xb1, 0xdd, 0xaa, 0x7c, 0x0b, 0x3e, 0x02, 0x1f, 0x87, 0x63,
+	0x25, 0x24, 0xc4, 0x11, 0xb5, 0x5f, 0x04, 0xc5, 0x79, 0xeb, 0xcb, 0xda, 0xdb, 0x8e, 0xf9, 0xe7,
+	0xf9, 0xff, 0xed, 0xdf, 0x63, 0xfb, 0xc1, 0xa7, 0x61, 0x9f, 0xda, 0xd4, 0x95, 0x60, 0x4b, 0x25,
+	0xc0, 0xbd, 0x62, 0x3c, 0xb0, 0xc7, 0x67, 0x14, 0x94, 0x7b, 0x56, 0x28, 0xd6, 0x50, 0x44, 0x2a,
+	0x22, 0xb5, 0xb0, 0x4f, 0xad, 0xb8, 0xd6, 0x2a, 0xfe, 0xa4, 0xb5, 0xb5, 0xfb, 0x91, 0x54, 0xc2,
+	0xf5, 0x22, 0x6e, 0xbb, 0xd4, 0x63, 0xb6, 0xfa, 0x3e, 0x04, 0x99, 0xd8, 0x6a, 0x0f, 0x17, 0x96,
+	0x88, 0x04, 0xe4, 0xf1, 0x21, 0x93, 0x0a, 0x78, 0x1e, 0xdf, 0x20, 0xf8, 0xd0, 0x19, 0x51, 0xe9,
+	0x09, 0x46, 0xa1, 0x0b, 0xdf, 0x46, 0x20, 0x55, 0xe3, 0xe7, 0x0e, 0x36, 0x1c, 0xbd, 0xd8, 0xf9,
+	0x18, 0xb8, 0x22, 0xc7, 0x78, 0x9f, 0x86, 0x91, 0x77, 0xd9, 0x1b, 0x00, 0x0b, 0x06, 0xaa, 0x8a,
+	0xea, 0xa8, 0x59, 0xee, 0x1a, 0x5a, 0xeb, 0x68, 0x89, 0x7c, 0xc4, 0x06, 0x85, 0x80, 0xf1, 0x9e,
+	0x16, 0xab, 0x3b, 0x75, 0xd4, 0x34, 0x5a, 0x8f, 0xad, 0xcd, 0x7b, 0xb7, 0xda, 0x71, 0x79, 0x3b,
+	0xae, 0xd6, 0x8b, 0x74, 0x4a, 0x5d, 0x4c, 0x73, 0x89, 0x5c, 0x60, 0xec, 0x43, 0xc8, 0xc6, 0x20,
+	0x7a, 0x6a, 0x52, 0x2d, 0xeb, 0xb8, 0xd3, 0x6d, 0x71, 0x1f, 0x92, 0xea, 0xcf, 0x93, 0x2c, 0xad,
+	0xe2, 0x67, 0x0a, 0xe9, 0xe0, 0x0a, 0x70, 0x3f, 0xdd, 0xda, 0x2d, 0x9d, 0xf5, 0x68, 0x5b, 0xd6,
+	0x39, 0xf7, 0x97, 0x36, 0xb6, 0x07, 0xa9, 0xd0, 0xde, 0xc5, 0xb7, 0x21, 0x16, 0x1b, 0x7f, 0x10,
+	0xbe, 0xb7, 0x42, 0x40, 0x5e, 0xe1, 0x5d, 0x91, 0x74, 0x50, 0x77, 0xc8, 0x68, 0xd5, 0xad, 0xec,
+	0x7c, 0xac, 0xf8, 0x7c, 0xac, 0xb4, 0xbf, 0x85, 0xaf, 0x9b, 0x19, 0xc8, 0x5b, 0xbc, 0x27, 0x40,
+	0x0e, 0x23, 0x2e, 0x21, 0x6d, 0xde, 0xf1, 0x9a, 0x39, 0xf9, 0xbd, 0xe0, 0xce, 0x2d, 0xa4, 0x83,
+	0x0f, 0xa4, 0x72, 0x15, 0xf4, 0xbc, 0x81, 0xcb, 0x03, 0x90, 0xd5, 0x72, 0xbd, 0xdc, 0x34, 0x5a,
+	0x0f, 0x16, 0x29, 0x23, 0x01, 0x39, 0xa1, 0x13, 0x7f, 0x5d, 0x7c, 0xf9, 0xe4, 0x32, 0xd1, 0xdd,
+	0xd7, 0xce, 0xf7, 0x89, 0xb1, 0xf1, 0x1b, 0xe1, 0xbb, 0xcb, 0xbd, 0x24, 0x2f, 0x57, 0xb9, 0x8e,
+	0xae, 0xe7, 0xca, 0x6d, 0x05, 0xd6, 0x9b, 0x35, 0xac, 0xfa, 0x06, 0xac, 0xc2, 0x7c, 0x13, 0x54,
+	0x53, 0x84, 0x0f, 0x96, 0x4e, 0x95, 0xbc, 0x58, 0x85, 0x32, 0xaf, 0x87, 0xca, 0x5c, 0x05, 0xd3,
+	0xeb, 0x35, 0xa6, 0xa3, 0x0d, 0x4c, 0xb9, 0xf7, 0x06, 0x90, 0x5a, 0x13, 0x7c, 0xe8, 0x64, 0x37,
+	0xd7, 0x01, 0x31, 0x66, 0x1e, 0x10, 0x1f, 0x57, 0xf2, 0xc7, 0x4c, 0x9e, 0x6c, 0xbb, 0xe2, 0xab,
+	0x6f, 0xbe, 0x76, 0xb2, 0xb5, 0xba, 0x18, 0x06, 0xcf, 0x50, 0xfb, 0xdd, 0xaf, 0x99, 0x89, 0xa6,
+	0x33, 0x13, 0xfd, 0x9b, 0x99, 0xe8, 0xc7, 0xdc, 0x2c, 0x4d, 0xe7, 0x66, 0xe9, 0xef, 0xdc, 0x2c,
+	0x7d, 0x3d, 0x09, 0x98, 0x1a, 0x8c, 0xa8, 0xe5, 0x45, 0x57, 0x76, 0xc8, 0x38, 0xd8, 0x61, 0x9f,
+	0x3e, 0x95, 0xfe, 0xe5, 0xc2, 0x98, 0xd3, 0x33, 0x8a, 0xde, 0xd1, 0xc3, 0xe7, 0xf9, 0xff, 0x01,
+	0x00, 0xa0, 0x77, 0x68, 0x5b, 0x09, 0x05, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// StreamingServiceClient is the client API for StreamingService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type StreamingServiceClient interface {
+	// Subscribe streams the events of the blocks delivered after the subscription.
+	Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (StreamingService_SubscribeClient, error)
+}
+
+type streamingServiceClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewStreamingServiceClient(cc grpc1.ClientConn) StreamingServiceClient {
+	return &streamingServiceClient{cc}
+}
+
+func (c *streamingServiceClient) Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (StreamingService_SubscribeClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_StreamingService_serviceDesc.Streams[0], "/lfb.base.streaming.v1beta1.StreamingService/Subscribe", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &streamingServiceSubscribeClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type StreamingService_SubscribeClient interface {
+	Recv() (*StreamEvent, error)
+	grpc.ClientStream
+}
+
+type streamingServiceSubscribeClient struct {
+	grpc.ClientStream
+}
+
+func (x *streamingServiceSubscribeClient) Recv() (*StreamEvent, error) {
+	m := new(StreamEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// StreamingServiceServer is the server API for StreamingService service.
+type StreamingServiceServer interface {
+	// Subscribe streams the events of the blocks delivered after the subscription.
+	Subscribe(*SubscribeRequest, StreamingService_SubscribeServer) error
+}
+
+// UnimplementedStreamingServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedStreamingServiceServer struct {
+}
+
+func (*UnimplementedStreamingServiceServer) Subscribe(req *SubscribeRequest, srv StreamingService_SubscribeServer) error {
+	return status.Errorf(codes.Unimplemented, "method Subscribe not implemented")
+}
+
+func RegisterStreamingServiceServer(s grpc1.Server, srv StreamingServiceServer) {
+	s.RegisterService(&_StreamingService_serviceDesc, srv)
+}
+
+func _StreamingService_Subscribe_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(StreamingServiceServer).Subscribe(m, &streamingServiceSubscribeServer{stream})
+}
+
+type StreamingService_SubscribeServer interface {
+	Send(*StreamEvent) error
+	grpc.ServerStream
+}
+
+type streamingServiceSubscribeServer struct {
+	grpc.ServerStream
+}
+
+func (x *streamingServiceSubscribeServer) Send(m *StreamEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _StreamingService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "lfb.base.streaming.v1beta1.StreamingService",
+	HandlerType: (*StreamingServiceServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Subscribe",
+			Handler:       _StreamingService_Subscribe_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "lfb/base/streaming/v1beta1/streaming.proto",
+}
+
+func (m *SubscribeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SubscribeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SubscribeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *StreamEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StreamEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StreamEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Event != nil {
+		{
+			size := m.Event.Size()
+			i -= size
+			if _, err := m.Event.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintStreaming(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StreamEvent_BeginBlock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StreamEvent_BeginBlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.BeginBlock != nil {
+		{
+			size, err := m.BeginBlock.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStreaming(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+func (m *StreamEvent_DeliverTx) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StreamEvent_DeliverTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.DeliverTx != nil {
+		{
+			size, err := m.DeliverTx.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStreaming(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *StreamEvent_EndBlock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StreamEvent_EndBlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.EndBlock != nil {
+		{
+			size, err := m.EndBlock.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStreaming(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	return len(dAtA) - i, nil
+}
+func (m *BeginBlockEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BeginBlockEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BeginBlockEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.StateChanges) > 0 {
+		for iNdEx := len(m.StateChanges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StateChanges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStreaming(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Response != nil {
+		{
+			size, err := m.Response.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStreaming(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Request != nil {
+		{
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStreaming(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeliverTxEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeliverTxEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeliverTxEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.StateChanges) > 0 {
+		for iNdEx := len(m.StateChanges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StateChanges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStreaming(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Response != nil {
+		{
+			size, err := m.Response.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStreaming(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Request != nil {
+		{
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStreaming(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EndBlockEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EndBlockEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EndBlockEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.StateChanges) > 0 {
+		for iNdEx := len(m.StateChanges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StateChanges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStreaming(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Response != nil {
+		{
+			size, err := m.Response.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStreaming(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Request != nil {
+		{
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStreaming(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintStreaming(dAtA []byte, offset int, v uint64) int {
+	offset -= sovStreaming(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *SubscribeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *StreamEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		n += 1 + sovStreaming(uint64(m.BlockHeight))
+	}
+	if m.Event != nil {
+		n += m.Event.Size()
+	}
+	return n
+}
+
+func (m *StreamEvent_BeginBlock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BeginBlock != nil {
+		l = m.BeginBlock.Size()
+		n += 1 + l + sovStreaming(uint64(l))
+	}
+	return n
+}
+func (m *StreamEvent_DeliverTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DeliverTx != nil {
+		l = m.DeliverTx.Size()
+		n += 1 + l + sovStreaming(uint64(l))
+	}
+	return n
+}
+func (m *StreamEvent_EndBlock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EndBlock != nil {
+		l = m.EndBlock.Size()
+		n += 1 + l + sovStreaming(uint64(l))
+	}
+	return n
+}
+func (m *BeginBlockEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 1 + l + sovStreaming(uint64(l))
+	}
+	if m.Response != nil {
+		l = m.Response.Size()
+		n += 1 + l + sovStreaming(uint64(l))
+	}
+	if len(m.StateChanges) > 0 {
+		for _, e := range m.StateChanges {
+			l = e.Size()
+			n += 1 + l + sovStreaming(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DeliverTxEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 1 + l + sovStreaming(uint64(l))
+	}
+	if m.Response != nil {
+		l = m.Response.Size()
+		n += 1 + l + sovStreaming(uint64(l))
+	}
+	if len(m.StateChanges) > 0 {
+		for _, e := range m.StateChanges {
+			l = e.Size()
+			n += 1 + l + sovStreaming(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EndBlockEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 1 + l + sovStreaming(uint64(l))
+	}
+	if m.Response != nil {
+		l = m.Response.Size()
+		n += 1 + l + sovStreaming(uint64(l))
+	}
+	if len(m.StateChanges) > 0 {
+		for _, e := range m.StateChanges {
+			l = e.Size()
+			n += 1 + l + sovStreaming(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovStreaming(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozStreaming(x uint64) (n int) {
+	return sovStreaming(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *SubscribeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStreaming
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SubscribeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SubscribeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStreaming(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStreaming
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StreamEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStreaming
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StreamEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StreamEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStreaming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BeginBlock", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStreaming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStreaming
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStreaming
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &BeginBlockEvent{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Event = &StreamEvent_BeginBlock{v}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeliverTx", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStreaming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStreaming
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStreaming
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &DeliverTxEvent{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Event = &StreamEvent_DeliverTx{v}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndBlock", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStreaming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStreaming
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStreaming
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &EndBlockEvent{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Event = &StreamEvent_EndBlock{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStreaming(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStreaming
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BeginBlockEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStreaming
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BeginBlockEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BeginBlockEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStreaming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStreaming
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStreaming
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Request == nil {
+				m.Request = &types.RequestBeginBlock{}
+			}
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Response", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStreaming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStreaming
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStreaming
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Response == nil {
+				m.Response = &types.ResponseBeginBlock{}
+			}
+			if err := m.Response.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StateChanges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStreaming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStreaming
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStreaming
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StateChanges = append(m.StateChanges, &types1.StoreKVPair{})
+			if err := m.StateChanges[len(m.StateChanges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStreaming(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStreaming
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeliverTxEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStreaming
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeliverTxEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeliverTxEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStreaming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStreaming
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStreaming
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Request == nil {
+				m.Request = &types.RequestDeliverTx{}
+			}
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Response", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStreaming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStreaming
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStreaming
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Response == nil {
+				m.Response = &types.ResponseDeliverTx{}
+			}
+			if err := m.Response.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StateChanges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStreaming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStreaming
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStreaming
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StateChanges = append(m.StateChanges, &types1.StoreKVPair{})
+			if err := m.StateChanges[len(m.StateChanges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStreaming(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStreaming
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EndBlockEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStreaming
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EndBlockEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EndBlockEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStreaming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStreaming
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStreaming
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Request == nil {
+				m.Request = &types.RequestEndBlock{}
+			}
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Response", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStreaming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStreaming
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStreaming
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Response == nil {
+				m.Response = &types.ResponseEndBlock{}
+			}
+			if err := m.Response.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StateChanges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStreaming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStreaming
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStreaming
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StateChanges = append(m.StateChanges, &types1.StoreKVPair{})
+			if err := m.StateChanges[len(m.StateChanges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStreaming(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStreaming
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipStreaming(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowStreaming
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowStreaming
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowStreaming
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthStreaming
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupStreaming
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthStreaming
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthStreaming        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowStreaming          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupStreaming = fmt.Errorf("proto: unexpected end of group")
+)
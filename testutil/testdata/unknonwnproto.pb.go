@@ -1,5 +1,5 @@
 // Code generated by protoc-gen-gogo. DO NOT EDIT.
-// source: unknonwnproto.proto
+// source: testutil/testdata/unknonwnproto.proto
 
 package testdata
 
@@ -57,7 +57,7 @@ func (x Customer2_City) String() string {
 }
 
 func (Customer2_City) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_448ea787339d1228, []int{1, 0}
+	return fileDescriptor_d06a0db4098b2212, []int{1, 0}
 }
 
 type Customer1 struct {
@@ -71,7 +71,7 @@ func (m *Customer1) Reset()         { *m = Customer1{} }
 func (m *Customer1) String() string { return proto.CompactTextString(m) }
 func (*Customer1) ProtoMessage()    {}
 func (*Customer1) Descriptor() ([]byte, []int) {
-	return fileDescriptor_448ea787339d1228, []int{0}
+	return fileDescriptor_d06a0db4098b2212, []int{0}
 }
 func (m *Customer1) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -142,7 +142,7 @@ func (m *Customer2) Reset()         { *m = Customer2{} }
 func (m *Customer2) String() string { return proto.CompactTextString(m) }
 func (*Customer2) ProtoMessage()    {}
 func (*Customer2) Descriptor() ([]byte, []int) {
-	return fileDescriptor_448ea787339d1228, []int{1}
+	return fileDescriptor_d06a0db4098b2212, []int{1}
 }
 func (m *Customer2) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -229,7 +229,7 @@ func (m *Nested4A) Reset()         { *m = Nested4A{} }
 func (m *Nested4A) String() string { return proto.CompactTextString(m) }
 func (*Nested4A) ProtoMessage()    {}
 func (*Nested4A) Descriptor() ([]byte, []int) {
-	return fileDescriptor_448ea787339d1228, []int{2}
+	return fileDescriptor_d06a0db4098b2212, []int{2}
 }
 func (m *Nested4A) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -283,7 +283,7 @@ func (m *Nested3A) Reset()         { *m = Nested3A{} }
 func (m *Nested3A) String() string { return proto.CompactTextString(m) }
 func (*Nested3A) ProtoMessage()    {}
 func (*Nested3A) Descriptor() ([]byte, []int) {
-	return fileDescriptor_448ea787339d1228, []int{3}
+	return fileDescriptor_d06a0db4098b2212, []int{3}
 }
 func (m *Nested3A) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -350,7 +350,7 @@ func (m *Nested2A) Reset()         { *m = Nested2A{} }
 func (m *Nested2A) String() string { return proto.CompactTextString(m) }
 func (*Nested2A) ProtoMessage()    {}
 func (*Nested2A) Descriptor() ([]byte, []int) {
-	return fileDescriptor_448ea787339d1228, []int{4}
+	return fileDescriptor_d06a0db4098b2212, []int{4}
 }
 func (m *Nested2A) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -409,7 +409,7 @@ func (m *Nested1A) Reset()         { *m = Nested1A{} }
 func (m *Nested1A) String() string { return proto.CompactTextString(m) }
 func (*Nested1A) ProtoMessage()    {}
 func (*Nested1A) Descriptor() ([]byte, []int) {
-	return fileDescriptor_448ea787339d1228, []int{5}
+	return fileDescriptor_d06a0db4098b2212, []int{5}
 }
 func (m *Nested1A) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -462,7 +462,7 @@ func (m *Nested4B) Reset()         { *m = Nested4B{} }
 func (m *Nested4B) String() string { return proto.CompactTextString(m) }
 func (*Nested4B) ProtoMessage()    {}
 func (*Nested4B) Descriptor() ([]byte, []int) {
-	return fileDescriptor_448ea787339d1228, []int{6}
+	return fileDescriptor_d06a0db4098b2212, []int{6}
 }
 func (m *Nested4B) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -523,7 +523,7 @@ func (m *Nested3B) Reset()         { *m = Nested3B{} }
 func (m *Nested3B) String() string { return proto.CompactTextString(m) }
 func (*Nested3B) ProtoMessage()    {}
 func (*Nested3B) Descriptor() ([]byte, []int) {
-	return fileDescriptor_448ea787339d1228, []int{7}
+	return fileDescriptor_d06a0db4098b2212, []int{7}
 }
 func (m *Nested3B) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -591,7 +591,7 @@ func (m *Nested2B) Reset()         { *m = Nested2B{} }
 func (m *Nested2B) String() string { return proto.CompactTextString(m) }
 func (*Nested2B) ProtoMessage()    {}
 func (*Nested2B) Descriptor() ([]byte, []int) {
-	return fileDescriptor_448ea787339d1228, []int{8}
+	return fileDescriptor_d06a0db4098b2212, []int{8}
 }
 func (m *Nested2B) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -658,7 +658,7 @@ func (m *Nested1B) Reset()         { *m = Nested1B{} }
 func (m *Nested1B) String() string { return proto.CompactTextString(m) }
 func (*Nested1B) ProtoMessage()    {}
 func (*Nested1B) Descriptor() ([]byte, []int) {
-	return fileDescriptor_448ea787339d1228, []int{9}
+	return fileDescriptor_d06a0db4098b2212, []int{9}
 }
 func (m *Nested1B) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -725,7 +725,7 @@ func (m *Customer3) Reset()         { *m = Customer3{} }
 func (m *Customer3) String() string { return proto.CompactTextString(m) }
 func (*Customer3) ProtoMessage()    {}
 func (*Customer3) Descriptor() ([]byte, []int) {
-	return fileDescriptor_448ea787339d1228, []int{10}
+	return fileDescriptor_d06a0db4098b2212, []int{10}
 }
 func (m *Customer3) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -862,7 +862,7 @@ func (m *TestVersion1) Reset()         { *m = TestVersion1{} }
 func (m *TestVersion1) String() string { return proto.CompactTextString(m) }
 func (*TestVersion1) ProtoMessage()    {}
 func (*TestVersion1) Descriptor() ([]byte, []int) {
-	return fileDescriptor_448ea787339d1228, []int{11}
+	return fileDescriptor_d06a0db4098b2212, []int{11}
 }
 func (m *TestVersion1) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1007,7 +1007,7 @@ func (m *TestVersion2) Reset()         { *m = TestVersion2{} }
 func (m *TestVersion2) String() string { return proto.CompactTextString(m) }
 func (*TestVersion2) ProtoMessage()    {}
 func (*TestVersion2) Descriptor() ([]byte, []int) {
-	return fileDescriptor_448ea787339d1228, []int{12}
+	return fileDescriptor_d06a0db4098b2212, []int{12}
 }
 func (m *TestVersion2) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1159,7 +1159,7 @@ func (m *TestVersion3) Reset()         { *m = TestVersion3{} }
 func (m *TestVersion3) String() string { return proto.CompactTextString(m) }
 func (*TestVersion3) ProtoMessage()    {}
 func (*TestVersion3) Descriptor() ([]byte, []int) {
-	return fileDescriptor_448ea787339d1228, []int{13}
+	return fileDescriptor_d06a0db4098b2212, []int{13}
 }
 func (m *TestVersion3) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1310,7 +1310,7 @@ func (m *TestVersion3LoneOneOfValue) Reset()         { *m = TestVersion3LoneOneO
 func (m *TestVersion3LoneOneOfValue) String() string { return proto.CompactTextString(m) }
 func (*TestVersion3LoneOneOfValue) ProtoMessage()    {}
 func (*TestVersion3LoneOneOfValue) Descriptor() ([]byte, []int) {
-	return fileDescriptor_448ea787339d1228, []int{14}
+	return fileDescriptor_d06a0db4098b2212, []int{14}
 }
 func (m *TestVersion3LoneOneOfValue) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1451,7 +1451,7 @@ func (m *TestVersion3LoneNesting) Reset()         { *m = TestVersion3LoneNesting
 func (m *TestVersion3LoneNesting) String() string { return proto.CompactTextString(m) }
 func (*TestVersion3LoneNesting) ProtoMessage()    {}
 func (*TestVersion3LoneNesting) Descriptor() ([]byte, []int) {
-	return fileDescriptor_448ea787339d1228, []int{15}
+	return fileDescriptor_d06a0db4098b2212, []int{15}
 }
 func (m *TestVersion3LoneNesting) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1593,7 +1593,7 @@ func (m *TestVersion3LoneNesting_Inner1) Reset()         { *m = TestVersion3Lone
 func (m *TestVersion3LoneNesting_Inner1) String() string { return proto.CompactTextString(m) }
 func (*TestVersion3LoneNesting_Inner1) ProtoMessage()    {}
 func (*TestVersion3LoneNesting_Inner1) Descriptor() ([]byte, []int) {
-	return fileDescriptor_448ea787339d1228, []int{15, 0}
+	return fileDescriptor_d06a0db4098b2212, []int{15, 0}
 }
 func (m *TestVersion3LoneNesting_Inner1) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1656,7 +1656,7 @@ func (m *TestVersion3LoneNesting_Inner1_InnerInner) String() string {
 }
 func (*TestVersion3LoneNesting_Inner1_InnerInner) ProtoMessage() {}
 func (*TestVersion3LoneNesting_Inner1_InnerInner) Descriptor() ([]byte, []int) {
-	return fileDescriptor_448ea787339d1228, []int{15, 0, 0}
+	return fileDescriptor_d06a0db4098b2212, []int{15, 0, 0}
 }
 func (m *TestVersion3LoneNesting_Inner1_InnerInner) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1709,7 +1709,7 @@ func (m *TestVersion3LoneNesting_Inner2) Reset()         { *m = TestVersion3Lone
 func (m *TestVersion3LoneNesting_Inner2) String() string { return proto.CompactTextString(m) }
 func (*TestVersion3LoneNesting_Inner2) ProtoMessage()    {}
 func (*TestVersion3LoneNesting_Inner2) Descriptor() ([]byte, []int) {
-	return fileDescriptor_448ea787339d1228, []int{15, 1}
+	return fileDescriptor_d06a0db4098b2212, []int{15, 1}
 }
 func (m *TestVersion3LoneNesting_Inner2) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1772,7 +1772,7 @@ func (m *TestVersion3LoneNesting_Inner2_InnerInner) String() string {
 }
 func (*TestVersion3LoneNesting_Inner2_InnerInner) ProtoMessage() {}
 func (*TestVersion3LoneNesting_Inner2_InnerInner) Descriptor() ([]byte, []int) {
-	return fileDescriptor_448ea787339d1228, []int{15, 1, 0}
+	return fileDescriptor_d06a0db4098b2212, []int{15, 1, 0}
 }
 func (m *TestVersion3LoneNesting_Inner2_InnerInner) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1838,7 +1838,7 @@ func (m *TestVersion4LoneNesting) Reset()         { *m = TestVersion4LoneNesting
 func (m *TestVersion4LoneNesting) String() string { return proto.CompactTextString(m) }
 func (*TestVersion4LoneNesting) ProtoMessage()    {}
 func (*TestVersion4LoneNesting) Descriptor() ([]byte, []int) {
-	return fileDescriptor_448ea787339d1228, []int{16}
+	return fileDescriptor_d06a0db4098b2212, []int{16}
 }
 func (m *TestVersion4LoneNesting) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1980,7 +1980,7 @@ func (m *TestVersion4LoneNesting_Inner1) Reset()         { *m = TestVersion4Lone
 func (m *TestVersion4LoneNesting_Inner1) String() string { return proto.CompactTextString(m) }
 func (*TestVersion4LoneNesting_Inner1) ProtoMessage()    {}
 func (*TestVersion4LoneNesting_Inner1) Descriptor() ([]byte, []int) {
-	return fileDescriptor_448ea787339d1228, []int{16, 0}
+	return fileDescriptor_d06a0db4098b2212, []int{16, 0}
 }
 func (m *TestVersion4LoneNesting_Inner1) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2043,7 +2043,7 @@ func (m *TestVersion4LoneNesting_Inner1_InnerInner) String() string {
 }
 func (*TestVersion4LoneNesting_Inner1_InnerInner) ProtoMessage() {}
 func (*TestVersion4LoneNesting_Inner1_InnerInner) Descriptor() ([]byte, []int) {
-	return fileDescriptor_448ea787339d1228, []int{16, 0, 0}
+	return fileDescriptor_d06a0db4098b2212, []int{16, 0, 0}
 }
 func (m *TestVersion4LoneNesting_Inner1_InnerInner) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2096,7 +2096,7 @@ func (m *TestVersion4LoneNesting_Inner2) Reset()         { *m = TestVersion4Lone
 func (m *TestVersion4LoneNesting_Inner2) String() string { return proto.CompactTextString(m) }
 func (*TestVersion4LoneNesting_Inner2) ProtoMessage()    {}
 func (*TestVersion4LoneNesting_Inner2) Descriptor() ([]byte, []int) {
-	return fileDescriptor_448ea787339d1228, []int{16, 1}
+	return fileDescriptor_d06a0db4098b2212, []int{16, 1}
 }
 func (m *TestVersion4LoneNesting_Inner2) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2159,7 +2159,7 @@ func (m *TestVersion4LoneNesting_Inner2_InnerInner) String() string {
 }
 func (*TestVersion4LoneNesting_Inner2_InnerInner) ProtoMessage() {}
 func (*TestVersion4LoneNesting_Inner2_InnerInner) Descriptor() ([]byte, []int) {
-	return fileDescriptor_448ea787339d1228, []int{16, 1, 0}
+	return fileDescriptor_d06a0db4098b2212, []int{16, 1, 0}
 }
 func (m *TestVersion4LoneNesting_Inner2_InnerInner) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2217,7 +2217,7 @@ func (m *TestVersionFD1) Reset()         { *m = TestVersionFD1{} }
 func (m *TestVersionFD1) String() string { return proto.CompactTextString(m) }
 func (*TestVersionFD1) ProtoMessage()    {}
 func (*TestVersionFD1) Descriptor() ([]byte, []int) {
-	return fileDescriptor_448ea787339d1228, []int{17}
+	return fileDescriptor_d06a0db4098b2212, []int{17}
 }
 func (m *TestVersionFD1) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2334,7 +2334,7 @@ func (m *TestVersionFD1WithExtraAny) Reset()         { *m = TestVersionFD1WithEx
 func (m *TestVersionFD1WithExtraAny) String() string { return proto.CompactTextString(m) }
 func (*TestVersionFD1WithExtraAny) ProtoMessage()    {}
 func (*TestVersionFD1WithExtraAny) Descriptor() ([]byte, []int) {
-	return fileDescriptor_448ea787339d1228, []int{18}
+	return fileDescriptor_d06a0db4098b2212, []int{18}
 }
 func (m *TestVersionFD1WithExtraAny) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2446,7 +2446,7 @@ func (m *AnyWithExtra) Reset()         { *m = AnyWithExtra{} }
 func (m *AnyWithExtra) String() string { return proto.CompactTextString(m) }
 func (*AnyWithExtra) ProtoMessage()    {}
 func (*AnyWithExtra) Descriptor() ([]byte, []int) {
-	return fileDescriptor_448ea787339d1228, []int{19}
+	return fileDescriptor_d06a0db4098b2212, []int{19}
 }
 func (m *AnyWithExtra) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2501,7 +2501,7 @@ func (m *TestUpdatedTxRaw) Reset()         { *m = TestUpdatedTxRaw{} }
 func (m *TestUpdatedTxRaw) String() string { return proto.CompactTextString(m) }
 func (*TestUpdatedTxRaw) ProtoMessage()    {}
 func (*TestUpdatedTxRaw) Descriptor() ([]byte, []int) {
-	return fileDescriptor_448ea787339d1228, []int{20}
+	return fileDescriptor_d06a0db4098b2212, []int{20}
 }
 func (m *TestUpdatedTxRaw) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2569,7 +2569,8 @@ type TestUpdatedTxBody struct {
 	Messages                     []*types.Any `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
 	Memo                         string       `protobuf:"bytes,2,opt,name=memo,proto3" json:"memo,omitempty"`
 	TimeoutHeight                int64        `protobuf:"varint,3,opt,name=timeout_height,json=timeoutHeight,proto3" json:"timeout_height,omitempty"`
-	SomeNewField                 uint64       `protobuf:"varint,4,opt,name=some_new_field,json=someNewField,proto3" json:"some_new_field,omitempty"`
+	SigBlockHeight               uint64       `protobuf:"varint,4,opt,name=sig_block_height,json=sigBlockHeight,proto3" json:"sig_block_height,omitempty"`
+	SomeNewField                 uint64       `protobuf:"varint,5,opt,name=some_new_field,json=someNewField,proto3" json:"some_new_field,omitempty"`
 	SomeNewFieldNonCriticalField string       `protobuf:"bytes,1050,opt,name=some_new_field_non_critical_field,json=someNewFieldNonCriticalField,proto3" json:"some_new_field_non_critical_field,omitempty"`
 	ExtensionOptions             []*types.Any `protobuf:"bytes,1023,rep,name=extension_options,json=extensionOptions,proto3" json:"extension_options,omitempty"`
 	NonCriticalExtensionOptions  []*types.Any `protobuf:"bytes,2047,rep,name=non_critical_extension_options,json=nonCriticalExtensionOptions,proto3" json:"non_critical_extension_options,omitempty"`
@@ -2579,7 +2580,7 @@ func (m *TestUpdatedTxBody) Reset()         { *m = TestUpdatedTxBody{} }
 func (m *TestUpdatedTxBody) String() string { return proto.CompactTextString(m) }
 func (*TestUpdatedTxBody) ProtoMessage()    {}
 func (*TestUpdatedTxBody) Descriptor() ([]byte, []int) {
-	return fileDescriptor_448ea787339d1228, []int{21}
+	return fileDescriptor_d06a0db4098b2212, []int{21}
 }
 func (m *TestUpdatedTxBody) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2629,6 +2630,13 @@ func (m *TestUpdatedTxBody) GetTimeoutHeight() int64 {
 	return 0
 }
 
+func (m *TestUpdatedTxBody) GetSigBlockHeight() uint64 {
+	if m != nil {
+		return m.SigBlockHeight
+	}
+	return 0
+}
+
 func (m *TestUpdatedTxBody) GetSomeNewField() uint64 {
 	if m != nil {
 		return m.SomeNewField
@@ -2668,7 +2676,7 @@ func (m *TestUpdatedAuthInfo) Reset()         { *m = TestUpdatedAuthInfo{} }
 func (m *TestUpdatedAuthInfo) String() string { return proto.CompactTextString(m) }
 func (*TestUpdatedAuthInfo) ProtoMessage()    {}
 func (*TestUpdatedAuthInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_448ea787339d1228, []int{22}
+	return fileDescriptor_d06a0db4098b2212, []int{22}
 }
 func (m *TestUpdatedAuthInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2733,7 +2741,7 @@ func (m *TestRepeatedUints) Reset()         { *m = TestRepeatedUints{} }
 func (m *TestRepeatedUints) String() string { return proto.CompactTextString(m) }
 func (*TestRepeatedUints) ProtoMessage()    {}
 func (*TestRepeatedUints) Descriptor() ([]byte, []int) {
-	return fileDescriptor_448ea787339d1228, []int{23}
+	return fileDescriptor_d06a0db4098b2212, []int{23}
 }
 func (m *TestRepeatedUints) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2806,113 +2814,117 @@ func init() {
 	proto.RegisterType((*TestRepeatedUints)(nil), "testdata.TestRepeatedUints")
 }
 
-func init() { proto.RegisterFile("unknonwnproto.proto", fileDescriptor_448ea787339d1228) }
-
-var fileDescriptor_448ea787339d1228 = []byte{
-	// 1646 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x58, 0x4d, 0x6f, 0x1b, 0xc7,
-	0x19, 0xd6, 0x70, 0x49, 0x89, 0x7c, 0x45, 0x53, 0xcc, 0x58, 0x68, 0x36, 0x74, 0xcc, 0x30, 0x0b,
-	0xc5, 0x61, 0x82, 0x86, 0x34, 0x97, 0x0c, 0x50, 0x04, 0x28, 0x10, 0x52, 0xb1, 0x2a, 0x01, 0xaa,
-	0x5c, 0x4c, 0x9d, 0xb4, 0xf0, 0x85, 0x58, 0x72, 0x87, 0xe4, 0x42, 0xe4, 0x8c, 0xba, 0x3b, 0x6b,
-	0x91, 0xb7, 0xa2, 0x3d, 0xf4, 0x9a, 0x4b, 0x51, 0xa0, 0xff, 0xa0, 0xa7, 0x22, 0xff, 0xa0, 0xe8,
-	0x29, 0x97, 0x02, 0xbe, 0x14, 0x28, 0x50, 0x20, 0x28, 0xec, 0x6b, 0xff, 0x41, 0x51, 0xb8, 0x98,
-	0xd9, 0x0f, 0x2e, 0x25, 0x51, 0xa6, 0xe4, 0xd6, 0x86, 0x80, 0x5e, 0xa4, 0x99, 0x77, 0x9f, 0x79,
-	0x3f, 0x9e, 0xf7, 0x83, 0x3b, 0x0b, 0xb7, 0x7d, 0x76, 0xcc, 0x38, 0x3b, 0x65, 0x27, 0x2e, 0x17,
-	0xbc, 0xa6, 0xfe, 0xe2, 0xac, 0xa0, 0x9e, 0xb0, 0x2d, 0x61, 0x95, 0xb6, 0x87, 0x7c, 0xc8, 0x95,
-	0xb0, 0x2e, 0x57, 0xc1, 0xf3, 0xd2, 0x3b, 0x43, 0xce, 0x87, 0x63, 0x5a, 0x57, 0xbb, 0x9e, 0x3f,
-	0xa8, 0x5b, 0x6c, 0x16, 0x3e, 0x7a, 0x7b, 0x3c, 0xe8, 0xd5, 0xc5, 0xb4, 0xfe, 0xa4, 0xd1, 0xa3,
-	0xc2, 0x6a, 0xd4, 0xc5, 0x34, 0x78, 0x60, 0x08, 0xc8, 0xed, 0xfa, 0x9e, 0xe0, 0x13, 0xea, 0x36,
-	0x70, 0x01, 0x52, 0x8e, 0xad, 0xa3, 0x0a, 0xaa, 0x66, 0x48, 0xca, 0xb1, 0x31, 0x86, 0x34, 0xb3,
-	0x26, 0x54, 0x4f, 0x55, 0x50, 0x35, 0x47, 0xd4, 0x1a, 0x7f, 0x04, 0x45, 0xcf, 0xef, 0x79, 0x7d,
-	0xd7, 0x39, 0x11, 0x0e, 0x67, 0xdd, 0x01, 0xa5, 0xba, 0x56, 0x41, 0xd5, 0x14, 0xd9, 0x4a, 0xca,
-	0xf7, 0x28, 0xc5, 0x3a, 0x6c, 0x9c, 0x58, 0xb3, 0x09, 0x65, 0x42, 0xdf, 0x50, 0x1a, 0xa2, 0xad,
-	0xf1, 0x4d, 0x6a, 0x6e, 0xd6, 0x3c, 0x67, 0xb6, 0x04, 0x59, 0x87, 0xd9, 0xbe, 0x27, 0xdc, 0x99,
-	0x32, 0x9d, 0x21, 0xf1, 0x3e, 0x76, 0x49, 0x4b, 0xb8, 0xb4, 0x0d, 0x99, 0x01, 0x3d, 0xa5, 0xae,
-	0x9e, 0x56, 0x7e, 0x04, 0x1b, 0x7c, 0x07, 0xb2, 0x2e, 0xf5, 0xa8, 0xfb, 0x84, 0xda, 0xfa, 0xef,
-	0xb2, 0x15, 0x54, 0xd5, 0x48, 0x2c, 0xc0, 0xdf, 0x87, 0x74, 0xdf, 0x11, 0x33, 0x7d, 0xbd, 0x82,
-	0xaa, 0x05, 0x53, 0xaf, 0x45, 0xcc, 0xd6, 0x62, 0xaf, 0x6a, 0xbb, 0x8e, 0x98, 0x11, 0x85, 0xc2,
-	0x9f, 0xc1, 0xad, 0x89, 0xe3, 0xf5, 0xe9, 0x78, 0x6c, 0x31, 0xca, 0x7d, 0x4f, 0x87, 0x0a, 0xaa,
-	0x6e, 0x9a, 0xdb, 0xb5, 0x80, 0xf0, 0x5a, 0x44, 0x78, 0xad, 0xcd, 0x66, 0x64, 0x11, 0x6a, 0xfc,
-	0x08, 0xd2, 0x52, 0x13, 0xce, 0x42, 0xfa, 0xd0, 0xe2, 0x5e, 0x71, 0x0d, 0x17, 0x00, 0x0e, 0xb9,
-	0xd7, 0x66, 0x43, 0x3a, 0xa6, 0x5e, 0x11, 0xe1, 0x3c, 0x64, 0x7f, 0x62, 0x8d, 0x79, 0x7b, 0x2c,
-	0x78, 0x31, 0x85, 0x01, 0xd6, 0x7f, 0xcc, 0xbd, 0x3e, 0x3f, 0x2d, 0x6a, 0x78, 0x13, 0x36, 0x8e,
-	0x2c, 0xc7, 0xe5, 0x3d, 0xa7, 0x98, 0x36, 0x6a, 0x90, 0x3d, 0xa2, 0x9e, 0xa0, 0x76, 0xab, 0xbd,
-	0x4a, 0xa2, 0x8c, 0xbf, 0xa2, 0xe8, 0x40, 0x73, 0xa5, 0x03, 0xd8, 0x80, 0x94, 0xd5, 0xd2, 0xd3,
-	0x15, 0xad, 0xba, 0x69, 0xe2, 0x39, 0x23, 0x91, 0x51, 0x92, 0xb2, 0x5a, 0xb8, 0x09, 0x19, 0x87,
-	0xd9, 0x74, 0xaa, 0x67, 0x14, 0xec, 0xee, 0x59, 0x58, 0xb3, 0x5d, 0x3b, 0x90, 0xcf, 0x1f, 0x30,
-	0xe1, 0xce, 0x48, 0x80, 0x2d, 0x1d, 0x02, 0xcc, 0x85, 0xb8, 0x08, 0xda, 0x31, 0x9d, 0x29, 0x5f,
-	0x34, 0x22, 0x97, 0xb8, 0x0a, 0x99, 0x27, 0xd6, 0xd8, 0x0f, 0xbc, 0xb9, 0xd8, 0x76, 0x00, 0xf8,
-	0x2c, 0xf5, 0x03, 0x64, 0x3c, 0x8e, 0xc2, 0x32, 0x57, 0x0b, 0xeb, 0x63, 0x58, 0x67, 0x0a, 0xaf,
-	0x6a, 0xe6, 0x02, 0xf5, 0xcd, 0x36, 0x09, 0x11, 0xc6, 0x5e, 0xa4, 0xbb, 0x71, 0x5e, 0xf7, 0x5c,
-	0xcf, 0x12, 0x37, 0xcd, 0xb9, 0x9e, 0xcf, 0xe3, 0x5c, 0x75, 0xce, 0xe9, 0x29, 0x82, 0x66, 0x0d,
-	0x69, 0x58, 0xd8, 0x72, 0x79, 0x51, 0x4d, 0x1b, 0x76, 0x9c, 0xbc, 0x6b, 0x6a, 0x90, 0xe9, 0xec,
-	0x2d, 0x4f, 0x67, 0x87, 0xa4, 0x7a, 0x2d, 0x83, 0xc5, 0x5c, 0x5e, 0x68, 0x45, 0xf6, 0xb6, 0xb4,
-	0x82, 0x88, 0x5c, 0xae, 0xc0, 0x64, 0x27, 0x62, 0x40, 0xf6, 0xa4, 0xcb, 0x7d, 0x41, 0x55, 0x4f,
-	0xe6, 0x48, 0xb0, 0x31, 0x7e, 0x1e, 0xf3, 0xdb, 0xb9, 0x06, 0xbf, 0x73, 0xed, 0x21, 0x03, 0x5a,
-	0xcc, 0x80, 0xf1, 0xab, 0xc4, 0x44, 0x69, 0xae, 0x54, 0x17, 0x05, 0x48, 0x79, 0x83, 0x70, 0x74,
-	0xa5, 0xbc, 0x01, 0x7e, 0x17, 0x72, 0x9e, 0xef, 0xf6, 0x47, 0x96, 0x3b, 0xa4, 0xe1, 0x24, 0x99,
-	0x0b, 0x70, 0x05, 0x36, 0x6d, 0xea, 0x09, 0x87, 0x59, 0x72, 0xba, 0xe9, 0x19, 0xa5, 0x28, 0x29,
-	0xc2, 0xf7, 0xa0, 0xd0, 0x77, 0xa9, 0xed, 0x88, 0x6e, 0xdf, 0x72, 0xed, 0x2e, 0xe3, 0xc1, 0xd0,
-	0xdb, 0x5f, 0x23, 0xf9, 0x40, 0xbe, 0x6b, 0xb9, 0xf6, 0x11, 0xc7, 0x77, 0x21, 0xd7, 0x1f, 0xd1,
-	0x5f, 0xf8, 0x54, 0x42, 0xb2, 0x21, 0x24, 0x1b, 0x88, 0x8e, 0x38, 0xae, 0x43, 0x96, 0xbb, 0xce,
-	0xd0, 0x61, 0xd6, 0x58, 0xcf, 0x29, 0x22, 0x6e, 0x9f, 0x9f, 0x4e, 0x0d, 0x12, 0x83, 0x3a, 0xb9,
-	0x78, 0xca, 0x1a, 0xff, 0x4c, 0x41, 0xfe, 0x11, 0xf5, 0xc4, 0x57, 0xd4, 0xf5, 0x1c, 0xce, 0x1a,
-	0x38, 0x0f, 0x68, 0x1a, 0x76, 0x1a, 0x9a, 0xe2, 0x1d, 0x40, 0x56, 0x48, 0xee, 0xf7, 0xe6, 0x3a,
-	0x93, 0x07, 0x08, 0xb2, 0x24, 0xaa, 0x17, 0x26, 0x78, 0x29, 0xaa, 0x27, 0x51, 0xfd, 0xb0, 0xb8,
-	0x96, 0xa2, 0xfa, 0xf8, 0x63, 0x40, 0x76, 0x38, 0x2a, 0x96, 0xa0, 0x3a, 0xe9, 0x6f, 0xbf, 0x7b,
-	0x6f, 0x8d, 0x20, 0x1b, 0x17, 0x00, 0x51, 0x35, 0x8f, 0x33, 0xfb, 0x6b, 0x04, 0x51, 0x7c, 0x0f,
-	0xd0, 0x40, 0x51, 0xb8, 0xf4, 0xac, 0xc4, 0x0d, 0xb0, 0x01, 0x68, 0xa8, 0x78, 0x5c, 0x36, 0x90,
-	0xd1, 0x50, 0x7a, 0x3b, 0xd2, 0x73, 0x97, 0x7b, 0x3b, 0xc2, 0x1f, 0x02, 0x3a, 0xd6, 0xf3, 0x4b,
-	0x39, 0xef, 0xa4, 0x9f, 0x7e, 0xf7, 0x1e, 0x22, 0xe8, 0xb8, 0x93, 0x01, 0xcd, 0xf3, 0x27, 0xc6,
-	0xaf, 0xb5, 0x05, 0xba, 0xcd, 0xab, 0xd2, 0x6d, 0xae, 0x44, 0xb7, 0xb9, 0x12, 0xdd, 0xa6, 0xa4,
-	0x7b, 0xe7, 0x65, 0x74, 0x9b, 0xd7, 0x22, 0xda, 0x7c, 0x53, 0x44, 0xe3, 0x3b, 0x90, 0x63, 0xf4,
-	0xb4, 0x3b, 0x70, 0xe8, 0xd8, 0xd6, 0xdf, 0xa9, 0xa0, 0x6a, 0x9a, 0x64, 0x19, 0x3d, 0xdd, 0x93,
-	0xfb, 0x28, 0x0b, 0xbf, 0x5d, 0xcc, 0x42, 0xf3, 0xaa, 0x59, 0x68, 0xae, 0x94, 0x85, 0xe6, 0x4a,
-	0x59, 0x68, 0xae, 0x94, 0x85, 0xe6, 0xb5, 0xb2, 0xd0, 0x7c, 0x63, 0x59, 0xf8, 0x04, 0x30, 0xe3,
-	0xac, 0xdb, 0x77, 0x1d, 0xe1, 0xf4, 0xad, 0x71, 0x98, 0x8e, 0xdf, 0xa8, 0xd9, 0x45, 0x8a, 0x8c,
-	0xb3, 0xdd, 0xf0, 0xc9, 0x42, 0x5e, 0xfe, 0x95, 0x82, 0x52, 0xd2, 0xfd, 0x43, 0xce, 0xe8, 0x43,
-	0x46, 0x1f, 0x0e, 0xbe, 0x92, 0x3f, 0xe5, 0x37, 0x34, 0x4b, 0x37, 0x86, 0xfd, 0x7f, 0xaf, 0xc3,
-	0xdb, 0x67, 0xd9, 0x3f, 0x52, 0xbf, 0x56, 0xc3, 0x1b, 0x42, 0x7d, 0x63, 0xde, 0x10, 0xef, 0x5f,
-	0x8c, 0x4a, 0xc4, 0x74, 0x43, 0x7a, 0x03, 0x7f, 0x0e, 0xeb, 0x0e, 0x63, 0xd4, 0x6d, 0xe8, 0x05,
-	0xa5, 0xbc, 0xfa, 0xd2, 0xc8, 0x6a, 0x07, 0x0a, 0x4f, 0xc2, 0x73, 0xb1, 0x06, 0x53, 0xdf, 0xba,
-	0x92, 0x06, 0x33, 0xd4, 0x60, 0x96, 0xfe, 0x80, 0x60, 0x3d, 0x50, 0x9a, 0x78, 0x4f, 0xd2, 0x96,
-	0xbe, 0x27, 0x1d, 0xc8, 0x57, 0x7e, 0x46, 0xdd, 0x30, 0xfb, 0xcd, 0x55, 0x3d, 0x0e, 0xfe, 0xa9,
-	0x3f, 0x24, 0xd0, 0x50, 0xba, 0x2f, 0x2f, 0x02, 0x91, 0x30, 0x61, 0x3c, 0x17, 0x19, 0x57, 0x77,
-	0xb2, 0xd0, 0xb8, 0x5c, 0x97, 0xfe, 0x18, 0xf9, 0x6a, 0x9e, 0x83, 0xeb, 0xb0, 0xd1, 0xe7, 0x3e,
-	0x8b, 0x2e, 0x89, 0x39, 0x12, 0x6d, 0xaf, 0xeb, 0xb1, 0xf9, 0xdf, 0xf0, 0x38, 0xea, 0xbf, 0x17,
-	0x8b, 0xfd, 0xd7, 0xfa, 0x7f, 0xff, 0xdd, 0xa0, 0xfe, 0x6b, 0xbd, 0x72, 0xff, 0xb5, 0x5e, 0x73,
-	0xff, 0xb5, 0x5e, 0xa9, 0xff, 0xb4, 0xa5, 0xfd, 0xf7, 0xcd, 0xff, 0xac, 0xff, 0x5a, 0x2b, 0xf5,
-	0x9f, 0x79, 0x69, 0xff, 0x6d, 0x27, 0x3f, 0x1c, 0x68, 0xe1, 0x47, 0x82, 0xa8, 0x03, 0xff, 0x82,
-	0xa0, 0x90, 0xb0, 0xb7, 0xf7, 0xc5, 0xf5, 0xae, 0x43, 0x6f, 0xfc, 0x5a, 0x12, 0xc5, 0xf3, 0x77,
-	0xb4, 0xf0, 0x3e, 0xb5, 0xf7, 0x45, 0xe3, 0x67, 0x8e, 0x18, 0x3d, 0x98, 0x0a, 0xd7, 0x6a, 0xb3,
-	0xd9, 0x6b, 0x8d, 0x6d, 0x67, 0x1e, 0x5b, 0x02, 0xd7, 0x66, 0xb3, 0xd8, 0xa3, 0x2b, 0x47, 0xf7,
-	0x08, 0xf2, 0xc9, 0xf3, 0xb8, 0x2a, 0x03, 0x40, 0xcb, 0xe9, 0x8b, 0x26, 0x80, 0x25, 0x03, 0x0f,
-	0x26, 0xa3, 0x26, 0x27, 0x60, 0x3e, 0x98, 0x80, 0x6a, 0xd7, 0x37, 0xfe, 0x84, 0xa0, 0x28, 0x0d,
-	0x7e, 0x79, 0x62, 0x5b, 0x82, 0xda, 0x8f, 0xa6, 0xc4, 0x3a, 0xc5, 0x77, 0x01, 0x7a, 0xdc, 0x9e,
-	0x75, 0x7b, 0x33, 0x41, 0x3d, 0x65, 0x23, 0x4f, 0x72, 0x52, 0xd2, 0x91, 0x02, 0x7c, 0x0f, 0xb6,
-	0x2c, 0x5f, 0x8c, 0xba, 0x0e, 0x1b, 0xf0, 0x10, 0x93, 0x52, 0x98, 0x5b, 0x52, 0x7c, 0xc0, 0x06,
-	0x3c, 0xc0, 0x95, 0x01, 0x3c, 0x67, 0xc8, 0x2c, 0xe1, 0xbb, 0xd4, 0xd3, 0xb5, 0x8a, 0x56, 0xcd,
-	0x93, 0x84, 0x04, 0x97, 0x61, 0x33, 0xbe, 0xbb, 0x74, 0x3f, 0x55, 0x5f, 0x0c, 0xf2, 0x24, 0x17,
-	0xdd, 0x5e, 0x3e, 0xc5, 0x1f, 0x40, 0x61, 0xfe, 0xbc, 0x71, 0xdf, 0x6c, 0xe9, 0xbf, 0xcc, 0x2a,
-	0x4c, 0x3e, 0xc2, 0x48, 0xa1, 0xf1, 0xb5, 0x06, 0x6f, 0x2d, 0x84, 0xd0, 0xe1, 0xf6, 0x0c, 0xdf,
-	0x87, 0xec, 0x84, 0x7a, 0x9e, 0x35, 0x54, 0x11, 0x68, 0x4b, 0x8b, 0x2c, 0x46, 0xc9, 0xee, 0x9e,
-	0xd0, 0x09, 0x8f, 0xba, 0x5b, 0xae, 0xa5, 0x0b, 0xc2, 0x99, 0x50, 0xee, 0x8b, 0xee, 0x88, 0x3a,
-	0xc3, 0x91, 0x08, 0x79, 0xbc, 0x15, 0x4a, 0xf7, 0x95, 0x10, 0xef, 0x40, 0xc1, 0xe3, 0x13, 0xda,
-	0x9d, 0x5f, 0xc5, 0xd2, 0xea, 0x2a, 0x96, 0x97, 0xd2, 0xa3, 0xd0, 0x59, 0xbc, 0x0f, 0xef, 0x2f,
-	0xa2, 0xba, 0x17, 0x0c, 0xe6, 0xdf, 0x07, 0x83, 0xf9, 0xdd, 0xe4, 0xc9, 0xa3, 0xb3, 0x43, 0xba,
-	0x03, 0x6f, 0xd1, 0xa9, 0xa0, 0x4c, 0xd6, 0x48, 0x97, 0xab, 0xcf, 0xc9, 0x9e, 0xfe, 0x62, 0xe3,
-	0x92, 0x30, 0x8b, 0x31, 0xfe, 0x61, 0x00, 0xc7, 0x8f, 0xa1, 0xbc, 0x60, 0xfe, 0x02, 0x85, 0x5b,
-	0x97, 0x28, 0xbc, 0x93, 0xf8, 0xe5, 0x78, 0x70, 0x46, 0xb7, 0xf1, 0x67, 0x04, 0xb7, 0x13, 0x29,
-	0x69, 0x87, 0x65, 0x81, 0x7f, 0x08, 0x79, 0x99, 0x7f, 0xea, 0xaa, 0xda, 0x89, 0x12, 0x53, 0xaa,
-	0x8d, 0x07, 0xbd, 0x9a, 0x98, 0xd6, 0xc2, 0x6f, 0xef, 0xb5, 0x9f, 0x2a, 0x8c, 0x3c, 0x41, 0x36,
-	0xbd, 0x78, 0xed, 0xe1, 0x0f, 0xe6, 0x1f, 0xdc, 0xe4, 0xaf, 0xde, 0x99, 0x53, 0x7b, 0x94, 0x06,
-	0x5f, 0xe1, 0x16, 0xea, 0xaa, 0xa9, 0x32, 0x96, 0xa8, 0xab, 0xe6, 0xaa, 0x75, 0xf5, 0x61, 0x50,
-	0x56, 0x84, 0x9e, 0x50, 0x19, 0xc4, 0x97, 0x0e, 0x13, 0xaa, 0x48, 0x98, 0x3f, 0x09, 0x3c, 0x4f,
-	0x13, 0xb5, 0xee, 0xec, 0x7e, 0xfb, 0xac, 0x8c, 0x9e, 0x3e, 0x2b, 0xa3, 0x7f, 0x3c, 0x2b, 0xa3,
-	0xaf, 0x9f, 0x97, 0xd7, 0x9e, 0x3e, 0x2f, 0xaf, 0xfd, 0xed, 0x79, 0x79, 0xed, 0xf1, 0x47, 0x43,
-	0x47, 0x8c, 0xfc, 0x5e, 0xad, 0xcf, 0x27, 0xf5, 0xb1, 0xc3, 0x68, 0x7d, 0x3c, 0xe8, 0x7d, 0xe2,
-	0xd9, 0xc7, 0x75, 0xd9, 0xec, 0xbe, 0x70, 0xc6, 0xf5, 0xa8, 0xeb, 0x7b, 0xeb, 0x8a, 0xdd, 0xe6,
-	0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0xff, 0xd6, 0xbe, 0xbb, 0xd8, 0x18, 0x00, 0x00,
+func init() {
+	proto.RegisterFile("testutil/testdata/unknonwnproto.proto", fileDescriptor_d06a0db4098b2212)
+}
+
+var fileDescriptor_d06a0db4098b2212 = []byte{
+	// 1665 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0x4f, 0x6f, 0x1b, 0xc7,
+	0x15, 0xd7, 0x70, 0x49, 0x89, 0x7c, 0xa2, 0x69, 0x66, 0x62, 0x34, 0x1b, 0x3a, 0x66, 0x98, 0x85,
+	0xed, 0x30, 0x41, 0x43, 0x9a, 0x4b, 0x06, 0x28, 0x02, 0x14, 0x08, 0xa9, 0x58, 0xb5, 0x01, 0x57,
+	0x2e, 0xb6, 0x4e, 0x5a, 0xf8, 0x42, 0x2c, 0xb9, 0xc3, 0xe5, 0x42, 0xcb, 0x19, 0x75, 0x67, 0xd6,
+	0x22, 0x6f, 0x45, 0x7b, 0xe8, 0xb5, 0x97, 0xa2, 0x40, 0xbf, 0x41, 0x4f, 0x45, 0xae, 0x3d, 0x15,
+	0x3d, 0xe5, 0x52, 0xc0, 0x97, 0x02, 0x05, 0x0a, 0x04, 0x85, 0x7d, 0xed, 0x37, 0x28, 0x8a, 0x14,
+	0x33, 0xfb, 0x87, 0x4b, 0x49, 0x54, 0x28, 0xa5, 0x8d, 0x21, 0xa0, 0x17, 0x6a, 0xe6, 0xed, 0x6f,
+	0xde, 0x7b, 0xf3, 0x7b, 0x7f, 0x76, 0x67, 0x04, 0x77, 0x04, 0xe1, 0x22, 0x14, 0x9e, 0xdf, 0x96,
+	0x03, 0xc7, 0x16, 0x76, 0x3b, 0xa4, 0x87, 0x94, 0xd1, 0x63, 0x7a, 0x14, 0x30, 0xc1, 0x5a, 0xea,
+	0x17, 0x17, 0x93, 0xa7, 0xb5, 0x1b, 0x2e, 0x73, 0x99, 0x12, 0xb6, 0xe5, 0x28, 0x7a, 0x5e, 0x7b,
+	0xd3, 0x65, 0xcc, 0xf5, 0x49, 0x5b, 0xcd, 0x46, 0xe1, 0xa4, 0x6d, 0xd3, 0x45, 0xfc, 0xe8, 0x0d,
+	0x7f, 0x32, 0x6a, 0x8b, 0x79, 0xfb, 0x59, 0x67, 0x44, 0x84, 0xdd, 0x69, 0x8b, 0x79, 0xf4, 0xc0,
+	0x10, 0x50, 0xda, 0x0b, 0xb9, 0x60, 0x33, 0x12, 0x74, 0x70, 0x05, 0x72, 0x9e, 0xa3, 0xa3, 0x06,
+	0x6a, 0x16, 0xac, 0x9c, 0xe7, 0x60, 0x0c, 0x79, 0x6a, 0xcf, 0x88, 0x9e, 0x6b, 0xa0, 0x66, 0xc9,
+	0x52, 0x63, 0xfc, 0x1e, 0x54, 0x79, 0x38, 0xe2, 0xe3, 0xc0, 0x3b, 0x12, 0x1e, 0xa3, 0xc3, 0x09,
+	0x21, 0xba, 0xd6, 0x40, 0xcd, 0x9c, 0x75, 0x3d, 0x2b, 0xdf, 0x27, 0x04, 0xeb, 0xb0, 0x73, 0x64,
+	0x2f, 0x66, 0x84, 0x0a, 0x7d, 0x47, 0x69, 0x48, 0xa6, 0xc6, 0xe7, 0xb9, 0xa5, 0x59, 0xf3, 0x94,
+	0xd9, 0x1a, 0x14, 0x3d, 0xea, 0x84, 0x5c, 0x04, 0x0b, 0x65, 0xba, 0x60, 0xa5, 0xf3, 0xd4, 0x25,
+	0x2d, 0xe3, 0xd2, 0x0d, 0x28, 0x4c, 0xc8, 0x31, 0x09, 0xf4, 0xbc, 0xf2, 0x23, 0x9a, 0xe0, 0x9b,
+	0x50, 0x0c, 0x08, 0x27, 0xc1, 0x33, 0xe2, 0xe8, 0xbf, 0x2d, 0x36, 0x50, 0x53, 0xb3, 0x52, 0x01,
+	0xfe, 0x2e, 0xe4, 0xc7, 0x9e, 0x58, 0xe8, 0xdb, 0x0d, 0xd4, 0xac, 0x98, 0x7a, 0x2b, 0x61, 0xb6,
+	0x95, 0x7a, 0xd5, 0xda, 0xf3, 0xc4, 0xc2, 0x52, 0x28, 0xfc, 0x11, 0x5c, 0x9b, 0x79, 0x7c, 0x4c,
+	0x7c, 0xdf, 0xa6, 0x84, 0x85, 0x5c, 0x87, 0x06, 0x6a, 0xee, 0x9a, 0x37, 0x5a, 0x11, 0xe1, 0xad,
+	0x84, 0xf0, 0x56, 0x9f, 0x2e, 0xac, 0x55, 0xa8, 0xf1, 0x03, 0xc8, 0x4b, 0x4d, 0xb8, 0x08, 0xf9,
+	0x47, 0x36, 0xe3, 0xd5, 0x2d, 0x5c, 0x01, 0x78, 0xc4, 0x78, 0x9f, 0xba, 0xc4, 0x27, 0xbc, 0x8a,
+	0x70, 0x19, 0x8a, 0x3f, 0xb2, 0x7d, 0xd6, 0xf7, 0x05, 0xab, 0xe6, 0x30, 0xc0, 0xf6, 0x0f, 0x19,
+	0x1f, 0xb3, 0xe3, 0xaa, 0x86, 0x77, 0x61, 0xe7, 0xc0, 0xf6, 0x02, 0x36, 0xf2, 0xaa, 0x79, 0xa3,
+	0x05, 0xc5, 0x03, 0xc2, 0x05, 0x71, 0x7a, 0xfd, 0x4d, 0x02, 0x65, 0xfc, 0x15, 0x25, 0x0b, 0xba,
+	0x1b, 0x2d, 0xc0, 0x06, 0xe4, 0xec, 0x9e, 0x9e, 0x6f, 0x68, 0xcd, 0x5d, 0x13, 0x2f, 0x19, 0x49,
+	0x8c, 0x5a, 0x39, 0xbb, 0x87, 0xbb, 0x50, 0xf0, 0xa8, 0x43, 0xe6, 0x7a, 0x41, 0xc1, 0x6e, 0x9d,
+	0x84, 0x75, 0xfb, 0xad, 0x87, 0xf2, 0xf9, 0x7d, 0x2a, 0x82, 0x85, 0x15, 0x61, 0x6b, 0x8f, 0x00,
+	0x96, 0x42, 0x5c, 0x05, 0xed, 0x90, 0x2c, 0x94, 0x2f, 0x9a, 0x25, 0x87, 0xb8, 0x09, 0x85, 0x67,
+	0xb6, 0x1f, 0x46, 0xde, 0x9c, 0x6d, 0x3b, 0x02, 0x7c, 0x94, 0xfb, 0x1e, 0x32, 0x9e, 0x26, 0xdb,
+	0x32, 0x37, 0xdb, 0xd6, 0xfb, 0xb0, 0x4d, 0x15, 0x5e, 0xd7, 0xce, 0x56, 0xdf, 0xed, 0x5b, 0x31,
+	0xc2, 0xd8, 0x4f, 0x74, 0x77, 0x4e, 0xeb, 0x5e, 0xea, 0x59, 0xe3, 0xa6, 0xb9, 0xd4, 0xf3, 0x71,
+	0x1a, 0xab, 0xc1, 0x29, 0x3d, 0x55, 0xd0, 0x6c, 0x97, 0xc4, 0x89, 0x2d, 0x87, 0x67, 0xe5, 0xb4,
+	0xe1, 0xa4, 0xc1, 0xbb, 0xa4, 0x06, 0x19, 0xce, 0xd1, 0xfa, 0x70, 0x0e, 0xac, 0xdc, 0xa8, 0x67,
+	0xd0, 0x94, 0xcb, 0x33, 0xad, 0x4c, 0x48, 0x64, 0x05, 0x59, 0x72, 0xb8, 0x01, 0x93, 0x83, 0x84,
+	0x01, 0x59, 0x93, 0x01, 0x0b, 0x05, 0x51, 0x35, 0x59, 0xb2, 0xa2, 0x89, 0xf1, 0xd3, 0x94, 0xdf,
+	0xc1, 0x25, 0xf8, 0x5d, 0x6a, 0x8f, 0x19, 0xd0, 0x52, 0x06, 0x8c, 0x5f, 0x64, 0x3a, 0x4a, 0x77,
+	0xa3, 0xbc, 0xa8, 0x40, 0x8e, 0x4f, 0xe2, 0xd6, 0x95, 0xe3, 0x13, 0xfc, 0x16, 0x94, 0x78, 0x18,
+	0x8c, 0xa7, 0x76, 0xe0, 0x92, 0xb8, 0x93, 0x2c, 0x05, 0xb8, 0x01, 0xbb, 0x0e, 0xe1, 0xc2, 0xa3,
+	0xb6, 0xec, 0x6e, 0x7a, 0x41, 0x29, 0xca, 0x8a, 0xf0, 0x5d, 0xa8, 0x8c, 0x03, 0xe2, 0x78, 0x62,
+	0x38, 0xb6, 0x03, 0x67, 0x48, 0x59, 0xd4, 0xf4, 0x1e, 0x6c, 0x59, 0xe5, 0x48, 0xbe, 0x67, 0x07,
+	0xce, 0x01, 0xc3, 0xb7, 0xa0, 0x34, 0x9e, 0x92, 0x9f, 0x85, 0x44, 0x42, 0x8a, 0x31, 0xa4, 0x18,
+	0x89, 0x0e, 0x18, 0x6e, 0x43, 0x91, 0x05, 0x9e, 0xeb, 0x51, 0xdb, 0xd7, 0x4b, 0x8a, 0x88, 0xd7,
+	0x4f, 0x77, 0xa7, 0x8e, 0x95, 0x82, 0x06, 0xa5, 0xb4, 0xcb, 0x1a, 0xff, 0xcc, 0x41, 0xf9, 0x09,
+	0xe1, 0xe2, 0x33, 0x12, 0x70, 0x8f, 0xd1, 0x0e, 0x2e, 0x03, 0x9a, 0xc7, 0x95, 0x86, 0xe6, 0xf8,
+	0x36, 0x20, 0x3b, 0x26, 0xf7, 0x3b, 0x4b, 0x9d, 0xd9, 0x05, 0x16, 0xb2, 0x25, 0x6a, 0xa4, 0x6b,
+	0xe7, 0xa3, 0x46, 0x12, 0x35, 0x8e, 0x93, 0x6b, 0x2d, 0x6a, 0x8c, 0xdf, 0x07, 0xe4, 0xe8, 0x85,
+	0xf3, 0x50, 0x83, 0xfc, 0x17, 0x5f, 0xbe, 0xbd, 0x65, 0x21, 0x07, 0x57, 0x00, 0x11, 0xd5, 0x8f,
+	0x0b, 0x0f, 0xb6, 0x2c, 0x44, 0xf0, 0x5d, 0x40, 0x13, 0x45, 0xe1, 0xda, 0xb5, 0x12, 0x37, 0xc1,
+	0x06, 0x20, 0x57, 0x2f, 0x9e, 0xd3, 0x90, 0x91, 0x2b, 0xbd, 0x9d, 0xea, 0xa5, 0xf3, 0xbd, 0x9d,
+	0xe2, 0x77, 0x01, 0x1d, 0xea, 0xe5, 0xb5, 0x9c, 0x0f, 0xf2, 0xcf, 0xbf, 0x7c, 0x1b, 0x59, 0xe8,
+	0x70, 0x50, 0x00, 0x8d, 0x87, 0x33, 0xe3, 0x97, 0xda, 0x0a, 0xdd, 0xe6, 0x45, 0xe9, 0x36, 0x37,
+	0xa2, 0xdb, 0xdc, 0x88, 0x6e, 0x53, 0xd2, 0x7d, 0xfb, 0xeb, 0xe8, 0x36, 0x2f, 0x45, 0xb4, 0xf9,
+	0xaa, 0x88, 0xc6, 0x37, 0xa1, 0x44, 0xc9, 0xf1, 0x70, 0xe2, 0x11, 0xdf, 0xd1, 0xdf, 0x6c, 0xa0,
+	0x66, 0xde, 0x2a, 0x52, 0x72, 0xbc, 0x2f, 0xe7, 0x49, 0x14, 0x7e, 0xb3, 0x1a, 0x85, 0xee, 0x45,
+	0xa3, 0xd0, 0xdd, 0x28, 0x0a, 0xdd, 0x8d, 0xa2, 0xd0, 0xdd, 0x28, 0x0a, 0xdd, 0x4b, 0x45, 0xa1,
+	0xfb, 0xca, 0xa2, 0xf0, 0x01, 0x60, 0xca, 0xe8, 0x70, 0x1c, 0x78, 0xc2, 0x1b, 0xdb, 0x7e, 0x1c,
+	0x8e, 0x5f, 0xa9, 0xde, 0x65, 0x55, 0x29, 0xa3, 0x7b, 0xf1, 0x93, 0x95, 0xb8, 0xfc, 0x2b, 0x07,
+	0xb5, 0xac, 0xfb, 0x8f, 0x18, 0x25, 0x8f, 0x29, 0x79, 0x3c, 0xf9, 0x4c, 0xbe, 0xca, 0xaf, 0x68,
+	0x94, 0xae, 0x0c, 0xfb, 0xff, 0xde, 0x86, 0x37, 0x4e, 0xb2, 0x7f, 0xa0, 0xde, 0x56, 0xee, 0x15,
+	0xa1, 0xbe, 0xb3, 0x2c, 0x88, 0x77, 0xce, 0x46, 0x65, 0xf6, 0x74, 0x45, 0x6a, 0x03, 0x7f, 0x0c,
+	0xdb, 0x1e, 0xa5, 0x24, 0xe8, 0xe8, 0x15, 0xa5, 0xbc, 0xf9, 0xb5, 0x3b, 0x6b, 0x3d, 0x54, 0x78,
+	0x2b, 0x5e, 0x97, 0x6a, 0x30, 0xf5, 0xeb, 0x17, 0xd2, 0x60, 0xc6, 0x1a, 0xcc, 0xda, 0xef, 0x11,
+	0x6c, 0x47, 0x4a, 0x33, 0xdf, 0x49, 0xda, 0xda, 0xef, 0xa4, 0x87, 0xf2, 0x93, 0x9f, 0x92, 0x20,
+	0x8e, 0x7e, 0x77, 0x53, 0x8f, 0xa3, 0x3f, 0xea, 0xc7, 0x8a, 0x34, 0xd4, 0xee, 0x01, 0x2c, 0x85,
+	0x19, 0xe3, 0xa5, 0xc4, 0xb8, 0x3a, 0x93, 0xc5, 0xc6, 0xe5, 0xb8, 0xf6, 0x87, 0xc4, 0x57, 0xf3,
+	0x14, 0x5c, 0x87, 0x9d, 0x31, 0x0b, 0x69, 0x72, 0x48, 0x2c, 0x59, 0xc9, 0xf4, 0xb2, 0x1e, 0x9b,
+	0xff, 0x0d, 0x8f, 0x93, 0xfa, 0xfb, 0x6a, 0xb5, 0xfe, 0x7a, 0xff, 0xaf, 0xbf, 0x2b, 0x54, 0x7f,
+	0xbd, 0x6f, 0x5c, 0x7f, 0xbd, 0x6f, 0xb9, 0xfe, 0x7a, 0xdf, 0xa8, 0xfe, 0xb4, 0xb5, 0xf5, 0xf7,
+	0xf9, 0xff, 0xac, 0xfe, 0x7a, 0x1b, 0xd5, 0x9f, 0x79, 0x6e, 0xfd, 0xdd, 0xc8, 0x5e, 0x1c, 0x68,
+	0xf1, 0x25, 0x41, 0x52, 0x81, 0x7f, 0x41, 0x50, 0xc9, 0xd8, 0xdb, 0xff, 0xe4, 0x72, 0xc7, 0xa1,
+	0x57, 0x7e, 0x2c, 0x49, 0xf6, 0xf3, 0x77, 0xb4, 0xf2, 0x3d, 0xb5, 0xff, 0x49, 0xe7, 0x27, 0x9e,
+	0x98, 0xde, 0x9f, 0x8b, 0xc0, 0xee, 0xd3, 0xc5, 0xb7, 0xba, 0xb7, 0xdb, 0xcb, 0xbd, 0x65, 0x70,
+	0x7d, 0xba, 0x48, 0x3d, 0xba, 0xf0, 0xee, 0x9e, 0x40, 0x39, 0xbb, 0x1e, 0x37, 0xe5, 0x06, 0xd0,
+	0x7a, 0xfa, 0x92, 0x0e, 0x60, 0xe3, 0x72, 0xd2, 0x19, 0x35, 0xd9, 0x01, 0xcb, 0x51, 0x07, 0x54,
+	0xb3, 0xb1, 0xf1, 0x27, 0x04, 0x55, 0x69, 0xf0, 0xd3, 0x23, 0xc7, 0x16, 0xc4, 0x79, 0x32, 0xb7,
+	0xec, 0x63, 0x7c, 0x0b, 0x60, 0xc4, 0x9c, 0xc5, 0x70, 0xb4, 0x10, 0x84, 0x2b, 0x1b, 0x65, 0xab,
+	0x24, 0x25, 0x03, 0x29, 0xc0, 0x77, 0xe1, 0xba, 0x1d, 0x8a, 0xe9, 0xd0, 0xa3, 0x13, 0x16, 0x63,
+	0x72, 0x0a, 0x73, 0x4d, 0x8a, 0x1f, 0xd2, 0x09, 0x8b, 0x70, 0x75, 0x00, 0xee, 0xb9, 0xd4, 0x16,
+	0x61, 0x40, 0xb8, 0xae, 0x35, 0xb4, 0x66, 0xd9, 0xca, 0x48, 0x70, 0x1d, 0x76, 0xd3, 0xb3, 0xcb,
+	0xf0, 0x43, 0x75, 0x63, 0x50, 0xb6, 0x4a, 0xc9, 0xe9, 0xe5, 0x43, 0x7c, 0x07, 0x2a, 0xcb, 0xe7,
+	0x9d, 0x7b, 0x66, 0x4f, 0xff, 0x79, 0x51, 0x61, 0xca, 0x09, 0x46, 0x0a, 0x8d, 0x3f, 0x6a, 0xf0,
+	0xda, 0xca, 0x16, 0x06, 0xcc, 0x59, 0xe0, 0x7b, 0x50, 0x9c, 0x11, 0xce, 0x6d, 0x57, 0xed, 0x40,
+	0x5b, 0x9b, 0x64, 0x29, 0x4a, 0x56, 0xf7, 0x8c, 0xcc, 0x58, 0x52, 0xdd, 0x72, 0x2c, 0x5d, 0x10,
+	0xde, 0x8c, 0xb0, 0x50, 0x0c, 0xa7, 0xc4, 0x73, 0xa7, 0x22, 0xe6, 0xf1, 0x5a, 0x2c, 0x7d, 0xa0,
+	0x84, 0xb8, 0x09, 0x55, 0xee, 0xb9, 0xc3, 0x91, 0xcf, 0xc6, 0x87, 0x09, 0x30, 0xaf, 0x0e, 0x63,
+	0x15, 0xee, 0xb9, 0x03, 0x29, 0x8e, 0x91, 0xb7, 0xa1, 0xc2, 0xd9, 0x8c, 0x0c, 0x97, 0x87, 0xb6,
+	0x82, 0xc2, 0x95, 0xa5, 0xf4, 0x20, 0xde, 0x16, 0x7e, 0x00, 0xef, 0xac, 0xa2, 0x86, 0x67, 0xb4,
+	0xf0, 0xdf, 0x45, 0x2d, 0xfc, 0xad, 0xec, 0xca, 0x83, 0x93, 0xed, 0x7c, 0x00, 0xaf, 0x91, 0xb9,
+	0x20, 0x54, 0x66, 0xd3, 0x90, 0xa9, 0x8b, 0x67, 0xae, 0x7f, 0xb5, 0x73, 0x0e, 0x21, 0xd5, 0x14,
+	0xff, 0x38, 0x82, 0xe3, 0xa7, 0x50, 0x5f, 0x31, 0x7f, 0x86, 0xc2, 0xeb, 0xe7, 0x28, 0xbc, 0x99,
+	0x79, 0xc7, 0xdc, 0x3f, 0xa1, 0xdb, 0xf8, 0x33, 0x82, 0xd7, 0x33, 0xc1, 0xeb, 0xc7, 0x09, 0x84,
+	0xbf, 0x0f, 0x65, 0x99, 0x29, 0x24, 0x50, 0x59, 0x96, 0x84, 0xb0, 0xd6, 0xf2, 0x27, 0xa3, 0x96,
+	0x98, 0xb7, 0xe2, 0x5b, 0xfa, 0xd6, 0x8f, 0x15, 0x46, 0xae, 0xb0, 0x76, 0x79, 0x3a, 0xe6, 0xf8,
+	0xce, 0xf2, 0x6a, 0x4e, 0xbe, 0x1f, 0x4f, 0xac, 0xda, 0x27, 0x24, 0xba, 0xaf, 0x5b, 0xc9, 0xc0,
+	0xae, 0xae, 0xad, 0x66, 0x60, 0x77, 0xd3, 0x0c, 0x7c, 0x37, 0x4a, 0x40, 0x8b, 0x1c, 0x11, 0xb9,
+	0x89, 0x4f, 0x3d, 0x2a, 0x54, 0x3a, 0xd1, 0x70, 0x16, 0x79, 0x9e, 0xb7, 0xd4, 0x78, 0xb0, 0xf7,
+	0xc5, 0x8b, 0x3a, 0x7a, 0xfe, 0xa2, 0x8e, 0xfe, 0xf1, 0xa2, 0x8e, 0x7e, 0xfd, 0xb2, 0xbe, 0xf5,
+	0xfc, 0x65, 0x7d, 0xeb, 0x6f, 0x2f, 0xeb, 0x5b, 0x4f, 0xdf, 0x73, 0x3d, 0x31, 0x0d, 0x47, 0xad,
+	0x31, 0x9b, 0xb5, 0x7d, 0x8f, 0x92, 0xb6, 0x3f, 0x19, 0x7d, 0xc0, 0x9d, 0xc3, 0xf6, 0xa9, 0x7f,
+	0x7c, 0x8c, 0xb6, 0x15, 0xbb, 0xdd, 0xff, 0x0c, 0x00, 0x02, 0x57, 0x4e, 0x09, 0x14, 0x19, 0x00,
+	0x00,
 }
 
 func (m *Customer1) Marshal() (dAtA []byte, err error) {
@@ -5252,6 +5264,11 @@ func (m *TestUpdatedTxBody) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	if m.SomeNewField != 0 {
 		i = encodeVarintUnknonwnproto(dAtA, i, uint64(m.SomeNewField))
 		i--
+		dAtA[i] = 0x28
+	}
+	if m.SigBlockHeight != 0 {
+		i = encodeVarintUnknonwnproto(dAtA, i, uint64(m.SigBlockHeight))
+		i--
 		dAtA[i] = 0x20
 	}
 	if m.TimeoutHeight != 0 {
@@ -6423,6 +6440,9 @@ func (m *TestUpdatedTxBody) Size() (n int) {
 	if m.TimeoutHeight != 0 {
 		n += 1 + sovUnknonwnproto(uint64(m.TimeoutHeight))
 	}
+	if m.SigBlockHeight != 0 {
+		n += 1 + sovUnknonwnproto(uint64(m.SigBlockHeight))
+	}
 	if m.SomeNewField != 0 {
 		n += 1 + sovUnknonwnproto(uint64(m.SomeNewField))
 	}
@@ -12594,6 +12614,25 @@ func (m *TestUpdatedTxBody) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SigBlockHeight", wireType)
+			}
+			m.SigBlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUnknonwnproto
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SigBlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field SomeNewField", wireType)
 			}
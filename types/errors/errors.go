@@ -135,6 +135,16 @@ var (
 	// supported.
 	ErrNotSupported = Register(RootCodespace, 37, "feature not supported")
 
+	// ErrInvalidSigBlockHeight defines an error for a tx signed at a block height
+	// outside of the window accepted by the chain.
+	ErrInvalidSigBlockHeight = Register(RootCodespace, 38, "invalid sig block height")
+
+	// ErrTxReplayed defines an error for a tx which has already been processed.
+	ErrTxReplayed = Register(RootCodespace, 39, "tx already processed")
+
+	// ErrNotFound defines an error when requested entity doesn't exist in the state.
+	ErrNotFound = Register(RootCodespace, 40, "not found")
+
 	// ErrPanic is only set when we recover from a panic, so we know to
 	// redact potentially sensitive system info
 	ErrPanic = Register(UndefinedCodespace, 111222, "panic")
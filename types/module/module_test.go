@@ -10,6 +10,8 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/gorilla/mux"
 	abci "github.com/line/ostracon/abci/types"
+	"github.com/line/ostracon/libs/log"
+	ostproto "github.com/line/ostracon/proto/ostracon/types"
 	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/require"
 
@@ -179,7 +181,7 @@ func TestManager_RegisterQueryServices(t *testing.T) {
 
 	msgRouter := mocks.NewMockServer(mockCtrl)
 	queryRouter := mocks.NewMockServer(mockCtrl)
-	cfg := module.NewConfigurator(msgRouter, queryRouter)
+	cfg := module.NewConfigurator(nil, msgRouter, queryRouter)
 	mockAppModule1.EXPECT().RegisterServices(cfg).Times(1)
 	mockAppModule2.EXPECT().RegisterServices(cfg).Times(1)
 
@@ -282,3 +284,73 @@ func TestManager_EndBlock(t *testing.T) {
 	mockAppModule2.EXPECT().EndBlock(gomock.Any(), gomock.Eq(req)).Times(1).Return([]abci.ValidatorUpdate{{}})
 	require.Panics(t, func() { mm.EndBlock(sdk.Context{}, req) })
 }
+
+func TestConfigurator_RegisterMigration(t *testing.T) {
+	cfg := module.NewConfigurator(nil, nil, nil)
+	noop := func(sdk.Context) error { return nil }
+
+	require.NoError(t, cfg.RegisterMigration("module1", 1, noop))
+	require.NoError(t, cfg.RegisterMigration("module1", 2, noop))
+	require.NoError(t, cfg.RegisterMigration("module2", 1, noop))
+	require.Error(t, cfg.RegisterMigration("module1", 0, noop))
+	require.Error(t, cfg.RegisterMigration("module1", 1, noop))
+}
+
+func TestManager_RunMigrations(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	t.Cleanup(mockCtrl.Finish)
+
+	mockAppModule1 := mocks.NewMockAppModule(mockCtrl)
+	mockAppModule2 := mocks.NewMockAppModule(mockCtrl)
+	mockAppModule1.EXPECT().Name().Times(2).Return("module1")
+	mockAppModule2.EXPECT().Name().Times(2).Return("module2")
+	mm := module.NewManager(mockAppModule1, mockAppModule2)
+
+	ctx := sdk.NewContext(nil, ostproto.Header{}, false, log.NewNopLogger())
+	cdc := codec.NewProtoCodec(types.NewInterfaceRegistry())
+	cfg := module.NewConfigurator(cdc, nil, nil)
+
+	var migrated []uint64
+	for _, version := range []uint64{1, 2} {
+		version := version
+		require.NoError(t, cfg.RegisterMigration("module1", version, func(sdk.Context) error {
+			migrated = append(migrated, version)
+			return nil
+		}))
+	}
+
+	// module1 is migrated from version 1 to 3, module2 is new and gets its default genesis
+	mockAppModule1.EXPECT().ConsensusVersion().Times(1).Return(uint64(3))
+	mockAppModule2.EXPECT().ConsensusVersion().Times(1).Return(uint64(1))
+	mockAppModule2.EXPECT().DefaultGenesis(gomock.Eq(cdc)).Times(1).Return(json.RawMessage(`{"key": "value"}`))
+	mockAppModule2.EXPECT().InitGenesis(gomock.Any(), gomock.Eq(cdc), gomock.Eq(json.RawMessage(`{"key": "value"}`))).Times(1).Return(nil)
+	vm, err := mm.RunMigrations(ctx, cfg, module.VersionMap{"module1": 1})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{1, 2}, migrated)
+	require.Equal(t, module.VersionMap{"module1": 3, "module2": 1}, vm)
+
+	// the migration from version 3 is missing
+	mockAppModule1.EXPECT().ConsensusVersion().Times(1).Return(uint64(4))
+	_, err = mm.RunMigrations(ctx, cfg, module.VersionMap{"module1": 3, "module2": 1})
+	require.Error(t, err)
+}
+
+func TestManager_GetVersionMap(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	t.Cleanup(mockCtrl.Finish)
+
+	mockAppModule1 := mocks.NewMockAppModule(mockCtrl)
+	mockAppModule2 := mocks.NewMockAppModule(mockCtrl)
+	mockAppModule1.EXPECT().Name().Times(3).Return("module1")
+	mockAppModule2.EXPECT().Name().Times(3).Return("module2")
+	mm := module.NewManager(mockAppModule1, mockAppModule2)
+
+	mockAppModule1.EXPECT().ConsensusVersion().Times(1).Return(uint64(2))
+	mockAppModule2.EXPECT().ConsensusVersion().Times(1).Return(uint64(1))
+	require.Equal(t, module.VersionMap{"module1": 2, "module2": 1}, mm.GetVersionMap())
+}
+
+func TestDefaultMigrationsOrder(t *testing.T) {
+	require.Equal(t, []string{"bank", "staking", "auth"}, module.DefaultMigrationsOrder([]string{"staking", "auth", "bank"}))
+	require.Equal(t, []string{"bank", "staking"}, module.DefaultMigrationsOrder([]string{"staking", "bank"}))
+}
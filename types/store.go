@@ -130,6 +130,11 @@ type KVPair = types.KVPair
 // every trace operation.
 type TraceContext = types.TraceContext
 
+//----------------------------------------
+
+// WriteListener receives the writes to the KVStores it listens to.
+type WriteListener = types.WriteListener
+
 // --------------------------------------
 
 type (
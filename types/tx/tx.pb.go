@@ -259,6 +259,10 @@ type TxBody struct {
 	// timeout is the block height after which this transaction will not
 	// be processed by the chain
 	TimeoutHeight uint64 `protobuf:"varint,3,opt,name=timeout_height,json=timeoutHeight,proto3" json:"timeout_height,omitempty"`
+	// sig_block_height is the recent block height the transaction was signed at.
+	// Chains protecting against replays by block height instead of account
+	// sequences only accept it within a window of blocks.
+	SigBlockHeight uint64 `protobuf:"varint,4,opt,name=sig_block_height,json=sigBlockHeight,proto3" json:"sig_block_height,omitempty"`
 	// extension_options are arbitrary options that can be added by chains
 	// when the default options are not sufficient. If any of these are present
 	// and can't be handled, the transaction will be rejected
@@ -323,6 +327,13 @@ func (m *TxBody) GetTimeoutHeight() uint64 {
 	return 0
 }
 
+func (m *TxBody) GetSigBlockHeight() uint64 {
+	if m != nil {
+		return m.SigBlockHeight
+	}
+	return 0
+}
+
 func (m *TxBody) GetExtensionOptions() []*types.Any {
 	if m != nil {
 		return m.ExtensionOptions
@@ -758,60 +769,61 @@ func init() {
 func init() { proto.RegisterFile("lfb/tx/v1beta1/tx.proto", fileDescriptor_3374cf1b0c1528d1) }
 
 var fileDescriptor_3374cf1b0c1528d1 = []byte{
-	// 844 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xcd, 0x8e, 0xdc, 0x44,
-	0x10, 0x1e, 0xcf, 0xdf, 0xce, 0xd4, 0xfe, 0x04, 0x9a, 0x15, 0x4c, 0x66, 0x85, 0x77, 0x34, 0x10,
-	0x34, 0x0a, 0x60, 0x93, 0x0d, 0x3f, 0x02, 0x09, 0xa4, 0x9d, 0x40, 0xb4, 0x11, 0x04, 0xa4, 0xde,
-	0x3d, 0x85, 0x83, 0xd5, 0xf6, 0xf4, 0x78, 0x5a, 0xb1, 0xbb, 0x07, 0x77, 0x1b, 0xec, 0x27, 0x40,
-	0xe2, 0x84, 0x10, 0xbc, 0x04, 0x17, 0x5e, 0x23, 0xc7, 0x70, 0xe3, 0x04, 0x68, 0xf7, 0x41, 0x40,
-	0xdd, 0x6e, 0x3b, 0xcb, 0x2a, 0x19, 0x2e, 0xb9, 0x75, 0x55, 0x7d, 0xf5, 0x55, 0x75, 0xfd, 0xc1,
-	0x2b, 0xc9, 0x32, 0xf4, 0x55, 0xe1, 0x7f, 0x7b, 0x2b, 0xa4, 0x8a, 0xdc, 0xf2, 0x55, 0xe1, 0xad,
-	0x33, 0xa1, 0x04, 0xda, 0x4b, 0x96, 0xa1, 0xa7, 0x0a, 0xcf, 0x1a, 0xc6, 0xfb, 0xb1, 0x88, 0x85,
-	0x31, 0xf9, 0xfa, 0x55, 0xa1, 0xc6, 0x37, 0xb5, 0x7b, 0x94, 0x95, 0x6b, 0x25, 0xfc, 0x34, 0x4f,
-	0x14, 0x93, 0x2c, 0x6e, 0xb8, 0x6a, 0x85, 0xc5, 0x1e, 0x68, 0x6c, 0x48, 0x24, 0x6d, 0x00, 0x91,
-	0x60, 0xdc, 0x1a, 0x5f, 0xb7, 0x79, 0x48, 0x16, 0x73, 0xc6, 0x9f, 0x70, 0x58, 0xd9, 0xa2, 0xae,
-	0xc7, 0x42, 0xc4, 0x09, 0xf5, 0x8d, 0x14, 0xe6, 0x4b, 0x9f, 0xf0, 0xb2, 0x32, 0x4d, 0xbf, 0x77,
-	0xa0, 0x7d, 0x56, 0xa0, 0x9b, 0xd0, 0x0d, 0xc5, 0xa2, 0x1c, 0x39, 0x13, 0x67, 0xb6, 0x7d, 0xf4,
-	0xb2, 0xf7, 0xdf, 0x5f, 0x78, 0x67, 0xc5, 0x5c, 0x2c, 0x4a, 0x6c, 0x30, 0xe8, 0x3d, 0x18, 0x92,
-	0x5c, 0xad, 0x02, 0xc6, 0x97, 0x62, 0xd4, 0x36, 0x0e, 0xa3, 0xab, 0x0e, 0xc7, 0xb9, 0x5a, 0xdd,
-	0xe3, 0x4b, 0x81, 0x07, 0xc4, 0xbe, 0x90, 0x0b, 0xa0, 0xb3, 0x22, 0x2a, 0xcf, 0xa8, 0x1c, 0x75,
-	0x26, 0x9d, 0xd9, 0x0e, 0xbe, 0xa4, 0x99, 0x72, 0xe8, 0x9d, 0x15, 0x98, 0x7c, 0x87, 0x5e, 0x05,
-	0xd0, 0x71, 0x82, 0xb0, 0x54, 0x54, 0x9a, 0x8c, 0x76, 0xf0, 0x50, 0x6b, 0xe6, 0x5a, 0x81, 0xde,
-	0x80, 0x6b, 0x4d, 0x78, 0x8b, 0x69, 0x1b, 0xcc, 0x6e, 0x1d, 0xaa, 0xc2, 0xfd, 0x5f, 0xbc, 0x9f,
-	0x1c, 0xd8, 0x3a, 0x65, 0x31, 0xff, 0x54, 0x44, 0xcf, 0x2b, 0xe4, 0x75, 0x18, 0x44, 0x2b, 0xc2,
-	0x78, 0xc0, 0x16, 0xa3, 0xce, 0xc4, 0x99, 0x0d, 0xf1, 0x96, 0x91, 0xef, 0x2d, 0xd0, 0x0d, 0xd8,
-	0x23, 0x51, 0x24, 0x72, 0xae, 0x02, 0x9e, 0xa7, 0x21, 0xcd, 0x46, 0xdd, 0x89, 0x33, 0xeb, 0xe2,
-	0x5d, 0xab, 0xfd, 0xd2, 0x28, 0xa7, 0xbf, 0xb4, 0xa1, 0x5f, 0x15, 0x1b, 0xbd, 0x03, 0x83, 0x94,
-	0x4a, 0x49, 0x62, 0x93, 0x51, 0x67, 0xb6, 0x7d, 0xb4, 0xef, 0x55, 0x7d, 0xf4, 0xea, 0x3e, 0x7a,
-	0xc7, 0xbc, 0xc4, 0x0d, 0x0a, 0x21, 0xe8, 0xa6, 0x34, 0xad, 0x7a, 0x32, 0xc4, 0xe6, 0xad, 0xe3,
-	0x2a, 0x96, 0x52, 0x91, 0xab, 0x60, 0x45, 0x59, 0xbc, 0x52, 0x26, 0xb1, 0x2e, 0xde, 0xb5, 0xda,
-	0x13, 0xa3, 0x44, 0x73, 0x78, 0x91, 0x16, 0x8a, 0x72, 0xc9, 0x04, 0x0f, 0xc4, 0x5a, 0x31, 0xc1,
-	0xe5, 0xe8, 0x9f, 0xad, 0x0d, 0x61, 0x5f, 0x68, 0xf0, 0x5f, 0x55, 0x70, 0xf4, 0x00, 0x5c, 0x2e,
-	0x78, 0x10, 0x65, 0x4c, 0xb1, 0x88, 0x24, 0xc1, 0x53, 0x08, 0xaf, 0x6d, 0x20, 0x3c, 0xe0, 0x82,
-	0xdf, 0xb1, 0xbe, 0x9f, 0x5d, 0xe1, 0x9e, 0xae, 0x61, 0x50, 0x8f, 0x14, 0xfa, 0x18, 0x76, 0x74,
-	0x1b, 0x69, 0x66, 0xfa, 0x51, 0x17, 0x67, 0x7c, 0x75, 0x04, 0x4f, 0x0d, 0xc6, 0x0c, 0xe1, 0xb6,
-	0x6c, 0xde, 0x12, 0xdd, 0x80, 0xce, 0x92, 0x52, 0x3b, 0xb8, 0x2f, 0x5d, 0xf5, 0xba, 0x4b, 0x29,
-	0xd6, 0xf6, 0xe9, 0xcf, 0x0e, 0xc0, 0x13, 0x0a, 0x74, 0x1b, 0x60, 0x9d, 0x87, 0x09, 0x8b, 0x82,
-	0x87, 0xb4, 0x5e, 0x93, 0xa7, 0xff, 0x63, 0x58, 0xe1, 0x3e, 0xa7, 0x66, 0x53, 0x52, 0xb1, 0xa0,
-	0x1b, 0x37, 0xe5, 0xbe, 0x58, 0xd0, 0x6a, 0x53, 0x52, 0xfb, 0x42, 0x63, 0x18, 0x48, 0xfa, 0x4d,
-	0x4e, 0x79, 0x44, 0x6d, 0xb7, 0x1a, 0x79, 0xfa, 0x7b, 0x1b, 0x06, 0xb5, 0x0b, 0xfa, 0x10, 0xfa,
-	0x92, 0xf1, 0x38, 0xa1, 0x36, 0xa1, 0xc3, 0x67, 0x91, 0x7b, 0xa7, 0x06, 0x76, 0xd2, 0xc2, 0xd6,
-	0x01, 0xbd, 0x0f, 0x3d, 0x73, 0x67, 0x6c, 0x5a, 0xee, 0x33, 0x3d, 0xef, 0x6b, 0xd4, 0x49, 0x0b,
-	0x57, 0xf0, 0xf1, 0x27, 0xd0, 0xaf, 0xb8, 0xd0, 0xbb, 0xd0, 0xd5, 0x19, 0x9b, 0xd0, 0x7b, 0x47,
-	0x93, 0x9a, 0xa0, 0xbe, 0x3c, 0x97, 0xdb, 0xa0, 0xc9, 0xb0, 0x41, 0x8f, 0x7f, 0x70, 0xa0, 0x67,
-	0x28, 0xd1, 0x09, 0x0c, 0x42, 0xa6, 0x48, 0x96, 0x91, 0xba, 0x9e, 0x6f, 0x19, 0x8e, 0xea, 0x2c,
-	0x7a, 0xcd, 0x15, 0xac, 0x89, 0xee, 0x88, 0x74, 0x4d, 0x22, 0x35, 0x67, 0xea, 0x58, 0xfb, 0xe0,
-	0xc6, 0x1b, 0x7d, 0x00, 0xd0, 0x94, 0x59, 0x6f, 0x66, 0x67, 0x63, 0x9d, 0x87, 0x75, 0x9d, 0xe5,
-	0xbc, 0x07, 0x1d, 0x99, 0xa7, 0xd3, 0xdf, 0x1c, 0xe8, 0xdc, 0xa5, 0x14, 0x7d, 0x0d, 0x7d, 0x92,
-	0xea, 0x65, 0xb4, 0x23, 0x55, 0x9d, 0x41, 0x7d, 0x7a, 0x2f, 0x25, 0xc1, 0xf8, 0xfc, 0xcd, 0x47,
-	0x7f, 0x1e, 0xb6, 0x7e, 0xfd, 0xeb, 0xf0, 0xb5, 0x98, 0xa9, 0x55, 0x1e, 0x7a, 0x91, 0x48, 0xfd,
-	0x84, 0x71, 0xea, 0x27, 0xcb, 0xf0, 0x6d, 0xb9, 0x78, 0xe8, 0xab, 0x72, 0x4d, 0xa5, 0xc1, 0x4a,
-	0x6c, 0x29, 0xd1, 0x01, 0x0c, 0x63, 0x22, 0x83, 0x84, 0xa5, 0x4c, 0x99, 0xa2, 0x77, 0xf1, 0x20,
-	0x26, 0xf2, 0x0b, 0x2d, 0xa3, 0x7d, 0xe8, 0xad, 0x49, 0x49, 0x33, 0x7b, 0x35, 0x2a, 0x01, 0x8d,
-	0x60, 0x2b, 0xce, 0x08, 0x57, 0xf6, 0x58, 0x0c, 0x71, 0x2d, 0xce, 0x3f, 0x7a, 0x74, 0xee, 0x3a,
-	0x8f, 0xcf, 0x5d, 0xe7, 0xef, 0x73, 0xd7, 0xf9, 0xf1, 0xc2, 0x6d, 0x3d, 0xbe, 0x70, 0x5b, 0x7f,
-	0x5c, 0xb8, 0xad, 0x07, 0x93, 0x8d, 0x39, 0xf9, 0xaa, 0x08, 0xfb, 0x66, 0x5a, 0x6f, 0xff, 0x1b,
-	0x00, 0x00, 0xff, 0xff, 0x2f, 0x66, 0x99, 0x92, 0xc3, 0x06, 0x00, 0x00,
+	// 863 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xcd, 0x8e, 0x1b, 0x45,
+	0x10, 0xf6, 0xf8, 0x6f, 0xed, 0xda, 0x9f, 0x84, 0x66, 0x05, 0x8e, 0x57, 0xcc, 0x5a, 0x86, 0x20,
+	0x2b, 0xc0, 0x0c, 0xd9, 0xf0, 0x23, 0x90, 0x40, 0x5a, 0x07, 0xa2, 0x8d, 0x20, 0x20, 0xf5, 0xee,
+	0x29, 0x1c, 0x46, 0x3d, 0xe3, 0xf6, 0xb8, 0xb5, 0x33, 0xdd, 0x66, 0xba, 0x07, 0x3c, 0x4f, 0x80,
+	0xc4, 0x09, 0x21, 0x9e, 0x82, 0x0b, 0x8f, 0xc0, 0x35, 0xc7, 0x70, 0xe3, 0x04, 0x68, 0xf7, 0x41,
+	0x40, 0xdd, 0xd3, 0x33, 0x59, 0xac, 0xac, 0xb9, 0x70, 0xeb, 0xaa, 0xfe, 0xea, 0xab, 0xaf, 0xab,
+	0xba, 0x0a, 0x5e, 0x4e, 0xe6, 0xa1, 0xaf, 0x56, 0xfe, 0x37, 0x77, 0x43, 0xaa, 0xc8, 0x5d, 0x5f,
+	0xad, 0xbc, 0x65, 0x26, 0x94, 0x40, 0x7b, 0xc9, 0x3c, 0xf4, 0xd4, 0xca, 0xb3, 0x17, 0xc3, 0xfd,
+	0x58, 0xc4, 0xc2, 0x5c, 0xf9, 0xfa, 0x54, 0xa2, 0x86, 0x77, 0x74, 0x78, 0x94, 0x15, 0x4b, 0x25,
+	0xfc, 0x34, 0x4f, 0x14, 0x93, 0x2c, 0xae, 0xb9, 0x2a, 0x87, 0xc5, 0x1e, 0x68, 0x6c, 0x48, 0x24,
+	0xad, 0x01, 0x91, 0x60, 0xdc, 0x5e, 0xbe, 0x66, 0x75, 0x48, 0x16, 0x73, 0xc6, 0x9f, 0x71, 0x58,
+	0xdb, 0xa2, 0x6e, 0xc5, 0x42, 0xc4, 0x09, 0xf5, 0x8d, 0x15, 0xe6, 0x73, 0x9f, 0xf0, 0xa2, 0xbc,
+	0x1a, 0x7f, 0xe7, 0x40, 0xf3, 0x6c, 0x85, 0xee, 0x40, 0x3b, 0x14, 0xb3, 0x62, 0xe0, 0x8c, 0x9c,
+	0xc9, 0xf6, 0xd1, 0x4b, 0xde, 0xbf, 0x5f, 0xe1, 0x9d, 0xad, 0xa6, 0x62, 0x56, 0x60, 0x83, 0x41,
+	0xef, 0x42, 0x9f, 0xe4, 0x6a, 0x11, 0x30, 0x3e, 0x17, 0x83, 0xa6, 0x09, 0x18, 0xac, 0x07, 0x1c,
+	0xe7, 0x6a, 0xf1, 0x90, 0xcf, 0x05, 0xee, 0x11, 0x7b, 0x42, 0x2e, 0x80, 0x56, 0x45, 0x54, 0x9e,
+	0x51, 0x39, 0x68, 0x8d, 0x5a, 0x93, 0x1d, 0x7c, 0xc5, 0x33, 0xe6, 0xd0, 0x39, 0x5b, 0x61, 0xf2,
+	0x2d, 0x7a, 0x05, 0x40, 0xe7, 0x09, 0xc2, 0x42, 0x51, 0x69, 0x14, 0xed, 0xe0, 0xbe, 0xf6, 0x4c,
+	0xb5, 0x03, 0xbd, 0x0e, 0x37, 0xea, 0xf4, 0x16, 0xd3, 0x34, 0x98, 0xdd, 0x2a, 0x55, 0x89, 0xfb,
+	0xaf, 0x7c, 0x3f, 0x3a, 0xb0, 0x75, 0xca, 0x62, 0xfe, 0x89, 0x88, 0xfe, 0xaf, 0x94, 0xb7, 0xa0,
+	0x17, 0x2d, 0x08, 0xe3, 0x01, 0x9b, 0x0d, 0x5a, 0x23, 0x67, 0xd2, 0xc7, 0x5b, 0xc6, 0x7e, 0x38,
+	0x43, 0xb7, 0x61, 0x8f, 0x44, 0x91, 0xc8, 0xb9, 0x0a, 0x78, 0x9e, 0x86, 0x34, 0x1b, 0xb4, 0x47,
+	0xce, 0xa4, 0x8d, 0x77, 0xad, 0xf7, 0x0b, 0xe3, 0x1c, 0xff, 0xda, 0x84, 0x6e, 0x59, 0x6c, 0xf4,
+	0x36, 0xf4, 0x52, 0x2a, 0x25, 0x89, 0x8d, 0xa2, 0xd6, 0x64, 0xfb, 0x68, 0xdf, 0x2b, 0xfb, 0xe8,
+	0x55, 0x7d, 0xf4, 0x8e, 0x79, 0x81, 0x6b, 0x14, 0x42, 0xd0, 0x4e, 0x69, 0x5a, 0xf6, 0xa4, 0x8f,
+	0xcd, 0x59, 0xe7, 0x55, 0x2c, 0xa5, 0x22, 0x57, 0xc1, 0x82, 0xb2, 0x78, 0xa1, 0x8c, 0xb0, 0x36,
+	0xde, 0xb5, 0xde, 0x13, 0xe3, 0x44, 0x13, 0xb8, 0x29, 0x59, 0x1c, 0x84, 0x89, 0x88, 0xce, 0x2b,
+	0x60, 0x29, 0x70, 0x4f, 0xb2, 0x78, 0xaa, 0xdd, 0x16, 0x39, 0x85, 0x17, 0xe8, 0x4a, 0x51, 0x2e,
+	0x99, 0xe0, 0x81, 0x58, 0x2a, 0x26, 0xb8, 0x1c, 0xfc, 0xbd, 0xb5, 0x41, 0xe0, 0xcd, 0x1a, 0xff,
+	0x65, 0x09, 0x47, 0x8f, 0xc1, 0xe5, 0x82, 0x07, 0x51, 0xc6, 0x14, 0x8b, 0x48, 0x12, 0x3c, 0x87,
+	0xf0, 0xc6, 0x06, 0xc2, 0x03, 0x2e, 0xf8, 0x7d, 0x1b, 0xfb, 0xe9, 0x1a, 0xf7, 0x78, 0x09, 0xbd,
+	0xea, 0xf3, 0xa1, 0x8f, 0x60, 0x47, 0x37, 0x9c, 0x66, 0xa6, 0x73, 0x55, 0x19, 0x87, 0xeb, 0x9f,
+	0xf5, 0xd4, 0x60, 0xcc, 0x77, 0xdd, 0x96, 0xf5, 0x59, 0xa2, 0xdb, 0xd0, 0x9a, 0x53, 0x6a, 0xbf,
+	0xf8, 0x8b, 0xeb, 0x51, 0x0f, 0x28, 0xc5, 0xfa, 0x7e, 0xfc, 0x93, 0x03, 0xf0, 0x8c, 0x02, 0xdd,
+	0x03, 0x58, 0xe6, 0x61, 0xc2, 0xa2, 0xe0, 0x9c, 0x56, 0x03, 0xf5, 0xfc, 0x77, 0xf4, 0x4b, 0xdc,
+	0x67, 0xd4, 0xcc, 0x54, 0x2a, 0x66, 0x74, 0xe3, 0x4c, 0x3d, 0x12, 0x33, 0x5a, 0xce, 0x54, 0x6a,
+	0x4f, 0x68, 0x08, 0x3d, 0x49, 0xbf, 0xce, 0x29, 0x8f, 0xa8, 0xed, 0x6b, 0x6d, 0x8f, 0x7f, 0x6b,
+	0x42, 0xaf, 0x0a, 0x41, 0x1f, 0x40, 0x57, 0x32, 0x1e, 0x27, 0xd4, 0x0a, 0x3a, 0xbc, 0x8e, 0xdc,
+	0x3b, 0x35, 0xb0, 0x93, 0x06, 0xb6, 0x01, 0xe8, 0x3d, 0xe8, 0x98, 0x8d, 0x64, 0x65, 0xb9, 0xd7,
+	0x46, 0x3e, 0xd2, 0xa8, 0x93, 0x06, 0x2e, 0xe1, 0xc3, 0x8f, 0xa1, 0x5b, 0x72, 0xa1, 0x77, 0xa0,
+	0xad, 0x15, 0x9b, 0xd4, 0x7b, 0x47, 0xa3, 0x8a, 0xa0, 0xda, 0x51, 0x57, 0xdb, 0xa0, 0xc9, 0xb0,
+	0x41, 0x0f, 0xbf, 0x77, 0xa0, 0x63, 0x28, 0xd1, 0x09, 0xf4, 0x42, 0xa6, 0x48, 0x96, 0x91, 0xaa,
+	0x9e, 0x6f, 0x1a, 0x8e, 0x72, 0x81, 0x7a, 0xf5, 0xbe, 0xac, 0x88, 0xee, 0x8b, 0x74, 0x49, 0x22,
+	0x35, 0x65, 0xea, 0x58, 0xc7, 0xe0, 0x3a, 0x1a, 0xbd, 0x0f, 0x50, 0x97, 0x59, 0xcf, 0x70, 0x6b,
+	0x63, 0x9d, 0xfb, 0x55, 0x9d, 0xe5, 0xb4, 0x03, 0x2d, 0x99, 0xa7, 0xe3, 0x5f, 0x1c, 0x68, 0x3d,
+	0xa0, 0x14, 0x7d, 0x05, 0x5d, 0x92, 0xea, 0xb1, 0xb5, 0x5f, 0xaa, 0x5c, 0x98, 0x7a, 0x49, 0x5f,
+	0x11, 0xc1, 0xf8, 0xf4, 0x8d, 0x27, 0x7f, 0x1c, 0x36, 0x7e, 0xfe, 0xf3, 0xf0, 0xd5, 0x98, 0xa9,
+	0x45, 0x1e, 0x7a, 0x91, 0x48, 0xfd, 0x84, 0x71, 0xea, 0x27, 0xf3, 0xf0, 0x2d, 0x39, 0x3b, 0xf7,
+	0x55, 0xb1, 0xa4, 0xd2, 0x60, 0x25, 0xb6, 0x94, 0xe8, 0x00, 0xfa, 0x31, 0x91, 0x41, 0xc2, 0x52,
+	0xa6, 0x4c, 0xd1, 0xdb, 0xb8, 0x17, 0x13, 0xf9, 0xb9, 0xb6, 0xd1, 0x3e, 0x74, 0x96, 0xa4, 0xa0,
+	0x99, 0xdd, 0x2f, 0xa5, 0x81, 0x06, 0xb0, 0x15, 0x67, 0x84, 0x2b, 0xbb, 0x56, 0xfa, 0xb8, 0x32,
+	0xa7, 0x1f, 0x3e, 0xb9, 0x70, 0x9d, 0xa7, 0x17, 0xae, 0xf3, 0xd7, 0x85, 0xeb, 0xfc, 0x70, 0xe9,
+	0x36, 0x9e, 0x5e, 0xba, 0x8d, 0xdf, 0x2f, 0xdd, 0xc6, 0xe3, 0xd1, 0x46, 0x4d, 0xbe, 0x5a, 0x85,
+	0x5d, 0xf3, 0x5b, 0xef, 0xfd, 0x33, 0x00, 0x1c, 0xae, 0x99, 0x0a, 0xed, 0x06, 0x00, 0x00,
 }
 
 func (m *Tx) Marshal() (dAtA []byte, err error) {
@@ -1017,6 +1029,11 @@ func (m *TxBody) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			dAtA[i] = 0xfa
 		}
 	}
+	if m.SigBlockHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SigBlockHeight))
+		i--
+		dAtA[i] = 0x20
+	}
 	if m.TimeoutHeight != 0 {
 		i = encodeVarintTx(dAtA, i, uint64(m.TimeoutHeight))
 		i--
@@ -1454,6 +1471,9 @@ func (m *TxBody) Size() (n int) {
 	if m.TimeoutHeight != 0 {
 		n += 1 + sovTx(uint64(m.TimeoutHeight))
 	}
+	if m.SigBlockHeight != 0 {
+		n += 1 + sovTx(uint64(m.SigBlockHeight))
+	}
 	if len(m.ExtensionOptions) > 0 {
 		for _, e := range m.ExtensionOptions {
 			l = e.Size()
@@ -2194,6 +2214,25 @@ func (m *TxBody) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SigBlockHeight", wireType)
+			}
+			m.SigBlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SigBlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		case 1023:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ExtensionOptions", wireType)
@@ -85,3 +85,13 @@ type TxDecoder func(txBytes []byte) (Tx, error)
 
 // TxEncoder marshals transaction to bytes
 type TxEncoder func(tx Tx) ([]byte, error)
+
+// MsgTypeURL returns the TypeURL of a `sdk.Msg`. The TypeURL of a ServiceMsg is
+// the one of its request, so both encodings of a msg share it.
+func MsgTypeURL(msg Msg) string {
+	if svcMsg, ok := msg.(ServiceMsg); ok {
+		return "/" + proto.MessageName(svcMsg.Request)
+	}
+
+	return "/" + proto.MessageName(msg)
+}
@@ -0,0 +1,22 @@
+package auth
+
+import (
+	"time"
+
+	"github.com/line/lfb-sdk/telemetry"
+	sdk "github.com/line/lfb-sdk/types"
+	"github.com/line/lfb-sdk/x/auth/keeper"
+	"github.com/line/lfb-sdk/x/auth/types"
+)
+
+// EndBlocker prunes the txs processed under sig block height replay protection
+// which were signed before the sig block height window of the current block.
+// They are rejected anyway, so they don't need to be remembered any longer.
+func EndBlocker(ctx sdk.Context, ak keeper.AccountKeeper) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
+
+	height, window := uint64(ctx.BlockHeight()), ak.GetParams(ctx).SigBlockHeightWindow
+	if height > window {
+		ak.PruneSeenTxs(ctx, height-window)
+	}
+}
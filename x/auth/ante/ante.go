@@ -6,15 +6,44 @@ import (
 	"github.com/line/lfb-sdk/x/auth/types"
 )
 
-// NewAnteHandler returns an AnteHandler that checks and increments sequence
-// numbers, checks signatures & account numbers, and deducts fees from the first
-// signer.
+// NewAnteHandler returns an AnteHandler that checks signatures & account
+// numbers, deducts fees from the first signer and protects txs from being
+// replayed with the given decorator, either the IncrementSequenceDecorator
+// checking and incrementing sequence numbers or the SigBlockHeightDecorator.
 func NewAnteHandler(
 	ak AccountKeeper, bankKeeper types.BankKeeper,
 	sigGasConsumer SignatureVerificationGasConsumer,
 	signModeHandler signing.SignModeHandler,
+	replayProtection sdk.AnteDecorator,
 ) sdk.AnteHandler {
-	return sdk.ChainAnteDecorators(
+	return newAnteHandler(
+		ak, sigGasConsumer, signModeHandler, replayProtection,
+		NewRejectFeeGranterDecorator(), NewDeductFeeDecorator(ak, bankKeeper),
+	)
+}
+
+// NewAnteHandlerWithFeeDecorator returns the AnteHandler of NewAnteHandler with
+// the given decorator deducting the fees of txs in place of the
+// RejectFeeGranterDecorator and the DeductFeeDecorator, e.g. to deduct them
+// from a fee granter.
+func NewAnteHandlerWithFeeDecorator(
+	ak AccountKeeper,
+	sigGasConsumer SignatureVerificationGasConsumer,
+	signModeHandler signing.SignModeHandler,
+	feeDecorator sdk.AnteDecorator,
+	replayProtection sdk.AnteDecorator,
+) sdk.AnteHandler {
+	return newAnteHandler(ak, sigGasConsumer, signModeHandler, replayProtection, feeDecorator)
+}
+
+func newAnteHandler(
+	ak AccountKeeper,
+	sigGasConsumer SignatureVerificationGasConsumer,
+	signModeHandler signing.SignModeHandler,
+	replayProtection sdk.AnteDecorator,
+	feeDecorators ...sdk.AnteDecorator,
+) sdk.AnteHandler {
+	decorators := []sdk.AnteDecorator{
 		NewSetUpContextDecorator(), // outermost AnteDecorator. SetUpContext must be called first
 		NewRejectExtensionOptionsDecorator(),
 		NewMempoolFeeDecorator(),
@@ -22,12 +51,15 @@ func NewAnteHandler(
 		TxTimeoutHeightDecorator{},
 		NewValidateMemoDecorator(ak),
 		NewConsumeGasForTxSizeDecorator(ak),
-		NewRejectFeeGranterDecorator(),
 		NewSetPubKeyDecorator(ak), // SetPubKeyDecorator must be called before all signature verification decorators
 		NewValidateSigCountDecorator(ak),
-		NewDeductFeeDecorator(ak, bankKeeper),
+	}
+	decorators = append(decorators, feeDecorators...)
+	decorators = append(decorators,
 		NewSigGasConsumeDecorator(ak, sigGasConsumer),
 		NewSigVerificationDecorator(ak, signModeHandler),
-		NewIncrementSequenceDecorator(ak),
+		replayProtection,
 	)
+
+	return sdk.ChainAnteDecorators(decorators...)
 }
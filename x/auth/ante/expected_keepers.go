@@ -13,3 +13,12 @@ type AccountKeeper interface {
 	SetAccount(ctx sdk.Context, acc types.AccountI)
 	GetModuleAddress(moduleName string) sdk.AccAddress
 }
+
+// SigBlockHeightKeeper defines the contract needed to track the txs processed
+// under sig block height replay protection.
+type SigBlockHeightKeeper interface {
+	GetParams(ctx sdk.Context) (params types.Params)
+	HasSeenTx(ctx sdk.Context, sigBlockHeight uint64, txHash []byte) bool
+	SetSeenTx(ctx sdk.Context, sigBlockHeight uint64, txHash []byte)
+	GetSeenTxsPrunedHeight(ctx sdk.Context) uint64
+}
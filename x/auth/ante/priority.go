@@ -0,0 +1,68 @@
+package ante
+
+import (
+	"fmt"
+	"math"
+
+	sdk "github.com/line/lfb-sdk/types"
+	authsigning "github.com/line/lfb-sdk/x/auth/signing"
+)
+
+// feePerGasPrecision scales the fee per gas of a tx into its priority, so fees
+// below one unit per gas still order txs.
+const feePerGasPrecision = 1000000
+
+// FeePerGasTxPriority is a baseapp.TxPriorityFunc prioritizing txs by the fee
+// they pay per unit of gas, in millionths of a fee unit. With several fee
+// denoms the lowest fee per gas counts.
+//
+// The sender key is the first signer with the nonce protecting the tx from
+// replays. With account sequences, it is the sequence the tx is signed with.
+// With the SigBlockHeightDecorator, sequences are never incremented and the
+// nonce is the sig block height with the signed content of the tx, so
+// independent txs of an account never share a sender key.
+func FeePerGasTxPriority(ctx sdk.Context, tx sdk.Tx) (priority int64, sender string) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return 0, ""
+	}
+
+	if gas := feeTx.GetGas(); gas > 0 {
+		priority = feePerGas(feeTx.GetFee(), gas)
+	}
+
+	sigTx, ok := tx.(authsigning.SigVerifiableTx)
+	if !ok {
+		return priority, ""
+	}
+
+	if heightTx, ok := tx.(TxWithSigBlockHeight); ok && heightTx.GetSigBlockHeight() != 0 {
+		txHash, err := signedContentHash(tx)
+		if err != nil {
+			return priority, ""
+		}
+		return priority, fmt.Sprintf("%s/%d/%X", sigTx.GetSigners()[0], heightTx.GetSigBlockHeight(), txHash)
+	}
+
+	sigs, err := sigTx.GetSignaturesV2()
+	if err != nil || len(sigs) == 0 {
+		return priority, ""
+	}
+
+	return priority, fmt.Sprintf("%s/%d", sigTx.GetSigners()[0], sigs[0].Sequence)
+}
+
+func feePerGas(fee sdk.Coins, gas uint64) int64 {
+	var priority int64
+	for i, coin := range fee {
+		p := int64(math.MaxInt64)
+		gasPrice := coin.Amount.MulRaw(feePerGasPrecision).Quo(sdk.NewIntFromUint64(gas))
+		if gasPrice.IsInt64() {
+			p = gasPrice.Int64()
+		}
+		if i == 0 || p < priority {
+			priority = p
+		}
+	}
+	return priority
+}
@@ -0,0 +1,76 @@
+package ante_test
+
+import (
+	"fmt"
+	"strings"
+
+	cryptotypes "github.com/line/lfb-sdk/crypto/types"
+	"github.com/line/lfb-sdk/testutil/testdata"
+	sdk "github.com/line/lfb-sdk/types"
+	"github.com/line/lfb-sdk/x/auth/ante"
+)
+
+func (suite *AnteTestSuite) TestFeePerGasTxPriority() {
+	suite.SetupTest(true) // setup
+
+	priv1, _, addr1 := testdata.KeyTestPubAddr()
+
+	testCases := []struct {
+		desc     string
+		fee      sdk.Coins
+		gas      uint64
+		priority int64
+	}{
+		{"no fee", sdk.NewCoins(), 200000, 0},
+		{"fee below one unit per gas", sdk.NewCoins(sdk.NewInt64Coin("atom", 150)), 200000, 750},
+		{"lowest fee per gas of several denoms", sdk.NewCoins(sdk.NewInt64Coin("atom", 150), sdk.NewInt64Coin("stake", 100)), 100, 1000000},
+		{"zero gas", sdk.NewCoins(sdk.NewInt64Coin("atom", 150)), 0, 0},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.desc, func() {
+			suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+			suite.Require().NoError(suite.txBuilder.SetMsgs(testdata.NewTestMsg(addr1)))
+			suite.txBuilder.SetFeeAmount(tc.fee)
+			suite.txBuilder.SetGasLimit(tc.gas)
+
+			privs, accNums, accSeqs := []cryptotypes.PrivKey{priv1}, []uint64{0}, []uint64{5}
+			tx, err := suite.CreateTestTx(privs, accNums, accSeqs, suite.ctx.ChainID())
+			suite.Require().NoError(err)
+
+			priority, sender := ante.FeePerGasTxPriority(suite.ctx, tx)
+			suite.Require().Equal(tc.priority, priority)
+			suite.Require().Equal(fmt.Sprintf("%s/5", addr1), sender)
+		})
+	}
+}
+
+func (suite *AnteTestSuite) TestFeePerGasTxPrioritySigBlockHeight() {
+	suite.SetupTest(true) // setup
+
+	priv1, _, addr1 := testdata.KeyTestPubAddr()
+
+	newTx := func(sigBlockHeight uint64, memo string) sdk.Tx {
+		suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+		suite.Require().NoError(suite.txBuilder.SetMsgs(testdata.NewTestMsg(addr1)))
+		suite.txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+		suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+		suite.txBuilder.SetMemo(memo)
+		suite.txBuilder.(interface{ SetSigBlockHeight(uint64) }).SetSigBlockHeight(sigBlockHeight)
+
+		privs, accNums, accSeqs := []cryptotypes.PrivKey{priv1}, []uint64{0}, []uint64{0}
+		tx, err := suite.CreateTestTx(privs, accNums, accSeqs, suite.ctx.ChainID())
+		suite.Require().NoError(err)
+		return tx
+	}
+
+	// txs signed at the same height with the same sequence don't share a sender key
+	_, sender1 := ante.FeePerGasTxPriority(suite.ctx, newTx(10, "first"))
+	_, sender2 := ante.FeePerGasTxPriority(suite.ctx, newTx(10, "second"))
+	suite.Require().True(strings.HasPrefix(sender1, fmt.Sprintf("%s/10/", addr1)))
+	suite.Require().NotEqual(sender1, sender2)
+
+	// the same tx always has the same sender key
+	_, sender := ante.FeePerGasTxPriority(suite.ctx, newTx(10, "first"))
+	suite.Require().Equal(sender1, sender)
+}
@@ -0,0 +1,118 @@
+package ante
+
+import (
+	"crypto/sha256"
+
+	sdk "github.com/line/lfb-sdk/types"
+	sdkerrors "github.com/line/lfb-sdk/types/errors"
+	"github.com/line/lfb-sdk/x/auth/legacy/legacytx"
+)
+
+// TxWithSigBlockHeight defines the interface a tx must implement in order for
+// SigBlockHeightDecorator to protect it from being replayed.
+type TxWithSigBlockHeight interface {
+	sdk.Tx
+
+	GetSigBlockHeight() uint64
+}
+
+// SigBlockHeightDecorator protects txs from being replayed without account
+// sequences. A tx must be signed at a block height within the last
+// SigBlockHeightWindow blocks, given by the auth params, and a tx signed at the
+// same height with the same signed content must not have been processed
+// before.
+//
+// Used in place of the IncrementSequenceDecorator, account sequences are never
+// incremented, so an account can have many txs in flight at once, all signed
+// with its unchanged sequence. Identical txs signed at the same height are
+// duplicates, the memo can be used to tell them apart.
+//
+// The processed txs are remembered until the auth EndBlocker prunes them once
+// they are out of the window. A tx signed before the pruned height is rejected
+// even if the window was widened since.
+// CONTRACT: Tx must implement TxWithSigBlockHeight, FeeTx, TxWithMemo and
+// TxWithTimeoutHeight interfaces
+type SigBlockHeightDecorator struct {
+	sk SigBlockHeightKeeper
+}
+
+func NewSigBlockHeightDecorator(sk SigBlockHeightKeeper) SigBlockHeightDecorator {
+	return SigBlockHeightDecorator{
+		sk: sk,
+	}
+}
+
+func (sbd SigBlockHeightDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	heightTx, ok := tx.(TxWithSigBlockHeight)
+	if !ok {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "invalid transaction type")
+	}
+
+	height := uint64(ctx.BlockHeight())
+	minHeight := uint64(1)
+	if window := sbd.sk.GetParams(ctx).SigBlockHeightWindow; height > window {
+		minHeight = height - window
+	}
+	if pruned := sbd.sk.GetSeenTxsPrunedHeight(ctx); pruned > minHeight {
+		minHeight = pruned
+	}
+
+	sigBlockHeight := heightTx.GetSigBlockHeight()
+	if sigBlockHeight < minHeight || sigBlockHeight > height {
+		return ctx, sdkerrors.Wrapf(
+			sdkerrors.ErrInvalidSigBlockHeight,
+			"tx signed at block height %d must be signed from block height %d to %d", sigBlockHeight, minHeight, height,
+		)
+	}
+
+	txHash, err := signedContentHash(tx)
+	if err != nil {
+		return ctx, err
+	}
+
+	if sbd.sk.HasSeenTx(ctx, sigBlockHeight, txHash) {
+		return ctx, sdkerrors.Wrapf(sdkerrors.ErrTxReplayed, "tx signed at block height %d", sigBlockHeight)
+	}
+	sbd.sk.SetSeenTx(ctx, sigBlockHeight, txHash)
+
+	return next(ctx, tx, simulate)
+}
+
+// signedContentHash returns the hash identifying a tx by the content its
+// signers sign over: its msgs, fee, memo, timeout height and sig block height.
+// Unlike the tx bytes or the signatures, which can be re-encoded or, for
+// multisigs, stripped of surplus signatures, the signed content of a tx can't
+// be changed without the signers' keys, so a replayed tx can't escape
+// detection. The content is hashed in its canonical amino JSON encoding, so
+// that re-encoding the protobuf tx doesn't change it either.
+func signedContentHash(tx sdk.Tx) ([]byte, error) {
+	heightTx, ok := tx.(TxWithSigBlockHeight)
+	if !ok {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "invalid transaction type")
+	}
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "invalid transaction type")
+	}
+	memoTx, ok := tx.(sdk.TxWithMemo)
+	if !ok {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "invalid transaction type")
+	}
+	timeoutTx, ok := tx.(TxWithTimeoutHeight)
+	if !ok {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "invalid transaction type")
+	}
+
+	content := legacytx.StdSignBytes(
+		"", 0, 0, timeoutTx.GetTimeoutHeight(), heightTx.GetSigBlockHeight(),
+		legacytx.NewStdFee(feeTx.GetGas(), feeTx.GetFee()), tx.GetMsgs(), memoTx.GetMemo(),
+	)
+
+	h := sha256.New()
+	h.Write(content)
+	// the fee payer and granter are not part of the amino JSON sign doc
+	h.Write(feeTx.FeePayer())
+	h.Write(feeTx.FeeGranter())
+
+	return h.Sum(nil), nil
+}
@@ -0,0 +1,109 @@
+package ante_test
+
+import (
+	clienttx "github.com/line/lfb-sdk/client/tx"
+	cryptotypes "github.com/line/lfb-sdk/crypto/types"
+	"github.com/line/lfb-sdk/testutil/testdata"
+	sdk "github.com/line/lfb-sdk/types"
+	sdkerrors "github.com/line/lfb-sdk/types/errors"
+	"github.com/line/lfb-sdk/types/tx/signing"
+	"github.com/line/lfb-sdk/x/auth"
+	"github.com/line/lfb-sdk/x/auth/ante"
+	xauthsigning "github.com/line/lfb-sdk/x/auth/signing"
+)
+
+func (suite *AnteTestSuite) TestSigBlockHeightDecorator() {
+	suite.SetupTest(false) // setup
+	params := suite.app.AccountKeeper.GetParams(suite.ctx)
+	params.SigBlockHeightWindow = 10
+	suite.app.AccountKeeper.SetParams(suite.ctx, params)
+	decorator := ante.NewSigBlockHeightDecorator(suite.app.AccountKeeper)
+	suite.anteHandler = ante.NewAnteHandler(
+		suite.app.AccountKeeper, suite.app.BankKeeper, ante.DefaultSigVerificationGasConsumer,
+		suite.clientCtx.TxConfig.SignModeHandler(), decorator,
+	)
+	suite.ctx = suite.ctx.WithBlockHeight(20)
+
+	accounts := suite.CreateTestAccounts(1)
+	msg := testdata.NewTestMsg(accounts[0].acc.GetAddress())
+	privs, accNums, accSeqs := []cryptotypes.PrivKey{accounts[0].priv}, []uint64{0}, []uint64{0}
+
+	newTx := func(sigBlockHeight uint64, memo string) sdk.Tx {
+		suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+		suite.Require().NoError(suite.txBuilder.SetMsgs(msg))
+		suite.txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+		suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+		suite.txBuilder.SetMemo(memo)
+		suite.txBuilder.(interface{ SetSigBlockHeight(uint64) }).SetSigBlockHeight(sigBlockHeight)
+
+		tx, err := suite.CreateTestTx(privs, accNums, accSeqs, suite.ctx.ChainID())
+		suite.Require().NoError(err)
+		return tx
+	}
+
+	// the same tx signed with another sign mode carries other signatures
+	newAminoJSONTx := func(sigBlockHeight uint64, memo string) sdk.Tx {
+		newTx(sigBlockHeight, memo)
+
+		signMode := signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON
+		suite.Require().NoError(suite.txBuilder.SetSignatures(signing.SignatureV2{
+			PubKey:   privs[0].PubKey(),
+			Data:     &signing.SingleSignatureData{SignMode: signMode},
+			Sequence: accSeqs[0],
+		}))
+		signerData := xauthsigning.SignerData{
+			ChainID:       suite.ctx.ChainID(),
+			AccountNumber: accNums[0],
+			Sequence:      accSeqs[0],
+		}
+		sig, err := clienttx.SignWithPrivKey(signMode, signerData, suite.txBuilder, privs[0], suite.clientCtx.TxConfig, accSeqs[0])
+		suite.Require().NoError(err)
+		suite.Require().NoError(suite.txBuilder.SetSignatures(sig))
+		return suite.txBuilder.GetTx()
+	}
+
+	testCases := []struct {
+		desc   string
+		tx     sdk.Tx
+		expErr *sdkerrors.Error
+	}{
+		{"no sig block height", newTx(0, ""), sdkerrors.ErrInvalidSigBlockHeight},
+		{"sig block height before the window", newTx(9, ""), sdkerrors.ErrInvalidSigBlockHeight},
+		{"future sig block height", newTx(21, ""), sdkerrors.ErrInvalidSigBlockHeight},
+		{"sig block height at the start of the window", newTx(10, ""), nil},
+		{"sig block height at the end of the window", newTx(20, ""), nil},
+		{"same sequence with another memo", newTx(20, "another"), nil},
+		{"replayed tx", newTx(20, ""), sdkerrors.ErrTxReplayed},
+		{"replayed tx with other signatures", newAminoJSONTx(20, ""), sdkerrors.ErrTxReplayed},
+		{"other tx signed with another sign mode", newAminoJSONTx(20, "amino"), nil},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.desc, func() {
+			_, err := suite.anteHandler(suite.ctx, tc.tx, false)
+			if tc.expErr != nil {
+				suite.Require().True(tc.expErr.Is(err), "unexpected error: %v", err)
+			} else {
+				suite.Require().NoError(err)
+			}
+		})
+	}
+
+	// the end blocker forgets the txs signed before the window only
+	suite.ctx = suite.ctx.WithBlockHeight(30)
+	auth.EndBlocker(suite.ctx, suite.app.AccountKeeper)
+	_, err := suite.anteHandler(suite.ctx, newTx(20, ""), false)
+	suite.Require().True(sdkerrors.ErrTxReplayed.Is(err), "unexpected error: %v", err)
+
+	// the txs signed before the pruned height stay rejected when the window is widened
+	params.SigBlockHeightWindow = 20
+	suite.app.AccountKeeper.SetParams(suite.ctx, params)
+	_, err = suite.anteHandler(suite.ctx, newTx(19, ""), false)
+	suite.Require().True(sdkerrors.ErrInvalidSigBlockHeight.Is(err), "unexpected error: %v", err)
+	_, err = suite.anteHandler(suite.ctx, newTx(20, "widened"), false)
+	suite.Require().NoError(err)
+
+	// the sequence is never incremented
+	acc := suite.app.AccountKeeper.GetAccount(suite.ctx, accounts[0].acc.GetAddress())
+	suite.Require().Equal(uint64(0), acc.GetSequence())
+}
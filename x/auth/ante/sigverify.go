@@ -291,7 +291,7 @@ func (svd *SigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simu
 				newSigKeys = append(newSigKeys, sigKey)
 			}
 		} else {
-			err = authsigning.VerifySignature(pubKey, signerData, sig.Data, svd.signModeHandler, tx)
+			err = authsigning.VerifySignature(sdk.WrapSDKContext(ctx), pubKey, signerData, sig.Data, svd.signModeHandler, tx)
 		}
 
 		if err != nil {
@@ -321,7 +321,7 @@ func (svd *SigVerificationDecorator) verifySignatureWithCache(
 ) (stored bool, err error) {
 	switch {
 	case ctx.IsCheckTx() && !ctx.IsReCheckTx(): // CheckTx
-		err = authsigning.VerifySignature(pubKey, signerData, sigData, svd.signModeHandler, tx)
+		err = authsigning.VerifySignature(sdk.WrapSDKContext(ctx), pubKey, signerData, sigData, svd.signModeHandler, tx)
 		if err == nil {
 			svd.txHashCache.Store(sigKey, txHash)
 			stored = true
@@ -342,7 +342,7 @@ func (svd *SigVerificationDecorator) verifySignatureWithCache(
 			svd.txHashCache.Delete(sigKey)
 		}
 		if !verified {
-			err = authsigning.VerifySignature(pubKey, signerData, sigData, svd.signModeHandler, tx)
+			err = authsigning.VerifySignature(sdk.WrapSDKContext(ctx), pubKey, signerData, sigData, svd.signModeHandler, tx)
 		}
 	}
 
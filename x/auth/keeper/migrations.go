@@ -0,0 +1,24 @@
+package keeper
+
+import (
+	sdk "github.com/line/lfb-sdk/types"
+	"github.com/line/lfb-sdk/x/auth/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper AccountKeeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper AccountKeeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates from version 1 to 2. It sets the sig block height window param.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	if !m.keeper.paramSubspace.Has(ctx, types.KeySigBlockHeightWindow) {
+		m.keeper.paramSubspace.Set(ctx, types.KeySigBlockHeightWindow, types.DefaultSigBlockHeightWindow)
+	}
+	return nil
+}
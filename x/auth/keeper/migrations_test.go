@@ -0,0 +1,30 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/line/lfb-sdk/x/auth/keeper"
+	authtypes "github.com/line/lfb-sdk/x/auth/types"
+	paramstypes "github.com/line/lfb-sdk/x/params/types"
+)
+
+func TestMigrate1to2(t *testing.T) {
+	app, ctx := createTestApp(false)
+
+	// a param space holding only the params of version 1, loaded without cached values as after the upgrade
+	subspace := paramstypes.NewSubspace(app.AppCodec(), app.LegacyAmino(), app.GetKey(paramstypes.StoreKey), "authv1").
+		WithKeyTable(authtypes.ParamKeyTable())
+	params := authtypes.DefaultParams()
+	for _, pair := range params.ParamSetPairs() {
+		if string(pair.Key) != string(authtypes.KeySigBlockHeightWindow) {
+			subspace.Set(ctx, pair.Key, pair.Value)
+		}
+	}
+	require.False(t, subspace.Has(ctx, authtypes.KeySigBlockHeightWindow))
+	ak := keeper.NewAccountKeeper(app.AppCodec(), app.GetKey(authtypes.StoreKey), subspace, authtypes.ProtoBaseAccount, nil)
+
+	require.NoError(t, keeper.NewMigrator(ak).Migrate1to2(ctx))
+	require.Equal(t, authtypes.DefaultParams(), ak.GetParams(ctx))
+}
@@ -0,0 +1,53 @@
+package keeper
+
+import (
+	"github.com/line/lfb-sdk/store/prefix"
+	sdk "github.com/line/lfb-sdk/types"
+	"github.com/line/lfb-sdk/x/auth/types"
+)
+
+// HasSeenTx returns true if the tx with the given hash, signed at the given
+// block height, has already been processed.
+func (ak AccountKeeper) HasSeenTx(ctx sdk.Context, sigBlockHeight uint64, txHash []byte) bool {
+	store := ctx.KVStore(ak.key)
+	return store.Has(types.SeenTxKey(sigBlockHeight, txHash))
+}
+
+// SetSeenTx records the tx with the given hash, signed at the given block
+// height, as processed.
+func (ak AccountKeeper) SetSeenTx(ctx sdk.Context, sigBlockHeight uint64, txHash []byte) {
+	store := ctx.KVStore(ak.key)
+	store.Set(types.SeenTxKey(sigBlockHeight, txHash), []byte{1})
+}
+
+// GetSeenTxsPrunedHeight returns the block height the processed txs signed
+// before were pruned at, 0 if none were.
+func (ak AccountKeeper) GetSeenTxsPrunedHeight(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(ak.key).Get(types.SeenTxsPrunedHeightKey)
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// PruneSeenTxs deletes the processed txs signed before the given block height
+// and records it as the pruned height.
+func (ak AccountKeeper) PruneSeenTxs(ctx sdk.Context, sigBlockHeight uint64) {
+	if sigBlockHeight <= ak.GetSeenTxsPrunedHeight(ctx) {
+		return
+	}
+	ctx.KVStore(ak.key).Set(types.SeenTxsPrunedHeightKey, sdk.Uint64ToBigEndian(sigBlockHeight))
+
+	store := prefix.NewStore(ctx.KVStore(ak.key), types.SeenTxKeyPrefix)
+	iterator := store.Iterator(nil, sdk.Uint64ToBigEndian(sigBlockHeight))
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
@@ -0,0 +1,33 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSeenTxs(t *testing.T) {
+	app, ctx := createTestApp(true)
+	hash1, hash2 := []byte("hash1"), []byte("hash2")
+
+	require.False(t, app.AccountKeeper.HasSeenTx(ctx, 10, hash1))
+	app.AccountKeeper.SetSeenTx(ctx, 10, hash1)
+	app.AccountKeeper.SetSeenTx(ctx, 11, hash2)
+	require.True(t, app.AccountKeeper.HasSeenTx(ctx, 10, hash1))
+	require.True(t, app.AccountKeeper.HasSeenTx(ctx, 11, hash2))
+	// the same tx signed at another height is a different tx
+	require.False(t, app.AccountKeeper.HasSeenTx(ctx, 11, hash1))
+
+	// only the txs signed before the given height are pruned
+	require.Zero(t, app.AccountKeeper.GetSeenTxsPrunedHeight(ctx))
+	app.AccountKeeper.PruneSeenTxs(ctx, 10)
+	require.True(t, app.AccountKeeper.HasSeenTx(ctx, 10, hash1))
+	app.AccountKeeper.PruneSeenTxs(ctx, 11)
+	require.False(t, app.AccountKeeper.HasSeenTx(ctx, 10, hash1))
+	require.True(t, app.AccountKeeper.HasSeenTx(ctx, 11, hash2))
+	require.Equal(t, uint64(11), app.AccountKeeper.GetSeenTxsPrunedHeight(ctx))
+
+	// the pruned height never decreases
+	app.AccountKeeper.PruneSeenTxs(ctx, 5)
+	require.Equal(t, uint64(11), app.AccountKeeper.GetSeenTxsPrunedHeight(ctx))
+}
@@ -74,6 +74,9 @@ func (s *StdTxBuilder) SetGasLimit(limit uint64) {
 	s.StdTx.Fee.Gas = limit
 }
 
+// SetFeeGranter does nothing for stdtx, as StdFee has no granter.
+func (s *StdTxBuilder) SetFeeGranter(_ sdk.AccAddress) {}
+
 // SetMemo implements TxBuilder.SetMemo
 func (s *StdTxBuilder) SetMemo(memo string) {
 	s.Memo = memo
@@ -1,6 +1,8 @@
 package signing
 
 import (
+	"context"
+
 	sdk "github.com/line/lfb-sdk/types"
 	"github.com/line/lfb-sdk/types/tx/signing"
 )
@@ -20,6 +22,26 @@ type SignModeHandler interface {
 	GetSignBytes(mode signing.SignMode, data SignerData, tx sdk.Tx) ([]byte, error)
 }
 
+// SignModeHandlerWithContext is a SignModeHandler whose sign bytes may depend
+// on the state of the chain, like SIGN_MODE_TEXTUAL which reads the bank
+// metadata of the denoms it renders.
+type SignModeHandlerWithContext interface {
+	SignModeHandler
+
+	// GetSignBytesWithContext returns the sign bytes for the provided SignMode,
+	// SignerData and Tx, reading the state of the chain through ctx, or an error
+	GetSignBytesWithContext(ctx context.Context, mode signing.SignMode, data SignerData, tx sdk.Tx) ([]byte, error)
+}
+
+// GetSignBytesWithContext returns the sign bytes of handler, passing ctx to it
+// if it is a SignModeHandlerWithContext.
+func GetSignBytesWithContext(ctx context.Context, handler SignModeHandler, mode signing.SignMode, data SignerData, tx sdk.Tx) ([]byte, error) {
+	if h, ok := handler.(SignModeHandlerWithContext); ok {
+		return h.GetSignBytesWithContext(ctx, mode, data, tx)
+	}
+	return handler.GetSignBytes(mode, data, tx)
+}
+
 // SignerData is the specific information needed to sign a transaction that generally
 // isn't included in the transaction body itself
 type SignerData struct {
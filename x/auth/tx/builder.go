@@ -162,6 +162,11 @@ func (w *wrapper) GetTimeoutHeight() uint64 {
 	return w.tx.Body.TimeoutHeight
 }
 
+// GetSigBlockHeight returns the block height the transaction was signed at (if set).
+func (w *wrapper) GetSigBlockHeight() uint64 {
+	return w.tx.Body.SigBlockHeight
+}
+
 func (w *wrapper) GetSignaturesV2() ([]signing.SignatureV2, error) {
 	signerInfos := w.tx.AuthInfo.SignerInfos
 	sigs := w.tx.Signatures
@@ -225,6 +230,14 @@ func (w *wrapper) SetTimeoutHeight(height uint64) {
 	w.bodyBz = nil
 }
 
+// SetSigBlockHeight sets the block height the transaction is signed at.
+func (w *wrapper) SetSigBlockHeight(height uint64) {
+	w.tx.Body.SigBlockHeight = height
+
+	// set bodyBz to nil because the cached bodyBz no longer matches tx.Body
+	w.bodyBz = nil
+}
+
 func (w *wrapper) SetMemo(memo string) {
 	w.tx.Body.Memo = memo
 
@@ -10,6 +10,7 @@ import (
 	"github.com/line/lfb-sdk/client"
 	sdk "github.com/line/lfb-sdk/types"
 	"github.com/line/lfb-sdk/x/auth/signing"
+	"github.com/line/lfb-sdk/x/auth/tx/textual"
 )
 
 type config struct {
@@ -24,8 +25,19 @@ type config struct {
 // NewTxConfig returns a new protobuf TxConfig using the provided ProtoCodec and sign modes. The
 // first enabled sign mode will become the default sign mode.
 func NewTxConfig(protoCodec codec.ProtoCodecMarshaler, enabledSignModes []signingtypes.SignMode) client.TxConfig {
+	return NewTxConfigWithTextual(protoCodec, enabledSignModes, nil)
+}
+
+// NewTxConfigWithTextual returns a new protobuf TxConfig like NewTxConfig, whose
+// SIGN_MODE_TEXTUAL handler, if enabled, renders the coins with the bank
+// metadata returned by coinMetadataQueryFn. The nodes should read the metadata
+// from the bank keeper and the clients through the bank gRPC query service.
+func NewTxConfigWithTextual(
+	protoCodec codec.ProtoCodecMarshaler, enabledSignModes []signingtypes.SignMode,
+	coinMetadataQueryFn textual.CoinMetadataQueryFn,
+) client.TxConfig {
 	return &config{
-		handler:     makeSignModeHandler(enabledSignModes),
+		handler:     makeSignModeHandler(enabledSignModes, coinMetadataQueryFn),
 		decoder:     DefaultTxDecoder(protoCodec),
 		encoder:     DefaultTxEncoder(),
 		jsonDecoder: DefaultJSONTxDecoder(protoCodec),
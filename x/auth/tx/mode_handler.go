@@ -5,6 +5,7 @@ import (
 
 	signingtypes "github.com/line/lfb-sdk/types/tx/signing"
 	"github.com/line/lfb-sdk/x/auth/signing"
+	"github.com/line/lfb-sdk/x/auth/tx/textual"
 )
 
 // DefaultSignModes are the default sign modes enabled for protobuf transactions.
@@ -14,8 +15,10 @@ var DefaultSignModes = []signingtypes.SignMode{
 }
 
 // makeSignModeHandler returns the default protobuf SignModeHandler supporting
-// SIGN_MODE_DIRECT and SIGN_MODE_LEGACY_AMINO_JSON.
-func makeSignModeHandler(modes []signingtypes.SignMode) signing.SignModeHandler {
+// SIGN_MODE_DIRECT, SIGN_MODE_LEGACY_AMINO_JSON and SIGN_MODE_TEXTUAL.
+// SIGN_MODE_TEXTUAL renders the coins with the bank metadata returned by
+// coinMetadataQueryFn, or in their base denom if it is nil.
+func makeSignModeHandler(modes []signingtypes.SignMode, coinMetadataQueryFn textual.CoinMetadataQueryFn) signing.SignModeHandler {
 	if len(modes) < 1 {
 		panic(fmt.Errorf("no sign modes enabled"))
 	}
@@ -28,6 +31,8 @@ func makeSignModeHandler(modes []signingtypes.SignMode) signing.SignModeHandler
 			handlers[i] = signModeDirectHandler{}
 		case signingtypes.SignMode_SIGN_MODE_LEGACY_AMINO_JSON:
 			handlers[i] = signModeLegacyAminoJSONHandler{}
+		case signingtypes.SignMode_SIGN_MODE_TEXTUAL:
+			handlers[i] = signModeTextualHandler{renderer: textual.NewRenderer(coinMetadataQueryFn)}
 		default:
 			panic(fmt.Errorf("unsupported sign mode %+v", mode))
 		}
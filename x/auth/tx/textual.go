@@ -0,0 +1,54 @@
+package tx
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/line/lfb-sdk/types"
+	signingtypes "github.com/line/lfb-sdk/types/tx/signing"
+	"github.com/line/lfb-sdk/x/auth/signing"
+	"github.com/line/lfb-sdk/x/auth/tx/textual"
+)
+
+var _ signing.SignModeHandlerWithContext = signModeTextualHandler{}
+
+// signModeTextualHandler defines the SIGN_MODE_TEXTUAL SignModeHandler. Its
+// sign bytes depend on the bank denom metadata, which is read through the
+// context passed to GetSignBytesWithContext.
+type signModeTextualHandler struct {
+	renderer textual.Renderer
+}
+
+// DefaultMode implements SignModeHandler.DefaultMode
+func (signModeTextualHandler) DefaultMode() signingtypes.SignMode {
+	return signingtypes.SignMode_SIGN_MODE_TEXTUAL
+}
+
+// Modes implements SignModeHandler.Modes
+func (signModeTextualHandler) Modes() []signingtypes.SignMode {
+	return []signingtypes.SignMode{signingtypes.SignMode_SIGN_MODE_TEXTUAL}
+}
+
+// GetSignBytes implements SignModeHandler.GetSignBytes
+func (h signModeTextualHandler) GetSignBytes(mode signingtypes.SignMode, data signing.SignerData, tx sdk.Tx) ([]byte, error) {
+	return h.GetSignBytesWithContext(context.Background(), mode, data, tx)
+}
+
+// GetSignBytesWithContext implements SignModeHandlerWithContext.GetSignBytesWithContext
+func (h signModeTextualHandler) GetSignBytesWithContext(ctx context.Context, mode signingtypes.SignMode, data signing.SignerData, tx sdk.Tx) ([]byte, error) {
+	if mode != signingtypes.SignMode_SIGN_MODE_TEXTUAL {
+		return nil, fmt.Errorf("expected %s, got %s", signingtypes.SignMode_SIGN_MODE_TEXTUAL, mode)
+	}
+
+	protoTx, ok := tx.(*wrapper)
+	if !ok {
+		return nil, fmt.Errorf("can only handle a protobuf Tx, got %T", tx)
+	}
+
+	return h.renderer.GetSignBytes(ctx, textual.TxData{
+		Body:          protoTx.tx.Body,
+		AuthInfo:      protoTx.tx.AuthInfo,
+		BodyBytes:     protoTx.getBodyBytes(),
+		AuthInfoBytes: protoTx.getAuthInfoBytes(),
+	}, data)
+}
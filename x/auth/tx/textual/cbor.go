@@ -0,0 +1,54 @@
+package textual
+
+import (
+	"bytes"
+	"encoding/binary"
+)
+
+// CBOR major types, see RFC 8949.
+const (
+	cborUint  byte = 0
+	cborText  byte = 3
+	cborArray byte = 4
+	cborMap   byte = 5
+
+	cborFalse byte = 0xf4
+	cborTrue  byte = 0xf5
+)
+
+// cborHead writes the head of a CBOR data item of the given major type,
+// using the shortest encoding of n as required by the deterministic encoding
+// of RFC 8949 section 4.2.
+func cborHead(buf *bytes.Buffer, major byte, n uint64) {
+	switch {
+	case n < 24:
+		buf.WriteByte(major<<5 | byte(n))
+	case n <= 0xff:
+		buf.WriteByte(major<<5 | 24)
+		buf.WriteByte(byte(n))
+	case n <= 0xffff:
+		buf.WriteByte(major<<5 | 25)
+		_ = binary.Write(buf, binary.BigEndian, uint16(n))
+	case n <= 0xffffffff:
+		buf.WriteByte(major<<5 | 26)
+		_ = binary.Write(buf, binary.BigEndian, uint32(n))
+	default:
+		buf.WriteByte(major<<5 | 27)
+		_ = binary.Write(buf, binary.BigEndian, n)
+	}
+}
+
+// cborString writes s as a CBOR text string.
+func cborString(buf *bytes.Buffer, s string) {
+	cborHead(buf, cborText, uint64(len(s)))
+	buf.WriteString(s)
+}
+
+// cborBool writes b as a CBOR simple value.
+func cborBool(buf *bytes.Buffer, b bool) {
+	if b {
+		buf.WriteByte(cborTrue)
+	} else {
+		buf.WriteByte(cborFalse)
+	}
+}
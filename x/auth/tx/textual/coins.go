@@ -0,0 +1,149 @@
+package textual
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	gogogrpc "github.com/gogo/protobuf/grpc"
+
+	sdk "github.com/line/lfb-sdk/types"
+	"github.com/line/lfb-sdk/types/query"
+	banktypes "github.com/line/lfb-sdk/x/bank/types"
+)
+
+// CoinMetadataQueryFn returns the bank metadata of a denom, or nil if the
+// denom has none.
+type CoinMetadataQueryFn func(ctx context.Context, denom string) (*banktypes.Metadata, error)
+
+// BankKeeper defines the bank keeper read by NewBankKeeperCoinMetadataQueryFn.
+type BankKeeper interface {
+	GetDenomMetaData(ctx sdk.Context, denom string) banktypes.Metadata
+}
+
+// NewBankKeeperCoinMetadataQueryFn returns the CoinMetadataQueryFn of the
+// nodes, reading the metadata from the bank keeper. The context must wrap an
+// sdk.Context, otherwise an error is returned.
+func NewBankKeeperCoinMetadataQueryFn(bk BankKeeper) CoinMetadataQueryFn {
+	return func(ctx context.Context, denom string) (*banktypes.Metadata, error) {
+		sdkCtx, ok := ctx.Value(sdk.SdkContextKey).(sdk.Context)
+		if !ok {
+			return nil, fmt.Errorf("cannot read the metadata of %s without an sdk.Context", denom)
+		}
+		metadata := bk.GetDenomMetaData(sdkCtx, denom)
+		if metadata.Base == "" {
+			return nil, nil
+		}
+		return &metadata, nil
+	}
+}
+
+// NewGRPCCoinMetadataQueryFn returns the CoinMetadataQueryFn of the clients,
+// querying the metadata through the bank gRPC query service, e.g. with a
+// client.Context.
+func NewGRPCCoinMetadataQueryFn(conn gogogrpc.ClientConn) CoinMetadataQueryFn {
+	return func(ctx context.Context, denom string) (*banktypes.Metadata, error) {
+		queryClient := banktypes.NewQueryClient(conn)
+
+		res, err := queryClient.DenomMetadata(ctx, &banktypes.QueryDenomMetadataRequest{Denom: denom})
+		if err == nil {
+			return &res.Metadata, nil
+		}
+
+		// the error code of a missing metadata is lost on the way through
+		// ABCI, so look for the denom among all the metadata
+		pageReq := &query.PageRequest{}
+		for {
+			res, err := queryClient.DenomsMetadata(ctx, &banktypes.QueryDenomsMetadataRequest{Pagination: pageReq})
+			if err != nil {
+				return nil, err
+			}
+			for _, metadata := range res.Metadatas {
+				if metadata.Base == denom {
+					metadata := metadata
+					return &metadata, nil
+				}
+			}
+			if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+				return nil, nil
+			}
+			pageReq = &query.PageRequest{Key: res.Pagination.NextKey}
+		}
+	}
+}
+
+// formatCoin formats amount, a base 10 number, of denom in the display unit
+// of its bank metadata, e.g. "1.5 ATOM" for 1500000uatom if ATOM is the
+// display unit of uatom with an exponent of 6. It falls back to the base
+// denom if the metadata or its display unit are missing.
+func (r Renderer) formatCoin(ctx context.Context, amount string, denom string) (display string, formatted string, err error) {
+	metadata, err := r.coinMetadata(ctx, denom)
+	if err != nil {
+		return "", "", err
+	}
+
+	if metadata != nil && metadata.Base == denom {
+		var baseExp, displayExp uint32
+		hasDisplay := false
+		for _, unit := range metadata.DenomUnits {
+			if unit.Denom == metadata.Base {
+				baseExp = unit.Exponent
+			}
+			if unit.Denom == metadata.Display {
+				displayExp = unit.Exponent
+				hasDisplay = true
+			}
+		}
+
+		if hasDisplay && displayExp >= baseExp {
+			amount = shiftDecimal(amount, displayExp-baseExp)
+			return metadata.Display, formatDecimal(amount) + " " + metadata.Display, nil
+		}
+	}
+
+	return denom, formatDecimal(amount) + " " + denom, nil
+}
+
+// coin is a coin whose amount is a base 10 number, i.e. either a sdk.Coin or
+// a sdk.DecCoin.
+type coin struct {
+	amount string
+	denom  string
+}
+
+// formatCoins formats coins with formatCoin, sorted by display unit and
+// separated by commas.
+func (r Renderer) formatCoins(ctx context.Context, coins []coin) (string, error) {
+	type formattedCoin struct {
+		display   string
+		formatted string
+	}
+
+	formatted := make([]formattedCoin, len(coins))
+	for i, coin := range coins {
+		display, s, err := r.formatCoin(ctx, coin.amount, coin.denom)
+		if err != nil {
+			return "", err
+		}
+		formatted[i] = formattedCoin{display: display, formatted: s}
+	}
+
+	sort.SliceStable(formatted, func(i, j int) bool {
+		return formatted[i].display < formatted[j].display
+	})
+
+	strs := make([]string, len(formatted))
+	for i, coin := range formatted {
+		strs[i] = coin.formatted
+	}
+	return strings.Join(strs, ", "), nil
+}
+
+// coinMetadata returns the bank metadata of denom, if any.
+func (r Renderer) coinMetadata(ctx context.Context, denom string) (*banktypes.Metadata, error) {
+	if r.coinMetadataQueryFn == nil {
+		return nil, nil
+	}
+	return r.coinMetadataQueryFn(ctx, denom)
+}
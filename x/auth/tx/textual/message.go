@@ -0,0 +1,263 @@
+package textual
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	gogotypes "github.com/gogo/protobuf/types"
+
+	codectypes "github.com/line/lfb-sdk/codec/types"
+	sdk "github.com/line/lfb-sdk/types"
+)
+
+var (
+	anyType       = reflect.TypeOf((*codectypes.Any)(nil))
+	bytesType     = reflect.TypeOf([]byte(nil))
+	stringerType  = reflect.TypeOf((*fmt.Stringer)(nil)).Elem()
+	protoMsgType  = reflect.TypeOf((*proto.Message)(nil)).Elem()
+	durationType  = reflect.TypeOf(time.Duration(0))
+	timestampType = reflect.TypeOf(time.Time{})
+)
+
+// renderAny renders a message packed in an Any: a screen with its type URL,
+// followed by the screens of its fields, indented one level more.
+func (r Renderer) renderAny(ctx context.Context, title string, any *codectypes.Any, indent int) ([]Screen, error) {
+	msg := any.GetCachedValue()
+	if serviceMsg, ok := msg.(sdk.ServiceMsg); ok {
+		msg = serviceMsg.Request
+	}
+	if msg == nil {
+		return nil, fmt.Errorf("cannot render %s: the Any is not unpacked", any.TypeUrl)
+	}
+
+	fields, err := r.renderFields(ctx, reflect.ValueOf(msg), indent+1)
+	if err != nil {
+		return nil, err
+	}
+
+	return append([]Screen{{Title: title, Content: any.TypeUrl, Indent: indent}}, fields...), nil
+}
+
+// renderFields renders the protobuf fields of a message in the order of their
+// declaration, one screen or more per field. The fields holding their default
+// value are not rendered.
+func (r Renderer) renderFields(ctx context.Context, v reflect.Value, indent int) ([]Screen, error) {
+	v = reflect.Indirect(v)
+	if v.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("cannot render the fields of %s", v.Type())
+	}
+
+	var screens []Screen
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field, fv := t.Field(i), v.Field(i)
+
+		// a oneof is an interface holding a pointer to a struct wrapping the
+		// field which is set
+		if _, ok := field.Tag.Lookup("protobuf_oneof"); ok {
+			if fv.IsNil() {
+				continue
+			}
+			wrapper := fv.Elem().Elem()
+			field, fv = wrapper.Type().Field(0), wrapper.Field(0)
+		}
+
+		name, ok := protobufFieldName(field)
+		if !ok || fv.IsZero() {
+			continue
+		}
+
+		fieldScreens, err := r.renderValue(ctx, fieldTitle(name), fv, indent)
+		if err != nil {
+			return nil, err
+		}
+		screens = append(screens, fieldScreens...)
+	}
+
+	return screens, nil
+}
+
+// renderValue renders the value of a field: a screen with its title and
+// content, followed by the screens of its elements or fields for repeated
+// fields and messages.
+func (r Renderer) renderValue(ctx context.Context, title string, v reflect.Value, indent int) ([]Screen, error) {
+	if v.Type() == anyType {
+		return r.renderAny(ctx, title, v.Interface().(*codectypes.Any), indent)
+	}
+
+	if v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return nil, nil
+		}
+		v = v.Elem()
+	}
+
+	content, ok, err := r.formatScalar(ctx, v)
+	if err != nil {
+		return nil, err
+	}
+	if ok {
+		return []Screen{{Title: title, Content: content, Indent: indent}}, nil
+	}
+
+	switch v.Kind() {
+	case reflect.Slice, reflect.Array:
+		n := v.Len()
+		screens := []Screen{{Title: title, Content: pluralize(n, "element"), Indent: indent}}
+		for i := 0; i < n; i++ {
+			elemScreens, err := r.renderValue(ctx, fmt.Sprintf("%s (%d/%d)", title, i+1, n), v.Index(i), indent+1)
+			if err != nil {
+				return nil, err
+			}
+			screens = append(screens, elemScreens...)
+		}
+		return screens, nil
+
+	case reflect.Map:
+		keys := v.MapKeys()
+		sort.Slice(keys, func(i, j int) bool {
+			return fmt.Sprint(keys[i].Interface()) < fmt.Sprint(keys[j].Interface())
+		})
+		screens := []Screen{{Title: title, Content: pluralize(len(keys), "entry"), Indent: indent}}
+		for _, key := range keys {
+			entryScreens, err := r.renderValue(ctx, fmt.Sprintf("%s (%v)", title, key.Interface()), v.MapIndex(key), indent+1)
+			if err != nil {
+				return nil, err
+			}
+			screens = append(screens, entryScreens...)
+		}
+		return screens, nil
+
+	case reflect.Struct:
+		fields, err := r.renderFields(ctx, v, indent+1)
+		if err != nil {
+			return nil, err
+		}
+		return append([]Screen{{Title: title, Content: messageName(v) + " object", Indent: indent}}, fields...), nil
+
+	default:
+		return nil, fmt.Errorf("cannot render field %s of type %s", title, v.Type())
+	}
+}
+
+// formatScalar formats the values rendered on a single screen: coins,
+// addresses, numbers, timestamps, durations, strings, bytes and enums. It
+// returns false for the other values.
+func (r Renderer) formatScalar(ctx context.Context, v reflect.Value) (string, bool, error) {
+	switch value := v.Interface().(type) {
+	case sdk.Coin:
+		_, s, err := r.formatCoin(ctx, value.Amount.String(), value.Denom)
+		return s, true, err
+	case sdk.DecCoin:
+		_, s, err := r.formatCoin(ctx, value.Amount.String(), value.Denom)
+		return s, true, err
+	case sdk.Coins:
+		coins := make([]coin, len(value))
+		for i, c := range value {
+			coins[i] = coin{amount: c.Amount.String(), denom: c.Denom}
+		}
+		s, err := r.formatCoins(ctx, coins)
+		return s, true, err
+	case sdk.DecCoins:
+		coins := make([]coin, len(value))
+		for i, c := range value {
+			coins[i] = coin{amount: c.Amount.String(), denom: c.Denom}
+		}
+		s, err := r.formatCoins(ctx, coins)
+		return s, true, err
+	case sdk.AccAddress, sdk.ValAddress, sdk.ConsAddress:
+		return value.(fmt.Stringer).String(), true, nil
+	case sdk.Int:
+		return formatInteger(value.String()), true, nil
+	case sdk.Uint:
+		return formatInteger(value.String()), true, nil
+	case sdk.Dec:
+		return formatDecimal(value.String()), true, nil
+	case time.Time:
+		return formatTimestamp(value), true, nil
+	case gogotypes.Timestamp:
+		t, err := gogotypes.TimestampFromProto(&value)
+		return formatTimestamp(t), true, err
+	case gogotypes.Duration:
+		d, err := gogotypes.DurationFromProto(&value)
+		return formatDuration(d), true, err
+	}
+
+	switch {
+	case v.Type() == durationType:
+		return formatDuration(time.Duration(v.Int())), true, nil
+	case v.Type() == timestampType:
+		return formatTimestamp(v.Interface().(time.Time)), true, nil
+	case v.Type().ConvertibleTo(bytesType) && v.Kind() == reflect.Slice:
+		return formatBytes(v.Convert(bytesType).Bytes()), true, nil
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		return v.String(), true, nil
+	case reflect.Bool:
+		return formatBool(v.Bool()), true, nil
+	case reflect.Int32:
+		// protobuf enums are int32 with a String method
+		if v.Type().Implements(stringerType) {
+			return v.Interface().(fmt.Stringer).String(), true, nil
+		}
+		return formatInteger(strconv.FormatInt(v.Int(), 10)), true, nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int64:
+		return formatInteger(strconv.FormatInt(v.Int(), 10)), true, nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return formatInteger(strconv.FormatUint(v.Uint(), 10)), true, nil
+	case reflect.Float32, reflect.Float64:
+		return formatDecimal(strconv.FormatFloat(v.Float(), 'f', -1, 64)), true, nil
+	}
+
+	return "", false, nil
+}
+
+// protobufFieldName returns the protobuf name of a field of a message
+// generated by gogoproto, and false for the other fields.
+func protobufFieldName(field reflect.StructField) (string, bool) {
+	tag, ok := field.Tag.Lookup("protobuf")
+	if !ok {
+		return "", false
+	}
+	for _, part := range strings.Split(tag, ",") {
+		if strings.HasPrefix(part, "name=") {
+			return strings.TrimPrefix(part, "name="), true
+		}
+	}
+	return "", false
+}
+
+// messageName returns the short protobuf name of a message, e.g. "Coin" for
+// lfb.base.v1beta1.Coin, or the name of its Go type if it is not a protobuf
+// message.
+func messageName(v reflect.Value) string {
+	ptr := reflect.New(v.Type())
+	ptr.Elem().Set(v)
+
+	if ptr.Type().Implements(protoMsgType) {
+		if name := proto.MessageName(ptr.Interface().(proto.Message)); name != "" {
+			return name[strings.LastIndex(name, ".")+1:]
+		}
+	}
+	return v.Type().Name()
+}
+
+// pluralize returns n followed by noun, in plural form if needed, e.g.
+// "2 elements".
+func pluralize(n int, noun string) string {
+	if n == 1 {
+		return "1 " + noun
+	}
+	if strings.HasSuffix(noun, "y") {
+		return fmt.Sprintf("%d %sies", n, strings.TrimSuffix(noun, "y"))
+	}
+	return fmt.Sprintf("%d %ss", n, noun)
+}
@@ -0,0 +1,80 @@
+package textual
+
+import (
+	"bytes"
+)
+
+// Screen is the unit of display of SIGN_MODE_TEXTUAL: one line of a hardware
+// wallet showing a title and its content.
+type Screen struct {
+	// Title is the text before the colon, if any.
+	Title string
+	// Content is the text after the colon.
+	Content string
+	// Indent is the nesting level of the screen, e.g. the fields of a message
+	// are indented one level more than the message itself.
+	Indent int
+	// Expert screens are only shown by the wallets in expert mode.
+	Expert bool
+}
+
+// Keys of the CBOR maps encoding the sign doc and its screens.
+const (
+	signDocScreensKey = 1
+
+	screenTitleKey   = 1
+	screenContentKey = 2
+	screenIndentKey  = 3
+	screenExpertKey  = 4
+)
+
+// EncodeScreens returns the SIGN_MODE_TEXTUAL sign bytes of screens: the
+// deterministic CBOR encoding of the map {1: [screen, ...]}, each screen
+// being the map {1: title, 2: content, 3: indent, 4: expert} without the
+// entries holding a default value.
+func EncodeScreens(screens []Screen) []byte {
+	var buf bytes.Buffer
+
+	cborHead(&buf, cborMap, 1)
+	cborHead(&buf, cborUint, signDocScreensKey)
+	cborHead(&buf, cborArray, uint64(len(screens)))
+	for _, screen := range screens {
+		screen.encode(&buf)
+	}
+
+	return buf.Bytes()
+}
+
+func (s Screen) encode(buf *bytes.Buffer) {
+	var n uint64
+	if s.Title != "" {
+		n++
+	}
+	if s.Content != "" {
+		n++
+	}
+	if s.Indent > 0 {
+		n++
+	}
+	if s.Expert {
+		n++
+	}
+
+	cborHead(buf, cborMap, n)
+	if s.Title != "" {
+		cborHead(buf, cborUint, screenTitleKey)
+		cborString(buf, s.Title)
+	}
+	if s.Content != "" {
+		cborHead(buf, cborUint, screenContentKey)
+		cborString(buf, s.Content)
+	}
+	if s.Indent > 0 {
+		cborHead(buf, cborUint, screenIndentKey)
+		cborHead(buf, cborUint, uint64(s.Indent))
+	}
+	if s.Expert {
+		cborHead(buf, cborUint, screenExpertKey)
+		cborBool(buf, true)
+	}
+}
@@ -0,0 +1,144 @@
+/*
+Package textual implements the rendering of SIGN_MODE_TEXTUAL.
+
+With SIGN_MODE_TEXTUAL, a transaction is rendered into a list of screens
+meant to be displayed to the signer, e.g. on the small screen of a hardware
+wallet, and the sign bytes are the deterministic CBOR encoding of these
+screens. Each screen has a title, a content, an indentation level and an
+expert flag for the screens which may be hidden to the non-expert users.
+
+The screens start with the signer data, then render each message of the
+TxBody with its fields, followed by the memo, the fees and, in expert mode,
+the other fields of the TxBody and AuthInfo. The last screen holds the hash of
+the raw bytes of the TxBody and AuthInfo, so that the signature covers the
+exact transaction even if two transactions render into the same screens.
+
+The coins are rendered in the display unit of their bank denom metadata if it
+is found with the CoinMetadataQueryFn of the Renderer, and in their base denom
+otherwise. The nodes and the clients must therefore see the same metadata for
+the signatures to be valid.
+*/
+package textual
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"reflect"
+
+	"github.com/line/lfb-sdk/types/tx"
+	"github.com/line/lfb-sdk/x/auth/signing"
+)
+
+// Renderer renders the transactions into SIGN_MODE_TEXTUAL screens.
+type Renderer struct {
+	coinMetadataQueryFn CoinMetadataQueryFn
+}
+
+// NewRenderer returns a Renderer reading the bank denom metadata with
+// coinMetadataQueryFn. If coinMetadataQueryFn is nil, the coins are rendered
+// in their base denom.
+func NewRenderer(coinMetadataQueryFn CoinMetadataQueryFn) Renderer {
+	return Renderer{coinMetadataQueryFn: coinMetadataQueryFn}
+}
+
+// TxData is the transaction rendered by a Renderer: its decoded TxBody and
+// AuthInfo, and their raw bytes as signed.
+type TxData struct {
+	Body          *tx.TxBody
+	AuthInfo      *tx.AuthInfo
+	BodyBytes     []byte
+	AuthInfoBytes []byte
+}
+
+// GetSignBytes returns the SIGN_MODE_TEXTUAL sign bytes of a transaction,
+// the CBOR encoding of its screens.
+func (r Renderer) GetSignBytes(ctx context.Context, data TxData, signerData signing.SignerData) ([]byte, error) {
+	screens, err := r.Render(ctx, data, signerData)
+	if err != nil {
+		return nil, err
+	}
+	return EncodeScreens(screens), nil
+}
+
+// Render renders a transaction signed with signerData into screens.
+func (r Renderer) Render(ctx context.Context, data TxData, signerData signing.SignerData) ([]Screen, error) {
+	if data.Body == nil || data.AuthInfo == nil {
+		return nil, fmt.Errorf("missing TxBody or AuthInfo")
+	}
+
+	screens := []Screen{
+		{Title: "Chain id", Content: signerData.ChainID},
+		{Title: "Account number", Content: formatInteger(fmt.Sprint(signerData.AccountNumber))},
+		{Title: "Sequence", Content: formatInteger(fmt.Sprint(signerData.Sequence))},
+	}
+
+	msgs := data.Body.Messages
+	screens = append(screens, Screen{Content: fmt.Sprintf("This transaction has %s", pluralize(len(msgs), "Message"))})
+	for i, msg := range msgs {
+		msgScreens, err := r.renderAny(ctx, fmt.Sprintf("Message (%d/%d)", i+1, len(msgs)), msg, 1)
+		if err != nil {
+			return nil, err
+		}
+		screens = append(screens, msgScreens...)
+	}
+	screens = append(screens, Screen{Content: "End of Messages"})
+
+	if data.Body.Memo != "" {
+		screens = append(screens, Screen{Title: "Memo", Content: data.Body.Memo})
+	}
+
+	fee := data.AuthInfo.Fee
+	if fee == nil {
+		fee = &tx.Fee{}
+	}
+	if !fee.Amount.Empty() {
+		feeScreens, err := r.renderValue(ctx, "Fees", reflect.ValueOf(fee.Amount), 0)
+		if err != nil {
+			return nil, err
+		}
+		screens = append(screens, feeScreens...)
+	}
+
+	expert := []Screen{}
+	if fee.Payer != "" {
+		expert = append(expert, Screen{Title: "Fee payer", Content: fee.Payer})
+	}
+	if fee.Granter != "" {
+		expert = append(expert, Screen{Title: "Fee granter", Content: fee.Granter})
+	}
+	if fee.GasLimit != 0 {
+		expert = append(expert, Screen{Title: "Gas limit", Content: formatInteger(fmt.Sprint(fee.GasLimit))})
+	}
+	if data.Body.TimeoutHeight != 0 {
+		expert = append(expert, Screen{Title: "Timeout height", Content: formatInteger(fmt.Sprint(data.Body.TimeoutHeight))})
+	}
+	if data.Body.SigBlockHeight != 0 {
+		expert = append(expert, Screen{Title: "Sig block height", Content: formatInteger(fmt.Sprint(data.Body.SigBlockHeight))})
+	}
+	for i, opt := range data.Body.ExtensionOptions {
+		expert = append(expert, Screen{
+			Title:   fmt.Sprintf("Extension option (%d/%d)", i+1, len(data.Body.ExtensionOptions)),
+			Content: opt.TypeUrl,
+		})
+	}
+
+	signDoc := tx.SignDoc{
+		BodyBytes:     data.BodyBytes,
+		AuthInfoBytes: data.AuthInfoBytes,
+		ChainId:       signerData.ChainID,
+		AccountNumber: signerData.AccountNumber,
+	}
+	bz, err := signDoc.Marshal()
+	if err != nil {
+		return nil, err
+	}
+	hash := sha256.Sum256(bz)
+	expert = append(expert, Screen{Title: "Hash of raw bytes", Content: formatBytes(hash[:])})
+
+	for i := range expert {
+		expert[i].Expert = true
+	}
+
+	return append(screens, expert...), nil
+}
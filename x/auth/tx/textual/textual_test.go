@@ -0,0 +1,165 @@
+package textual_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	codectypes "github.com/line/lfb-sdk/codec/types"
+	sdk "github.com/line/lfb-sdk/types"
+	"github.com/line/lfb-sdk/types/tx"
+	"github.com/line/lfb-sdk/x/auth/signing"
+	"github.com/line/lfb-sdk/x/auth/tx/textual"
+	banktypes "github.com/line/lfb-sdk/x/bank/types"
+)
+
+const (
+	fromAddr = "link1qyqszqgpqyqszqgpqyqszqgpqyqszqgp8apuk5"
+	toAddr   = "link1zgfpyysjzgfpyysjzgfpyysjzgfpyysjhucg0g"
+)
+
+var signerData = signing.SignerData{ChainID: "test-chain", AccountNumber: 1234, Sequence: 5}
+
+func atomMetadataQueryFn(_ context.Context, denom string) (*banktypes.Metadata, error) {
+	if denom != "uatom" {
+		return nil, nil
+	}
+	return &banktypes.Metadata{
+		DenomUnits: []*banktypes.DenomUnit{{Denom: "uatom"}, {Denom: "ATOM", Exponent: 6}},
+		Base:       "uatom",
+		Display:    "ATOM",
+	}, nil
+}
+
+func newTxData(t *testing.T, msgs ...sdk.Msg) textual.TxData {
+	anys := make([]*codectypes.Any, len(msgs))
+	for i, msg := range msgs {
+		any, err := codectypes.NewAnyWithValue(msg)
+		require.NoError(t, err)
+		anys[i] = any
+	}
+
+	data := textual.TxData{
+		Body: &tx.TxBody{Messages: anys, Memo: "memo", SigBlockHeight: 10},
+		AuthInfo: &tx.AuthInfo{Fee: &tx.Fee{
+			Amount:   sdk.NewCoins(sdk.NewInt64Coin("uatom", 2500), sdk.NewInt64Coin("stake", 1000)),
+			GasLimit: 200000,
+		}},
+	}
+
+	var err error
+	data.BodyBytes, err = data.Body.Marshal()
+	require.NoError(t, err)
+	data.AuthInfoBytes, err = data.AuthInfo.Marshal()
+	require.NoError(t, err)
+
+	return data
+}
+
+func TestRender(t *testing.T) {
+	msg := &banktypes.MsgSend{
+		FromAddress: fromAddr,
+		ToAddress:   toAddr,
+		Amount:      sdk.NewCoins(sdk.NewInt64Coin("uatom", 1500000)),
+	}
+	data := newTxData(t, msg)
+
+	screens, err := textual.NewRenderer(atomMetadataQueryFn).Render(context.Background(), data, signerData)
+	require.NoError(t, err)
+
+	hash := screens[len(screens)-1]
+	require.Equal(t, "Hash of raw bytes", hash.Title)
+	require.True(t, hash.Expert)
+	require.Len(t, hash.Content, 64)
+
+	require.Equal(t, []textual.Screen{
+		{Title: "Chain id", Content: "test-chain"},
+		{Title: "Account number", Content: "1'234"},
+		{Title: "Sequence", Content: "5"},
+		{Content: "This transaction has 1 Message"},
+		{Title: "Message (1/1)", Content: "/lfb.bank.v1beta1.MsgSend", Indent: 1},
+		{Title: "From address", Content: fromAddr, Indent: 2},
+		{Title: "To address", Content: toAddr, Indent: 2},
+		{Title: "Amount", Content: "1.5 ATOM", Indent: 2},
+		{Content: "End of Messages"},
+		{Title: "Memo", Content: "memo"},
+		{Title: "Fees", Content: "0.0025 ATOM, 1'000 stake"},
+		{Title: "Gas limit", Content: "200'000", Expert: true},
+		{Title: "Sig block height", Content: "10", Expert: true},
+		hash,
+	}, screens)
+
+	// without metadata, the coins are rendered in their base denom
+	screens, err = textual.NewRenderer(nil).Render(context.Background(), data, signerData)
+	require.NoError(t, err)
+	require.Equal(t, textual.Screen{Title: "Amount", Content: "1'500'000 uatom", Indent: 2}, screens[7])
+	require.Equal(t, textual.Screen{Title: "Fees", Content: "1'000 stake, 2'500 uatom"}, screens[10])
+}
+
+func TestRenderNestedMessages(t *testing.T) {
+	msg := &banktypes.MsgMultiSend{
+		Inputs: []banktypes.Input{
+			{Address: fromAddr, Coins: sdk.NewCoins(sdk.NewInt64Coin("uatom", 10))},
+		},
+		Outputs: []banktypes.Output{
+			{Address: toAddr, Coins: sdk.NewCoins(sdk.NewInt64Coin("uatom", 4))},
+			{Address: fromAddr, Coins: sdk.NewCoins(sdk.NewInt64Coin("uatom", 6))},
+		},
+	}
+
+	screens, err := textual.NewRenderer(nil).Render(context.Background(), newTxData(t, msg), signerData)
+	require.NoError(t, err)
+	require.Equal(t, []textual.Screen{
+		{Title: "Message (1/1)", Content: "/lfb.bank.v1beta1.MsgMultiSend", Indent: 1},
+		{Title: "Inputs", Content: "1 element", Indent: 2},
+		{Title: "Inputs (1/1)", Content: "Input object", Indent: 3},
+		{Title: "Address", Content: fromAddr, Indent: 4},
+		{Title: "Coins", Content: "10 uatom", Indent: 4},
+		{Title: "Outputs", Content: "2 elements", Indent: 2},
+		{Title: "Outputs (1/2)", Content: "Output object", Indent: 3},
+		{Title: "Address", Content: toAddr, Indent: 4},
+		{Title: "Coins", Content: "4 uatom", Indent: 4},
+		{Title: "Outputs (2/2)", Content: "Output object", Indent: 3},
+		{Title: "Address", Content: fromAddr, Indent: 4},
+		{Title: "Coins", Content: "6 uatom", Indent: 4},
+	}, screens[4:16])
+}
+
+func TestGetSignBytes(t *testing.T) {
+	msg := &banktypes.MsgSend{
+		FromAddress: fromAddr,
+		ToAddress:   toAddr,
+		Amount:      sdk.NewCoins(sdk.NewInt64Coin("uatom", 1500000)),
+	}
+	data := newTxData(t, msg)
+	renderer := textual.NewRenderer(atomMetadataQueryFn)
+
+	bz1, err := renderer.GetSignBytes(context.Background(), data, signerData)
+	require.NoError(t, err)
+	bz2, err := renderer.GetSignBytes(context.Background(), data, signerData)
+	require.NoError(t, err)
+	require.Equal(t, bz1, bz2)
+
+	// the sign bytes depend on the metadata
+	bz3, err := textual.NewRenderer(nil).GetSignBytes(context.Background(), data, signerData)
+	require.NoError(t, err)
+	require.NotEqual(t, bz1, bz3)
+
+	// and on the signer data
+	bz4, err := renderer.GetSignBytes(context.Background(), data, signing.SignerData{ChainID: "test-chain", AccountNumber: 1234, Sequence: 6})
+	require.NoError(t, err)
+	require.NotEqual(t, bz1, bz4)
+
+	// the errors of the metadata queries are returned
+	_, err = textual.NewRenderer(func(context.Context, string) (*banktypes.Metadata, error) {
+		return nil, errors.New("query failed")
+	}).GetSignBytes(context.Background(), data, signerData)
+	require.EqualError(t, err, "query failed")
+
+	// messages must be unpacked
+	data.Body.Messages[0] = &codectypes.Any{TypeUrl: data.Body.Messages[0].TypeUrl, Value: data.Body.Messages[0].Value}
+	_, err = renderer.GetSignBytes(context.Background(), data, signerData)
+	require.Error(t, err)
+}
@@ -0,0 +1,110 @@
+package textual
+
+import (
+	"encoding/hex"
+	"strings"
+	"time"
+)
+
+// thousandSeparator groups the digits of the integer part of the numbers.
+const thousandSeparator = "'"
+
+// formatInteger formats a base 10 integer, grouping its digits by three, e.g.
+// "-1234567" is rendered as "-1'234'567".
+func formatInteger(s string) string {
+	sign := ""
+	if strings.HasPrefix(s, "-") {
+		sign, s = "-", s[1:]
+	}
+
+	s = strings.TrimLeft(s, "0")
+	if s == "" {
+		return "0"
+	}
+
+	var groups []string
+	for len(s) > 3 {
+		groups = append([]string{s[len(s)-3:]}, groups...)
+		s = s[:len(s)-3]
+	}
+	groups = append([]string{s}, groups...)
+
+	return sign + strings.Join(groups, thousandSeparator)
+}
+
+// formatDecimal formats a base 10 decimal number, grouping the digits of its
+// integer part by three and dropping the trailing zeros of its fractional
+// part, e.g. "1234.500" is rendered as "1'234.5".
+func formatDecimal(s string) string {
+	sign := ""
+	if strings.HasPrefix(s, "-") {
+		sign, s = "-", s[1:]
+	}
+
+	intPart, fracPart := s, ""
+	if i := strings.IndexByte(s, '.'); i >= 0 {
+		intPart, fracPart = s[:i], strings.TrimRight(s[i+1:], "0")
+	}
+
+	formatted := formatInteger(intPart)
+	if fracPart != "" {
+		formatted += "." + fracPart
+	}
+	if formatted == "0" {
+		return formatted
+	}
+	return sign + formatted
+}
+
+// shiftDecimal divides the base 10 decimal number s by 10^n.
+func shiftDecimal(s string, n uint32) string {
+	sign := ""
+	if strings.HasPrefix(s, "-") {
+		sign, s = "-", s[1:]
+	}
+
+	intPart, fracPart := s, ""
+	if i := strings.IndexByte(s, '.'); i >= 0 {
+		intPart, fracPart = s[:i], s[i+1:]
+	}
+
+	if pad := int(n) + 1 - len(intPart); pad > 0 {
+		intPart = strings.Repeat("0", pad) + intPart
+	}
+	point := len(intPart) - int(n)
+
+	return sign + intPart[:point] + "." + intPart[point:] + fracPart
+}
+
+// formatTimestamp formats a timestamp in RFC 3339 format, in UTC.
+func formatTimestamp(t time.Time) string {
+	return t.UTC().Format(time.RFC3339Nano)
+}
+
+// formatDuration formats a duration, e.g. "72h3m0.5s".
+func formatDuration(d time.Duration) string {
+	return d.String()
+}
+
+// formatBytes formats bytes as uppercase hexadecimal.
+func formatBytes(bz []byte) string {
+	return strings.ToUpper(hex.EncodeToString(bz))
+}
+
+// formatBool formats a boolean as "True" or "False".
+func formatBool(b bool) string {
+	if b {
+		return "True"
+	}
+	return "False"
+}
+
+// fieldTitle returns the title of the screen of a protobuf field, e.g.
+// "From address" for from_address.
+func fieldTitle(name string) string {
+	title := strings.ReplaceAll(name, "_", " ")
+	if title == "" {
+		return title
+	}
+	return strings.ToUpper(title[:1]) + title[1:]
+}
@@ -0,0 +1,74 @@
+package textual
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFormatInteger(t *testing.T) {
+	cases := map[string]string{
+		"0":        "0",
+		"000":      "0",
+		"12":       "12",
+		"123":      "123",
+		"1234":     "1'234",
+		"1234567":  "1'234'567",
+		"-1234567": "-1'234'567",
+	}
+	for in, out := range cases {
+		require.Equal(t, out, formatInteger(in), in)
+	}
+}
+
+func TestFormatDecimal(t *testing.T) {
+	cases := map[string]string{
+		"0.000000000000000000":    "0",
+		"-0.000":                  "0",
+		"1234.500000000000000000": "1'234.5",
+		"-1234.05":                "-1'234.05",
+		"0.001":                   "0.001",
+		"1000":                    "1'000",
+	}
+	for in, out := range cases {
+		require.Equal(t, out, formatDecimal(in), in)
+	}
+}
+
+func TestShiftDecimal(t *testing.T) {
+	require.Equal(t, "1.500000", shiftDecimal("1500000", 6))
+	require.Equal(t, "0.000015", shiftDecimal("15", 6))
+	require.Equal(t, "0.00001525", shiftDecimal("15.25", 6))
+	require.Equal(t, "-0.15", shiftDecimal("-15", 2))
+	require.Equal(t, "1'500", formatDecimal(shiftDecimal("1500000000", 6)))
+}
+
+func TestFormatValues(t *testing.T) {
+	ts := time.Date(2021, 3, 4, 5, 6, 7, 800, time.FixedZone("KST", 9*60*60))
+	require.Equal(t, "2021-03-03T20:06:07.0000008Z", formatTimestamp(ts))
+	require.Equal(t, "72h3m0.5s", formatDuration(72*time.Hour+3*time.Minute+500*time.Millisecond))
+	require.Equal(t, "00AB", formatBytes([]byte{0x00, 0xab}))
+	require.Equal(t, "True", formatBool(true))
+	require.Equal(t, "False", formatBool(false))
+	require.Equal(t, "From address", fieldTitle("from_address"))
+	require.Equal(t, "", fieldTitle(""))
+}
+
+func TestEncodeScreens(t *testing.T) {
+	bz := EncodeScreens([]Screen{
+		{Title: "Chain id", Content: "a"},
+		{Content: "b", Indent: 1, Expert: true},
+	})
+
+	require.Equal(t, []byte{
+		0xa1, 0x01, 0x82, // {1: [
+		0xa2, // {
+		0x01, 0x68, 'C', 'h', 'a', 'i', 'n', ' ', 'i', 'd', // 1: "Chain id",
+		0x02, 0x61, 'a', // 2: "a"}
+		0xa3,            // {
+		0x02, 0x61, 'b', // 2: "b",
+		0x03, 0x01, // 3: 1,
+		0x04, 0xf5, // 4: true}
+	}, bz)
+}
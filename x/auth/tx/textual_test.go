@@ -0,0 +1,67 @@
+package tx
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/line/lfb-sdk/codec"
+	codectypes "github.com/line/lfb-sdk/codec/types"
+	"github.com/line/lfb-sdk/testutil/testdata"
+	sdk "github.com/line/lfb-sdk/types"
+	signingtypes "github.com/line/lfb-sdk/types/tx/signing"
+	"github.com/line/lfb-sdk/x/auth/legacy/legacytx"
+	"github.com/line/lfb-sdk/x/auth/signing"
+	"github.com/line/lfb-sdk/x/auth/tx/textual"
+)
+
+func TestTextualModeHandler(t *testing.T) {
+	_, _, addr := testdata.KeyTestPubAddr()
+	interfaceRegistry := codectypes.NewInterfaceRegistry()
+	interfaceRegistry.RegisterImplementations((*sdk.Msg)(nil), &testdata.TestMsg{})
+	marshaler := codec.NewProtoCodec(interfaceRegistry)
+
+	txConfig := NewTxConfig(marshaler, []signingtypes.SignMode{signingtypes.SignMode_SIGN_MODE_TEXTUAL})
+	txBuilder := txConfig.NewTxBuilder()
+	require.NoError(t, txBuilder.SetMsgs(testdata.NewTestMsg(addr)))
+	txBuilder.SetMemo("sometestmemo")
+	txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin("atom", 150)))
+	txBuilder.SetGasLimit(20000)
+
+	t.Log("verify modes and default-mode")
+	modeHandler := txConfig.SignModeHandler()
+	require.Equal(t, signingtypes.SignMode_SIGN_MODE_TEXTUAL, modeHandler.DefaultMode())
+	require.Len(t, modeHandler.Modes(), 1)
+
+	signingData := signing.SignerData{
+		ChainID:       "test-chain",
+		AccountNumber: 1,
+		Sequence:      2,
+	}
+
+	t.Log("verify the sign bytes are the encoded screens")
+	signBytes, err := modeHandler.GetSignBytes(signingtypes.SignMode_SIGN_MODE_TEXTUAL, signingData, txBuilder.GetTx())
+	require.NoError(t, err)
+
+	protoTx := txBuilder.(*wrapper)
+	expectedSignBytes, err := textual.NewRenderer(nil).GetSignBytes(context.Background(), textual.TxData{
+		Body:          protoTx.tx.Body,
+		AuthInfo:      protoTx.tx.AuthInfo,
+		BodyBytes:     protoTx.getBodyBytes(),
+		AuthInfoBytes: protoTx.getAuthInfoBytes(),
+	}, signingData)
+	require.NoError(t, err)
+	require.Equal(t, expectedSignBytes, signBytes)
+
+	signBytesWithContext, err := signing.GetSignBytesWithContext(context.Background(), modeHandler, signingtypes.SignMode_SIGN_MODE_TEXTUAL, signingData, txBuilder.GetTx())
+	require.NoError(t, err)
+	require.Equal(t, signBytes, signBytesWithContext)
+
+	t.Log("verify GetSignBytes with a wrong sign mode or tx")
+	textualHandler := signModeTextualHandler{}
+	_, err = textualHandler.GetSignBytes(signingtypes.SignMode_SIGN_MODE_DIRECT, signingData, txBuilder.GetTx())
+	require.Error(t, err)
+	_, err = textualHandler.GetSignBytes(signingtypes.SignMode_SIGN_MODE_TEXTUAL, signingData, legacytx.StdTx{})
+	require.Error(t, err)
+}
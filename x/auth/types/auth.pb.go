@@ -113,6 +113,9 @@ type Params struct {
 	TxSizeCostPerByte      uint64 `protobuf:"varint,3,opt,name=tx_size_cost_per_byte,json=txSizeCostPerByte,proto3" json:"tx_size_cost_per_byte,omitempty" yaml:"tx_size_cost_per_byte"`
 	SigVerifyCostED25519   uint64 `protobuf:"varint,4,opt,name=sig_verify_cost_ed25519,json=sigVerifyCostEd25519,proto3" json:"sig_verify_cost_ed25519,omitempty" yaml:"sig_verify_cost_ed25519"`
 	SigVerifyCostSecp256k1 uint64 `protobuf:"varint,5,opt,name=sig_verify_cost_secp256k1,json=sigVerifyCostSecp256k1,proto3" json:"sig_verify_cost_secp256k1,omitempty" yaml:"sig_verify_cost_secp256k1"`
+	// sig_block_height_window is the number of blocks a tx signed at a block height is valid for under sig block
+	// height replay protection.
+	SigBlockHeightWindow uint64 `protobuf:"varint,6,opt,name=sig_block_height_window,json=sigBlockHeightWindow,proto3" json:"sig_block_height_window,omitempty" yaml:"sig_block_height_window"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -182,6 +185,13 @@ func (m *Params) GetSigVerifyCostSecp256k1() uint64 {
 	return 0
 }
 
+func (m *Params) GetSigBlockHeightWindow() uint64 {
+	if m != nil {
+		return m.SigBlockHeightWindow
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*BaseAccount)(nil), "lfb.auth.v1beta1.BaseAccount")
 	proto.RegisterType((*ModuleAccount)(nil), "lfb.auth.v1beta1.ModuleAccount")
@@ -191,50 +201,52 @@ func init() {
 func init() { proto.RegisterFile("lfb/auth/v1beta1/auth.proto", fileDescriptor_f89657c3058cd869) }
 
 var fileDescriptor_f89657c3058cd869 = []byte{
-	// 678 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x54, 0x3d, 0x4f, 0xdb, 0x5a,
-	0x18, 0x8e, 0x6f, 0x72, 0xf9, 0x38, 0x01, 0x74, 0x31, 0x01, 0x9c, 0x70, 0xaf, 0x1d, 0x59, 0x77,
-	0xc8, 0xd0, 0xd8, 0x4a, 0x2a, 0x2a, 0x91, 0x01, 0x15, 0xd3, 0x0e, 0xa8, 0x05, 0x21, 0x23, 0x75,
-	0xa8, 0x5a, 0x59, 0xc7, 0xce, 0x89, 0xb1, 0xf0, 0xf1, 0x31, 0x3e, 0xc7, 0x28, 0xe6, 0x17, 0x74,
-	0xec, 0xd8, 0x91, 0x1f, 0xc1, 0x0f, 0xe8, 0xd8, 0x11, 0x31, 0x75, 0x72, 0xab, 0xb0, 0x54, 0x1d,
-	0xb3, 0x57, 0xaa, 0xe2, 0xe3, 0x84, 0x04, 0xd1, 0xed, 0x3c, 0xef, 0xf3, 0xbc, 0xcf, 0xfb, 0x95,
-	0x18, 0x6c, 0xf9, 0x3d, 0x5b, 0x87, 0x31, 0x3b, 0xd5, 0x2f, 0x5a, 0x36, 0x62, 0xb0, 0x95, 0x01,
-	0x2d, 0x8c, 0x08, 0x23, 0xe2, 0x3f, 0x7e, 0xcf, 0xd6, 0x32, 0x9c, 0x93, 0xb5, 0xaa, 0x43, 0x28,
-	0x26, 0xd4, 0xca, 0x78, 0x9d, 0x03, 0x2e, 0xae, 0x55, 0x5c, 0xe2, 0x12, 0x1e, 0x1f, 0xbd, 0xf2,
-	0x68, 0xd5, 0x25, 0xc4, 0xf5, 0x91, 0x9e, 0x21, 0x3b, 0xee, 0xe9, 0x30, 0x48, 0x38, 0xa5, 0xfe,
-	0x12, 0x40, 0xd9, 0x80, 0x14, 0xed, 0x39, 0x0e, 0x89, 0x03, 0x26, 0x4a, 0x60, 0x1e, 0x76, 0xbb,
-	0x11, 0xa2, 0x54, 0x12, 0xea, 0x42, 0x63, 0xd1, 0x1c, 0x43, 0xf1, 0x1d, 0x98, 0x0f, 0x63, 0xdb,
-	0x3a, 0x43, 0x89, 0xf4, 0x57, 0x5d, 0x68, 0x94, 0xdb, 0x15, 0x8d, 0xdb, 0x6a, 0x63, 0x5b, 0x6d,
-	0x2f, 0x48, 0x8c, 0xe6, 0xcf, 0x54, 0xa9, 0x84, 0xb1, 0xed, 0x7b, 0xce, 0x48, 0xfb, 0x84, 0x60,
-	0x8f, 0x21, 0x1c, 0xb2, 0x64, 0x98, 0x2a, 0xab, 0x09, 0xc4, 0x7e, 0x47, 0xbd, 0x67, 0x55, 0x73,
-	0x2e, 0x8c, 0xed, 0x57, 0x28, 0x11, 0x9f, 0x83, 0x15, 0xc8, 0x5b, 0xb0, 0x82, 0x18, 0xdb, 0x28,
-	0x92, 0x8a, 0x75, 0xa1, 0x51, 0x32, 0xaa, 0xc3, 0x54, 0x59, 0xe7, 0x69, 0xb3, 0xbc, 0x6a, 0x2e,
-	0xe7, 0x81, 0xa3, 0x0c, 0x8b, 0x35, 0xb0, 0x40, 0xd1, 0x79, 0x8c, 0x02, 0x07, 0x49, 0xa5, 0x51,
-	0xae, 0x39, 0xc1, 0x1d, 0xe9, 0xc3, 0x95, 0x52, 0xf8, 0x74, 0xa5, 0x14, 0x7e, 0x5c, 0x29, 0x85,
-	0xdb, 0xeb, 0xe6, 0x42, 0x3e, 0xee, 0x81, 0xfa, 0x59, 0x00, 0xcb, 0x87, 0xa4, 0x1b, 0xfb, 0x93,
-	0x0d, 0xbc, 0x07, 0x4b, 0x36, 0xa4, 0xc8, 0xca, 0xdd, 0xb3, 0x35, 0x94, 0xdb, 0xff, 0x69, 0x0f,
-	0xcf, 0xa0, 0x4d, 0xad, 0xcd, 0xd8, 0xba, 0x49, 0x15, 0x61, 0x98, 0x2a, 0x6b, 0xbc, 0xd5, 0x69,
-	0x03, 0xd5, 0x2c, 0xdb, 0x53, 0x0b, 0x16, 0x41, 0x29, 0x80, 0x18, 0x65, 0x3b, 0x5c, 0x34, 0xb3,
-	0xb7, 0x58, 0x07, 0xe5, 0x10, 0x45, 0xd8, 0xa3, 0xd4, 0x23, 0x01, 0x95, 0x8a, 0xf5, 0x62, 0x63,
-	0xd1, 0x9c, 0x0e, 0x75, 0x6a, 0xe3, 0x01, 0x6e, 0xaf, 0x9b, 0x2b, 0x33, 0xfd, 0x1e, 0xa8, 0xdf,
-	0x8a, 0x60, 0xee, 0x18, 0x46, 0x10, 0x53, 0xf1, 0x08, 0xac, 0x61, 0xd8, 0xb7, 0x30, 0xc2, 0xc4,
-	0x72, 0x4e, 0x61, 0x04, 0x1d, 0x86, 0x22, 0x7e, 0xc9, 0x92, 0x21, 0x0f, 0x53, 0xa5, 0xc6, 0xfb,
-	0x7b, 0x44, 0xa4, 0x9a, 0xab, 0x18, 0xf6, 0x0f, 0x11, 0x26, 0xfb, 0x93, 0x98, 0xb8, 0x03, 0x96,
-	0x58, 0xdf, 0xa2, 0x9e, 0x6b, 0xf9, 0x1e, 0xf6, 0x58, 0xd6, 0x74, 0xc9, 0xd8, 0xbc, 0x1f, 0x74,
-	0x9a, 0x55, 0x4d, 0xc0, 0xfa, 0x27, 0x9e, 0xfb, 0x7a, 0x04, 0x44, 0x13, 0xac, 0x67, 0xe4, 0x25,
-	0xb2, 0x1c, 0x42, 0x99, 0x15, 0xa2, 0xc8, 0xb2, 0x13, 0x86, 0xf2, 0xbb, 0xd6, 0x87, 0xa9, 0xf2,
-	0xef, 0x94, 0xc7, 0x43, 0x99, 0x6a, 0xae, 0x8e, 0xcc, 0x2e, 0xd1, 0x3e, 0xa1, 0xec, 0x18, 0x45,
-	0x46, 0xc2, 0x90, 0x78, 0x0e, 0x36, 0x47, 0xd5, 0x2e, 0x50, 0xe4, 0xf5, 0x12, 0xae, 0x47, 0xdd,
-	0xf6, 0xf6, 0x76, 0x6b, 0x87, 0x5f, 0xdc, 0xe8, 0x0c, 0x52, 0xa5, 0x72, 0xe2, 0xb9, 0x6f, 0x32,
-	0xc5, 0x28, 0xf5, 0xe5, 0x8b, 0x8c, 0x1f, 0xa6, 0x8a, 0xcc, 0xab, 0xfd, 0xc1, 0x40, 0x35, 0x2b,
-	0x74, 0x26, 0x8f, 0x87, 0xc5, 0x04, 0x54, 0x1f, 0x66, 0x50, 0xe4, 0x84, 0xed, 0xed, 0x67, 0x67,
-	0x2d, 0xe9, 0xef, 0xac, 0xe8, 0xee, 0x20, 0x55, 0x36, 0x66, 0x8a, 0x9e, 0x8c, 0x15, 0xc3, 0x54,
-	0xa9, 0x3f, 0x5e, 0x76, 0x62, 0xa2, 0x9a, 0x1b, 0xf4, 0xd1, 0xdc, 0xce, 0x42, 0xfe, 0x83, 0x15,
-	0x8c, 0xdd, 0x2f, 0x03, 0x59, 0xb8, 0x19, 0xc8, 0xc2, 0xf7, 0x81, 0x2c, 0x7c, 0xbc, 0x93, 0x0b,
-	0x37, 0x77, 0x72, 0xe1, 0xeb, 0x9d, 0x5c, 0x78, 0xfb, 0xbf, 0xeb, 0xb1, 0xd3, 0xd8, 0xd6, 0x1c,
-	0x82, 0x75, 0xdf, 0x0b, 0x90, 0xee, 0xf7, 0xec, 0x26, 0xed, 0x9e, 0xe9, 0x7d, 0xfe, 0x3d, 0x61,
-	0x49, 0x88, 0xa8, 0x3d, 0x97, 0xfd, 0x43, 0x9f, 0xfe, 0x0e, 0x00, 0x00, 0xff, 0xff, 0xdb, 0x9e,
-	0xd5, 0x94, 0x68, 0x04, 0x00, 0x00,
+	// 714 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x54, 0xcf, 0x4f, 0xdb, 0x48,
+	0x18, 0x8d, 0x97, 0x6c, 0x80, 0x09, 0xa0, 0xc5, 0x04, 0x70, 0xc2, 0xae, 0x1d, 0x59, 0x7b, 0xc8,
+	0x61, 0x63, 0x2b, 0x59, 0xb1, 0x12, 0x39, 0xa0, 0xc5, 0xec, 0x4a, 0x45, 0x2d, 0x08, 0x19, 0xa9,
+	0x55, 0xab, 0x56, 0x96, 0xed, 0x4c, 0x1c, 0x2b, 0xb6, 0xc7, 0x78, 0xc6, 0x10, 0xf3, 0x17, 0xf4,
+	0xd8, 0x63, 0x8f, 0xfc, 0x11, 0x9c, 0x7a, 0xea, 0xb1, 0x47, 0xc4, 0xa9, 0x27, 0xab, 0x0a, 0x97,
+	0xaa, 0xc7, 0xdc, 0x2b, 0x55, 0x9e, 0x49, 0x42, 0x82, 0xd2, 0x9b, 0xbf, 0xf7, 0xde, 0xf7, 0xbe,
+	0x5f, 0x1a, 0x83, 0x1d, 0xaf, 0x63, 0xa9, 0x66, 0x4c, 0xba, 0xea, 0x45, 0xc3, 0x82, 0xc4, 0x6c,
+	0xd0, 0x40, 0x09, 0x23, 0x44, 0x10, 0xff, 0x9b, 0xd7, 0xb1, 0x14, 0x1a, 0x8f, 0xc8, 0x4a, 0xd9,
+	0x46, 0xd8, 0x47, 0xd8, 0xa0, 0xbc, 0xca, 0x02, 0x26, 0xae, 0x94, 0x1c, 0xe4, 0x20, 0x86, 0x67,
+	0x5f, 0x23, 0xb4, 0xec, 0x20, 0xe4, 0x78, 0x50, 0xa5, 0x91, 0x15, 0x77, 0x54, 0x33, 0x48, 0x18,
+	0x25, 0x7f, 0xe7, 0x40, 0x51, 0x33, 0x31, 0x3c, 0xb0, 0x6d, 0x14, 0x07, 0x84, 0x17, 0xc0, 0xa2,
+	0xd9, 0x6e, 0x47, 0x10, 0x63, 0x81, 0xab, 0x72, 0xb5, 0x65, 0x7d, 0x1c, 0xf2, 0xaf, 0xc1, 0x62,
+	0x18, 0x5b, 0x46, 0x0f, 0x26, 0xc2, 0x2f, 0x55, 0xae, 0x56, 0x6c, 0x96, 0x14, 0x66, 0xab, 0x8c,
+	0x6d, 0x95, 0x83, 0x20, 0xd1, 0xea, 0xdf, 0x52, 0xa9, 0x14, 0xc6, 0x96, 0xe7, 0xda, 0x99, 0xf6,
+	0x2f, 0xe4, 0xbb, 0x04, 0xfa, 0x21, 0x49, 0x86, 0xa9, 0xb4, 0x9e, 0x98, 0xbe, 0xd7, 0x92, 0x1f,
+	0x58, 0x59, 0x2f, 0x84, 0xb1, 0xf5, 0x14, 0x26, 0xfc, 0xbf, 0x60, 0xcd, 0x64, 0x2d, 0x18, 0x41,
+	0xec, 0x5b, 0x30, 0x12, 0x16, 0xaa, 0x5c, 0x2d, 0xaf, 0x95, 0x87, 0xa9, 0xb4, 0xc9, 0xd2, 0x66,
+	0x79, 0x59, 0x5f, 0x1d, 0x01, 0x27, 0x34, 0xe6, 0x2b, 0x60, 0x09, 0xc3, 0xf3, 0x18, 0x06, 0x36,
+	0x14, 0xf2, 0x59, 0xae, 0x3e, 0x89, 0x5b, 0xc2, 0xdb, 0x6b, 0x29, 0xf7, 0xfe, 0x5a, 0xca, 0x7d,
+	0xbd, 0x96, 0x72, 0x77, 0x37, 0xf5, 0xa5, 0xd1, 0xb8, 0x47, 0xf2, 0x47, 0x0e, 0xac, 0x1e, 0xa3,
+	0x76, 0xec, 0x4d, 0x36, 0xf0, 0x06, 0xac, 0x58, 0x26, 0x86, 0xc6, 0xc8, 0x9d, 0xae, 0xa1, 0xd8,
+	0xfc, 0x43, 0x79, 0x7c, 0x06, 0x65, 0x6a, 0x6d, 0xda, 0xce, 0x6d, 0x2a, 0x71, 0xc3, 0x54, 0xda,
+	0x60, 0xad, 0x4e, 0x1b, 0xc8, 0x7a, 0xd1, 0x9a, 0x5a, 0x30, 0x0f, 0xf2, 0x81, 0xe9, 0x43, 0xba,
+	0xc3, 0x65, 0x9d, 0x7e, 0xf3, 0x55, 0x50, 0x0c, 0x61, 0xe4, 0xbb, 0x18, 0xbb, 0x28, 0xc0, 0xc2,
+	0x42, 0x75, 0xa1, 0xb6, 0xac, 0x4f, 0x43, 0xad, 0xca, 0x78, 0x80, 0xbb, 0x9b, 0xfa, 0xda, 0x4c,
+	0xbf, 0x47, 0xf2, 0x87, 0x3c, 0x28, 0x9c, 0x9a, 0x91, 0xe9, 0x63, 0xfe, 0x04, 0x6c, 0xf8, 0x66,
+	0xdf, 0xf0, 0xa1, 0x8f, 0x0c, 0xbb, 0x6b, 0x46, 0xa6, 0x4d, 0x60, 0xc4, 0x2e, 0x99, 0xd7, 0xc4,
+	0x61, 0x2a, 0x55, 0x58, 0x7f, 0x73, 0x44, 0xb2, 0xbe, 0xee, 0x9b, 0xfd, 0x63, 0xe8, 0xa3, 0xc3,
+	0x09, 0xc6, 0xef, 0x81, 0x15, 0xd2, 0x37, 0xb0, 0xeb, 0x18, 0x9e, 0xeb, 0xbb, 0x84, 0x36, 0x9d,
+	0xd7, 0xb6, 0x1f, 0x06, 0x9d, 0x66, 0x65, 0x1d, 0x90, 0xfe, 0x99, 0xeb, 0x3c, 0xcb, 0x02, 0x5e,
+	0x07, 0x9b, 0x94, 0xbc, 0x82, 0x86, 0x8d, 0x30, 0x31, 0x42, 0x18, 0x19, 0x56, 0x42, 0xe0, 0xe8,
+	0xae, 0xd5, 0x61, 0x2a, 0xfd, 0x3e, 0xe5, 0xf1, 0x58, 0x26, 0xeb, 0xeb, 0x99, 0xd9, 0x15, 0x3c,
+	0x44, 0x98, 0x9c, 0xc2, 0x48, 0x4b, 0x08, 0xe4, 0xcf, 0xc1, 0x76, 0x56, 0xed, 0x02, 0x46, 0x6e,
+	0x27, 0x61, 0x7a, 0xd8, 0x6e, 0xee, 0xee, 0x36, 0xf6, 0xd8, 0xc5, 0xb5, 0xd6, 0x20, 0x95, 0x4a,
+	0x67, 0xae, 0xf3, 0x9c, 0x2a, 0xb2, 0xd4, 0xff, 0xff, 0xa3, 0xfc, 0x30, 0x95, 0x44, 0x56, 0xed,
+	0x27, 0x06, 0xb2, 0x5e, 0xc2, 0x33, 0x79, 0x0c, 0xe6, 0x13, 0x50, 0x7e, 0x9c, 0x81, 0xa1, 0x1d,
+	0x36, 0x77, 0xff, 0xe9, 0x35, 0x84, 0x5f, 0x69, 0xd1, 0xfd, 0x41, 0x2a, 0x6d, 0xcd, 0x14, 0x3d,
+	0x1b, 0x2b, 0x86, 0xa9, 0x54, 0x9d, 0x5f, 0x76, 0x62, 0x22, 0xeb, 0x5b, 0x78, 0x6e, 0x2e, 0xff,
+	0x92, 0x4d, 0x6b, 0x79, 0xc8, 0xee, 0x19, 0x5d, 0xe8, 0x3a, 0x5d, 0x62, 0x5c, 0xba, 0x41, 0x1b,
+	0x5d, 0x0a, 0x05, 0x5a, 0x58, 0x9e, 0x9d, 0x6a, 0x8e, 0x90, 0x4d, 0xa5, 0x65, 0xc4, 0x13, 0x8a,
+	0xbf, 0xa0, 0x70, 0x6b, 0x69, 0xf4, 0x16, 0x38, 0x6d, 0xff, 0xd3, 0x40, 0xe4, 0x6e, 0x07, 0x22,
+	0xf7, 0x65, 0x20, 0x72, 0xef, 0xee, 0xc5, 0xdc, 0xed, 0xbd, 0x98, 0xfb, 0x7c, 0x2f, 0xe6, 0x5e,
+	0xfd, 0xe9, 0xb8, 0xa4, 0x1b, 0x5b, 0x8a, 0x8d, 0x7c, 0xd5, 0x73, 0x03, 0xa8, 0x7a, 0x1d, 0xab,
+	0x8e, 0xdb, 0x3d, 0xb5, 0xcf, 0x7e, 0x55, 0x24, 0x09, 0x21, 0xb6, 0x0a, 0xf4, 0xf1, 0xff, 0xfd,
+	0x63, 0x00, 0xc3, 0xc2, 0x40, 0x49, 0xc3, 0x04, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -271,6 +283,9 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.SigVerifyCostSecp256k1 != that1.SigVerifyCostSecp256k1 {
 		return false
 	}
+	if this.SigBlockHeightWindow != that1.SigBlockHeightWindow {
+		return false
+	}
 	return true
 }
 func (m *BaseAccount) Marshal() (dAtA []byte, err error) {
@@ -396,6 +411,11 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.SigBlockHeightWindow != 0 {
+		i = encodeVarintAuth(dAtA, i, uint64(m.SigBlockHeightWindow))
+		i--
+		dAtA[i] = 0x30
+	}
 	if m.SigVerifyCostSecp256k1 != 0 {
 		i = encodeVarintAuth(dAtA, i, uint64(m.SigVerifyCostSecp256k1))
 		i--
@@ -502,6 +522,9 @@ func (m *Params) Size() (n int) {
 	if m.SigVerifyCostSecp256k1 != 0 {
 		n += 1 + sovAuth(uint64(m.SigVerifyCostSecp256k1))
 	}
+	if m.SigBlockHeightWindow != 0 {
+		n += 1 + sovAuth(uint64(m.SigBlockHeightWindow))
+	}
 	return n
 }
 
@@ -941,6 +964,25 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SigBlockHeightWindow", wireType)
+			}
+			m.SigBlockHeightWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SigBlockHeightWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuth(dAtA[iNdEx:])
@@ -27,9 +27,21 @@ var (
 
 	// param key for global account number
 	GlobalAccountNumberKey = []byte("globalAccountNumber")
+
+	// SeenTxKeyPrefix prefix for the txs processed under sig block height replay protection
+	SeenTxKeyPrefix = []byte{0x02}
+
+	// SeenTxsPrunedHeightKey key for the block height the processed txs signed before were pruned at
+	SeenTxsPrunedHeightKey = []byte{0x03}
 )
 
 // AddressStoreKey turn an address to key used to get it from the account store
 func AddressStoreKey(addr sdk.AccAddress) []byte {
 	return append(AddressStoreKeyPrefix, addr.Bytes()...)
 }
+
+// SeenTxKey returns the key of a processed tx, ordered by the block height it
+// was signed at.
+func SeenTxKey(sigBlockHeight uint64, txHash []byte) []byte {
+	return append(append(SeenTxKeyPrefix, sdk.Uint64ToBigEndian(sigBlockHeight)...), txHash...)
+}
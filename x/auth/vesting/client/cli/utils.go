@@ -0,0 +1,49 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+
+	sdk "github.com/line/lfb-sdk/types"
+	"github.com/line/lfb-sdk/x/auth/vesting/types"
+)
+
+// VestingData defines the JSON schedule file read by the commands creating a
+// periodic or clawback vesting account.
+type VestingData struct {
+	StartTime int64         `json:"start_time"`
+	Periods   []InputPeriod `json:"periods"`
+}
+
+// InputPeriod defines a vesting period of a VestingData.
+type InputPeriod struct {
+	Coins  string `json:"coins"`
+	Length int64  `json:"length_seconds"`
+}
+
+// ParseVestingSchedule reads and parses the start time and the periods of a
+// vesting schedule from a JSON file.
+func ParseVestingSchedule(path string) (int64, types.Periods, error) {
+	contents, err := ioutil.ReadFile(path)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	var data VestingData
+	if err := json.Unmarshal(contents, &data); err != nil {
+		return 0, nil, err
+	}
+
+	periods := make(types.Periods, 0, len(data.Periods))
+	for i, p := range data.Periods {
+		amount, err := sdk.ParseCoinsNormalized(p.Coins)
+		if err != nil {
+			return 0, nil, fmt.Errorf("invalid coins %s in period %d: %w", p.Coins, i, err)
+		}
+
+		periods = append(periods, types.Period{Length: p.Length, Amount: amount})
+	}
+
+	return data.StartTime, periods, nil
+}
@@ -2,6 +2,7 @@ package vesting_test
 
 import (
 	"testing"
+	"time"
 
 	ostproto "github.com/line/ostracon/proto/ostracon/types"
 	"github.com/stretchr/testify/suite"
@@ -10,6 +11,7 @@ import (
 	sdk "github.com/line/lfb-sdk/types"
 	"github.com/line/lfb-sdk/x/auth/vesting"
 	"github.com/line/lfb-sdk/x/auth/vesting/types"
+	"github.com/line/lfb-sdk/x/staking/teststaking"
 )
 
 type HandlerTestSuite struct {
@@ -23,7 +25,7 @@ func (suite *HandlerTestSuite) SetupTest() {
 	checkTx := false
 	app := simapp.Setup(checkTx)
 
-	suite.handler = vesting.NewHandler(app.AccountKeeper, app.BankKeeper)
+	suite.handler = vesting.NewHandler(app.AccountKeeper, app.BankKeeper, app.StakingKeeper)
 	suite.app = app
 }
 
@@ -91,6 +93,169 @@ func (suite *HandlerTestSuite) TestMsgCreateVestingAccount() {
 	}
 }
 
+func (suite *HandlerTestSuite) TestMsgCreatePermanentLockedAccount() {
+	ctx := suite.app.BaseApp.NewContext(false, ostproto.Header{Height: suite.app.LastBlockHeight() + 1})
+
+	balances := sdk.NewCoins(sdk.NewInt64Coin("test", 1000))
+	addr1 := sdk.AccAddress([]byte("addr1_______________"))
+	addr2 := sdk.AccAddress([]byte("addr2_______________"))
+
+	acc1 := suite.app.AccountKeeper.NewAccountWithAddress(ctx, addr1)
+	suite.app.AccountKeeper.SetAccount(ctx, acc1)
+	suite.Require().NoError(suite.app.BankKeeper.SetBalances(ctx, addr1, balances))
+
+	amount := sdk.NewCoins(sdk.NewInt64Coin("test", 100))
+	res, err := suite.handler(ctx, types.NewMsgCreatePermanentLockedAccount(addr1, addr2, amount))
+	suite.Require().NoError(err)
+	suite.Require().NotNil(res)
+
+	acc, ok := suite.app.AccountKeeper.GetAccount(ctx, addr2).(*types.PermanentLockedAccount)
+	suite.Require().True(ok)
+	suite.Require().Equal(amount, acc.GetVestingCoins(ctx.BlockTime().Add(100*365*24*time.Hour)))
+	suite.Require().True(suite.app.BankKeeper.SpendableCoins(ctx, addr2).IsZero())
+
+	_, err = suite.handler(ctx, types.NewMsgCreatePermanentLockedAccount(addr1, addr2, amount))
+	suite.Require().Error(err)
+}
+
+func (suite *HandlerTestSuite) TestMsgCreatePeriodicVestingAccounts() {
+	ctx := suite.app.BaseApp.NewContext(false, ostproto.Header{Height: suite.app.LastBlockHeight() + 1, Time: time.Unix(1000, 0)})
+
+	balances := sdk.NewCoins(sdk.NewInt64Coin("test", 1000))
+	addr1 := sdk.AccAddress([]byte("addr1_______________"))
+	addr2 := sdk.AccAddress([]byte("addr2_______________"))
+	addr3 := sdk.AccAddress([]byte("addr3_______________"))
+
+	acc1 := suite.app.AccountKeeper.NewAccountWithAddress(ctx, addr1)
+	suite.app.AccountKeeper.SetAccount(ctx, acc1)
+	suite.Require().NoError(suite.app.BankKeeper.SetBalances(ctx, addr1, balances))
+
+	periods := types.Periods{
+		{Length: 100, Amount: sdk.NewCoins(sdk.NewInt64Coin("test", 100))},
+		{Length: 100, Amount: sdk.NewCoins(sdk.NewInt64Coin("test", 200))},
+	}
+
+	res, err := suite.handler(ctx, types.NewMsgCreatePeriodicVestingAccount(addr1, addr2, 1000, periods))
+	suite.Require().NoError(err)
+	suite.Require().NotNil(res)
+
+	pva, ok := suite.app.AccountKeeper.GetAccount(ctx, addr2).(*types.PeriodicVestingAccount)
+	suite.Require().True(ok)
+	suite.Require().Equal(int64(1200), pva.GetEndTime())
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("test", 300)), pva.GetOriginalVesting())
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("test", 100)), pva.GetVestedCoins(time.Unix(1150, 0)))
+
+	res, err = suite.handler(ctx, types.NewMsgCreateClawbackVestingAccount(addr1, addr3, 1000, periods))
+	suite.Require().NoError(err)
+	suite.Require().NotNil(res)
+
+	cva, ok := suite.app.AccountKeeper.GetAccount(ctx, addr3).(*types.ClawbackVestingAccount)
+	suite.Require().True(ok)
+	suite.Require().Equal(addr1, cva.GetFunder())
+	suite.Require().Equal(int64(1200), cva.GetEndTime())
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("test", 400)), suite.app.BankKeeper.GetAllBalances(ctx, addr1))
+
+	_, err = suite.handler(ctx, types.NewMsgCreateClawbackVestingAccount(addr1, addr3, 1000, periods))
+	suite.Require().Error(err)
+}
+
+// setupClawback creates a validator and a clawback vesting account of 3000
+// bond tokens vesting a third every 100 seconds from time 1000, and returns
+// a context at time 1150 with the validator, the funder and the account.
+func (suite *HandlerTestSuite) setupClawback() (sdk.Context, *teststaking.Helper, sdk.ValAddress, sdk.AccAddress, sdk.AccAddress) {
+	app := suite.app
+	ctx := app.BaseApp.NewContext(false, ostproto.Header{Height: app.LastBlockHeight() + 1, Time: time.Unix(1000, 0)})
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+
+	pks := simapp.CreateTestPubKeys(1)
+	simapp.AddTestAddrsFromPubKeys(app, ctx, pks, sdk.TokensFromConsensusPower(10))
+	valAddr := sdk.ValAddress(pks[0].Address())
+	funder := simapp.AddTestAddrsIncremental(app, ctx, 1, sdk.NewInt(10000))[0]
+	addr := sdk.AccAddress([]byte("clawback____________"))
+
+	tstaking := teststaking.NewHelper(suite.T(), ctx, app.StakingKeeper)
+	tstaking.CreateValidator(valAddr, pks[0], sdk.TokensFromConsensusPower(1), true)
+
+	period := types.Period{Length: 100, Amount: sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 1000))}
+	_, err := suite.handler(ctx, types.NewMsgCreateClawbackVestingAccount(funder, addr, 1000, types.Periods{period, period, period}))
+	suite.Require().NoError(err)
+
+	ctx = ctx.WithBlockTime(time.Unix(1150, 0))
+	tstaking.Ctx = ctx
+
+	return ctx, tstaking, valAddr, funder, addr
+}
+
+func (suite *HandlerTestSuite) TestMsgClawback() {
+	app := suite.app
+	ctx, tstaking, valAddr, funder, addr := suite.setupClawback()
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+	dest := sdk.AccAddress([]byte("dest________________"))
+
+	tstaking.Delegate(addr, valAddr, sdk.NewInt(2500))
+
+	_, err := suite.handler(ctx, types.NewMsgClawback(dest, addr, nil))
+	suite.Require().Error(err)
+	_, err = suite.handler(ctx, types.NewMsgClawback(funder, funder, nil))
+	suite.Require().Error(err)
+
+	res, err := suite.handler(ctx, types.NewMsgClawback(funder, addr, dest))
+	suite.Require().NoError(err)
+	suite.Require().NotNil(res)
+
+	// 500 are taken from the balance and 1500 from the delegation
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 500)), app.BankKeeper.GetAllBalances(ctx, dest))
+	delegation, found := app.StakingKeeper.GetDelegation(ctx, dest, valAddr)
+	suite.Require().True(found)
+	suite.Require().Equal(sdk.NewDec(1500), delegation.Shares)
+	delegation, found = app.StakingKeeper.GetDelegation(ctx, addr, valAddr)
+	suite.Require().True(found)
+	suite.Require().Equal(sdk.NewDec(1000), delegation.Shares)
+
+	va := app.AccountKeeper.GetAccount(ctx, addr).(*types.ClawbackVestingAccount)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 1000)), va.GetOriginalVesting())
+	suite.Require().Equal(int64(1100), va.GetEndTime())
+	suite.Require().True(va.GetVestingCoins(ctx.BlockTime()).IsZero())
+	suite.Require().True(va.GetDelegatedVesting().IsZero())
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 1000)), va.GetDelegatedFree())
+	suite.Require().NoError(va.Validate())
+
+	// nothing is left to claw back
+	res, err = suite.handler(ctx, types.NewMsgClawback(funder, addr, dest))
+	suite.Require().NoError(err)
+	suite.Require().NotNil(res)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 500)), app.BankKeeper.GetAllBalances(ctx, dest))
+}
+
+func (suite *HandlerTestSuite) TestMsgClawbackRelocksUnbonding() {
+	app := suite.app
+	ctx, tstaking, valAddr, funder, addr := suite.setupClawback()
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+
+	tstaking.Delegate(addr, valAddr, sdk.NewInt(2500))
+	tstaking.Undelegate(addr, valAddr, sdk.NewInt(2000), true)
+	funderBalance := app.BankKeeper.GetBalance(ctx, funder, bondDenom)
+
+	res, err := suite.handler(ctx, types.NewMsgClawback(funder, addr, nil))
+	suite.Require().NoError(err)
+	suite.Require().NotNil(res)
+
+	// 500 are taken from the balance, 500 from the delegation and the
+	// remaining 1000 are unbonding
+	suite.Require().Equal(funderBalance.Add(sdk.NewInt64Coin(bondDenom, 500)), app.BankKeeper.GetBalance(ctx, funder, bondDenom))
+	delegation, found := app.StakingKeeper.GetDelegation(ctx, funder, valAddr)
+	suite.Require().True(found)
+	suite.Require().Equal(sdk.NewDec(500), delegation.Shares)
+	_, found = app.StakingKeeper.GetDelegation(ctx, addr, valAddr)
+	suite.Require().False(found)
+
+	va := app.AccountKeeper.GetAccount(ctx, addr).(*types.ClawbackVestingAccount)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 2000)), va.GetOriginalVesting())
+	suite.Require().Equal(int64(1300), va.GetEndTime())
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 1000)), va.GetVestingCoins(ctx.BlockTime()))
+	suite.Require().NoError(va.Validate())
+}
+
 func TestHandlerTestSuite(t *testing.T) {
 	suite.Run(t, new(HandlerTestSuite))
 }
@@ -2,6 +2,7 @@ package vesting
 
 import (
 	"context"
+	"math"
 
 	authtypes "github.com/line/lfb-sdk/x/auth/types"
 
@@ -17,66 +18,273 @@ import (
 type msgServer struct {
 	keeper.AccountKeeper
 	types.BankKeeper
+	types.StakingKeeper
 }
 
 // NewMsgServerImpl returns an implementation of the vesting MsgServer interface,
-// wrapping the corresponding AccountKeeper and BankKeeper.
-func NewMsgServerImpl(k keeper.AccountKeeper, bk types.BankKeeper) types.MsgServer {
-	return &msgServer{AccountKeeper: k, BankKeeper: bk}
+// wrapping the corresponding AccountKeeper, BankKeeper and StakingKeeper.
+func NewMsgServerImpl(k keeper.AccountKeeper, bk types.BankKeeper, sk types.StakingKeeper) types.MsgServer {
+	return &msgServer{AccountKeeper: k, BankKeeper: bk, StakingKeeper: sk}
 }
 
 var _ types.MsgServer = msgServer{}
 
 func (s msgServer) CreateVestingAccount(goCtx context.Context, msg *types.MsgCreateVestingAccount) (*types.MsgCreateVestingAccountResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
-	ak := s.AccountKeeper
-	bk := s.BankKeeper
 
-	if err := bk.SendEnabledCoins(ctx, msg.Amount...); err != nil {
+	from, to, baseAccount, err := s.newBaseAccount(ctx, msg.FromAddress, msg.ToAddress, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	baseVestingAccount := types.NewBaseVestingAccount(baseAccount, msg.Amount.Sort(), msg.EndTime)
+
+	var acc authtypes.AccountI
+
+	if msg.Delayed {
+		acc = types.NewDelayedVestingAccountRaw(baseVestingAccount)
+	} else {
+		acc = types.NewContinuousVestingAccountRaw(baseVestingAccount, ctx.BlockTime().Unix())
+	}
+
+	if err := s.fundAccount(ctx, acc, from, to, msg.Amount, "create_vesting_account"); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateVestingAccountResponse{}, nil
+}
+
+func (s msgServer) CreatePermanentLockedAccount(goCtx context.Context, msg *types.MsgCreatePermanentLockedAccount) (*types.MsgCreatePermanentLockedAccountResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	from, to, baseAccount, err := s.newBaseAccount(ctx, msg.FromAddress, msg.ToAddress, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	acc := types.NewPermanentLockedAccount(baseAccount, msg.Amount.Sort())
+
+	if err := s.fundAccount(ctx, acc, from, to, msg.Amount, "create_permanent_locked_account"); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreatePermanentLockedAccountResponse{}, nil
+}
+
+func (s msgServer) CreatePeriodicVestingAccount(goCtx context.Context, msg *types.MsgCreatePeriodicVestingAccount) (*types.MsgCreatePeriodicVestingAccountResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	periods := types.Periods(msg.VestingPeriods)
+	amount := periods.TotalAmount()
+
+	from, to, baseAccount, err := s.newBaseAccount(ctx, msg.FromAddress, msg.ToAddress, amount)
+	if err != nil {
 		return nil, err
 	}
 
-	from, err := sdk.AccAddressFromBech32(msg.FromAddress)
+	acc := types.NewPeriodicVestingAccount(baseAccount, amount, msg.StartTime, periods)
+
+	if err := s.fundAccount(ctx, acc, from, to, amount, "create_periodic_vesting_account"); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreatePeriodicVestingAccountResponse{}, nil
+}
+
+func (s msgServer) CreateClawbackVestingAccount(goCtx context.Context, msg *types.MsgCreateClawbackVestingAccount) (*types.MsgCreateClawbackVestingAccountResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	periods := types.Periods(msg.VestingPeriods)
+	amount := periods.TotalAmount()
+
+	from, to, baseAccount, err := s.newBaseAccount(ctx, msg.FromAddress, msg.ToAddress, amount)
 	if err != nil {
 		return nil, err
 	}
-	to, err := sdk.AccAddressFromBech32(msg.ToAddress)
+
+	acc := types.NewClawbackVestingAccount(baseAccount, from, amount, msg.StartTime, periods)
+
+	if err := s.fundAccount(ctx, acc, from, to, amount, "create_clawback_vesting_account"); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateClawbackVestingAccountResponse{}, nil
+}
+
+// Clawback returns the unvested coins of a ClawbackVestingAccount to the
+// funder, or to the destination address of the message. The coins are taken
+// from the spendable balance of the account first and then from its
+// delegations of the bond denom, which are transferred as they are. Coins
+// which are unbonding at that time are locked again until the end of the
+// original schedule so that a later clawback can still reclaim them.
+func (s msgServer) Clawback(goCtx context.Context, msg *types.MsgClawback) (*types.MsgClawbackResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	ak := s.AccountKeeper
+	bk := s.BankKeeper
+	sk := s.StakingKeeper
+
+	funder, err := sdk.AccAddressFromBech32(msg.FunderAddress)
+	if err != nil {
+		return nil, err
+	}
+	addr, err := sdk.AccAddressFromBech32(msg.Address)
 	if err != nil {
 		return nil, err
 	}
+	dest := funder
+	if msg.DestAddress != "" {
+		dest, err = sdk.AccAddressFromBech32(msg.DestAddress)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if bk.BlockedAddr(dest) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", dest)
+	}
+
+	acc := ak.GetAccount(ctx, addr)
+	if acc == nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrNotFound, "account %s does not exist", msg.Address)
+	}
+	va, ok := acc.(*types.ClawbackVestingAccount)
+	if !ok {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "account %s is not a clawback vesting account", msg.Address)
+	}
+	if va.FunderAddress != msg.FunderAddress {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "clawback can only be requested by the original funder %s", va.FunderAddress)
+	}
+
+	endTime := va.EndTime
+	unvested := va.ComputeClawback(ctx.BlockTime())
+	ak.SetAccount(ctx, va)
+
+	// take the unvested coins from the balance of the account first
+	clawedBack := coinsMin(unvested, bk.SpendableCoins(ctx, addr))
+	if !clawedBack.IsZero() {
+		if err := bk.SendCoins(ctx, addr, dest, clawedBack); err != nil {
+			return nil, err
+		}
+	}
+
+	// then move the delegations to the destination, skipping the ones which
+	// cannot be transferred such as those received through a redelegation
+	bondDenom := sk.BondDenom(ctx)
+	wanted := unvested.Sub(clawedBack).AmountOf(bondDenom)
+	for _, delegation := range sk.GetDelegatorDelegations(ctx, addr, math.MaxUint16) {
+		if !wanted.IsPositive() {
+			break
+		}
+
+		validator, found := sk.GetValidator(ctx, delegation.GetValidatorAddr())
+		if !found {
+			continue
+		}
+
+		amount := sdk.NewCoin(bondDenom, sdk.MinInt(validator.TokensFromShares(delegation.Shares).TruncateInt(), wanted))
+		if !amount.IsPositive() {
+			continue
+		}
+
+		cacheCtx, writeCache := ctx.CacheContext()
+		if _, err := sk.TransferDelegation(cacheCtx, addr, delegation.GetValidatorAddr(), dest, amount); err != nil {
+			continue
+		}
+		writeCache()
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+
+		clawedBack = clawedBack.Add(amount)
+		wanted = wanted.Sub(amount.Amount)
+	}
+
+	// what is left may be unbonding; it returns to the account later and
+	// stays locked until the original end time
+	unbonding := sdk.ZeroInt()
+	for _, ubd := range sk.GetUnbondingDelegations(ctx, addr, math.MaxUint16) {
+		for _, entry := range ubd.Entries {
+			unbonding = unbonding.Add(entry.Balance)
+		}
+	}
+	if relock := sdk.MinInt(wanted, unbonding); relock.IsPositive() {
+		va = ak.GetAccount(ctx, addr).(*types.ClawbackVestingAccount)
+		va.AddVestingPeriod(endTime, sdk.NewCoins(sdk.NewCoin(bondDenom, relock)))
+		ak.SetAccount(ctx, va)
+	}
+
+	defer func() {
+		for _, a := range clawedBack {
+			if a.Amount.IsInt64() {
+				telemetry.SetGaugeWithLabels(
+					[]string{"tx", "msg", "clawback"},
+					float32(a.Amount.Int64()),
+					[]metrics.Label{telemetry.NewLabel("denom", a.Denom)},
+				)
+			}
+		}
+	}()
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.FunderAddress),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, clawedBack.String()),
+		),
+	)
+
+	return &types.MsgClawbackResponse{}, nil
+}
+
+// newBaseAccount checks that the amount may be sent to the not yet existing
+// recipient of a new vesting account and returns its base account.
+func (s msgServer) newBaseAccount(
+	ctx sdk.Context, fromAddress, toAddress string, amount sdk.Coins,
+) (sdk.AccAddress, sdk.AccAddress, *authtypes.BaseAccount, error) {
+	ak := s.AccountKeeper
+	bk := s.BankKeeper
+
+	if err := bk.SendEnabledCoins(ctx, amount...); err != nil {
+		return nil, nil, nil, err
+	}
+
+	from, err := sdk.AccAddressFromBech32(fromAddress)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	to, err := sdk.AccAddressFromBech32(toAddress)
+	if err != nil {
+		return nil, nil, nil, err
+	}
 
 	if bk.BlockedAddr(to) {
-		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", msg.ToAddress)
+		return nil, nil, nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", toAddress)
 	}
 
 	if acc := ak.GetAccount(ctx, to); acc != nil {
-		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "account %s already exists", msg.ToAddress)
+		return nil, nil, nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "account %s already exists", toAddress)
 	}
 
 	baseAccount := ak.NewAccountWithAddress(ctx, to)
 	if _, ok := baseAccount.(*authtypes.BaseAccount); !ok {
-		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid account type; expected: BaseAccount, got: %T", baseAccount)
+		return nil, nil, nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid account type; expected: BaseAccount, got: %T", baseAccount)
 	}
 
-	baseVestingAccount := types.NewBaseVestingAccount(baseAccount.(*authtypes.BaseAccount), msg.Amount.Sort(), msg.EndTime)
-
-	var acc authtypes.AccountI
-
-	if msg.Delayed {
-		acc = types.NewDelayedVestingAccountRaw(baseVestingAccount)
-	} else {
-		acc = types.NewContinuousVestingAccountRaw(baseVestingAccount, ctx.BlockTime().Unix())
-	}
+	return from, to, baseAccount.(*authtypes.BaseAccount), nil
+}
 
-	ak.SetAccount(ctx, acc)
+// fundAccount stores the new vesting account and sends it the amount.
+func (s msgServer) fundAccount(
+	ctx sdk.Context, acc authtypes.AccountI, from, to sdk.AccAddress, amount sdk.Coins, metric string,
+) error {
+	s.AccountKeeper.SetAccount(ctx, acc)
 
 	defer func() {
 		telemetry.IncrCounter(1, "new", "account")
 
-		for _, a := range msg.Amount {
+		for _, a := range amount {
 			if a.Amount.IsInt64() {
 				telemetry.SetGaugeWithLabels(
-					[]string{"tx", "msg", "create_vesting_account"},
+					[]string{"tx", "msg", metric},
 					float32(a.Amount.Int64()),
 					[]metrics.Label{telemetry.NewLabel("denom", a.Denom)},
 				)
@@ -84,9 +292,8 @@ func (s msgServer) CreateVestingAccount(goCtx context.Context, msg *types.MsgCre
 		}
 	}()
 
-	err = bk.SendCoins(ctx, from, to, msg.Amount)
-	if err != nil {
-		return nil, err
+	if err := s.BankKeeper.SendCoins(ctx, from, to, amount); err != nil {
+		return err
 	}
 
 	ctx.EventManager().EmitEvent(
@@ -96,5 +303,16 @@ func (s msgServer) CreateVestingAccount(goCtx context.Context, msg *types.MsgCre
 		),
 	)
 
-	return &types.MsgCreateVestingAccountResponse{}, nil
+	return nil
+}
+
+// coinsMin returns the minimum of each denom of a and b.
+func coinsMin(a, b sdk.Coins) sdk.Coins {
+	min := sdk.NewCoins()
+	for _, coin := range a {
+		if amount := sdk.MinInt(coin.Amount, b.AmountOf(coin.Denom)); amount.IsPositive() {
+			min = min.Add(sdk.NewCoin(coin.Denom, amount))
+		}
+	}
+	return min
 }
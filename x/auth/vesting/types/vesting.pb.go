@@ -238,55 +238,143 @@ func (m *PeriodicVestingAccount) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_PeriodicVestingAccount proto.InternalMessageInfo
 
+// PermanentLockedAccount implements the VestingAccount interface. It does
+// not ever release coins, locking them indefinitely. Coins in this account can
+// still be used for delegating and for governance votes even while locked.
+type PermanentLockedAccount struct {
+	*BaseVestingAccount `protobuf:"bytes,1,opt,name=base_vesting_account,json=baseVestingAccount,proto3,embedded=base_vesting_account" json:"base_vesting_account,omitempty"`
+}
+
+func (m *PermanentLockedAccount) Reset()      { *m = PermanentLockedAccount{} }
+func (*PermanentLockedAccount) ProtoMessage() {}
+func (*PermanentLockedAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_409a1a7fead3f5ae, []int{5}
+}
+func (m *PermanentLockedAccount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PermanentLockedAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PermanentLockedAccount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PermanentLockedAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PermanentLockedAccount.Merge(m, src)
+}
+func (m *PermanentLockedAccount) XXX_Size() int {
+	return m.Size()
+}
+func (m *PermanentLockedAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_PermanentLockedAccount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PermanentLockedAccount proto.InternalMessageInfo
+
+// ClawbackVestingAccount implements the VestingAccount interface. It vests
+// coins by period like a PeriodicVestingAccount, but the funder may reclaim
+// the coins which have not vested yet at any time.
+type ClawbackVestingAccount struct {
+	*BaseVestingAccount `protobuf:"bytes,1,opt,name=base_vesting_account,json=baseVestingAccount,proto3,embedded=base_vesting_account" json:"base_vesting_account,omitempty"`
+	FunderAddress       string   `protobuf:"bytes,2,opt,name=funder_address,json=funderAddress,proto3" json:"funder_address,omitempty" yaml:"funder_address"`
+	StartTime           int64    `protobuf:"varint,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty" yaml:"start_time"`
+	VestingPeriods      []Period `protobuf:"bytes,4,rep,name=vesting_periods,json=vestingPeriods,proto3" json:"vesting_periods" yaml:"vesting_periods"`
+}
+
+func (m *ClawbackVestingAccount) Reset()      { *m = ClawbackVestingAccount{} }
+func (*ClawbackVestingAccount) ProtoMessage() {}
+func (*ClawbackVestingAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_409a1a7fead3f5ae, []int{6}
+}
+func (m *ClawbackVestingAccount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClawbackVestingAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClawbackVestingAccount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ClawbackVestingAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClawbackVestingAccount.Merge(m, src)
+}
+func (m *ClawbackVestingAccount) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClawbackVestingAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClawbackVestingAccount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClawbackVestingAccount proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*BaseVestingAccount)(nil), "lfb.vesting.v1beta1.BaseVestingAccount")
 	proto.RegisterType((*ContinuousVestingAccount)(nil), "lfb.vesting.v1beta1.ContinuousVestingAccount")
 	proto.RegisterType((*DelayedVestingAccount)(nil), "lfb.vesting.v1beta1.DelayedVestingAccount")
 	proto.RegisterType((*Period)(nil), "lfb.vesting.v1beta1.Period")
 	proto.RegisterType((*PeriodicVestingAccount)(nil), "lfb.vesting.v1beta1.PeriodicVestingAccount")
+	proto.RegisterType((*PermanentLockedAccount)(nil), "lfb.vesting.v1beta1.PermanentLockedAccount")
+	proto.RegisterType((*ClawbackVestingAccount)(nil), "lfb.vesting.v1beta1.ClawbackVestingAccount")
 }
 
 func init() { proto.RegisterFile("lfb/vesting/v1beta1/vesting.proto", fileDescriptor_409a1a7fead3f5ae) }
 
 var fileDescriptor_409a1a7fead3f5ae = []byte{
-	// 592 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x94, 0x31, 0x6f, 0xd3, 0x40,
-	0x14, 0xc7, 0x7d, 0x49, 0x08, 0xe5, 0x02, 0x4d, 0xeb, 0x36, 0xc1, 0x6a, 0x85, 0x1d, 0xcc, 0x40,
-	0x24, 0xc0, 0x56, 0x0b, 0x53, 0x36, 0xdc, 0x2a, 0xac, 0xc8, 0x42, 0x0c, 0x30, 0x44, 0x76, 0x7c,
-	0x71, 0x4e, 0x38, 0xbe, 0xc8, 0xbe, 0x54, 0x64, 0xa5, 0x0b, 0x48, 0x0c, 0x8c, 0x6c, 0x74, 0xe6,
-	0x0b, 0x30, 0xf0, 0x05, 0x3a, 0x66, 0x64, 0x0a, 0x28, 0xf9, 0x06, 0xf9, 0x04, 0xc8, 0x77, 0x67,
-	0xa7, 0xb8, 0x25, 0x2a, 0x0b, 0x52, 0x37, 0xbf, 0x7b, 0xf7, 0xfe, 0xef, 0x77, 0xcf, 0xff, 0x3b,
-	0x78, 0x37, 0xe8, 0xb9, 0xe6, 0x11, 0x8a, 0x29, 0x0e, 0x7d, 0xf3, 0x68, 0xcf, 0x45, 0xd4, 0xd9,
-	0x4b, 0x63, 0x63, 0x18, 0x11, 0x4a, 0xe4, 0xad, 0xa0, 0xe7, 0x1a, 0xe9, 0x92, 0xd8, 0xb2, 0xb3,
-	0xed, 0x13, 0x9f, 0xb0, 0xbc, 0x99, 0x7c, 0xf1, 0xad, 0x3b, 0xbb, 0x89, 0x9a, 0xeb, 0xc4, 0x28,
-	0x93, 0xea, 0x12, 0x1c, 0x9e, 0x4d, 0x3a, 0x23, 0xda, 0xcf, 0x92, 0x49, 0xc0, 0x93, 0xfa, 0xb7,
-	0x12, 0x94, 0x2d, 0x27, 0x46, 0x2f, 0x79, 0x9f, 0xa7, 0xdd, 0x2e, 0x19, 0x85, 0x54, 0x6e, 0xc3,
-	0x9b, 0x89, 0x5c, 0xc7, 0xe1, 0xb1, 0x02, 0x1a, 0xa0, 0x59, 0xd9, 0xbf, 0x63, 0x24, 0x48, 0xac,
-	0x5a, 0x48, 0x19, 0x49, 0xad, 0x28, 0xb2, 0x4a, 0x93, 0xa9, 0x06, 0xec, 0x8a, 0xbb, 0x5c, 0x92,
-	0x3f, 0x00, 0xb8, 0x41, 0x22, 0xec, 0xe3, 0xd0, 0x09, 0x3a, 0xe2, 0x2c, 0x4a, 0xa1, 0x51, 0x6c,
-	0x56, 0xf6, 0xeb, 0x4c, 0x2c, 0xd9, 0x9c, 0x89, 0x1d, 0x10, 0x1c, 0x5a, 0xed, 0xd3, 0xa9, 0x26,
-	0x2d, 0xa6, 0xda, 0xed, 0xb1, 0x33, 0x08, 0x5a, 0x7a, 0xbe, 0x5a, 0xff, 0xfa, 0x53, 0xbb, 0xe7,
-	0x63, 0xda, 0x1f, 0xb9, 0x46, 0x97, 0x0c, 0xcc, 0x00, 0x87, 0xc8, 0x0c, 0x7a, 0xee, 0xa3, 0xd8,
-	0x7b, 0x63, 0xd2, 0xf1, 0x10, 0xc5, 0x4c, 0x26, 0xb6, 0xab, 0x69, 0xa5, 0x38, 0x9a, 0x7c, 0x0c,
-	0xe0, 0xba, 0x87, 0x02, 0xe4, 0x3b, 0x14, 0x79, 0x9d, 0x5e, 0x84, 0x90, 0x52, 0x5c, 0x49, 0x72,
-	0x28, 0x48, 0x6a, 0x9c, 0xe4, 0xcf, 0xda, 0x4b, 0x73, 0xdc, 0xca, 0xea, 0xda, 0x11, 0x42, 0xf2,
-	0x47, 0x00, 0x37, 0x97, 0x4a, 0xe9, 0x48, 0x4a, 0x2b, 0x41, 0x9e, 0x09, 0x10, 0x25, 0x0f, 0xf2,
-	0xaf, 0x33, 0xd9, 0xc8, 0x4a, 0xd3, 0xa1, 0x18, 0x70, 0x0d, 0x85, 0x5e, 0x87, 0xe2, 0x01, 0x52,
-	0xae, 0x35, 0x40, 0xb3, 0x68, 0x6d, 0x2d, 0xa6, 0x5a, 0x95, 0x37, 0x4a, 0x33, 0xba, 0x7d, 0x1d,
-	0x85, 0xde, 0x0b, 0x3c, 0x40, 0xad, 0xb5, 0xf7, 0x27, 0x9a, 0xf4, 0xf9, 0x44, 0x93, 0xf4, 0xef,
-	0x00, 0x2a, 0x07, 0x24, 0xa4, 0x38, 0x1c, 0x91, 0x51, 0x9c, 0xf3, 0x4f, 0x07, 0x6e, 0x33, 0xff,
-	0x08, 0xc0, 0x9c, 0x8f, 0xee, 0x1b, 0x17, 0x58, 0xdb, 0x38, 0x6f, 0x43, 0xe1, 0x28, 0xd9, 0x3d,
-	0x6f, 0xd0, 0x27, 0x10, 0xc6, 0xd4, 0x89, 0x28, 0x27, 0x2f, 0x30, 0xf2, 0xda, 0x62, 0xaa, 0x6d,
-	0x72, 0xf2, 0x65, 0x4e, 0xb7, 0x6f, 0xb0, 0x20, 0x47, 0xff, 0x0e, 0xc0, 0xda, 0x21, 0x0a, 0x9c,
-	0x71, 0x36, 0x8a, 0xff, 0x85, 0x7e, 0x06, 0xe2, 0x18, 0xc0, 0xf2, 0x73, 0x14, 0x61, 0xe2, 0xc9,
-	0x75, 0x58, 0x0e, 0x50, 0xe8, 0xd3, 0x3e, 0xeb, 0x53, 0xb4, 0x45, 0x24, 0xbf, 0x86, 0x65, 0x67,
-	0xc0, 0xfa, 0xaf, 0xbe, 0x35, 0x0f, 0x12, 0x8b, 0x5c, 0xd6, 0x06, 0x42, 0xb2, 0x55, 0x62, 0x14,
-	0x5f, 0x0a, 0xb0, 0xce, 0x29, 0x70, 0xf7, 0x4a, 0xfc, 0x46, 0xd9, 0x83, 0xd5, 0x94, 0x68, 0xc8,
-	0xc0, 0x63, 0x71, 0x93, 0x77, 0x2f, 0x24, 0xe2, 0x87, 0xb3, 0x54, 0x71, 0x8b, 0xea, 0x5c, 0x3b,
-	0xa7, 0xa0, 0xdb, 0xeb, 0x62, 0x85, 0x6f, 0x8f, 0x97, 0xff, 0xc9, 0x6a, 0x9f, 0xce, 0x54, 0x30,
-	0x99, 0xa9, 0xe0, 0xd7, 0x4c, 0x05, 0x9f, 0xe6, 0xaa, 0x34, 0x99, 0xab, 0xd2, 0x8f, 0xb9, 0x2a,
-	0xbd, 0x7a, 0xf8, 0xb7, 0x71, 0xbf, 0x15, 0x2f, 0xae, 0x78, 0xe1, 0xd9, 0xf4, 0xdd, 0x32, 0x7b,
-	0x73, 0x1f, 0xff, 0x0e, 0x00, 0x00, 0xff, 0xff, 0x0f, 0x54, 0x7c, 0x20, 0xfd, 0x05, 0x00, 0x00,
+	// 664 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x95, 0x3f, 0x53, 0xd4, 0x4e,
+	0x18, 0xc7, 0x6f, 0xef, 0xee, 0x77, 0x3f, 0x58, 0xe4, 0x5f, 0x80, 0xf3, 0x84, 0x31, 0xc1, 0x58,
+	0xc8, 0x8c, 0x9a, 0x1b, 0xd0, 0x8a, 0x4a, 0x02, 0x73, 0x36, 0x16, 0x4e, 0xc6, 0xb1, 0xd0, 0xe2,
+	0x66, 0x93, 0x3c, 0x17, 0x32, 0xe4, 0x76, 0x99, 0x64, 0x83, 0xd2, 0x4a, 0xa3, 0x33, 0x16, 0x96,
+	0x76, 0x52, 0xfb, 0x06, 0x2c, 0x7c, 0x03, 0x94, 0x94, 0x56, 0xa7, 0xc2, 0x3b, 0xe0, 0x15, 0x38,
+	0xd9, 0xdd, 0xdc, 0x41, 0x40, 0x06, 0x9d, 0x41, 0xc7, 0xee, 0x9e, 0x7d, 0xf6, 0xf9, 0xee, 0x27,
+	0xfb, 0x7c, 0x9f, 0x5b, 0x7c, 0x23, 0xea, 0xb8, 0xcd, 0x2d, 0x48, 0x78, 0x48, 0x83, 0xe6, 0xd6,
+	0xa2, 0x0b, 0x9c, 0x2c, 0xe6, 0xb1, 0xb5, 0x19, 0x33, 0xce, 0xb4, 0xa9, 0xa8, 0xe3, 0x5a, 0xf9,
+	0x92, 0xda, 0x32, 0x3b, 0x1d, 0xb0, 0x80, 0x89, 0x7c, 0x33, 0xfb, 0x25, 0xb7, 0xce, 0xce, 0x65,
+	0x6a, 0x2e, 0x49, 0xa0, 0x2f, 0xe5, 0xb1, 0x90, 0x1e, 0x4f, 0x92, 0x94, 0xaf, 0xf7, 0x93, 0x59,
+	0x20, 0x93, 0xe6, 0xa7, 0x2a, 0xd6, 0x6c, 0x92, 0xc0, 0x53, 0x79, 0xce, 0x8a, 0xe7, 0xb1, 0x94,
+	0x72, 0xad, 0x85, 0xaf, 0x64, 0x72, 0x6d, 0x22, 0xe3, 0x06, 0x9a, 0x47, 0x0b, 0x23, 0x4b, 0xd7,
+	0xad, 0x0c, 0x49, 0x54, 0x2b, 0x29, 0x2b, 0xab, 0x55, 0x45, 0x76, 0x75, 0xbf, 0x67, 0x20, 0x67,
+	0xc4, 0x1d, 0x2c, 0x69, 0x6f, 0x10, 0x9e, 0x60, 0x71, 0x18, 0x84, 0x94, 0x44, 0x6d, 0xf5, 0x2d,
+	0x8d, 0xf2, 0x7c, 0x65, 0x61, 0x64, 0xa9, 0x2e, 0xc4, 0xb2, 0xcd, 0x7d, 0xb1, 0x55, 0x16, 0x52,
+	0xbb, 0xb5, 0xd7, 0x33, 0x4a, 0x47, 0x3d, 0xe3, 0xea, 0x36, 0xe9, 0x46, 0xcb, 0x66, 0xb1, 0xda,
+	0xfc, 0xf8, 0xd5, 0xb8, 0x19, 0x84, 0x7c, 0x3d, 0x75, 0x2d, 0x8f, 0x75, 0x9b, 0x51, 0x48, 0xa1,
+	0x19, 0x75, 0xdc, 0xbb, 0x89, 0xbf, 0xd1, 0xe4, 0xdb, 0x9b, 0x90, 0x08, 0x99, 0xc4, 0x19, 0xcf,
+	0x2b, 0xd5, 0xa7, 0x69, 0x3b, 0x08, 0x8f, 0xf9, 0x10, 0x41, 0x40, 0x38, 0xf8, 0xed, 0x4e, 0x0c,
+	0xd0, 0xa8, 0x9c, 0x4b, 0xb2, 0xa6, 0x48, 0x66, 0x24, 0xc9, 0xc9, 0xda, 0x0b, 0x73, 0x8c, 0xf6,
+	0xeb, 0x5a, 0x31, 0x80, 0xf6, 0x16, 0xe1, 0xc9, 0x81, 0x52, 0x7e, 0x25, 0xd5, 0x73, 0x41, 0x1e,
+	0x2a, 0x90, 0x46, 0x11, 0xe4, 0x57, 0xef, 0x64, 0xa2, 0x5f, 0x9a, 0x5f, 0x8a, 0x85, 0x87, 0x80,
+	0xfa, 0x6d, 0x1e, 0x76, 0xa1, 0xf1, 0xdf, 0x3c, 0x5a, 0xa8, 0xd8, 0x53, 0x47, 0x3d, 0x63, 0x5c,
+	0x1e, 0x94, 0x67, 0x4c, 0xe7, 0x7f, 0xa0, 0xfe, 0x93, 0xb0, 0x0b, 0xcb, 0x43, 0xaf, 0x77, 0x8d,
+	0xd2, 0xfb, 0x5d, 0xa3, 0x64, 0x7e, 0x46, 0xb8, 0xb1, 0xca, 0x28, 0x0f, 0x69, 0xca, 0xd2, 0xa4,
+	0xe0, 0x9f, 0x36, 0x9e, 0x16, 0xfe, 0x51, 0x80, 0x05, 0x1f, 0xdd, 0xb2, 0xce, 0xb0, 0xb6, 0x75,
+	0xda, 0x86, 0xca, 0x51, 0x9a, 0x7b, 0xda, 0xa0, 0xf7, 0x31, 0x4e, 0x38, 0x89, 0xb9, 0x24, 0x2f,
+	0x0b, 0xf2, 0x99, 0xa3, 0x9e, 0x31, 0x29, 0xc9, 0x07, 0x39, 0xd3, 0x19, 0x16, 0x41, 0x81, 0xfe,
+	0x15, 0xc2, 0x33, 0x6b, 0x10, 0x91, 0x6d, 0xf0, 0x0b, 0xca, 0x97, 0x8d, 0x7e, 0x0c, 0x62, 0x07,
+	0xe1, 0xda, 0x63, 0x88, 0x43, 0xe6, 0x6b, 0x75, 0x5c, 0x8b, 0x80, 0x06, 0x7c, 0x5d, 0x9c, 0x53,
+	0x71, 0x54, 0xa4, 0x3d, 0xc7, 0x35, 0xd2, 0x15, 0xe7, 0x9f, 0x3f, 0x35, 0xb7, 0x33, 0x8b, 0x5c,
+	0xd4, 0x06, 0x4a, 0x72, 0xb9, 0x2a, 0x28, 0x3e, 0x94, 0x71, 0x5d, 0x52, 0x84, 0xde, 0x3f, 0xd1,
+	0x46, 0xcd, 0xc7, 0xe3, 0x39, 0xd1, 0xa6, 0x00, 0x4f, 0xd4, 0x24, 0xcf, 0x9d, 0x49, 0x24, 0x3f,
+	0xce, 0xd6, 0xd5, 0x14, 0xd5, 0xa5, 0x76, 0x41, 0xc1, 0x74, 0xc6, 0xd4, 0x8a, 0xdc, 0x9e, 0x9c,
+	0xec, 0x53, 0x76, 0x43, 0x5d, 0x42, 0x81, 0xf2, 0x47, 0xcc, 0xdb, 0x00, 0xff, 0x2f, 0xb8, 0xe5,
+	0x7b, 0x19, 0xd7, 0x57, 0x23, 0xf2, 0xc2, 0x25, 0xde, 0xc6, 0x9f, 0xee, 0xd3, 0x03, 0x3c, 0xd6,
+	0x49, 0xa9, 0x0f, 0x71, 0x9b, 0xf8, 0x7e, 0x0c, 0x49, 0x22, 0x7a, 0x35, 0x6c, 0x5f, 0x1b, 0xfc,
+	0x3d, 0x9e, 0xcc, 0x9b, 0xce, 0xa8, 0x5c, 0x58, 0x91, 0x71, 0xa1, 0xd3, 0x95, 0xdf, 0xef, 0x74,
+	0xf5, 0x12, 0x3b, 0x6d, 0xb7, 0xf6, 0x0e, 0x74, 0xb4, 0x7f, 0xa0, 0xa3, 0x6f, 0x07, 0x3a, 0x7a,
+	0x77, 0xa8, 0x97, 0xf6, 0x0f, 0xf5, 0xd2, 0x97, 0x43, 0xbd, 0xf4, 0xec, 0xce, 0xcf, 0x06, 0xeb,
+	0xa5, 0x7a, 0x5b, 0xd5, 0x5b, 0x2e, 0xe6, 0xcc, 0xad, 0x89, 0xd7, 0xf5, 0xde, 0x8f, 0x01, 0x00,
+	0x7e, 0x41, 0x58, 0x69, 0xe7, 0x07, 0x00, 0x00,
 }
 
 func (m *BaseVestingAccount) Marshal() (dAtA []byte, err error) {
@@ -542,6 +630,102 @@ func (m *PeriodicVestingAccount) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *PermanentLockedAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PermanentLockedAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PermanentLockedAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BaseVestingAccount != nil {
+		{
+			size, err := m.BaseVestingAccount.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintVesting(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ClawbackVestingAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClawbackVestingAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClawbackVestingAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VestingPeriods) > 0 {
+		for iNdEx := len(m.VestingPeriods) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VestingPeriods[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintVesting(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.StartTime != 0 {
+		i = encodeVarintVesting(dAtA, i, uint64(m.StartTime))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.FunderAddress) > 0 {
+		i -= len(m.FunderAddress)
+		copy(dAtA[i:], m.FunderAddress)
+		i = encodeVarintVesting(dAtA, i, uint64(len(m.FunderAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.BaseVestingAccount != nil {
+		{
+			size, err := m.BaseVestingAccount.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintVesting(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintVesting(dAtA []byte, offset int, v uint64) int {
 	offset -= sovVesting(v)
 	base := offset
@@ -656,6 +840,45 @@ func (m *PeriodicVestingAccount) Size() (n int) {
 	return n
 }
 
+func (m *PermanentLockedAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BaseVestingAccount != nil {
+		l = m.BaseVestingAccount.Size()
+		n += 1 + l + sovVesting(uint64(l))
+	}
+	return n
+}
+
+func (m *ClawbackVestingAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BaseVestingAccount != nil {
+		l = m.BaseVestingAccount.Size()
+		n += 1 + l + sovVesting(uint64(l))
+	}
+	l = len(m.FunderAddress)
+	if l > 0 {
+		n += 1 + l + sovVesting(uint64(l))
+	}
+	if m.StartTime != 0 {
+		n += 1 + sovVesting(uint64(m.StartTime))
+	}
+	if len(m.VestingPeriods) > 0 {
+		for _, e := range m.VestingPeriods {
+			l = e.Size()
+			n += 1 + l + sovVesting(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovVesting(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1302,6 +1525,263 @@ func (m *PeriodicVestingAccount) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *PermanentLockedAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVesting
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PermanentLockedAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PermanentLockedAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseVestingAccount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BaseVestingAccount == nil {
+				m.BaseVestingAccount = &BaseVestingAccount{}
+			}
+			if err := m.BaseVestingAccount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVesting(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClawbackVestingAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVesting
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClawbackVestingAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClawbackVestingAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseVestingAccount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BaseVestingAccount == nil {
+				m.BaseVestingAccount = &BaseVestingAccount{}
+			}
+			if err := m.BaseVestingAccount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FunderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FunderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			m.StartTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingPeriods", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VestingPeriods = append(m.VestingPeriods, Period{})
+			if err := m.VestingPeriods[len(m.VestingPeriods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVesting(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipVesting(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -578,6 +578,82 @@ func TestTrackUndelegationPeriodicVestingAcc(t *testing.T) {
 	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 25)}, pva.DelegatedVesting)
 }
 
+func TestGetVestingCoinsPermLockedVestingAcc(t *testing.T) {
+	now := osttime.Now()
+	endTime := now.Add(1000 * 24 * time.Hour)
+
+	_, _, addr := testdata.KeyTestPubAddr()
+	origCoins := sdk.Coins{sdk.NewInt64Coin(feeDenom, 1000), sdk.NewInt64Coin(stakeDenom, 100)}
+	bacc := authtypes.NewBaseAccountWithAddress(addr)
+	plva := types.NewPermanentLockedAccount(bacc, origCoins)
+
+	// require no coins are vested until the very end of the schedule
+	require.Nil(t, plva.GetVestedCoins(now))
+	require.Nil(t, plva.GetVestedCoins(endTime))
+	require.Equal(t, origCoins, plva.GetVestingCoins(endTime))
+	require.Equal(t, origCoins, plva.LockedCoins(endTime))
+
+	// require the locked coins to be delegatable and only the received coins
+	// to be free
+	plva.TrackDelegation(endTime, origCoins, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 100)})
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 100)}, plva.DelegatedVesting)
+	require.Nil(t, plva.DelegatedFree)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 1000)}, plva.LockedCoins(endTime))
+	require.Equal(t, int64(0), plva.GetEndTime())
+}
+
+func TestComputeClawbackVestingAcc(t *testing.T) {
+	now := osttime.Now()
+	endTime := now.Add(24 * time.Hour)
+	periods := types.Periods{
+		types.Period{Length: int64(12 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}},
+		types.Period{Length: int64(6 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 250), sdk.NewInt64Coin(stakeDenom, 25)}},
+		types.Period{Length: int64(6 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 250), sdk.NewInt64Coin(stakeDenom, 25)}},
+	}
+
+	_, _, addr := testdata.KeyTestPubAddr()
+	_, _, funder := testdata.KeyTestPubAddr()
+	origCoins := sdk.Coins{sdk.NewInt64Coin(feeDenom, 1000), sdk.NewInt64Coin(stakeDenom, 100)}
+	bacc := authtypes.NewBaseAccountWithAddress(addr)
+	va := types.NewClawbackVestingAccount(bacc, funder, origCoins, now.Unix(), periods)
+	require.Equal(t, endTime.Unix(), va.GetEndTime())
+	require.Equal(t, funder, va.GetFunder())
+
+	// require the vesting of the periodic schedule
+	require.Nil(t, va.GetVestedCoins(now))
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}, va.GetVestedCoins(now.Add(15*time.Hour)))
+	require.Equal(t, origCoins, va.GetVestedCoins(endTime))
+
+	// delegate all the vesting coins and half of the vested ones
+	va.TrackDelegation(now.Add(15*time.Hour), origCoins, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 75)})
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}, va.DelegatedVesting)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 25)}, va.DelegatedFree)
+
+	// require the unvested coins to be removed from the schedule
+	unvested := va.ComputeClawback(now.Add(15 * time.Hour))
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}, unvested)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}, va.OriginalVesting)
+	require.Equal(t, now.Add(12*time.Hour).Unix(), va.GetEndTime())
+	require.Len(t, va.VestingPeriods, 1)
+	require.Empty(t, va.DelegatedVesting)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 75)}, va.DelegatedFree)
+	require.Empty(t, va.GetVestingCoins(now.Add(15*time.Hour)))
+	require.NoError(t, va.Validate())
+
+	// require an added period to extend the schedule
+	va.AddVestingPeriod(endTime.Unix(), sdk.Coins{sdk.NewInt64Coin(stakeDenom, 10)})
+	require.Equal(t, endTime.Unix(), va.GetEndTime())
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 10)}, va.GetVestingCoins(now.Add(15*time.Hour)))
+	require.NoError(t, va.Validate())
+
+	// require nothing to be clawed back before the start of the schedule
+	va = types.NewClawbackVestingAccount(bacc, funder, origCoins, now.Unix(), periods)
+	require.Equal(t, origCoins, va.ComputeClawback(now))
+	require.Equal(t, now.Unix(), va.GetEndTime())
+	require.Empty(t, va.OriginalVesting)
+	require.NoError(t, va.Validate())
+}
+
 func TestGenesisAccountValidate(t *testing.T) {
 	pubkey := secp256k1.GenPrivKey().PubKey()
 	addr := sdk.AccAddress(pubkey.Address())
@@ -633,6 +709,26 @@ func TestGenesisAccountValidate(t *testing.T) {
 				0, types.Periods{types.Period{Length: int64(100), Amount: sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, 25)}}}),
 			true,
 		},
+		{
+			"valid permanent locked account",
+			types.NewPermanentLockedAccount(baseAcc, initialVesting),
+			false,
+		},
+		{
+			"invalid permanent locked account end time",
+			&types.PermanentLockedAccount{BaseVestingAccount: baseVestingWithCoins},
+			true,
+		},
+		{
+			"valid clawback vesting account",
+			types.NewClawbackVestingAccount(baseAcc, addr, initialVesting, 0, types.Periods{types.Period{Length: int64(100), Amount: sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, 50)}}}),
+			false,
+		},
+		{
+			"invalid clawback vesting account funder",
+			&types.ClawbackVestingAccount{BaseVestingAccount: baseVestingWithCoins, StartTime: 0, VestingPeriods: types.Periods{types.Period{Length: int64(100), Amount: sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, 50)}}}},
+			true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -707,3 +803,45 @@ func TestDelayedVestingAccountMarshal(t *testing.T) {
 	_, err = app.AccountKeeper.UnmarshalAccount(bz[:len(bz)/2])
 	require.NotNil(t, err)
 }
+
+func TestPermanentLockedAccountMarshal(t *testing.T) {
+	pubkey := secp256k1.GenPrivKey().PubKey()
+	addr := sdk.AccAddress(pubkey.Address())
+	coins := sdk.NewCoins(sdk.NewInt64Coin("test", 5))
+	baseAcc := authtypes.NewBaseAccount(addr, pubkey, 10, 50)
+
+	acc := types.NewPermanentLockedAccount(baseAcc, coins)
+
+	bz, err := app.AccountKeeper.MarshalAccount(acc)
+	require.Nil(t, err)
+
+	acc2, err := app.AccountKeeper.UnmarshalAccount(bz)
+	require.Nil(t, err)
+	require.IsType(t, &types.PermanentLockedAccount{}, acc2)
+	require.Equal(t, acc.String(), acc2.String())
+
+	// error on bad bytes
+	_, err = app.AccountKeeper.UnmarshalAccount(bz[:len(bz)/2])
+	require.NotNil(t, err)
+}
+
+func TestClawbackVestingAccountMarshal(t *testing.T) {
+	pubkey := secp256k1.GenPrivKey().PubKey()
+	addr := sdk.AccAddress(pubkey.Address())
+	coins := sdk.NewCoins(sdk.NewInt64Coin("test", 5))
+	baseAcc := authtypes.NewBaseAccount(addr, pubkey, 10, 50)
+
+	acc := types.NewClawbackVestingAccount(baseAcc, addr, coins, time.Now().Unix(), types.Periods{types.Period{3600, coins}})
+
+	bz, err := app.AccountKeeper.MarshalAccount(acc)
+	require.Nil(t, err)
+
+	acc2, err := app.AccountKeeper.UnmarshalAccount(bz)
+	require.Nil(t, err)
+	require.IsType(t, &types.ClawbackVestingAccount{}, acc2)
+	require.Equal(t, acc.String(), acc2.String())
+
+	// error on bad bytes
+	_, err = app.AccountKeeper.UnmarshalAccount(bz[:len(bz)/2])
+	require.NotNil(t, err)
+}
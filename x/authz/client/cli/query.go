@@ -0,0 +1,96 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/line/lfb-sdk/client"
+	"github.com/line/lfb-sdk/client/flags"
+	sdk "github.com/line/lfb-sdk/types"
+	"github.com/line/lfb-sdk/version"
+	"github.com/line/lfb-sdk/x/authz/types"
+	banktypes "github.com/line/lfb-sdk/x/bank/types"
+)
+
+// GetQueryCmd returns the cli query commands for this module
+func GetQueryCmd() *cobra.Command {
+	authorizationQueryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the authz module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	authorizationQueryCmd.AddCommand(
+		GetCmdQueryGrants(),
+	)
+
+	return authorizationQueryCmd
+}
+
+// GetCmdQueryGrants returns cmd to query for the grants of a granter to a grantee.
+func GetCmdQueryGrants() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grants [granter] [grantee] [msg_type_url]?",
+		Args:  cobra.RangeArgs(2, 3),
+		Short: "query grants for a granter-grantee pair and optionally a msg type url",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query authorization grants for a granter-grantee pair. If msg_type_url
+is set, it will select grants only for that msg type.
+
+Examples:
+  $ %[1]s query %[2]s grants link1... link1...
+  $ %[1]s query %[2]s grants link1... link1... %[3]s
+`,
+				version.AppName, types.ModuleName, sdk.MsgTypeURL(&banktypes.MsgSend{}),
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			granter, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			grantee, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+			msgAuthorized := ""
+			if len(args) >= 3 {
+				msgAuthorized = args[2]
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.Grants(
+				context.Background(),
+				&types.QueryGrantsRequest{
+					Granter:    granter.String(),
+					Grantee:    grantee.String(),
+					MsgTypeUrl: msgAuthorized,
+					Pagination: pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "grants")
+	return cmd
+}
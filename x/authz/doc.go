@@ -0,0 +1,16 @@
+/*
+Package authz provides functionality for granting arbitrary privileges from one
+account (the granter) to another account (the grantee).
+
+Authorizations are granted for a single Msg type, identified by its type URL,
+and must implement the Authorization interface. GenericAuthorization grants the
+unrestricted execution of a Msg type, while other modules provide authorizations
+restricting how their Msgs may be executed, e.g. the SendAuthorization of x/bank
+and the StakeAuthorization of x/staking.
+
+A granter grants an authorization with MsgGrant and revokes it with MsgRevoke.
+The grantee executes Msgs on behalf of granters with MsgExec. Each Msg of a
+MsgExec must have a single signer, the granter, and is dispatched to its Msg
+service through the baseapp.MsgServiceRouter once the grant accepted it.
+*/
+package authz
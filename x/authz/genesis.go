@@ -0,0 +1,58 @@
+package authz
+
+import (
+	"fmt"
+
+	sdk "github.com/line/lfb-sdk/types"
+	"github.com/line/lfb-sdk/x/authz/keeper"
+	"github.com/line/lfb-sdk/x/authz/types"
+)
+
+// InitGenesis initializes the authz module's state from a provided genesis
+// state. Grants which have already expired are dropped.
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, gs *types.GenesisState) {
+	if err := gs.Validate(); err != nil {
+		panic(fmt.Sprintf("failed to validate %s genesis state: %s", types.ModuleName, err))
+	}
+
+	now := ctx.BlockTime()
+	for _, entry := range gs.Authorization {
+		if entry.Expiration.Before(now) {
+			continue
+		}
+
+		grantee, err := sdk.AccAddressFromBech32(entry.Grantee)
+		if err != nil {
+			panic(err)
+		}
+		granter, err := sdk.AccAddressFromBech32(entry.Granter)
+		if err != nil {
+			panic(err)
+		}
+
+		a, ok := entry.Authorization.GetCachedValue().(types.Authorization)
+		if !ok {
+			panic("expected authorization")
+		}
+
+		if err := k.SaveGrant(ctx, grantee, granter, a, entry.Expiration); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// ExportGenesis returns the authz module's exported genesis.
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	var entries []types.GrantAuthorization
+	k.IterateGrants(ctx, func(granter, grantee sdk.AccAddress, grant types.Grant) bool {
+		entries = append(entries, types.GrantAuthorization{
+			Granter:       granter.String(),
+			Grantee:       grantee.String(),
+			Authorization: grant.Authorization,
+			Expiration:    grant.Expiration,
+		})
+		return false
+	})
+
+	return types.NewGenesisState(entries)
+}
@@ -0,0 +1,90 @@
+package authz_test
+
+import (
+	"testing"
+	"time"
+
+	ostproto "github.com/line/ostracon/proto/ostracon/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/line/lfb-sdk/crypto/keys/secp256k1"
+	"github.com/line/lfb-sdk/simapp"
+	sdk "github.com/line/lfb-sdk/types"
+	"github.com/line/lfb-sdk/x/authz"
+	"github.com/line/lfb-sdk/x/authz/keeper"
+	"github.com/line/lfb-sdk/x/authz/types"
+	banktypes "github.com/line/lfb-sdk/x/bank/types"
+)
+
+type GenesisTestSuite struct {
+	suite.Suite
+
+	ctx    sdk.Context
+	keeper keeper.Keeper
+}
+
+func (suite *GenesisTestSuite) SetupTest() {
+	checkTx := false
+	app := simapp.Setup(checkTx)
+
+	suite.ctx = app.BaseApp.NewContext(checkTx, ostproto.Header{Height: 1, Time: time.Now().UTC()})
+	suite.keeper = app.AuthzKeeper
+}
+
+var (
+	granteePub  = secp256k1.GenPrivKey().PubKey()
+	granterPub  = secp256k1.GenPrivKey().PubKey()
+	granteeAddr = sdk.AccAddress(granteePub.Address())
+	granterAddr = sdk.AccAddress(granterPub.Address())
+)
+
+func (suite *GenesisTestSuite) TestImportExportGenesis() {
+	coins := sdk.NewCoins(sdk.NewCoin("foo", sdk.NewInt(1000)))
+	now := suite.ctx.BlockHeader().Time
+
+	grant := banktypes.NewSendAuthorization(coins)
+	err := suite.keeper.SaveGrant(suite.ctx, granteeAddr, granterAddr, grant, now.Add(time.Hour))
+	suite.Require().NoError(err)
+
+	genesis := authz.ExportGenesis(suite.ctx, suite.keeper)
+	suite.Require().Len(genesis.Authorization, 1)
+
+	// clear the store and import the exported genesis again
+	suite.SetupTest()
+	authz.InitGenesis(suite.ctx, suite.keeper, genesis)
+
+	newGenesis := authz.ExportGenesis(suite.ctx, suite.keeper)
+	suite.Require().Equal(genesis, newGenesis)
+}
+
+func (suite *GenesisTestSuite) TestInitGenesisDropsExpiredGrants() {
+	coins := sdk.NewCoins(sdk.NewCoin("foo", sdk.NewInt(1000)))
+	now := suite.ctx.BlockHeader().Time
+
+	expired, err := types.NewGrant(banktypes.NewSendAuthorization(coins), now.Add(-time.Hour))
+	suite.Require().NoError(err)
+	genesis := types.NewGenesisState([]types.GrantAuthorization{{
+		Granter:       granterAddr.String(),
+		Grantee:       granteeAddr.String(),
+		Authorization: expired.Authorization,
+		Expiration:    expired.Expiration,
+	}})
+
+	authz.InitGenesis(suite.ctx, suite.keeper, genesis)
+	suite.Require().Empty(authz.ExportGenesis(suite.ctx, suite.keeper).Authorization)
+}
+
+func (suite *GenesisTestSuite) TestInitInvalidGenesis() {
+	genesis := types.NewGenesisState([]types.GrantAuthorization{{
+		Granter: granterAddr.String(),
+		Grantee: granterAddr.String(),
+	}})
+
+	suite.Require().Panics(func() {
+		authz.InitGenesis(suite.ctx, suite.keeper, genesis)
+	})
+}
+
+func TestGenesisTestSuite(t *testing.T) {
+	suite.Run(t, new(GenesisTestSuite))
+}
@@ -0,0 +1,64 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/line/lfb-sdk/store/prefix"
+	sdk "github.com/line/lfb-sdk/types"
+	"github.com/line/lfb-sdk/types/query"
+	"github.com/line/lfb-sdk/x/authz/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// Grants implements the Query/Grants gRPC method.
+func (k Keeper) Grants(c context.Context, req *types.QueryGrantsRequest) (*types.QueryGrantsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	granter, err := sdk.AccAddressFromBech32(req.Granter)
+	if err != nil {
+		return nil, err
+	}
+	grantee, err := sdk.AccAddressFromBech32(req.Grantee)
+	if err != nil {
+		return nil, err
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if req.MsgTypeUrl != "" {
+		grant, found := k.getGrant(ctx, types.GrantStoreKey(granter, grantee, req.MsgTypeUrl))
+		if !found {
+			return nil, status.Errorf(codes.NotFound, "no authorization found for %s type", req.MsgTypeUrl)
+		}
+
+		return &types.QueryGrantsResponse{
+			Grants: []*types.Grant{&grant},
+		}, nil
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	grantsStore := prefix.NewStore(store, types.GrantPrefix(granter, grantee))
+
+	var authorizations []*types.Grant
+	pageRes, err := query.Paginate(grantsStore, req.Pagination, func(key []byte, value []byte) error {
+		var grant types.Grant
+		if err := k.cdc.UnmarshalBinaryBare(value, &grant); err != nil {
+			return err
+		}
+		authorizations = append(authorizations, &grant)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryGrantsResponse{
+		Grants:     authorizations,
+		Pagination: pageRes,
+	}, nil
+}
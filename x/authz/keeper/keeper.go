@@ -0,0 +1,221 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/line/ostracon/libs/log"
+
+	"github.com/line/lfb-sdk/baseapp"
+	"github.com/line/lfb-sdk/codec"
+	sdk "github.com/line/lfb-sdk/types"
+	sdkerrors "github.com/line/lfb-sdk/types/errors"
+	"github.com/line/lfb-sdk/x/authz/types"
+)
+
+// Keeper manages the authorization grants and dispatches the msgs executed
+// through them to their Msg services.
+type Keeper struct {
+	storeKey sdk.StoreKey
+	cdc      codec.BinaryMarshaler
+	router   *baseapp.MsgServiceRouter
+}
+
+// NewKeeper constructs a message authorization Keeper
+func NewKeeper(storeKey sdk.StoreKey, cdc codec.BinaryMarshaler, router *baseapp.MsgServiceRouter) Keeper {
+	return Keeper{
+		storeKey: storeKey,
+		cdc:      cdc,
+		router:   router,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// getGrant returns grant stored at skey.
+func (k Keeper) getGrant(ctx sdk.Context, skey []byte) (grant types.Grant, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(skey)
+	if bz == nil {
+		return grant, false
+	}
+	k.cdc.MustUnmarshalBinaryBare(bz, &grant)
+	return grant, true
+}
+
+func (k Keeper) update(ctx sdk.Context, grantee sdk.AccAddress, granter sdk.AccAddress, updated types.Authorization) error {
+	skey := types.GrantStoreKey(granter, grantee, updated.MsgTypeURL())
+	grant, found := k.getGrant(ctx, skey)
+	if !found {
+		return types.ErrNoAuthorizationFound
+	}
+
+	grant, err := types.NewGrant(updated, grant.Expiration)
+	if err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(skey, k.cdc.MustMarshalBinaryBare(&grant))
+	return nil
+}
+
+// DispatchActions attempts to execute the provided messages via authorization
+// grants from the message signer to the grantee.
+func (k Keeper) DispatchActions(ctx sdk.Context, grantee sdk.AccAddress, msgs []sdk.Msg) ([][]byte, error) {
+	results := make([][]byte, len(msgs))
+	for i, msg := range msgs {
+		signers := msg.GetSigners()
+		if len(signers) != 1 {
+			return nil, types.ErrAuthorizationNumOfSigners
+		}
+		granter := signers[0]
+		msgTypeURL := sdk.MsgTypeURL(msg)
+
+		// if granter != grantee then check authorization.Accept, otherwise we implicitly accept.
+		if !granter.Equals(grantee) {
+			authorization, _ := k.GetCleanAuthorization(ctx, grantee, granter, msgTypeURL)
+			if authorization == nil {
+				return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "authorization not found")
+			}
+			resp, err := authorization.Accept(ctx, msg)
+			if resp.Delete {
+				err = k.DeleteGrant(ctx, grantee, granter, msgTypeURL)
+			} else if resp.Updated != nil {
+				err = k.update(ctx, grantee, granter, resp.Updated)
+			}
+			if err != nil {
+				return nil, err
+			}
+			if !resp.Accept {
+				return nil, sdkerrors.ErrUnauthorized
+			}
+		}
+
+		handler := k.router.HandlerByTypeURL(msgTypeURL)
+		if handler == nil {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized message route: %s", msgTypeURL)
+		}
+		req, ok := msg.(sdk.MsgRequest)
+		if !ok {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "%T is not a Msg service request", msg)
+		}
+
+		msgResp, err := handler(ctx, req)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(err, "failed to execute message; message %v", msg)
+		}
+		results[i] = msgResp.Data
+
+		// emit the events from the dispatched actions
+		events := make(sdk.Events, len(msgResp.Events))
+		for j, event := range msgResp.Events {
+			events[j] = sdk.Event(event)
+		}
+		ctx.EventManager().EmitEvents(events)
+	}
+
+	return results, nil
+}
+
+// SaveGrant method grants the provided authorization to the grantee on the granter's account
+// with the provided expiration time. If there is an existing authorization grant for the
+// same `sdk.Msg` type, this grant overwrites that.
+func (k Keeper) SaveGrant(ctx sdk.Context, grantee, granter sdk.AccAddress, authorization types.Authorization, expiration time.Time) error {
+	store := ctx.KVStore(k.storeKey)
+
+	grant, err := types.NewGrant(authorization, expiration)
+	if err != nil {
+		return err
+	}
+
+	bz := k.cdc.MustMarshalBinaryBare(&grant)
+	msgType := authorization.MsgTypeURL()
+	store.Set(types.GrantStoreKey(granter, grantee, msgType), bz)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeGrant,
+			sdk.NewAttribute(types.AttributeKeyMsgTypeURL, msgType),
+			sdk.NewAttribute(types.AttributeKeyGranter, granter.String()),
+			sdk.NewAttribute(types.AttributeKeyGrantee, grantee.String()),
+		),
+	)
+
+	return nil
+}
+
+// DeleteGrant revokes any authorization for the provided message type granted to the grantee
+// by the granter.
+func (k Keeper) DeleteGrant(ctx sdk.Context, grantee sdk.AccAddress, granter sdk.AccAddress, msgType string) error {
+	store := ctx.KVStore(k.storeKey)
+	skey := types.GrantStoreKey(granter, grantee, msgType)
+	_, found := k.getGrant(ctx, skey)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrNoAuthorizationFound, "failed to delete grant with key %X", skey)
+	}
+	store.Delete(skey)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRevoke,
+			sdk.NewAttribute(types.AttributeKeyMsgTypeURL, msgType),
+			sdk.NewAttribute(types.AttributeKeyGranter, granter.String()),
+			sdk.NewAttribute(types.AttributeKeyGrantee, grantee.String()),
+		),
+	)
+
+	return nil
+}
+
+// GetAuthorizations Returns list of `Authorizations` granted to the grantee by the granter.
+func (k Keeper) GetAuthorizations(ctx sdk.Context, grantee sdk.AccAddress, granter sdk.AccAddress) (authorizations []types.Authorization) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.GrantPrefix(granter, grantee))
+	defer iter.Close()
+
+	var grant types.Grant
+	for ; iter.Valid(); iter.Next() {
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &grant)
+		authorizations = append(authorizations, grant.GetAuthorization())
+	}
+	return authorizations
+}
+
+// GetCleanAuthorization returns an `Authorization` and it's expiration time for
+// (grantee, granter, message name) grant. If there is no grant `nil` is returned.
+// If the grant is expired, the grant is revoked, removed from the storage, and `nil` is returned.
+func (k Keeper) GetCleanAuthorization(ctx sdk.Context, grantee sdk.AccAddress, granter sdk.AccAddress, msgType string) (cap types.Authorization, expiration time.Time) {
+	grant, found := k.getGrant(ctx, types.GrantStoreKey(granter, grantee, msgType))
+	if !found {
+		return nil, time.Time{}
+	}
+	if grant.Expiration.Before(ctx.BlockHeader().Time) {
+		k.DeleteGrant(ctx, grantee, granter, msgType) //nolint:errcheck
+		return nil, time.Time{}
+	}
+
+	return grant.GetAuthorization(), grant.Expiration
+}
+
+// IterateGrants iterates over all authorization grants
+// This function should be used with caution because it can involve significant IO operations.
+// It should not be used in query or msg services without charging additional gas.
+func (k Keeper) IterateGrants(ctx sdk.Context,
+	handler func(granterAddr sdk.AccAddress, granteeAddr sdk.AccAddress, grant types.Grant) bool,
+) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.GrantKey)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var grant types.Grant
+		granterAddr, granteeAddr, _ := types.ParseGrantStoreKey(iter.Key())
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &grant)
+		if handler(granterAddr, granteeAddr, grant) {
+			break
+		}
+	}
+}
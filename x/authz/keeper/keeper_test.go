@@ -0,0 +1,210 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	ostproto "github.com/line/ostracon/proto/ostracon/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/line/lfb-sdk/baseapp"
+	"github.com/line/lfb-sdk/simapp"
+	sdk "github.com/line/lfb-sdk/types"
+	"github.com/line/lfb-sdk/x/authz/keeper"
+	"github.com/line/lfb-sdk/x/authz/types"
+	banktypes "github.com/line/lfb-sdk/x/bank/types"
+)
+
+var bankSendAuthMsgType = banktypes.SendAuthorization{}.MsgTypeURL()
+
+type TestSuite struct {
+	suite.Suite
+
+	app         *simapp.SimApp
+	ctx         sdk.Context
+	addrs       []sdk.AccAddress
+	queryClient types.QueryClient
+}
+
+func TestTestSuite(t *testing.T) {
+	suite.Run(t, new(TestSuite))
+}
+
+func (s *TestSuite) SetupTest() {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, ostproto.Header{Time: time.Now().UTC()})
+
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, app.AuthzKeeper)
+
+	s.app = app
+	s.ctx = ctx
+	s.queryClient = types.NewQueryClient(queryHelper)
+	s.addrs = simapp.AddTestAddrsIncremental(app, ctx, 3, sdk.NewInt(30000000))
+}
+
+func (s *TestSuite) TestKeeper() {
+	app, ctx, addrs := s.app, s.ctx, s.addrs
+	granterAddr, granteeAddr := addrs[0], addrs[1]
+	now := ctx.BlockTime()
+
+	s.T().Log("verify that no authorization returns nil")
+	authorization, expiration := app.AuthzKeeper.GetCleanAuthorization(ctx, granteeAddr, granterAddr, bankSendAuthMsgType)
+	s.Require().Nil(authorization)
+	s.Require().Zero(expiration)
+
+	s.T().Log("verify if expired authorization is rejected")
+	newCoins := sdk.NewCoins(sdk.NewInt64Coin("steak", 100))
+	err := app.AuthzKeeper.SaveGrant(ctx, granteeAddr, granterAddr, banktypes.NewSendAuthorization(newCoins), now.Add(-1*time.Hour))
+	s.Require().NoError(err)
+	authorization, _ = app.AuthzKeeper.GetCleanAuthorization(ctx, granteeAddr, granterAddr, bankSendAuthMsgType)
+	s.Require().Nil(authorization)
+
+	s.T().Log("verify if authorization is accepted")
+	err = app.AuthzKeeper.SaveGrant(ctx, granteeAddr, granterAddr, banktypes.NewSendAuthorization(newCoins), now.Add(time.Hour))
+	s.Require().NoError(err)
+	authorization, _ = app.AuthzKeeper.GetCleanAuthorization(ctx, granteeAddr, granterAddr, bankSendAuthMsgType)
+	s.Require().NotNil(authorization)
+	s.Require().Equal(bankSendAuthMsgType, authorization.MsgTypeURL())
+	s.Require().Len(app.AuthzKeeper.GetAuthorizations(ctx, granteeAddr, granterAddr), 1)
+
+	s.T().Log("verify fetching authorization with wrong msg type fails")
+	authorization, _ = app.AuthzKeeper.GetCleanAuthorization(ctx, granteeAddr, granterAddr, sdk.MsgTypeURL(&banktypes.MsgMultiSend{}))
+	s.Require().Nil(authorization)
+
+	s.T().Log("verify fetching authorization with wrong grantee fails")
+	authorization, _ = app.AuthzKeeper.GetCleanAuthorization(ctx, addrs[2], granterAddr, bankSendAuthMsgType)
+	s.Require().Nil(authorization)
+
+	s.T().Log("verify revoke fails with wrong information")
+	err = app.AuthzKeeper.DeleteGrant(ctx, addrs[2], granterAddr, bankSendAuthMsgType)
+	s.Require().Error(err)
+	authorization, _ = app.AuthzKeeper.GetCleanAuthorization(ctx, addrs[2], granterAddr, bankSendAuthMsgType)
+	s.Require().Nil(authorization)
+
+	s.T().Log("verify revoke executes with correct information")
+	err = app.AuthzKeeper.DeleteGrant(ctx, granteeAddr, granterAddr, bankSendAuthMsgType)
+	s.Require().NoError(err)
+	authorization, _ = app.AuthzKeeper.GetCleanAuthorization(ctx, granteeAddr, granterAddr, bankSendAuthMsgType)
+	s.Require().Nil(authorization)
+}
+
+func (s *TestSuite) TestKeeperIter() {
+	app, ctx, addrs := s.app, s.ctx, s.addrs
+	granterAddr, granteeAddr := addrs[0], addrs[1]
+
+	newCoins := sdk.NewCoins(sdk.NewInt64Coin("steak", 100))
+	err := app.AuthzKeeper.SaveGrant(ctx, granteeAddr, granterAddr, banktypes.NewSendAuthorization(newCoins), ctx.BlockTime().Add(time.Hour))
+	s.Require().NoError(err)
+	err = app.AuthzKeeper.SaveGrant(ctx, granteeAddr, granterAddr, types.NewGenericAuthorization(sdk.MsgTypeURL(&banktypes.MsgMultiSend{})), ctx.BlockTime().Add(time.Hour))
+	s.Require().NoError(err)
+
+	var count int
+	app.AuthzKeeper.IterateGrants(ctx, func(granter, grantee sdk.AccAddress, grant types.Grant) bool {
+		s.Require().Equal(granterAddr, granter)
+		s.Require().Equal(granteeAddr, grantee)
+		count++
+		return false
+	})
+	s.Require().Equal(2, count)
+}
+
+func (s *TestSuite) TestDispatchActions() {
+	app, ctx, addrs := s.app, s.ctx, s.addrs
+	granterAddr, granteeAddr, recipientAddr := addrs[0], addrs[1], addrs[2]
+	a := banktypes.NewSendAuthorization(sdk.NewCoins(sdk.NewInt64Coin("stake", 100)))
+
+	newMsgSend := func(amount int64) []sdk.Msg {
+		return []sdk.Msg{banktypes.NewMsgSend(granterAddr, recipientAddr, sdk.NewCoins(sdk.NewInt64Coin("stake", amount)))}
+	}
+
+	s.T().Log("verify dispatch fails without an authorization")
+	_, err := app.AuthzKeeper.DispatchActions(ctx, granteeAddr, newMsgSend(20))
+	s.Require().Error(err)
+
+	s.T().Log("verify dispatch fails with an invalid authorization")
+	err = app.AuthzKeeper.SaveGrant(ctx, granteeAddr, granterAddr, a, ctx.BlockTime().Add(time.Hour))
+	s.Require().NoError(err)
+	_, err = app.AuthzKeeper.DispatchActions(ctx, granteeAddr, newMsgSend(200))
+	s.Require().Error(err)
+
+	s.T().Log("verify dispatch executes the msg and updates the authorization")
+	balance := app.BankKeeper.GetBalance(ctx, recipientAddr, "stake")
+	_, err = app.AuthzKeeper.DispatchActions(ctx, granteeAddr, newMsgSend(20))
+	s.Require().NoError(err)
+	s.Require().Equal(balance.Add(sdk.NewInt64Coin("stake", 20)), app.BankKeeper.GetBalance(ctx, recipientAddr, "stake"))
+
+	authorization, _ := app.AuthzKeeper.GetCleanAuthorization(ctx, granteeAddr, granterAddr, bankSendAuthMsgType)
+	s.Require().NotNil(authorization)
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 80)), authorization.(*banktypes.SendAuthorization).SpendLimit)
+
+	s.T().Log("verify dispatch deletes the authorization once it is used up")
+	_, err = app.AuthzKeeper.DispatchActions(ctx, granteeAddr, newMsgSend(80))
+	s.Require().NoError(err)
+	authorization, _ = app.AuthzKeeper.GetCleanAuthorization(ctx, granteeAddr, granterAddr, bankSendAuthMsgType)
+	s.Require().Nil(authorization)
+
+	s.T().Log("verify a granter can execute its own msgs without an authorization")
+	_, err = app.AuthzKeeper.DispatchActions(ctx, granterAddr, newMsgSend(10))
+	s.Require().NoError(err)
+}
+
+func (s *TestSuite) TestMsgServer() {
+	app, ctx, addrs := s.app, s.ctx, s.addrs
+	granterAddr, granteeAddr, recipientAddr := addrs[0], addrs[1], addrs[2]
+	msgServer := keeper.NewMsgServerImpl(app.AuthzKeeper)
+	goCtx := sdk.WrapSDKContext(ctx)
+	msgType := sdk.MsgTypeURL(&banktypes.MsgSend{})
+
+	s.T().Log("verify grant fails with an expiration in the past")
+	grantMsg, err := types.NewMsgGrant(granterAddr, granteeAddr, types.NewGenericAuthorization(msgType), ctx.BlockTime().Add(-time.Hour))
+	s.Require().NoError(err)
+	_, err = msgServer.Grant(goCtx, grantMsg)
+	s.Require().Error(err)
+
+	s.T().Log("verify grant fails with an unknown msg type")
+	grantMsg, err = types.NewMsgGrant(granterAddr, granteeAddr, types.NewGenericAuthorization("/lfb.unknown.v1beta1.MsgUnknown"), ctx.BlockTime().Add(time.Hour))
+	s.Require().NoError(err)
+	_, err = msgServer.Grant(goCtx, grantMsg)
+	s.Require().Error(err)
+
+	s.T().Log("verify exec runs the msg after a generic grant")
+	grantMsg, err = types.NewMsgGrant(granterAddr, granteeAddr, types.NewGenericAuthorization(msgType), ctx.BlockTime().Add(time.Hour))
+	s.Require().NoError(err)
+	_, err = msgServer.Grant(goCtx, grantMsg)
+	s.Require().NoError(err)
+
+	balance := app.BankKeeper.GetBalance(ctx, recipientAddr, "stake")
+	execMsg := types.NewMsgExec(granteeAddr, []sdk.Msg{
+		banktypes.NewMsgSend(granterAddr, recipientAddr, sdk.NewCoins(sdk.NewInt64Coin("stake", 10))),
+	})
+	res, err := msgServer.Exec(goCtx, &execMsg)
+	s.Require().NoError(err)
+	s.Require().Len(res.Results, 1)
+	s.Require().Equal(balance.Add(sdk.NewInt64Coin("stake", 10)), app.BankKeeper.GetBalance(ctx, recipientAddr, "stake"))
+
+	s.T().Log("verify the grant can be queried")
+	queryRes, err := s.queryClient.Grants(goCtx, &types.QueryGrantsRequest{
+		Granter: granterAddr.String(), Grantee: granteeAddr.String(), MsgTypeUrl: msgType,
+	})
+	s.Require().NoError(err)
+	s.Require().Len(queryRes.Grants, 1)
+
+	queryRes, err = s.queryClient.Grants(goCtx, &types.QueryGrantsRequest{
+		Granter: granterAddr.String(), Grantee: granteeAddr.String(),
+	})
+	s.Require().NoError(err)
+	s.Require().Len(queryRes.Grants, 1)
+
+	s.T().Log("verify exec fails after revoke")
+	revokeMsg := types.NewMsgRevoke(granterAddr, granteeAddr, msgType)
+	_, err = msgServer.Revoke(goCtx, &revokeMsg)
+	s.Require().NoError(err)
+	_, err = msgServer.Exec(goCtx, &execMsg)
+	s.Require().Error(err)
+
+	_, err = s.queryClient.Grants(goCtx, &types.QueryGrantsRequest{
+		Granter: granterAddr.String(), Grantee: granteeAddr.String(), MsgTypeUrl: msgType,
+	})
+	s.Require().Error(err)
+}
@@ -0,0 +1,27 @@
+package simulation
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/line/lfb-sdk/codec"
+	"github.com/line/lfb-sdk/types/kv"
+	"github.com/line/lfb-sdk/x/authz/types"
+)
+
+// NewDecodeStore returns a decoder function closure that umarshals the KVPair's
+// Value to the corresponding authz type.
+func NewDecodeStore(cdc codec.Marshaler) func(kvA, kvB kv.Pair) string {
+	return func(kvA, kvB kv.Pair) string {
+		switch {
+		case bytes.Equal(kvA.Key[:1], types.GrantKey):
+			var grantA, grantB types.Grant
+			cdc.MustUnmarshalBinaryBare(kvA.Value, &grantA)
+			cdc.MustUnmarshalBinaryBare(kvB.Value, &grantB)
+			return fmt.Sprintf("%v\n%v", grantA, grantB)
+
+		default:
+			panic(fmt.Sprintf("invalid authz key %X", kvA.Key))
+		}
+	}
+}
@@ -0,0 +1,81 @@
+package types
+
+import (
+	"github.com/line/lfb-sdk/codec"
+	"github.com/line/lfb-sdk/codec/types"
+	cryptocodec "github.com/line/lfb-sdk/crypto/codec"
+	sdk "github.com/line/lfb-sdk/types"
+	"github.com/line/lfb-sdk/types/msgservice"
+)
+
+// RegisterLegacyAminoCodec registers the necessary x/authz interfaces and concrete types
+// on the provided LegacyAmino codec. These types are used for Amino JSON serialization.
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterInterface((*Authorization)(nil), nil)
+	cdc.RegisterConcrete(&MsgGrant{}, "lfb-sdk/MsgGrant", nil)
+	cdc.RegisterConcrete(&MsgRevoke{}, "lfb-sdk/MsgRevoke", nil)
+	cdc.RegisterConcrete(&MsgExec{}, "lfb-sdk/MsgExec", nil)
+	cdc.RegisterConcrete(&GenericAuthorization{}, "lfb-sdk/GenericAuthorization", nil)
+}
+
+// RegisterInterfaces registers the interfaces types with the interface registry
+func RegisterInterfaces(registry types.InterfaceRegistry) {
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgGrant{},
+		&MsgRevoke{},
+		&MsgExec{},
+	)
+
+	registry.RegisterInterface(
+		"lfb.authz.v1beta1.Authorization",
+		(*Authorization)(nil),
+		&GenericAuthorization{},
+	)
+
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
+
+// RegisterAuthorizationTypeCodec registers an external authorization type
+// defined in another module for the internal ModuleCdc. This allows the MsgGrant
+// to be correctly Amino encoded and decoded.
+//
+// NOTE: This should only be used for applications that are still using a concrete
+// Amino codec for serialization.
+func RegisterAuthorizationTypeCodec(o interface{}, name string) {
+	amino.RegisterConcrete(o, name, nil)
+}
+
+// RegisterAuthorizationInterfaceCodec registers an interface used by the fields
+// of an external authorization type, e.g. a oneof, for the internal ModuleCdc.
+// It must be called before the implementations of the interface are registered.
+func RegisterAuthorizationInterfaceCodec(o interface{}) {
+	amino.RegisterInterface(o, nil)
+}
+
+// RegisterMsgTypeCodec registers an external sdk.Msg type defined in another
+// module for the internal ModuleCdc. This allows the MsgExec to be correctly
+// Amino encoded and decoded.
+//
+// NOTE: This should only be used for applications that are still using a concrete
+// Amino codec for serialization.
+func RegisterMsgTypeCodec(o interface{}, name string) {
+	amino.RegisterConcrete(o, name, nil)
+}
+
+var (
+	amino = codec.NewLegacyAmino()
+
+	// ModuleCdc references the global x/authz module codec. Note, the codec
+	// should ONLY be used in certain instances of tests and for JSON encoding as Amino
+	// is still used for that purpose.
+	//
+	// The actual codec used for serialization should be provided to x/authz and
+	// defined at the application level.
+	ModuleCdc = codec.NewAminoCodec(amino)
+)
+
+func init() {
+	RegisterLegacyAminoCodec(amino)
+	cryptocodec.RegisterCrypto(amino)
+	sdk.RegisterLegacyAminoCodec(amino)
+}
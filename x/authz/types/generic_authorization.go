@@ -0,0 +1,35 @@
+package types
+
+import (
+	sdk "github.com/line/lfb-sdk/types"
+	sdkerrors "github.com/line/lfb-sdk/types/errors"
+)
+
+var (
+	_ Authorization = &GenericAuthorization{}
+)
+
+// NewGenericAuthorization creates a new GenericAuthorization object.
+func NewGenericAuthorization(msgTypeURL string) *GenericAuthorization {
+	return &GenericAuthorization{
+		Msg: msgTypeURL,
+	}
+}
+
+// MsgTypeURL implements Authorization.MsgTypeURL.
+func (a GenericAuthorization) MsgTypeURL() string {
+	return a.Msg
+}
+
+// Accept implements Authorization.Accept.
+func (a GenericAuthorization) Accept(ctx sdk.Context, msg sdk.Msg) (AcceptResponse, error) {
+	return AcceptResponse{Accept: true}, nil
+}
+
+// ValidateBasic implements Authorization.ValidateBasic.
+func (a GenericAuthorization) ValidateBasic() error {
+	if a.Msg == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidType, "msg type url cannot be empty")
+	}
+	return nil
+}
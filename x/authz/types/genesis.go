@@ -0,0 +1,78 @@
+package types
+
+import (
+	"github.com/line/lfb-sdk/codec/types"
+	sdk "github.com/line/lfb-sdk/types"
+	sdkerrors "github.com/line/lfb-sdk/types/errors"
+)
+
+var (
+	_ types.UnpackInterfacesMessage = GenesisState{}
+	_ types.UnpackInterfacesMessage = GrantAuthorization{}
+)
+
+// NewGenesisState creates new GenesisState object
+func NewGenesisState(entries []GrantAuthorization) *GenesisState {
+	return &GenesisState{
+		Authorization: entries,
+	}
+}
+
+// DefaultGenesisState returns the authz module's default genesis state.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	for _, a := range gs.Authorization {
+		if err := a.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (gs GenesisState) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	for _, a := range gs.Authorization {
+		err := a.UnpackInterfaces(unpacker)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ValidateBasic performs a stateless validation of the grant authorization.
+func (ga GrantAuthorization) ValidateBasic() error {
+	granter, err := sdk.AccAddressFromBech32(ga.Granter)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid granter address (%s)", err)
+	}
+	grantee, err := sdk.AccAddressFromBech32(ga.Grantee)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid grantee address (%s)", err)
+	}
+	if granter.Equals(grantee) {
+		return sdkerrors.Wrap(ErrGranteeIsGranter, "granter and grantee cannot be same")
+	}
+
+	if ga.Authorization == nil {
+		return sdkerrors.Wrap(ErrNoAuthorizationFound, "authorization is missing")
+	}
+	a, ok := ga.Authorization.GetCachedValue().(Authorization)
+	if !ok {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "expected %T, got %T", (Authorization)(nil), ga.Authorization.GetCachedValue())
+	}
+	return a.ValidateBasic()
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (ga GrantAuthorization) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	var a Authorization
+	return unpacker.UnpackAny(ga.Authorization, &a)
+}
@@ -0,0 +1,62 @@
+package types
+
+import (
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/line/lfb-sdk/codec/types"
+	sdkerrors "github.com/line/lfb-sdk/types/errors"
+)
+
+var _ types.UnpackInterfacesMessage = &Grant{}
+
+// NewGrant returns new Grant
+func NewGrant(a Authorization, expiration time.Time) (Grant, error) {
+	g := Grant{
+		Expiration: expiration,
+	}
+	msg, ok := a.(proto.Message)
+	if !ok {
+		return Grant{}, sdkerrors.Wrapf(sdkerrors.ErrPackAny, "cannot proto marshal %T", a)
+	}
+
+	any, err := types.NewAnyWithValue(msg)
+	if err != nil {
+		return Grant{}, err
+	}
+	g.Authorization = any
+
+	return g, nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (g Grant) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	var authorization Authorization
+	return unpacker.UnpackAny(g.Authorization, &authorization)
+}
+
+// GetAuthorization returns the cached value from the Grant.Authorization if present.
+func (g Grant) GetAuthorization() Authorization {
+	if g.Authorization == nil {
+		return nil
+	}
+	a, ok := g.Authorization.GetCachedValue().(Authorization)
+	if !ok {
+		return nil
+	}
+	return a
+}
+
+// ValidateBasic performs a stateless validation of the grant.
+func (g Grant) ValidateBasic() error {
+	if g.Authorization == nil {
+		return sdkerrors.Wrap(ErrNoAuthorizationFound, "authorization is missing")
+	}
+	av := g.Authorization.GetCachedValue()
+	a, ok := av.(Authorization)
+	if !ok {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "expected %T, got %T", (Authorization)(nil), av)
+	}
+	return a.ValidateBasic()
+}
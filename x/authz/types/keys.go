@@ -0,0 +1,45 @@
+package types
+
+import (
+	sdk "github.com/line/lfb-sdk/types"
+)
+
+const (
+	// ModuleName is the module name constant used in many places
+	ModuleName = "authz"
+
+	// StoreKey is the store key string for authz
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for authz
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for authz
+	QuerierRoute = ModuleName
+)
+
+var (
+	// GrantKey is the prefix of the kvstore for grants
+	GrantKey = []byte{0x01}
+)
+
+// GrantStoreKey returns the key of the grant of granter to grantee for the
+// msg type, i.e. 0x01<granter><grantee><msgType>.
+func GrantStoreKey(granter, grantee sdk.AccAddress, msgType string) []byte {
+	return append(GrantPrefix(granter, grantee), []byte(msgType)...)
+}
+
+// GrantPrefix returns the prefix of all grants of granter to grantee.
+func GrantPrefix(granter, grantee sdk.AccAddress) []byte {
+	key := append(append([]byte{}, GrantKey...), granter.Bytes()...)
+	return append(key, grantee.Bytes()...)
+}
+
+// ParseGrantStoreKey parses a grant store key into its granter, grantee and
+// msg type.
+func ParseGrantStoreKey(key []byte) (granter, grantee sdk.AccAddress, msgType string) {
+	key = key[len(GrantKey):]
+	granter = sdk.AccAddress(key[:sdk.AddrLen])
+	grantee = sdk.AccAddress(key[sdk.AddrLen : 2*sdk.AddrLen])
+	return granter, grantee, string(key[2*sdk.AddrLen:])
+}
@@ -0,0 +1,248 @@
+package types
+
+import (
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/line/lfb-sdk/codec/types"
+	sdk "github.com/line/lfb-sdk/types"
+	sdkerrors "github.com/line/lfb-sdk/types/errors"
+)
+
+// authz message types
+const (
+	TypeMsgGrant  = "grant"
+	TypeMsgRevoke = "revoke"
+	TypeMsgExec   = "exec"
+)
+
+var (
+	_, _, _ sdk.Msg                       = &MsgGrant{}, &MsgRevoke{}, &MsgExec{}
+	_, _    types.UnpackInterfacesMessage = &MsgGrant{}, &MsgExec{}
+)
+
+// NewMsgGrant creates a new MsgGrant
+//nolint:interfacer
+func NewMsgGrant(granter sdk.AccAddress, grantee sdk.AccAddress, a Authorization, expiration time.Time) (*MsgGrant, error) {
+	m := &MsgGrant{
+		Granter: granter.String(),
+		Grantee: grantee.String(),
+		Grant:   Grant{Expiration: expiration},
+	}
+	err := m.SetAuthorization(a)
+	if err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgGrant) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgGrant) Type() string { return TypeMsgGrant }
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgGrant) ValidateBasic() error {
+	granter, err := sdk.AccAddressFromBech32(msg.Granter)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid granter address (%s)", err)
+	}
+	grantee, err := sdk.AccAddressFromBech32(msg.Grantee)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid grantee address (%s)", err)
+	}
+
+	if granter.Equals(grantee) {
+		return sdkerrors.Wrap(ErrGranteeIsGranter, "granter and grantee cannot be same")
+	}
+	return msg.Grant.ValidateBasic()
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgGrant) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgGrant) GetSigners() []sdk.AccAddress {
+	granter, err := sdk.AccAddressFromBech32(msg.Granter)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{granter}
+}
+
+// GetAuthorization returns the cache value from the MsgGrant.Authorization if present.
+func (msg *MsgGrant) GetAuthorization() Authorization {
+	return msg.Grant.GetAuthorization()
+}
+
+// SetAuthorization converts Authorization to any and adds it to MsgGrant.Authorization.
+func (msg *MsgGrant) SetAuthorization(a Authorization) error {
+	m, ok := a.(proto.Message)
+	if !ok {
+		return sdkerrors.Wrapf(sdkerrors.ErrPackAny, "can't proto marshal %T", m)
+	}
+	any, err := types.NewAnyWithValue(m)
+	if err != nil {
+		return err
+	}
+	msg.Grant.Authorization = any
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg MsgGrant) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	return msg.Grant.UnpackInterfaces(unpacker)
+}
+
+// NewMsgRevoke creates a new MsgRevoke
+//nolint:interfacer
+func NewMsgRevoke(granter sdk.AccAddress, grantee sdk.AccAddress, msgTypeURL string) MsgRevoke {
+	return MsgRevoke{
+		Granter:    granter.String(),
+		Grantee:    grantee.String(),
+		MsgTypeUrl: msgTypeURL,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgRevoke) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgRevoke) Type() string { return TypeMsgRevoke }
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgRevoke) ValidateBasic() error {
+	granter, err := sdk.AccAddressFromBech32(msg.Granter)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid granter address (%s)", err)
+	}
+	grantee, err := sdk.AccAddressFromBech32(msg.Grantee)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid grantee address (%s)", err)
+	}
+
+	if granter.Equals(grantee) {
+		return sdkerrors.Wrap(ErrGranteeIsGranter, "granter and grantee cannot be same")
+	}
+
+	if msg.MsgTypeUrl == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "missing msg type url")
+	}
+
+	return nil
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgRevoke) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgRevoke) GetSigners() []sdk.AccAddress {
+	granter, err := sdk.AccAddressFromBech32(msg.Granter)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{granter}
+}
+
+// NewMsgExec creates a new MsgExec. The requests of ServiceMsgs are packed in
+// place of the ServiceMsgs themselves.
+//nolint:interfacer
+func NewMsgExec(grantee sdk.AccAddress, msgs []sdk.Msg) MsgExec {
+	msgsAny := make([]*types.Any, len(msgs))
+	for i, msg := range msgs {
+		var m proto.Message = msg
+		if svcMsg, ok := msg.(sdk.ServiceMsg); ok {
+			m = svcMsg.Request
+		}
+
+		any, err := types.NewAnyWithValue(m)
+		if err != nil {
+			panic(err)
+		}
+
+		msgsAny[i] = any
+	}
+
+	return MsgExec{
+		Grantee: grantee.String(),
+		Msgs:    msgsAny,
+	}
+}
+
+// GetMessages returns the cache values from the MsgExec.Msgs if present.
+func (msg MsgExec) GetMessages() ([]sdk.Msg, error) {
+	msgs := make([]sdk.Msg, len(msg.Msgs))
+	for i, msgAny := range msg.Msgs {
+		msg, ok := msgAny.GetCachedValue().(sdk.Msg)
+		if !ok {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "messages contains %T which is not a sdk.Msg", msgAny)
+		}
+		msgs[i] = msg
+	}
+
+	return msgs, nil
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgExec) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgExec) Type() string { return TypeMsgExec }
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgExec) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Grantee)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid grantee address (%s)", err)
+	}
+
+	if len(msg.Msgs) == 0 {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "messages cannot be empty")
+	}
+
+	msgs, err := msg.GetMessages()
+	if err != nil {
+		return err
+	}
+
+	for _, msg := range msgs {
+		if err = msg.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgExec) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgExec) GetSigners() []sdk.AccAddress {
+	grantee, err := sdk.AccAddressFromBech32(msg.Grantee)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{grantee}
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg MsgExec) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	for _, x := range msg.Msgs {
+		var msgExecAuthorized sdk.Msg
+		err := unpacker.UnpackAny(x, &msgExecAuthorized)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,132 @@
+package types_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/line/lfb-sdk/types"
+	"github.com/line/lfb-sdk/x/authz/types"
+	banktypes "github.com/line/lfb-sdk/x/bank/types"
+	stakingtypes "github.com/line/lfb-sdk/x/staking/types"
+)
+
+var (
+	coinsPos = sdk.NewCoins(sdk.NewInt64Coin("steak", 100))
+	granter  = sdk.AccAddress("_______granter______")
+	grantee  = sdk.AccAddress("_______grantee______")
+)
+
+func TestMsgExecAuthorized(t *testing.T) {
+	tests := []struct {
+		title      string
+		grantee    sdk.AccAddress
+		msgs       []sdk.Msg
+		expectPass bool
+	}{
+		{"nil grantee address", nil, []sdk.Msg{}, false},
+		{"zero-messages test: should fail", grantee, []sdk.Msg{}, false},
+		{"valid test: msg type", grantee, []sdk.Msg{
+			banktypes.NewMsgSend(granter, grantee, coinsPos),
+		}, true},
+		{"valid test: service msg", grantee, []sdk.Msg{
+			sdk.ServiceMsg{
+				MethodName: "/lfb.bank.v1beta1.Msg/Send",
+				Request:    banktypes.NewMsgSend(granter, grantee, coinsPos),
+			},
+		}, true},
+		{"invalid test: msg fails validation", grantee, []sdk.Msg{
+			banktypes.NewMsgSend(granter, grantee, sdk.Coins{}),
+		}, false},
+	}
+	for i, tc := range tests {
+		msg := types.NewMsgExec(tc.grantee, tc.msgs)
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
+
+func TestMsgRevokeAuthorization(t *testing.T) {
+	tests := []struct {
+		title            string
+		granter, grantee sdk.AccAddress
+		msgType          string
+		expectPass       bool
+	}{
+		{"nil Granter address", nil, grantee, "hello", false},
+		{"nil Grantee address", granter, nil, "hello", false},
+		{"nil Granter and Grantee address", nil, nil, "hello", false},
+		{"valid test case", granter, grantee, "hello", true},
+		{"same granter and grantee", granter, granter, "hello", false},
+		{"empty msg type", granter, grantee, "", false},
+	}
+	for i, tc := range tests {
+		msg := types.NewMsgRevoke(tc.granter, tc.grantee, tc.msgType)
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
+
+func TestMsgGrantAuthorization(t *testing.T) {
+	tests := []struct {
+		title            string
+		granter, grantee sdk.AccAddress
+		authorization    types.Authorization
+		expiration       time.Time
+		expectErr        bool
+		expectPass       bool
+	}{
+		{"nil granter address", nil, grantee, &banktypes.SendAuthorization{SpendLimit: coinsPos}, time.Now(), false, false},
+		{"nil grantee address", granter, nil, &banktypes.SendAuthorization{SpendLimit: coinsPos}, time.Now(), false, false},
+		{"nil granter and grantee address", nil, nil, &banktypes.SendAuthorization{SpendLimit: coinsPos}, time.Now(), false, false},
+		{"nil authorization", granter, grantee, nil, time.Now(), true, false},
+		{"valid test case", granter, grantee, &banktypes.SendAuthorization{SpendLimit: coinsPos}, time.Now().AddDate(0, 1, 0), false, true},
+		{"invalid authorization", granter, grantee, &banktypes.SendAuthorization{}, time.Now().AddDate(0, 1, 0), false, false},
+		{"same granter and grantee", granter, granter, &banktypes.SendAuthorization{SpendLimit: coinsPos}, time.Now().AddDate(0, 1, 0), false, false},
+	}
+	for i, tc := range tests {
+		msg, err := types.NewMsgGrant(tc.granter, tc.grantee, tc.authorization, tc.expiration)
+		if tc.expectErr {
+			require.Error(t, err)
+			continue
+		}
+		require.NoError(t, err)
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
+
+func TestMsgGrantGetSignBytes(t *testing.T) {
+	expiration := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	msg, err := types.NewMsgGrant(granter, grantee, banktypes.NewSendAuthorization(coinsPos), expiration)
+	require.NoError(t, err)
+	expected := fmt.Sprintf(`{"type":"lfb-sdk/MsgGrant","value":{"grant":{"authorization":{"type":"lfb-sdk/SendAuthorization","value":{"spend_limit":[{"amount":"100","denom":"steak"}]}},"expiration":"2022-01-01T00:00:00Z"},"grantee":"%s","granter":"%s"}}`,
+		grantee, granter)
+	require.Equal(t, expected, string(msg.GetSignBytes()))
+
+	valAddr := sdk.ValAddress(granter)
+	stakeAuthorization, err := stakingtypes.NewStakeAuthorization([]sdk.ValAddress{valAddr}, nil, stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_DELEGATE, nil)
+	require.NoError(t, err)
+	msg, err = types.NewMsgGrant(granter, grantee, stakeAuthorization, expiration)
+	require.NoError(t, err)
+	require.NotPanics(t, func() { msg.GetSignBytes() })
+}
+
+func TestMsgExecGetSignBytes(t *testing.T) {
+	msg := types.NewMsgExec(grantee, []sdk.Msg{banktypes.NewMsgSend(granter, grantee, coinsPos)})
+	expected := fmt.Sprintf(`{"type":"lfb-sdk/MsgExec","value":{"grantee":"%s","msgs":[{"type":"lfb-sdk/MsgSend","value":{"amount":[{"amount":"100","denom":"steak"}],"from_address":"%s","to_address":"%s"}}]}}`,
+		grantee, granter, grantee)
+	require.Equal(t, expected, string(msg.GetSignBytes()))
+}
@@ -0,0 +1,81 @@
+package keeper
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/line/lfb-sdk/codec"
+	sdk "github.com/line/lfb-sdk/types"
+	"github.com/line/lfb-sdk/x/bank/types"
+)
+
+// BalanceAccumulator declares the balances of accounts which txs only add to,
+// such as the fee collector receiving the fees of all txs, so that the txs of
+// a block crediting them can be executed concurrently. The encoded length of a
+// balance never decreases when its amount grows, as the amount is encoded as a
+// decimal string.
+// It implements the baseapp.TxAccumulator interface.
+type BalanceAccumulator struct {
+	cdc      codec.BinaryMarshaler
+	storeKey sdk.StoreKey
+	prefixes [][]byte
+}
+
+// BalanceAccumulator returns the accumulator of the balances of the given
+// addresses.
+func (k BaseViewKeeper) BalanceAccumulator(addrs ...sdk.AccAddress) BalanceAccumulator {
+	prefixes := make([][]byte, len(addrs))
+	for i, addr := range addrs {
+		prefixes[i] = append(append([]byte{}, types.BalancesPrefix...), addr.Bytes()...)
+	}
+
+	return BalanceAccumulator{
+		cdc:      k.cdc,
+		storeKey: k.storeKey,
+		prefixes: prefixes,
+	}
+}
+
+// IsAccumulated returns true if the key is a balance of one of the addresses.
+func (a BalanceAccumulator) IsAccumulated(storeKey sdk.StoreKey, key []byte) bool {
+	if storeKey != a.storeKey {
+		return false
+	}
+
+	for _, prefix := range a.prefixes {
+		if bytes.HasPrefix(key, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// Merge adds the amount a tx added to the balance to its current amount.
+func (a BalanceAccumulator) Merge(original, written, current []byte) ([]byte, error) {
+	originalAmt, writtenAmt, currentAmt := a.amount(original), a.amount(written), a.amount(current)
+	if writtenAmt.LT(originalAmt) {
+		return nil, fmt.Errorf("balance decreased from %s to %s", originalAmt, writtenAmt)
+	}
+
+	// the denom is only known from a stored balance
+	var balance sdk.Coin
+	switch {
+	case current != nil:
+		a.cdc.MustUnmarshalBinaryBare(current, &balance)
+	default:
+		a.cdc.MustUnmarshalBinaryBare(written, &balance)
+	}
+
+	balance.Amount = currentAmt.Add(writtenAmt.Sub(originalAmt))
+	return a.cdc.MustMarshalBinaryBare(&balance), nil
+}
+
+func (a BalanceAccumulator) amount(bz []byte) sdk.Int {
+	if bz == nil {
+		return sdk.ZeroInt()
+	}
+
+	var balance sdk.Coin
+	a.cdc.MustUnmarshalBinaryBare(bz, &balance)
+	return balance.Amount
+}
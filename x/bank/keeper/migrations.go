@@ -0,0 +1,24 @@
+package keeper
+
+import (
+	"github.com/gogo/protobuf/grpc"
+
+	sdk "github.com/line/lfb-sdk/types"
+	v2 "github.com/line/lfb-sdk/x/bank/legacy/v2"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper      BaseKeeper
+	queryServer grpc.Server
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper BaseKeeper, queryServer grpc.Server) Migrator {
+	return Migrator{keeper: keeper, queryServer: queryServer}
+}
+
+// Migrate1to2 migrates from version 1 to 2.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	return v2.MigrateStore(ctx, m.keeper.ak, m.keeper, m.queryServer)
+}
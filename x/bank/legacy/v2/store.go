@@ -0,0 +1,163 @@
+package v2
+
+import (
+	"fmt"
+
+	"github.com/gogo/protobuf/grpc"
+
+	"github.com/line/lfb-sdk/baseapp"
+	"github.com/line/lfb-sdk/codec"
+	sdk "github.com/line/lfb-sdk/types"
+	"github.com/line/lfb-sdk/types/query"
+	authtypes "github.com/line/lfb-sdk/x/auth/types"
+	vestexported "github.com/line/lfb-sdk/x/auth/vesting/exported"
+	vestingtypes "github.com/line/lfb-sdk/x/auth/vesting/types"
+	"github.com/line/lfb-sdk/x/bank/types"
+	stakingtypes "github.com/line/lfb-sdk/x/staking/types"
+	abci "github.com/line/ostracon/abci/types"
+)
+
+const (
+	delegatorDelegationsPath          = "/lfb.staking.v1beta1.Query/DelegatorDelegations"
+	delegatorUnbondingDelegationsPath = "/lfb.staking.v1beta1.Query/DelegatorUnbondingDelegations"
+	stakingParamsPath                 = "/lfb.staking.v1beta1.Query/Params"
+)
+
+// BalanceKeeper defines the balances read by the migration.
+type BalanceKeeper interface {
+	GetAllBalances(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
+}
+
+// MigrateStore performs in-place store migrations from consensus version 1 to 2.
+// The migration includes:
+//
+// - Resetting the delegated vesting and delegated free coins of every vesting
+// account, which were not stored when a delegation was tracked, and tracking
+// its delegations and unbonding delegations again. The delegations are read
+// from the staking query service registered on the query server.
+func MigrateStore(ctx sdk.Context, ak types.AccountKeeper, bk BalanceKeeper, queryServer grpc.Server) error {
+	querier, ok := queryServer.(*baseapp.GRPCQueryRouter)
+	if !ok {
+		return fmt.Errorf("unexpected query server type %T", queryServer)
+	}
+
+	// the accounts are collected first, as the store must not be written while iterating it
+	var accounts []vestexported.VestingAccount
+	ak.IterateAccounts(ctx, func(acc authtypes.AccountI) bool {
+		if vacc, ok := acc.(vestexported.VestingAccount); ok {
+			accounts = append(accounts, vacc)
+		}
+		return false
+	})
+	if len(accounts) == 0 {
+		return nil
+	}
+
+	var params stakingtypes.QueryParamsResponse
+	if err := queryRoute(ctx, querier, stakingParamsPath, &stakingtypes.QueryParamsRequest{}, &params); err != nil {
+		return err
+	}
+
+	for _, vacc := range accounts {
+		delegations, err := delegatedCoins(ctx, querier, vacc.GetAddress().String(), params.Params.BondDenom)
+		if err != nil {
+			return err
+		}
+		if err := resetDelegatedCoins(vacc); err != nil {
+			return err
+		}
+		if !delegations.IsZero() {
+			// the balance tracked against is the one before the coins were delegated
+			balance := bk.GetAllBalances(ctx, vacc.GetAddress()).Add(delegations...)
+			vacc.TrackDelegation(ctx.BlockTime(), balance, delegations)
+		}
+		ak.SetAccount(ctx, vacc)
+	}
+	return nil
+}
+
+// delegatedCoins returns the coins bonded and unbonding by the delegator.
+func delegatedCoins(ctx sdk.Context, querier *baseapp.GRPCQueryRouter, delegator, bondDenom string) (sdk.Coins, error) {
+	coins := sdk.NewCoins()
+
+	var nextKey []byte
+	for {
+		var res stakingtypes.QueryDelegatorDelegationsResponse
+		req := &stakingtypes.QueryDelegatorDelegationsRequest{
+			DelegatorAddr: delegator,
+			Pagination:    &query.PageRequest{Key: nextKey},
+		}
+		if err := queryRoute(ctx, querier, delegatorDelegationsPath, req, &res); err != nil {
+			return nil, err
+		}
+		for _, delegation := range res.DelegationResponses {
+			coins = coins.Add(delegation.Balance)
+		}
+		if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+			break
+		}
+		nextKey = res.Pagination.NextKey
+	}
+
+	nextKey = nil
+	for {
+		var res stakingtypes.QueryDelegatorUnbondingDelegationsResponse
+		req := &stakingtypes.QueryDelegatorUnbondingDelegationsRequest{
+			DelegatorAddr: delegator,
+			Pagination:    &query.PageRequest{Key: nextKey},
+		}
+		if err := queryRoute(ctx, querier, delegatorUnbondingDelegationsPath, req, &res); err != nil {
+			return nil, err
+		}
+		for _, ubd := range res.UnbondingResponses {
+			for _, entry := range ubd.Entries {
+				coins = coins.Add(sdk.NewCoin(bondDenom, entry.Balance))
+			}
+		}
+		if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+			break
+		}
+		nextKey = res.Pagination.NextKey
+	}
+
+	return coins, nil
+}
+
+// resetDelegatedCoins clears the delegated vesting and delegated free coins of the account.
+func resetDelegatedCoins(vacc vestexported.VestingAccount) error {
+	var bva *vestingtypes.BaseVestingAccount
+	switch acc := vacc.(type) {
+	case *vestingtypes.ContinuousVestingAccount:
+		bva = acc.BaseVestingAccount
+	case *vestingtypes.DelayedVestingAccount:
+		bva = acc.BaseVestingAccount
+	case *vestingtypes.PeriodicVestingAccount:
+		bva = acc.BaseVestingAccount
+	case *vestingtypes.PermanentLockedAccount:
+		bva = acc.BaseVestingAccount
+	case *vestingtypes.ClawbackVestingAccount:
+		bva = acc.BaseVestingAccount
+	default:
+		return fmt.Errorf("unexpected vesting account type %T", vacc)
+	}
+	bva.DelegatedVesting = sdk.NewCoins()
+	bva.DelegatedFree = sdk.NewCoins()
+	return nil
+}
+
+func queryRoute(ctx sdk.Context, querier *baseapp.GRPCQueryRouter, path string, req, res codec.ProtoMarshaler) error {
+	handler := querier.Route(path)
+	if handler == nil {
+		return fmt.Errorf("no query route for %s", path)
+	}
+
+	bz, err := req.Marshal()
+	if err != nil {
+		return err
+	}
+	resp, err := handler(ctx, abci.RequestQuery{Data: bz, Path: path})
+	if err != nil {
+		return err
+	}
+	return res.Unmarshal(resp.Value)
+}
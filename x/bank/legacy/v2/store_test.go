@@ -0,0 +1,51 @@
+package v2_test
+
+import (
+	"testing"
+	"time"
+
+	ostproto "github.com/line/ostracon/proto/ostracon/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/line/lfb-sdk/simapp"
+	sdk "github.com/line/lfb-sdk/types"
+	authtypes "github.com/line/lfb-sdk/x/auth/types"
+	vestingtypes "github.com/line/lfb-sdk/x/auth/vesting/types"
+	v2 "github.com/line/lfb-sdk/x/bank/legacy/v2"
+	"github.com/line/lfb-sdk/x/staking/teststaking"
+)
+
+func TestMigrateStore(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, ostproto.Header{Time: time.Unix(1000, 0)})
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+
+	pks := simapp.CreateTestPubKeys(1)
+	simapp.AddTestAddrsFromPubKeys(app, ctx, pks, sdk.TokensFromConsensusPower(10))
+	valAddr := sdk.ValAddress(pks[0].Address())
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+	tstaking.CreateValidator(valAddr, pks[0], sdk.TokensFromConsensusPower(1), true)
+
+	addr := sdk.AccAddress("vesting_____________")
+	origCoins := sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 1000))
+	vacc := vestingtypes.NewContinuousVestingAccount(authtypes.NewBaseAccountWithAddress(addr), origCoins, 1000, 2000)
+	app.AccountKeeper.SetAccount(ctx, vacc)
+	require.NoError(t, app.BankKeeper.AddCoins(ctx, addr, origCoins))
+
+	tstaking.Delegate(addr, valAddr, sdk.NewInt(600))
+	tstaking.Undelegate(addr, valAddr, sdk.NewInt(200), true)
+	expected := app.AccountKeeper.GetAccount(ctx, addr).(*vestingtypes.ContinuousVestingAccount)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 600)), expected.DelegatedVesting)
+
+	// the delegated coins were not stored by the earlier versions
+	stale := app.AccountKeeper.GetAccount(ctx, addr).(*vestingtypes.ContinuousVestingAccount)
+	stale.DelegatedVesting = sdk.NewCoins()
+	stale.DelegatedFree = sdk.NewCoins()
+	app.AccountKeeper.SetAccount(ctx, stale)
+
+	require.NoError(t, v2.MigrateStore(ctx, app.AccountKeeper, app.BankKeeper, app.GRPCQueryRouter()))
+
+	migrated := app.AccountKeeper.GetAccount(ctx, addr).(*vestingtypes.ContinuousVestingAccount)
+	require.Equal(t, expected.DelegatedVesting, migrated.DelegatedVesting)
+	require.Equal(t, expected.DelegatedFree, migrated.DelegatedFree)
+}
@@ -6,6 +6,8 @@ import (
 	cryptocodec "github.com/line/lfb-sdk/crypto/codec"
 	sdk "github.com/line/lfb-sdk/types"
 	"github.com/line/lfb-sdk/types/msgservice"
+	authztypes "github.com/line/lfb-sdk/x/authz/types"
+	grouptypes "github.com/line/lfb-sdk/x/group/types"
 	"github.com/line/lfb-sdk/x/bank/exported"
 )
 
@@ -16,6 +18,7 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&Supply{}, "lfb-sdk/Supply", nil)
 	cdc.RegisterConcrete(&MsgSend{}, "lfb-sdk/MsgSend", nil)
 	cdc.RegisterConcrete(&MsgMultiSend{}, "lfb-sdk/MsgMultiSend", nil)
+	cdc.RegisterConcrete(&SendAuthorization{}, "lfb-sdk/SendAuthorization", nil)
 }
 
 func RegisterInterfaces(registry types.InterfaceRegistry) {
@@ -30,6 +33,11 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&Supply{},
 	)
 
+	registry.RegisterImplementations(
+		(*authztypes.Authorization)(nil),
+		&SendAuthorization{},
+	)
+
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
 
@@ -49,4 +57,10 @@ func init() {
 	RegisterLegacyAminoCodec(amino)
 	cryptocodec.RegisterCrypto(amino)
 	amino.Seal()
+
+	authztypes.RegisterMsgTypeCodec(&MsgSend{}, "lfb-sdk/MsgSend")
+	authztypes.RegisterMsgTypeCodec(&MsgMultiSend{}, "lfb-sdk/MsgMultiSend")
+	grouptypes.RegisterMsgTypeCodec(&MsgSend{}, "lfb-sdk/MsgSend")
+	grouptypes.RegisterMsgTypeCodec(&MsgMultiSend{}, "lfb-sdk/MsgMultiSend")
+	authztypes.RegisterAuthorizationTypeCodec(&SendAuthorization{}, "lfb-sdk/SendAuthorization")
 }
@@ -0,0 +1,47 @@
+package types
+
+import (
+	"testing"
+
+	ostproto "github.com/line/ostracon/proto/ostracon/types"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/line/lfb-sdk/types"
+)
+
+func TestSendAuthorization(t *testing.T) {
+	ctx := sdk.NewContext(nil, ostproto.Header{}, false, nil)
+	fromAddr := sdk.AccAddress("_______granter______")
+	toAddr := sdk.AccAddress("_______grantee______")
+	coins1000 := sdk.NewCoins(sdk.NewInt64Coin("stake", 1000))
+	coins500 := sdk.NewCoins(sdk.NewInt64Coin("stake", 500))
+
+	authorization := NewSendAuthorization(coins1000)
+	require.Equal(t, "/lfb.bank.v1beta1.MsgSend", authorization.MsgTypeURL())
+	require.NoError(t, authorization.ValidateBasic())
+
+	// a partial spend updates the spend limit
+	resp, err := authorization.Accept(ctx, NewMsgSend(fromAddr, toAddr, coins500))
+	require.NoError(t, err)
+	require.True(t, resp.Accept)
+	require.False(t, resp.Delete)
+	require.Equal(t, NewSendAuthorization(coins500), resp.Updated)
+
+	// spending the whole limit deletes the authorization
+	resp, err = resp.Updated.Accept(ctx, NewMsgSend(fromAddr, toAddr, coins500))
+	require.NoError(t, err)
+	require.True(t, resp.Accept)
+	require.True(t, resp.Delete)
+	require.Nil(t, resp.Updated)
+
+	// spending more than the limit fails
+	_, err = authorization.Accept(ctx, NewMsgSend(fromAddr, toAddr, coins1000.Add(coins500...)))
+	require.Error(t, err)
+
+	// other msg types are rejected
+	_, err = authorization.Accept(ctx, NewMsgMultiSend(nil, nil))
+	require.Error(t, err)
+
+	require.Error(t, NewSendAuthorization(nil).ValidateBasic())
+	require.Error(t, NewSendAuthorization(sdk.Coins{sdk.Coin{Denom: "stake", Amount: sdk.ZeroInt()}}).ValidateBasic())
+}
@@ -626,3 +626,56 @@ func TestCalculateRewardsMultiDelegatorMultWithdraw(t *testing.T) {
 	// commission should be zero
 	require.True(t, app.DistrKeeper.GetValidatorAccumulatedCommission(ctx, valAddrs[0]).Commission.IsZero())
 }
+
+func TestWithdrawTokenizeShareRecordReward(t *testing.T) {
+	balanceTokens := sdk.TokensFromConsensusPower(1000)
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, ostproto.Header{})
+
+	addr := simapp.AddTestAddrs(app, ctx, 2, sdk.NewInt(1000000000))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addr)
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+
+	// set module account coins
+	distrAcc := app.DistrKeeper.GetDistributionAccount(ctx)
+	require.NoError(t, app.BankKeeper.SetBalances(ctx, distrAcc.GetAddress(), sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, balanceTokens))))
+	app.AccountKeeper.SetModuleAccount(ctx, distrAcc)
+
+	// create validator with zero commission
+	valTokens := tstaking.CreateValidatorWithValPower(valAddrs[0], valConsPk1, 100, true)
+
+	// end block to bond validator
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	// next block
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+
+	// tokenize half of the self-delegation, the rewards belonging to the second address
+	_, err := app.StakingKeeper.TokenizeShares(ctx, addr[0], valAddrs[0], sdk.NewCoin(sdk.DefaultBondDenom, valTokens.QuoRaw(2)), addr[1])
+	require.NoError(t, err)
+
+	// allocate some rewards
+	initial := sdk.TokensFromConsensusPower(10)
+	val := app.StakingKeeper.Validator(ctx, valAddrs[0])
+	app.DistrKeeper.AllocateTokensToValidator(ctx, val, sdk.DecCoins{sdk.NewDecCoin(sdk.DefaultBondDenom, initial)})
+
+	// next block
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+
+	balance := app.BankKeeper.GetBalance(ctx, addr[1], sdk.DefaultBondDenom)
+
+	rewards, err := app.DistrKeeper.WithdrawTokenizeShareRecordReward(ctx, addr[1])
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, initial.QuoRaw(2))), rewards)
+	require.Equal(t, balance.Amount.Add(initial.QuoRaw(2)), app.BankKeeper.GetBalance(ctx, addr[1], sdk.DefaultBondDenom).Amount)
+
+	// nothing is left to withdraw
+	rewards, err = app.DistrKeeper.WithdrawTokenizeShareRecordReward(ctx, addr[1])
+	require.NoError(t, err)
+	require.True(t, rewards.IsZero())
+
+	// the delegator owns no record
+	rewards, err = app.DistrKeeper.WithdrawTokenizeShareRecordReward(ctx, addr[0])
+	require.NoError(t, err)
+	require.True(t, rewards.IsZero())
+}
@@ -339,6 +339,86 @@ func (m *MsgFundCommunityPoolResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgFundCommunityPoolResponse proto.InternalMessageInfo
 
+// MsgWithdrawTokenizeShareRecordReward withdraws the rewards of all tokenize
+// share records owned by an address to that address.
+type MsgWithdrawTokenizeShareRecordReward struct {
+	OwnerAddress string `protobuf:"bytes,1,opt,name=owner_address,json=ownerAddress,proto3" json:"owner_address,omitempty" yaml:"owner_address"`
+}
+
+func (m *MsgWithdrawTokenizeShareRecordReward) Reset()         { *m = MsgWithdrawTokenizeShareRecordReward{} }
+func (m *MsgWithdrawTokenizeShareRecordReward) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawTokenizeShareRecordReward) ProtoMessage()    {}
+func (*MsgWithdrawTokenizeShareRecordReward) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cdb9afe73c3a66d5, []int{8}
+}
+func (m *MsgWithdrawTokenizeShareRecordReward) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawTokenizeShareRecordReward) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawTokenizeShareRecordReward.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWithdrawTokenizeShareRecordReward) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawTokenizeShareRecordReward.Merge(m, src)
+}
+func (m *MsgWithdrawTokenizeShareRecordReward) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawTokenizeShareRecordReward) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawTokenizeShareRecordReward.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWithdrawTokenizeShareRecordReward proto.InternalMessageInfo
+
+// MsgWithdrawTokenizeShareRecordRewardResponse defines the Msg/WithdrawTokenizeShareRecordReward response type.
+type MsgWithdrawTokenizeShareRecordRewardResponse struct {
+}
+
+func (m *MsgWithdrawTokenizeShareRecordRewardResponse) Reset() {
+	*m = MsgWithdrawTokenizeShareRecordRewardResponse{}
+}
+func (m *MsgWithdrawTokenizeShareRecordRewardResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*MsgWithdrawTokenizeShareRecordRewardResponse) ProtoMessage() {}
+func (*MsgWithdrawTokenizeShareRecordRewardResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cdb9afe73c3a66d5, []int{9}
+}
+func (m *MsgWithdrawTokenizeShareRecordRewardResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawTokenizeShareRecordRewardResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawTokenizeShareRecordRewardResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWithdrawTokenizeShareRecordRewardResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawTokenizeShareRecordRewardResponse.Merge(m, src)
+}
+func (m *MsgWithdrawTokenizeShareRecordRewardResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawTokenizeShareRecordRewardResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawTokenizeShareRecordRewardResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWithdrawTokenizeShareRecordRewardResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgSetWithdrawAddress)(nil), "lfb.distribution.v1beta1.MsgSetWithdrawAddress")
 	proto.RegisterType((*MsgSetWithdrawAddressResponse)(nil), "lfb.distribution.v1beta1.MsgSetWithdrawAddressResponse")
@@ -348,48 +428,54 @@ func init() {
 	proto.RegisterType((*MsgWithdrawValidatorCommissionResponse)(nil), "lfb.distribution.v1beta1.MsgWithdrawValidatorCommissionResponse")
 	proto.RegisterType((*MsgFundCommunityPool)(nil), "lfb.distribution.v1beta1.MsgFundCommunityPool")
 	proto.RegisterType((*MsgFundCommunityPoolResponse)(nil), "lfb.distribution.v1beta1.MsgFundCommunityPoolResponse")
+	proto.RegisterType((*MsgWithdrawTokenizeShareRecordReward)(nil), "lfb.distribution.v1beta1.MsgWithdrawTokenizeShareRecordReward")
+	proto.RegisterType((*MsgWithdrawTokenizeShareRecordRewardResponse)(nil), "lfb.distribution.v1beta1.MsgWithdrawTokenizeShareRecordRewardResponse")
 }
 
 func init() { proto.RegisterFile("lfb/distribution/v1beta1/tx.proto", fileDescriptor_cdb9afe73c3a66d5) }
 
 var fileDescriptor_cdb9afe73c3a66d5 = []byte{
-	// 562 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0xc1, 0x6b, 0xd4, 0x4e,
-	0x14, 0xc7, 0x33, 0xed, 0x8f, 0xf2, 0xeb, 0xf3, 0xe0, 0x6e, 0xa8, 0x76, 0xc9, 0xae, 0x49, 0x8d,
-	0x22, 0x0b, 0x6a, 0x42, 0xab, 0xa8, 0x14, 0x0f, 0xba, 0x95, 0x42, 0x85, 0x05, 0x89, 0xa0, 0xa0,
-	0x07, 0x49, 0x3a, 0xb3, 0xe9, 0x60, 0x36, 0xb3, 0x64, 0x26, 0xdd, 0x2e, 0x82, 0x67, 0x2f, 0x82,
-	0x57, 0xf1, 0xd2, 0xa3, 0x78, 0xf0, 0xe4, 0xd1, 0x3f, 0xa0, 0xc7, 0x1e, 0x3d, 0xad, 0xb2, 0x7b,
-	0xf1, 0xdc, 0xbf, 0x40, 0x36, 0xd9, 0xc4, 0xdd, 0x26, 0x59, 0x6c, 0xf5, 0x16, 0xde, 0xfb, 0xbe,
-	0xcf, 0x7c, 0x1f, 0xf9, 0xce, 0xc0, 0x45, 0xaf, 0xe5, 0x98, 0x98, 0x72, 0x11, 0x50, 0x27, 0x14,
-	0x94, 0xf9, 0xe6, 0xee, 0xaa, 0x43, 0x84, 0xbd, 0x6a, 0x8a, 0x3d, 0xa3, 0x13, 0x30, 0xc1, 0xe4,
-	0x8a, 0xd7, 0x72, 0x8c, 0x49, 0x89, 0x31, 0x96, 0x28, 0x4b, 0x2e, 0x73, 0x59, 0x24, 0x32, 0x47,
-	0x5f, 0xb1, 0x5e, 0xa9, 0x8e, 0x90, 0x8e, 0xcd, 0x49, 0x8a, 0xda, 0x66, 0xd4, 0x8f, 0x9b, 0xfa,
-	0x17, 0x04, 0xe7, 0x9a, 0xdc, 0x7d, 0x4c, 0xc4, 0x53, 0x2a, 0x76, 0x70, 0x60, 0x77, 0xef, 0x63,
-	0x1c, 0x10, 0xce, 0xe5, 0x2d, 0x28, 0x63, 0xe2, 0x11, 0xd7, 0x16, 0x2c, 0x78, 0x61, 0xc7, 0xc5,
-	0x0a, 0x5a, 0x41, 0xf5, 0xc5, 0x46, 0xed, 0xa8, 0xaf, 0x55, 0x7a, 0x76, 0xdb, 0x5b, 0xd7, 0x33,
-	0x12, 0xdd, 0x2a, 0xa5, 0xb5, 0x04, 0xb5, 0x09, 0xa5, 0xee, 0x98, 0x9e, 0x92, 0xe6, 0x22, 0x52,
-	0xf5, 0xa8, 0xaf, 0x2d, 0xc7, 0xa4, 0xe3, 0x0a, 0xdd, 0x3a, 0xdb, 0x9d, 0xb6, 0xb4, 0xfe, 0xff,
-	0x9b, 0x7d, 0x4d, 0xfa, 0xb9, 0xaf, 0x49, 0xba, 0x06, 0x17, 0x72, 0x5d, 0x5b, 0x84, 0x77, 0x98,
-	0xcf, 0x89, 0xfe, 0x15, 0x81, 0xd2, 0xe4, 0x6e, 0xd2, 0x7e, 0x90, 0x58, 0xb2, 0x48, 0xd7, 0x0e,
-	0xf0, 0xbf, 0x5c, 0x6e, 0x0b, 0xca, 0xbb, 0xb6, 0x47, 0xf1, 0x14, 0x6a, 0xee, 0x38, 0x2a, 0x23,
-	0xd1, 0xad, 0x52, 0x5a, 0xcb, 0xee, 0x77, 0x19, 0xf4, 0x62, 0xf7, 0xe9, 0x92, 0x21, 0xa8, 0x13,
-	0xaa, 0x27, 0x09, 0x6e, 0x83, 0xb5, 0xdb, 0x94, 0x73, 0xca, 0xfc, 0x7c, 0x73, 0xe8, 0x2f, 0xcd,
-	0xd5, 0xe1, 0xca, 0xec, 0x63, 0x53, 0x83, 0x1f, 0x10, 0x2c, 0x35, 0xb9, 0xbb, 0x19, 0xfa, 0x78,
-	0xd4, 0x0d, 0x7d, 0x2a, 0x7a, 0x8f, 0x18, 0xf3, 0xe4, 0xe7, 0xb0, 0x60, 0xb7, 0x59, 0xe8, 0x8b,
-	0x0a, 0x5a, 0x99, 0xaf, 0x9f, 0x59, 0x3b, 0x6f, 0x8c, 0x42, 0x3d, 0x0a, 0x69, 0x12, 0x66, 0x63,
-	0x83, 0x51, 0xbf, 0x71, 0xf5, 0xa0, 0xaf, 0x49, 0x9f, 0xbe, 0x6b, 0x97, 0x5c, 0x2a, 0x76, 0x42,
-	0xc7, 0xd8, 0x66, 0x6d, 0xd3, 0xa3, 0x3e, 0x31, 0xbd, 0x96, 0x73, 0x9d, 0xe3, 0x97, 0xa6, 0xe8,
-	0x75, 0x08, 0x8f, 0xb4, 0xdc, 0x1a, 0x23, 0xe5, 0x1a, 0x2c, 0x62, 0xd2, 0x61, 0x9c, 0x0a, 0x16,
-	0xc4, 0x7f, 0xc2, 0xfa, 0x5d, 0x98, 0xd8, 0x43, 0x85, 0x5a, 0x9e, 0xb9, 0xc4, 0xfd, 0xda, 0xe7,
-	0xff, 0x60, 0xbe, 0xc9, 0x5d, 0xf9, 0x35, 0xc8, 0x39, 0xf7, 0xc3, 0x34, 0x8a, 0xee, 0xa1, 0x91,
-	0x1b, 0x4d, 0xe5, 0xf6, 0x09, 0x07, 0x12, 0x1f, 0xf2, 0x5b, 0x04, 0xcb, 0x45, 0x41, 0xbe, 0x39,
-	0x13, 0x5a, 0x30, 0xa5, 0xdc, 0x3d, 0xcd, 0x54, 0xea, 0xe7, 0x3d, 0x82, 0xea, 0xac, 0xd0, 0xdd,
-	0xf9, 0x23, 0x7a, 0xce, 0xa4, 0x72, 0xef, 0xb4, 0x93, 0xa9, 0xb7, 0x57, 0x50, 0xce, 0xa6, 0xcd,
-	0x98, 0x89, 0xcd, 0xe8, 0x95, 0x5b, 0x27, 0xd3, 0x27, 0x87, 0x37, 0x1e, 0x7e, 0x1c, 0xa8, 0xe8,
-	0x60, 0xa0, 0xa2, 0xc3, 0x81, 0x8a, 0x7e, 0x0c, 0x54, 0xf4, 0x6e, 0xa8, 0x4a, 0x87, 0x43, 0x55,
-	0xfa, 0x36, 0x54, 0xa5, 0x67, 0xd7, 0x8a, 0x32, 0xbc, 0x37, 0xfd, 0xe0, 0x47, 0x91, 0x76, 0x16,
-	0xa2, 0xf7, 0xf9, 0xc6, 0xaf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x20, 0x95, 0x27, 0x49, 0x11, 0x06,
-	0x00, 0x00,
+	// 632 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0xc1, 0x6b, 0xd3, 0x50,
+	0x18, 0xcf, 0xdb, 0x70, 0xb8, 0xa7, 0xe2, 0x16, 0xa6, 0x2b, 0x69, 0x4d, 0xb6, 0x38, 0xa4, 0xe0,
+	0x4c, 0xd8, 0x14, 0x95, 0xa1, 0xa2, 0x9d, 0x14, 0x26, 0x14, 0x24, 0x13, 0x05, 0x3d, 0x48, 0xd2,
+	0xbc, 0xa6, 0x8f, 0xa5, 0x79, 0x25, 0xef, 0x65, 0x5d, 0x15, 0x3c, 0x7b, 0x11, 0xbc, 0x8a, 0x97,
+	0x1d, 0x45, 0xaf, 0x1e, 0xfd, 0x03, 0x76, 0xdc, 0xd1, 0x83, 0x54, 0x69, 0x2f, 0x9e, 0xfb, 0x17,
+	0x48, 0x93, 0x26, 0xb6, 0x4d, 0x52, 0xbb, 0xcd, 0x5b, 0xf2, 0x7d, 0xbf, 0xdf, 0xef, 0xfd, 0xbe,
+	0xf7, 0xbe, 0xef, 0x3d, 0xb8, 0x6c, 0x57, 0x0c, 0xd5, 0xc4, 0x94, 0xb9, 0xd8, 0xf0, 0x18, 0x26,
+	0x8e, 0xba, 0xbb, 0x66, 0x20, 0xa6, 0xaf, 0xa9, 0x6c, 0x4f, 0xa9, 0xbb, 0x84, 0x11, 0x3e, 0x63,
+	0x57, 0x0c, 0x65, 0x10, 0xa2, 0xf4, 0x21, 0xc2, 0x82, 0x45, 0x2c, 0xe2, 0x83, 0xd4, 0xde, 0x57,
+	0x80, 0x17, 0xb2, 0x3d, 0x49, 0x43, 0xa7, 0x28, 0x92, 0x2a, 0x13, 0xec, 0x04, 0x49, 0xf9, 0x2b,
+	0x80, 0x17, 0x4a, 0xd4, 0xda, 0x46, 0xec, 0x19, 0x66, 0x55, 0xd3, 0xd5, 0x1b, 0x0f, 0x4c, 0xd3,
+	0x45, 0x94, 0xf2, 0x5b, 0x70, 0xde, 0x44, 0x36, 0xb2, 0x74, 0x46, 0xdc, 0x97, 0x7a, 0x10, 0xcc,
+	0x80, 0x25, 0x90, 0x9f, 0x2d, 0xe4, 0xba, 0x2d, 0x29, 0xd3, 0xd4, 0x6b, 0xf6, 0x86, 0x1c, 0x83,
+	0xc8, 0xda, 0x5c, 0x14, 0x0b, 0xa5, 0x8a, 0x70, 0xae, 0xd1, 0x57, 0x8f, 0x94, 0xa6, 0x7c, 0xa5,
+	0x6c, 0xb7, 0x25, 0x2d, 0x06, 0x4a, 0xa3, 0x08, 0x59, 0x3b, 0xdf, 0x18, 0xb6, 0xb4, 0x71, 0xfa,
+	0xed, 0xbe, 0xc4, 0xfd, 0xde, 0x97, 0x38, 0x59, 0x82, 0x97, 0x12, 0x5d, 0x6b, 0x88, 0xd6, 0x89,
+	0x43, 0x91, 0xfc, 0x0d, 0x40, 0xa1, 0x44, 0xad, 0x30, 0xfd, 0x30, 0xb4, 0xa4, 0xa1, 0x86, 0xee,
+	0x9a, 0xff, 0xb3, 0xb8, 0x2d, 0x38, 0xbf, 0xab, 0xdb, 0xd8, 0x1c, 0x92, 0x9a, 0x1a, 0x95, 0x8a,
+	0x41, 0x64, 0x6d, 0x2e, 0x8a, 0xc5, 0xeb, 0x5b, 0x81, 0x72, 0xba, 0xfb, 0xa8, 0x48, 0x0f, 0x8a,
+	0x03, 0xa8, 0xa7, 0xa1, 0xdc, 0x26, 0xa9, 0xd5, 0x30, 0xa5, 0x98, 0x38, 0xc9, 0xe6, 0xc0, 0x09,
+	0xcd, 0xe5, 0xe1, 0x95, 0xf1, 0xcb, 0x46, 0x06, 0x3f, 0x02, 0xb8, 0x50, 0xa2, 0x56, 0xd1, 0x73,
+	0xcc, 0x5e, 0xd6, 0x73, 0x30, 0x6b, 0x3e, 0x26, 0xc4, 0xe6, 0x5f, 0xc0, 0x19, 0xbd, 0x46, 0x3c,
+	0x87, 0x65, 0xc0, 0xd2, 0x74, 0xfe, 0xcc, 0xfa, 0x45, 0xa5, 0xd7, 0xd4, 0xbd, 0x26, 0x0d, 0x9b,
+	0x59, 0xd9, 0x24, 0xd8, 0x29, 0x5c, 0x3d, 0x68, 0x49, 0xdc, 0xe7, 0x9f, 0xd2, 0x65, 0x0b, 0xb3,
+	0xaa, 0x67, 0x28, 0x65, 0x52, 0x53, 0x6d, 0xec, 0x20, 0xd5, 0xae, 0x18, 0xd7, 0xa8, 0xb9, 0xa3,
+	0xb2, 0x66, 0x1d, 0x51, 0x1f, 0x4b, 0xb5, 0xbe, 0x24, 0x9f, 0x83, 0xb3, 0x26, 0xaa, 0x13, 0x8a,
+	0x19, 0x71, 0x83, 0x93, 0xd0, 0xfe, 0x06, 0x06, 0xea, 0x10, 0x61, 0x2e, 0xc9, 0x5c, 0xe4, 0x9e,
+	0xc0, 0x95, 0x81, 0x3a, 0x9f, 0x90, 0x1d, 0xe4, 0xe0, 0x57, 0x68, 0xbb, 0xaa, 0xbb, 0x48, 0x43,
+	0x65, 0xe2, 0x9a, 0xc1, 0x71, 0xf0, 0x77, 0xe1, 0x39, 0xd2, 0x70, 0xd0, 0xe8, 0x06, 0x67, 0xba,
+	0x2d, 0x69, 0x21, 0xd8, 0xe0, 0xa1, 0xb4, 0xac, 0x9d, 0xf5, 0xff, 0xe3, 0x1b, 0xab, 0xc0, 0xd5,
+	0x49, 0x16, 0x0c, 0x0d, 0xae, 0xff, 0x38, 0x05, 0xa7, 0x4b, 0xd4, 0xe2, 0xdf, 0x40, 0x3e, 0x61,
+	0x80, 0x55, 0x25, 0xed, 0xa2, 0x50, 0x12, 0x67, 0x47, 0xb8, 0x75, 0x44, 0x42, 0xe8, 0x83, 0x7f,
+	0x07, 0xe0, 0x62, 0xda, 0xa4, 0xdd, 0x18, 0x2b, 0x9a, 0xc2, 0x12, 0xee, 0x1c, 0x87, 0x15, 0xf9,
+	0xf9, 0x00, 0x60, 0x76, 0xdc, 0x54, 0xdc, 0x9e, 0x48, 0x3d, 0x81, 0x29, 0xdc, 0x3f, 0x2e, 0x33,
+	0xf2, 0xf6, 0x1a, 0xce, 0xc7, 0xc7, 0x41, 0x19, 0x2b, 0x1b, 0xc3, 0x0b, 0x37, 0x8f, 0x86, 0x8f,
+	0x16, 0xff, 0x02, 0xe0, 0xf2, 0xbf, 0xfb, 0xf9, 0xde, 0x44, 0x45, 0xa6, 0xf2, 0x85, 0xe2, 0xc9,
+	0xf8, 0xa1, 0xdb, 0xc2, 0xa3, 0x4f, 0x6d, 0x11, 0x1c, 0xb4, 0x45, 0x70, 0xd8, 0x16, 0xc1, 0xaf,
+	0xb6, 0x08, 0xde, 0x77, 0x44, 0xee, 0xb0, 0x23, 0x72, 0xdf, 0x3b, 0x22, 0xf7, 0x7c, 0x35, 0xed,
+	0x4a, 0xd8, 0x1b, 0x7e, 0x3f, 0xfd, 0x1b, 0xc2, 0x98, 0xf1, 0x9f, 0xbb, 0xeb, 0x7f, 0x06, 0x00,
+	0x69, 0x76, 0xca, 0x18, 0x60, 0x07, 0x00, 0x00,
 }
 
 func (this *MsgSetWithdrawAddressResponse) Equal(that interface{}) bool {
@@ -476,6 +562,27 @@ func (this *MsgFundCommunityPoolResponse) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *MsgWithdrawTokenizeShareRecordRewardResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgWithdrawTokenizeShareRecordRewardResponse)
+	if !ok {
+		that2, ok := that.(MsgWithdrawTokenizeShareRecordRewardResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ context.Context
@@ -501,6 +608,9 @@ type MsgClient interface {
 	// FundCommunityPool defines a method to allow an account to directly
 	// fund the community pool.
 	FundCommunityPool(ctx context.Context, in *MsgFundCommunityPool, opts ...grpc.CallOption) (*MsgFundCommunityPoolResponse, error)
+	// WithdrawTokenizeShareRecordReward defines a method to withdraw the rewards
+	// of all tokenize share records owned by an address.
+	WithdrawTokenizeShareRecordReward(ctx context.Context, in *MsgWithdrawTokenizeShareRecordReward, opts ...grpc.CallOption) (*MsgWithdrawTokenizeShareRecordRewardResponse, error)
 }
 
 type msgClient struct {
@@ -547,6 +657,15 @@ func (c *msgClient) FundCommunityPool(ctx context.Context, in *MsgFundCommunityP
 	return out, nil
 }
 
+func (c *msgClient) WithdrawTokenizeShareRecordReward(ctx context.Context, in *MsgWithdrawTokenizeShareRecordReward, opts ...grpc.CallOption) (*MsgWithdrawTokenizeShareRecordRewardResponse, error) {
+	out := new(MsgWithdrawTokenizeShareRecordRewardResponse)
+	err := c.cc.Invoke(ctx, "/lfb.distribution.v1beta1.Msg/WithdrawTokenizeShareRecordReward", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// SetWithdrawAddress defines a method to change the withdraw address
@@ -561,6 +680,9 @@ type MsgServer interface {
 	// FundCommunityPool defines a method to allow an account to directly
 	// fund the community pool.
 	FundCommunityPool(context.Context, *MsgFundCommunityPool) (*MsgFundCommunityPoolResponse, error)
+	// WithdrawTokenizeShareRecordReward defines a method to withdraw the rewards
+	// of all tokenize share records owned by an address.
+	WithdrawTokenizeShareRecordReward(context.Context, *MsgWithdrawTokenizeShareRecordReward) (*MsgWithdrawTokenizeShareRecordRewardResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -579,6 +701,9 @@ func (*UnimplementedMsgServer) WithdrawValidatorCommission(ctx context.Context,
 func (*UnimplementedMsgServer) FundCommunityPool(ctx context.Context, req *MsgFundCommunityPool) (*MsgFundCommunityPoolResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FundCommunityPool not implemented")
 }
+func (*UnimplementedMsgServer) WithdrawTokenizeShareRecordReward(ctx context.Context, req *MsgWithdrawTokenizeShareRecordReward) (*MsgWithdrawTokenizeShareRecordRewardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawTokenizeShareRecordReward not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -656,6 +781,24 @@ func _Msg_FundCommunityPool_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_WithdrawTokenizeShareRecordReward_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawTokenizeShareRecordReward)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawTokenizeShareRecordReward(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lfb.distribution.v1beta1.Msg/WithdrawTokenizeShareRecordReward",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawTokenizeShareRecordReward(ctx, req.(*MsgWithdrawTokenizeShareRecordReward))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "lfb.distribution.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -676,6 +819,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "FundCommunityPool",
 			Handler:    _Msg_FundCommunityPool_Handler,
 		},
+		{
+			MethodName: "WithdrawTokenizeShareRecordReward",
+			Handler:    _Msg_WithdrawTokenizeShareRecordReward_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "lfb/distribution/v1beta1/tx.proto",
@@ -921,6 +1068,59 @@ func (m *MsgFundCommunityPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgWithdrawTokenizeShareRecordReward) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawTokenizeShareRecordReward) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawTokenizeShareRecordReward) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OwnerAddress) > 0 {
+		i -= len(m.OwnerAddress)
+		copy(dAtA[i:], m.OwnerAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OwnerAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawTokenizeShareRecordRewardResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawTokenizeShareRecordRewardResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawTokenizeShareRecordRewardResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -1034,6 +1234,28 @@ func (m *MsgFundCommunityPoolResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgWithdrawTokenizeShareRecordReward) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OwnerAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgWithdrawTokenizeShareRecordRewardResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1666,6 +1888,138 @@ func (m *MsgFundCommunityPoolResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgWithdrawTokenizeShareRecordReward) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawTokenizeShareRecordReward: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawTokenizeShareRecordReward: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OwnerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawTokenizeShareRecordRewardResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawTokenizeShareRecordRewardResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawTokenizeShareRecordRewardResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,32 @@
+package ante
+
+import (
+	sdk "github.com/line/lfb-sdk/types"
+	authante "github.com/line/lfb-sdk/x/auth/ante"
+	"github.com/line/lfb-sdk/x/auth/signing"
+	authtypes "github.com/line/lfb-sdk/x/auth/types"
+)
+
+// AccountKeeper defines the contract needed for AccountKeeper related APIs.
+type AccountKeeper = authante.AccountKeeper
+
+// NewAnteHandler returns an AnteHandler that checks signatures & account
+// numbers, deducts fees from the fee granter of the tx if it has granted an
+// allowance to the first signer, or else from the first signer, and protects
+// txs from being replayed with the given decorator.
+//
+// It is the counterpart of the x/auth AnteHandler for chains supporting fee
+// grants, the RejectFeeGranterDecorator and DeductFeeDecorator being replaced
+// by the DeductGrantedFeeDecorator.
+func NewAnteHandler(
+	ak AccountKeeper, bankKeeper authtypes.BankKeeper, feegrantKeeper FeegrantKeeper,
+	sigGasConsumer authante.SignatureVerificationGasConsumer,
+	signModeHandler signing.SignModeHandler,
+	replayProtection sdk.AnteDecorator,
+) sdk.AnteHandler {
+	return authante.NewAnteHandlerWithFeeDecorator(
+		ak, sigGasConsumer, signModeHandler,
+		NewDeductGrantedFeeDecorator(ak, bankKeeper, feegrantKeeper),
+		replayProtection,
+	)
+}
@@ -0,0 +1,84 @@
+package ante
+
+import (
+	"fmt"
+
+	sdk "github.com/line/lfb-sdk/types"
+	sdkerrors "github.com/line/lfb-sdk/types/errors"
+	authante "github.com/line/lfb-sdk/x/auth/ante"
+	authtypes "github.com/line/lfb-sdk/x/auth/types"
+)
+
+// FeegrantKeeper defines the contract needed by the DeductGrantedFeeDecorator to
+// charge the fees of a tx to a fee allowance.
+type FeegrantKeeper interface {
+	UseGrantedFees(ctx sdk.Context, granter, grantee sdk.AccAddress, fee sdk.Coins, msgs []sdk.Msg) error
+}
+
+// DeductGrantedFeeDecorator deducts fees from the fee granter of the tx if it is
+// set and has granted an allowance covering the fees to the fee payer, otherwise
+// from the fee payer, i.e. the first signer.
+// If the account paying the fees does not have the funds to pay for them, return
+// with InsufficientFunds error.
+// Call next AnteHandler if fees successfully deducted
+// CONTRACT: Tx must implement FeeTx interface to use DeductGrantedFeeDecorator
+type DeductGrantedFeeDecorator struct {
+	ak AccountKeeper
+	k  FeegrantKeeper
+	bk authtypes.BankKeeper
+}
+
+func NewDeductGrantedFeeDecorator(ak AccountKeeper, bk authtypes.BankKeeper, k FeegrantKeeper) DeductGrantedFeeDecorator {
+	return DeductGrantedFeeDecorator{
+		ak: ak,
+		k:  k,
+		bk: bk,
+	}
+}
+
+// AnteHandle performs a decorated ante-handler responsible for deducting transaction
+// fees. Fees will be deducted from the account designated by the FeePayer on a
+// transaction by default. However, if the fee payer differs from the transaction
+// signer, the handler will check if a fee grant has been authorized. If the
+// transaction's signer does not exist, it will be created.
+func (d DeductGrantedFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	if addr := d.ak.GetModuleAddress(authtypes.FeeCollectorName); addr == nil {
+		panic(fmt.Sprintf("%s module account has not been set", authtypes.FeeCollectorName))
+	}
+
+	fee := feeTx.GetFee()
+	feePayer := feeTx.FeePayer()
+	feeGranter := feeTx.FeeGranter()
+
+	deductFeesFrom := feePayer
+
+	// ensure the grant is allowed, if we request a different fee payer
+	if len(feeGranter) != 0 && !feeGranter.Equals(feePayer) {
+		err := d.k.UseGrantedFees(ctx, feeGranter, feePayer, fee, tx.GetMsgs())
+		if err != nil {
+			return ctx, sdkerrors.Wrapf(err, "%s not allowed to pay fees from %s", feePayer, feeGranter)
+		}
+
+		deductFeesFrom = feeGranter
+	}
+
+	deductFeesFromAcc := d.ak.GetAccount(ctx, deductFeesFrom)
+	if deductFeesFromAcc == nil {
+		return ctx, sdkerrors.Wrapf(sdkerrors.ErrUnknownAddress, "fee payer address: %s does not exist", deductFeesFrom)
+	}
+
+	// deduct the fees
+	if !fee.IsZero() {
+		err = authante.DeductFees(d.bk, ctx, deductFeesFromAcc, fee)
+		if err != nil {
+			return ctx, err
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
@@ -0,0 +1,138 @@
+package ante_test
+
+import (
+	"testing"
+
+	ostproto "github.com/line/ostracon/proto/ostracon/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/line/lfb-sdk/simapp"
+	"github.com/line/lfb-sdk/testutil/testdata"
+	sdk "github.com/line/lfb-sdk/types"
+	"github.com/line/lfb-sdk/x/feegrant/ante"
+	"github.com/line/lfb-sdk/x/feegrant/types"
+)
+
+type AnteTestSuite struct {
+	suite.Suite
+
+	app *simapp.SimApp
+	ctx sdk.Context
+}
+
+func TestAnteTestSuite(t *testing.T) {
+	suite.Run(t, new(AnteTestSuite))
+}
+
+func (suite *AnteTestSuite) SetupTest() {
+	suite.app = simapp.Setup(false)
+	suite.ctx = suite.app.BaseApp.NewContext(false, ostproto.Header{}).WithBlockHeight(1)
+}
+
+func (suite *AnteTestSuite) TestDeductGrantedFees() {
+	app, ctx := suite.app, suite.ctx
+	encodingConfig := simapp.MakeTestEncodingConfig()
+
+	dgfd := ante.NewDeductGrantedFeeDecorator(app.AccountKeeper, app.BankKeeper, app.FeeGrantKeeper)
+	antehandler := sdk.ChainAnteDecorators(dgfd)
+
+	// the grantee holds nothing, fees are only payable through its allowances
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 3, sdk.NewInt(100000))
+	granter, grantee, stranger := addrs[0], addrs[1], addrs[2]
+	_, _, emptyGrantee := testdata.KeyTestPubAddr()
+	app.AccountKeeper.SetAccount(ctx, app.AccountKeeper.NewAccountWithAddress(ctx, emptyGrantee))
+
+	err := app.FeeGrantKeeper.GrantAllowance(ctx, granter, emptyGrantee, &types.BasicAllowance{
+		SpendLimit: sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 500)),
+	})
+	suite.Require().NoError(err)
+	err = app.FeeGrantKeeper.GrantAllowance(ctx, stranger, grantee, &types.BasicAllowance{
+		SpendLimit: sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 20)),
+	})
+	suite.Require().NoError(err)
+
+	// the cases depend on each other, so they are run in order
+	cases := []struct {
+		name       string
+		feePayer   sdk.AccAddress
+		feeGranter sdk.AccAddress
+		fee        int64
+		valid      bool
+	}{
+		{
+			name:     "paid by fee payer",
+			feePayer: grantee,
+			fee:      50,
+			valid:    true,
+		},
+		{
+			name:     "paid by fee payer without funds",
+			feePayer: emptyGrantee,
+			fee:      50,
+			valid:    false,
+		},
+		{
+			name:       "paid by fee payer acting as granter",
+			feePayer:   grantee,
+			feeGranter: grantee,
+			fee:        50,
+			valid:      true,
+		},
+		{
+			name:       "paid by granter within allowance",
+			feePayer:   emptyGrantee,
+			feeGranter: granter,
+			fee:        300,
+			valid:      true,
+		},
+		{
+			name:       "paid by granter over remaining allowance",
+			feePayer:   emptyGrantee,
+			feeGranter: granter,
+			fee:        300,
+			valid:      false,
+		},
+		{
+			name:       "paid by granter over allowance",
+			feePayer:   grantee,
+			feeGranter: stranger,
+			fee:        50,
+			valid:      false,
+		},
+		{
+			name:       "paid by granter without allowance",
+			feePayer:   grantee,
+			feeGranter: granter,
+			fee:        50,
+			valid:      false,
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			fee := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, tc.fee))
+
+			txBuilder := encodingConfig.TxConfig.NewTxBuilder()
+			suite.Require().NoError(txBuilder.SetMsgs(testdata.NewTestMsg(tc.feePayer)))
+			txBuilder.SetFeeAmount(fee)
+			txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+			txBuilder.SetFeeGranter(tc.feeGranter)
+
+			payer := tc.feePayer
+			if len(tc.feeGranter) != 0 {
+				payer = tc.feeGranter
+			}
+			before := app.BankKeeper.GetAllBalances(ctx, payer)
+
+			_, err := antehandler(ctx, txBuilder.GetTx(), false)
+			if !tc.valid {
+				suite.Require().Error(err)
+				suite.Require().Equal(before, app.BankKeeper.GetAllBalances(ctx, payer))
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().Equal(before.Sub(fee), app.BankKeeper.GetAllBalances(ctx, payer))
+		})
+	}
+}
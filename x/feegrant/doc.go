@@ -0,0 +1,19 @@
+/*
+Package feegrant provides functionality for authorizing the payment of transaction
+fees from one account (key) to another account (key).
+
+Effectively, this allows for a user to pay fees using the balance of an account
+different from their own. Example use cases would be allowing a key on a device to
+pay for fees using a master wallet, or a third party service allowing users to
+pay for transactions without ever really holding their own tokens. This package
+provides ways for specifying fee allowances such that authorizing fee payment to
+another account can be done with clear and safe restrictions.
+
+A user would authorize granting fee payment to another user using
+MsgGrantAllowance and revoke that delegation using MsgRevokeAllowance.
+
+The fee granter is set on a tx through the Fee.granter field, and the fees are
+deducted from the granter by the ante.DeductGrantedFeeDecorator when its
+allowance covers them.
+*/
+package feegrant
@@ -0,0 +1,52 @@
+package feegrant
+
+import (
+	"fmt"
+
+	sdk "github.com/line/lfb-sdk/types"
+	"github.com/line/lfb-sdk/x/feegrant/keeper"
+	"github.com/line/lfb-sdk/x/feegrant/types"
+)
+
+// InitGenesis initializes the feegrant module's state from a provided genesis
+// state.
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, gs *types.GenesisState) {
+	if err := gs.Validate(); err != nil {
+		panic(fmt.Sprintf("failed to validate %s genesis state: %s", types.ModuleName, err))
+	}
+
+	for _, f := range gs.Allowances {
+		granter, err := sdk.AccAddressFromBech32(f.Granter)
+		if err != nil {
+			panic(err)
+		}
+		grantee, err := sdk.AccAddressFromBech32(f.Grantee)
+		if err != nil {
+			panic(err)
+		}
+
+		grant, err := f.GetGrant()
+		if err != nil {
+			panic(err)
+		}
+
+		if err := k.GrantAllowance(ctx, granter, grantee, grant); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// ExportGenesis returns the feegrant module's exported genesis.
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	var grants []types.Grant
+
+	err := k.IterateAllFeeAllowances(ctx, func(grant types.Grant) bool {
+		grants = append(grants, grant)
+		return false
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return types.NewGenesisState(grants)
+}
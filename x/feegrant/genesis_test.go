@@ -0,0 +1,108 @@
+package feegrant_test
+
+import (
+	"testing"
+	"time"
+
+	ostproto "github.com/line/ostracon/proto/ostracon/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/line/lfb-sdk/crypto/keys/secp256k1"
+	"github.com/line/lfb-sdk/simapp"
+	sdk "github.com/line/lfb-sdk/types"
+	"github.com/line/lfb-sdk/x/feegrant"
+	"github.com/line/lfb-sdk/x/feegrant/keeper"
+	"github.com/line/lfb-sdk/x/feegrant/types"
+)
+
+type GenesisTestSuite struct {
+	suite.Suite
+
+	ctx    sdk.Context
+	keeper keeper.Keeper
+}
+
+func (suite *GenesisTestSuite) SetupTest() {
+	checkTx := false
+	app := simapp.Setup(checkTx)
+
+	suite.ctx = app.BaseApp.NewContext(checkTx, ostproto.Header{Height: 1, Time: time.Now().UTC()})
+	suite.keeper = app.FeeGrantKeeper
+}
+
+var (
+	granteePub  = secp256k1.GenPrivKey().PubKey()
+	granterPub  = secp256k1.GenPrivKey().PubKey()
+	granteeAddr = sdk.AccAddress(granteePub.Address())
+	granterAddr = sdk.AccAddress(granterPub.Address())
+)
+
+func (suite *GenesisTestSuite) TestImportExportGenesis() {
+	coins := sdk.NewCoins(sdk.NewCoin("foo", sdk.NewInt(1000)))
+	now := suite.ctx.BlockHeader().Time
+	oneYear := now.AddDate(1, 0, 0)
+
+	allowance := &types.BasicAllowance{SpendLimit: coins, Expiration: &oneYear}
+	err := suite.keeper.GrantAllowance(suite.ctx, granterAddr, granteeAddr, allowance)
+	suite.Require().NoError(err)
+
+	genesis := feegrant.ExportGenesis(suite.ctx, suite.keeper)
+	suite.Require().Len(genesis.Allowances, 1)
+
+	// re-import the exported state into a fresh app
+	suite.SetupTest()
+	feegrant.InitGenesis(suite.ctx, suite.keeper, genesis)
+
+	newGenesis := feegrant.ExportGenesis(suite.ctx, suite.keeper)
+	suite.Require().Equal(genesis, newGenesis)
+}
+
+func (suite *GenesisTestSuite) TestInitGenesisInvalid() {
+	any, err := types.NewGrant(granterAddr, granteeAddr, &types.BasicAllowance{})
+	suite.Require().NoError(err)
+
+	testCases := []struct {
+		msg     string
+		grants  []types.Grant
+		isPanic bool
+	}{
+		{
+			"invalid granter",
+			[]types.Grant{{Granter: "invalid granter", Grantee: granteeAddr.String(), Allowance: any.Allowance}},
+			true,
+		},
+		{
+			"invalid grantee",
+			[]types.Grant{{Granter: granterAddr.String(), Grantee: "invalid grantee", Allowance: any.Allowance}},
+			true,
+		},
+		{
+			"invalid allowance",
+			[]types.Grant{{Granter: granterAddr.String(), Grantee: granteeAddr.String()}},
+			true,
+		},
+		{
+			"valid",
+			[]types.Grant{any},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.msg, func() {
+			suite.SetupTest()
+
+			genesis := types.NewGenesisState(tc.grants)
+			if tc.isPanic {
+				suite.Require().Panics(func() { feegrant.InitGenesis(suite.ctx, suite.keeper, genesis) })
+			} else {
+				suite.Require().NotPanics(func() { feegrant.InitGenesis(suite.ctx, suite.keeper, genesis) })
+			}
+		})
+	}
+}
+
+func TestGenesisTestSuite(t *testing.T) {
+	suite.Run(t, new(GenesisTestSuite))
+}
@@ -0,0 +1,189 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/line/ostracon/libs/log"
+
+	"github.com/line/lfb-sdk/codec"
+	sdk "github.com/line/lfb-sdk/types"
+	sdkerrors "github.com/line/lfb-sdk/types/errors"
+	"github.com/line/lfb-sdk/x/feegrant/types"
+)
+
+// Keeper manages state of all fee grants, as well as calculating approval.
+// It must have a codec with all available allowances registered.
+type Keeper struct {
+	cdc        codec.BinaryMarshaler
+	storeKey   sdk.StoreKey
+	authKeeper types.AccountKeeper
+}
+
+// NewKeeper creates a fee grant Keeper
+func NewKeeper(cdc codec.BinaryMarshaler, storeKey sdk.StoreKey, ak types.AccountKeeper) Keeper {
+	return Keeper{
+		cdc:        cdc,
+		storeKey:   storeKey,
+		authKeeper: ak,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// GrantAllowance creates a new grant
+func (k Keeper) GrantAllowance(ctx sdk.Context, granter, grantee sdk.AccAddress, feeAllowance types.FeeAllowanceI) error {
+	// create the account if it is not in account state
+	granteeAcc := k.authKeeper.GetAccount(ctx, grantee)
+	if granteeAcc == nil {
+		granteeAcc = k.authKeeper.NewAccountWithAddress(ctx, grantee)
+		k.authKeeper.SetAccount(ctx, granteeAcc)
+	}
+
+	if err := k.setAllowance(ctx, granter, grantee, feeAllowance); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetFeeGrant,
+			sdk.NewAttribute(types.AttributeKeyGranter, granter.String()),
+			sdk.NewAttribute(types.AttributeKeyGrantee, grantee.String()),
+		),
+	)
+
+	return nil
+}
+
+func (k Keeper) setAllowance(ctx sdk.Context, granter, grantee sdk.AccAddress, feeAllowance types.FeeAllowanceI) error {
+	grant, err := types.NewGrant(granter, grantee, feeAllowance)
+	if err != nil {
+		return err
+	}
+
+	bz, err := k.cdc.MarshalBinaryBare(&grant)
+	if err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.FeeAllowanceKey(granter, grantee), bz)
+
+	return nil
+}
+
+// revokeAllowance removes an existing grant
+func (k Keeper) revokeAllowance(ctx sdk.Context, granter, grantee sdk.AccAddress) error {
+	if _, err := k.getGrant(ctx, granter, grantee); err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.FeeAllowanceKey(granter, grantee))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRevokeFeeGrant,
+			sdk.NewAttribute(types.AttributeKeyGranter, granter.String()),
+			sdk.NewAttribute(types.AttributeKeyGrantee, grantee.String()),
+		),
+	)
+
+	return nil
+}
+
+// GetAllowance returns the allowance between the granter and grantee.
+// If there is none, it returns nil, nil.
+// Returns an error on parsing issues
+func (k Keeper) GetAllowance(ctx sdk.Context, granter, grantee sdk.AccAddress) (types.FeeAllowanceI, error) {
+	grant, err := k.getGrant(ctx, granter, grantee)
+	if err != nil {
+		return nil, err
+	}
+
+	return grant.GetGrant()
+}
+
+// getGrant returns entire grant between both accounts
+func (k Keeper) getGrant(ctx sdk.Context, granter sdk.AccAddress, grantee sdk.AccAddress) (*types.Grant, error) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.FeeAllowanceKey(granter, grantee))
+	if len(bz) == 0 {
+		return nil, sdkerrors.Wrap(types.ErrNoAllowance, "fee-grant not found")
+	}
+
+	var feegrant types.Grant
+	if err := k.cdc.UnmarshalBinaryBare(bz, &feegrant); err != nil {
+		return nil, err
+	}
+
+	return &feegrant, nil
+}
+
+// IterateAllFeeAllowances iterates over all the grants in the store.
+// Callback to get all data, returns true to stop, false to keep reading
+// Calling this without pagination is very expensive and only designed for export genesis
+func (k Keeper) IterateAllFeeAllowances(ctx sdk.Context, cb func(grant types.Grant) bool) error {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.FeeAllowanceKeyPrefix)
+	defer iter.Close()
+
+	stop := false
+	for ; iter.Valid() && !stop; iter.Next() {
+		bz := iter.Value()
+		var feeGrant types.Grant
+		if err := k.cdc.UnmarshalBinaryBare(bz, &feeGrant); err != nil {
+			return err
+		}
+
+		stop = cb(feeGrant)
+	}
+
+	return nil
+}
+
+// UseGrantedFees will try to pay the given fee from the granter's account as requested by the grantee
+func (k Keeper) UseGrantedFees(ctx sdk.Context, granter, grantee sdk.AccAddress, fee sdk.Coins, msgs []sdk.Msg) error {
+	f, err := k.getGrant(ctx, granter, grantee)
+	if err != nil {
+		return err
+	}
+
+	grant, err := f.GetGrant()
+	if err != nil {
+		return err
+	}
+
+	remove, err := grant.Accept(ctx, fee, msgs)
+	if remove {
+		// Ignoring the `revokeAllowance` error, because the grant is known to exist.
+		_ = k.revokeAllowance(ctx, granter, grantee)
+		if err != nil {
+			return err
+		}
+
+		emitUseGrantEvent(ctx, granter.String(), grantee.String())
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	emitUseGrantEvent(ctx, granter.String(), grantee.String())
+
+	// if fee allowance is accepted, store the updated state of the allowance
+	return k.setAllowance(ctx, granter, grantee, grant)
+}
+
+func emitUseGrantEvent(ctx sdk.Context, granter, grantee string) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUseFeeGrant,
+			sdk.NewAttribute(types.AttributeKeyGranter, granter),
+			sdk.NewAttribute(types.AttributeKeyGrantee, grantee),
+		),
+	)
+}
@@ -0,0 +1,153 @@
+package types_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/line/lfb-sdk/codec"
+	"github.com/line/lfb-sdk/codec/types"
+	sdk "github.com/line/lfb-sdk/types"
+	feegranttypes "github.com/line/lfb-sdk/x/feegrant/types"
+)
+
+func TestMsgGrantAllowance(t *testing.T) {
+	cdc := codec.NewProtoCodec(types.NewInterfaceRegistry())
+	addr := sdk.AccAddress("grantee_address_____")
+	addr2 := sdk.AccAddress("granter_address_____")
+	atom := sdk.NewCoins(sdk.NewInt64Coin("atom", 555))
+	threeHours := time.Now().Add(3 * time.Hour)
+	basic := &feegranttypes.BasicAllowance{
+		SpendLimit: atom,
+		Expiration: &threeHours,
+	}
+
+	cases := map[string]struct {
+		grantee sdk.AccAddress
+		granter sdk.AccAddress
+		grant   *feegranttypes.BasicAllowance
+		valid   bool
+	}{
+		"valid": {
+			grantee: addr,
+			granter: addr2,
+			grant:   basic,
+			valid:   true,
+		},
+		"no grantee": {
+			granter: addr2,
+			grantee: sdk.AccAddress{},
+			grant:   basic,
+			valid:   false,
+		},
+		"no granter": {
+			granter: sdk.AccAddress{},
+			grantee: addr,
+			grant:   basic,
+			valid:   false,
+		},
+		"grantee == granter": {
+			grantee: addr,
+			granter: addr,
+			grant:   basic,
+			valid:   false,
+		},
+	}
+
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			msg, err := feegranttypes.NewMsgGrantAllowance(tc.grant, tc.granter, tc.grantee)
+			require.NoError(t, err)
+			err = msg.ValidateBasic()
+
+			if tc.valid {
+				require.NoError(t, err)
+
+				addrSlice := msg.GetSigners()
+				require.Equal(t, tc.granter.String(), addrSlice[0].String())
+
+				allowance, err := msg.GetFeeAllowanceI()
+				require.NoError(t, err)
+				require.Equal(t, tc.grant, allowance)
+
+				err = msg.UnpackInterfaces(cdc)
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestMsgRevokeAllowance(t *testing.T) {
+	addr := sdk.AccAddress("grantee_address_____")
+	addr2 := sdk.AccAddress("granter_address_____")
+
+	cases := map[string]struct {
+		grantee sdk.AccAddress
+		granter sdk.AccAddress
+		valid   bool
+	}{
+		"valid": {
+			grantee: addr,
+			granter: addr2,
+			valid:   true,
+		},
+		"no grantee": {
+			granter: addr2,
+			grantee: sdk.AccAddress{},
+			valid:   false,
+		},
+		"no granter": {
+			granter: sdk.AccAddress{},
+			grantee: addr,
+			valid:   false,
+		},
+		"grantee == granter": {
+			grantee: addr,
+			granter: addr,
+			valid:   false,
+		},
+	}
+
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			msg := feegranttypes.NewMsgRevokeAllowance(tc.granter, tc.grantee)
+			err := msg.ValidateBasic()
+			if tc.valid {
+				require.NoError(t, err)
+				addrSlice := msg.GetSigners()
+				require.Equal(t, tc.granter.String(), addrSlice[0].String())
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestAminoJSON(t *testing.T) {
+	someDate := time.Date(1, 1, 1, 1, 1, 1, 1, time.UTC)
+	basic := &feegranttypes.BasicAllowance{
+		SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("atom", 555)),
+		Expiration: &someDate,
+	}
+	grantee := sdk.AccAddress("grantee_address_____")
+	granter := sdk.AccAddress("granter_address_____")
+
+	grant, err := feegranttypes.NewMsgGrantAllowance(basic, granter, grantee)
+	require.NoError(t, err)
+	require.Equal(t,
+		fmt.Sprintf(`{"type":"lfb-sdk/MsgGrantAllowance","value":{"allowance":{"type":"lfb-sdk/BasicAllowance","value":{"expiration":"0001-01-01T01:01:01.000000001Z","spend_limit":[{"amount":"555","denom":"atom"}]}},"grantee":"%s","granter":"%s"}}`, grantee, granter),
+		string(grant.GetSignBytes()),
+	)
+
+	revoke := feegranttypes.NewMsgRevokeAllowance(granter, grantee)
+	require.Equal(t,
+		fmt.Sprintf(`{"type":"lfb-sdk/MsgRevokeAllowance","value":{"grantee":"%s","granter":"%s"}}`, grantee, granter),
+		string(revoke.GetSignBytes()),
+	)
+}
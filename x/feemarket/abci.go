@@ -0,0 +1,42 @@
+package feemarket
+
+import (
+	"time"
+
+	"github.com/line/lfb-sdk/telemetry"
+	sdk "github.com/line/lfb-sdk/types"
+	"github.com/line/lfb-sdk/x/feemarket/keeper"
+	"github.com/line/lfb-sdk/x/feemarket/types"
+)
+
+// EndBlocker burns the base fees collected by the txs of the block and adjusts
+// the base fee for the next block to the gas used by the block.
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
+
+	if err := k.BurnCollectedFees(ctx); err != nil {
+		panic(err)
+	}
+
+	params := k.GetParams(ctx)
+	if !params.Enabled {
+		return
+	}
+
+	gasMeter := ctx.BlockGasMeter()
+	if gasMeter == nil {
+		return
+	}
+	gasUsed := gasMeter.GasConsumedToLimit()
+
+	baseFee := params.NextBaseFee(k.GetBaseFee(ctx), gasUsed, gasMeter.Limit())
+	k.SetBaseFee(ctx, baseFee)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeFeeMarket,
+			sdk.NewAttribute(types.AttributeKeyBaseFee, baseFee.String()),
+			sdk.NewAttribute(types.AttributeKeyGasUsed, sdk.NewIntFromUint64(gasUsed).String()),
+		),
+	)
+}
@@ -0,0 +1,67 @@
+package feemarket_test
+
+import (
+	"testing"
+
+	ostproto "github.com/line/ostracon/proto/ostracon/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/line/lfb-sdk/simapp"
+	sdk "github.com/line/lfb-sdk/types"
+	authtypes "github.com/line/lfb-sdk/x/auth/types"
+	"github.com/line/lfb-sdk/x/feemarket"
+	"github.com/line/lfb-sdk/x/feemarket/types"
+	minttypes "github.com/line/lfb-sdk/x/mint/types"
+)
+
+func TestEndBlocker(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, ostproto.Header{})
+
+	// the default genesis base fee is zero
+	require.Equal(t, sdk.ZeroDec(), app.FeeMarketKeeper.GetBaseFee(ctx))
+
+	baseFee := sdk.NewDecWithPrec(1, 1)
+	app.FeeMarketKeeper.SetBaseFee(ctx, baseFee)
+
+	// a block of unlimited gas leaves the base fee unchanged
+	ctx = ctx.WithBlockGasMeter(sdk.NewInfiniteGasMeter())
+	ctx.BlockGasMeter().ConsumeGas(1000, "test")
+	feemarket.EndBlocker(ctx, app.FeeMarketKeeper)
+	require.Equal(t, baseFee, app.FeeMarketKeeper.GetBaseFee(ctx))
+
+	// a full block raises the base fee by 1/8
+	ctx = ctx.WithBlockGasMeter(sdk.NewGasMeter(1000))
+	ctx.BlockGasMeter().ConsumeGas(1000, "test")
+	feemarket.EndBlocker(ctx, app.FeeMarketKeeper)
+	require.Equal(t, sdk.NewDecWithPrec(1125, 4), app.FeeMarketKeeper.GetBaseFee(ctx))
+
+	// an empty block lowers it by 1/8
+	ctx = ctx.WithBlockGasMeter(sdk.NewGasMeter(1000))
+	feemarket.EndBlocker(ctx, app.FeeMarketKeeper)
+	require.Equal(t, sdk.NewDecWithPrec(984375, 7), app.FeeMarketKeeper.GetBaseFee(ctx))
+
+	// the base fee is not adjusted while the fee market is disabled
+	params := app.FeeMarketKeeper.GetParams(ctx)
+	params.Enabled = false
+	app.FeeMarketKeeper.SetParams(ctx, params)
+	feemarket.EndBlocker(ctx, app.FeeMarketKeeper)
+	require.Equal(t, sdk.NewDecWithPrec(984375, 7), app.FeeMarketKeeper.GetBaseFee(ctx))
+}
+
+func TestEndBlockerBurnsCollectedFees(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, ostproto.Header{})
+
+	fees := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000))
+	require.NoError(t, app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, fees))
+	require.NoError(t, app.BankKeeper.SendCoinsFromModuleToModule(ctx, minttypes.ModuleName, authtypes.FeeCollectorName, fees))
+	require.NoError(t, app.FeeMarketKeeper.CollectBurnedFees(ctx, fees))
+
+	supply := app.BankKeeper.GetSupply(ctx).GetTotal()
+	feemarket.EndBlocker(ctx.WithBlockGasMeter(sdk.NewInfiniteGasMeter()), app.FeeMarketKeeper)
+
+	feeMarket := app.AccountKeeper.GetModuleAddress(types.ModuleName)
+	require.True(t, app.BankKeeper.GetAllBalances(ctx, feeMarket).IsZero())
+	require.Equal(t, supply.Sub(fees), app.BankKeeper.GetSupply(ctx).GetTotal())
+}
@@ -0,0 +1,81 @@
+package ante
+
+import (
+	sdk "github.com/line/lfb-sdk/types"
+	sdkerrors "github.com/line/lfb-sdk/types/errors"
+	"github.com/line/lfb-sdk/x/feemarket/types"
+)
+
+// FeeMarketKeeper defines the contract needed by the BaseFeeDecorator to enforce
+// the base fee and burn it.
+type FeeMarketKeeper interface {
+	GetParams(ctx sdk.Context) types.Params
+	GetBaseFee(ctx sdk.Context) sdk.Dec
+	CollectBurnedFees(ctx sdk.Context, fees sdk.Coins) error
+}
+
+// BaseFeeDecorator rejects txs whose fees don't cover the base fee for their
+// gas limit, and collects the base part of their fees to be burned at the end of
+// the block once the next AnteHandler has deducted them to the fee collector. The rest of the fees is left to the
+// fee collector as a tip, and rewarded to the proposer and the validators of
+// the block by x/distribution.
+// The base fee is not enforced when simulating, but the fees are burned up to
+// it anyway.
+// CONTRACT: Tx must implement FeeTx interface to use BaseFeeDecorator
+type BaseFeeDecorator struct {
+	k FeeMarketKeeper
+}
+
+func NewBaseFeeDecorator(k FeeMarketKeeper) BaseFeeDecorator {
+	return BaseFeeDecorator{
+		k: k,
+	}
+}
+
+func (bfd BaseFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	params := bfd.k.GetParams(ctx)
+	if !params.Enabled {
+		return next(ctx, tx, simulate)
+	}
+
+	required := params.RequiredFee(bfd.k.GetBaseFee(ctx), feeTx.GetGas())
+	paid := feeTx.GetFee().AmountOf(params.FeeDenom)
+	if !simulate && paid.LT(required.Amount) {
+		return ctx, sdkerrors.Wrapf(
+			sdkerrors.ErrInsufficientFee, "insufficient fees; got: %s required: %s", feeTx.GetFee(), required,
+		)
+	}
+
+	newCtx, err := next(ctx, tx, simulate)
+	if err != nil {
+		return newCtx, err
+	}
+
+	burned := required
+	if paid.LT(burned.Amount) {
+		burned.Amount = paid
+	}
+
+	// burning is not charged to the tx, so that simulations estimate its gas
+	// whatever the fees they are given
+	if err := bfd.k.CollectBurnedFees(newCtx.WithGasMeter(sdk.NewInfiniteGasMeter()), sdk.NewCoins(burned)); err != nil {
+		return newCtx, err
+	}
+
+	return newCtx, nil
+}
+
+// NewAnteHandler returns an AnteHandler enforcing and burning the base fee
+// around the given AnteHandler, which must deduct the fees of txs to the fee
+// collector.
+func NewAnteHandler(k FeeMarketKeeper, anteHandler sdk.AnteHandler) sdk.AnteHandler {
+	bfd := NewBaseFeeDecorator(k)
+	return func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+		return bfd.AnteHandle(ctx, tx, simulate, anteHandler)
+	}
+}
@@ -0,0 +1,125 @@
+package ante_test
+
+import (
+	"errors"
+	"testing"
+
+	ostproto "github.com/line/ostracon/proto/ostracon/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/line/lfb-sdk/simapp"
+	"github.com/line/lfb-sdk/testutil/testdata"
+	sdk "github.com/line/lfb-sdk/types"
+	sdkerrors "github.com/line/lfb-sdk/types/errors"
+	authante "github.com/line/lfb-sdk/x/auth/ante"
+	authtypes "github.com/line/lfb-sdk/x/auth/types"
+	"github.com/line/lfb-sdk/x/feemarket/ante"
+	"github.com/line/lfb-sdk/x/feemarket/types"
+)
+
+type AnteTestSuite struct {
+	suite.Suite
+
+	app *simapp.SimApp
+	ctx sdk.Context
+}
+
+func TestAnteTestSuite(t *testing.T) {
+	suite.Run(t, new(AnteTestSuite))
+}
+
+func (suite *AnteTestSuite) SetupTest() {
+	suite.app = simapp.Setup(false)
+	suite.ctx = suite.app.BaseApp.NewContext(false, ostproto.Header{}).WithBlockHeight(1)
+}
+
+func (suite *AnteTestSuite) TestBaseFee() {
+	app, ctx := suite.app, suite.ctx
+	encodingConfig := simapp.MakeTestEncodingConfig()
+
+	antehandler := ante.NewAnteHandler(
+		app.FeeMarketKeeper,
+		sdk.ChainAnteDecorators(authante.NewDeductFeeDecorator(app.AccountKeeper, app.BankKeeper)),
+	)
+
+	// 0.025 * 200000 gas
+	app.FeeMarketKeeper.SetBaseFee(ctx, sdk.NewDecWithPrec(25, 3))
+	baseFee := sdk.NewInt64Coin(sdk.DefaultBondDenom, 5000)
+
+	addr := simapp.AddTestAddrsIncremental(app, ctx, 1, sdk.NewInt(100000))[0]
+	feeCollector := app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	feeMarket := app.AccountKeeper.GetModuleAddress(types.ModuleName)
+
+	cases := []struct {
+		name     string
+		fee      sdk.Coins
+		simulate bool
+		disabled bool
+		expErr   error
+		burned   sdk.Coins
+	}{
+		{
+			name:   "covering the base fee",
+			fee:    sdk.NewCoins(baseFee),
+			burned: sdk.NewCoins(baseFee),
+		},
+		{
+			name:   "with a tip",
+			fee:    sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 6000)),
+			burned: sdk.NewCoins(baseFee),
+		},
+		{
+			name:   "below the base fee",
+			fee:    sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 4999)),
+			expErr: sdkerrors.ErrInsufficientFee,
+		},
+		{
+			name:   "in another denom",
+			fee:    sdk.NewCoins(sdk.NewInt64Coin("atom", 5000)),
+			expErr: sdkerrors.ErrInsufficientFee,
+		},
+		{
+			name:     "simulating below the base fee",
+			fee:      sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000)),
+			simulate: true,
+			burned:   sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000)),
+		},
+		{
+			name:     "disabled",
+			fee:      sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000)),
+			disabled: true,
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			ctx, _ := ctx.CacheContext()
+
+			params := app.FeeMarketKeeper.GetParams(ctx)
+			params.Enabled = !tc.disabled
+			app.FeeMarketKeeper.SetParams(ctx, params)
+
+			txBuilder := encodingConfig.TxConfig.NewTxBuilder()
+			suite.Require().NoError(txBuilder.SetMsgs(testdata.NewTestMsg(addr)))
+			txBuilder.SetFeeAmount(tc.fee)
+			txBuilder.SetGasLimit(200000)
+
+			collected := app.BankKeeper.GetAllBalances(ctx, feeCollector)
+			toBurn := app.BankKeeper.GetAllBalances(ctx, feeMarket)
+
+			_, err := antehandler(ctx, txBuilder.GetTx(), tc.simulate)
+			if tc.expErr != nil {
+				suite.Require().True(errors.Is(err, tc.expErr), "unexpected error: %v", err)
+				return
+			}
+			suite.Require().NoError(err)
+
+			// the fees are deducted, the base part of them is collected to be
+			// burned and the tip is collected by the fee collector
+			tip := tc.fee.Sub(tc.burned)
+			suite.Require().Equal(collected.Add(tip...), app.BankKeeper.GetAllBalances(ctx, feeCollector))
+			suite.Require().True(toBurn.Add(tc.burned...).IsEqual(app.BankKeeper.GetAllBalances(ctx, feeMarket)))
+		})
+	}
+}
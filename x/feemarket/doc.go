@@ -0,0 +1,19 @@
+/*
+Package feemarket implements an on-chain fee market in the style of EIP-1559.
+
+The txs of a block must pay fees of at least the base fee, a gas price in the
+fee denom, times their gas limit. The base fee is adjusted at the end of each
+block from the gas used by the block, as measured by its block gas meter: it
+rises when the block used more gas than its target, i.e. the block gas limit
+divided by the elasticity multiplier, and falls when it used less. It is never
+adjusted if the block gas is unlimited.
+
+The ante.BaseFeeDecorator enforces the base fee and moves the base part of the
+fees to the feemarket module account, which is burned at the end of the block.
+The rest of the fees, the tip, is left to the fee collector and rewarded
+to the proposer and the validators of the block as usual.
+
+The parameters of the fee market are controlled by governance through param
+change proposals, and the current base fee is queryable over gRPC.
+*/
+package feemarket
@@ -0,0 +1,19 @@
+package feemarket
+
+import (
+	sdk "github.com/line/lfb-sdk/types"
+	"github.com/line/lfb-sdk/x/feemarket/keeper"
+	"github.com/line/lfb-sdk/x/feemarket/types"
+)
+
+// InitGenesis new feemarket genesis
+func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, ak types.AccountKeeper, data *types.GenesisState) {
+	keeper.SetParams(ctx, data.Params)
+	keeper.SetBaseFee(ctx, data.BaseFee)
+	ak.GetModuleAccount(ctx, types.ModuleName)
+}
+
+// ExportGenesis returns a GenesisState for a given context and keeper.
+func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) *types.GenesisState {
+	return types.NewGenesisState(keeper.GetParams(ctx), keeper.GetBaseFee(ctx))
+}
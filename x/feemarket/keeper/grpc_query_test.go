@@ -0,0 +1,33 @@
+package keeper_test
+
+import (
+	gocontext "context"
+	"testing"
+
+	ostproto "github.com/line/ostracon/proto/ostracon/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/line/lfb-sdk/baseapp"
+	"github.com/line/lfb-sdk/simapp"
+	sdk "github.com/line/lfb-sdk/types"
+	"github.com/line/lfb-sdk/x/feemarket/types"
+)
+
+func TestGRPCQuery(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, ostproto.Header{})
+
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, app.FeeMarketKeeper)
+	queryClient := types.NewQueryClient(queryHelper)
+
+	params, err := queryClient.Params(gocontext.Background(), &types.QueryParamsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, app.FeeMarketKeeper.GetParams(ctx), params.Params)
+
+	app.FeeMarketKeeper.SetBaseFee(ctx, sdk.NewDecWithPrec(25, 3))
+	baseFee, err := queryClient.BaseFee(gocontext.Background(), &types.QueryBaseFeeRequest{})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDecWithPrec(25, 3), baseFee.BaseFee)
+	require.Equal(t, params.Params.FeeDenom, baseFee.FeeDenom)
+}
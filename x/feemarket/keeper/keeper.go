@@ -0,0 +1,112 @@
+package keeper
+
+import (
+	"github.com/line/ostracon/libs/log"
+
+	"github.com/line/lfb-sdk/codec"
+	sdk "github.com/line/lfb-sdk/types"
+	"github.com/line/lfb-sdk/x/feemarket/types"
+	paramtypes "github.com/line/lfb-sdk/x/params/types"
+)
+
+// Keeper of the feemarket store
+type Keeper struct {
+	cdc              codec.BinaryMarshaler
+	storeKey         sdk.StoreKey
+	paramSpace       *paramtypes.Subspace
+	bankKeeper       types.BankKeeper
+	feeCollectorName string
+	moduleAddress    sdk.AccAddress
+}
+
+// NewKeeper creates a new feemarket Keeper instance
+func NewKeeper(
+	cdc codec.BinaryMarshaler, key sdk.StoreKey, paramSpace *paramtypes.Subspace,
+	ak types.AccountKeeper, bk types.BankKeeper, feeCollectorName string,
+) Keeper {
+	// ensure feemarket module account is set
+	moduleAddress := ak.GetModuleAddress(types.ModuleName)
+	if moduleAddress == nil {
+		panic("the feemarket module account has not been set")
+	}
+
+	// set KeyTable if it has not already been set
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	}
+
+	return Keeper{
+		cdc:              cdc,
+		storeKey:         key,
+		paramSpace:       paramSpace,
+		bankKeeper:       bk,
+		feeCollectorName: feeCollectorName,
+		moduleAddress:    moduleAddress,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+types.ModuleName)
+}
+
+// GetBaseFee returns the gas price the txs of the current block must pay at
+// least.
+func (k Keeper) GetBaseFee(ctx sdk.Context) sdk.Dec {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.BaseFeeKey)
+	if bz == nil {
+		panic("stored base fee should not have been nil")
+	}
+
+	var baseFee sdk.Dec
+	if err := baseFee.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+
+	return baseFee
+}
+
+// SetBaseFee sets the base fee.
+func (k Keeper) SetBaseFee(ctx sdk.Context, baseFee sdk.Dec) {
+	bz, err := baseFee.Marshal()
+	if err != nil {
+		panic(err)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.BaseFeeKey, bz)
+}
+
+// GetParams returns the total set of feemarket parameters.
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	k.paramSpace.GetParamSet(ctx, &params)
+	return params
+}
+
+// SetParams sets the total set of feemarket parameters.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
+
+// CollectBurnedFees moves the given fees out of those collected by the fee
+// collector to the feemarket module account, where they are burned at the end
+// of the block. Burning them once per block keeps the txs of a block from all
+// updating the supply, so that they can be executed concurrently.
+func (k Keeper) CollectBurnedFees(ctx sdk.Context, fees sdk.Coins) error {
+	if fees.Empty() {
+		return nil
+	}
+
+	return k.bankKeeper.SendCoinsFromModuleToModule(ctx, k.feeCollectorName, types.ModuleName, fees)
+}
+
+// BurnCollectedFees burns the fees collected by the feemarket module account.
+func (k Keeper) BurnCollectedFees(ctx sdk.Context) error {
+	fees := k.bankKeeper.GetAllBalances(ctx, k.moduleAddress)
+	if fees.Empty() {
+		return nil
+	}
+
+	return k.bankKeeper.BurnCoins(ctx, types.ModuleName, fees)
+}
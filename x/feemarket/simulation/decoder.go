@@ -0,0 +1,30 @@
+package simulation
+
+import (
+	"bytes"
+	"fmt"
+
+	sdk "github.com/line/lfb-sdk/types"
+	"github.com/line/lfb-sdk/types/kv"
+	"github.com/line/lfb-sdk/x/feemarket/types"
+)
+
+// NewDecodeStore returns a decoder function closure that umarshals the KVPair's
+// Value to the corresponding feemarket type.
+func NewDecodeStore() func(kvA, kvB kv.Pair) string {
+	return func(kvA, kvB kv.Pair) string {
+		switch {
+		case bytes.Equal(kvA.Key, types.BaseFeeKey):
+			var baseFeeA, baseFeeB sdk.Dec
+			if err := baseFeeA.Unmarshal(kvA.Value); err != nil {
+				panic(err)
+			}
+			if err := baseFeeB.Unmarshal(kvB.Value); err != nil {
+				panic(err)
+			}
+			return fmt.Sprintf("%v\n%v", baseFeeA, baseFeeB)
+		default:
+			panic(fmt.Sprintf("invalid feemarket key %X", kvA.Key))
+		}
+	}
+}
@@ -0,0 +1,57 @@
+package simulation
+
+// DONTCOVER
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+
+	sdk "github.com/line/lfb-sdk/types"
+	"github.com/line/lfb-sdk/types/module"
+	"github.com/line/lfb-sdk/x/feemarket/types"
+)
+
+// Simulation parameter constants
+const (
+	BaseFeeChangeDenominator = "base_fee_change_denominator"
+	ElasticityMultiplier     = "elasticity_multiplier"
+)
+
+// GenBaseFeeChangeDenominator randomized BaseFeeChangeDenominator
+func GenBaseFeeChangeDenominator(r *rand.Rand) uint32 {
+	return uint32(r.Intn(16) + 1)
+}
+
+// GenElasticityMultiplier randomized ElasticityMultiplier
+func GenElasticityMultiplier(r *rand.Rand) uint32 {
+	return uint32(r.Intn(4) + 1)
+}
+
+// RandomizedGenState generates a random GenesisState for feemarket
+func RandomizedGenState(simState *module.SimulationState) {
+	// params
+	var baseFeeChangeDenominator uint32
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, BaseFeeChangeDenominator, &baseFeeChangeDenominator, simState.Rand,
+		func(r *rand.Rand) { baseFeeChangeDenominator = GenBaseFeeChangeDenominator(r) },
+	)
+
+	var elasticityMultiplier uint32
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, ElasticityMultiplier, &elasticityMultiplier, simState.Rand,
+		func(r *rand.Rand) { elasticityMultiplier = GenElasticityMultiplier(r) },
+	)
+
+	params := types.NewParams(true, sdk.DefaultBondDenom, baseFeeChangeDenominator, elasticityMultiplier, sdk.ZeroDec())
+
+	// the simulated txs pay random fees, which may not cover any base fee
+	feemarketGenesis := types.NewGenesisState(params, sdk.ZeroDec())
+
+	bz, err := json.MarshalIndent(&feemarketGenesis, "", " ")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Selected randomly generated feemarket parameters:\n%s\n", bz)
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(feemarketGenesis)
+}
@@ -0,0 +1,34 @@
+package simulation
+
+// DONTCOVER
+
+import (
+	"fmt"
+	"math/rand"
+
+	simtypes "github.com/line/lfb-sdk/types/simulation"
+	"github.com/line/lfb-sdk/x/feemarket/types"
+	"github.com/line/lfb-sdk/x/simulation"
+)
+
+const (
+	keyBaseFeeChangeDenominator = "BaseFeeChangeDenominator"
+	keyElasticityMultiplier     = "ElasticityMultiplier"
+)
+
+// ParamChanges defines the parameters that can be modified by param change proposals
+// on the simulation
+func ParamChanges(r *rand.Rand) []simtypes.ParamChange {
+	return []simtypes.ParamChange{
+		simulation.NewSimParamChange(types.ModuleName, keyBaseFeeChangeDenominator,
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("%d", GenBaseFeeChangeDenominator(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, keyElasticityMultiplier,
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("%d", GenElasticityMultiplier(r))
+			},
+		),
+	}
+}
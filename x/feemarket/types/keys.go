@@ -0,0 +1,17 @@
+package types
+
+const (
+	// ModuleName is the module name constant used in many places
+	ModuleName = "feemarket"
+
+	// StoreKey is the store key string for feemarket
+	StoreKey = ModuleName
+
+	// QuerierRoute is the querier route for feemarket
+	QuerierRoute = ModuleName
+)
+
+var (
+	// BaseFeeKey is the key of the current base fee
+	BaseFeeKey = []byte{0x00}
+)
@@ -0,0 +1,184 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	yaml "gopkg.in/yaml.v2"
+
+	sdk "github.com/line/lfb-sdk/types"
+	paramtypes "github.com/line/lfb-sdk/x/params/types"
+)
+
+// Parameter store keys
+var (
+	KeyEnabled                  = []byte("Enabled")
+	KeyFeeDenom                 = []byte("FeeDenom")
+	KeyBaseFeeChangeDenominator = []byte("BaseFeeChangeDenominator")
+	KeyElasticityMultiplier     = []byte("ElasticityMultiplier")
+	KeyMinBaseFee               = []byte("MinBaseFee")
+)
+
+// ParamKeyTable for feemarket module.
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+func NewParams(
+	enabled bool, feeDenom string, baseFeeChangeDenominator, elasticityMultiplier uint32, minBaseFee sdk.Dec,
+) Params {
+	return Params{
+		Enabled:                  enabled,
+		FeeDenom:                 feeDenom,
+		BaseFeeChangeDenominator: baseFeeChangeDenominator,
+		ElasticityMultiplier:     elasticityMultiplier,
+		MinBaseFee:               minBaseFee,
+	}
+}
+
+// default feemarket module parameters, those of EIP-1559
+func DefaultParams() Params {
+	return Params{
+		Enabled:                  true,
+		FeeDenom:                 sdk.DefaultBondDenom,
+		BaseFeeChangeDenominator: 8,
+		ElasticityMultiplier:     2,
+		MinBaseFee:               sdk.ZeroDec(),
+	}
+}
+
+// validate params
+func (p Params) Validate() error {
+	if err := validateEnabled(p.Enabled); err != nil {
+		return err
+	}
+	if err := validateFeeDenom(p.FeeDenom); err != nil {
+		return err
+	}
+	if err := validateBaseFeeChangeDenominator(p.BaseFeeChangeDenominator); err != nil {
+		return err
+	}
+	if err := validateElasticityMultiplier(p.ElasticityMultiplier); err != nil {
+		return err
+	}
+
+	return validateMinBaseFee(p.MinBaseFee)
+}
+
+// String implements the Stringer interface.
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+// Implements params.ParamSet
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyEnabled, &p.Enabled, validateEnabled),
+		paramtypes.NewParamSetPair(KeyFeeDenom, &p.FeeDenom, validateFeeDenom),
+		paramtypes.NewParamSetPair(KeyBaseFeeChangeDenominator, &p.BaseFeeChangeDenominator, validateBaseFeeChangeDenominator),
+		paramtypes.NewParamSetPair(KeyElasticityMultiplier, &p.ElasticityMultiplier, validateElasticityMultiplier),
+		paramtypes.NewParamSetPair(KeyMinBaseFee, &p.MinBaseFee, validateMinBaseFee),
+	}
+}
+
+// NextBaseFee returns the base fee of the block following a block of the given
+// gas limit which used the given gas.
+//
+// The base fee rises if the block used more gas than its target, i.e. its gas
+// limit divided by the elasticity multiplier, and falls if it used less, in
+// proportion to the gap and by at most 1/BaseFeeChangeDenominator of itself.
+// It is left unchanged if the fee market is disabled or the block gas is
+// unlimited.
+func (p Params) NextBaseFee(baseFee sdk.Dec, gasUsed, gasLimit uint64) sdk.Dec {
+	target := gasLimit / uint64(p.ElasticityMultiplier)
+	if !p.Enabled || target == 0 || gasUsed == target {
+		return baseFee
+	}
+
+	var gap uint64
+	if gasUsed > target {
+		gap = gasUsed - target
+	} else {
+		gap = target - gasUsed
+	}
+	delta := baseFee.MulInt(sdk.NewIntFromUint64(gap)).
+		QuoInt(sdk.NewIntFromUint64(target)).
+		QuoInt64(int64(p.BaseFeeChangeDenominator))
+
+	if gasUsed > target {
+		// rise by the smallest step at least, so that a zero base fee can rise
+		return baseFee.Add(sdk.MaxDec(delta, sdk.SmallestDec()))
+	}
+
+	return sdk.MaxDec(baseFee.Sub(delta), p.MinBaseFee)
+}
+
+// RequiredFee returns the fee a tx of the given gas limit must pay at least
+// under the given base fee.
+func (p Params) RequiredFee(baseFee sdk.Dec, gasLimit uint64) sdk.Coin {
+	amount := baseFee.MulInt(sdk.NewIntFromUint64(gasLimit)).Ceil().RoundInt()
+	return sdk.NewCoin(p.FeeDenom, amount)
+}
+
+func validateEnabled(i interface{}) error {
+	_, ok := i.(bool)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateFeeDenom(i interface{}) error {
+	v, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if strings.TrimSpace(v) == "" {
+		return errors.New("fee denom cannot be blank")
+	}
+
+	return sdk.ValidateDenom(v)
+}
+
+func validateBaseFeeChangeDenominator(i interface{}) error {
+	v, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("base fee change denominator must be positive: %d", v)
+	}
+
+	return nil
+}
+
+func validateElasticityMultiplier(i interface{}) error {
+	v, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("elasticity multiplier must be positive: %d", v)
+	}
+
+	return nil
+}
+
+func validateMinBaseFee(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || v.IsNegative() {
+		return fmt.Errorf("min base fee cannot be negative: %s", v)
+	}
+
+	return nil
+}
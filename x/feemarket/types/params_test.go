@@ -0,0 +1,67 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/line/lfb-sdk/types"
+)
+
+func TestNextBaseFee(t *testing.T) {
+	params := DefaultParams()
+	params.MinBaseFee = sdk.NewDecWithPrec(1, 2)
+	baseFee := sdk.NewDecWithPrec(1, 1)
+
+	tests := []struct {
+		name              string
+		baseFee           sdk.Dec
+		gasUsed, gasLimit uint64
+		exp               sdk.Dec
+	}{
+		{"on target", baseFee, 500, 1000, baseFee},
+		// 0.1 + 0.1 * 1/8
+		{"full block", baseFee, 1000, 1000, sdk.NewDecWithPrec(1125, 4)},
+		// 0.1 + 0.1 * (250/500) * 1/8
+		{"half over target", baseFee, 750, 1000, sdk.NewDecWithPrec(10625, 5)},
+		// 0.1 - 0.1 * 1/8
+		{"empty block", baseFee, 0, 1000, sdk.NewDecWithPrec(875, 4)},
+		{"bounded by the min base fee", sdk.NewDecWithPrec(11, 3), 0, 1000, params.MinBaseFee},
+		{"rises from zero", sdk.ZeroDec(), 1000, 1000, sdk.SmallestDec()},
+		{"unlimited block gas", baseFee, 1000, 0, baseFee},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.exp, params.NextBaseFee(tc.baseFee, tc.gasUsed, tc.gasLimit))
+		})
+	}
+
+	params.Enabled = false
+	require.Equal(t, baseFee, params.NextBaseFee(baseFee, 1000, 1000))
+}
+
+func TestRequiredFee(t *testing.T) {
+	params := DefaultParams()
+
+	require.Equal(t, sdk.NewInt64Coin(params.FeeDenom, 5000), params.RequiredFee(sdk.NewDecWithPrec(25, 3), 200000))
+	// fractions are rounded up
+	require.Equal(t, sdk.NewInt64Coin(params.FeeDenom, 1), params.RequiredFee(sdk.SmallestDec(), 200000))
+	require.Equal(t, sdk.NewInt64Coin(params.FeeDenom, 0), params.RequiredFee(sdk.ZeroDec(), 200000))
+}
+
+func TestValidateGenesis(t *testing.T) {
+	require.NoError(t, ValidateGenesis(*DefaultGenesisState()))
+
+	params := DefaultParams()
+	params.MinBaseFee = sdk.NewDecWithPrec(1, 2)
+	require.Error(t, ValidateGenesis(*NewGenesisState(params, sdk.NewDecWithPrec(1, 3))))
+	require.NoError(t, ValidateGenesis(*NewGenesisState(params, sdk.NewDecWithPrec(1, 2))))
+
+	params.ElasticityMultiplier = 0
+	require.Error(t, ValidateGenesis(*NewGenesisState(params, sdk.NewDecWithPrec(1, 2))))
+
+	params = DefaultParams()
+	params.FeeDenom = ""
+	require.Error(t, ValidateGenesis(*NewGenesisState(params, sdk.ZeroDec())))
+}
@@ -53,13 +53,21 @@ func EndBlocker(ctx sdk.Context, keeper keeper.Keeper) {
 		}
 
 		if passes {
-			handler := keeper.Router().GetRoute(proposal.ProposalRoute())
 			cacheCtx, writeCache := ctx.CacheContext()
 
 			// The proposal handler may execute state mutating logic depending
-			// on the proposal content. If the handler fails, no state mutation
-			// is written and the error message is logged.
-			err := handler(cacheCtx, proposal.GetContent())
+			// on the proposal content, and so may the proposal messages. If the
+			// handler or any of the messages fails, no state mutation is
+			// written and the error message is logged. Proposals which only
+			// execute messages have no content to handle.
+			var err error
+			if content := proposal.GetContent(); content != nil {
+				handler := keeper.Router().GetRoute(content.ProposalRoute())
+				err = handler(cacheCtx, content)
+			}
+			if err == nil {
+				err = keeper.ExecuteProposalMessages(cacheCtx, proposal)
+			}
 			if err == nil {
 				proposal.Status = types.StatusPassed
 				tagValue = types.AttributeValueProposalPassed
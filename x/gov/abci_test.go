@@ -14,6 +14,7 @@ import (
 	"github.com/line/lfb-sdk/x/gov"
 	"github.com/line/lfb-sdk/x/gov/types"
 	"github.com/line/lfb-sdk/x/staking"
+	upgradetypes "github.com/line/lfb-sdk/x/upgrade/types"
 )
 
 func TestTickExpiredDepositPeriod(t *testing.T) {
@@ -307,7 +308,7 @@ func TestProposalPassedEndblocker(t *testing.T) {
 	deposits := initialModuleAccCoins.Add(proposal.TotalDeposit...).Add(proposalCoins...)
 	require.True(t, moduleAccCoins.IsEqual(deposits))
 
-	err = app.GovKeeper.AddVote(ctx, proposal.ProposalId, addrs[0], types.OptionYes)
+	err = app.GovKeeper.AddVote(ctx, proposal.ProposalId, addrs[0], types.NewNonSplitVoteOption(types.OptionYes))
 	require.NoError(t, err)
 
 	newHeader := ctx.BlockHeader()
@@ -348,7 +349,7 @@ func TestEndBlockerProposalHandlerFailed(t *testing.T) {
 
 	handleAndCheck(t, gov.NewHandler(app.GovKeeper), ctx, newDepositMsg)
 
-	err = app.GovKeeper.AddVote(ctx, proposal.ProposalId, addrs[0], types.OptionYes)
+	err = app.GovKeeper.AddVote(ctx, proposal.ProposalId, addrs[0], types.NewNonSplitVoteOption(types.OptionYes))
 	require.NoError(t, err)
 
 	newHeader := ctx.BlockHeader()
@@ -362,3 +363,66 @@ func TestEndBlockerProposalHandlerFailed(t *testing.T) {
 	// validate that the proposal fails/has been rejected
 	gov.EndBlocker(ctx, app.GovKeeper)
 }
+
+func TestEndBlockerProposalMessages(t *testing.T) {
+	testCases := []struct {
+		name       string
+		content    types.Content
+		planHeight int64
+		expStatus  types.ProposalStatus
+	}{
+		{"messages executed", TestProposal, 100, types.StatusPassed},
+		{"messages without content executed", nil, 100, types.StatusPassed},
+		{"message fails", TestProposal, 5, types.StatusFailed},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			app := simapp.Setup(false)
+			ctx := app.BaseApp.NewContext(false, ostproto.Header{})
+			addrs := simapp.AddTestAddrs(app, ctx, 1, valTokens)
+
+			stakingHandler := staking.NewHandler(app.StakingKeeper)
+			header := ostproto.Header{Height: app.LastBlockHeight() + 1}
+			app.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+			valAddr := sdk.ValAddress(addrs[0])
+
+			createValidators(t, stakingHandler, ctx, []sdk.ValAddress{valAddr}, []int64{10})
+			staking.EndBlocker(ctx, app.StakingKeeper)
+
+			govAddr := app.GovKeeper.GetGovernanceAccount(ctx).GetAddress()
+			plan := upgradetypes.Plan{Name: "v2", Height: tc.planHeight}
+			proposal, err := app.GovKeeper.SubmitProposal(ctx, tc.content, upgradetypes.NewMsgSoftwareUpgrade(govAddr, plan))
+			require.NoError(t, err)
+
+			proposalCoins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(10)))
+			newDepositMsg := types.NewMsgDeposit(addrs[0], proposal.ProposalId, proposalCoins)
+
+			handleAndCheck(t, gov.NewHandler(app.GovKeeper), ctx, newDepositMsg)
+
+			err = app.GovKeeper.AddVote(ctx, proposal.ProposalId, addrs[0], types.NewNonSplitVoteOption(types.OptionYes))
+			require.NoError(t, err)
+
+			newHeader := ctx.BlockHeader()
+			newHeader.Height = 10
+			newHeader.Time = ctx.BlockHeader().Time.Add(app.GovKeeper.GetDepositParams(ctx).MaxDepositPeriod).Add(app.GovKeeper.GetVotingParams(ctx).VotingPeriod)
+			ctx = ctx.WithBlockHeader(newHeader)
+
+			gov.EndBlocker(ctx, app.GovKeeper)
+
+			proposal, ok := app.GovKeeper.GetProposal(ctx, proposal.ProposalId)
+			require.True(t, ok)
+			require.Equal(t, tc.expStatus, proposal.Status)
+
+			scheduled, found := app.UpgradeKeeper.GetUpgradePlan(ctx)
+			if tc.expStatus == types.StatusPassed {
+				require.True(t, found)
+				require.Equal(t, plan, scheduled)
+			} else {
+				require.False(t, found)
+			}
+		})
+	}
+}
@@ -14,7 +14,13 @@ func TestParseSubmitProposalFlags(t *testing.T) {
   "title": "Test Proposal",
   "description": "My awesome proposal",
   "type": "Text",
-  "deposit": "1000test"
+  "deposit": "1000test",
+  "messages": [
+    {
+      "@type": "/lfb.upgrade.v1beta1.MsgCancelUpgrade",
+      "authority": "link10d07y265gmmuvt4z0w9aw880jnsr700j0vn8dm"
+    }
+  ]
 }
 `)
 
@@ -39,6 +45,7 @@ func TestParseSubmitProposalFlags(t *testing.T) {
 	require.Equal(t, "My awesome proposal", proposal1.Description)
 	require.Equal(t, "Text", proposal1.Type)
 	require.Equal(t, "1000test", proposal1.Deposit)
+	require.Len(t, proposal1.Messages, 1)
 
 	// flags that can't be used with --proposal
 	for _, incompatibleFlag := range ProposalFlags {
@@ -1,6 +1,7 @@
 package cli
 
 import (
+	"encoding/json"
 	"fmt"
 	"strconv"
 	"strings"
@@ -33,6 +34,7 @@ type proposal struct {
 	Description string
 	Type        string
 	Deposit     string
+	Messages    []json.RawMessage
 }
 
 // ProposalFlags defines the core required fields of a proposal. It is used to
@@ -68,6 +70,7 @@ func NewTxCmd(propCmds []*cobra.Command) *cobra.Command {
 	govTxCmd.AddCommand(
 		NewCmdDeposit(),
 		NewCmdVote(),
+		NewCmdWeightedVote(),
 		cmdSubmitProp,
 	)
 
@@ -98,6 +101,26 @@ Where proposal.json contains:
 Which is equivalent to:
 
 $ %s tx gov submit-proposal --title="Test Proposal" --description="My awesome proposal" --type="Text" --deposit="10test" --from mykey
+
+A proposal JSON file can also list messages, signed by the governance module
+account, that are executed once the proposal passes:
+
+{
+  "title": "Upgrade Proposal",
+  "description": "Schedule the v2 upgrade",
+  "type": "Text",
+  "deposit": "10test",
+  "messages": [
+    {
+      "@type": "/lfb.upgrade.v1beta1.MsgSoftwareUpgrade",
+      "authority": "link10d07y265gmmuvt4z0w9aw880jnsr700j0vn8dm",
+      "plan": {"name": "v2", "height": "1000"}
+    }
+  ]
+}
+
+The title, description and type may be omitted for proposals which only
+execute messages.
 `,
 				version.AppName, version.AppName,
 			),
@@ -118,9 +141,20 @@ $ %s tx gov submit-proposal --title="Test Proposal" --description="My awesome pr
 				return err
 			}
 
-			content := types.ContentFromProposalType(proposal.Title, proposal.Description, proposal.Type)
+			msgs := make([]sdk.Msg, len(proposal.Messages))
+			for i, rawMsg := range proposal.Messages {
+				if err := clientCtx.JSONMarshaler.UnmarshalInterfaceJSON(rawMsg, &msgs[i]); err != nil {
+					return fmt.Errorf("failed to parse proposal message %d: %w", i, err)
+				}
+			}
 
-			msg, err := types.NewMsgSubmitProposal(content, amount, clientCtx.GetFromAddress())
+			// proposals which only execute messages may omit the content
+			var content types.Content
+			if proposal.Type != "" || len(msgs) == 0 {
+				content = types.ContentFromProposalType(proposal.Title, proposal.Description, proposal.Type)
+			}
+
+			msg, err := types.NewMsgSubmitProposal(content, amount, clientCtx.GetFromAddress(), msgs...)
 			if err != nil {
 				return fmt.Errorf("invalid message: %w", err)
 			}
@@ -247,3 +281,56 @@ $ %s tx gov vote 1 yes --from mykey
 
 	return cmd
 }
+
+// NewCmdWeightedVote implements creating a new weighted vote command.
+func NewCmdWeightedVote() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "weighted-vote [proposal-id] [weighted-options]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Vote for an active proposal, options: yes/no/no_with_veto/abstain",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a vote for an active proposal, split across several options
+with weights summing to 1. You can find the proposal-id by running "%s query gov proposals".
+
+
+Example:
+$ %s tx gov weighted-vote 1 yes=0.6,no=0.3,abstain=0.05,no_with_veto=0.05 --from mykey
+`,
+				version.AppName, version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			// Get voter address
+			from := clientCtx.GetFromAddress()
+
+			// validate that the proposal id is a uint
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal-id %s not a valid int, please input a valid proposal-id", args[0])
+			}
+
+			// Figure out which vote options user chose
+			options, err := types.WeightedVoteOptionsFromString(govutils.NormalizeWeightedVoteOptions(args[1]))
+			if err != nil {
+				return err
+			}
+
+			// Build vote message and run basic validation
+			msg := types.NewMsgVoteWeighted(from, proposalID, options)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
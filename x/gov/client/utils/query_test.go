@@ -73,10 +73,18 @@ func TestGetPaginatedVotes(t *testing.T) {
 		types.NewMsgVote(acc1, 0, types.OptionYes),
 		types.NewMsgVote(acc1, 0, types.OptionYes),
 	}
+	acc2Options := types.WeightedVoteOptions{
+		types.NewWeightedVoteOption(types.OptionYes, sdk.NewDecWithPrec(6, 1)),
+		types.NewWeightedVoteOption(types.OptionNo, sdk.NewDecWithPrec(4, 1)),
+	}
 	acc2Msgs := []sdk.Msg{
-		types.NewMsgVote(acc2, 0, types.OptionYes),
-		types.NewMsgVote(acc2, 0, types.OptionYes),
+		types.NewMsgVoteWeighted(acc2, 0, acc2Options),
+		types.NewMsgVoteWeighted(acc2, 0, acc2Options),
 	}
+	// the deprecated option is populated for votes with a single option
+	acc1Vote := types.NewVote(0, acc1, types.NewNonSplitVoteOption(types.OptionYes))
+	acc1Vote.Option = types.OptionYes
+	acc2Vote := types.NewVote(0, acc2, acc2Options)
 	for _, tc := range []testCase{
 		{
 			description: "1MsgPerTxAll",
@@ -87,8 +95,8 @@ func TestGetPaginatedVotes(t *testing.T) {
 				acc2Msgs[:1],
 			},
 			votes: []types.Vote{
-				types.NewVote(0, acc1, types.OptionYes),
-				types.NewVote(0, acc2, types.OptionYes)},
+				acc1Vote,
+				acc2Vote},
 		},
 		{
 			description: "2MsgPerTx1Chunk",
@@ -99,8 +107,8 @@ func TestGetPaginatedVotes(t *testing.T) {
 				acc2Msgs,
 			},
 			votes: []types.Vote{
-				types.NewVote(0, acc1, types.OptionYes),
-				types.NewVote(0, acc1, types.OptionYes)},
+				acc1Vote,
+				acc1Vote},
 		},
 		{
 			description: "2MsgPerTx2Chunk",
@@ -111,8 +119,8 @@ func TestGetPaginatedVotes(t *testing.T) {
 				acc2Msgs,
 			},
 			votes: []types.Vote{
-				types.NewVote(0, acc2, types.OptionYes),
-				types.NewVote(0, acc2, types.OptionYes)},
+				acc2Vote,
+				acc2Vote},
 		},
 		{
 			description: "IncompleteSearchTx",
@@ -121,7 +129,7 @@ func TestGetPaginatedVotes(t *testing.T) {
 			msgs: [][]sdk.Msg{
 				acc1Msgs[:1],
 			},
-			votes: []types.Vote{types.NewVote(0, acc1, types.OptionYes)},
+			votes: []types.Vote{acc1Vote},
 		},
 		{
 			description: "InvalidPage",
@@ -33,3 +33,25 @@ func TestNormalizeProposalStatus(t *testing.T) {
 		})
 	}
 }
+
+func TestNormalizeWeightedVoteOptions(t *testing.T) {
+	cases := map[string]struct {
+		options    string
+		normalized string
+	}{
+		"simple":             {"yes", "VOTE_OPTION_YES"},
+		"simple with weight": {"yes=1", "VOTE_OPTION_YES=1"},
+		"split": {
+			"yes=0.1,no=0.2,abstain=0.3,no_with_veto=0.4",
+			"VOTE_OPTION_YES=0.1,VOTE_OPTION_NO=0.2,VOTE_OPTION_ABSTAIN=0.3,VOTE_OPTION_NO_WITH_VETO=0.4",
+		},
+		"spaces":         {"Yes=0.6, NoWithVeto=0.4", "VOTE_OPTION_YES=0.6,VOTE_OPTION_NO_WITH_VETO=0.4"},
+		"unknown option": {"maybe=1", "maybe=1"},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.normalized, utils.NormalizeWeightedVoteOptions(tc.options))
+		})
+	}
+}
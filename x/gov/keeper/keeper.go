@@ -6,6 +6,7 @@ import (
 
 	"github.com/line/ostracon/libs/log"
 
+	"github.com/line/lfb-sdk/baseapp"
 	"github.com/line/lfb-sdk/codec"
 	sdk "github.com/line/lfb-sdk/types"
 	authtypes "github.com/line/lfb-sdk/x/auth/types"
@@ -31,18 +32,23 @@ type Keeper struct {
 
 	// Proposal router
 	router types.Router
+
+	// Msg service router executing the messages of passed proposals
+	msgRouter *baseapp.MsgServiceRouter
 }
 
 // NewKeeper returns a governance keeper. It handles:
 // - submitting governance proposals
 // - depositing funds into proposals, and activating upon sufficient funds being deposited
 // - users voting on proposals, with weight proportional to stake in the system
-// - and tallying the result of the vote.
+// - and tallying the result of the vote
+// - executing the messages of passed proposals as the governance module account.
 //
 // CONTRACT: the parameter Subspace must have the param key table already initialized
 func NewKeeper(
 	cdc codec.BinaryMarshaler, key sdk.StoreKey, paramSpace types.ParamSubspace,
 	authKeeper types.AccountKeeper, bankKeeper types.BankKeeper, sk types.StakingKeeper, rtr types.Router,
+	msgRouter *baseapp.MsgServiceRouter,
 ) Keeper {
 
 	// ensure governance module account is set
@@ -63,6 +69,7 @@ func NewKeeper(
 		sk:         sk,
 		cdc:        cdc,
 		router:     rtr,
+		msgRouter:  msgRouter,
 	}
 }
 
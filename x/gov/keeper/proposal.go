@@ -9,19 +9,38 @@ import (
 	"github.com/line/lfb-sdk/x/gov/types"
 )
 
-// SubmitProposal create new proposal given a content
-func (keeper Keeper) SubmitProposal(ctx sdk.Context, content types.Content) (types.Proposal, error) {
-	if !keeper.router.HasRoute(content.ProposalRoute()) {
+// SubmitProposal create new proposal given a content and the messages to
+// execute once it passes. The content may be nil if the proposal has messages.
+func (keeper Keeper) SubmitProposal(ctx sdk.Context, content types.Content, messages ...sdk.Msg) (types.Proposal, error) {
+	if content == nil && len(messages) == 0 {
+		return types.Proposal{}, sdkerrors.Wrap(types.ErrInvalidProposalContent, "missing content")
+	}
+	if content != nil && !keeper.router.HasRoute(content.ProposalRoute()) {
 		return types.Proposal{}, sdkerrors.Wrap(types.ErrNoProposalHandlerExists, content.ProposalRoute())
 	}
 
+	// The messages are executed by the governance module account, so it must
+	// be their only signer, and a Msg service must be able to handle them.
+	govAddr := keeper.authKeeper.GetModuleAddress(types.ModuleName)
+	for i, msg := range messages {
+		signers := msg.GetSigners()
+		if len(signers) != 1 || !signers[0].Equals(govAddr) {
+			return types.Proposal{}, sdkerrors.Wrapf(types.ErrInvalidSigner, "msg %d", i)
+		}
+		if keeper.msgRouter.HandlerByTypeURL(sdk.MsgTypeURL(msg)) == nil {
+			return types.Proposal{}, sdkerrors.Wrap(types.ErrUnroutableProposalMsg, sdk.MsgTypeURL(msg))
+		}
+	}
+
 	// Execute the proposal content in a new context branch (with branched store)
 	// to validate the actual parameter changes before the proposal proceeds
 	// through the governance process. State is not persisted.
-	cacheCtx, _ := ctx.CacheContext()
-	handler := keeper.router.GetRoute(content.ProposalRoute())
-	if err := handler(cacheCtx, content); err != nil {
-		return types.Proposal{}, sdkerrors.Wrap(types.ErrInvalidProposalContent, err.Error())
+	if content != nil {
+		cacheCtx, _ := ctx.CacheContext()
+		handler := keeper.router.GetRoute(content.ProposalRoute())
+		if err := handler(cacheCtx, content); err != nil {
+			return types.Proposal{}, sdkerrors.Wrap(types.ErrInvalidProposalContent, err.Error())
+		}
 	}
 
 	proposalID, err := keeper.GetProposalID(ctx)
@@ -32,7 +51,7 @@ func (keeper Keeper) SubmitProposal(ctx sdk.Context, content types.Content) (typ
 	submitTime := ctx.BlockHeader().Time
 	depositPeriod := keeper.GetDepositParams(ctx).MaxDepositPeriod
 
-	proposal, err := types.NewProposal(content, proposalID, submitTime, submitTime.Add(depositPeriod))
+	proposal, err := types.NewProposal(content, proposalID, submitTime, submitTime.Add(depositPeriod), messages...)
 	if err != nil {
 		return types.Proposal{}, err
 	}
@@ -51,6 +70,61 @@ func (keeper Keeper) SubmitProposal(ctx sdk.Context, content types.Content) (typ
 	return proposal, nil
 }
 
+// ExecuteProposalMessages executes the messages of a passed proposal with the
+// governance module account as their signer. The messages are executed in a
+// branch of the given context which is written only if all of them succeed, in
+// which case their events are emitted to the event manager of the given
+// context. A panicking message handler fails the execution instead of halting
+// the chain.
+func (keeper Keeper) ExecuteProposalMessages(ctx sdk.Context, proposal types.Proposal) (err error) {
+	msgs, err := proposal.GetMessages()
+	if err != nil {
+		return err
+	}
+
+	cacheCtx, writeCache := ctx.CacheContext()
+	defer func() {
+		if r := recover(); r != nil {
+			err = sdkerrors.Wrapf(types.ErrProposalMsgPanic, "%v", r)
+		}
+	}()
+
+	if err := keeper.executeProposalMessages(cacheCtx, msgs); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	writeCache()
+	return nil
+}
+
+func (keeper Keeper) executeProposalMessages(ctx sdk.Context, msgs []sdk.Msg) error {
+
+	for i, msg := range msgs {
+		handler := keeper.msgRouter.HandlerByTypeURL(sdk.MsgTypeURL(msg))
+		if handler == nil {
+			return sdkerrors.Wrap(types.ErrUnroutableProposalMsg, sdk.MsgTypeURL(msg))
+		}
+		req, ok := msg.(sdk.MsgRequest)
+		if !ok {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "%T is not a Msg service request", msg)
+		}
+
+		res, err := handler(ctx, req)
+		if err != nil {
+			return sdkerrors.Wrapf(err, "failed to execute message %d", i)
+		}
+
+		events := make(sdk.Events, len(res.Events))
+		for j, event := range res.Events {
+			events[j] = sdk.Event(event)
+		}
+		ctx.EventManager().EmitEvents(events)
+	}
+
+	return nil
+}
+
 // GetProposal get proposal from store by ProposalID
 func (keeper Keeper) GetProposal(ctx sdk.Context, proposalID uint64) (types.Proposal, bool) {
 	store := ctx.KVStore(keeper.storeKey)
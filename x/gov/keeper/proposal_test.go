@@ -11,8 +11,10 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/line/lfb-sdk/simapp"
+	"github.com/line/lfb-sdk/testutil/testdata"
 	sdk "github.com/line/lfb-sdk/types"
 	"github.com/line/lfb-sdk/x/gov/types"
+	upgradetypes "github.com/line/lfb-sdk/x/upgrade/types"
 )
 
 func TestGetSetProposal(t *testing.T) {
@@ -83,6 +85,65 @@ func TestSubmitProposal(t *testing.T) {
 	}
 }
 
+func TestSubmitProposalMessages(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, ostproto.Header{})
+
+	govAddr := app.GovKeeper.GetGovernanceAccount(ctx).GetAddress()
+	otherAddr := sdk.AccAddress("other_______________")
+	plan := upgradetypes.Plan{Name: "v2", Height: 100}
+
+	testCases := []struct {
+		name        string
+		content     types.Content
+		msgs        []sdk.Msg
+		expectedErr error
+	}{
+		{"no messages", TestProposal, nil, nil},
+		{"gov signed message", TestProposal, []sdk.Msg{upgradetypes.NewMsgSoftwareUpgrade(govAddr, plan)}, nil},
+		{"messages without content", nil, []sdk.Msg{upgradetypes.NewMsgSoftwareUpgrade(govAddr, plan)}, nil},
+		{"no content nor messages", nil, nil, types.ErrInvalidProposalContent},
+		{"non gov signer", TestProposal, []sdk.Msg{upgradetypes.NewMsgSoftwareUpgrade(otherAddr, plan)}, types.ErrInvalidSigner},
+		{"multiple signers", TestProposal, []sdk.Msg{testdata.NewTestMsg(govAddr, otherAddr)}, types.ErrInvalidSigner},
+		{"unroutable message", TestProposal, []sdk.Msg{testdata.NewTestMsg(govAddr)}, types.ErrUnroutableProposalMsg},
+	}
+
+	for _, tc := range testCases {
+		proposal, err := app.GovKeeper.SubmitProposal(ctx, tc.content, tc.msgs...)
+		if tc.expectedErr != nil {
+			require.True(t, errors.Is(err, tc.expectedErr), "%s; got: %v, expected: %v", tc.name, err, tc.expectedErr)
+			continue
+		}
+		require.NoError(t, err, tc.name)
+
+		stored, ok := app.GovKeeper.GetProposal(ctx, proposal.ProposalId)
+		require.True(t, ok, tc.name)
+		msgs, err := stored.GetMessages()
+		require.NoError(t, err, tc.name)
+		require.Len(t, msgs, len(tc.msgs), tc.name)
+		for i, msg := range msgs {
+			require.Equal(t, tc.msgs[i], msg, tc.name)
+		}
+	}
+}
+
+func TestExecuteProposalMessagesPanic(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, ostproto.Header{})
+
+	govAddr := app.GovKeeper.GetGovernanceAccount(ctx).GetAddress()
+	plan := upgradetypes.Plan{Name: "v2", Height: 100}
+	proposal, err := types.NewProposal(nil, 1, time.Now(), time.Now(), upgradetypes.NewMsgSoftwareUpgrade(govAddr, plan))
+	require.NoError(t, err)
+
+	// running out of gas panics in the message handler
+	err = app.GovKeeper.ExecuteProposalMessages(ctx.WithGasMeter(sdk.NewGasMeter(1)), proposal)
+	require.True(t, errors.Is(err, types.ErrProposalMsgPanic), err)
+
+	_, found := app.UpgradeKeeper.GetUpgradePlan(ctx)
+	require.False(t, found)
+}
+
 func TestGetProposalsFiltered(t *testing.T) {
 	proposalID := uint64(1)
 	app := simapp.Setup(false)
@@ -101,7 +162,7 @@ func TestGetProposalsFiltered(t *testing.T) {
 
 			if i%2 == 0 {
 				d := types.NewDeposit(proposalID, addr1, nil)
-				v := types.NewVote(proposalID, addr1, types.OptionYes)
+				v := types.NewVote(proposalID, addr1, types.NewNonSplitVoteOption(types.OptionYes))
 				app.GovKeeper.SetDeposit(ctx, d)
 				app.GovKeeper.SetVote(ctx, v)
 			}
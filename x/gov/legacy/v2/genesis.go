@@ -0,0 +1,19 @@
+package v2
+
+import (
+	"github.com/line/lfb-sdk/x/gov/types"
+)
+
+// MigrateJSON migrates an exported gov genesis state of consensus version 1 to
+// version 2. The single option of every vote becomes a weighted vote option
+// with weight 1.
+func MigrateJSON(oldState *types.GenesisState) *types.GenesisState {
+	newState := *oldState
+
+	newState.Votes = make(types.Votes, len(oldState.Votes))
+	for i, vote := range oldState.Votes {
+		newState.Votes[i] = migrateVote(vote)
+	}
+
+	return &newState
+}
@@ -0,0 +1,34 @@
+package v2_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/line/lfb-sdk/types"
+	v2 "github.com/line/lfb-sdk/x/gov/legacy/v2"
+	"github.com/line/lfb-sdk/x/gov/types"
+)
+
+func TestMigrateJSON(t *testing.T) {
+	voter := sdk.AccAddress("voter_______________")
+
+	oldState := types.DefaultGenesisState()
+	oldState.Votes = types.Votes{
+		{ProposalId: 1, Voter: voter.String(), Option: types.OptionYes},
+		{ProposalId: 2, Voter: voter.String(), Option: types.OptionNo},
+	}
+	require.Error(t, types.ValidateGenesis(oldState), "votes without weighted options are invalid")
+
+	newState := v2.MigrateJSON(oldState)
+	require.NoError(t, types.ValidateGenesis(newState))
+	require.Equal(t, types.Votes{
+		types.NewVote(1, voter, types.NewNonSplitVoteOption(types.OptionYes)),
+		types.NewVote(2, voter, types.NewNonSplitVoteOption(types.OptionNo)),
+	}, newState.Votes)
+
+	// the old state is left untouched
+	require.Equal(t, types.OptionYes, oldState.Votes[0].Option)
+	require.Empty(t, oldState.Votes[0].Options)
+	require.Equal(t, oldState.DepositParams, newState.DepositParams)
+}
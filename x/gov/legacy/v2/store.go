@@ -0,0 +1,55 @@
+package v2
+
+import (
+	"github.com/line/lfb-sdk/codec"
+	"github.com/line/lfb-sdk/store/prefix"
+	sdk "github.com/line/lfb-sdk/types"
+	"github.com/line/lfb-sdk/x/gov/types"
+)
+
+// MigrateStore performs in-place store migrations from consensus version 1 to 2.
+// The migration includes:
+//
+// - Converting the single option of every stored vote into a weighted vote
+// option with weight 1.
+func MigrateStore(ctx sdk.Context, storeKey sdk.StoreKey, cdc codec.BinaryMarshaler) error {
+	store := prefix.NewStore(ctx.KVStore(storeKey), types.VotesKeyPrefix)
+
+	// the votes are collected first, as the store must not be written while iterating it
+	var (
+		keys  [][]byte
+		votes []types.Vote
+	)
+	iterator := store.Iterator(nil, nil)
+	for ; iterator.Valid(); iterator.Next() {
+		var vote types.Vote
+		if err := cdc.UnmarshalBinaryBare(iterator.Value(), &vote); err != nil {
+			iterator.Close()
+			return err
+		}
+		keys = append(keys, iterator.Key())
+		votes = append(votes, migrateVote(vote))
+	}
+	iterator.Close()
+
+	for i, vote := range votes {
+		bz, err := cdc.MarshalBinaryBare(&vote)
+		if err != nil {
+			return err
+		}
+		store.Set(keys[i], bz)
+	}
+
+	return nil
+}
+
+// migrateVote moves the deprecated single option of a vote into its weighted
+// vote options.
+func migrateVote(vote types.Vote) types.Vote {
+	if len(vote.Options) == 0 && vote.Option != types.OptionEmpty { //nolint:staticcheck
+		vote.Options = types.NewNonSplitVoteOption(vote.Option) //nolint:staticcheck
+	}
+	vote.Option = types.OptionEmpty //nolint:staticcheck
+
+	return vote
+}
@@ -0,0 +1,49 @@
+package v2_test
+
+import (
+	"testing"
+
+	ostproto "github.com/line/ostracon/proto/ostracon/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/line/lfb-sdk/simapp"
+	sdk "github.com/line/lfb-sdk/types"
+	v2 "github.com/line/lfb-sdk/x/gov/legacy/v2"
+	"github.com/line/lfb-sdk/x/gov/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, ostproto.Header{})
+	cdc := app.AppCodec()
+	govKey := app.GetKey(types.StoreKey)
+	store := ctx.KVStore(govKey)
+
+	voter := sdk.AccAddress("voter_______________")
+	weighted := types.WeightedVoteOptions{
+		types.NewWeightedVoteOption(types.OptionYes, sdk.NewDecWithPrec(5, 1)),
+		types.NewWeightedVoteOption(types.OptionNo, sdk.NewDecWithPrec(5, 1)),
+	}
+	oldVotes := []types.Vote{
+		{ProposalId: 1, Voter: voter.String(), Option: types.OptionNoWithVeto},
+		{ProposalId: 2, Voter: voter.String(), Option: types.OptionAbstain},
+		{ProposalId: 3, Voter: voter.String(), Options: weighted},
+	}
+	for _, vote := range oldVotes {
+		vote := vote
+		store.Set(types.VoteKey(vote.ProposalId, voter), cdc.MustMarshalBinaryBare(&vote))
+	}
+
+	require.NoError(t, v2.MigrateStore(ctx, govKey, cdc))
+
+	expected := []types.Vote{
+		types.NewVote(1, voter, types.NewNonSplitVoteOption(types.OptionNoWithVeto)),
+		types.NewVote(2, voter, types.NewNonSplitVoteOption(types.OptionAbstain)),
+		types.NewVote(3, voter, weighted),
+	}
+	for _, vote := range expected {
+		var migrated types.Vote
+		cdc.MustUnmarshalBinaryBare(store.Get(types.VoteKey(vote.ProposalId, voter)), &migrated)
+		require.Equal(t, vote, migrated)
+	}
+}
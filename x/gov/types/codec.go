@@ -15,6 +15,7 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgSubmitProposal{}, "lfb-sdk/MsgSubmitProposal", nil)
 	cdc.RegisterConcrete(&MsgDeposit{}, "lfb-sdk/MsgDeposit", nil)
 	cdc.RegisterConcrete(&MsgVote{}, "lfb-sdk/MsgVote", nil)
+	cdc.RegisterConcrete(&MsgVoteWeighted{}, "lfb-sdk/MsgVoteWeighted", nil)
 	cdc.RegisterConcrete(&TextProposal{}, "lfb-sdk/TextProposal", nil)
 }
 
@@ -22,6 +23,7 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgSubmitProposal{},
 		&MsgVote{},
+		&MsgVoteWeighted{},
 		&MsgDeposit{},
 	)
 	registry.RegisterInterface(
@@ -33,9 +35,10 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
 
-// RegisterProposalTypeCodec registers an external proposal content type defined
-// in another module for the internal ModuleCdc. This allows the MsgSubmitProposal
-// to be correctly Amino encoded and decoded.
+// RegisterProposalTypeCodec registers an external proposal content type, or an
+// sdk.Msg executed by proposals, defined in another module for the internal
+// ModuleCdc. This allows the MsgSubmitProposal to be correctly Amino encoded and
+// decoded.
 //
 // NOTE: This should only be used for applications that are still using a concrete
 // Amino codec for serialization.
@@ -61,6 +61,12 @@ func ValidateGenesis(data *GenesisState) error {
 			data.DepositParams.MinDeposit.String())
 	}
 
+	for _, vote := range data.Votes {
+		if err := ValidateWeightedVoteOptions(vote.Options); err != nil {
+			return fmt.Errorf("invalid vote of %s on proposal %d: %w", vote.Voter, vote.ProposalId, err)
+		}
+	}
+
 	return nil
 }
 
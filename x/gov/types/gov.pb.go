@@ -8,12 +8,12 @@ import (
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "github.com/golang/protobuf/ptypes/duration"
+	_ "github.com/golang/protobuf/ptypes/timestamp"
 	types1 "github.com/line/lfb-sdk/codec/types"
 	github_com_line_lfb_sdk_types "github.com/line/lfb-sdk/types"
 	types "github.com/line/lfb-sdk/types"
 	_ "github.com/regen-network/cosmos-proto"
-	_ "google.golang.org/protobuf/types/known/durationpb"
-	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
@@ -121,6 +121,44 @@ func (ProposalStatus) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_3153f88f0b20d768, []int{1}
 }
 
+// WeightedVoteOption defines a unit of vote for vote split.
+type WeightedVoteOption struct {
+	Option VoteOption                        `protobuf:"varint,1,opt,name=option,proto3,enum=lfb.gov.v1beta1.VoteOption" json:"option,omitempty"`
+	Weight github_com_line_lfb_sdk_types.Dec `protobuf:"bytes,2,opt,name=weight,proto3,customtype=github.com/line/lfb-sdk/types.Dec" json:"weight" yaml:"weight"`
+}
+
+func (m *WeightedVoteOption) Reset()      { *m = WeightedVoteOption{} }
+func (*WeightedVoteOption) ProtoMessage() {}
+func (*WeightedVoteOption) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3153f88f0b20d768, []int{0}
+}
+func (m *WeightedVoteOption) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WeightedVoteOption) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WeightedVoteOption.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WeightedVoteOption) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WeightedVoteOption.Merge(m, src)
+}
+func (m *WeightedVoteOption) XXX_Size() int {
+	return m.Size()
+}
+func (m *WeightedVoteOption) XXX_DiscardUnknown() {
+	xxx_messageInfo_WeightedVoteOption.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WeightedVoteOption proto.InternalMessageInfo
+
 // TextProposal defines a standard text proposal whose changes need to be
 // manually updated in case of approval.
 type TextProposal struct {
@@ -131,7 +169,7 @@ type TextProposal struct {
 func (m *TextProposal) Reset()      { *m = TextProposal{} }
 func (*TextProposal) ProtoMessage() {}
 func (*TextProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3153f88f0b20d768, []int{0}
+	return fileDescriptor_3153f88f0b20d768, []int{1}
 }
 func (m *TextProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -171,7 +209,7 @@ type Deposit struct {
 func (m *Deposit) Reset()      { *m = Deposit{} }
 func (*Deposit) ProtoMessage() {}
 func (*Deposit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3153f88f0b20d768, []int{1}
+	return fileDescriptor_3153f88f0b20d768, []int{2}
 }
 func (m *Deposit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -211,12 +249,15 @@ type Proposal struct {
 	TotalDeposit     github_com_line_lfb_sdk_types.Coins `protobuf:"bytes,7,rep,name=total_deposit,json=totalDeposit,proto3,castrepeated=github.com/line/lfb-sdk/types.Coins" json:"total_deposit" yaml:"total_deposit"`
 	VotingStartTime  time.Time                           `protobuf:"bytes,8,opt,name=voting_start_time,json=votingStartTime,proto3,stdtime" json:"voting_start_time" yaml:"voting_start_time"`
 	VotingEndTime    time.Time                           `protobuf:"bytes,9,opt,name=voting_end_time,json=votingEndTime,proto3,stdtime" json:"voting_end_time" yaml:"voting_end_time"`
+	// messages are the sdk.Msgs executed by the governance module account when
+	// the proposal passes.
+	Messages []*types1.Any `protobuf:"bytes,10,rep,name=messages,proto3" json:"messages,omitempty"`
 }
 
 func (m *Proposal) Reset()      { *m = Proposal{} }
 func (*Proposal) ProtoMessage() {}
 func (*Proposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3153f88f0b20d768, []int{2}
+	return fileDescriptor_3153f88f0b20d768, []int{3}
 }
 func (m *Proposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -256,7 +297,7 @@ type TallyResult struct {
 func (m *TallyResult) Reset()      { *m = TallyResult{} }
 func (*TallyResult) ProtoMessage() {}
 func (*TallyResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3153f88f0b20d768, []int{3}
+	return fileDescriptor_3153f88f0b20d768, []int{4}
 }
 func (m *TallyResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -288,15 +329,19 @@ var xxx_messageInfo_TallyResult proto.InternalMessageInfo
 // Vote defines a vote on a governance proposal.
 // A Vote consists of a proposal ID, the voter, and the vote option.
 type Vote struct {
-	ProposalId uint64     `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty" yaml:"proposal_id"`
-	Voter      string     `protobuf:"bytes,2,opt,name=voter,proto3" json:"voter,omitempty"`
-	Option     VoteOption `protobuf:"varint,3,opt,name=option,proto3,enum=lfb.gov.v1beta1.VoteOption" json:"option,omitempty"`
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty" yaml:"proposal_id"`
+	Voter      string `protobuf:"bytes,2,opt,name=voter,proto3" json:"voter,omitempty"`
+	// Deprecated: Prefer to use `options` instead. This field is set in queries
+	// if and only if `len(options) == 1` and that option has weight 1. In all
+	// other cases, this field will default to VOTE_OPTION_UNSPECIFIED.
+	Option  VoteOption           `protobuf:"varint,3,opt,name=option,proto3,enum=lfb.gov.v1beta1.VoteOption" json:"option,omitempty"` // Deprecated: Do not use.
+	Options []WeightedVoteOption `protobuf:"bytes,4,rep,name=options,proto3" json:"options"`
 }
 
 func (m *Vote) Reset()      { *m = Vote{} }
 func (*Vote) ProtoMessage() {}
 func (*Vote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3153f88f0b20d768, []int{4}
+	return fileDescriptor_3153f88f0b20d768, []int{5}
 }
 func (m *Vote) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -337,7 +382,7 @@ type DepositParams struct {
 func (m *DepositParams) Reset()      { *m = DepositParams{} }
 func (*DepositParams) ProtoMessage() {}
 func (*DepositParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3153f88f0b20d768, []int{5}
+	return fileDescriptor_3153f88f0b20d768, []int{6}
 }
 func (m *DepositParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -375,7 +420,7 @@ type VotingParams struct {
 func (m *VotingParams) Reset()      { *m = VotingParams{} }
 func (*VotingParams) ProtoMessage() {}
 func (*VotingParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3153f88f0b20d768, []int{6}
+	return fileDescriptor_3153f88f0b20d768, []int{7}
 }
 func (m *VotingParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -419,7 +464,7 @@ type TallyParams struct {
 func (m *TallyParams) Reset()      { *m = TallyParams{} }
 func (*TallyParams) ProtoMessage() {}
 func (*TallyParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3153f88f0b20d768, []int{7}
+	return fileDescriptor_3153f88f0b20d768, []int{8}
 }
 func (m *TallyParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -451,6 +496,7 @@ var xxx_messageInfo_TallyParams proto.InternalMessageInfo
 func init() {
 	proto.RegisterEnum("lfb.gov.v1beta1.VoteOption", VoteOption_name, VoteOption_value)
 	proto.RegisterEnum("lfb.gov.v1beta1.ProposalStatus", ProposalStatus_name, ProposalStatus_value)
+	proto.RegisterType((*WeightedVoteOption)(nil), "lfb.gov.v1beta1.WeightedVoteOption")
 	proto.RegisterType((*TextProposal)(nil), "lfb.gov.v1beta1.TextProposal")
 	proto.RegisterType((*Deposit)(nil), "lfb.gov.v1beta1.Deposit")
 	proto.RegisterType((*Proposal)(nil), "lfb.gov.v1beta1.Proposal")
@@ -464,94 +510,100 @@ func init() {
 func init() { proto.RegisterFile("lfb/gov/v1beta1/gov.proto", fileDescriptor_3153f88f0b20d768) }
 
 var fileDescriptor_3153f88f0b20d768 = []byte{
-	// 1385 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x57, 0xcf, 0x6f, 0x13, 0x47,
-	0x14, 0xf6, 0x3a, 0xbf, 0xc7, 0x4e, 0xb2, 0x4c, 0x42, 0xe2, 0x2c, 0xd4, 0xbb, 0x2c, 0x3d, 0x50,
-	0x0a, 0x76, 0x09, 0x95, 0x2a, 0x12, 0x55, 0xaa, 0x1d, 0x2f, 0xad, 0x2b, 0x64, 0x5b, 0xeb, 0xc5,
-	0x08, 0x90, 0x58, 0xad, 0xed, 0x89, 0xb3, 0xed, 0xee, 0x8e, 0xeb, 0x1d, 0xa7, 0xb1, 0x7a, 0xe1,
-	0x52, 0x09, 0xb9, 0x52, 0x85, 0xd4, 0x1e, 0xb8, 0x58, 0x42, 0xea, 0xad, 0x67, 0xd4, 0x7f, 0xa1,
-	0xa8, 0xe2, 0x80, 0x7a, 0x42, 0x3d, 0x98, 0x12, 0xa4, 0x0a, 0x71, 0xcc, 0x5f, 0x50, 0xed, 0xce,
-	0x6c, 0xbc, 0xb6, 0x29, 0x09, 0xbd, 0x79, 0xde, 0x7c, 0xdf, 0xfb, 0xde, 0x7c, 0xfb, 0xde, 0x83,
-	0x80, 0x35, 0x6b, 0xbb, 0x9a, 0x6e, 0xe0, 0xdd, 0xf4, 0xee, 0xa5, 0x2a, 0x22, 0xc6, 0x25, 0xef,
-	0x77, 0xaa, 0xd9, 0xc2, 0x04, 0xc3, 0x45, 0x6b, 0xbb, 0x9a, 0xf2, 0x8e, 0xec, 0x4a, 0x38, 0xe5,
-	0x61, 0xab, 0x86, 0x8b, 0x0e, 0xc1, 0x35, 0x6c, 0x3a, 0x14, 0x2d, 0x2c, 0x37, 0x70, 0x03, 0xfb,
-	0x3f, 0xd3, 0xde, 0x2f, 0x16, 0x5d, 0xab, 0x61, 0xd7, 0xc6, 0xae, 0x4e, 0x2f, 0xe8, 0x81, 0x5d,
-	0x89, 0x0d, 0x8c, 0x1b, 0x16, 0x4a, 0xfb, 0xa7, 0x6a, 0x7b, 0x3b, 0x4d, 0x4c, 0x1b, 0xb9, 0xc4,
-	0xb0, 0x9b, 0x01, 0x77, 0x14, 0x60, 0x38, 0x1d, 0x76, 0x95, 0x1c, 0xbd, 0xaa, 0xb7, 0x5b, 0x06,
-	0x31, 0x31, 0x2b, 0x46, 0xbe, 0x01, 0xe2, 0x1a, 0xda, 0x23, 0xa5, 0x16, 0x6e, 0x62, 0xd7, 0xb0,
-	0xe0, 0x32, 0x98, 0x22, 0x26, 0xb1, 0x50, 0x82, 0x93, 0xb8, 0x73, 0x73, 0x2a, 0x3d, 0x40, 0x09,
-	0xc4, 0xea, 0xc8, 0xad, 0xb5, 0xcc, 0xa6, 0x47, 0x4d, 0x44, 0xfd, 0xbb, 0x70, 0x68, 0x63, 0xf1,
-	0xd5, 0x43, 0x91, 0xfb, 0xf3, 0xd1, 0xc5, 0x99, 0x2d, 0xec, 0x10, 0xe4, 0x10, 0xf9, 0x77, 0x0e,
-	0xcc, 0xe4, 0x50, 0x13, 0xbb, 0x26, 0x81, 0x9f, 0x80, 0x58, 0x93, 0x09, 0xe8, 0x66, 0xdd, 0x4f,
-	0x3d, 0x99, 0x5d, 0x39, 0xe8, 0x8b, 0xb0, 0x63, 0xd8, 0xd6, 0x86, 0x1c, 0xba, 0x94, 0x55, 0x10,
-	0x9c, 0xf2, 0x75, 0x78, 0x1a, 0xcc, 0xd5, 0x69, 0x0e, 0xdc, 0x62, 0xaa, 0x83, 0x00, 0xbc, 0x0d,
-	0xa6, 0x0d, 0x1b, 0xb7, 0x1d, 0x92, 0x98, 0x90, 0x26, 0xce, 0xc5, 0xd6, 0x57, 0x52, 0xde, 0x77,
-	0xf0, 0x6c, 0x0f, 0x3e, 0x44, 0x6a, 0x0b, 0x9b, 0x4e, 0xf6, 0xc3, 0xc7, 0x7d, 0x31, 0xf2, 0xeb,
-	0x73, 0xf1, 0x6c, 0xc3, 0x24, 0x3b, 0xed, 0x6a, 0xaa, 0x86, 0xed, 0xb4, 0x65, 0x3a, 0x28, 0x6d,
-	0x6d, 0x57, 0x2f, 0xba, 0xf5, 0xaf, 0xd3, 0xa4, 0xd3, 0x44, 0xae, 0x8f, 0x75, 0x55, 0x96, 0x72,
-	0x63, 0xf6, 0xde, 0x43, 0x31, 0xf2, 0xea, 0xa1, 0x18, 0x91, 0x5f, 0x4d, 0x83, 0xd9, 0x43, 0x7f,
-	0x3e, 0x7e, 0xd3, 0x53, 0x96, 0x5e, 0xf7, 0xc5, 0xa8, 0x59, 0x3f, 0xe8, 0x8b, 0x73, 0xf4, 0x41,
-	0xa3, 0xef, 0xd8, 0x04, 0x33, 0x35, 0xea, 0x8b, 0xff, 0x8a, 0xd8, 0xfa, 0x72, 0x8a, 0x7e, 0x97,
-	0x54, 0xf0, 0x5d, 0x52, 0x19, 0xa7, 0x93, 0x8d, 0xfd, 0x31, 0x30, 0x50, 0x0d, 0x18, 0xb0, 0x0c,
-	0xa6, 0x5d, 0x62, 0x90, 0xb6, 0x9b, 0x98, 0x90, 0xb8, 0x73, 0x0b, 0xeb, 0x62, 0x6a, 0xa4, 0xdd,
-	0x52, 0x41, 0x75, 0x65, 0x1f, 0x96, 0x15, 0x0e, 0xfa, 0xe2, 0xca, 0x88, 0xb3, 0x34, 0x83, 0xac,
-	0xb2, 0x54, 0xd0, 0x06, 0x70, 0xdb, 0x74, 0x0c, 0x4b, 0x27, 0x86, 0x65, 0x75, 0xf4, 0x16, 0x72,
-	0xdb, 0x16, 0x49, 0x4c, 0xfa, 0xc5, 0x9d, 0x1e, 0x13, 0xd0, 0x3c, 0x90, 0xea, 0x63, 0xb2, 0x67,
-	0x3c, 0x37, 0x0f, 0xfa, 0xe2, 0x1a, 0x55, 0x18, 0xcf, 0x22, 0xab, 0xbc, 0x1f, 0x0c, 0x91, 0xe0,
-	0x6d, 0x10, 0x73, 0xdb, 0x55, 0xdb, 0x24, 0xba, 0xd7, 0xbb, 0x89, 0x29, 0x5f, 0x47, 0x18, 0x33,
-	0x41, 0x0b, 0x1a, 0x3b, 0x9b, 0x64, 0x2a, 0xac, 0x43, 0x42, 0x64, 0xf9, 0xfe, 0x73, 0x91, 0x53,
-	0x01, 0x8d, 0x78, 0x04, 0x68, 0x02, 0x9e, 0x35, 0x85, 0x8e, 0x9c, 0x3a, 0x55, 0x98, 0x3e, 0x52,
-	0xe1, 0x2c, 0x53, 0x58, 0xa5, 0x0a, 0xa3, 0x19, 0xa8, 0xcc, 0x02, 0x0b, 0x2b, 0x4e, 0xdd, 0x97,
-	0xba, 0xcb, 0x81, 0x79, 0x82, 0x89, 0x61, 0xe9, 0xec, 0x22, 0x31, 0xf3, 0xd6, 0xd6, 0xdb, 0x62,
-	0x22, 0xcb, 0x54, 0x64, 0x88, 0x2a, 0x1f, 0xb7, 0x25, 0xe3, 0x3e, 0x2d, 0x18, 0x26, 0x0b, 0x9c,
-	0xd8, 0xc5, 0xc4, 0x74, 0x1a, 0xde, 0x37, 0x6d, 0x31, 0x43, 0x67, 0x8f, 0x7c, 0xee, 0xfb, 0xac,
-	0x92, 0x04, 0xad, 0x64, 0x2c, 0x05, 0x7d, 0xef, 0x22, 0x8d, 0x97, 0xbd, 0xb0, 0xff, 0xe0, 0x6d,
-	0xc0, 0x42, 0x03, 0x6b, 0xe7, 0x8e, 0xd4, 0x92, 0x99, 0xd6, 0xca, 0x90, 0xd6, 0xb0, 0xb3, 0xf3,
-	0x34, 0xca, 0x8c, 0xdd, 0x98, 0xf4, 0xf6, 0x87, 0xfc, 0x28, 0x0a, 0x62, 0xe1, 0xb6, 0xd9, 0x04,
-	0x13, 0x1d, 0xe4, 0xd2, 0x5d, 0x94, 0xfd, 0xc0, 0xcb, 0xfa, 0x57, 0x5f, 0x3c, 0xf3, 0x76, 0xcf,
-	0xf2, 0x0e, 0x51, 0x3d, 0x16, 0xdc, 0x02, 0x33, 0x46, 0xd5, 0x25, 0x86, 0xc9, 0x16, 0xd6, 0xbb,
-	0x24, 0x08, 0x98, 0xf0, 0x0a, 0x88, 0x3a, 0xd8, 0x1f, 0xbc, 0x77, 0xe2, 0x47, 0x1d, 0x0c, 0xab,
-	0x20, 0xee, 0x60, 0xfd, 0x5b, 0x93, 0xec, 0xe8, 0xbb, 0x88, 0x60, 0x7f, 0xb8, 0xe6, 0xb2, 0x9f,
-	0x1d, 0x3b, 0xc9, 0x41, 0x5f, 0x5c, 0xa2, 0x06, 0x86, 0xd3, 0xc8, 0x2a, 0x70, 0xf0, 0x0d, 0x93,
-	0xec, 0x54, 0x10, 0xc1, 0xcc, 0xb6, 0x9f, 0x39, 0x30, 0x59, 0xc1, 0x04, 0xfd, 0xff, 0x45, 0xbb,
-	0x0c, 0xa6, 0x76, 0x31, 0x41, 0xc1, 0x92, 0xa5, 0x07, 0x78, 0x19, 0x4c, 0x63, 0xba, 0xf1, 0xe9,
-	0xe6, 0x39, 0x35, 0xb6, 0x18, 0x3c, 0xd5, 0xa2, 0x0f, 0x51, 0x19, 0x74, 0x63, 0xf6, 0x41, 0xb0,
-	0x38, 0x7f, 0x8b, 0x82, 0x79, 0xd6, 0xb5, 0x25, 0xa3, 0x65, 0xd8, 0x2e, 0xfc, 0x89, 0x03, 0x31,
-	0xdb, 0x74, 0x0e, 0x87, 0x87, 0x7b, 0xeb, 0xf0, 0xdc, 0xf2, 0xac, 0x7a, 0xdd, 0x17, 0x4f, 0x86,
-	0x28, 0x17, 0xb0, 0x6d, 0x12, 0x64, 0x37, 0x49, 0x67, 0xf0, 0xaa, 0xd0, 0xf5, 0xb1, 0x67, 0x0a,
-	0xd8, 0xa6, 0x13, 0x4c, 0xd4, 0x8f, 0x1c, 0x80, 0xb6, 0xb1, 0x17, 0xe4, 0xd0, 0x9b, 0xa8, 0x65,
-	0xe2, 0x3a, 0xdb, 0xd4, 0x6b, 0x63, 0x7d, 0x9e, 0x63, 0xff, 0x82, 0x66, 0x15, 0x56, 0xdf, 0xe9,
-	0x71, 0xf2, 0x50, 0x99, 0x6c, 0x53, 0x8e, 0xa3, 0xe4, 0x07, 0xde, 0x24, 0xf0, 0xb6, 0xb1, 0x17,
-	0xd8, 0x44, 0xc3, 0x3f, 0x70, 0x20, 0x5e, 0xf1, 0xc7, 0x83, 0xf9, 0xf6, 0x1d, 0x60, 0xe3, 0x12,
-	0xd4, 0xc6, 0x1d, 0x55, 0xdb, 0x26, 0xab, 0x6d, 0x75, 0x88, 0x37, 0x54, 0xd6, 0xf2, 0xd0, 0x74,
-	0x86, 0x2b, 0x8a, 0xd3, 0x18, 0xab, 0xe6, 0x49, 0x30, 0x94, 0xac, 0x98, 0xeb, 0x60, 0xfa, 0x9b,
-	0x36, 0x6e, 0xb5, 0x6d, 0xbf, 0x8a, 0x78, 0xf6, 0xd3, 0xe3, 0x75, 0x74, 0x0e, 0xd5, 0x5e, 0xf7,
-	0x45, 0x9e, 0x52, 0x07, 0x85, 0xa8, 0x2c, 0x19, 0xbc, 0x03, 0xe6, 0xc8, 0x4e, 0x0b, 0xb9, 0x3b,
-	0xd8, 0xa2, 0xde, 0xc7, 0x8f, 0x3b, 0x2b, 0x34, 0xf3, 0xd2, 0x21, 0x3b, 0x94, 0x7c, 0x90, 0x12,
-	0x7e, 0xcf, 0x81, 0x05, 0x6f, 0x80, 0xf4, 0x81, 0xca, 0x84, 0xaf, 0x72, 0xe7, 0x5d, 0x54, 0x12,
-	0xc3, 0x29, 0x86, 0x0c, 0x3d, 0xc9, 0x0c, 0x1d, 0x42, 0xc8, 0xea, 0xbc, 0x17, 0xd0, 0x82, 0xf3,
-	0xf9, 0x7f, 0x38, 0x00, 0x06, 0x63, 0x03, 0x2f, 0x80, 0xd5, 0x4a, 0x51, 0x53, 0xf4, 0x62, 0x49,
-	0xcb, 0x17, 0x0b, 0xfa, 0xf5, 0x42, 0xb9, 0xa4, 0x6c, 0xe5, 0xaf, 0xe6, 0x95, 0x1c, 0x1f, 0x11,
-	0x16, 0xbb, 0x3d, 0x29, 0x46, 0x81, 0x8a, 0x27, 0x02, 0x65, 0xb0, 0x18, 0x46, 0xdf, 0x54, 0xca,
-	0x3c, 0x27, 0xcc, 0x77, 0x7b, 0xd2, 0x1c, 0x45, 0xdd, 0x44, 0x2e, 0x3c, 0x0f, 0x96, 0xc2, 0x98,
-	0x4c, 0xb6, 0xac, 0x65, 0xf2, 0x05, 0x3e, 0x2a, 0x9c, 0xe8, 0xf6, 0xa4, 0x79, 0x8a, 0xcb, 0xb0,
-	0xf5, 0x26, 0x81, 0x85, 0x30, 0xb6, 0x50, 0xe4, 0x27, 0x84, 0x78, 0xb7, 0x27, 0xcd, 0x52, 0x58,
-	0x01, 0xc3, 0x75, 0x90, 0x18, 0x46, 0xe8, 0x37, 0xf2, 0xda, 0x17, 0x7a, 0x45, 0xd1, 0x8a, 0xfc,
-	0xa4, 0xb0, 0xdc, 0xed, 0x49, 0x7c, 0x80, 0x0d, 0xb6, 0x92, 0x30, 0x79, 0xef, 0x97, 0x64, 0xe4,
-	0xfc, 0x93, 0x28, 0x58, 0x18, 0xfe, 0x9f, 0x09, 0x4c, 0x81, 0x53, 0x25, 0xb5, 0x58, 0x2a, 0x96,
-	0x33, 0xd7, 0xf4, 0xb2, 0x96, 0xd1, 0xae, 0x97, 0x47, 0x1e, 0xec, 0x3f, 0x85, 0x82, 0x0b, 0xa6,
-	0x05, 0x37, 0x41, 0x72, 0x14, 0x9f, 0x53, 0x4a, 0xc5, 0x72, 0x5e, 0xd3, 0x4b, 0x8a, 0x9a, 0x2f,
-	0xe6, 0x78, 0x4e, 0x58, 0xed, 0xf6, 0xa4, 0x25, 0x4a, 0x19, 0x9a, 0x22, 0x78, 0x05, 0xbc, 0x37,
-	0x4a, 0xae, 0x14, 0xb5, 0x7c, 0xe1, 0xf3, 0x80, 0x1b, 0x15, 0x56, 0xba, 0x3d, 0x09, 0x52, 0x6e,
-	0x25, 0xd4, 0xf2, 0xf0, 0x02, 0x58, 0x19, 0xa5, 0x96, 0x32, 0xe5, 0xb2, 0x92, 0xe3, 0x27, 0x04,
-	0xbe, 0xdb, 0x93, 0xe2, 0x94, 0x53, 0x32, 0x5c, 0x17, 0xd5, 0xe1, 0x47, 0x20, 0x31, 0x8a, 0x56,
-	0x95, 0x2f, 0x95, 0x2d, 0x4d, 0xc9, 0xf1, 0x93, 0x02, 0xec, 0xf6, 0xa4, 0x05, 0x8a, 0x57, 0xd1,
-	0x57, 0xa8, 0x46, 0xd0, 0x1b, 0xf3, 0x5f, 0xcd, 0xe4, 0xaf, 0x29, 0x39, 0x7e, 0x2a, 0x9c, 0xff,
-	0xaa, 0x61, 0x5a, 0xa8, 0x4e, 0xed, 0xcc, 0xe6, 0x1f, 0xbf, 0x48, 0x46, 0x9e, 0xbd, 0x48, 0x46,
-	0xee, 0xee, 0x27, 0x23, 0x8f, 0xf7, 0x93, 0xdc, 0xd3, 0xfd, 0x24, 0xf7, 0xf7, 0x7e, 0x92, 0xbb,
-	0xff, 0x32, 0x19, 0x79, 0xfa, 0x32, 0x19, 0x79, 0xf6, 0x32, 0x19, 0xb9, 0xf5, 0x9f, 0xcb, 0x6f,
-	0xcf, 0xff, 0xdb, 0xc5, 0x6f, 0xe5, 0xea, 0xb4, 0xbf, 0x2f, 0x2e, 0xff, 0x1b, 0x00, 0x00, 0xff,
-	0xff, 0xd4, 0x3a, 0x51, 0x9b, 0xd3, 0x0c, 0x00, 0x00,
+	// 1485 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x57, 0xdf, 0x6f, 0x13, 0xc7,
+	0x16, 0xf6, 0xda, 0xce, 0x0f, 0x8f, 0x9d, 0xc4, 0x4c, 0x42, 0xb2, 0x31, 0x5c, 0xef, 0xb2, 0xdc,
+	0x07, 0x2e, 0x17, 0xec, 0x4b, 0xb8, 0x12, 0x22, 0x11, 0x12, 0xde, 0x78, 0xb9, 0xd7, 0x57, 0xdc,
+	0xd8, 0x5a, 0x9b, 0x44, 0x80, 0xc4, 0x6a, 0x1d, 0x4f, 0x9c, 0x2d, 0xbb, 0x3b, 0xae, 0x77, 0x1c,
+	0x12, 0xf5, 0x85, 0x97, 0x4a, 0xc8, 0x95, 0x2a, 0xa4, 0xbe, 0xf0, 0x62, 0x09, 0xa9, 0x0f, 0x95,
+	0xfa, 0x8c, 0xfa, 0x2f, 0x14, 0x55, 0x3c, 0xa0, 0x3e, 0xa1, 0x4a, 0x35, 0x25, 0x48, 0x15, 0xe2,
+	0x31, 0x7f, 0x41, 0xb5, 0x3b, 0xb3, 0xf6, 0xda, 0xa6, 0xf9, 0xd1, 0xb7, 0x99, 0x33, 0xdf, 0x77,
+	0xce, 0x99, 0xcf, 0xe7, 0x9c, 0x59, 0x83, 0x45, 0x73, 0xab, 0x9a, 0xad, 0xe3, 0x9d, 0xec, 0xce,
+	0x95, 0x2a, 0x22, 0xfa, 0x15, 0x77, 0x9d, 0x69, 0x34, 0x31, 0xc1, 0x70, 0xc6, 0xdc, 0xaa, 0x66,
+	0xdc, 0x2d, 0x3b, 0x4a, 0x9d, 0x71, 0xb1, 0x55, 0xdd, 0x41, 0x3d, 0xf0, 0x26, 0x36, 0x6c, 0x8a,
+	0x4e, 0xcd, 0xd5, 0x71, 0x1d, 0x7b, 0xcb, 0xac, 0xbb, 0x62, 0xd6, 0xc5, 0x4d, 0xec, 0x58, 0xd8,
+	0xd1, 0xe8, 0x01, 0xdd, 0xb0, 0x23, 0xa1, 0x8e, 0x71, 0xdd, 0x44, 0x59, 0x6f, 0x57, 0x6d, 0x6d,
+	0x65, 0x89, 0x61, 0x21, 0x87, 0xe8, 0x56, 0xc3, 0xe7, 0x0e, 0x03, 0x74, 0x7b, 0x8f, 0x1d, 0xa5,
+	0x87, 0x8f, 0x6a, 0xad, 0xa6, 0x4e, 0x0c, 0xcc, 0x92, 0x91, 0x3a, 0x1c, 0x80, 0x1b, 0xc8, 0xa8,
+	0x6f, 0x13, 0x54, 0x5b, 0xc7, 0x04, 0x15, 0x1b, 0xee, 0x21, 0xbc, 0x0a, 0xc6, 0xb1, 0xb7, 0xe2,
+	0x39, 0x91, 0xbb, 0x30, 0xbd, 0x74, 0x26, 0x33, 0x74, 0xc5, 0x4c, 0x1f, 0xac, 0x32, 0x28, 0x54,
+	0xc1, 0xf8, 0x23, 0xcf, 0x15, 0x1f, 0x16, 0xb9, 0x0b, 0x31, 0x79, 0xf9, 0x65, 0x57, 0x08, 0xfd,
+	0xd2, 0x15, 0xce, 0xd5, 0x0d, 0xb2, 0xdd, 0xaa, 0x66, 0x36, 0xb1, 0x95, 0x35, 0x0d, 0x1b, 0x65,
+	0xcd, 0xad, 0xea, 0x65, 0xa7, 0xf6, 0x30, 0x4b, 0xf6, 0x1a, 0xc8, 0xc9, 0xe4, 0xd1, 0xe6, 0x41,
+	0x57, 0x98, 0xda, 0xd3, 0x2d, 0x73, 0x59, 0xa2, 0x0e, 0x24, 0x95, 0x79, 0x92, 0x36, 0x40, 0xa2,
+	0x82, 0x76, 0x49, 0xa9, 0x89, 0x1b, 0xd8, 0xd1, 0x4d, 0x38, 0x07, 0xc6, 0x88, 0x41, 0x4c, 0xe4,
+	0xe5, 0x15, 0x53, 0xe9, 0x06, 0x8a, 0x20, 0x5e, 0x43, 0xce, 0x66, 0xd3, 0xa0, 0x39, 0x7b, 0xe1,
+	0xd5, 0xa0, 0x69, 0x79, 0xe6, 0xc3, 0x73, 0x81, 0xfb, 0xf9, 0xc5, 0xe5, 0x89, 0x55, 0x6c, 0x13,
+	0x64, 0x13, 0xe9, 0x47, 0x0e, 0x4c, 0xe4, 0x51, 0x03, 0x3b, 0x06, 0x81, 0xd7, 0x40, 0xbc, 0xc1,
+	0x02, 0x68, 0x46, 0xcd, 0x73, 0x1d, 0x95, 0xe7, 0x0f, 0xba, 0x02, 0xa4, 0x49, 0x05, 0x0e, 0x25,
+	0x15, 0xf8, 0xbb, 0x42, 0x0d, 0x9e, 0x05, 0xb1, 0x1a, 0xf5, 0x81, 0x9b, 0x2c, 0x6a, 0xdf, 0x00,
+	0xef, 0x83, 0x71, 0xdd, 0xc2, 0x2d, 0x9b, 0xf0, 0x11, 0x31, 0x72, 0x21, 0xbe, 0x34, 0xef, 0x89,
+	0xe8, 0x96, 0x45, 0x4f, 0xc5, 0x55, 0x6c, 0xd8, 0xf2, 0x3f, 0x5d, 0x9d, 0xbe, 0x7f, 0x2b, 0x9c,
+	0x3f, 0x5c, 0x27, 0x17, 0xeb, 0xa8, 0xcc, 0xe5, 0xf2, 0xe4, 0x93, 0xe7, 0x42, 0xe8, 0xc3, 0x73,
+	0x21, 0x24, 0x7d, 0x37, 0x01, 0x26, 0x7b, 0xfa, 0xfc, 0xfb, 0x53, 0x57, 0x99, 0xfd, 0xd8, 0x15,
+	0xc2, 0x46, 0xed, 0xa0, 0x2b, 0xc4, 0xe8, 0x85, 0x86, 0xef, 0xb1, 0x02, 0x26, 0x36, 0xa9, 0x2e,
+	0xde, 0x2d, 0xe2, 0x4b, 0x73, 0x19, 0x5a, 0x37, 0x19, 0xbf, 0x6e, 0x32, 0x39, 0x7b, 0x4f, 0x8e,
+	0xff, 0xd4, 0x17, 0x50, 0xf5, 0x19, 0xb0, 0x0c, 0xc6, 0x1d, 0xa2, 0x93, 0x96, 0xc3, 0x47, 0xbc,
+	0x5a, 0x11, 0x46, 0x6a, 0xc5, 0xcf, 0xae, 0xec, 0xc1, 0xe4, 0xd4, 0x41, 0x57, 0x98, 0x1f, 0x52,
+	0x96, 0x7a, 0x90, 0x54, 0xe6, 0x0a, 0x5a, 0x00, 0x6e, 0x19, 0xb6, 0x6e, 0x6a, 0x44, 0x37, 0xcd,
+	0x3d, 0xad, 0x89, 0x9c, 0x96, 0x49, 0xf8, 0xa8, 0x97, 0xdc, 0xd9, 0x91, 0x00, 0x15, 0x17, 0xa4,
+	0x7a, 0x18, 0xf9, 0x9c, 0xab, 0xe6, 0x41, 0x57, 0x58, 0xa4, 0x11, 0x46, 0xbd, 0x48, 0x6a, 0xd2,
+	0x33, 0x06, 0x48, 0xf0, 0x3e, 0x88, 0x3b, 0xad, 0xaa, 0x65, 0x10, 0xcd, 0xed, 0x2d, 0x7e, 0xcc,
+	0x8b, 0x93, 0x1a, 0x11, 0xa1, 0xe2, 0x37, 0x9e, 0x9c, 0x66, 0x51, 0x58, 0x85, 0x04, 0xc8, 0xd2,
+	0xd3, 0xb7, 0x02, 0xa7, 0x02, 0x6a, 0x71, 0x09, 0xd0, 0x00, 0x49, 0x56, 0x14, 0x1a, 0xb2, 0x6b,
+	0x34, 0xc2, 0xf8, 0x91, 0x11, 0xce, 0xb3, 0x08, 0x0b, 0x34, 0xc2, 0xb0, 0x07, 0x1a, 0x66, 0x9a,
+	0x99, 0x15, 0xbb, 0xe6, 0x85, 0x7a, 0xcc, 0x81, 0x29, 0x82, 0x89, 0x6e, 0x6a, 0xec, 0x80, 0x9f,
+	0x38, 0xb4, 0xf4, 0x56, 0x59, 0x90, 0x39, 0x1a, 0x64, 0x80, 0x2a, 0x1d, 0xb7, 0x24, 0x13, 0x1e,
+	0xcd, 0x6f, 0x26, 0x13, 0x9c, 0xda, 0xc1, 0xc4, 0xb0, 0xeb, 0xee, 0x6f, 0xda, 0x64, 0x82, 0x4e,
+	0x1e, 0x79, 0xdd, 0xbf, 0xb3, 0x4c, 0x78, 0x9a, 0xc9, 0x88, 0x0b, 0x7a, 0xdf, 0x19, 0x6a, 0x2f,
+	0xbb, 0x66, 0xef, 0xc2, 0x5b, 0x80, 0x99, 0xfa, 0xd2, 0xc6, 0x8e, 0x8c, 0x25, 0xb1, 0x58, 0xf3,
+	0x03, 0xb1, 0x06, 0x95, 0x9d, 0xa2, 0x56, 0x5f, 0xd8, 0x1b, 0x60, 0xd2, 0x42, 0x8e, 0xa3, 0xd7,
+	0x91, 0xc3, 0x03, 0x31, 0x72, 0x78, 0x8b, 0x38, 0xb5, 0x87, 0x99, 0xff, 0x3b, 0x75, 0xb5, 0x47,
+	0x59, 0x8e, 0xba, 0xe3, 0x47, 0x7a, 0x11, 0x06, 0xf1, 0x60, 0xd5, 0xad, 0x80, 0xc8, 0x1e, 0x72,
+	0xe8, 0x28, 0x93, 0xff, 0x71, 0xbc, 0x69, 0x59, 0xb0, 0x89, 0xea, 0xb2, 0xe0, 0x2a, 0x98, 0xd0,
+	0xab, 0x0e, 0xd1, 0x0d, 0x36, 0xef, 0x4e, 0xe2, 0xc0, 0x67, 0xc2, 0xeb, 0x20, 0x6c, 0x63, 0x3e,
+	0x72, 0x52, 0x7e, 0xd8, 0xc6, 0xb0, 0x0a, 0x12, 0x36, 0xd6, 0x1e, 0x19, 0x64, 0x5b, 0xdb, 0x41,
+	0x04, 0x7b, 0xbd, 0x19, 0x93, 0x6f, 0x1e, 0xdb, 0xc9, 0x41, 0x57, 0x98, 0xa5, 0xfa, 0x07, 0xdd,
+	0x48, 0x2a, 0xb0, 0xf1, 0x86, 0x41, 0xb6, 0xd7, 0x11, 0xc1, 0x4c, 0xb6, 0x5f, 0x39, 0x10, 0x75,
+	0x9f, 0x9b, 0xbf, 0x3e, 0xa7, 0xe7, 0xc0, 0xd8, 0x0e, 0x26, 0xc8, 0x9f, 0xd1, 0x74, 0x03, 0xaf,
+	0xf5, 0x1e, 0xb9, 0xc8, 0x91, 0x8f, 0x9c, 0x1c, 0xe6, 0xb9, 0xde, 0x43, 0xb7, 0x0a, 0x26, 0xe8,
+	0xca, 0xe1, 0xa3, 0x5e, 0x2d, 0x9c, 0x1f, 0x61, 0x8e, 0xbe, 0xa9, 0x72, 0xd4, 0x95, 0x46, 0xf5,
+	0x99, 0xcb, 0x93, 0xcf, 0xfc, 0x01, 0xfe, 0x43, 0x18, 0x4c, 0xb1, 0xee, 0x29, 0xe9, 0x4d, 0xdd,
+	0x72, 0xe0, 0x37, 0x1c, 0x88, 0x5b, 0x86, 0xdd, 0x6b, 0x62, 0xee, 0xd0, 0x26, 0xbe, 0xe7, 0x3a,
+	0xfe, 0xd8, 0x15, 0x4e, 0x07, 0x28, 0x97, 0xb0, 0x65, 0x10, 0x64, 0x35, 0xc8, 0x5e, 0x5f, 0x9e,
+	0xc0, 0xf1, 0xb1, 0x7b, 0x1b, 0x58, 0x86, 0xed, 0x77, 0xf6, 0xd7, 0x1c, 0x80, 0x96, 0xbe, 0xeb,
+	0xfb, 0xd0, 0x1a, 0xa8, 0x69, 0xe0, 0x1a, 0x7b, 0x31, 0x16, 0x47, 0xda, 0x21, 0xcf, 0xbe, 0x34,
+	0x64, 0x85, 0xe5, 0x77, 0x76, 0x94, 0x3c, 0x90, 0x26, 0x9b, 0xd8, 0xa3, 0x28, 0xe9, 0x99, 0xdb,
+	0x91, 0x49, 0x4b, 0xdf, 0xf5, 0x65, 0xa2, 0xe6, 0xaf, 0x38, 0x90, 0x58, 0xf7, 0xda, 0x94, 0xe9,
+	0xf6, 0x05, 0x60, 0x6d, 0xeb, 0xe7, 0xc6, 0x1d, 0x95, 0xdb, 0x0a, 0xcb, 0x6d, 0x61, 0x80, 0x37,
+	0x90, 0xd6, 0xdc, 0xc0, 0x94, 0x08, 0x66, 0x94, 0xa0, 0x36, 0x96, 0xcd, 0x2b, 0xbf, 0xbb, 0x59,
+	0x32, 0x77, 0xc0, 0xf8, 0xe7, 0x2d, 0xdc, 0x6c, 0x59, 0x5e, 0x16, 0x09, 0xf9, 0xc6, 0xb1, 0x3f,
+	0x87, 0x3e, 0x76, 0x85, 0x24, 0xa5, 0xf6, 0x13, 0x51, 0x99, 0x33, 0xf8, 0x00, 0xc4, 0xc8, 0x76,
+	0x13, 0x39, 0xdb, 0xd8, 0xa4, 0xda, 0x27, 0xe4, 0x9b, 0x27, 0xf1, 0x3c, 0xdb, 0x63, 0x07, 0x9c,
+	0xf7, 0x5d, 0xc2, 0x2f, 0x39, 0x30, 0xed, 0x76, 0xa2, 0xd6, 0x8f, 0x12, 0xf1, 0xa2, 0x3c, 0x38,
+	0x49, 0x14, 0x7e, 0xd0, 0xc5, 0x80, 0xa0, 0xa7, 0x99, 0xa0, 0x03, 0x08, 0x49, 0x9d, 0x72, 0x0d,
+	0x15, 0x7f, 0x7f, 0xf1, 0x77, 0x0e, 0x80, 0xc0, 0x17, 0xe9, 0x25, 0xb0, 0xb0, 0x5e, 0xac, 0x28,
+	0x5a, 0xb1, 0x54, 0x29, 0x14, 0xd7, 0xb4, 0x3b, 0x6b, 0xe5, 0x92, 0xb2, 0x5a, 0xb8, 0x55, 0x50,
+	0xf2, 0xc9, 0x50, 0x6a, 0xa6, 0xdd, 0x11, 0xe3, 0x14, 0xa8, 0xb8, 0x41, 0xa0, 0x04, 0x66, 0x82,
+	0xe8, 0xbb, 0x4a, 0x39, 0xc9, 0xa5, 0xa6, 0xda, 0x1d, 0x31, 0x46, 0x51, 0x77, 0x91, 0x03, 0x2f,
+	0x82, 0xd9, 0x20, 0x26, 0x27, 0x97, 0x2b, 0xb9, 0xc2, 0x5a, 0x32, 0x9c, 0x3a, 0xd5, 0xee, 0x88,
+	0x53, 0x14, 0x97, 0x63, 0x73, 0x52, 0x04, 0xd3, 0x41, 0xec, 0x5a, 0x31, 0x19, 0x49, 0x25, 0xda,
+	0x1d, 0x71, 0x92, 0xc2, 0xd6, 0x30, 0x5c, 0x02, 0xfc, 0x20, 0x42, 0xdb, 0x28, 0x54, 0xfe, 0xab,
+	0xad, 0x2b, 0x95, 0x62, 0x32, 0x9a, 0x9a, 0x6b, 0x77, 0xc4, 0xa4, 0x8f, 0xf5, 0xc7, 0x5b, 0x2a,
+	0xfa, 0xe4, 0xdb, 0x74, 0xe8, 0xe2, 0xab, 0x30, 0x98, 0x1e, 0xfc, 0x42, 0x82, 0x19, 0x70, 0xa6,
+	0xa4, 0x16, 0x4b, 0xc5, 0x72, 0xee, 0xb6, 0x56, 0xae, 0xe4, 0x2a, 0x77, 0xca, 0x43, 0x17, 0xf6,
+	0xae, 0x42, 0xc1, 0x6b, 0x86, 0x09, 0x57, 0x40, 0x7a, 0x18, 0x9f, 0x57, 0x4a, 0xc5, 0x72, 0xa1,
+	0xa2, 0x95, 0x14, 0xb5, 0x50, 0xcc, 0x27, 0xb9, 0xd4, 0x42, 0xbb, 0x23, 0xce, 0x52, 0xca, 0x40,
+	0x17, 0xc1, 0xeb, 0xe0, 0x6f, 0xc3, 0xe4, 0xf5, 0x62, 0xa5, 0xb0, 0xf6, 0x1f, 0x9f, 0x1b, 0x4e,
+	0xcd, 0xb7, 0x3b, 0x22, 0xa4, 0xdc, 0xf5, 0x40, 0xc9, 0xc3, 0x4b, 0x60, 0x7e, 0x98, 0x5a, 0xca,
+	0x95, 0xcb, 0x4a, 0x3e, 0x19, 0x49, 0x25, 0xdb, 0x1d, 0x31, 0x41, 0x39, 0x25, 0xdd, 0x71, 0x50,
+	0x0d, 0xfe, 0x0b, 0xf0, 0xc3, 0x68, 0x55, 0xf9, 0x9f, 0xb2, 0x5a, 0x51, 0xf2, 0xc9, 0x68, 0x0a,
+	0xb6, 0x3b, 0xe2, 0x34, 0xc5, 0xab, 0xe8, 0x33, 0xb4, 0x49, 0xd0, 0x27, 0xfd, 0xdf, 0xca, 0x15,
+	0x6e, 0x2b, 0xf9, 0xe4, 0x58, 0xd0, 0xff, 0x2d, 0xdd, 0x30, 0x51, 0x8d, 0xca, 0x29, 0x17, 0x5e,
+	0xbe, 0x4b, 0x87, 0xde, 0xbc, 0x4b, 0x87, 0x1e, 0xef, 0xa7, 0x43, 0x2f, 0xf7, 0xd3, 0xdc, 0xeb,
+	0xfd, 0x34, 0xf7, 0xdb, 0x7e, 0x9a, 0x7b, 0xfa, 0x3e, 0x1d, 0x7a, 0xfd, 0x3e, 0x1d, 0x7a, 0xf3,
+	0x3e, 0x1d, 0xba, 0xf7, 0xa7, 0xc3, 0x6f, 0xd7, 0xfb, 0x8f, 0xe7, 0x95, 0x72, 0x75, 0xdc, 0x9b,
+	0x17, 0x57, 0xff, 0x18, 0x00, 0xef, 0xaf, 0xb2, 0xe9, 0xfb, 0x0d, 0x00, 0x00,
 }
 
 func (this *TextProposal) Equal(that interface{}) bool {
@@ -632,6 +684,14 @@ func (this *Proposal) Equal(that interface{}) bool {
 	if !this.VotingEndTime.Equal(that1.VotingEndTime) {
 		return false
 	}
+	if len(this.Messages) != len(that1.Messages) {
+		return false
+	}
+	for i := range this.Messages {
+		if !this.Messages[i].Equal(that1.Messages[i]) {
+			return false
+		}
+	}
 	return true
 }
 func (this *TallyResult) Equal(that interface{}) bool {
@@ -667,6 +727,44 @@ func (this *TallyResult) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (m *WeightedVoteOption) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WeightedVoteOption) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WeightedVoteOption) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Weight.Size()
+		i -= size
+		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGov(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Option != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.Option))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *TextProposal) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -773,6 +871,20 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Messages) > 0 {
+		for iNdEx := len(m.Messages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Messages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
 	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.VotingEndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.VotingEndTime):])
 	if err1 != nil {
 		return 0, err1
@@ -937,6 +1049,20 @@ func (m *Vote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Options) > 0 {
+		for iNdEx := len(m.Options) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Options[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if m.Option != 0 {
 		i = encodeVarintGov(dAtA, i, uint64(m.Option))
 		i--
@@ -1097,6 +1223,20 @@ func encodeVarintGov(dAtA []byte, offset int, v uint64) int {
 	dAtA[offset] = uint8(v)
 	return base
 }
+func (m *WeightedVoteOption) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Option != 0 {
+		n += 1 + sovGov(uint64(m.Option))
+	}
+	l = m.Weight.Size()
+	n += 1 + l + sovGov(uint64(l))
+	return n
+}
+
 func (m *TextProposal) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1168,6 +1308,12 @@ func (m *Proposal) Size() (n int) {
 	n += 1 + l + sovGov(uint64(l))
 	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.VotingEndTime)
 	n += 1 + l + sovGov(uint64(l))
+	if len(m.Messages) > 0 {
+		for _, e := range m.Messages {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1204,6 +1350,12 @@ func (m *Vote) Size() (n int) {
 	if m.Option != 0 {
 		n += 1 + sovGov(uint64(m.Option))
 	}
+	if len(m.Options) > 0 {
+		for _, e := range m.Options {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1256,6 +1408,109 @@ func sovGov(x uint64) (n int) {
 func sozGov(x uint64) (n int) {
 	return sovGov(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
+func (m *WeightedVoteOption) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WeightedVoteOption: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WeightedVoteOption: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Option", wireType)
+			}
+			m.Option = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Option |= VoteOption(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *TextProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1807,6 +2062,40 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Messages = append(m.Messages, &types1.Any{})
+			if err := m.Messages[len(m.Messages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGov(dAtA[iNdEx:])
@@ -2113,6 +2402,40 @@ func (m *Vote) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Options = append(m.Options, WeightedVoteOption{})
+			if err := m.Options[len(m.Options)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGov(dAtA[iNdEx:])
@@ -16,17 +16,19 @@ import (
 const (
 	TypeMsgDeposit        = "deposit"
 	TypeMsgVote           = "vote"
+	TypeMsgVoteWeighted   = "weighted_vote"
 	TypeMsgSubmitProposal = "submit_proposal"
 )
 
 var (
-	_, _, _ sdk.Msg                       = &MsgSubmitProposal{}, &MsgDeposit{}, &MsgVote{}
-	_       types.UnpackInterfacesMessage = &MsgSubmitProposal{}
+	_, _, _, _ sdk.Msg                       = &MsgSubmitProposal{}, &MsgDeposit{}, &MsgVote{}, &MsgVoteWeighted{}
+	_          types.UnpackInterfacesMessage = &MsgSubmitProposal{}
 )
 
-// NewMsgSubmitProposal creates a new MsgSubmitProposal.
+// NewMsgSubmitProposal creates a new MsgSubmitProposal. The messages are
+// executed by the governance module account once the proposal passes.
 //nolint:interfacer
-func NewMsgSubmitProposal(content Content, initialDeposit sdk.Coins, proposer sdk.AccAddress) (*MsgSubmitProposal, error) {
+func NewMsgSubmitProposal(content Content, initialDeposit sdk.Coins, proposer sdk.AccAddress, messages ...sdk.Msg) (*MsgSubmitProposal, error) {
 	m := &MsgSubmitProposal{
 		InitialDeposit: initialDeposit,
 		Proposer:       proposer.String(),
@@ -35,6 +37,9 @@ func NewMsgSubmitProposal(content Content, initialDeposit sdk.Coins, proposer sd
 	if err != nil {
 		return nil, err
 	}
+	if err := m.SetMessages(messages); err != nil {
+		return nil, err
+	}
 	return m, nil
 }
 
@@ -46,6 +51,9 @@ func (m *MsgSubmitProposal) GetProposer() sdk.AccAddress {
 }
 
 func (m *MsgSubmitProposal) GetContent() Content {
+	if m.Content == nil {
+		return nil
+	}
 	content, ok := m.Content.GetCachedValue().(Content)
 	if !ok {
 		return nil
@@ -53,6 +61,11 @@ func (m *MsgSubmitProposal) GetContent() Content {
 	return content
 }
 
+// GetMessages returns the sdk.Msgs executed when the proposal passes.
+func (m *MsgSubmitProposal) GetMessages() ([]sdk.Msg, error) {
+	return UnpackMessages(m.Messages)
+}
+
 func (m *MsgSubmitProposal) SetInitialDeposit(coins sdk.Coins) {
 	m.InitialDeposit = coins
 }
@@ -61,7 +74,13 @@ func (m *MsgSubmitProposal) SetProposer(address fmt.Stringer) {
 	m.Proposer = address.String()
 }
 
+// SetContent sets the proposal content. A nil content is allowed for proposals
+// which only execute messages.
 func (m *MsgSubmitProposal) SetContent(content Content) error {
+	if content == nil {
+		m.Content = nil
+		return nil
+	}
 	msg, ok := content.(proto.Message)
 	if !ok {
 		return fmt.Errorf("can't proto marshal %T", msg)
@@ -74,6 +93,15 @@ func (m *MsgSubmitProposal) SetContent(content Content) error {
 	return nil
 }
 
+func (m *MsgSubmitProposal) SetMessages(messages []sdk.Msg) error {
+	anys, err := PackMessages(messages)
+	if err != nil {
+		return err
+	}
+	m.Messages = anys
+	return nil
+}
+
 // Route implements Msg
 func (m MsgSubmitProposal) Route() string { return RouterKey }
 
@@ -92,15 +120,29 @@ func (m MsgSubmitProposal) ValidateBasic() error {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, m.InitialDeposit.String())
 	}
 
+	msgs, err := m.GetMessages()
+	if err != nil {
+		return err
+	}
+
+	// the content is optional for proposals which execute messages
 	content := m.GetContent()
 	if content == nil {
-		return sdkerrors.Wrap(ErrInvalidProposalContent, "missing content")
+		if len(msgs) == 0 {
+			return sdkerrors.Wrap(ErrInvalidProposalContent, "missing content")
+		}
+	} else {
+		if !IsValidProposalType(content.ProposalType()) {
+			return sdkerrors.Wrap(ErrInvalidProposalType, content.ProposalType())
+		}
+		if err := content.ValidateBasic(); err != nil {
+			return err
+		}
 	}
-	if !IsValidProposalType(content.ProposalType()) {
-		return sdkerrors.Wrap(ErrInvalidProposalType, content.ProposalType())
-	}
-	if err := content.ValidateBasic(); err != nil {
-		return err
+	for i, msg := range msgs {
+		if err := msg.ValidateBasic(); err != nil {
+			return sdkerrors.Wrapf(ErrInvalidProposalMsg, "msg %d: %s", i, err)
+		}
 	}
 
 	return nil
@@ -126,8 +168,13 @@ func (m MsgSubmitProposal) String() string {
 
 // UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
 func (m MsgSubmitProposal) UnpackInterfaces(unpacker types.AnyUnpacker) error {
-	var content Content
-	return unpacker.UnpackAny(m.Content, &content)
+	if m.Content != nil {
+		var content Content
+		if err := unpacker.UnpackAny(m.Content, &content); err != nil {
+			return err
+		}
+	}
+	return unpackMessagesInterfaces(unpacker, m.Messages)
 }
 
 // NewMsgDeposit creates a new MsgDeposit instance
@@ -216,3 +263,43 @@ func (msg MsgVote) GetSigners() []sdk.AccAddress {
 	voter, _ := sdk.AccAddressFromBech32(msg.Voter)
 	return []sdk.AccAddress{voter}
 }
+
+// NewMsgVoteWeighted creates a message to cast a vote on an active proposal,
+// split across several options
+//nolint:interfacer
+func NewMsgVoteWeighted(voter sdk.AccAddress, proposalID uint64, options WeightedVoteOptions) *MsgVoteWeighted {
+	return &MsgVoteWeighted{proposalID, voter.String(), options}
+}
+
+// Route implements Msg
+func (msg MsgVoteWeighted) Route() string { return RouterKey }
+
+// Type implements Msg
+func (msg MsgVoteWeighted) Type() string { return TypeMsgVoteWeighted }
+
+// ValidateBasic implements Msg
+func (msg MsgVoteWeighted) ValidateBasic() error {
+	if msg.Voter == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Voter)
+	}
+
+	return ValidateWeightedVoteOptions(msg.Options)
+}
+
+// String implements the Stringer interface
+func (msg MsgVoteWeighted) String() string {
+	out, _ := yaml.Marshal(msg)
+	return string(out)
+}
+
+// GetSignBytes implements Msg
+func (msg MsgVoteWeighted) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements Msg
+func (msg MsgVoteWeighted) GetSigners() []sdk.AccAddress {
+	voter, _ := sdk.AccAddressFromBech32(msg.Voter)
+	return []sdk.AccAddress{voter}
+}
@@ -59,6 +59,46 @@ func TestMsgSubmitProposal(t *testing.T) {
 	}
 }
 
+func TestMsgSubmitProposalMessages(t *testing.T) {
+	tests := []struct {
+		msgs       []sdk.Msg
+		expectPass bool
+	}{
+		{nil, true},
+		{[]sdk.Msg{NewMsgVote(addrs[0], 1, OptionYes)}, true},
+		{[]sdk.Msg{NewMsgVote(addrs[0], 1, OptionYes), NewMsgDeposit(addrs[0], 1, coinsPos)}, true},
+		{[]sdk.Msg{NewMsgVote(addrs[0], 1, OptionYes), NewMsgVote(addrs[0], 1, VoteOption(0x13))}, false},
+		{[]sdk.Msg{NewMsgDeposit(sdk.AccAddress{}, 1, coinsPos)}, false},
+	}
+
+	for i, tc := range tests {
+		msg, err := NewMsgSubmitProposal(NewTextProposal("Test Proposal", "description"), coinsPos, addrs[0], tc.msgs...)
+		require.NoError(t, err)
+
+		msgs, err := msg.GetMessages()
+		require.NoError(t, err)
+		require.Len(t, msgs, len(tc.msgs))
+
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
+
+func TestMsgSubmitProposalWithoutContent(t *testing.T) {
+	msg, err := NewMsgSubmitProposal(nil, coinsPos, addrs[0])
+	require.NoError(t, err)
+	require.Nil(t, msg.GetContent())
+	require.Error(t, msg.ValidateBasic())
+
+	msg, err = NewMsgSubmitProposal(nil, coinsPos, addrs[0], NewMsgVote(addrs[0], 1, OptionYes))
+	require.NoError(t, err)
+	require.Nil(t, msg.GetContent())
+	require.NoError(t, msg.ValidateBasic())
+}
+
 func TestMsgDepositGetSignBytes(t *testing.T) {
 	addr := sdk.AccAddress("addr1")
 	msg := NewMsgDeposit(addr, 0, coinsPos)
@@ -118,6 +158,55 @@ func TestMsgVote(t *testing.T) {
 	}
 }
 
+func TestMsgVoteWeighted(t *testing.T) {
+	dec := func(s string) sdk.Dec { return sdk.MustNewDecFromStr(s) }
+
+	tests := []struct {
+		proposalID uint64
+		voterAddr  sdk.AccAddress
+		options    WeightedVoteOptions
+		expectPass bool
+	}{
+		{0, addrs[0], NewNonSplitVoteOption(OptionYes), true},
+		{0, sdk.AccAddress{}, NewNonSplitVoteOption(OptionYes), false},
+		{0, addrs[0], NewNonSplitVoteOption(OptionNoWithVeto), true},
+		{0, addrs[0], NewNonSplitVoteOption(VoteOption(0x13)), false},
+		{0, addrs[0], WeightedVoteOptions{
+			NewWeightedVoteOption(OptionYes, dec("0.1")),
+			NewWeightedVoteOption(OptionAbstain, dec("0.2")),
+			NewWeightedVoteOption(OptionNo, dec("0.3")),
+			NewWeightedVoteOption(OptionNoWithVeto, dec("0.4")),
+		}, true},
+		{0, addrs[0], WeightedVoteOptions{
+			NewWeightedVoteOption(OptionYes, dec("0.5")),
+			NewWeightedVoteOption(OptionYes, dec("0.5")),
+		}, false},
+		{0, addrs[0], WeightedVoteOptions{NewWeightedVoteOption(OptionYes, dec("0.9"))}, false},
+		{0, addrs[0], WeightedVoteOptions{
+			NewWeightedVoteOption(OptionYes, dec("0.6")),
+			NewWeightedVoteOption(OptionNo, dec("0.6")),
+		}, false},
+		{0, addrs[0], WeightedVoteOptions{
+			NewWeightedVoteOption(OptionYes, dec("1.5")),
+			NewWeightedVoteOption(OptionNo, dec("-0.5")),
+		}, false},
+		{0, addrs[0], WeightedVoteOptions{
+			NewWeightedVoteOption(OptionYes, dec("1")),
+			NewWeightedVoteOption(OptionNo, dec("0")),
+		}, false},
+		{0, addrs[0], WeightedVoteOptions{}, false},
+	}
+
+	for i, tc := range tests {
+		msg := NewMsgVoteWeighted(tc.voterAddr, tc.proposalID, tc.options)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
+
 // this tests that Amino JSON MsgSubmitProposal.GetSignBytes() still works with Content as Any using the ModuleCdc
 func TestMsgSubmitProposal_GetSignBytes(t *testing.T) {
 	msg, err := NewMsgSubmitProposal(NewTextProposal("test", "abcd"), sdk.NewCoins(), sdk.AccAddress{})
@@ -16,8 +16,10 @@ import (
 // DefaultStartingProposalID is 1
 const DefaultStartingProposalID uint64 = 1
 
-// NewProposal creates a new Proposal instance
-func NewProposal(content Content, id uint64, submitTime, depositEndTime time.Time) (Proposal, error) {
+// NewProposal creates a new Proposal instance. The messages are executed by the
+// governance module account once the proposal passes. The content may be nil
+// for proposals which only execute messages.
+func NewProposal(content Content, id uint64, submitTime, depositEndTime time.Time, messages ...sdk.Msg) (Proposal, error) {
 	p := Proposal{
 		ProposalId:       id,
 		Status:           StatusDepositPeriod,
@@ -27,18 +29,26 @@ func NewProposal(content Content, id uint64, submitTime, depositEndTime time.Tim
 		DepositEndTime:   depositEndTime,
 	}
 
-	msg, ok := content.(proto.Message)
-	if !ok {
-		return Proposal{}, fmt.Errorf("%T does not implement proto.Message", content)
+	if content != nil {
+		msg, ok := content.(proto.Message)
+		if !ok {
+			return Proposal{}, fmt.Errorf("%T does not implement proto.Message", content)
+		}
+
+		any, err := types.NewAnyWithValue(msg)
+		if err != nil {
+			return Proposal{}, err
+		}
+
+		p.Content = any
 	}
 
-	any, err := types.NewAnyWithValue(msg)
+	var err error
+	p.Messages, err = PackMessages(messages)
 	if err != nil {
 		return Proposal{}, err
 	}
 
-	p.Content = any
-
 	return p, nil
 }
 
@@ -48,8 +58,12 @@ func (p Proposal) String() string {
 	return string(out)
 }
 
-// GetContent returns the proposal Content
+// GetContent returns the proposal Content, or nil if the proposal only
+// executes messages
 func (p Proposal) GetContent() Content {
+	if p.Content == nil {
+		return nil
+	}
 	content, ok := p.Content.GetCachedValue().(Content)
 	if !ok {
 		return nil
@@ -57,6 +71,11 @@ func (p Proposal) GetContent() Content {
 	return content
 }
 
+// GetMessages returns the sdk.Msgs executed when the proposal passes.
+func (p Proposal) GetMessages() ([]sdk.Msg, error) {
+	return UnpackMessages(p.Messages)
+}
+
 func (p Proposal) ProposalType() string {
 	content := p.GetContent()
 	if content == nil {
@@ -83,8 +102,13 @@ func (p Proposal) GetTitle() string {
 
 // UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
 func (p Proposal) UnpackInterfaces(unpacker types.AnyUnpacker) error {
-	var content Content
-	return unpacker.UnpackAny(p.Content, &content)
+	if p.Content != nil {
+		var content Content
+		if err := unpacker.UnpackAny(p.Content, &content); err != nil {
+			return err
+		}
+	}
+	return unpackMessagesInterfaces(unpacker, p.Messages)
 }
 
 // Proposals is an array of proposal
@@ -261,3 +285,51 @@ func ProposalHandler(_ sdk.Context, c Content) error {
 		return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized gov proposal type: %s", c.ProposalType())
 	}
 }
+
+// PackMessages packs the messages of a proposal into Anys. The requests of
+// ServiceMsgs are packed in place of the ServiceMsgs themselves.
+func PackMessages(msgs []sdk.Msg) ([]*types.Any, error) {
+	if len(msgs) == 0 {
+		return nil, nil
+	}
+
+	anys := make([]*types.Any, len(msgs))
+	for i, msg := range msgs {
+		var m proto.Message = msg
+		if svcMsg, ok := msg.(sdk.ServiceMsg); ok {
+			m = svcMsg.Request
+		}
+
+		any, err := types.NewAnyWithValue(m)
+		if err != nil {
+			return nil, err
+		}
+		anys[i] = any
+	}
+
+	return anys, nil
+}
+
+// UnpackMessages returns the cached sdk.Msgs of the given Anys.
+func UnpackMessages(anys []*types.Any) ([]sdk.Msg, error) {
+	msgs := make([]sdk.Msg, len(anys))
+	for i, any := range anys {
+		msg, ok := any.GetCachedValue().(sdk.Msg)
+		if !ok {
+			return nil, sdkerrors.Wrapf(ErrInvalidProposalMsg, "messages contains %T which is not a sdk.Msg", any)
+		}
+		msgs[i] = msg
+	}
+
+	return msgs, nil
+}
+
+func unpackMessagesInterfaces(unpacker types.AnyUnpacker, anys []*types.Any) error {
+	for _, any := range anys {
+		var msg sdk.Msg
+		if err := unpacker.UnpackAny(any, &msg); err != nil {
+			return err
+		}
+	}
+	return nil
+}
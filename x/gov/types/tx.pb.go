@@ -33,11 +33,14 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // MsgSubmitProposal defines an sdk.Msg type that supports submitting arbitrary
-// proposal Content.
+// proposal Content, optionally along with sdk.Msgs to execute once it passes.
 type MsgSubmitProposal struct {
 	Content        *types.Any                          `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
 	InitialDeposit github_com_line_lfb_sdk_types.Coins `protobuf:"bytes,2,rep,name=initial_deposit,json=initialDeposit,proto3,castrepeated=github.com/line/lfb-sdk/types.Coins" json:"initial_deposit" yaml:"initial_deposit"`
 	Proposer       string                              `protobuf:"bytes,3,opt,name=proposer,proto3" json:"proposer,omitempty"`
+	// messages are the sdk.Msgs executed by the governance module account when
+	// the proposal passes. Their only signer must be the governance module account.
+	Messages []*types.Any `protobuf:"bytes,4,rep,name=messages,proto3" json:"messages,omitempty"`
 }
 
 func (m *MsgSubmitProposal) Reset()      { *m = MsgSubmitProposal{} }
@@ -193,6 +196,83 @@ func (m *MsgVoteResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgVoteResponse proto.InternalMessageInfo
 
+// MsgVoteWeighted defines a message to cast a vote split across several
+// options, with weights summing to 1.
+type MsgVoteWeighted struct {
+	ProposalId uint64               `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id" yaml:"proposal_id"`
+	Voter      string               `protobuf:"bytes,2,opt,name=voter,proto3" json:"voter,omitempty"`
+	Options    []WeightedVoteOption `protobuf:"bytes,3,rep,name=options,proto3" json:"options"`
+}
+
+func (m *MsgVoteWeighted) Reset()      { *m = MsgVoteWeighted{} }
+func (*MsgVoteWeighted) ProtoMessage() {}
+func (*MsgVoteWeighted) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3c5e38f8143c80d7, []int{4}
+}
+func (m *MsgVoteWeighted) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgVoteWeighted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgVoteWeighted.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgVoteWeighted) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgVoteWeighted.Merge(m, src)
+}
+func (m *MsgVoteWeighted) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgVoteWeighted) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgVoteWeighted.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgVoteWeighted proto.InternalMessageInfo
+
+// MsgVoteWeightedResponse defines the Msg/VoteWeighted response type.
+type MsgVoteWeightedResponse struct {
+}
+
+func (m *MsgVoteWeightedResponse) Reset()         { *m = MsgVoteWeightedResponse{} }
+func (m *MsgVoteWeightedResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgVoteWeightedResponse) ProtoMessage()    {}
+func (*MsgVoteWeightedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3c5e38f8143c80d7, []int{5}
+}
+func (m *MsgVoteWeightedResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgVoteWeightedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgVoteWeightedResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgVoteWeightedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgVoteWeightedResponse.Merge(m, src)
+}
+func (m *MsgVoteWeightedResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgVoteWeightedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgVoteWeightedResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgVoteWeightedResponse proto.InternalMessageInfo
+
 // MsgDeposit defines a message to submit a deposit to an existing proposal.
 type MsgDeposit struct {
 	ProposalId uint64                              `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id" yaml:"proposal_id"`
@@ -203,7 +283,7 @@ type MsgDeposit struct {
 func (m *MsgDeposit) Reset()      { *m = MsgDeposit{} }
 func (*MsgDeposit) ProtoMessage() {}
 func (*MsgDeposit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3c5e38f8143c80d7, []int{4}
+	return fileDescriptor_3c5e38f8143c80d7, []int{6}
 }
 func (m *MsgDeposit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -240,7 +320,7 @@ func (m *MsgDepositResponse) Reset()         { *m = MsgDepositResponse{} }
 func (m *MsgDepositResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgDepositResponse) ProtoMessage()    {}
 func (*MsgDepositResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3c5e38f8143c80d7, []int{5}
+	return fileDescriptor_3c5e38f8143c80d7, []int{7}
 }
 func (m *MsgDepositResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -274,6 +354,8 @@ func init() {
 	proto.RegisterType((*MsgSubmitProposalResponse)(nil), "lfb.gov.v1beta1.MsgSubmitProposalResponse")
 	proto.RegisterType((*MsgVote)(nil), "lfb.gov.v1beta1.MsgVote")
 	proto.RegisterType((*MsgVoteResponse)(nil), "lfb.gov.v1beta1.MsgVoteResponse")
+	proto.RegisterType((*MsgVoteWeighted)(nil), "lfb.gov.v1beta1.MsgVoteWeighted")
+	proto.RegisterType((*MsgVoteWeightedResponse)(nil), "lfb.gov.v1beta1.MsgVoteWeightedResponse")
 	proto.RegisterType((*MsgDeposit)(nil), "lfb.gov.v1beta1.MsgDeposit")
 	proto.RegisterType((*MsgDepositResponse)(nil), "lfb.gov.v1beta1.MsgDepositResponse")
 }
@@ -281,44 +363,50 @@ func init() {
 func init() { proto.RegisterFile("lfb/gov/v1beta1/tx.proto", fileDescriptor_3c5e38f8143c80d7) }
 
 var fileDescriptor_3c5e38f8143c80d7 = []byte{
-	// 591 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xb1, 0x6f, 0xd3, 0x4e,
-	0x14, 0xb6, 0x93, 0xfe, 0x92, 0x5f, 0x2f, 0x52, 0x42, 0xad, 0xa8, 0x72, 0x1d, 0x64, 0x47, 0xae,
-	0x90, 0x22, 0x50, 0x6d, 0x35, 0xdd, 0x8a, 0x18, 0x48, 0x01, 0x09, 0xa1, 0x08, 0x64, 0x24, 0x06,
-	0x18, 0x82, 0x9d, 0x38, 0xe6, 0x84, 0x73, 0xcf, 0xca, 0x5d, 0xa2, 0x66, 0x63, 0x02, 0x46, 0x46,
-	0xc6, 0xcc, 0x0c, 0x4c, 0xfc, 0x11, 0x15, 0x53, 0x47, 0x06, 0x14, 0x20, 0x59, 0x10, 0x63, 0x27,
-	0x46, 0xe4, 0xb3, 0x2f, 0xad, 0x92, 0xb4, 0x30, 0x74, 0xf3, 0x7b, 0xdf, 0xf7, 0x3e, 0xdd, 0xf7,
-	0xdd, 0xf3, 0x21, 0x35, 0xec, 0x7a, 0x76, 0x00, 0x43, 0x7b, 0xb8, 0xeb, 0xf9, 0xcc, 0xdd, 0xb5,
-	0xd9, 0xa1, 0x15, 0xf5, 0x81, 0x81, 0x52, 0x0a, 0xbb, 0x9e, 0x15, 0xc0, 0xd0, 0x4a, 0x11, 0xad,
-	0x12, 0x53, 0x3d, 0x97, 0xfa, 0x73, 0x6e, 0x1b, 0x30, 0x49, 0xd8, 0xda, 0xd6, 0xa2, 0x4e, 0x3c,
-	0x99, 0x42, 0x6d, 0xa0, 0x3d, 0xa0, 0x2d, 0x5e, 0xd9, 0x49, 0x91, 0x42, 0xe5, 0x00, 0x02, 0x48,
-	0xfa, 0xf1, 0x97, 0x18, 0x08, 0x00, 0x82, 0xd0, 0xb7, 0x79, 0xe5, 0x0d, 0xba, 0xb6, 0x4b, 0x46,
-	0x09, 0x64, 0xbe, 0xc9, 0xa0, 0x8d, 0x26, 0x0d, 0x1e, 0x0f, 0xbc, 0x1e, 0x66, 0x8f, 0xfa, 0x10,
-	0x01, 0x75, 0x43, 0xe5, 0x26, 0xca, 0xb7, 0x81, 0x30, 0x9f, 0x30, 0x55, 0xae, 0xca, 0xb5, 0x42,
-	0xbd, 0x6c, 0x25, 0x12, 0x96, 0x90, 0xb0, 0x6e, 0x93, 0x51, 0xa3, 0xf0, 0xf9, 0xd3, 0x4e, 0xfe,
-	0x20, 0x21, 0x3a, 0x62, 0x42, 0x79, 0x2d, 0xa3, 0x12, 0x26, 0x98, 0x61, 0x37, 0x6c, 0x75, 0xfc,
-	0x08, 0x28, 0x66, 0x6a, 0xa6, 0x9a, 0xad, 0x15, 0xea, 0x9b, 0x56, 0x1c, 0x41, 0xec, 0x58, 0x64,
-	0x60, 0x1d, 0x00, 0x26, 0x8d, 0xbb, 0x47, 0x13, 0x43, 0x3a, 0x99, 0x18, 0x9b, 0x23, 0xb7, 0x17,
-	0xee, 0x9b, 0x0b, 0xc3, 0xe6, 0x87, 0x6f, 0xc6, 0x76, 0x80, 0xd9, 0x8b, 0x81, 0x67, 0xb5, 0xa1,
-	0x67, 0x87, 0x98, 0xf8, 0x76, 0xd8, 0xf5, 0x76, 0x68, 0xe7, 0xa5, 0xcd, 0x46, 0x91, 0x4f, 0xb9,
-	0x0a, 0x75, 0x8a, 0xe9, 0xe0, 0x9d, 0x64, 0x4e, 0xd1, 0xd0, 0xff, 0x11, 0x77, 0xe4, 0xf7, 0xd5,
-	0x6c, 0x55, 0xae, 0xad, 0x3b, 0xf3, 0x7a, 0xff, 0xca, 0xdb, 0xb1, 0x21, 0xbd, 0x1f, 0x1b, 0xd2,
-	0xcf, 0xb1, 0x21, 0xbd, 0xfa, 0x5a, 0x95, 0xcc, 0x36, 0xda, 0x5a, 0x0a, 0xc2, 0xf1, 0x69, 0x04,
-	0x84, 0xfa, 0xca, 0x3d, 0x54, 0x88, 0xd2, 0x5e, 0x0b, 0x77, 0x78, 0x28, 0x6b, 0x8d, 0x6b, 0xbf,
-	0x26, 0xc6, 0xd9, 0xf6, 0xc9, 0xc4, 0x50, 0x12, 0x07, 0x67, 0x9a, 0xa6, 0x83, 0x44, 0x75, 0xbf,
-	0x63, 0x7e, 0x94, 0x51, 0xbe, 0x49, 0x83, 0x27, 0xc0, 0x2e, 0x4d, 0x53, 0x29, 0xa3, 0xff, 0x86,
-	0xc0, 0xfc, 0xbe, 0x9a, 0xe1, 0x1e, 0x93, 0x42, 0xd9, 0x43, 0x39, 0x88, 0x18, 0x06, 0xc2, 0xad,
-	0x17, 0xeb, 0x15, 0x6b, 0x61, 0xfd, 0xac, 0xf8, 0x10, 0x0f, 0x39, 0xc5, 0x49, 0xa9, 0x2b, 0x52,
-	0xd9, 0x40, 0xa5, 0xf4, 0xbc, 0x22, 0x0b, 0xf3, 0x87, 0x8c, 0x50, 0x93, 0x06, 0x22, 0xe5, 0xcb,
-	0xb2, 0x71, 0x15, 0xad, 0xa7, 0x17, 0x0e, 0xc2, 0xca, 0x69, 0x43, 0x79, 0x86, 0x72, 0x6e, 0x0f,
-	0x06, 0x84, 0xa9, 0xd9, 0x0b, 0x57, 0xe9, 0x46, 0xbc, 0x4a, 0xff, 0xba, 0x30, 0xa9, 0xe4, 0x0a,
-	0xdb, 0x65, 0xa4, 0x9c, 0x5a, 0x14, 0xce, 0xeb, 0xbf, 0x65, 0x94, 0x6d, 0xd2, 0x40, 0x79, 0x8e,
-	0x8a, 0x0b, 0x3f, 0x8c, 0xb9, 0x94, 0xee, 0xd2, 0x2e, 0x69, 0xd7, 0xff, 0xce, 0x99, 0xef, 0x5b,
-	0x03, 0xad, 0xf1, 0x1d, 0x51, 0x57, 0xcd, 0xc4, 0x88, 0x56, 0x3d, 0x0f, 0x99, 0x6b, 0x3c, 0x40,
-	0x79, 0x71, 0x47, 0x95, 0x55, 0xe4, 0x14, 0xd4, 0xb6, 0x2f, 0x00, 0x85, 0x58, 0xe3, 0xd6, 0xd1,
-	0x54, 0x97, 0x8f, 0xa7, 0xba, 0xfc, 0x7d, 0xaa, 0xcb, 0xef, 0x66, 0xba, 0x74, 0x3c, 0xd3, 0xa5,
-	0x2f, 0x33, 0x5d, 0x7a, 0x7a, 0x6e, 0xd2, 0x87, 0xfc, 0xf9, 0xe2, 0x79, 0x7b, 0x39, 0xfe, 0x6e,
-	0xec, 0xfd, 0x09, 0x00, 0x00, 0xff, 0xff, 0x54, 0x97, 0xf5, 0xec, 0x1e, 0x05, 0x00, 0x00,
+	// 676 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0xb1, 0x6f, 0xd3, 0x4e,
+	0x14, 0xb6, 0x93, 0xfc, 0x9a, 0xf6, 0xe5, 0xa7, 0x96, 0x5a, 0x51, 0x71, 0x5d, 0x14, 0x47, 0xae,
+	0x90, 0x22, 0x50, 0x6d, 0x35, 0xdd, 0x8a, 0x3a, 0x90, 0x02, 0x12, 0x42, 0x11, 0xc8, 0x48, 0x20,
+	0x95, 0xa1, 0xd8, 0xc9, 0xe5, 0x6a, 0xd5, 0xf1, 0x59, 0xb9, 0x4b, 0xd4, 0x6c, 0x4c, 0x88, 0x91,
+	0x91, 0xb1, 0x33, 0x03, 0x13, 0x7f, 0x43, 0x55, 0x31, 0x75, 0x60, 0x60, 0x40, 0x01, 0xda, 0x05,
+	0x31, 0xf6, 0x2f, 0x40, 0x3e, 0xfb, 0xdc, 0x92, 0xa4, 0x29, 0x43, 0xd9, 0xf2, 0xde, 0xfb, 0xbe,
+	0x4f, 0xf7, 0x7d, 0x77, 0xcf, 0x01, 0xd5, 0x6f, 0xb9, 0x16, 0x26, 0x3d, 0xab, 0xb7, 0xea, 0x22,
+	0xe6, 0xac, 0x5a, 0x6c, 0xcf, 0x0c, 0x3b, 0x84, 0x11, 0x65, 0xce, 0x6f, 0xb9, 0x26, 0x26, 0x3d,
+	0x33, 0x99, 0x68, 0x4b, 0x11, 0xd4, 0x75, 0x28, 0x4a, 0xb1, 0x0d, 0xe2, 0x05, 0x31, 0x5a, 0x5b,
+	0x1c, 0xd6, 0x89, 0x98, 0xc9, 0xa8, 0x41, 0x68, 0x9b, 0xd0, 0x6d, 0x5e, 0x59, 0x71, 0x91, 0x8c,
+	0x8a, 0x98, 0x60, 0x12, 0xf7, 0xa3, 0x5f, 0x82, 0x80, 0x09, 0xc1, 0x3e, 0xb2, 0x78, 0xe5, 0x76,
+	0x5b, 0x96, 0x13, 0xf4, 0xe3, 0x91, 0x71, 0x90, 0x81, 0xf9, 0x3a, 0xc5, 0x4f, 0xbb, 0x6e, 0xdb,
+	0x63, 0x4f, 0x3a, 0x24, 0x24, 0xd4, 0xf1, 0x95, 0x3b, 0x90, 0x6f, 0x90, 0x80, 0xa1, 0x80, 0xa9,
+	0x72, 0x59, 0xae, 0x14, 0xaa, 0x45, 0x33, 0x96, 0x30, 0x85, 0x84, 0x79, 0x37, 0xe8, 0xd7, 0x0a,
+	0x9f, 0x3e, 0xae, 0xe4, 0x37, 0x63, 0xa0, 0x2d, 0x18, 0xca, 0x6b, 0x19, 0xe6, 0xbc, 0xc0, 0x63,
+	0x9e, 0xe3, 0x6f, 0x37, 0x51, 0x48, 0xa8, 0xc7, 0xd4, 0x4c, 0x39, 0x5b, 0x29, 0x54, 0x17, 0xcc,
+	0x28, 0x82, 0xc8, 0xb1, 0xc8, 0xc0, 0xdc, 0x24, 0x5e, 0x50, 0xbb, 0x7f, 0x38, 0xd0, 0xa5, 0xd3,
+	0x81, 0xbe, 0xd0, 0x77, 0xda, 0xfe, 0xba, 0x31, 0x44, 0x36, 0xde, 0x7f, 0xd3, 0x97, 0xb1, 0xc7,
+	0x76, 0xba, 0xae, 0xd9, 0x20, 0x6d, 0xcb, 0xf7, 0x02, 0x64, 0xf9, 0x2d, 0x77, 0x85, 0x36, 0x77,
+	0x2d, 0xd6, 0x0f, 0x11, 0xe5, 0x2a, 0xd4, 0x9e, 0x4d, 0x88, 0xf7, 0x62, 0x9e, 0xa2, 0xc1, 0x74,
+	0xc8, 0x1d, 0xa1, 0x8e, 0x9a, 0x2d, 0xcb, 0x95, 0x19, 0x3b, 0xad, 0x95, 0x0d, 0x98, 0x6e, 0x23,
+	0x4a, 0x1d, 0x8c, 0xa8, 0x9a, 0x2b, 0x67, 0x27, 0x5b, 0xa4, 0xcd, 0x5d, 0xb3, 0x4e, 0xb1, 0x9d,
+	0x52, 0xd6, 0xaf, 0xbd, 0xd9, 0xd7, 0xa5, 0x77, 0xfb, 0xba, 0xf4, 0x73, 0x5f, 0x97, 0x5e, 0x7d,
+	0x2d, 0x4b, 0x46, 0x03, 0x16, 0x47, 0x72, 0xb4, 0x11, 0x0d, 0x49, 0x40, 0x91, 0xf2, 0x00, 0x0a,
+	0x61, 0xd2, 0xdb, 0xf6, 0x9a, 0x3c, 0xd3, 0x5c, 0xed, 0xe6, 0xaf, 0x81, 0x7e, 0xbe, 0x7d, 0x3a,
+	0xd0, 0x95, 0x38, 0x80, 0x73, 0x4d, 0xc3, 0x06, 0x51, 0x3d, 0x6c, 0x1a, 0x1f, 0x64, 0xc8, 0xd7,
+	0x29, 0x7e, 0x46, 0xd8, 0x95, 0x69, 0x2a, 0x45, 0xf8, 0xaf, 0x47, 0x18, 0xea, 0xa8, 0x19, 0x1e,
+	0x51, 0x5c, 0x28, 0x6b, 0x30, 0x45, 0x42, 0xe6, 0x91, 0x80, 0x27, 0x37, 0x5b, 0x5d, 0x32, 0x87,
+	0x5e, 0xaf, 0x19, 0x1d, 0xe2, 0x31, 0x87, 0xd8, 0x09, 0x74, 0x4c, 0x2a, 0xf3, 0x30, 0x97, 0x9c,
+	0x57, 0x64, 0x61, 0x1c, 0xc8, 0x69, 0xef, 0x39, 0xf2, 0xf0, 0x0e, 0x43, 0xcd, 0x7f, 0xec, 0x65,
+	0x13, 0xf2, 0xf1, 0x01, 0xa9, 0x9a, 0xe5, 0x57, 0xbd, 0x3c, 0x62, 0x46, 0x9c, 0xe4, 0xcc, 0x54,
+	0x2d, 0x17, 0x3d, 0x4a, 0x5b, 0x30, 0xc7, 0x78, 0x5b, 0x84, 0xeb, 0x43, 0x3e, 0x52, 0x8f, 0x3f,
+	0x64, 0x80, 0x3a, 0xc5, 0xe2, 0x21, 0x5e, 0x95, 0xbd, 0x1b, 0x30, 0x93, 0xec, 0x04, 0x11, 0x16,
+	0xcf, 0x1a, 0xca, 0x0b, 0x98, 0x72, 0xda, 0xa4, 0x1b, 0x30, 0x35, 0x3b, 0x71, 0xdb, 0x6e, 0x47,
+	0xc6, 0xfe, 0x76, 0xa7, 0x12, 0xc9, 0x31, 0xf6, 0x8b, 0xa0, 0x9c, 0x59, 0x14, 0xce, 0xab, 0x9f,
+	0x33, 0x90, 0xad, 0x53, 0xac, 0xbc, 0x84, 0xd9, 0xa1, 0x6f, 0x8a, 0x31, 0x12, 0xfa, 0xc8, 0xbe,
+	0x68, 0xb7, 0x2e, 0xc7, 0xa4, 0x3b, 0x55, 0x83, 0x1c, 0xdf, 0x03, 0x75, 0x1c, 0x27, 0x9a, 0x68,
+	0xe5, 0x8b, 0x26, 0xa9, 0xc6, 0x16, 0xfc, 0xff, 0xc7, 0x3b, 0xbc, 0x90, 0x21, 0x10, 0x5a, 0xe5,
+	0x32, 0x44, 0xaa, 0xfd, 0x08, 0xf2, 0xe2, 0xfe, 0x97, 0xc6, 0x91, 0x92, 0xa1, 0xb6, 0x3c, 0x61,
+	0x28, 0xc4, 0x6a, 0x1b, 0x87, 0xc7, 0x25, 0xf9, 0xe8, 0xb8, 0x24, 0x7f, 0x3f, 0x2e, 0xc9, 0x6f,
+	0x4f, 0x4a, 0xd2, 0xd1, 0x49, 0x49, 0xfa, 0x72, 0x52, 0x92, 0xb6, 0x2e, 0xbc, 0xc5, 0x3d, 0xfe,
+	0xef, 0xc1, 0xef, 0xd2, 0x9d, 0xe2, 0xdf, 0xb4, 0xb5, 0xdf, 0x03, 0x00, 0x33, 0xf4, 0x77, 0x46,
+	0x9d, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -337,6 +425,8 @@ type MsgClient interface {
 	SubmitProposal(ctx context.Context, in *MsgSubmitProposal, opts ...grpc.CallOption) (*MsgSubmitProposalResponse, error)
 	// Vote defines a method to add a vote on a specific proposal.
 	Vote(ctx context.Context, in *MsgVote, opts ...grpc.CallOption) (*MsgVoteResponse, error)
+	// VoteWeighted defines a method to add a weighted vote on a specific proposal.
+	VoteWeighted(ctx context.Context, in *MsgVoteWeighted, opts ...grpc.CallOption) (*MsgVoteWeightedResponse, error)
 	// Deposit defines a method to add deposit on a specific proposal.
 	Deposit(ctx context.Context, in *MsgDeposit, opts ...grpc.CallOption) (*MsgDepositResponse, error)
 }
@@ -367,6 +457,15 @@ func (c *msgClient) Vote(ctx context.Context, in *MsgVote, opts ...grpc.CallOpti
 	return out, nil
 }
 
+func (c *msgClient) VoteWeighted(ctx context.Context, in *MsgVoteWeighted, opts ...grpc.CallOption) (*MsgVoteWeightedResponse, error) {
+	out := new(MsgVoteWeightedResponse)
+	err := c.cc.Invoke(ctx, "/lfb.gov.v1beta1.Msg/VoteWeighted", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) Deposit(ctx context.Context, in *MsgDeposit, opts ...grpc.CallOption) (*MsgDepositResponse, error) {
 	out := new(MsgDepositResponse)
 	err := c.cc.Invoke(ctx, "/lfb.gov.v1beta1.Msg/Deposit", in, out, opts...)
@@ -382,6 +481,8 @@ type MsgServer interface {
 	SubmitProposal(context.Context, *MsgSubmitProposal) (*MsgSubmitProposalResponse, error)
 	// Vote defines a method to add a vote on a specific proposal.
 	Vote(context.Context, *MsgVote) (*MsgVoteResponse, error)
+	// VoteWeighted defines a method to add a weighted vote on a specific proposal.
+	VoteWeighted(context.Context, *MsgVoteWeighted) (*MsgVoteWeightedResponse, error)
 	// Deposit defines a method to add deposit on a specific proposal.
 	Deposit(context.Context, *MsgDeposit) (*MsgDepositResponse, error)
 }
@@ -396,6 +497,9 @@ func (*UnimplementedMsgServer) SubmitProposal(ctx context.Context, req *MsgSubmi
 func (*UnimplementedMsgServer) Vote(ctx context.Context, req *MsgVote) (*MsgVoteResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Vote not implemented")
 }
+func (*UnimplementedMsgServer) VoteWeighted(ctx context.Context, req *MsgVoteWeighted) (*MsgVoteWeightedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VoteWeighted not implemented")
+}
 func (*UnimplementedMsgServer) Deposit(ctx context.Context, req *MsgDeposit) (*MsgDepositResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Deposit not implemented")
 }
@@ -440,6 +544,24 @@ func _Msg_Vote_Handler(srv interface{}, ctx context.Context, dec func(interface{
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_VoteWeighted_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgVoteWeighted)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).VoteWeighted(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lfb.gov.v1beta1.Msg/VoteWeighted",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).VoteWeighted(ctx, req.(*MsgVoteWeighted))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_Deposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgDeposit)
 	if err := dec(in); err != nil {
@@ -470,6 +592,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Vote",
 			Handler:    _Msg_Vote_Handler,
 		},
+		{
+			MethodName: "VoteWeighted",
+			Handler:    _Msg_VoteWeighted_Handler,
+		},
 		{
 			MethodName: "Deposit",
 			Handler:    _Msg_Deposit_Handler,
@@ -499,6 +625,20 @@ func (m *MsgSubmitProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Messages) > 0 {
+		for iNdEx := len(m.Messages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Messages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.Proposer) > 0 {
 		i -= len(m.Proposer)
 		copy(dAtA[i:], m.Proposer)
@@ -626,6 +766,78 @@ func (m *MsgVoteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgVoteWeighted) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgVoteWeighted) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgVoteWeighted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Options) > 0 {
+		for iNdEx := len(m.Options) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Options[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Voter) > 0 {
+		i -= len(m.Voter)
+		copy(dAtA[i:], m.Voter)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Voter)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgVoteWeightedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgVoteWeightedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgVoteWeightedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgDeposit) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -729,6 +941,12 @@ func (m *MsgSubmitProposal) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if len(m.Messages) > 0 {
+		for _, e := range m.Messages {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -772,6 +990,37 @@ func (m *MsgVoteResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgVoteWeighted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovTx(uint64(m.ProposalId))
+	}
+	l = len(m.Voter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Options) > 0 {
+		for _, e := range m.Options {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgVoteWeightedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func (m *MsgDeposit) Size() (n int) {
 	if m == nil {
 		return 0
@@ -940,6 +1189,40 @@ func (m *MsgSubmitProposal) Unmarshal(dAtA []byte) error {
 			}
 			m.Proposer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Messages = append(m.Messages, &types.Any{})
+			if err := m.Messages[len(m.Messages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1200,6 +1483,191 @@ func (m *MsgVoteResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgVoteWeighted) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgVoteWeighted: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgVoteWeighted: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Voter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Options = append(m.Options, WeightedVoteOption{})
+			if err := m.Options[len(m.Options)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgVoteWeightedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgVoteWeightedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgVoteWeightedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -2,16 +2,18 @@ package types
 
 import (
 	"fmt"
+	"strings"
 
 	yaml "gopkg.in/yaml.v2"
 
 	sdk "github.com/line/lfb-sdk/types"
+	sdkerrors "github.com/line/lfb-sdk/types/errors"
 )
 
 // NewVote creates a new Vote instance
 //nolint:interfacer
-func NewVote(proposalID uint64, voter sdk.AccAddress, option VoteOption) Vote {
-	return Vote{proposalID, voter.String(), option}
+func NewVote(proposalID uint64, voter sdk.AccAddress, options WeightedVoteOptions) Vote {
+	return Vote{ProposalId: proposalID, Voter: voter.String(), Options: options}
 }
 
 func (v Vote) String() string {
@@ -43,7 +45,7 @@ func (v Votes) String() string {
 	}
 	out := fmt.Sprintf("Votes for Proposal %d:", v[0].ProposalId)
 	for _, vot := range v {
-		out += fmt.Sprintf("\n  %s: %s", vot.Voter, vot.Option)
+		out += fmt.Sprintf("\n  %s: %s", vot.Voter, WeightedVoteOptions(vot.Options))
 	}
 	return out
 }
@@ -53,6 +55,99 @@ func (v Vote) Empty() bool {
 	return v.String() == Vote{}.String()
 }
 
+// NewWeightedVoteOption creates a new WeightedVoteOption instance
+func NewWeightedVoteOption(option VoteOption, weight sdk.Dec) WeightedVoteOption {
+	return WeightedVoteOption{option, weight}
+}
+
+// NewNonSplitVoteOption creates a single option vote with weight 1
+func NewNonSplitVoteOption(option VoteOption) WeightedVoteOptions {
+	return WeightedVoteOptions{{option, sdk.OneDec()}}
+}
+
+func (v WeightedVoteOption) String() string {
+	out, _ := yaml.Marshal(v)
+	return string(out)
+}
+
+// WeightedVoteOptions describes an array of WeightedVoteOption
+type WeightedVoteOptions []WeightedVoteOption
+
+func (v WeightedVoteOptions) String() string {
+	options := make([]string, len(v))
+	for i, option := range v {
+		options[i] = fmt.Sprintf("%s=%s", option.Option, option.Weight)
+	}
+	return strings.Join(options, ",")
+}
+
+// ValidWeightedVoteOption returns true if the weighted vote option is valid and false otherwise.
+func ValidWeightedVoteOption(option WeightedVoteOption) bool {
+	if !option.Weight.IsPositive() || option.Weight.GT(sdk.OneDec()) {
+		return false
+	}
+	return ValidVoteOption(option.Option)
+}
+
+// ValidateWeightedVoteOptions checks that the options are valid, distinct and
+// that their weights sum to 1.
+func ValidateWeightedVoteOptions(options WeightedVoteOptions) error {
+	if len(options) == 0 {
+		return sdkerrors.Wrap(ErrInvalidVote, "no vote options")
+	}
+
+	totalWeight := sdk.ZeroDec()
+	usedOptions := make(map[VoteOption]bool)
+	for _, option := range options {
+		if !ValidWeightedVoteOption(option) {
+			return sdkerrors.Wrapf(ErrInvalidVote, "%s=%s", option.Option, option.Weight)
+		}
+		if usedOptions[option.Option] {
+			return sdkerrors.Wrapf(ErrInvalidVote, "duplicated vote option %s", option.Option)
+		}
+		usedOptions[option.Option] = true
+		totalWeight = totalWeight.Add(option.Weight)
+	}
+
+	if !totalWeight.Equal(sdk.OneDec()) {
+		return sdkerrors.Wrapf(ErrInvalidVote, "total weight %s of %s is not 1", totalWeight, options)
+	}
+
+	return nil
+}
+
+// WeightedVoteOptionsFromString returns weighted vote options from a string of
+// comma separated {option}={weight} pairs. A single option without a weight
+// is given weight 1. It returns an error if the string is invalid.
+func WeightedVoteOptionsFromString(str string) (WeightedVoteOptions, error) {
+	str = strings.TrimSpace(str)
+	if !strings.Contains(str, "=") {
+		option, err := VoteOptionFromString(str)
+		if err != nil {
+			return nil, err
+		}
+		return NewNonSplitVoteOption(option), nil
+	}
+
+	var options WeightedVoteOptions
+	for _, pair := range strings.Split(str, ",") {
+		fields := strings.Split(strings.TrimSpace(pair), "=")
+		if len(fields) != 2 {
+			return nil, fmt.Errorf("'%s' is not a valid weighted vote option", pair)
+		}
+		option, err := VoteOptionFromString(fields[0])
+		if err != nil {
+			return nil, err
+		}
+		weight, err := sdk.NewDecFromStr(fields[1])
+		if err != nil {
+			return nil, fmt.Errorf("'%s' is not a valid vote weight: %w", fields[1], err)
+		}
+		options = append(options, NewWeightedVoteOption(option, weight))
+	}
+	return options, nil
+}
+
 // VoteOptionFromString returns a VoteOption from a string. It returns an error
 // if the string is invalid.
 func VoteOptionFromString(str string) (VoteOption, error) {
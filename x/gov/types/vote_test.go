@@ -0,0 +1,67 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/line/lfb-sdk/types"
+)
+
+func TestWeightedVoteOptionsFromString(t *testing.T) {
+	tests := map[string]struct {
+		str     string
+		options WeightedVoteOptions
+		expErr  bool
+	}{
+		"single option": {
+			str:     "VOTE_OPTION_YES",
+			options: NewNonSplitVoteOption(OptionYes),
+		},
+		"split options": {
+			str: "VOTE_OPTION_YES=0.6,VOTE_OPTION_NO=0.3,VOTE_OPTION_NO_WITH_VETO=0.1",
+			options: WeightedVoteOptions{
+				NewWeightedVoteOption(OptionYes, sdk.NewDecWithPrec(6, 1)),
+				NewWeightedVoteOption(OptionNo, sdk.NewDecWithPrec(3, 1)),
+				NewWeightedVoteOption(OptionNoWithVeto, sdk.NewDecWithPrec(1, 1)),
+			},
+		},
+		"unknown option": {
+			str:    "VOTE_OPTION_MAYBE=1",
+			expErr: true,
+		},
+		"invalid weight": {
+			str:    "VOTE_OPTION_YES=one",
+			expErr: true,
+		},
+		"missing weight": {
+			str:    "VOTE_OPTION_YES=0.5,VOTE_OPTION_NO",
+			expErr: true,
+		},
+	}
+
+	for name, tc := range tests {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			options, err := WeightedVoteOptionsFromString(tc.str)
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.options, options)
+		})
+	}
+}
+
+func TestWeightedVoteOptionsString(t *testing.T) {
+	options := WeightedVoteOptions{
+		NewWeightedVoteOption(OptionYes, sdk.NewDecWithPrec(6, 1)),
+		NewWeightedVoteOption(OptionNo, sdk.NewDecWithPrec(4, 1)),
+	}
+	require.Equal(t, "VOTE_OPTION_YES=0.600000000000000000,VOTE_OPTION_NO=0.400000000000000000", options.String())
+
+	parsed, err := WeightedVoteOptionsFromString(options.String())
+	require.NoError(t, err)
+	require.Equal(t, options, parsed)
+}
@@ -0,0 +1,664 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/line/lfb-sdk/client"
+	"github.com/line/lfb-sdk/client/flags"
+	"github.com/line/lfb-sdk/client/tx"
+	sdk "github.com/line/lfb-sdk/types"
+	"github.com/line/lfb-sdk/version"
+	"github.com/line/lfb-sdk/x/group/types"
+)
+
+// flags for group module
+const (
+	FlagExec = "exec"
+	ExecTry  = "try"
+)
+
+// member defines a member of a group in a members JSON file.
+type member struct {
+	Address  string `json:"address"`
+	Weight   string `json:"weight"`
+	Metadata string `json:"metadata"`
+}
+
+// members defines the JSON file listing the members of a group.
+type members struct {
+	Members []member `json:"members"`
+}
+
+// proposal defines the JSON file of a group proposal.
+type proposal struct {
+	Address   string            `json:"address"`
+	Proposers []string          `json:"proposers"`
+	Metadata  string            `json:"metadata"`
+	Messages  []json.RawMessage `json:"messages"`
+}
+
+// GetTxCmd returns the transaction commands for this module
+func GetTxCmd() *cobra.Command {
+	groupTxCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Group transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	groupTxCmd.AddCommand(
+		NewCmdCreateGroup(),
+		NewCmdUpdateGroupMembers(),
+		NewCmdUpdateGroupAdmin(),
+		NewCmdUpdateGroupMetadata(),
+		NewCmdCreateGroupPolicy(),
+		NewCmdUpdateGroupPolicyAdmin(),
+		NewCmdUpdateGroupPolicyDecisionPolicy(),
+		NewCmdSubmitProposal(),
+		NewCmdWithdrawProposal(),
+		NewCmdVote(),
+		NewCmdExec(),
+	)
+
+	return groupTxCmd
+}
+
+// NewCmdCreateGroup returns a CLI command handler for creating a MsgCreateGroup transaction.
+func NewCmdCreateGroup() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-group [admin] [metadata] [members-json-file]",
+		Short: "Create a group which is an aggregation of member accounts with associated weights and an administrator account",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Create a group which is an aggregation of member accounts with associated weights and an administrator account.
+Note, the '--from' flag is ignored as it is implied from [admin].
+
+Example:
+$ %s tx %s create-group [admin] [metadata] [members-json-file]
+
+Where members.json contains:
+
+{
+  "members": [
+    {
+      "address": "addr1",
+      "weight": "1",
+      "metadata": "some metadata"
+    },
+    {
+      "address": "addr2",
+      "weight": "1",
+      "metadata": "some metadata"
+    }
+  ]
+}
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := cmd.Flags().Set(flags.FlagFrom, args[0]); err != nil {
+				return err
+			}
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			members, err := parseMembers(args[2])
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgCreateGroup{
+				Admin:    clientCtx.GetFromAddress().String(),
+				Members:  members,
+				Metadata: []byte(args[1]),
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return fmt.Errorf("message validation failed: %w", err)
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewCmdUpdateGroupMembers returns a CLI command handler for creating a MsgUpdateGroupMembers transaction.
+func NewCmdUpdateGroupMembers() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-group-members [admin] [group-id] [members-json-file]",
+		Short: "Update a group's members. Set a member's weight to \"0\" to delete it.",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Update a group's members. Set a member's weight to "0" to delete it.
+Note, the '--from' flag is ignored as it is implied from [admin].
+
+Example:
+$ %s tx %s update-group-members [admin] [group-id] [members-json-file]
+
+Where members.json contains:
+
+{
+  "members": [
+    {
+      "address": "addr1",
+      "weight": "1",
+      "metadata": "some new metadata"
+    },
+    {
+      "address": "addr2",
+      "weight": "0",
+      "metadata": "some metadata"
+    }
+  ]
+}
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := cmd.Flags().Set(flags.FlagFrom, args[0]); err != nil {
+				return err
+			}
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			groupID, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+			members, err := parseMembers(args[2])
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgUpdateGroupMembers{
+				Admin:         clientCtx.GetFromAddress().String(),
+				GroupId:       groupID,
+				MemberUpdates: members,
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return fmt.Errorf("message validation failed: %w", err)
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewCmdUpdateGroupAdmin returns a CLI command handler for creating a MsgUpdateGroupAdmin transaction.
+func NewCmdUpdateGroupAdmin() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-group-admin [admin] [group-id] [new-admin]",
+		Short: "Update a group's admin",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := cmd.Flags().Set(flags.FlagFrom, args[0]); err != nil {
+				return err
+			}
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			groupID, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgUpdateGroupAdmin{
+				Admin:    clientCtx.GetFromAddress().String(),
+				GroupId:  groupID,
+				NewAdmin: args[2],
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return fmt.Errorf("message validation failed: %w", err)
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewCmdUpdateGroupMetadata returns a CLI command handler for creating a MsgUpdateGroupMetadata transaction.
+func NewCmdUpdateGroupMetadata() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-group-metadata [admin] [group-id] [metadata]",
+		Short: "Update a group's metadata",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := cmd.Flags().Set(flags.FlagFrom, args[0]); err != nil {
+				return err
+			}
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			groupID, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgUpdateGroupMetadata{
+				Admin:    clientCtx.GetFromAddress().String(),
+				GroupId:  groupID,
+				Metadata: []byte(args[2]),
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return fmt.Errorf("message validation failed: %w", err)
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewCmdCreateGroupPolicy returns a CLI command handler for creating a MsgCreateGroupPolicy transaction.
+func NewCmdCreateGroupPolicy() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-group-policy [admin] [group-id] [metadata] [decision-policy]",
+		Short: "Create a group policy which is an account associated with a group and a decision policy",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Create a group policy which is an account associated with a group and a decision policy.
+Note, the '--from' flag is ignored as it is implied from [admin].
+
+Example:
+$ %s tx %s create-group-policy [admin] [group-id] [metadata] \
+'{"@type":"/lfb.group.v1beta1.ThresholdDecisionPolicy", "threshold":"1", "voting_period": "120h"}'
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := cmd.Flags().Set(flags.FlagFrom, args[0]); err != nil {
+				return err
+			}
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			groupID, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			var policy types.DecisionPolicy
+			if err := clientCtx.JSONMarshaler.UnmarshalInterfaceJSON([]byte(args[3]), &policy); err != nil {
+				return fmt.Errorf("failed to parse decision policy: %w", err)
+			}
+
+			msg, err := types.NewMsgCreateGroupPolicy(clientCtx.GetFromAddress(), groupID, []byte(args[2]), policy)
+			if err != nil {
+				return err
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return fmt.Errorf("message validation failed: %w", err)
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewCmdUpdateGroupPolicyAdmin returns a CLI command handler for creating a MsgUpdateGroupPolicyAdmin transaction.
+func NewCmdUpdateGroupPolicyAdmin() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-group-policy-admin [admin] [group-policy-account] [new-admin]",
+		Short: "Update a group policy admin",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := cmd.Flags().Set(flags.FlagFrom, args[0]); err != nil {
+				return err
+			}
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgUpdateGroupPolicyAdmin{
+				Admin:    clientCtx.GetFromAddress().String(),
+				Address:  args[1],
+				NewAdmin: args[2],
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return fmt.Errorf("message validation failed: %w", err)
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewCmdUpdateGroupPolicyDecisionPolicy returns a CLI command handler for creating a MsgUpdateGroupPolicyDecisionPolicy transaction.
+func NewCmdUpdateGroupPolicyDecisionPolicy() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-group-policy-decision-policy [admin] [group-policy-account] [decision-policy]",
+		Short: "Update a group policy's decision policy",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := cmd.Flags().Set(flags.FlagFrom, args[0]); err != nil {
+				return err
+			}
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			address, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			var policy types.DecisionPolicy
+			if err := clientCtx.JSONMarshaler.UnmarshalInterfaceJSON([]byte(args[2]), &policy); err != nil {
+				return fmt.Errorf("failed to parse decision policy: %w", err)
+			}
+
+			msg, err := types.NewMsgUpdateGroupPolicyDecisionPolicy(clientCtx.GetFromAddress(), address, policy)
+			if err != nil {
+				return err
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return fmt.Errorf("message validation failed: %w", err)
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewCmdSubmitProposal returns a CLI command handler for creating a MsgSubmitProposal transaction.
+func NewCmdSubmitProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "submit-proposal [proposal-json-file]",
+		Short: "Submit a new proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a new proposal on a group policy account.
+The proposal is signed by its first proposer, the other proposers must sign
+the generated transaction too.
+
+Example:
+$ %s tx %s submit-proposal path/to/proposal.json
+
+Where proposal.json contains:
+
+{
+  "address": "link1...",
+  "proposers": ["link1...", "link1..."],
+  "metadata": "some metadata",
+  "messages": [
+    {
+      "@type": "/lfb.bank.v1beta1.MsgSend",
+      "from_address": "link1...",
+      "to_address": "link1...",
+      "amount": [{"denom": "stake", "amount": "10"}]
+    }
+  ]
+}
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			prop, err := parseProposal(args[0])
+			if err != nil {
+				return err
+			}
+			if len(prop.Proposers) == 0 {
+				return fmt.Errorf("a proposal must have at least one proposer")
+			}
+
+			if err := cmd.Flags().Set(flags.FlagFrom, prop.Proposers[0]); err != nil {
+				return err
+			}
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			address, err := sdk.AccAddressFromBech32(prop.Address)
+			if err != nil {
+				return err
+			}
+			msgs := make([]sdk.Msg, len(prop.Messages))
+			for i, rawMsg := range prop.Messages {
+				if err := clientCtx.JSONMarshaler.UnmarshalInterfaceJSON(rawMsg, &msgs[i]); err != nil {
+					return fmt.Errorf("failed to parse proposal message %d: %w", i, err)
+				}
+			}
+			exec, err := parseExec(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg, err := types.NewMsgSubmitProposal(address, prop.Proposers, msgs, []byte(prop.Metadata), exec)
+			if err != nil {
+				return err
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return fmt.Errorf("message validation failed: %w", err)
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagExec, "", "Set to 'try' to try to execute the proposal right after its creation (proposers' signatures are considered as Yes votes)")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewCmdWithdrawProposal returns a CLI command handler for creating a MsgWithdrawProposal transaction.
+func NewCmdWithdrawProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "withdraw-proposal [proposal-id] [group-policy-admin-or-proposer]",
+		Short: "Withdraw a submitted proposal",
+		Long: `Withdraw a submitted proposal. Only the group policy admin or one of the
+proposers of the proposal can withdraw it.
+Note, the '--from' flag is ignored as it is implied from [group-policy-admin-or-proposer].`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := cmd.Flags().Set(flags.FlagFrom, args[1]); err != nil {
+				return err
+			}
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgWithdrawProposal{
+				ProposalId: proposalID,
+				Address:    clientCtx.GetFromAddress().String(),
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return fmt.Errorf("message validation failed: %w", err)
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewCmdVote returns a CLI command handler for creating a MsgVote transaction.
+func NewCmdVote() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vote [proposal-id] [voter] [vote-option] [metadata]",
+		Short: "Vote on a proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Vote on a proposal.
+Note, the '--from' flag is ignored as it is implied from [voter].
+
+Example:
+$ %s tx %s vote 1 [voter] VOTE_OPTION_YES "my vote"
+
+Vote options are VOTE_OPTION_YES, VOTE_OPTION_NO, VOTE_OPTION_ABSTAIN and VOTE_OPTION_NO_WITH_VETO.
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := cmd.Flags().Set(flags.FlagFrom, args[1]); err != nil {
+				return err
+			}
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			option, err := types.VoteOptionFromString(args[2])
+			if err != nil {
+				return err
+			}
+			exec, err := parseExec(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgVote{
+				ProposalId: proposalID,
+				Voter:      clientCtx.GetFromAddress().String(),
+				Option:     option,
+				Metadata:   []byte(args[3]),
+				Exec:       exec,
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return fmt.Errorf("message validation failed: %w", err)
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagExec, "", "Set to 'try' to try to execute the proposal right after the vote")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewCmdExec returns a CLI command handler for creating a MsgExec transaction.
+func NewCmdExec() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "exec [proposal-id]",
+		Short: "Execute a proposal",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgExec{
+				ProposalId: proposalID,
+				Signer:     clientCtx.GetFromAddress().String(),
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return fmt.Errorf("message validation failed: %w", err)
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func parseMembers(path string) ([]types.Member, error) {
+	var m members
+	contents, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(contents, &m); err != nil {
+		return nil, fmt.Errorf("failed to parse members: %w", err)
+	}
+
+	result := make([]types.Member, len(m.Members))
+	for i, member := range m.Members {
+		result[i] = types.Member{
+			Address:  member.Address,
+			Weight:   member.Weight,
+			Metadata: []byte(member.Metadata),
+		}
+	}
+	return result, nil
+}
+
+func parseProposal(path string) (proposal, error) {
+	var p proposal
+	contents, err := ioutil.ReadFile(path)
+	if err != nil {
+		return p, err
+	}
+	if err := json.Unmarshal(contents, &p); err != nil {
+		return p, fmt.Errorf("failed to parse proposal: %w", err)
+	}
+	return p, nil
+}
+
+func parseExec(cmd *cobra.Command) (types.Exec, error) {
+	exec, err := cmd.Flags().GetString(FlagExec)
+	if err != nil {
+		return types.ExecUnspecified, err
+	}
+	switch exec {
+	case "":
+		return types.ExecUnspecified, nil
+	case ExecTry:
+		return types.ExecTry, nil
+	default:
+		return types.ExecUnspecified, fmt.Errorf("invalid %s flag: %s", FlagExec, exec)
+	}
+}
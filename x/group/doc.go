@@ -0,0 +1,20 @@
+/*
+Package group provides on-chain groups of weighted members, which act through
+group policy accounts.
+
+A group is created by its admin with MsgCreateGroup, and its members and their
+weights can later be updated with MsgUpdateGroupMembers. A group has one or
+more group policies, created with MsgCreateGroupPolicy. Each group policy owns
+a new account, whose address is derived from the group module, and a decision
+policy: ThresholdDecisionPolicy accepts a proposal once the weight of the yes
+votes reaches a threshold, while PercentageDecisionPolicy requires a
+percentage of the total weight of the group.
+
+Group members submit proposals holding sdk.Msgs signed by a group policy
+account with MsgSubmitProposal, and vote on them with MsgVote. Once accepted,
+anyone can execute a proposal with MsgExec: its Msgs are then dispatched to
+their Msg services through the baseapp.MsgServiceRouter. Updating a group or a
+group policy makes the proposals still open for voting obsolete, and they are
+aborted when executed.
+*/
+package group
@@ -0,0 +1,71 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/line/lfb-sdk/types"
+	"github.com/line/lfb-sdk/x/group/types"
+)
+
+// InitGenesis initializes the group module's state from a provided genesis
+// state. The group policy accounts are expected to be part of the genesis of
+// the auth module.
+func (k Keeper) InitGenesis(ctx sdk.Context, gs *types.GenesisState) {
+	if err := gs.Validate(); err != nil {
+		panic(fmt.Sprintf("failed to validate %s genesis state: %s", types.ModuleName, err))
+	}
+
+	k.setSequence(ctx, types.GroupSeqKey, gs.GroupSeq)
+	for _, group := range gs.Groups {
+		k.setGroupInfo(ctx, group)
+	}
+	for _, member := range gs.GroupMembers {
+		k.setGroupMember(ctx, member)
+	}
+
+	k.setSequence(ctx, types.GroupPolicySeqKey, gs.GroupPolicySeq)
+	for _, policy := range gs.GroupPolicies {
+		k.setGroupPolicyInfo(ctx, policy)
+	}
+
+	k.setSequence(ctx, types.ProposalSeqKey, gs.ProposalSeq)
+	for _, proposal := range gs.Proposals {
+		k.setProposal(ctx, proposal)
+	}
+	for _, vote := range gs.Votes {
+		k.setVote(ctx, vote)
+	}
+}
+
+// ExportGenesis returns the group module's exported genesis.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	gs := types.NewGenesisState()
+
+	gs.GroupSeq = k.getSequence(ctx, types.GroupSeqKey)
+	k.IterateGroups(ctx, func(group types.GroupInfo) bool {
+		gs.Groups = append(gs.Groups, group)
+		return false
+	})
+	k.IterateGroupMembers(ctx, func(member types.GroupMember) bool {
+		gs.GroupMembers = append(gs.GroupMembers, member)
+		return false
+	})
+
+	gs.GroupPolicySeq = k.getSequence(ctx, types.GroupPolicySeqKey)
+	k.IterateGroupPolicies(ctx, func(policy types.GroupPolicyInfo) bool {
+		gs.GroupPolicies = append(gs.GroupPolicies, policy)
+		return false
+	})
+
+	gs.ProposalSeq = k.getSequence(ctx, types.ProposalSeqKey)
+	k.IterateProposals(ctx, func(proposal types.Proposal) bool {
+		gs.Proposals = append(gs.Proposals, proposal)
+		return false
+	})
+	k.IterateVotes(ctx, func(vote types.Vote) bool {
+		gs.Votes = append(gs.Votes, vote)
+		return false
+	})
+
+	return gs
+}
@@ -0,0 +1,68 @@
+package keeper_test
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/line/lfb-sdk/types"
+	"github.com/line/lfb-sdk/x/auth"
+	authtypes "github.com/line/lfb-sdk/x/auth/types"
+	banktypes "github.com/line/lfb-sdk/x/bank/types"
+	"github.com/line/lfb-sdk/x/group/types"
+)
+
+func (s *TestSuite) TestImportExportGenesis() {
+	groupID := s.createGroup("1", "2")
+	policyAddr := s.createGroupPolicy(groupID, types.NewThresholdDecisionPolicy("2", time.Hour))
+	send := banktypes.NewMsgSend(policyAddr, s.addrs[3], sdk.NewCoins(sdk.NewInt64Coin("stake", 100)))
+	proposalID, err := s.submitProposal(policyAddr, s.addrs[0], types.ExecUnspecified, send)
+	s.Require().NoError(err)
+	s.Require().NoError(s.vote(proposalID, s.addrs[0], types.VoteOptionYes, types.ExecUnspecified))
+
+	genesis := s.app.GroupKeeper.ExportGenesis(s.ctx)
+	s.Require().NoError(genesis.Validate())
+	s.Require().Equal(uint64(1), genesis.GroupSeq)
+	s.Require().Len(genesis.GroupMembers, 2)
+	s.Require().Len(genesis.GroupPolicies, 1)
+	s.Require().Len(genesis.Proposals, 1)
+	s.Require().Len(genesis.Votes, 1)
+
+	s.SetupTest()
+	s.app.GroupKeeper.InitGenesis(s.ctx, genesis)
+	s.Require().Equal(genesis, s.app.GroupKeeper.ExportGenesis(s.ctx))
+
+	s.T().Log("the sequences are restored")
+	s.Require().Equal(groupID+1, s.createGroup("1"))
+}
+
+func (s *TestSuite) TestExportGroupPolicyAccounts() {
+	groupID := s.createGroup("1")
+	policyAddr := s.createGroupPolicy(groupID, types.NewThresholdDecisionPolicy("1", time.Hour))
+
+	account, ok := s.app.AccountKeeper.GetAccount(s.ctx, policyAddr).(*authtypes.ModuleAccount)
+	s.Require().True(ok)
+	s.Require().Equal(fmt.Sprintf("%s/1", types.ModuleName), account.Name)
+
+	s.T().Log("the exported policy accounts are valid genesis accounts")
+	authGenesis := auth.ExportGenesis(s.ctx, s.app.AccountKeeper)
+	s.Require().NoError(authtypes.ValidateGenesis(*authGenesis))
+	groupGenesis := s.app.GroupKeeper.ExportGenesis(s.ctx)
+	s.Require().NoError(groupGenesis.Validate())
+
+	s.SetupTest()
+	auth.InitGenesis(s.ctx, s.app.AccountKeeper, *authGenesis)
+	s.app.GroupKeeper.InitGenesis(s.ctx, groupGenesis)
+	imported, ok := s.app.AccountKeeper.GetAccount(s.ctx, policyAddr).(*authtypes.ModuleAccount)
+	s.Require().True(ok)
+	s.Require().Equal(account.Name, imported.Name)
+	s.Require().NoError(authtypes.ValidateGenesis(*auth.ExportGenesis(s.ctx, s.app.AccountKeeper)))
+}
+
+func (s *TestSuite) TestInitInvalidGenesis() {
+	genesis := &types.GenesisState{
+		Groups: []types.GroupInfo{{GroupId: 1, Admin: s.addrs[0].String(), TotalWeight: "1"}},
+	}
+	s.Require().Panics(func() {
+		s.app.GroupKeeper.InitGenesis(s.ctx, genesis)
+	})
+}
@@ -0,0 +1,231 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/line/lfb-sdk/store/prefix"
+	sdk "github.com/line/lfb-sdk/types"
+	"github.com/line/lfb-sdk/types/query"
+	"github.com/line/lfb-sdk/x/group/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// GroupInfo implements the Query/GroupInfo gRPC method.
+func (k Keeper) GroupInfo(c context.Context, req *types.QueryGroupInfoRequest) (*types.QueryGroupInfoResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	group, err := k.GetGroupInfo(ctx, req.GroupId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryGroupInfoResponse{Info: &group}, nil
+}
+
+// GroupPolicyInfo implements the Query/GroupPolicyInfo gRPC method.
+func (k Keeper) GroupPolicyInfo(c context.Context, req *types.QueryGroupPolicyInfoRequest) (*types.QueryGroupPolicyInfoResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+	address, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, err
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	policy, err := k.GetGroupPolicyInfo(ctx, address)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryGroupPolicyInfoResponse{Info: &policy}, nil
+}
+
+// GroupMembers implements the Query/GroupMembers gRPC method.
+func (k Keeper) GroupMembers(c context.Context, req *types.QueryGroupMembersRequest) (*types.QueryGroupMembersResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	membersStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.GroupMembersPrefix(req.GroupId))
+
+	var members []*types.GroupMember
+	pageRes, err := query.Paginate(membersStore, req.Pagination, func(key []byte, value []byte) error {
+		var member types.GroupMember
+		if err := k.cdc.UnmarshalBinaryBare(value, &member); err != nil {
+			return err
+		}
+		members = append(members, &member)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryGroupMembersResponse{
+		Members:    members,
+		Pagination: pageRes,
+	}, nil
+}
+
+// GroupsByAdmin implements the Query/GroupsByAdmin gRPC method.
+func (k Keeper) GroupsByAdmin(c context.Context, req *types.QueryGroupsByAdminRequest) (*types.QueryGroupsByAdminResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+	admin, err := sdk.AccAddressFromBech32(req.Admin)
+	if err != nil {
+		return nil, err
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.GroupsByAdminPrefix(admin))
+
+	var groups []*types.GroupInfo
+	pageRes, err := query.Paginate(indexStore, req.Pagination, func(key []byte, _ []byte) error {
+		group, err := k.GetGroupInfo(ctx, types.GetIDFromBytes(key))
+		if err != nil {
+			return err
+		}
+		groups = append(groups, &group)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryGroupsByAdminResponse{
+		Groups:     groups,
+		Pagination: pageRes,
+	}, nil
+}
+
+// GroupPoliciesByGroup implements the Query/GroupPoliciesByGroup gRPC method.
+func (k Keeper) GroupPoliciesByGroup(c context.Context, req *types.QueryGroupPoliciesByGroupRequest) (*types.QueryGroupPoliciesByGroupResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.GroupPoliciesByGroupPrefix(req.GroupId))
+
+	var policies []*types.GroupPolicyInfo
+	pageRes, err := query.Paginate(indexStore, req.Pagination, func(key []byte, _ []byte) error {
+		policy, err := k.GetGroupPolicyInfo(ctx, sdk.AccAddress(key))
+		if err != nil {
+			return err
+		}
+		policies = append(policies, &policy)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryGroupPoliciesByGroupResponse{
+		GroupPolicies: policies,
+		Pagination:    pageRes,
+	}, nil
+}
+
+// Proposal implements the Query/Proposal gRPC method.
+func (k Keeper) Proposal(c context.Context, req *types.QueryProposalRequest) (*types.QueryProposalResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	proposal, err := k.GetProposal(ctx, req.ProposalId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryProposalResponse{Proposal: &proposal}, nil
+}
+
+// ProposalsByGroupPolicy implements the Query/ProposalsByGroupPolicy gRPC method.
+func (k Keeper) ProposalsByGroupPolicy(c context.Context, req *types.QueryProposalsByGroupPolicyRequest) (*types.QueryProposalsByGroupPolicyResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+	address, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, err
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.ProposalsByGroupPolicyPrefix(address))
+
+	var proposals []*types.Proposal
+	pageRes, err := query.Paginate(indexStore, req.Pagination, func(key []byte, _ []byte) error {
+		proposal, err := k.GetProposal(ctx, types.GetIDFromBytes(key))
+		if err != nil {
+			return err
+		}
+		proposals = append(proposals, &proposal)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryProposalsByGroupPolicyResponse{
+		Proposals:  proposals,
+		Pagination: pageRes,
+	}, nil
+}
+
+// VoteByProposalVoter implements the Query/VoteByProposalVoter gRPC method.
+func (k Keeper) VoteByProposalVoter(c context.Context, req *types.QueryVoteByProposalVoterRequest) (*types.QueryVoteByProposalVoterResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+	voter, err := sdk.AccAddressFromBech32(req.Voter)
+	if err != nil {
+		return nil, err
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	vote, err := k.GetVote(ctx, req.ProposalId, voter)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryVoteByProposalVoterResponse{Vote: &vote}, nil
+}
+
+// VotesByProposal implements the Query/VotesByProposal gRPC method.
+func (k Keeper) VotesByProposal(c context.Context, req *types.QueryVotesByProposalRequest) (*types.QueryVotesByProposalResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	votesStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.VotesByProposalPrefix(req.ProposalId))
+
+	var votes []*types.Vote
+	pageRes, err := query.Paginate(votesStore, req.Pagination, func(key []byte, value []byte) error {
+		var vote types.Vote
+		if err := k.cdc.UnmarshalBinaryBare(value, &vote); err != nil {
+			return err
+		}
+		votes = append(votes, &vote)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryVotesByProposalResponse{
+		Votes:      votes,
+		Pagination: pageRes,
+	}, nil
+}
@@ -0,0 +1,256 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/line/ostracon/libs/log"
+
+	"github.com/line/lfb-sdk/baseapp"
+	"github.com/line/lfb-sdk/codec"
+	sdk "github.com/line/lfb-sdk/types"
+	sdkerrors "github.com/line/lfb-sdk/types/errors"
+	authtypes "github.com/line/lfb-sdk/x/auth/types"
+	"github.com/line/lfb-sdk/x/group/types"
+)
+
+// Keeper manages the groups, their group policy accounts and the proposals
+// voted on by the group members.
+type Keeper struct {
+	storeKey  sdk.StoreKey
+	cdc       codec.BinaryMarshaler
+	accKeeper types.AccountKeeper
+	router    *baseapp.MsgServiceRouter
+}
+
+// NewKeeper constructs a group Keeper
+func NewKeeper(storeKey sdk.StoreKey, cdc codec.BinaryMarshaler, accKeeper types.AccountKeeper, router *baseapp.MsgServiceRouter) Keeper {
+	return Keeper{
+		storeKey:  storeKey,
+		cdc:       cdc,
+		accKeeper: accKeeper,
+		router:    router,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// getSequence returns the value of the sequence stored at key.
+func (k Keeper) getSequence(ctx sdk.Context, key []byte) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(key)
+	if bz == nil {
+		return 0
+	}
+	return types.GetIDFromBytes(bz)
+}
+
+// setSequence sets the value of the sequence stored at key.
+func (k Keeper) setSequence(ctx sdk.Context, key []byte, seq uint64) {
+	ctx.KVStore(k.storeKey).Set(key, types.GetIDBytes(seq))
+}
+
+// nextSequence increments the sequence stored at key and returns its new value.
+func (k Keeper) nextSequence(ctx sdk.Context, key []byte) uint64 {
+	seq := k.getSequence(ctx, key) + 1
+	k.setSequence(ctx, key, seq)
+	return seq
+}
+
+// GetGroupInfo returns the group with the given id.
+func (k Keeper) GetGroupInfo(ctx sdk.Context, groupID uint64) (types.GroupInfo, error) {
+	var group types.GroupInfo
+	bz := ctx.KVStore(k.storeKey).Get(types.GroupKey(groupID))
+	if bz == nil {
+		return group, sdkerrors.Wrapf(types.ErrNotFound, "group %d", groupID)
+	}
+	k.cdc.MustUnmarshalBinaryBare(bz, &group)
+	return group, nil
+}
+
+// setGroupInfo stores the group and indexes it by its admin.
+func (k Keeper) setGroupInfo(ctx sdk.Context, group types.GroupInfo) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GroupKey(group.GroupId), k.cdc.MustMarshalBinaryBare(&group))
+	store.Set(types.GroupByAdminKey(mustAccAddress(group.Admin), group.GroupId), []byte{})
+}
+
+// IterateGroups iterates over all the groups until cb returns true.
+func (k Keeper) IterateGroups(ctx sdk.Context, cb func(group types.GroupInfo) (stop bool)) {
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.GroupKeyPrefix)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var group types.GroupInfo
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &group)
+		if cb(group) {
+			break
+		}
+	}
+}
+
+// GetGroupMember returns the member of a group with the given address.
+func (k Keeper) GetGroupMember(ctx sdk.Context, groupID uint64, member sdk.AccAddress) (types.GroupMember, error) {
+	var groupMember types.GroupMember
+	bz := ctx.KVStore(k.storeKey).Get(types.GroupMemberKey(groupID, member))
+	if bz == nil {
+		return groupMember, sdkerrors.Wrapf(types.ErrNotFound, "member %s of group %d", member, groupID)
+	}
+	k.cdc.MustUnmarshalBinaryBare(bz, &groupMember)
+	return groupMember, nil
+}
+
+func (k Keeper) setGroupMember(ctx sdk.Context, member types.GroupMember) {
+	key := types.GroupMemberKey(member.GroupId, mustAccAddress(member.Member.Address))
+	ctx.KVStore(k.storeKey).Set(key, k.cdc.MustMarshalBinaryBare(&member))
+}
+
+func (k Keeper) deleteGroupMember(ctx sdk.Context, groupID uint64, member sdk.AccAddress) {
+	ctx.KVStore(k.storeKey).Delete(types.GroupMemberKey(groupID, member))
+}
+
+// IterateGroupMembers iterates over the members of all the groups until cb
+// returns true.
+func (k Keeper) IterateGroupMembers(ctx sdk.Context, cb func(member types.GroupMember) (stop bool)) {
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.GroupMemberKeyPrefix)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var member types.GroupMember
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &member)
+		if cb(member) {
+			break
+		}
+	}
+}
+
+// GetGroupPolicyInfo returns the group policy with the given account address.
+func (k Keeper) GetGroupPolicyInfo(ctx sdk.Context, address sdk.AccAddress) (types.GroupPolicyInfo, error) {
+	var policy types.GroupPolicyInfo
+	bz := ctx.KVStore(k.storeKey).Get(types.GroupPolicyKey(address))
+	if bz == nil {
+		return policy, sdkerrors.Wrapf(types.ErrNotFound, "group policy %s", address)
+	}
+	if err := k.cdc.UnmarshalBinaryBare(bz, &policy); err != nil {
+		return policy, err
+	}
+	return policy, nil
+}
+
+// setGroupPolicyInfo stores the group policy and indexes it by its group.
+func (k Keeper) setGroupPolicyInfo(ctx sdk.Context, policy types.GroupPolicyInfo) {
+	store := ctx.KVStore(k.storeKey)
+	address := mustAccAddress(policy.Address)
+	store.Set(types.GroupPolicyKey(address), k.cdc.MustMarshalBinaryBare(&policy))
+	store.Set(types.GroupPolicyByGroupKey(policy.GroupId, address), []byte{})
+}
+
+// IterateGroupPolicies iterates over all the group policies until cb returns
+// true.
+func (k Keeper) IterateGroupPolicies(ctx sdk.Context, cb func(policy types.GroupPolicyInfo) (stop bool)) {
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.GroupPolicyKeyPrefix)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var policy types.GroupPolicyInfo
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &policy)
+		if cb(policy) {
+			break
+		}
+	}
+}
+
+// createGroupPolicyAccount creates the module account of a new group policy.
+// It is named after the number of group policies created so far, e.g.
+// "group/1", which its address is derived from, and addresses already used by
+// other accounts are skipped.
+func (k Keeper) createGroupPolicyAccount(ctx sdk.Context) sdk.AccAddress {
+	for {
+		seq := k.nextSequence(ctx, types.GroupPolicySeqKey)
+		name := fmt.Sprintf("%s/%d", types.ModuleName, seq)
+		address := authtypes.NewModuleAddress(name)
+		if k.accKeeper.GetAccount(ctx, address) != nil {
+			continue
+		}
+
+		account := k.accKeeper.NewAccount(ctx, authtypes.NewModuleAccount(
+			authtypes.NewBaseAccountWithAddress(address), name,
+		))
+		k.accKeeper.SetAccount(ctx, account)
+		return address
+	}
+}
+
+// GetProposal returns the proposal with the given id.
+func (k Keeper) GetProposal(ctx sdk.Context, proposalID uint64) (types.Proposal, error) {
+	var proposal types.Proposal
+	bz := ctx.KVStore(k.storeKey).Get(types.ProposalKey(proposalID))
+	if bz == nil {
+		return proposal, sdkerrors.Wrapf(types.ErrNotFound, "proposal %d", proposalID)
+	}
+	if err := k.cdc.UnmarshalBinaryBare(bz, &proposal); err != nil {
+		return proposal, err
+	}
+	return proposal, nil
+}
+
+// setProposal stores the proposal and indexes it by its group policy.
+func (k Keeper) setProposal(ctx sdk.Context, proposal types.Proposal) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ProposalKey(proposal.ProposalId), k.cdc.MustMarshalBinaryBare(&proposal))
+	store.Set(types.ProposalByGroupPolicyKey(mustAccAddress(proposal.Address), proposal.ProposalId), []byte{})
+}
+
+// IterateProposals iterates over all the proposals until cb returns true.
+func (k Keeper) IterateProposals(ctx sdk.Context, cb func(proposal types.Proposal) (stop bool)) {
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.ProposalKeyPrefix)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var proposal types.Proposal
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &proposal)
+		if cb(proposal) {
+			break
+		}
+	}
+}
+
+// GetVote returns the vote of a voter on a proposal.
+func (k Keeper) GetVote(ctx sdk.Context, proposalID uint64, voter sdk.AccAddress) (types.Vote, error) {
+	var vote types.Vote
+	bz := ctx.KVStore(k.storeKey).Get(types.VoteKey(proposalID, voter))
+	if bz == nil {
+		return vote, sdkerrors.Wrapf(types.ErrNotFound, "vote of %s on proposal %d", voter, proposalID)
+	}
+	k.cdc.MustUnmarshalBinaryBare(bz, &vote)
+	return vote, nil
+}
+
+func (k Keeper) setVote(ctx sdk.Context, vote types.Vote) {
+	key := types.VoteKey(vote.ProposalId, mustAccAddress(vote.Voter))
+	ctx.KVStore(k.storeKey).Set(key, k.cdc.MustMarshalBinaryBare(&vote))
+}
+
+// IterateVotes iterates over the votes on all the proposals until cb returns
+// true.
+func (k Keeper) IterateVotes(ctx sdk.Context, cb func(vote types.Vote) (stop bool)) {
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.VoteKeyPrefix)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var vote types.Vote
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &vote)
+		if cb(vote) {
+			break
+		}
+	}
+}
+
+func mustAccAddress(address string) sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(address)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
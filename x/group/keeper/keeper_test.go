@@ -0,0 +1,430 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	ostproto "github.com/line/ostracon/proto/ostracon/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/line/lfb-sdk/baseapp"
+	"github.com/line/lfb-sdk/simapp"
+	sdk "github.com/line/lfb-sdk/types"
+	banktypes "github.com/line/lfb-sdk/x/bank/types"
+	"github.com/line/lfb-sdk/x/group/keeper"
+	"github.com/line/lfb-sdk/x/group/types"
+)
+
+type TestSuite struct {
+	suite.Suite
+
+	app         *simapp.SimApp
+	ctx         sdk.Context
+	addrs       []sdk.AccAddress
+	msgServer   types.MsgServer
+	queryClient types.QueryClient
+}
+
+func TestTestSuite(t *testing.T) {
+	suite.Run(t, new(TestSuite))
+}
+
+func (s *TestSuite) SetupTest() {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, ostproto.Header{Time: time.Now().UTC()})
+
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, app.GroupKeeper)
+
+	s.app = app
+	s.ctx = ctx
+	s.msgServer = keeper.NewMsgServerImpl(app.GroupKeeper)
+	s.queryClient = types.NewQueryClient(queryHelper)
+	s.addrs = simapp.AddTestAddrsIncremental(app, ctx, 4, sdk.NewInt(30000000))
+}
+
+// createGroup creates a group of the first three test addresses with the
+// given weights, administered by the last one.
+func (s *TestSuite) createGroup(weights ...string) uint64 {
+	members := make([]types.Member, len(weights))
+	for i, weight := range weights {
+		members[i] = types.Member{Address: s.addrs[i].String(), Weight: weight}
+	}
+	res, err := s.msgServer.CreateGroup(sdk.WrapSDKContext(s.ctx), &types.MsgCreateGroup{
+		Admin:   s.addrs[3].String(),
+		Members: members,
+	})
+	s.Require().NoError(err)
+	return res.GroupId
+}
+
+func (s *TestSuite) createGroupPolicy(groupID uint64, policy types.DecisionPolicy) sdk.AccAddress {
+	msg, err := types.NewMsgCreateGroupPolicy(s.addrs[3], groupID, nil, policy)
+	s.Require().NoError(err)
+	res, err := s.msgServer.CreateGroupPolicy(sdk.WrapSDKContext(s.ctx), msg)
+	s.Require().NoError(err)
+	address, err := sdk.AccAddressFromBech32(res.Address)
+	s.Require().NoError(err)
+	return address
+}
+
+func (s *TestSuite) submitProposal(policyAddr sdk.AccAddress, proposer sdk.AccAddress, exec types.Exec, msgs ...sdk.Msg) (uint64, error) {
+	msg, err := types.NewMsgSubmitProposal(policyAddr, []string{proposer.String()}, msgs, nil, exec)
+	s.Require().NoError(err)
+	res, err := s.msgServer.SubmitProposal(sdk.WrapSDKContext(s.ctx), msg)
+	if err != nil {
+		return 0, err
+	}
+	return res.ProposalId, nil
+}
+
+func (s *TestSuite) vote(proposalID uint64, voter sdk.AccAddress, option types.VoteOption, exec types.Exec) error {
+	_, err := s.msgServer.Vote(sdk.WrapSDKContext(s.ctx), &types.MsgVote{
+		ProposalId: proposalID,
+		Voter:      voter.String(),
+		Option:     option,
+		Exec:       exec,
+	})
+	return err
+}
+
+func (s *TestSuite) TestCreateGroup() {
+	groupID := s.createGroup("1", "2")
+	s.Require().Equal(uint64(1), groupID)
+
+	group, err := s.app.GroupKeeper.GetGroupInfo(s.ctx, groupID)
+	s.Require().NoError(err)
+	s.Require().Equal(s.addrs[3].String(), group.Admin)
+	s.Require().Equal(uint64(1), group.Version)
+	s.Require().Equal(sdk.NewDec(3).String(), group.TotalWeight)
+
+	member, err := s.app.GroupKeeper.GetGroupMember(s.ctx, groupID, s.addrs[1])
+	s.Require().NoError(err)
+	s.Require().Equal("2", member.Member.Weight)
+
+	_, err = s.app.GroupKeeper.GetGroupInfo(s.ctx, groupID+1)
+	s.Require().ErrorIs(err, types.ErrNotFound)
+
+	s.Require().Equal(uint64(2), s.createGroup("1"))
+}
+
+func (s *TestSuite) TestUpdateGroupMembers() {
+	groupID := s.createGroup("1", "2")
+	goCtx := sdk.WrapSDKContext(s.ctx)
+
+	s.T().Log("only the group admin can update the members")
+	msg := &types.MsgUpdateGroupMembers{
+		Admin:   s.addrs[0].String(),
+		GroupId: groupID,
+		MemberUpdates: []types.Member{
+			{Address: s.addrs[0].String(), Weight: "0"},
+			{Address: s.addrs[1].String(), Weight: "3"},
+			{Address: s.addrs[2].String(), Weight: "5"},
+		},
+	}
+	_, err := s.msgServer.UpdateGroupMembers(goCtx, msg)
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	s.T().Log("members are added, updated and removed")
+	msg.Admin = s.addrs[3].String()
+	_, err = s.msgServer.UpdateGroupMembers(goCtx, msg)
+	s.Require().NoError(err)
+
+	group, err := s.app.GroupKeeper.GetGroupInfo(s.ctx, groupID)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(2), group.Version)
+	s.Require().Equal(sdk.NewDec(8).String(), group.TotalWeight)
+	_, err = s.app.GroupKeeper.GetGroupMember(s.ctx, groupID, s.addrs[0])
+	s.Require().ErrorIs(err, types.ErrNotFound)
+
+	res, err := s.queryClient.GroupMembers(goCtx, &types.QueryGroupMembersRequest{GroupId: groupID})
+	s.Require().NoError(err)
+	s.Require().Len(res.Members, 2)
+
+	s.T().Log("removing a missing member fails")
+	msg.MemberUpdates = []types.Member{{Address: s.addrs[0].String(), Weight: "0"}}
+	_, err = s.msgServer.UpdateGroupMembers(goCtx, msg)
+	s.Require().ErrorIs(err, types.ErrNotFound)
+}
+
+func (s *TestSuite) TestUpdateGroupAdmin() {
+	groupID := s.createGroup("1")
+	goCtx := sdk.WrapSDKContext(s.ctx)
+
+	_, err := s.msgServer.UpdateGroupAdmin(goCtx, &types.MsgUpdateGroupAdmin{
+		Admin:    s.addrs[3].String(),
+		GroupId:  groupID,
+		NewAdmin: s.addrs[0].String(),
+	})
+	s.Require().NoError(err)
+
+	res, err := s.queryClient.GroupsByAdmin(goCtx, &types.QueryGroupsByAdminRequest{Admin: s.addrs[3].String()})
+	s.Require().NoError(err)
+	s.Require().Empty(res.Groups)
+	res, err = s.queryClient.GroupsByAdmin(goCtx, &types.QueryGroupsByAdminRequest{Admin: s.addrs[0].String()})
+	s.Require().NoError(err)
+	s.Require().Len(res.Groups, 1)
+	s.Require().Equal(groupID, res.Groups[0].GroupId)
+	s.Require().Equal(uint64(2), res.Groups[0].Version)
+}
+
+func (s *TestSuite) TestCreateGroupPolicy() {
+	groupID := s.createGroup("1", "2")
+	policy := types.NewThresholdDecisionPolicy("2", time.Hour)
+
+	s.T().Log("only the group admin can create a group policy")
+	msg, err := types.NewMsgCreateGroupPolicy(s.addrs[0], groupID, nil, policy)
+	s.Require().NoError(err)
+	_, err = s.msgServer.CreateGroupPolicy(sdk.WrapSDKContext(s.ctx), msg)
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	s.T().Log("each group policy has its own account")
+	addr1 := s.createGroupPolicy(groupID, policy)
+	addr2 := s.createGroupPolicy(groupID, policy)
+	s.Require().NotEqual(addr1, addr2)
+	s.Require().NotNil(s.app.AccountKeeper.GetAccount(s.ctx, addr1))
+	s.Require().NotNil(s.app.AccountKeeper.GetAccount(s.ctx, addr2))
+
+	res, err := s.queryClient.GroupPolicyInfo(sdk.WrapSDKContext(s.ctx), &types.QueryGroupPolicyInfoRequest{Address: addr1.String()})
+	s.Require().NoError(err)
+	s.Require().Equal(groupID, res.Info.GroupId)
+	s.Require().Equal(policy, res.Info.GetDecisionPolicy())
+
+	policies, err := s.queryClient.GroupPoliciesByGroup(sdk.WrapSDKContext(s.ctx), &types.QueryGroupPoliciesByGroupRequest{GroupId: groupID})
+	s.Require().NoError(err)
+	s.Require().Len(policies.GroupPolicies, 2)
+}
+
+func (s *TestSuite) TestSubmitProposal() {
+	groupID := s.createGroup("1", "2")
+	policyAddr := s.createGroupPolicy(groupID, types.NewThresholdDecisionPolicy("2", time.Hour))
+	send := banktypes.NewMsgSend(policyAddr, s.addrs[3], sdk.NewCoins(sdk.NewInt64Coin("stake", 100)))
+
+	s.T().Log("proposers must be group members")
+	_, err := s.submitProposal(policyAddr, s.addrs[3], types.ExecUnspecified, send)
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	s.T().Log("messages must be signed by the group policy account")
+	_, err = s.submitProposal(policyAddr, s.addrs[0], types.ExecUnspecified,
+		banktypes.NewMsgSend(s.addrs[0], s.addrs[3], sdk.NewCoins(sdk.NewInt64Coin("stake", 100))))
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	proposalID, err := s.submitProposal(policyAddr, s.addrs[0], types.ExecUnspecified, send)
+	s.Require().NoError(err)
+
+	res, err := s.queryClient.Proposal(sdk.WrapSDKContext(s.ctx), &types.QueryProposalRequest{ProposalId: proposalID})
+	s.Require().NoError(err)
+	proposal := res.Proposal
+	s.Require().Equal(types.ProposalStatusSubmitted, proposal.Status)
+	s.Require().Equal(types.ProposalResultUnfinalized, proposal.Result)
+	s.Require().Equal(types.ProposalExecutorResultNotRun, proposal.ExecutorResult)
+	s.Require().Equal(s.ctx.BlockTime().Add(time.Hour), proposal.VotingPeriodEnd)
+	msgs, err := proposal.GetMessages()
+	s.Require().NoError(err)
+	s.Require().Equal([]sdk.Msg{send}, msgs)
+
+	proposals, err := s.queryClient.ProposalsByGroupPolicy(sdk.WrapSDKContext(s.ctx), &types.QueryProposalsByGroupPolicyRequest{Address: policyAddr.String()})
+	s.Require().NoError(err)
+	s.Require().Len(proposals.Proposals, 1)
+}
+
+func (s *TestSuite) TestVoteAndExec() {
+	groupID := s.createGroup("1", "2", "3")
+	policyAddr := s.createGroupPolicy(groupID, types.NewThresholdDecisionPolicy("3", time.Hour))
+	coins := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+	s.Require().NoError(s.app.BankKeeper.SendCoins(s.ctx, s.addrs[0], policyAddr, coins))
+	send := banktypes.NewMsgSend(policyAddr, s.addrs[3], coins)
+	goCtx := sdk.WrapSDKContext(s.ctx)
+
+	proposalID, err := s.submitProposal(policyAddr, s.addrs[0], types.ExecUnspecified, send)
+	s.Require().NoError(err)
+
+	s.T().Log("only group members can vote, once")
+	s.Require().ErrorIs(s.vote(proposalID, s.addrs[3], types.VoteOptionYes, types.ExecUnspecified), types.ErrUnauthorized)
+	s.Require().NoError(s.vote(proposalID, s.addrs[0], types.VoteOptionYes, types.ExecUnspecified))
+	s.Require().ErrorIs(s.vote(proposalID, s.addrs[0], types.VoteOptionNo, types.ExecUnspecified), types.ErrDuplicate)
+
+	s.T().Log("a proposal which isn't accepted yet isn't executed")
+	_, err = s.msgServer.Exec(goCtx, &types.MsgExec{ProposalId: proposalID, Signer: s.addrs[3].String()})
+	s.Require().NoError(err)
+	proposal, err := s.app.GroupKeeper.GetProposal(s.ctx, proposalID)
+	s.Require().NoError(err)
+	s.Require().Equal(types.ProposalStatusSubmitted, proposal.Status)
+	s.Require().Equal(types.ProposalExecutorResultNotRun, proposal.ExecutorResult)
+
+	s.T().Log("the proposal is accepted once the threshold is reached")
+	s.Require().NoError(s.vote(proposalID, s.addrs[1], types.VoteOptionYes, types.ExecUnspecified))
+	proposal, err = s.app.GroupKeeper.GetProposal(s.ctx, proposalID)
+	s.Require().NoError(err)
+	s.Require().Equal(types.ProposalStatusClosed, proposal.Status)
+	s.Require().Equal(types.ProposalResultAccepted, proposal.Result)
+	s.Require().Equal(sdk.NewDec(3).String(), proposal.FinalTallyResult.YesCount)
+	s.Require().ErrorIs(s.vote(proposalID, s.addrs[2], types.VoteOptionNo, types.ExecUnspecified), types.ErrInvalid)
+
+	votes, err := s.queryClient.VotesByProposal(goCtx, &types.QueryVotesByProposalRequest{ProposalId: proposalID})
+	s.Require().NoError(err)
+	s.Require().Len(votes.Votes, 2)
+
+	s.T().Log("anyone can execute an accepted proposal")
+	_, err = s.msgServer.Exec(goCtx, &types.MsgExec{ProposalId: proposalID, Signer: s.addrs[3].String()})
+	s.Require().NoError(err)
+	proposal, err = s.app.GroupKeeper.GetProposal(s.ctx, proposalID)
+	s.Require().NoError(err)
+	s.Require().Equal(types.ProposalExecutorResultSuccess, proposal.ExecutorResult)
+	s.Require().True(s.app.BankKeeper.GetAllBalances(s.ctx, policyAddr).IsZero())
+
+	s.T().Log("a proposal is executed only once")
+	balance := s.app.BankKeeper.GetAllBalances(s.ctx, s.addrs[3])
+	_, err = s.msgServer.Exec(goCtx, &types.MsgExec{ProposalId: proposalID, Signer: s.addrs[3].String()})
+	s.Require().NoError(err)
+	s.Require().Equal(balance, s.app.BankKeeper.GetAllBalances(s.ctx, s.addrs[3]))
+}
+
+func (s *TestSuite) TestSubmitProposalExecTryMultipleProposers() {
+	groupID := s.createGroup("1", "1")
+	policyAddr := s.createGroupPolicy(groupID, types.NewThresholdDecisionPolicy("1", time.Hour))
+	coins := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+	s.Require().NoError(s.app.BankKeeper.SendCoins(s.ctx, s.addrs[0], policyAddr, coins))
+	send := banktypes.NewMsgSend(policyAddr, s.addrs[3], coins)
+
+	s.T().Log("the vote of the first proposer decides the proposal, the others don't vote")
+	msg, err := types.NewMsgSubmitProposal(policyAddr, []string{s.addrs[0].String(), s.addrs[1].String()}, []sdk.Msg{send}, nil, types.ExecTry)
+	s.Require().NoError(err)
+	res, err := s.msgServer.SubmitProposal(sdk.WrapSDKContext(s.ctx), msg)
+	s.Require().NoError(err)
+
+	proposal, err := s.app.GroupKeeper.GetProposal(s.ctx, res.ProposalId)
+	s.Require().NoError(err)
+	s.Require().Equal(types.ProposalResultAccepted, proposal.Result)
+	s.Require().Equal(types.ProposalExecutorResultSuccess, proposal.ExecutorResult)
+	s.Require().Equal("1.000000000000000000", proposal.FinalTallyResult.YesCount)
+	_, err = s.app.GroupKeeper.GetVote(s.ctx, res.ProposalId, s.addrs[1])
+	s.Require().Error(err)
+	s.Require().True(s.app.BankKeeper.GetAllBalances(s.ctx, policyAddr).IsZero())
+}
+
+func (s *TestSuite) TestExecFailure() {
+	groupID := s.createGroup("1")
+	policyAddr := s.createGroupPolicy(groupID, types.NewPercentageDecisionPolicy("0.5", time.Hour))
+	coins := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+	send := banktypes.NewMsgSend(policyAddr, s.addrs[3], coins)
+
+	s.T().Log("a failed execution is recorded and can be tried again")
+	proposalID, err := s.submitProposal(policyAddr, s.addrs[0], types.ExecTry, send)
+	s.Require().NoError(err)
+	proposal, err := s.app.GroupKeeper.GetProposal(s.ctx, proposalID)
+	s.Require().NoError(err)
+	s.Require().Equal(types.ProposalResultAccepted, proposal.Result)
+	s.Require().Equal(types.ProposalExecutorResultFailure, proposal.ExecutorResult)
+
+	s.Require().NoError(s.app.BankKeeper.SendCoins(s.ctx, s.addrs[0], policyAddr, coins))
+	_, err = s.msgServer.Exec(sdk.WrapSDKContext(s.ctx), &types.MsgExec{ProposalId: proposalID, Signer: s.addrs[0].String()})
+	s.Require().NoError(err)
+	proposal, err = s.app.GroupKeeper.GetProposal(s.ctx, proposalID)
+	s.Require().NoError(err)
+	s.Require().Equal(types.ProposalExecutorResultSuccess, proposal.ExecutorResult)
+	s.Require().True(s.app.BankKeeper.GetAllBalances(s.ctx, policyAddr).IsZero())
+}
+
+func (s *TestSuite) TestRejectedProposal() {
+	groupID := s.createGroup("1", "1", "1")
+	policyAddr := s.createGroupPolicy(groupID, types.NewPercentageDecisionPolicy("0.5", time.Hour))
+	send := banktypes.NewMsgSend(policyAddr, s.addrs[3], sdk.NewCoins(sdk.NewInt64Coin("stake", 100)))
+
+	proposalID, err := s.submitProposal(policyAddr, s.addrs[0], types.ExecUnspecified, send)
+	s.Require().NoError(err)
+	s.Require().NoError(s.vote(proposalID, s.addrs[0], types.VoteOptionNo, types.ExecUnspecified))
+	s.Require().NoError(s.vote(proposalID, s.addrs[1], types.VoteOptionNoWithVeto, types.ExecUnspecified))
+
+	proposal, err := s.app.GroupKeeper.GetProposal(s.ctx, proposalID)
+	s.Require().NoError(err)
+	s.Require().Equal(types.ProposalStatusClosed, proposal.Status)
+	s.Require().Equal(types.ProposalResultRejected, proposal.Result)
+
+	_, err = s.msgServer.Exec(sdk.WrapSDKContext(s.ctx), &types.MsgExec{ProposalId: proposalID, Signer: s.addrs[0].String()})
+	s.Require().NoError(err)
+	proposal, err = s.app.GroupKeeper.GetProposal(s.ctx, proposalID)
+	s.Require().NoError(err)
+	s.Require().Equal(types.ProposalExecutorResultNotRun, proposal.ExecutorResult)
+}
+
+func (s *TestSuite) TestVotingPeriodEnd() {
+	groupID := s.createGroup("1", "1")
+	policyAddr := s.createGroupPolicy(groupID, types.NewThresholdDecisionPolicy("2", time.Hour))
+	send := banktypes.NewMsgSend(policyAddr, s.addrs[3], sdk.NewCoins(sdk.NewInt64Coin("stake", 100)))
+
+	proposalID, err := s.submitProposal(policyAddr, s.addrs[0], types.ExecUnspecified, send)
+	s.Require().NoError(err)
+	s.Require().NoError(s.vote(proposalID, s.addrs[0], types.VoteOptionYes, types.ExecUnspecified))
+
+	s.ctx = s.ctx.WithBlockTime(s.ctx.BlockTime().Add(time.Hour))
+	s.Require().ErrorIs(s.vote(proposalID, s.addrs[1], types.VoteOptionYes, types.ExecUnspecified), types.ErrExpired)
+
+	s.T().Log("a proposal not accepted at the end of the voting period is rejected")
+	_, err = s.msgServer.Exec(sdk.WrapSDKContext(s.ctx), &types.MsgExec{ProposalId: proposalID, Signer: s.addrs[0].String()})
+	s.Require().NoError(err)
+	proposal, err := s.app.GroupKeeper.GetProposal(s.ctx, proposalID)
+	s.Require().NoError(err)
+	s.Require().Equal(types.ProposalStatusClosed, proposal.Status)
+	s.Require().Equal(types.ProposalResultRejected, proposal.Result)
+}
+
+func (s *TestSuite) TestModifiedGroup() {
+	groupID := s.createGroup("1", "1")
+	policyAddr := s.createGroupPolicy(groupID, types.NewThresholdDecisionPolicy("2", time.Hour))
+	send := banktypes.NewMsgSend(policyAddr, s.addrs[3], sdk.NewCoins(sdk.NewInt64Coin("stake", 100)))
+	goCtx := sdk.WrapSDKContext(s.ctx)
+
+	proposalID, err := s.submitProposal(policyAddr, s.addrs[0], types.ExecUnspecified, send)
+	s.Require().NoError(err)
+
+	_, err = s.msgServer.UpdateGroupMetadata(goCtx, &types.MsgUpdateGroupMetadata{
+		Admin:    s.addrs[3].String(),
+		GroupId:  groupID,
+		Metadata: []byte("updated"),
+	})
+	s.Require().NoError(err)
+
+	s.T().Log("votes on the proposals of a modified group are rejected")
+	s.Require().ErrorIs(s.vote(proposalID, s.addrs[0], types.VoteOptionYes, types.ExecUnspecified), types.ErrModified)
+
+	s.T().Log("the proposals of a modified group are aborted")
+	_, err = s.msgServer.Exec(goCtx, &types.MsgExec{ProposalId: proposalID, Signer: s.addrs[0].String()})
+	s.Require().NoError(err)
+	proposal, err := s.app.GroupKeeper.GetProposal(s.ctx, proposalID)
+	s.Require().NoError(err)
+	s.Require().Equal(types.ProposalStatusAborted, proposal.Status)
+
+	s.T().Log("the proposals of a modified group policy are rejected too")
+	proposalID, err = s.submitProposal(policyAddr, s.addrs[0], types.ExecUnspecified, send)
+	s.Require().NoError(err)
+	msg, err := types.NewMsgUpdateGroupPolicyDecisionPolicy(s.addrs[3], policyAddr, types.NewThresholdDecisionPolicy("1", time.Hour))
+	s.Require().NoError(err)
+	_, err = s.msgServer.UpdateGroupPolicyDecisionPolicy(goCtx, msg)
+	s.Require().NoError(err)
+	s.Require().ErrorIs(s.vote(proposalID, s.addrs[0], types.VoteOptionYes, types.ExecUnspecified), types.ErrModified)
+}
+
+func (s *TestSuite) TestWithdrawProposal() {
+	groupID := s.createGroup("1", "1")
+	policyAddr := s.createGroupPolicy(groupID, types.NewThresholdDecisionPolicy("2", time.Hour))
+	send := banktypes.NewMsgSend(policyAddr, s.addrs[3], sdk.NewCoins(sdk.NewInt64Coin("stake", 100)))
+	goCtx := sdk.WrapSDKContext(s.ctx)
+
+	proposalID, err := s.submitProposal(policyAddr, s.addrs[0], types.ExecUnspecified, send)
+	s.Require().NoError(err)
+
+	s.T().Log("only the group policy admin or a proposer can withdraw a proposal")
+	_, err = s.msgServer.WithdrawProposal(goCtx, &types.MsgWithdrawProposal{ProposalId: proposalID, Address: s.addrs[1].String()})
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+	_, err = s.msgServer.WithdrawProposal(goCtx, &types.MsgWithdrawProposal{ProposalId: proposalID, Address: s.addrs[0].String()})
+	s.Require().NoError(err)
+
+	proposal, err := s.app.GroupKeeper.GetProposal(s.ctx, proposalID)
+	s.Require().NoError(err)
+	s.Require().Equal(types.ProposalStatusWithdrawn, proposal.Status)
+
+	_, err = s.msgServer.WithdrawProposal(goCtx, &types.MsgWithdrawProposal{ProposalId: proposalID, Address: s.addrs[3].String()})
+	s.Require().ErrorIs(err, types.ErrInvalid)
+	s.Require().ErrorIs(s.vote(proposalID, s.addrs[1], types.VoteOptionYes, types.ExecUnspecified), types.ErrInvalid)
+}
@@ -0,0 +1,327 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/line/lfb-sdk/types"
+	sdkerrors "github.com/line/lfb-sdk/types/errors"
+	"github.com/line/lfb-sdk/x/group/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the group MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(k Keeper) types.MsgServer {
+	return &msgServer{
+		Keeper: k,
+	}
+}
+
+var _ types.MsgServer = msgServer{}
+
+// CreateGroup implements the MsgServer.CreateGroup method to create a new group
+// with the given members.
+func (k msgServer) CreateGroup(goCtx context.Context, msg *types.MsgCreateGroup) (*types.MsgCreateGroupResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	groupID := k.nextSequence(ctx, types.GroupSeqKey)
+	totalWeight := sdk.ZeroDec()
+	for _, member := range msg.Members {
+		weight, err := types.ParseWeight(member.Weight)
+		if err != nil {
+			return nil, err
+		}
+		totalWeight = totalWeight.Add(weight)
+		k.setGroupMember(ctx, types.GroupMember{GroupId: groupID, Member: member})
+	}
+
+	k.setGroupInfo(ctx, types.GroupInfo{
+		GroupId:     groupID,
+		Admin:       msg.Admin,
+		Metadata:    msg.Metadata,
+		Version:     1,
+		TotalWeight: totalWeight.String(),
+	})
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeCreateGroup,
+		sdk.NewAttribute(types.AttributeKeyGroupID, fmt.Sprintf("%d", groupID)),
+	))
+
+	return &types.MsgCreateGroupResponse{GroupId: groupID}, nil
+}
+
+// UpdateGroupMembers implements the MsgServer.UpdateGroupMembers method. A
+// member whose weight is updated to 0 is removed from the group.
+func (k msgServer) UpdateGroupMembers(goCtx context.Context, msg *types.MsgUpdateGroupMembers) (*types.MsgUpdateGroupMembersResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	err := k.updateGroup(ctx, msg.GroupId, msg.Admin, func(group *types.GroupInfo) error {
+		totalWeight, err := types.ParseWeight(group.TotalWeight)
+		if err != nil {
+			return err
+		}
+
+		for _, update := range msg.MemberUpdates {
+			address := mustAccAddress(update.Address)
+			weight, err := types.ParseWeight(update.Weight)
+			if err != nil {
+				return err
+			}
+
+			if prev, err := k.GetGroupMember(ctx, group.GroupId, address); err == nil {
+				prevWeight, err := types.ParseWeight(prev.Member.Weight)
+				if err != nil {
+					return err
+				}
+				totalWeight = totalWeight.Sub(prevWeight)
+			} else if weight.IsZero() {
+				return err
+			}
+
+			if weight.IsZero() {
+				k.deleteGroupMember(ctx, group.GroupId, address)
+				continue
+			}
+			totalWeight = totalWeight.Add(weight)
+			k.setGroupMember(ctx, types.GroupMember{GroupId: group.GroupId, Member: update})
+		}
+
+		group.TotalWeight = totalWeight.String()
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateGroupMembersResponse{}, nil
+}
+
+// UpdateGroupAdmin implements the MsgServer.UpdateGroupAdmin method.
+func (k msgServer) UpdateGroupAdmin(goCtx context.Context, msg *types.MsgUpdateGroupAdmin) (*types.MsgUpdateGroupAdminResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	err := k.updateGroup(ctx, msg.GroupId, msg.Admin, func(group *types.GroupInfo) error {
+		ctx.KVStore(k.storeKey).Delete(types.GroupByAdminKey(mustAccAddress(group.Admin), group.GroupId))
+		group.Admin = msg.NewAdmin
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateGroupAdminResponse{}, nil
+}
+
+// UpdateGroupMetadata implements the MsgServer.UpdateGroupMetadata method.
+func (k msgServer) UpdateGroupMetadata(goCtx context.Context, msg *types.MsgUpdateGroupMetadata) (*types.MsgUpdateGroupMetadataResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	err := k.updateGroup(ctx, msg.GroupId, msg.Admin, func(group *types.GroupInfo) error {
+		group.Metadata = msg.Metadata
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateGroupMetadataResponse{}, nil
+}
+
+// CreateGroupPolicy implements the MsgServer.CreateGroupPolicy method to
+// create a new group policy account for a group.
+func (k msgServer) CreateGroupPolicy(goCtx context.Context, msg *types.MsgCreateGroupPolicy) (*types.MsgCreateGroupPolicyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	group, err := k.GetGroupInfo(ctx, msg.GroupId)
+	if err != nil {
+		return nil, err
+	}
+	if group.Admin != msg.Admin {
+		return nil, sdkerrors.Wrap(types.ErrUnauthorized, "not group admin")
+	}
+
+	address := k.createGroupPolicyAccount(ctx)
+	policy := types.GroupPolicyInfo{
+		Address:  address.String(),
+		GroupId:  msg.GroupId,
+		Admin:    msg.Admin,
+		Metadata: msg.Metadata,
+		Version:  1,
+	}
+	if err := policy.SetDecisionPolicy(msg.GetDecisionPolicy()); err != nil {
+		return nil, err
+	}
+	k.setGroupPolicyInfo(ctx, policy)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeCreateGroupPolicy,
+		sdk.NewAttribute(types.AttributeKeyAddress, address.String()),
+	))
+
+	return &types.MsgCreateGroupPolicyResponse{Address: address.String()}, nil
+}
+
+// UpdateGroupPolicyAdmin implements the MsgServer.UpdateGroupPolicyAdmin method.
+func (k msgServer) UpdateGroupPolicyAdmin(goCtx context.Context, msg *types.MsgUpdateGroupPolicyAdmin) (*types.MsgUpdateGroupPolicyAdminResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	err := k.updateGroupPolicy(ctx, msg.Address, msg.Admin, func(policy *types.GroupPolicyInfo) error {
+		policy.Admin = msg.NewAdmin
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateGroupPolicyAdminResponse{}, nil
+}
+
+// UpdateGroupPolicyDecisionPolicy implements the
+// MsgServer.UpdateGroupPolicyDecisionPolicy method.
+func (k msgServer) UpdateGroupPolicyDecisionPolicy(goCtx context.Context, msg *types.MsgUpdateGroupPolicyDecisionPolicy) (*types.MsgUpdateGroupPolicyDecisionPolicyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	err := k.updateGroupPolicy(ctx, msg.Address, msg.Admin, func(policy *types.GroupPolicyInfo) error {
+		return policy.SetDecisionPolicy(msg.GetDecisionPolicy())
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateGroupPolicyDecisionPolicyResponse{}, nil
+}
+
+// SubmitProposal implements the MsgServer.SubmitProposal method. With
+// ExecTry, the proposers vote yes and the proposal is executed right away if
+// their votes are enough to accept it. The proposers stop voting as soon as
+// their votes decide the proposal.
+func (k msgServer) SubmitProposal(goCtx context.Context, msg *types.MsgSubmitProposal) (*types.MsgSubmitProposalResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	msgs, err := msg.GetMsgs()
+	if err != nil {
+		return nil, err
+	}
+	proposalID, err := k.Keeper.SubmitProposal(ctx, mustAccAddress(msg.Address), msg.Proposers, msg.Metadata, msgs)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Exec == types.ExecTry {
+		for _, proposer := range msg.Proposers {
+			proposal, err := k.Keeper.GetProposal(ctx, proposalID)
+			if err != nil {
+				return nil, err
+			}
+			if proposal.Status != types.ProposalStatusSubmitted {
+				break
+			}
+			if err := k.Keeper.Vote(ctx, proposalID, mustAccAddress(proposer), types.VoteOptionYes, nil); err != nil {
+				return nil, err
+			}
+		}
+		if err := k.Keeper.Exec(ctx, proposalID); err != nil {
+			return nil, err
+		}
+	}
+
+	return &types.MsgSubmitProposalResponse{ProposalId: proposalID}, nil
+}
+
+// WithdrawProposal implements the MsgServer.WithdrawProposal method. Only the
+// group policy admin or one of the proposers can withdraw a proposal.
+func (k msgServer) WithdrawProposal(goCtx context.Context, msg *types.MsgWithdrawProposal) (*types.MsgWithdrawProposalResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.Keeper.WithdrawProposal(ctx, msg.ProposalId, mustAccAddress(msg.Address)); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgWithdrawProposalResponse{}, nil
+}
+
+// Vote implements the MsgServer.Vote method. With ExecTry, the proposal is
+// executed right away if the vote is enough to accept it.
+func (k msgServer) Vote(goCtx context.Context, msg *types.MsgVote) (*types.MsgVoteResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.Keeper.Vote(ctx, msg.ProposalId, mustAccAddress(msg.Voter), msg.Option, msg.Metadata); err != nil {
+		return nil, err
+	}
+
+	if msg.Exec == types.ExecTry {
+		if err := k.Keeper.Exec(ctx, msg.ProposalId); err != nil {
+			return nil, err
+		}
+	}
+
+	return &types.MsgVoteResponse{}, nil
+}
+
+// Exec implements the MsgServer.Exec method to execute the messages of an
+// accepted proposal. Anyone can execute a proposal.
+func (k msgServer) Exec(goCtx context.Context, msg *types.MsgExec) (*types.MsgExecResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.Keeper.Exec(ctx, msg.ProposalId); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgExecResponse{}, nil
+}
+
+// updateGroup applies update to a group after checking its admin, and bumps
+// the group version so that the proposals submitted before become outdated.
+func (k msgServer) updateGroup(ctx sdk.Context, groupID uint64, admin string, update func(group *types.GroupInfo) error) error {
+	group, err := k.GetGroupInfo(ctx, groupID)
+	if err != nil {
+		return err
+	}
+	if group.Admin != admin {
+		return sdkerrors.Wrap(types.ErrUnauthorized, "not group admin")
+	}
+
+	if err := update(&group); err != nil {
+		return err
+	}
+	group.Version++
+	k.setGroupInfo(ctx, group)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeUpdateGroup,
+		sdk.NewAttribute(types.AttributeKeyGroupID, fmt.Sprintf("%d", groupID)),
+	))
+
+	return nil
+}
+
+// updateGroupPolicy applies update to a group policy after checking its admin,
+// and bumps the group policy version so that the proposals submitted before
+// become outdated.
+func (k msgServer) updateGroupPolicy(ctx sdk.Context, address string, admin string, update func(policy *types.GroupPolicyInfo) error) error {
+	policy, err := k.GetGroupPolicyInfo(ctx, mustAccAddress(address))
+	if err != nil {
+		return err
+	}
+	if policy.Admin != admin {
+		return sdkerrors.Wrap(types.ErrUnauthorized, "not group policy admin")
+	}
+
+	if err := update(&policy); err != nil {
+		return err
+	}
+	policy.Version++
+	k.setGroupPolicyInfo(ctx, policy)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeUpdateGroupPolicy,
+		sdk.NewAttribute(types.AttributeKeyAddress, address),
+	))
+
+	return nil
+}
@@ -0,0 +1,300 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/line/lfb-sdk/types"
+	sdkerrors "github.com/line/lfb-sdk/types/errors"
+	"github.com/line/lfb-sdk/x/group/types"
+)
+
+// SubmitProposal creates a new proposal on a group policy. The proposers must
+// be members of the group of the policy, and the messages must be routable and
+// signed by the group policy account only.
+func (k Keeper) SubmitProposal(ctx sdk.Context, address sdk.AccAddress, proposers []string, metadata []byte, msgs []sdk.Msg) (uint64, error) {
+	policy, err := k.GetGroupPolicyInfo(ctx, address)
+	if err != nil {
+		return 0, err
+	}
+	group, err := k.GetGroupInfo(ctx, policy.GroupId)
+	if err != nil {
+		return 0, err
+	}
+	decisionPolicy := policy.GetDecisionPolicy()
+	if decisionPolicy == nil {
+		return 0, sdkerrors.Wrapf(types.ErrEmpty, "decision policy of %s", address)
+	}
+
+	for _, proposer := range proposers {
+		if _, err := k.GetGroupMember(ctx, group.GroupId, mustAccAddress(proposer)); err != nil {
+			return 0, sdkerrors.Wrapf(types.ErrUnauthorized, "proposer %s is not a group member", proposer)
+		}
+	}
+	if err := k.ensureMsgsAuthorized(address, msgs); err != nil {
+		return 0, err
+	}
+
+	anys, err := types.PackMessages(msgs)
+	if err != nil {
+		return 0, err
+	}
+
+	proposalID := k.nextSequence(ctx, types.ProposalSeqKey)
+	k.setProposal(ctx, types.Proposal{
+		ProposalId:         proposalID,
+		Address:            address.String(),
+		Metadata:           metadata,
+		Proposers:          proposers,
+		SubmitTime:         ctx.BlockTime(),
+		GroupVersion:       group.Version,
+		GroupPolicyVersion: policy.Version,
+		Status:             types.ProposalStatusSubmitted,
+		Result:             types.ProposalResultUnfinalized,
+		FinalTallyResult:   types.DefaultTallyResult(),
+		VotingPeriodEnd:    ctx.BlockTime().Add(decisionPolicy.GetVotingPeriod()),
+		ExecutorResult:     types.ProposalExecutorResultNotRun,
+		Messages:           anys,
+	})
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeSubmitProposal,
+		sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposalID)),
+	))
+
+	return proposalID, nil
+}
+
+// WithdrawProposal withdraws a proposal which is still open for voting. Only
+// the group policy admin or one of the proposers can withdraw a proposal.
+func (k Keeper) WithdrawProposal(ctx sdk.Context, proposalID uint64, address sdk.AccAddress) error {
+	proposal, err := k.GetProposal(ctx, proposalID)
+	if err != nil {
+		return err
+	}
+	if proposal.Status != types.ProposalStatusSubmitted {
+		return sdkerrors.Wrapf(types.ErrInvalid, "cannot withdraw a proposal with the status of %s", proposal.Status)
+	}
+
+	policy, err := k.GetGroupPolicyInfo(ctx, mustAccAddress(proposal.Address))
+	if err != nil {
+		return err
+	}
+	if policy.Admin != address.String() && !contains(proposal.Proposers, address.String()) {
+		return sdkerrors.Wrapf(types.ErrUnauthorized, "%s is neither the group policy admin nor a proposer", address)
+	}
+
+	proposal.Status = types.ProposalStatusWithdrawn
+	k.setProposal(ctx, proposal)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeWithdrawProposal,
+		sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposalID)),
+	))
+
+	return nil
+}
+
+// Vote casts the vote of a group member on a proposal. The proposal is closed
+// as soon as the votes are enough for its decision policy to be final.
+func (k Keeper) Vote(ctx sdk.Context, proposalID uint64, voter sdk.AccAddress, option types.VoteOption, metadata []byte) error {
+	proposal, err := k.GetProposal(ctx, proposalID)
+	if err != nil {
+		return err
+	}
+	if proposal.Status != types.ProposalStatusSubmitted {
+		return sdkerrors.Wrapf(types.ErrInvalid, "cannot vote on a proposal with the status of %s", proposal.Status)
+	}
+	if !ctx.BlockTime().Before(proposal.VotingPeriodEnd) {
+		return sdkerrors.Wrap(types.ErrExpired, "voting period has ended")
+	}
+
+	policy, group, err := k.getProposalGroup(ctx, proposal)
+	if err != nil {
+		return err
+	}
+	member, err := k.GetGroupMember(ctx, group.GroupId, voter)
+	if err != nil {
+		return sdkerrors.Wrapf(types.ErrUnauthorized, "voter %s is not a group member", voter)
+	}
+	if _, err := k.GetVote(ctx, proposalID, voter); err == nil {
+		return sdkerrors.Wrapf(types.ErrDuplicate, "vote of %s on proposal %d", voter, proposalID)
+	}
+
+	if err := proposal.FinalTallyResult.Add(option, member.Member.Weight); err != nil {
+		return err
+	}
+	k.setVote(ctx, types.Vote{
+		ProposalId: proposalID,
+		Voter:      voter.String(),
+		Option:     option,
+		Metadata:   metadata,
+		SubmitTime: ctx.BlockTime(),
+	})
+
+	if err := k.tally(&proposal, policy, group, false); err != nil {
+		return err
+	}
+	k.setProposal(ctx, proposal)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeVote,
+		sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposalID)),
+	))
+
+	return nil
+}
+
+// Exec finalizes the tally of a proposal if needed and executes its messages
+// once it is accepted. A failure of the messages doesn't fail Exec: it is
+// recorded in the executor result, and the execution can be tried again.
+func (k Keeper) Exec(ctx sdk.Context, proposalID uint64) error {
+	proposal, err := k.GetProposal(ctx, proposalID)
+	if err != nil {
+		return err
+	}
+	if proposal.Status != types.ProposalStatusSubmitted && proposal.Status != types.ProposalStatusClosed {
+		return sdkerrors.Wrapf(types.ErrInvalid, "cannot execute a proposal with the status of %s", proposal.Status)
+	}
+
+	policy, err := k.GetGroupPolicyInfo(ctx, mustAccAddress(proposal.Address))
+	if err != nil {
+		return err
+	}
+
+	if proposal.Status == types.ProposalStatusSubmitted {
+		group, err := k.GetGroupInfo(ctx, policy.GroupId)
+		if err != nil {
+			return err
+		}
+		if proposal.GroupVersion != group.Version || proposal.GroupPolicyVersion != policy.Version {
+			proposal.Status = types.ProposalStatusAborted
+		} else {
+			votingEnded := !ctx.BlockTime().Before(proposal.VotingPeriodEnd)
+			if err := k.tally(&proposal, policy, group, votingEnded); err != nil {
+				return err
+			}
+		}
+	}
+
+	if proposal.Result == types.ProposalResultAccepted && proposal.ExecutorResult != types.ProposalExecutorResultSuccess {
+		cacheCtx, writeCache := ctx.CacheContext()
+		if err := k.execMsgs(cacheCtx, proposal); err != nil {
+			proposal.ExecutorResult = types.ProposalExecutorResultFailure
+			k.Logger(ctx).Info("proposal execution failed", "proposal", proposalID, "err", err)
+		} else {
+			proposal.ExecutorResult = types.ProposalExecutorResultSuccess
+			writeCache()
+			ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+		}
+	}
+	k.setProposal(ctx, proposal)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeExec,
+		sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposalID)),
+		sdk.NewAttribute(types.AttributeKeyExecutorResult, proposal.ExecutorResult.String()),
+	))
+
+	return nil
+}
+
+// tally closes the proposal once its decision policy is final. When the voting
+// period has ended, a proposal which is not accepted yet is rejected.
+func (k Keeper) tally(proposal *types.Proposal, policy types.GroupPolicyInfo, group types.GroupInfo, votingEnded bool) error {
+	decisionPolicy := policy.GetDecisionPolicy()
+	if decisionPolicy == nil {
+		return sdkerrors.Wrapf(types.ErrEmpty, "decision policy of %s", policy.Address)
+	}
+	result, err := decisionPolicy.Allow(proposal.FinalTallyResult, group.TotalWeight)
+	if err != nil {
+		return err
+	}
+	if !result.Final && !votingEnded {
+		return nil
+	}
+
+	proposal.Status = types.ProposalStatusClosed
+	if result.Allow {
+		proposal.Result = types.ProposalResultAccepted
+	} else {
+		proposal.Result = types.ProposalResultRejected
+	}
+	return nil
+}
+
+// getProposalGroup returns the group policy and the group of a proposal, and
+// checks that neither has changed since the proposal was submitted.
+func (k Keeper) getProposalGroup(ctx sdk.Context, proposal types.Proposal) (types.GroupPolicyInfo, types.GroupInfo, error) {
+	policy, err := k.GetGroupPolicyInfo(ctx, mustAccAddress(proposal.Address))
+	if err != nil {
+		return types.GroupPolicyInfo{}, types.GroupInfo{}, err
+	}
+	if proposal.GroupPolicyVersion != policy.Version {
+		return types.GroupPolicyInfo{}, types.GroupInfo{}, sdkerrors.Wrap(types.ErrModified, "group policy was modified")
+	}
+	group, err := k.GetGroupInfo(ctx, policy.GroupId)
+	if err != nil {
+		return types.GroupPolicyInfo{}, types.GroupInfo{}, err
+	}
+	if proposal.GroupVersion != group.Version {
+		return types.GroupPolicyInfo{}, types.GroupInfo{}, sdkerrors.Wrap(types.ErrModified, "group was modified")
+	}
+	return policy, group, nil
+}
+
+// execMsgs executes the messages of a proposal on behalf of its group policy
+// account.
+func (k Keeper) execMsgs(ctx sdk.Context, proposal types.Proposal) error {
+	msgs, err := proposal.GetMessages()
+	if err != nil {
+		return err
+	}
+	if err := k.ensureMsgsAuthorized(mustAccAddress(proposal.Address), msgs); err != nil {
+		return err
+	}
+
+	for i, msg := range msgs {
+		handler := k.router.HandlerByTypeURL(sdk.MsgTypeURL(msg))
+		req, ok := msg.(sdk.MsgRequest)
+		if !ok {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "%T is not a Msg service request", msg)
+		}
+
+		res, err := handler(ctx, req)
+		if err != nil {
+			return sdkerrors.Wrapf(err, "failed to execute message %d", i)
+		}
+
+		events := make(sdk.Events, len(res.Events))
+		for j, event := range res.Events {
+			events[j] = sdk.Event(event)
+		}
+		ctx.EventManager().EmitEvents(events)
+	}
+
+	return nil
+}
+
+// ensureMsgsAuthorized checks that the messages can be routed and are signed
+// by the group policy account only.
+func (k Keeper) ensureMsgsAuthorized(address sdk.AccAddress, msgs []sdk.Msg) error {
+	for i, msg := range msgs {
+		if k.router.HandlerByTypeURL(sdk.MsgTypeURL(msg)) == nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized message route: %s", sdk.MsgTypeURL(msg))
+		}
+		signers := msg.GetSigners()
+		if len(signers) != 1 || !signers[0].Equals(address) {
+			return sdkerrors.Wrapf(types.ErrUnauthorized, "msg %d does not have group policy authorization", i)
+		}
+	}
+	return nil
+}
+
+func contains(addresses []string, address string) bool {
+	for _, a := range addresses {
+		if a == address {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,84 @@
+package types
+
+import (
+	"github.com/line/lfb-sdk/codec"
+	"github.com/line/lfb-sdk/codec/types"
+	cryptocodec "github.com/line/lfb-sdk/crypto/codec"
+	sdk "github.com/line/lfb-sdk/types"
+	"github.com/line/lfb-sdk/types/msgservice"
+)
+
+// RegisterLegacyAminoCodec registers all the necessary group module concrete
+// types with the provided codec reference.
+// These types are used for Amino JSON serialization.
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterInterface((*DecisionPolicy)(nil), nil)
+	cdc.RegisterConcrete(&ThresholdDecisionPolicy{}, "lfb-sdk/group/ThresholdDecisionPolicy", nil)
+	cdc.RegisterConcrete(&PercentageDecisionPolicy{}, "lfb-sdk/group/PercentageDecisionPolicy", nil)
+
+	cdc.RegisterConcrete(&MsgCreateGroup{}, "lfb-sdk/group/MsgCreateGroup", nil)
+	cdc.RegisterConcrete(&MsgUpdateGroupMembers{}, "lfb-sdk/group/MsgUpdateGroupMembers", nil)
+	cdc.RegisterConcrete(&MsgUpdateGroupAdmin{}, "lfb-sdk/group/MsgUpdateGroupAdmin", nil)
+	cdc.RegisterConcrete(&MsgUpdateGroupMetadata{}, "lfb-sdk/group/MsgUpdateGroupMetadata", nil)
+	cdc.RegisterConcrete(&MsgCreateGroupPolicy{}, "lfb-sdk/group/MsgCreateGroupPolicy", nil)
+	cdc.RegisterConcrete(&MsgUpdateGroupPolicyAdmin{}, "lfb-sdk/group/MsgUpdateGroupPolicyAdmin", nil)
+	cdc.RegisterConcrete(&MsgUpdateGroupPolicyDecisionPolicy{}, "lfb-sdk/group/MsgUpdateGroupPolicyDecisionPolicy", nil)
+	cdc.RegisterConcrete(&MsgSubmitProposal{}, "lfb-sdk/group/MsgSubmitProposal", nil)
+	cdc.RegisterConcrete(&MsgWithdrawProposal{}, "lfb-sdk/group/MsgWithdrawProposal", nil)
+	cdc.RegisterConcrete(&MsgVote{}, "lfb-sdk/group/MsgVote", nil)
+	cdc.RegisterConcrete(&MsgExec{}, "lfb-sdk/group/MsgExec", nil)
+}
+
+// RegisterInterfaces registers the interfaces types with the interface registry
+func RegisterInterfaces(registry types.InterfaceRegistry) {
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgCreateGroup{},
+		&MsgUpdateGroupMembers{},
+		&MsgUpdateGroupAdmin{},
+		&MsgUpdateGroupMetadata{},
+		&MsgCreateGroupPolicy{},
+		&MsgUpdateGroupPolicyAdmin{},
+		&MsgUpdateGroupPolicyDecisionPolicy{},
+		&MsgSubmitProposal{},
+		&MsgWithdrawProposal{},
+		&MsgVote{},
+		&MsgExec{},
+	)
+
+	registry.RegisterInterface(
+		"lfb.group.v1beta1.DecisionPolicy",
+		(*DecisionPolicy)(nil),
+		&ThresholdDecisionPolicy{},
+		&PercentageDecisionPolicy{},
+	)
+
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
+
+// RegisterMsgTypeCodec registers an external sdk.Msg type defined in another
+// module for the internal ModuleCdc. This allows the MsgSubmitProposal to be
+// correctly Amino encoded and decoded.
+//
+// NOTE: This should only be used for applications that are still using a concrete
+// Amino codec for serialization.
+func RegisterMsgTypeCodec(o interface{}, name string) {
+	amino.RegisterConcrete(o, name, nil)
+}
+
+var (
+	amino = codec.NewLegacyAmino()
+
+	// ModuleCdc references the global x/group module codec. Note, the codec
+	// should ONLY be used in certain instances of tests and for JSON encoding as
+	// Amino is still used for that purpose.
+	//
+	// The actual codec used for serialization should be provided to x/group and
+	// defined at the application level.
+	ModuleCdc = codec.NewAminoCodec(amino)
+)
+
+func init() {
+	RegisterLegacyAminoCodec(amino)
+	cryptocodec.RegisterCrypto(amino)
+	sdk.RegisterLegacyAminoCodec(amino)
+}
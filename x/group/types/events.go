@@ -0,0 +1,20 @@
+package types
+
+// group module events
+const (
+	EventTypeCreateGroup       = "create_group"
+	EventTypeUpdateGroup       = "update_group"
+	EventTypeCreateGroupPolicy = "create_group_policy"
+	EventTypeUpdateGroupPolicy = "update_group_policy"
+	EventTypeSubmitProposal    = "submit_proposal"
+	EventTypeWithdrawProposal  = "withdraw_proposal"
+	EventTypeVote              = "vote"
+	EventTypeExec              = "exec"
+
+	AttributeKeyGroupID        = "group_id"
+	AttributeKeyAddress        = "address"
+	AttributeKeyProposalID     = "proposal_id"
+	AttributeKeyExecutorResult = "executor_result"
+
+	AttributeValueCategory = ModuleName
+)
@@ -0,0 +1,115 @@
+package types
+
+import (
+	"github.com/line/lfb-sdk/codec/types"
+	sdk "github.com/line/lfb-sdk/types"
+	sdkerrors "github.com/line/lfb-sdk/types/errors"
+)
+
+var _ types.UnpackInterfacesMessage = GenesisState{}
+
+// NewGenesisState creates a new genesis state with default values.
+func NewGenesisState() *GenesisState {
+	return &GenesisState{}
+}
+
+// DefaultGenesisState returns the group module's default genesis state.
+func DefaultGenesisState() *GenesisState {
+	return NewGenesisState()
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	groups := make(map[uint64]GroupInfo, len(gs.Groups))
+	for _, g := range gs.Groups {
+		if g.GroupId == 0 || g.GroupId > gs.GroupSeq {
+			return sdkerrors.Wrapf(ErrInvalid, "group id %d", g.GroupId)
+		}
+		if _, ok := groups[g.GroupId]; ok {
+			return sdkerrors.Wrapf(ErrDuplicate, "group id %d", g.GroupId)
+		}
+		if err := validateAddress(g.Admin, "admin"); err != nil {
+			return err
+		}
+		if _, err := parseNonNegativeDec(g.TotalWeight); err != nil {
+			return sdkerrors.Wrapf(err, "group %d total weight", g.GroupId)
+		}
+		groups[g.GroupId] = g
+	}
+
+	for _, m := range gs.GroupMembers {
+		if _, ok := groups[m.GroupId]; !ok {
+			return sdkerrors.Wrapf(ErrNotFound, "group %d of member %s", m.GroupId, m.Member.Address)
+		}
+		if err := m.Member.ValidateBasic(false); err != nil {
+			return err
+		}
+	}
+
+	policies := make(map[string]GroupPolicyInfo, len(gs.GroupPolicies))
+	for _, p := range gs.GroupPolicies {
+		if _, ok := groups[p.GroupId]; !ok {
+			return sdkerrors.Wrapf(ErrNotFound, "group %d of group policy %s", p.GroupId, p.Address)
+		}
+		if err := validateAddress(p.Address, "group policy"); err != nil {
+			return err
+		}
+		if err := validateAddress(p.Admin, "admin"); err != nil {
+			return err
+		}
+		if err := validateDecisionPolicy(p.GetDecisionPolicy()); err != nil {
+			return err
+		}
+		if _, ok := policies[p.Address]; ok {
+			return sdkerrors.Wrapf(ErrDuplicate, "group policy %s", p.Address)
+		}
+		policies[p.Address] = p
+	}
+
+	proposals := make(map[uint64]Proposal, len(gs.Proposals))
+	for _, p := range gs.Proposals {
+		if p.ProposalId == 0 || p.ProposalId > gs.ProposalSeq {
+			return sdkerrors.Wrapf(ErrInvalid, "proposal id %d", p.ProposalId)
+		}
+		if _, ok := proposals[p.ProposalId]; ok {
+			return sdkerrors.Wrapf(ErrDuplicate, "proposal id %d", p.ProposalId)
+		}
+		if _, ok := policies[p.Address]; !ok {
+			return sdkerrors.Wrapf(ErrNotFound, "group policy %s of proposal %d", p.Address, p.ProposalId)
+		}
+		if len(p.Proposers) == 0 {
+			return sdkerrors.Wrapf(ErrEmpty, "proposers of proposal %d", p.ProposalId)
+		}
+		proposals[p.ProposalId] = p
+	}
+
+	for _, v := range gs.Votes {
+		if _, ok := proposals[v.ProposalId]; !ok {
+			return sdkerrors.Wrapf(ErrNotFound, "proposal %d of vote", v.ProposalId)
+		}
+		if _, err := sdk.AccAddressFromBech32(v.Voter); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "voter: %s", err)
+		}
+		if v.Option == VoteOptionUnspecified {
+			return sdkerrors.Wrapf(ErrInvalid, "vote option of %s on proposal %d", v.Voter, v.ProposalId)
+		}
+	}
+
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (gs GenesisState) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	for _, p := range gs.GroupPolicies {
+		if err := p.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+	for _, p := range gs.Proposals {
+		if err := p.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+	return nil
+}
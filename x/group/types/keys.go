@@ -0,0 +1,126 @@
+package types
+
+import (
+	"encoding/binary"
+
+	sdk "github.com/line/lfb-sdk/types"
+)
+
+const (
+	// ModuleName is the module name constant used in many places
+	ModuleName = "group"
+
+	// StoreKey is the store key string for group
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for group
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for group
+	QuerierRoute = ModuleName
+)
+
+// Keys for group store
+// Items are stored with the following key: values
+//
+// - 0x01: last group id
+// - 0x02<group_id_Bytes>: GroupInfo
+// - 0x03<admin_Bytes><group_id_Bytes>: []byte{}
+// - 0x10<group_id_Bytes><member_Bytes>: GroupMember
+// - 0x20: number of created group policies
+// - 0x21<address_Bytes>: GroupPolicyInfo
+// - 0x22<group_id_Bytes><address_Bytes>: []byte{}
+// - 0x30: last proposal id
+// - 0x31<proposal_id_Bytes>: Proposal
+// - 0x32<address_Bytes><proposal_id_Bytes>: []byte{}
+// - 0x40<proposal_id_Bytes><voter_Bytes>: Vote
+var (
+	GroupSeqKey              = []byte{0x01}
+	GroupKeyPrefix           = []byte{0x02}
+	GroupByAdminKeyPrefix    = []byte{0x03}
+	GroupMemberKeyPrefix     = []byte{0x10}
+	GroupPolicySeqKey        = []byte{0x20}
+	GroupPolicyKeyPrefix     = []byte{0x21}
+	GroupPolicyByGroupPrefix = []byte{0x22}
+	ProposalSeqKey           = []byte{0x30}
+	ProposalKeyPrefix        = []byte{0x31}
+	ProposalByPolicyPrefix   = []byte{0x32}
+	VoteKeyPrefix            = []byte{0x40}
+)
+
+// GetIDBytes returns the byte representation of an ID.
+func GetIDBytes(id uint64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, id)
+	return bz
+}
+
+// GetIDFromBytes returns an ID from its byte representation.
+func GetIDFromBytes(bz []byte) uint64 {
+	return binary.BigEndian.Uint64(bz)
+}
+
+// GroupKey returns the key of a group.
+func GroupKey(groupID uint64) []byte {
+	return append(append([]byte{}, GroupKeyPrefix...), GetIDBytes(groupID)...)
+}
+
+// GroupsByAdminPrefix returns the prefix of the index of the groups of an admin.
+func GroupsByAdminPrefix(admin sdk.AccAddress) []byte {
+	return append(append([]byte{}, GroupByAdminKeyPrefix...), admin.Bytes()...)
+}
+
+// GroupByAdminKey returns the key of a group in the index of the groups of an admin.
+func GroupByAdminKey(admin sdk.AccAddress, groupID uint64) []byte {
+	return append(GroupsByAdminPrefix(admin), GetIDBytes(groupID)...)
+}
+
+// GroupMembersPrefix returns the prefix of the members of a group.
+func GroupMembersPrefix(groupID uint64) []byte {
+	return append(append([]byte{}, GroupMemberKeyPrefix...), GetIDBytes(groupID)...)
+}
+
+// GroupMemberKey returns the key of a member of a group.
+func GroupMemberKey(groupID uint64, member sdk.AccAddress) []byte {
+	return append(GroupMembersPrefix(groupID), member.Bytes()...)
+}
+
+// GroupPolicyKey returns the key of a group policy.
+func GroupPolicyKey(address sdk.AccAddress) []byte {
+	return append(append([]byte{}, GroupPolicyKeyPrefix...), address.Bytes()...)
+}
+
+// GroupPoliciesByGroupPrefix returns the prefix of the index of the policies of a group.
+func GroupPoliciesByGroupPrefix(groupID uint64) []byte {
+	return append(append([]byte{}, GroupPolicyByGroupPrefix...), GetIDBytes(groupID)...)
+}
+
+// GroupPolicyByGroupKey returns the key of a group policy in the index of the policies of a group.
+func GroupPolicyByGroupKey(groupID uint64, address sdk.AccAddress) []byte {
+	return append(GroupPoliciesByGroupPrefix(groupID), address.Bytes()...)
+}
+
+// ProposalKey returns the key of a proposal.
+func ProposalKey(proposalID uint64) []byte {
+	return append(append([]byte{}, ProposalKeyPrefix...), GetIDBytes(proposalID)...)
+}
+
+// ProposalsByGroupPolicyPrefix returns the prefix of the index of the proposals of a group policy.
+func ProposalsByGroupPolicyPrefix(address sdk.AccAddress) []byte {
+	return append(append([]byte{}, ProposalByPolicyPrefix...), address.Bytes()...)
+}
+
+// ProposalByGroupPolicyKey returns the key of a proposal in the index of the proposals of a group policy.
+func ProposalByGroupPolicyKey(address sdk.AccAddress, proposalID uint64) []byte {
+	return append(ProposalsByGroupPolicyPrefix(address), GetIDBytes(proposalID)...)
+}
+
+// VotesByProposalPrefix returns the prefix of the votes of a proposal.
+func VotesByProposalPrefix(proposalID uint64) []byte {
+	return append(append([]byte{}, VoteKeyPrefix...), GetIDBytes(proposalID)...)
+}
+
+// VoteKey returns the key of the vote of a voter on a proposal.
+func VoteKey(proposalID uint64, voter sdk.AccAddress) []byte {
+	return append(VotesByProposalPrefix(proposalID), voter.Bytes()...)
+}
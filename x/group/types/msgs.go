@@ -0,0 +1,538 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/line/lfb-sdk/codec/types"
+	sdk "github.com/line/lfb-sdk/types"
+	sdkerrors "github.com/line/lfb-sdk/types/errors"
+)
+
+// Group message types
+const (
+	TypeMsgCreateGroup                     = "create_group"
+	TypeMsgUpdateGroupMembers              = "update_group_members"
+	TypeMsgUpdateGroupAdmin                = "update_group_admin"
+	TypeMsgUpdateGroupMetadata             = "update_group_metadata"
+	TypeMsgCreateGroupPolicy               = "create_group_policy"
+	TypeMsgUpdateGroupPolicyAdmin          = "update_group_policy_admin"
+	TypeMsgUpdateGroupPolicyDecisionPolicy = "update_group_policy_decision_policy"
+	TypeMsgSubmitProposal                  = "submit_proposal"
+	TypeMsgWithdrawProposal                = "withdraw_proposal"
+	TypeMsgVote                            = "vote"
+	TypeMsgExec                            = "exec"
+)
+
+var (
+	_ sdk.Msg = &MsgCreateGroup{}
+	_ sdk.Msg = &MsgUpdateGroupMembers{}
+	_ sdk.Msg = &MsgUpdateGroupAdmin{}
+	_ sdk.Msg = &MsgUpdateGroupMetadata{}
+	_ sdk.Msg = &MsgCreateGroupPolicy{}
+	_ sdk.Msg = &MsgUpdateGroupPolicyAdmin{}
+	_ sdk.Msg = &MsgUpdateGroupPolicyDecisionPolicy{}
+	_ sdk.Msg = &MsgSubmitProposal{}
+	_ sdk.Msg = &MsgWithdrawProposal{}
+	_ sdk.Msg = &MsgVote{}
+	_ sdk.Msg = &MsgExec{}
+
+	_ types.UnpackInterfacesMessage = MsgCreateGroupPolicy{}
+	_ types.UnpackInterfacesMessage = MsgUpdateGroupPolicyDecisionPolicy{}
+	_ types.UnpackInterfacesMessage = MsgSubmitProposal{}
+)
+
+// Route implements Msg
+func (m MsgCreateGroup) Route() string { return RouterKey }
+
+// Type implements Msg
+func (m MsgCreateGroup) Type() string { return TypeMsgCreateGroup }
+
+// ValidateBasic implements Msg
+func (m MsgCreateGroup) ValidateBasic() error {
+	if err := validateAddress(m.Admin, "admin"); err != nil {
+		return err
+	}
+	if len(m.Metadata) > MaxMetadataLength {
+		return sdkerrors.Wrap(ErrMaxLimit, "group metadata")
+	}
+	return ValidateMembers(m.Members, false)
+}
+
+// GetSignBytes implements Msg
+func (m MsgCreateGroup) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners implements Msg
+func (m MsgCreateGroup) GetSigners() []sdk.AccAddress {
+	return mustAccAddresses(m.Admin)
+}
+
+// Route implements Msg
+func (m MsgUpdateGroupMembers) Route() string { return RouterKey }
+
+// Type implements Msg
+func (m MsgUpdateGroupMembers) Type() string { return TypeMsgUpdateGroupMembers }
+
+// ValidateBasic implements Msg
+func (m MsgUpdateGroupMembers) ValidateBasic() error {
+	if m.GroupId == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "group id")
+	}
+	if err := validateAddress(m.Admin, "admin"); err != nil {
+		return err
+	}
+	if len(m.MemberUpdates) == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "member updates")
+	}
+	return ValidateMembers(m.MemberUpdates, true)
+}
+
+// GetSignBytes implements Msg
+func (m MsgUpdateGroupMembers) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners implements Msg
+func (m MsgUpdateGroupMembers) GetSigners() []sdk.AccAddress {
+	return mustAccAddresses(m.Admin)
+}
+
+// Route implements Msg
+func (m MsgUpdateGroupAdmin) Route() string { return RouterKey }
+
+// Type implements Msg
+func (m MsgUpdateGroupAdmin) Type() string { return TypeMsgUpdateGroupAdmin }
+
+// ValidateBasic implements Msg
+func (m MsgUpdateGroupAdmin) ValidateBasic() error {
+	if m.GroupId == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "group id")
+	}
+	if err := validateAddress(m.Admin, "admin"); err != nil {
+		return err
+	}
+	if err := validateAddress(m.NewAdmin, "new admin"); err != nil {
+		return err
+	}
+	if m.Admin == m.NewAdmin {
+		return sdkerrors.Wrap(ErrInvalid, "new and old admin are the same")
+	}
+	return nil
+}
+
+// GetSignBytes implements Msg
+func (m MsgUpdateGroupAdmin) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners implements Msg
+func (m MsgUpdateGroupAdmin) GetSigners() []sdk.AccAddress {
+	return mustAccAddresses(m.Admin)
+}
+
+// Route implements Msg
+func (m MsgUpdateGroupMetadata) Route() string { return RouterKey }
+
+// Type implements Msg
+func (m MsgUpdateGroupMetadata) Type() string { return TypeMsgUpdateGroupMetadata }
+
+// ValidateBasic implements Msg
+func (m MsgUpdateGroupMetadata) ValidateBasic() error {
+	if m.GroupId == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "group id")
+	}
+	if err := validateAddress(m.Admin, "admin"); err != nil {
+		return err
+	}
+	if len(m.Metadata) > MaxMetadataLength {
+		return sdkerrors.Wrap(ErrMaxLimit, "group metadata")
+	}
+	return nil
+}
+
+// GetSignBytes implements Msg
+func (m MsgUpdateGroupMetadata) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners implements Msg
+func (m MsgUpdateGroupMetadata) GetSigners() []sdk.AccAddress {
+	return mustAccAddresses(m.Admin)
+}
+
+// NewMsgCreateGroupPolicy creates a new MsgCreateGroupPolicy.
+//nolint:interfacer
+func NewMsgCreateGroupPolicy(admin sdk.AccAddress, group uint64, metadata []byte, decisionPolicy DecisionPolicy) (*MsgCreateGroupPolicy, error) {
+	m := &MsgCreateGroupPolicy{
+		Admin:    admin.String(),
+		GroupId:  group,
+		Metadata: metadata,
+	}
+	if err := m.SetDecisionPolicy(decisionPolicy); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// GetDecisionPolicy returns the decision policy of the group policy.
+func (m *MsgCreateGroupPolicy) GetDecisionPolicy() DecisionPolicy {
+	if m.DecisionPolicy == nil {
+		return nil
+	}
+	policy, ok := m.DecisionPolicy.GetCachedValue().(DecisionPolicy)
+	if !ok {
+		return nil
+	}
+	return policy
+}
+
+// SetDecisionPolicy sets the decision policy of the group policy.
+func (m *MsgCreateGroupPolicy) SetDecisionPolicy(decisionPolicy DecisionPolicy) error {
+	any, err := packDecisionPolicy(decisionPolicy)
+	if err != nil {
+		return err
+	}
+	m.DecisionPolicy = any
+	return nil
+}
+
+// Route implements Msg
+func (m MsgCreateGroupPolicy) Route() string { return RouterKey }
+
+// Type implements Msg
+func (m MsgCreateGroupPolicy) Type() string { return TypeMsgCreateGroupPolicy }
+
+// ValidateBasic implements Msg
+func (m MsgCreateGroupPolicy) ValidateBasic() error {
+	if m.GroupId == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "group id")
+	}
+	if err := validateAddress(m.Admin, "admin"); err != nil {
+		return err
+	}
+	if len(m.Metadata) > MaxMetadataLength {
+		return sdkerrors.Wrap(ErrMaxLimit, "group policy metadata")
+	}
+	return validateDecisionPolicy(m.GetDecisionPolicy())
+}
+
+// GetSignBytes implements Msg
+func (m MsgCreateGroupPolicy) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners implements Msg
+func (m MsgCreateGroupPolicy) GetSigners() []sdk.AccAddress {
+	return mustAccAddresses(m.Admin)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (m MsgCreateGroupPolicy) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	var policy DecisionPolicy
+	return unpacker.UnpackAny(m.DecisionPolicy, &policy)
+}
+
+// Route implements Msg
+func (m MsgUpdateGroupPolicyAdmin) Route() string { return RouterKey }
+
+// Type implements Msg
+func (m MsgUpdateGroupPolicyAdmin) Type() string { return TypeMsgUpdateGroupPolicyAdmin }
+
+// ValidateBasic implements Msg
+func (m MsgUpdateGroupPolicyAdmin) ValidateBasic() error {
+	if err := validateAddress(m.Admin, "admin"); err != nil {
+		return err
+	}
+	if err := validateAddress(m.NewAdmin, "new admin"); err != nil {
+		return err
+	}
+	if err := validateAddress(m.Address, "group policy"); err != nil {
+		return err
+	}
+	if m.Admin == m.NewAdmin {
+		return sdkerrors.Wrap(ErrInvalid, "new and old admin are the same")
+	}
+	return nil
+}
+
+// GetSignBytes implements Msg
+func (m MsgUpdateGroupPolicyAdmin) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners implements Msg
+func (m MsgUpdateGroupPolicyAdmin) GetSigners() []sdk.AccAddress {
+	return mustAccAddresses(m.Admin)
+}
+
+// NewMsgUpdateGroupPolicyDecisionPolicy creates a new MsgUpdateGroupPolicyDecisionPolicy.
+//nolint:interfacer
+func NewMsgUpdateGroupPolicyDecisionPolicy(admin sdk.AccAddress, address sdk.AccAddress, decisionPolicy DecisionPolicy) (*MsgUpdateGroupPolicyDecisionPolicy, error) {
+	m := &MsgUpdateGroupPolicyDecisionPolicy{
+		Admin:   admin.String(),
+		Address: address.String(),
+	}
+	if err := m.SetDecisionPolicy(decisionPolicy); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// GetDecisionPolicy returns the updated decision policy of the group policy.
+func (m *MsgUpdateGroupPolicyDecisionPolicy) GetDecisionPolicy() DecisionPolicy {
+	if m.DecisionPolicy == nil {
+		return nil
+	}
+	policy, ok := m.DecisionPolicy.GetCachedValue().(DecisionPolicy)
+	if !ok {
+		return nil
+	}
+	return policy
+}
+
+// SetDecisionPolicy sets the updated decision policy of the group policy.
+func (m *MsgUpdateGroupPolicyDecisionPolicy) SetDecisionPolicy(decisionPolicy DecisionPolicy) error {
+	any, err := packDecisionPolicy(decisionPolicy)
+	if err != nil {
+		return err
+	}
+	m.DecisionPolicy = any
+	return nil
+}
+
+// Route implements Msg
+func (m MsgUpdateGroupPolicyDecisionPolicy) Route() string { return RouterKey }
+
+// Type implements Msg
+func (m MsgUpdateGroupPolicyDecisionPolicy) Type() string {
+	return TypeMsgUpdateGroupPolicyDecisionPolicy
+}
+
+// ValidateBasic implements Msg
+func (m MsgUpdateGroupPolicyDecisionPolicy) ValidateBasic() error {
+	if err := validateAddress(m.Admin, "admin"); err != nil {
+		return err
+	}
+	if err := validateAddress(m.Address, "group policy"); err != nil {
+		return err
+	}
+	return validateDecisionPolicy(m.GetDecisionPolicy())
+}
+
+// GetSignBytes implements Msg
+func (m MsgUpdateGroupPolicyDecisionPolicy) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners implements Msg
+func (m MsgUpdateGroupPolicyDecisionPolicy) GetSigners() []sdk.AccAddress {
+	return mustAccAddresses(m.Admin)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (m MsgUpdateGroupPolicyDecisionPolicy) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	var policy DecisionPolicy
+	return unpacker.UnpackAny(m.DecisionPolicy, &policy)
+}
+
+// NewMsgSubmitProposal creates a new MsgSubmitProposal.
+//nolint:interfacer
+func NewMsgSubmitProposal(address sdk.AccAddress, proposers []string, msgs []sdk.Msg, metadata []byte, exec Exec) (*MsgSubmitProposal, error) {
+	m := &MsgSubmitProposal{
+		Address:   address.String(),
+		Proposers: proposers,
+		Metadata:  metadata,
+		Exec:      exec,
+	}
+	if err := m.SetMsgs(msgs); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// GetMsgs unpacks m.Messages Any's into sdk.Msg's
+func (m *MsgSubmitProposal) GetMsgs() ([]sdk.Msg, error) {
+	return UnpackMessages(m.Messages)
+}
+
+// SetMsgs packs msgs into Any's
+func (m *MsgSubmitProposal) SetMsgs(msgs []sdk.Msg) error {
+	anys, err := PackMessages(msgs)
+	if err != nil {
+		return err
+	}
+	m.Messages = anys
+	return nil
+}
+
+// Route implements Msg
+func (m MsgSubmitProposal) Route() string { return RouterKey }
+
+// Type implements Msg
+func (m MsgSubmitProposal) Type() string { return TypeMsgSubmitProposal }
+
+// ValidateBasic implements Msg
+func (m MsgSubmitProposal) ValidateBasic() error {
+	address, err := sdk.AccAddressFromBech32(m.Address)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "group policy: %s", err)
+	}
+	if len(m.Proposers) == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "proposers")
+	}
+	seen := make(map[string]bool, len(m.Proposers))
+	for _, proposer := range m.Proposers {
+		if err := validateAddress(proposer, "proposer"); err != nil {
+			return err
+		}
+		if seen[proposer] {
+			return sdkerrors.Wrapf(ErrDuplicate, "proposer %s", proposer)
+		}
+		seen[proposer] = true
+	}
+	if len(m.Metadata) > MaxMetadataLength {
+		return sdkerrors.Wrap(ErrMaxLimit, "proposal metadata")
+	}
+
+	msgs, err := m.GetMsgs()
+	if err != nil {
+		return err
+	}
+	for i, msg := range msgs {
+		if err := msg.ValidateBasic(); err != nil {
+			return sdkerrors.Wrapf(err, "msg %d", i)
+		}
+		// the messages are executed by the group policy account
+		signers := msg.GetSigners()
+		if len(signers) != 1 || !signers[0].Equals(address) {
+			return sdkerrors.Wrapf(ErrUnauthorized, "msg %d does not have group policy authorization", i)
+		}
+	}
+	return nil
+}
+
+// GetSignBytes implements Msg
+func (m MsgSubmitProposal) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners implements Msg
+func (m MsgSubmitProposal) GetSigners() []sdk.AccAddress {
+	return mustAccAddresses(m.Proposers...)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (m MsgSubmitProposal) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	return unpackMessagesInterfaces(unpacker, m.Messages)
+}
+
+// Route implements Msg
+func (m MsgWithdrawProposal) Route() string { return RouterKey }
+
+// Type implements Msg
+func (m MsgWithdrawProposal) Type() string { return TypeMsgWithdrawProposal }
+
+// ValidateBasic implements Msg
+func (m MsgWithdrawProposal) ValidateBasic() error {
+	if m.ProposalId == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "proposal id")
+	}
+	return validateAddress(m.Address, "address")
+}
+
+// GetSignBytes implements Msg
+func (m MsgWithdrawProposal) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners implements Msg
+func (m MsgWithdrawProposal) GetSigners() []sdk.AccAddress {
+	return mustAccAddresses(m.Address)
+}
+
+// Route implements Msg
+func (m MsgVote) Route() string { return RouterKey }
+
+// Type implements Msg
+func (m MsgVote) Type() string { return TypeMsgVote }
+
+// ValidateBasic implements Msg
+func (m MsgVote) ValidateBasic() error {
+	if m.ProposalId == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "proposal id")
+	}
+	if err := validateAddress(m.Voter, "voter"); err != nil {
+		return err
+	}
+	if _, ok := VoteOption_name[int32(m.Option)]; !ok || m.Option == VoteOptionUnspecified {
+		return sdkerrors.Wrap(ErrInvalid, "vote option")
+	}
+	if len(m.Metadata) > MaxMetadataLength {
+		return sdkerrors.Wrap(ErrMaxLimit, "vote metadata")
+	}
+	return nil
+}
+
+// GetSignBytes implements Msg
+func (m MsgVote) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners implements Msg
+func (m MsgVote) GetSigners() []sdk.AccAddress {
+	return mustAccAddresses(m.Voter)
+}
+
+// Route implements Msg
+func (m MsgExec) Route() string { return RouterKey }
+
+// Type implements Msg
+func (m MsgExec) Type() string { return TypeMsgExec }
+
+// ValidateBasic implements Msg
+func (m MsgExec) ValidateBasic() error {
+	if m.ProposalId == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "proposal id")
+	}
+	return validateAddress(m.Signer, "signer")
+}
+
+// GetSignBytes implements Msg
+func (m MsgExec) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners implements Msg
+func (m MsgExec) GetSigners() []sdk.AccAddress {
+	return mustAccAddresses(m.Signer)
+}
+
+func validateAddress(address string, name string) error {
+	if _, err := sdk.AccAddressFromBech32(address); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "%s: %s", name, err)
+	}
+	return nil
+}
+
+func validateDecisionPolicy(policy DecisionPolicy) error {
+	if policy == nil {
+		return sdkerrors.Wrap(ErrEmpty, "decision policy")
+	}
+	if err := policy.ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(err, "decision policy")
+	}
+	return nil
+}
+
+func mustAccAddresses(addresses ...string) []sdk.AccAddress {
+	addrs := make([]sdk.AccAddress, len(addresses))
+	for i, address := range addresses {
+		addr, err := sdk.AccAddressFromBech32(address)
+		if err != nil {
+			panic(fmt.Sprintf("invalid address %s: %s", address, err))
+		}
+		addrs[i] = addr
+	}
+	return addrs
+}
@@ -0,0 +1,125 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/line/lfb-sdk/types"
+	banktypes "github.com/line/lfb-sdk/x/bank/types"
+	"github.com/line/lfb-sdk/x/group/types"
+)
+
+var (
+	admin  = sdk.AccAddress("________admin_______")
+	member = sdk.AccAddress("_______member_______")
+	policy = sdk.AccAddress("_______policy_______")
+)
+
+func TestMsgCreateGroupValidateBasic(t *testing.T) {
+	cases := map[string]struct {
+		members []types.Member
+		expErr  bool
+	}{
+		"valid": {
+			members: []types.Member{{Address: member.String(), Weight: "1"}},
+		},
+		"no members": {},
+		"zero weight": {
+			members: []types.Member{{Address: member.String(), Weight: "0"}},
+			expErr:  true,
+		},
+		"duplicate member": {
+			members: []types.Member{{Address: member.String(), Weight: "1"}, {Address: member.String(), Weight: "2"}},
+			expErr:  true,
+		},
+		"invalid address": {
+			members: []types.Member{{Address: "invalid", Weight: "1"}},
+			expErr:  true,
+		},
+	}
+
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			msg := types.MsgCreateGroup{Admin: admin.String(), Members: tc.members}
+			err := msg.ValidateBasic()
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMsgUpdateGroupMembersValidateBasic(t *testing.T) {
+	msg := types.MsgUpdateGroupMembers{
+		Admin:         admin.String(),
+		GroupId:       1,
+		MemberUpdates: []types.Member{{Address: member.String(), Weight: "0"}},
+	}
+	require.NoError(t, msg.ValidateBasic())
+
+	msg.MemberUpdates = nil
+	require.Error(t, msg.ValidateBasic())
+}
+
+func TestMsgCreateGroupPolicy(t *testing.T) {
+	msg, err := types.NewMsgCreateGroupPolicy(admin, 1, nil, types.NewThresholdDecisionPolicy("1", time.Hour))
+	require.NoError(t, err)
+	require.NoError(t, msg.ValidateBasic())
+	require.Equal(t, []sdk.AccAddress{admin}, msg.GetSigners())
+
+	msg, err = types.NewMsgCreateGroupPolicy(admin, 1, nil, types.NewThresholdDecisionPolicy("1", 0))
+	require.NoError(t, err)
+	require.Error(t, msg.ValidateBasic())
+
+	msg.DecisionPolicy = nil
+	require.Error(t, msg.ValidateBasic())
+}
+
+func TestMsgSubmitProposal(t *testing.T) {
+	coins := sdk.NewCoins(sdk.NewInt64Coin("stake", 10))
+	send := banktypes.NewMsgSend(policy, member, coins)
+
+	msg, err := types.NewMsgSubmitProposal(policy, []string{member.String()}, []sdk.Msg{send}, nil, types.ExecTry)
+	require.NoError(t, err)
+	require.NoError(t, msg.ValidateBasic())
+	require.Equal(t, []sdk.AccAddress{member}, msg.GetSigners())
+	require.Contains(t, string(msg.GetSignBytes()), "lfb-sdk/MsgSend")
+
+	msgs, err := msg.GetMsgs()
+	require.NoError(t, err)
+	require.Equal(t, []sdk.Msg{send}, msgs)
+
+	// the messages must be signed by the group policy account
+	msg, err = types.NewMsgSubmitProposal(policy, []string{member.String()}, []sdk.Msg{banktypes.NewMsgSend(member, policy, coins)}, nil, types.ExecUnspecified)
+	require.NoError(t, err)
+	require.Error(t, msg.ValidateBasic())
+
+	// the proposers must be unique
+	msg, err = types.NewMsgSubmitProposal(policy, []string{member.String(), member.String()}, []sdk.Msg{send}, nil, types.ExecUnspecified)
+	require.NoError(t, err)
+	require.Error(t, msg.ValidateBasic())
+
+	msg, err = types.NewMsgSubmitProposal(policy, nil, []sdk.Msg{send}, nil, types.ExecUnspecified)
+	require.NoError(t, err)
+	require.Error(t, msg.ValidateBasic())
+}
+
+func TestMsgVoteValidateBasic(t *testing.T) {
+	msg := types.MsgVote{ProposalId: 1, Voter: member.String(), Option: types.VoteOptionYes}
+	require.NoError(t, msg.ValidateBasic())
+
+	msg.Option = types.VoteOptionUnspecified
+	require.Error(t, msg.ValidateBasic())
+
+	msg.Option = types.VoteOption(10)
+	require.Error(t, msg.ValidateBasic())
+
+	msg.Option = types.VoteOptionNo
+	msg.ProposalId = 0
+	require.Error(t, msg.ValidateBasic())
+}
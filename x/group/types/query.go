@@ -0,0 +1,41 @@
+package types
+
+import (
+	codectypes "github.com/line/lfb-sdk/codec/types"
+)
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (m *QueryGroupPolicyInfoResponse) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	if m.Info == nil {
+		return nil
+	}
+	return m.Info.UnpackInterfaces(unpacker)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (m *QueryGroupPoliciesByGroupResponse) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for _, policy := range m.GroupPolicies {
+		if err := policy.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (m *QueryProposalResponse) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	if m.Proposal == nil {
+		return nil
+	}
+	return m.Proposal.UnpackInterfaces(unpacker)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (m *QueryProposalsByGroupPolicyResponse) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for _, proposal := range m.Proposals {
+		if err := proposal.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+	return nil
+}
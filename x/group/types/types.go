@@ -0,0 +1,390 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/line/lfb-sdk/codec"
+	codectypes "github.com/line/lfb-sdk/codec/types"
+	sdk "github.com/line/lfb-sdk/types"
+	sdkerrors "github.com/line/lfb-sdk/types/errors"
+)
+
+// MaxMetadataLength is the maximum length of the metadata of groups, group
+// policies, members, proposals and votes.
+const MaxMetadataLength = 255
+
+// DecisionPolicyResult is the result of whether a proposal passes or not a
+// decision policy.
+type DecisionPolicyResult struct {
+	// Allow determines if the proposal is allowed to pass.
+	Allow bool
+	// Final determines if the tally result is final or not. If final, then
+	// the proposal is closed, and the tally result is saved in the proposal's
+	// `FinalTallyResult` field.
+	Final bool
+}
+
+// DecisionPolicy is the persistent set of rules to determine the result of
+// election on a proposal.
+type DecisionPolicy interface {
+	codec.ProtoMarshaler
+
+	// GetVotingPeriod returns the duration after proposal submission where
+	// votes are accepted.
+	GetVotingPeriod() time.Duration
+	// Allow defines policy-specific logic to allow a proposal to pass or not,
+	// based on its tally result and the group's total power.
+	Allow(tallyResult TallyResult, totalPower string) (DecisionPolicyResult, error)
+	// ValidateBasic performs stateless validation of the decision policy.
+	ValidateBasic() error
+}
+
+var _ DecisionPolicy = &ThresholdDecisionPolicy{}
+
+// NewThresholdDecisionPolicy creates a threshold DecisionPolicy
+func NewThresholdDecisionPolicy(threshold string, votingPeriod time.Duration) DecisionPolicy {
+	return &ThresholdDecisionPolicy{Threshold: threshold, VotingPeriod: votingPeriod}
+}
+
+// ValidateBasic does basic validation on ThresholdDecisionPolicy
+func (p ThresholdDecisionPolicy) ValidateBasic() error {
+	if _, err := parsePositiveDec(p.Threshold); err != nil {
+		return sdkerrors.Wrap(err, "threshold")
+	}
+	if p.VotingPeriod <= 0 {
+		return sdkerrors.Wrap(ErrInvalid, "voting period must be positive")
+	}
+	return nil
+}
+
+// Allow allows a proposal to pass when the tally of yes votes equals or
+// exceeds the threshold, capped by the total power of the group.
+func (p ThresholdDecisionPolicy) Allow(tallyResult TallyResult, totalPower string) (DecisionPolicyResult, error) {
+	threshold, err := parsePositiveDec(p.Threshold)
+	if err != nil {
+		return DecisionPolicyResult{}, sdkerrors.Wrap(err, "threshold")
+	}
+	total, err := parseNonNegativeDec(totalPower)
+	if err != nil {
+		return DecisionPolicyResult{}, sdkerrors.Wrap(err, "total power")
+	}
+	if total.IsZero() {
+		return DecisionPolicyResult{Allow: false, Final: true}, nil
+	}
+	yes, undecided, err := tallyResult.yesAndUndecided(total)
+	if err != nil {
+		return DecisionPolicyResult{}, err
+	}
+
+	// the threshold can never be reached if it exceeds the total power of the
+	// group, so the total power is required instead in that case
+	threshold = sdk.MinDec(threshold, total)
+
+	if yes.GTE(threshold) {
+		return DecisionPolicyResult{Allow: true, Final: true}, nil
+	}
+	if yes.Add(undecided).LT(threshold) {
+		return DecisionPolicyResult{Allow: false, Final: true}, nil
+	}
+	return DecisionPolicyResult{Allow: false, Final: false}, nil
+}
+
+var _ DecisionPolicy = &PercentageDecisionPolicy{}
+
+// NewPercentageDecisionPolicy creates a new percentage DecisionPolicy
+func NewPercentageDecisionPolicy(percentage string, votingPeriod time.Duration) DecisionPolicy {
+	return &PercentageDecisionPolicy{Percentage: percentage, VotingPeriod: votingPeriod}
+}
+
+// ValidateBasic does basic validation on PercentageDecisionPolicy
+func (p PercentageDecisionPolicy) ValidateBasic() error {
+	percentage, err := parsePositiveDec(p.Percentage)
+	if err != nil {
+		return sdkerrors.Wrap(err, "percentage")
+	}
+	if percentage.GT(sdk.OneDec()) {
+		return sdkerrors.Wrap(ErrInvalid, "percentage must be > 0 and <= 1")
+	}
+	if p.VotingPeriod <= 0 {
+		return sdkerrors.Wrap(ErrInvalid, "voting period must be positive")
+	}
+	return nil
+}
+
+// Allow allows a proposal to pass when the yes votes represent at least the
+// percentage of the total power of the group.
+func (p PercentageDecisionPolicy) Allow(tallyResult TallyResult, totalPower string) (DecisionPolicyResult, error) {
+	percentage, err := parsePositiveDec(p.Percentage)
+	if err != nil {
+		return DecisionPolicyResult{}, sdkerrors.Wrap(err, "percentage")
+	}
+	total, err := parseNonNegativeDec(totalPower)
+	if err != nil {
+		return DecisionPolicyResult{}, sdkerrors.Wrap(err, "total power")
+	}
+	if total.IsZero() {
+		return DecisionPolicyResult{Allow: false, Final: true}, nil
+	}
+	yes, undecided, err := tallyResult.yesAndUndecided(total)
+	if err != nil {
+		return DecisionPolicyResult{}, err
+	}
+
+	if yes.Quo(total).GTE(percentage) {
+		return DecisionPolicyResult{Allow: true, Final: true}, nil
+	}
+	if yes.Add(undecided).Quo(total).LT(percentage) {
+		return DecisionPolicyResult{Allow: false, Final: true}, nil
+	}
+	return DecisionPolicyResult{Allow: false, Final: false}, nil
+}
+
+// ValidateBasic performs stateless validation of a member. A zero weight is
+// only allowed when allowZeroWeight is set, i.e. when removing a member.
+func (m Member) ValidateBasic(allowZeroWeight bool) error {
+	if _, err := sdk.AccAddressFromBech32(m.Address); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "member address: %s", err)
+	}
+	if allowZeroWeight {
+		if _, err := parseNonNegativeDec(m.Weight); err != nil {
+			return sdkerrors.Wrap(err, "member weight")
+		}
+	} else if _, err := parsePositiveDec(m.Weight); err != nil {
+		return sdkerrors.Wrap(err, "member weight")
+	}
+	if len(m.Metadata) > MaxMetadataLength {
+		return sdkerrors.Wrap(ErrMaxLimit, "member metadata")
+	}
+	return nil
+}
+
+// ValidateMembers validates a list of members and checks that none of them is
+// listed twice.
+func ValidateMembers(members []Member, allowZeroWeight bool) error {
+	seen := make(map[string]bool, len(members))
+	for _, member := range members {
+		if err := member.ValidateBasic(allowZeroWeight); err != nil {
+			return err
+		}
+		if seen[member.Address] {
+			return sdkerrors.Wrapf(ErrDuplicate, "member %s", member.Address)
+		}
+		seen[member.Address] = true
+	}
+	return nil
+}
+
+// GetDecisionPolicy returns the decision policy of the group policy.
+func (g GroupPolicyInfo) GetDecisionPolicy() DecisionPolicy {
+	if g.DecisionPolicy == nil {
+		return nil
+	}
+	policy, ok := g.DecisionPolicy.GetCachedValue().(DecisionPolicy)
+	if !ok {
+		return nil
+	}
+	return policy
+}
+
+// SetDecisionPolicy sets the decision policy of the group policy.
+func (g *GroupPolicyInfo) SetDecisionPolicy(policy DecisionPolicy) error {
+	any, err := packDecisionPolicy(policy)
+	if err != nil {
+		return err
+	}
+	g.DecisionPolicy = any
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (g GroupPolicyInfo) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var policy DecisionPolicy
+	return unpacker.UnpackAny(g.DecisionPolicy, &policy)
+}
+
+// GetMessages returns the sdk.Msgs executed when the proposal passes.
+func (p Proposal) GetMessages() ([]sdk.Msg, error) {
+	return UnpackMessages(p.Messages)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (p Proposal) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return unpackMessagesInterfaces(unpacker, p.Messages)
+}
+
+// DefaultTallyResult returns a TallyResult with all counts set to 0.
+func DefaultTallyResult() TallyResult {
+	return TallyResult{
+		YesCount:        "0",
+		NoCount:         "0",
+		AbstainCount:    "0",
+		NoWithVetoCount: "0",
+	}
+}
+
+// Add adds the weight of a voter to the count of its vote option.
+func (t *TallyResult) Add(option VoteOption, weight string) error {
+	w, err := parseNonNegativeDec(weight)
+	if err != nil {
+		return sdkerrors.Wrap(err, "weight")
+	}
+
+	var count *string
+	switch option {
+	case VoteOptionYes:
+		count = &t.YesCount
+	case VoteOptionNo:
+		count = &t.NoCount
+	case VoteOptionAbstain:
+		count = &t.AbstainCount
+	case VoteOptionNoWithVeto:
+		count = &t.NoWithVetoCount
+	default:
+		return sdkerrors.Wrapf(ErrInvalid, "unknown vote option %s", option)
+	}
+
+	c, err := parseNonNegativeDec(*count)
+	if err != nil {
+		return err
+	}
+	*count = c.Add(w).String()
+	return nil
+}
+
+// TotalCounts is the sum of the counts of all vote options.
+func (t TallyResult) TotalCounts() (sdk.Dec, error) {
+	total := sdk.ZeroDec()
+	for _, count := range []string{t.YesCount, t.NoCount, t.AbstainCount, t.NoWithVetoCount} {
+		c, err := parseNonNegativeDec(count)
+		if err != nil {
+			return sdk.Dec{}, err
+		}
+		total = total.Add(c)
+	}
+	return total, nil
+}
+
+// yesAndUndecided returns the yes count and the weight that did not vote yet
+// out of the total power.
+func (t TallyResult) yesAndUndecided(totalPower sdk.Dec) (yes, undecided sdk.Dec, err error) {
+	yes, err = parseNonNegativeDec(t.YesCount)
+	if err != nil {
+		return sdk.Dec{}, sdk.Dec{}, err
+	}
+	counts, err := t.TotalCounts()
+	if err != nil {
+		return sdk.Dec{}, sdk.Dec{}, err
+	}
+	undecided = totalPower.Sub(counts)
+	if undecided.IsNegative() {
+		undecided = sdk.ZeroDec()
+	}
+	return yes, undecided, nil
+}
+
+// VoteOptionFromString returns a VoteOption from a string. It returns an
+// error if the string is invalid.
+func VoteOptionFromString(str string) (VoteOption, error) {
+	vo, ok := VoteOption_value[str]
+	if !ok || vo == int32(VoteOptionUnspecified) {
+		return VoteOptionUnspecified, fmt.Errorf("'%s' is not a valid vote option", str)
+	}
+	return VoteOption(vo), nil
+}
+
+func parseDec(str string) (sdk.Dec, error) {
+	if str == "" {
+		return sdk.Dec{}, sdkerrors.Wrap(ErrEmpty, "decimal")
+	}
+	dec, err := sdk.NewDecFromStr(str)
+	if err != nil {
+		return sdk.Dec{}, sdkerrors.Wrap(ErrInvalid, err.Error())
+	}
+	return dec, nil
+}
+
+func parsePositiveDec(str string) (sdk.Dec, error) {
+	dec, err := parseDec(str)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	if !dec.IsPositive() {
+		return sdk.Dec{}, sdkerrors.Wrapf(ErrInvalid, "expected a positive decimal, got %s", str)
+	}
+	return dec, nil
+}
+
+func parseNonNegativeDec(str string) (sdk.Dec, error) {
+	dec, err := parseDec(str)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	if dec.IsNegative() {
+		return sdk.Dec{}, sdkerrors.Wrapf(ErrInvalid, "expected a non-negative decimal, got %s", str)
+	}
+	return dec, nil
+}
+
+// ParseWeight parses the weight of a member, or the total weight of a group.
+func ParseWeight(weight string) (sdk.Dec, error) {
+	return parseNonNegativeDec(weight)
+}
+
+func packDecisionPolicy(policy DecisionPolicy) (*codectypes.Any, error) {
+	msg, ok := policy.(proto.Message)
+	if !ok {
+		return nil, fmt.Errorf("can't proto marshal %T", policy)
+	}
+	return codectypes.NewAnyWithValue(msg)
+}
+
+// PackMessages packs the messages of a proposal into Anys. The requests of
+// ServiceMsgs are packed in place of the ServiceMsgs themselves.
+func PackMessages(msgs []sdk.Msg) ([]*codectypes.Any, error) {
+	if len(msgs) == 0 {
+		return nil, nil
+	}
+
+	anys := make([]*codectypes.Any, len(msgs))
+	for i, msg := range msgs {
+		var m proto.Message = msg
+		if svcMsg, ok := msg.(sdk.ServiceMsg); ok {
+			m = svcMsg.Request
+		}
+
+		any, err := codectypes.NewAnyWithValue(m)
+		if err != nil {
+			return nil, err
+		}
+		anys[i] = any
+	}
+
+	return anys, nil
+}
+
+// UnpackMessages returns the cached sdk.Msgs of the given Anys.
+func UnpackMessages(anys []*codectypes.Any) ([]sdk.Msg, error) {
+	msgs := make([]sdk.Msg, len(anys))
+	for i, any := range anys {
+		msg, ok := any.GetCachedValue().(sdk.Msg)
+		if !ok {
+			return nil, sdkerrors.Wrapf(ErrInvalid, "messages contains %T which is not a sdk.Msg", any)
+		}
+		msgs[i] = msg
+	}
+
+	return msgs, nil
+}
+
+func unpackMessagesInterfaces(unpacker codectypes.AnyUnpacker, anys []*codectypes.Any) error {
+	for _, any := range anys {
+		var msg sdk.Msg
+		if err := unpacker.UnpackAny(any, &msg); err != nil {
+			return err
+		}
+	}
+	return nil
+}
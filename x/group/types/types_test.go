@@ -0,0 +1,116 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/line/lfb-sdk/x/group/types"
+)
+
+func tally(yes, no, abstain, veto string) types.TallyResult {
+	return types.TallyResult{YesCount: yes, NoCount: no, AbstainCount: abstain, NoWithVetoCount: veto}
+}
+
+func TestThresholdDecisionPolicy(t *testing.T) {
+	cases := map[string]struct {
+		threshold  string
+		tally      types.TallyResult
+		totalPower string
+		exp        types.DecisionPolicyResult
+	}{
+		"accepted when the threshold is reached": {
+			threshold: "2", tally: tally("2", "0", "0", "0"), totalPower: "3",
+			exp: types.DecisionPolicyResult{Allow: true, Final: true},
+		},
+		"not final while the threshold can be reached": {
+			threshold: "2", tally: tally("1", "1", "0", "0"), totalPower: "3",
+			exp: types.DecisionPolicyResult{Allow: false, Final: false},
+		},
+		"rejected when the threshold can't be reached anymore": {
+			threshold: "2", tally: tally("1", "1", "1", "0"), totalPower: "3",
+			exp: types.DecisionPolicyResult{Allow: false, Final: true},
+		},
+		"threshold capped by the total power": {
+			threshold: "10", tally: tally("3", "0", "0", "0"), totalPower: "3",
+			exp: types.DecisionPolicyResult{Allow: true, Final: true},
+		},
+		"rejected when the group is empty": {
+			threshold: "1", tally: tally("0", "0", "0", "0"), totalPower: "0",
+			exp: types.DecisionPolicyResult{Allow: false, Final: true},
+		},
+	}
+
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			policy := types.NewThresholdDecisionPolicy(tc.threshold, time.Hour)
+			require.NoError(t, policy.ValidateBasic())
+			res, err := policy.Allow(tc.tally, tc.totalPower)
+			require.NoError(t, err)
+			require.Equal(t, tc.exp, res)
+		})
+	}
+}
+
+func TestPercentageDecisionPolicy(t *testing.T) {
+	cases := map[string]struct {
+		percentage string
+		tally      types.TallyResult
+		totalPower string
+		exp        types.DecisionPolicyResult
+	}{
+		"accepted when the percentage is reached": {
+			percentage: "0.5", tally: tally("2", "0", "0", "0"), totalPower: "4",
+			exp: types.DecisionPolicyResult{Allow: true, Final: true},
+		},
+		"not final while the percentage can be reached": {
+			percentage: "0.5", tally: tally("1", "1", "0", "0"), totalPower: "4",
+			exp: types.DecisionPolicyResult{Allow: false, Final: false},
+		},
+		"rejected when the percentage can't be reached anymore": {
+			percentage: "0.5", tally: tally("1", "2", "0", "1"), totalPower: "4",
+			exp: types.DecisionPolicyResult{Allow: false, Final: true},
+		},
+		"rejected when the group is empty": {
+			percentage: "0.5", tally: tally("0", "0", "0", "0"), totalPower: "0",
+			exp: types.DecisionPolicyResult{Allow: false, Final: true},
+		},
+	}
+
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			policy := types.NewPercentageDecisionPolicy(tc.percentage, time.Hour)
+			require.NoError(t, policy.ValidateBasic())
+			res, err := policy.Allow(tc.tally, tc.totalPower)
+			require.NoError(t, err)
+			require.Equal(t, tc.exp, res)
+		})
+	}
+}
+
+func TestDecisionPolicyValidateBasic(t *testing.T) {
+	require.Error(t, types.NewThresholdDecisionPolicy("0", time.Hour).ValidateBasic())
+	require.Error(t, types.NewThresholdDecisionPolicy("-1", time.Hour).ValidateBasic())
+	require.Error(t, types.NewThresholdDecisionPolicy("abc", time.Hour).ValidateBasic())
+	require.Error(t, types.NewThresholdDecisionPolicy("1", 0).ValidateBasic())
+	require.Error(t, types.NewPercentageDecisionPolicy("0", time.Hour).ValidateBasic())
+	require.Error(t, types.NewPercentageDecisionPolicy("1.1", time.Hour).ValidateBasic())
+	require.Error(t, types.NewPercentageDecisionPolicy("0.5", 0).ValidateBasic())
+	require.NoError(t, types.NewPercentageDecisionPolicy("1", time.Hour).ValidateBasic())
+}
+
+func TestTallyResultAdd(t *testing.T) {
+	tally := types.DefaultTallyResult()
+	require.NoError(t, tally.Add(types.VoteOptionYes, "1.5"))
+	require.NoError(t, tally.Add(types.VoteOptionNo, "2"))
+	require.NoError(t, tally.Add(types.VoteOptionAbstain, "1"))
+	require.NoError(t, tally.Add(types.VoteOptionNoWithVeto, "0.5"))
+	require.Error(t, tally.Add(types.VoteOptionUnspecified, "1"))
+
+	total, err := tally.TotalCounts()
+	require.NoError(t, err)
+	require.Equal(t, "5.000000000000000000", total.String())
+}
@@ -0,0 +1,240 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/line/lfb-sdk/client"
+	"github.com/line/lfb-sdk/client/flags"
+	"github.com/line/lfb-sdk/version"
+	"github.com/line/lfb-sdk/x/ibc/applications/fee/types"
+)
+
+// GetCmdIncentivizedPacket returns the unrelayed incentivized packet for a given packetID
+func GetCmdIncentivizedPacket() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "packet [port-id] [channel-id] [sequence]",
+		Short:   "Query for an unrelayed incentivized packet by port-id, channel-id and packet sequence.",
+		Long:    "Query for an unrelayed incentivized packet by port-id, channel-id and packet sequence.",
+		Args:    cobra.ExactArgs(3),
+		Example: fmt.Sprintf("%s query ibc-fee packet transfer channel-5 100", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			seq, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryIncentivizedPacketRequest{
+				PortId:    args[0],
+				ChannelId: args[1],
+				Sequence:  seq,
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.IncentivizedPacket(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdIncentivizedPackets returns all of the unrelayed incentivized packets
+func GetCmdIncentivizedPackets() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "packets",
+		Short:   "Query for all of the unrelayed incentivized packets and associated fees across all channels.",
+		Long:    "Query for all of the unrelayed incentivized packets and associated fees across all channels.",
+		Args:    cobra.NoArgs,
+		Example: fmt.Sprintf("%s query ibc-fee packets", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryIncentivizedPacketsRequest{
+				Pagination: pageReq,
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.IncentivizedPackets(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "packets")
+
+	return cmd
+}
+
+// GetCmdPayee returns the command handler for the Query/Payee rpc.
+func GetCmdPayee() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "payee [channel-id] [relayer]",
+		Short:   "Query the relayer payee address on a given channel",
+		Long:    "Query the relayer payee address on a given channel",
+		Args:    cobra.ExactArgs(2),
+		Example: fmt.Sprintf("%s query ibc-fee payee channel-5 link1...", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryPayeeRequest{
+				ChannelId: args[0],
+				Relayer:   args[1],
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Payee(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdCounterpartyPayee returns the command handler for the Query/CounterpartyPayee rpc.
+func GetCmdCounterpartyPayee() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "counterparty-payee [channel-id] [relayer]",
+		Short:   "Query the relayer counterparty payee on a given channel",
+		Long:    "Query the relayer counterparty payee on a given channel",
+		Args:    cobra.ExactArgs(2),
+		Example: fmt.Sprintf("%s query ibc-fee counterparty-payee channel-5 link1...", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryCounterpartyPayeeRequest{
+				ChannelId: args[0],
+				Relayer:   args[1],
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.CounterpartyPayee(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdFeeEnabledChannel returns the command handler for the Query/FeeEnabledChannel rpc.
+func GetCmdFeeEnabledChannel() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "channel [port-id] [channel-id]",
+		Short:   "Query the ics29 fee enabled status of a channel",
+		Long:    "Query the ics29 fee enabled status of a channel specified by port-id and channel-id",
+		Args:    cobra.ExactArgs(2),
+		Example: fmt.Sprintf("%s query ibc-fee channel transfer channel-6", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryFeeEnabledChannelRequest{
+				PortId:    args[0],
+				ChannelId: args[1],
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.FeeEnabledChannel(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdFeeEnabledChannels returns the command handler for the Query/FeeEnabledChannels rpc.
+func GetCmdFeeEnabledChannels() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "channels",
+		Short:   "Query the ics29 fee enabled channels",
+		Long:    "Query the ics29 fee enabled channels",
+		Args:    cobra.NoArgs,
+		Example: fmt.Sprintf("%s query ibc-fee channels", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryFeeEnabledChannelsRequest{
+				Pagination: pageReq,
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.FeeEnabledChannels(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "channels")
+
+	return cmd
+}
@@ -0,0 +1,148 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/line/lfb-sdk/client"
+	"github.com/line/lfb-sdk/client/flags"
+	"github.com/line/lfb-sdk/client/tx"
+	sdk "github.com/line/lfb-sdk/types"
+	"github.com/line/lfb-sdk/version"
+	"github.com/line/lfb-sdk/x/ibc/applications/fee/types"
+)
+
+const (
+	flagRecvFee    = "recv-fee"
+	flagAckFee     = "ack-fee"
+	flagTimeoutFee = "timeout-fee"
+)
+
+// NewRegisterPayeeCmd returns the command to create a MsgRegisterPayee
+func NewRegisterPayeeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "register-payee [port-id] [channel-id] [payee]",
+		Short:   "Register a payee on a given channel.",
+		Long:    "Register a payee address on a given channel to which acknowledgement and timeout fees earned by the sender are paid out.",
+		Args:    cobra.ExactArgs(3),
+		Example: fmt.Sprintf("%s tx ibc-fee register-payee transfer channel-0 link1... --from [relayer]", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRegisterPayee(args[0], args[1], clientCtx.GetFromAddress().String(), args[2])
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewRegisterCounterpartyPayeeCmd returns the command to create a MsgRegisterCounterpartyPayee
+func NewRegisterCounterpartyPayeeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "register-counterparty-payee [port-id] [channel-id] [counterparty-payee]",
+		Short:   "Register a counterparty payee address on a given channel.",
+		Long:    "Register a counterparty payee address on a given channel to which receive fees earned by the sender on the counterparty chain are paid out.",
+		Args:    cobra.ExactArgs(3),
+		Example: fmt.Sprintf("%s tx ibc-fee register-counterparty-payee transfer channel-0 link1... --from [relayer]", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRegisterCounterpartyPayee(args[0], args[1], clientCtx.GetFromAddress().String(), args[2])
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewPayPacketFeeAsyncTxCmd returns the command to create a MsgPayPacketFeeAsync
+func NewPayPacketFeeAsyncTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pay-packet-fee [src-port] [src-channel] [sequence]",
+		Short: "Pay a fee to incentivize an existing IBC packet",
+		Long: strings.TrimSpace(`Pay a fee to incentivize an existing IBC packet. The fees are escrowed until the
+packet is acknowledged or timed out, unused fees are refunded to the sender.`),
+		Example: fmt.Sprintf("%s tx ibc-fee pay-packet-fee transfer channel-0 1 --recv-fee 10stake --ack-fee 10stake --timeout-fee 10stake --from [sender]", version.AppName),
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			sequence, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			packetID := types.NewPacketId(args[0], args[1], sequence)
+
+			recvFee, err := parseFeeFlag(cmd, flagRecvFee)
+			if err != nil {
+				return err
+			}
+
+			ackFee, err := parseFeeFlag(cmd, flagAckFee)
+			if err != nil {
+				return err
+			}
+
+			timeoutFee, err := parseFeeFlag(cmd, flagTimeoutFee)
+			if err != nil {
+				return err
+			}
+
+			fee := types.NewFee(recvFee, ackFee, timeoutFee)
+			packetFee := types.NewPacketFee(fee, clientCtx.GetFromAddress().String())
+
+			msg := types.NewMsgPayPacketFeeAsync(packetID, packetFee)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(flagRecvFee, "", "Fee paid to a relayer for relaying a packet receive.")
+	cmd.Flags().String(flagAckFee, "", "Fee paid to a relayer for relaying a packet acknowledgement.")
+	cmd.Flags().String(flagTimeoutFee, "", "Fee paid to a relayer for relaying a packet timeout.")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func parseFeeFlag(cmd *cobra.Command, flagName string) (sdk.Coins, error) {
+	feeStr, err := cmd.Flags().GetString(flagName)
+	if err != nil {
+		return nil, err
+	}
+
+	if feeStr == "" {
+		return nil, nil
+	}
+
+	return sdk.ParseCoinsNormalized(feeStr)
+}
@@ -0,0 +1,206 @@
+package fee_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	sdk "github.com/line/lfb-sdk/types"
+	"github.com/line/lfb-sdk/x/ibc/applications/fee/keeper"
+	"github.com/line/lfb-sdk/x/ibc/applications/fee/types"
+	transfertypes "github.com/line/lfb-sdk/x/ibc/applications/transfer/types"
+	clienttypes "github.com/line/lfb-sdk/x/ibc/core/02-client/types"
+	channeltypes "github.com/line/lfb-sdk/x/ibc/core/04-channel/types"
+	host "github.com/line/lfb-sdk/x/ibc/core/24-host"
+	"github.com/line/lfb-sdk/x/ibc/core/exported"
+	ibctesting "github.com/line/lfb-sdk/x/ibc/testing"
+)
+
+var (
+	payee        = sdk.AccAddress("payee_______________")
+	forwardPayee = sdk.AccAddress("forward_payee_______")
+	fee          = types.NewFee(
+		sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)),
+		sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 200)),
+		sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 300)),
+	)
+	coinToSend = sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000)
+)
+
+type FeeTestSuite struct {
+	suite.Suite
+
+	coordinator *ibctesting.Coordinator
+
+	// testing chains used for convenience and readability
+	chainA *ibctesting.TestChain
+	chainB *ibctesting.TestChain
+
+	clientA, clientB   string
+	channelA, channelB ibctesting.TestChannel
+}
+
+// SetupTest creates a fee enabled transfer channel between chainA and chainB.
+func (suite *FeeTestSuite) SetupTest() {
+	suite.coordinator = ibctesting.NewCoordinator(suite.T(), 2)
+	suite.chainA = suite.coordinator.GetChain(ibctesting.GetChainID(0))
+	suite.chainB = suite.coordinator.GetChain(ibctesting.GetChainID(1))
+
+	var connA, connB *ibctesting.TestConnection
+	suite.clientA, suite.clientB, connA, connB = suite.coordinator.SetupClientConnections(suite.chainA, suite.chainB, exported.Tendermint)
+
+	feeVersion := types.NewMetadata(transfertypes.Version).ChannelVersion()
+	connA.NextChannelVersion = feeVersion
+	connB.NextChannelVersion = feeVersion
+	suite.channelA, suite.channelB = suite.coordinator.CreateTransferChannels(suite.chainA, suite.chainB, connA, connB, channeltypes.UNORDERED)
+}
+
+// registerPayees registers the payee of the relayer of chainA and the
+// counterparty payee of the relayer of chainB.
+func (suite *FeeTestSuite) registerPayees() {
+	msg := types.NewMsgRegisterPayee(suite.channelA.PortID, suite.channelA.ID, suite.chainA.SenderAccount.GetAddress().String(), payee.String())
+	err := suite.coordinator.SendMsg(suite.chainA, suite.chainB, suite.clientB, msg)
+	suite.Require().NoError(err)
+
+	cpMsg := types.NewMsgRegisterCounterpartyPayee(suite.channelB.PortID, suite.channelB.ID, suite.chainB.SenderAccount.GetAddress().String(), forwardPayee.String())
+	err = suite.coordinator.SendMsg(suite.chainB, suite.chainA, suite.clientA, cpMsg)
+	suite.Require().NoError(err)
+}
+
+// transferWithFee escrows the fee and sends a transfer from chainA to chainB in a
+// single transaction, it returns the packet sent.
+func (suite *FeeTestSuite) transferWithFee(timeoutHeight clienttypes.Height) channeltypes.Packet {
+	sender := suite.chainA.SenderAccount.GetAddress()
+	receiver := suite.chainB.SenderAccount.GetAddress()
+
+	msgs := []sdk.Msg{
+		types.NewMsgPayPacketFee(fee, suite.channelA.PortID, suite.channelA.ID, sender.String()),
+		transfertypes.NewMsgTransfer(suite.channelA.PortID, suite.channelA.ID, coinToSend, sender, receiver.String(), timeoutHeight, 0),
+	}
+	err := suite.coordinator.SendMsgs(suite.chainA, suite.chainB, suite.clientB, msgs)
+	suite.Require().NoError(err)
+
+	data := transfertypes.NewFungibleTokenPacketData(coinToSend.Denom, coinToSend.Amount.Uint64(), sender.String(), receiver.String())
+	return channeltypes.NewPacket(data.GetBytes(), 1, suite.channelA.PortID, suite.channelA.ID, suite.channelB.PortID, suite.channelB.ID, timeoutHeight, 0)
+}
+
+func (suite *FeeTestSuite) balance(chain *ibctesting.TestChain, addr sdk.AccAddress) sdk.Coin {
+	return chain.App.BankKeeper.GetBalance(chain.GetContext(), addr, sdk.DefaultBondDenom)
+}
+
+func (suite *FeeTestSuite) TestFeeEnabledHandshake() {
+	suite.Require().True(suite.chainA.App.IBCFeeKeeper.IsFeeEnabled(suite.chainA.GetContext(), suite.channelA.PortID, suite.channelA.ID))
+	suite.Require().True(suite.chainB.App.IBCFeeKeeper.IsFeeEnabled(suite.chainB.GetContext(), suite.channelB.PortID, suite.channelB.ID))
+
+	// the channel version is the fee metadata wrapping the transfer version
+	channel := suite.chainA.GetChannel(suite.channelA)
+	metadata, err := types.MetadataFromVersion(channel.Version)
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.NewMetadata(transfertypes.Version), metadata)
+}
+
+func (suite *FeeTestSuite) TestNonFeeChannel() {
+	clientA, clientB, connA, connB := suite.coordinator.SetupClientConnections(suite.chainA, suite.chainB, exported.Tendermint)
+	channelA, channelB := suite.coordinator.CreateTransferChannels(suite.chainA, suite.chainB, connA, connB, channeltypes.UNORDERED)
+
+	suite.Require().False(suite.chainA.App.IBCFeeKeeper.IsFeeEnabled(suite.chainA.GetContext(), channelA.PortID, channelA.ID))
+	suite.Require().False(suite.chainB.App.IBCFeeKeeper.IsFeeEnabled(suite.chainB.GetContext(), channelB.PortID, channelB.ID))
+
+	// fees cannot be escrowed on the channel
+	sender := suite.chainA.SenderAccount.GetAddress()
+	msgServer := keeper.NewMsgServerImpl(suite.chainA.App.IBCFeeKeeper)
+	_, err := msgServer.PayPacketFee(sdk.WrapSDKContext(suite.chainA.GetContext()), types.NewMsgPayPacketFee(fee, channelA.PortID, channelA.ID, sender.String()))
+	suite.Require().ErrorIs(err, types.ErrFeeNotEnabled)
+
+	// packets are relayed with the plain application acknowledgement
+	timeoutHeight := clienttypes.NewHeight(0, 110)
+	receiver := suite.chainB.SenderAccount.GetAddress()
+	msg := transfertypes.NewMsgTransfer(channelA.PortID, channelA.ID, coinToSend, sender, receiver.String(), timeoutHeight, 0)
+	err = suite.coordinator.SendMsg(suite.chainA, suite.chainB, clientB, msg)
+	suite.Require().NoError(err)
+
+	data := transfertypes.NewFungibleTokenPacketData(coinToSend.Denom, coinToSend.Amount.Uint64(), sender.String(), receiver.String())
+	packet := channeltypes.NewPacket(data.GetBytes(), 1, channelA.PortID, channelA.ID, channelB.PortID, channelB.ID, timeoutHeight, 0)
+	ack := channeltypes.NewResultAcknowledgement([]byte{byte(1)})
+	err = suite.coordinator.RelayPacket(suite.chainA, suite.chainB, clientA, clientB, packet, ack.GetBytes())
+	suite.Require().NoError(err)
+}
+
+func (suite *FeeTestSuite) TestPayFeeOnAcknowledgement() {
+	suite.registerPayees()
+
+	refundBalance := suite.balance(suite.chainA, suite.chainA.SenderAccount.GetAddress())
+
+	packet := suite.transferWithFee(clienttypes.NewHeight(0, 110))
+
+	packetID := types.NewPacketId(packet.SourcePort, packet.SourceChannel, packet.Sequence)
+	feesInEscrow, found := suite.chainA.App.IBCFeeKeeper.GetFeesInEscrow(suite.chainA.GetContext(), packetID)
+	suite.Require().True(found)
+	suite.Require().Equal(types.NewPacketFees([]types.PacketFee{types.NewPacketFee(fee, suite.chainA.SenderAccount.GetAddress().String())}), feesInEscrow)
+
+	moduleAddr := suite.chainA.App.IBCFeeKeeper.GetFeeModuleAddress()
+	suite.Require().Equal(fee.Total(), sdk.NewCoins(suite.balance(suite.chainA, moduleAddr)))
+
+	// the acknowledgement carries the counterparty payee of the relayer of chainB
+	ack := types.NewIncentivizedAcknowledgement(forwardPayee.String(), channeltypes.NewResultAcknowledgement([]byte{byte(1)}).GetBytes(), true)
+	err := suite.coordinator.RelayPacket(suite.chainA, suite.chainB, suite.clientA, suite.clientB, packet, ack.Acknowledgement())
+	suite.Require().NoError(err)
+
+	// the transfer was executed by the underlying application
+	voucher := transfertypes.ParseDenomTrace(transfertypes.GetPrefixedDenom(packet.DestinationPort, packet.DestinationChannel, sdk.DefaultBondDenom))
+	balance := suite.chainB.App.BankKeeper.GetBalance(suite.chainB.GetContext(), suite.chainB.SenderAccount.GetAddress(), voucher.IBCDenom())
+	suite.Require().Equal(coinToSend.Amount, balance.Amount)
+
+	// recv fee is paid to the forward relayer and ack fee to the payee of the reverse relayer
+	suite.Require().Equal(fee.RecvFee, sdk.NewCoins(suite.balance(suite.chainA, forwardPayee)))
+	suite.Require().Equal(fee.AckFee, sdk.NewCoins(suite.balance(suite.chainA, payee)))
+
+	// timeout fee is refunded
+	expRefundBalance := refundBalance.Sub(coinToSend).Sub(fee.RecvFee[0]).Sub(fee.AckFee[0])
+	suite.Require().Equal(expRefundBalance, suite.balance(suite.chainA, suite.chainA.SenderAccount.GetAddress()))
+
+	suite.Require().True(suite.balance(suite.chainA, moduleAddr).IsZero())
+	suite.Require().False(suite.chainA.App.IBCFeeKeeper.HasFeesInEscrow(suite.chainA.GetContext(), packetID))
+}
+
+func (suite *FeeTestSuite) TestRefundRecvFeeWithoutCounterpartyPayee() {
+	refundBalance := suite.balance(suite.chainA, suite.chainA.SenderAccount.GetAddress())
+
+	packet := suite.transferWithFee(clienttypes.NewHeight(0, 110))
+
+	// no counterparty payee is registered so the forward relayer is empty
+	ack := types.NewIncentivizedAcknowledgement("", channeltypes.NewResultAcknowledgement([]byte{byte(1)}).GetBytes(), true)
+	err := suite.coordinator.RelayPacket(suite.chainA, suite.chainB, suite.clientA, suite.clientB, packet, ack.Acknowledgement())
+	suite.Require().NoError(err)
+
+	// the relayer of chainA is the sender as well, it is only charged the transfer
+	suite.Require().Equal(refundBalance.Sub(coinToSend), suite.balance(suite.chainA, suite.chainA.SenderAccount.GetAddress()))
+}
+
+func (suite *FeeTestSuite) TestPayFeeOnTimeout() {
+	suite.registerPayees()
+
+	refundBalance := suite.balance(suite.chainA, suite.chainA.SenderAccount.GetAddress())
+
+	packet := suite.transferWithFee(clienttypes.GetSelfHeight(suite.chainB.GetContext()))
+
+	// need to update chainA client to prove missing receipt
+	err := suite.coordinator.UpdateClient(suite.chainA, suite.chainB, suite.clientA, exported.Tendermint)
+	suite.Require().NoError(err)
+
+	proof, proofHeight := suite.chainB.QueryProof(host.PacketReceiptKey(packet.DestinationPort, packet.DestinationChannel, packet.Sequence))
+	msg := channeltypes.NewMsgTimeout(packet, 1, proof, proofHeight, suite.chainA.SenderAccount.GetAddress())
+	err = suite.coordinator.SendMsg(suite.chainA, suite.chainB, suite.clientB, msg)
+	suite.Require().NoError(err)
+
+	// timeout fee is paid to the payee of the relayer, recv and ack fees and the transfer are refunded
+	suite.Require().Equal(fee.TimeoutFee, sdk.NewCoins(suite.balance(suite.chainA, payee)))
+	suite.Require().Equal(refundBalance.Sub(fee.TimeoutFee[0]), suite.balance(suite.chainA, suite.chainA.SenderAccount.GetAddress()))
+
+	packetID := types.NewPacketId(packet.SourcePort, packet.SourceChannel, packet.Sequence)
+	suite.Require().False(suite.chainA.App.IBCFeeKeeper.HasFeesInEscrow(suite.chainA.GetContext(), packetID))
+}
+
+func TestFeeTestSuite(t *testing.T) {
+	suite.Run(t, new(FeeTestSuite))
+}
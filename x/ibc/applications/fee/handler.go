@@ -0,0 +1,38 @@
+package fee
+
+import (
+	sdk "github.com/line/lfb-sdk/types"
+	sdkerrors "github.com/line/lfb-sdk/types/errors"
+	"github.com/line/lfb-sdk/x/ibc/applications/fee/keeper"
+	"github.com/line/lfb-sdk/x/ibc/applications/fee/types"
+)
+
+// NewHandler returns sdk.Handler for IBC fee middleware messages
+func NewHandler(k keeper.Keeper) sdk.Handler {
+	msgServer := keeper.NewMsgServerImpl(k)
+
+	return func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+		switch msg := msg.(type) {
+		case *types.MsgRegisterPayee:
+			res, err := msgServer.RegisterPayee(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgRegisterCounterpartyPayee:
+			res, err := msgServer.RegisterCounterpartyPayee(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgPayPacketFee:
+			res, err := msgServer.PayPacketFee(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgPayPacketFeeAsync:
+			res, err := msgServer.PayPacketFeeAsync(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		default:
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized ICS-29 fee message type: %T", msg)
+		}
+	}
+}
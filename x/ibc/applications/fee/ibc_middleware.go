@@ -0,0 +1,293 @@
+package fee
+
+import (
+	sdk "github.com/line/lfb-sdk/types"
+	sdkerrors "github.com/line/lfb-sdk/types/errors"
+	capabilitytypes "github.com/line/lfb-sdk/x/capability/types"
+	"github.com/line/lfb-sdk/x/ibc/applications/fee/keeper"
+	"github.com/line/lfb-sdk/x/ibc/applications/fee/types"
+	channeltypes "github.com/line/lfb-sdk/x/ibc/core/04-channel/types"
+	porttypes "github.com/line/lfb-sdk/x/ibc/core/05-port/types"
+	"github.com/line/lfb-sdk/x/ibc/core/exported"
+)
+
+var _ porttypes.Middleware = &IBCMiddleware{}
+
+// IBCMiddleware implements the ICS26 callbacks for the fee middleware given the
+// fee keeper and the underlying application.
+type IBCMiddleware struct {
+	app    porttypes.IBCModule
+	keeper keeper.Keeper
+}
+
+// NewIBCMiddleware creates a new IBCMiddlware given the keeper and underlying application
+func NewIBCMiddleware(app porttypes.IBCModule, k keeper.Keeper) IBCMiddleware {
+	return IBCMiddleware{
+		app:    app,
+		keeper: k,
+	}
+}
+
+// OnChanOpenInit implements the IBCMiddleware interface
+func (im IBCMiddleware) OnChanOpenInit(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID string,
+	channelID string,
+	chanCap *capabilitytypes.Capability,
+	counterparty channeltypes.Counterparty,
+	version string,
+) error {
+	versionMetadata, err := types.MetadataFromVersion(version)
+	if err != nil {
+		// Since it is valid for fee version to not be specified, the above middleware version may be for a middleware
+		// lower down in the stack. Thus, if it is not a fee version we pass the entire version string onto the underlying
+		// application.
+		return im.app.OnChanOpenInit(ctx, order, connectionHops, portID, channelID,
+			chanCap, counterparty, version)
+	}
+
+	if err := versionMetadata.ValidateBasic(); err != nil {
+		return err
+	}
+
+	if err := im.app.OnChanOpenInit(ctx, order, connectionHops, portID, channelID,
+		chanCap, counterparty, versionMetadata.AppVersion); err != nil {
+		return err
+	}
+
+	im.keeper.SetFeeEnabled(ctx, portID, channelID)
+
+	return nil
+}
+
+// OnChanOpenTry implements the IBCMiddleware interface
+// If the counterparty version is not fee metadata the versions are passed through to the underlying application.
+// Otherwise both versions must be fee metadata and the underlying application receives the wrapped app versions.
+func (im IBCMiddleware) OnChanOpenTry(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID,
+	channelID string,
+	chanCap *capabilitytypes.Capability,
+	counterparty channeltypes.Counterparty,
+	version,
+	counterpartyVersion string,
+) error {
+	cpMetadata, err := types.MetadataFromVersion(counterpartyVersion)
+	if err != nil {
+		return im.app.OnChanOpenTry(ctx, order, connectionHops, portID, channelID, chanCap, counterparty, version, counterpartyVersion)
+	}
+
+	if err := cpMetadata.ValidateBasic(); err != nil {
+		return err
+	}
+
+	// the version proposed by the relayer on this end must agree on the fee version
+	versionMetadata, err := types.MetadataFromVersion(version)
+	if err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidVersion, "counterparty version %s is fee enabled but version %s is not", counterpartyVersion, version)
+	}
+
+	if err := versionMetadata.ValidateBasic(); err != nil {
+		return err
+	}
+
+	if err := im.app.OnChanOpenTry(ctx, order, connectionHops, portID, channelID, chanCap, counterparty,
+		versionMetadata.AppVersion, cpMetadata.AppVersion); err != nil {
+		return err
+	}
+
+	im.keeper.SetFeeEnabled(ctx, portID, channelID)
+
+	return nil
+}
+
+// OnChanOpenAck implements the IBCMiddleware interface
+func (im IBCMiddleware) OnChanOpenAck(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	counterpartyVersion string,
+) error {
+	// If handshake was initialized with fee enabled it must complete with fee enabled.
+	// If handshake was initialized with fee disabled it must complete with fee disabled.
+	if im.keeper.IsFeeEnabled(ctx, portID, channelID) {
+		versionMetadata, err := types.MetadataFromVersion(counterpartyVersion)
+		if err != nil {
+			return sdkerrors.Wrapf(err, "failed to unmarshal ICS29 counterparty version metadata: %s", counterpartyVersion)
+		}
+
+		if err := versionMetadata.ValidateBasic(); err != nil {
+			return err
+		}
+
+		// call underlying app's OnChanOpenAck callback with the counterparty app version.
+		return im.app.OnChanOpenAck(ctx, portID, channelID, versionMetadata.AppVersion)
+	}
+
+	// call underlying app's OnChanOpenAck callback with the counterparty app version.
+	return im.app.OnChanOpenAck(ctx, portID, channelID, counterpartyVersion)
+}
+
+// OnChanOpenConfirm implements the IBCMiddleware interface
+func (im IBCMiddleware) OnChanOpenConfirm(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+) error {
+	// call underlying app's OnChanOpenConfirm callback.
+	return im.app.OnChanOpenConfirm(ctx, portID, channelID)
+}
+
+// OnChanCloseInit implements the IBCMiddleware interface
+func (im IBCMiddleware) OnChanCloseInit(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+) error {
+	if err := im.app.OnChanCloseInit(ctx, portID, channelID); err != nil {
+		return err
+	}
+
+	if !im.keeper.IsFeeEnabled(ctx, portID, channelID) {
+		return nil
+	}
+
+	im.keeper.RefundFeesOnChannelClosure(ctx, portID, channelID)
+
+	return nil
+}
+
+// OnChanCloseConfirm implements the IBCMiddleware interface
+func (im IBCMiddleware) OnChanCloseConfirm(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+) error {
+	if err := im.app.OnChanCloseConfirm(ctx, portID, channelID); err != nil {
+		return err
+	}
+
+	if !im.keeper.IsFeeEnabled(ctx, portID, channelID) {
+		return nil
+	}
+
+	im.keeper.RefundFeesOnChannelClosure(ctx, portID, channelID)
+
+	return nil
+}
+
+// OnRecvPacket implements the IBCMiddleware interface.
+// If fees are not enabled, this callback will default to the ibc-core packet callback
+func (im IBCMiddleware) OnRecvPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) (*sdk.Result, []byte, error) {
+	if !im.keeper.IsFeeEnabled(ctx, packet.DestinationPort, packet.DestinationChannel) {
+		return im.app.OnRecvPacket(ctx, packet, relayer)
+	}
+
+	res, ack, err := im.app.OnRecvPacket(ctx, packet, relayer)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// in case of async acknowledgement (ack == nil) store the relayer address for use later during async WriteAcknowledgement
+	if ack == nil {
+		im.keeper.SetRelayerAddressForAsyncAck(ctx, types.NewPacketId(packet.DestinationPort, packet.DestinationChannel, packet.Sequence), relayer.String())
+		return res, nil, nil
+	}
+
+	// if forwardRelayer is not found we refund recv_fee
+	forwardRelayer, _ := im.keeper.GetCounterpartyPayeeAddress(ctx, relayer.String(), packet.DestinationChannel)
+
+	incentivizedAck := types.NewIncentivizedAcknowledgement(forwardRelayer, ack, types.IsAppAcknowledgementSuccess(ack))
+	return res, incentivizedAck.Acknowledgement(), nil
+}
+
+// OnAcknowledgementPacket implements the IBCMiddleware interface
+// If fees are not enabled, this callback will default to the ibc-core packet callback
+func (im IBCMiddleware) OnAcknowledgementPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	acknowledgement []byte,
+	relayer sdk.AccAddress,
+) (*sdk.Result, error) {
+	if !im.keeper.IsFeeEnabled(ctx, packet.SourcePort, packet.SourceChannel) {
+		return im.app.OnAcknowledgementPacket(ctx, packet, acknowledgement, relayer)
+	}
+
+	var ack types.IncentivizedAcknowledgement
+	if err := types.ModuleCdc.UnmarshalJSON(acknowledgement, &ack); err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal ICS-29 incentivized packet acknowledgement: %v", err)
+	}
+
+	packetID := types.NewPacketId(packet.SourcePort, packet.SourceChannel, packet.Sequence)
+	feesInEscrow, found := im.keeper.GetFeesInEscrow(ctx, packetID)
+	if found {
+		im.keeper.DistributePacketFeesOnAcknowledgement(ctx, ack.ForwardRelayerAddress, im.payee(ctx, relayer, packet.SourceChannel), feesInEscrow.PacketFees, packetID)
+	}
+
+	// call underlying callback
+	return im.app.OnAcknowledgementPacket(ctx, packet, ack.AppAcknowledgement, relayer)
+}
+
+// OnTimeoutPacket implements the IBCMiddleware interface
+// If fees are not enabled, this callback will default to the ibc-core packet callback
+func (im IBCMiddleware) OnTimeoutPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) (*sdk.Result, error) {
+	if !im.keeper.IsFeeEnabled(ctx, packet.SourcePort, packet.SourceChannel) {
+		return im.app.OnTimeoutPacket(ctx, packet, relayer)
+	}
+
+	packetID := types.NewPacketId(packet.SourcePort, packet.SourceChannel, packet.Sequence)
+	feesInEscrow, found := im.keeper.GetFeesInEscrow(ctx, packetID)
+	if found {
+		im.keeper.DistributePacketFeesOnTimeout(ctx, im.payee(ctx, relayer, packet.SourceChannel), feesInEscrow.PacketFees, packetID)
+	}
+
+	// call underlying callback
+	return im.app.OnTimeoutPacket(ctx, packet, relayer)
+}
+
+// payee returns the address registered by the relayer to receive the fees
+// distributed on the source chain, defaulting to the relayer itself.
+func (im IBCMiddleware) payee(ctx sdk.Context, relayer sdk.AccAddress, channelID string) sdk.AccAddress {
+	payeeAddr, found := im.keeper.GetPayeeAddress(ctx, relayer.String(), channelID)
+	if !found {
+		return relayer
+	}
+
+	payee, err := sdk.AccAddressFromBech32(payeeAddr)
+	if err != nil {
+		return relayer
+	}
+
+	return payee
+}
+
+// SendPacket implements the ICS4 Wrapper interface
+func (im IBCMiddleware) SendPacket(
+	ctx sdk.Context,
+	chanCap *capabilitytypes.Capability,
+	packet exported.PacketI,
+) error {
+	return im.keeper.SendPacket(ctx, chanCap, packet)
+}
+
+// WriteAcknowledgement implements the ICS4 Wrapper interface
+func (im IBCMiddleware) WriteAcknowledgement(
+	ctx sdk.Context,
+	chanCap *capabilitytypes.Capability,
+	packet exported.PacketI,
+	ack []byte,
+) error {
+	return im.keeper.WriteAcknowledgement(ctx, chanCap, packet, ack)
+}
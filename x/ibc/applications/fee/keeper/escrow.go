@@ -0,0 +1,151 @@
+package keeper
+
+import (
+	"bytes"
+
+	sdk "github.com/line/lfb-sdk/types"
+	sdkerrors "github.com/line/lfb-sdk/types/errors"
+	"github.com/line/lfb-sdk/x/ibc/applications/fee/types"
+)
+
+// escrowPacketFee sends the packet fee to the 29-fee module account to hold in escrow
+func (k Keeper) escrowPacketFee(ctx sdk.Context, packetID types.PacketId, packetFee types.PacketFee) error {
+	// check if the refund address is valid
+	refundAddr, err := sdk.AccAddressFromBech32(packetFee.RefundAddress)
+	if err != nil {
+		return err
+	}
+
+	coins := packetFee.Fee.Total()
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, refundAddr, types.ModuleName, coins); err != nil {
+		return sdkerrors.Wrap(err, "failed to escrow packet fee")
+	}
+
+	// multiple fees may be escrowed for a single packet, firstly create a slice containing the new fee
+	// retrieve any previous fees stored in escrow for the packet and append them to the list
+	fees := []types.PacketFee{packetFee}
+	if feesInEscrow, found := k.GetFeesInEscrow(ctx, packetID); found {
+		fees = append(fees, feesInEscrow.PacketFees...)
+	}
+
+	packetFees := types.NewPacketFees(fees)
+	k.SetFeesInEscrow(ctx, packetID, packetFees)
+
+	EmitIncentivizedPacketEvent(ctx, packetID, packetFees)
+
+	return nil
+}
+
+// DistributePacketFeesOnAcknowledgement pays all the acknowledgement & receive fees for a given packetID while refunding the timeout fees to the refund account.
+func (k Keeper) DistributePacketFeesOnAcknowledgement(ctx sdk.Context, forwardRelayer string, reverseRelayer sdk.AccAddress, packetFees []types.PacketFee, packetID types.PacketId) {
+	// forward relayer address will be empty if conversion fails
+	forwardAddr, _ := sdk.AccAddressFromBech32(forwardRelayer)
+
+	for _, packetFee := range packetFees {
+		// check if refundAcc address works
+		refundAddr, err := sdk.AccAddressFromBech32(packetFee.RefundAddress)
+		if err != nil {
+			panic(sdkerrors.Wrapf(err, "could not parse refundAcc %s to sdk.AccAddress", packetFee.RefundAddress))
+		}
+
+		// distribute fee to valid forward relayer address otherwise refund the fee
+		if !forwardAddr.Empty() {
+			// distribute fee for forward relaying
+			k.distributeFee(ctx, forwardAddr, refundAddr, packetFee.Fee.RecvFee)
+		} else {
+			k.distributeFee(ctx, refundAddr, refundAddr, packetFee.Fee.RecvFee)
+		}
+
+		// distribute fee for reverse relaying
+		k.distributeFee(ctx, reverseRelayer, refundAddr, packetFee.Fee.AckFee)
+
+		// refund timeout fee for unused timeout
+		k.distributeFee(ctx, refundAddr, refundAddr, packetFee.Fee.TimeoutFee)
+	}
+
+	// removes the fees from the store as fees are now paid
+	k.DeleteFeesInEscrow(ctx, packetID)
+}
+
+// DistributePacketFeesOnTimeout pays all the timeout fees for a given packetID while refunding the acknowledgement & receive fees to the refund account.
+func (k Keeper) DistributePacketFeesOnTimeout(ctx sdk.Context, timeoutRelayer sdk.AccAddress, packetFees []types.PacketFee, packetID types.PacketId) {
+	for _, packetFee := range packetFees {
+		// check if refundAcc address works
+		refundAddr, err := sdk.AccAddressFromBech32(packetFee.RefundAddress)
+		if err != nil {
+			panic(sdkerrors.Wrapf(err, "could not parse refundAcc %s to sdk.AccAddress", packetFee.RefundAddress))
+		}
+
+		// refund receive fee for unused forward relaying
+		k.distributeFee(ctx, refundAddr, refundAddr, packetFee.Fee.RecvFee)
+
+		// refund ack fee for unused reverse relaying
+		k.distributeFee(ctx, refundAddr, refundAddr, packetFee.Fee.AckFee)
+
+		// distribute fee for timeout relaying
+		k.distributeFee(ctx, timeoutRelayer, refundAddr, packetFee.Fee.TimeoutFee)
+	}
+
+	// removes the fee from the store as fee is now paid
+	k.DeleteFeesInEscrow(ctx, packetID)
+}
+
+// distributeFee will attempt to distribute the escrowed fee to the receiver address.
+// If the distribution fails for any reason (such as the receiving address being blocked),
+// the state changes will be discarded and the fee is refunded to the refund address.
+func (k Keeper) distributeFee(ctx sdk.Context, receiver, refundAccAddress sdk.AccAddress, fee sdk.Coins) {
+	if fee.IsZero() {
+		return
+	}
+
+	if k.bankKeeper.BlockedAddr(receiver) {
+		receiver = refundAccAddress
+	}
+
+	// cache context before trying to distribute fees
+	// if the escrow account has insufficient balance then we want to avoid partially distributing fees
+	cacheCtx, writeFn := ctx.CacheContext()
+
+	err := k.bankKeeper.SendCoinsFromModuleToAccount(cacheCtx, types.ModuleName, receiver, fee)
+	if err != nil {
+		if bytes.Equal(receiver, refundAccAddress) {
+			k.Logger(ctx).Error("error distributing fee", "receiver address", receiver, "fee", fee)
+			return // if sending to the refund address already failed, then return (no-op)
+		}
+
+		// if an error is returned from x/bank and the receiver is not the refundAccAddress
+		// then attempt to refund the fee to the original sender
+		cacheCtx, writeFn = ctx.CacheContext()
+		receiver = refundAccAddress
+
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(cacheCtx, types.ModuleName, refundAccAddress, fee); err != nil {
+			k.Logger(ctx).Error("error refunding fee to the original sender", "refund address", refundAccAddress, "fee", fee)
+			return // if sending to the refund address fails, no-op
+		}
+	}
+
+	// write the cache
+	writeFn()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+
+	EmitDistributeFeeEvent(ctx, receiver.String(), fee)
+}
+
+// RefundFeesOnChannelClosure will refund all fees associated with the given port and channel identifiers.
+// Fees are refunded to the refund address of every packet fee, the escrowed fees are then removed from state.
+func (k Keeper) RefundFeesOnChannelClosure(ctx sdk.Context, portID, channelID string) {
+	identifiedPacketFees := k.GetIdentifiedPacketFeesForChannel(ctx, portID, channelID)
+
+	for _, identifiedPacketFee := range identifiedPacketFees {
+		for _, packetFee := range identifiedPacketFee.PacketFees {
+			refundAddr, err := sdk.AccAddressFromBech32(packetFee.RefundAddress)
+			if err != nil {
+				panic(sdkerrors.Wrapf(err, "could not parse refundAcc %s to sdk.AccAddress", packetFee.RefundAddress))
+			}
+
+			k.distributeFee(ctx, refundAddr, refundAddr, packetFee.Fee.Total())
+		}
+
+		k.DeleteFeesInEscrow(ctx, identifiedPacketFee.PacketId)
+	}
+}
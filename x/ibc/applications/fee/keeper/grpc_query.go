@@ -0,0 +1,165 @@
+package keeper
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/line/lfb-sdk/store/prefix"
+	sdk "github.com/line/lfb-sdk/types"
+	sdkerrors "github.com/line/lfb-sdk/types/errors"
+	"github.com/line/lfb-sdk/types/query"
+	"github.com/line/lfb-sdk/x/ibc/applications/fee/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// IncentivizedPackets implements the Query/IncentivizedPackets gRPC method
+func (k Keeper) IncentivizedPackets(goCtx context.Context, req *types.QueryIncentivizedPacketsRequest) (*types.QueryIncentivizedPacketsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	var identifiedPackets []types.IdentifiedPacketFees
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.FeesInEscrowPrefix+"/"))
+	pageRes, err := query.Paginate(store, req.Pagination, func(key, value []byte) error {
+		packetID, err := types.ParseKeyFeesInEscrow(types.FeesInEscrowPrefix + "/" + string(key))
+		if err != nil {
+			return err
+		}
+
+		packetFees := k.MustUnmarshalFees(value)
+		identifiedPackets = append(identifiedPackets, types.NewIdentifiedPacketFees(packetID, packetFees.PacketFees))
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return &types.QueryIncentivizedPacketsResponse{
+		IncentivizedPackets: identifiedPackets,
+		Pagination:          pageRes,
+	}, nil
+}
+
+// IncentivizedPacket implements the Query/IncentivizedPacket gRPC method
+func (k Keeper) IncentivizedPacket(goCtx context.Context, req *types.QueryIncentivizedPacketRequest) (*types.QueryIncentivizedPacketResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	packetID := types.NewPacketId(req.PortId, req.ChannelId, req.Sequence)
+	if err := packetID.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	feesInEscrow, exists := k.GetFeesInEscrow(ctx, packetID)
+	if !exists {
+		return nil, status.Error(
+			codes.NotFound,
+			sdkerrors.Wrapf(types.ErrFeeNotFound, "channel: %s, port: %s, sequence: %d", req.ChannelId, req.PortId, req.Sequence).Error(),
+		)
+	}
+
+	return &types.QueryIncentivizedPacketResponse{
+		IncentivizedPacket: types.NewIdentifiedPacketFees(packetID, feesInEscrow.PacketFees),
+	}, nil
+}
+
+// Payee implements the Query/Payee gRPC method and returns the registered payee address to which packet fees are paid out
+func (k Keeper) Payee(goCtx context.Context, req *types.QueryPayeeRequest) (*types.QueryPayeeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if strings.TrimSpace(req.Relayer) == "" {
+		return nil, status.Error(codes.InvalidArgument, "relayer address cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	payeeAddr, found := k.GetPayeeAddress(ctx, req.Relayer, req.ChannelId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "payee address not found for address: %s on channel: %s", req.Relayer, req.ChannelId)
+	}
+
+	return &types.QueryPayeeResponse{
+		PayeeAddress: payeeAddr,
+	}, nil
+}
+
+// CounterpartyPayee implements the Query/CounterpartyPayee gRPC method and returns the registered counterparty payee address for forward relaying
+func (k Keeper) CounterpartyPayee(goCtx context.Context, req *types.QueryCounterpartyPayeeRequest) (*types.QueryCounterpartyPayeeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if strings.TrimSpace(req.Relayer) == "" {
+		return nil, status.Error(codes.InvalidArgument, "relayer address cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	counterpartyPayeeAddr, found := k.GetCounterpartyPayeeAddress(ctx, req.Relayer, req.ChannelId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "counterparty payee address not found for address: %s on channel: %s", req.Relayer, req.ChannelId)
+	}
+
+	return &types.QueryCounterpartyPayeeResponse{
+		CounterpartyPayee: counterpartyPayeeAddr,
+	}, nil
+}
+
+// FeeEnabledChannels implements the Query/FeeEnabledChannels gRPC method and returns a list of fee enabled channels
+func (k Keeper) FeeEnabledChannels(goCtx context.Context, req *types.QueryFeeEnabledChannelsRequest) (*types.QueryFeeEnabledChannelsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	var feeEnabledChannels []types.FeeEnabledChannel
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.FeeEnabledKeyPrefix+"/"))
+	pageRes, err := query.Paginate(store, req.Pagination, func(key, _ []byte) error {
+		portID, channelID, err := types.ParseKeyFeeEnabled(types.FeeEnabledKeyPrefix + "/" + string(key))
+		if err != nil {
+			return err
+		}
+
+		feeEnabledChannels = append(feeEnabledChannels, types.FeeEnabledChannel{
+			PortId:    portID,
+			ChannelId: channelID,
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return &types.QueryFeeEnabledChannelsResponse{
+		FeeEnabledChannels: feeEnabledChannels,
+		Pagination:         pageRes,
+	}, nil
+}
+
+// FeeEnabledChannel implements the Query/FeeEnabledChannel gRPC method and returns true if the provided
+// port and channel identifiers belong to a fee enabled channel
+func (k Keeper) FeeEnabledChannel(goCtx context.Context, req *types.QueryFeeEnabledChannelRequest) (*types.QueryFeeEnabledChannelResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	isFeeEnabled := k.IsFeeEnabled(ctx, req.PortId, req.ChannelId)
+
+	return &types.QueryFeeEnabledChannelResponse{
+		FeeEnabled: isFeeEnabled,
+	}, nil
+}
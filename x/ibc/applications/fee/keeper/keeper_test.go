@@ -0,0 +1,258 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/line/lfb-sdk/baseapp"
+	sdk "github.com/line/lfb-sdk/types"
+	"github.com/line/lfb-sdk/x/ibc/applications/fee/keeper"
+	"github.com/line/lfb-sdk/x/ibc/applications/fee/types"
+	transfertypes "github.com/line/lfb-sdk/x/ibc/applications/transfer/types"
+	clienttypes "github.com/line/lfb-sdk/x/ibc/core/02-client/types"
+	channeltypes "github.com/line/lfb-sdk/x/ibc/core/04-channel/types"
+	"github.com/line/lfb-sdk/x/ibc/core/exported"
+	ibctesting "github.com/line/lfb-sdk/x/ibc/testing"
+)
+
+var (
+	relayer = sdk.AccAddress("relayer_____________")
+	payee   = sdk.AccAddress("payee_______________")
+	fee     = types.NewFee(
+		sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)),
+		sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 200)),
+		sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 300)),
+	)
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	coordinator *ibctesting.Coordinator
+
+	// testing chains used for convenience and readability
+	chainA *ibctesting.TestChain
+	chainB *ibctesting.TestChain
+
+	clientB            string
+	channelA, channelB ibctesting.TestChannel
+
+	msgServer types.MsgServer
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	suite.coordinator = ibctesting.NewCoordinator(suite.T(), 2)
+	suite.chainA = suite.coordinator.GetChain(ibctesting.GetChainID(0))
+	suite.chainB = suite.coordinator.GetChain(ibctesting.GetChainID(1))
+
+	_, clientB, connA, connB := suite.coordinator.SetupClientConnections(suite.chainA, suite.chainB, exported.Tendermint)
+	feeVersion := types.NewMetadata(transfertypes.Version).ChannelVersion()
+	connA.NextChannelVersion = feeVersion
+	connB.NextChannelVersion = feeVersion
+	suite.clientB = clientB
+	suite.channelA, suite.channelB = suite.coordinator.CreateTransferChannels(suite.chainA, suite.chainB, connA, connB, channeltypes.UNORDERED)
+
+	suite.msgServer = keeper.NewMsgServerImpl(suite.chainA.App.IBCFeeKeeper)
+}
+
+// sendTransfer sends a transfer on the fee enabled channel of chainA without
+// paying any fee.
+func (suite *KeeperTestSuite) sendTransfer() {
+	msg := transfertypes.NewMsgTransfer(
+		suite.channelA.PortID, suite.channelA.ID, sdk.NewInt64Coin(sdk.DefaultBondDenom, 100),
+		suite.chainA.SenderAccount.GetAddress(), suite.chainB.SenderAccount.GetAddress().String(),
+		clienttypes.NewHeight(0, 110), 0,
+	)
+	err := suite.coordinator.SendMsg(suite.chainA, suite.chainB, suite.clientB, msg)
+	suite.Require().NoError(err)
+}
+
+func (suite *KeeperTestSuite) TestRegisterPayee() {
+	ctx := suite.chainA.GetContext()
+
+	msg := types.NewMsgRegisterPayee(suite.channelA.PortID, suite.channelA.ID, relayer.String(), payee.String())
+	_, err := suite.msgServer.RegisterPayee(sdk.WrapSDKContext(ctx), msg)
+	suite.Require().NoError(err)
+
+	payeeAddr, found := suite.chainA.App.IBCFeeKeeper.GetPayeeAddress(ctx, relayer.String(), suite.channelA.ID)
+	suite.Require().True(found)
+	suite.Require().Equal(payee.String(), payeeAddr)
+
+	// channel does not exist
+	msg = types.NewMsgRegisterPayee(suite.channelA.PortID, "channel-100", relayer.String(), payee.String())
+	_, err = suite.msgServer.RegisterPayee(sdk.WrapSDKContext(ctx), msg)
+	suite.Require().ErrorIs(err, channeltypes.ErrChannelNotFound)
+
+	// channel is not fee enabled
+	suite.chainA.App.IBCFeeKeeper.DeleteFeeEnabled(ctx, suite.channelA.PortID, suite.channelA.ID)
+	msg = types.NewMsgRegisterPayee(suite.channelA.PortID, suite.channelA.ID, relayer.String(), payee.String())
+	_, err = suite.msgServer.RegisterPayee(sdk.WrapSDKContext(ctx), msg)
+	suite.Require().ErrorIs(err, types.ErrFeeNotEnabled)
+}
+
+func (suite *KeeperTestSuite) TestRegisterCounterpartyPayee() {
+	ctx := suite.chainA.GetContext()
+
+	msg := types.NewMsgRegisterCounterpartyPayee(suite.channelA.PortID, suite.channelA.ID, relayer.String(), payee.String())
+	_, err := suite.msgServer.RegisterCounterpartyPayee(sdk.WrapSDKContext(ctx), msg)
+	suite.Require().NoError(err)
+
+	counterpartyPayee, found := suite.chainA.App.IBCFeeKeeper.GetCounterpartyPayeeAddress(ctx, relayer.String(), suite.channelA.ID)
+	suite.Require().True(found)
+	suite.Require().Equal(payee.String(), counterpartyPayee)
+
+	// channel does not exist
+	msg = types.NewMsgRegisterCounterpartyPayee(suite.channelA.PortID, "channel-100", relayer.String(), payee.String())
+	_, err = suite.msgServer.RegisterCounterpartyPayee(sdk.WrapSDKContext(ctx), msg)
+	suite.Require().ErrorIs(err, channeltypes.ErrChannelNotFound)
+}
+
+func (suite *KeeperTestSuite) TestPayPacketFeeAsync() {
+	suite.sendTransfer()
+
+	ctx := suite.chainA.GetContext()
+	refundAddr := suite.chainA.SenderAccount.GetAddress().String()
+	packetFee := types.NewPacketFee(fee, refundAddr)
+
+	// fees may be added several times to a packet in flight
+	packetID := types.NewPacketId(suite.channelA.PortID, suite.channelA.ID, 1)
+	for i := 0; i < 2; i++ {
+		_, err := suite.msgServer.PayPacketFeeAsync(sdk.WrapSDKContext(ctx), types.NewMsgPayPacketFeeAsync(packetID, packetFee))
+		suite.Require().NoError(err)
+	}
+
+	feesInEscrow, found := suite.chainA.App.IBCFeeKeeper.GetFeesInEscrow(ctx, packetID)
+	suite.Require().True(found)
+	suite.Require().Equal([]types.PacketFee{packetFee, packetFee}, feesInEscrow.PacketFees)
+
+	// packet has not been sent
+	packetID = types.NewPacketId(suite.channelA.PortID, suite.channelA.ID, 2)
+	_, err := suite.msgServer.PayPacketFeeAsync(sdk.WrapSDKContext(ctx), types.NewMsgPayPacketFeeAsync(packetID, packetFee))
+	suite.Require().ErrorIs(err, types.ErrPacketNotInFlight)
+}
+
+func (suite *KeeperTestSuite) TestWriteAcknowledgementAsync() {
+	ctx := suite.chainB.GetContext()
+	feeKeeper := suite.chainB.App.IBCFeeKeeper
+
+	packet := channeltypes.NewPacket([]byte("data"), 1, suite.channelA.PortID, suite.channelA.ID, suite.channelB.PortID, suite.channelB.ID, clienttypes.NewHeight(0, 110), 0)
+	packetID := types.NewPacketId(suite.channelB.PortID, suite.channelB.ID, 1)
+	chanCap := suite.chainB.GetChannelCapability(suite.channelB.PortID, suite.channelB.ID)
+	appAck := channeltypes.NewResultAcknowledgement([]byte{byte(1)}).GetBytes()
+
+	// the relayer which delivered the packet must be known
+	err := feeKeeper.WriteAcknowledgement(ctx, chanCap, packet, appAck)
+	suite.Require().ErrorIs(err, types.ErrRelayerNotFoundForAsyncAck)
+
+	feeKeeper.SetRelayerAddressForAsyncAck(ctx, packetID, relayer.String())
+	feeKeeper.SetCounterpartyPayeeAddress(ctx, relayer.String(), payee.String(), suite.channelB.ID)
+
+	err = feeKeeper.WriteAcknowledgement(ctx, chanCap, packet, appAck)
+	suite.Require().NoError(err)
+
+	// the written acknowledgement is incentivized with the counterparty payee of the relayer
+	ack := types.NewIncentivizedAcknowledgement(payee.String(), appAck, true)
+	commitment, found := suite.chainB.App.IBCKeeper.ChannelKeeper.GetPacketAcknowledgement(ctx, packet.DestinationPort, packet.DestinationChannel, packet.Sequence)
+	suite.Require().True(found)
+	suite.Require().Equal(channeltypes.CommitAcknowledgement(ack.Acknowledgement()), commitment)
+
+	_, found = feeKeeper.GetRelayerAddressForAsyncAck(ctx, packetID)
+	suite.Require().False(found)
+}
+
+func (suite *KeeperTestSuite) TestRefundFeesOnChannelClosure() {
+	suite.sendTransfer()
+
+	ctx := suite.chainA.GetContext()
+	feeKeeper := suite.chainA.App.IBCFeeKeeper
+	refundAddr := suite.chainA.SenderAccount.GetAddress()
+
+	packetID := types.NewPacketId(suite.channelA.PortID, suite.channelA.ID, 1)
+	_, err := suite.msgServer.PayPacketFeeAsync(sdk.WrapSDKContext(ctx), types.NewMsgPayPacketFeeAsync(packetID, types.NewPacketFee(fee, refundAddr.String())))
+	suite.Require().NoError(err)
+
+	balance := suite.chainA.App.BankKeeper.GetBalance(ctx, refundAddr, sdk.DefaultBondDenom)
+
+	feeKeeper.RefundFeesOnChannelClosure(ctx, suite.channelA.PortID, suite.channelA.ID)
+
+	suite.Require().False(feeKeeper.HasFeesInEscrow(ctx, packetID))
+	suite.Require().Equal(balance.Add(sdk.NewCoin(sdk.DefaultBondDenom, fee.Total().AmountOf(sdk.DefaultBondDenom))), suite.chainA.App.BankKeeper.GetBalance(ctx, refundAddr, sdk.DefaultBondDenom))
+	suite.Require().True(suite.chainA.App.BankKeeper.GetAllBalances(ctx, feeKeeper.GetFeeModuleAddress()).IsZero())
+}
+
+func (suite *KeeperTestSuite) TestQueries() {
+	suite.sendTransfer()
+
+	ctx := suite.chainA.GetContext()
+	packetFee := types.NewPacketFee(fee, suite.chainA.SenderAccount.GetAddress().String())
+	packetID := types.NewPacketId(suite.channelA.PortID, suite.channelA.ID, 1)
+	_, err := suite.msgServer.PayPacketFeeAsync(sdk.WrapSDKContext(ctx), types.NewMsgPayPacketFeeAsync(packetID, packetFee))
+	suite.Require().NoError(err)
+	suite.chainA.App.IBCFeeKeeper.SetPayeeAddress(ctx, relayer.String(), payee.String(), suite.channelA.ID)
+	suite.chainA.App.IBCFeeKeeper.SetCounterpartyPayeeAddress(ctx, relayer.String(), "counterparty", suite.channelA.ID)
+
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, suite.chainA.App.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, suite.chainA.App.IBCFeeKeeper)
+	queryClient := types.NewQueryClient(queryHelper)
+
+	expPacket := types.NewIdentifiedPacketFees(packetID, []types.PacketFee{packetFee})
+
+	packetsRes, err := queryClient.IncentivizedPackets(sdk.WrapSDKContext(ctx), &types.QueryIncentivizedPacketsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.IdentifiedPacketFees{expPacket}, packetsRes.IncentivizedPackets)
+
+	packetRes, err := queryClient.IncentivizedPacket(sdk.WrapSDKContext(ctx), &types.QueryIncentivizedPacketRequest{PortId: packetID.PortId, ChannelId: packetID.ChannelId, Sequence: 1})
+	suite.Require().NoError(err)
+	suite.Require().Equal(expPacket, packetRes.IncentivizedPacket)
+
+	_, err = queryClient.IncentivizedPacket(sdk.WrapSDKContext(ctx), &types.QueryIncentivizedPacketRequest{PortId: packetID.PortId, ChannelId: packetID.ChannelId, Sequence: 2})
+	suite.Require().Error(err)
+
+	payeeRes, err := queryClient.Payee(sdk.WrapSDKContext(ctx), &types.QueryPayeeRequest{ChannelId: suite.channelA.ID, Relayer: relayer.String()})
+	suite.Require().NoError(err)
+	suite.Require().Equal(payee.String(), payeeRes.PayeeAddress)
+
+	cpPayeeRes, err := queryClient.CounterpartyPayee(sdk.WrapSDKContext(ctx), &types.QueryCounterpartyPayeeRequest{ChannelId: suite.channelA.ID, Relayer: relayer.String()})
+	suite.Require().NoError(err)
+	suite.Require().Equal("counterparty", cpPayeeRes.CounterpartyPayee)
+
+	channelsRes, err := queryClient.FeeEnabledChannels(sdk.WrapSDKContext(ctx), &types.QueryFeeEnabledChannelsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.FeeEnabledChannel{{PortId: suite.channelA.PortID, ChannelId: suite.channelA.ID}}, channelsRes.FeeEnabledChannels)
+
+	channelRes, err := queryClient.FeeEnabledChannel(sdk.WrapSDKContext(ctx), &types.QueryFeeEnabledChannelRequest{PortId: suite.channelA.PortID, ChannelId: "channel-100"})
+	suite.Require().NoError(err)
+	suite.Require().False(channelRes.FeeEnabled)
+}
+
+func (suite *KeeperTestSuite) TestGenesis() {
+	suite.sendTransfer()
+
+	ctx := suite.chainA.GetContext()
+	feeKeeper := suite.chainA.App.IBCFeeKeeper
+
+	packetID := types.NewPacketId(suite.channelA.PortID, suite.channelA.ID, 1)
+	_, err := suite.msgServer.PayPacketFeeAsync(sdk.WrapSDKContext(ctx), types.NewMsgPayPacketFeeAsync(packetID, types.NewPacketFee(fee, suite.chainA.SenderAccount.GetAddress().String())))
+	suite.Require().NoError(err)
+	feeKeeper.SetPayeeAddress(ctx, relayer.String(), payee.String(), suite.channelA.ID)
+	feeKeeper.SetCounterpartyPayeeAddress(ctx, relayer.String(), "counterparty", suite.channelA.ID)
+	feeKeeper.SetRelayerAddressForAsyncAck(ctx, types.NewPacketId(suite.channelA.PortID, suite.channelA.ID, 5), relayer.String())
+
+	genesis := feeKeeper.ExportGenesis(ctx)
+	suite.Require().NoError(genesis.Validate())
+	suite.Require().Len(genesis.IdentifiedFees, 1)
+	suite.Require().Len(genesis.FeeEnabledChannels, 1)
+	suite.Require().Len(genesis.RegisteredPayees, 1)
+	suite.Require().Len(genesis.RegisteredCounterpartyPayees, 1)
+	suite.Require().Len(genesis.ForwardRelayers, 1)
+
+	// chainB has the same fee enabled channel identifiers as chainA
+	ctxB := suite.chainB.GetContext()
+	suite.chainB.App.IBCFeeKeeper.InitGenesis(ctxB, *genesis)
+	suite.Require().Equal(genesis, suite.chainB.App.IBCFeeKeeper.ExportGenesis(ctxB))
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
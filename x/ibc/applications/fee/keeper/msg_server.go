@@ -0,0 +1,134 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/line/lfb-sdk/types"
+	sdkerrors "github.com/line/lfb-sdk/types/errors"
+	"github.com/line/lfb-sdk/x/ibc/applications/fee/types"
+	channeltypes "github.com/line/lfb-sdk/x/ibc/core/04-channel/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the 29-fee MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+var _ types.MsgServer = msgServer{}
+
+// RegisterPayee defines a rpc handler method for MsgRegisterPayee
+// RegisterPayee is called by the relayer on each channelEnd and allows them to set an optional
+// payee to which reverse and timeout relayer packet fees will be paid out. The payee should be registered on
+// the source chain from which packets originate as this is where fee distribution takes place. This function may be
+// called more than once by a relayer, in which case, the latest payee is always used.
+func (k msgServer) RegisterPayee(goCtx context.Context, msg *types.MsgRegisterPayee) (*types.MsgRegisterPayeeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// only register payee address if the channel exists and is fee enabled
+	if _, found := k.channelKeeper.GetChannel(ctx, msg.PortId, msg.ChannelId); !found {
+		return nil, channeltypes.ErrChannelNotFound
+	}
+
+	if !k.IsFeeEnabled(ctx, msg.PortId, msg.ChannelId) {
+		return nil, types.ErrFeeNotEnabled
+	}
+
+	k.SetPayeeAddress(ctx, msg.Relayer, msg.Payee, msg.ChannelId)
+
+	k.Logger(ctx).Info("registering payee address for relayer", "relayer", msg.Relayer, "payee", msg.Payee, "channel", msg.ChannelId)
+
+	EmitRegisterPayeeEvent(ctx, msg.Relayer, msg.Payee, msg.ChannelId)
+
+	return &types.MsgRegisterPayeeResponse{}, nil
+}
+
+// RegisterCounterpartyPayee defines a rpc handler method for MsgRegisterCounterpartyPayee
+// RegisterCounterpartyPayee is called by the relayer on each channelEnd and allows them to specify the counterparty
+// payee address before relaying. This ensures they will be properly compensated for forward relaying since
+// the destination chain must include the registered counterparty payee address in the acknowledgement. This function
+// may be called more than once by a relayer, in which case, the latest counterparty payee address is always used.
+func (k msgServer) RegisterCounterpartyPayee(goCtx context.Context, msg *types.MsgRegisterCounterpartyPayee) (*types.MsgRegisterCounterpartyPayeeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// only register counterparty payee if the channel exists and is fee enabled
+	if _, found := k.channelKeeper.GetChannel(ctx, msg.PortId, msg.ChannelId); !found {
+		return nil, channeltypes.ErrChannelNotFound
+	}
+
+	if !k.IsFeeEnabled(ctx, msg.PortId, msg.ChannelId) {
+		return nil, types.ErrFeeNotEnabled
+	}
+
+	k.SetCounterpartyPayeeAddress(ctx, msg.Relayer, msg.CounterpartyPayee, msg.ChannelId)
+
+	k.Logger(ctx).Info("registering counterparty payee for relayer", "relayer", msg.Relayer, "counterparty payee", msg.CounterpartyPayee, "channel", msg.ChannelId)
+
+	EmitRegisterCounterpartyPayeeEvent(ctx, msg.Relayer, msg.CounterpartyPayee, msg.ChannelId)
+
+	return &types.MsgRegisterCounterpartyPayeeResponse{}, nil
+}
+
+// PayPacketFee defines a rpc handler method for MsgPayPacketFee
+// PayPacketFee is an open callback that may be called by any module/user that wishes to escrow funds in order to relay the packet with the next sequence
+func (k msgServer) PayPacketFee(goCtx context.Context, msg *types.MsgPayPacketFee) (*types.MsgPayPacketFeeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !k.IsFeeEnabled(ctx, msg.SourcePortId, msg.SourceChannelId) {
+		// users may not escrow fees on this channel. Must send packets without a fee message
+		return nil, types.ErrFeeNotEnabled
+	}
+
+	// get the next sequence
+	sequence, found := k.channelKeeper.GetNextSequenceSend(ctx, msg.SourcePortId, msg.SourceChannelId)
+	if !found {
+		return nil, sdkerrors.Wrapf(channeltypes.ErrSequenceSendNotFound, "channel does not exist, portID: %s, channelID: %s", msg.SourcePortId, msg.SourceChannelId)
+	}
+
+	packetID := types.NewPacketId(msg.SourcePortId, msg.SourceChannelId, sequence)
+	packetFee := types.NewPacketFee(msg.Fee, msg.Signer)
+
+	if err := k.escrowPacketFee(ctx, packetID, packetFee); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgPayPacketFeeResponse{}, nil
+}
+
+// PayPacketFeeAsync defines a rpc handler method for MsgPayPacketFeeAsync
+// PayPacketFeeAsync is an open callback that may be called by any module/user that wishes to escrow funds in order to
+// incentivize the relaying of a known packet. Only packets which have been sent and have not gone through the
+// packet life cycle may be incentivized.
+func (k msgServer) PayPacketFeeAsync(goCtx context.Context, msg *types.MsgPayPacketFeeAsync) (*types.MsgPayPacketFeeAsyncResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !k.IsFeeEnabled(ctx, msg.PacketId.PortId, msg.PacketId.ChannelId) {
+		// users may not escrow fees on this channel. Must send packets without a fee message
+		return nil, types.ErrFeeNotEnabled
+	}
+
+	nextSeqSend, found := k.channelKeeper.GetNextSequenceSend(ctx, msg.PacketId.PortId, msg.PacketId.ChannelId)
+	if !found {
+		return nil, sdkerrors.Wrapf(channeltypes.ErrSequenceSendNotFound, "channel does not exist, portID: %s, channelID: %s", msg.PacketId.PortId, msg.PacketId.ChannelId)
+	}
+
+	// only allow incentivizing of packets which have been sent
+	if msg.PacketId.Sequence >= nextSeqSend {
+		return nil, sdkerrors.Wrapf(types.ErrPacketNotInFlight, "packet with sequence %d has not been sent", msg.PacketId.Sequence)
+	}
+
+	// only allow incentivizng of packets which have not completed the packet life cycle
+	if commitment := k.channelKeeper.GetPacketCommitment(ctx, msg.PacketId.PortId, msg.PacketId.ChannelId, msg.PacketId.Sequence); len(commitment) == 0 {
+		return nil, sdkerrors.Wrapf(types.ErrPacketNotInFlight, "packet with sequence %d has already been acknowledged or timed out", msg.PacketId.Sequence)
+	}
+
+	if err := k.escrowPacketFee(ctx, msg.PacketId, msg.PacketFee); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgPayPacketFeeAsyncResponse{}, nil
+}
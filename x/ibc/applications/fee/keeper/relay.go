@@ -0,0 +1,42 @@
+package keeper
+
+import (
+	sdk "github.com/line/lfb-sdk/types"
+	sdkerrors "github.com/line/lfb-sdk/types/errors"
+	capabilitytypes "github.com/line/lfb-sdk/x/capability/types"
+	"github.com/line/lfb-sdk/x/ibc/applications/fee/types"
+	"github.com/line/lfb-sdk/x/ibc/core/exported"
+)
+
+// SendPacket wraps IBC ChannelKeeper's SendPacket function
+func (k Keeper) SendPacket(ctx sdk.Context, chanCap *capabilitytypes.Capability, packet exported.PacketI) error {
+	return k.ics4Wrapper.SendPacket(ctx, chanCap, packet)
+}
+
+// WriteAcknowledgement wraps IBC ChannelKeeper's WriteAcknowledgement function
+// ICS29 WriteAcknowledgement is used for asynchronous acknowledgements
+func (k Keeper) WriteAcknowledgement(ctx sdk.Context, chanCap *capabilitytypes.Capability, packet exported.PacketI, acknowledgement []byte) error {
+	if !k.IsFeeEnabled(ctx, packet.GetDestPort(), packet.GetDestChannel()) {
+		// ics4Wrapper may be core IBC or higher-level middleware
+		return k.ics4Wrapper.WriteAcknowledgement(ctx, chanCap, packet, acknowledgement)
+	}
+
+	packetID := types.NewPacketId(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+
+	// retrieve the relayer which delivered the packet, it was stored in OnRecvPacket
+	relayer, found := k.GetRelayerAddressForAsyncAck(ctx, packetID)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrRelayerNotFoundForAsyncAck, "no relayer address stored for async acknowledgement for packet with portID: %s, channelID: %s, sequence: %d", packetID.PortId, packetID.ChannelId, packetID.Sequence)
+	}
+
+	// it is possible that a relayer has not registered a counterparty address.
+	// if there is no registered counterparty address then write acknowledgement with empty relayer address and refund recv_fee.
+	forwardRelayer, _ := k.GetCounterpartyPayeeAddress(ctx, relayer, packet.GetDestChannel())
+
+	ack := types.NewIncentivizedAcknowledgement(forwardRelayer, acknowledgement, types.IsAppAcknowledgementSuccess(acknowledgement))
+
+	k.DeleteForwardRelayerAddress(ctx, packetID)
+
+	// ics4Wrapper may be core IBC or higher-level middleware
+	return k.ics4Wrapper.WriteAcknowledgement(ctx, chanCap, packet, ack.Acknowledgement())
+}
@@ -0,0 +1,33 @@
+package types
+
+import (
+	channeltypes "github.com/line/lfb-sdk/x/ibc/core/04-channel/types"
+)
+
+// NewIncentivizedAcknowledgement creates a new instance of IncentivizedAcknowledgement
+func NewIncentivizedAcknowledgement(relayer string, ack []byte, success bool) IncentivizedAcknowledgement {
+	return IncentivizedAcknowledgement{
+		AppAcknowledgement:    ack,
+		ForwardRelayerAddress: relayer,
+		UnderlyingAppSuccess:  success,
+	}
+}
+
+// Acknowledgement returns the JSON encoded incentivized acknowledgement
+// written to the channel in place of the application acknowledgement.
+func (ack IncentivizedAcknowledgement) Acknowledgement() []byte {
+	return ModuleCdc.MustMarshalJSON(&ack)
+}
+
+// IsAppAcknowledgementSuccess reports whether the application acknowledgement
+// is successful. Acknowledgements that are not ICS-04 acknowledgements are
+// assumed to be successful since their format is application specific.
+func IsAppAcknowledgementSuccess(ack []byte) bool {
+	var channelAck channeltypes.Acknowledgement
+	if err := ModuleCdc.UnmarshalJSON(ack, &channelAck); err != nil {
+		return true
+	}
+
+	_, isError := channelAck.Response.(*channeltypes.Acknowledgement_Error)
+	return !isError
+}
@@ -0,0 +1,40 @@
+package types
+
+import (
+	"github.com/line/lfb-sdk/codec"
+	codectypes "github.com/line/lfb-sdk/codec/types"
+	sdk "github.com/line/lfb-sdk/types"
+	"github.com/line/lfb-sdk/types/msgservice"
+)
+
+// RegisterLegacyAminoCodec registers the necessary x/ibc 29-fee interfaces and concrete types
+// on the provided LegacyAmino codec. These types are used for Amino JSON serialization.
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&MsgPayPacketFee{}, "lfb-sdk/MsgPayPacketFee", nil)
+	cdc.RegisterConcrete(&MsgPayPacketFeeAsync{}, "lfb-sdk/MsgPayPacketFeeAsync", nil)
+	cdc.RegisterConcrete(&MsgRegisterPayee{}, "lfb-sdk/MsgRegisterPayee", nil)
+	cdc.RegisterConcrete(&MsgRegisterCounterpartyPayee{}, "lfb-sdk/MsgRegisterCounterpartyPayee", nil)
+}
+
+// RegisterInterfaces register the 29-fee module interfaces to protobuf
+// Any.
+func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
+	registry.RegisterImplementations(
+		(*sdk.Msg)(nil),
+		&MsgPayPacketFee{},
+		&MsgPayPacketFeeAsync{},
+		&MsgRegisterPayee{},
+		&MsgRegisterCounterpartyPayee{},
+	)
+
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
+
+var (
+	// ModuleCdc references the global x/ibc 29-fee module codec. Note, the codec
+	// should ONLY be used in certain instances of tests and for JSON encoding.
+	//
+	// The actual codec used for serialization should be provided to x/ibc 29-fee and
+	// defined at the application level.
+	ModuleCdc = codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+)
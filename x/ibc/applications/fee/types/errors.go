@@ -0,0 +1,16 @@
+package types
+
+import (
+	sdkerrors "github.com/line/lfb-sdk/types/errors"
+)
+
+// 29-fee sentinel errors
+var (
+	ErrInvalidVersion             = sdkerrors.Register(ModuleName, 2, "invalid ICS29 middleware version")
+	ErrInvalidMetadata            = sdkerrors.Register(ModuleName, 3, "invalid ICS29 middleware metadata")
+	ErrFeeNotFound                = sdkerrors.Register(ModuleName, 4, "there is no fee escrowed for the given packetID")
+	ErrFeeNotEnabled              = sdkerrors.Register(ModuleName, 5, "fee module is not enabled for this channel. If this error occurs after channel setup, fee module may not be enabled")
+	ErrCounterpartyPayeeEmpty     = sdkerrors.Register(ModuleName, 6, "counterparty payee must not be empty")
+	ErrRelayerNotFoundForAsyncAck = sdkerrors.Register(ModuleName, 7, "relayer address must be stored for async WriteAcknowledgement")
+	ErrPacketNotInFlight          = sdkerrors.Register(ModuleName, 8, "packet is not in flight, it has not been sent or was already acknowledged or timed out")
+)
@@ -0,0 +1,101 @@
+package types
+
+import (
+	sdk "github.com/line/lfb-sdk/types"
+	sdkerrors "github.com/line/lfb-sdk/types/errors"
+	channeltypes "github.com/line/lfb-sdk/x/ibc/core/04-channel/types"
+	host "github.com/line/lfb-sdk/x/ibc/core/24-host"
+)
+
+// NewFee creates and returns a new Fee struct encapsulating the receive, acknowledgement and timeout fees as sdk.Coins
+func NewFee(recvFee, ackFee, timeoutFee sdk.Coins) Fee {
+	return Fee{
+		RecvFee:    recvFee,
+		AckFee:     ackFee,
+		TimeoutFee: timeoutFee,
+	}
+}
+
+// Total returns the total amount for a given Fee
+func (f Fee) Total() sdk.Coins {
+	return f.RecvFee.Add(f.AckFee...).Add(f.TimeoutFee...)
+}
+
+// Validate asserts that each Fee is valid and all three Fees are not empty or zero
+func (f Fee) Validate() error {
+	var errFees []string
+	if !f.AckFee.IsValid() {
+		errFees = append(errFees, "ack fee invalid")
+	}
+	if !f.RecvFee.IsValid() {
+		errFees = append(errFees, "recv fee invalid")
+	}
+	if !f.TimeoutFee.IsValid() {
+		errFees = append(errFees, "timeout fee invalid")
+	}
+
+	if len(errFees) > 0 {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "contains invalid fees: %v", errFees)
+	}
+
+	// if all three fee's are zero or empty return an error
+	if f.AckFee.IsZero() && f.RecvFee.IsZero() && f.TimeoutFee.IsZero() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "all fees are zero")
+	}
+
+	return nil
+}
+
+// NewPacketFee creates and returns a new PacketFee struct including the incentivization fees and refund address
+func NewPacketFee(fee Fee, refundAddr string) PacketFee {
+	return PacketFee{
+		Fee:           fee,
+		RefundAddress: refundAddr,
+	}
+}
+
+// Validate performs basic stateless validation of the associated PacketFee
+func (p PacketFee) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(p.RefundAddress); err != nil {
+		return sdkerrors.Wrap(err, "failed to convert RefundAddress into sdk.AccAddress")
+	}
+
+	return p.Fee.Validate()
+}
+
+// NewPacketFees creates and returns a new PacketFees struct including a list of type PacketFee
+func NewPacketFees(packetFees []PacketFee) PacketFees {
+	return PacketFees{
+		PacketFees: packetFees,
+	}
+}
+
+// NewPacketId returns a new instance of PacketId
+func NewPacketId(portID, channelID string, seq uint64) PacketId {
+	return PacketId{PortId: portID, ChannelId: channelID, Sequence: seq}
+}
+
+// Validate performs basic validation of the PacketId
+func (p PacketId) Validate() error {
+	if err := host.PortIdentifierValidator(p.PortId); err != nil {
+		return sdkerrors.Wrap(err, "invalid source port ID")
+	}
+
+	if err := host.ChannelIdentifierValidator(p.ChannelId); err != nil {
+		return sdkerrors.Wrap(err, "invalid source channel ID")
+	}
+
+	if p.Sequence == 0 {
+		return sdkerrors.Wrap(channeltypes.ErrInvalidPacket, "packet sequence cannot be 0")
+	}
+
+	return nil
+}
+
+// NewIdentifiedPacketFees creates and returns a new IdentifiedPacketFees struct containing a packet ID and packet fees
+func NewIdentifiedPacketFees(packetID PacketId, packetFees []PacketFee) IdentifiedPacketFees {
+	return IdentifiedPacketFees{
+		PacketId:   packetID,
+		PacketFees: packetFees,
+	}
+}
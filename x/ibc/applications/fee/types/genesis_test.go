@@ -0,0 +1,66 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/line/lfb-sdk/x/ibc/applications/fee/types"
+)
+
+func TestValidateGenesis(t *testing.T) {
+	packetID := types.NewPacketId("transfer", "channel-0", 1)
+
+	var genState *types.GenesisState
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{"default", func() { genState = types.DefaultGenesisState() }, true},
+		{"valid genesis", func() {}, true},
+		{"invalid packet id", func() {
+			genState.IdentifiedFees[0].PacketId = types.NewPacketId("transfer", "channel-0", 0)
+		}, false},
+		{"invalid refund address", func() {
+			genState.IdentifiedFees[0].PacketFees[0].RefundAddress = "invalid"
+		}, false},
+		{"invalid fee enabled channel", func() {
+			genState.FeeEnabledChannels[0].PortId = "(invalid)"
+		}, false},
+		{"payee equals relayer", func() {
+			genState.RegisteredPayees[0].Payee = relayer.String()
+		}, false},
+		{"invalid payee", func() {
+			genState.RegisteredPayees[0].Payee = "invalid"
+		}, false},
+		{"empty counterparty payee", func() {
+			genState.RegisteredCounterpartyPayees[0].CounterpartyPayee = ""
+		}, false},
+		{"invalid forward relayer", func() {
+			genState.ForwardRelayers[0].Address = "invalid"
+		}, false},
+	}
+
+	for _, tc := range testCases {
+		genState = types.NewGenesisState(
+			[]types.IdentifiedPacketFees{
+				types.NewIdentifiedPacketFees(packetID, []types.PacketFee{types.NewPacketFee(validFee, relayer.String())}),
+			},
+			[]types.FeeEnabledChannel{{PortId: "transfer", ChannelId: "channel-0"}},
+			[]types.RegisteredPayee{{ChannelId: "channel-0", Relayer: relayer.String(), Payee: payee.String()}},
+			[]types.RegisteredCounterpartyPayee{{ChannelId: "channel-0", Relayer: relayer.String(), CounterpartyPayee: "cosmos1counterparty"}},
+			[]types.ForwardRelayerAddress{{Address: relayer.String(), PacketId: packetID}},
+		)
+
+		tc.malleate()
+
+		err := genState.Validate()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
@@ -0,0 +1,138 @@
+package types
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	sdkerrors "github.com/line/lfb-sdk/types/errors"
+)
+
+const (
+	// ModuleName defines the 29-fee name
+	ModuleName = "feeibc"
+
+	// StoreKey is the store key string for IBC fee module
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for IBC fee module
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for IBC fee module
+	QuerierRoute = ModuleName
+
+	// Version defines the current version the IBC fee module supports
+	Version = "ics29-1"
+
+	// FeeEnabledKeyPrefix is the key prefix for storing fee enabled flag
+	FeeEnabledKeyPrefix = "feeEnabled"
+
+	// PayeeKeyPrefix is the key prefix for the fee payee address stored in state
+	PayeeKeyPrefix = "payee"
+
+	// CounterpartyPayeeKeyPrefix is the key prefix for the counterparty payee address mapping
+	CounterpartyPayeeKeyPrefix = "counterpartyPayee"
+
+	// FeesInEscrowPrefix is the key prefix for fee in escrow mapping
+	FeesInEscrowPrefix = "feesInEscrow"
+
+	// ForwardRelayerPrefix is the key prefix for forward relayer addresses stored in state for async acknowledgements
+	ForwardRelayerPrefix = "forwardRelayer"
+)
+
+// KeyFeeEnabled returns the key that stores a flag to determine if fee logic should
+// be enabled for the given port and channel identifiers.
+func KeyFeeEnabled(portID, channelID string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s", FeeEnabledKeyPrefix, portID, channelID))
+}
+
+// ParseKeyFeeEnabled parses the key used to indicate if a channel is fee enabled
+func ParseKeyFeeEnabled(key string) (portID, channelID string, err error) {
+	keySplit := strings.Split(key, "/")
+	if len(keySplit) != 3 || keySplit[0] != FeeEnabledKeyPrefix {
+		return "", "", sdkerrors.Wrapf(
+			sdkerrors.ErrLogic, "key provided is incorrect: the key split has incorrect length, expected %d, got %d", 3, len(keySplit),
+		)
+	}
+
+	return keySplit[1], keySplit[2], nil
+}
+
+// KeyPayee returns the key for relayer address -> payee address mapping
+func KeyPayee(relayerAddr, channelID string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s", PayeeKeyPrefix, relayerAddr, channelID))
+}
+
+// ParseKeyPayeeAddress returns the registered relayer address and channelID used to store the payee address
+func ParseKeyPayeeAddress(key string) (relayerAddr, channelID string, err error) {
+	keySplit := strings.Split(key, "/")
+	if len(keySplit) != 3 || keySplit[0] != PayeeKeyPrefix {
+		return "", "", sdkerrors.Wrapf(
+			sdkerrors.ErrLogic, "key provided is incorrect: the key split has incorrect length, expected %d, got %d", 3, len(keySplit),
+		)
+	}
+
+	return keySplit[1], keySplit[2], nil
+}
+
+// KeyCounterpartyPayee returns the key for relayer address -> counterparty payee address mapping
+func KeyCounterpartyPayee(address, channelID string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s", CounterpartyPayeeKeyPrefix, address, channelID))
+}
+
+// ParseKeyCounterpartyPayee returns the registered relayer address and channelID used to store the counterparty payee address
+func ParseKeyCounterpartyPayee(key string) (address, channelID string, err error) {
+	keySplit := strings.Split(key, "/")
+	if len(keySplit) != 3 || keySplit[0] != CounterpartyPayeeKeyPrefix {
+		return "", "", sdkerrors.Wrapf(
+			sdkerrors.ErrLogic, "key provided is incorrect: the key split has incorrect length, expected %d, got %d", 3, len(keySplit),
+		)
+	}
+
+	return keySplit[1], keySplit[2], nil
+}
+
+// KeyRelayerAddressForAsyncAck returns the key for packetID -> forwardAddress mapping
+func KeyRelayerAddressForAsyncAck(packetID PacketId) []byte {
+	return []byte(fmt.Sprintf("%s/%s", ForwardRelayerPrefix, packetKey(packetID)))
+}
+
+// ParseKeyRelayerAddressForAsyncAck parses the key used to store the forward relayer address and returns the packetID
+func ParseKeyRelayerAddressForAsyncAck(key string) (PacketId, error) {
+	return parsePacketKey(key, ForwardRelayerPrefix)
+}
+
+// KeyFeesInEscrow returns the key for escrowed fees
+func KeyFeesInEscrow(packetID PacketId) []byte {
+	return []byte(fmt.Sprintf("%s/%s", FeesInEscrowPrefix, packetKey(packetID)))
+}
+
+// ParseKeyFeesInEscrow parses the key used to store fees in escrow and returns the packet id
+func ParseKeyFeesInEscrow(key string) (PacketId, error) {
+	return parsePacketKey(key, FeesInEscrowPrefix)
+}
+
+// KeyFeesInEscrowChannelPrefix returns the key prefix for escrowed fees on the given channel
+func KeyFeesInEscrowChannelPrefix(portID, channelID string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s/", FeesInEscrowPrefix, portID, channelID))
+}
+
+func packetKey(packetID PacketId) string {
+	return fmt.Sprintf("%s/%s/%d", packetID.PortId, packetID.ChannelId, packetID.Sequence)
+}
+
+func parsePacketKey(key, prefix string) (PacketId, error) {
+	keySplit := strings.Split(key, "/")
+	if len(keySplit) != 4 || keySplit[0] != prefix {
+		return PacketId{}, sdkerrors.Wrapf(
+			sdkerrors.ErrLogic, "key provided is incorrect: the key split has incorrect length, expected %d, got %d", 4, len(keySplit),
+		)
+	}
+
+	seq, err := strconv.ParseUint(keySplit[3], 10, 64)
+	if err != nil {
+		return PacketId{}, err
+	}
+
+	return NewPacketId(keySplit[1], keySplit[2], seq), nil
+}
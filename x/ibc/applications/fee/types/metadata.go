@@ -0,0 +1,46 @@
+package types
+
+import (
+	"strings"
+
+	sdkerrors "github.com/line/lfb-sdk/types/errors"
+)
+
+// NewMetadata creates a new Metadata wrapping the version of the underlying
+// application in the ICS29 fee version
+func NewMetadata(appVersion string) Metadata {
+	return Metadata{
+		FeeVersion: Version,
+		AppVersion: appVersion,
+	}
+}
+
+// ChannelVersion returns the JSON encoded metadata used as channel version
+func (m Metadata) ChannelVersion() string {
+	return string(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// MetadataFromVersion attempts to parse the given string into a fee version
+// Metadata. An error is returned if the version is not a JSON encoded fee
+// version.
+func MetadataFromVersion(version string) (Metadata, error) {
+	var metadata Metadata
+	if err := ModuleCdc.UnmarshalJSON([]byte(version), &metadata); err != nil {
+		return metadata, sdkerrors.Wrapf(ErrInvalidMetadata, "failed to unmarshal metadata: %v", err)
+	}
+
+	return metadata, nil
+}
+
+// ValidateBasic checks that the metadata carries the supported fee version
+// and an application version
+func (m Metadata) ValidateBasic() error {
+	if m.FeeVersion != Version {
+		return sdkerrors.Wrapf(ErrInvalidVersion, "expected %s, got %s", Version, m.FeeVersion)
+	}
+	if strings.TrimSpace(m.AppVersion) == "" {
+		return sdkerrors.Wrap(ErrInvalidVersion, "application version cannot be empty")
+	}
+
+	return nil
+}
@@ -0,0 +1,239 @@
+package types
+
+import (
+	"strings"
+
+	sdk "github.com/line/lfb-sdk/types"
+	sdkerrors "github.com/line/lfb-sdk/types/errors"
+	host "github.com/line/lfb-sdk/x/ibc/core/24-host"
+)
+
+// msg types
+const (
+	TypeMsgRegisterPayee             = "registerPayee"
+	TypeMsgRegisterCounterpartyPayee = "registerCounterpartyPayee"
+	TypeMsgPayPacketFee              = "payPacketFee"
+	TypeMsgPayPacketFeeAsync         = "payPacketFeeAsync"
+)
+
+var (
+	_ sdk.Msg = &MsgRegisterPayee{}
+	_ sdk.Msg = &MsgRegisterCounterpartyPayee{}
+	_ sdk.Msg = &MsgPayPacketFee{}
+	_ sdk.Msg = &MsgPayPacketFeeAsync{}
+)
+
+// NewMsgRegisterPayee creates a new instance of MsgRegisterPayee
+func NewMsgRegisterPayee(portID, channelID, relayerAddr, payeeAddr string) *MsgRegisterPayee {
+	return &MsgRegisterPayee{
+		PortId:    portID,
+		ChannelId: channelID,
+		Relayer:   relayerAddr,
+		Payee:     payeeAddr,
+	}
+}
+
+// Route implements sdk.Msg
+func (MsgRegisterPayee) Route() string {
+	return RouterKey
+}
+
+// Type implements sdk.Msg
+func (MsgRegisterPayee) Type() string {
+	return TypeMsgRegisterPayee
+}
+
+// ValidateBasic implements sdk.Msg and performs basic stateless validation
+func (msg MsgRegisterPayee) ValidateBasic() error {
+	if err := host.PortIdentifierValidator(msg.PortId); err != nil {
+		return err
+	}
+
+	if err := host.ChannelIdentifierValidator(msg.ChannelId); err != nil {
+		return err
+	}
+
+	if msg.Relayer == msg.Payee {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "relayer address and payee must not be equal")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Relayer); err != nil {
+		return sdkerrors.Wrap(err, "failed to create sdk.AccAddress from relayer address")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Payee); err != nil {
+		return sdkerrors.Wrap(err, "failed to create sdk.AccAddress from payee address")
+	}
+
+	return nil
+}
+
+// GetSignBytes implements sdk.Msg
+func (msg MsgRegisterPayee) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgRegisterPayee) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Relayer)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{signer}
+}
+
+// NewMsgRegisterCounterpartyPayee creates a new instance of MsgRegisterCounterpartyPayee
+func NewMsgRegisterCounterpartyPayee(portID, channelID, relayerAddr, counterpartyPayeeAddr string) *MsgRegisterCounterpartyPayee {
+	return &MsgRegisterCounterpartyPayee{
+		PortId:            portID,
+		ChannelId:         channelID,
+		Relayer:           relayerAddr,
+		CounterpartyPayee: counterpartyPayeeAddr,
+	}
+}
+
+// Route implements sdk.Msg
+func (MsgRegisterCounterpartyPayee) Route() string {
+	return RouterKey
+}
+
+// Type implements sdk.Msg
+func (MsgRegisterCounterpartyPayee) Type() string {
+	return TypeMsgRegisterCounterpartyPayee
+}
+
+// ValidateBasic performs a basic check of the MsgRegisterCounterpartyPayee fields.
+// The counterparty payee is an address on the counterparty chain, its format is
+// therefore not validated.
+func (msg MsgRegisterCounterpartyPayee) ValidateBasic() error {
+	if err := host.PortIdentifierValidator(msg.PortId); err != nil {
+		return err
+	}
+
+	if err := host.ChannelIdentifierValidator(msg.ChannelId); err != nil {
+		return err
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Relayer); err != nil {
+		return sdkerrors.Wrap(err, "failed to create sdk.AccAddress from relayer address")
+	}
+
+	if strings.TrimSpace(msg.CounterpartyPayee) == "" {
+		return ErrCounterpartyPayeeEmpty
+	}
+
+	return nil
+}
+
+// GetSignBytes implements sdk.Msg
+func (msg MsgRegisterCounterpartyPayee) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgRegisterCounterpartyPayee) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Relayer)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{signer}
+}
+
+// NewMsgPayPacketFee creates a new instance of MsgPayPacketFee
+func NewMsgPayPacketFee(fee Fee, sourcePortID, sourceChannelID, signer string) *MsgPayPacketFee {
+	return &MsgPayPacketFee{
+		Fee:             fee,
+		SourcePortId:    sourcePortID,
+		SourceChannelId: sourceChannelID,
+		Signer:          signer,
+	}
+}
+
+// Route implements sdk.Msg
+func (MsgPayPacketFee) Route() string {
+	return RouterKey
+}
+
+// Type implements sdk.Msg
+func (MsgPayPacketFee) Type() string {
+	return TypeMsgPayPacketFee
+}
+
+// ValidateBasic performs a basic check of the MsgPayPacketFee fields
+func (msg MsgPayPacketFee) ValidateBasic() error {
+	// validate channelId
+	if err := host.ChannelIdentifierValidator(msg.SourceChannelId); err != nil {
+		return err
+	}
+
+	// validate portId
+	if err := host.PortIdentifierValidator(msg.SourcePortId); err != nil {
+		return err
+	}
+
+	// signer check
+	if _, err := sdk.AccAddressFromBech32(msg.Signer); err != nil {
+		return sdkerrors.Wrap(err, "failed to convert msg.Signer into sdk.AccAddress")
+	}
+
+	return msg.Fee.Validate()
+}
+
+// GetSignBytes implements sdk.Msg
+func (msg MsgPayPacketFee) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgPayPacketFee) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{signer}
+}
+
+// NewMsgPayPacketFeeAsync creates a new instance of MsgPayPacketFeeAsync
+func NewMsgPayPacketFeeAsync(packetID PacketId, packetFee PacketFee) *MsgPayPacketFeeAsync {
+	return &MsgPayPacketFeeAsync{
+		PacketId:  packetID,
+		PacketFee: packetFee,
+	}
+}
+
+// Route implements sdk.Msg
+func (MsgPayPacketFeeAsync) Route() string {
+	return RouterKey
+}
+
+// Type implements sdk.Msg
+func (MsgPayPacketFeeAsync) Type() string {
+	return TypeMsgPayPacketFeeAsync
+}
+
+// ValidateBasic performs a basic check of the MsgPayPacketFeeAsync fields
+func (msg MsgPayPacketFeeAsync) ValidateBasic() error {
+	if err := msg.PacketId.Validate(); err != nil {
+		return err
+	}
+
+	return msg.PacketFee.Validate()
+}
+
+// GetSignBytes implements sdk.Msg
+func (msg MsgPayPacketFeeAsync) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgPayPacketFeeAsync) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.PacketFee.RefundAddress)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{signer}
+}
@@ -0,0 +1,138 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/line/lfb-sdk/types"
+	"github.com/line/lfb-sdk/x/ibc/applications/fee/types"
+)
+
+var (
+	relayer = sdk.AccAddress("relayer_____________")
+	payee   = sdk.AccAddress("payee_______________")
+
+	validFee = types.NewFee(
+		sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)),
+		sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 200)),
+		sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 300)),
+	)
+)
+
+func TestMsgRegisterPayeeValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name    string
+		msg     *types.MsgRegisterPayee
+		expPass bool
+	}{
+		{"valid", types.NewMsgRegisterPayee("transfer", "channel-0", relayer.String(), payee.String()), true},
+		{"invalid port", types.NewMsgRegisterPayee("(invalid)", "channel-0", relayer.String(), payee.String()), false},
+		{"invalid channel", types.NewMsgRegisterPayee("transfer", "(invalid)", relayer.String(), payee.String()), false},
+		{"relayer equals payee", types.NewMsgRegisterPayee("transfer", "channel-0", relayer.String(), relayer.String()), false},
+		{"invalid relayer", types.NewMsgRegisterPayee("transfer", "channel-0", "invalid", payee.String()), false},
+		{"invalid payee", types.NewMsgRegisterPayee("transfer", "channel-0", relayer.String(), "invalid"), false},
+	}
+
+	for _, tc := range testCases {
+		err := tc.msg.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+			require.Equal(t, []sdk.AccAddress{relayer}, tc.msg.GetSigners())
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestMsgRegisterCounterpartyPayeeValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name    string
+		msg     *types.MsgRegisterCounterpartyPayee
+		expPass bool
+	}{
+		{"valid", types.NewMsgRegisterCounterpartyPayee("transfer", "channel-0", relayer.String(), "cosmos1counterparty"), true},
+		{"invalid port", types.NewMsgRegisterCounterpartyPayee("(invalid)", "channel-0", relayer.String(), "cosmos1counterparty"), false},
+		{"invalid channel", types.NewMsgRegisterCounterpartyPayee("transfer", "(invalid)", relayer.String(), "cosmos1counterparty"), false},
+		{"invalid relayer", types.NewMsgRegisterCounterpartyPayee("transfer", "channel-0", "invalid", "cosmos1counterparty"), false},
+		{"empty counterparty payee", types.NewMsgRegisterCounterpartyPayee("transfer", "channel-0", relayer.String(), "  "), false},
+	}
+
+	for _, tc := range testCases {
+		err := tc.msg.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+			require.Equal(t, []sdk.AccAddress{relayer}, tc.msg.GetSigners())
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestMsgPayPacketFeeValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name    string
+		msg     *types.MsgPayPacketFee
+		expPass bool
+	}{
+		{"valid", types.NewMsgPayPacketFee(validFee, "transfer", "channel-0", relayer.String()), true},
+		{"valid with only recv fee", types.NewMsgPayPacketFee(types.NewFee(validFee.RecvFee, nil, nil), "transfer", "channel-0", relayer.String()), true},
+		{"invalid port", types.NewMsgPayPacketFee(validFee, "(invalid)", "channel-0", relayer.String()), false},
+		{"invalid channel", types.NewMsgPayPacketFee(validFee, "transfer", "(invalid)", relayer.String()), false},
+		{"invalid signer", types.NewMsgPayPacketFee(validFee, "transfer", "channel-0", "invalid"), false},
+		{"zero fees", types.NewMsgPayPacketFee(types.NewFee(nil, nil, nil), "transfer", "channel-0", relayer.String()), false},
+		{"invalid fee", types.NewMsgPayPacketFee(types.NewFee(sdk.Coins{sdk.Coin{Denom: "stake", Amount: sdk.NewInt(-1)}}, nil, nil), "transfer", "channel-0", relayer.String()), false},
+	}
+
+	for _, tc := range testCases {
+		err := tc.msg.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+			require.Equal(t, []sdk.AccAddress{relayer}, tc.msg.GetSigners())
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestMsgPayPacketFeeAsyncValidateBasic(t *testing.T) {
+	packetID := types.NewPacketId("transfer", "channel-0", 1)
+
+	testCases := []struct {
+		name    string
+		msg     *types.MsgPayPacketFeeAsync
+		expPass bool
+	}{
+		{"valid", types.NewMsgPayPacketFeeAsync(packetID, types.NewPacketFee(validFee, relayer.String())), true},
+		{"invalid port", types.NewMsgPayPacketFeeAsync(types.NewPacketId("(invalid)", "channel-0", 1), types.NewPacketFee(validFee, relayer.String())), false},
+		{"zero sequence", types.NewMsgPayPacketFeeAsync(types.NewPacketId("transfer", "channel-0", 0), types.NewPacketFee(validFee, relayer.String())), false},
+		{"invalid refund address", types.NewMsgPayPacketFeeAsync(packetID, types.NewPacketFee(validFee, "invalid")), false},
+		{"zero fees", types.NewMsgPayPacketFeeAsync(packetID, types.NewPacketFee(types.NewFee(nil, nil, nil), relayer.String())), false},
+	}
+
+	for _, tc := range testCases {
+		err := tc.msg.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+			require.Equal(t, []sdk.AccAddress{relayer}, tc.msg.GetSigners())
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestMetadata(t *testing.T) {
+	version := types.NewMetadata("ics20-1").ChannelVersion()
+
+	metadata, err := types.MetadataFromVersion(version)
+	require.NoError(t, err)
+	require.Equal(t, types.Version, metadata.FeeVersion)
+	require.Equal(t, "ics20-1", metadata.AppVersion)
+	require.NoError(t, metadata.ValidateBasic())
+
+	_, err = types.MetadataFromVersion("ics20-1")
+	require.Error(t, err)
+
+	require.Error(t, types.Metadata{FeeVersion: "ics29-2", AppVersion: "ics20-1"}.ValidateBasic())
+	require.Error(t, types.Metadata{FeeVersion: types.Version}.ValidateBasic())
+}
@@ -0,0 +1,46 @@
+package cli
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/line/lfb-sdk/client"
+	controllercli "github.com/line/lfb-sdk/x/ibc/applications/interchain-accounts/controller/client/cli"
+	hostcli "github.com/line/lfb-sdk/x/ibc/applications/interchain-accounts/host/client/cli"
+)
+
+// GetQueryCmd returns the query commands for the interchain accounts submodules
+func GetQueryCmd() *cobra.Command {
+	queryCmd := &cobra.Command{
+		Use:                        "interchain-accounts",
+		Aliases:                    []string{"ica"},
+		Short:                      "interchain-accounts subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+	}
+
+	queryCmd.AddCommand(
+		controllercli.GetQueryCmd(),
+		hostcli.GetQueryCmd(),
+	)
+
+	return queryCmd
+}
+
+// NewTxCmd returns the transaction commands for the interchain accounts submodules
+func NewTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        "interchain-accounts",
+		Aliases:                    []string{"ica"},
+		Short:                      "interchain-accounts transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	txCmd.AddCommand(
+		controllercli.NewTxCmd(),
+		hostcli.NewTxCmd(),
+	)
+
+	return txCmd
+}
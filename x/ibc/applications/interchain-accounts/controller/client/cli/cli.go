@@ -0,0 +1,42 @@
+package cli
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/line/lfb-sdk/client"
+)
+
+// GetQueryCmd returns the query commands for the interchain accounts controller submodule
+func GetQueryCmd() *cobra.Command {
+	queryCmd := &cobra.Command{
+		Use:                        "controller",
+		Short:                      "interchain-accounts controller subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+	}
+
+	queryCmd.AddCommand(
+		GetCmdQueryInterchainAccount(),
+		GetCmdParams(),
+	)
+
+	return queryCmd
+}
+
+// NewTxCmd returns the transaction commands for the interchain accounts controller submodule
+func NewTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        "controller",
+		Short:                      "interchain-accounts controller transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	txCmd.AddCommand(
+		NewRegisterInterchainAccountCmd(),
+		NewSendTxCmd(),
+	)
+
+	return txCmd
+}
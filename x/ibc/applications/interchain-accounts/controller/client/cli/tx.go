@@ -0,0 +1,96 @@
+package cli
+
+import (
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/line/lfb-sdk/client"
+	"github.com/line/lfb-sdk/client/flags"
+	"github.com/line/lfb-sdk/client/tx"
+	"github.com/line/lfb-sdk/version"
+	"github.com/line/lfb-sdk/x/ibc/applications/interchain-accounts/controller/types"
+	icatypes "github.com/line/lfb-sdk/x/ibc/applications/interchain-accounts/types"
+)
+
+const (
+	flagRelativePacketTimeout = "relative-packet-timeout"
+)
+
+// NewRegisterInterchainAccountCmd returns the command to create a MsgRegisterInterchainAccount transaction
+func NewRegisterInterchainAccountCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register [connection-id]",
+		Short: "Register an interchain account on the host chain of the connection",
+		Long: strings.TrimSpace(`Register an interchain account owned by the sender on the host chain of the connection.
+A new ORDERED channel is opened with the host, the interchain account address can be queried once the
+channel handshake is completed.`),
+		Example: fmt.Sprintf("%s tx interchain-accounts controller register [connection-id] --from [owner]", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRegisterInterchainAccount(args[0], clientCtx.GetFromAddress())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewSendTxCmd returns the command to create a MsgSendTx transaction
+func NewSendTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "send-tx [connection-id] [path/to/packet_msg.json]",
+		Short: "Send an interchain account tx on the provided connection",
+		Long: strings.TrimSpace(`Submits pre-built packet data containing messages to be executed on the host chain
+by the interchain account of the sender. The packet data can be generated with the
+"interchain-accounts host generate-packet-data" command. The timeout is relative to the block time.`),
+		Example: fmt.Sprintf("%s tx interchain-accounts controller send-tx [connection-id] packet_msg.json --from [owner]", version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contents, err := ioutil.ReadFile(args[1])
+			if err != nil {
+				return err
+			}
+
+			var packetData icatypes.InterchainAccountPacketData
+			if err := clientCtx.JSONMarshaler.UnmarshalJSON(contents, &packetData); err != nil {
+				return fmt.Errorf("error unmarshalling packet data: %w", err)
+			}
+
+			relativeTimeout, err := cmd.Flags().GetUint64(flagRelativePacketTimeout)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSendTx(clientCtx.GetFromAddress(), args[0], relativeTimeout, packetData)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Uint64(flagRelativePacketTimeout, icatypes.DefaultRelativePacketTimeoutTimestamp, "Relative packet timeout in nanoseconds from now. Default is 10 minutes.")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,30 @@
+package controller
+
+import (
+	sdk "github.com/line/lfb-sdk/types"
+	sdkerrors "github.com/line/lfb-sdk/types/errors"
+	"github.com/line/lfb-sdk/x/ibc/applications/interchain-accounts/controller/keeper"
+	"github.com/line/lfb-sdk/x/ibc/applications/interchain-accounts/controller/types"
+)
+
+// NewHandler returns sdk.Handler for interchain accounts controller messages
+func NewHandler(k keeper.Keeper) sdk.Handler {
+	msgServer := keeper.NewMsgServerImpl(k)
+
+	return func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+		switch msg := msg.(type) {
+		case *types.MsgRegisterInterchainAccount:
+			res, err := msgServer.RegisterInterchainAccount(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgSendTx:
+			res, err := msgServer.SendTx(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		default:
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized ICS-27 controller message type: %T", msg)
+		}
+	}
+}
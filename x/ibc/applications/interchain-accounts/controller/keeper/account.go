@@ -0,0 +1,73 @@
+package keeper
+
+import (
+	sdk "github.com/line/lfb-sdk/types"
+	sdkerrors "github.com/line/lfb-sdk/types/errors"
+	icatypes "github.com/line/lfb-sdk/x/ibc/applications/interchain-accounts/types"
+	channeltypes "github.com/line/lfb-sdk/x/ibc/core/04-channel/types"
+)
+
+// RegisterInterchainAccount is the entry point to registering an interchain account.
+// It generates a new port identifier using the owner address, binds it if needed and
+// initiates the opening of an ORDERED channel with the host on the connection.
+// The channel identifier is returned. The interchain account is registered when the
+// handshake completes, at OnChanOpenAck.
+func (k Keeper) RegisterInterchainAccount(ctx sdk.Context, connectionID, owner string) (string, error) {
+	if !k.IsControllerEnabled(ctx) {
+		return "", icatypes.ErrControllerSubModuleDisabled
+	}
+
+	portID, err := icatypes.NewControllerPortID(owner)
+	if err != nil {
+		return "", err
+	}
+
+	if err := k.checkNoActiveChannel(ctx, connectionID, portID); err != nil {
+		return "", err
+	}
+
+	if !k.IsBound(ctx, portID) {
+		if err := k.BindPort(ctx, portID); err != nil {
+			return "", sdkerrors.Wrapf(err, "unable to bind to newly generated portID: %s", portID)
+		}
+	}
+
+	ownerAddr, err := sdk.AccAddressFromBech32(owner)
+	if err != nil {
+		return "", err
+	}
+
+	msg := channeltypes.NewMsgChannelOpenInit(
+		portID, icatypes.Version, channeltypes.ORDERED, []string{connectionID}, icatypes.HostPortID, ownerAddr,
+	)
+	handler := k.msgRouter.HandlerByTypeURL(sdk.MsgTypeURL(msg))
+	if handler == nil {
+		return "", sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized message route: %s", sdk.MsgTypeURL(msg))
+	}
+
+	res, err := handler(ctx, msg)
+	if err != nil {
+		return "", err
+	}
+
+	// emit the events of the channel handshake for the relayers, and read the
+	// channel identifier from them
+	var channelID string
+	events := make(sdk.Events, len(res.Events))
+	for i, event := range res.Events {
+		events[i] = sdk.Event(event)
+		if event.Type != channeltypes.EventTypeChannelOpenInit {
+			continue
+		}
+		for _, attr := range event.Attributes {
+			if string(attr.Key) == channeltypes.AttributeKeyChannelID {
+				channelID = string(attr.Value)
+			}
+		}
+	}
+	ctx.EventManager().EmitEvents(events)
+
+	k.Logger(ctx).Info("initiated interchain account channel handshake", "port-id", portID, "channel-id", channelID, "connection-id", connectionID)
+
+	return channelID, nil
+}
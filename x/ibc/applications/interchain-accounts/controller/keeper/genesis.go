@@ -0,0 +1,43 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/line/lfb-sdk/types"
+	genesistypes "github.com/line/lfb-sdk/x/ibc/applications/interchain-accounts/genesis/types"
+)
+
+// InitGenesis initializes the interchain accounts controller application state from a provided genesis state
+func (k Keeper) InitGenesis(ctx sdk.Context, state genesistypes.ControllerGenesisState) {
+	for _, portID := range state.Ports {
+		// Only try to bind to port if it is not already bound, since we may already own
+		// port capability from capability InitGenesis
+		if !k.IsBound(ctx, portID) {
+			if err := k.BindPort(ctx, portID); err != nil {
+				panic(fmt.Sprintf("could not claim port capability: %v", err))
+			}
+		} else {
+			k.setPort(ctx, portID)
+		}
+	}
+
+	for _, ch := range state.ActiveChannels {
+		k.SetActiveChannelID(ctx, ch.ConnectionId, ch.PortId, ch.ChannelId)
+	}
+
+	for _, acc := range state.InterchainAccounts {
+		k.SetInterchainAccountAddress(ctx, acc.ConnectionId, acc.PortId, acc.AccountAddress)
+	}
+
+	k.SetParams(ctx, state.Params)
+}
+
+// ExportGenesis returns the interchain accounts controller exported genesis
+func (k Keeper) ExportGenesis(ctx sdk.Context) genesistypes.ControllerGenesisState {
+	return genesistypes.NewControllerGenesisState(
+		k.GetAllActiveChannels(ctx),
+		k.GetAllInterchainAccounts(ctx),
+		k.GetAllPorts(ctx),
+		k.GetParams(ctx),
+	)
+}
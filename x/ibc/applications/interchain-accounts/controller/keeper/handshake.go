@@ -0,0 +1,122 @@
+package keeper
+
+import (
+	"strings"
+
+	sdk "github.com/line/lfb-sdk/types"
+	"github.com/line/lfb-sdk/types/bech32"
+	sdkerrors "github.com/line/lfb-sdk/types/errors"
+	capabilitytypes "github.com/line/lfb-sdk/x/capability/types"
+	icatypes "github.com/line/lfb-sdk/x/ibc/applications/interchain-accounts/types"
+	channeltypes "github.com/line/lfb-sdk/x/ibc/core/04-channel/types"
+	host "github.com/line/lfb-sdk/x/ibc/core/24-host"
+)
+
+// OnChanOpenInit performs basic validation of channel initialization.
+// The channel order must be ORDERED, the counterparty port identifier
+// must be the host chain representation as defined in the types package,
+// the channel version must be equal to the version in the types package,
+// there must not be an active channel for the specfied port identifier,
+// and the interchain accounts module must be able to claim the channel
+// capability.
+func (k Keeper) OnChanOpenInit(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID string,
+	channelID string,
+	chanCap *capabilitytypes.Capability,
+	counterparty channeltypes.Counterparty,
+	version string,
+) error {
+	if order != channeltypes.ORDERED {
+		return sdkerrors.Wrapf(channeltypes.ErrInvalidChannelOrdering, "expected %s channel, got %s", channeltypes.ORDERED, order)
+	}
+
+	if !strings.HasPrefix(portID, icatypes.ControllerPortPrefix) {
+		return sdkerrors.Wrapf(icatypes.ErrInvalidControllerPort, "expected %s{owner}, got %s", icatypes.ControllerPortPrefix, portID)
+	}
+
+	if counterparty.PortId != icatypes.HostPortID {
+		return sdkerrors.Wrapf(icatypes.ErrInvalidHostPort, "expected %s, got %s", icatypes.HostPortID, counterparty.PortId)
+	}
+
+	if version != icatypes.Version {
+		return sdkerrors.Wrapf(icatypes.ErrInvalidVersion, "expected %s, got %s", icatypes.Version, version)
+	}
+
+	if err := k.checkNoActiveChannel(ctx, connectionHops[0], portID); err != nil {
+		return err
+	}
+
+	// Claim channel capability passed back by IBC module
+	if err := k.ClaimCapability(ctx, chanCap, host.ChannelCapabilityPath(portID, channelID)); err != nil {
+		return sdkerrors.Wrap(err, "failed to claim capability")
+	}
+
+	return nil
+}
+
+// OnChanOpenAck sets the active channel for the interchain account/owner pair
+// and stores the associated interchain account address in state keyed by it's corresponding port identifier.
+// The address of the interchain account is read from the version agreed by the host.
+func (k Keeper) OnChanOpenAck(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	counterpartyVersion string,
+) error {
+	if portID == icatypes.HostPortID {
+		return sdkerrors.Wrapf(icatypes.ErrInvalidControllerPort, "portID cannot be host chain port ID: %s", icatypes.HostPortID)
+	}
+
+	address, err := icatypes.ParseAddressFromVersion(counterpartyVersion)
+	if err != nil {
+		return err
+	}
+
+	// the address has the bech32 prefix of the host chain
+	if _, _, err := bech32.DecodeAndConvert(address); err != nil {
+		return sdkerrors.Wrapf(icatypes.ErrInvalidAccountAddress, "invalid interchain account address %s: %s", address, err)
+	}
+
+	channel, found := k.channelKeeper.GetChannel(ctx, portID, channelID)
+	if !found {
+		return sdkerrors.Wrapf(channeltypes.ErrChannelNotFound, "failed to retrieve channel %s on port %s", channelID, portID)
+	}
+	connectionID := channel.ConnectionHops[0]
+
+	if err := k.checkNoActiveChannel(ctx, connectionID, portID); err != nil {
+		return err
+	}
+
+	if registered, found := k.GetInterchainAccountAddress(ctx, connectionID, portID); found && registered != address {
+		return sdkerrors.Wrapf(icatypes.ErrInvalidAccountAddress, "interchain account %s is already registered, got %s", registered, address)
+	}
+
+	k.SetActiveChannelID(ctx, connectionID, portID, channelID)
+	k.SetInterchainAccountAddress(ctx, connectionID, portID, address)
+
+	return nil
+}
+
+// checkNoActiveChannel returns an error if the owner of portID has an active
+// channel on connectionID which is not closed. The channels are closed when a
+// packet times out.
+func (k Keeper) checkNoActiveChannel(ctx sdk.Context, connectionID, portID string) error {
+	activeChannelID, found := k.GetActiveChannelID(ctx, connectionID, portID)
+	if !found {
+		return nil
+	}
+
+	channel, found := k.channelKeeper.GetChannel(ctx, portID, activeChannelID)
+	if !found {
+		return sdkerrors.Wrapf(channeltypes.ErrChannelNotFound, "failed to retrieve channel %s on port %s", activeChannelID, portID)
+	}
+
+	if channel.State != channeltypes.CLOSED {
+		return sdkerrors.Wrapf(icatypes.ErrActiveChannelAlreadySet, "existing active channel %s for portID %s must be %s", activeChannelID, portID, channeltypes.CLOSED)
+	}
+
+	return nil
+}
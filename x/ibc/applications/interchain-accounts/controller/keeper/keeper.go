@@ -0,0 +1,217 @@
+package keeper
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/line/ostracon/libs/log"
+
+	"github.com/line/lfb-sdk/baseapp"
+	"github.com/line/lfb-sdk/codec"
+	sdk "github.com/line/lfb-sdk/types"
+	capabilitykeeper "github.com/line/lfb-sdk/x/capability/keeper"
+	capabilitytypes "github.com/line/lfb-sdk/x/capability/types"
+	"github.com/line/lfb-sdk/x/ibc/applications/interchain-accounts/controller/types"
+	genesistypes "github.com/line/lfb-sdk/x/ibc/applications/interchain-accounts/genesis/types"
+	icatypes "github.com/line/lfb-sdk/x/ibc/applications/interchain-accounts/types"
+	channeltypes "github.com/line/lfb-sdk/x/ibc/core/04-channel/types"
+	host "github.com/line/lfb-sdk/x/ibc/core/24-host"
+	paramtypes "github.com/line/lfb-sdk/x/params/types"
+)
+
+// Keeper defines the IBC interchain accounts controller keeper
+type Keeper struct {
+	storeKey   sdk.StoreKey
+	cdc        codec.BinaryMarshaler
+	paramSpace *paramtypes.Subspace
+
+	channelKeeper icatypes.ChannelKeeper
+	portKeeper    icatypes.PortKeeper
+	scopedKeeper  capabilitykeeper.ScopedKeeper
+
+	msgRouter *baseapp.MsgServiceRouter
+}
+
+// NewKeeper creates a new interchain accounts controller Keeper instance
+func NewKeeper(
+	cdc codec.BinaryMarshaler, key sdk.StoreKey, paramSpace *paramtypes.Subspace,
+	channelKeeper icatypes.ChannelKeeper, portKeeper icatypes.PortKeeper,
+	scopedKeeper capabilitykeeper.ScopedKeeper, msgRouter *baseapp.MsgServiceRouter,
+) Keeper {
+	// set KeyTable if it has not already been set
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	}
+
+	return Keeper{
+		storeKey:      key,
+		cdc:           cdc,
+		paramSpace:    paramSpace,
+		channelKeeper: channelKeeper,
+		portKeeper:    portKeeper,
+		scopedKeeper:  scopedKeeper,
+		msgRouter:     msgRouter,
+	}
+}
+
+// Logger returns the application logger, scoped to the associated module
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s-%s", host.ModuleName, icatypes.ModuleName))
+}
+
+// GetAllPorts returns all ports to which the interchain accounts controller module is bound. Used in ExportGenesis
+func (k Keeper) GetAllPorts(ctx sdk.Context) []string {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, []byte(icatypes.PortKeyPrefix))
+	defer iterator.Close()
+
+	var ports []string
+	for ; iterator.Valid(); iterator.Next() {
+		keySplit := strings.Split(string(iterator.Key()), "/")
+
+		ports = append(ports, keySplit[1])
+	}
+
+	return ports
+}
+
+// BindPort stores the provided portID and binds to it, returning the associated capability
+func (k Keeper) BindPort(ctx sdk.Context, portID string) error {
+	k.setPort(ctx, portID)
+
+	cap := k.portKeeper.BindPort(ctx, portID)
+	return k.ClaimCapability(ctx, cap, host.PortPath(portID))
+}
+
+// setPort stores the provided portID
+func (k Keeper) setPort(ctx sdk.Context, portID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(icatypes.KeyPort(portID), []byte{0x01})
+}
+
+// IsBound checks if the interchain account controller module is already bound to the desired port
+func (k Keeper) IsBound(ctx sdk.Context, portID string) bool {
+	_, ok := k.scopedKeeper.GetCapability(ctx, host.PortPath(portID))
+	return ok
+}
+
+// AuthenticateCapability wraps the scopedKeeper's AuthenticateCapability function
+func (k Keeper) AuthenticateCapability(ctx sdk.Context, cap *capabilitytypes.Capability, name string) bool {
+	return k.scopedKeeper.AuthenticateCapability(ctx, cap, name)
+}
+
+// ClaimCapability wraps the scopedKeeper's ClaimCapability function
+func (k Keeper) ClaimCapability(ctx sdk.Context, cap *capabilitytypes.Capability, name string) error {
+	return k.scopedKeeper.ClaimCapability(ctx, cap, name)
+}
+
+// GetActiveChannelID retrieves the active channelID from the store, keyed by the provided connectionID and portID
+func (k Keeper) GetActiveChannelID(ctx sdk.Context, connectionID, portID string) (string, bool) {
+	store := ctx.KVStore(k.storeKey)
+	key := icatypes.KeyActiveChannel(portID, connectionID)
+
+	if !store.Has(key) {
+		return "", false
+	}
+
+	return string(store.Get(key)), true
+}
+
+// GetOpenActiveChannel retrieves the active channelID from the store, keyed by the provided connectionID and portID & checks if the channel in question is in state OPEN
+func (k Keeper) GetOpenActiveChannel(ctx sdk.Context, connectionID, portID string) (string, bool) {
+	channelID, found := k.GetActiveChannelID(ctx, connectionID, portID)
+	if !found {
+		return "", false
+	}
+
+	channel, found := k.channelKeeper.GetChannel(ctx, portID, channelID)
+	if found && channel.State == channeltypes.OPEN {
+		return channelID, true
+	}
+
+	return "", false
+}
+
+// IterateActiveChannels iterates over the active channels in the store and
+// performs a callback function
+func (k Keeper) IterateActiveChannels(ctx sdk.Context, cb func(genesistypes.ActiveChannel) bool) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, []byte(icatypes.ActiveChannelKeyPrefix))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		keySplit := strings.Split(string(iterator.Key()), "/")
+
+		ch := genesistypes.ActiveChannel{
+			PortId:       keySplit[1],
+			ConnectionId: keySplit[2],
+			ChannelId:    string(iterator.Value()),
+		}
+		if cb(ch) {
+			break
+		}
+	}
+}
+
+// GetAllActiveChannels returns a list of all active interchain accounts controller channels and their associated connection and port identifiers
+func (k Keeper) GetAllActiveChannels(ctx sdk.Context) []genesistypes.ActiveChannel {
+	var activeChannels []genesistypes.ActiveChannel
+	k.IterateActiveChannels(ctx, func(ch genesistypes.ActiveChannel) bool {
+		activeChannels = append(activeChannels, ch)
+		return false
+	})
+
+	return activeChannels
+}
+
+// SetActiveChannelID stores the active channelID, keyed by the provided connectionID and portID
+func (k Keeper) SetActiveChannelID(ctx sdk.Context, connectionID, portID, channelID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(icatypes.KeyActiveChannel(portID, connectionID), []byte(channelID))
+}
+
+// IsActiveChannel returns true if there exists an active channel for the provided connectionID and portID, otherwise false
+func (k Keeper) IsActiveChannel(ctx sdk.Context, connectionID, portID string) bool {
+	_, ok := k.GetActiveChannelID(ctx, connectionID, portID)
+	return ok
+}
+
+// GetInterchainAccountAddress retrieves the InterchainAccount address from the store associated with the provided connectionID and portID
+func (k Keeper) GetInterchainAccountAddress(ctx sdk.Context, connectionID, portID string) (string, bool) {
+	store := ctx.KVStore(k.storeKey)
+	key := icatypes.KeyOwnerAccount(portID, connectionID)
+
+	if !store.Has(key) {
+		return "", false
+	}
+
+	return string(store.Get(key)), true
+}
+
+// GetAllInterchainAccounts returns a list of all registered interchain account addresses and their associated connection and controller port identifiers
+func (k Keeper) GetAllInterchainAccounts(ctx sdk.Context) []genesistypes.RegisteredInterchainAccount {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, []byte(icatypes.OwnerKeyPrefix))
+	defer iterator.Close()
+
+	var interchainAccounts []genesistypes.RegisteredInterchainAccount
+	for ; iterator.Valid(); iterator.Next() {
+		keySplit := strings.Split(string(iterator.Key()), "/")
+
+		acc := genesistypes.RegisteredInterchainAccount{
+			PortId:         keySplit[1],
+			ConnectionId:   keySplit[2],
+			AccountAddress: string(iterator.Value()),
+		}
+
+		interchainAccounts = append(interchainAccounts, acc)
+	}
+
+	return interchainAccounts
+}
+
+// SetInterchainAccountAddress stores the InterchainAccount address, keyed by the associated connectionID and portID
+func (k Keeper) SetInterchainAccountAddress(ctx sdk.Context, connectionID, portID, address string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(icatypes.KeyOwnerAccount(portID, connectionID), []byte(address))
+}
@@ -0,0 +1,53 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/line/lfb-sdk/types"
+	"github.com/line/lfb-sdk/x/ibc/applications/interchain-accounts/controller/types"
+	icatypes "github.com/line/lfb-sdk/x/ibc/applications/interchain-accounts/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the interchain accounts
+// controller MsgServer interface for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+var _ types.MsgServer = msgServer{}
+
+// RegisterInterchainAccount defines a rpc handler for MsgRegisterInterchainAccount
+func (k msgServer) RegisterInterchainAccount(goCtx context.Context, msg *types.MsgRegisterInterchainAccount) (*types.MsgRegisterInterchainAccountResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	channelID, err := k.Keeper.RegisterInterchainAccount(ctx, msg.ConnectionId, msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRegisterInterchainAccountResponse{ChannelId: channelID}, nil
+}
+
+// SendTx defines a rpc handler for MsgSendTx
+func (k msgServer) SendTx(goCtx context.Context, msg *types.MsgSendTx) (*types.MsgSendTxResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	portID, err := icatypes.NewControllerPortID(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	// the packet timeout is relative to the current block time
+	timeoutTimestamp := uint64(ctx.BlockTime().UnixNano()) + msg.RelativeTimeout
+
+	seq, err := k.Keeper.SendTx(ctx, msg.ConnectionId, portID, msg.PacketData, timeoutTimestamp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSendTxResponse{Sequence: seq}, nil
+}
@@ -0,0 +1,72 @@
+package keeper
+
+import (
+	sdk "github.com/line/lfb-sdk/types"
+	sdkerrors "github.com/line/lfb-sdk/types/errors"
+	icatypes "github.com/line/lfb-sdk/x/ibc/applications/interchain-accounts/types"
+	clienttypes "github.com/line/lfb-sdk/x/ibc/core/02-client/types"
+	channeltypes "github.com/line/lfb-sdk/x/ibc/core/04-channel/types"
+	host "github.com/line/lfb-sdk/x/ibc/core/24-host"
+)
+
+// SendTx takes pre-built packet data containing messages to be executed on the host chain from an authentication module and attempts to send the packet.
+// The packet sequence for the outgoing packet is returned as a result.
+// If the base application has the capability to send on the provided portID and the channel is OPEN, the packet will be sent.
+// The timeoutTimestamp is an absolute timestamp, in nanoseconds, which must be after the block time.
+func (k Keeper) SendTx(
+	ctx sdk.Context, connectionID, portID string,
+	icaPacketData icatypes.InterchainAccountPacketData, timeoutTimestamp uint64,
+) (uint64, error) {
+	if !k.IsControllerEnabled(ctx) {
+		return 0, icatypes.ErrControllerSubModuleDisabled
+	}
+
+	if uint64(ctx.BlockTime().UnixNano()) >= timeoutTimestamp {
+		return 0, icatypes.ErrInvalidTimeoutTimestamp
+	}
+
+	if err := icaPacketData.ValidateBasic(); err != nil {
+		return 0, sdkerrors.Wrap(err, "invalid interchain account packet data")
+	}
+
+	activeChannelID, found := k.GetOpenActiveChannel(ctx, connectionID, portID)
+	if !found {
+		return 0, sdkerrors.Wrapf(icatypes.ErrActiveChannelNotFound, "failed to retrieve active channel on connection %s for port %s", connectionID, portID)
+	}
+
+	chanCap, found := k.scopedKeeper.GetCapability(ctx, host.ChannelCapabilityPath(portID, activeChannelID))
+	if !found {
+		return 0, sdkerrors.Wrapf(channeltypes.ErrChannelCapabilityNotFound, "failed to find capability: %s", host.ChannelCapabilityPath(portID, activeChannelID))
+	}
+
+	channel, found := k.channelKeeper.GetChannel(ctx, portID, activeChannelID)
+	if !found {
+		return 0, sdkerrors.Wrapf(channeltypes.ErrChannelNotFound, "port ID (%s) channel ID (%s)", portID, activeChannelID)
+	}
+
+	// get the next sequence
+	sequence, found := k.channelKeeper.GetNextSequenceSend(ctx, portID, activeChannelID)
+	if !found {
+		return 0, sdkerrors.Wrapf(
+			channeltypes.ErrSequenceSendNotFound,
+			"source port: %s, source channel: %s", portID, activeChannelID,
+		)
+	}
+
+	packet := channeltypes.NewPacket(
+		icaPacketData.GetBytes(),
+		sequence,
+		portID,
+		activeChannelID,
+		channel.Counterparty.PortId,
+		channel.Counterparty.ChannelId,
+		clienttypes.ZeroHeight(),
+		timeoutTimestamp,
+	)
+
+	if err := k.channelKeeper.SendPacket(ctx, chanCap, packet); err != nil {
+		return 0, err
+	}
+
+	return sequence, nil
+}
@@ -0,0 +1,34 @@
+package types
+
+import (
+	"github.com/line/lfb-sdk/codec"
+	codectypes "github.com/line/lfb-sdk/codec/types"
+	sdk "github.com/line/lfb-sdk/types"
+	"github.com/line/lfb-sdk/types/msgservice"
+)
+
+// RegisterLegacyAminoCodec registers the interchain accounts controller concrete types
+// on the provided LegacyAmino codec. These types are used for Amino JSON serialization.
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&MsgRegisterInterchainAccount{}, "lfb-sdk/MsgRegisterInterchainAccount", nil)
+	cdc.RegisterConcrete(&MsgSendTx{}, "lfb-sdk/MsgSendTx", nil)
+}
+
+// RegisterInterfaces registers the interchain accounts controller Msg service
+// to protobuf Any.
+func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
+	registry.RegisterImplementations(
+		(*sdk.Msg)(nil),
+		&MsgRegisterInterchainAccount{},
+		&MsgSendTx{},
+	)
+
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
+
+var (
+	// ModuleCdc references the global interchain accounts controller module codec.
+	// Note, the codec should ONLY be used in certain instances of tests and for
+	// JSON encoding.
+	ModuleCdc = codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+)
@@ -0,0 +1,131 @@
+package types
+
+import (
+	sdk "github.com/line/lfb-sdk/types"
+	sdkerrors "github.com/line/lfb-sdk/types/errors"
+	icatypes "github.com/line/lfb-sdk/x/ibc/applications/interchain-accounts/types"
+	host "github.com/line/lfb-sdk/x/ibc/core/24-host"
+)
+
+// msg types
+const (
+	TypeMsgRegisterInterchainAccount = "register_interchain_account"
+	TypeMsgSendTx                    = "send_tx"
+)
+
+var (
+	_ sdk.Msg = &MsgRegisterInterchainAccount{}
+	_ sdk.Msg = &MsgSendTx{}
+)
+
+// NewMsgRegisterInterchainAccount creates a new instance of MsgRegisterInterchainAccount
+//nolint:interfacer
+func NewMsgRegisterInterchainAccount(connectionID string, owner sdk.AccAddress) *MsgRegisterInterchainAccount {
+	return &MsgRegisterInterchainAccount{
+		ConnectionId: connectionID,
+		Owner:        owner.String(),
+	}
+}
+
+// Route implements sdk.Msg
+func (MsgRegisterInterchainAccount) Route() string {
+	return RouterKey
+}
+
+// Type implements sdk.Msg
+func (MsgRegisterInterchainAccount) Type() string {
+	return TypeMsgRegisterInterchainAccount
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgRegisterInterchainAccount) ValidateBasic() error {
+	if err := host.ConnectionIdentifierValidator(msg.ConnectionId); err != nil {
+		return sdkerrors.Wrap(err, "invalid connection ID")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	portID, _ := icatypes.NewControllerPortID(msg.Owner)
+	if err := host.PortIdentifierValidator(portID); err != nil {
+		return sdkerrors.Wrap(err, "owner address is too long to be used in a port ID")
+	}
+
+	return nil
+}
+
+// GetSignBytes implements sdk.Msg
+func (msg MsgRegisterInterchainAccount) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgRegisterInterchainAccount) GetSigners() []sdk.AccAddress {
+	accAddr, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{accAddr}
+}
+
+// NewMsgSendTx creates a new instance of MsgSendTx
+//nolint:interfacer
+func NewMsgSendTx(
+	owner sdk.AccAddress, connectionID string, relativeTimeout uint64,
+	packetData icatypes.InterchainAccountPacketData,
+) *MsgSendTx {
+	return &MsgSendTx{
+		ConnectionId:    connectionID,
+		Owner:           owner.String(),
+		RelativeTimeout: relativeTimeout,
+		PacketData:      packetData,
+	}
+}
+
+// Route implements sdk.Msg
+func (MsgSendTx) Route() string {
+	return RouterKey
+}
+
+// Type implements sdk.Msg
+func (MsgSendTx) Type() string {
+	return TypeMsgSendTx
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgSendTx) ValidateBasic() error {
+	if err := host.ConnectionIdentifierValidator(msg.ConnectionId); err != nil {
+		return sdkerrors.Wrap(err, "invalid connection ID")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	if err := msg.PacketData.ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(err, "invalid interchain account packet data")
+	}
+
+	if msg.RelativeTimeout == 0 {
+		return sdkerrors.Wrap(icatypes.ErrInvalidTimeoutTimestamp, "relative timeout cannot be zero")
+	}
+
+	return nil
+}
+
+// GetSignBytes implements sdk.Msg
+func (msg MsgSendTx) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgSendTx) GetSigners() []sdk.AccAddress {
+	accAddr, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{accAddr}
+}
@@ -0,0 +1,62 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/line/lfb-sdk/types"
+	"github.com/line/lfb-sdk/x/ibc/applications/interchain-accounts/controller/types"
+	icatypes "github.com/line/lfb-sdk/x/ibc/applications/interchain-accounts/types"
+)
+
+var (
+	owner      = sdk.AccAddress("owner_______________")
+	packetData = icatypes.InterchainAccountPacketData{Type: icatypes.EXECUTE_TX, Data: []byte("data")}
+)
+
+func TestMsgRegisterInterchainAccountValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name    string
+		msg     *types.MsgRegisterInterchainAccount
+		expPass bool
+	}{
+		{"valid", types.NewMsgRegisterInterchainAccount("connection-0", owner), true},
+		{"invalid connection", types.NewMsgRegisterInterchainAccount("(invalid)", owner), false},
+		{"empty owner", &types.MsgRegisterInterchainAccount{ConnectionId: "connection-0"}, false},
+	}
+
+	for _, tc := range testCases {
+		err := tc.msg.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+			require.Equal(t, []sdk.AccAddress{owner}, tc.msg.GetSigners())
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestMsgSendTxValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name    string
+		msg     *types.MsgSendTx
+		expPass bool
+	}{
+		{"valid", types.NewMsgSendTx(owner, "connection-0", icatypes.DefaultRelativePacketTimeoutTimestamp, packetData), true},
+		{"invalid connection", types.NewMsgSendTx(owner, "(invalid)", icatypes.DefaultRelativePacketTimeoutTimestamp, packetData), false},
+		{"empty owner", &types.MsgSendTx{ConnectionId: "connection-0", RelativeTimeout: 1, PacketData: packetData}, false},
+		{"invalid packet data", types.NewMsgSendTx(owner, "connection-0", icatypes.DefaultRelativePacketTimeoutTimestamp, icatypes.InterchainAccountPacketData{}), false},
+		{"zero timeout", types.NewMsgSendTx(owner, "connection-0", 0, packetData), false},
+	}
+
+	for _, tc := range testCases {
+		err := tc.msg.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+			require.Equal(t, []sdk.AccAddress{owner}, tc.msg.GetSigners())
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
@@ -0,0 +1,152 @@
+package types
+
+import (
+	"strings"
+
+	sdkerrors "github.com/line/lfb-sdk/types/errors"
+	controllertypes "github.com/line/lfb-sdk/x/ibc/applications/interchain-accounts/controller/types"
+	hosttypes "github.com/line/lfb-sdk/x/ibc/applications/interchain-accounts/host/types"
+	icatypes "github.com/line/lfb-sdk/x/ibc/applications/interchain-accounts/types"
+	host "github.com/line/lfb-sdk/x/ibc/core/24-host"
+)
+
+// DefaultGenesis creates and returns the interchain accounts GenesisState
+func DefaultGenesis() *GenesisState {
+	return &GenesisState{
+		ControllerGenesisState: DefaultControllerGenesis(),
+		HostGenesisState:       DefaultHostGenesis(),
+	}
+}
+
+// NewGenesisState creates and returns a new GenesisState instance from the provided controller and host genesis state types
+func NewGenesisState(controllerGenesisState ControllerGenesisState, hostGenesisState HostGenesisState) *GenesisState {
+	return &GenesisState{
+		ControllerGenesisState: controllerGenesisState,
+		HostGenesisState:       hostGenesisState,
+	}
+}
+
+// Validate performs basic validation of the interchain accounts GenesisState
+func (gs GenesisState) Validate() error {
+	if err := gs.ControllerGenesisState.Validate(); err != nil {
+		return err
+	}
+
+	return gs.HostGenesisState.Validate()
+}
+
+// DefaultControllerGenesis creates and returns the default interchain accounts ControllerGenesisState
+func DefaultControllerGenesis() ControllerGenesisState {
+	return ControllerGenesisState{
+		Params: controllertypes.DefaultParams(),
+	}
+}
+
+// NewControllerGenesisState creates a returns a new ControllerGenesisState instance
+func NewControllerGenesisState(
+	channels []ActiveChannel, accounts []RegisteredInterchainAccount, ports []string, params controllertypes.Params,
+) ControllerGenesisState {
+	return ControllerGenesisState{
+		ActiveChannels:     channels,
+		InterchainAccounts: accounts,
+		Ports:              ports,
+		Params:             params,
+	}
+}
+
+// Validate performs basic validation of the ControllerGenesisState
+func (gs ControllerGenesisState) Validate() error {
+	for _, ch := range gs.ActiveChannels {
+		if err := ch.Validate(); err != nil {
+			return err
+		}
+	}
+
+	for _, acc := range gs.InterchainAccounts {
+		if err := acc.Validate(); err != nil {
+			return err
+		}
+	}
+
+	for _, port := range gs.Ports {
+		if err := host.PortIdentifierValidator(port); err != nil {
+			return err
+		}
+		if !strings.HasPrefix(port, icatypes.ControllerPortPrefix) {
+			return sdkerrors.Wrapf(icatypes.ErrInvalidControllerPort, "expected %s{owner}, got %s", icatypes.ControllerPortPrefix, port)
+		}
+	}
+
+	return gs.Params.Validate()
+}
+
+// DefaultHostGenesis creates and returns the default interchain accounts HostGenesisState
+func DefaultHostGenesis() HostGenesisState {
+	return HostGenesisState{
+		Port:   icatypes.HostPortID,
+		Params: hosttypes.DefaultParams(),
+	}
+}
+
+// NewHostGenesisState creates a returns a new HostGenesisState instance
+func NewHostGenesisState(
+	channels []ActiveChannel, accounts []RegisteredInterchainAccount, port string, params hosttypes.Params,
+) HostGenesisState {
+	return HostGenesisState{
+		ActiveChannels:     channels,
+		InterchainAccounts: accounts,
+		Port:               port,
+		Params:             params,
+	}
+}
+
+// Validate performs basic validation of the HostGenesisState
+func (gs HostGenesisState) Validate() error {
+	for _, ch := range gs.ActiveChannels {
+		if err := ch.Validate(); err != nil {
+			return err
+		}
+	}
+
+	for _, acc := range gs.InterchainAccounts {
+		if err := acc.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if err := host.PortIdentifierValidator(gs.Port); err != nil {
+		return err
+	}
+
+	return gs.Params.Validate()
+}
+
+// Validate performs basic validation of the ActiveChannel
+func (ac ActiveChannel) Validate() error {
+	if err := host.ConnectionIdentifierValidator(ac.ConnectionId); err != nil {
+		return err
+	}
+
+	if err := host.PortIdentifierValidator(ac.PortId); err != nil {
+		return err
+	}
+
+	return host.ChannelIdentifierValidator(ac.ChannelId)
+}
+
+// Validate performs basic validation of the RegisteredInterchainAccount
+func (ia RegisteredInterchainAccount) Validate() error {
+	if err := host.ConnectionIdentifierValidator(ia.ConnectionId); err != nil {
+		return err
+	}
+
+	if err := host.PortIdentifierValidator(ia.PortId); err != nil {
+		return err
+	}
+
+	if strings.TrimSpace(ia.AccountAddress) == "" {
+		return sdkerrors.Wrap(icatypes.ErrInvalidAccountAddress, "account address cannot be empty")
+	}
+
+	return nil
+}
@@ -0,0 +1,94 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	controllertypes "github.com/line/lfb-sdk/x/ibc/applications/interchain-accounts/controller/types"
+	"github.com/line/lfb-sdk/x/ibc/applications/interchain-accounts/genesis/types"
+	hosttypes "github.com/line/lfb-sdk/x/ibc/applications/interchain-accounts/host/types"
+	icatypes "github.com/line/lfb-sdk/x/ibc/applications/interchain-accounts/types"
+)
+
+const (
+	connectionID = "connection-0"
+	channelID    = "channel-0"
+	portID       = "icacontroller-owner"
+)
+
+var address = icatypes.GenerateAddress(connectionID, portID).String()
+
+func TestValidateGenesis(t *testing.T) {
+	activeChannels := []types.ActiveChannel{{ConnectionId: connectionID, PortId: portID, ChannelId: channelID}}
+	accounts := []types.RegisteredInterchainAccount{{ConnectionId: connectionID, PortId: portID, AccountAddress: address}}
+
+	testCases := []struct {
+		name     string
+		genState *types.GenesisState
+		expPass  bool
+	}{
+		{
+			"default",
+			types.DefaultGenesis(),
+			true,
+		},
+		{
+			"valid genesis",
+			types.NewGenesisState(
+				types.NewControllerGenesisState(activeChannels, accounts, []string{portID}, controllertypes.DefaultParams()),
+				types.NewHostGenesisState(activeChannels, accounts, icatypes.HostPortID, hosttypes.NewParams(true, []string{"/lfb.bank.v1beta1.MsgSend"})),
+			),
+			true,
+		},
+		{
+			"invalid controller port",
+			types.NewGenesisState(
+				types.NewControllerGenesisState(nil, nil, []string{icatypes.HostPortID}, controllertypes.DefaultParams()),
+				types.DefaultHostGenesis(),
+			),
+			false,
+		},
+		{
+			"invalid active channel",
+			types.NewGenesisState(
+				types.NewControllerGenesisState([]types.ActiveChannel{{ConnectionId: connectionID, PortId: portID, ChannelId: ""}}, nil, nil, controllertypes.DefaultParams()),
+				types.DefaultHostGenesis(),
+			),
+			false,
+		},
+		{
+			"empty interchain account address",
+			types.NewGenesisState(
+				types.DefaultControllerGenesis(),
+				types.NewHostGenesisState(nil, []types.RegisteredInterchainAccount{{ConnectionId: connectionID, PortId: portID}}, icatypes.HostPortID, hosttypes.DefaultParams()),
+			),
+			false,
+		},
+		{
+			"invalid host port",
+			types.NewGenesisState(
+				types.DefaultControllerGenesis(),
+				types.NewHostGenesisState(nil, nil, "", hosttypes.DefaultParams()),
+			),
+			false,
+		},
+		{
+			"empty allowed message",
+			types.NewGenesisState(
+				types.DefaultControllerGenesis(),
+				types.NewHostGenesisState(nil, nil, icatypes.HostPortID, hosttypes.NewParams(true, []string{" "})),
+			),
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := tc.genState.Validate()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
@@ -0,0 +1,40 @@
+package cli
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/line/lfb-sdk/client"
+)
+
+// GetQueryCmd returns the query commands for the interchain accounts host submodule
+func GetQueryCmd() *cobra.Command {
+	queryCmd := &cobra.Command{
+		Use:                        "host",
+		Short:                      "interchain-accounts host subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+	}
+
+	queryCmd.AddCommand(
+		GetCmdParams(),
+	)
+
+	return queryCmd
+}
+
+// NewTxCmd returns the transaction commands for the interchain accounts host submodule
+func NewTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        "host",
+		Short:                      "interchain-accounts host subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	txCmd.AddCommand(
+		GeneratePacketDataCmd(),
+	)
+
+	return txCmd
+}
@@ -0,0 +1,110 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/line/lfb-sdk/client"
+	"github.com/line/lfb-sdk/codec"
+	sdk "github.com/line/lfb-sdk/types"
+	"github.com/line/lfb-sdk/version"
+	icatypes "github.com/line/lfb-sdk/x/ibc/applications/interchain-accounts/types"
+)
+
+const (
+	flagMemo = "memo"
+)
+
+// GeneratePacketDataCmd returns a command to generate the packet data of an
+// EXECUTE_TX interchain account packet, for use in the controller send-tx command
+func GeneratePacketDataCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "generate-packet-data [message]",
+		Short: "Generates ICA packet data",
+		Long: strings.TrimSpace(`Generates the packet data of an interchain account transaction executing the given message,
+or the given array of messages, with the interchain account as signer. The messages are given as JSON
+with their "@type" URL. The output is printed to stdout.`),
+		Example: fmt.Sprintf(`%s tx interchain-accounts host generate-packet-data '{
+	"@type": "/lfb.bank.v1beta1.MsgSend",
+	"from_address": "link1...",
+	"to_address": "link1...",
+	"amount": [{"denom": "stake", "amount": "1000"}]
+}' --memo memo`, version.AppName),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			memo, err := cmd.Flags().GetString(flagMemo)
+			if err != nil {
+				return err
+			}
+
+			cdc := codec.NewProtoCodec(clientCtx.InterfaceRegistry)
+
+			packetData, err := generatePacketData(cdc, []byte(args[0]), memo)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintBytes(packetData)
+		},
+	}
+
+	cmd.Flags().String(flagMemo, "", "an optional memo to be included in the interchain account packet data")
+
+	return cmd
+}
+
+// generatePacketData takes in message bytes and a memo and serializes the message into an
+// instance of InterchainAccountPacketData which is returned as bytes.
+func generatePacketData(cdc *codec.ProtoCodec, msgBytes []byte, memo string) ([]byte, error) {
+	msgs, err := convertBytesIntoMsgs(cdc, msgBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := icatypes.SerializeCosmosTx(cdc, msgs)
+	if err != nil {
+		return nil, err
+	}
+
+	packetData := icatypes.InterchainAccountPacketData{
+		Type: icatypes.EXECUTE_TX,
+		Data: data,
+		Memo: memo,
+	}
+
+	if err := packetData.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	return cdc.MarshalJSON(&packetData)
+}
+
+// convertBytesIntoMsgs accepts bytes which are either a single message or an array of messages
+func convertBytesIntoMsgs(cdc *codec.ProtoCodec, msgBytes []byte) ([]sdk.Msg, error) {
+	var msg sdk.Msg
+	if err := cdc.UnmarshalInterfaceJSON(msgBytes, &msg); err == nil {
+		return []sdk.Msg{msg}, nil
+	}
+
+	var rawMsgs []json.RawMessage
+	if err := json.Unmarshal(msgBytes, &rawMsgs); err != nil {
+		return nil, fmt.Errorf("cannot unmarshal message or array of messages: %w", err)
+	}
+
+	msgs := make([]sdk.Msg, len(rawMsgs))
+	for i, rawMsg := range rawMsgs {
+		if err := cdc.UnmarshalInterfaceJSON(rawMsg, &msgs[i]); err != nil {
+			return nil, err
+		}
+	}
+
+	return msgs, nil
+}
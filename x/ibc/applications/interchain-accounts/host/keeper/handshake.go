@@ -0,0 +1,139 @@
+package keeper
+
+import (
+	"strings"
+
+	sdk "github.com/line/lfb-sdk/types"
+	sdkerrors "github.com/line/lfb-sdk/types/errors"
+	authtypes "github.com/line/lfb-sdk/x/auth/types"
+	capabilitytypes "github.com/line/lfb-sdk/x/capability/types"
+	icatypes "github.com/line/lfb-sdk/x/ibc/applications/interchain-accounts/types"
+	channeltypes "github.com/line/lfb-sdk/x/ibc/core/04-channel/types"
+	host "github.com/line/lfb-sdk/x/ibc/core/24-host"
+)
+
+// OnChanOpenTry performs basic validation of the ICA channel
+// and registers a new interchain account (if it doesn't exist).
+// The channel order must be ORDERED, the port identifier must be the host
+// port, the counterparty version must be equal to the version in the types
+// package and the channel version must carry the address of the interchain
+// account generated for the connection and the counterparty port.
+func (k Keeper) OnChanOpenTry(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID,
+	channelID string,
+	chanCap *capabilitytypes.Capability,
+	counterparty channeltypes.Counterparty,
+	version,
+	counterpartyVersion string,
+) error {
+	if order != channeltypes.ORDERED {
+		return sdkerrors.Wrapf(channeltypes.ErrInvalidChannelOrdering, "expected %s channel, got %s", channeltypes.ORDERED, order)
+	}
+
+	if portID != icatypes.HostPortID {
+		return sdkerrors.Wrapf(icatypes.ErrInvalidHostPort, "expected %s, got %s", icatypes.HostPortID, portID)
+	}
+
+	if !strings.HasPrefix(counterparty.PortId, icatypes.ControllerPortPrefix) {
+		return sdkerrors.Wrapf(icatypes.ErrInvalidControllerPort, "expected %s{owner}, got %s", icatypes.ControllerPortPrefix, counterparty.PortId)
+	}
+
+	if counterpartyVersion != icatypes.Version {
+		return sdkerrors.Wrapf(icatypes.ErrInvalidVersion, "invalid counterparty version: expected %s, got %s", icatypes.Version, counterpartyVersion)
+	}
+
+	connectionID := connectionHops[0]
+	accAddress := icatypes.GenerateAddress(connectionID, counterparty.PortId)
+	if version != icatypes.NewAppVersion(accAddress.String()) {
+		return sdkerrors.Wrapf(icatypes.ErrInvalidVersion, "expected %s, got %s", icatypes.NewAppVersion(accAddress.String()), version)
+	}
+
+	if err := k.checkNoActiveChannel(ctx, connectionID, counterparty.PortId); err != nil {
+		return err
+	}
+
+	// Claim channel capability passed back by IBC module
+	if err := k.ClaimCapability(ctx, chanCap, host.ChannelCapabilityPath(portID, channelID)); err != nil {
+		return sdkerrors.Wrap(err, "failed to claim capability")
+	}
+
+	if err := k.createInterchainAccount(ctx, accAddress, counterparty.PortId); err != nil {
+		return err
+	}
+
+	k.SetInterchainAccountAddress(ctx, connectionID, counterparty.PortId, accAddress.String())
+
+	return nil
+}
+
+// OnChanOpenConfirm completes the handshake process by setting the active channel in state on the host chain
+func (k Keeper) OnChanOpenConfirm(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+) error {
+	channel, found := k.channelKeeper.GetChannel(ctx, portID, channelID)
+	if !found {
+		return sdkerrors.Wrapf(channeltypes.ErrChannelNotFound, "failed to retrieve channel %s on port %s", channelID, portID)
+	}
+	connectionID := channel.ConnectionHops[0]
+
+	// another channel may have been opened for the same controller port since OnChanOpenTry
+	if err := k.checkNoActiveChannel(ctx, connectionID, channel.Counterparty.PortId); err != nil {
+		return err
+	}
+
+	k.SetActiveChannelID(ctx, connectionID, channel.Counterparty.PortId, channelID)
+
+	return nil
+}
+
+// createInterchainAccount creates a new interchain account owned by the
+// controller port portID. An interchain account previously registered at the
+// address, e.g. over a channel which has since been closed, is reused. A plain
+// account which never signed a tx, e.g. created by a transfer to the address
+// before the registration, is converted into the interchain account.
+func (k Keeper) createInterchainAccount(ctx sdk.Context, accAddress sdk.AccAddress, portID string) error {
+	if acc := k.accountKeeper.GetAccount(ctx, accAddress); acc != nil {
+		if icaAccount, ok := acc.(icatypes.InterchainAccountI); ok && icaAccount.GetAccountOwner() == portID {
+			return nil
+		}
+
+		baseAccount, ok := acc.(*authtypes.BaseAccount)
+		if !ok || baseAccount.GetPubKey() != nil || baseAccount.GetSequence() != 0 {
+			return sdkerrors.Wrapf(icatypes.ErrAccountAlreadyExist, "existing account for interchain account address %s", accAddress)
+		}
+
+		k.accountKeeper.SetAccount(ctx, icatypes.NewInterchainAccount(baseAccount, portID))
+		return nil
+	}
+
+	interchainAccount := icatypes.NewInterchainAccount(authtypes.NewBaseAccountWithAddress(accAddress), portID)
+	k.accountKeeper.NewAccount(ctx, interchainAccount)
+	k.accountKeeper.SetAccount(ctx, interchainAccount)
+
+	return nil
+}
+
+// checkNoActiveChannel returns an error if the controller port portID has an
+// active channel on connectionID which is not closed.
+func (k Keeper) checkNoActiveChannel(ctx sdk.Context, connectionID, portID string) error {
+	activeChannelID, found := k.GetActiveChannelID(ctx, connectionID, portID)
+	if !found {
+		return nil
+	}
+
+	channel, found := k.channelKeeper.GetChannel(ctx, icatypes.HostPortID, activeChannelID)
+	if !found {
+		return sdkerrors.Wrapf(channeltypes.ErrChannelNotFound, "failed to retrieve channel %s on port %s", activeChannelID, icatypes.HostPortID)
+	}
+
+	if channel.State != channeltypes.CLOSED {
+		return sdkerrors.Wrapf(icatypes.ErrActiveChannelAlreadySet, "existing active channel %s for portID %s must be %s", activeChannelID, portID, channeltypes.CLOSED)
+	}
+
+	return nil
+}
@@ -0,0 +1,31 @@
+package keeper
+
+import (
+	sdk "github.com/line/lfb-sdk/types"
+	"github.com/line/lfb-sdk/x/ibc/applications/interchain-accounts/host/types"
+)
+
+// IsHostEnabled retrieves the host enabled boolean from the paramstore.
+// True is returned if the host submodule is enabled.
+func (k Keeper) IsHostEnabled(ctx sdk.Context) bool {
+	var res bool
+	k.paramSpace.Get(ctx, types.KeyHostEnabled, &res)
+	return res
+}
+
+// GetAllowMessages retrieves the host enabled msg types from the paramstore
+func (k Keeper) GetAllowMessages(ctx sdk.Context) []string {
+	var res []string
+	k.paramSpace.Get(ctx, types.KeyAllowMessages, &res)
+	return res
+}
+
+// GetParams returns the total set of the host submodule parameters.
+func (k Keeper) GetParams(ctx sdk.Context) types.Params {
+	return types.NewParams(k.IsHostEnabled(ctx), k.GetAllowMessages(ctx))
+}
+
+// SetParams sets the total set of the host submodule parameters.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
@@ -0,0 +1,126 @@
+package keeper
+
+import (
+	sdk "github.com/line/lfb-sdk/types"
+	sdkerrors "github.com/line/lfb-sdk/types/errors"
+	icatypes "github.com/line/lfb-sdk/x/ibc/applications/interchain-accounts/types"
+	channeltypes "github.com/line/lfb-sdk/x/ibc/core/04-channel/types"
+)
+
+// OnRecvPacket handles a given interchain accounts packet on a destination host chain.
+// The messages of an EXECUTE_TX packet are executed atomically by the interchain
+// account, the marshalled sdk.TxMsgData of their responses is returned.
+func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet) ([]byte, error) {
+	var data icatypes.InterchainAccountPacketData
+	if err := icatypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		// UnmarshalJSON errors are indeterminate and therefore are not wrapped and included in failed acks
+		return nil, sdkerrors.Wrapf(icatypes.ErrUnknownDataType, "cannot unmarshal ICS-27 interchain account packet data")
+	}
+
+	switch data.Type {
+	case icatypes.EXECUTE_TX:
+		msgs, err := icatypes.DeserializeCosmosTx(k.cdc, data.Data)
+		if err != nil {
+			return nil, err
+		}
+
+		return k.executeTx(ctx, packet.SourcePort, packet.DestinationPort, packet.DestinationChannel, msgs)
+	default:
+		return nil, icatypes.ErrUnknownDataType
+	}
+}
+
+// executeTx attempts to execute the provided transaction. It begins by authenticating the transaction signer.
+// If authentication succeeds, it does basic validation of the messages before attempting to deliver each message
+// into state. The state changes will only be committed if all messages in the transaction succeed. Thus the
+// execution of the transaction is atomic, all state changes are reverted if a single message fails.
+func (k Keeper) executeTx(ctx sdk.Context, sourcePort, destPort, destChannel string, msgs []sdk.Msg) ([]byte, error) {
+	channel, found := k.channelKeeper.GetChannel(ctx, destPort, destChannel)
+	if !found {
+		return nil, channeltypes.ErrChannelNotFound
+	}
+
+	if err := k.authenticateTx(ctx, msgs, channel.ConnectionHops[0], sourcePort); err != nil {
+		return nil, err
+	}
+
+	txMsgData := &sdk.TxMsgData{
+		Data: make([]*sdk.MsgData, len(msgs)),
+	}
+
+	// CacheContext returns a new context with the multi-store branched into a cached storage object
+	// writeCache is called only if all msgs succeed, performing state transitions atomically
+	cacheCtx, writeCache := ctx.CacheContext()
+	for i, msg := range msgs {
+		if err := msg.ValidateBasic(); err != nil {
+			return nil, err
+		}
+
+		msgResponse, err := k.executeMsg(cacheCtx, msg)
+		if err != nil {
+			return nil, err
+		}
+
+		txMsgData.Data[i] = &sdk.MsgData{
+			MsgType: sdk.MsgTypeURL(msg),
+			Data:    msgResponse,
+		}
+	}
+
+	// NOTE: The context returned by CacheContext() creates a new EventManager, so events must be correctly propagated back to the current context
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	writeCache()
+
+	txResponse, err := k.cdc.MarshalBinaryBare(txMsgData)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "failed to marshal tx data")
+	}
+
+	return txResponse, nil
+}
+
+// authenticateTx ensures the provided msgs contain the correct interchain account signer address retrieved
+// from state using the provided controller port identifier, and that every msg type is allowed by the host
+func (k Keeper) authenticateTx(ctx sdk.Context, msgs []sdk.Msg, connectionID, portID string) error {
+	interchainAccountAddr, found := k.GetInterchainAccountAddress(ctx, connectionID, portID)
+	if !found {
+		return sdkerrors.Wrapf(icatypes.ErrInterchainAccountNotFound, "failed to retrieve interchain account on port %s", portID)
+	}
+
+	allowMsgs := k.GetParams(ctx)
+	for _, msg := range msgs {
+		if !allowMsgs.IsAllowedMsg(sdk.MsgTypeURL(msg)) {
+			return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "message type not allowed: %s", sdk.MsgTypeURL(msg))
+		}
+
+		for _, signer := range msg.GetSigners() {
+			if interchainAccountAddr != signer.String() {
+				return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "unexpected signer address: expected %s, got %s", interchainAccountAddr, signer)
+			}
+		}
+	}
+
+	return nil
+}
+
+// executeMsg attempts to execute the provided message through the msg service router and returns its response data
+func (k Keeper) executeMsg(ctx sdk.Context, msg sdk.Msg) ([]byte, error) {
+	handler := k.msgRouter.HandlerByTypeURL(sdk.MsgTypeURL(msg))
+	if handler == nil {
+		return nil, icatypes.ErrInvalidRoute
+	}
+
+	res, err := handler(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	// NOTE: The sdk msg handler creates a new EventManager, so events must be correctly propagated back to the current context
+	events := make(sdk.Events, len(res.Events))
+	for i, event := range res.Events {
+		events[i] = sdk.Event(event)
+	}
+	ctx.EventManager().EmitEvents(events)
+
+	return res.Data, nil
+}
@@ -0,0 +1,9 @@
+package types
+
+const (
+	// SubModuleName defines the interchain accounts host module name
+	SubModuleName = "icahost"
+
+	// StoreKey is the store key string for the interchain accounts host module
+	StoreKey = SubModuleName
+)
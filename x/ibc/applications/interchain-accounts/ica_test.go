@@ -0,0 +1,379 @@
+package ica_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/line/lfb-sdk/baseapp"
+	sdk "github.com/line/lfb-sdk/types"
+	banktypes "github.com/line/lfb-sdk/x/bank/types"
+	controllertypes "github.com/line/lfb-sdk/x/ibc/applications/interchain-accounts/controller/types"
+	genesistypes "github.com/line/lfb-sdk/x/ibc/applications/interchain-accounts/genesis/types"
+	icahost "github.com/line/lfb-sdk/x/ibc/applications/interchain-accounts/host"
+	hosttypes "github.com/line/lfb-sdk/x/ibc/applications/interchain-accounts/host/types"
+	icatypes "github.com/line/lfb-sdk/x/ibc/applications/interchain-accounts/types"
+	clienttypes "github.com/line/lfb-sdk/x/ibc/core/02-client/types"
+	channeltypes "github.com/line/lfb-sdk/x/ibc/core/04-channel/types"
+	host "github.com/line/lfb-sdk/x/ibc/core/24-host"
+	"github.com/line/lfb-sdk/x/ibc/core/exported"
+	ibctesting "github.com/line/lfb-sdk/x/ibc/testing"
+)
+
+type InterchainAccountsTestSuite struct {
+	suite.Suite
+
+	coordinator *ibctesting.Coordinator
+
+	// testing chains used for convenience and readability
+	// chainA is the controller chain and chainB the host chain
+	chainA *ibctesting.TestChain
+	chainB *ibctesting.TestChain
+
+	clientA, clientB string
+	connA, connB     *ibctesting.TestConnection
+}
+
+func (suite *InterchainAccountsTestSuite) SetupTest() {
+	suite.coordinator = ibctesting.NewCoordinator(suite.T(), 2)
+	suite.chainA = suite.coordinator.GetChain(ibctesting.GetChainID(0))
+	suite.chainB = suite.coordinator.GetChain(ibctesting.GetChainID(1))
+
+	suite.clientA, suite.clientB, suite.connA, suite.connB = suite.coordinator.SetupClientConnections(suite.chainA, suite.chainB, exported.Tendermint)
+}
+
+// owner returns the owner of the interchain account, the sender of the controller chain
+func (suite *InterchainAccountsTestSuite) owner() string {
+	return suite.chainA.SenderAccount.GetAddress().String()
+}
+
+// portID returns the controller port of the owner
+func (suite *InterchainAccountsTestSuite) portID() string {
+	portID, err := icatypes.NewControllerPortID(suite.owner())
+	suite.Require().NoError(err)
+	return portID
+}
+
+// registerInterchainAccount registers an interchain account for the owner on
+// the controller chain and completes the channel handshake with the host chain.
+func (suite *InterchainAccountsTestSuite) registerInterchainAccount() (ibctesting.TestChannel, ibctesting.TestChannel) {
+	channelA := suite.chainA.AddTestChannel(suite.connA, suite.portID())
+	channelA.Version = icatypes.Version
+
+	msg := controllertypes.NewMsgRegisterInterchainAccount(suite.connA.ID, suite.chainA.SenderAccount.GetAddress())
+	err := suite.coordinator.SendMsg(suite.chainA, suite.chainB, suite.clientB, msg)
+	suite.Require().NoError(err)
+
+	// the host agrees on the version carrying the address of the interchain account
+	channelB := suite.chainB.AddTestChannel(suite.connB, icatypes.HostPortID)
+	channelB.Version = icatypes.NewAppVersion(icatypes.GenerateAddress(suite.connB.ID, channelA.PortID).String())
+
+	err = suite.coordinator.ChanOpenTry(suite.chainB, suite.chainA, channelB, channelA, suite.connB, channeltypes.ORDERED)
+	suite.Require().NoError(err)
+
+	err = suite.coordinator.ChanOpenAck(suite.chainA, suite.chainB, channelA, channelB)
+	suite.Require().NoError(err)
+
+	err = suite.coordinator.ChanOpenConfirm(suite.chainB, suite.chainA, channelB, channelA)
+	suite.Require().NoError(err)
+
+	return channelA, channelB
+}
+
+// interchainAccountAddress returns the address of the interchain account of
+// the owner registered on the controller chain
+func (suite *InterchainAccountsTestSuite) interchainAccountAddress() sdk.AccAddress {
+	addr, found := suite.chainA.App.ICAControllerKeeper.GetInterchainAccountAddress(suite.chainA.GetContext(), suite.connA.ID, suite.portID())
+	suite.Require().True(found)
+
+	icaAddr, err := sdk.AccAddressFromBech32(addr)
+	suite.Require().NoError(err)
+	return icaAddr
+}
+
+// sendTx sends the msgs to be executed by the interchain account of the owner
+// and returns the packet sent by the controller chain
+func (suite *InterchainAccountsTestSuite) sendTx(channelA, channelB ibctesting.TestChannel, msgs []sdk.Msg) channeltypes.Packet {
+	data, err := icatypes.SerializeCosmosTx(suite.chainA.App.AppCodec(), msgs)
+	suite.Require().NoError(err)
+
+	packetData := icatypes.InterchainAccountPacketData{
+		Type: icatypes.EXECUTE_TX,
+		Data: data,
+	}
+	timeoutTimestamp := uint64(suite.chainA.CurrentHeader.Time.UnixNano()) + icatypes.DefaultRelativePacketTimeoutTimestamp
+
+	msg := controllertypes.NewMsgSendTx(suite.chainA.SenderAccount.GetAddress(), suite.connA.ID, icatypes.DefaultRelativePacketTimeoutTimestamp, packetData)
+	err = suite.coordinator.SendMsg(suite.chainA, suite.chainB, suite.clientB, msg)
+	suite.Require().NoError(err)
+
+	nextSequence, found := suite.chainA.App.IBCKeeper.ChannelKeeper.GetNextSequenceSend(suite.chainA.GetContext(), channelA.PortID, channelA.ID)
+	suite.Require().True(found)
+
+	return channeltypes.NewPacket(
+		packetData.GetBytes(), nextSequence-1, channelA.PortID, channelA.ID, channelB.PortID, channelB.ID,
+		clienttypes.ZeroHeight(), timeoutTimestamp,
+	)
+}
+
+func (suite *InterchainAccountsTestSuite) TestRegisterInterchainAccount() {
+	channelA, channelB := suite.registerInterchainAccount()
+
+	// the channels are open and active on both chains
+	suite.Require().Equal(channeltypes.OPEN, suite.chainA.GetChannel(channelA).State)
+	suite.Require().Equal(channeltypes.OPEN, suite.chainB.GetChannel(channelB).State)
+
+	activeChannelID, found := suite.chainA.App.ICAControllerKeeper.GetOpenActiveChannel(suite.chainA.GetContext(), suite.connA.ID, channelA.PortID)
+	suite.Require().True(found)
+	suite.Require().Equal(channelA.ID, activeChannelID)
+
+	activeChannelID, found = suite.chainB.App.ICAHostKeeper.GetOpenActiveChannel(suite.chainB.GetContext(), suite.connB.ID, channelA.PortID)
+	suite.Require().True(found)
+	suite.Require().Equal(channelB.ID, activeChannelID)
+
+	// the interchain account is created on the host chain
+	icaAddr := suite.interchainAccountAddress()
+	suite.Require().Equal(icatypes.GenerateAddress(suite.connB.ID, channelA.PortID), icaAddr)
+
+	acc := suite.chainB.App.AccountKeeper.GetAccount(suite.chainB.GetContext(), icaAddr)
+	suite.Require().NotNil(acc)
+	icaAcc, ok := acc.(icatypes.InterchainAccountI)
+	suite.Require().True(ok)
+	suite.Require().Equal(channelA.PortID, icaAcc.GetAccountOwner())
+
+	hostAddr, found := suite.chainB.App.ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), suite.connB.ID, channelA.PortID)
+	suite.Require().True(found)
+	suite.Require().Equal(icaAddr.String(), hostAddr)
+
+	// the owner cannot open a second channel while the active channel is open
+	_, err := suite.chainA.App.ICAControllerKeeper.RegisterInterchainAccount(suite.chainA.GetContext(), suite.connA.ID, suite.owner())
+	suite.Require().ErrorIs(err, icatypes.ErrActiveChannelAlreadySet)
+}
+
+func (suite *InterchainAccountsTestSuite) TestRegisterInterchainAccountAfterChannelClosed() {
+	channelA, channelB := suite.registerInterchainAccount()
+	icaAddr := suite.interchainAccountAddress()
+
+	// a timeout closes the ORDERED channel on both ends
+	err := suite.coordinator.SetChannelClosed(suite.chainA, suite.chainB, channelA)
+	suite.Require().NoError(err)
+	err = suite.coordinator.SetChannelClosed(suite.chainB, suite.chainA, channelB)
+	suite.Require().NoError(err)
+
+	_, found := suite.chainA.App.ICAControllerKeeper.GetOpenActiveChannel(suite.chainA.GetContext(), suite.connA.ID, channelA.PortID)
+	suite.Require().False(found)
+
+	// a new channel is opened for the same interchain account
+	newChannelA, newChannelB := suite.registerInterchainAccount()
+	suite.Require().NotEqual(channelA.ID, newChannelA.ID)
+	suite.Require().NotEqual(channelB.ID, newChannelB.ID)
+	suite.Require().Equal(icaAddr, suite.interchainAccountAddress())
+
+	activeChannelID, found := suite.chainB.App.ICAHostKeeper.GetOpenActiveChannel(suite.chainB.GetContext(), suite.connB.ID, channelA.PortID)
+	suite.Require().True(found)
+	suite.Require().Equal(newChannelB.ID, activeChannelID)
+}
+
+func (suite *InterchainAccountsTestSuite) TestRegisterInterchainAccountExistingAccount() {
+	icaAddr := icatypes.GenerateAddress(suite.connB.ID, suite.portID())
+	amount := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)))
+
+	// a transfer to the address before the registration creates a plain account
+	err := suite.chainB.App.BankKeeper.SendCoins(suite.chainB.GetContext(), suite.chainB.SenderAccount.GetAddress(), icaAddr, amount)
+	suite.Require().NoError(err)
+	acc := suite.chainB.App.AccountKeeper.GetAccount(suite.chainB.GetContext(), icaAddr)
+	suite.Require().NotNil(acc)
+
+	// it is converted into the interchain account, keeping its number and balance
+	suite.registerInterchainAccount()
+	icaAcc, ok := suite.chainB.App.AccountKeeper.GetAccount(suite.chainB.GetContext(), icaAddr).(icatypes.InterchainAccountI)
+	suite.Require().True(ok)
+	suite.Require().Equal(suite.portID(), icaAcc.GetAccountOwner())
+	suite.Require().Equal(acc.GetAccountNumber(), icaAcc.GetAccountNumber())
+	suite.Require().Equal(amount, suite.chainB.App.BankKeeper.GetAllBalances(suite.chainB.GetContext(), icaAddr))
+}
+
+func (suite *InterchainAccountsTestSuite) TestRegisterInterchainAccountUsedAccount() {
+	icaAddr := icatypes.GenerateAddress(suite.connB.ID, suite.portID())
+
+	// an account which signed a tx is not converted
+	acc := suite.chainB.App.AccountKeeper.NewAccountWithAddress(suite.chainB.GetContext(), icaAddr)
+	suite.Require().NoError(acc.SetSequence(1))
+	suite.chainB.App.AccountKeeper.SetAccount(suite.chainB.GetContext(), acc)
+
+	ctx := suite.chainB.GetContext()
+	chanCap, err := suite.chainB.App.ScopedIBCKeeper.NewCapability(ctx, host.ChannelCapabilityPath(icatypes.HostPortID, "channel-0"))
+	suite.Require().NoError(err)
+
+	hostModule := icahost.NewIBCModule(suite.chainB.App.ICAHostKeeper)
+	counterparty := channeltypes.NewCounterparty(suite.portID(), "channel-0")
+	err = hostModule.OnChanOpenTry(
+		ctx, channeltypes.ORDERED, []string{suite.connB.ID}, icatypes.HostPortID, "channel-0",
+		chanCap, counterparty, icatypes.NewAppVersion(icaAddr.String()), icatypes.Version,
+	)
+	suite.Require().ErrorIs(err, icatypes.ErrAccountAlreadyExist)
+
+	_, isICA := suite.chainB.App.AccountKeeper.GetAccount(suite.chainB.GetContext(), icaAddr).(icatypes.InterchainAccountI)
+	suite.Require().False(isICA)
+}
+
+func (suite *InterchainAccountsTestSuite) TestSubModulesDisabled() {
+	suite.chainA.App.ICAControllerKeeper.SetParams(suite.chainA.GetContext(), controllertypes.NewParams(false))
+	suite.chainB.App.ICAHostKeeper.SetParams(suite.chainB.GetContext(), hosttypes.NewParams(false, nil))
+
+	_, err := suite.chainA.App.ICAControllerKeeper.RegisterInterchainAccount(suite.chainA.GetContext(), suite.connA.ID, suite.owner())
+	suite.Require().ErrorIs(err, icatypes.ErrControllerSubModuleDisabled)
+
+	hostModule := icahost.NewIBCModule(suite.chainB.App.ICAHostKeeper)
+	counterparty := channeltypes.NewCounterparty(suite.portID(), "channel-0")
+	version := icatypes.NewAppVersion(icatypes.GenerateAddress(suite.connB.ID, suite.portID()).String())
+	err = hostModule.OnChanOpenTry(
+		suite.chainB.GetContext(), channeltypes.ORDERED, []string{suite.connB.ID}, icatypes.HostPortID, "channel-0",
+		nil, counterparty, version, icatypes.Version,
+	)
+	suite.Require().ErrorIs(err, icatypes.ErrHostSubModuleDisabled)
+}
+
+func (suite *InterchainAccountsTestSuite) TestSendTx() {
+	amount := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)))
+	receiver := suite.chainB.SenderAccount.GetAddress()
+
+	testCases := []struct {
+		msg       string
+		allowMsgs []string
+		signer    func(icaAddr sdk.AccAddress) sdk.AccAddress
+		expPass   bool
+	}{
+		{
+			"success", []string{sdk.MsgTypeURL(&banktypes.MsgSend{})},
+			func(icaAddr sdk.AccAddress) sdk.AccAddress { return icaAddr }, true,
+		},
+		{
+			"msg type not allowed", nil,
+			func(icaAddr sdk.AccAddress) sdk.AccAddress { return icaAddr }, false,
+		},
+		{
+			"signer is not the interchain account", []string{sdk.MsgTypeURL(&banktypes.MsgSend{})},
+			func(_ sdk.AccAddress) sdk.AccAddress { return receiver }, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+			receiver = suite.chainB.SenderAccount.GetAddress()
+
+			suite.chainB.App.ICAHostKeeper.SetParams(suite.chainB.GetContext(), hosttypes.NewParams(true, tc.allowMsgs))
+			suite.coordinator.CommitBlock(suite.chainB)
+
+			channelA, channelB := suite.registerInterchainAccount()
+			icaAddr := suite.interchainAccountAddress()
+
+			// fund the interchain account on the host chain
+			fundMsg := banktypes.NewMsgSend(suite.chainB.SenderAccount.GetAddress(), icaAddr, amount)
+			err := suite.coordinator.SendMsg(suite.chainB, suite.chainA, suite.clientA, fundMsg)
+			suite.Require().NoError(err)
+
+			msgSend := banktypes.NewMsgSend(tc.signer(icaAddr), receiver, amount)
+			packet := suite.sendTx(channelA, channelB, []sdk.Msg{msgSend})
+
+			receiverBalance := suite.chainB.App.BankKeeper.GetBalance(suite.chainB.GetContext(), receiver, sdk.DefaultBondDenom)
+
+			if tc.expPass {
+				txMsgData := &sdk.TxMsgData{
+					Data: []*sdk.MsgData{{MsgType: sdk.MsgTypeURL(msgSend)}},
+				}
+				txResponse, err := suite.chainB.App.AppCodec().MarshalBinaryBare(txMsgData)
+				suite.Require().NoError(err)
+				ack := channeltypes.NewResultAcknowledgement(txResponse)
+
+				err = suite.coordinator.RelayPacket(suite.chainA, suite.chainB, suite.clientA, suite.clientB, packet, ack.GetBytes())
+				suite.Require().NoError(err)
+
+				suite.Require().True(suite.chainB.App.BankKeeper.GetBalance(suite.chainB.GetContext(), icaAddr, sdk.DefaultBondDenom).IsZero())
+				suite.Require().Equal(
+					receiverBalance.Add(amount[0]),
+					suite.chainB.App.BankKeeper.GetBalance(suite.chainB.GetContext(), receiver, sdk.DefaultBondDenom),
+				)
+			} else {
+				err = suite.coordinator.RecvPacket(suite.chainA, suite.chainB, suite.clientA, packet)
+				suite.Require().NoError(err)
+
+				// an error acknowledgement is written and the msgs are not executed
+				_, found := suite.chainB.App.IBCKeeper.ChannelKeeper.GetPacketAcknowledgement(suite.chainB.GetContext(), packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+				suite.Require().True(found)
+				suite.Require().Equal(amount, suite.chainB.App.BankKeeper.GetAllBalances(suite.chainB.GetContext(), icaAddr))
+				suite.Require().Equal(
+					receiverBalance,
+					suite.chainB.App.BankKeeper.GetBalance(suite.chainB.GetContext(), receiver, sdk.DefaultBondDenom),
+				)
+			}
+		})
+	}
+}
+
+func (suite *InterchainAccountsTestSuite) TestQueries() {
+	suite.registerInterchainAccount()
+	icaAddr := suite.interchainAccountAddress()
+
+	queryHelperA := baseapp.NewQueryServerTestHelper(suite.chainA.GetContext(), suite.chainA.App.InterfaceRegistry())
+	controllertypes.RegisterQueryServer(queryHelperA, suite.chainA.App.ICAControllerKeeper)
+	controllerQueryClient := controllertypes.NewQueryClient(queryHelperA)
+	res, err := controllerQueryClient.InterchainAccount(
+		context.Background(),
+		&controllertypes.QueryInterchainAccountRequest{Owner: suite.owner(), ConnectionId: suite.connA.ID},
+	)
+	suite.Require().NoError(err)
+	suite.Require().Equal(icaAddr.String(), res.Address)
+
+	_, err = controllerQueryClient.InterchainAccount(
+		context.Background(),
+		&controllertypes.QueryInterchainAccountRequest{Owner: suite.owner(), ConnectionId: "connection-100"},
+	)
+	suite.Require().Error(err)
+
+	controllerParams, err := controllerQueryClient.Params(context.Background(), &controllertypes.QueryParamsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(controllertypes.DefaultParams(), *controllerParams.Params)
+
+	queryHelperB := baseapp.NewQueryServerTestHelper(suite.chainB.GetContext(), suite.chainB.App.InterfaceRegistry())
+	hosttypes.RegisterQueryServer(queryHelperB, suite.chainB.App.ICAHostKeeper)
+	hostQueryClient := hosttypes.NewQueryClient(queryHelperB)
+	hostParams, err := hostQueryClient.Params(context.Background(), &hosttypes.QueryParamsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(hosttypes.DefaultParams(), *hostParams.Params)
+}
+
+func (suite *InterchainAccountsTestSuite) TestGenesis() {
+	channelA, channelB := suite.registerInterchainAccount()
+	icaAddr := suite.interchainAccountAddress()
+
+	controllerGenesis := suite.chainA.App.ICAControllerKeeper.ExportGenesis(suite.chainA.GetContext())
+	suite.Require().Equal([]string{channelA.PortID}, controllerGenesis.Ports)
+	suite.Require().Equal([]genesistypes.ActiveChannel{{ConnectionId: suite.connA.ID, PortId: channelA.PortID, ChannelId: channelA.ID}}, controllerGenesis.ActiveChannels)
+	suite.Require().Equal([]genesistypes.RegisteredInterchainAccount{{ConnectionId: suite.connA.ID, PortId: channelA.PortID, AccountAddress: icaAddr.String()}}, controllerGenesis.InterchainAccounts)
+
+	hostGenesis := suite.chainB.App.ICAHostKeeper.ExportGenesis(suite.chainB.GetContext())
+	suite.Require().Equal(icatypes.HostPortID, hostGenesis.Port)
+	suite.Require().Equal([]genesistypes.ActiveChannel{{ConnectionId: suite.connB.ID, PortId: channelA.PortID, ChannelId: channelB.ID}}, hostGenesis.ActiveChannels)
+	suite.Require().Equal([]genesistypes.RegisteredInterchainAccount{{ConnectionId: suite.connB.ID, PortId: channelA.PortID, AccountAddress: icaAddr.String()}}, hostGenesis.InterchainAccounts)
+
+	suite.Require().NoError(genesistypes.NewGenesisState(controllerGenesis, hostGenesis).Validate())
+
+	// import the exported state on a new chain
+	suite.SetupTest()
+
+	suite.chainA.App.ICAControllerKeeper.InitGenesis(suite.chainA.GetContext(), controllerGenesis)
+	suite.Require().Equal(controllerGenesis, suite.chainA.App.ICAControllerKeeper.ExportGenesis(suite.chainA.GetContext()))
+	suite.Require().True(suite.chainA.App.ICAControllerKeeper.IsBound(suite.chainA.GetContext(), channelA.PortID))
+
+	suite.chainB.App.ICAHostKeeper.InitGenesis(suite.chainB.GetContext(), hostGenesis)
+	suite.Require().Equal(hostGenesis, suite.chainB.App.ICAHostKeeper.ExportGenesis(suite.chainB.GetContext()))
+}
+
+func TestInterchainAccountsTestSuite(t *testing.T) {
+	suite.Run(t, new(InterchainAccountsTestSuite))
+}
@@ -0,0 +1,188 @@
+package ica
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/gorilla/mux"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"github.com/spf13/cobra"
+
+	abci "github.com/line/ostracon/abci/types"
+
+	"github.com/line/lfb-sdk/client"
+	"github.com/line/lfb-sdk/codec"
+	codectypes "github.com/line/lfb-sdk/codec/types"
+	sdk "github.com/line/lfb-sdk/types"
+	"github.com/line/lfb-sdk/types/module"
+	"github.com/line/lfb-sdk/x/ibc/applications/interchain-accounts/client/cli"
+	"github.com/line/lfb-sdk/x/ibc/applications/interchain-accounts/controller"
+	controllerkeeper "github.com/line/lfb-sdk/x/ibc/applications/interchain-accounts/controller/keeper"
+	controllertypes "github.com/line/lfb-sdk/x/ibc/applications/interchain-accounts/controller/types"
+	genesistypes "github.com/line/lfb-sdk/x/ibc/applications/interchain-accounts/genesis/types"
+	hostkeeper "github.com/line/lfb-sdk/x/ibc/applications/interchain-accounts/host/keeper"
+	hosttypes "github.com/line/lfb-sdk/x/ibc/applications/interchain-accounts/host/types"
+	"github.com/line/lfb-sdk/x/ibc/applications/interchain-accounts/types"
+)
+
+var (
+	_ module.AppModule      = AppModule{}
+	_ module.AppModuleBasic = AppModuleBasic{}
+)
+
+// AppModuleBasic is the IBC interchain accounts AppModuleBasic
+type AppModuleBasic struct{}
+
+// Name implements AppModuleBasic interface
+func (AppModuleBasic) Name() string {
+	return types.ModuleName
+}
+
+// RegisterLegacyAminoCodec implements AppModuleBasic interface
+func (AppModuleBasic) RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	types.RegisterLegacyAminoCodec(cdc)
+	controllertypes.RegisterLegacyAminoCodec(cdc)
+}
+
+// RegisterInterfaces registers module concrete types into protobuf Any
+func (AppModuleBasic) RegisterInterfaces(registry codectypes.InterfaceRegistry) {
+	types.RegisterInterfaces(registry)
+	controllertypes.RegisterInterfaces(registry)
+}
+
+// DefaultGenesis returns default genesis state as raw bytes for the IBC
+// interchain accounts module
+func (AppModuleBasic) DefaultGenesis(cdc codec.JSONMarshaler) json.RawMessage {
+	return cdc.MustMarshalJSON(genesistypes.DefaultGenesis())
+}
+
+// ValidateGenesis performs genesis state validation for the IBC interchain accounts module
+func (AppModuleBasic) ValidateGenesis(cdc codec.JSONMarshaler, config client.TxEncodingConfig, bz json.RawMessage) error {
+	var gs genesistypes.GenesisState
+	if err := cdc.UnmarshalJSON(bz, &gs); err != nil {
+		return fmt.Errorf("failed to unmarshal %s genesis state: %w", types.ModuleName, err)
+	}
+
+	return gs.Validate()
+}
+
+// RegisterRESTRoutes implements AppModuleBasic interface
+func (AppModuleBasic) RegisterRESTRoutes(clientCtx client.Context, rtr *mux.Router) {
+}
+
+// RegisterGRPCGatewayRoutes registers the gRPC Gateway routes for the interchain accounts module
+func (AppModuleBasic) RegisterGRPCGatewayRoutes(clientCtx client.Context, mux *runtime.ServeMux) {
+	controllertypes.RegisterQueryHandlerClient(context.Background(), mux, controllertypes.NewQueryClient(clientCtx))
+	hosttypes.RegisterQueryHandlerClient(context.Background(), mux, hosttypes.NewQueryClient(clientCtx))
+}
+
+// GetTxCmd implements AppModuleBasic interface
+func (AppModuleBasic) GetTxCmd() *cobra.Command {
+	return cli.NewTxCmd()
+}
+
+// GetQueryCmd implements AppModuleBasic interface
+func (AppModuleBasic) GetQueryCmd() *cobra.Command {
+	return cli.GetQueryCmd()
+}
+
+// AppModule is the application module for the IBC interchain accounts module.
+// The controller and host submodules are optional, a nil keeper disables the
+// submodule on the chain.
+type AppModule struct {
+	AppModuleBasic
+	controllerKeeper *controllerkeeper.Keeper
+	hostKeeper       *hostkeeper.Keeper
+}
+
+// NewAppModule creates a new IBC interchain accounts module
+func NewAppModule(controllerKeeper *controllerkeeper.Keeper, hostKeeper *hostkeeper.Keeper) AppModule {
+	return AppModule{
+		controllerKeeper: controllerKeeper,
+		hostKeeper:       hostKeeper,
+	}
+}
+
+// RegisterInvariants implements the AppModule interface
+func (AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
+}
+
+// Route implements the AppModule interface
+func (am AppModule) Route() sdk.Route {
+	if am.controllerKeeper == nil {
+		return sdk.Route{}
+	}
+
+	return sdk.NewRoute(controllertypes.RouterKey, controller.NewHandler(*am.controllerKeeper))
+}
+
+// QuerierRoute implements the AppModule interface
+func (AppModule) QuerierRoute() string {
+	return types.QuerierRoute
+}
+
+// LegacyQuerierHandler implements the AppModule interface
+func (am AppModule) LegacyQuerierHandler(*codec.LegacyAmino) sdk.Querier {
+	return nil
+}
+
+// RegisterServices registers module services
+func (am AppModule) RegisterServices(cfg module.Configurator) {
+	if am.controllerKeeper != nil {
+		controllertypes.RegisterMsgServer(cfg.MsgServer(), controllerkeeper.NewMsgServerImpl(*am.controllerKeeper))
+		controllertypes.RegisterQueryServer(cfg.QueryServer(), am.controllerKeeper)
+	}
+
+	if am.hostKeeper != nil {
+		hosttypes.RegisterQueryServer(cfg.QueryServer(), am.hostKeeper)
+	}
+}
+
+// ConsensusVersion implements AppModule/ConsensusVersion.
+func (AppModule) ConsensusVersion() uint64 { return 1 }
+
+// InitGenesis performs genesis initialization for the interchain accounts module.
+// It returns no validator updates.
+func (am AppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONMarshaler, data json.RawMessage) []abci.ValidatorUpdate {
+	var genesisState genesistypes.GenesisState
+	cdc.MustUnmarshalJSON(data, &genesisState)
+
+	if am.controllerKeeper != nil {
+		am.controllerKeeper.InitGenesis(ctx, genesisState.ControllerGenesisState)
+	}
+
+	if am.hostKeeper != nil {
+		am.hostKeeper.InitGenesis(ctx, genesisState.HostGenesisState)
+	}
+
+	return []abci.ValidatorUpdate{}
+}
+
+// ExportGenesis returns the exported genesis state as raw bytes for the interchain accounts module
+func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONMarshaler) json.RawMessage {
+	var (
+		controllerGenesisState = genesistypes.DefaultControllerGenesis()
+		hostGenesisState       = genesistypes.DefaultHostGenesis()
+	)
+
+	if am.controllerKeeper != nil {
+		controllerGenesisState = am.controllerKeeper.ExportGenesis(ctx)
+	}
+
+	if am.hostKeeper != nil {
+		hostGenesisState = am.hostKeeper.ExportGenesis(ctx)
+	}
+
+	gs := genesistypes.NewGenesisState(controllerGenesisState, hostGenesisState)
+	return cdc.MustMarshalJSON(gs)
+}
+
+// BeginBlock implements the AppModule interface
+func (am AppModule) BeginBlock(ctx sdk.Context, req abci.RequestBeginBlock) {
+}
+
+// EndBlock implements the AppModule interface
+func (am AppModule) EndBlock(ctx sdk.Context, req abci.RequestEndBlock) []abci.ValidatorUpdate {
+	return []abci.ValidatorUpdate{}
+}
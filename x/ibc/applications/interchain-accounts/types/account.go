@@ -0,0 +1,138 @@
+package types
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	yaml "gopkg.in/yaml.v2"
+
+	cryptotypes "github.com/line/lfb-sdk/crypto/types"
+	sdk "github.com/line/lfb-sdk/types"
+	sdkerrors "github.com/line/lfb-sdk/types/errors"
+	authtypes "github.com/line/lfb-sdk/x/auth/types"
+)
+
+var (
+	_ authtypes.GenesisAccount = (*InterchainAccount)(nil)
+	_ InterchainAccountI       = (*InterchainAccount)(nil)
+)
+
+// InterchainAccountI wraps the authtypes.AccountI interface
+type InterchainAccountI interface {
+	authtypes.AccountI
+	GetAccountOwner() string
+}
+
+// GenerateAddress returns the address of the interchain account of the
+// controller port portID on the host connection connectionID. The address is
+// derived like the ICS-20 escrow addresses, following ADR 028.
+func GenerateAddress(connectionID, portID string) sdk.AccAddress {
+	// a slash is used to create domain separation between connection and port
+	// identifiers to prevent address collisions
+	contents := fmt.Sprintf("%s/%s", connectionID, portID)
+
+	preImage := []byte(Version)
+	preImage = append(preImage, 0)
+	preImage = append(preImage, contents...)
+	hash := sha256.Sum256(preImage)
+	return hash[:20]
+}
+
+// NewInterchainAccount creates and returns a new InterchainAccount type
+func NewInterchainAccount(ba *authtypes.BaseAccount, accountOwner string) *InterchainAccount {
+	return &InterchainAccount{
+		BaseAccount:  ba,
+		AccountOwner: accountOwner,
+	}
+}
+
+// GetAccountOwner returns the port ID of the controller of the account
+func (ia InterchainAccount) GetAccountOwner() string {
+	return ia.AccountOwner
+}
+
+// SetPubKey implements the authtypes.AccountI interface. Interchain accounts
+// have no public key, they are only controlled through ICS-27 packets.
+func (ia InterchainAccount) SetPubKey(pubKey cryptotypes.PubKey) error {
+	return sdkerrors.Wrap(ErrUnsupported, "cannot set public key for interchain account")
+}
+
+// SetSequence implements the authtypes.AccountI interface
+func (ia InterchainAccount) SetSequence(seq uint64) error {
+	return sdkerrors.Wrap(ErrUnsupported, "cannot set sequence number for interchain account")
+}
+
+// Validate implements basic validation of the InterchainAccount
+func (ia InterchainAccount) Validate() error {
+	if strings.TrimSpace(ia.AccountOwner) == "" {
+		return sdkerrors.Wrap(ErrInvalidAccountAddress, "AccountOwner cannot be empty")
+	}
+
+	return ia.BaseAccount.Validate()
+}
+
+type interchainAccountPretty struct {
+	Address       sdk.AccAddress `json:"address" yaml:"address"`
+	PubKey        string         `json:"public_key" yaml:"public_key"`
+	AccountNumber uint64         `json:"account_number" yaml:"account_number"`
+	Sequence      uint64         `json:"sequence" yaml:"sequence"`
+	AccountOwner  string         `json:"account_owner" yaml:"account_owner"`
+}
+
+// String returns a string representation of the InterchainAccount
+func (ia InterchainAccount) String() string {
+	out, _ := ia.MarshalYAML()
+	return out.(string)
+}
+
+// MarshalYAML returns the YAML representation of an InterchainAccount
+func (ia InterchainAccount) MarshalYAML() (interface{}, error) {
+	accAddr, err := sdk.AccAddressFromBech32(ia.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	bz, err := yaml.Marshal(interchainAccountPretty{
+		Address:       accAddr,
+		PubKey:        "",
+		AccountNumber: ia.AccountNumber,
+		Sequence:      ia.Sequence,
+		AccountOwner:  ia.AccountOwner,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return string(bz), nil
+}
+
+// MarshalJSON returns the JSON representation of an InterchainAccount
+func (ia InterchainAccount) MarshalJSON() ([]byte, error) {
+	accAddr, err := sdk.AccAddressFromBech32(ia.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(interchainAccountPretty{
+		Address:       accAddr,
+		PubKey:        "",
+		AccountNumber: ia.AccountNumber,
+		Sequence:      ia.Sequence,
+		AccountOwner:  ia.AccountOwner,
+	})
+}
+
+// UnmarshalJSON unmarshals raw JSON bytes into the InterchainAccount
+func (ia *InterchainAccount) UnmarshalJSON(bz []byte) error {
+	var alias interchainAccountPretty
+	if err := json.Unmarshal(bz, &alias); err != nil {
+		return err
+	}
+
+	ia.BaseAccount = authtypes.NewBaseAccount(alias.Address, nil, alias.AccountNumber, alias.Sequence)
+	ia.AccountOwner = alias.AccountOwner
+
+	return nil
+}
@@ -0,0 +1,66 @@
+package types_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/line/lfb-sdk/types"
+	authtypes "github.com/line/lfb-sdk/x/auth/types"
+	"github.com/line/lfb-sdk/x/ibc/applications/interchain-accounts/types"
+)
+
+func TestGenerateAddress(t *testing.T) {
+	addr := types.GenerateAddress("connection-0", "icacontroller-owner")
+	require.Len(t, addr, 20)
+	require.Equal(t, addr, types.GenerateAddress("connection-0", "icacontroller-owner"))
+
+	require.NotEqual(t, addr, types.GenerateAddress("connection-1", "icacontroller-owner"))
+	require.NotEqual(t, addr, types.GenerateAddress("connection-0", "icacontroller-other"))
+}
+
+func TestAppVersion(t *testing.T) {
+	addr := types.GenerateAddress("connection-0", "icacontroller-owner").String()
+
+	version := types.NewAppVersion(addr)
+	require.Equal(t, types.Version+types.Delimiter+addr, version)
+
+	parsed, err := types.ParseAddressFromVersion(version)
+	require.NoError(t, err)
+	require.Equal(t, addr, parsed)
+
+	for _, version := range []string{"", types.Version, types.Version + types.Delimiter, "ics20-1" + types.Delimiter + addr} {
+		_, err := types.ParseAddressFromVersion(version)
+		require.Error(t, err, version)
+	}
+}
+
+func TestNewControllerPortID(t *testing.T) {
+	portID, err := types.NewControllerPortID("owner")
+	require.NoError(t, err)
+	require.Equal(t, "icacontroller-owner", portID)
+
+	_, err = types.NewControllerPortID(" ")
+	require.Error(t, err)
+}
+
+func TestInterchainAccount(t *testing.T) {
+	addr := types.GenerateAddress("connection-0", "icacontroller-owner")
+	acc := types.NewInterchainAccount(authtypes.NewBaseAccountWithAddress(addr), "icacontroller-owner")
+
+	require.NoError(t, acc.Validate())
+	require.Error(t, acc.SetPubKey(nil))
+	require.Error(t, acc.SetSequence(1))
+
+	bz, err := json.Marshal(acc)
+	require.NoError(t, err)
+
+	var acc2 types.InterchainAccount
+	require.NoError(t, json.Unmarshal(bz, &acc2))
+	require.Equal(t, acc.String(), acc2.String())
+	require.Equal(t, sdk.AccAddress(addr), acc2.GetAddress())
+
+	acc.AccountOwner = ""
+	require.Error(t, acc.Validate())
+}
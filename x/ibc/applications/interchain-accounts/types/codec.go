@@ -0,0 +1,29 @@
+package types
+
+import (
+	"github.com/line/lfb-sdk/codec"
+	codectypes "github.com/line/lfb-sdk/codec/types"
+	authtypes "github.com/line/lfb-sdk/x/auth/types"
+)
+
+// RegisterLegacyAminoCodec registers the account interfaces and concrete types on the
+// provided LegacyAmino codec. These types are used for Amino JSON serialization
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&InterchainAccount{}, "lfb-sdk/InterchainAccount", nil)
+}
+
+// RegisterInterfaces registers the concrete InterchainAccount implementation against the associated
+// x/auth AccountI and GenesisAccount interfaces
+func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
+	registry.RegisterImplementations((*authtypes.AccountI)(nil), &InterchainAccount{})
+	registry.RegisterImplementations((*authtypes.GenesisAccount)(nil), &InterchainAccount{})
+}
+
+var (
+	// ModuleCdc references the global interchain accounts module codec. Note, the codec
+	// should ONLY be used in certain instances of tests and for JSON encoding.
+	//
+	// The actual codec used for serialization should be provided to interchain accounts and
+	// defined at the application level.
+	ModuleCdc = codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+)
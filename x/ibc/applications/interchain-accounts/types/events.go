@@ -0,0 +1,10 @@
+package types
+
+// Interchain accounts events
+const (
+	EventTypePacket = "ics27_packet"
+
+	AttributeKeyAckError    = "error"
+	AttributeKeyHostChannel = "host_channel_id"
+	AttributeKeyAckSuccess  = "success"
+)
@@ -0,0 +1,28 @@
+package types
+
+import (
+	sdk "github.com/line/lfb-sdk/types"
+	authtypes "github.com/line/lfb-sdk/x/auth/types"
+	capabilitytypes "github.com/line/lfb-sdk/x/capability/types"
+	channeltypes "github.com/line/lfb-sdk/x/ibc/core/04-channel/types"
+	ibcexported "github.com/line/lfb-sdk/x/ibc/core/exported"
+)
+
+// AccountKeeper defines the expected account keeper
+type AccountKeeper interface {
+	NewAccount(ctx sdk.Context, acc authtypes.AccountI) authtypes.AccountI
+	GetAccount(ctx sdk.Context, addr sdk.AccAddress) authtypes.AccountI
+	SetAccount(ctx sdk.Context, acc authtypes.AccountI)
+}
+
+// ChannelKeeper defines the expected IBC channel keeper
+type ChannelKeeper interface {
+	GetChannel(ctx sdk.Context, srcPort, srcChan string) (channel channeltypes.Channel, found bool)
+	GetNextSequenceSend(ctx sdk.Context, portID, channelID string) (uint64, bool)
+	SendPacket(ctx sdk.Context, channelCap *capabilitytypes.Capability, packet ibcexported.PacketI) error
+}
+
+// PortKeeper defines the expected IBC port keeper
+type PortKeeper interface {
+	BindPort(ctx sdk.Context, portID string) *capabilitytypes.Capability
+}
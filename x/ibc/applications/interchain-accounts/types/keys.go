@@ -0,0 +1,57 @@
+package types
+
+import (
+	"fmt"
+)
+
+const (
+	// ModuleName defines the interchain accounts module name
+	ModuleName = "interchainaccounts"
+
+	// Version defines the current version the interchain accounts module
+	// supports. The host appends the address of the interchain account to it.
+	Version = "ics27-1"
+
+	// Delimiter separates the version from the interchain account address in
+	// the channel version agreed by the host.
+	Delimiter = "."
+
+	// ControllerPortPrefix is the prefix of the ports bound by the
+	// controller, followed by the address of the owner of the account.
+	ControllerPortPrefix = "icacontroller-"
+
+	// HostPortID is the port the host binds to.
+	HostPortID = "icahost"
+
+	// QuerierRoute is the querier route for interchain accounts
+	QuerierRoute = ModuleName
+)
+
+const (
+	// ActiveChannelKeyPrefix defines the key prefix used to store active
+	// channels
+	ActiveChannelKeyPrefix = "activeChannel"
+
+	// OwnerKeyPrefix defines the key prefix used to store interchain accounts
+	OwnerKeyPrefix = "owner"
+
+	// PortKeyPrefix defines the key prefix used to store ports
+	PortKeyPrefix = "port"
+)
+
+// KeyActiveChannel creates and returns a new key used for active channels
+// store operations
+func KeyActiveChannel(portID, connectionID string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s", ActiveChannelKeyPrefix, portID, connectionID))
+}
+
+// KeyOwnerAccount returns the key used to store the interchain account
+// associated with the portID and connectionID
+func KeyOwnerAccount(portID, connectionID string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s", OwnerKeyPrefix, portID, connectionID))
+}
+
+// KeyPort creates and returns a new key used for port store operations
+func KeyPort(portID string) []byte {
+	return []byte(fmt.Sprintf("%s/%s", PortKeyPrefix, portID))
+}
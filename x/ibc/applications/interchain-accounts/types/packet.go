@@ -0,0 +1,97 @@
+package types
+
+import (
+	"time"
+
+	"github.com/line/lfb-sdk/codec"
+	codectypes "github.com/line/lfb-sdk/codec/types"
+	sdk "github.com/line/lfb-sdk/types"
+	sdkerrors "github.com/line/lfb-sdk/types/errors"
+)
+
+// MaxMemoCharLength defines the maximum length for the InterchainAccountPacketData memo field
+const MaxMemoCharLength = 256
+
+var (
+	// DefaultRelativePacketTimeoutTimestamp is the default packet timeout timestamp (in nanoseconds)
+	// relative to the current block timestamp of the counterparty chain provided by the client
+	// state. The timeout is disabled when set to 0. The default is currently set to a 10 minute
+	// timeout.
+	DefaultRelativePacketTimeoutTimestamp = uint64((time.Duration(10) * time.Minute).Nanoseconds())
+)
+
+var _ codectypes.UnpackInterfacesMessage = CosmosTx{}
+
+// ValidateBasic performs basic validation of the interchain account packet data.
+// The memo may be empty.
+func (iapd InterchainAccountPacketData) ValidateBasic() error {
+	if iapd.Type == UNSPECIFIED {
+		return sdkerrors.Wrap(ErrInvalidOutgoingData, "packet data type cannot be unspecified")
+	}
+
+	if len(iapd.Data) == 0 {
+		return sdkerrors.Wrap(ErrInvalidOutgoingData, "packet data cannot be empty")
+	}
+
+	if len(iapd.Memo) > MaxMemoCharLength {
+		return sdkerrors.Wrapf(ErrInvalidOutgoingData, "packet data memo cannot be greater than %d characters", MaxMemoCharLength)
+	}
+
+	return nil
+}
+
+// GetBytes returns the JSON marshalled interchain account packet data.
+func (iapd InterchainAccountPacketData) GetBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&iapd))
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (ct CosmosTx) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for _, any := range ct.Messages {
+		var msg sdk.Msg
+		if err := unpacker.UnpackAny(any, &msg); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// SerializeCosmosTx serializes a slice of sdk.Msg's into the data of an
+// InterchainAccountPacketData of type EXECUTE_TX.
+func SerializeCosmosTx(cdc codec.BinaryMarshaler, msgs []sdk.Msg) ([]byte, error) {
+	if len(msgs) == 0 {
+		return nil, sdkerrors.Wrap(ErrInvalidOutgoingData, "cannot serialize empty msgs")
+	}
+
+	anys := make([]*codectypes.Any, len(msgs))
+	for i, msg := range msgs {
+		any, err := codectypes.NewAnyWithValue(msg)
+		if err != nil {
+			return nil, err
+		}
+		anys[i] = any
+	}
+
+	return cdc.MarshalBinaryBare(&CosmosTx{Messages: anys})
+}
+
+// DeserializeCosmosTx unmarshals and unpacks a slice of sdk.Msg's from the
+// data of an InterchainAccountPacketData of type EXECUTE_TX.
+func DeserializeCosmosTx(cdc codec.BinaryMarshaler, data []byte) ([]sdk.Msg, error) {
+	var cosmosTx CosmosTx
+	if err := cdc.UnmarshalBinaryBare(data, &cosmosTx); err != nil {
+		return nil, sdkerrors.Wrapf(ErrInvalidCodec, "cannot unmarshal cosmos tx: %s", err.Error())
+	}
+
+	msgs := make([]sdk.Msg, len(cosmosTx.Messages))
+	for i, any := range cosmosTx.Messages {
+		msg, ok := any.GetCachedValue().(sdk.Msg)
+		if !ok {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "cannot unpack %s as a sdk.Msg", any.TypeUrl)
+		}
+		msgs[i] = msg
+	}
+
+	return msgs, nil
+}
@@ -0,0 +1,60 @@
+package types_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/line/lfb-sdk/simapp"
+	sdk "github.com/line/lfb-sdk/types"
+	banktypes "github.com/line/lfb-sdk/x/bank/types"
+	"github.com/line/lfb-sdk/x/ibc/applications/interchain-accounts/types"
+)
+
+func TestInterchainAccountPacketDataValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name       string
+		packetData types.InterchainAccountPacketData
+		expPass    bool
+	}{
+		{"valid", types.InterchainAccountPacketData{Type: types.EXECUTE_TX, Data: []byte("data"), Memo: "memo"}, true},
+		{"empty memo", types.InterchainAccountPacketData{Type: types.EXECUTE_TX, Data: []byte("data")}, true},
+		{"unspecified type", types.InterchainAccountPacketData{Type: types.UNSPECIFIED, Data: []byte("data")}, false},
+		{"empty data", types.InterchainAccountPacketData{Type: types.EXECUTE_TX}, false},
+		{"memo too long", types.InterchainAccountPacketData{Type: types.EXECUTE_TX, Data: []byte("data"), Memo: strings.Repeat("a", types.MaxMemoCharLength+1)}, false},
+	}
+
+	for _, tc := range testCases {
+		err := tc.packetData.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestSerializeCosmosTx(t *testing.T) {
+	cdc := simapp.MakeTestEncodingConfig().Marshaler
+
+	addr1 := sdk.AccAddress("addr1_______________")
+	addr2 := sdk.AccAddress("addr2_______________")
+	msgs := []sdk.Msg{
+		banktypes.NewMsgSend(addr1, addr2, sdk.NewCoins(sdk.NewInt64Coin("stake", 100))),
+		banktypes.NewMsgSend(addr2, addr1, sdk.NewCoins(sdk.NewInt64Coin("stake", 50))),
+	}
+
+	bz, err := types.SerializeCosmosTx(cdc, msgs)
+	require.NoError(t, err)
+
+	deserialized, err := types.DeserializeCosmosTx(cdc, bz)
+	require.NoError(t, err)
+	require.Equal(t, msgs, deserialized)
+
+	_, err = types.SerializeCosmosTx(cdc, nil)
+	require.Error(t, err)
+
+	_, err = types.DeserializeCosmosTx(cdc, []byte("invalid"))
+	require.Error(t, err)
+}
@@ -0,0 +1,17 @@
+package types
+
+import (
+	"strings"
+
+	sdkerrors "github.com/line/lfb-sdk/types/errors"
+)
+
+// NewControllerPortID returns the port ID bound by the controller for the
+// interchain accounts of owner, i.e. "icacontroller-{owner}".
+func NewControllerPortID(owner string) (string, error) {
+	if strings.TrimSpace(owner) == "" {
+		return "", sdkerrors.Wrap(ErrInvalidAccountAddress, "owner address cannot be empty")
+	}
+
+	return ControllerPortPrefix + owner, nil
+}
@@ -0,0 +1,24 @@
+package types
+
+import (
+	"strings"
+
+	sdkerrors "github.com/line/lfb-sdk/types/errors"
+)
+
+// NewAppVersion returns the channel version agreed by the host for the
+// interchain account address, i.e. "ics27-1.{address}".
+func NewAppVersion(address string) string {
+	return Version + Delimiter + address
+}
+
+// ParseAddressFromVersion parses the interchain account address from the
+// channel version agreed by the host.
+func ParseAddressFromVersion(version string) (string, error) {
+	parts := strings.SplitN(version, Delimiter, 2)
+	if len(parts) != 2 || parts[0] != Version || strings.TrimSpace(parts[1]) == "" {
+		return "", sdkerrors.Wrapf(ErrInvalidVersion, "expected %s, got %s", NewAppVersion("{address}"), version)
+	}
+
+	return parts[1], nil
+}
@@ -33,3 +33,17 @@ func (k Keeper) MarshalDenomTrace(denomTrace types.DenomTrace) ([]byte, error) {
 func (k Keeper) MustMarshalDenomTrace(denomTrace types.DenomTrace) []byte {
 	return k.cdc.MustMarshalBinaryBare(&denomTrace)
 }
+
+// MustUnmarshalInFlightPacket attempts to decode and return an InFlightPacket
+// object from raw encoded bytes. It panics on error.
+func (k Keeper) MustUnmarshalInFlightPacket(bz []byte) types.InFlightPacket {
+	var packet types.InFlightPacket
+	k.cdc.MustUnmarshalBinaryBare(bz, &packet)
+	return packet
+}
+
+// MustMarshalInFlightPacket attempts to encode an InFlightPacket object and
+// returns the raw encoded bytes. It panics on error.
+func (k Keeper) MustMarshalInFlightPacket(packet types.InFlightPacket) []byte {
+	return k.cdc.MustMarshalBinaryBare(&packet)
+}
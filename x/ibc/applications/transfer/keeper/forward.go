@@ -0,0 +1,265 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/line/lfb-sdk/store/prefix"
+	sdk "github.com/line/lfb-sdk/types"
+	sdkerrors "github.com/line/lfb-sdk/types/errors"
+	"github.com/line/lfb-sdk/x/ibc/applications/transfer/types"
+	clienttypes "github.com/line/lfb-sdk/x/ibc/core/02-client/types"
+	channeltypes "github.com/line/lfb-sdk/x/ibc/core/04-channel/types"
+	host "github.com/line/lfb-sdk/x/ibc/core/24-host"
+)
+
+// forwardPacket receives the tokens of a packet whose receiver requests
+// forwarding and sends them to the next receiver. The receive and the send are
+// executed atomically so that an error acknowledgement never leaves the tokens
+// on this chain. The acknowledgement of the received packet is written once
+// the forwarded packet is acknowledged or finally timed out.
+func (k Keeper) forwardPacket(ctx sdk.Context, packet channeltypes.Packet, data types.FungibleTokenPacketData, metadata types.ForwardMetadata) error {
+	cacheCtx, writeFn := ctx.CacheContext()
+
+	received := data
+	received.Receiver = metadata.Receiver
+	if err := k.OnRecvPacket(cacheCtx, packet, received); err != nil {
+		return err
+	}
+
+	// NOTE: receiver address correctness checked during metadata validation
+	receiver, err := sdk.AccAddressFromBech32(metadata.Receiver)
+	if err != nil {
+		return err
+	}
+
+	token := receivedToken(packet, data)
+	if err := k.sendForwardPacket(
+		cacheCtx, packet, token, receiver, metadata.Port, metadata.Channel, metadata.NextReceiver, types.ForwardPacketRetries,
+	); err != nil {
+		return sdkerrors.Wrap(types.ErrForwardFailed, err.Error())
+	}
+
+	writeFn()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+
+	return nil
+}
+
+// sendForwardPacket sends the token from the sender to the receiver on the
+// given port and channel, and stores the packet as in flight until it is
+// acknowledged or timed out.
+func (k Keeper) sendForwardPacket(
+	ctx sdk.Context, originalPacket channeltypes.Packet, token sdk.Coin, sender sdk.AccAddress,
+	portID, channelID, receiver string, retries uint32,
+) error {
+	sequence, found := k.channelKeeper.GetNextSequenceSend(ctx, portID, channelID)
+	if !found {
+		return sdkerrors.Wrapf(
+			channeltypes.ErrSequenceSendNotFound,
+			"source port: %s, source channel: %s", portID, channelID,
+		)
+	}
+
+	timeoutTimestamp := uint64(ctx.BlockTime().Add(types.ForwardPacketTimeout).UnixNano())
+	if err := k.SendTransfer(
+		ctx, portID, channelID, token, sender, receiver, clienttypes.ZeroHeight(), timeoutTimestamp,
+	); err != nil {
+		return err
+	}
+
+	k.SetInFlightPacket(ctx, types.NewInFlightPacket(originalPacket, portID, channelID, sequence, retries))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeForward,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyReceiver, receiver),
+			sdk.NewAttribute(types.AttributeKeyForwardPort, portID),
+			sdk.NewAttribute(types.AttributeKeyForwardChannel, channelID),
+			sdk.NewAttribute(types.AttributeKeyForwardSeq, fmt.Sprintf("%d", sequence)),
+			sdk.NewAttribute(types.AttributeKeyRetries, fmt.Sprintf("%d", retries)),
+		),
+	)
+
+	return nil
+}
+
+// acknowledgeForwardedPacket relays the acknowledgement of a forwarded packet
+// back to the packet it was forwarded from. It is a no-op for packets which
+// were not forwarded by this chain.
+func (k Keeper) acknowledgeForwardedPacket(ctx sdk.Context, packet channeltypes.Packet, ack channeltypes.Acknowledgement) error {
+	inFlight, found := k.GetInFlightPacket(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+	if !found {
+		return nil
+	}
+	k.DeleteInFlightPacket(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+
+	switch resp := ack.Response.(type) {
+	case *channeltypes.Acknowledgement_Error:
+		return k.revertForwardedPacket(ctx, inFlight, resp.Error)
+	default:
+		return k.writeForwardAcknowledgement(ctx, inFlight.OriginalPacket, channeltypes.NewResultAcknowledgement([]byte{byte(1)}))
+	}
+}
+
+// timeoutForwardedPacket sends a timed out forwarded packet again if it has
+// retries remaining. Otherwise the packet it was forwarded from is reverted.
+// It is a no-op for packets which were not forwarded by this chain.
+func (k Keeper) timeoutForwardedPacket(ctx sdk.Context, packet channeltypes.Packet, data types.FungibleTokenPacketData) error {
+	inFlight, found := k.GetInFlightPacket(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+	if !found {
+		return nil
+	}
+	k.DeleteInFlightPacket(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+
+	if inFlight.RetriesRemaining > 0 {
+		// NOTE: the tokens were refunded to the sender before the retry
+		err := k.retryForwardedPacket(ctx, packet, data, inFlight)
+		if err == nil {
+			return nil
+		}
+
+		k.Logger(ctx).Info("failed to retry forwarded packet", "port", packet.GetSourcePort(), "channel", packet.GetSourceChannel(), "sequence", packet.GetSequence(), "error", err)
+	}
+
+	return k.revertForwardedPacket(ctx, inFlight, "forwarded packet timed out")
+}
+
+// retryForwardedPacket sends the tokens of a timed out forwarded packet again.
+func (k Keeper) retryForwardedPacket(ctx sdk.Context, packet channeltypes.Packet, data types.FungibleTokenPacketData, inFlight types.InFlightPacket) error {
+	cacheCtx, writeFn := ctx.CacheContext()
+
+	sender, err := sdk.AccAddressFromBech32(data.Sender)
+	if err != nil {
+		return err
+	}
+
+	token := sdk.NewCoin(types.ParseDenomTrace(data.Denom).IBCDenom(), sdk.NewIntFromUint64(data.Amount))
+	if err := k.sendForwardPacket(
+		cacheCtx, inFlight.OriginalPacket, token, sender,
+		packet.GetSourcePort(), packet.GetSourceChannel(), data.Receiver, inFlight.RetriesRemaining-1,
+	); err != nil {
+		return err
+	}
+
+	writeFn()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+
+	return nil
+}
+
+// revertForwardedPacket undoes the receive of the packet a failed forward
+// originates from and writes an error acknowledgement for it, so that the
+// tokens are refunded on the previous chain.
+func (k Keeper) revertForwardedPacket(ctx sdk.Context, inFlight types.InFlightPacket, reason string) error {
+	packet := inFlight.OriginalPacket
+
+	var data types.FungibleTokenPacketData
+	if err := types.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal ICS-20 transfer packet data: %s", err.Error())
+	}
+
+	metadata, _, err := types.ParseForwardReceiver(data.Receiver)
+	if err != nil {
+		return err
+	}
+
+	receiver, err := sdk.AccAddressFromBech32(metadata.Receiver)
+	if err != nil {
+		return err
+	}
+
+	token := receivedToken(packet, data)
+
+	if types.ReceiverChainIsSource(packet.GetSourcePort(), packet.GetSourceChannel(), data.Denom) {
+		// escrow the tokens which were unescrowed on receive
+		escrowAddress := types.GetEscrowAddress(packet.GetDestPort(), packet.GetDestChannel())
+		if err := k.bankKeeper.SendCoins(ctx, receiver, escrowAddress, sdk.NewCoins(token)); err != nil {
+			return err
+		}
+	} else {
+		// burn the vouchers which were minted on receive
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(
+			ctx, receiver, types.ModuleName, sdk.NewCoins(token),
+		); err != nil {
+			return err
+		}
+
+		if err := k.bankKeeper.BurnCoins(
+			ctx, types.ModuleName, sdk.NewCoins(token),
+		); err != nil {
+			panic(fmt.Sprintf("cannot burn coins after a successful send to a module account: %v", err))
+		}
+	}
+
+	ack := channeltypes.NewErrorAcknowledgement(sdkerrors.Wrap(types.ErrForwardFailed, reason).Error())
+	return k.writeForwardAcknowledgement(ctx, packet, ack)
+}
+
+// writeForwardAcknowledgement writes the asynchronous acknowledgement of a
+// packet whose tokens were forwarded.
+func (k Keeper) writeForwardAcknowledgement(ctx sdk.Context, packet channeltypes.Packet, ack channeltypes.Acknowledgement) error {
+	capName := host.ChannelCapabilityPath(packet.GetDestPort(), packet.GetDestChannel())
+	chanCap, ok := k.scopedKeeper.GetCapability(ctx, capName)
+	if !ok {
+		return sdkerrors.Wrapf(channeltypes.ErrChannelCapabilityNotFound, "could not retrieve channel capability at: %s", capName)
+	}
+
+	return k.ics4Wrapper.WriteAcknowledgement(ctx, chanCap, packet, ack.GetBytes())
+}
+
+// receivedToken returns the token credited to the receiver of the packet on
+// this chain.
+func receivedToken(packet channeltypes.Packet, data types.FungibleTokenPacketData) sdk.Coin {
+	var denom string
+	if types.ReceiverChainIsSource(packet.GetSourcePort(), packet.GetSourceChannel(), data.Denom) {
+		voucherPrefix := types.GetDenomPrefix(packet.GetSourcePort(), packet.GetSourceChannel())
+		denom = types.ParseDenomTrace(data.Denom[len(voucherPrefix):]).IBCDenom()
+	} else {
+		sourcePrefix := types.GetDenomPrefix(packet.GetDestPort(), packet.GetDestChannel())
+		denom = types.ParseDenomTrace(sourcePrefix + data.Denom).IBCDenom()
+	}
+
+	return sdk.NewCoin(denom, sdk.NewIntFromUint64(data.Amount))
+}
+
+// GetInFlightPacket returns the in-flight packet forwarding tokens on the
+// given port, channel and sequence.
+func (k Keeper) GetInFlightPacket(ctx sdk.Context, portID, channelID string, sequence uint64) (types.InFlightPacket, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetInFlightPacketKey(portID, channelID, sequence))
+	if bz == nil {
+		return types.InFlightPacket{}, false
+	}
+
+	return k.MustUnmarshalInFlightPacket(bz), true
+}
+
+// SetInFlightPacket stores the in-flight packet keyed by its forward port,
+// channel and sequence.
+func (k Keeper) SetInFlightPacket(ctx sdk.Context, packet types.InFlightPacket) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.MustMarshalInFlightPacket(packet)
+	store.Set(types.GetInFlightPacketKey(packet.ForwardPortId, packet.ForwardChannelId, packet.ForwardSequence), bz)
+}
+
+// DeleteInFlightPacket deletes the in-flight packet forwarding tokens on the
+// given port, channel and sequence.
+func (k Keeper) DeleteInFlightPacket(ctx sdk.Context, portID, channelID string, sequence uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetInFlightPacketKey(portID, channelID, sequence))
+}
+
+// GetAllInFlightPackets returns all the packets being forwarded.
+func (k Keeper) GetAllInFlightPackets(ctx sdk.Context) []types.InFlightPacket {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.InFlightPacketKey)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	packets := []types.InFlightPacket{}
+	for ; iterator.Valid(); iterator.Next() {
+		packets = append(packets, k.MustUnmarshalInFlightPacket(iterator.Value()))
+	}
+
+	return packets
+}
@@ -0,0 +1,229 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/line/lfb-sdk/types"
+	sdkerrors "github.com/line/lfb-sdk/types/errors"
+	"github.com/line/lfb-sdk/x/ibc/applications/transfer/types"
+	clienttypes "github.com/line/lfb-sdk/x/ibc/core/02-client/types"
+	channeltypes "github.com/line/lfb-sdk/x/ibc/core/04-channel/types"
+	host "github.com/line/lfb-sdk/x/ibc/core/24-host"
+	"github.com/line/lfb-sdk/x/ibc/core/exported"
+	ibctesting "github.com/line/lfb-sdk/x/ibc/testing"
+)
+
+// forwardPath holds the channels and packets of a transfer from chainA to
+// chainC forwarded by chainB.
+type forwardPath struct {
+	clientA, clientB        string
+	clientOnBForC           string
+	clientOnCForB           string
+	channelA, channelB      ibctesting.TestChannel
+	channelOnBForC          ibctesting.TestChannel
+	channelOnCForB          ibctesting.TestChannel
+	packet                  channeltypes.Packet
+	forwardData             types.FungibleTokenPacketData
+	forwardTimeoutTimestamp uint64
+}
+
+// forwardPacket returns the packet forwarding the tokens from chainB to chainC
+// with the given sequence.
+func (path forwardPath) forwardPacket(sequence uint64) channeltypes.Packet {
+	return channeltypes.NewPacket(
+		path.forwardData.GetBytes(), sequence,
+		path.channelOnBForC.PortID, path.channelOnBForC.ID,
+		path.channelOnCForB.PortID, path.channelOnCForB.ID,
+		clienttypes.ZeroHeight(), path.forwardTimeoutTimestamp,
+	)
+}
+
+// setupForward sends 100 tokens from chainA to chainC through chainB and
+// receives the packet on chainB, which forwards it to chainC.
+func (suite *KeeperTestSuite) setupForward() forwardPath {
+	var path forwardPath
+
+	clientA, clientB, connA, connB := suite.coordinator.SetupClientConnections(suite.chainA, suite.chainB, exported.Tendermint)
+	path.clientA, path.clientB = clientA, clientB
+	path.channelA, path.channelB = suite.coordinator.CreateTransferChannels(suite.chainA, suite.chainB, connA, connB, channeltypes.UNORDERED)
+
+	clientOnBForC, clientOnCForB, connOnBForC, connOnCForB := suite.coordinator.SetupClientConnections(suite.chainB, suite.chainC, exported.Tendermint)
+	path.clientOnBForC, path.clientOnCForB = clientOnBForC, clientOnCForB
+	path.channelOnBForC, path.channelOnCForB = suite.coordinator.CreateTransferChannels(suite.chainB, suite.chainC, connOnBForC, connOnCForB, channeltypes.UNORDERED)
+
+	receiver := types.NewForwardReceiver(
+		suite.chainB.SenderAccount.GetAddress().String(), path.channelOnBForC.PortID, path.channelOnBForC.ID,
+		suite.chainC.SenderAccount.GetAddress().String(),
+	)
+	timeoutHeight := clienttypes.NewHeight(0, 110)
+	coin := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))
+
+	msg := types.NewMsgTransfer(path.channelA.PortID, path.channelA.ID, coin, suite.chainA.SenderAccount.GetAddress(), receiver, timeoutHeight, 0)
+	err := suite.coordinator.SendMsg(suite.chainA, suite.chainB, path.clientB, msg)
+	suite.Require().NoError(err)
+
+	data := types.NewFungibleTokenPacketData(coin.Denom, coin.Amount.Uint64(), suite.chainA.SenderAccount.GetAddress().String(), receiver)
+	path.packet = channeltypes.NewPacket(data.GetBytes(), 1, path.channelA.PortID, path.channelA.ID, path.channelB.PortID, path.channelB.ID, timeoutHeight, 0)
+
+	// the packet is received on chainB after the time is incremented twice
+	recvTime := suite.chainB.CurrentHeader.Time.Add(2 * ibctesting.TimeIncrement)
+	err = suite.coordinator.RecvPacket(suite.chainA, suite.chainB, path.clientA, path.packet)
+	suite.Require().NoError(err)
+
+	// update chainC's client of chainB to relay the forwarded packet
+	err = suite.coordinator.UpdateClient(suite.chainC, suite.chainB, path.clientOnCForB, exported.Tendermint)
+	suite.Require().NoError(err)
+
+	path.forwardData = types.NewFungibleTokenPacketData(
+		types.GetPrefixedDenom(path.channelB.PortID, path.channelB.ID, sdk.DefaultBondDenom), coin.Amount.Uint64(),
+		suite.chainB.SenderAccount.GetAddress().String(), suite.chainC.SenderAccount.GetAddress().String(),
+	)
+	path.forwardTimeoutTimestamp = uint64(recvTime.Add(types.ForwardPacketTimeout).UnixNano())
+
+	return path
+}
+
+// timeoutForwardPacket times out the packet forwarded from chainB to chainC.
+// It returns the time the timeout is executed on chainB.
+func (suite *KeeperTestSuite) timeoutForwardPacket(path forwardPath, packet channeltypes.Packet) time.Time {
+	suite.coordinator.IncrementTimeBy(types.ForwardPacketTimeout)
+	err := suite.coordinator.UpdateClient(suite.chainB, suite.chainC, path.clientOnBForC, exported.Tendermint)
+	suite.Require().NoError(err)
+
+	proof, proofHeight := suite.chainC.QueryProof(host.PacketReceiptKey(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence()))
+	msg := channeltypes.NewMsgTimeout(packet, 1, proof, proofHeight, suite.chainB.SenderAccount.GetAddress())
+	execTime := suite.chainB.CurrentHeader.Time
+	err = suite.coordinator.SendMsg(suite.chainB, suite.chainC, path.clientOnCForB, msg)
+	suite.Require().NoError(err)
+
+	return execTime
+}
+
+// acknowledgeOriginalPacket acknowledges the packet sent from chainA with the
+// acknowledgement written asynchronously by chainB.
+func (suite *KeeperTestSuite) acknowledgeOriginalPacket(path forwardPath, ack channeltypes.Acknowledgement) {
+	err := suite.coordinator.UpdateClient(suite.chainA, suite.chainB, path.clientA, exported.Tendermint)
+	suite.Require().NoError(err)
+
+	err = suite.coordinator.AcknowledgePacket(suite.chainA, suite.chainB, path.clientB, path.packet, ack.GetBytes())
+	suite.Require().NoError(err)
+}
+
+func (suite *KeeperTestSuite) TestForwardPacket() {
+	originalBalance := suite.chainA.App.BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), sdk.DefaultBondDenom)
+	path := suite.setupForward()
+
+	// the acknowledgement is not written until the forwarded packet is acknowledged
+	_, found := suite.chainB.App.IBCKeeper.ChannelKeeper.GetPacketAcknowledgement(suite.chainB.GetContext(), path.channelB.PortID, path.channelB.ID, 1)
+	suite.Require().False(found)
+
+	inFlight, found := suite.chainB.App.TransferKeeper.GetInFlightPacket(suite.chainB.GetContext(), path.channelOnBForC.PortID, path.channelOnBForC.ID, 1)
+	suite.Require().True(found)
+	suite.Require().Equal(types.NewInFlightPacket(path.packet, path.channelOnBForC.PortID, path.channelOnBForC.ID, 1, types.ForwardPacketRetries), inFlight)
+
+	ack := channeltypes.NewResultAcknowledgement([]byte{byte(1)})
+	err := suite.coordinator.RelayPacket(suite.chainB, suite.chainC, path.clientOnBForC, path.clientOnCForB, path.forwardPacket(1), ack.GetBytes())
+	suite.Require().NoError(err)
+
+	_, found = suite.chainB.App.TransferKeeper.GetInFlightPacket(suite.chainB.GetContext(), path.channelOnBForC.PortID, path.channelOnBForC.ID, 1)
+	suite.Require().False(found)
+
+	suite.acknowledgeOriginalPacket(path, ack)
+
+	// the tokens are received on chainC and nothing is left on chainB
+	fullDenomPath := types.GetPrefixedDenom(path.channelOnCForB.PortID, path.channelOnCForB.ID, path.forwardData.Denom)
+	balance := suite.chainC.App.BankKeeper.GetBalance(suite.chainC.GetContext(), suite.chainC.SenderAccount.GetAddress(), types.ParseDenomTrace(fullDenomPath).IBCDenom())
+	suite.Require().Equal(sdk.NewInt(100), balance.Amount)
+
+	voucherDenom := types.ParseDenomTrace(path.forwardData.Denom).IBCDenom()
+	balance = suite.chainB.App.BankKeeper.GetBalance(suite.chainB.GetContext(), suite.chainB.SenderAccount.GetAddress(), voucherDenom)
+	suite.Require().True(balance.IsZero())
+
+	balance = suite.chainA.App.BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), sdk.DefaultBondDenom)
+	suite.Require().Equal(originalBalance.Sub(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)), balance)
+}
+
+func (suite *KeeperTestSuite) TestForwardPacketErrorAcknowledgement() {
+	originalBalance := suite.chainA.App.BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), sdk.DefaultBondDenom)
+	path := suite.setupForward()
+
+	// chainC rejects the forwarded tokens
+	suite.chainC.App.TransferKeeper.SetParams(suite.chainC.GetContext(), types.NewParams(true, false))
+
+	errAck := channeltypes.NewErrorAcknowledgement(types.ErrReceiveDisabled.Error())
+	err := suite.coordinator.RelayPacket(suite.chainB, suite.chainC, path.clientOnBForC, path.clientOnCForB, path.forwardPacket(1), errAck.GetBytes())
+	suite.Require().NoError(err)
+
+	// the vouchers received on chainB are burned
+	voucherDenom := types.ParseDenomTrace(path.forwardData.Denom).IBCDenom()
+	balance := suite.chainB.App.BankKeeper.GetBalance(suite.chainB.GetContext(), suite.chainB.SenderAccount.GetAddress(), voucherDenom)
+	suite.Require().True(balance.IsZero())
+	suite.Require().True(suite.chainB.App.BankKeeper.GetSupply(suite.chainB.GetContext()).GetTotal().AmountOf(voucherDenom).IsZero())
+
+	// the error is acknowledged back to chainA which refunds the sender
+	ack := channeltypes.NewErrorAcknowledgement(sdkerrors.Wrap(types.ErrForwardFailed, errAck.GetError()).Error())
+	suite.acknowledgeOriginalPacket(path, ack)
+
+	balance = suite.chainA.App.BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), sdk.DefaultBondDenom)
+	suite.Require().Equal(originalBalance, balance)
+
+	escrowAddress := types.GetEscrowAddress(path.channelA.PortID, path.channelA.ID)
+	balance = suite.chainA.App.BankKeeper.GetBalance(suite.chainA.GetContext(), escrowAddress, sdk.DefaultBondDenom)
+	suite.Require().True(balance.IsZero())
+}
+
+func (suite *KeeperTestSuite) TestForwardPacketTimeout() {
+	originalBalance := suite.chainA.App.BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), sdk.DefaultBondDenom)
+	path := suite.setupForward()
+
+	// the first timeout sends the tokens again
+	timeoutTime := suite.timeoutForwardPacket(path, path.forwardPacket(1))
+
+	inFlight, found := suite.chainB.App.TransferKeeper.GetInFlightPacket(suite.chainB.GetContext(), path.channelOnBForC.PortID, path.channelOnBForC.ID, 2)
+	suite.Require().True(found)
+	suite.Require().Equal(uint32(types.ForwardPacketRetries-1), inFlight.RetriesRemaining)
+
+	_, found = suite.chainB.App.IBCKeeper.ChannelKeeper.GetPacketAcknowledgement(suite.chainB.GetContext(), path.channelB.PortID, path.channelB.ID, 1)
+	suite.Require().False(found)
+
+	// the retry times out relative to the block time of the timeout
+	path.forwardTimeoutTimestamp = uint64(timeoutTime.Add(types.ForwardPacketTimeout).UnixNano())
+	retry := path.forwardPacket(2)
+	commitment := suite.chainB.App.IBCKeeper.ChannelKeeper.GetPacketCommitment(suite.chainB.GetContext(), retry.GetSourcePort(), retry.GetSourceChannel(), retry.GetSequence())
+	suite.Require().Equal(channeltypes.CommitPacket(suite.chainB.App.AppCodec(), retry), commitment)
+
+	// the last timeout refunds the tokens along the path
+	suite.timeoutForwardPacket(path, retry)
+
+	_, found = suite.chainB.App.TransferKeeper.GetInFlightPacket(suite.chainB.GetContext(), path.channelOnBForC.PortID, path.channelOnBForC.ID, 2)
+	suite.Require().False(found)
+
+	voucherDenom := types.ParseDenomTrace(path.forwardData.Denom).IBCDenom()
+	suite.Require().True(suite.chainB.App.BankKeeper.GetSupply(suite.chainB.GetContext()).GetTotal().AmountOf(voucherDenom).IsZero())
+
+	ack := channeltypes.NewErrorAcknowledgement(sdkerrors.Wrap(types.ErrForwardFailed, "forwarded packet timed out").Error())
+	suite.acknowledgeOriginalPacket(path, ack)
+
+	balance := suite.chainA.App.BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), sdk.DefaultBondDenom)
+	suite.Require().Equal(originalBalance, balance)
+}
+
+func (suite *KeeperTestSuite) TestForwardPacketFailed() {
+	_, _, connA, connB := suite.coordinator.SetupClientConnections(suite.chainA, suite.chainB, exported.Tendermint)
+	channelA, channelB := suite.coordinator.CreateTransferChannels(suite.chainA, suite.chainB, connA, connB, channeltypes.UNORDERED)
+
+	receiver := suite.chainB.SenderAccount.GetAddress()
+	data := types.NewFungibleTokenPacketData(
+		sdk.DefaultBondDenom, 100, suite.chainA.SenderAccount.GetAddress().String(),
+		types.NewForwardReceiver(receiver.String(), ibctesting.TransferPort, ibctesting.InvalidID, suite.chainC.SenderAccount.GetAddress().String()),
+	)
+	packet := channeltypes.NewPacket(data.GetBytes(), 1, channelA.PortID, channelA.ID, channelB.PortID, channelB.ID, clienttypes.NewHeight(0, 110), 0)
+
+	err := suite.chainB.App.TransferKeeper.OnRecvPacket(suite.chainB.GetContext(), packet, data)
+	suite.Require().ErrorIs(err, types.ErrForwardFailed)
+
+	// the tokens received before the failed forward are reverted
+	voucherDenom := types.ParseDenomTrace(types.GetPrefixedDenom(channelB.PortID, channelB.ID, sdk.DefaultBondDenom)).IBCDenom()
+	balance := suite.chainB.App.BankKeeper.GetBalance(suite.chainB.GetContext(), receiver, voucherDenom)
+	suite.Require().True(balance.IsZero())
+}
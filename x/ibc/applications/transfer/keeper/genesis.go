@@ -15,6 +15,10 @@ func (k Keeper) InitGenesis(ctx sdk.Context, state types.GenesisState) {
 		k.SetDenomTrace(ctx, trace)
 	}
 
+	for _, packet := range state.InFlightPackets {
+		k.SetInFlightPacket(ctx, packet)
+	}
+
 	// Only try to bind to port if it is not already bound, since we may already own
 	// port capability from capability InitGenesis
 	if !k.IsBound(ctx, state.PortId) {
@@ -38,8 +42,9 @@ func (k Keeper) InitGenesis(ctx sdk.Context, state types.GenesisState) {
 // ExportGenesis exports ibc-transfer module's portID and denom trace info into its genesis state.
 func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	return &types.GenesisState{
-		PortId:      k.GetPort(ctx),
-		DenomTraces: k.GetAllDenomTraces(ctx),
-		Params:      k.GetParams(ctx),
+		PortId:          k.GetPort(ctx),
+		DenomTraces:     k.GetAllDenomTraces(ctx),
+		Params:          k.GetParams(ctx),
+		InFlightPackets: k.GetAllInFlightPackets(ctx),
 	}
 }
@@ -3,7 +3,10 @@ package keeper_test
 import (
 	"fmt"
 
+	sdk "github.com/line/lfb-sdk/types"
 	"github.com/line/lfb-sdk/x/ibc/applications/transfer/types"
+	clienttypes "github.com/line/lfb-sdk/x/ibc/core/02-client/types"
+	channeltypes "github.com/line/lfb-sdk/x/ibc/core/04-channel/types"
 )
 
 func (suite *KeeperTestSuite) TestGenesis() {
@@ -28,10 +31,16 @@ func (suite *KeeperTestSuite) TestGenesis() {
 		suite.chainA.App.TransferKeeper.SetDenomTrace(suite.chainA.GetContext(), denomTrace)
 	}
 
+	data := types.NewFungibleTokenPacketData(sdk.DefaultBondDenom, 100, "sender", "receiver")
+	packet := channeltypes.NewPacket(data.GetBytes(), 1, types.PortID, "channel-0", types.PortID, "channel-0", clienttypes.NewHeight(0, 10), 0)
+	inFlight := types.NewInFlightPacket(packet, types.PortID, "channel-1", 1, types.ForwardPacketRetries)
+	suite.chainA.App.TransferKeeper.SetInFlightPacket(suite.chainA.GetContext(), inFlight)
+
 	genesis := suite.chainA.App.TransferKeeper.ExportGenesis(suite.chainA.GetContext())
 
 	suite.Require().Equal(types.PortID, genesis.PortId)
 	suite.Require().Equal(traces.Sort(), genesis.DenomTraces)
+	suite.Require().Equal([]types.InFlightPacket{inFlight}, genesis.InFlightPackets)
 
 	suite.Require().NotPanics(func() {
 		suite.chainA.App.TransferKeeper.InitGenesis(suite.chainA.GetContext(), *genesis)
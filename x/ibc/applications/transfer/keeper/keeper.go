@@ -13,6 +13,7 @@ import (
 	capabilitytypes "github.com/line/lfb-sdk/x/capability/types"
 	"github.com/line/lfb-sdk/x/ibc/applications/transfer/types"
 	channeltypes "github.com/line/lfb-sdk/x/ibc/core/04-channel/types"
+	porttypes "github.com/line/lfb-sdk/x/ibc/core/05-port/types"
 	host "github.com/line/lfb-sdk/x/ibc/core/24-host"
 	paramtypes "github.com/line/lfb-sdk/x/params/types"
 )
@@ -23,6 +24,7 @@ type Keeper struct {
 	cdc        codec.BinaryMarshaler
 	paramSpace *paramtypes.Subspace
 
+	ics4Wrapper   porttypes.ICS4Wrapper
 	channelKeeper types.ChannelKeeper
 	portKeeper    types.PortKeeper
 	authKeeper    types.AccountKeeper
@@ -30,10 +32,12 @@ type Keeper struct {
 	scopedKeeper  capabilitykeeper.ScopedKeeper
 }
 
-// NewKeeper creates a new IBC transfer Keeper instance
+// NewKeeper creates a new IBC transfer Keeper instance. Packets are sent through
+// the ics4Wrapper, which is either the channel keeper or the middleware stacked
+// on top of the transfer module.
 func NewKeeper(
 	cdc codec.BinaryMarshaler, key sdk.StoreKey, paramSpace *paramtypes.Subspace,
-	channelKeeper types.ChannelKeeper, portKeeper types.PortKeeper,
+	ics4Wrapper porttypes.ICS4Wrapper, channelKeeper types.ChannelKeeper, portKeeper types.PortKeeper,
 	authKeeper types.AccountKeeper, bankKeeper types.BankKeeper, scopedKeeper capabilitykeeper.ScopedKeeper,
 ) Keeper {
 
@@ -51,6 +55,7 @@ func NewKeeper(
 		cdc:           cdc,
 		storeKey:      key,
 		paramSpace:    paramSpace,
+		ics4Wrapper:   ics4Wrapper,
 		channelKeeper: channelKeeper,
 		portKeeper:    portKeeper,
 		authKeeper:    authKeeper,
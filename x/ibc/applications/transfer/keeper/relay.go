@@ -157,7 +157,7 @@ func (k Keeper) SendTransfer(
 		timeoutTimestamp,
 	)
 
-	if err := k.channelKeeper.SendPacket(ctx, channelCap, packet); err != nil {
+	if err := k.ics4Wrapper.SendPacket(ctx, channelCap, packet); err != nil {
 		return err
 	}
 
@@ -182,13 +182,22 @@ func (k Keeper) SendTransfer(
 // sender chain is the source of minted tokens then vouchers will be minted
 // and sent to the receiving address. Otherwise if the sender chain is sending
 // back tokens this chain originally transferred to it, the tokens are
-// unescrowed and sent to the receiving address.
+// unescrowed and sent to the receiving address. If the receiver requests
+// forwarding, the tokens are sent on to the next receiver.
 func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, data types.FungibleTokenPacketData) error {
 	// validate packet data upon receiving
 	if err := data.ValidateBasic(); err != nil {
 		return err
 	}
 
+	metadata, forward, err := types.ParseForwardReceiver(data.Receiver)
+	if err != nil {
+		return err
+	}
+	if forward {
+		return k.forwardPacket(ctx, packet, data, metadata)
+	}
+
 	if !k.GetReceiveEnabled(ctx) {
 		return types.ErrReceiveDisabled
 	}
@@ -322,21 +331,31 @@ func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, data t
 // acknowledgement written on the receiving chain. If the acknowledgement
 // was a success then nothing occurs. If the acknowledgement failed, then
 // the sender is refunded their tokens using the refundPacketToken function.
+// If the packet was forwarding tokens, the acknowledgement is relayed back
+// to the packet the tokens were received with.
 func (k Keeper) OnAcknowledgementPacket(ctx sdk.Context, packet channeltypes.Packet, data types.FungibleTokenPacketData, ack channeltypes.Acknowledgement) error {
 	switch ack.Response.(type) {
 	case *channeltypes.Acknowledgement_Error:
-		return k.refundPacketToken(ctx, packet, data)
+		if err := k.refundPacketToken(ctx, packet, data); err != nil {
+			return err
+		}
 	default:
 		// the acknowledgement succeeded on the receiving chain so nothing
-		// needs to be executed and no error needs to be returned
-		return nil
+		// needs to be refunded
 	}
+
+	return k.acknowledgeForwardedPacket(ctx, packet, ack)
 }
 
 // OnTimeoutPacket refunds the sender since the original packet sent was
-// never received and has been timed out.
+// never received and has been timed out. If the packet was forwarding tokens,
+// it is retried or the packet the tokens were received with is reverted.
 func (k Keeper) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet, data types.FungibleTokenPacketData) error {
-	return k.refundPacketToken(ctx, packet, data)
+	if err := k.refundPacketToken(ctx, packet, data); err != nil {
+		return err
+	}
+
+	return k.timeoutForwardedPacket(ctx, packet, data)
 }
 
 // refundPacketToken will unescrow and send back the tokens back to sender
@@ -11,10 +11,11 @@ import (
 // TransferUnmarshaler defines the expected encoding store functions.
 type TransferUnmarshaler interface {
 	MustUnmarshalDenomTrace([]byte) types.DenomTrace
+	MustUnmarshalInFlightPacket([]byte) types.InFlightPacket
 }
 
 // NewDecodeStore returns a decoder function closure that unmarshals the KVPair's
-// Value to the corresponding DenomTrace type.
+// Value to the corresponding DenomTrace or InFlightPacket type.
 func NewDecodeStore(cdc TransferUnmarshaler) func(kvA, kvB kv.Pair) string {
 	return func(kvA, kvB kv.Pair) string {
 		switch {
@@ -26,6 +27,11 @@ func NewDecodeStore(cdc TransferUnmarshaler) func(kvA, kvB kv.Pair) string {
 			denomTraceB := cdc.MustUnmarshalDenomTrace(kvB.Value)
 			return fmt.Sprintf("DenomTrace A: %s\nDenomTrace B: %s", denomTraceA.IBCDenom(), denomTraceB.IBCDenom())
 
+		case bytes.Equal(kvA.Key[:1], types.InFlightPacketKey):
+			packetA := cdc.MustUnmarshalInFlightPacket(kvA.Value)
+			packetB := cdc.MustUnmarshalInFlightPacket(kvB.Value)
+			return fmt.Sprintf("InFlightPacket A: %v\nInFlightPacket B: %v", packetA, packetB)
+
 		default:
 			panic(fmt.Sprintf("invalid %s key prefix %X", types.ModuleName, kvA.Key[:1]))
 		}
@@ -10,6 +10,8 @@ import (
 	"github.com/line/lfb-sdk/types/kv"
 	"github.com/line/lfb-sdk/x/ibc/applications/transfer/simulation"
 	"github.com/line/lfb-sdk/x/ibc/applications/transfer/types"
+	clienttypes "github.com/line/lfb-sdk/x/ibc/core/02-client/types"
+	channeltypes "github.com/line/lfb-sdk/x/ibc/core/04-channel/types"
 )
 
 func TestDecodeStore(t *testing.T) {
@@ -21,6 +23,10 @@ func TestDecodeStore(t *testing.T) {
 		Path:      "transfer/channelToA",
 	}
 
+	data := types.NewFungibleTokenPacketData(trace.GetFullDenomPath(), 100, "sender", "receiver")
+	packet := channeltypes.NewPacket(data.GetBytes(), 1, types.PortID, "channel-0", types.PortID, "channel-0", clienttypes.NewHeight(0, 10), 0)
+	inFlight := types.NewInFlightPacket(packet, types.PortID, "channel-1", 1, types.ForwardPacketRetries)
+
 	kvPairs := kv.Pairs{
 		Pairs: []kv.Pair{
 			{
@@ -31,6 +37,10 @@ func TestDecodeStore(t *testing.T) {
 				Key:   types.DenomTraceKey,
 				Value: app.TransferKeeper.MustMarshalDenomTrace(trace),
 			},
+			{
+				Key:   types.GetInFlightPacketKey(inFlight.ForwardPortId, inFlight.ForwardChannelId, inFlight.ForwardSequence),
+				Value: app.TransferKeeper.MustMarshalInFlightPacket(inFlight),
+			},
 			{
 				Key:   []byte{0x99},
 				Value: []byte{0x99},
@@ -43,6 +53,7 @@ func TestDecodeStore(t *testing.T) {
 	}{
 		{"PortID", fmt.Sprintf("Port A: %s\nPort B: %s", types.PortID, types.PortID)},
 		{"DenomTrace", fmt.Sprintf("DenomTrace A: %s\nDenomTrace B: %s", trace.IBCDenom(), trace.IBCDenom())},
+		{"InFlightPacket", fmt.Sprintf("InFlightPacket A: %v\nInFlightPacket B: %v", inFlight, inFlight)},
 		{"other", ""},
 	}
 
@@ -7,6 +7,7 @@ const (
 	EventTypeTransfer     = "ibc_transfer"
 	EventTypeChannelClose = "channel_closed"
 	EventTypeDenomTrace   = "denomination_trace"
+	EventTypeForward      = "forward_packet"
 
 	AttributeKeyReceiver       = "receiver"
 	AttributeKeyDenom          = "denom"
@@ -18,4 +19,8 @@ const (
 	AttributeKeyAck            = "acknowledgement"
 	AttributeKeyAckError       = "error"
 	AttributeKeyTraceHash      = "trace_hash"
+	AttributeKeyForwardPort    = "forward_port"
+	AttributeKeyForwardChannel = "forward_channel"
+	AttributeKeyForwardSeq     = "forward_sequence"
+	AttributeKeyRetries        = "retries_remaining"
 )
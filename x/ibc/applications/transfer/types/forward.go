@@ -0,0 +1,124 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	sdk "github.com/line/lfb-sdk/types"
+	sdkerrors "github.com/line/lfb-sdk/types/errors"
+	channeltypes "github.com/line/lfb-sdk/x/ibc/core/04-channel/types"
+	host "github.com/line/lfb-sdk/x/ibc/core/24-host"
+)
+
+const (
+	// ForwardPacketTimeout is the timeout, relative to the block time, of the
+	// packets forwarding tokens to the next chain
+	ForwardPacketTimeout = 10 * time.Minute
+
+	// ForwardPacketRetries is the number of times a forwarded packet is sent
+	// again when it times out, before the tokens are refunded along the path
+	ForwardPacketRetries = 1
+)
+
+// ForwardMetadata defines the forwarding information encoded in the receiver
+// of a fungible token packet with the format:
+//
+//	{receiver}|{port}/{channel}:{next receiver}
+//
+// The tokens are received by the receiver on this chain, which forwards them
+// on the given port and channel to the next receiver. The next receiver may
+// itself be encoded with forwarding information for multi-hop transfers.
+type ForwardMetadata struct {
+	Receiver     string
+	Port         string
+	Channel      string
+	NextReceiver string
+}
+
+// NewForwardReceiver returns the receiver encoding the forwarding of the
+// tokens received by receiver to the next receiver on the given port and
+// channel.
+func NewForwardReceiver(receiver, portID, channelID, nextReceiver string) string {
+	return fmt.Sprintf("%s|%s/%s:%s", receiver, portID, channelID, nextReceiver)
+}
+
+// ParseForwardReceiver parses the forwarding information of a packet receiver.
+// It returns false if the receiver is a plain address which does not request
+// forwarding.
+func ParseForwardReceiver(receiver string) (ForwardMetadata, bool, error) {
+	split := strings.SplitN(receiver, "|", 2)
+	if len(split) != 2 {
+		return ForwardMetadata{}, false, nil
+	}
+
+	path := strings.SplitN(split[1], ":", 2)
+	if len(path) != 2 {
+		return ForwardMetadata{}, true, sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "forward receiver %s is not in the format {receiver}|{port}/{channel}:{next receiver}", receiver)
+	}
+
+	identifiers := strings.Split(path[0], "/")
+	if len(identifiers) != 2 {
+		return ForwardMetadata{}, true, sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "forward path %s is not in the format {port}/{channel}", path[0])
+	}
+
+	metadata := ForwardMetadata{
+		Receiver:     split[0],
+		Port:         identifiers[0],
+		Channel:      identifiers[1],
+		NextReceiver: path[1],
+	}
+
+	if err := metadata.Validate(); err != nil {
+		return ForwardMetadata{}, true, err
+	}
+
+	return metadata, true, nil
+}
+
+// Validate performs a basic validation of the forwarding information.
+func (m ForwardMetadata) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(m.Receiver); err != nil {
+		return sdkerrors.Wrap(err, "invalid forward receiver address")
+	}
+	if err := host.PortIdentifierValidator(m.Port); err != nil {
+		return sdkerrors.Wrap(err, "invalid forward port ID")
+	}
+	if err := host.ChannelIdentifierValidator(m.Channel); err != nil {
+		return sdkerrors.Wrap(err, "invalid forward channel ID")
+	}
+	if strings.TrimSpace(m.NextReceiver) == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "next receiver address cannot be blank")
+	}
+	return nil
+}
+
+// NewInFlightPacket creates a new InFlightPacket instance.
+func NewInFlightPacket(
+	originalPacket channeltypes.Packet, forwardPortID, forwardChannelID string, forwardSequence uint64, retries uint32,
+) InFlightPacket {
+	return InFlightPacket{
+		OriginalPacket:   originalPacket,
+		ForwardPortId:    forwardPortID,
+		ForwardChannelId: forwardChannelID,
+		ForwardSequence:  forwardSequence,
+		RetriesRemaining: retries,
+	}
+}
+
+// Validate performs a basic validation of the in-flight packet.
+func (p InFlightPacket) Validate() error {
+	if err := p.OriginalPacket.ValidateBasic(); err != nil {
+		return err
+	}
+	if err := host.PortIdentifierValidator(p.ForwardPortId); err != nil {
+		return sdkerrors.Wrap(err, "invalid forward port ID")
+	}
+	if err := host.ChannelIdentifierValidator(p.ForwardChannelId); err != nil {
+		return sdkerrors.Wrap(err, "invalid forward channel ID")
+	}
+	if p.ForwardSequence == 0 {
+		return sdkerrors.Wrap(channeltypes.ErrInvalidPacket, "forward packet sequence cannot be 0")
+	}
+	return nil
+}
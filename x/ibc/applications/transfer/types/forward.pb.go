@@ -0,0 +1,515 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: ibc/applications/transfer/v1/forward.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	types "github.com/line/lfb-sdk/x/ibc/core/04-channel/types"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// InFlightPacket defines a received packet whose tokens were forwarded to the
+// next chain. The received packet is acknowledged once the forwarded packet is
+// acknowledged, or timed out with no retries remaining.
+type InFlightPacket struct {
+	// the received packet which is acknowledged asynchronously
+	OriginalPacket types.Packet `protobuf:"bytes,1,opt,name=original_packet,json=originalPacket,proto3" json:"original_packet" yaml:"original_packet"`
+	// the port on which the tokens are forwarded
+	ForwardPortId string `protobuf:"bytes,2,opt,name=forward_port_id,json=forwardPortId,proto3" json:"forward_port_id,omitempty" yaml:"forward_port_id"`
+	// the channel on which the tokens are forwarded
+	ForwardChannelId string `protobuf:"bytes,3,opt,name=forward_channel_id,json=forwardChannelId,proto3" json:"forward_channel_id,omitempty" yaml:"forward_channel_id"`
+	// the sequence of the forwarded packet
+	ForwardSequence uint64 `protobuf:"varint,4,opt,name=forward_sequence,json=forwardSequence,proto3" json:"forward_sequence,omitempty" yaml:"forward_sequence"`
+	// the number of times the forwarded packet is sent again when it times out
+	RetriesRemaining uint32 `protobuf:"varint,5,opt,name=retries_remaining,json=retriesRemaining,proto3" json:"retries_remaining,omitempty" yaml:"retries_remaining"`
+}
+
+func (m *InFlightPacket) Reset()         { *m = InFlightPacket{} }
+func (m *InFlightPacket) String() string { return proto.CompactTextString(m) }
+func (*InFlightPacket) ProtoMessage()    {}
+func (*InFlightPacket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a7c19038e1df31a, []int{0}
+}
+func (m *InFlightPacket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InFlightPacket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InFlightPacket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InFlightPacket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InFlightPacket.Merge(m, src)
+}
+func (m *InFlightPacket) XXX_Size() int {
+	return m.Size()
+}
+func (m *InFlightPacket) XXX_DiscardUnknown() {
+	xxx_messageInfo_InFlightPacket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InFlightPacket proto.InternalMessageInfo
+
+func (m *InFlightPacket) GetOriginalPacket() types.Packet {
+	if m != nil {
+		return m.OriginalPacket
+	}
+	return types.Packet{}
+}
+
+func (m *InFlightPacket) GetForwardPortId() string {
+	if m != nil {
+		return m.ForwardPortId
+	}
+	return ""
+}
+
+func (m *InFlightPacket) GetForwardChannelId() string {
+	if m != nil {
+		return m.ForwardChannelId
+	}
+	return ""
+}
+
+func (m *InFlightPacket) GetForwardSequence() uint64 {
+	if m != nil {
+		return m.ForwardSequence
+	}
+	return 0
+}
+
+func (m *InFlightPacket) GetRetriesRemaining() uint32 {
+	if m != nil {
+		return m.RetriesRemaining
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*InFlightPacket)(nil), "ibc.applications.transfer.v1.InFlightPacket")
+}
+
+func init() {
+	proto.RegisterFile("ibc/applications/transfer/v1/forward.proto", fileDescriptor_4a7c19038e1df31a)
+}
+
+var fileDescriptor_4a7c19038e1df31a = []byte{
+	// 398 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0xcf, 0x8e, 0x94, 0x30,
+	0x1c, 0x80, 0xa9, 0xbb, 0x9a, 0x88, 0xd9, 0x3f, 0x12, 0xa3, 0x38, 0xbb, 0x02, 0x72, 0x22, 0x26,
+	0xb6, 0x19, 0x3d, 0xe9, 0x11, 0x93, 0x4d, 0x88, 0x97, 0x0d, 0x7b, 0xf3, 0x42, 0x4a, 0xe9, 0x30,
+	0xcd, 0x32, 0x2d, 0x96, 0xee, 0xe8, 0xbe, 0x85, 0x67, 0x9f, 0x68, 0x8e, 0x73, 0xf4, 0x44, 0xcc,
+	0xcc, 0x1b, 0xf0, 0x04, 0x06, 0x68, 0x33, 0xce, 0x18, 0x6f, 0xcd, 0x97, 0xaf, 0x1f, 0xbf, 0x5f,
+	0xa8, 0xfd, 0x86, 0xe5, 0x04, 0xe1, 0xba, 0xae, 0x18, 0xc1, 0x8a, 0x09, 0xde, 0x20, 0x25, 0x31,
+	0x6f, 0x66, 0x54, 0xa2, 0xe5, 0x14, 0xcd, 0x84, 0xfc, 0x86, 0x65, 0x01, 0x6b, 0x29, 0x94, 0x70,
+	0x2e, 0x59, 0x4e, 0xe0, 0xdf, 0x2e, 0x34, 0x2e, 0x5c, 0x4e, 0x27, 0xcf, 0x4a, 0x51, 0x8a, 0x41,
+	0x44, 0xfd, 0x69, 0xbc, 0x33, 0x79, 0xdd, 0xf7, 0x89, 0x90, 0x14, 0x91, 0x39, 0xe6, 0x9c, 0x56,
+	0x7d, 0x56, 0x1f, 0x47, 0x25, 0xfc, 0x79, 0x64, 0x9f, 0x26, 0xfc, 0xaa, 0x62, 0xe5, 0x5c, 0x5d,
+	0x63, 0x72, 0x4b, 0x95, 0x53, 0xd8, 0x67, 0x42, 0xb2, 0x92, 0x71, 0x5c, 0x65, 0xf5, 0x80, 0x5c,
+	0x10, 0x80, 0xe8, 0xc9, 0xbb, 0x0b, 0xd8, 0xcf, 0xd0, 0xf7, 0xa0, 0x89, 0x2c, 0xa7, 0x70, 0xbc,
+	0x15, 0x7b, 0xab, 0xd6, 0xb7, 0xba, 0xd6, 0x7f, 0x7e, 0x8f, 0x17, 0xd5, 0xc7, 0xf0, 0xa0, 0x10,
+	0xa6, 0xa7, 0x86, 0xe8, 0xaf, 0xc4, 0xf6, 0x99, 0x5e, 0x30, 0xab, 0x85, 0x54, 0x19, 0x2b, 0xdc,
+	0x07, 0x01, 0x88, 0x1e, 0xc7, 0x93, 0x5d, 0xe4, 0x40, 0x08, 0xd3, 0x13, 0x4d, 0xae, 0x85, 0x54,
+	0x49, 0xe1, 0x7c, 0xb6, 0x1d, 0xa3, 0xe8, 0x81, 0xfa, 0xcc, 0xd1, 0x90, 0x79, 0xd5, 0xb5, 0xfe,
+	0xcb, 0xfd, 0xcc, 0xce, 0x09, 0xd3, 0x73, 0x0d, 0x3f, 0x8d, 0x2c, 0x29, 0x9c, 0x2b, 0xdb, 0xb0,
+	0xac, 0xa1, 0x5f, 0xef, 0x28, 0x27, 0xd4, 0x3d, 0x0e, 0x40, 0x74, 0x1c, 0x5f, 0x74, 0xad, 0xff,
+	0x62, 0x3f, 0x65, 0x8c, 0x30, 0x35, 0x5b, 0xdc, 0x68, 0xe2, 0x24, 0xf6, 0x53, 0x49, 0x95, 0x64,
+	0xb4, 0xc9, 0x24, 0x5d, 0x60, 0xc6, 0x19, 0x2f, 0xdd, 0x87, 0x01, 0x88, 0x4e, 0xe2, 0xcb, 0xae,
+	0xf5, 0xdd, 0x31, 0xf4, 0x8f, 0x12, 0xa6, 0xe7, 0x9a, 0xa5, 0x06, 0xc5, 0x37, 0xab, 0x8d, 0x07,
+	0xd6, 0x1b, 0x0f, 0xfc, 0xde, 0x78, 0xe0, 0xc7, 0xd6, 0xb3, 0xd6, 0x5b, 0xcf, 0xfa, 0xb5, 0xf5,
+	0xac, 0x2f, 0x1f, 0x4a, 0xa6, 0xe6, 0x77, 0x39, 0x24, 0x62, 0x81, 0x2a, 0xc6, 0x29, 0xaa, 0x66,
+	0xf9, 0xdb, 0xa6, 0xb8, 0x45, 0xdf, 0xd1, 0xff, 0xdf, 0x94, 0xba, 0xaf, 0x69, 0x93, 0x3f, 0x1a,
+	0x7e, 0xfc, 0xfb, 0x3f, 0x03, 0x00, 0xda, 0x07, 0x45, 0x6d, 0x7d, 0x02, 0x00, 0x00,
+}
+
+func (m *InFlightPacket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InFlightPacket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InFlightPacket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RetriesRemaining != 0 {
+		i = encodeVarintForward(dAtA, i, uint64(m.RetriesRemaining))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ForwardSequence != 0 {
+		i = encodeVarintForward(dAtA, i, uint64(m.ForwardSequence))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ForwardChannelId) > 0 {
+		i -= len(m.ForwardChannelId)
+		copy(dAtA[i:], m.ForwardChannelId)
+		i = encodeVarintForward(dAtA, i, uint64(len(m.ForwardChannelId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ForwardPortId) > 0 {
+		i -= len(m.ForwardPortId)
+		copy(dAtA[i:], m.ForwardPortId)
+		i = encodeVarintForward(dAtA, i, uint64(len(m.ForwardPortId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.OriginalPacket.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintForward(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintForward(dAtA []byte, offset int, v uint64) int {
+	offset -= sovForward(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *InFlightPacket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.OriginalPacket.Size()
+	n += 1 + l + sovForward(uint64(l))
+	l = len(m.ForwardPortId)
+	if l > 0 {
+		n += 1 + l + sovForward(uint64(l))
+	}
+	l = len(m.ForwardChannelId)
+	if l > 0 {
+		n += 1 + l + sovForward(uint64(l))
+	}
+	if m.ForwardSequence != 0 {
+		n += 1 + sovForward(uint64(m.ForwardSequence))
+	}
+	if m.RetriesRemaining != 0 {
+		n += 1 + sovForward(uint64(m.RetriesRemaining))
+	}
+	return n
+}
+
+func sovForward(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozForward(x uint64) (n int) {
+	return sovForward(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *InFlightPacket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowForward
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InFlightPacket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InFlightPacket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginalPacket", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowForward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthForward
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthForward
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OriginalPacket.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForwardPortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowForward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthForward
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthForward
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ForwardPortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForwardChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowForward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthForward
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthForward
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ForwardChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForwardSequence", wireType)
+			}
+			m.ForwardSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowForward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ForwardSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetriesRemaining", wireType)
+			}
+			m.RetriesRemaining = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowForward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RetriesRemaining |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipForward(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthForward
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipForward(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowForward
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowForward
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowForward
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthForward
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupForward
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthForward
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthForward        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowForward          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupForward = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,48 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/line/lfb-sdk/types"
+	"github.com/line/lfb-sdk/x/ibc/applications/transfer/types"
+)
+
+func TestParseForwardReceiver(t *testing.T) {
+	receiver := sdk.AccAddress("receiver____________").String()
+	nextReceiver := types.NewForwardReceiver(receiver, "transfer", "channel-1", "next")
+
+	testCases := []struct {
+		name       string
+		receiver   string
+		expForward bool
+		expPass    bool
+		expected   types.ForwardMetadata
+	}{
+		{"plain receiver", receiver, false, true, types.ForwardMetadata{}},
+		{"valid forward", types.NewForwardReceiver(receiver, "transfer", "channel-0", "next"), true, true, types.ForwardMetadata{
+			Receiver: receiver, Port: "transfer", Channel: "channel-0", NextReceiver: "next",
+		}},
+		{"multi-hop forward", types.NewForwardReceiver(receiver, "transfer", "channel-0", nextReceiver), true, true, types.ForwardMetadata{
+			Receiver: receiver, Port: "transfer", Channel: "channel-0", NextReceiver: nextReceiver,
+		}},
+		{"missing next receiver", receiver + "|transfer/channel-0", true, false, types.ForwardMetadata{}},
+		{"missing channel", receiver + "|transfer:next", true, false, types.ForwardMetadata{}},
+		{"invalid receiver", types.NewForwardReceiver("invalid", "transfer", "channel-0", "next"), true, false, types.ForwardMetadata{}},
+		{"invalid port", types.NewForwardReceiver(receiver, "(invalid)", "channel-0", "next"), true, false, types.ForwardMetadata{}},
+		{"invalid channel", types.NewForwardReceiver(receiver, "transfer", "(invalid)", "next"), true, false, types.ForwardMetadata{}},
+		{"blank next receiver", types.NewForwardReceiver(receiver, "transfer", "channel-0", " "), true, false, types.ForwardMetadata{}},
+	}
+
+	for _, tc := range testCases {
+		metadata, forward, err := types.ParseForwardReceiver(tc.receiver)
+		require.Equal(t, tc.expForward, forward, tc.name)
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+			require.Equal(t, tc.expected, metadata, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
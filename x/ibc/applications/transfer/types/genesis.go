@@ -5,20 +5,22 @@ import (
 )
 
 // NewGenesisState creates a new ibc-transfer GenesisState instance.
-func NewGenesisState(portID string, denomTraces Traces, params Params) *GenesisState {
+func NewGenesisState(portID string, denomTraces Traces, params Params, inFlightPackets []InFlightPacket) *GenesisState {
 	return &GenesisState{
-		PortId:      portID,
-		DenomTraces: denomTraces,
-		Params:      params,
+		PortId:          portID,
+		DenomTraces:     denomTraces,
+		Params:          params,
+		InFlightPackets: inFlightPackets,
 	}
 }
 
 // DefaultGenesisState returns a GenesisState with "transfer" as the default PortID.
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
-		PortId:      PortID,
-		DenomTraces: Traces{},
-		Params:      DefaultParams(),
+		PortId:          PortID,
+		DenomTraces:     Traces{},
+		Params:          DefaultParams(),
+		InFlightPackets: []InFlightPacket{},
 	}
 }
 
@@ -31,5 +33,10 @@ func (gs GenesisState) Validate() error {
 	if err := gs.DenomTraces.Validate(); err != nil {
 		return err
 	}
+	for _, packet := range gs.InFlightPackets {
+		if err := packet.Validate(); err != nil {
+			return err
+		}
+	}
 	return gs.Params.Validate()
 }
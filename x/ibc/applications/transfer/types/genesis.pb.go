@@ -28,6 +28,8 @@ type GenesisState struct {
 	PortId      string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty" yaml:"port_id"`
 	DenomTraces Traces `protobuf:"bytes,2,rep,name=denom_traces,json=denomTraces,proto3,castrepeated=Traces" json:"denom_traces" yaml:"denom_traces"`
 	Params      Params `protobuf:"bytes,3,opt,name=params,proto3" json:"params"`
+	// packets received whose tokens are being forwarded to another chain
+	InFlightPackets []InFlightPacket `protobuf:"bytes,4,rep,name=in_flight_packets,json=inFlightPackets,proto3" json:"in_flight_packets" yaml:"in_flight_packets"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -84,6 +86,13 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetInFlightPackets() []InFlightPacket {
+	if m != nil {
+		return m.InFlightPackets
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "ibc.applications.transfer.v1.GenesisState")
 }
@@ -93,27 +102,31 @@ func init() {
 }
 
 var fileDescriptor_a4f788affd5bea89 = []byte{
-	// 315 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x90, 0xc1, 0x4a, 0xc3, 0x30,
-	0x1c, 0xc6, 0x1b, 0x27, 0x15, 0xbb, 0xe1, 0xa1, 0x7a, 0x18, 0x43, 0xd2, 0x51, 0x14, 0x8a, 0xc3,
-	0x84, 0xcd, 0x93, 0x1e, 0x8b, 0x20, 0xde, 0x64, 0xf3, 0xe4, 0x65, 0xa4, 0x6d, 0x56, 0x83, 0x6d,
-	0x13, 0x92, 0x38, 0xdc, 0x5b, 0xf8, 0x1c, 0x3e, 0xc9, 0x8e, 0x3b, 0x7a, 0x9a, 0xb2, 0xbe, 0xc1,
-	0x7c, 0x01, 0x69, 0x3b, 0xc7, 0x2e, 0xf6, 0xf6, 0x87, 0xfc, 0x7e, 0xdf, 0x17, 0x3e, 0xeb, 0x82,
-	0x05, 0x21, 0x26, 0x42, 0x24, 0x2c, 0x24, 0x9a, 0xf1, 0x4c, 0x61, 0x2d, 0x49, 0xa6, 0x26, 0x54,
-	0xe2, 0x69, 0x1f, 0xc7, 0x34, 0xa3, 0x8a, 0x29, 0x24, 0x24, 0xd7, 0xdc, 0x3e, 0x65, 0x41, 0x88,
-	0x76, 0x59, 0xf4, 0xc7, 0xa2, 0x69, 0xbf, 0x73, 0x12, 0xf3, 0x98, 0x97, 0x20, 0x2e, 0xae, 0xca,
-	0xe9, 0xf4, 0x6a, 0xf3, 0xb7, 0x7e, 0x09, 0xbb, 0x3f, 0xc0, 0x6a, 0xdd, 0x55, 0x95, 0x23, 0x4d,
-	0x34, 0xb5, 0x7b, 0xd6, 0x81, 0xe0, 0x52, 0x8f, 0x59, 0xd4, 0x06, 0x5d, 0xe0, 0x1d, 0xfa, 0xf6,
-	0x7a, 0xe9, 0x1c, 0xcd, 0x48, 0x9a, 0xdc, 0xb8, 0x9b, 0x07, 0x77, 0x68, 0x16, 0xd7, 0x7d, 0x64,
-	0x4b, 0xab, 0x15, 0xd1, 0x8c, 0xa7, 0x63, 0x2d, 0x49, 0x48, 0x55, 0x7b, 0xaf, 0xdb, 0xf0, 0x9a,
-	0x03, 0x0f, 0xd5, 0xfd, 0x1a, 0xdd, 0x16, 0xc6, 0x63, 0x21, 0xf8, 0xe7, 0xf3, 0xa5, 0x63, 0xac,
-	0x97, 0xce, 0x71, 0x95, 0xbf, 0x9b, 0xe5, 0x7e, 0x7c, 0x39, 0x66, 0x49, 0xa9, 0x61, 0x33, 0xda,
-	0x2a, 0xca, 0xf6, 0x2d, 0x53, 0x10, 0x49, 0x52, 0xd5, 0x6e, 0x74, 0x81, 0xd7, 0x1c, 0x9c, 0xd5,
-	0xb7, 0x3d, 0x94, 0xac, 0xbf, 0x5f, 0x34, 0x0d, 0x37, 0xa6, 0x3f, 0x9a, 0xaf, 0x20, 0x58, 0xac,
-	0x20, 0xf8, 0x5e, 0x41, 0xf0, 0x9e, 0x43, 0x63, 0x91, 0x43, 0xe3, 0x33, 0x87, 0xc6, 0xd3, 0x75,
-	0xcc, 0xf4, 0xf3, 0x6b, 0x80, 0x42, 0x9e, 0xe2, 0x84, 0x65, 0x14, 0x27, 0x93, 0xe0, 0x52, 0x45,
-	0x2f, 0xf8, 0x0d, 0xff, 0x3f, 0xab, 0x9e, 0x09, 0xaa, 0x02, 0xb3, 0x5c, 0xf4, 0xea, 0x37, 0x00,
-	0x00, 0xff, 0xff, 0xd5, 0x64, 0x6e, 0xaf, 0xe0, 0x01, 0x00, 0x00,
+	// 373 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x91, 0xc1, 0x6a, 0xa3, 0x40,
+	0x18, 0xc7, 0x35, 0x09, 0x2e, 0x6b, 0xc2, 0x2e, 0xeb, 0xee, 0x41, 0xc2, 0xa2, 0x22, 0xbb, 0x20,
+	0x9b, 0xad, 0x43, 0xd2, 0x53, 0x7b, 0x94, 0xd2, 0x92, 0x5b, 0x30, 0x3d, 0xf5, 0x22, 0xa3, 0x8e,
+	0x66, 0x88, 0x3a, 0x32, 0x33, 0x4d, 0x9b, 0x3e, 0x45, 0x9f, 0xa3, 0x4f, 0x92, 0x63, 0x8e, 0x3d,
+	0xa5, 0x25, 0x79, 0x80, 0x42, 0x9e, 0xa0, 0xa8, 0x69, 0x48, 0x29, 0xf5, 0x36, 0x30, 0xbf, 0xdf,
+	0xff, 0xfb, 0xfe, 0x7c, 0xf2, 0x3f, 0xec, 0x07, 0x00, 0xe6, 0x79, 0x82, 0x03, 0xc8, 0x31, 0xc9,
+	0x18, 0xe0, 0x14, 0x66, 0x2c, 0x42, 0x14, 0xcc, 0xfa, 0x20, 0x46, 0x19, 0x62, 0x98, 0xd9, 0x39,
+	0x25, 0x9c, 0x28, 0xbf, 0xb1, 0x1f, 0xd8, 0x87, 0xac, 0xfd, 0xc6, 0xda, 0xb3, 0x7e, 0xf7, 0x57,
+	0x4c, 0x62, 0x52, 0x82, 0xa0, 0x78, 0x55, 0x4e, 0xb7, 0x57, 0x9b, 0xbf, 0xf7, 0x2b, 0xb8, 0x7e,
+	0x99, 0x88, 0xd0, 0x1b, 0x48, 0xc3, 0x8a, 0x35, 0x5f, 0x1a, 0x72, 0xe7, 0xa2, 0x5a, 0x6f, 0xcc,
+	0x21, 0x47, 0x4a, 0x4f, 0xfe, 0x92, 0x13, 0xca, 0x3d, 0x1c, 0xaa, 0xa2, 0x21, 0x5a, 0x5f, 0x1d,
+	0x65, 0xbb, 0xd2, 0xbf, 0xcd, 0x61, 0x9a, 0x9c, 0x9a, 0xbb, 0x0f, 0xd3, 0x95, 0x8a, 0xd7, 0x30,
+	0x54, 0xa8, 0xdc, 0x09, 0x51, 0x46, 0x52, 0x8f, 0x53, 0x18, 0x20, 0xa6, 0x36, 0x8c, 0xa6, 0xd5,
+	0x1e, 0x58, 0x76, 0x5d, 0x43, 0xfb, 0xac, 0x30, 0x2e, 0x0b, 0xc1, 0xf9, 0xbb, 0x58, 0xe9, 0xc2,
+	0x76, 0xa5, 0xff, 0xac, 0xf2, 0x0f, 0xb3, 0xcc, 0x87, 0x27, 0x5d, 0x2a, 0x29, 0xe6, 0xb6, 0xc3,
+	0xbd, 0xc2, 0x14, 0x47, 0x96, 0x72, 0x48, 0x61, 0xca, 0xd4, 0xa6, 0x21, 0x5a, 0xed, 0xc1, 0x9f,
+	0xfa, 0x69, 0xa3, 0x92, 0x75, 0x5a, 0xc5, 0x24, 0x77, 0x67, 0x2a, 0x77, 0xf2, 0x0f, 0x9c, 0x79,
+	0x51, 0x82, 0xe3, 0x09, 0xf7, 0x72, 0x18, 0x4c, 0x11, 0x67, 0x6a, 0xab, 0x5c, 0xfe, 0x7f, 0x7d,
+	0xdc, 0x30, 0x3b, 0x2f, 0xad, 0x51, 0x29, 0x39, 0xc6, 0xae, 0x80, 0x5a, 0x15, 0xf8, 0x10, 0x6a,
+	0xba, 0xdf, 0xf1, 0x3b, 0x83, 0x39, 0xe3, 0xc5, 0x5a, 0x13, 0x97, 0x6b, 0x4d, 0x7c, 0x5e, 0x6b,
+	0xe2, 0xfd, 0x46, 0x13, 0x96, 0x1b, 0x4d, 0x78, 0xdc, 0x68, 0xc2, 0xd5, 0x49, 0x8c, 0xf9, 0xe4,
+	0xda, 0xb7, 0x03, 0x92, 0x82, 0x04, 0x67, 0x08, 0x24, 0x91, 0x7f, 0xc4, 0xc2, 0x29, 0xb8, 0x05,
+	0x9f, 0x5f, 0x94, 0xcf, 0x73, 0xc4, 0x7c, 0xa9, 0xbc, 0xe6, 0xf1, 0xeb, 0x00, 0x54, 0x88, 0x50,
+	0x9f, 0x88, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -136,6 +149,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.InFlightPackets) > 0 {
+		for iNdEx := len(m.InFlightPackets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.InFlightPackets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -199,6 +226,12 @@ func (m *GenesisState) Size() (n int) {
 	}
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.InFlightPackets) > 0 {
+		for _, e := range m.InFlightPackets {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -336,6 +369,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InFlightPackets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InFlightPackets = append(m.InFlightPackets, InFlightPacket{})
+			if err := m.InFlightPackets[len(m.InFlightPackets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -6,9 +6,14 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/line/lfb-sdk/x/ibc/applications/transfer/types"
+	clienttypes "github.com/line/lfb-sdk/x/ibc/core/02-client/types"
+	channeltypes "github.com/line/lfb-sdk/x/ibc/core/04-channel/types"
 )
 
 func TestValidateGenesis(t *testing.T) {
+	data := types.NewFungibleTokenPacketData("atom", 100, "sender", "receiver")
+	packet := channeltypes.NewPacket(data.GetBytes(), 1, "transfer", "channel-0", "transfer", "channel-0", clienttypes.NewHeight(0, 10), 0)
+
 	testCases := []struct {
 		name     string
 		genState *types.GenesisState
@@ -33,6 +38,30 @@ func TestValidateGenesis(t *testing.T) {
 			},
 			false,
 		},
+		{
+			"valid in-flight packet",
+			&types.GenesisState{
+				PortId:          "portidone",
+				InFlightPackets: []types.InFlightPacket{types.NewInFlightPacket(packet, "transfer", "channel-1", 1, types.ForwardPacketRetries)},
+			},
+			true,
+		},
+		{
+			"invalid in-flight packet sequence",
+			&types.GenesisState{
+				PortId:          "portidone",
+				InFlightPackets: []types.InFlightPacket{types.NewInFlightPacket(packet, "transfer", "channel-1", 0, types.ForwardPacketRetries)},
+			},
+			false,
+		},
+		{
+			"invalid in-flight original packet",
+			&types.GenesisState{
+				PortId:          "portidone",
+				InFlightPackets: []types.InFlightPacket{types.NewInFlightPacket(channeltypes.Packet{}, "transfer", "channel-1", 1, types.ForwardPacketRetries)},
+			},
+			false,
+		},
 	}
 
 	for _, tc := range testCases {
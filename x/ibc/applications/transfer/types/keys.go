@@ -36,8 +36,16 @@ var (
 	PortKey = []byte{0x01}
 	// DenomTraceKey defines the key to store the denomination trace info in store
 	DenomTraceKey = []byte{0x02}
+	// InFlightPacketKey defines the key to store the packets being forwarded in store
+	InFlightPacketKey = []byte{0x03}
 )
 
+// GetInFlightPacketKey returns the store key of the packet forwarding tokens
+// on the given port, channel and sequence.
+func GetInFlightPacketKey(portID, channelID string, sequence uint64) []byte {
+	return append(InFlightPacketKey, []byte(fmt.Sprintf("%s/%s/%d", portID, channelID, sequence))...)
+}
+
 // GetEscrowAddress returns the escrow address for the specified channel.
 // The escrow address follows the format as outlined in ADR 028:
 // https://github.com/cosmos/cosmos-sdk/blob/master/docs/architecture/adr-028-public-key-addresses.md
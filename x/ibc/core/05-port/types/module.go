@@ -5,6 +5,7 @@ import (
 
 	capabilitytypes "github.com/line/lfb-sdk/x/capability/types"
 	channeltypes "github.com/line/lfb-sdk/x/ibc/core/04-channel/types"
+	"github.com/line/lfb-sdk/x/ibc/core/exported"
 )
 
 // IBCModule defines an interface that implements all the callbacks
@@ -60,19 +61,50 @@ type IBCModule interface {
 
 	// OnRecvPacket must return the acknowledgement bytes
 	// In the case of an asynchronous acknowledgement, nil should be returned.
+	// The relayer is the signer of the MsgRecvPacket that delivered the packet.
 	OnRecvPacket(
 		ctx sdk.Context,
 		packet channeltypes.Packet,
+		relayer sdk.AccAddress,
 	) (*sdk.Result, []byte, error)
 
 	OnAcknowledgementPacket(
 		ctx sdk.Context,
 		packet channeltypes.Packet,
 		acknowledgement []byte,
+		relayer sdk.AccAddress,
 	) (*sdk.Result, error)
 
 	OnTimeoutPacket(
 		ctx sdk.Context,
 		packet channeltypes.Packet,
+		relayer sdk.AccAddress,
 	) (*sdk.Result, error)
 }
+
+// ICS4Wrapper implements the ICS4 interfaces that IBC applications use to send
+// packets and write acknowledgements. Middleware implements it by wrapping the
+// next ICS4Wrapper in the stack, which for the outermost layer is the channel
+// keeper.
+type ICS4Wrapper interface {
+	SendPacket(
+		ctx sdk.Context,
+		chanCap *capabilitytypes.Capability,
+		packet exported.PacketI,
+	) error
+
+	WriteAcknowledgement(
+		ctx sdk.Context,
+		chanCap *capabilitytypes.Capability,
+		packet exported.PacketI,
+		acknowledgement []byte,
+	) error
+}
+
+// Middleware must implement IBCModule to wrap communication from core IBC to
+// the underlying application, and ICS4Wrapper to wrap communication from the
+// underlying application to core IBC.
+type Middleware interface {
+	IBCModule
+	ICS4Wrapper
+}
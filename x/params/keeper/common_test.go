@@ -10,6 +10,8 @@ import (
 	"github.com/line/lfb-sdk/codec"
 	"github.com/line/lfb-sdk/store"
 	sdk "github.com/line/lfb-sdk/types"
+	authtypes "github.com/line/lfb-sdk/x/auth/types"
+	govtypes "github.com/line/lfb-sdk/x/gov/types"
 	paramskeeper "github.com/line/lfb-sdk/x/params/keeper"
 )
 
@@ -18,7 +20,7 @@ func testComponents() (*codec.LegacyAmino, sdk.Context, sdk.StoreKey, paramskeep
 	legacyAmino := createTestCodec()
 	mkey := sdk.NewKVStoreKey("test")
 	ctx := defaultContext(mkey)
-	keeper := paramskeeper.NewKeeper(marshaler, legacyAmino, mkey)
+	keeper := paramskeeper.NewKeeper(marshaler, legacyAmino, mkey, authtypes.NewModuleAddress(govtypes.ModuleName).String())
 
 	return legacyAmino, ctx, mkey, keeper
 }
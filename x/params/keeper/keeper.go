@@ -1,10 +1,13 @@
 package keeper
 
 import (
+	"fmt"
+
 	"github.com/line/ostracon/libs/log"
 
 	"github.com/line/lfb-sdk/codec"
 	sdk "github.com/line/lfb-sdk/types"
+	sdkerrors "github.com/line/lfb-sdk/types/errors"
 	"github.com/line/lfb-sdk/x/params/types"
 	"github.com/line/lfb-sdk/x/params/types/proposal"
 )
@@ -15,18 +18,28 @@ type Keeper struct {
 	legacyAmino *codec.LegacyAmino
 	key         sdk.StoreKey
 	spaces      map[string]*types.Subspace
+
+	// the address capable of changing the parameters through Msgs, usually
+	// the gov module account
+	authority string
 }
 
 // NewKeeper constructs a params keeper
-func NewKeeper(cdc codec.BinaryMarshaler, legacyAmino *codec.LegacyAmino, key sdk.StoreKey) Keeper {
+func NewKeeper(cdc codec.BinaryMarshaler, legacyAmino *codec.LegacyAmino, key sdk.StoreKey, authority string) Keeper {
 	return Keeper{
 		cdc:         cdc,
 		legacyAmino: legacyAmino,
 		key:         key,
 		spaces:      make(map[string]*types.Subspace),
+		authority:   authority,
 	}
 }
 
+// GetAuthority returns the address capable of changing the parameters
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
 // Logger returns a module-specific logger.
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", "x/"+proposal.ModuleName)
@@ -57,3 +70,23 @@ func (k Keeper) GetSubspace(s string) (*types.Subspace, bool) {
 	}
 	return space, ok
 }
+
+// ChangeParams applies the given parameter changes to their subspaces
+func (k Keeper) ChangeParams(ctx sdk.Context, changes []proposal.ParamChange) error {
+	for _, c := range changes {
+		ss, ok := k.GetSubspace(c.Subspace)
+		if !ok {
+			return sdkerrors.Wrap(proposal.ErrUnknownSubspace, c.Subspace)
+		}
+
+		k.Logger(ctx).Info(
+			fmt.Sprintf("attempt to set new parameter value; key: %s, value: %s", c.Key, c.Value),
+		)
+
+		if err := ss.Update(ctx, []byte(c.Key), []byte(c.Value)); err != nil {
+			return sdkerrors.Wrapf(proposal.ErrSettingParameter, "key: %s, value: %s, err: %s", c.Key, c.Value, err.Error())
+		}
+	}
+
+	return nil
+}
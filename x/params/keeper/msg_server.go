@@ -0,0 +1,34 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/line/lfb-sdk/types"
+	sdkerrors "github.com/line/lfb-sdk/types/errors"
+	"github.com/line/lfb-sdk/x/params/types/proposal"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the params MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) proposal.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+var _ proposal.MsgServer = msgServer{}
+
+func (k msgServer) ChangeParams(goCtx context.Context, msg *proposal.MsgChangeParams) (*proposal.MsgChangeParamsResponse, error) {
+	if k.authority != msg.Authority {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "expected %s got %s", k.authority, msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.Keeper.ChangeParams(ctx, msg.Changes); err != nil {
+		return nil, err
+	}
+
+	return &proposal.MsgChangeParamsResponse{}, nil
+}
@@ -0,0 +1,44 @@
+package keeper_test
+
+import (
+	sdk "github.com/line/lfb-sdk/types"
+	authtypes "github.com/line/lfb-sdk/x/auth/types"
+	govtypes "github.com/line/lfb-sdk/x/gov/types"
+	"github.com/line/lfb-sdk/x/params/keeper"
+	"github.com/line/lfb-sdk/x/params/types/proposal"
+	stakingtypes "github.com/line/lfb-sdk/x/staking/types"
+)
+
+func (suite *KeeperTestSuite) TestMsgChangeParams() {
+	govAddr := authtypes.NewModuleAddress(govtypes.ModuleName)
+	suite.Require().Equal(govAddr.String(), suite.app.ParamsKeeper.GetAuthority())
+
+	msgServer := keeper.NewMsgServerImpl(suite.app.ParamsKeeper)
+	goCtx := sdk.WrapSDKContext(suite.ctx)
+	changes := []proposal.ParamChange{
+		proposal.NewParamChange(stakingtypes.ModuleName, string(stakingtypes.KeyMaxValidators), "7"),
+		proposal.NewParamChange(stakingtypes.ModuleName, string(stakingtypes.KeyMinCommissionRate), `"0.050000000000000000"`),
+	}
+
+	testCases := []struct {
+		name    string
+		req     *proposal.MsgChangeParams
+		expPass bool
+	}{
+		{"unauthorized authority", proposal.NewMsgChangeParams(sdk.AccAddress("other_______________"), changes), false},
+		{"unknown subspace", proposal.NewMsgChangeParams(govAddr, []proposal.ParamChange{proposal.NewParamChange("unknown", "key", "1")}), false},
+		{"invalid value", proposal.NewMsgChangeParams(govAddr, []proposal.ParamChange{proposal.NewParamChange(stakingtypes.ModuleName, string(stakingtypes.KeyMaxValidators), "-1")}), false},
+		{"params changed", proposal.NewMsgChangeParams(govAddr, changes), true},
+	}
+
+	for _, tc := range testCases {
+		_, err := msgServer.ChangeParams(goCtx, tc.req)
+		if tc.expPass {
+			suite.Require().NoError(err, tc.name)
+			suite.Require().Equal(uint32(7), suite.app.StakingKeeper.MaxValidators(suite.ctx))
+			suite.Require().Equal(sdk.NewDecWithPrec(5, 2), suite.app.StakingKeeper.MinCommissionRate(suite.ctx))
+		} else {
+			suite.Require().Error(err, tc.name)
+		}
+	}
+}
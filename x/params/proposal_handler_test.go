@@ -13,6 +13,8 @@ import (
 	"github.com/line/lfb-sdk/codec"
 	"github.com/line/lfb-sdk/store"
 	sdk "github.com/line/lfb-sdk/types"
+	authtypes "github.com/line/lfb-sdk/x/auth/types"
+	govtypes "github.com/line/lfb-sdk/x/gov/types"
 	"github.com/line/lfb-sdk/x/params"
 	"github.com/line/lfb-sdk/x/params/keeper"
 	"github.com/line/lfb-sdk/x/params/types"
@@ -75,7 +77,7 @@ func newTestInput(t *testing.T) testInput {
 	require.Nil(t, err)
 
 	encCfg := simapp.MakeTestEncodingConfig()
-	keeper := keeper.NewKeeper(encCfg.Marshaler, encCfg.Amino, keyParams)
+	keeper := keeper.NewKeeper(encCfg.Marshaler, encCfg.Amino, keyParams, authtypes.NewModuleAddress(govtypes.ModuleName).String())
 	ctx := sdk.NewContext(cms, ostproto.Header{}, false, log.NewNopLogger())
 
 	return testInput{ctx, cdc, keeper}
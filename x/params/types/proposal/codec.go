@@ -3,12 +3,15 @@ package proposal
 import (
 	"github.com/line/lfb-sdk/codec"
 	"github.com/line/lfb-sdk/codec/types"
+	sdk "github.com/line/lfb-sdk/types"
+	"github.com/line/lfb-sdk/types/msgservice"
 	govtypes "github.com/line/lfb-sdk/x/gov/types"
 )
 
 // RegisterLegacyAminoCodec registers all necessary param module types with a given LegacyAmino codec.
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&ParameterChangeProposal{}, "lfb-sdk/ParameterChangeProposal", nil)
+	cdc.RegisterConcrete(&MsgChangeParams{}, "lfb-sdk/MsgChangeParams", nil)
 }
 
 func RegisterInterfaces(registry types.InterfaceRegistry) {
@@ -16,4 +19,26 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		(*govtypes.Content)(nil),
 		&ParameterChangeProposal{},
 	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgChangeParams{},
+	)
+
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
+
+var (
+	amino = codec.NewLegacyAmino()
+
+	// ModuleCdc references the global x/params module codec. Note, the codec
+	// should ONLY be used in certain instances of tests and for JSON encoding as
+	// Amino is still used for that purpose.
+	ModuleCdc = codec.NewAminoCodec(amino)
+)
+
+func init() {
+	RegisterLegacyAminoCodec(amino)
+
+	// register the Msg on the gov codec as well so that proposals carrying it
+	// can be Amino JSON signed
+	govtypes.RegisterProposalTypeCodec(&MsgChangeParams{}, "lfb-sdk/MsgChangeParams")
 }
@@ -0,0 +1,45 @@
+package proposal
+
+import (
+	sdk "github.com/line/lfb-sdk/types"
+	sdkerrors "github.com/line/lfb-sdk/types/errors"
+)
+
+// params message types
+const (
+	TypeMsgChangeParams = "change_params"
+)
+
+var _ sdk.Msg = &MsgChangeParams{}
+
+// NewMsgChangeParams creates a new MsgChangeParams instance
+//nolint:interfacer
+func NewMsgChangeParams(authority sdk.AccAddress, changes []ParamChange) *MsgChangeParams {
+	return &MsgChangeParams{Authority: authority.String(), Changes: changes}
+}
+
+// Route implements Msg
+func (m MsgChangeParams) Route() string { return RouterKey }
+
+// Type implements Msg
+func (m MsgChangeParams) Type() string { return TypeMsgChangeParams }
+
+// ValidateBasic implements Msg
+func (m MsgChangeParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address: %s", err)
+	}
+	return ValidateChanges(m.Changes)
+}
+
+// GetSignBytes implements Msg
+func (m MsgChangeParams) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&m)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements Msg
+func (m MsgChangeParams) GetSigners() []sdk.AccAddress {
+	authority, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{authority}
+}
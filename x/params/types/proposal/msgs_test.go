@@ -0,0 +1,21 @@
+package proposal
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/line/lfb-sdk/types"
+)
+
+func TestMsgChangeParams(t *testing.T) {
+	authority := sdk.AccAddress("authority___________")
+	pc := NewParamChange("sub", "foo", "baz")
+
+	msg := NewMsgChangeParams(authority, []ParamChange{pc})
+	require.NoError(t, msg.ValidateBasic())
+	require.Equal(t, []sdk.AccAddress{authority}, msg.GetSigners())
+
+	require.Error(t, NewMsgChangeParams(authority, nil).ValidateBasic())
+	require.Error(t, (&MsgChangeParams{Changes: []ParamChange{pc}}).ValidateBasic())
+}
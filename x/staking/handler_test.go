@@ -416,6 +416,76 @@ func TestEditValidatorIncreaseMinSelfDelegationBeyondCurrentBond(t *testing.T) {
 	tstaking.Handle(msgEditValidator, false)
 }
 
+func TestCreateValidatorBelowParamFloors(t *testing.T) {
+	initPower := int64(100)
+	initBond := sdk.TokensFromConsensusPower(100)
+	app, ctx, _, valAddrs := bootstrapHandlerGenesisTest(t, initPower, 2, sdk.TokensFromConsensusPower(initPower))
+
+	params := app.StakingKeeper.GetParams(ctx)
+	params.MinCommissionRate = sdk.NewDecWithPrec(5, 2)
+	params.MinSelfDelegation = sdk.NewInt(10)
+	app.StakingKeeper.SetParams(ctx, params)
+
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+
+	// the commission rate is below the minimum
+	msgCreateValidator := tstaking.CreateValidatorMsg(valAddrs[0], PKs[0], initBond)
+	msgCreateValidator.MinSelfDelegation = sdk.NewInt(10)
+	tstaking.Handle(msgCreateValidator, false)
+
+	// the minimum self delegation is below the chain minimum
+	tstaking.Commission = types.NewCommissionRates(sdk.NewDecWithPrec(5, 2), sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(1, 2))
+	msgCreateValidator = tstaking.CreateValidatorMsg(valAddrs[0], PKs[0], initBond)
+	msgCreateValidator.MinSelfDelegation = sdk.NewInt(9)
+	tstaking.Handle(msgCreateValidator, false)
+
+	msgCreateValidator.MinSelfDelegation = sdk.NewInt(10)
+	tstaking.Handle(msgCreateValidator, true)
+}
+
+func TestEditValidatorBelowParamFloors(t *testing.T) {
+	initPower := int64(100)
+	initBond := sdk.TokensFromConsensusPower(100)
+	app, ctx, _, valAddrs := bootstrapHandlerGenesisTest(t, initPower, 1, sdk.TokensFromConsensusPower(initPower))
+
+	validatorAddr := valAddrs[0]
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+	tstaking.Commission = types.NewCommissionRates(sdk.NewDecWithPrec(1, 1), sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(1, 1))
+
+	// create validator
+	msgCreateValidator := tstaking.CreateValidatorMsg(validatorAddr, PKs[0], initBond)
+	msgCreateValidator.MinSelfDelegation = sdk.NewInt(2)
+	tstaking.Handle(msgCreateValidator, true)
+
+	// raise the floors above the validator values
+	params := app.StakingKeeper.GetParams(ctx)
+	params.MinCommissionRate = sdk.NewDecWithPrec(15, 2)
+	params.MinSelfDelegation = sdk.NewInt(10)
+	app.StakingKeeper.SetParams(ctx, params)
+
+	// commission changes are allowed once a day
+	ctx = ctx.WithBlockTime(ctx.BlockHeader().Time.Add(48 * time.Hour))
+	tstaking.Ctx = ctx
+
+	newRate := sdk.NewDecWithPrec(12, 2)
+	msgEditValidator := types.NewMsgEditValidator(validatorAddr, types.Description{}, &newRate, nil)
+	tstaking.Handle(msgEditValidator, false)
+
+	newMinSelfDelegation := sdk.NewInt(5)
+	msgEditValidator = types.NewMsgEditValidator(validatorAddr, types.Description{}, nil, &newMinSelfDelegation)
+	tstaking.Handle(msgEditValidator, false)
+
+	newRate = sdk.NewDecWithPrec(15, 2)
+	newMinSelfDelegation = sdk.NewInt(10)
+	msgEditValidator = types.NewMsgEditValidator(validatorAddr, types.Description{}, &newRate, &newMinSelfDelegation)
+	tstaking.Handle(msgEditValidator, true)
+
+	validator, found := app.StakingKeeper.GetValidator(ctx, validatorAddr)
+	require.True(t, found)
+	require.Equal(t, newRate, validator.Commission.Rate)
+	require.Equal(t, newMinSelfDelegation, validator.MinSelfDelegation)
+}
+
 func TestIncrementsMsgUnbond(t *testing.T) {
 	initPower := int64(1000)
 
@@ -800,6 +800,63 @@ func (k Keeper) CompleteUnbonding(ctx sdk.Context, delAddr sdk.AccAddress, valAd
 	return balances, nil
 }
 
+// CancelUnbondingDelegation cancels the given amount of the unbonding delegation
+// entry created at creationHeight and delegates it back to the validator. The
+// tokens of the entry are still held by the not bonded pool, so they are moved
+// back to the validator without going through the delegator account.
+func (k Keeper) CancelUnbondingDelegation(
+	ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, creationHeight int64, amount sdk.Int,
+) error {
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return types.ErrNoValidatorFound
+	}
+
+	ubd, found := k.GetUnbondingDelegation(ctx, delAddr, valAddr)
+	if !found {
+		return types.ErrNoUnbondingDelegation
+	}
+
+	entryIndex := -1
+	for i, entry := range ubd.Entries {
+		if entry.CreationHeight == creationHeight && !entry.IsMature(ctx.BlockHeader().Time) {
+			entryIndex = i
+			break
+		}
+	}
+
+	if entryIndex == -1 {
+		return sdkerrors.Wrapf(types.ErrNoUnbondingDelegationEntry, "creation height %d", creationHeight)
+	}
+
+	entry := ubd.Entries[entryIndex]
+	if amount.GT(entry.Balance) {
+		return types.ErrBadCancelUnbondingAmount
+	}
+
+	// delegate back the unbonding tokens, which are still in the not bonded pool
+	if _, err := k.Delegate(ctx, delAddr, amount, types.Unbonding, validator, false); err != nil {
+		return err
+	}
+
+	if amount.Equal(entry.Balance) {
+		ubd.RemoveEntry(int64(entryIndex))
+	} else {
+		entry.Balance = entry.Balance.Sub(amount)
+		entry.InitialBalance = entry.InitialBalance.Sub(amount)
+		ubd.Entries[entryIndex] = entry
+	}
+
+	// set the unbonding delegation or remove it if there are no more entries
+	if len(ubd.Entries) == 0 {
+		k.RemoveUnbondingDelegation(ctx, ubd)
+	} else {
+		k.SetUnbondingDelegation(ctx, ubd)
+	}
+
+	return nil
+}
+
 // begin unbonding / redelegation; create a redelegation record
 func (k Keeper) BeginRedelegation(
 	ctx sdk.Context, delAddr sdk.AccAddress, valSrcAddr, valDstAddr sdk.ValAddress, sharesAmount sdk.Dec,
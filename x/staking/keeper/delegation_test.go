@@ -1017,3 +1017,77 @@ func TestRedelegateFromUnbondedValidator(t *testing.T) {
 	red, found := app.StakingKeeper.GetRedelegation(ctx, addrDels[0], addrVals[0], addrVals[1])
 	require.False(t, found, "%v", red)
 }
+
+func TestCancelUnbondingDelegation(t *testing.T) {
+	_, app, ctx := createTestInput()
+
+	addrDels := simapp.AddTestAddrsIncremental(app, ctx, 2, sdk.TokensFromConsensusPower(100))
+	addrVals := simapp.ConvertAddrsToValAddrs(addrDels)
+	delTokens := sdk.TokensFromConsensusPower(10)
+
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+	tstaking.CreateValidatorWithValPower(addrVals[0], PKs[0], 10, true)
+	tstaking.Delegate(addrDels[1], addrVals[0], delTokens)
+	applyValidatorSetUpdates(t, ctx, app.StakingKeeper, 1)
+
+	bondedPool := app.StakingKeeper.GetBondedPool(ctx)
+	notBondedPool := app.StakingKeeper.GetNotBondedPool(ctx)
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+	oldBonded := app.BankKeeper.GetBalance(ctx, bondedPool.GetAddress(), bondDenom).Amount
+	oldNotBonded := app.BankKeeper.GetBalance(ctx, notBondedPool.GetAddress(), bondDenom).Amount
+
+	ctx = ctx.WithBlockHeight(10)
+	unbondTokens := sdk.TokensFromConsensusPower(4)
+	_, err := app.StakingKeeper.Undelegate(ctx, addrDels[1], addrVals[0], unbondTokens.ToDec())
+	require.NoError(t, err)
+	require.Equal(t, oldNotBonded.Add(unbondTokens), app.BankKeeper.GetBalance(ctx, notBondedPool.GetAddress(), bondDenom).Amount)
+
+	ctx = ctx.WithBlockHeight(11)
+
+	// the entry must exist at the creation height
+	err = app.StakingKeeper.CancelUnbondingDelegation(ctx, addrDels[1], addrVals[0], 9, unbondTokens)
+	require.ErrorIs(t, err, types.ErrNoUnbondingDelegationEntry)
+
+	// the amount cannot exceed the entry balance
+	err = app.StakingKeeper.CancelUnbondingDelegation(ctx, addrDels[1], addrVals[0], 10, unbondTokens.AddRaw(1))
+	require.ErrorIs(t, err, types.ErrBadCancelUnbondingAmount)
+
+	// there is no unbonding delegation to another validator
+	err = app.StakingKeeper.CancelUnbondingDelegation(ctx, addrDels[1], addrVals[1], 10, unbondTokens)
+	require.Error(t, err)
+
+	// cancel a part of the entry
+	cancelTokens := sdk.TokensFromConsensusPower(1)
+	require.NoError(t, app.StakingKeeper.CancelUnbondingDelegation(ctx, addrDels[1], addrVals[0], 10, cancelTokens))
+
+	ubd, found := app.StakingKeeper.GetUnbondingDelegation(ctx, addrDels[1], addrVals[0])
+	require.True(t, found)
+	require.Len(t, ubd.Entries, 1)
+	require.Equal(t, unbondTokens.Sub(cancelTokens), ubd.Entries[0].Balance)
+	require.Equal(t, unbondTokens.Sub(cancelTokens), ubd.Entries[0].InitialBalance)
+
+	delegation, found := app.StakingKeeper.GetDelegation(ctx, addrDels[1], addrVals[0])
+	require.True(t, found)
+	require.Equal(t, delTokens.Sub(unbondTokens).Add(cancelTokens).ToDec(), delegation.Shares)
+
+	// cancel the rest of the entry which removes the unbonding delegation
+	require.NoError(t, app.StakingKeeper.CancelUnbondingDelegation(ctx, addrDels[1], addrVals[0], 10, unbondTokens.Sub(cancelTokens)))
+
+	_, found = app.StakingKeeper.GetUnbondingDelegation(ctx, addrDels[1], addrVals[0])
+	require.False(t, found)
+
+	delegation, found = app.StakingKeeper.GetDelegation(ctx, addrDels[1], addrVals[0])
+	require.True(t, found)
+	require.Equal(t, delTokens.ToDec(), delegation.Shares)
+
+	// the tokens moved back to the bonded pool
+	require.Equal(t, oldBonded, app.BankKeeper.GetBalance(ctx, bondedPool.GetAddress(), bondDenom).Amount)
+	require.Equal(t, oldNotBonded, app.BankKeeper.GetBalance(ctx, notBondedPool.GetAddress(), bondDenom).Amount)
+
+	// a mature entry cannot be canceled
+	_, err = app.StakingKeeper.Undelegate(ctx, addrDels[1], addrVals[0], unbondTokens.ToDec())
+	require.NoError(t, err)
+	ctx = ctx.WithBlockTime(ctx.BlockHeader().Time.Add(app.StakingKeeper.UnbondingTime(ctx)))
+	err = app.StakingKeeper.CancelUnbondingDelegation(ctx, addrDels[1], addrVals[0], 11, unbondTokens)
+	require.ErrorIs(t, err, types.ErrNoUnbondingDelegationEntry)
+}
@@ -0,0 +1,457 @@
+package keeper
+
+import (
+	sdk "github.com/line/lfb-sdk/types"
+	sdkerrors "github.com/line/lfb-sdk/types/errors"
+	authtypes "github.com/line/lfb-sdk/x/auth/types"
+	"github.com/line/lfb-sdk/x/staking/types"
+)
+
+// GetLastTokenizeShareRecordID returns the id of the last created tokenize
+// share record.
+func (k Keeper) GetLastTokenizeShareRecordID(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.LastTokenizeShareRecordIDKey)
+	if bz == nil {
+		return 0
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetLastTokenizeShareRecordID sets the id of the last created tokenize share
+// record.
+func (k Keeper) SetLastTokenizeShareRecordID(ctx sdk.Context, id uint64) {
+	ctx.KVStore(k.storeKey).Set(types.LastTokenizeShareRecordIDKey, sdk.Uint64ToBigEndian(id))
+}
+
+// GetTokenizeShareRecord returns the tokenize share record with the given id.
+func (k Keeper) GetTokenizeShareRecord(ctx sdk.Context, id uint64) (types.TokenizeShareRecord, error) {
+	var record types.TokenizeShareRecord
+
+	bz := ctx.KVStore(k.storeKey).Get(types.GetTokenizeShareRecordByIndexKey(id))
+	if bz == nil {
+		return record, sdkerrors.Wrapf(types.ErrTokenizeShareRecordNotExists, "id %d", id)
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(bz, &record)
+
+	return record, nil
+}
+
+// GetTokenizeShareRecordByDenom returns the tokenize share record backing the
+// given share token denom.
+func (k Keeper) GetTokenizeShareRecordByDenom(ctx sdk.Context, denom string) (types.TokenizeShareRecord, error) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetTokenizeShareRecordIDByDenomKey(denom))
+	if bz == nil {
+		return types.TokenizeShareRecord{}, sdkerrors.Wrapf(types.ErrTokenizeShareRecordNotExists, "denom %s", denom)
+	}
+
+	return k.GetTokenizeShareRecord(ctx, sdk.BigEndianToUint64(bz))
+}
+
+// GetTokenizeShareRecordsByOwner returns the tokenize share records owned by
+// the given address.
+func (k Keeper) GetTokenizeShareRecordsByOwner(ctx sdk.Context, owner sdk.AccAddress) (records []types.TokenizeShareRecord) {
+	store := ctx.KVStore(k.storeKey)
+	prefix := types.GetTokenizeShareRecordIdsByOwnerPrefix(owner)
+
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		record, err := k.GetTokenizeShareRecord(ctx, sdk.BigEndianToUint64(iterator.Key()[len(prefix):]))
+		if err != nil {
+			panic(err)
+		}
+
+		records = append(records, record)
+	}
+
+	return records
+}
+
+// GetAllTokenizeShareRecords returns all tokenize share records.
+func (k Keeper) GetAllTokenizeShareRecords(ctx sdk.Context) (records []types.TokenizeShareRecord) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.TokenizeShareRecordPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.TokenizeShareRecord
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &record)
+
+		records = append(records, record)
+	}
+
+	return records
+}
+
+// SetTokenizeShareRecord stores a tokenize share record together with its
+// owner and denom indexes.
+func (k Keeper) SetTokenizeShareRecord(ctx sdk.Context, record types.TokenizeShareRecord) {
+	store := ctx.KVStore(k.storeKey)
+
+	store.Set(types.GetTokenizeShareRecordByIndexKey(record.Id), k.cdc.MustMarshalBinaryBare(&record))
+	store.Set(types.GetTokenizeShareRecordIDByOwnerAndIDKey(record.GetOwnerAddr(), record.Id), []byte{})
+	store.Set(types.GetTokenizeShareRecordIDByDenomKey(record.GetShareTokenDenom()), sdk.Uint64ToBigEndian(record.Id))
+}
+
+// DeleteTokenizeShareRecord removes a tokenize share record together with its
+// owner and denom indexes.
+func (k Keeper) DeleteTokenizeShareRecord(ctx sdk.Context, id uint64) error {
+	record, err := k.GetTokenizeShareRecord(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetTokenizeShareRecordByIndexKey(record.Id))
+	store.Delete(types.GetTokenizeShareRecordIDByOwnerAndIDKey(record.GetOwnerAddr(), record.Id))
+	store.Delete(types.GetTokenizeShareRecordIDByDenomKey(record.GetShareTokenDenom()))
+
+	return nil
+}
+
+// GetValidatorLiquidShares returns the delegator shares of a validator that
+// have been converted into tokenized shares.
+func (k Keeper) GetValidatorLiquidShares(ctx sdk.Context, valAddr sdk.ValAddress) sdk.Dec {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetValidatorLiquidSharesKey(valAddr))
+	if bz == nil {
+		return sdk.ZeroDec()
+	}
+
+	dp := sdk.DecProto{}
+	k.cdc.MustUnmarshalBinaryBare(bz, &dp)
+
+	return dp.Dec
+}
+
+// SetValidatorLiquidShares sets the tokenized delegator shares of a validator.
+func (k Keeper) SetValidatorLiquidShares(ctx sdk.Context, valAddr sdk.ValAddress, shares sdk.Dec) {
+	store := ctx.KVStore(k.storeKey)
+	if !shares.IsPositive() {
+		store.Delete(types.GetValidatorLiquidSharesKey(valAddr))
+		return
+	}
+
+	store.Set(types.GetValidatorLiquidSharesKey(valAddr), k.cdc.MustMarshalBinaryBare(&sdk.DecProto{Dec: shares}))
+}
+
+// IterateValidatorLiquidShares iterates through the tokenized delegator shares
+// of all validators.
+func (k Keeper) IterateValidatorLiquidShares(ctx sdk.Context, cb func(valAddr sdk.ValAddress, shares sdk.Dec) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.ValidatorLiquidSharesPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		dp := sdk.DecProto{}
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &dp)
+
+		if cb(sdk.ValAddress(iterator.Key()[len(types.ValidatorLiquidSharesPrefix):]), dp.Dec) {
+			break
+		}
+	}
+}
+
+// GetTotalLiquidStakedTokens returns the amount of tokens backing all
+// tokenized shares.
+func (k Keeper) GetTotalLiquidStakedTokens(ctx sdk.Context) sdk.Int {
+	total := sdk.ZeroInt()
+
+	k.IterateValidatorLiquidShares(ctx, func(valAddr sdk.ValAddress, shares sdk.Dec) bool {
+		validator, found := k.GetValidator(ctx, valAddr)
+		if found {
+			total = total.Add(validator.TokensFromShares(shares).TruncateInt())
+		}
+		return false
+	})
+
+	return total
+}
+
+// checkLiquidStakingCaps returns an error if tokenizing the given tokens and
+// shares of a validator would exceed the global or validator liquid staking
+// caps. A cap of one leaves liquid staking unrestricted.
+func (k Keeper) checkLiquidStakingCaps(ctx sdk.Context, validator types.Validator, tokens sdk.Int, shares sdk.Dec) error {
+	if globalCap := k.GlobalLiquidStakingCap(ctx); globalCap.LT(sdk.OneDec()) {
+		totalBonded := k.TotalBondedTokens(ctx)
+		totalLiquid := k.GetTotalLiquidStakedTokens(ctx).Add(tokens)
+
+		if !totalBonded.IsPositive() || totalLiquid.ToDec().QuoInt(totalBonded).GT(globalCap) {
+			return types.ErrGlobalLiquidStakingCapExceeded
+		}
+	}
+
+	if validatorCap := k.ValidatorLiquidStakingCap(ctx); validatorCap.LT(sdk.OneDec()) {
+		liquidShares := k.GetValidatorLiquidShares(ctx, validator.GetOperator()).Add(shares)
+
+		if !validator.DelegatorShares.IsPositive() || liquidShares.Quo(validator.DelegatorShares).GT(validatorCap) {
+			return types.ErrValidatorLiquidStakingCapExceeded
+		}
+	}
+
+	return nil
+}
+
+// moveDelegationShares unbonds shares of the delegation from one address to a
+// validator and delegates the resulting tokens from another address without
+// going through the unbonding period. The tokens pass through the account
+// balances so that vesting accounts cannot move locked delegations.
+func (k Keeper) moveDelegationShares(
+	ctx sdk.Context, from, to sdk.AccAddress, valAddr sdk.ValAddress, shares sdk.Dec,
+) (tokens sdk.Int, newShares sdk.Dec, err error) {
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return tokens, newShares, types.ErrNoValidatorFound
+	}
+
+	tokens, err = k.Unbond(ctx, from, valAddr, shares)
+	if err != nil {
+		return tokens, newShares, err
+	}
+
+	if !tokens.IsPositive() {
+		return tokens, newShares, types.ErrTinyRedelegationAmount
+	}
+
+	// transfer the validator tokens to the not bonded pool
+	if validator.IsBonded() {
+		k.bondedTokensToNotBonded(ctx, tokens)
+	}
+
+	coins := sdk.NewCoins(sdk.NewCoin(k.BondDenom(ctx), tokens))
+	if err := k.bankKeeper.UndelegateCoinsFromModuleToAccount(ctx, types.NotBondedPoolName, from, coins); err != nil {
+		return tokens, newShares, err
+	}
+
+	if err := k.bankKeeper.SendCoins(ctx, from, to, coins); err != nil {
+		return tokens, newShares, err
+	}
+
+	validator, found = k.GetValidator(ctx, valAddr)
+	if !found {
+		return tokens, newShares, types.ErrNoValidatorFound
+	}
+
+	newShares, err = k.Delegate(ctx, to, tokens, types.Unbonded, validator, true)
+	if err != nil {
+		return tokens, newShares, err
+	}
+
+	return tokens, newShares, nil
+}
+
+// createTokenizeShareRecordAccount creates the module account holding the
+// delegation of a new tokenize share record and returns the record id.
+func (k Keeper) createTokenizeShareRecordAccount(ctx sdk.Context) uint64 {
+	for {
+		id := k.GetLastTokenizeShareRecordID(ctx) + 1
+		k.SetLastTokenizeShareRecordID(ctx, id)
+
+		address := types.GetTokenizeShareRecordModuleAddress(id)
+		if k.authKeeper.GetAccount(ctx, address) != nil {
+			continue
+		}
+
+		account := k.authKeeper.NewAccount(ctx, authtypes.NewModuleAccount(
+			authtypes.NewBaseAccountWithAddress(address), types.GetTokenizeShareRecordModuleAccountName(id),
+		))
+		k.authKeeper.SetAccount(ctx, account)
+
+		return id
+	}
+}
+
+// TokenizeShares converts amount of the delegation from delAddr to valAddr
+// into share tokens sent to the delegator. The delegation is moved to the
+// account of a new tokenize share record owned by owner, which is entitled to
+// the rewards it accrues.
+func (k Keeper) TokenizeShares(
+	ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, amount sdk.Coin, owner sdk.AccAddress,
+) (sdk.Coin, error) {
+	bondDenom := k.BondDenom(ctx)
+	if amount.Denom != bondDenom {
+		return sdk.Coin{}, sdkerrors.Wrapf(types.ErrBadDenom, "got %s, expected %s", amount.Denom, bondDenom)
+	}
+
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return sdk.Coin{}, types.ErrNoValidatorFound
+	}
+
+	// shares received through a redelegation are still subject to slashing of
+	// the source validator, so they cannot leave the delegator
+	if k.HasReceivingRedelegation(ctx, delAddr, valAddr) {
+		return sdk.Coin{}, types.ErrRedelegationInProgress
+	}
+
+	shares, err := k.ValidateUnbondAmount(ctx, delAddr, valAddr, amount.Amount)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if err := k.checkLiquidStakingCaps(ctx, validator, amount.Amount, shares); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	record := types.NewTokenizeShareRecord(k.createTokenizeShareRecordAccount(ctx), owner, valAddr)
+
+	tokens, newShares, err := k.moveDelegationShares(ctx, delAddr, record.GetModuleAddress(), valAddr, shares)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	k.SetValidatorLiquidShares(ctx, valAddr, k.GetValidatorLiquidShares(ctx, valAddr).Add(newShares))
+	k.SetTokenizeShareRecord(ctx, record)
+
+	shareToken := sdk.NewCoin(record.GetShareTokenDenom(), tokens)
+	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(shareToken)); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, delAddr, sdk.NewCoins(shareToken)); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	return shareToken, nil
+}
+
+// RedeemTokensForShares burns share tokens held by delAddr and moves the
+// corresponding part of the record delegation back to the delegator. Once the
+// record delegation is fully redeemed, the rewards left on the record account
+// are sent to the record owner and the record is removed.
+func (k Keeper) RedeemTokensForShares(ctx sdk.Context, delAddr sdk.AccAddress, shareToken sdk.Coin) (sdk.Coin, error) {
+	record, err := k.GetTokenizeShareRecordByDenom(ctx, shareToken.Denom)
+	if err != nil {
+		return sdk.Coin{}, sdkerrors.Wrap(types.ErrInvalidShareToken, err.Error())
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(record.Validator)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	recordAddr := record.GetModuleAddress()
+
+	delegation, found := k.GetDelegation(ctx, recordAddr, valAddr)
+	if !found {
+		return sdk.Coin{}, types.ErrNoDelegation
+	}
+
+	supply := k.bankKeeper.GetSupply(ctx).GetTotal().AmountOf(shareToken.Denom)
+	if shareToken.Amount.GT(supply) {
+		return sdk.Coin{}, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFunds, "%s is greater than the supply %s", shareToken.Amount, supply)
+	}
+
+	shares := delegation.Shares
+	if shareToken.Amount.LT(supply) {
+		shares = shares.MulInt(shareToken.Amount).QuoInt(supply)
+	}
+
+	coins := sdk.NewCoins(shareToken)
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, delAddr, types.ModuleName, coins); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, coins); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	tokens, _, err := k.moveDelegationShares(ctx, recordAddr, delAddr, valAddr, shares)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	liquidShares := k.GetValidatorLiquidShares(ctx, valAddr).Sub(shares)
+	k.SetValidatorLiquidShares(ctx, valAddr, sdk.MaxDec(liquidShares, sdk.ZeroDec()))
+
+	if _, found := k.GetDelegation(ctx, recordAddr, valAddr); !found {
+		if rewards := k.bankKeeper.GetAllBalances(ctx, recordAddr); !rewards.IsZero() {
+			if err := k.bankKeeper.SendCoins(ctx, recordAddr, record.GetOwnerAddr(), rewards); err != nil {
+				return sdk.Coin{}, err
+			}
+		}
+
+		if err := k.DeleteTokenizeShareRecord(ctx, record.Id); err != nil {
+			return sdk.Coin{}, err
+		}
+	}
+
+	return sdk.NewCoin(k.BondDenom(ctx), tokens), nil
+}
+
+// TransferTokenizeShareRecord transfers the ownership of a tokenize share
+// record, and with it the rights to its rewards, from sender to newOwner.
+func (k Keeper) TransferTokenizeShareRecord(ctx sdk.Context, id uint64, sender, newOwner sdk.AccAddress) error {
+	record, err := k.GetTokenizeShareRecord(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if !record.GetOwnerAddr().Equals(sender) {
+		return types.ErrNotTokenizeShareRecordOwner
+	}
+
+	ctx.KVStore(k.storeKey).Delete(types.GetTokenizeShareRecordIDByOwnerAndIDKey(sender, id))
+
+	record.Owner = newOwner.String()
+	k.SetTokenizeShareRecord(ctx, record)
+
+	return nil
+}
+
+// TransferDelegation moves amount of the delegation from delAddr to valAddr
+// to the receiver without going through the unbonding period.
+func (k Keeper) TransferDelegation(
+	ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, receiver sdk.AccAddress, amount sdk.Coin,
+) (sdk.Dec, error) {
+	bondDenom := k.BondDenom(ctx)
+	if amount.Denom != bondDenom {
+		return sdk.Dec{}, sdkerrors.Wrapf(types.ErrBadDenom, "got %s, expected %s", amount.Denom, bondDenom)
+	}
+
+	if delAddr.Equals(receiver) {
+		return sdk.Dec{}, types.ErrSelfDelegationTransfer
+	}
+
+	// shares received through a redelegation are still subject to slashing of
+	// the source validator, so they cannot leave the delegator
+	if k.HasReceivingRedelegation(ctx, delAddr, valAddr) {
+		return sdk.Dec{}, types.ErrRedelegationInProgress
+	}
+
+	shares, err := k.ValidateUnbondAmount(ctx, delAddr, valAddr, amount.Amount)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+
+	_, newShares, err := k.moveDelegationShares(ctx, delAddr, receiver, valAddr, shares)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+
+	return newShares, nil
+}
+
+// InitTokenizeShareRecords sets the tokenize share records of the genesis
+// state and rebuilds the liquid shares of their validators from the record
+// delegations, which must already be set.
+func (k Keeper) InitTokenizeShareRecords(ctx sdk.Context, records []types.TokenizeShareRecord, lastID uint64) {
+	for _, record := range records {
+		k.SetTokenizeShareRecord(ctx, record)
+
+		valAddr, err := sdk.ValAddressFromBech32(record.Validator)
+		if err != nil {
+			panic(err)
+		}
+
+		if delegation, found := k.GetDelegation(ctx, record.GetModuleAddress(), valAddr); found {
+			k.SetValidatorLiquidShares(ctx, valAddr, k.GetValidatorLiquidShares(ctx, valAddr).Add(delegation.Shares))
+		}
+	}
+
+	k.SetLastTokenizeShareRecordID(ctx, lastID)
+}
@@ -0,0 +1,252 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	ostproto "github.com/line/ostracon/proto/ostracon/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/line/lfb-sdk/simapp"
+	sdk "github.com/line/lfb-sdk/types"
+	"github.com/line/lfb-sdk/x/staking"
+	"github.com/line/lfb-sdk/x/staking/keeper"
+	"github.com/line/lfb-sdk/x/staking/teststaking"
+	"github.com/line/lfb-sdk/x/staking/types"
+)
+
+// setupLiquidStaking returns an app with a bonded validator owned by addrs[0]
+// and a delegation of delTokens from addrs[1] to it.
+func setupLiquidStaking(t *testing.T) (*simapp.SimApp, sdk.Context, []sdk.AccAddress, sdk.ValAddress, sdk.Int) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, ostproto.Header{Time: time.Now()})
+
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 3, sdk.TokensFromConsensusPower(100))
+	valAddr := sdk.ValAddress(addrs[0])
+
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+	tstaking.CreateValidatorWithValPower(valAddr, PKs[0], 10, true)
+
+	delTokens := sdk.TokensFromConsensusPower(10)
+	tstaking.Delegate(addrs[1], valAddr, delTokens)
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	validator, found := app.StakingKeeper.GetValidator(ctx, valAddr)
+	require.True(t, found)
+	require.True(t, validator.IsBonded())
+
+	return app, ctx, addrs, valAddr, delTokens
+}
+
+func TestTokenizeSharesAndRedeemTokens(t *testing.T) {
+	app, ctx, addrs, valAddr, delTokens := setupLiquidStaking(t)
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+	bondedPool := app.StakingKeeper.GetBondedPool(ctx)
+	bondedTokens := app.BankKeeper.GetBalance(ctx, bondedPool.GetAddress(), bondDenom)
+
+	tokenizeAmount := delTokens.QuoRaw(2)
+	shareToken, err := app.StakingKeeper.TokenizeShares(ctx, addrs[1], valAddr, sdk.NewCoin(bondDenom, tokenizeAmount), addrs[2])
+	require.NoError(t, err)
+	require.Equal(t, tokenizeAmount, shareToken.Amount)
+
+	// the tokens stay bonded and the share tokens go to the delegator
+	require.Equal(t, bondedTokens, app.BankKeeper.GetBalance(ctx, bondedPool.GetAddress(), bondDenom))
+	require.Equal(t, shareToken, app.BankKeeper.GetBalance(ctx, addrs[1], shareToken.Denom))
+
+	record, err := app.StakingKeeper.GetTokenizeShareRecordByDenom(ctx, shareToken.Denom)
+	require.NoError(t, err)
+	require.Equal(t, types.NewTokenizeShareRecord(1, addrs[2], valAddr), record)
+	require.Equal(t, []types.TokenizeShareRecord{record}, app.StakingKeeper.GetTokenizeShareRecordsByOwner(ctx, addrs[2]))
+
+	delegation, found := app.StakingKeeper.GetDelegation(ctx, addrs[1], valAddr)
+	require.True(t, found)
+	require.Equal(t, tokenizeAmount.ToDec(), delegation.Shares)
+
+	recordDelegation, found := app.StakingKeeper.GetDelegation(ctx, record.GetModuleAddress(), valAddr)
+	require.True(t, found)
+	require.Equal(t, tokenizeAmount.ToDec(), recordDelegation.Shares)
+	require.Equal(t, tokenizeAmount.ToDec(), app.StakingKeeper.GetValidatorLiquidShares(ctx, valAddr))
+	require.Equal(t, tokenizeAmount, app.StakingKeeper.GetTotalLiquidStakedTokens(ctx))
+
+	// share tokens are transferable and redeemable by any holder
+	half := sdk.NewCoin(shareToken.Denom, shareToken.Amount.QuoRaw(2))
+	require.NoError(t, app.BankKeeper.SendCoins(ctx, addrs[1], addrs[2], sdk.NewCoins(half)))
+
+	redeemed, err := app.StakingKeeper.RedeemTokensForShares(ctx, addrs[2], half)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoin(bondDenom, half.Amount), redeemed)
+
+	delegation, found = app.StakingKeeper.GetDelegation(ctx, addrs[2], valAddr)
+	require.True(t, found)
+	require.Equal(t, half.Amount.ToDec(), delegation.Shares)
+	require.Equal(t, half.Amount.ToDec(), app.StakingKeeper.GetValidatorLiquidShares(ctx, valAddr))
+
+	_, err = app.StakingKeeper.GetTokenizeShareRecord(ctx, record.Id)
+	require.NoError(t, err)
+
+	// redeeming the remaining tokens removes the record
+	_, err = app.StakingKeeper.RedeemTokensForShares(ctx, addrs[1], half)
+	require.NoError(t, err)
+
+	delegation, found = app.StakingKeeper.GetDelegation(ctx, addrs[1], valAddr)
+	require.True(t, found)
+	require.Equal(t, delTokens.Sub(half.Amount).ToDec(), delegation.Shares)
+
+	_, err = app.StakingKeeper.GetTokenizeShareRecord(ctx, record.Id)
+	require.ErrorIs(t, err, types.ErrTokenizeShareRecordNotExists)
+	require.Empty(t, app.StakingKeeper.GetTokenizeShareRecordsByOwner(ctx, addrs[2]))
+	require.True(t, app.StakingKeeper.GetValidatorLiquidShares(ctx, valAddr).IsZero())
+	require.True(t, app.BankKeeper.GetSupply(ctx).GetTotal().AmountOf(shareToken.Denom).IsZero())
+	require.Equal(t, bondedTokens, app.BankKeeper.GetBalance(ctx, bondedPool.GetAddress(), bondDenom))
+
+	// the share tokens of a removed record cannot be redeemed
+	_, err = app.StakingKeeper.RedeemTokensForShares(ctx, addrs[1], half)
+	require.ErrorIs(t, err, types.ErrInvalidShareToken)
+}
+
+func TestTokenizeSharesFailures(t *testing.T) {
+	app, ctx, addrs, valAddr, delTokens := setupLiquidStaking(t)
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+
+	_, err := app.StakingKeeper.TokenizeShares(ctx, addrs[1], valAddr, sdk.NewCoin("foo", delTokens), addrs[1])
+	require.ErrorIs(t, err, types.ErrBadDenom)
+
+	_, err = app.StakingKeeper.TokenizeShares(ctx, addrs[2], valAddr, sdk.NewCoin(bondDenom, delTokens), addrs[2])
+	require.ErrorIs(t, err, types.ErrNoDelegation)
+
+	_, err = app.StakingKeeper.TokenizeShares(ctx, addrs[1], valAddr, sdk.NewCoin(bondDenom, delTokens.AddRaw(1)), addrs[1])
+	require.ErrorIs(t, err, types.ErrBadSharesAmount)
+
+	// shares received through a redelegation cannot be tokenized
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+	valAddr2 := sdk.ValAddress(addrs[2])
+	tstaking.CreateValidatorWithValPower(valAddr2, PKs[1], 10, true)
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	redelegateAmount := sdk.NewCoin(bondDenom, delTokens.QuoRaw(2))
+	tstaking.Handle(types.NewMsgBeginRedelegate(addrs[1], valAddr, valAddr2, redelegateAmount), true)
+
+	_, err = app.StakingKeeper.TokenizeShares(ctx, addrs[1], valAddr2, redelegateAmount, addrs[1])
+	require.ErrorIs(t, err, types.ErrRedelegationInProgress)
+}
+
+func TestTokenizeSharesCaps(t *testing.T) {
+	app, ctx, addrs, valAddr, delTokens := setupLiquidStaking(t)
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+
+	// the validator holds 20 power of which 5 would be tokenized
+	amount := sdk.NewCoin(bondDenom, delTokens.QuoRaw(2))
+
+	params := app.StakingKeeper.GetParams(ctx)
+	params.ValidatorLiquidStakingCap = sdk.NewDecWithPrec(2, 1)
+	app.StakingKeeper.SetParams(ctx, params)
+
+	_, err := app.StakingKeeper.TokenizeShares(ctx, addrs[1], valAddr, amount, addrs[1])
+	require.ErrorIs(t, err, types.ErrValidatorLiquidStakingCapExceeded)
+
+	params.ValidatorLiquidStakingCap = sdk.OneDec()
+	params.GlobalLiquidStakingCap = sdk.NewDecWithPrec(2, 1)
+	app.StakingKeeper.SetParams(ctx, params)
+
+	_, err = app.StakingKeeper.TokenizeShares(ctx, addrs[1], valAddr, amount, addrs[1])
+	require.ErrorIs(t, err, types.ErrGlobalLiquidStakingCapExceeded)
+
+	params.ValidatorLiquidStakingCap = sdk.NewDecWithPrec(25, 2)
+	params.GlobalLiquidStakingCap = sdk.NewDecWithPrec(25, 2)
+	app.StakingKeeper.SetParams(ctx, params)
+
+	_, err = app.StakingKeeper.TokenizeShares(ctx, addrs[1], valAddr, amount, addrs[1])
+	require.NoError(t, err)
+
+	// the already tokenized shares count towards the caps
+	_, err = app.StakingKeeper.TokenizeShares(ctx, addrs[1], valAddr, sdk.NewCoin(bondDenom, sdk.TokensFromConsensusPower(1)), addrs[1])
+	require.ErrorIs(t, err, types.ErrGlobalLiquidStakingCapExceeded)
+}
+
+func TestTransferTokenizeShareRecord(t *testing.T) {
+	app, ctx, addrs, valAddr, delTokens := setupLiquidStaking(t)
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+
+	_, err := app.StakingKeeper.TokenizeShares(ctx, addrs[1], valAddr, sdk.NewCoin(bondDenom, delTokens), addrs[1])
+	require.NoError(t, err)
+
+	require.ErrorIs(t, app.StakingKeeper.TransferTokenizeShareRecord(ctx, 1, addrs[2], addrs[2]), types.ErrNotTokenizeShareRecordOwner)
+	require.ErrorIs(t, app.StakingKeeper.TransferTokenizeShareRecord(ctx, 2, addrs[1], addrs[2]), types.ErrTokenizeShareRecordNotExists)
+
+	require.NoError(t, app.StakingKeeper.TransferTokenizeShareRecord(ctx, 1, addrs[1], addrs[2]))
+	require.Empty(t, app.StakingKeeper.GetTokenizeShareRecordsByOwner(ctx, addrs[1]))
+
+	records := app.StakingKeeper.GetTokenizeShareRecordsByOwner(ctx, addrs[2])
+	require.Len(t, records, 1)
+	require.Equal(t, addrs[2].String(), records[0].Owner)
+}
+
+func TestTransferDelegation(t *testing.T) {
+	app, ctx, addrs, valAddr, delTokens := setupLiquidStaking(t)
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+	bondedPool := app.StakingKeeper.GetBondedPool(ctx)
+	bondedTokens := app.BankKeeper.GetBalance(ctx, bondedPool.GetAddress(), bondDenom)
+	balance := app.BankKeeper.GetBalance(ctx, addrs[2], bondDenom)
+
+	amount := sdk.NewCoin(bondDenom, delTokens.QuoRaw(4))
+	_, err := app.StakingKeeper.TransferDelegation(ctx, addrs[1], valAddr, addrs[1], amount)
+	require.ErrorIs(t, err, types.ErrSelfDelegationTransfer)
+
+	shares, err := app.StakingKeeper.TransferDelegation(ctx, addrs[1], valAddr, addrs[2], amount)
+	require.NoError(t, err)
+	require.Equal(t, amount.Amount.ToDec(), shares)
+
+	delegation, found := app.StakingKeeper.GetDelegation(ctx, addrs[1], valAddr)
+	require.True(t, found)
+	require.Equal(t, delTokens.Sub(amount.Amount).ToDec(), delegation.Shares)
+
+	delegation, found = app.StakingKeeper.GetDelegation(ctx, addrs[2], valAddr)
+	require.True(t, found)
+	require.Equal(t, amount.Amount.ToDec(), delegation.Shares)
+
+	// nothing is unbonded and the balances are left untouched
+	_, found = app.StakingKeeper.GetUnbondingDelegation(ctx, addrs[1], valAddr)
+	require.False(t, found)
+	require.Equal(t, bondedTokens, app.BankKeeper.GetBalance(ctx, bondedPool.GetAddress(), bondDenom))
+	require.Equal(t, balance, app.BankKeeper.GetBalance(ctx, addrs[2], bondDenom))
+
+	// the whole delegation can be transferred
+	_, err = app.StakingKeeper.TransferDelegation(ctx, addrs[1], valAddr, addrs[2], sdk.NewCoin(bondDenom, delTokens.Sub(amount.Amount)))
+	require.NoError(t, err)
+
+	_, found = app.StakingKeeper.GetDelegation(ctx, addrs[1], valAddr)
+	require.False(t, found)
+
+	_, err = app.StakingKeeper.TransferDelegation(ctx, addrs[1], valAddr, addrs[2], amount)
+	require.ErrorIs(t, err, types.ErrNoDelegation)
+}
+
+func TestTokenizeShareRecordQueries(t *testing.T) {
+	app, ctx, addrs, valAddr, delTokens := setupLiquidStaking(t)
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+	querier := keeper.Querier{Keeper: app.StakingKeeper}
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	shareToken, err := app.StakingKeeper.TokenizeShares(ctx, addrs[1], valAddr, sdk.NewCoin(bondDenom, delTokens), addrs[2])
+	require.NoError(t, err)
+	record := types.NewTokenizeShareRecord(1, addrs[2], valAddr)
+
+	byID, err := querier.TokenizeShareRecordById(goCtx, &types.QueryTokenizeShareRecordByIdRequest{Id: 1})
+	require.NoError(t, err)
+	require.Equal(t, record, byID.Record)
+
+	_, err = querier.TokenizeShareRecordById(goCtx, &types.QueryTokenizeShareRecordByIdRequest{Id: 2})
+	require.Error(t, err)
+
+	byDenom, err := querier.TokenizeShareRecordByDenom(goCtx, &types.QueryTokenizeShareRecordByDenomRequest{Denom: shareToken.Denom})
+	require.NoError(t, err)
+	require.Equal(t, record, byDenom.Record)
+
+	owned, err := querier.TokenizeShareRecordsOwned(goCtx, &types.QueryTokenizeShareRecordsOwnedRequest{Owner: addrs[2].String()})
+	require.NoError(t, err)
+	require.Equal(t, []types.TokenizeShareRecord{record}, owned.Records)
+
+	total, err := querier.TotalLiquidStaked(goCtx, &types.QueryTotalLiquidStakedRequest{})
+	require.NoError(t, err)
+	require.Equal(t, delTokens, total.Tokens)
+}
@@ -0,0 +1,22 @@
+package v2
+
+import (
+	"github.com/line/lfb-sdk/x/staking/types"
+)
+
+// MigrateJSON migrates an exported staking genesis state of consensus version 1
+// to version 2. The liquid staking caps missing from the old params are set to
+// their defaults.
+func MigrateJSON(oldState *types.GenesisState) *types.GenesisState {
+	newState := *oldState
+
+	if newState.Params.GlobalLiquidStakingCap.IsNil() {
+		newState.Params.GlobalLiquidStakingCap = types.DefaultGlobalLiquidStakingCap
+	}
+
+	if newState.Params.ValidatorLiquidStakingCap.IsNil() {
+		newState.Params.ValidatorLiquidStakingCap = types.DefaultValidatorLiquidStakingCap
+	}
+
+	return &newState
+}
@@ -0,0 +1,25 @@
+package v2_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/line/lfb-sdk/types"
+	v2 "github.com/line/lfb-sdk/x/staking/legacy/v2"
+	"github.com/line/lfb-sdk/x/staking/types"
+)
+
+func TestMigrateJSON(t *testing.T) {
+	oldState := types.DefaultGenesisState()
+	oldState.Params.GlobalLiquidStakingCap = sdk.Dec{}
+	oldState.Params.ValidatorLiquidStakingCap = sdk.Dec{}
+
+	newState := v2.MigrateJSON(oldState)
+	require.Equal(t, types.DefaultGlobalLiquidStakingCap, newState.Params.GlobalLiquidStakingCap)
+	require.Equal(t, types.DefaultValidatorLiquidStakingCap, newState.Params.ValidatorLiquidStakingCap)
+
+	// the old state is left untouched
+	require.True(t, oldState.Params.GlobalLiquidStakingCap.IsNil())
+	require.Equal(t, oldState.Params.UnbondingTime, newState.Params.UnbondingTime)
+}
@@ -0,0 +1,19 @@
+package v2
+
+import (
+	sdk "github.com/line/lfb-sdk/types"
+	paramtypes "github.com/line/lfb-sdk/x/params/types"
+	"github.com/line/lfb-sdk/x/staking/types"
+)
+
+// MigrateStore performs in-place store migrations from consensus version 1 to 2.
+// The migration includes:
+//
+// - Setting the global and validator liquid staking caps to their defaults,
+// which leave liquid staking uncapped.
+func MigrateStore(ctx sdk.Context, paramstore *paramtypes.Subspace) error {
+	paramstore.Set(ctx, types.KeyGlobalLiquidStakingCap, types.DefaultGlobalLiquidStakingCap)
+	paramstore.Set(ctx, types.KeyValidatorLiquidStakingCap, types.DefaultValidatorLiquidStakingCap)
+
+	return nil
+}
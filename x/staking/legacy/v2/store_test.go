@@ -0,0 +1,31 @@
+package v2_test
+
+import (
+	"testing"
+
+	ostproto "github.com/line/ostracon/proto/ostracon/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/line/lfb-sdk/simapp"
+	sdk "github.com/line/lfb-sdk/types"
+	v2 "github.com/line/lfb-sdk/x/staking/legacy/v2"
+	"github.com/line/lfb-sdk/x/staking/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, ostproto.Header{})
+	paramstore := app.GetSubspace(types.ModuleName)
+
+	paramstore.Set(ctx, types.KeyGlobalLiquidStakingCap, sdk.NewDecWithPrec(25, 2))
+	paramstore.Set(ctx, types.KeyValidatorLiquidStakingCap, sdk.NewDecWithPrec(50, 2))
+
+	require.NoError(t, v2.MigrateStore(ctx, paramstore))
+
+	var globalCap, validatorCap sdk.Dec
+	paramstore.Get(ctx, types.KeyGlobalLiquidStakingCap, &globalCap)
+	paramstore.Get(ctx, types.KeyValidatorLiquidStakingCap, &validatorCap)
+	require.Equal(t, types.DefaultGlobalLiquidStakingCap, globalCap)
+	require.Equal(t, types.DefaultValidatorLiquidStakingCap, validatorCap)
+	require.Equal(t, types.DefaultParams(), app.StakingKeeper.GetParams(ctx))
+}
@@ -0,0 +1,54 @@
+package v3
+
+import (
+	"time"
+
+	sdk "github.com/line/lfb-sdk/types"
+	"github.com/line/lfb-sdk/x/staking/types"
+)
+
+// MigrateJSON migrates an exported staking genesis state of consensus version 2
+// to version 3. The minimum commission rate and minimum self delegation missing
+// from the old params are set to their defaults, and the validators below them
+// are raised to the floors.
+func MigrateJSON(oldState *types.GenesisState, genesisTime time.Time) *types.GenesisState {
+	newState := *oldState
+
+	if newState.Params.MinCommissionRate.IsNil() {
+		newState.Params.MinCommissionRate = types.DefaultMinCommissionRate
+	}
+
+	if newState.Params.MinSelfDelegation.IsNil() {
+		newState.Params.MinSelfDelegation = types.DefaultMinSelfDelegation
+	}
+
+	newState.Validators = make([]types.Validator, len(oldState.Validators))
+	for i, validator := range oldState.Validators {
+		bumpValidator(&validator, newState.Params.MinCommissionRate, newState.Params.MinSelfDelegation, genesisTime)
+		newState.Validators[i] = validator
+	}
+
+	return &newState
+}
+
+// bumpValidator raises the commission rate and the minimum self delegation of
+// the validator to the given floors. It returns whether the validator changed.
+func bumpValidator(validator *types.Validator, minRate sdk.Dec, minSelfDelegation sdk.Int, updateTime time.Time) bool {
+	changed := false
+
+	if validator.Commission.Rate.LT(minRate) {
+		validator.Commission.Rate = minRate
+		if validator.Commission.MaxRate.LT(minRate) {
+			validator.Commission.MaxRate = minRate
+		}
+		validator.Commission.UpdateTime = updateTime
+		changed = true
+	}
+
+	if validator.MinSelfDelegation.LT(minSelfDelegation) {
+		validator.MinSelfDelegation = minSelfDelegation
+		changed = true
+	}
+
+	return changed
+}
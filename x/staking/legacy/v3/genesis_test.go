@@ -0,0 +1,37 @@
+package v3_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/line/lfb-sdk/types"
+	v3 "github.com/line/lfb-sdk/x/staking/legacy/v3"
+	"github.com/line/lfb-sdk/x/staking/types"
+)
+
+func TestMigrateJSON(t *testing.T) {
+	genesisTime := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	oldState := types.DefaultGenesisState()
+	oldState.Params.MinCommissionRate = sdk.Dec{}
+	oldState.Params.MinSelfDelegation = sdk.Int{}
+	oldState.Validators = []types.Validator{
+		{
+			OperatorAddress:   "linkvaloper1tnh2q55v8wyygtt9srz5safamzdengsn8rx882",
+			Commission:        types.NewCommission(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec()),
+			MinSelfDelegation: sdk.ZeroInt(),
+		},
+	}
+
+	newState := v3.MigrateJSON(oldState, genesisTime)
+	require.Equal(t, types.DefaultMinCommissionRate, newState.Params.MinCommissionRate)
+	require.Equal(t, types.DefaultMinSelfDelegation, newState.Params.MinSelfDelegation)
+	require.Equal(t, types.DefaultMinSelfDelegation, newState.Validators[0].MinSelfDelegation)
+	require.Equal(t, sdk.ZeroDec(), newState.Validators[0].Commission.Rate)
+
+	// the old state is left untouched
+	require.True(t, oldState.Params.MinCommissionRate.IsNil())
+	require.Equal(t, sdk.ZeroInt(), oldState.Validators[0].MinSelfDelegation)
+}
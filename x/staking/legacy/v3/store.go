@@ -0,0 +1,58 @@
+package v3
+
+import (
+	"github.com/line/lfb-sdk/codec"
+	sdk "github.com/line/lfb-sdk/types"
+	paramtypes "github.com/line/lfb-sdk/x/params/types"
+	"github.com/line/lfb-sdk/x/staking/types"
+)
+
+// MigrateStore performs in-place store migrations from consensus version 2 to 3.
+// The migration includes:
+//
+// - Setting the minimum commission rate and minimum self delegation params to
+// their defaults, unless an upgrade handler has already set them.
+// - Raising the commission rate and minimum self delegation of the existing
+// validators that are below those floors.
+func MigrateStore(ctx sdk.Context, storeKey sdk.StoreKey, cdc codec.BinaryMarshaler, paramstore *paramtypes.Subspace) error {
+	if !paramstore.Has(ctx, types.KeyMinCommissionRate) {
+		paramstore.Set(ctx, types.KeyMinCommissionRate, types.DefaultMinCommissionRate)
+	}
+
+	if !paramstore.Has(ctx, types.KeyMinSelfDelegation) {
+		paramstore.Set(ctx, types.KeyMinSelfDelegation, types.DefaultMinSelfDelegation)
+	}
+
+	var (
+		minRate           sdk.Dec
+		minSelfDelegation sdk.Int
+	)
+
+	paramstore.Get(ctx, types.KeyMinCommissionRate, &minRate)
+	paramstore.Get(ctx, types.KeyMinSelfDelegation, &minSelfDelegation)
+
+	// the bumped validators are collected first, as the store must not be written while iterating it
+	var (
+		keys       [][]byte
+		validators []types.Validator
+	)
+	store := ctx.KVStore(storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.ValidatorsKey)
+	for ; iterator.Valid(); iterator.Next() {
+		validator := types.MustUnmarshalValidator(cdc, iterator.Value())
+
+		if !bumpValidator(&validator, minRate, minSelfDelegation, ctx.BlockHeader().Time) {
+			continue
+		}
+
+		keys = append(keys, iterator.Key())
+		validators = append(validators, validator)
+	}
+	iterator.Close()
+
+	for i, validator := range validators {
+		store.Set(keys[i], types.MustMarshalValidator(cdc, &validator))
+	}
+
+	return nil
+}
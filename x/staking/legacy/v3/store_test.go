@@ -0,0 +1,56 @@
+package v3_test
+
+import (
+	"testing"
+	"time"
+
+	ostproto "github.com/line/ostracon/proto/ostracon/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/line/lfb-sdk/simapp"
+	sdk "github.com/line/lfb-sdk/types"
+	v3 "github.com/line/lfb-sdk/x/staking/legacy/v3"
+	"github.com/line/lfb-sdk/x/staking/teststaking"
+	"github.com/line/lfb-sdk/x/staking/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	app := simapp.Setup(false)
+	now := time.Now().UTC()
+	ctx := app.BaseApp.NewContext(false, ostproto.Header{Time: now})
+	paramstore := app.GetSubspace(types.ModuleName)
+
+	pks := simapp.CreateTestPubKeys(2)
+	lowAddr, highAddr := sdk.ValAddress(pks[0].Address()), sdk.ValAddress(pks[1].Address())
+
+	low := teststaking.NewValidator(t, lowAddr, pks[0])
+	low.Commission = types.NewCommission(sdk.NewDecWithPrec(1, 2), sdk.NewDecWithPrec(2, 2), sdk.NewDecWithPrec(1, 2))
+	low.MinSelfDelegation = sdk.OneInt()
+	app.StakingKeeper.SetValidator(ctx, low)
+
+	high := teststaking.NewValidator(t, highAddr, pks[1])
+	high.Commission = types.NewCommission(sdk.NewDecWithPrec(1, 1), sdk.NewDecWithPrec(2, 1), sdk.NewDecWithPrec(1, 2))
+	high.MinSelfDelegation = sdk.NewInt(100)
+	app.StakingKeeper.SetValidator(ctx, high)
+
+	// an upgrade handler sets the floors before running the migration
+	minRate, minSelfDelegation := sdk.NewDecWithPrec(5, 2), sdk.NewInt(10)
+	paramstore.Set(ctx, types.KeyMinCommissionRate, minRate)
+	paramstore.Set(ctx, types.KeyMinSelfDelegation, minSelfDelegation)
+
+	require.NoError(t, v3.MigrateStore(ctx, app.GetKey(types.StoreKey), app.AppCodec(), paramstore))
+
+	require.Equal(t, minRate, app.StakingKeeper.MinCommissionRate(ctx))
+	require.Equal(t, minSelfDelegation, app.StakingKeeper.MinSelfDelegation(ctx))
+
+	validator, found := app.StakingKeeper.GetValidator(ctx, lowAddr)
+	require.True(t, found)
+	require.Equal(t, minRate, validator.Commission.Rate)
+	require.Equal(t, minRate, validator.Commission.MaxRate)
+	require.Equal(t, now, validator.Commission.UpdateTime)
+	require.Equal(t, minSelfDelegation, validator.MinSelfDelegation)
+
+	validator, found = app.StakingKeeper.GetValidator(ctx, highAddr)
+	require.True(t, found)
+	require.Equal(t, high, validator)
+}
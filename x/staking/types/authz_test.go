@@ -0,0 +1,185 @@
+package types_test
+
+import (
+	"testing"
+
+	ostproto "github.com/line/ostracon/proto/ostracon/types"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/line/lfb-sdk/types"
+	authztypes "github.com/line/lfb-sdk/x/authz/types"
+	"github.com/line/lfb-sdk/x/staking/types"
+)
+
+var (
+	coin100 = sdk.NewInt64Coin("steak", 100)
+	coin150 = sdk.NewInt64Coin("steak", 150)
+	coin50  = sdk.NewInt64Coin("steak", 50)
+
+	delAddr = sdk.AccAddress("_____delegator _____")
+	val1    = sdk.ValAddress("_____validator1_____")
+	val2    = sdk.ValAddress("_____validator2_____")
+	val3    = sdk.ValAddress("_____validator3_____")
+)
+
+func TestAuthzAuthorizations(t *testing.T) {
+	ctx := sdk.NewContext(nil, ostproto.Header{}, false, nil)
+
+	// verify ValidateBasic returns error for the AUTHORIZATION_TYPE_UNSPECIFIED authorization type
+	delAuth, err := types.NewStakeAuthorization([]sdk.ValAddress{val1, val2}, []sdk.ValAddress{}, types.AuthorizationType_AUTHORIZATION_TYPE_UNSPECIFIED, &coin100)
+	require.NoError(t, err)
+	require.Error(t, delAuth.ValidateBasic())
+
+	// verify MsgTypeURL
+	delAuth, err = types.NewStakeAuthorization([]sdk.ValAddress{val1, val2}, []sdk.ValAddress{}, types.AuthorizationType_AUTHORIZATION_TYPE_DELEGATE, &coin100)
+	require.NoError(t, err)
+	require.Equal(t, "/lfb.staking.v1beta1.MsgDelegate", delAuth.MsgTypeURL())
+
+	// both lists can be neither empty nor set
+	_, err = types.NewStakeAuthorization(nil, nil, types.AuthorizationType_AUTHORIZATION_TYPE_DELEGATE, &coin100)
+	require.Error(t, err)
+	_, err = types.NewStakeAuthorization([]sdk.ValAddress{val1}, []sdk.ValAddress{val2}, types.AuthorizationType_AUTHORIZATION_TYPE_DELEGATE, &coin100)
+	require.Error(t, err)
+
+	validators1_2 := []string{val1.String(), val2.String()}
+
+	testCases := []struct {
+		msg                  string
+		allowed              []sdk.ValAddress
+		denied               []sdk.ValAddress
+		msgType              types.AuthorizationType
+		limit                *sdk.Coin
+		srvMsg               sdk.Msg
+		expectErr            bool
+		isDelete             bool
+		updatedAuthorization *types.StakeAuthorization
+	}{
+		{
+			"delegate: expect 0 remaining coins",
+			[]sdk.ValAddress{val1, val2},
+			[]sdk.ValAddress{},
+			types.AuthorizationType_AUTHORIZATION_TYPE_DELEGATE,
+			&coin100,
+			types.NewMsgDelegate(delAddr, val1, coin100),
+			false,
+			true,
+			nil,
+		},
+		{
+			"delegate: coins more than allowed",
+			[]sdk.ValAddress{val1, val2},
+			[]sdk.ValAddress{},
+			types.AuthorizationType_AUTHORIZATION_TYPE_DELEGATE,
+			&coin100,
+			types.NewMsgDelegate(delAddr, val1, coin150),
+			true,
+			false,
+			nil,
+		},
+		{
+			"delegate: verify remaining coins",
+			[]sdk.ValAddress{val1, val2},
+			[]sdk.ValAddress{},
+			types.AuthorizationType_AUTHORIZATION_TYPE_DELEGATE,
+			&coin100,
+			types.NewMsgDelegate(delAddr, val1, coin50),
+			false,
+			false,
+			&types.StakeAuthorization{
+				Validators: &types.StakeAuthorization_AllowList{
+					AllowList: &types.StakeAuthorization_Validators{Address: validators1_2},
+				}, MaxTokens: &coin50, AuthorizationType: types.AuthorizationType_AUTHORIZATION_TYPE_DELEGATE},
+		},
+		{
+			"delegate: testing with invalid validator",
+			[]sdk.ValAddress{val1, val2},
+			[]sdk.ValAddress{},
+			types.AuthorizationType_AUTHORIZATION_TYPE_DELEGATE,
+			&coin100,
+			types.NewMsgDelegate(delAddr, val3, coin100),
+			true,
+			false,
+			nil,
+		},
+		{
+			"delegate: testing delegate without spent limit",
+			[]sdk.ValAddress{val1, val2},
+			[]sdk.ValAddress{},
+			types.AuthorizationType_AUTHORIZATION_TYPE_DELEGATE,
+			nil,
+			types.NewMsgDelegate(delAddr, val2, coin100),
+			false,
+			false,
+			&types.StakeAuthorization{
+				Validators: &types.StakeAuthorization_AllowList{
+					AllowList: &types.StakeAuthorization_Validators{Address: validators1_2},
+				}, MaxTokens: nil, AuthorizationType: types.AuthorizationType_AUTHORIZATION_TYPE_DELEGATE},
+		},
+		{
+			"delegate: fail validator denied",
+			[]sdk.ValAddress{},
+			[]sdk.ValAddress{val1},
+			types.AuthorizationType_AUTHORIZATION_TYPE_DELEGATE,
+			nil,
+			types.NewMsgDelegate(delAddr, val1, coin100),
+			true,
+			false,
+			nil,
+		},
+		{
+			"undelegate: expect 0 remaining coins",
+			[]sdk.ValAddress{val1, val2},
+			[]sdk.ValAddress{},
+			types.AuthorizationType_AUTHORIZATION_TYPE_UNDELEGATE,
+			&coin100,
+			types.NewMsgUndelegate(delAddr, val1, coin100),
+			false,
+			true,
+			nil,
+		},
+		{
+			"redelegate: checks the destination validator",
+			[]sdk.ValAddress{val1, val2},
+			[]sdk.ValAddress{},
+			types.AuthorizationType_AUTHORIZATION_TYPE_REDELEGATE,
+			&coin100,
+			types.NewMsgBeginRedelegate(delAddr, val1, val3, coin100),
+			true,
+			false,
+			nil,
+		},
+		{
+			"redelegate: verify remaining coins",
+			[]sdk.ValAddress{val1, val2},
+			[]sdk.ValAddress{},
+			types.AuthorizationType_AUTHORIZATION_TYPE_REDELEGATE,
+			&coin100,
+			types.NewMsgBeginRedelegate(delAddr, val3, val1, coin50),
+			false,
+			false,
+			&types.StakeAuthorization{
+				Validators: &types.StakeAuthorization_AllowList{
+					AllowList: &types.StakeAuthorization_Validators{Address: validators1_2},
+				}, MaxTokens: &coin50, AuthorizationType: types.AuthorizationType_AUTHORIZATION_TYPE_REDELEGATE},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.msg, func(t *testing.T) {
+			delAuth, err := types.NewStakeAuthorization(tc.allowed, tc.denied, tc.msgType, tc.limit)
+			require.NoError(t, err)
+			resp, err := delAuth.Accept(ctx, tc.srvMsg)
+			require.Equal(t, tc.isDelete, resp.Delete)
+			if tc.expectErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				require.True(t, resp.Accept)
+				if tc.updatedAuthorization != nil {
+					require.Equal(t, authztypes.Authorization(tc.updatedAuthorization), resp.Updated)
+				}
+			}
+		})
+	}
+}
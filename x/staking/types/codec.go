@@ -6,6 +6,8 @@ import (
 	cryptocodec "github.com/line/lfb-sdk/crypto/codec"
 	sdk "github.com/line/lfb-sdk/types"
 	"github.com/line/lfb-sdk/types/msgservice"
+	authztypes "github.com/line/lfb-sdk/x/authz/types"
+	grouptypes "github.com/line/lfb-sdk/x/group/types"
 )
 
 // RegisterLegacyAminoCodec registers the necessary x/staking interfaces and concrete types
@@ -16,6 +18,21 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgDelegate{}, "lfb-sdk/MsgDelegate", nil)
 	cdc.RegisterConcrete(&MsgUndelegate{}, "lfb-sdk/MsgUndelegate", nil)
 	cdc.RegisterConcrete(&MsgBeginRedelegate{}, "lfb-sdk/MsgBeginRedelegate", nil)
+	cdc.RegisterConcrete(&MsgTokenizeShares{}, "lfb-sdk/MsgTokenizeShares", nil)
+	cdc.RegisterConcrete(&MsgRedeemTokensForShares{}, "lfb-sdk/MsgRedeemTokensForShares", nil)
+	cdc.RegisterConcrete(&MsgTransferTokenizeShareRecord{}, "lfb-sdk/MsgTransferTokenizeShareRecord", nil)
+	cdc.RegisterConcrete(&MsgTransferDelegation{}, "lfb-sdk/MsgTransferDelegation", nil)
+	cdc.RegisterConcrete(&MsgCancelUnbondingDelegation{}, "lfb-sdk/MsgCancelUnbondingDelegation", nil)
+	registerStakeAuthorization(cdc)
+}
+
+// registerStakeAuthorization registers the StakeAuthorization together with its
+// validator list variants, which amino needs to encode the oneof field.
+func registerStakeAuthorization(cdc *codec.LegacyAmino) {
+	cdc.RegisterInterface((*isStakeAuthorization_Validators)(nil), nil)
+	cdc.RegisterConcrete(&StakeAuthorization_AllowList{}, "lfb-sdk/StakeAuthorization/AllowList", nil)
+	cdc.RegisterConcrete(&StakeAuthorization_DenyList{}, "lfb-sdk/StakeAuthorization/DenyList", nil)
+	cdc.RegisterConcrete(&StakeAuthorization{}, "lfb-sdk/StakeAuthorization", nil)
 }
 
 // RegisterInterfaces registers the x/staking interfaces types with the interface registry
@@ -26,6 +43,16 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgDelegate{},
 		&MsgUndelegate{},
 		&MsgBeginRedelegate{},
+		&MsgTokenizeShares{},
+		&MsgRedeemTokensForShares{},
+		&MsgTransferTokenizeShareRecord{},
+		&MsgTransferDelegation{},
+		&MsgCancelUnbondingDelegation{},
+	)
+
+	registry.RegisterImplementations(
+		(*authztypes.Authorization)(nil),
+		&StakeAuthorization{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -47,4 +74,29 @@ func init() {
 	RegisterLegacyAminoCodec(amino)
 	cryptocodec.RegisterCrypto(amino)
 	amino.Seal()
+
+	authztypes.RegisterMsgTypeCodec(&MsgCreateValidator{}, "lfb-sdk/MsgCreateValidator")
+	authztypes.RegisterMsgTypeCodec(&MsgEditValidator{}, "lfb-sdk/MsgEditValidator")
+	authztypes.RegisterMsgTypeCodec(&MsgDelegate{}, "lfb-sdk/MsgDelegate")
+	authztypes.RegisterMsgTypeCodec(&MsgUndelegate{}, "lfb-sdk/MsgUndelegate")
+	authztypes.RegisterMsgTypeCodec(&MsgBeginRedelegate{}, "lfb-sdk/MsgBeginRedelegate")
+	authztypes.RegisterMsgTypeCodec(&MsgTokenizeShares{}, "lfb-sdk/MsgTokenizeShares")
+	authztypes.RegisterMsgTypeCodec(&MsgRedeemTokensForShares{}, "lfb-sdk/MsgRedeemTokensForShares")
+	authztypes.RegisterMsgTypeCodec(&MsgTransferTokenizeShareRecord{}, "lfb-sdk/MsgTransferTokenizeShareRecord")
+	authztypes.RegisterMsgTypeCodec(&MsgTransferDelegation{}, "lfb-sdk/MsgTransferDelegation")
+	authztypes.RegisterMsgTypeCodec(&MsgCancelUnbondingDelegation{}, "lfb-sdk/MsgCancelUnbondingDelegation")
+	grouptypes.RegisterMsgTypeCodec(&MsgCreateValidator{}, "lfb-sdk/MsgCreateValidator")
+	grouptypes.RegisterMsgTypeCodec(&MsgEditValidator{}, "lfb-sdk/MsgEditValidator")
+	grouptypes.RegisterMsgTypeCodec(&MsgDelegate{}, "lfb-sdk/MsgDelegate")
+	grouptypes.RegisterMsgTypeCodec(&MsgUndelegate{}, "lfb-sdk/MsgUndelegate")
+	grouptypes.RegisterMsgTypeCodec(&MsgBeginRedelegate{}, "lfb-sdk/MsgBeginRedelegate")
+	grouptypes.RegisterMsgTypeCodec(&MsgTokenizeShares{}, "lfb-sdk/MsgTokenizeShares")
+	grouptypes.RegisterMsgTypeCodec(&MsgRedeemTokensForShares{}, "lfb-sdk/MsgRedeemTokensForShares")
+	grouptypes.RegisterMsgTypeCodec(&MsgTransferTokenizeShareRecord{}, "lfb-sdk/MsgTransferTokenizeShareRecord")
+	grouptypes.RegisterMsgTypeCodec(&MsgTransferDelegation{}, "lfb-sdk/MsgTransferDelegation")
+	grouptypes.RegisterMsgTypeCodec(&MsgCancelUnbondingDelegation{}, "lfb-sdk/MsgCancelUnbondingDelegation")
+	authztypes.RegisterAuthorizationInterfaceCodec((*isStakeAuthorization_Validators)(nil))
+	authztypes.RegisterAuthorizationTypeCodec(&StakeAuthorization{}, "lfb-sdk/StakeAuthorization")
+	authztypes.RegisterAuthorizationTypeCodec(&StakeAuthorization_AllowList{}, "lfb-sdk/StakeAuthorization/AllowList")
+	authztypes.RegisterAuthorizationTypeCodec(&StakeAuthorization_DenyList{}, "lfb-sdk/StakeAuthorization/DenyList")
 }
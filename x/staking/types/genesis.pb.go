@@ -43,6 +43,10 @@ type GenesisState struct {
 	// redelegations defines the redelegations active at genesis.
 	Redelegations []Redelegation `protobuf:"bytes,7,rep,name=redelegations,proto3" json:"redelegations"`
 	Exported      bool           `protobuf:"varint,8,opt,name=exported,proto3" json:"exported,omitempty"`
+	// tokenize_share_records defines the tokenize share records active at genesis.
+	TokenizeShareRecords []TokenizeShareRecord `protobuf:"bytes,9,rep,name=tokenize_share_records,json=tokenizeShareRecords,proto3" json:"tokenize_share_records" yaml:"tokenize_share_records"`
+	// last_tokenize_share_record_id is the id of the last created tokenize share record.
+	LastTokenizeShareRecordId uint64 `protobuf:"varint,10,opt,name=last_tokenize_share_record_id,json=lastTokenizeShareRecordId,proto3" json:"last_tokenize_share_record_id,omitempty" yaml:"last_tokenize_share_record_id"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -127,6 +131,20 @@ func (m *GenesisState) GetExported() bool {
 	return false
 }
 
+func (m *GenesisState) GetTokenizeShareRecords() []TokenizeShareRecord {
+	if m != nil {
+		return m.TokenizeShareRecords
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLastTokenizeShareRecordId() uint64 {
+	if m != nil {
+		return m.LastTokenizeShareRecordId
+	}
+	return 0
+}
+
 // LastValidatorPower required for validator set update logic.
 type LastValidatorPower struct {
 	// address is the address of the validator.
@@ -176,38 +194,43 @@ func init() {
 func init() { proto.RegisterFile("lfb/staking/v1beta1/genesis.proto", fileDescriptor_d60feb2ffc8dd766) }
 
 var fileDescriptor_d60feb2ffc8dd766 = []byte{
-	// 493 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x93, 0x3f, 0x6f, 0xd3, 0x40,
-	0x18, 0xc6, 0x7d, 0xa4, 0x49, 0xc3, 0xa5, 0x20, 0x74, 0x4d, 0x85, 0x15, 0x90, 0x9d, 0x58, 0x48,
-	0xf5, 0x82, 0xad, 0x96, 0x89, 0x6e, 0x44, 0x91, 0x2a, 0x24, 0x40, 0x95, 0xf9, 0x33, 0xb0, 0x44,
-	0x67, 0x7c, 0x31, 0x56, 0x2f, 0x77, 0x96, 0xef, 0x52, 0xda, 0x11, 0xb1, 0x30, 0xf2, 0x11, 0xfa,
-	0x45, 0xd8, 0x3b, 0x76, 0x44, 0x0c, 0x11, 0x4a, 0x16, 0xe6, 0x7e, 0x02, 0xe4, 0xb3, 0x63, 0x4c,
-	0x72, 0x6c, 0x7e, 0x5f, 0x3f, 0xcf, 0xef, 0x39, 0x9f, 0xdf, 0x17, 0x0e, 0xe8, 0x24, 0xf4, 0x85,
-	0xc4, 0xa7, 0x09, 0x8b, 0xfd, 0xb3, 0x83, 0x90, 0x48, 0x7c, 0xe0, 0xc7, 0x84, 0x11, 0x91, 0x08,
-	0x2f, 0xcd, 0xb8, 0xe4, 0x68, 0x97, 0x4e, 0x42, 0xaf, 0x94, 0x78, 0xa5, 0xa4, 0xd7, 0x8d, 0x79,
-	0xcc, 0xd5, 0x7b, 0x3f, 0x7f, 0x2a, 0xa4, 0x3d, 0x2d, 0x6d, 0x65, 0x55, 0x12, 0xe7, 0x7b, 0x13,
-	0xee, 0x1c, 0x17, 0xfc, 0xd7, 0x12, 0x4b, 0x82, 0x9e, 0xc2, 0x56, 0x8a, 0x33, 0x3c, 0x15, 0x26,
-	0xe8, 0x03, 0xb7, 0x73, 0xf8, 0xc0, 0xd3, 0xe4, 0x79, 0x27, 0x4a, 0x32, 0xdc, 0xba, 0x9a, 0xdb,
-	0x46, 0x50, 0x1a, 0x10, 0x83, 0xf7, 0x28, 0x16, 0x72, 0x2c, 0xb9, 0xc4, 0x74, 0x9c, 0xf2, 0x4f,
-	0x24, 0x33, 0x6f, 0xf5, 0x81, 0xbb, 0x33, 0x1c, 0xe5, 0xba, 0x9f, 0x73, 0x7b, 0x10, 0x27, 0xf2,
-	0xe3, 0x2c, 0xf4, 0x3e, 0xf0, 0xa9, 0x4f, 0x13, 0x46, 0x7c, 0x3a, 0x09, 0x1f, 0x8b, 0xe8, 0xd4,
-	0x97, 0x17, 0x29, 0x11, 0xde, 0x73, 0x26, 0x6f, 0xe6, 0xf6, 0xfd, 0x0b, 0x3c, 0xa5, 0x47, 0xce,
-	0x3a, 0xca, 0x09, 0xee, 0xe6, 0xad, 0x37, 0x79, 0xe7, 0x24, 0x6f, 0xa0, 0xcf, 0x00, 0xee, 0x29,
-	0xd5, 0x19, 0xa6, 0x49, 0x84, 0x25, 0xcf, 0x0a, 0xa5, 0x30, 0x1b, 0xfd, 0x86, 0xdb, 0x39, 0xdc,
-	0xd7, 0x1e, 0xfd, 0x05, 0x16, 0xf2, 0xdd, 0xca, 0xa0, 0x40, 0xc3, 0x47, 0xf9, 0xf1, 0x6e, 0xe6,
-	0xf6, 0xc3, 0x5a, 0xf2, 0x3a, 0xd3, 0x09, 0x76, 0xe9, 0x86, 0x53, 0xa0, 0x11, 0x84, 0x95, 0x52,
-	0x98, 0x5b, 0x2a, 0xd7, 0xd2, 0xe6, 0x56, 0xce, 0xf2, 0xd6, 0x6a, 0x3e, 0x74, 0x0c, 0x3b, 0x11,
-	0xa1, 0x24, 0xc6, 0x32, 0xe1, 0x4c, 0x98, 0x4d, 0x85, 0xb1, 0xb5, 0x98, 0x51, 0xa5, 0x2b, 0x39,
-	0x75, 0x27, 0xfa, 0x02, 0xe0, 0xde, 0x8c, 0x85, 0x9c, 0x45, 0x09, 0x8b, 0xc7, 0x75, 0x66, 0x4b,
-	0x31, 0x5d, 0x2d, 0xf3, 0xed, 0xca, 0x51, 0x83, 0xaf, 0xdd, 0x89, 0x16, 0xea, 0x04, 0xdd, 0xd9,
-	0xa6, 0x55, 0xa0, 0x97, 0xf0, 0x4e, 0x46, 0xea, 0xe1, 0xdb, 0x2a, 0x7c, 0xa0, 0x0d, 0x0f, 0x6a,
-	0xca, 0xf2, 0x93, 0xfe, 0x75, 0xa3, 0x1e, 0x6c, 0x93, 0xf3, 0x94, 0x67, 0x92, 0x44, 0x66, 0xbb,
-	0x0f, 0xdc, 0x76, 0x50, 0xd5, 0xce, 0x2b, 0x88, 0x36, 0x7f, 0x28, 0x32, 0xe1, 0x36, 0x8e, 0xa2,
-	0x8c, 0x88, 0x62, 0x8a, 0x6f, 0x07, 0xab, 0x12, 0x75, 0x61, 0xf3, 0xef, 0x60, 0x36, 0x82, 0xa2,
-	0x38, 0x6a, 0x7f, 0xbd, 0xb4, 0x8d, 0xdf, 0x97, 0xb6, 0x31, 0x7c, 0x76, 0xb5, 0xb0, 0xc0, 0xf5,
-	0xc2, 0x02, 0xbf, 0x16, 0x16, 0xf8, 0xb6, 0xb4, 0x8c, 0xeb, 0xa5, 0x65, 0xfc, 0x58, 0x5a, 0xc6,
-	0xfb, 0xfd, 0xff, 0xcd, 0xee, 0x79, 0xb5, 0x62, 0x6a, 0x8a, 0xc3, 0x96, 0xda, 0xac, 0x27, 0x7f,
-	0x02, 0x00, 0x00, 0xff, 0xff, 0xa7, 0xd6, 0x85, 0x0e, 0xcc, 0x03, 0x00, 0x00,
+	// 573 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x94, 0xc1, 0x6e, 0xd3, 0x30,
+	0x18, 0xc7, 0x13, 0xda, 0xb5, 0x9d, 0x3b, 0x10, 0xf2, 0x3a, 0x08, 0x85, 0x25, 0x6d, 0x34, 0xb4,
+	0x5c, 0x48, 0xb4, 0x71, 0x62, 0x37, 0xa2, 0x4a, 0xd3, 0x24, 0x40, 0x53, 0x36, 0x38, 0x70, 0x89,
+	0x9c, 0xc5, 0xcd, 0x42, 0xd3, 0xb8, 0x8a, 0xdd, 0xb1, 0x71, 0x43, 0x08, 0x89, 0x23, 0x8f, 0xb0,
+	0x57, 0xe0, 0x2d, 0x76, 0xdc, 0x11, 0x71, 0xa8, 0x50, 0x7b, 0xe1, 0xdc, 0x27, 0x40, 0x71, 0xd2,
+	0x92, 0xb5, 0x66, 0xb7, 0xd8, 0xf9, 0xff, 0xfe, 0x7f, 0x7f, 0xd6, 0xf7, 0x19, 0xb4, 0xa3, 0xae,
+	0x67, 0x51, 0x86, 0x7a, 0x61, 0x1c, 0x58, 0x67, 0x3b, 0x1e, 0x66, 0x68, 0xc7, 0x0a, 0x70, 0x8c,
+	0x69, 0x48, 0xcd, 0x41, 0x42, 0x18, 0x81, 0xeb, 0x51, 0xd7, 0x33, 0x73, 0x89, 0x99, 0x4b, 0x9a,
+	0x8d, 0x80, 0x04, 0x84, 0xff, 0xb7, 0xd2, 0xaf, 0x4c, 0xda, 0x14, 0xba, 0xcd, 0x50, 0x2e, 0xd1,
+	0x7f, 0x54, 0xc1, 0xda, 0x7e, 0xe6, 0x7f, 0xc4, 0x10, 0xc3, 0xf0, 0x05, 0xa8, 0x0c, 0x50, 0x82,
+	0xfa, 0x54, 0x91, 0x5b, 0xb2, 0x51, 0xdf, 0x7d, 0x6c, 0x0a, 0xf2, 0xcc, 0x43, 0x2e, 0xb1, 0xcb,
+	0x57, 0x23, 0x4d, 0x72, 0x72, 0x00, 0xc6, 0xe0, 0x7e, 0x84, 0x28, 0x73, 0x19, 0x61, 0x28, 0x72,
+	0x07, 0xe4, 0x23, 0x4e, 0x94, 0x3b, 0x2d, 0xd9, 0x58, 0xb3, 0x3b, 0xa9, 0xee, 0xd7, 0x48, 0x6b,
+	0x07, 0x21, 0x3b, 0x1d, 0x7a, 0xe6, 0x09, 0xe9, 0x5b, 0x51, 0x18, 0x63, 0x2b, 0xea, 0x7a, 0xcf,
+	0xa8, 0xdf, 0xb3, 0xd8, 0xc5, 0x00, 0x53, 0xf3, 0x20, 0x66, 0xd3, 0x91, 0xf6, 0xf0, 0x02, 0xf5,
+	0xa3, 0x3d, 0x7d, 0xd1, 0x4a, 0x77, 0xee, 0xa5, 0x5b, 0xc7, 0xe9, 0xce, 0x61, 0xba, 0x01, 0x3f,
+	0xcb, 0x60, 0x83, 0xab, 0xce, 0x50, 0x14, 0xfa, 0x88, 0x91, 0x24, 0x53, 0x52, 0xa5, 0xd4, 0x2a,
+	0x19, 0xf5, 0xdd, 0x6d, 0xe1, 0xd1, 0x5f, 0x21, 0xca, 0xde, 0xcd, 0x00, 0x6e, 0x64, 0x6f, 0xa5,
+	0xc7, 0x9b, 0x8e, 0xb4, 0x27, 0x85, 0xe4, 0x45, 0x4f, 0xdd, 0x59, 0x8f, 0x96, 0x48, 0x0a, 0x3b,
+	0x00, 0xcc, 0x95, 0x54, 0x29, 0xf3, 0x5c, 0x55, 0x98, 0x3b, 0x27, 0xf3, 0x5b, 0x2b, 0x70, 0x70,
+	0x1f, 0xd4, 0x7d, 0x1c, 0xe1, 0x00, 0xb1, 0x90, 0xc4, 0x54, 0x59, 0xe1, 0x36, 0x9a, 0xd0, 0xa6,
+	0x33, 0xd7, 0xe5, 0x3e, 0x45, 0x12, 0x7e, 0x91, 0xc1, 0xc6, 0x30, 0xf6, 0x48, 0xec, 0x87, 0x71,
+	0xe0, 0x16, 0x3d, 0x2b, 0xdc, 0xd3, 0x10, 0x7a, 0xbe, 0x9d, 0x11, 0x05, 0xf3, 0x85, 0x3b, 0x11,
+	0x9a, 0xea, 0x4e, 0x63, 0xb8, 0x8c, 0x52, 0xf8, 0x1a, 0xdc, 0x4d, 0x70, 0x31, 0xbc, 0xca, 0xc3,
+	0xdb, 0xc2, 0x70, 0x07, 0xfb, 0x8b, 0x25, 0xdd, 0xa4, 0x61, 0x13, 0xd4, 0xf0, 0xf9, 0x80, 0x24,
+	0x0c, 0xfb, 0x4a, 0xad, 0x25, 0x1b, 0x35, 0x67, 0xbe, 0x86, 0x5f, 0x65, 0xf0, 0x80, 0x91, 0x1e,
+	0x8e, 0xc3, 0x4f, 0xd8, 0xa5, 0xa7, 0x28, 0xc1, 0x6e, 0x82, 0x4f, 0x48, 0xe2, 0x53, 0x65, 0xf5,
+	0x96, 0x8a, 0x8f, 0x73, 0xe4, 0x28, 0x25, 0x1c, 0x0e, 0xd8, 0x4f, 0xf3, 0x8a, 0x37, 0xb3, 0x8a,
+	0xc5, 0xae, 0xba, 0xd3, 0x60, 0xcb, 0x2c, 0x85, 0x1f, 0xc0, 0x66, 0xde, 0xb0, 0x02, 0xca, 0x0d,
+	0x7d, 0x05, 0xb4, 0x64, 0xa3, 0x6c, 0x1b, 0xd3, 0x91, 0xb6, 0x75, 0xa3, 0xbf, 0xc5, 0x72, 0xdd,
+	0x79, 0x94, 0x35, 0xfb, 0x52, 0xd4, 0x81, 0xaf, 0xbf, 0x01, 0x70, 0xb9, 0x89, 0xa1, 0x02, 0xaa,
+	0xc8, 0xf7, 0x13, 0x4c, 0xb3, 0xc9, 0x5d, 0x75, 0x66, 0x4b, 0xd8, 0x00, 0x2b, 0xff, 0x86, 0xb1,
+	0xe4, 0x64, 0x8b, 0xbd, 0xda, 0xb7, 0x4b, 0x4d, 0xfa, 0x73, 0xa9, 0x49, 0xf6, 0xcb, 0xab, 0xb1,
+	0x2a, 0x5f, 0x8f, 0x55, 0xf9, 0xf7, 0x58, 0x95, 0xbf, 0x4f, 0x54, 0xe9, 0x7a, 0xa2, 0x4a, 0x3f,
+	0x27, 0xaa, 0xf4, 0x7e, 0xfb, 0x7f, 0xf3, 0x7a, 0x3e, 0x7f, 0x56, 0xf8, 0xe4, 0x7a, 0x15, 0xfe,
+	0x9a, 0x3c, 0xff, 0x3b, 0x00, 0xb8, 0x77, 0x53, 0x00, 0xc0, 0x04, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -230,6 +253,25 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.LastTokenizeShareRecordId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastTokenizeShareRecordId))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.TokenizeShareRecords) > 0 {
+		for iNdEx := len(m.TokenizeShareRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TokenizeShareRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	if m.Exported {
 		i--
 		if m.Exported {
@@ -422,6 +464,15 @@ func (m *GenesisState) Size() (n int) {
 	if m.Exported {
 		n += 2
 	}
+	if len(m.TokenizeShareRecords) > 0 {
+		for _, e := range m.TokenizeShareRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.LastTokenizeShareRecordId != 0 {
+		n += 1 + sovGenesis(uint64(m.LastTokenizeShareRecordId))
+	}
 	return n
 }
 
@@ -732,6 +783,59 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Exported = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenizeShareRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenizeShareRecords = append(m.TokenizeShareRecords, TokenizeShareRecord{})
+			if err := m.TokenizeShareRecords[len(m.TokenizeShareRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastTokenizeShareRecordId", wireType)
+			}
+			m.LastTokenizeShareRecordId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastTokenizeShareRecordId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -46,6 +46,12 @@ var (
 	ValidatorQueueKey    = []byte{0x43} // prefix for the timestamps in validator queue
 
 	HistoricalInfoKey = []byte{0x50} // prefix for the historical info
+
+	TokenizeShareRecordPrefix          = []byte{0x61} // key for tokenize share record with given id
+	TokenizeShareRecordIDByOwnerPrefix = []byte{0x62} // key for tokenize share record id by owner
+	TokenizeShareRecordIDByDenomPrefix = []byte{0x63} // key for tokenize share record id by denom
+	LastTokenizeShareRecordIDKey       = []byte{0x64} // key for last tokenize share record id
+	ValidatorLiquidSharesPrefix        = []byte{0x65} // prefix for the tokenized shares of each validator
 )
 
 // gets the key for the validator with address
@@ -324,3 +330,36 @@ func GetREDsByDelToValDstIndexKey(delAddr sdk.AccAddress, valDstAddr sdk.ValAddr
 func GetHistoricalInfoKey(height int64) []byte {
 	return append(HistoricalInfoKey, []byte(strconv.FormatInt(height, 10))...)
 }
+
+// GetTokenizeShareRecordByIndexKey returns the key of a tokenize share record
+// VALUE: staking/TokenizeShareRecord
+func GetTokenizeShareRecordByIndexKey(id uint64) []byte {
+	return append(TokenizeShareRecordPrefix, sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetTokenizeShareRecordIdsByOwnerPrefix returns the prefix of the tokenize
+// share record ids owned by an address
+func GetTokenizeShareRecordIdsByOwnerPrefix(owner sdk.AccAddress) []byte {
+	return append(TokenizeShareRecordIDByOwnerPrefix, owner.Bytes()...)
+}
+
+// GetTokenizeShareRecordIDByOwnerAndIDKey returns the index key of a tokenize
+// share record owned by an address
+// VALUE: none
+func GetTokenizeShareRecordIDByOwnerAndIDKey(owner sdk.AccAddress, id uint64) []byte {
+	return append(GetTokenizeShareRecordIdsByOwnerPrefix(owner), sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetTokenizeShareRecordIDByDenomKey returns the key of the tokenize share
+// record id backing a share token denom
+// VALUE: big endian encoded record id
+func GetTokenizeShareRecordIDByDenomKey(denom string) []byte {
+	return append(TokenizeShareRecordIDByDenomPrefix, []byte(denom)...)
+}
+
+// GetValidatorLiquidSharesKey returns the key of the tokenized shares of a
+// validator
+// VALUE: sdk.Dec
+func GetValidatorLiquidSharesKey(valAddr sdk.ValAddress) []byte {
+	return append(ValidatorLiquidSharesPrefix, valAddr.Bytes()...)
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	sdk "github.com/line/lfb-sdk/types"
 	"github.com/line/lfb-sdk/x/staking/types"
 )
 
@@ -21,3 +22,36 @@ func TestParamsEqual(t *testing.T) {
 	ok = p1.Equal(p2)
 	require.False(t, ok)
 }
+
+func TestParamsValidateLiquidStakingCaps(t *testing.T) {
+	params := types.DefaultParams()
+	require.NoError(t, params.Validate())
+
+	params.GlobalLiquidStakingCap = sdk.NewDecWithPrec(25, 2)
+	params.ValidatorLiquidStakingCap = sdk.ZeroDec()
+	require.NoError(t, params.Validate())
+
+	params.GlobalLiquidStakingCap = sdk.NewDecWithPrec(-1, 2)
+	require.Error(t, params.Validate())
+
+	params.GlobalLiquidStakingCap = sdk.OneDec()
+	params.ValidatorLiquidStakingCap = sdk.NewDecWithPrec(101, 2)
+	require.Error(t, params.Validate())
+}
+
+func TestParamsValidateMinimums(t *testing.T) {
+	params := types.DefaultParams()
+	params.MinCommissionRate = sdk.NewDecWithPrec(5, 2)
+	params.MinSelfDelegation = sdk.NewInt(100)
+	require.NoError(t, params.Validate())
+
+	params.MinCommissionRate = sdk.NewDecWithPrec(-1, 2)
+	require.Error(t, params.Validate())
+
+	params.MinCommissionRate = sdk.NewDecWithPrec(101, 2)
+	require.Error(t, params.Validate())
+
+	params.MinCommissionRate = sdk.ZeroDec()
+	params.MinSelfDelegation = sdk.ZeroInt()
+	require.Error(t, params.Validate())
+}
@@ -12,13 +12,13 @@ import (
 	proto "github.com/gogo/protobuf/proto"
 	github_com_gogo_protobuf_protoc_gen_gogo_descriptor "github.com/gogo/protobuf/protoc-gen-gogo/descriptor"
 	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "github.com/golang/protobuf/ptypes/duration"
+	_ "github.com/golang/protobuf/ptypes/timestamp"
 	types1 "github.com/line/lfb-sdk/codec/types"
 	github_com_line_lfb_sdk_types "github.com/line/lfb-sdk/types"
 	types2 "github.com/line/lfb-sdk/types"
 	types "github.com/line/ostracon/proto/ostracon/types"
 	_ "github.com/regen-network/cosmos-proto"
-	_ "google.golang.org/protobuf/types/known/durationpb"
-	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	io_ioutil "io/ioutil"
 	math "math"
@@ -844,6 +844,18 @@ type Params struct {
 	HistoricalEntries uint32 `protobuf:"varint,4,opt,name=historical_entries,json=historicalEntries,proto3" json:"historical_entries,omitempty" yaml:"historical_entries"`
 	// bond_denom defines the bondable coin denomination.
 	BondDenom string `protobuf:"bytes,5,opt,name=bond_denom,json=bondDenom,proto3" json:"bond_denom,omitempty" yaml:"bond_denom"`
+	// global_liquid_staking_cap is the maximum fraction of the total bonded tokens
+	// that may be converted into tokenized shares.
+	GlobalLiquidStakingCap github_com_line_lfb_sdk_types.Dec `protobuf:"bytes,6,opt,name=global_liquid_staking_cap,json=globalLiquidStakingCap,proto3,customtype=github.com/line/lfb-sdk/types.Dec" json:"global_liquid_staking_cap" yaml:"global_liquid_staking_cap"`
+	// validator_liquid_staking_cap is the maximum fraction of a validator's
+	// delegator shares that may be converted into tokenized shares.
+	ValidatorLiquidStakingCap github_com_line_lfb_sdk_types.Dec `protobuf:"bytes,7,opt,name=validator_liquid_staking_cap,json=validatorLiquidStakingCap,proto3,customtype=github.com/line/lfb-sdk/types.Dec" json:"validator_liquid_staking_cap" yaml:"validator_liquid_staking_cap"`
+	// min_commission_rate is the chain-wide minimum commission rate that a
+	// validator can charge its delegators.
+	MinCommissionRate github_com_line_lfb_sdk_types.Dec `protobuf:"bytes,8,opt,name=min_commission_rate,json=minCommissionRate,proto3,customtype=github.com/line/lfb-sdk/types.Dec" json:"min_commission_rate" yaml:"min_commission_rate"`
+	// min_self_delegation is the chain-wide floor of the minimum self delegation
+	// a validator can declare.
+	MinSelfDelegation github_com_line_lfb_sdk_types.Int `protobuf:"bytes,9,opt,name=min_self_delegation,json=minSelfDelegation,proto3,customtype=github.com/line/lfb-sdk/types.Int" json:"min_self_delegation" yaml:"min_self_delegation"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -1109,6 +1121,81 @@ func (m *Pool) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Pool proto.InternalMessageInfo
 
+// TokenizeShareRecord represents a delegation that has been converted into
+// transferable share tokens. The delegation is held by a module account owned
+// by the record and the share tokens carry the denom `{validator}/{id}`.
+type TokenizeShareRecord struct {
+	// id is the unique identifier of the record.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// owner is the address entitled to the rewards accrued by the record.
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// module_account is the address of the account holding the delegation.
+	ModuleAccount string `protobuf:"bytes,3,opt,name=module_account,json=moduleAccount,proto3" json:"module_account,omitempty" yaml:"module_account"`
+	// validator is the operator address of the validator delegated to.
+	Validator string `protobuf:"bytes,4,opt,name=validator,proto3" json:"validator,omitempty"`
+}
+
+func (m *TokenizeShareRecord) Reset()         { *m = TokenizeShareRecord{} }
+func (m *TokenizeShareRecord) String() string { return proto.CompactTextString(m) }
+func (*TokenizeShareRecord) ProtoMessage()    {}
+func (*TokenizeShareRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7e7ccde09813ae51, []int{20}
+}
+func (m *TokenizeShareRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TokenizeShareRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TokenizeShareRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TokenizeShareRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenizeShareRecord.Merge(m, src)
+}
+func (m *TokenizeShareRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *TokenizeShareRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenizeShareRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenizeShareRecord proto.InternalMessageInfo
+
+func (m *TokenizeShareRecord) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *TokenizeShareRecord) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *TokenizeShareRecord) GetModuleAccount() string {
+	if m != nil {
+		return m.ModuleAccount
+	}
+	return ""
+}
+
+func (m *TokenizeShareRecord) GetValidator() string {
+	if m != nil {
+		return m.Validator
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterEnum("lfb.staking.v1beta1.BondStatus", BondStatus_name, BondStatus_value)
 	proto.RegisterType((*HistoricalInfo)(nil), "lfb.staking.v1beta1.HistoricalInfo")
@@ -1131,125 +1218,137 @@ func init() {
 	proto.RegisterType((*RedelegationEntryResponse)(nil), "lfb.staking.v1beta1.RedelegationEntryResponse")
 	proto.RegisterType((*RedelegationResponse)(nil), "lfb.staking.v1beta1.RedelegationResponse")
 	proto.RegisterType((*Pool)(nil), "lfb.staking.v1beta1.Pool")
+	proto.RegisterType((*TokenizeShareRecord)(nil), "lfb.staking.v1beta1.TokenizeShareRecord")
 }
 
 func init() { proto.RegisterFile("lfb/staking/v1beta1/staking.proto", fileDescriptor_7e7ccde09813ae51) }
 
 var fileDescriptor_7e7ccde09813ae51 = []byte{
-	// 1798 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x58, 0x4d, 0x6c, 0x23, 0x49,
-	0x15, 0x76, 0xdb, 0x5e, 0xc7, 0x7e, 0xce, 0xc4, 0x71, 0x4d, 0x66, 0xd6, 0xf1, 0x0c, 0x6e, 0x4f,
-	0x0b, 0x2d, 0x01, 0x31, 0x0e, 0x1b, 0x56, 0xbb, 0xd2, 0x68, 0x41, 0xc4, 0x71, 0x66, 0x13, 0x2d,
-	0x0c, 0x51, 0x27, 0x13, 0x04, 0x7b, 0xb0, 0xca, 0xdd, 0x15, 0xa7, 0x37, 0xed, 0x6e, 0xd3, 0x55,
-	0x9e, 0x89, 0x6f, 0x48, 0x80, 0x34, 0x9a, 0xd3, 0xee, 0x6d, 0x2f, 0x91, 0x22, 0x71, 0xe5, 0x88,
-	0x90, 0x38, 0x73, 0x59, 0x38, 0xa0, 0x39, 0x22, 0x40, 0x06, 0xcd, 0x5c, 0x10, 0x27, 0x64, 0x04,
-	0x67, 0x54, 0x3f, 0xfd, 0x93, 0xb6, 0x67, 0x27, 0x91, 0xf6, 0xb0, 0x12, 0x5c, 0x2c, 0xd7, 0xab,
-	0xf7, 0xbe, 0x57, 0xef, 0xb7, 0x5e, 0x35, 0xdc, 0x71, 0x8f, 0x7a, 0xeb, 0x94, 0xe1, 0x13, 0xc7,
-	0xeb, 0xaf, 0x3f, 0x7a, 0xb3, 0x47, 0x18, 0x7e, 0x33, 0x5c, 0xb7, 0x86, 0x81, 0xcf, 0x7c, 0x74,
-	0xdd, 0x3d, 0xea, 0xb5, 0x42, 0x92, 0x62, 0xa9, 0xaf, 0xf4, 0xfd, 0xbe, 0x2f, 0xf6, 0xd7, 0xf9,
-	0x3f, 0xc9, 0x5a, 0x5f, 0xed, 0xfb, 0x7e, 0xdf, 0x25, 0xeb, 0x62, 0xd5, 0x1b, 0x1d, 0xad, 0x63,
-	0x6f, 0xac, 0xb6, 0x1a, 0xe9, 0x2d, 0x7b, 0x14, 0x60, 0xe6, 0xf8, 0x9e, 0xda, 0xd7, 0xd3, 0xfb,
-	0xcc, 0x19, 0x10, 0xca, 0xf0, 0x60, 0x18, 0x62, 0x5b, 0x3e, 0x1d, 0xf8, 0xb4, 0x2b, 0x95, 0xca,
-	0x85, 0xda, 0xba, 0xc5, 0x8d, 0xe8, 0x61, 0x4a, 0x22, 0x0b, 0x2c, 0xdf, 0x09, 0x81, 0xeb, 0x3e,
-	0x65, 0x01, 0xb6, 0x7c, 0x6f, 0x9d, 0x8d, 0x87, 0x84, 0xca, 0x5f, 0xb9, 0x67, 0xfc, 0x4c, 0x83,
-	0xa5, 0x1d, 0x87, 0x32, 0x3f, 0x70, 0x2c, 0xec, 0xee, 0x7a, 0x47, 0x3e, 0x7a, 0x0b, 0x0a, 0xc7,
-	0x04, 0xdb, 0x24, 0xa8, 0x69, 0x4d, 0x6d, 0xad, 0xbc, 0x71, 0xb3, 0x15, 0xca, 0xb7, 0xa4, 0xe4,
-	0x8e, 0xd8, 0x6d, 0xe7, 0x3f, 0x9d, 0xe8, 0x19, 0x53, 0xf1, 0xa2, 0x77, 0xa1, 0xf0, 0x08, 0xbb,
-	0x94, 0xb0, 0x5a, 0xb6, 0x99, 0x5b, 0x2b, 0x6f, 0x34, 0x5a, 0x73, 0x9c, 0xd6, 0x3a, 0xc4, 0xae,
-	0x63, 0x63, 0xe6, 0x47, 0xd2, 0x52, 0xc6, 0x38, 0xcf, 0x42, 0x65, 0xcb, 0x1f, 0x0c, 0x1c, 0x4a,
-	0x1d, 0xdf, 0x33, 0x31, 0x23, 0x14, 0x7d, 0x0b, 0xf2, 0x01, 0x66, 0x44, 0x9c, 0xa2, 0xd4, 0xfe,
-	0x2a, 0xe7, 0xff, 0xd3, 0x44, 0xbf, 0xd3, 0x77, 0xd8, 0xf1, 0xa8, 0xd7, 0xb2, 0xfc, 0xc1, 0xba,
-	0xeb, 0x78, 0x64, 0xdd, 0x3d, 0xea, 0xdd, 0xa5, 0xf6, 0x89, 0xb2, 0xaa, 0x43, 0x2c, 0x53, 0x88,
-	0xa1, 0x1f, 0x40, 0x71, 0x80, 0x4f, 0xbb, 0x02, 0x22, 0x2b, 0x20, 0xde, 0xbd, 0x34, 0xc4, 0x74,
-	0xa2, 0x57, 0xc6, 0x78, 0xe0, 0xde, 0x33, 0x42, 0x08, 0xc3, 0x5c, 0x18, 0xe0, 0x53, 0x7e, 0x30,
-	0x74, 0x02, 0x15, 0x4e, 0xb5, 0x8e, 0xb1, 0xd7, 0x27, 0x12, 0x3f, 0x27, 0xf0, 0xb7, 0xae, 0x82,
-	0x7f, 0x33, 0xc6, 0x4f, 0x20, 0x19, 0xe6, 0xb5, 0x01, 0x3e, 0xdd, 0x12, 0x04, 0xae, 0xec, 0x5e,
-	0xf1, 0x93, 0x73, 0x3d, 0xf3, 0xf7, 0x73, 0x5d, 0x33, 0xfe, 0xa0, 0x01, 0xc4, 0x2e, 0x42, 0x3f,
-	0x84, 0x65, 0x2b, 0x5a, 0x09, 0x59, 0xaa, 0xe2, 0xf5, 0xe5, 0xb9, 0x9e, 0x4f, 0x79, 0xb7, 0x5d,
-	0xe4, 0x87, 0x7d, 0x36, 0xd1, 0x35, 0xb3, 0x62, 0xa5, 0x1c, 0xff, 0x01, 0x94, 0x47, 0x43, 0x1b,
-	0x33, 0xd2, 0xe5, 0x19, 0x28, 0x9c, 0x57, 0xde, 0xa8, 0xb7, 0x64, 0x7a, 0xb6, 0xc2, 0xf4, 0x6c,
-	0x1d, 0x84, 0xe9, 0xd9, 0x6e, 0x70, 0xac, 0xe9, 0x44, 0x47, 0xd2, 0xa6, 0x84, 0xb0, 0xf1, 0xd1,
-	0x5f, 0x75, 0xcd, 0x04, 0x49, 0xe1, 0x02, 0x09, 0x83, 0x7e, 0xa7, 0x41, 0xb9, 0x43, 0xa8, 0x15,
-	0x38, 0x43, 0x5e, 0x05, 0xa8, 0x06, 0x0b, 0x03, 0xdf, 0x73, 0x4e, 0x54, 0xe2, 0x95, 0xcc, 0x70,
-	0x89, 0xea, 0x50, 0x74, 0x6c, 0xe2, 0x31, 0x87, 0x8d, 0x65, 0x28, 0xcd, 0x68, 0xcd, 0xa5, 0x1e,
-	0x93, 0x1e, 0x75, 0xc2, 0x28, 0x98, 0xe1, 0x12, 0xdd, 0x87, 0x65, 0x4a, 0xac, 0x51, 0xe0, 0xb0,
-	0x71, 0xd7, 0xf2, 0x3d, 0x86, 0x2d, 0x56, 0xcb, 0x8b, 0x40, 0xdd, 0x9a, 0x4e, 0xf4, 0xd7, 0xe5,
-	0x59, 0xd3, 0x1c, 0x86, 0x59, 0x09, 0x49, 0x5b, 0x92, 0xc2, 0x35, 0xd8, 0x84, 0x61, 0xc7, 0xa5,
-	0xb5, 0xd7, 0xa4, 0x06, 0xb5, 0x4c, 0xd8, 0xf2, 0xf1, 0x02, 0x94, 0xa2, 0xdc, 0xe6, 0x9a, 0xfd,
-	0x21, 0x09, 0xf8, 0xff, 0x2e, 0xb6, 0xed, 0x80, 0x50, 0xaa, 0xb2, 0x38, 0xa1, 0x39, 0xcd, 0x61,
-	0x98, 0x95, 0x90, 0xb4, 0x29, 0x29, 0xe8, 0xc7, 0x3c, 0xc6, 0x1e, 0x25, 0x1e, 0x1d, 0xd1, 0xee,
-	0x70, 0xd4, 0x3b, 0x21, 0x63, 0x15, 0x8d, 0x95, 0x99, 0x68, 0x6c, 0x7a, 0xe3, 0xf6, 0x37, 0x62,
-	0xf4, 0xb4, 0x9c, 0xf1, 0xfb, 0x5f, 0xdd, 0xad, 0xf2, 0xbc, 0xb0, 0x82, 0xf1, 0x90, 0xf9, 0xad,
-	0xbd, 0x51, 0xef, 0x7d, 0x32, 0xe6, 0xb1, 0x57, 0x7c, 0x7b, 0x82, 0x0d, 0xdd, 0x84, 0xc2, 0x87,
-	0xd8, 0x71, 0x89, 0x2d, 0xbc, 0x59, 0x34, 0xd5, 0x0a, 0xbd, 0x03, 0x05, 0xca, 0x30, 0x1b, 0x51,
-	0xe1, 0xc2, 0xa5, 0x0d, 0x7d, 0x6e, 0x92, 0xb5, 0x7d, 0xcf, 0xde, 0x17, 0x6c, 0xa6, 0x62, 0x47,
-	0x9b, 0x50, 0x60, 0xfe, 0x09, 0xf1, 0x94, 0xf3, 0x2e, 0x5b, 0xc7, 0xbb, 0x1e, 0x33, 0x95, 0x20,
-	0xf2, 0x61, 0xd9, 0x26, 0x2e, 0xe9, 0x0b, 0x6f, 0xd1, 0x63, 0x1c, 0x10, 0x5a, 0x2b, 0x08, 0xb0,
-	0xce, 0x55, 0x2a, 0x4e, 0x79, 0x26, 0x0d, 0x65, 0x98, 0x95, 0x88, 0xb4, 0x2f, 0x28, 0x68, 0x07,
-	0xca, 0x76, 0x9c, 0x98, 0xb5, 0x05, 0xe1, 0xf2, 0xe6, 0x5c, 0x8b, 0x13, 0x09, 0xac, 0x5a, 0x5a,
-	0x52, 0x94, 0x67, 0xc2, 0xc8, 0xeb, 0xf9, 0x9e, 0xed, 0x78, 0xfd, 0xee, 0x31, 0x71, 0xfa, 0xc7,
-	0xac, 0x56, 0x6c, 0x6a, 0x6b, 0xb9, 0x64, 0x26, 0xa4, 0x39, 0x0c, 0xb3, 0x12, 0x91, 0x76, 0x04,
-	0x05, 0xd9, 0xb0, 0x14, 0x73, 0x89, 0xaa, 0x2c, 0xbd, 0xb2, 0x2a, 0xef, 0xa8, 0xaa, 0xbc, 0x91,
-	0xd6, 0x12, 0x17, 0xe6, 0xb5, 0x88, 0xc8, 0xc5, 0xd0, 0x36, 0x40, 0xdc, 0x0b, 0x6a, 0x20, 0x34,
-	0xe8, 0xaf, 0xe8, 0x26, 0xca, 0xea, 0x84, 0x20, 0x7a, 0x0c, 0xd7, 0x07, 0x8e, 0xd7, 0xa5, 0xc4,
-	0x3d, 0xea, 0x2a, 0xd7, 0x72, 0xbc, 0xb2, 0x08, 0xd9, 0x7b, 0x97, 0x8e, 0xff, 0x74, 0xa2, 0xd7,
-	0x55, 0x93, 0x9c, 0x45, 0x33, 0xcc, 0xea, 0xc0, 0xf1, 0xf6, 0x89, 0x7b, 0xd4, 0x89, 0x68, 0xf7,
-	0x16, 0x9f, 0x9c, 0xeb, 0x19, 0x55, 0x93, 0x19, 0xe3, 0x6d, 0x58, 0x3c, 0xc4, 0xae, 0xaa, 0x25,
-	0x42, 0xd1, 0x6d, 0x28, 0xe1, 0x70, 0x51, 0xd3, 0x9a, 0xb9, 0xb5, 0x92, 0x19, 0x13, 0x64, 0x2d,
-	0xff, 0xe4, 0x2f, 0x4d, 0xcd, 0xf8, 0xa5, 0x06, 0x85, 0xce, 0xe1, 0x1e, 0x76, 0x02, 0xb4, 0x0b,
-	0xd5, 0x38, 0x5d, 0x2e, 0x56, 0xf2, 0xed, 0xe9, 0x44, 0xaf, 0xa5, 0x33, 0x2a, 0x2a, 0xe5, 0x38,
-	0x61, 0xc3, 0x5a, 0xde, 0x85, 0xea, 0xa3, 0xb0, 0x41, 0x44, 0x50, 0xd9, 0x34, 0xd4, 0x0c, 0x8b,
-	0x61, 0x2e, 0x47, 0x34, 0x05, 0x95, 0x32, 0xb3, 0x0d, 0x0b, 0xf2, 0xb4, 0x14, 0xbd, 0x03, 0xaf,
-	0x0d, 0xf9, 0x1f, 0x61, 0x5d, 0x79, 0xe3, 0xd6, 0xfc, 0x8c, 0x15, 0xcc, 0x2a, 0x6c, 0x92, 0xdf,
-	0xf8, 0x38, 0x0b, 0xd0, 0x39, 0x3c, 0x3c, 0x08, 0x9c, 0xa1, 0x4b, 0xd8, 0xe7, 0x69, 0xf6, 0x01,
-	0xdc, 0x88, 0x6d, 0xa2, 0x81, 0x95, 0x32, 0xbd, 0x39, 0x9d, 0xe8, 0xb7, 0xd3, 0xa6, 0x27, 0xd8,
-	0x0c, 0xf3, 0x7a, 0x44, 0xdf, 0x0f, 0xac, 0xb9, 0xa8, 0x36, 0x65, 0x11, 0x6a, 0xee, 0xe5, 0xa8,
-	0x09, 0xb6, 0x24, 0x6a, 0x87, 0xb2, 0xf9, 0x7e, 0xdd, 0x83, 0x72, 0xec, 0x12, 0xde, 0xc7, 0x8a,
-	0x4c, 0xfd, 0x57, 0xee, 0xd5, 0x5f, 0xe2, 0xde, 0x50, 0x46, 0xb9, 0x38, 0x12, 0x33, 0xfe, 0xa5,
-	0x01, 0xc4, 0xd9, 0xfa, 0xc5, 0x4c, 0x2e, 0xde, 0xaf, 0x55, 0x8b, 0xcd, 0x5d, 0x75, 0xee, 0x52,
-	0x82, 0x29, 0x3f, 0x3e, 0xc9, 0xc2, 0xf5, 0x87, 0x61, 0x9b, 0xf9, 0xc2, 0x9b, 0xff, 0x3d, 0x58,
-	0x20, 0x1e, 0x0b, 0x1c, 0x61, 0x3f, 0x8f, 0xf2, 0xdd, 0xb9, 0x51, 0x9e, 0x63, 0xd0, 0xb6, 0xc7,
-	0x82, 0xb1, 0x8a, 0x79, 0x88, 0x91, 0x72, 0xc5, 0xcf, 0x73, 0x50, 0x7b, 0x99, 0x24, 0xda, 0x82,
-	0x8a, 0x15, 0x10, 0x41, 0x08, 0x6f, 0x0a, 0x4d, 0xdc, 0x14, 0xf5, 0x78, 0x5a, 0x4c, 0x31, 0x18,
-	0xe6, 0x52, 0x48, 0x51, 0xf7, 0x44, 0x1f, 0xf8, 0x34, 0xc7, 0xd3, 0x8d, 0x73, 0x5d, 0x72, 0x7c,
-	0x33, 0xd4, 0x45, 0x11, 0x2a, 0xb9, 0x08, 0x20, 0x6f, 0x8a, 0xa5, 0x98, 0x2a, 0xae, 0x0a, 0x17,
-	0x2a, 0x8e, 0xe7, 0x30, 0x07, 0xbb, 0xdd, 0x1e, 0x76, 0xb1, 0x67, 0x5d, 0x71, 0x08, 0x96, 0xfd,
-	0x5d, 0x69, 0x4c, 0x21, 0x19, 0xe6, 0x92, 0xa2, 0xb4, 0x25, 0x01, 0x6d, 0xc1, 0x42, 0xa8, 0x25,
-	0x7f, 0xd5, 0x29, 0x22, 0x94, 0x4c, 0x4c, 0x6b, 0x3f, 0xcd, 0x41, 0xd5, 0x24, 0xf6, 0xff, 0x03,
-	0x70, 0xe9, 0x00, 0xec, 0x00, 0xc8, 0xe2, 0xe6, 0x6d, 0xf4, 0x6a, 0x31, 0xe0, 0x9d, 0xa1, 0x24,
-	0x85, 0x3b, 0x94, 0x25, 0xa2, 0xf0, 0xe7, 0x2c, 0x2c, 0x26, 0xa3, 0xf0, 0x3f, 0x7a, 0xed, 0xa0,
-	0xfb, 0x71, 0xcb, 0xc9, 0x8b, 0x96, 0xf3, 0xc6, 0xdc, 0x96, 0x33, 0x93, 0xb0, 0x9f, 0xdd, 0x6b,
-	0xfe, 0x93, 0x85, 0xc2, 0x1e, 0x0e, 0xf0, 0x80, 0x22, 0x6b, 0x66, 0x78, 0x94, 0x0f, 0xc5, 0xd5,
-	0x99, 0x94, 0xec, 0xa8, 0x2f, 0x12, 0xaf, 0x98, 0x1d, 0x3f, 0x99, 0x33, 0x3b, 0x7e, 0x07, 0x96,
-	0xf8, 0x5b, 0x36, 0x32, 0x50, 0xba, 0xfa, 0x5a, 0x7b, 0x35, 0x46, 0xb9, 0xb8, 0x2f, 0x9f, 0xba,
-	0xd1, 0xa3, 0x89, 0x4f, 0x2f, 0x65, 0xce, 0x11, 0xb7, 0x5f, 0x2e, 0x7e, 0x33, 0x7e, 0x56, 0x26,
-	0x36, 0x0d, 0x13, 0x06, 0xf8, 0x74, 0x5b, 0x2e, 0xd0, 0x77, 0x01, 0x1d, 0x47, 0x9f, 0x30, 0xba,
-	0xb1, 0x2f, 0xb9, 0xfc, 0x97, 0xa6, 0x13, 0x7d, 0x55, 0xca, 0xcf, 0xf2, 0x18, 0x66, 0x35, 0x26,
-	0x86, 0x68, 0x6f, 0x01, 0x70, 0xbb, 0xba, 0x36, 0xf1, 0xfc, 0x81, 0x7a, 0xb4, 0xdc, 0x98, 0x4e,
-	0xf4, 0xaa, 0x44, 0x89, 0xf7, 0x0c, 0xb3, 0xc4, 0x17, 0x1d, 0xfe, 0x3f, 0x91, 0xd6, 0x67, 0x1a,
-	0xa0, 0xb8, 0xb7, 0x9b, 0x84, 0x0e, 0xf9, 0x13, 0x8b, 0xcf, 0xd6, 0x89, 0x59, 0x58, 0xfb, 0x8c,
-	0xd9, 0x3a, 0x16, 0x0e, 0x67, 0xeb, 0x44, 0x8d, 0xbc, 0x1d, 0x77, 0xc2, 0xac, 0xfa, 0x3a, 0xc3,
-	0x31, 0x7a, 0x98, 0x92, 0xc4, 0x70, 0xee, 0x84, 0xa2, 0x33, 0xcd, 0x2f, 0x63, 0xfc, 0x56, 0x83,
-	0xd5, 0x99, 0x5c, 0x8a, 0x8e, 0xf9, 0x01, 0xa0, 0x20, 0xb1, 0x29, 0x3c, 0x35, 0x56, 0xc7, 0xbd,
-	0x5a, 0x5e, 0x56, 0x83, 0x39, 0x1d, 0xf6, 0x73, 0x68, 0xe3, 0x79, 0xe1, 0xe5, 0xdf, 0x68, 0xb0,
-	0x92, 0xd4, 0x1c, 0x19, 0xf0, 0x3e, 0x2c, 0x26, 0x15, 0xab, 0xa3, 0xdf, 0x79, 0xe5, 0xd1, 0xd5,
-	0xa9, 0x2f, 0x08, 0xa3, 0x07, 0x71, 0x69, 0xca, 0xaf, 0x5a, 0xad, 0xcb, 0xb9, 0x20, 0x3c, 0x4d,
-	0xba, 0x44, 0xf3, 0x22, 0x02, 0xff, 0xd6, 0x20, 0xbf, 0xe7, 0xfb, 0x2e, 0xfa, 0x10, 0xaa, 0x9e,
-	0xcf, 0xba, 0x3c, 0x8b, 0x88, 0xdd, 0x55, 0xcf, 0x64, 0xd9, 0xf0, 0xbe, 0x7d, 0x69, 0xcf, 0xfc,
-	0x63, 0xa2, 0xcf, 0xa2, 0x98, 0x15, 0xcf, 0x67, 0x6d, 0x41, 0x39, 0x90, 0x8f, 0xe8, 0xc7, 0x70,
-	0xed, 0xa2, 0x1e, 0xd9, 0x09, 0xcd, 0xab, 0xe8, 0xb9, 0x88, 0x30, 0x9d, 0xe8, 0x2b, 0x71, 0x4d,
-	0x44, 0x64, 0xc3, 0x5c, 0xec, 0x25, 0x14, 0xdf, 0x2b, 0xf2, 0x78, 0xfd, 0xf3, 0x5c, 0xd7, 0xbe,
-	0xf6, 0x6b, 0x0d, 0x20, 0xfe, 0x42, 0x80, 0xbe, 0x0e, 0xaf, 0xb7, 0xbf, 0xff, 0xa0, 0xd3, 0xdd,
-	0x3f, 0xd8, 0x3c, 0x78, 0xb8, 0xdf, 0x7d, 0xf8, 0x60, 0x7f, 0x6f, 0x7b, 0x6b, 0xf7, 0xfe, 0xee,
-	0x76, 0x67, 0x39, 0x53, 0xaf, 0x3c, 0x3d, 0x6b, 0x96, 0x1f, 0x7a, 0x74, 0x48, 0x2c, 0xe7, 0xc8,
-	0x21, 0x36, 0x7a, 0x03, 0x56, 0x2e, 0x72, 0xf3, 0xd5, 0x76, 0x67, 0x59, 0xab, 0x2f, 0x3e, 0x3d,
-	0x6b, 0x16, 0xe5, 0x58, 0x45, 0x6c, 0xb4, 0x06, 0x37, 0x66, 0xf9, 0x76, 0x1f, 0xbc, 0xb7, 0x9c,
-	0xad, 0x5f, 0x7b, 0x7a, 0xd6, 0x2c, 0x45, 0xf3, 0x17, 0x32, 0x00, 0x25, 0x39, 0x15, 0x5e, 0xae,
-	0x0e, 0x4f, 0xcf, 0x9a, 0x05, 0xe9, 0xbb, 0x7a, 0xfe, 0xc9, 0x2f, 0x1a, 0x99, 0xf6, 0xe6, 0xa7,
-	0xcf, 0x1b, 0xda, 0xb3, 0xe7, 0x0d, 0xed, 0x6f, 0xcf, 0x1b, 0xda, 0x47, 0x2f, 0x1a, 0x99, 0x67,
-	0x2f, 0x1a, 0x99, 0x3f, 0xbe, 0x68, 0x64, 0x7e, 0xf4, 0x95, 0x97, 0xb9, 0xed, 0x34, 0xfa, 0xa4,
-	0x2c, 0x1c, 0xd8, 0x2b, 0x88, 0x1e, 0xfb, 0xcd, 0xff, 0x06, 0x00, 0x00, 0xff, 0xff, 0x29, 0x07,
-	0x94, 0x29, 0x6e, 0x16, 0x00, 0x00,
+	// 1970 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0x4d, 0x6c, 0x23, 0x49,
+	0x15, 0x76, 0x3b, 0x1e, 0xc7, 0x7e, 0x4e, 0xe2, 0xa4, 0x92, 0xc9, 0x3a, 0x9e, 0xc1, 0xed, 0x69,
+	0xd0, 0x12, 0x10, 0xe3, 0xb0, 0x61, 0xb5, 0x2b, 0x8d, 0x16, 0x44, 0x1c, 0x67, 0x36, 0xd1, 0xee,
+	0x0e, 0x51, 0x27, 0x13, 0x04, 0x7b, 0x68, 0x95, 0xbb, 0x2b, 0x4e, 0x6f, 0xda, 0xdd, 0xde, 0xee,
+	0xf2, 0x4c, 0xcc, 0x09, 0x09, 0x10, 0xa3, 0x39, 0xed, 0xdc, 0x56, 0x42, 0x91, 0x46, 0xe2, 0xca,
+	0x11, 0x21, 0x71, 0xe6, 0xb2, 0x70, 0x40, 0x73, 0x44, 0x80, 0x0c, 0x9a, 0xb9, 0x20, 0x4e, 0x28,
+	0x88, 0x3b, 0xaa, 0x9f, 0xfe, 0x71, 0xdb, 0xd9, 0x89, 0xa5, 0x45, 0x5a, 0x89, 0xbd, 0x58, 0x5d,
+	0xaf, 0xde, 0xfb, 0xea, 0xfd, 0xd5, 0xab, 0x7a, 0x65, 0xb8, 0xe5, 0x1c, 0xb7, 0x37, 0x02, 0x8a,
+	0x4f, 0x6d, 0xb7, 0xb3, 0xf1, 0xe0, 0xb5, 0x36, 0xa1, 0xf8, 0xb5, 0x70, 0xdc, 0xe8, 0xf9, 0x1e,
+	0xf5, 0xd0, 0xb2, 0x73, 0xdc, 0x6e, 0x84, 0x24, 0xc9, 0x52, 0x5d, 0xe9, 0x78, 0x1d, 0x8f, 0xcf,
+	0x6f, 0xb0, 0x2f, 0xc1, 0x5a, 0x5d, 0xeb, 0x78, 0x5e, 0xc7, 0x21, 0x1b, 0x7c, 0xd4, 0xee, 0x1f,
+	0x6f, 0x60, 0x77, 0x20, 0xa7, 0x6a, 0xe9, 0x29, 0xab, 0xef, 0x63, 0x6a, 0x7b, 0xae, 0x9c, 0x57,
+	0xd3, 0xf3, 0xd4, 0xee, 0x92, 0x80, 0xe2, 0x6e, 0x2f, 0xc4, 0x36, 0xbd, 0xa0, 0xeb, 0x05, 0x86,
+	0x58, 0x54, 0x0c, 0xe4, 0xd4, 0x0d, 0x66, 0x44, 0x1b, 0x07, 0x24, 0xb2, 0xc0, 0xf4, 0xec, 0x10,
+	0xb8, 0xea, 0x05, 0xd4, 0xc7, 0xa6, 0xe7, 0x6e, 0xd0, 0x41, 0x8f, 0x04, 0xe2, 0x57, 0xcc, 0x69,
+	0x3f, 0x55, 0x60, 0x61, 0xd7, 0x0e, 0xa8, 0xe7, 0xdb, 0x26, 0x76, 0xf6, 0xdc, 0x63, 0x0f, 0xbd,
+	0x0e, 0xf9, 0x13, 0x82, 0x2d, 0xe2, 0x57, 0x94, 0xba, 0xb2, 0x5e, 0xda, 0x5c, 0x6d, 0x84, 0xf2,
+	0x0d, 0x21, 0xb9, 0xcb, 0x67, 0x9b, 0xb9, 0x4f, 0x86, 0x6a, 0x46, 0x97, 0xbc, 0xe8, 0x2d, 0xc8,
+	0x3f, 0xc0, 0x4e, 0x40, 0x68, 0x25, 0x5b, 0x9f, 0x59, 0x2f, 0x6d, 0xd6, 0x1a, 0x13, 0x9c, 0xd6,
+	0x38, 0xc2, 0x8e, 0x6d, 0x61, 0xea, 0x45, 0xd2, 0x42, 0x46, 0x7b, 0x9a, 0x85, 0xf2, 0xb6, 0xd7,
+	0xed, 0xda, 0x41, 0x60, 0x7b, 0xae, 0x8e, 0x29, 0x09, 0xd0, 0xb7, 0x21, 0xe7, 0x63, 0x4a, 0xb8,
+	0x16, 0xc5, 0xe6, 0xd7, 0x18, 0xff, 0x9f, 0x87, 0xea, 0xad, 0x8e, 0x4d, 0x4f, 0xfa, 0xed, 0x86,
+	0xe9, 0x75, 0x37, 0x1c, 0xdb, 0x25, 0x1b, 0xce, 0x71, 0xfb, 0x76, 0x60, 0x9d, 0x4a, 0xab, 0x5a,
+	0xc4, 0xd4, 0xb9, 0x18, 0xfa, 0x3e, 0x14, 0xba, 0xf8, 0xcc, 0xe0, 0x10, 0x59, 0x0e, 0xf1, 0xd6,
+	0x95, 0x21, 0x2e, 0x86, 0x6a, 0x79, 0x80, 0xbb, 0xce, 0x1d, 0x2d, 0x84, 0xd0, 0xf4, 0xd9, 0x2e,
+	0x3e, 0x63, 0x8a, 0xa1, 0x53, 0x28, 0x33, 0xaa, 0x79, 0x82, 0xdd, 0x0e, 0x11, 0xf8, 0x33, 0x1c,
+	0x7f, 0x7b, 0x1a, 0xfc, 0xd5, 0x18, 0x3f, 0x81, 0xa4, 0xe9, 0xf3, 0x5d, 0x7c, 0xb6, 0xcd, 0x09,
+	0x6c, 0xb1, 0x3b, 0x85, 0x8f, 0x9f, 0xaa, 0x99, 0x7f, 0x3c, 0x55, 0x15, 0xed, 0x8f, 0x0a, 0x40,
+	0xec, 0x22, 0xf4, 0x03, 0x58, 0x34, 0xa3, 0x11, 0x97, 0x0d, 0x64, 0xbc, 0xbe, 0x32, 0xd1, 0xf3,
+	0x29, 0xef, 0x36, 0x0b, 0x4c, 0xd9, 0x67, 0x43, 0x55, 0xd1, 0xcb, 0x66, 0xca, 0xf1, 0xef, 0x43,
+	0xa9, 0xdf, 0xb3, 0x30, 0x25, 0x06, 0xcb, 0x40, 0xee, 0xbc, 0xd2, 0x66, 0xb5, 0x21, 0xd2, 0xb3,
+	0x11, 0xa6, 0x67, 0xe3, 0x30, 0x4c, 0xcf, 0x66, 0x8d, 0x61, 0x5d, 0x0c, 0x55, 0x24, 0x6c, 0x4a,
+	0x08, 0x6b, 0x1f, 0xfd, 0x4d, 0x55, 0x74, 0x10, 0x14, 0x26, 0x90, 0x30, 0xe8, 0xf7, 0x0a, 0x94,
+	0x5a, 0x24, 0x30, 0x7d, 0xbb, 0xc7, 0x76, 0x01, 0xaa, 0xc0, 0x6c, 0xd7, 0x73, 0xed, 0x53, 0x99,
+	0x78, 0x45, 0x3d, 0x1c, 0xa2, 0x2a, 0x14, 0x6c, 0x8b, 0xb8, 0xd4, 0xa6, 0x03, 0x11, 0x4a, 0x3d,
+	0x1a, 0x33, 0xa9, 0x87, 0xa4, 0x1d, 0xd8, 0x61, 0x14, 0xf4, 0x70, 0x88, 0xee, 0xc2, 0x62, 0x40,
+	0xcc, 0xbe, 0x6f, 0xd3, 0x81, 0x61, 0x7a, 0x2e, 0xc5, 0x26, 0xad, 0xe4, 0x78, 0xa0, 0x6e, 0x5c,
+	0x0c, 0xd5, 0x57, 0x84, 0xae, 0x69, 0x0e, 0x4d, 0x2f, 0x87, 0xa4, 0x6d, 0x41, 0x61, 0x2b, 0x58,
+	0x84, 0x62, 0xdb, 0x09, 0x2a, 0xd7, 0xc4, 0x0a, 0x72, 0x98, 0xb0, 0xe5, 0xc9, 0x2c, 0x14, 0xa3,
+	0xdc, 0x66, 0x2b, 0x7b, 0x3d, 0xe2, 0xb3, 0x6f, 0x03, 0x5b, 0x96, 0x4f, 0x82, 0xa0, 0xa2, 0xa4,
+	0x57, 0x4e, 0x73, 0x68, 0x7a, 0x39, 0x24, 0x6d, 0x09, 0x0a, 0xfa, 0x90, 0xc5, 0xd8, 0x0d, 0x88,
+	0x1b, 0xf4, 0x03, 0xa3, 0xd7, 0x6f, 0x9f, 0x92, 0x81, 0x8c, 0xc6, 0xca, 0x58, 0x34, 0xb6, 0xdc,
+	0x41, 0xf3, 0x9b, 0x31, 0x7a, 0x5a, 0x4e, 0xfb, 0xc3, 0xaf, 0x6f, 0x2f, 0xb1, 0xbc, 0x30, 0xfd,
+	0x41, 0x8f, 0x7a, 0x8d, 0xfd, 0x7e, 0xfb, 0x1d, 0x32, 0xd0, 0xcb, 0x11, 0xdf, 0x3e, 0x67, 0x43,
+	0xab, 0x90, 0xff, 0x00, 0xdb, 0x0e, 0xb1, 0xb8, 0x37, 0x0b, 0xba, 0x1c, 0xa1, 0x37, 0x21, 0x1f,
+	0x50, 0x4c, 0xfb, 0x01, 0x77, 0xe1, 0xc2, 0xa6, 0x3a, 0x31, 0xc9, 0x9a, 0x9e, 0x6b, 0x1d, 0x70,
+	0x36, 0x5d, 0xb2, 0xa3, 0x2d, 0xc8, 0x53, 0xef, 0x94, 0xb8, 0xd2, 0x79, 0x57, 0xdd, 0xc7, 0x7b,
+	0x2e, 0xd5, 0xa5, 0x20, 0xf2, 0x60, 0xd1, 0x22, 0x0e, 0xe9, 0x70, 0x6f, 0x05, 0x27, 0xd8, 0x27,
+	0x41, 0x25, 0xcf, 0xc1, 0x5a, 0xd3, 0xec, 0x38, 0xe9, 0x99, 0x34, 0x94, 0xa6, 0x97, 0x23, 0xd2,
+	0x01, 0xa7, 0xa0, 0x5d, 0x28, 0x59, 0x71, 0x62, 0x56, 0x66, 0xb9, 0xcb, 0xeb, 0x13, 0x2d, 0x4e,
+	0x24, 0xb0, 0x2c, 0x69, 0x49, 0x51, 0x96, 0x09, 0x7d, 0xb7, 0xed, 0xb9, 0x96, 0xed, 0x76, 0x8c,
+	0x13, 0x62, 0x77, 0x4e, 0x68, 0xa5, 0x50, 0x57, 0xd6, 0x67, 0x92, 0x99, 0x90, 0xe6, 0xd0, 0xf4,
+	0x72, 0x44, 0xda, 0xe5, 0x14, 0x64, 0xc1, 0x42, 0xcc, 0xc5, 0x77, 0x65, 0xf1, 0xa5, 0xbb, 0xf2,
+	0x96, 0xdc, 0x95, 0xd7, 0xd3, 0xab, 0xc4, 0x1b, 0x73, 0x3e, 0x22, 0x32, 0x31, 0xb4, 0x03, 0x10,
+	0xd7, 0x82, 0x0a, 0xf0, 0x15, 0xd4, 0x97, 0x54, 0x13, 0x69, 0x75, 0x42, 0x10, 0x3d, 0x84, 0xe5,
+	0xae, 0xed, 0x1a, 0x01, 0x71, 0x8e, 0x0d, 0xe9, 0x5a, 0x86, 0x57, 0xe2, 0x21, 0x7b, 0xfb, 0xca,
+	0xf1, 0xbf, 0x18, 0xaa, 0x55, 0x59, 0x24, 0xc7, 0xd1, 0x34, 0x7d, 0xa9, 0x6b, 0xbb, 0x07, 0xc4,
+	0x39, 0x6e, 0x45, 0xb4, 0x3b, 0x73, 0x8f, 0x9e, 0xaa, 0x19, 0xb9, 0x27, 0x33, 0xda, 0x1b, 0x30,
+	0x77, 0x84, 0x1d, 0xb9, 0x97, 0x48, 0x80, 0x6e, 0x42, 0x11, 0x87, 0x83, 0x8a, 0x52, 0x9f, 0x59,
+	0x2f, 0xea, 0x31, 0x41, 0xec, 0xe5, 0x1f, 0xff, 0xb5, 0xae, 0x68, 0xbf, 0x52, 0x20, 0xdf, 0x3a,
+	0xda, 0xc7, 0xb6, 0x8f, 0xf6, 0x60, 0x29, 0x4e, 0x97, 0xd1, 0x9d, 0x7c, 0xf3, 0x62, 0xa8, 0x56,
+	0xd2, 0x19, 0x15, 0x6d, 0xe5, 0x38, 0x61, 0xc3, 0xbd, 0xbc, 0x07, 0x4b, 0x0f, 0xc2, 0x02, 0x11,
+	0x41, 0x65, 0xd3, 0x50, 0x63, 0x2c, 0x9a, 0xbe, 0x18, 0xd1, 0x24, 0x54, 0xca, 0xcc, 0x26, 0xcc,
+	0x0a, 0x6d, 0x03, 0xf4, 0x26, 0x5c, 0xeb, 0xb1, 0x0f, 0x6e, 0x5d, 0x69, 0xf3, 0xc6, 0xe4, 0x8c,
+	0xe5, 0xcc, 0x32, 0x6c, 0x82, 0x5f, 0x7b, 0x92, 0x05, 0x68, 0x1d, 0x1d, 0x1d, 0xfa, 0x76, 0xcf,
+	0x21, 0xf4, 0xb3, 0x34, 0xfb, 0x10, 0xae, 0xc7, 0x36, 0x05, 0xbe, 0x99, 0x32, 0xbd, 0x7e, 0x31,
+	0x54, 0x6f, 0xa6, 0x4d, 0x4f, 0xb0, 0x69, 0xfa, 0x72, 0x44, 0x3f, 0xf0, 0xcd, 0x89, 0xa8, 0x56,
+	0x40, 0x23, 0xd4, 0x99, 0xcb, 0x51, 0x13, 0x6c, 0x49, 0xd4, 0x56, 0x40, 0x27, 0xfb, 0x75, 0x1f,
+	0x4a, 0xb1, 0x4b, 0x58, 0x1d, 0x2b, 0x50, 0xf9, 0x2d, 0xdd, 0xab, 0x5e, 0xe2, 0xde, 0x50, 0x46,
+	0xba, 0x38, 0x12, 0xd3, 0xfe, 0xad, 0x00, 0xc4, 0xd9, 0xfa, 0xf9, 0x4c, 0x2e, 0x56, 0xaf, 0x65,
+	0x89, 0x9d, 0x99, 0xf6, 0xde, 0x25, 0x05, 0x53, 0x7e, 0x7c, 0x94, 0x85, 0xe5, 0xfb, 0x61, 0x99,
+	0xf9, 0xdc, 0x9b, 0xff, 0x1e, 0xcc, 0x12, 0x97, 0xfa, 0x36, 0xb7, 0x9f, 0x45, 0xf9, 0xf6, 0xc4,
+	0x28, 0x4f, 0x30, 0x68, 0xc7, 0xa5, 0xfe, 0x40, 0xc6, 0x3c, 0xc4, 0x48, 0xb9, 0xe2, 0x67, 0x33,
+	0x50, 0xb9, 0x4c, 0x12, 0x6d, 0x43, 0xd9, 0xf4, 0x09, 0x27, 0x84, 0x27, 0x85, 0xc2, 0x4f, 0x8a,
+	0x6a, 0x7c, 0x5b, 0x4c, 0x31, 0x68, 0xfa, 0x42, 0x48, 0x91, 0xe7, 0x44, 0x07, 0xd8, 0x6d, 0x8e,
+	0xa5, 0x1b, 0xe3, 0xba, 0xe2, 0xf5, 0x4d, 0x93, 0x07, 0x45, 0xb8, 0xc8, 0x28, 0x80, 0x38, 0x29,
+	0x16, 0x62, 0x2a, 0x3f, 0x2a, 0x1c, 0x28, 0xdb, 0xae, 0x4d, 0x6d, 0xec, 0x18, 0x6d, 0xec, 0x60,
+	0xd7, 0x9c, 0xf2, 0x12, 0x2c, 0xea, 0xbb, 0x5c, 0x31, 0x85, 0xa4, 0xe9, 0x0b, 0x92, 0xd2, 0x14,
+	0x04, 0xb4, 0x0d, 0xb3, 0xe1, 0x2a, 0xb9, 0x69, 0x6f, 0x11, 0xa1, 0x64, 0xe2, 0xb6, 0xf6, 0x93,
+	0x19, 0x58, 0xd2, 0x89, 0xf5, 0x45, 0x00, 0xae, 0x1c, 0x80, 0x5d, 0x00, 0xb1, 0xb9, 0x59, 0x19,
+	0xad, 0xe4, 0xa6, 0xad, 0x0c, 0x45, 0x21, 0xdc, 0x0a, 0x68, 0x22, 0x0a, 0x7f, 0xc9, 0xc2, 0x5c,
+	0x32, 0x0a, 0xff, 0xa7, 0xc7, 0x0e, 0xba, 0x1b, 0x97, 0x9c, 0x1c, 0x2f, 0x39, 0xaf, 0x4e, 0x2c,
+	0x39, 0x63, 0x09, 0xfb, 0xe9, 0xb5, 0xe6, 0xe7, 0xb3, 0x90, 0xdf, 0xc7, 0x3e, 0xee, 0x06, 0xc8,
+	0x1c, 0xbb, 0x3c, 0x8a, 0x46, 0x71, 0x6d, 0x2c, 0x25, 0x5b, 0xf2, 0x45, 0xe2, 0x25, 0x77, 0xc7,
+	0x8f, 0x27, 0xdc, 0x1d, 0xbf, 0x0b, 0x0b, 0xac, 0x97, 0x8d, 0x0c, 0x14, 0xae, 0x9e, 0x6f, 0xae,
+	0xc5, 0x28, 0xa3, 0xf3, 0xa2, 0xd5, 0x8d, 0x9a, 0x26, 0x76, 0x7b, 0x29, 0x31, 0x8e, 0xb8, 0xfc,
+	0x32, 0xf1, 0xd5, 0xb8, 0xad, 0x4c, 0x4c, 0x6a, 0x3a, 0x74, 0xf1, 0xd9, 0x8e, 0x18, 0xa0, 0x77,
+	0x01, 0x9d, 0x44, 0x4f, 0x18, 0x46, 0xec, 0x4b, 0x26, 0xff, 0xa5, 0x8b, 0xa1, 0xba, 0x26, 0xe4,
+	0xc7, 0x79, 0x34, 0x7d, 0x29, 0x26, 0x86, 0x68, 0xaf, 0x03, 0x30, 0xbb, 0x0c, 0x8b, 0xb8, 0x5e,
+	0x57, 0x36, 0x2d, 0xd7, 0x2f, 0x86, 0xea, 0x92, 0x40, 0x89, 0xe7, 0x34, 0xbd, 0xc8, 0x06, 0x2d,
+	0xf6, 0x8d, 0x1e, 0x29, 0xb0, 0xd6, 0x71, 0xbc, 0x36, 0x76, 0x0c, 0xc7, 0xfe, 0xb0, 0x6f, 0x5b,
+	0x86, 0x8c, 0x9f, 0x61, 0xe2, 0x9e, 0xec, 0x56, 0xde, 0x9b, 0xa6, 0x5b, 0xa9, 0x8b, 0xe5, 0x2e,
+	0xc5, 0xd4, 0xf4, 0x55, 0x31, 0xf7, 0x2e, 0x9f, 0x3a, 0x10, 0x33, 0xdb, 0xb8, 0x87, 0x9e, 0x28,
+	0x70, 0x33, 0xce, 0xbf, 0x09, 0xda, 0xcc, 0x72, 0x6d, 0xf6, 0xa7, 0xd1, 0xe6, 0xcb, 0xe9, 0xb4,
+	0x9e, 0xa4, 0xd0, 0x5a, 0x34, 0x3d, 0xa6, 0x93, 0x6c, 0x09, 0x52, 0x2f, 0x16, 0x95, 0xc2, 0x34,
+	0x2d, 0x81, 0xd0, 0x24, 0xd1, 0x12, 0xa4, 0xd0, 0x44, 0x4b, 0x30, 0xfa, 0xce, 0x71, 0x59, 0x2f,
+	0x52, 0xfc, 0x9f, 0xf7, 0x22, 0x71, 0x9d, 0x3b, 0x57, 0x00, 0xc5, 0x13, 0x3a, 0x09, 0x7a, 0xac,
+	0xe7, 0x66, 0xcd, 0x56, 0x42, 0x21, 0xe5, 0x53, 0x9a, 0xad, 0x58, 0x38, 0x6c, 0xb6, 0x62, 0x41,
+	0xf4, 0x46, 0x7c, 0x34, 0x66, 0xe5, 0x73, 0x1d, 0xc3, 0x60, 0x6f, 0x81, 0x89, 0x6e, 0xcd, 0x0e,
+	0x45, 0xc7, 0x4e, 0xc3, 0x8c, 0xf6, 0x3b, 0x05, 0xd6, 0xc6, 0x8a, 0x4b, 0xa4, 0xe6, 0xfb, 0x80,
+	0xfc, 0xc4, 0x24, 0xdf, 0x3a, 0x03, 0xa9, 0xee, 0x74, 0x85, 0x6a, 0xc9, 0x9f, 0x70, 0xe4, 0x7e,
+	0x06, 0xe7, 0x7a, 0x8e, 0x7b, 0xf9, 0xb7, 0x0a, 0xac, 0x24, 0x57, 0x8e, 0x0c, 0x78, 0x07, 0xe6,
+	0x92, 0x0b, 0x4b, 0xd5, 0x6f, 0xbd, 0x54, 0x75, 0xa9, 0xf5, 0x88, 0x30, 0xba, 0x17, 0xd7, 0x6a,
+	0xf1, 0xcc, 0xd9, 0xb8, 0x9a, 0x0b, 0x42, 0x6d, 0xd2, 0x35, 0x3b, 0xc7, 0x23, 0xf0, 0x1f, 0x05,
+	0x72, 0xfb, 0x9e, 0xe7, 0xa0, 0x0f, 0x60, 0xc9, 0xf5, 0xa8, 0xc1, 0xca, 0x0a, 0xb1, 0x0c, 0xf9,
+	0x6e, 0x22, 0x4e, 0xc0, 0xef, 0x5c, 0xd9, 0x33, 0xff, 0x1c, 0xaa, 0xe3, 0x28, 0x7a, 0xd9, 0xf5,
+	0x68, 0x93, 0x53, 0x0e, 0x39, 0x01, 0x3d, 0x84, 0xf9, 0xd1, 0x75, 0xc4, 0xd1, 0xa8, 0x4f, 0xb3,
+	0xce, 0x28, 0xc2, 0xc5, 0x50, 0x5d, 0x89, 0x8b, 0x64, 0x44, 0xd6, 0xf4, 0xb9, 0x76, 0x62, 0xe1,
+	0x3b, 0x05, 0x16, 0xaf, 0x7f, 0xb1, 0x98, 0xfd, 0x42, 0x81, 0x65, 0x4e, 0xb4, 0x7f, 0x44, 0xf8,
+	0xd3, 0x8b, 0x4e, 0x4c, 0xcf, 0xb7, 0xd0, 0x02, 0x64, 0x6d, 0x8b, 0xdb, 0x9d, 0xd3, 0xb3, 0xb6,
+	0x85, 0x56, 0xe0, 0x9a, 0xf7, 0xd0, 0x25, 0xbe, 0x7c, 0xfc, 0x13, 0x03, 0x7e, 0xe2, 0x78, 0x56,
+	0xdf, 0x21, 0x06, 0x36, 0x4d, 0xaf, 0xef, 0x52, 0x79, 0x0c, 0x27, 0x4f, 0x9c, 0x91, 0x79, 0x76,
+	0xe2, 0x70, 0xc2, 0x96, 0x18, 0xb3, 0x17, 0x81, 0xa8, 0x64, 0x89, 0x04, 0xd4, 0x63, 0xc2, 0xd7,
+	0x7f, 0xa3, 0x00, 0xc4, 0x0f, 0x5a, 0xe8, 0x1b, 0xf0, 0x4a, 0xf3, 0x7b, 0xf7, 0x5a, 0xc6, 0xc1,
+	0xe1, 0xd6, 0xe1, 0xfd, 0x03, 0xe3, 0xfe, 0xbd, 0x83, 0xfd, 0x9d, 0xed, 0xbd, 0xbb, 0x7b, 0x3b,
+	0xad, 0xc5, 0x4c, 0xb5, 0xfc, 0xf8, 0xbc, 0x5e, 0xba, 0xef, 0x06, 0x3d, 0x62, 0xda, 0xc7, 0x36,
+	0xb1, 0xd0, 0xab, 0xb0, 0x32, 0xca, 0xcd, 0x46, 0x3b, 0xad, 0x45, 0xa5, 0x3a, 0xf7, 0xf8, 0xbc,
+	0x5e, 0x10, 0x5d, 0x00, 0xb1, 0xd0, 0x3a, 0x5c, 0x1f, 0xe7, 0xdb, 0xbb, 0xf7, 0xf6, 0x62, 0xb6,
+	0x3a, 0xff, 0xf8, 0xbc, 0x5e, 0x8c, 0xda, 0x05, 0xa4, 0x01, 0x4a, 0x72, 0x4a, 0xbc, 0x99, 0x2a,
+	0x3c, 0x3e, 0xaf, 0xe7, 0x45, 0x64, 0xab, 0xb9, 0x47, 0xbf, 0xac, 0x65, 0x9a, 0x5b, 0x9f, 0x3c,
+	0xaf, 0x29, 0xcf, 0x9e, 0xd7, 0x94, 0xbf, 0x3f, 0xaf, 0x29, 0x1f, 0xbd, 0xa8, 0x65, 0x9e, 0xbd,
+	0xa8, 0x65, 0xfe, 0xf4, 0xa2, 0x96, 0xf9, 0xe1, 0x57, 0x2f, 0x0b, 0xea, 0x59, 0xf4, 0x0f, 0x08,
+	0x0f, 0x6f, 0x3b, 0xcf, 0xaf, 0x04, 0xdf, 0xfa, 0xef, 0x00, 0xf9, 0xc6, 0x9b, 0x3c, 0x1d, 0x19,
+	0x00, 0x00,
 }
 
 func (this *Pool) Description() (desc *github_com_gogo_protobuf_protoc_gen_gogo_descriptor.FileDescriptorSet) {
@@ -1258,607 +1357,672 @@ func (this *Pool) Description() (desc *github_com_gogo_protobuf_protoc_gen_gogo_
 func StakingDescription() (desc *github_com_gogo_protobuf_protoc_gen_gogo_descriptor.FileDescriptorSet) {
 	d := &github_com_gogo_protobuf_protoc_gen_gogo_descriptor.FileDescriptorSet{}
 	var gzipped = []byte{
-		// 9588 bytes of a gzipped FileDescriptorSet
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7d, 0x6d, 0x70, 0x1c, 0xd9,
-		0x71, 0x18, 0x67, 0x77, 0x01, 0xec, 0x36, 0x16, 0xc0, 0xe2, 0x01, 0x04, 0x97, 0x4b, 0x1e, 0x01,
-		0xce, 0x7d, 0x90, 0xc7, 0xbb, 0x03, 0xee, 0x78, 0xe4, 0xdd, 0x71, 0x79, 0x77, 0x34, 0x16, 0xbb,
-		0x04, 0x71, 0x87, 0x2f, 0x0f, 0x00, 0xea, 0x24, 0xd9, 0xb5, 0x19, 0xec, 0x3e, 0x2c, 0xe6, 0x30,
-		0x3b, 0x33, 0x9a, 0x99, 0x25, 0x89, 0x53, 0x94, 0x3a, 0x4b, 0xb6, 0x22, 0x5f, 0x2a, 0x8e, 0x64,
-		0xa5, 0x4a, 0xb2, 0x2c, 0x2a, 0x27, 0x2b, 0x8e, 0x1c, 0xc5, 0x91, 0xa5, 0x44, 0x91, 0xad, 0x44,
-		0x71, 0xc9, 0x95, 0x72, 0x2c, 0x4b, 0x29, 0x97, 0x54, 0xae, 0x24, 0x2e, 0x3b, 0x45, 0x3b, 0x27,
-		0xa5, 0xa2, 0xc8, 0xb2, 0xac, 0xd0, 0x52, 0x2a, 0x29, 0xe5, 0x47, 0xea, 0x7d, 0xcd, 0xd7, 0xce,
-		0x7e, 0x80, 0x22, 0x25, 0x25, 0xce, 0x2f, 0xec, 0xf4, 0xeb, 0xee, 0xd7, 0xdd, 0xaf, 0x5f, 0x77,
-		0xbf, 0x37, 0xef, 0x0d, 0xe0, 0xd3, 0x17, 0x61, 0xa6, 0x61, 0x9a, 0x0d, 0x1d, 0xcf, 0x59, 0xb6,
-		0xe9, 0x9a, 0xdb, 0xad, 0x9d, 0xb9, 0x3a, 0x76, 0x6a, 0xb6, 0x66, 0xb9, 0xa6, 0x3d, 0x4b, 0x61,
-		0x68, 0x8c, 0x61, 0xcc, 0x0a, 0x0c, 0x79, 0x05, 0xc6, 0x2f, 0x6b, 0x3a, 0x2e, 0x7b, 0x88, 0x1b,
-		0xd8, 0x45, 0xcf, 0x40, 0x6a, 0x47, 0xd3, 0x71, 0x5e, 0x9a, 0x49, 0x9e, 0x1e, 0x3e, 0xfb, 0xc0,
-		0x6c, 0x84, 0x68, 0x36, 0x4c, 0xb1, 0x4e, 0xc0, 0x0a, 0xa5, 0x90, 0xbf, 0x9e, 0x82, 0x89, 0x98,
-		0x56, 0x84, 0x20, 0x65, 0xa8, 0x4d, 0xc2, 0x51, 0x3a, 0x9d, 0x51, 0xe8, 0x6f, 0x94, 0x87, 0x21,
-		0x4b, 0xad, 0xed, 0xa9, 0x0d, 0x9c, 0x4f, 0x50, 0xb0, 0x78, 0x44, 0x27, 0x00, 0xea, 0xd8, 0xc2,
-		0x46, 0x1d, 0x1b, 0xb5, 0xfd, 0x7c, 0x72, 0x26, 0x79, 0x3a, 0xa3, 0x04, 0x20, 0xe8, 0x11, 0x18,
-		0xb7, 0x5a, 0xdb, 0xba, 0x56, 0xab, 0x06, 0xd0, 0x60, 0x26, 0x79, 0x7a, 0x40, 0xc9, 0xb1, 0x86,
-		0xb2, 0x8f, 0x7c, 0x0a, 0xc6, 0xae, 0x63, 0x75, 0x2f, 0x88, 0x3a, 0x4c, 0x51, 0x47, 0x09, 0x38,
-		0x80, 0xb8, 0x00, 0xd9, 0x26, 0x76, 0x1c, 0xb5, 0x81, 0xab, 0xee, 0xbe, 0x85, 0xf3, 0x29, 0xaa,
-		0xfd, 0x4c, 0x9b, 0xf6, 0x51, 0xcd, 0x87, 0x39, 0xd5, 0xe6, 0xbe, 0x85, 0xd1, 0x3c, 0x64, 0xb0,
-		0xd1, 0x6a, 0x32, 0x0e, 0x03, 0x1d, 0xec, 0x57, 0x31, 0x5a, 0xcd, 0x28, 0x97, 0x34, 0x21, 0xe3,
-		0x2c, 0x86, 0x1c, 0x6c, 0x5f, 0xd3, 0x6a, 0x38, 0x3f, 0x48, 0x19, 0x9c, 0x6a, 0x63, 0xb0, 0xc1,
-		0xda, 0xa3, 0x3c, 0x04, 0x1d, 0x5a, 0x80, 0x0c, 0xbe, 0xe1, 0x62, 0xc3, 0xd1, 0x4c, 0x23, 0x3f,
-		0x44, 0x99, 0x3c, 0x18, 0x33, 0x8a, 0x58, 0xaf, 0x47, 0x59, 0xf8, 0x74, 0xe8, 0x29, 0x18, 0x32,
-		0x2d, 0x57, 0x33, 0x0d, 0x27, 0x9f, 0x9e, 0x91, 0x4e, 0x0f, 0x9f, 0x3d, 0x1e, 0xeb, 0x08, 0x6b,
-		0x0c, 0x47, 0x11, 0xc8, 0x68, 0x09, 0x72, 0x8e, 0xd9, 0xb2, 0x6b, 0xb8, 0x5a, 0x33, 0xeb, 0xb8,
-		0xaa, 0x19, 0x3b, 0x66, 0x3e, 0x43, 0x19, 0x4c, 0xb7, 0x2b, 0x42, 0x11, 0x17, 0xcc, 0x3a, 0x5e,
-		0x32, 0x76, 0x4c, 0x65, 0xd4, 0x09, 0x3d, 0xa3, 0x29, 0x18, 0x74, 0xf6, 0x0d, 0x57, 0xbd, 0x91,
-		0xcf, 0x52, 0x0f, 0xe1, 0x4f, 0xf2, 0xe7, 0x06, 0x61, 0xac, 0x1f, 0x17, 0xbb, 0x08, 0x03, 0x3b,
-		0x44, 0xcb, 0x7c, 0xe2, 0x20, 0x36, 0x60, 0x34, 0x61, 0x23, 0x0e, 0xde, 0xa1, 0x11, 0xe7, 0x61,
-		0xd8, 0xc0, 0x8e, 0x8b, 0xeb, 0xcc, 0x23, 0x92, 0x7d, 0xfa, 0x14, 0x30, 0xa2, 0x76, 0x97, 0x4a,
-		0xdd, 0x91, 0x4b, 0xbd, 0x04, 0x63, 0x9e, 0x48, 0x55, 0x5b, 0x35, 0x1a, 0xc2, 0x37, 0xe7, 0x7a,
-		0x49, 0x32, 0x5b, 0x11, 0x74, 0x0a, 0x21, 0x53, 0x46, 0x71, 0xe8, 0x19, 0x95, 0x01, 0x4c, 0x03,
-		0x9b, 0x3b, 0xd5, 0x3a, 0xae, 0xe9, 0xf9, 0x74, 0x07, 0x2b, 0xad, 0x11, 0x94, 0x36, 0x2b, 0x99,
-		0x0c, 0x5a, 0xd3, 0xd1, 0x05, 0xdf, 0xd5, 0x86, 0x3a, 0x78, 0xca, 0x0a, 0x9b, 0x64, 0x6d, 0xde,
-		0xb6, 0x05, 0xa3, 0x36, 0x26, 0x7e, 0x8f, 0xeb, 0x5c, 0xb3, 0x0c, 0x15, 0x62, 0xb6, 0xa7, 0x66,
-		0x0a, 0x27, 0x63, 0x8a, 0x8d, 0xd8, 0xc1, 0x47, 0x74, 0x3f, 0x78, 0x80, 0x2a, 0x75, 0x2b, 0xa0,
-		0x51, 0x28, 0x2b, 0x80, 0xab, 0x6a, 0x13, 0x17, 0x5e, 0x81, 0xd1, 0xb0, 0x79, 0xd0, 0x24, 0x0c,
-		0x38, 0xae, 0x6a, 0xbb, 0xd4, 0x0b, 0x07, 0x14, 0xf6, 0x80, 0x72, 0x90, 0xc4, 0x46, 0x9d, 0x46,
-		0xb9, 0x01, 0x85, 0xfc, 0x44, 0x3f, 0xe1, 0x2b, 0x9c, 0xa4, 0x0a, 0x3f, 0xd4, 0x3e, 0xa2, 0x21,
-		0xce, 0x51, 0xbd, 0x0b, 0x4f, 0xc3, 0x48, 0x48, 0x81, 0x7e, 0xbb, 0x96, 0xff, 0x26, 0x1c, 0x8e,
-		0x65, 0x8d, 0x5e, 0x82, 0xc9, 0x96, 0xa1, 0x19, 0x2e, 0xb6, 0x2d, 0x1b, 0x13, 0x8f, 0x65, 0x5d,
-		0xe5, 0xff, 0xeb, 0x50, 0x07, 0x9f, 0xdb, 0x0a, 0x62, 0x33, 0x2e, 0xca, 0x44, 0xab, 0x1d, 0x78,
-		0x26, 0x93, 0xfe, 0xc6, 0x50, 0xee, 0xd5, 0x57, 0x5f, 0x7d, 0x35, 0x21, 0xff, 0xce, 0x20, 0x4c,
-		0xc6, 0xcd, 0x99, 0xd8, 0xe9, 0x3b, 0x05, 0x83, 0x46, 0xab, 0xb9, 0x8d, 0x6d, 0x6a, 0xa4, 0x01,
-		0x85, 0x3f, 0xa1, 0x79, 0x18, 0xd0, 0xd5, 0x6d, 0xac, 0xe7, 0x53, 0x33, 0xd2, 0xe9, 0xd1, 0xb3,
-		0x8f, 0xf4, 0x35, 0x2b, 0x67, 0x97, 0x09, 0x89, 0xc2, 0x28, 0xd1, 0xf3, 0x90, 0xe2, 0x21, 0x9a,
-		0x70, 0x38, 0xd3, 0x1f, 0x07, 0x32, 0x97, 0x14, 0x4a, 0x87, 0x8e, 0x41, 0x86, 0xfc, 0x65, 0xbe,
-		0x31, 0x48, 0x65, 0x4e, 0x13, 0x00, 0xf1, 0x0b, 0x54, 0x80, 0x34, 0x9d, 0x26, 0x75, 0x2c, 0x52,
-		0x9b, 0xf7, 0x4c, 0x1c, 0xab, 0x8e, 0x77, 0xd4, 0x96, 0xee, 0x56, 0xaf, 0xa9, 0x7a, 0x0b, 0x53,
-		0x87, 0xcf, 0x28, 0x59, 0x0e, 0xbc, 0x4a, 0x60, 0x68, 0x1a, 0x86, 0xd9, 0xac, 0xd2, 0x8c, 0x3a,
-		0xbe, 0x41, 0xa3, 0xe7, 0x80, 0xc2, 0x26, 0xda, 0x12, 0x81, 0x90, 0xee, 0x5f, 0x76, 0x4c, 0x43,
-		0xb8, 0x26, 0xed, 0x82, 0x00, 0x68, 0xf7, 0x4f, 0x47, 0x03, 0xf7, 0x7d, 0xf1, 0xea, 0xb5, 0xcd,
-		0xa5, 0x53, 0x30, 0x46, 0x31, 0x9e, 0xe4, 0x43, 0xaf, 0xea, 0xf9, 0xf1, 0x19, 0xe9, 0x74, 0x5a,
-		0x19, 0x65, 0xe0, 0x35, 0x0e, 0x95, 0x3f, 0x9b, 0x80, 0x14, 0x0d, 0x2c, 0x63, 0x30, 0xbc, 0xf9,
-		0xe6, 0xf5, 0x4a, 0xb5, 0xbc, 0xb6, 0x55, 0x5a, 0xae, 0xe4, 0x24, 0x34, 0x0a, 0x40, 0x01, 0x97,
-		0x97, 0xd7, 0xe6, 0x37, 0x73, 0x09, 0xef, 0x79, 0x69, 0x75, 0xf3, 0xa9, 0x73, 0xb9, 0xa4, 0x47,
-		0xb0, 0xc5, 0x00, 0xa9, 0x20, 0xc2, 0x93, 0x67, 0x73, 0x03, 0x28, 0x07, 0x59, 0xc6, 0x60, 0xe9,
-		0xa5, 0x4a, 0xf9, 0xa9, 0x73, 0xb9, 0xc1, 0x30, 0xe4, 0xc9, 0xb3, 0xb9, 0x21, 0x34, 0x02, 0x19,
-		0x0a, 0x29, 0xad, 0xad, 0x2d, 0xe7, 0xd2, 0x1e, 0xcf, 0x8d, 0x4d, 0x65, 0x69, 0x75, 0x31, 0x97,
-		0xf1, 0x78, 0x2e, 0x2a, 0x6b, 0x5b, 0xeb, 0x39, 0xf0, 0x38, 0xac, 0x54, 0x36, 0x36, 0xe6, 0x17,
-		0x2b, 0xb9, 0x61, 0x0f, 0xa3, 0xf4, 0xe6, 0xcd, 0xca, 0x46, 0x2e, 0x1b, 0x12, 0xeb, 0xc9, 0xb3,
-		0xb9, 0x11, 0xaf, 0x8b, 0xca, 0xea, 0xd6, 0x4a, 0x6e, 0x14, 0x8d, 0xc3, 0x08, 0xeb, 0x42, 0x08,
-		0x31, 0x16, 0x01, 0x3d, 0x75, 0x2e, 0x97, 0xf3, 0x05, 0x61, 0x5c, 0xc6, 0x43, 0x80, 0xa7, 0xce,
-		0xe5, 0x90, 0xbc, 0x00, 0x03, 0xd4, 0x0d, 0x11, 0x82, 0xd1, 0xe5, 0xf9, 0x52, 0x65, 0xb9, 0xba,
-		0xb6, 0xbe, 0xb9, 0xb4, 0xb6, 0x3a, 0xbf, 0x9c, 0x93, 0x7c, 0x98, 0x52, 0xf9, 0xc9, 0xad, 0x25,
-		0xa5, 0x52, 0xce, 0x25, 0x82, 0xb0, 0xf5, 0xca, 0xfc, 0x66, 0xa5, 0x9c, 0x4b, 0xca, 0x35, 0x98,
-		0x8c, 0x0b, 0xa8, 0xb1, 0x53, 0x28, 0xe0, 0x0b, 0x89, 0x0e, 0xbe, 0x40, 0x79, 0x45, 0x7d, 0x41,
-		0xfe, 0x5a, 0x02, 0x26, 0x62, 0x92, 0x4a, 0x6c, 0x27, 0x97, 0x60, 0x80, 0xf9, 0x32, 0x4b, 0xb3,
-		0x0f, 0xc7, 0x66, 0x27, 0xea, 0xd9, 0x6d, 0xa9, 0x96, 0xd2, 0x05, 0x4b, 0x8d, 0x64, 0x87, 0x52,
-		0x83, 0xb0, 0x68, 0x73, 0xd8, 0x9f, 0x6e, 0x0b, 0xfe, 0x2c, 0x3f, 0x3e, 0xd5, 0x4f, 0x7e, 0xa4,
-		0xb0, 0x83, 0x25, 0x81, 0x81, 0x98, 0x24, 0x70, 0x11, 0xc6, 0xdb, 0x18, 0xf5, 0x1d, 0x8c, 0xdf,
-		0x25, 0x41, 0xbe, 0x93, 0x71, 0x7a, 0x84, 0xc4, 0x44, 0x28, 0x24, 0x5e, 0x8c, 0x5a, 0xf0, 0x64,
-		0xe7, 0x41, 0x68, 0x1b, 0xeb, 0x8f, 0x4b, 0x30, 0x15, 0x5f, 0x52, 0xc6, 0xca, 0xf0, 0x3c, 0x0c,
-		0x36, 0xb1, 0xbb, 0x6b, 0x8a, 0xb2, 0xea, 0xa1, 0x98, 0x64, 0x4d, 0x9a, 0xa3, 0x83, 0xcd, 0xa9,
-		0x82, 0xd9, 0x3e, 0xd9, 0xa9, 0x2e, 0x64, 0xd2, 0xb4, 0x49, 0xfa, 0xf3, 0x09, 0x38, 0x1c, 0xcb,
-		0x3c, 0x56, 0xd0, 0xfb, 0x00, 0x34, 0xc3, 0x6a, 0xb9, 0xac, 0x74, 0x62, 0x91, 0x38, 0x43, 0x21,
-		0x34, 0x78, 0x91, 0x28, 0xdb, 0x72, 0xbd, 0xf6, 0x24, 0x6d, 0x07, 0x06, 0xa2, 0x08, 0xcf, 0xf8,
-		0x82, 0xa6, 0xa8, 0xa0, 0x27, 0x3a, 0x68, 0xda, 0xe6, 0x98, 0x8f, 0x43, 0xae, 0xa6, 0x6b, 0xd8,
-		0x70, 0xab, 0x8e, 0x6b, 0x63, 0xb5, 0xa9, 0x19, 0x0d, 0x9a, 0x6a, 0xd2, 0xc5, 0x81, 0x1d, 0x55,
-		0x77, 0xb0, 0x32, 0xc6, 0x9a, 0x37, 0x44, 0x2b, 0xa1, 0xa0, 0x0e, 0x64, 0x07, 0x28, 0x06, 0x43,
-		0x14, 0xac, 0xd9, 0xa3, 0x90, 0xdf, 0x97, 0x81, 0xe1, 0x40, 0x01, 0x8e, 0x4e, 0x42, 0xf6, 0x65,
-		0xf5, 0x9a, 0x5a, 0x15, 0x8b, 0x2a, 0x66, 0x89, 0x61, 0x02, 0x5b, 0xe7, 0x0b, 0xab, 0xc7, 0x61,
-		0x92, 0xa2, 0x98, 0x2d, 0x17, 0xdb, 0xd5, 0x9a, 0xae, 0x3a, 0x0e, 0x35, 0x5a, 0x9a, 0xa2, 0x22,
-		0xd2, 0xb6, 0x46, 0x9a, 0x16, 0x44, 0x0b, 0x3a, 0x0f, 0x13, 0x94, 0xa2, 0xd9, 0xd2, 0x5d, 0xcd,
-		0xd2, 0x71, 0x95, 0x2c, 0xf3, 0x1c, 0x9a, 0x72, 0x3c, 0xc9, 0xc6, 0x09, 0xc6, 0x0a, 0x47, 0x20,
-		0x12, 0x39, 0xa8, 0x0c, 0xf7, 0x51, 0xb2, 0x06, 0x36, 0xb0, 0xad, 0xba, 0xb8, 0x8a, 0xdf, 0xd6,
-		0x52, 0x75, 0xa7, 0xaa, 0x1a, 0xf5, 0xea, 0xae, 0xea, 0xec, 0xe6, 0x27, 0x09, 0x83, 0x52, 0x22,
-		0x2f, 0x29, 0x47, 0x09, 0xe2, 0x22, 0xc7, 0xab, 0x50, 0xb4, 0x79, 0xa3, 0x7e, 0x45, 0x75, 0x76,
-		0x51, 0x11, 0xa6, 0x28, 0x17, 0xc7, 0xb5, 0x35, 0xa3, 0x51, 0xad, 0xed, 0xe2, 0xda, 0x5e, 0xb5,
-		0xe5, 0xee, 0x3c, 0x93, 0x3f, 0x16, 0xec, 0x9f, 0x4a, 0xb8, 0x41, 0x71, 0x16, 0x08, 0xca, 0x96,
-		0xbb, 0xf3, 0x0c, 0xda, 0x80, 0x2c, 0x19, 0x8c, 0xa6, 0xf6, 0x0a, 0xae, 0xee, 0x98, 0x36, 0xcd,
-		0xa1, 0xa3, 0x31, 0xa1, 0x29, 0x60, 0xc1, 0xd9, 0x35, 0x4e, 0xb0, 0x62, 0xd6, 0x71, 0x71, 0x60,
-		0x63, 0xbd, 0x52, 0x29, 0x2b, 0xc3, 0x82, 0xcb, 0x65, 0xd3, 0x26, 0x0e, 0xd5, 0x30, 0x3d, 0x03,
-		0x0f, 0x33, 0x87, 0x6a, 0x98, 0xc2, 0xbc, 0xe7, 0x61, 0xa2, 0x56, 0x63, 0x3a, 0x6b, 0xb5, 0x2a,
-		0x5f, 0x8c, 0x39, 0xf9, 0x5c, 0xc8, 0x58, 0xb5, 0xda, 0x22, 0x43, 0xe0, 0x3e, 0xee, 0xa0, 0x0b,
-		0x70, 0xd8, 0x37, 0x56, 0x90, 0x70, 0xbc, 0x4d, 0xcb, 0x28, 0xe9, 0x79, 0x98, 0xb0, 0xf6, 0xdb,
-		0x09, 0x51, 0xa8, 0x47, 0x6b, 0x3f, 0x4a, 0xf6, 0x34, 0x4c, 0x5a, 0xbb, 0x56, 0x3b, 0xdd, 0x99,
-		0x20, 0x1d, 0xb2, 0x76, 0xad, 0x28, 0xe1, 0x83, 0x74, 0x65, 0x6e, 0xe3, 0x9a, 0xea, 0xe2, 0x7a,
-		0xfe, 0x48, 0x10, 0x3d, 0xd0, 0x80, 0x66, 0x21, 0x57, 0xab, 0x55, 0xb1, 0xa1, 0x6e, 0xeb, 0xb8,
-		0xaa, 0xda, 0xd8, 0x50, 0x9d, 0xfc, 0x34, 0x45, 0x4e, 0xb9, 0x76, 0x0b, 0x2b, 0xa3, 0xb5, 0x5a,
-		0x85, 0x36, 0xce, 0xd3, 0x36, 0x74, 0x06, 0xc6, 0xcd, 0xed, 0x97, 0x6b, 0xcc, 0x23, 0xab, 0x96,
-		0x8d, 0x77, 0xb4, 0x1b, 0xf9, 0x07, 0xa8, 0x79, 0xc7, 0x48, 0x03, 0xf5, 0xc7, 0x75, 0x0a, 0x46,
-		0x0f, 0x43, 0xae, 0xe6, 0xec, 0xaa, 0xb6, 0x45, 0x43, 0xb2, 0x63, 0xa9, 0x35, 0x9c, 0x7f, 0x90,
-		0xa1, 0x32, 0xf8, 0xaa, 0x00, 0x93, 0x19, 0xe1, 0x5c, 0xd7, 0x76, 0x5c, 0xc1, 0xf1, 0x14, 0x9b,
-		0x11, 0x14, 0xc6, 0xb9, 0x9d, 0x86, 0x1c, 0xb1, 0x44, 0xa8, 0xe3, 0xd3, 0x14, 0x6d, 0xd4, 0xda,
-		0xb5, 0x82, 0xfd, 0xde, 0x0f, 0x23, 0x04, 0xd3, 0xef, 0xf4, 0x61, 0x56, 0xb8, 0x59, 0xbb, 0x81,
-		0x1e, 0xcf, 0xc1, 0x14, 0x41, 0x6a, 0x62, 0x57, 0xad, 0xab, 0xae, 0x1a, 0xc0, 0x7e, 0x94, 0x62,
-		0x13, 0xb3, 0xaf, 0xf0, 0xc6, 0x90, 0x9c, 0x76, 0x6b, 0x7b, 0xdf, 0x73, 0xac, 0xc7, 0x98, 0x9c,
-		0x04, 0x26, 0x5c, 0xeb, 0x9e, 0x15, 0xe7, 0x72, 0x11, 0xb2, 0x41, 0xbf, 0x47, 0x19, 0x60, 0x9e,
-		0x9f, 0x93, 0x48, 0x11, 0xb4, 0xb0, 0x56, 0x26, 0xe5, 0xcb, 0x5b, 0x2a, 0xb9, 0x04, 0x29, 0xa3,
-		0x96, 0x97, 0x36, 0x2b, 0x55, 0x65, 0x6b, 0x75, 0x73, 0x69, 0xa5, 0x92, 0x4b, 0x06, 0x0a, 0xfb,
-		0x17, 0x52, 0xe9, 0x87, 0x72, 0xa7, 0xe4, 0xaf, 0x26, 0x60, 0x34, 0xbc, 0x52, 0x43, 0xcf, 0xc2,
-		0x11, 0xb1, 0xad, 0xe2, 0x60, 0xb7, 0x7a, 0x5d, 0xb3, 0xe9, 0x84, 0x6c, 0xaa, 0x2c, 0x39, 0x7a,
-		0xfe, 0x33, 0xc9, 0xb1, 0x36, 0xb0, 0xfb, 0x26, 0xcd, 0x26, 0xd3, 0xad, 0xa9, 0xba, 0x68, 0x19,
-		0xa6, 0x0d, 0xb3, 0xea, 0xb8, 0xaa, 0x51, 0x57, 0xed, 0x7a, 0xd5, 0xdf, 0xd0, 0xaa, 0xaa, 0xb5,
-		0x1a, 0x76, 0x1c, 0x93, 0x25, 0x42, 0x8f, 0xcb, 0x71, 0xc3, 0xdc, 0xe0, 0xc8, 0x7e, 0x86, 0x98,
-		0xe7, 0xa8, 0x11, 0xf7, 0x4d, 0x76, 0x72, 0xdf, 0x63, 0x90, 0x69, 0xaa, 0x56, 0x15, 0x1b, 0xae,
-		0xbd, 0x4f, 0xeb, 0xf3, 0xb4, 0x92, 0x6e, 0xaa, 0x56, 0x85, 0x3c, 0xff, 0x50, 0x96, 0x49, 0x2f,
-		0xa4, 0xd2, 0xe9, 0x5c, 0xe6, 0x85, 0x54, 0x3a, 0x93, 0x03, 0xf9, 0x8d, 0x24, 0x64, 0x83, 0xf5,
-		0x3a, 0x59, 0xfe, 0xd4, 0x68, 0xc6, 0x92, 0x68, 0x4c, 0xbb, 0xbf, 0x6b, 0x75, 0x3f, 0xbb, 0x40,
-		0x52, 0x59, 0x71, 0x90, 0x15, 0xc7, 0x0a, 0xa3, 0x24, 0x65, 0x04, 0x71, 0x36, 0xcc, 0x8a, 0x91,
-		0xb4, 0xc2, 0x9f, 0xd0, 0x22, 0x0c, 0xbe, 0xec, 0x50, 0xde, 0x83, 0x94, 0xf7, 0x03, 0xdd, 0x79,
-		0xbf, 0xb0, 0x41, 0x99, 0x67, 0x5e, 0xd8, 0xa8, 0xae, 0xae, 0x29, 0x2b, 0xf3, 0xcb, 0x0a, 0x27,
-		0x47, 0x47, 0x21, 0xa5, 0xab, 0xaf, 0xec, 0x87, 0x93, 0x1e, 0x05, 0xf5, 0x3b, 0x08, 0x47, 0x21,
-		0x75, 0x1d, 0xab, 0x7b, 0xe1, 0x54, 0x43, 0x41, 0xf7, 0x70, 0x32, 0xcc, 0xc1, 0x00, 0xb5, 0x17,
-		0x02, 0xe0, 0x16, 0xcb, 0x1d, 0x42, 0x69, 0x48, 0x2d, 0xac, 0x29, 0x64, 0x42, 0xe4, 0x20, 0xcb,
-		0xa0, 0xd5, 0xf5, 0xa5, 0xca, 0x42, 0x25, 0x97, 0x90, 0xcf, 0xc3, 0x20, 0x33, 0x02, 0x99, 0x2c,
-		0x9e, 0x19, 0x72, 0x87, 0xf8, 0x23, 0xe7, 0x21, 0x89, 0xd6, 0xad, 0x95, 0x52, 0x45, 0xc9, 0x25,
-		0xc2, 0x43, 0x9d, 0xca, 0x0d, 0xc8, 0x0e, 0x64, 0x83, 0x75, 0xf8, 0x0f, 0x67, 0x31, 0xfe, 0x05,
-		0x09, 0x86, 0x03, 0x75, 0x35, 0x29, 0x88, 0x54, 0x5d, 0x37, 0xaf, 0x57, 0x55, 0x5d, 0x53, 0x1d,
-		0xee, 0x1a, 0x40, 0x41, 0xf3, 0x04, 0xd2, 0xef, 0xd0, 0xfd, 0x90, 0xa6, 0xc8, 0x40, 0x6e, 0x50,
-		0xfe, 0x88, 0x04, 0xb9, 0x68, 0x61, 0x1b, 0x11, 0x53, 0xfa, 0x51, 0x8a, 0x29, 0x7f, 0x58, 0x82,
-		0xd1, 0x70, 0x35, 0x1b, 0x11, 0xef, 0xe4, 0x8f, 0x54, 0xbc, 0x3f, 0x4b, 0xc0, 0x48, 0xa8, 0x86,
-		0xed, 0x57, 0xba, 0xb7, 0xc1, 0xb8, 0x56, 0xc7, 0x4d, 0xcb, 0x74, 0xb1, 0x51, 0xdb, 0xaf, 0xea,
-		0xf8, 0x1a, 0xd6, 0xf3, 0x32, 0x0d, 0x1a, 0x73, 0xdd, 0xab, 0xe4, 0xd9, 0x25, 0x9f, 0x6e, 0x99,
-		0x90, 0x15, 0x27, 0x96, 0xca, 0x95, 0x95, 0xf5, 0xb5, 0xcd, 0xca, 0xea, 0xc2, 0x9b, 0xab, 0x5b,
-		0xab, 0x2f, 0xae, 0xae, 0xbd, 0x69, 0x55, 0xc9, 0x69, 0x11, 0xb4, 0x7b, 0x38, 0xed, 0xd7, 0x21,
-		0x17, 0x15, 0x0a, 0x1d, 0x81, 0x38, 0xb1, 0x72, 0x87, 0xd0, 0x04, 0x8c, 0xad, 0xae, 0x55, 0x37,
-		0x96, 0xca, 0x95, 0x6a, 0xe5, 0xf2, 0xe5, 0xca, 0xc2, 0xe6, 0x06, 0xdb, 0xf7, 0xf0, 0xb0, 0x37,
-		0x43, 0x13, 0x5c, 0xfe, 0x50, 0x12, 0x26, 0x62, 0x24, 0x41, 0xf3, 0x7c, 0xc5, 0xc2, 0x16, 0x51,
-		0x8f, 0xf5, 0x23, 0xfd, 0x2c, 0xa9, 0x19, 0xd6, 0x55, 0xdb, 0xe5, 0x0b, 0x9c, 0x87, 0x81, 0x58,
-		0xc9, 0x70, 0xb5, 0x1d, 0x0d, 0xdb, 0x7c, 0x3f, 0x89, 0x2d, 0x63, 0xc6, 0x7c, 0x38, 0xdb, 0x52,
-		0x7a, 0x14, 0x90, 0x65, 0x3a, 0x9a, 0xab, 0x5d, 0xc3, 0x55, 0xcd, 0x10, 0x9b, 0x4f, 0x64, 0x59,
-		0x93, 0x52, 0x72, 0xa2, 0x65, 0xc9, 0x70, 0x3d, 0x6c, 0x03, 0x37, 0xd4, 0x08, 0x36, 0x09, 0xe6,
-		0x49, 0x25, 0x27, 0x5a, 0x3c, 0xec, 0x93, 0x90, 0xad, 0x9b, 0x2d, 0x52, 0xeb, 0x31, 0x3c, 0x92,
-		0x3b, 0x24, 0x65, 0x98, 0xc1, 0x3c, 0x14, 0x5e, 0xc5, 0xfb, 0xbb, 0x5e, 0x59, 0x65, 0x98, 0xc1,
-		0x18, 0xca, 0x29, 0x18, 0x53, 0x1b, 0x0d, 0x9b, 0x30, 0x17, 0x8c, 0xd8, 0xba, 0x64, 0xd4, 0x03,
-		0x53, 0xc4, 0xc2, 0x0b, 0x90, 0x16, 0x76, 0x20, 0xa9, 0x9a, 0x58, 0xa2, 0x6a, 0xb1, 0xc5, 0x76,
-		0xe2, 0x74, 0x46, 0x49, 0x1b, 0xa2, 0xf1, 0x24, 0x64, 0x35, 0xa7, 0xea, 0x6f, 0xe2, 0x27, 0x66,
-		0x12, 0xa7, 0xd3, 0xca, 0xb0, 0xe6, 0x78, 0x1b, 0xa0, 0xf2, 0xc7, 0x13, 0x30, 0x1a, 0x7e, 0x09,
-		0x81, 0xca, 0x90, 0xd6, 0xcd, 0x9a, 0x4a, 0x5d, 0x8b, 0xbd, 0x01, 0x3b, 0xdd, 0xe3, 0xbd, 0xc5,
-		0xec, 0x32, 0xc7, 0x57, 0x3c, 0xca, 0xc2, 0x1f, 0x48, 0x90, 0x16, 0x60, 0x34, 0x05, 0x29, 0x4b,
-		0x75, 0x77, 0x29, 0xbb, 0x81, 0x52, 0x22, 0x27, 0x29, 0xf4, 0x99, 0xc0, 0x1d, 0x4b, 0x35, 0xa8,
-		0x0b, 0x70, 0x38, 0x79, 0x26, 0xe3, 0xaa, 0x63, 0xb5, 0x4e, 0x17, 0x3d, 0x66, 0xb3, 0x89, 0x0d,
-		0xd7, 0x11, 0xe3, 0xca, 0xe1, 0x0b, 0x1c, 0x8c, 0x1e, 0x81, 0x71, 0xd7, 0x56, 0x35, 0x3d, 0x84,
-		0x9b, 0xa2, 0xb8, 0x39, 0xd1, 0xe0, 0x21, 0x17, 0xe1, 0xa8, 0xe0, 0x5b, 0xc7, 0xae, 0x5a, 0xdb,
-		0xc5, 0x75, 0x9f, 0x68, 0x90, 0x6e, 0x6e, 0x1c, 0xe1, 0x08, 0x65, 0xde, 0x2e, 0x68, 0xe5, 0xaf,
-		0x4a, 0x30, 0x2e, 0x96, 0x69, 0x75, 0xcf, 0x58, 0x2b, 0x00, 0xaa, 0x61, 0x98, 0x6e, 0xd0, 0x5c,
-		0xed, 0xae, 0xdc, 0x46, 0x37, 0x3b, 0xef, 0x11, 0x29, 0x01, 0x06, 0x85, 0x26, 0x80, 0xdf, 0xd2,
-		0xd1, 0x6c, 0xd3, 0x30, 0xcc, 0xdf, 0x30, 0xd1, 0xd7, 0x94, 0x6c, 0x61, 0x0f, 0x0c, 0x44, 0xd6,
-		0x73, 0x68, 0x12, 0x06, 0xb6, 0x71, 0x43, 0x33, 0xf8, 0xbe, 0x31, 0x7b, 0x10, 0xdb, 0x2f, 0x29,
-		0x6f, 0xfb, 0xa5, 0xf4, 0xb7, 0x60, 0xa2, 0x66, 0x36, 0xa3, 0xe2, 0x96, 0x72, 0x91, 0xcd, 0x05,
-		0xe7, 0x8a, 0xf4, 0x96, 0xc7, 0x38, 0x52, 0xc3, 0xd4, 0x55, 0xa3, 0x31, 0x6b, 0xda, 0x0d, 0xff,
-		0x35, 0x2b, 0xa9, 0x78, 0x9c, 0xc0, 0xcb, 0x56, 0x6b, 0xfb, 0x7f, 0x4a, 0xd2, 0xaf, 0x24, 0x92,
-		0x8b, 0xeb, 0xa5, 0x4f, 0x24, 0x0a, 0x8b, 0x8c, 0x70, 0x5d, 0x18, 0x43, 0xc1, 0x3b, 0x3a, 0xae,
-		0x11, 0x05, 0xe1, 0x9b, 0x8f, 0xc0, 0x64, 0xc3, 0x6c, 0x98, 0x94, 0xd3, 0x1c, 0xf9, 0xc5, 0xdf,
-		0xd3, 0x66, 0x3c, 0x68, 0xa1, 0xe7, 0x4b, 0xdd, 0xe2, 0x2a, 0x4c, 0x70, 0xe4, 0x2a, 0x7d, 0x51,
-		0xc4, 0x96, 0x31, 0xa8, 0xeb, 0x1e, 0x5a, 0xfe, 0xd3, 0x5f, 0xa7, 0xe9, 0x5b, 0x19, 0xe7, 0xa4,
-		0xa4, 0x8d, 0xad, 0x74, 0x8a, 0x0a, 0x1c, 0x0e, 0xf1, 0x63, 0x93, 0x14, 0xdb, 0x3d, 0x38, 0xfe,
-		0x2e, 0xe7, 0x38, 0x11, 0xe0, 0xb8, 0xc1, 0x49, 0x8b, 0x0b, 0x30, 0x72, 0x10, 0x5e, 0xff, 0x96,
-		0xf3, 0xca, 0xe2, 0x20, 0x93, 0x45, 0x18, 0xa3, 0x4c, 0x6a, 0x2d, 0xc7, 0x35, 0x9b, 0x34, 0x02,
-		0x76, 0x67, 0xf3, 0x7b, 0x5f, 0x67, 0xb3, 0x66, 0x94, 0x90, 0x2d, 0x78, 0x54, 0xc5, 0x22, 0xd0,
-		0x77, 0x63, 0x75, 0x5c, 0xd3, 0x7b, 0x70, 0xf8, 0x22, 0x17, 0xc4, 0xc3, 0x2f, 0x5e, 0x85, 0x49,
-		0xf2, 0x9b, 0x06, 0xa8, 0xa0, 0x24, 0xbd, 0x37, 0xdc, 0xf2, 0x5f, 0x7d, 0x17, 0x9b, 0x98, 0x13,
-		0x1e, 0x83, 0x80, 0x4c, 0x81, 0x51, 0x6c, 0x60, 0xd7, 0xc5, 0xb6, 0x53, 0x55, 0xf5, 0x38, 0xf1,
-		0x02, 0x3b, 0x16, 0xf9, 0x5f, 0xfa, 0x56, 0x78, 0x14, 0x17, 0x19, 0xe5, 0xbc, 0xae, 0x17, 0xb7,
-		0xe0, 0x48, 0x8c, 0x57, 0xf4, 0xc1, 0xf3, 0x43, 0x9c, 0xe7, 0x64, 0x9b, 0x67, 0x10, 0xb6, 0xeb,
-		0x20, 0xe0, 0xde, 0x58, 0xf6, 0xc1, 0xf3, 0x97, 0x39, 0x4f, 0xc4, 0x69, 0xc5, 0x90, 0x12, 0x8e,
-		0x2f, 0xc0, 0xf8, 0x35, 0x6c, 0x6f, 0x9b, 0x0e, 0xdf, 0x25, 0xea, 0x83, 0xdd, 0x87, 0x39, 0xbb,
-		0x31, 0x4e, 0x48, 0xb7, 0x8d, 0x08, 0xaf, 0x0b, 0x90, 0xde, 0x51, 0x6b, 0xb8, 0x0f, 0x16, 0x37,
-		0x39, 0x8b, 0x21, 0x82, 0x4f, 0x48, 0xe7, 0x21, 0xdb, 0x30, 0x79, 0x8e, 0xea, 0x4d, 0xfe, 0x11,
-		0x4e, 0x3e, 0x2c, 0x68, 0x38, 0x0b, 0xcb, 0xb4, 0x5a, 0x3a, 0x49, 0x60, 0xbd, 0x59, 0xfc, 0x03,
-		0xc1, 0x42, 0xd0, 0x70, 0x16, 0x07, 0x30, 0xeb, 0xeb, 0x82, 0x85, 0x13, 0xb0, 0xe7, 0x25, 0x18,
-		0x36, 0x0d, 0x7d, 0xdf, 0x34, 0xfa, 0x11, 0xe2, 0xa3, 0x9c, 0x03, 0x70, 0x12, 0xc2, 0xe0, 0x22,
-		0x64, 0xfa, 0x1d, 0x88, 0x5f, 0xfd, 0x96, 0x98, 0x1e, 0x62, 0x04, 0x16, 0x61, 0x4c, 0x04, 0x28,
-		0xcd, 0x34, 0xfa, 0x60, 0xf1, 0x8f, 0x38, 0x8b, 0xd1, 0x00, 0x19, 0x57, 0xc3, 0xc5, 0x8e, 0xdb,
-		0xc0, 0xfd, 0x30, 0xf9, 0xb8, 0x50, 0x83, 0x93, 0x70, 0x53, 0x6e, 0x63, 0xa3, 0xb6, 0xdb, 0x1f,
-		0x87, 0x5f, 0x13, 0xa6, 0x14, 0x34, 0x84, 0xc5, 0x02, 0x8c, 0x34, 0x55, 0xdb, 0xd9, 0x55, 0xf5,
-		0xbe, 0x86, 0xe3, 0x1f, 0x73, 0x1e, 0x59, 0x8f, 0x88, 0x5b, 0xa4, 0x65, 0x1c, 0x84, 0xcd, 0x27,
-		0x84, 0x45, 0x02, 0x64, 0x7c, 0xea, 0x39, 0x2e, 0xdd, 0x52, 0x3b, 0x08, 0xb7, 0x7f, 0x22, 0xa6,
-		0x1e, 0xa3, 0x5d, 0x09, 0x72, 0xbc, 0x08, 0x19, 0x47, 0x7b, 0xa5, 0x2f, 0x36, 0xbf, 0x2e, 0x46,
-		0x9a, 0x12, 0x10, 0xe2, 0x37, 0xc3, 0xd1, 0xd8, 0x34, 0xd1, 0x07, 0xb3, 0x7f, 0xca, 0x99, 0x4d,
-		0xc5, 0xa4, 0x0a, 0x1e, 0x12, 0x0e, 0xca, 0xf2, 0x93, 0x22, 0x24, 0xe0, 0x08, 0xaf, 0x75, 0xb2,
-		0x6a, 0x70, 0xd4, 0x9d, 0x83, 0x59, 0xed, 0x37, 0x84, 0xd5, 0x18, 0x6d, 0xc8, 0x6a, 0x9b, 0x30,
-		0xc5, 0x39, 0x1e, 0x6c, 0x5c, 0x3f, 0x25, 0x02, 0x2b, 0xa3, 0xde, 0x0a, 0x8f, 0xee, 0x5b, 0xa1,
-		0xe0, 0x99, 0x53, 0x94, 0xa7, 0x4e, 0xb5, 0xa9, 0x5a, 0x7d, 0x70, 0xfe, 0x34, 0xe7, 0x2c, 0x22,
-		0xbe, 0x57, 0xdf, 0x3a, 0x2b, 0xaa, 0x45, 0x98, 0xbf, 0x04, 0x79, 0xc1, 0xbc, 0x65, 0xd8, 0xb8,
-		0x66, 0x36, 0x0c, 0xed, 0x15, 0x5c, 0xef, 0x83, 0xf5, 0x3f, 0x8b, 0x0c, 0xd5, 0x56, 0x80, 0x9c,
-		0x70, 0x5e, 0x82, 0x9c, 0x57, 0xab, 0x54, 0xb5, 0xa6, 0x65, 0xda, 0x6e, 0x0f, 0x8e, 0xff, 0x5c,
-		0x8c, 0x94, 0x47, 0xb7, 0x44, 0xc9, 0x8a, 0x15, 0x60, 0xef, 0x99, 0xfb, 0x75, 0xc9, 0xcf, 0x70,
-		0x46, 0x23, 0x3e, 0x15, 0x0f, 0x1c, 0x35, 0xb3, 0x69, 0xa9, 0x76, 0x3f, 0xf1, 0xef, 0x5f, 0x88,
-		0xc0, 0xc1, 0x49, 0x78, 0xe0, 0x20, 0x15, 0x1d, 0xc9, 0xf6, 0x7d, 0x70, 0xf8, 0xac, 0x08, 0x1c,
-		0x82, 0x86, 0xb3, 0x10, 0x05, 0x43, 0x1f, 0x2c, 0x7e, 0x53, 0xb0, 0x10, 0x34, 0x84, 0xc5, 0x4f,
-		0xfa, 0x89, 0xd6, 0xc6, 0x0d, 0xcd, 0x71, 0x6d, 0x56, 0x14, 0x77, 0x67, 0xf5, 0x5b, 0xdf, 0x0a,
-		0x17, 0x61, 0x4a, 0x80, 0x94, 0x44, 0x22, 0xbe, 0xc9, 0x4a, 0xd7, 0x4c, 0xbd, 0x05, 0xfb, 0x9c,
-		0x88, 0x44, 0x01, 0x32, 0x22, 0x5b, 0xa0, 0x42, 0x24, 0x66, 0xaf, 0x91, 0x95, 0x42, 0x1f, 0xec,
-		0xfe, 0x65, 0x44, 0xb8, 0x0d, 0x41, 0x4b, 0x78, 0x06, 0xea, 0x9f, 0x96, 0xb1, 0x87, 0xf7, 0xfb,
-		0xf2, 0xce, 0x7f, 0x15, 0xa9, 0x7f, 0xb6, 0x18, 0x25, 0x8b, 0x21, 0x63, 0x91, 0x7a, 0x0a, 0xf5,
-		0x3a, 0x55, 0x94, 0xff, 0x99, 0xef, 0x72, 0x7d, 0xc3, 0xe5, 0x54, 0x71, 0x99, 0x38, 0x79, 0xb8,
-		0xe8, 0xe9, 0xcd, 0xec, 0x5d, 0xdf, 0xf5, 0xfc, 0x3c, 0x54, 0xf3, 0x14, 0x2f, 0xc3, 0x48, 0xa8,
-		0xe0, 0xe9, 0xcd, 0xea, 0x67, 0x39, 0xab, 0x6c, 0xb0, 0xde, 0x29, 0x9e, 0x87, 0x14, 0x29, 0x5e,
-		0x7a, 0x93, 0xff, 0x1c, 0x27, 0xa7, 0xe8, 0xc5, 0xe7, 0x20, 0x2d, 0x8a, 0x96, 0xde, 0xa4, 0xef,
-		0xe6, 0xa4, 0x1e, 0x09, 0x21, 0x17, 0x05, 0x4b, 0x6f, 0xf2, 0xbf, 0x2d, 0xc8, 0x05, 0x09, 0x21,
-		0xef, 0xdf, 0x84, 0x5f, 0xf8, 0x3b, 0x29, 0x9e, 0x74, 0x84, 0xed, 0x2e, 0xc2, 0x10, 0xaf, 0x54,
-		0x7a, 0x53, 0xff, 0x3c, 0xef, 0x5c, 0x50, 0x14, 0x9f, 0x86, 0x81, 0x3e, 0x0d, 0xfe, 0x77, 0x39,
-		0x29, 0xc3, 0x2f, 0x2e, 0xc0, 0x70, 0xa0, 0x3a, 0xe9, 0x4d, 0xfe, 0x0b, 0x9c, 0x3c, 0x48, 0x45,
-		0x44, 0xe7, 0xd5, 0x49, 0x6f, 0x06, 0x7f, 0x4f, 0x88, 0xce, 0x29, 0x88, 0xd9, 0x44, 0x61, 0xd2,
-		0x9b, 0xfa, 0xbd, 0xc2, 0xea, 0x82, 0xa4, 0x78, 0x09, 0x32, 0x5e, 0xb2, 0xe9, 0x4d, 0xff, 0x3e,
-		0x4e, 0xef, 0xd3, 0x10, 0x0b, 0x04, 0x92, 0x5d, 0x6f, 0x16, 0xbf, 0x28, 0x2c, 0x10, 0xa0, 0x22,
-		0xd3, 0x28, 0x5a, 0xc0, 0xf4, 0xe6, 0xf4, 0x7e, 0x31, 0x8d, 0x22, 0xf5, 0x0b, 0x19, 0x4d, 0x1a,
-		0xf3, 0x7b, 0xb3, 0xf8, 0xfb, 0x62, 0x34, 0x29, 0x3e, 0x11, 0x23, 0x5a, 0x11, 0xf4, 0xe6, 0xf1,
-		0x01, 0x21, 0x46, 0xa4, 0x20, 0x28, 0xae, 0x03, 0x6a, 0xaf, 0x06, 0x7a, 0xf3, 0xfb, 0x20, 0xe7,
-		0x37, 0xde, 0x56, 0x0c, 0x14, 0xdf, 0x04, 0x53, 0xf1, 0x95, 0x40, 0x6f, 0xae, 0xbf, 0xf4, 0xdd,
-		0xc8, 0xda, 0x2d, 0x58, 0x08, 0x14, 0x37, 0xfd, 0x94, 0x12, 0xac, 0x02, 0x7a, 0xb3, 0xfd, 0xd0,
-		0x77, 0xc3, 0x81, 0x3b, 0x58, 0x04, 0x14, 0xe7, 0x01, 0xfc, 0x04, 0xdc, 0x9b, 0xd7, 0x87, 0x39,
-		0xaf, 0x00, 0x11, 0x99, 0x1a, 0x3c, 0xff, 0xf6, 0xa6, 0xbf, 0x29, 0xa6, 0x06, 0xa7, 0x20, 0x53,
-		0x43, 0xa4, 0xde, 0xde, 0xd4, 0x1f, 0x11, 0x53, 0x43, 0x90, 0x10, 0xcf, 0x0e, 0x64, 0xb7, 0xde,
-		0x1c, 0x3e, 0x2a, 0x3c, 0x3b, 0x40, 0x55, 0x5c, 0x85, 0xf1, 0xb6, 0x84, 0xd8, 0x9b, 0xd5, 0xaf,
-		0x70, 0x56, 0xb9, 0x68, 0x3e, 0x0c, 0x26, 0x2f, 0x9e, 0x0c, 0x7b, 0x73, 0xfb, 0x58, 0x24, 0x79,
-		0xf1, 0x5c, 0x58, 0xbc, 0x08, 0x69, 0xa3, 0xa5, 0xeb, 0x64, 0xf2, 0xa0, 0xee, 0x27, 0x01, 0xf3,
-		0xff, 0xed, 0xfb, 0xdc, 0x3a, 0x82, 0xa0, 0x78, 0x1e, 0x06, 0x70, 0x73, 0x1b, 0xd7, 0x7b, 0x51,
-		0x7e, 0xf3, 0xfb, 0x22, 0x60, 0x12, 0xec, 0xe2, 0x25, 0x00, 0xb6, 0x35, 0x42, 0x5f, 0x06, 0xf6,
-		0xa0, 0xfd, 0xf3, 0xef, 0xf3, 0xa3, 0x37, 0x3e, 0x89, 0xcf, 0x80, 0x1d, 0xe4, 0xe9, 0xce, 0xe0,
-		0x5b, 0x61, 0x06, 0x74, 0x44, 0x2e, 0xc0, 0xd0, 0xcb, 0x8e, 0x69, 0xb8, 0x6a, 0xa3, 0x17, 0xf5,
-		0x5f, 0x70, 0x6a, 0x81, 0x4f, 0x0c, 0xd6, 0x34, 0x6d, 0xec, 0xaa, 0x0d, 0xa7, 0x17, 0xed, 0xb7,
-		0x39, 0xad, 0x47, 0x40, 0x88, 0x6b, 0xaa, 0xe3, 0xf6, 0xa3, 0xf7, 0x5f, 0x0a, 0x62, 0x41, 0x40,
-		0x84, 0x26, 0xbf, 0xf7, 0xf0, 0x7e, 0x2f, 0xda, 0xef, 0x08, 0xa1, 0x39, 0x7e, 0xf1, 0x39, 0xc8,
-		0x90, 0x9f, 0xec, 0x3c, 0x5d, 0x0f, 0xe2, 0xff, 0xce, 0x89, 0x7d, 0x0a, 0xd2, 0xb3, 0xe3, 0xd6,
-		0x5d, 0xad, 0xb7, 0xb1, 0x6f, 0xf3, 0x91, 0x16, 0xf8, 0xc5, 0x79, 0x18, 0x76, 0xdc, 0x7a, 0xbd,
-		0xc5, 0xeb, 0xd3, 0x1e, 0xe4, 0x7f, 0xf5, 0x7d, 0x6f, 0xcb, 0xc2, 0xa3, 0x21, 0xa3, 0x7d, 0x7d,
-		0xcf, 0xb5, 0x4c, 0xfa, 0xc2, 0xa3, 0x17, 0x87, 0xef, 0x72, 0x0e, 0x01, 0x92, 0xe2, 0x02, 0x64,
-		0x89, 0x2e, 0x36, 0xb6, 0x30, 0x7d, 0x3b, 0xd5, 0x83, 0xc5, 0xf7, 0xb8, 0x01, 0x42, 0x44, 0xa5,
-		0x9f, 0xfe, 0xe2, 0x1b, 0x27, 0xa4, 0xaf, 0xbc, 0x71, 0x42, 0xfa, 0xb3, 0x37, 0x4e, 0x48, 0xef,
-		0xfd, 0xda, 0x89, 0x43, 0x5f, 0xf9, 0xda, 0x89, 0x43, 0x7f, 0xf4, 0xb5, 0x13, 0x87, 0xe2, 0x77,
-		0x89, 0x61, 0xd1, 0x5c, 0x34, 0xd9, 0xfe, 0xf0, 0x5b, 0xe4, 0x86, 0xe6, 0xee, 0xb6, 0xb6, 0x67,
-		0x6b, 0x66, 0x93, 0x6e, 0xe3, 0xfa, 0xbb, 0xb5, 0xde, 0x22, 0x07, 0xbe, 0x27, 0x91, 0x05, 0x73,
-		0x78, 0x2f, 0x57, 0x35, 0xf6, 0x3b, 0xdc, 0xcc, 0x29, 0xc4, 0x6e, 0x0c, 0xcb, 0xcf, 0x42, 0x72,
-		0xde, 0xd8, 0x47, 0x47, 0x59, 0xcc, 0xab, 0xb6, 0x6c, 0x9d, 0x9f, 0xf3, 0x1a, 0x22, 0xcf, 0x5b,
-		0xb6, 0x8e, 0x26, 0xfd, 0xc3, 0x98, 0xd2, 0xe9, 0x2c, 0x3f, 0x61, 0x59, 0x4c, 0x7d, 0xe7, 0xa3,
-		0xd3, 0x87, 0x4a, 0x7b, 0x51, 0x0d, 0xbf, 0xd0, 0x53, 0xcb, 0xf4, 0xbc, 0xb1, 0x4f, 0x95, 0x5c,
-		0x97, 0xde, 0x32, 0x40, 0x37, 0xba, 0xc5, 0xc6, 0xf6, 0x89, 0xe8, 0xc6, 0xf6, 0x9b, 0xb0, 0xae,
-		0xbf, 0x68, 0x98, 0xd7, 0x8d, 0x4d, 0x82, 0xb6, 0x3d, 0xc8, 0x0e, 0x0d, 0xc3, 0xfb, 0x13, 0x70,
-		0xa2, 0x6d, 0x0f, 0x9b, 0x8f, 0x7c, 0xa7, 0x6b, 0x49, 0x45, 0x48, 0x97, 0x85, 0x43, 0xe5, 0x61,
-		0xc8, 0xc1, 0x35, 0xd3, 0xa8, 0x3b, 0x54, 0xd5, 0xa4, 0x22, 0x1e, 0x89, 0xaa, 0x86, 0x6a, 0x98,
-		0x0e, 0x3f, 0x0b, 0xc9, 0x1e, 0x4a, 0xbf, 0x2c, 0x1d, 0x6c, 0x1c, 0x47, 0x44, 0x4f, 0x42, 0xcd,
-		0x27, 0x7a, 0x6e, 0xf5, 0xef, 0x11, 0x2d, 0x3d, 0x25, 0x42, 0xdb, 0xfd, 0xfd, 0x5a, 0xe5, 0x03,
-		0x09, 0x98, 0x8e, 0x5a, 0x85, 0x4c, 0x27, 0xc7, 0x55, 0x9b, 0x56, 0x27, 0xb3, 0x5c, 0x84, 0xcc,
-		0xa6, 0xc0, 0x39, 0xb0, 0x5d, 0x6e, 0x1e, 0xd0, 0x2e, 0xa3, 0x5e, 0x57, 0xc2, 0x30, 0x67, 0xfb,
-		0x34, 0x8c, 0xa7, 0xc7, 0x1d, 0x59, 0xe6, 0x9d, 0x49, 0x38, 0x5a, 0x33, 0x9d, 0xa6, 0xe9, 0x54,
-		0x99, 0xfb, 0xb3, 0x07, 0x6e, 0x93, 0x6c, 0xb0, 0xa9, 0x8f, 0x97, 0x23, 0x57, 0x60, 0x94, 0x86,
-		0x08, 0xba, 0x2d, 0x4c, 0xa3, 0x72, 0xcf, 0x44, 0xfa, 0xfb, 0xff, 0x61, 0x80, 0x4e, 0xa9, 0x11,
-		0x8f, 0x90, 0x9e, 0x72, 0xd9, 0x84, 0x49, 0xad, 0x69, 0xe9, 0x98, 0xbe, 0x0e, 0xab, 0x7a, 0x6d,
-		0xbd, 0xf9, 0x7d, 0x89, 0xf3, 0x9b, 0xf0, 0xc9, 0x97, 0x04, 0x75, 0x71, 0x19, 0xc6, 0xd5, 0x5a,
-		0x0d, 0x5b, 0x21, 0x96, 0x3d, 0xc2, 0x97, 0x10, 0x30, 0xc7, 0x29, 0x3d, 0x6e, 0xa5, 0x4b, 0x9d,
-		0x86, 0xf8, 0x2d, 0x0f, 0x06, 0x22, 0x94, 0x8d, 0x1b, 0xd8, 0x78, 0xcc, 0xc0, 0xee, 0x75, 0xd3,
-		0xde, 0xe3, 0xe6, 0x7d, 0x8c, 0x75, 0x25, 0x06, 0xe1, 0x7f, 0x25, 0xe0, 0x98, 0xbe, 0xb3, 0x3d,
-		0xb7, 0xad, 0x3a, 0x78, 0xee, 0xda, 0x13, 0xdb, 0xd8, 0x55, 0x9f, 0x98, 0xab, 0x99, 0x9a, 0x98,
-		0xb1, 0x39, 0x7d, 0x67, 0x7b, 0x96, 0x34, 0xce, 0xf2, 0xc6, 0x0e, 0xf1, 0x6a, 0x11, 0x52, 0x0b,
-		0xa6, 0x66, 0x10, 0x97, 0xac, 0x63, 0xc3, 0x6c, 0xf2, 0x68, 0xc5, 0x1e, 0xd0, 0xfd, 0x30, 0xa8,
-		0x36, 0xcd, 0x96, 0xe1, 0xb2, 0x77, 0x78, 0xa5, 0xe1, 0x2f, 0xde, 0x9a, 0x3e, 0xf4, 0xc7, 0xb7,
-		0xa6, 0x93, 0x4b, 0x86, 0xab, 0xf0, 0xa6, 0x62, 0xea, 0x1b, 0xaf, 0x4f, 0x4b, 0xf2, 0x0b, 0x30,
-		0x54, 0xc6, 0xb5, 0x3b, 0xe1, 0x55, 0xc6, 0xb5, 0x08, 0xaf, 0x87, 0x21, 0xbd, 0x64, 0xb8, 0xec,
-		0xdc, 0xf0, 0x7d, 0x90, 0xd4, 0x0c, 0x76, 0x14, 0x2d, 0xd2, 0x3f, 0x81, 0x13, 0xd4, 0x32, 0xae,
-		0x79, 0xa8, 0x75, 0x5c, 0x8b, 0xa2, 0x12, 0xf6, 0x04, 0x5e, 0xba, 0xf4, 0x47, 0xff, 0xf9, 0xc4,
-		0xa1, 0x57, 0xdf, 0x38, 0x71, 0xa8, 0xe3, 0x18, 0xdc, 0x17, 0x18, 0x03, 0x5d, 0x33, 0xf0, 0x9c,
-		0xbe, 0xb3, 0xfd, 0x98, 0x53, 0xdf, 0x63, 0x93, 0xc7, 0xb3, 0xfd, 0x07, 0x53, 0x70, 0x8c, 0xac,
-		0xb6, 0xd5, 0x9a, 0x69, 0xcc, 0xd5, 0xec, 0x7d, 0xcb, 0xa5, 0xf9, 0xc4, 0xdc, 0x11, 0x61, 0x41,
-		0x34, 0xce, 0xb2, 0xc6, 0x0e, 0xa6, 0xdf, 0x81, 0x81, 0x75, 0x42, 0x45, 0xec, 0xe5, 0x9a, 0xae,
-		0xaa, 0xf3, 0x30, 0xc1, 0x1e, 0x08, 0x94, 0xdd, 0x2e, 0x49, 0x30, 0xa8, 0x26, 0x2e, 0x96, 0xe8,
-		0x58, 0xdd, 0x61, 0x87, 0x74, 0x93, 0x34, 0x83, 0xa4, 0x09, 0x80, 0x9e, 0xc7, 0x9d, 0x84, 0x01,
-		0xb5, 0xc5, 0xde, 0x2f, 0x27, 0x49, 0x6a, 0xa1, 0x0f, 0xf2, 0x12, 0x0c, 0xf1, 0xb7, 0x5c, 0x28,
-		0x07, 0xc9, 0x3d, 0xbc, 0x4f, 0xfb, 0xc9, 0x2a, 0xe4, 0x27, 0x7a, 0x14, 0x06, 0xa8, 0xe8, 0xfc,
-		0xf6, 0xc1, 0xd4, 0x6c, 0x44, 0xf6, 0x59, 0x2a, 0xa2, 0xc2, 0x90, 0xe4, 0x17, 0x20, 0x5d, 0x36,
-		0x9b, 0x9a, 0x61, 0x86, 0x79, 0x65, 0x18, 0x2f, 0x2a, 0xb1, 0xd5, 0xe2, 0x03, 0xac, 0xb0, 0x07,
-		0x34, 0x05, 0x83, 0xec, 0xc8, 0x36, 0x7f, 0x43, 0xce, 0x9f, 0xe4, 0x05, 0x18, 0xa2, 0xbc, 0xd7,
-		0x2c, 0x84, 0xf8, 0x65, 0x1f, 0x7e, 0x36, 0x9c, 0x46, 0x01, 0xce, 0x3e, 0xe1, 0x8b, 0x8a, 0x20,
-		0x55, 0x57, 0x5d, 0x95, 0x6b, 0x4d, 0x7f, 0xcb, 0xcf, 0x42, 0x9a, 0x33, 0x71, 0xd0, 0xe3, 0x90,
-		0x34, 0x2d, 0x87, 0xbf, 0xe3, 0xce, 0xc7, 0x2b, 0xb2, 0x66, 0x95, 0x52, 0xc4, 0x31, 0x14, 0x82,
-		0x5a, 0xba, 0xd2, 0xd1, 0x13, 0x66, 0xa3, 0x9e, 0xe0, 0x8d, 0x37, 0x1b, 0xc6, 0xc8, 0xf0, 0x07,
-		0x73, 0xe9, 0x71, 0xaf, 0xed, 0x1a, 0xb6, 0xc9, 0xd2, 0x8e, 0x79, 0x8f, 0x98, 0x97, 0x9e, 0x58,
-		0xbc, 0xb5, 0x83, 0x73, 0x3c, 0x07, 0xc9, 0x79, 0xcb, 0x42, 0x05, 0x48, 0xd3, 0xe7, 0x9a, 0xc9,
-		0xbc, 0x23, 0xa5, 0x78, 0xcf, 0xa4, 0xcd, 0x31, 0x77, 0xdc, 0xeb, 0xaa, 0xed, 0xdd, 0x62, 0x12,
-		0xcf, 0xf2, 0x05, 0xc8, 0x2c, 0x98, 0x86, 0x83, 0x0d, 0xa7, 0x45, 0xd3, 0xcd, 0xb6, 0x6e, 0xd6,
-		0xf6, 0x38, 0x07, 0xf6, 0x40, 0x0c, 0xac, 0x5a, 0x16, 0xa5, 0x4c, 0x29, 0xe4, 0x27, 0x9b, 0x7c,
-		0xa5, 0xa5, 0x8e, 0x46, 0x99, 0xeb, 0xd7, 0x28, 0x5c, 0x35, 0xcf, 0x2a, 0x7f, 0x29, 0x41, 0x21,
-		0x3a, 0x61, 0xf6, 0xf0, 0xbe, 0x73, 0xb0, 0xf9, 0xf2, 0x12, 0x64, 0xd6, 0xe9, 0xe5, 0xe1, 0x17,
-		0xf1, 0x3e, 0x2a, 0xc0, 0x10, 0xae, 0x9f, 0x3d, 0x7f, 0xfe, 0x89, 0x0b, 0xcc, 0x9b, 0xaf, 0x1c,
-		0x52, 0x04, 0x00, 0x9d, 0x80, 0x8c, 0x83, 0x6b, 0xd6, 0xd9, 0xf3, 0x4f, 0xed, 0x3d, 0xc1, 0x1c,
-		0xe8, 0xca, 0x21, 0xc5, 0x07, 0x15, 0xd3, 0x44, 0xcf, 0x6f, 0x7c, 0x74, 0x5a, 0x2a, 0x0d, 0x40,
-		0xd2, 0x69, 0x35, 0xef, 0x81, 0x1f, 0xbc, 0x73, 0x00, 0x4e, 0x78, 0x6d, 0x2c, 0xf5, 0x5e, 0x53,
-		0x75, 0xad, 0xae, 0xfa, 0x57, 0xbd, 0x47, 0x3d, 0xad, 0x69, 0x7b, 0xbc, 0xd2, 0x85, 0x2e, 0x76,
-		0x93, 0x3f, 0x29, 0x41, 0xf6, 0xaa, 0xe0, 0xba, 0x81, 0x5d, 0x74, 0x01, 0xc0, 0xeb, 0x45, 0x4c,
-		0x84, 0xa3, 0xb3, 0xe1, 0x7e, 0x66, 0x3d, 0x0a, 0x25, 0x80, 0x8c, 0xce, 0x53, 0x47, 0xb3, 0x4c,
-		0x87, 0xdf, 0x5c, 0xe9, 0x4a, 0xe8, 0xa1, 0xa2, 0x47, 0x01, 0xd1, 0x68, 0x55, 0xbd, 0x66, 0xba,
-		0x9a, 0xd1, 0xa8, 0x5a, 0xe6, 0x75, 0x7e, 0x1b, 0x30, 0xa9, 0xe4, 0x68, 0xcb, 0x55, 0xda, 0xb0,
-		0x4e, 0xe0, 0xf2, 0xa7, 0x24, 0xc8, 0x78, 0x5c, 0x48, 0x7d, 0xa4, 0xd6, 0xeb, 0x36, 0x76, 0x1c,
-		0x1e, 0x90, 0xc4, 0x23, 0xba, 0x00, 0x43, 0x56, 0x6b, 0xbb, 0x2a, 0xe6, 0xff, 0xf0, 0xd9, 0x42,
-		0xfb, 0x6c, 0x16, 0x9e, 0xc0, 0xe7, 0xf3, 0xa0, 0xd5, 0xda, 0x26, 0x7e, 0x71, 0x12, 0xb2, 0x31,
-		0xa2, 0x0c, 0x5f, 0xf3, 0xa5, 0xa0, 0xb7, 0xd3, 0xb9, 0xfc, 0x55, 0xcb, 0xd6, 0x4c, 0x5b, 0x73,
-		0xf7, 0xe9, 0xa1, 0x93, 0xa4, 0x92, 0x13, 0x0d, 0xeb, 0x1c, 0x2e, 0x6b, 0x30, 0xb6, 0x41, 0xcb,
-		0x02, 0x5f, 0xee, 0x27, 0x7d, 0xe9, 0xa4, 0x5e, 0xd2, 0x75, 0x94, 0x2b, 0xd1, 0x26, 0x57, 0x69,
-		0xb1, 0xa3, 0x17, 0x3e, 0xd6, 0xaf, 0x17, 0x86, 0xf3, 0xd4, 0x6f, 0x1e, 0x0d, 0x4c, 0x3b, 0xe6,
-		0x84, 0xc1, 0x50, 0xd4, 0x9f, 0x03, 0xf6, 0x2a, 0x82, 0x0b, 0xdd, 0x52, 0x61, 0xa1, 0x6b, 0x30,
-		0x2c, 0xf4, 0x98, 0x22, 0xf2, 0x05, 0x18, 0x59, 0x57, 0x6d, 0x77, 0x03, 0xbb, 0x57, 0xb0, 0x5a,
-		0xc7, 0x76, 0x38, 0x53, 0x8e, 0x88, 0x4c, 0x89, 0x20, 0x45, 0xd3, 0x21, 0xcb, 0x15, 0xf4, 0xb7,
-		0xbc, 0x03, 0x29, 0x7a, 0xc4, 0xcc, 0xcb, 0xa2, 0x9c, 0x82, 0x65, 0x51, 0x12, 0x11, 0xf7, 0x5d,
-		0xec, 0x88, 0x35, 0x18, 0x7d, 0x40, 0x67, 0x45, 0x2e, 0x4c, 0x76, 0xcb, 0x85, 0xdc, 0xe1, 0x78,
-		0x46, 0x7c, 0x19, 0x86, 0x4a, 0x24, 0x9c, 0x2e, 0x95, 0x3d, 0x31, 0x24, 0x5f, 0x0c, 0xf4, 0x22,
-		0x8c, 0x59, 0xaa, 0xed, 0xd2, 0x93, 0xf5, 0xbb, 0x54, 0x07, 0xef, 0x9a, 0x5f, 0x64, 0x76, 0x85,
-		0x14, 0xe5, 0x7d, 0x8c, 0x58, 0x41, 0xa0, 0xfc, 0x46, 0x0a, 0x06, 0xb9, 0x21, 0x2e, 0xc2, 0x10,
-		0x37, 0x28, 0xf7, 0xc1, 0x63, 0xb3, 0xd1, 0xc4, 0x32, 0xeb, 0x25, 0x00, 0xce, 0x4d, 0x50, 0xa0,
-		0x87, 0x20, 0x5d, 0xdb, 0x55, 0x35, 0xa3, 0xaa, 0xd5, 0x45, 0x2d, 0xf6, 0xc6, 0xad, 0xe9, 0xa1,
-		0x05, 0x02, 0x5b, 0x2a, 0x2b, 0x43, 0xb4, 0x71, 0xa9, 0x4e, 0x32, 0xf7, 0x2e, 0xd6, 0x1a, 0xbb,
-		0x2e, 0x9f, 0x45, 0xfc, 0x09, 0x3d, 0x03, 0x29, 0xe2, 0x04, 0xfc, 0xce, 0x55, 0xa1, 0xad, 0x16,
-		0xf6, 0xd6, 0x25, 0xa5, 0x34, 0xe9, 0xf8, 0xbd, 0x7f, 0x3a, 0x2d, 0x29, 0x94, 0x02, 0xcd, 0xc3,
-		0x88, 0xae, 0x3a, 0x6e, 0x95, 0x66, 0x20, 0xd2, 0xfd, 0x00, 0x65, 0x71, 0x24, 0x6a, 0x0c, 0x6e,
-		0x52, 0x2e, 0xf8, 0x30, 0xa1, 0x61, 0xa0, 0x3a, 0x3a, 0x0d, 0x39, 0xca, 0xa2, 0x66, 0x36, 0x9b,
-		0x9a, 0xcb, 0xea, 0xa0, 0x41, 0x6a, 0xf1, 0x51, 0x02, 0x5f, 0xa0, 0x60, 0x5a, 0x0d, 0x1d, 0x83,
-		0x0c, 0xbd, 0xe3, 0x41, 0x51, 0xd8, 0x79, 0xc6, 0x34, 0x01, 0xd0, 0xc6, 0x53, 0x30, 0xe6, 0x47,
-		0x3f, 0x86, 0x92, 0x66, 0x5c, 0x7c, 0x30, 0x45, 0x7c, 0x1c, 0x26, 0x0d, 0x7c, 0x83, 0x9e, 0xb0,
-		0x0c, 0x61, 0x67, 0x28, 0x36, 0x22, 0x6d, 0x57, 0xc3, 0x14, 0x0f, 0xc2, 0x68, 0x4d, 0x98, 0x9e,
-		0xe1, 0x02, 0xc5, 0x1d, 0xf1, 0xa0, 0x14, 0xed, 0x28, 0xa4, 0x55, 0xcb, 0x62, 0x08, 0xc3, 0x3c,
-		0xfe, 0x59, 0x16, 0x6d, 0x3a, 0x03, 0xe3, 0x54, 0x47, 0x1b, 0x3b, 0x2d, 0xdd, 0xe5, 0x4c, 0xb2,
-		0x14, 0x67, 0x8c, 0x34, 0x28, 0x0c, 0x4e, 0x71, 0xef, 0x87, 0x11, 0x7c, 0x4d, 0xab, 0x63, 0xa3,
-		0x86, 0x19, 0xde, 0x08, 0xc5, 0xcb, 0x0a, 0x20, 0x45, 0x7a, 0x18, 0xbc, 0xc8, 0x56, 0x15, 0x31,
-		0x77, 0x94, 0xf1, 0x13, 0xf0, 0x79, 0x06, 0x96, 0xf3, 0x90, 0x2a, 0xab, 0xae, 0x4a, 0xca, 0x03,
-		0xf7, 0x06, 0x4b, 0x22, 0x59, 0x85, 0xfc, 0x94, 0xbf, 0x9e, 0x80, 0xd4, 0x55, 0xd3, 0xc5, 0xe8,
-		0x89, 0x40, 0xb9, 0x36, 0xda, 0xee, 0xc9, 0x1b, 0x5a, 0xc3, 0xc0, 0xf5, 0x15, 0xa7, 0x11, 0xb8,
-		0x8e, 0xed, 0xbb, 0x52, 0x22, 0xe4, 0x4a, 0x93, 0x30, 0x60, 0x9b, 0x2d, 0xa3, 0x2e, 0x0e, 0x02,
-		0xd2, 0x07, 0xb4, 0x00, 0x69, 0xcf, 0x43, 0x52, 0xdd, 0x3d, 0x64, 0x8c, 0x78, 0x08, 0xf1, 0x5e,
-		0x0e, 0x50, 0x86, 0xb6, 0xb9, 0xa3, 0x94, 0x20, 0xe3, 0x85, 0x2a, 0xee, 0x67, 0xfd, 0xb9, 0xaa,
-		0x4f, 0x46, 0x52, 0x85, 0x37, 0xee, 0x9e, 0xe1, 0x98, 0xb7, 0xe5, 0xbc, 0x06, 0x6e, 0xb9, 0x90,
-		0x4b, 0xf1, 0x8b, 0xe1, 0x43, 0x54, 0x2b, 0xdf, 0xa5, 0xd8, 0xe5, 0xf0, 0xe3, 0x90, 0x71, 0xb4,
-		0x86, 0xa1, 0xba, 0x2d, 0x1b, 0x73, 0xaf, 0xf3, 0x01, 0xf2, 0xe7, 0x25, 0x18, 0x64, 0x5e, 0x1c,
-		0xb0, 0x9a, 0x14, 0x6f, 0xb5, 0x44, 0x27, 0xab, 0x25, 0xef, 0xd4, 0x6a, 0x97, 0x00, 0x3c, 0x51,
-		0x1c, 0x7e, 0x5b, 0xb7, 0xad, 0x12, 0x60, 0xe2, 0x6d, 0x68, 0x0d, 0x3e, 0x41, 0x03, 0x24, 0xf2,
-		0x9f, 0x48, 0xa4, 0xf4, 0xe4, 0xed, 0xe8, 0x12, 0x8c, 0x08, 0x99, 0xaa, 0x3b, 0xba, 0xda, 0xe0,
-		0x3e, 0x73, 0xac, 0x83, 0x60, 0x97, 0x75, 0xb5, 0xa1, 0x0c, 0x73, 0x59, 0xc8, 0x43, 0xfc, 0x08,
-		0x24, 0x3a, 0x8c, 0x40, 0x68, 0xc8, 0x93, 0x77, 0x36, 0xe4, 0xa1, 0xc1, 0x49, 0x45, 0x07, 0xe7,
-		0x37, 0x12, 0x74, 0xc1, 0x61, 0x99, 0x8e, 0xaa, 0xdf, 0xfb, 0x79, 0x70, 0x0c, 0x32, 0x96, 0xa9,
-		0x57, 0x59, 0x0b, 0x3b, 0x16, 0x9b, 0xb6, 0x4c, 0x5d, 0x69, 0x1b, 0xee, 0x81, 0xbb, 0x32, 0x49,
-		0x06, 0xef, 0x82, 0xc5, 0x86, 0xa2, 0x16, 0x33, 0x20, 0xcb, 0x0c, 0xc1, 0x33, 0xd7, 0x2c, 0xb1,
-		0x00, 0x4d, 0x84, 0x52, 0x34, 0xcb, 0x32, 0xa1, 0x19, 0x9e, 0xc2, 0xb1, 0x08, 0x3e, 0x0b, 0xf5,
-		0xed, 0x2b, 0xd4, 0xa0, 0x33, 0x2a, 0x1c, 0x4b, 0xfe, 0x45, 0x09, 0x60, 0x99, 0xd8, 0x94, 0xea,
-		0x4a, 0x32, 0x8e, 0x43, 0xbb, 0xaf, 0x86, 0x7a, 0x3d, 0x1e, 0x3f, 0x58, 0xbc, 0xef, 0xac, 0x13,
-		0x94, 0x78, 0x1e, 0x46, 0x7c, 0x17, 0x74, 0xb0, 0x10, 0xe4, 0x78, 0xc7, 0xfa, 0x78, 0x03, 0xbb,
-		0x4a, 0xf6, 0x5a, 0xe0, 0x49, 0xfe, 0x6d, 0x09, 0x32, 0x54, 0x9e, 0x15, 0xec, 0xaa, 0xa1, 0x91,
-		0x93, 0xee, 0x74, 0xe4, 0xee, 0x03, 0x60, 0x4c, 0x1c, 0xed, 0x15, 0xcc, 0xbd, 0x29, 0x43, 0x21,
-		0x1b, 0xda, 0x2b, 0x18, 0x9d, 0xf3, 0xcc, 0x9c, 0xec, 0x66, 0x66, 0x51, 0x3d, 0x73, 0x63, 0x1f,
-		0x81, 0x21, 0xfa, 0x25, 0x9b, 0x1b, 0x0e, 0x2f, 0x88, 0x07, 0x8d, 0x56, 0x73, 0xf3, 0x86, 0x23,
-		0xef, 0xc2, 0xd0, 0xe6, 0x0d, 0xb6, 0x5b, 0x71, 0x0c, 0x32, 0xb6, 0x69, 0xf2, 0xcc, 0xcb, 0x6a,
-		0x9d, 0x34, 0x01, 0xd0, 0x44, 0x23, 0xd6, 0xe8, 0x09, 0x7f, 0x8d, 0xee, 0x6f, 0x31, 0x24, 0xfb,
-		0xd8, 0x62, 0x38, 0xf3, 0x1f, 0x25, 0x18, 0x0e, 0x44, 0x03, 0xf4, 0x04, 0x1c, 0x2e, 0x2d, 0xaf,
-		0x2d, 0xbc, 0x58, 0x5d, 0x2a, 0x57, 0x2f, 0x2f, 0xcf, 0x2f, 0xfa, 0x17, 0x3d, 0x0a, 0x53, 0xaf,
-		0xdd, 0x9c, 0x41, 0x01, 0xdc, 0x2d, 0x83, 0x6e, 0x6f, 0xa2, 0x39, 0x98, 0x0c, 0x93, 0xcc, 0x97,
-		0x36, 0x2a, 0xab, 0x9b, 0x39, 0xa9, 0x70, 0xf8, 0xb5, 0x9b, 0x33, 0xe3, 0x01, 0x8a, 0xf9, 0x6d,
-		0x07, 0x1b, 0x6e, 0x3b, 0xc1, 0xc2, 0xda, 0xca, 0xca, 0xd2, 0x66, 0x2e, 0xd1, 0x46, 0xc0, 0x03,
-		0xf3, 0xc3, 0x30, 0x1e, 0x26, 0x58, 0x5d, 0x5a, 0xce, 0x25, 0x0b, 0xe8, 0xb5, 0x9b, 0x33, 0xa3,
-		0x01, 0xec, 0x55, 0x4d, 0x2f, 0xa4, 0xdf, 0xf3, 0xb1, 0x13, 0x87, 0x7e, 0xed, 0x1f, 0x9e, 0x90,
-		0x88, 0x66, 0x23, 0xa1, 0x98, 0x80, 0x1e, 0x85, 0x23, 0x1b, 0x4b, 0x8b, 0xab, 0x95, 0x72, 0x75,
-		0x65, 0x63, 0xb1, 0xca, 0x3e, 0x70, 0xe1, 0x69, 0x37, 0xf6, 0xda, 0xcd, 0x99, 0x61, 0xae, 0x52,
-		0x27, 0xec, 0x75, 0xa5, 0x72, 0x75, 0x6d, 0xb3, 0x92, 0x93, 0x18, 0xf6, 0xba, 0x8d, 0xaf, 0x99,
-		0x2e, 0xfb, 0xd0, 0xd5, 0xe3, 0x70, 0x34, 0x06, 0xdb, 0x53, 0x6c, 0xfc, 0xb5, 0x9b, 0x33, 0x23,
-		0xeb, 0x36, 0x66, 0xb3, 0x86, 0x52, 0xcc, 0x42, 0xbe, 0x9d, 0x62, 0x6d, 0x7d, 0x6d, 0x63, 0x7e,
-		0x39, 0x37, 0x53, 0xc8, 0xbd, 0x76, 0x73, 0x26, 0x2b, 0x42, 0x1f, 0xc1, 0xf7, 0x35, 0xbb, 0xfb,
-		0x2b, 0x97, 0x6f, 0x3f, 0x0a, 0x27, 0xf5, 0x9d, 0xed, 0x39, 0xc7, 0x55, 0xf7, 0x34, 0xa3, 0xe1,
-		0x6d, 0x70, 0xf2, 0x67, 0xbe, 0x80, 0x99, 0xd0, 0x77, 0xb6, 0x67, 0x05, 0xa8, 0xeb, 0x36, 0x67,
-		0xa1, 0xf3, 0x8b, 0x9d, 0x42, 0x8f, 0x77, 0x1f, 0xbd, 0x17, 0x40, 0x9d, 0x37, 0xc3, 0x0b, 0xdd,
-		0xb6, 0x68, 0x0b, 0x5d, 0xd6, 0x66, 0xf2, 0xcf, 0x4a, 0x30, 0x7a, 0x45, 0x73, 0x5c, 0xd3, 0xd6,
-		0x6a, 0xaa, 0x4e, 0x6f, 0x72, 0x9c, 0xeb, 0x2f, 0x70, 0x46, 0x66, 0xf4, 0xb3, 0x30, 0x78, 0x4d,
-		0xd5, 0x59, 0xd4, 0x4a, 0xd2, 0x2f, 0x24, 0xc4, 0x18, 0xcd, 0x0f, 0x5d, 0x82, 0x9a, 0xd1, 0xc8,
-		0xaf, 0x27, 0x60, 0x8c, 0xba, 0xbc, 0xc3, 0xbe, 0x46, 0x44, 0x56, 0x49, 0xcf, 0x41, 0xca, 0x56,
-		0x5d, 0xbe, 0x59, 0x57, 0x7a, 0x98, 0x6f, 0xb3, 0x9e, 0xec, 0xba, 0x75, 0x3a, 0x5b, 0xc6, 0x35,
-		0x85, 0x92, 0xa1, 0x37, 0x41, 0xba, 0xa9, 0xde, 0xa8, 0x52, 0x16, 0x6c, 0xf1, 0xf1, 0x6c, 0xdf,
-		0x2c, 0x6e, 0xdf, 0x9a, 0x1e, 0xdb, 0x57, 0x9b, 0x7a, 0x51, 0x16, 0x2c, 0x64, 0x65, 0xa8, 0xa9,
-		0xde, 0x20, 0x82, 0xa1, 0x3d, 0x18, 0x23, 0xd0, 0xda, 0xae, 0x6a, 0x34, 0x30, 0xe3, 0x4f, 0x37,
-		0x1c, 0x4b, 0x0b, 0x07, 0xe1, 0x3f, 0xe5, 0xf3, 0x0f, 0x70, 0x92, 0x95, 0x91, 0xa6, 0x7a, 0x63,
-		0x81, 0x02, 0x48, 0x67, 0xc5, 0xf4, 0x07, 0x5f, 0x9f, 0x3e, 0x44, 0xf7, 0xaa, 0xff, 0x40, 0x02,
-		0xf0, 0x4d, 0x84, 0xde, 0x0c, 0xb9, 0x9a, 0xf7, 0x44, 0x69, 0x1d, 0x3e, 0x5e, 0x0f, 0xc4, 0x5a,
-		0x3e, 0x62, 0x5d, 0x96, 0x61, 0xbf, 0x72, 0x6b, 0x5a, 0x52, 0xc6, 0x6a, 0x11, 0xc3, 0xbf, 0x15,
-		0x86, 0x5b, 0x56, 0x5d, 0x75, 0x71, 0x95, 0xae, 0xbe, 0x12, 0x3d, 0xb3, 0xf5, 0x09, 0xc2, 0xeb,
-		0xf6, 0xad, 0x69, 0xc4, 0x74, 0x0a, 0x10, 0xcb, 0x34, 0x87, 0x03, 0x83, 0x10, 0x82, 0x80, 0x42,
-		0xbf, 0x2f, 0xc1, 0x70, 0x39, 0x70, 0x98, 0x2a, 0x0f, 0x43, 0x4d, 0xd3, 0xd0, 0xf6, 0xb8, 0xe3,
-		0x65, 0x14, 0xf1, 0x88, 0x0a, 0x90, 0x66, 0xb7, 0xd8, 0xdc, 0x7d, 0xb1, 0x01, 0x29, 0x9e, 0x09,
-		0xd5, 0x75, 0xbc, 0xed, 0x68, 0x62, 0x14, 0x14, 0xf1, 0x88, 0x2e, 0x43, 0xce, 0xc1, 0xb5, 0x96,
-		0xad, 0xb9, 0xfb, 0xd5, 0x9a, 0x69, 0xb8, 0x6a, 0xcd, 0x65, 0xf7, 0xa1, 0x4a, 0xc7, 0x6e, 0xdf,
-		0x9a, 0x3e, 0xc2, 0x64, 0x8d, 0x62, 0xc8, 0xca, 0x98, 0x00, 0x2d, 0x30, 0x08, 0xe9, 0xa1, 0x8e,
-		0x5d, 0x55, 0xd3, 0x9d, 0x3c, 0x7b, 0xdb, 0x22, 0x1e, 0x03, 0xba, 0xbc, 0x6f, 0x28, 0xb8, 0xe1,
-		0x74, 0x19, 0x72, 0xa6, 0x85, 0xed, 0x50, 0x29, 0x29, 0x45, 0x7b, 0x8e, 0x62, 0xc8, 0xca, 0x98,
-		0x00, 0x89, 0x32, 0xf3, 0x6d, 0x64, 0x8c, 0xc5, 0x02, 0xcf, 0x6a, 0x6d, 0xfb, 0xfb, 0x54, 0x93,
-		0x6d, 0xa3, 0x31, 0x6f, 0xec, 0x97, 0x1e, 0xf7, 0xb9, 0x47, 0xe9, 0xe4, 0x2f, 0x7d, 0xe6, 0xb1,
-		0x71, 0xe2, 0x17, 0xfe, 0xc6, 0xd1, 0x8b, 0x78, 0x9f, 0x8c, 0x3d, 0xc7, 0x5b, 0xa7, 0x68, 0xa4,
-		0x6e, 0x7c, 0x59, 0xd5, 0x74, 0x71, 0xa9, 0x57, 0xe1, 0x4f, 0xe8, 0x69, 0x18, 0x74, 0x5c, 0xd5,
-		0x6d, 0x39, 0xfc, 0x53, 0x5b, 0xd3, 0xb1, 0x4e, 0x56, 0x32, 0x8d, 0xfa, 0x06, 0x45, 0x53, 0x38,
-		0x3a, 0x9a, 0x87, 0x41, 0xd7, 0xdc, 0xc3, 0x06, 0x37, 0x5e, 0xbf, 0xf3, 0x98, 0xbe, 0xf7, 0x61,
-		0x84, 0xc8, 0x84, 0x5c, 0x1d, 0xeb, 0xb8, 0xc1, 0xea, 0xa2, 0x5d, 0x95, 0x2c, 0x18, 0xe8, 0x97,
-		0xb6, 0x4a, 0xe5, 0x83, 0xcc, 0x38, 0x6e, 0x99, 0x28, 0x2b, 0x59, 0x19, 0xf3, 0x40, 0x1b, 0x14,
-		0x82, 0xae, 0x84, 0x4e, 0xf9, 0xf1, 0x2f, 0xd1, 0xcd, 0xc4, 0x6a, 0x1c, 0x70, 0x60, 0xb1, 0x89,
-		0x10, 0x3c, 0x20, 0x78, 0x19, 0x72, 0x2d, 0x63, 0xdb, 0x34, 0xe8, 0x4d, 0x3b, 0x5e, 0x90, 0x93,
-		0x85, 0x58, 0x32, 0xe8, 0x09, 0x51, 0x0c, 0x59, 0x19, 0xf3, 0x40, 0x57, 0x58, 0xd9, 0x5e, 0x87,
-		0x51, 0x1f, 0x8b, 0xce, 0xca, 0x4c, 0xcf, 0x59, 0x79, 0x92, 0xcf, 0xca, 0xc3, 0xd1, 0x5e, 0xfc,
-		0x89, 0x39, 0xe2, 0x01, 0x09, 0x19, 0xaa, 0x00, 0xf8, 0xb1, 0x80, 0x6e, 0x26, 0x0c, 0x77, 0x18,
-		0x68, 0x3f, 0x9a, 0x88, 0x95, 0x99, 0x4f, 0x88, 0xae, 0xc3, 0x44, 0x53, 0x33, 0xaa, 0x0e, 0xd6,
-		0x77, 0xaa, 0xdc, 0xb4, 0x84, 0x1f, 0xfd, 0x4a, 0x4a, 0x69, 0xb1, 0xef, 0xf1, 0xbf, 0x7d, 0x6b,
-		0xba, 0xc0, 0x83, 0x64, 0x3b, 0x37, 0x59, 0x19, 0x6f, 0x6a, 0xc6, 0x06, 0xd6, 0x77, 0xca, 0x1e,
-		0xac, 0x98, 0x7d, 0xcf, 0xeb, 0xd3, 0x87, 0xf8, 0x9c, 0x3c, 0x24, 0x3f, 0x45, 0x37, 0xad, 0xf9,
-		0x5c, 0xc2, 0x0e, 0x59, 0x3e, 0xa8, 0xe2, 0x81, 0x6e, 0x37, 0x64, 0x14, 0x1f, 0xc0, 0xe6, 0xf2,
-		0xab, 0xff, 0x69, 0x46, 0x92, 0x7f, 0x5d, 0x82, 0xc1, 0xf2, 0xd5, 0x75, 0x55, 0xb3, 0xd1, 0x12,
-		0x8c, 0xfb, 0xee, 0x12, 0x9e, 0xc9, 0xc7, 0x6f, 0xdf, 0x9a, 0xce, 0x47, 0x3d, 0xca, 0x9b, 0xca,
-		0xbe, 0xc3, 0x8a, 0xb9, 0xbc, 0xd4, 0x69, 0x7d, 0x19, 0x62, 0xd5, 0x86, 0x22, 0xb7, 0xaf, 0x3e,
-		0x23, 0x6a, 0x96, 0x60, 0x88, 0x49, 0xeb, 0xa0, 0xa7, 0x61, 0xc0, 0x22, 0x3f, 0xf8, 0x8e, 0xfc,
-		0xb1, 0x78, 0x8f, 0xa5, 0xc8, 0xde, 0xe6, 0x22, 0xc1, 0x97, 0xdf, 0x97, 0x00, 0x28, 0x5f, 0xbd,
-		0xba, 0x69, 0x6b, 0x96, 0x8e, 0xdd, 0xbb, 0xa9, 0xf6, 0x26, 0x1c, 0x0e, 0xac, 0x69, 0xec, 0x5a,
-		0x44, 0xf5, 0x99, 0xdb, 0xb7, 0xa6, 0x8f, 0x47, 0x55, 0x0f, 0xa0, 0xc9, 0xca, 0x84, 0xbf, 0xbe,
-		0xb1, 0x6b, 0xb1, 0x5c, 0xeb, 0x8e, 0xeb, 0x71, 0x4d, 0x76, 0xe6, 0x1a, 0x40, 0x0b, 0x72, 0x2d,
-		0x3b, 0x6e, 0xbc, 0x5d, 0xd7, 0x61, 0xd8, 0x37, 0x09, 0x89, 0x63, 0x69, 0x97, 0xff, 0xe6, 0xe6,
-		0x9d, 0xee, 0x60, 0x5e, 0x41, 0xc3, 0x4d, 0xec, 0x91, 0xc9, 0x7f, 0x25, 0x01, 0xf8, 0xde, 0xfa,
-		0xe3, 0xe9, 0x5c, 0x24, 0x5e, 0xf3, 0x10, 0x9b, 0x3c, 0x68, 0xdd, 0xc5, 0x09, 0x23, 0x76, 0x7c,
-		0x4f, 0x02, 0x26, 0xb6, 0x44, 0x98, 0xf9, 0xb1, 0x57, 0x7f, 0x05, 0x86, 0xb0, 0xe1, 0xda, 0x1a,
-		0xd5, 0x9f, 0xdd, 0x61, 0x8e, 0x1b, 0xe5, 0x18, 0x85, 0xe8, 0x47, 0x61, 0xc4, 0x0e, 0x38, 0xe7,
-		0x11, 0x31, 0xc5, 0xcf, 0x25, 0x21, 0xdf, 0x89, 0x12, 0x2d, 0xc0, 0x58, 0xcd, 0xc6, 0x14, 0x50,
-		0x0d, 0x6e, 0xc6, 0x95, 0x0a, 0x7e, 0xb5, 0x18, 0x41, 0x90, 0x95, 0x51, 0x01, 0xe1, 0x79, 0xa2,
-		0x01, 0xa4, 0x9a, 0x23, 0xee, 0x46, 0xb0, 0xfa, 0x2c, 0xdf, 0x64, 0x9e, 0x28, 0x44, 0x27, 0x61,
-		0x06, 0x2c, 0x53, 0x8c, 0xfa, 0x50, 0x9a, 0x2a, 0x74, 0x18, 0xd3, 0x0c, 0xcd, 0xd5, 0x54, 0xbd,
-		0xba, 0xad, 0xea, 0xaa, 0x51, 0x3b, 0x60, 0x11, 0xcc, 0xe2, 0x3b, 0xef, 0x31, 0xc2, 0x49, 0x56,
-		0x46, 0x39, 0xa4, 0xc4, 0x00, 0x68, 0x01, 0x86, 0x44, 0x2f, 0xa9, 0x83, 0x56, 0x11, 0x82, 0x32,
-		0x50, 0xad, 0xbd, 0x2b, 0x09, 0xe3, 0x0a, 0xae, 0xff, 0xff, 0x01, 0xe8, 0x7b, 0x00, 0xae, 0x00,
-		0xb0, 0xc9, 0x4d, 0xc2, 0xe8, 0xc1, 0xc6, 0x80, 0x44, 0x86, 0x0c, 0x23, 0x2e, 0x3b, 0x6e, 0x60,
-		0x14, 0xfe, 0x24, 0x01, 0xd9, 0xe0, 0x28, 0xfc, 0x35, 0x4d, 0x3b, 0xe8, 0xb2, 0x1f, 0x72, 0x52,
-		0xfc, 0x33, 0x8a, 0x71, 0x21, 0xa7, 0xcd, 0x61, 0xbb, 0xc7, 0x9a, 0xff, 0x91, 0x80, 0xc1, 0x75,
-		0xd5, 0x56, 0x9b, 0x0e, 0xaa, 0xb5, 0x15, 0x8f, 0x12, 0x7f, 0xf1, 0xde, 0xf6, 0x65, 0x64, 0xbe,
-		0x23, 0xd1, 0xa3, 0x76, 0xfc, 0x60, 0x4c, 0xed, 0xf8, 0x13, 0x30, 0x4a, 0xd6, 0xb2, 0x81, 0x63,
-		0x01, 0xc4, 0xd4, 0x23, 0xa5, 0xa3, 0x3e, 0x97, 0x70, 0x3b, 0x5b, 0xea, 0xfa, 0xaf, 0xb4, 0xd0,
-		0xd3, 0x30, 0x4c, 0x30, 0xfc, 0xf0, 0x4b, 0xc8, 0xa7, 0xfc, 0x65, 0x65, 0xa0, 0x51, 0x56, 0xa0,
-		0xa9, 0xde, 0xa8, 0xb0, 0x07, 0xb4, 0x0c, 0x68, 0xd7, 0xdb, 0xc2, 0xa8, 0xfa, 0xb6, 0x24, 0xf4,
-		0xf7, 0xdd, 0xbe, 0x35, 0x7d, 0x94, 0xd1, 0xb7, 0xe3, 0xc8, 0xca, 0xb8, 0x0f, 0x14, 0xdc, 0xce,
-		0x01, 0x10, 0xbd, 0xaa, 0xec, 0x64, 0x19, 0x5b, 0xb4, 0x1c, 0xbe, 0x7d, 0x6b, 0x7a, 0x9c, 0x71,
-		0xf1, 0xdb, 0x64, 0x25, 0x43, 0x1e, 0xca, 0xe4, 0x77, 0xc0, 0xad, 0x6f, 0x4a, 0x80, 0xfc, 0xd8,
-		0xae, 0x60, 0xc7, 0x22, 0x4b, 0x2c, 0x52, 0x5b, 0x07, 0x6a, 0x61, 0xa9, 0x4b, 0x6d, 0xed, 0x13,
-		0x8b, 0xda, 0x3a, 0x30, 0x47, 0x9e, 0xf2, 0x23, 0xa1, 0xd8, 0xa6, 0x8e, 0x1e, 0xc0, 0x9b, 0x5d,
-		0x30, 0x35, 0x41, 0xda, 0x16, 0xfc, 0x0e, 0xc9, 0xff, 0x46, 0x82, 0xa3, 0x6d, 0xbe, 0xe4, 0x89,
-		0xf9, 0x56, 0x40, 0x76, 0xa0, 0x91, 0x7f, 0xfa, 0x4c, 0xe2, 0x9f, 0xa6, 0x3e, 0x88, 0x5f, 0x8e,
-		0xdb, 0x31, 0x11, 0xf6, 0x2e, 0x84, 0x71, 0x76, 0x72, 0xef, 0x73, 0x12, 0x4c, 0x06, 0x7b, 0xf6,
-		0x14, 0x78, 0x11, 0xb2, 0xc1, 0x8e, 0xb9, 0xe8, 0x27, 0x7b, 0x8a, 0xce, 0xa5, 0x0e, 0x11, 0xa3,
-		0x55, 0x7f, 0x6a, 0x26, 0xf8, 0xc7, 0xc4, 0xfb, 0x32, 0x81, 0x90, 0x26, 0x3a, 0x45, 0x53, 0x74,
-		0x04, 0xbe, 0x27, 0x41, 0x6a, 0xdd, 0x34, 0x75, 0xf4, 0x32, 0x8c, 0x1b, 0xa6, 0x5b, 0x25, 0x5e,
-		0x84, 0xeb, 0x55, 0xbe, 0x4c, 0x66, 0x01, 0xef, 0xf9, 0xbe, 0x2d, 0xf3, 0xcd, 0x5b, 0xd3, 0xed,
-		0x5c, 0x94, 0x31, 0xc3, 0x74, 0x4b, 0x14, 0xb2, 0xc9, 0x16, 0xd1, 0xd7, 0x61, 0x24, 0xdc, 0x0f,
-		0x8b, 0x84, 0xca, 0x41, 0xfa, 0x09, 0x73, 0xb8, 0x7d, 0x6b, 0x7a, 0xd2, 0x9f, 0x13, 0x1e, 0x58,
-		0x56, 0xb2, 0xdb, 0x81, 0x8e, 0xd9, 0x21, 0xa8, 0xef, 0xbc, 0x3e, 0x2d, 0x9d, 0xf9, 0xac, 0x04,
-		0xe0, 0xef, 0x10, 0xa0, 0x47, 0xe1, 0x48, 0x69, 0x6d, 0xb5, 0x5c, 0xdd, 0xd8, 0x9c, 0xdf, 0xdc,
-		0xda, 0xa8, 0x6e, 0xad, 0x6e, 0xac, 0x57, 0x16, 0x96, 0x2e, 0x2f, 0x55, 0xca, 0xfe, 0xa6, 0xb4,
-		0x63, 0xe1, 0x9a, 0xb6, 0xa3, 0xe1, 0x3a, 0x7a, 0x08, 0x26, 0xc3, 0xd8, 0xe4, 0xa9, 0x52, 0xce,
-		0x49, 0x85, 0xec, 0x6b, 0x37, 0x67, 0xd2, 0xac, 0xac, 0xc2, 0x75, 0x74, 0x1a, 0x0e, 0xb7, 0xe3,
-		0x2d, 0xad, 0x2e, 0xe6, 0x12, 0x85, 0x91, 0xd7, 0x6e, 0xce, 0x64, 0xbc, 0xfa, 0x0b, 0xc9, 0x80,
-		0x82, 0x98, 0x9c, 0x5f, 0xb2, 0x00, 0xaf, 0xdd, 0x9c, 0x19, 0x64, 0xb6, 0x2b, 0xa4, 0xde, 0xf3,
-		0xb1, 0x13, 0x87, 0x4a, 0xf3, 0x1d, 0xb7, 0x9d, 0x4f, 0x75, 0x32, 0xdb, 0x0d, 0x6f, 0x4b, 0x39,
-		0xbc, 0xe1, 0xfc, 0xef, 0x86, 0xe2, 0x37, 0x9c, 0x1b, 0xd8, 0xc0, 0x8e, 0xe6, 0x1c, 0x7c, 0xc3,
-		0xb9, 0xf7, 0xf6, 0xb5, 0xfc, 0xaf, 0x07, 0x20, 0xbb, 0xc8, 0xf8, 0x13, 0xd3, 0x63, 0x74, 0x01,
-		0x06, 0x2d, 0x9a, 0x1a, 0xbc, 0x33, 0x1d, 0x71, 0x5e, 0xcd, 0xb2, 0x87, 0x77, 0xec, 0x89, 0xe5,
-		0x12, 0x83, 0x9f, 0x8a, 0x60, 0x87, 0xb1, 0xfc, 0x23, 0x46, 0xd9, 0x7e, 0xf7, 0x62, 0x58, 0xdd,
-		0xc1, 0x77, 0x3e, 0xa2, 0xac, 0x64, 0x76, 0xb6, 0x62, 0x93, 0x40, 0xd8, 0x19, 0xaa, 0x9f, 0x91,
-		0xe0, 0x30, 0xc5, 0xf2, 0x33, 0x2a, 0xc5, 0x14, 0xe5, 0xf9, 0xa9, 0x58, 0xd1, 0x97, 0x55, 0xc7,
-		0x3f, 0x2c, 0xc1, 0x0e, 0x3d, 0x3d, 0xc0, 0x33, 0xda, 0xf1, 0x40, 0xcf, 0x51, 0x9e, 0xb2, 0x32,
-		0xa1, 0xb7, 0x51, 0x3a, 0xa8, 0x1c, 0x3a, 0xed, 0x96, 0x3a, 0xc0, 0xf6, 0x76, 0xf0, 0xe0, 0xdb,
-		0x22, 0x0c, 0xfb, 0x51, 0xc2, 0xe1, 0xff, 0x76, 0xa1, 0xcf, 0x0c, 0x10, 0xa4, 0x44, 0xef, 0x92,
-		0xe0, 0xb0, 0x9f, 0x90, 0x83, 0x3c, 0x07, 0xf9, 0x47, 0xaa, 0xfa, 0x5c, 0xb1, 0x44, 0x6d, 0x12,
-		0xcb, 0x54, 0x56, 0x26, 0x5b, 0xed, 0xa4, 0x64, 0xa1, 0x34, 0x12, 0x0c, 0x95, 0x0e, 0xff, 0xef,
-		0x22, 0x7d, 0x07, 0xda, 0x30, 0x35, 0xfb, 0x52, 0xbe, 0x65, 0xda, 0x2e, 0xae, 0xd3, 0x0d, 0xb2,
-		0xb4, 0xe2, 0x3d, 0xcb, 0xab, 0x80, 0xda, 0x07, 0x34, 0x7a, 0xaa, 0x2f, 0xe3, 0x9f, 0xea, 0x9b,
-		0x84, 0x81, 0xe0, 0xd9, 0x37, 0xf6, 0x50, 0x4c, 0xbf, 0x87, 0x67, 0xc0, 0xbb, 0x39, 0x9d, 0x3f,
-		0x97, 0x80, 0x53, 0xde, 0xab, 0x97, 0xb7, 0xb5, 0xb0, 0xbd, 0xef, 0xcd, 0x41, 0x4b, 0x6d, 0x68,
-		0x46, 0xf0, 0x6e, 0xcb, 0x94, 0x97, 0xa8, 0x29, 0xa2, 0x30, 0x8e, 0x6c, 0xc0, 0xf0, 0xba, 0xda,
-		0xc0, 0x0a, 0x7e, 0x5b, 0x0b, 0x3b, 0x6e, 0xcc, 0xd1, 0xe9, 0x29, 0x18, 0x34, 0x77, 0x76, 0xc4,
-		0x0b, 0xe1, 0x94, 0xc2, 0x9f, 0x88, 0x9e, 0xba, 0xd6, 0xd4, 0xd8, 0xa9, 0xa9, 0x94, 0xc2, 0x1e,
-		0xd0, 0x34, 0x0c, 0xd7, 0xcc, 0x96, 0xc1, 0xe7, 0x55, 0x3e, 0x25, 0xbe, 0x6a, 0xd0, 0x32, 0xd8,
-		0xbc, 0x92, 0x2f, 0x41, 0x96, 0xf5, 0xc7, 0x33, 0xe6, 0x51, 0x48, 0xd3, 0x83, 0x47, 0x7e, 0xaf,
-		0x43, 0xe4, 0xf9, 0x45, 0x76, 0xd0, 0x9a, 0x71, 0x61, 0x1d, 0xb3, 0x87, 0xd2, 0x73, 0x1d, 0xed,
-		0x77, 0x7f, 0xd7, 0xb9, 0xcf, 0x0c, 0xe4, 0xd9, 0xee, 0x77, 0x07, 0xe0, 0x30, 0x7f, 0xe5, 0xa5,
-		0x5a, 0xda, 0xdc, 0xae, 0xeb, 0x8a, 0xeb, 0x2e, 0xc0, 0xeb, 0x52, 0xd5, 0xd2, 0xe4, 0x7d, 0x48,
-		0x5d, 0x71, 0x5d, 0x0b, 0x9d, 0x81, 0x01, 0xbb, 0xa5, 0x63, 0xb1, 0xf9, 0xe2, 0x6d, 0x80, 0xab,
-		0x96, 0x36, 0x4b, 0x10, 0x94, 0x96, 0x8e, 0x15, 0x86, 0x82, 0x2a, 0x30, 0xbd, 0xd3, 0xd2, 0xf5,
-		0xfd, 0x6a, 0x1d, 0xd3, 0xff, 0x3c, 0xe3, 0x7d, 0xbb, 0x1d, 0xdf, 0xb0, 0x54, 0xf1, 0x05, 0x38,
-		0x62, 0x96, 0xe3, 0x14, 0xad, 0x4c, 0xb1, 0xc4, 0x77, 0xdb, 0x2b, 0x02, 0x47, 0xfe, 0xe3, 0x04,
-		0xa4, 0x05, 0x6b, 0x7a, 0x08, 0x1a, 0xeb, 0xb8, 0xe6, 0x9a, 0xe2, 0xf5, 0x84, 0xf7, 0x8c, 0x10,
-		0x24, 0x1b, 0x7c, 0x74, 0x32, 0x57, 0x0e, 0x29, 0xe4, 0x81, 0xc0, 0xbc, 0xa3, 0xe8, 0x04, 0x66,
-		0xb5, 0xc8, 0x80, 0xa5, 0x2c, 0x53, 0xac, 0x9f, 0xae, 0x1c, 0x52, 0xe8, 0x13, 0xca, 0xc3, 0x20,
-		0x99, 0x09, 0x2e, 0xfb, 0xac, 0x1e, 0x81, 0xf3, 0x67, 0x34, 0x05, 0x03, 0x96, 0xea, 0xd6, 0xd8,
-		0xb9, 0x33, 0xd2, 0xc0, 0x1e, 0xd1, 0xd3, 0x30, 0xc8, 0x2e, 0x38, 0x46, 0xff, 0xad, 0x03, 0x31,
-		0x06, 0xfb, 0x92, 0x14, 0x91, 0x7b, 0x5d, 0x75, 0x5d, 0x6c, 0x1b, 0x84, 0x21, 0x43, 0x47, 0x08,
-		0x52, 0xdb, 0x66, 0x7d, 0x9f, 0xff, 0xab, 0x09, 0xfa, 0x9b, 0x7f, 0xdb, 0x9e, 0xba, 0x42, 0x95,
-		0x36, 0xb2, 0xff, 0xb0, 0x93, 0x15, 0xc0, 0x12, 0x41, 0xaa, 0xc0, 0x84, 0x5a, 0xaf, 0x6b, 0xec,
-		0xbf, 0x3e, 0x54, 0xb7, 0x35, 0x1a, 0x0e, 0x1c, 0xfa, 0xff, 0x93, 0x3a, 0x8d, 0x05, 0xf2, 0x09,
-		0x4a, 0x1c, 0xbf, 0x94, 0x81, 0x21, 0x8b, 0x09, 0x25, 0x5f, 0x84, 0xf1, 0x36, 0x49, 0x89, 0x7c,
-		0x7b, 0x9a, 0x51, 0x17, 0xe7, 0xf3, 0xc9, 0x6f, 0x02, 0xa3, 0xdf, 0x7e, 0x63, 0x2f, 0x7e, 0xe8,
-		0xef, 0xd2, 0x3b, 0x3b, 0xdf, 0x60, 0x1a, 0x0d, 0xdc, 0x60, 0x52, 0x2d, 0xad, 0x94, 0xa1, 0xfc,
-		0xf9, 0xbd, 0xa5, 0xf9, 0xf6, 0x7b, 0x4b, 0x0d, 0x6c, 0x88, 0x9c, 0x4a, 0x9a, 0x54, 0x4b, 0x73,
-		0xa8, 0x3b, 0xfa, 0xdf, 0xa2, 0x73, 0x2e, 0x06, 0x7e, 0xd3, 0x6b, 0x4c, 0xa9, 0xc5, 0xf9, 0xf5,
-		0x25, 0xcf, 0x8f, 0x7f, 0x27, 0x01, 0xc7, 0x03, 0x7e, 0x1c, 0x40, 0x6e, 0x77, 0xe7, 0x42, 0xbc,
-		0xc7, 0xf7, 0x71, 0x7d, 0xe9, 0x45, 0x48, 0x11, 0x7c, 0xd4, 0xe3, 0xcb, 0xf3, 0xf9, 0x4f, 0x7d,
-		0xe9, 0xf3, 0x72, 0xf8, 0x15, 0x51, 0x68, 0x54, 0x28, 0x93, 0xd2, 0xbb, 0xfb, 0xb7, 0x5f, 0xce,
-		0xff, 0x0c, 0x9f, 0x73, 0xf7, 0xcc, 0x18, 0xb5, 0xe1, 0xab, 0xe7, 0x60, 0x3a, 0xae, 0x90, 0x61,
-		0x91, 0xb2, 0x4b, 0x51, 0xd4, 0x6f, 0xf4, 0xed, 0x50, 0x3d, 0x75, 0x1d, 0xb8, 0x7e, 0x6a, 0xab,
-		0x16, 0x4c, 0xfd, 0x24, 0xe9, 0xd8, 0x5f, 0xc7, 0x8a, 0x38, 0x3e, 0xe5, 0xbd, 0x31, 0x93, 0xf8,
-		0xbf, 0xac, 0x62, 0x65, 0xef, 0x02, 0x80, 0x2f, 0x1c, 0x5f, 0xc4, 0xdd, 0x3f, 0x1b, 0x9f, 0x1b,
-		0x66, 0x03, 0x89, 0x41, 0x09, 0x90, 0xc9, 0xbf, 0x2a, 0xc1, 0x91, 0xb6, 0x7e, 0x79, 0x3c, 0x2f,
-		0xc7, 0x1c, 0xce, 0x3f, 0x78, 0xb9, 0x52, 0x8e, 0x11, 0xf3, 0x81, 0xee, 0x62, 0xb2, 0xfe, 0x43,
-		0x72, 0x3e, 0x0f, 0x87, 0xc3, 0x62, 0x0a, 0xeb, 0x3c, 0x08, 0xa3, 0xe1, 0xfd, 0x58, 0x6e, 0xa5,
-		0x91, 0xd0, 0x8e, 0xac, 0xfc, 0x53, 0x51, 0xf3, 0x7a, 0x5a, 0x96, 0x20, 0xe3, 0xa1, 0xf2, 0x32,
-		0xb6, 0x3f, 0x25, 0x7d, 0x32, 0xf9, 0x17, 0x24, 0x98, 0x09, 0xb3, 0x0f, 0x54, 0x38, 0x07, 0x93,
-		0xf4, 0xee, 0x0c, 0xeb, 0x1b, 0x12, 0x9c, 0xec, 0x22, 0x10, 0x57, 0xfd, 0x3a, 0x4c, 0x06, 0x56,
-		0xe8, 0x22, 0x4e, 0x8b, 0xa1, 0x3e, 0xd5, 0xa3, 0xa4, 0xf4, 0xd6, 0xa6, 0xc7, 0x88, 0x39, 0x3e,
-		0xf1, 0xa7, 0xd3, 0x13, 0xed, 0x6d, 0x8e, 0x32, 0xd1, 0xbe, 0xb4, 0xbe, 0x5b, 0x3e, 0xf1, 0x01,
-		0x09, 0x1e, 0x0e, 0x2b, 0x19, 0x53, 0x9b, 0xfe, 0x48, 0xcc, 0xff, 0x87, 0x12, 0x9c, 0xe9, 0x47,
-		0x32, 0x3e, 0x0e, 0x55, 0x98, 0xf0, 0x4b, 0xe6, 0xe8, 0x30, 0xf4, 0x5f, 0x85, 0x33, 0xb7, 0x44,
-		0x1e, 0xab, 0xbb, 0x6d, 0x6f, 0x8b, 0xcf, 0xa1, 0xe0, 0x30, 0x7b, 0xb6, 0x0d, 0xef, 0xa6, 0x0a,
-		0xdb, 0x86, 0xf6, 0x53, 0x63, 0x86, 0x20, 0x11, 0x33, 0x04, 0x7e, 0x81, 0x2d, 0x3b, 0x3c, 0x38,
-		0xc5, 0x6c, 0x83, 0xbd, 0x04, 0x13, 0x31, 0xbe, 0xcb, 0x27, 0x70, 0xbf, 0xae, 0xab, 0xa0, 0x76,
-		0xef, 0x94, 0xf7, 0x61, 0x9a, 0x76, 0x1a, 0x63, 0xe2, 0x7b, 0xad, 0xef, 0xcb, 0x3c, 0x8c, 0xc4,
-		0x76, 0xcd, 0x15, 0xbf, 0x0c, 0x83, 0x6c, 0x84, 0xb9, 0xae, 0x07, 0xf5, 0x0f, 0x4e, 0x2d, 0x7f,
-		0x50, 0xc4, 0xac, 0xb2, 0x90, 0x39, 0x7e, 0xd2, 0xf4, 0xa3, 0xe8, 0xdd, 0x98, 0x34, 0x01, 0x33,
-		0x7c, 0x59, 0x44, 0xaf, 0x78, 0xd1, 0xb8, 0x21, 0xfe, 0xc6, 0xdd, 0x89, 0x5e, 0xcc, 0x2a, 0xf7,
-		0x30, 0x4c, 0x7d, 0x54, 0x84, 0x29, 0x4f, 0x9b, 0x1e, 0x61, 0xea, 0x47, 0x60, 0x71, 0x2f, 0x60,
-		0xf5, 0x90, 0xf1, 0xff, 0xae, 0x80, 0xf5, 0x17, 0x12, 0x1c, 0xa5, 0x5a, 0x05, 0xb7, 0x0d, 0x0e,
-		0x6a, 0xe9, 0x47, 0x01, 0x39, 0x76, 0xad, 0x1a, 0x3b, 0x91, 0x73, 0x8e, 0x5d, 0xbb, 0x1a, 0x4a,
-		0x1f, 0x8f, 0x02, 0xaa, 0x87, 0x36, 0x84, 0x28, 0x36, 0x3b, 0x50, 0x96, 0xab, 0x07, 0xf6, 0x1e,
-		0x62, 0x46, 0x31, 0xf5, 0x83, 0x8e, 0xe2, 0x97, 0x24, 0x28, 0xc4, 0xe9, 0xcb, 0x47, 0x6d, 0x07,
-		0xa6, 0x42, 0x5b, 0xf2, 0xd1, 0x81, 0x7b, 0xb8, 0xe7, 0x96, 0x4b, 0x64, 0xd2, 0x1c, 0xb6, 0xf1,
-		0x3d, 0xcd, 0xee, 0xd3, 0x61, 0x97, 0x6c, 0x2f, 0x8d, 0x7f, 0x34, 0x93, 0xe5, 0x93, 0x6d, 0x91,
-		0xf3, 0xc7, 0xbc, 0x78, 0xbe, 0x01, 0x27, 0x3a, 0xc8, 0x7b, 0xaf, 0x13, 0x1a, 0xee, 0x38, 0x86,
-		0x77, 0xb5, 0xfe, 0x3e, 0xc7, 0xfd, 0x3e, 0x7c, 0x00, 0x39, 0xb0, 0x80, 0x8a, 0xbb, 0x94, 0x24,
-		0x5f, 0x85, 0x63, 0xb1, 0x54, 0x5c, 0xb0, 0xa7, 0x21, 0xb5, 0xab, 0x39, 0x2e, 0x97, 0xe9, 0xfe,
-		0x58, 0x99, 0x22, 0xa4, 0x94, 0x40, 0x46, 0x90, 0xa3, 0x7c, 0xd7, 0x4d, 0x53, 0xe7, 0x32, 0xc8,
-		0x57, 0x60, 0x3c, 0x00, 0xe3, 0x3d, 0x3c, 0x09, 0x29, 0xcb, 0xe4, 0xf7, 0xe4, 0x87, 0xcf, 0x1e,
-		0x8d, 0xdf, 0x3c, 0x37, 0x4d, 0x9d, 0x2b, 0x4c, 0x91, 0xe5, 0x49, 0x40, 0x8c, 0x13, 0xdd, 0x47,
-		0x17, 0xfc, 0xd7, 0x61, 0x22, 0x04, 0xe5, 0x3d, 0xdc, 0xf9, 0x06, 0xfd, 0xd9, 0x3f, 0x9f, 0x84,
-		0x01, 0xca, 0x12, 0xbd, 0x57, 0x02, 0x08, 0xbc, 0x5e, 0x7d, 0x24, 0x96, 0x47, 0xfc, 0xe2, 0xb5,
-		0xf0, 0x68, 0x7f, 0xc8, 0xbc, 0xf4, 0x3a, 0xf5, 0xce, 0x3f, 0xfc, 0xfa, 0xfb, 0x13, 0x27, 0xd1,
-		0xf4, 0x5c, 0xdc, 0x82, 0x39, 0x30, 0x2f, 0x3e, 0x12, 0xba, 0x97, 0x7d, 0xa6, 0x8f, 0x4e, 0x84,
-		0x40, 0x8f, 0xf4, 0x85, 0xcb, 0xe5, 0x79, 0x86, 0xca, 0x73, 0x16, 0x3d, 0xde, 0x43, 0x9e, 0xb9,
-		0xb7, 0x87, 0xa7, 0xc5, 0x3b, 0xd0, 0x97, 0x25, 0x98, 0x8c, 0x5b, 0x7b, 0xa1, 0xf3, 0x7d, 0xf4,
-		0xdf, 0x5e, 0x16, 0x14, 0x9e, 0x3a, 0x28, 0x19, 0xd7, 0xa0, 0x4c, 0x35, 0x78, 0x1e, 0x3d, 0x7b,
-		0x50, 0x0d, 0xe6, 0x82, 0x9b, 0xea, 0xdf, 0x91, 0xe0, 0xbe, 0xae, 0x4b, 0x19, 0xf4, 0x7c, 0x1f,
-		0xf2, 0x75, 0x29, 0x7b, 0x0a, 0x97, 0xee, 0x98, 0x9e, 0x2b, 0xba, 0x4a, 0x15, 0xbd, 0x82, 0x2e,
-		0x1f, 0x58, 0xd1, 0xd8, 0xb7, 0x15, 0xe8, 0xb7, 0xc3, 0x67, 0xec, 0xba, 0xb8, 0x4d, 0xdb, 0xf2,
-		0xa0, 0x9b, 0xd3, 0xb7, 0x17, 0xa0, 0xf2, 0x16, 0x95, 0x7c, 0x0d, 0xad, 0xfc, 0x20, 0x43, 0x34,
-		0xf7, 0xf6, 0x70, 0xfc, 0x7e, 0x07, 0xfa, 0xb6, 0x14, 0x7f, 0x5c, 0xee, 0x5c, 0x67, 0xe1, 0x3a,
-		0xaf, 0x78, 0x0a, 0xe7, 0x0f, 0x48, 0xc5, 0x75, 0x7b, 0x99, 0xea, 0x56, 0x47, 0xdb, 0x77, 0x55,
-		0xb7, 0xd8, 0x21, 0x43, 0x5f, 0x90, 0x60, 0x32, 0x6e, 0xc1, 0xd0, 0x6d, 0xca, 0x75, 0x59, 0xfb,
-		0x74, 0x9b, 0x72, 0xdd, 0xd6, 0x25, 0xf2, 0x05, 0xaa, 0xf3, 0x93, 0xe8, 0x89, 0x58, 0x9d, 0xbb,
-		0x8e, 0x19, 0x99, 0x67, 0x5d, 0x2b, 0xf0, 0x6e, 0xf3, 0xac, 0x9f, 0xe5, 0x45, 0xb7, 0x79, 0xd6,
-		0x57, 0xe9, 0xdf, 0x63, 0x9e, 0x79, 0x0a, 0xf5, 0x39, 0x68, 0x0e, 0xfa, 0x2d, 0x09, 0x46, 0x42,
-		0xe5, 0x2a, 0x9a, 0xed, 0x2c, 0x62, 0x5c, 0x1d, 0x5f, 0x98, 0xeb, 0x1b, 0x9f, 0xab, 0x70, 0x99,
-		0xaa, 0xf0, 0x13, 0xe8, 0xf9, 0x03, 0xab, 0x10, 0x7e, 0xd5, 0xf8, 0x7b, 0x12, 0x4c, 0xc4, 0x94,
-		0x80, 0xdd, 0x66, 0x58, 0xe7, 0x5a, 0xb6, 0x70, 0xfe, 0x80, 0x54, 0x5c, 0x99, 0x05, 0xaa, 0xcc,
-		0x73, 0xe8, 0xe2, 0x81, 0x95, 0x09, 0xa4, 0xd3, 0x7f, 0xef, 0x1f, 0x35, 0x0a, 0x74, 0x82, 0x9e,
-		0x3c, 0x88, 0x48, 0x42, 0x8f, 0x73, 0x07, 0x23, 0xe2, 0x6a, 0x6c, 0x52, 0x35, 0x56, 0xd1, 0xf2,
-		0x0f, 0xa0, 0x46, 0x7b, 0x16, 0xfe, 0x44, 0xfb, 0xa5, 0xb4, 0x2e, 0xde, 0x12, 0x5b, 0x3d, 0x16,
-		0x1e, 0xef, 0x9f, 0x80, 0xeb, 0x72, 0x9e, 0xea, 0x32, 0x87, 0x1e, 0x8b, 0xd5, 0x25, 0x70, 0x7a,
-		0x4c, 0x33, 0x76, 0xcc, 0xb9, 0xb7, 0xb3, 0x6a, 0xf4, 0x1d, 0xe8, 0x06, 0x3f, 0xcc, 0xf3, 0x60,
-		0xe7, 0x0e, 0x03, 0x15, 0x65, 0xe1, 0xa1, 0x5e, 0x68, 0x5c, 0x9a, 0x93, 0x54, 0x9a, 0x63, 0xe8,
-		0x68, 0xac, 0x34, 0xa4, 0xa4, 0x44, 0xaf, 0x4a, 0xde, 0x11, 0xbf, 0x53, 0x5d, 0xb8, 0x06, 0x0b,
-		0xce, 0xc2, 0xe9, 0xde, 0x88, 0x5c, 0x80, 0xfb, 0xa9, 0x00, 0xf7, 0xa1, 0x63, 0xf1, 0x02, 0xb0,
-		0xda, 0xf3, 0x2e, 0xbe, 0x4a, 0xff, 0xcc, 0x14, 0x1c, 0x8f, 0xeb, 0xc9, 0xbd, 0xd1, 0xed, 0xfd,
-		0x4f, 0x97, 0xfb, 0x96, 0x3d, 0xef, 0x53, 0x76, 0xb8, 0xc1, 0x79, 0x87, 0xb7, 0x2c, 0xfb, 0x78,
-		0x59, 0xf4, 0xf9, 0x14, 0xa0, 0x15, 0xa7, 0xb1, 0x60, 0x63, 0xf6, 0x2f, 0xcc, 0xf8, 0x7c, 0x8d,
-		0x5c, 0x37, 0x92, 0xee, 0xfc, 0xba, 0xd1, 0x0b, 0xa1, 0x0b, 0x3c, 0x89, 0x03, 0x5c, 0x07, 0xec,
-		0xfb, 0x16, 0x4f, 0xf2, 0x5e, 0xdf, 0xe2, 0x89, 0x3f, 0x06, 0x9c, 0xba, 0x7b, 0x17, 0x03, 0x06,
-		0xee, 0xe8, 0x62, 0xc0, 0x02, 0x0c, 0xf2, 0x1b, 0x78, 0x83, 0x5d, 0x6e, 0xe0, 0x1d, 0x8e, 0xbf,
-		0x66, 0xc7, 0x49, 0xd1, 0x59, 0xf1, 0x49, 0xd6, 0xa1, 0x3e, 0xce, 0x6e, 0xf2, 0x0f, 0xb6, 0xfa,
-		0xab, 0xf2, 0xe3, 0x50, 0x68, 0xf7, 0x1e, 0x6f, 0xbe, 0xbe, 0x3b, 0x09, 0xb9, 0x15, 0xa7, 0x51,
-		0xa9, 0x6b, 0xee, 0xbd, 0x70, 0xad, 0x4b, 0x9d, 0xef, 0x58, 0xa0, 0xdb, 0xb7, 0xa6, 0x47, 0x99,
-		0x29, 0xbb, 0x18, 0x70, 0x17, 0xc6, 0x22, 0x17, 0x56, 0xb9, 0x2f, 0x5d, 0x3a, 0xe0, 0x95, 0xd9,
-		0x08, 0x17, 0x99, 0x1e, 0x8d, 0x0f, 0x38, 0x33, 0x72, 0xe2, 0x3d, 0x97, 0xb9, 0xd0, 0xc2, 0x3d,
-		0xba, 0x7b, 0xe6, 0x0f, 0x53, 0x01, 0xf2, 0xd1, 0x71, 0xf0, 0x06, 0xe9, 0x6b, 0x12, 0x0c, 0xaf,
-		0x38, 0xa2, 0x42, 0xc3, 0x3f, 0xa6, 0x97, 0x60, 0xce, 0x79, 0x5f, 0xd0, 0x4c, 0xf6, 0xe1, 0xa7,
-		0xe2, 0x93, 0x9a, 0xbe, 0x05, 0x0e, 0xc3, 0x44, 0x40, 0x49, 0x4f, 0xf9, 0x2f, 0x27, 0x68, 0xf8,
-		0x2b, 0xe1, 0x86, 0x66, 0x78, 0x25, 0x1e, 0xfe, 0xeb, 0x7a, 0xec, 0xdf, 0x37, 0x72, 0xea, 0x8e,
-		0x8c, 0xbc, 0x47, 0xa3, 0x41, 0xc4, 0x98, 0xde, 0x0e, 0xd2, 0x4a, 0xfb, 0x3d, 0x14, 0xe9, 0x00,
-		0x5f, 0x5d, 0x89, 0xdc, 0x36, 0x91, 0xff, 0x8b, 0x04, 0x23, 0x2b, 0x4e, 0x63, 0xcb, 0xa8, 0xff,
-		0xbf, 0xed, 0xb9, 0x3b, 0x70, 0x38, 0xa4, 0xe6, 0x3d, 0xb2, 0xe7, 0xd9, 0xff, 0x9d, 0x84, 0xe4,
-		0x8a, 0xd3, 0x40, 0x7b, 0x30, 0x16, 0xad, 0x06, 0xe2, 0x4b, 0xb9, 0xf6, 0xc0, 0xdf, 0x61, 0x01,
-		0xd5, 0x39, 0x43, 0x20, 0x0c, 0x23, 0xe1, 0xec, 0xf0, 0x60, 0x27, 0x0e, 0x21, 0xb4, 0xc2, 0x63,
-		0x7d, 0xa1, 0x79, 0xdd, 0x5c, 0x85, 0xb4, 0x17, 0xdf, 0x66, 0x3a, 0x91, 0x0a, 0x8c, 0x0e, 0x05,
-		0x69, 0x4c, 0xf8, 0x20, 0xb6, 0x8a, 0x86, 0x8e, 0x8e, 0xb6, 0x8a, 0x20, 0x76, 0xb6, 0x55, 0xa7,
-		0xf9, 0xf3, 0x53, 0x00, 0x01, 0x67, 0x97, 0x3b, 0x91, 0xfb, 0x38, 0x85, 0x33, 0xbd, 0x71, 0xbc,
-		0xf7, 0x30, 0x77, 0xaf, 0x6c, 0xfe, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x48, 0x64, 0x9f, 0x5b,
-		0xee, 0x91, 0x00, 0x00,
+		// 10634 bytes of a gzipped FileDescriptorSet
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7d, 0x6d, 0x70, 0x1c, 0xc9,
+		0x75, 0x18, 0x67, 0x77, 0x01, 0xec, 0x3e, 0x7c, 0x2d, 0x1a, 0x20, 0xb9, 0x58, 0x7e, 0x2c, 0x38,
+		0xbc, 0x23, 0x79, 0xbc, 0x23, 0x70, 0xc7, 0x23, 0xef, 0x8e, 0xcb, 0xbb, 0xa3, 0xb0, 0xc0, 0x12,
+		0xc4, 0x1d, 0xbe, 0x34, 0x00, 0xa8, 0xd3, 0x49, 0xaa, 0xcd, 0x60, 0xb7, 0xb1, 0x98, 0xe3, 0xec,
+		0xcc, 0xde, 0xcc, 0x2c, 0x49, 0x9c, 0x72, 0x55, 0x67, 0xc9, 0x92, 0xa5, 0x4b, 0x62, 0x49, 0x96,
+		0x2b, 0x96, 0x65, 0x51, 0x91, 0xfc, 0x11, 0x39, 0x8a, 0x23, 0x4b, 0x89, 0x2c, 0x5b, 0xb1, 0xca,
+		0x91, 0xcb, 0xe5, 0x58, 0x96, 0x12, 0x97, 0x14, 0x55, 0x1c, 0x97, 0xed, 0xa2, 0x9c, 0x93, 0x52,
+		0x51, 0x14, 0xd9, 0x56, 0x68, 0xa9, 0x2a, 0x2e, 0xfd, 0x49, 0xf5, 0xd7, 0x7c, 0xed, 0xec, 0x17,
+		0x44, 0x4a, 0xb2, 0x9d, 0x5f, 0x40, 0xbf, 0x7e, 0xef, 0xf5, 0xeb, 0xd7, 0xaf, 0x5f, 0xbf, 0xd7,
+		0xdd, 0xd3, 0x0b, 0xbf, 0x77, 0x11, 0xa6, 0xaa, 0xa6, 0x59, 0xd5, 0xf1, 0x4c, 0xdd, 0x32, 0x1d,
+		0x73, 0xab, 0xb1, 0x3d, 0x53, 0xc1, 0x76, 0xd9, 0xd2, 0xea, 0x8e, 0x69, 0x4d, 0x53, 0x18, 0x1a,
+		0x65, 0x18, 0xd3, 0x02, 0x43, 0x5e, 0x86, 0xb1, 0xcb, 0x9a, 0x8e, 0xe7, 0x5d, 0xc4, 0x75, 0xec,
+		0xa0, 0x27, 0x20, 0xb1, 0xad, 0xe9, 0x38, 0x23, 0x4d, 0xc5, 0x4f, 0x0d, 0x9e, 0xbd, 0x6f, 0x3a,
+		0x44, 0x34, 0x1d, 0xa4, 0x58, 0x23, 0x60, 0x85, 0x52, 0xc8, 0xdf, 0x48, 0xc0, 0x78, 0x44, 0x2d,
+		0x42, 0x90, 0x30, 0xd4, 0x1a, 0xe1, 0x28, 0x9d, 0x4a, 0x29, 0xf4, 0x7f, 0x94, 0x81, 0x81, 0xba,
+		0x5a, 0xbe, 0xa6, 0x56, 0x71, 0x26, 0x46, 0xc1, 0xa2, 0x88, 0x8e, 0x02, 0x54, 0x70, 0x1d, 0x1b,
+		0x15, 0x6c, 0x94, 0x77, 0x33, 0xf1, 0xa9, 0xf8, 0xa9, 0x94, 0xe2, 0x83, 0xa0, 0x07, 0x61, 0xac,
+		0xde, 0xd8, 0xd2, 0xb5, 0x72, 0xc9, 0x87, 0x06, 0x53, 0xf1, 0x53, 0x7d, 0x4a, 0x9a, 0x55, 0xcc,
+		0x7b, 0xc8, 0x27, 0x61, 0xf4, 0x06, 0x56, 0xaf, 0xf9, 0x51, 0x07, 0x29, 0xea, 0x08, 0x01, 0xfb,
+		0x10, 0xe7, 0x60, 0xa8, 0x86, 0x6d, 0x5b, 0xad, 0xe2, 0x92, 0xb3, 0x5b, 0xc7, 0x99, 0x04, 0xed,
+		0xfd, 0x54, 0x53, 0xef, 0xc3, 0x3d, 0x1f, 0xe4, 0x54, 0x1b, 0xbb, 0x75, 0x8c, 0x66, 0x21, 0x85,
+		0x8d, 0x46, 0x8d, 0x71, 0xe8, 0x6b, 0xa1, 0xbf, 0xa2, 0xd1, 0xa8, 0x85, 0xb9, 0x24, 0x09, 0x19,
+		0x67, 0x31, 0x60, 0x63, 0xeb, 0xba, 0x56, 0xc6, 0x99, 0x7e, 0xca, 0xe0, 0x64, 0x13, 0x83, 0x75,
+		0x56, 0x1f, 0xe6, 0x21, 0xe8, 0xd0, 0x1c, 0xa4, 0xf0, 0x4d, 0x07, 0x1b, 0xb6, 0x66, 0x1a, 0x99,
+		0x01, 0xca, 0xe4, 0xfe, 0x88, 0x51, 0xc4, 0x7a, 0x25, 0xcc, 0xc2, 0xa3, 0x43, 0x8f, 0xc1, 0x80,
+		0x59, 0x77, 0x34, 0xd3, 0xb0, 0x33, 0xc9, 0x29, 0xe9, 0xd4, 0xe0, 0xd9, 0xc3, 0x91, 0x86, 0xb0,
+		0xca, 0x70, 0x14, 0x81, 0x8c, 0x16, 0x21, 0x6d, 0x9b, 0x0d, 0xab, 0x8c, 0x4b, 0x65, 0xb3, 0x82,
+		0x4b, 0x9a, 0xb1, 0x6d, 0x66, 0x52, 0x94, 0x41, 0xae, 0xb9, 0x23, 0x14, 0x71, 0xce, 0xac, 0xe0,
+		0x45, 0x63, 0xdb, 0x54, 0x46, 0xec, 0x40, 0x19, 0x1d, 0x80, 0x7e, 0x7b, 0xd7, 0x70, 0xd4, 0x9b,
+		0x99, 0x21, 0x6a, 0x21, 0xbc, 0x24, 0x7f, 0xb6, 0x1f, 0x46, 0xbb, 0x31, 0xb1, 0x8b, 0xd0, 0xb7,
+		0x4d, 0x7a, 0x99, 0x89, 0xf5, 0xa2, 0x03, 0x46, 0x13, 0x54, 0x62, 0xff, 0x1e, 0x95, 0x38, 0x0b,
+		0x83, 0x06, 0xb6, 0x1d, 0x5c, 0x61, 0x16, 0x11, 0xef, 0xd2, 0xa6, 0x80, 0x11, 0x35, 0x9b, 0x54,
+		0x62, 0x4f, 0x26, 0xf5, 0x1c, 0x8c, 0xba, 0x22, 0x95, 0x2c, 0xd5, 0xa8, 0x0a, 0xdb, 0x9c, 0xe9,
+		0x24, 0xc9, 0x74, 0x51, 0xd0, 0x29, 0x84, 0x4c, 0x19, 0xc1, 0x81, 0x32, 0x9a, 0x07, 0x30, 0x0d,
+		0x6c, 0x6e, 0x97, 0x2a, 0xb8, 0xac, 0x67, 0x92, 0x2d, 0xb4, 0xb4, 0x4a, 0x50, 0x9a, 0xb4, 0x64,
+		0x32, 0x68, 0x59, 0x47, 0x17, 0x3c, 0x53, 0x1b, 0x68, 0x61, 0x29, 0xcb, 0x6c, 0x92, 0x35, 0x59,
+		0xdb, 0x26, 0x8c, 0x58, 0x98, 0xd8, 0x3d, 0xae, 0xf0, 0x9e, 0xa5, 0xa8, 0x10, 0xd3, 0x1d, 0x7b,
+		0xa6, 0x70, 0x32, 0xd6, 0xb1, 0x61, 0xcb, 0x5f, 0x44, 0xc7, 0xc1, 0x05, 0x94, 0xa8, 0x59, 0x01,
+		0xf5, 0x42, 0x43, 0x02, 0xb8, 0xa2, 0xd6, 0x70, 0xf6, 0x25, 0x18, 0x09, 0xaa, 0x07, 0x4d, 0x40,
+		0x9f, 0xed, 0xa8, 0x96, 0x43, 0xad, 0xb0, 0x4f, 0x61, 0x05, 0x94, 0x86, 0x38, 0x36, 0x2a, 0xd4,
+		0xcb, 0xf5, 0x29, 0xe4, 0x5f, 0xf4, 0x3a, 0xaf, 0xc3, 0x71, 0xda, 0xe1, 0x13, 0xcd, 0x23, 0x1a,
+		0xe0, 0x1c, 0xee, 0x77, 0xf6, 0x71, 0x18, 0x0e, 0x74, 0xa0, 0xdb, 0xa6, 0xe5, 0x7f, 0x0c, 0xfb,
+		0x23, 0x59, 0xa3, 0xe7, 0x60, 0xa2, 0x61, 0x68, 0x86, 0x83, 0xad, 0xba, 0x85, 0x89, 0xc5, 0xb2,
+		0xa6, 0x32, 0xff, 0x73, 0xa0, 0x85, 0xcd, 0x6d, 0xfa, 0xb1, 0x19, 0x17, 0x65, 0xbc, 0xd1, 0x0c,
+		0x3c, 0x9d, 0x4a, 0x7e, 0x73, 0x20, 0xfd, 0xca, 0x2b, 0xaf, 0xbc, 0x12, 0x93, 0x7f, 0xb7, 0x1f,
+		0x26, 0xa2, 0xe6, 0x4c, 0xe4, 0xf4, 0x3d, 0x00, 0xfd, 0x46, 0xa3, 0xb6, 0x85, 0x2d, 0xaa, 0xa4,
+		0x3e, 0x85, 0x97, 0xd0, 0x2c, 0xf4, 0xe9, 0xea, 0x16, 0xd6, 0x33, 0x89, 0x29, 0xe9, 0xd4, 0xc8,
+		0xd9, 0x07, 0xbb, 0x9a, 0x95, 0xd3, 0x4b, 0x84, 0x44, 0x61, 0x94, 0xe8, 0x69, 0x48, 0x70, 0x17,
+		0x4d, 0x38, 0x9c, 0xee, 0x8e, 0x03, 0x99, 0x4b, 0x0a, 0xa5, 0x43, 0x87, 0x20, 0x45, 0xfe, 0x32,
+		0xdb, 0xe8, 0xa7, 0x32, 0x27, 0x09, 0x80, 0xd8, 0x05, 0xca, 0x42, 0x92, 0x4e, 0x93, 0x0a, 0x16,
+		0x4b, 0x9b, 0x5b, 0x26, 0x86, 0x55, 0xc1, 0xdb, 0x6a, 0x43, 0x77, 0x4a, 0xd7, 0x55, 0xbd, 0x81,
+		0xa9, 0xc1, 0xa7, 0x94, 0x21, 0x0e, 0xbc, 0x4a, 0x60, 0x28, 0x07, 0x83, 0x6c, 0x56, 0x69, 0x46,
+		0x05, 0xdf, 0xa4, 0xde, 0xb3, 0x4f, 0x61, 0x13, 0x6d, 0x91, 0x40, 0x48, 0xf3, 0x2f, 0xd8, 0xa6,
+		0x21, 0x4c, 0x93, 0x36, 0x41, 0x00, 0xb4, 0xf9, 0xc7, 0xc3, 0x8e, 0xfb, 0x48, 0x74, 0xf7, 0x9a,
+		0xe6, 0xd2, 0x49, 0x18, 0xa5, 0x18, 0x8f, 0xf2, 0xa1, 0x57, 0xf5, 0xcc, 0xd8, 0x94, 0x74, 0x2a,
+		0xa9, 0x8c, 0x30, 0xf0, 0x2a, 0x87, 0xca, 0x9f, 0x89, 0x41, 0x82, 0x3a, 0x96, 0x51, 0x18, 0xdc,
+		0x78, 0xe3, 0x5a, 0xb1, 0x34, 0xbf, 0xba, 0x59, 0x58, 0x2a, 0xa6, 0x25, 0x34, 0x02, 0x40, 0x01,
+		0x97, 0x97, 0x56, 0x67, 0x37, 0xd2, 0x31, 0xb7, 0xbc, 0xb8, 0xb2, 0xf1, 0xd8, 0xb9, 0x74, 0xdc,
+		0x25, 0xd8, 0x64, 0x80, 0x84, 0x1f, 0xe1, 0xd1, 0xb3, 0xe9, 0x3e, 0x94, 0x86, 0x21, 0xc6, 0x60,
+		0xf1, 0xb9, 0xe2, 0xfc, 0x63, 0xe7, 0xd2, 0xfd, 0x41, 0xc8, 0xa3, 0x67, 0xd3, 0x03, 0x68, 0x18,
+		0x52, 0x14, 0x52, 0x58, 0x5d, 0x5d, 0x4a, 0x27, 0x5d, 0x9e, 0xeb, 0x1b, 0xca, 0xe2, 0xca, 0x42,
+		0x3a, 0xe5, 0xf2, 0x5c, 0x50, 0x56, 0x37, 0xd7, 0xd2, 0xe0, 0x72, 0x58, 0x2e, 0xae, 0xaf, 0xcf,
+		0x2e, 0x14, 0xd3, 0x83, 0x2e, 0x46, 0xe1, 0x8d, 0x1b, 0xc5, 0xf5, 0xf4, 0x50, 0x40, 0xac, 0x47,
+		0xcf, 0xa6, 0x87, 0xdd, 0x26, 0x8a, 0x2b, 0x9b, 0xcb, 0xe9, 0x11, 0x34, 0x06, 0xc3, 0xac, 0x09,
+		0x21, 0xc4, 0x68, 0x08, 0xf4, 0xd8, 0xb9, 0x74, 0xda, 0x13, 0x84, 0x71, 0x19, 0x0b, 0x00, 0x1e,
+		0x3b, 0x97, 0x46, 0xf2, 0x1c, 0xf4, 0x51, 0x33, 0x44, 0x08, 0x46, 0x96, 0x66, 0x0b, 0xc5, 0xa5,
+		0xd2, 0xea, 0xda, 0xc6, 0xe2, 0xea, 0xca, 0xec, 0x52, 0x5a, 0xf2, 0x60, 0x4a, 0xf1, 0xf5, 0x9b,
+		0x8b, 0x4a, 0x71, 0x3e, 0x1d, 0xf3, 0xc3, 0xd6, 0x8a, 0xb3, 0x1b, 0xc5, 0xf9, 0x74, 0x5c, 0x2e,
+		0xc3, 0x44, 0x94, 0x43, 0x8d, 0x9c, 0x42, 0x3e, 0x5b, 0x88, 0xb5, 0xb0, 0x05, 0xca, 0x2b, 0x6c,
+		0x0b, 0xf2, 0xd7, 0x63, 0x30, 0x1e, 0xb1, 0xa8, 0x44, 0x36, 0x72, 0x09, 0xfa, 0x98, 0x2d, 0xb3,
+		0x65, 0xf6, 0x81, 0xc8, 0xd5, 0x89, 0x5a, 0x76, 0xd3, 0x52, 0x4b, 0xe9, 0xfc, 0xa1, 0x46, 0xbc,
+		0x45, 0xa8, 0x41, 0x58, 0x34, 0x19, 0xec, 0x5b, 0x9a, 0x9c, 0x3f, 0x5b, 0x1f, 0x1f, 0xeb, 0x66,
+		0x7d, 0xa4, 0xb0, 0xde, 0x16, 0x81, 0xbe, 0x88, 0x45, 0xe0, 0x22, 0x8c, 0x35, 0x31, 0xea, 0xda,
+		0x19, 0xbf, 0x5d, 0x82, 0x4c, 0x2b, 0xe5, 0x74, 0x70, 0x89, 0xb1, 0x80, 0x4b, 0xbc, 0x18, 0xd6,
+		0xe0, 0xb1, 0xd6, 0x83, 0xd0, 0x34, 0xd6, 0x1f, 0x93, 0xe0, 0x40, 0x74, 0x48, 0x19, 0x29, 0xc3,
+		0xd3, 0xd0, 0x5f, 0xc3, 0xce, 0x8e, 0x29, 0xc2, 0xaa, 0x13, 0x11, 0x8b, 0x35, 0xa9, 0x0e, 0x0f,
+		0x36, 0xa7, 0x42, 0x17, 0xc2, 0xb2, 0xe6, 0x5a, 0x05, 0xb8, 0x4d, 0x92, 0xbe, 0x3b, 0x06, 0xfb,
+		0x23, 0x99, 0x47, 0x0a, 0x7a, 0x04, 0x40, 0x33, 0xea, 0x0d, 0x87, 0x85, 0x4e, 0xcc, 0x13, 0xa7,
+		0x28, 0x84, 0x3a, 0x2f, 0xe2, 0x65, 0x1b, 0x8e, 0x5b, 0x1f, 0xa7, 0xf5, 0xc0, 0x40, 0x14, 0xe1,
+		0x09, 0x4f, 0xd0, 0x04, 0x15, 0xf4, 0x68, 0x8b, 0x9e, 0x36, 0x19, 0xe6, 0xc3, 0x90, 0x2e, 0xeb,
+		0x1a, 0x36, 0x9c, 0x92, 0xed, 0x58, 0x58, 0xad, 0x69, 0x46, 0x95, 0x2e, 0x35, 0xc9, 0x7c, 0xdf,
+		0xb6, 0xaa, 0xdb, 0x58, 0x19, 0x65, 0xd5, 0xeb, 0xa2, 0x96, 0x50, 0x50, 0x03, 0xb2, 0x7c, 0x14,
+		0xfd, 0x01, 0x0a, 0x56, 0xed, 0x52, 0xc8, 0xef, 0x4b, 0xc1, 0xa0, 0x2f, 0x00, 0x47, 0xc7, 0x60,
+		0xe8, 0x05, 0xf5, 0xba, 0x5a, 0x12, 0x49, 0x15, 0xd3, 0xc4, 0x20, 0x81, 0xad, 0x31, 0x10, 0x7a,
+		0x18, 0x26, 0x28, 0x8a, 0xd9, 0x70, 0xb0, 0x55, 0x2a, 0xeb, 0xaa, 0x6d, 0x53, 0xa5, 0x25, 0x29,
+		0x2a, 0x22, 0x75, 0xab, 0xa4, 0x6a, 0x4e, 0xd4, 0xa0, 0xf3, 0x30, 0x4e, 0x29, 0x6a, 0x0d, 0xdd,
+		0xd1, 0xea, 0x3a, 0x2e, 0x91, 0x34, 0xcf, 0xce, 0x80, 0x5f, 0xb2, 0x31, 0x82, 0xb1, 0xcc, 0x11,
+		0x88, 0x44, 0x36, 0x9a, 0x87, 0x23, 0x94, 0xac, 0x8a, 0x0d, 0x6c, 0xa9, 0x0e, 0x2e, 0xe1, 0x17,
+		0x1b, 0xaa, 0x6e, 0x97, 0x54, 0xa3, 0x52, 0xda, 0x51, 0xed, 0x9d, 0xcc, 0x04, 0x61, 0x50, 0x88,
+		0x65, 0x24, 0x65, 0x92, 0x20, 0x2e, 0x70, 0xbc, 0x22, 0x45, 0x9b, 0x35, 0x2a, 0x57, 0x54, 0x7b,
+		0x07, 0xe5, 0xe1, 0x00, 0xe5, 0x62, 0x3b, 0x96, 0x66, 0x54, 0x4b, 0xe5, 0x1d, 0x5c, 0xbe, 0x56,
+		0x6a, 0x38, 0xdb, 0x4f, 0x64, 0x0e, 0xf9, 0xdb, 0xa7, 0x12, 0xae, 0x53, 0x9c, 0x39, 0x82, 0xb2,
+		0xe9, 0x6c, 0x3f, 0x81, 0xd6, 0x61, 0x88, 0x0c, 0x46, 0x4d, 0x7b, 0x09, 0x97, 0xb6, 0x4d, 0x8b,
+		0xae, 0xa1, 0x23, 0x11, 0xae, 0xc9, 0xa7, 0xc1, 0xe9, 0x55, 0x4e, 0xb0, 0x6c, 0x56, 0x70, 0xbe,
+		0x6f, 0x7d, 0xad, 0x58, 0x9c, 0x57, 0x06, 0x05, 0x97, 0xcb, 0xa6, 0x45, 0x0c, 0xaa, 0x6a, 0xba,
+		0x0a, 0x1e, 0x64, 0x06, 0x55, 0x35, 0x85, 0x7a, 0xcf, 0xc3, 0x78, 0xb9, 0xcc, 0xfa, 0xac, 0x95,
+		0x4b, 0x3c, 0x19, 0xb3, 0x33, 0xe9, 0x80, 0xb2, 0xca, 0xe5, 0x05, 0x86, 0xc0, 0x6d, 0xdc, 0x46,
+		0x17, 0x60, 0xbf, 0xa7, 0x2c, 0x3f, 0xe1, 0x58, 0x53, 0x2f, 0xc3, 0xa4, 0xe7, 0x61, 0xbc, 0xbe,
+		0xdb, 0x4c, 0x88, 0x02, 0x2d, 0xd6, 0x77, 0xc3, 0x64, 0x8f, 0xc3, 0x44, 0x7d, 0xa7, 0xde, 0x4c,
+		0x77, 0xda, 0x4f, 0x87, 0xea, 0x3b, 0xf5, 0x30, 0xe1, 0xfd, 0x34, 0x33, 0xb7, 0x70, 0x59, 0x75,
+		0x70, 0x25, 0x73, 0xd0, 0x8f, 0xee, 0xab, 0x40, 0xd3, 0x90, 0x2e, 0x97, 0x4b, 0xd8, 0x50, 0xb7,
+		0x74, 0x5c, 0x52, 0x2d, 0x6c, 0xa8, 0x76, 0x26, 0x47, 0x91, 0x13, 0x8e, 0xd5, 0xc0, 0xca, 0x48,
+		0xb9, 0x5c, 0xa4, 0x95, 0xb3, 0xb4, 0x0e, 0x9d, 0x86, 0x31, 0x73, 0xeb, 0x85, 0x32, 0xb3, 0xc8,
+		0x52, 0xdd, 0xc2, 0xdb, 0xda, 0xcd, 0xcc, 0x7d, 0x54, 0xbd, 0xa3, 0xa4, 0x82, 0xda, 0xe3, 0x1a,
+		0x05, 0xa3, 0x07, 0x20, 0x5d, 0xb6, 0x77, 0x54, 0xab, 0x4e, 0x5d, 0xb2, 0x5d, 0x57, 0xcb, 0x38,
+		0x73, 0x3f, 0x43, 0x65, 0xf0, 0x15, 0x01, 0x26, 0x33, 0xc2, 0xbe, 0xa1, 0x6d, 0x3b, 0x82, 0xe3,
+		0x49, 0x36, 0x23, 0x28, 0x8c, 0x73, 0x3b, 0x05, 0x69, 0xa2, 0x89, 0x40, 0xc3, 0xa7, 0x28, 0xda,
+		0x48, 0x7d, 0xa7, 0xee, 0x6f, 0xf7, 0x38, 0x0c, 0xd7, 0x77, 0xfc, 0x8d, 0x3e, 0xc0, 0x02, 0xb7,
+		0xfa, 0x8e, 0xaf, 0xc5, 0x73, 0x70, 0x80, 0x20, 0xd5, 0xb0, 0xa3, 0x56, 0x54, 0x47, 0xf5, 0x61,
+		0x3f, 0x44, 0xb1, 0x89, 0xda, 0x97, 0x79, 0x65, 0x40, 0x4e, 0xab, 0xb1, 0xb5, 0xeb, 0x1a, 0xd6,
+		0x19, 0x26, 0x27, 0x81, 0x09, 0xd3, 0xba, 0x67, 0xc1, 0xb9, 0x9c, 0x87, 0x21, 0xbf, 0xdd, 0xa3,
+		0x14, 0x30, 0xcb, 0x4f, 0x4b, 0x24, 0x08, 0x9a, 0x5b, 0x9d, 0x27, 0xe1, 0xcb, 0xf3, 0xc5, 0x74,
+		0x8c, 0x84, 0x51, 0x4b, 0x8b, 0x1b, 0xc5, 0x92, 0xb2, 0xb9, 0xb2, 0xb1, 0xb8, 0x5c, 0x4c, 0xc7,
+		0x7d, 0x81, 0xfd, 0x33, 0x89, 0xe4, 0x89, 0xf4, 0x49, 0xf9, 0x2b, 0x31, 0x18, 0x09, 0x66, 0x6a,
+		0xe8, 0x49, 0x38, 0x28, 0xb6, 0x55, 0x6c, 0xec, 0x94, 0x6e, 0x68, 0x16, 0x9d, 0x90, 0x35, 0x95,
+		0x2d, 0x8e, 0xae, 0xfd, 0x4c, 0x70, 0xac, 0x75, 0xec, 0xbc, 0x41, 0xb3, 0xc8, 0x74, 0xab, 0xa9,
+		0x0e, 0x5a, 0x82, 0x9c, 0x61, 0x96, 0x6c, 0x47, 0x35, 0x2a, 0xaa, 0x55, 0x29, 0x79, 0x1b, 0x5a,
+		0x25, 0xb5, 0x5c, 0xc6, 0xb6, 0x6d, 0xb2, 0x85, 0xd0, 0xe5, 0x72, 0xd8, 0x30, 0xd7, 0x39, 0xb2,
+		0xb7, 0x42, 0xcc, 0x72, 0xd4, 0x90, 0xf9, 0xc6, 0x5b, 0x99, 0xef, 0x21, 0x48, 0xd5, 0xd4, 0x7a,
+		0x09, 0x1b, 0x8e, 0xb5, 0x4b, 0xe3, 0xf3, 0xa4, 0x92, 0xac, 0xa9, 0xf5, 0x22, 0x29, 0xff, 0x50,
+		0xd2, 0xa4, 0x67, 0x12, 0xc9, 0x64, 0x3a, 0xf5, 0x4c, 0x22, 0x99, 0x4a, 0x83, 0xfc, 0x5a, 0x1c,
+		0x86, 0xfc, 0xf1, 0x3a, 0x49, 0x7f, 0xca, 0x74, 0xc5, 0x92, 0xa8, 0x4f, 0x3b, 0xde, 0x36, 0xba,
+		0x9f, 0x9e, 0x23, 0x4b, 0x59, 0xbe, 0x9f, 0x05, 0xc7, 0x0a, 0xa3, 0x24, 0x61, 0x04, 0x31, 0x36,
+		0xcc, 0x82, 0x91, 0xa4, 0xc2, 0x4b, 0x68, 0x01, 0xfa, 0x5f, 0xb0, 0x29, 0xef, 0x7e, 0xca, 0xfb,
+		0xbe, 0xf6, 0xbc, 0x9f, 0x59, 0xa7, 0xcc, 0x53, 0xcf, 0xac, 0x97, 0x56, 0x56, 0x95, 0xe5, 0xd9,
+		0x25, 0x85, 0x93, 0xa3, 0x49, 0x48, 0xe8, 0xea, 0x4b, 0xbb, 0xc1, 0x45, 0x8f, 0x82, 0xba, 0x1d,
+		0x84, 0x49, 0x48, 0x90, 0x0d, 0xba, 0xe0, 0x52, 0x43, 0x41, 0xf7, 0x70, 0x32, 0xcc, 0x40, 0x1f,
+		0xd5, 0x17, 0x02, 0xe0, 0x1a, 0x4b, 0xef, 0x43, 0x49, 0x48, 0xcc, 0xad, 0x2a, 0x64, 0x42, 0xa4,
+		0x61, 0x88, 0x41, 0x4b, 0x6b, 0x8b, 0xc5, 0xb9, 0x62, 0x3a, 0x26, 0x9f, 0x87, 0x7e, 0xa6, 0x04,
+		0x32, 0x59, 0x5c, 0x35, 0xa4, 0xf7, 0xf1, 0x22, 0xe7, 0x21, 0x89, 0xda, 0xcd, 0xe5, 0x42, 0x51,
+		0x49, 0xc7, 0x82, 0x43, 0x9d, 0x48, 0xf7, 0xc9, 0x36, 0x0c, 0xf9, 0xe3, 0xf0, 0x1f, 0x4e, 0x32,
+		0xfe, 0x79, 0x09, 0x06, 0x7d, 0x71, 0x35, 0x09, 0x88, 0x54, 0x5d, 0x37, 0x6f, 0x94, 0x54, 0x5d,
+		0x53, 0x6d, 0x6e, 0x1a, 0x40, 0x41, 0xb3, 0x04, 0xd2, 0xed, 0xd0, 0xfd, 0x90, 0xa6, 0x48, 0x5f,
+		0xba, 0x5f, 0xfe, 0xb0, 0x04, 0xe9, 0x70, 0x60, 0x1b, 0x12, 0x53, 0xfa, 0x51, 0x8a, 0x29, 0x7f,
+		0x48, 0x82, 0x91, 0x60, 0x34, 0x1b, 0x12, 0xef, 0xd8, 0x8f, 0x54, 0xbc, 0xbf, 0x88, 0xc1, 0x70,
+		0x20, 0x86, 0xed, 0x56, 0xba, 0x17, 0x61, 0x4c, 0xab, 0xe0, 0x5a, 0xdd, 0x74, 0xc8, 0xe6, 0x79,
+		0x49, 0xc7, 0xd7, 0xb1, 0x9e, 0x91, 0xa9, 0xd3, 0x98, 0x69, 0x1f, 0x25, 0x4f, 0x2f, 0x7a, 0x74,
+		0x4b, 0x84, 0x2c, 0x3f, 0xbe, 0x38, 0x5f, 0x5c, 0x5e, 0x5b, 0xdd, 0x28, 0xae, 0xcc, 0xbd, 0xb1,
+		0xb4, 0xb9, 0xf2, 0xec, 0xca, 0xea, 0x1b, 0x56, 0x94, 0xb4, 0x16, 0x42, 0xbb, 0x87, 0xd3, 0x7e,
+		0x0d, 0xd2, 0x61, 0xa1, 0xd0, 0x41, 0x88, 0x12, 0x2b, 0xbd, 0x0f, 0x8d, 0xc3, 0xe8, 0xca, 0x6a,
+		0x69, 0x7d, 0x71, 0xbe, 0x58, 0x2a, 0x5e, 0xbe, 0x5c, 0x9c, 0xdb, 0x58, 0x67, 0xfb, 0x1e, 0x2e,
+		0xf6, 0x46, 0x60, 0x82, 0xcb, 0x1f, 0x8c, 0xc3, 0x78, 0x84, 0x24, 0x68, 0x96, 0x67, 0x2c, 0x2c,
+		0x89, 0x3a, 0xd3, 0x8d, 0xf4, 0xd3, 0x24, 0x66, 0x58, 0x53, 0x2d, 0x87, 0x27, 0x38, 0x0f, 0x00,
+		0xd1, 0x92, 0xe1, 0x68, 0xdb, 0x1a, 0xb6, 0xf8, 0x7e, 0x12, 0x4b, 0x63, 0x46, 0x3d, 0x38, 0xdb,
+		0x52, 0x7a, 0x08, 0x50, 0xdd, 0xb4, 0x35, 0x47, 0xbb, 0x4e, 0xb6, 0xe4, 0xc5, 0xe6, 0x13, 0x49,
+		0x6b, 0x12, 0x4a, 0x5a, 0xd4, 0x2c, 0x1a, 0x8e, 0x8b, 0x6d, 0xe0, 0xaa, 0x1a, 0xc2, 0x26, 0xce,
+		0x3c, 0xae, 0xa4, 0x45, 0x8d, 0x8b, 0x7d, 0x0c, 0x86, 0x2a, 0x66, 0x83, 0xc4, 0x7a, 0x0c, 0x8f,
+		0xac, 0x1d, 0x92, 0x32, 0xc8, 0x60, 0x2e, 0x0a, 0x8f, 0xe2, 0xbd, 0x5d, 0xaf, 0x21, 0x65, 0x90,
+		0xc1, 0x18, 0xca, 0x49, 0x18, 0x55, 0xab, 0x55, 0x8b, 0x30, 0x17, 0x8c, 0x58, 0x5e, 0x32, 0xe2,
+		0x82, 0x29, 0x62, 0xf6, 0x19, 0x48, 0x0a, 0x3d, 0x90, 0xa5, 0x9a, 0x68, 0xa2, 0x54, 0x67, 0xc9,
+		0x76, 0x8c, 0x6c, 0x84, 0x19, 0xa2, 0xf2, 0x18, 0x0c, 0x69, 0x76, 0xc9, 0xdb, 0xc4, 0x8f, 0x4d,
+		0xc5, 0x4e, 0x25, 0x95, 0x41, 0xcd, 0x76, 0x37, 0x40, 0xe5, 0x8f, 0xc5, 0x60, 0x24, 0x78, 0x08,
+		0x81, 0xe6, 0x21, 0xa9, 0x9b, 0x65, 0x95, 0x9a, 0x16, 0x3b, 0x01, 0x3b, 0xd5, 0xe1, 0xdc, 0x62,
+		0x7a, 0x89, 0xe3, 0x2b, 0x2e, 0x65, 0xf6, 0x8f, 0x24, 0x48, 0x0a, 0x30, 0x3a, 0x00, 0x89, 0xba,
+		0xea, 0xec, 0x50, 0x76, 0x7d, 0x85, 0x58, 0x5a, 0x52, 0x68, 0x99, 0xc0, 0xed, 0xba, 0x6a, 0x64,
+		0x62, 0x1e, 0x9c, 0x94, 0xc9, 0xb8, 0xea, 0x58, 0xad, 0xd0, 0xa4, 0xc7, 0xac, 0xd5, 0xb0, 0xe1,
+		0xd8, 0x62, 0x5c, 0x39, 0x7c, 0x8e, 0x83, 0xc9, 0x59, 0x98, 0x63, 0xa9, 0x9a, 0x1e, 0xc0, 0x4d,
+		0x50, 0xdc, 0xb4, 0xa8, 0x70, 0x91, 0xf3, 0x30, 0x29, 0xf8, 0x56, 0xb0, 0xa3, 0x96, 0x77, 0x70,
+		0xc5, 0x23, 0xea, 0xa7, 0x9b, 0x1b, 0x07, 0x39, 0xc2, 0x3c, 0xaf, 0x17, 0xb4, 0xf2, 0x57, 0x24,
+		0x18, 0x13, 0x69, 0x5a, 0xc5, 0x55, 0xd6, 0x32, 0x80, 0x6a, 0x18, 0xa6, 0xe3, 0x57, 0x57, 0xb3,
+		0x29, 0x37, 0xd1, 0x4d, 0xcf, 0xba, 0x44, 0x8a, 0x8f, 0x41, 0xb6, 0x06, 0xe0, 0xd5, 0xb4, 0x54,
+		0x5b, 0x0e, 0x06, 0xf9, 0x09, 0x13, 0x3d, 0xa6, 0x64, 0x89, 0x3d, 0x30, 0x10, 0xc9, 0xe7, 0xc8,
+		0xf6, 0xcb, 0x16, 0xae, 0x6a, 0x06, 0xdf, 0x37, 0x66, 0x05, 0xb1, 0xfd, 0x92, 0x70, 0xb7, 0x5f,
+		0x0a, 0xef, 0x91, 0x60, 0xbc, 0x6c, 0xd6, 0xc2, 0xf2, 0x16, 0xd2, 0xa1, 0xdd, 0x05, 0xfb, 0x8a,
+		0xf4, 0xfc, 0xd3, 0x55, 0xcd, 0xd9, 0x69, 0x6c, 0x4d, 0x97, 0xcd, 0xda, 0x4c, 0xd5, 0xd4, 0x55,
+		0xa3, 0xea, 0x9d, 0xb3, 0xd2, 0x7f, 0xca, 0x67, 0xaa, 0xd8, 0x38, 0x53, 0x35, 0x7d, 0xa7, 0xae,
+		0x17, 0xbd, 0x7f, 0xff, 0xaf, 0x24, 0xfd, 0x62, 0x2c, 0xbe, 0xb0, 0x56, 0xf8, 0x78, 0x2c, 0xbb,
+		0xc0, 0x9a, 0x5b, 0x13, 0xea, 0x51, 0xf0, 0xb6, 0x8e, 0xcb, 0xa4, 0xcb, 0xf0, 0xad, 0x07, 0x61,
+		0xa2, 0x6a, 0x56, 0x4d, 0xca, 0x71, 0x86, 0xfc, 0xc7, 0x4f, 0x6e, 0x53, 0x2e, 0x34, 0xdb, 0xf1,
+		0x98, 0x37, 0xbf, 0x02, 0xe3, 0x1c, 0xb9, 0x44, 0x8f, 0x8e, 0x58, 0x62, 0x83, 0xda, 0xee, 0xaa,
+		0x65, 0x3e, 0xf5, 0x0d, 0xba, 0xa0, 0x2b, 0x63, 0x9c, 0x94, 0xd4, 0xb1, 0xdc, 0x27, 0xaf, 0xc0,
+		0xfe, 0x00, 0x3f, 0x36, 0x6d, 0xb1, 0xd5, 0x81, 0xe3, 0xef, 0x73, 0x8e, 0xe3, 0x3e, 0x8e, 0xeb,
+		0x9c, 0x34, 0x3f, 0x07, 0xc3, 0xbd, 0xf0, 0xfa, 0x8f, 0x9c, 0xd7, 0x10, 0xf6, 0x33, 0x59, 0x80,
+		0x51, 0xca, 0xa4, 0xdc, 0xb0, 0x1d, 0xb3, 0x46, 0x7d, 0x62, 0x7b, 0x36, 0x7f, 0xf0, 0x0d, 0x36,
+		0x8f, 0x46, 0x08, 0xd9, 0x9c, 0x4b, 0x95, 0xcf, 0x03, 0x3d, 0x2d, 0x23, 0xa7, 0x58, 0x1d, 0x38,
+		0x7c, 0x81, 0x0b, 0xe2, 0xe2, 0xe7, 0xaf, 0xc2, 0x04, 0xf9, 0x9f, 0xba, 0x2c, 0xbf, 0x24, 0x9d,
+		0xb7, 0xe0, 0x32, 0x5f, 0x79, 0x3b, 0x9b, 0xaa, 0xe3, 0x2e, 0x03, 0x9f, 0x4c, 0xbe, 0x51, 0xac,
+		0x62, 0xc7, 0xc1, 0x96, 0x5d, 0x52, 0xf5, 0x28, 0xf1, 0x7c, 0x7b, 0x18, 0x99, 0x9f, 0xff, 0x76,
+		0x70, 0x14, 0x17, 0x18, 0xe5, 0xac, 0xae, 0xe7, 0x37, 0xe1, 0x60, 0x84, 0x55, 0x74, 0xc1, 0xf3,
+		0x83, 0x9c, 0xe7, 0x44, 0x93, 0x65, 0x10, 0xb6, 0x6b, 0x20, 0xe0, 0xee, 0x58, 0x76, 0xc1, 0xf3,
+		0x17, 0x38, 0x4f, 0xc4, 0x69, 0xc5, 0x90, 0x12, 0x8e, 0xcf, 0xc0, 0xd8, 0x75, 0x6c, 0x6d, 0x99,
+		0x36, 0xdf, 0x37, 0xea, 0x82, 0xdd, 0x87, 0x38, 0xbb, 0x51, 0x4e, 0x48, 0x37, 0x92, 0x08, 0xaf,
+		0x0b, 0x90, 0xdc, 0x56, 0xcb, 0xb8, 0x0b, 0x16, 0xb7, 0x38, 0x8b, 0x01, 0x82, 0x4f, 0x48, 0x67,
+		0x61, 0xa8, 0x6a, 0xf2, 0x55, 0xab, 0x33, 0xf9, 0x87, 0x39, 0xf9, 0xa0, 0xa0, 0xe1, 0x2c, 0xea,
+		0x66, 0xbd, 0xa1, 0x93, 0x25, 0xad, 0x33, 0x8b, 0x7f, 0x21, 0x58, 0x08, 0x1a, 0xce, 0xa2, 0x07,
+		0xb5, 0x7e, 0x44, 0xb0, 0xb0, 0x7d, 0xfa, 0xbc, 0x44, 0x8e, 0x93, 0xf4, 0x5d, 0xd3, 0xe8, 0x46,
+		0x88, 0x8f, 0x72, 0x0e, 0xc0, 0x49, 0x08, 0x83, 0x8b, 0x90, 0xea, 0x76, 0x20, 0x7e, 0xe5, 0xdb,
+		0x62, 0x7a, 0x88, 0x11, 0x58, 0x80, 0x51, 0xe1, 0xa0, 0xc8, 0xf1, 0x73, 0x67, 0x16, 0xff, 0x92,
+		0xb3, 0x18, 0xf1, 0x91, 0xf1, 0x6e, 0x38, 0xd8, 0x76, 0xaa, 0xb8, 0x1b, 0x26, 0x1f, 0x13, 0xdd,
+		0xe0, 0x24, 0x5c, 0x95, 0x5b, 0xd8, 0x28, 0xef, 0x74, 0xc7, 0xe1, 0x57, 0x85, 0x2a, 0x05, 0x0d,
+		0x61, 0x31, 0x07, 0xc3, 0x35, 0xd5, 0xb2, 0x77, 0x54, 0xbd, 0xab, 0xe1, 0xf8, 0x57, 0x9c, 0xc7,
+		0x90, 0x4b, 0xc4, 0x35, 0xd2, 0x30, 0x7a, 0x61, 0xf3, 0x71, 0xa1, 0x91, 0x86, 0x11, 0x60, 0xb4,
+		0x06, 0x13, 0xb6, 0x43, 0x37, 0xd9, 0x7a, 0xe1, 0xf6, 0xaf, 0xc5, 0xd4, 0x63, 0xb4, 0xcb, 0x7e,
+		0x8e, 0x17, 0x21, 0x65, 0x6b, 0x2f, 0x75, 0xc5, 0xe6, 0xd7, 0xc4, 0x48, 0x53, 0x02, 0x42, 0xfc,
+		0x46, 0x98, 0x8c, 0x5c, 0x26, 0xba, 0x60, 0xf6, 0x6f, 0x38, 0xb3, 0x03, 0x11, 0x4b, 0x05, 0x77,
+		0x09, 0xbd, 0xb2, 0xfc, 0x84, 0x70, 0x09, 0x38, 0xc4, 0x6b, 0x8d, 0xe4, 0x11, 0xb6, 0xba, 0xdd,
+		0x9b, 0xd6, 0x7e, 0x5d, 0x68, 0x8d, 0xd1, 0x06, 0xb4, 0xb6, 0x01, 0x07, 0x38, 0xc7, 0xde, 0xc6,
+		0xf5, 0x93, 0xc2, 0xb1, 0x32, 0xea, 0xcd, 0xe0, 0xe8, 0xbe, 0x09, 0xb2, 0xae, 0x3a, 0x45, 0xc0,
+		0x6a, 0x97, 0xc8, 0xce, 0x54, 0x67, 0xce, 0x9f, 0xe2, 0x9c, 0x85, 0xc7, 0x77, 0x23, 0x5e, 0x7b,
+		0x59, 0xad, 0x13, 0xe6, 0xcf, 0x41, 0x46, 0x30, 0x6f, 0x18, 0x16, 0x2e, 0x9b, 0x55, 0x43, 0x7b,
+		0x09, 0x57, 0xba, 0x60, 0xfd, 0x6f, 0x43, 0x43, 0xb5, 0xe9, 0x23, 0x27, 0x9c, 0x17, 0x21, 0xed,
+		0xc6, 0x2a, 0x25, 0xad, 0x56, 0x37, 0x2d, 0xa7, 0x03, 0xc7, 0x7f, 0x27, 0x46, 0xca, 0xa5, 0x5b,
+		0xa4, 0x64, 0xf9, 0x22, 0xb0, 0x93, 0xe7, 0x6e, 0x4d, 0xf2, 0xd3, 0x9c, 0xd1, 0xb0, 0x47, 0xc5,
+		0x1d, 0x47, 0xd9, 0xac, 0xd5, 0x55, 0xab, 0x1b, 0xff, 0xf7, 0x1b, 0xc2, 0x71, 0x70, 0x12, 0xee,
+		0x38, 0xc8, 0xae, 0x16, 0x59, 0xed, 0xbb, 0xe0, 0xf0, 0x19, 0xe1, 0x38, 0x04, 0x0d, 0x67, 0x21,
+		0x02, 0x86, 0x2e, 0x58, 0xfc, 0xa6, 0x60, 0x21, 0x68, 0x08, 0x8b, 0xd7, 0x7b, 0x0b, 0xad, 0x85,
+		0xab, 0x9a, 0xed, 0x58, 0x2c, 0x4c, 0x6e, 0xcf, 0xea, 0xb7, 0xbe, 0x1d, 0x0c, 0xc2, 0x14, 0x1f,
+		0x29, 0xf1, 0x44, 0x7c, 0xdb, 0x95, 0x66, 0x51, 0x9d, 0x05, 0xfb, 0xac, 0xf0, 0x44, 0x3e, 0x32,
+		0x22, 0x9b, 0x2f, 0x42, 0x24, 0x6a, 0x2f, 0x93, 0xdc, 0xa1, 0x0b, 0x76, 0xff, 0x3e, 0x24, 0xdc,
+		0xba, 0xa0, 0x25, 0x3c, 0x7d, 0xf1, 0x4f, 0xc3, 0xb8, 0x86, 0x77, 0xbb, 0xb2, 0xce, 0xdf, 0x0e,
+		0xc5, 0x3f, 0x9b, 0x8c, 0x92, 0xf9, 0x90, 0xd1, 0x50, 0x3c, 0x85, 0x3a, 0xdd, 0x33, 0xca, 0xfc,
+		0xc4, 0x77, 0x79, 0x7f, 0x83, 0xe1, 0x54, 0x7e, 0x09, 0xd2, 0x1c, 0xe2, 0x05, 0xb0, 0x1d, 0x99,
+		0xbd, 0xfd, 0xbb, 0xae, 0x9d, 0x07, 0x62, 0x9e, 0xfc, 0x65, 0x18, 0x0e, 0x04, 0x3c, 0x9d, 0x59,
+		0xfd, 0x24, 0x67, 0x35, 0xe4, 0x8f, 0x77, 0xf2, 0xe7, 0x21, 0x41, 0x82, 0x97, 0xce, 0xe4, 0xef,
+		0xe0, 0xe4, 0x14, 0x3d, 0xff, 0x14, 0x24, 0x45, 0xd0, 0xd2, 0x99, 0xf4, 0x9d, 0x9c, 0xd4, 0x25,
+		0x21, 0xe4, 0x22, 0x60, 0xe9, 0x4c, 0xfe, 0x53, 0x82, 0x5c, 0x90, 0x10, 0xf2, 0xee, 0x55, 0xf8,
+		0xf9, 0x7f, 0x92, 0x60, 0xe4, 0x82, 0x24, 0x4f, 0x4e, 0xbe, 0x59, 0xa4, 0xd2, 0x99, 0xfa, 0xdd,
+		0xbc, 0x71, 0x41, 0x91, 0x7f, 0x1c, 0xfa, 0xba, 0x54, 0xf8, 0x3f, 0xe3, 0xa4, 0x0c, 0x3f, 0x3f,
+		0x07, 0x83, 0xbe, 0xe8, 0xa4, 0x33, 0xf9, 0x4f, 0x73, 0x72, 0x3f, 0x15, 0x11, 0x9d, 0x47, 0x27,
+		0x9d, 0x19, 0xbc, 0x47, 0x88, 0xce, 0x29, 0x88, 0xda, 0x44, 0x60, 0xd2, 0x99, 0xfa, 0xbd, 0x42,
+		0xeb, 0x82, 0x24, 0x7f, 0x09, 0x52, 0xee, 0x62, 0xd3, 0x99, 0xfe, 0x7d, 0x9c, 0xde, 0xa3, 0x21,
+		0x1a, 0x68, 0x18, 0x3d, 0xb0, 0xf8, 0x19, 0xa1, 0x01, 0x1f, 0x15, 0x99, 0x46, 0xe1, 0x00, 0xa6,
+		0x33, 0xa7, 0xf7, 0x8b, 0x69, 0x14, 0x8a, 0x5f, 0xc8, 0x68, 0x52, 0x9f, 0xdf, 0x99, 0xc5, 0xcf,
+		0x8a, 0xd1, 0xa4, 0xf8, 0x44, 0x8c, 0x70, 0x44, 0xd0, 0x99, 0xc7, 0xcf, 0x09, 0x31, 0x42, 0x01,
+		0x41, 0x7e, 0x0d, 0x50, 0x73, 0x34, 0xd0, 0x99, 0xdf, 0x07, 0x38, 0xbf, 0xb1, 0xa6, 0x60, 0x20,
+		0xff, 0x06, 0x38, 0x10, 0x1d, 0x09, 0x74, 0xe6, 0xfa, 0xf3, 0xdf, 0x0d, 0xe5, 0x6e, 0xfe, 0x40,
+		0x20, 0xbf, 0x01, 0x13, 0x51, 0x51, 0x40, 0x67, 0xb6, 0x1f, 0xfc, 0x6e, 0xd0, 0x71, 0xfb, 0x83,
+		0x80, 0xfc, 0x2c, 0x80, 0xb7, 0x00, 0x77, 0xe6, 0xf5, 0x21, 0xce, 0xcb, 0x47, 0x44, 0xa6, 0x06,
+		0x5f, 0x7f, 0x3b, 0xd3, 0xdf, 0x12, 0x53, 0x83, 0x53, 0x90, 0xa9, 0x21, 0x96, 0xde, 0xce, 0xd4,
+		0x1f, 0x16, 0x53, 0x43, 0x90, 0x10, 0xcb, 0xf6, 0xad, 0x6e, 0x9d, 0x39, 0x7c, 0x54, 0x58, 0xb6,
+		0x8f, 0x2a, 0xbf, 0x02, 0x63, 0x4d, 0x0b, 0x62, 0x67, 0x56, 0xbf, 0xc8, 0x59, 0xa5, 0xc3, 0xeb,
+		0xa1, 0x7f, 0xf1, 0xe2, 0x8b, 0x61, 0x67, 0x6e, 0xbf, 0x14, 0x5a, 0xbc, 0xf8, 0x5a, 0x98, 0xbf,
+		0x08, 0x49, 0xa3, 0xa1, 0xeb, 0x64, 0xf2, 0xa0, 0xf6, 0x77, 0x03, 0x33, 0xff, 0xeb, 0xfb, 0x5c,
+		0x3b, 0x82, 0x20, 0x7f, 0x1e, 0xfa, 0x70, 0x6d, 0x0b, 0x57, 0x3a, 0x51, 0x7e, 0xeb, 0xfb, 0xc2,
+		0x61, 0x12, 0xec, 0xfc, 0x25, 0x00, 0xb6, 0x35, 0x42, 0x8f, 0x07, 0x3b, 0xd0, 0xfe, 0xef, 0xef,
+		0xf3, 0xcb, 0x38, 0x1e, 0x89, 0xc7, 0x80, 0x5d, 0xed, 0x69, 0xcf, 0xe0, 0xdb, 0x41, 0x06, 0x74,
+		0x44, 0x2e, 0xc0, 0x00, 0xb9, 0x22, 0xe9, 0xa8, 0xd5, 0x4e, 0xd4, 0x7f, 0xc9, 0xa9, 0x05, 0x3e,
+		0x51, 0x58, 0xcd, 0xb4, 0xb0, 0xa3, 0x56, 0xed, 0x4e, 0xb4, 0x7f, 0xc5, 0x69, 0x5d, 0x02, 0x42,
+		0x5c, 0x56, 0x6d, 0xa7, 0x9b, 0x7e, 0xff, 0xb5, 0x20, 0x16, 0x04, 0x44, 0x68, 0xf2, 0xff, 0x35,
+		0xbc, 0xdb, 0x89, 0xf6, 0x3b, 0x42, 0x68, 0x8e, 0x9f, 0x7f, 0x0a, 0x52, 0xe4, 0x5f, 0x76, 0xc3,
+		0xae, 0x03, 0xf1, 0xff, 0xe1, 0xc4, 0x1e, 0x05, 0x69, 0xd9, 0x76, 0x2a, 0x8e, 0xd6, 0x59, 0xd9,
+		0x77, 0xf8, 0x48, 0x0b, 0xfc, 0xfc, 0x2c, 0x0c, 0xda, 0x4e, 0xa5, 0xd2, 0xe0, 0xf1, 0x69, 0x07,
+		0xf2, 0xbf, 0xf9, 0xbe, 0xbb, 0x65, 0xe1, 0xd2, 0x90, 0xd1, 0xbe, 0x71, 0xcd, 0xa9, 0x9b, 0xf4,
+		0x08, 0xa4, 0x13, 0x87, 0xef, 0x72, 0x0e, 0x3e, 0x92, 0xfc, 0x1c, 0x0c, 0x91, 0xbe, 0x58, 0xb8,
+		0x8e, 0xe9, 0x79, 0x55, 0x07, 0x16, 0xdf, 0xe3, 0x0a, 0x08, 0x10, 0x15, 0xde, 0xf2, 0x85, 0xd7,
+		0x8e, 0x4a, 0x5f, 0x7e, 0xed, 0xa8, 0xf4, 0x17, 0xaf, 0x1d, 0x95, 0xde, 0xfb, 0xf5, 0xa3, 0xfb,
+		0xbe, 0xfc, 0xf5, 0xa3, 0xfb, 0xfe, 0xe4, 0xeb, 0x47, 0xf7, 0x45, 0x6f, 0x1b, 0xc3, 0x82, 0xb9,
+		0x60, 0xb2, 0x0d, 0xe3, 0xe7, 0xe5, 0xc0, 0x76, 0x71, 0xd5, 0xf4, 0x76, 0x6b, 0xdd, 0x24, 0x07,
+		0xbe, 0x27, 0xc1, 0x24, 0xe3, 0xe1, 0xd5, 0xaa, 0xc6, 0x6e, 0x8b, 0x6f, 0x75, 0xb2, 0x91, 0x1b,
+		0xc3, 0xf2, 0x93, 0x10, 0x9f, 0x35, 0x76, 0xd1, 0x24, 0xf3, 0x79, 0xa5, 0x86, 0xa5, 0xf3, 0x9b,
+		0x5f, 0x03, 0xa4, 0xbc, 0x69, 0xe9, 0x64, 0x37, 0x5c, 0x5c, 0xcf, 0x24, 0x87, 0x2e, 0xac, 0x90,
+		0x4f, 0x7c, 0xe7, 0xa3, 0xb9, 0x7d, 0x85, 0x6b, 0xe1, 0x1e, 0x7e, 0xbe, 0x63, 0x2f, 0x93, 0xb3,
+		0xc6, 0x2e, 0xed, 0xe4, 0x9a, 0xf4, 0x7c, 0x1f, 0x69, 0xc3, 0x16, 0x1b, 0xdb, 0x47, 0xc3, 0x1b,
+		0xdb, 0x6f, 0xc0, 0xba, 0xfe, 0xac, 0x61, 0xde, 0x30, 0xc8, 0x09, 0xb9, 0xbd, 0xd5, 0xcf, 0xae,
+		0x11, 0xc3, 0x3f, 0x8d, 0xc1, 0xd1, 0x70, 0xbf, 0xc5, 0xc8, 0xb7, 0xfa, 0x50, 0x29, 0x0f, 0xc9,
+		0x79, 0x61, 0x50, 0x19, 0xf2, 0x85, 0x4c, 0xd9, 0x34, 0x2a, 0x36, 0xed, 0x6a, 0x5c, 0x11, 0x45,
+		0xd2, 0x55, 0x43, 0x35, 0x4c, 0x9b, 0xdf, 0x8e, 0x64, 0x85, 0xc2, 0xcf, 0x4a, 0xbd, 0x8d, 0xe3,
+		0xb0, 0x68, 0x49, 0x74, 0xf3, 0x74, 0xbb, 0xbd, 0x7f, 0xaa, 0x02, 0x57, 0x7e, 0xdf, 0x3e, 0x7f,
+		0xb7, 0xea, 0x78, 0x6f, 0x0c, 0x72, 0x61, 0x75, 0x90, 0x79, 0x64, 0x3b, 0x6a, 0xad, 0xde, 0x4a,
+		0x1f, 0x17, 0x21, 0xb5, 0x21, 0x70, 0x7a, 0x56, 0xc8, 0x3f, 0xef, 0x51, 0x21, 0x23, 0x6e, 0x53,
+		0x42, 0x23, 0x0f, 0x76, 0xd6, 0x88, 0xdb, 0x85, 0x3d, 0xa8, 0xe4, 0x6d, 0x71, 0x98, 0x2c, 0x9b,
+		0x76, 0xcd, 0xb4, 0x4b, 0xcc, 0xe0, 0x59, 0x81, 0x2b, 0x63, 0xc8, 0x5f, 0xd5, 0xc5, 0x71, 0xc8,
+		0x15, 0x18, 0xa1, 0x4e, 0x81, 0x6e, 0x04, 0x53, 0x3f, 0xdc, 0x71, 0xe9, 0xfc, 0xc3, 0x3f, 0xee,
+		0xa3, 0x93, 0x68, 0xd8, 0x25, 0xa4, 0x37, 0x5d, 0x36, 0x60, 0x42, 0xab, 0xd5, 0x75, 0x4c, 0x8f,
+		0xc4, 0x4a, 0x6e, 0x5d, 0x67, 0x7e, 0x5f, 0xe4, 0xfc, 0xc6, 0x3d, 0xf2, 0x45, 0x41, 0x9d, 0x5f,
+		0x82, 0x31, 0x72, 0x9b, 0xa9, 0x1e, 0x60, 0xd9, 0xc1, 0x61, 0x09, 0x01, 0xd3, 0x9c, 0xd2, 0xe5,
+		0x56, 0xb8, 0xd4, 0x6a, 0x6c, 0x9f, 0xbf, 0xdf, 0x37, 0x68, 0x16, 0x26, 0xa7, 0x55, 0x06, 0x76,
+		0x6e, 0x98, 0xd6, 0x35, 0xae, 0xde, 0x33, 0xac, 0x29, 0x31, 0x08, 0x7f, 0x1b, 0x83, 0x43, 0xfa,
+		0xf6, 0xd6, 0xcc, 0x96, 0x6a, 0xe3, 0x99, 0xeb, 0x8f, 0x6c, 0x61, 0x47, 0x7d, 0x64, 0xa6, 0x6c,
+		0x6a, 0x62, 0x8e, 0xa6, 0xf5, 0xed, 0xad, 0x69, 0x52, 0x39, 0xcd, 0x2b, 0x5b, 0x78, 0xa8, 0x05,
+		0x48, 0xcc, 0x99, 0x9a, 0x41, 0x6c, 0xb1, 0x82, 0x0d, 0xb3, 0xc6, 0xfd, 0x13, 0x2b, 0xa0, 0xe3,
+		0xd0, 0xaf, 0xd6, 0xcc, 0x86, 0xe1, 0xb0, 0x73, 0xbc, 0xc2, 0xe0, 0x17, 0x6e, 0xe7, 0xf6, 0xfd,
+		0xe9, 0xed, 0x5c, 0x7c, 0xd1, 0x70, 0x14, 0x5e, 0x95, 0x4f, 0x7c, 0xf3, 0x23, 0x39, 0x49, 0x7e,
+		0x06, 0x06, 0xe6, 0x71, 0x79, 0x2f, 0xbc, 0xe6, 0x71, 0x39, 0xc4, 0xeb, 0x01, 0x48, 0x2e, 0x1a,
+		0x0e, 0xbb, 0x3b, 0x7c, 0x04, 0xe2, 0x9a, 0xc1, 0xae, 0xa3, 0x85, 0xda, 0x27, 0x70, 0x82, 0x3a,
+		0x8f, 0xcb, 0x2e, 0x6a, 0x05, 0x97, 0x33, 0x52, 0x33, 0x7b, 0x02, 0x2f, 0x5c, 0xfa, 0x93, 0xff,
+		0x7e, 0x74, 0xdf, 0x2b, 0xaf, 0x1d, 0xdd, 0xd7, 0x72, 0x0c, 0x8e, 0xf8, 0xc6, 0x40, 0xd7, 0x0c,
+		0x3c, 0xa3, 0x6f, 0x6f, 0x9d, 0xb1, 0x2b, 0xd7, 0x66, 0x9c, 0xc0, 0x04, 0xf8, 0x40, 0x02, 0x0e,
+		0x91, 0xfc, 0x5a, 0x2d, 0x9b, 0xc6, 0x4c, 0xd9, 0xda, 0xad, 0x3b, 0x74, 0x05, 0x31, 0xb7, 0x85,
+		0x3f, 0x10, 0x95, 0xd3, 0xac, 0xb2, 0x85, 0xea, 0xb7, 0xa1, 0x6f, 0x8d, 0x50, 0x11, 0x7d, 0x39,
+		0xa6, 0xa3, 0xea, 0xdc, 0x3f, 0xb0, 0x02, 0x81, 0xb2, 0x2f, 0x4c, 0x62, 0x0c, 0xaa, 0x89, 0x8f,
+		0x4b, 0x74, 0xac, 0x6e, 0xb3, 0x8b, 0xba, 0x71, 0xba, 0x66, 0x24, 0x09, 0x80, 0xde, 0xc9, 0x9d,
+		0x80, 0x3e, 0xb5, 0xc1, 0xce, 0x98, 0xe3, 0x64, 0x31, 0xa1, 0x05, 0x79, 0x11, 0x06, 0xf8, 0xb9,
+		0x16, 0x39, 0x65, 0xbd, 0x86, 0x77, 0x69, 0x3b, 0x43, 0x0a, 0xf9, 0x17, 0x3d, 0x04, 0x7d, 0x54,
+		0x74, 0xfe, 0x05, 0xc2, 0x81, 0xe9, 0x90, 0xec, 0xd3, 0x54, 0x44, 0x85, 0x21, 0xc9, 0xcf, 0x40,
+		0x72, 0xde, 0xac, 0x69, 0x86, 0x19, 0xe4, 0x95, 0x62, 0xbc, 0xa8, 0xc4, 0xf5, 0x06, 0x1f, 0x60,
+		0x85, 0x15, 0xc8, 0x75, 0x36, 0x76, 0x6d, 0x9b, 0x9f, 0x92, 0xf3, 0x92, 0x3c, 0x07, 0x03, 0x94,
+		0xf7, 0x6a, 0x9d, 0xdc, 0x0f, 0x77, 0xef, 0xcc, 0xa5, 0xf8, 0x47, 0x3c, 0x9c, 0x7d, 0xcc, 0x13,
+		0x15, 0x41, 0xa2, 0xa2, 0x3a, 0x2a, 0xef, 0x35, 0xfd, 0x5f, 0x7e, 0x12, 0x92, 0x9c, 0x09, 0xb9,
+		0xd7, 0x1d, 0x37, 0xeb, 0x36, 0x3f, 0xe7, 0xce, 0x44, 0x77, 0x64, 0xb5, 0x5e, 0x48, 0x10, 0xc3,
+		0x50, 0x08, 0x6a, 0xe1, 0x4a, 0x4b, 0x4b, 0x98, 0x0e, 0x5b, 0x82, 0x3b, 0xde, 0x6c, 0x18, 0x43,
+		0xc3, 0xef, 0x9a, 0xc6, 0xfb, 0x63, 0x70, 0xd8, 0xad, 0xbb, 0x8e, 0x2d, 0x92, 0xcc, 0x31, 0xeb,
+		0x11, 0xf3, 0xd2, 0x15, 0x8b, 0xd7, 0xb6, 0x30, 0x8e, 0xa7, 0x20, 0x3e, 0x5b, 0xaf, 0x93, 0xaf,
+		0x95, 0x68, 0xb9, 0x6c, 0x32, 0xeb, 0x48, 0x28, 0x6e, 0x99, 0xd4, 0xd9, 0xe6, 0xb6, 0x73, 0x43,
+		0xb5, 0xdc, 0x2f, 0x99, 0x44, 0x59, 0xbe, 0x00, 0xa9, 0x39, 0xd3, 0xb0, 0xb1, 0x61, 0x37, 0xe8,
+		0x3a, 0xb3, 0xa5, 0x9b, 0xe5, 0x6b, 0x9c, 0x03, 0x2b, 0x10, 0x05, 0xab, 0xf5, 0x3a, 0xa5, 0x4c,
+		0x28, 0xe4, 0x5f, 0x36, 0xf9, 0x0a, 0x8b, 0x2d, 0x95, 0x32, 0xd3, 0xad, 0x52, 0x78, 0xd7, 0x5c,
+		0xad, 0xfc, 0xb5, 0x04, 0xd9, 0xf0, 0x84, 0xb9, 0x86, 0x77, 0xed, 0xde, 0xe6, 0xcb, 0x73, 0x90,
+		0x5a, 0xa3, 0x1f, 0x10, 0x3f, 0x8b, 0x77, 0x51, 0x16, 0x06, 0x70, 0xe5, 0xec, 0xf9, 0xf3, 0x8f,
+		0x5c, 0x60, 0xd6, 0x7c, 0x65, 0x9f, 0x22, 0x00, 0xe8, 0x28, 0xa4, 0x6c, 0x5c, 0xae, 0x9f, 0x3d,
+		0xff, 0xd8, 0xb5, 0x47, 0x98, 0x01, 0x5d, 0xd9, 0xa7, 0x78, 0xa0, 0x7c, 0x92, 0xf4, 0xf3, 0x9b,
+		0x1f, 0xcd, 0x49, 0x85, 0x3e, 0x88, 0xdb, 0x8d, 0xda, 0x3d, 0xb0, 0x83, 0xb7, 0xf5, 0xc1, 0x51,
+		0xb7, 0x8e, 0x2d, 0xb9, 0xd7, 0x55, 0x5d, 0xab, 0xa8, 0xde, 0xe7, 0xde, 0x23, 0x6e, 0xaf, 0x69,
+		0x7d, 0x74, 0xa7, 0xb3, 0x6d, 0xf4, 0x26, 0x7f, 0x42, 0x82, 0xa1, 0xab, 0x82, 0x2b, 0xf9, 0x36,
+		0xfc, 0x02, 0x80, 0xdb, 0x8a, 0x98, 0x08, 0x93, 0xd3, 0xc1, 0x76, 0xa6, 0x5d, 0x0a, 0xc5, 0x87,
+		0x8c, 0xce, 0x53, 0x43, 0xab, 0x9b, 0x36, 0xff, 0x7a, 0xa5, 0x2d, 0xa1, 0x8b, 0x4a, 0xee, 0x22,
+		0x51, 0x6f, 0x55, 0xba, 0x6e, 0x3a, 0xe4, 0x28, 0xb6, 0x6e, 0xde, 0xe0, 0x5f, 0x04, 0xc6, 0x95,
+		0x34, 0xad, 0xb9, 0x4a, 0x2b, 0xd6, 0x08, 0x5c, 0xfe, 0xa4, 0x04, 0x29, 0x97, 0x0b, 0x09, 0x8c,
+		0xd4, 0x4a, 0xc5, 0xc2, 0xb6, 0xcd, 0x1d, 0x92, 0x28, 0x92, 0x8f, 0x50, 0xea, 0x8d, 0xad, 0x92,
+		0x98, 0xff, 0x83, 0x67, 0xb3, 0xcd, 0xb3, 0x59, 0x58, 0x02, 0x9f, 0xcf, 0xfd, 0xf5, 0xc6, 0x16,
+		0xb1, 0x8b, 0x63, 0x30, 0x14, 0x21, 0xca, 0xe0, 0x75, 0x4f, 0x0a, 0xfa, 0x85, 0x3a, 0x97, 0xbf,
+		0x54, 0xb7, 0x34, 0xd3, 0xd2, 0x9c, 0x5d, 0x7a, 0xf1, 0x24, 0xae, 0xa4, 0x45, 0xc5, 0x1a, 0x87,
+		0xcb, 0x1a, 0x8c, 0xae, 0xd3, 0xb0, 0xc0, 0x93, 0xfb, 0x51, 0x4f, 0x3a, 0xa9, 0x93, 0x74, 0x2d,
+		0xe5, 0x8a, 0x35, 0xc9, 0x55, 0x58, 0x68, 0x69, 0x85, 0x67, 0xba, 0xb5, 0xc2, 0xe0, 0x3a, 0xf5,
+		0x9b, 0x93, 0x90, 0x0d, 0x56, 0x05, 0x5c, 0x51, 0x77, 0x06, 0xd8, 0x29, 0xfa, 0xcd, 0xb6, 0x5b,
+		0x0a, 0xb3, 0x6d, 0x9d, 0x61, 0xb6, 0xc3, 0x14, 0x91, 0x2f, 0xc0, 0x30, 0xb9, 0x2b, 0xb6, 0x8e,
+		0x9d, 0x2b, 0x58, 0xad, 0x60, 0x2b, 0xb8, 0x52, 0x0e, 0x8b, 0x95, 0x12, 0x41, 0x82, 0x2e, 0x87,
+		0x6c, 0xad, 0xa0, 0xff, 0xcb, 0xdb, 0x90, 0x20, 0xa4, 0xde, 0x2a, 0xca, 0x29, 0x68, 0x81, 0x40,
+		0xb7, 0x76, 0x1d, 0x6c, 0x8b, 0xac, 0x8b, 0x16, 0xd0, 0x59, 0xb1, 0x16, 0xc6, 0xdb, 0xad, 0x85,
+		0xdc, 0xe0, 0xf8, 0x8a, 0xf8, 0x02, 0x0c, 0x14, 0x88, 0x3b, 0x5d, 0x9c, 0x77, 0xc5, 0x90, 0x3c,
+		0x31, 0xd0, 0xb3, 0x30, 0x5a, 0x57, 0x2d, 0x87, 0xde, 0xae, 0xdf, 0xa1, 0x7d, 0x70, 0x3f, 0xf5,
+		0x0b, 0xcd, 0xae, 0x40, 0x47, 0x79, 0x1b, 0xc3, 0x75, 0x3f, 0x50, 0x7e, 0x2d, 0x01, 0xfd, 0x5c,
+		0x11, 0x17, 0x61, 0x80, 0x2b, 0x94, 0xdb, 0xe0, 0xa1, 0xe9, 0xf0, 0xc2, 0x32, 0xed, 0x2e, 0x00,
+		0x9c, 0x9b, 0xa0, 0x40, 0x27, 0x20, 0x59, 0xde, 0x51, 0x35, 0xa3, 0xa4, 0x55, 0x44, 0x2c, 0xf6,
+		0xda, 0xed, 0xdc, 0xc0, 0x1c, 0x81, 0x2d, 0xce, 0x2b, 0x03, 0xb4, 0x72, 0xb1, 0x42, 0x56, 0xee,
+		0x1d, 0xac, 0x55, 0x77, 0x1c, 0x3e, 0x8b, 0x78, 0x89, 0x3c, 0x41, 0x41, 0x8c, 0x80, 0x7f, 0x77,
+		0x95, 0x6d, 0x8a, 0x85, 0xdd, 0x84, 0xa4, 0x90, 0x24, 0x0d, 0xbf, 0xf7, 0x6b, 0x39, 0x49, 0xa1,
+		0x14, 0x68, 0x16, 0x86, 0x75, 0xd5, 0x76, 0x4a, 0x74, 0x05, 0x22, 0xcd, 0xf7, 0x51, 0x16, 0x07,
+		0xc3, 0xca, 0xe0, 0x2a, 0xe5, 0x82, 0x0f, 0x12, 0x1a, 0x06, 0xaa, 0x90, 0x8f, 0x42, 0x28, 0x0b,
+		0x72, 0x35, 0x4e, 0x73, 0x58, 0x1c, 0xd4, 0x4f, 0x35, 0x3e, 0x42, 0xe0, 0x73, 0x14, 0x4c, 0xa3,
+		0xa1, 0x43, 0x90, 0xa2, 0xdf, 0x79, 0x50, 0x14, 0x76, 0xa7, 0x31, 0x49, 0x00, 0xb4, 0xf2, 0x24,
+		0x8c, 0x7a, 0xde, 0x8f, 0xa1, 0x24, 0x19, 0x17, 0x0f, 0x4c, 0x11, 0x1f, 0x86, 0x09, 0x03, 0xdf,
+		0x74, 0x4a, 0x1e, 0x98, 0x61, 0xa7, 0x28, 0x36, 0x22, 0x75, 0x57, 0x83, 0x14, 0xf7, 0xc3, 0x48,
+		0x59, 0xa8, 0x9e, 0xe1, 0x02, 0xc5, 0x1d, 0x76, 0xa1, 0x14, 0x6d, 0x12, 0x92, 0x6a, 0xbd, 0xce,
+		0x10, 0x06, 0xb9, 0xff, 0xab, 0xd7, 0x69, 0xd5, 0x69, 0x18, 0xa3, 0x7d, 0xb4, 0xb0, 0xdd, 0xd0,
+		0x1d, 0xce, 0x64, 0x88, 0xe2, 0x8c, 0x92, 0x0a, 0x85, 0xc1, 0x29, 0xee, 0x71, 0x18, 0xc6, 0xd7,
+		0xb5, 0x0a, 0x36, 0xca, 0x98, 0xe1, 0x0d, 0x53, 0xbc, 0x21, 0x01, 0xa4, 0x48, 0x0f, 0x80, 0xeb,
+		0xd9, 0x4a, 0xc2, 0xe7, 0x8e, 0x30, 0x7e, 0x02, 0x3e, 0xcb, 0xc0, 0x72, 0x06, 0x12, 0xf3, 0xaa,
+		0xa3, 0x92, 0xf0, 0xc0, 0xb9, 0xc9, 0x16, 0x91, 0x21, 0x85, 0xfc, 0x2b, 0x7f, 0x23, 0x06, 0x89,
+		0xab, 0xa6, 0x83, 0xd1, 0x23, 0xbe, 0x70, 0x6d, 0xa4, 0xd9, 0x92, 0xd7, 0xb5, 0xaa, 0x81, 0x2b,
+		0xcb, 0x76, 0xd5, 0xf7, 0x49, 0xb6, 0x67, 0x4a, 0xb1, 0x80, 0x29, 0x4d, 0x40, 0x9f, 0x65, 0x36,
+		0x8c, 0x8a, 0xb8, 0x0c, 0x48, 0x0b, 0x68, 0x0e, 0x92, 0xae, 0x85, 0x24, 0xda, 0x5b, 0xc8, 0x28,
+		0xb1, 0x10, 0x62, 0xbd, 0x1c, 0xa0, 0x0c, 0x6c, 0x71, 0x43, 0x29, 0x40, 0xca, 0x75, 0x55, 0x99,
+		0xbe, 0x1e, 0x4c, 0xd5, 0x23, 0x23, 0x4b, 0x85, 0x3b, 0xee, 0xae, 0xe2, 0x98, 0xb5, 0xa5, 0xdd,
+		0x0a, 0xae, 0xb9, 0x80, 0x49, 0xf1, 0x8f, 0xc3, 0x07, 0x68, 0xaf, 0x3c, 0x93, 0x62, 0x1f, 0x88,
+		0x1f, 0x26, 0xf7, 0x38, 0xaa, 0x86, 0xea, 0x34, 0x2c, 0xcc, 0xad, 0xce, 0x03, 0xc8, 0x9f, 0x93,
+		0xa0, 0x9f, 0x59, 0xb1, 0x4f, 0x6b, 0x52, 0xb4, 0xd6, 0x62, 0xad, 0xb4, 0x16, 0xdf, 0xab, 0xd6,
+		0x2e, 0x01, 0xb8, 0xa2, 0xd8, 0xfc, 0x8b, 0xdd, 0xa6, 0x48, 0x80, 0x89, 0xb7, 0xae, 0x55, 0xf9,
+		0x04, 0xf5, 0x91, 0xc8, 0x7f, 0x26, 0x41, 0xca, 0xad, 0x47, 0x97, 0x60, 0x58, 0xc8, 0x54, 0xda,
+		0xd6, 0xd5, 0x2a, 0xb7, 0x99, 0x43, 0x2d, 0x04, 0xbb, 0xac, 0xab, 0x55, 0x65, 0x90, 0xcb, 0x42,
+		0x0a, 0xd1, 0x23, 0x10, 0x6b, 0x31, 0x02, 0x81, 0x21, 0x8f, 0xef, 0x6d, 0xc8, 0x03, 0x83, 0x93,
+		0x08, 0x0f, 0xce, 0xaf, 0xc7, 0x68, 0xc2, 0x51, 0x37, 0x6d, 0x55, 0xbf, 0xf7, 0xf3, 0xe0, 0x10,
+		0xa4, 0xea, 0xa6, 0x5e, 0x62, 0x35, 0xec, 0x6a, 0x6c, 0xb2, 0x6e, 0xea, 0x4a, 0xd3, 0x70, 0xf7,
+		0xdd, 0x95, 0x49, 0xd2, 0x7f, 0x17, 0x34, 0x36, 0x10, 0xd6, 0x98, 0x01, 0x43, 0x4c, 0x11, 0x7c,
+		0xe5, 0x9a, 0x26, 0x1a, 0x20, 0xff, 0x65, 0xa4, 0xf0, 0x2a, 0xcb, 0x84, 0x66, 0x78, 0x4a, 0xff,
+		0x8e, 0x8b, 0xcf, 0x5c, 0x7d, 0x26, 0x16, 0x8d, 0xcf, 0x8c, 0x4d, 0xe1, 0x58, 0xf2, 0xcf, 0x48,
+		0x00, 0x4b, 0x44, 0xa7, 0xb4, 0xaf, 0x64, 0xc5, 0xb1, 0x69, 0xf3, 0xa5, 0x40, 0xab, 0x87, 0xa3,
+		0x07, 0x8b, 0xb7, 0x3d, 0x64, 0xfb, 0x25, 0x9e, 0x85, 0x61, 0xcf, 0x04, 0x6d, 0x2c, 0x04, 0x39,
+		0xdc, 0x32, 0x3e, 0x5e, 0xc7, 0x8e, 0x32, 0x74, 0xdd, 0x57, 0x92, 0x7f, 0x47, 0x82, 0x14, 0x95,
+		0x87, 0x7c, 0x5f, 0x18, 0x18, 0x39, 0x69, 0xaf, 0x23, 0x77, 0x04, 0x80, 0x31, 0x21, 0x27, 0x58,
+		0xdc, 0x9a, 0x52, 0x14, 0x42, 0xce, 0xa5, 0xd0, 0x39, 0x57, 0xcd, 0xf1, 0x76, 0x6a, 0x16, 0xd1,
+		0x33, 0x57, 0xf6, 0x41, 0x18, 0xa0, 0xaf, 0xd9, 0xdc, 0xb4, 0x79, 0x40, 0x4c, 0x3e, 0x61, 0xdf,
+		0xb8, 0x69, 0xcb, 0x3b, 0x30, 0xb0, 0x71, 0x93, 0xed, 0x56, 0x1c, 0x82, 0x94, 0x65, 0x9a, 0x7c,
+		0xe5, 0x65, 0xb1, 0x4e, 0x92, 0x00, 0xe8, 0x42, 0x23, 0x72, 0xf4, 0x98, 0x97, 0xa3, 0x7b, 0x5b,
+		0x0c, 0xf1, 0x2e, 0xb6, 0x18, 0x4e, 0xff, 0x37, 0x09, 0x06, 0x7d, 0xde, 0x00, 0x3d, 0x02, 0xfb,
+		0x0b, 0x4b, 0xab, 0x73, 0xcf, 0x96, 0x16, 0xe7, 0x4b, 0x97, 0x97, 0x66, 0x17, 0xbc, 0x8f, 0x3d,
+		0xb2, 0x07, 0x5e, 0xbd, 0x35, 0x85, 0x7c, 0xb8, 0x9b, 0xc6, 0x35, 0xb2, 0x5b, 0x89, 0x66, 0x60,
+		0x22, 0x48, 0x32, 0x5b, 0x58, 0x27, 0x5f, 0x7e, 0x48, 0xd9, 0xfd, 0xaf, 0xde, 0x9a, 0x1a, 0xf3,
+		0x51, 0xcc, 0x6e, 0xd9, 0xd8, 0x70, 0x9a, 0x09, 0xe6, 0x56, 0x97, 0x97, 0x17, 0x37, 0xd2, 0xb1,
+		0x26, 0x02, 0xee, 0x98, 0x1f, 0x80, 0xb1, 0x20, 0xc1, 0xca, 0xe2, 0x52, 0x3a, 0x9e, 0x45, 0xaf,
+		0xde, 0x9a, 0x1a, 0xf1, 0x61, 0xaf, 0x68, 0x7a, 0x36, 0xf9, 0xae, 0x5f, 0x3a, 0xba, 0xef, 0x57,
+		0x7f, 0xf9, 0xa8, 0x44, 0x7a, 0x36, 0x1c, 0xf0, 0x09, 0xe8, 0x21, 0x38, 0xb8, 0xbe, 0xb8, 0xb0,
+		0x52, 0x9c, 0x2f, 0x2d, 0xaf, 0x2f, 0x94, 0xd8, 0x23, 0x17, 0x6e, 0xef, 0x46, 0x5f, 0xbd, 0x35,
+		0x35, 0xc8, 0xbb, 0xd4, 0x0a, 0x7b, 0x4d, 0x29, 0x5e, 0x5d, 0xdd, 0x28, 0xa6, 0x25, 0x86, 0xbd,
+		0x66, 0xe1, 0xeb, 0xa6, 0xc3, 0x1e, 0xbb, 0x7a, 0x18, 0x26, 0x23, 0xb0, 0xdd, 0x8e, 0x8d, 0xbd,
+		0x7a, 0x6b, 0x6a, 0x78, 0x8d, 0x9c, 0x0b, 0x93, 0x0e, 0x51, 0x8a, 0x69, 0xc8, 0x34, 0x53, 0xac,
+		0xae, 0xad, 0xae, 0xcf, 0x2e, 0xa5, 0xa7, 0xb2, 0xe9, 0x57, 0x6f, 0x4d, 0x0d, 0x09, 0xd7, 0x47,
+		0xf0, 0xbd, 0x9e, 0xdd, 0xfd, 0xcc, 0xe5, 0xd6, 0x59, 0x38, 0x46, 0x76, 0x37, 0x6d, 0x47, 0xbd,
+		0xa6, 0x19, 0x55, 0x77, 0x83, 0x93, 0x97, 0x79, 0x02, 0x33, 0x4e, 0xf6, 0x38, 0x05, 0xa8, 0xed,
+		0x36, 0x67, 0xb6, 0xf5, 0x51, 0x4e, 0xb6, 0xc3, 0x69, 0x47, 0xe7, 0x04, 0xa8, 0xf5, 0x66, 0x78,
+		0xb6, 0xdd, 0x16, 0x6d, 0xb6, 0x4d, 0x6e, 0x26, 0xff, 0xa4, 0x04, 0x23, 0x57, 0x34, 0xdb, 0x31,
+		0x2d, 0xad, 0xac, 0xea, 0xf4, 0x6b, 0x8e, 0x73, 0xdd, 0x39, 0xce, 0xd0, 0x8c, 0x7e, 0x12, 0xfa,
+		0xaf, 0xab, 0x3a, 0xf3, 0x5a, 0x71, 0xfa, 0x4a, 0x42, 0x84, 0xd2, 0x3c, 0xd7, 0x25, 0xa8, 0x19,
+		0x8d, 0xfc, 0x91, 0x18, 0x8c, 0x52, 0x93, 0xb7, 0xd9, 0x8b, 0x44, 0x24, 0x4b, 0x7a, 0x0a, 0x12,
+		0x96, 0xea, 0xf0, 0xcd, 0xba, 0xc2, 0x03, 0x7c, 0x9b, 0xf5, 0x58, 0xdb, 0xad, 0xd3, 0x69, 0xb2,
+		0x09, 0x4b, 0xc9, 0xd0, 0x1b, 0x20, 0x59, 0x53, 0x6f, 0x96, 0x28, 0x0b, 0x96, 0x7c, 0x3c, 0xd9,
+		0x35, 0x8b, 0x3b, 0xb7, 0x73, 0xa3, 0xbb, 0x6a, 0x4d, 0xcf, 0xcb, 0x82, 0x85, 0xac, 0x0c, 0xd4,
+		0xd4, 0x9b, 0x44, 0x30, 0x74, 0x0d, 0x46, 0x09, 0xb4, 0xbc, 0xa3, 0x1a, 0x55, 0xcc, 0xf8, 0xd3,
+		0x0d, 0xc7, 0xc2, 0x5c, 0x2f, 0xfc, 0x0f, 0x78, 0xfc, 0x7d, 0x9c, 0x64, 0x65, 0xb8, 0xa6, 0xde,
+		0x9c, 0xa3, 0x00, 0xd2, 0x58, 0x3e, 0xf9, 0x81, 0x8f, 0xe4, 0xf6, 0xd1, 0xbd, 0xea, 0x3f, 0x92,
+		0x00, 0x3c, 0x15, 0xa1, 0x37, 0x42, 0xba, 0xec, 0x96, 0x28, 0xad, 0xcd, 0xc7, 0xeb, 0xbe, 0x48,
+		0xcd, 0x87, 0xb4, 0xcb, 0x56, 0xd8, 0x2f, 0xdf, 0xce, 0x49, 0xca, 0x68, 0x39, 0xa4, 0xf8, 0x37,
+		0xc1, 0x60, 0xa3, 0x5e, 0x51, 0x1d, 0x5c, 0xa2, 0xd9, 0x57, 0xac, 0xe3, 0x6a, 0x7d, 0x94, 0xf0,
+		0xba, 0x73, 0x3b, 0x87, 0x58, 0x9f, 0x7c, 0xc4, 0x32, 0x5d, 0xc3, 0x81, 0x41, 0x08, 0x81, 0xaf,
+		0x43, 0x7f, 0x28, 0xc1, 0xe0, 0xbc, 0xef, 0xfa, 0x54, 0x06, 0x06, 0x6a, 0xa6, 0xa1, 0x5d, 0xe3,
+		0x86, 0x97, 0x52, 0x44, 0x91, 0x6c, 0x40, 0xb2, 0x2f, 0xd9, 0x9c, 0x5d, 0xb1, 0x01, 0x29, 0xca,
+		0x84, 0xea, 0x06, 0xde, 0xb2, 0x35, 0x31, 0x0a, 0x8a, 0x28, 0xa2, 0xcb, 0xe4, 0x31, 0x8d, 0x72,
+		0x83, 0xec, 0xae, 0x94, 0xca, 0xa6, 0xe1, 0xa8, 0x65, 0x87, 0x7d, 0x13, 0x55, 0x38, 0x74, 0xe7,
+		0x76, 0xee, 0x20, 0x93, 0x35, 0x8c, 0x21, 0x2b, 0xa3, 0x02, 0x34, 0xc7, 0x20, 0xa4, 0x85, 0x0a,
+		0x76, 0x54, 0x4d, 0xb7, 0x33, 0xec, 0xb4, 0x45, 0x14, 0x7d, 0x7d, 0x79, 0xdf, 0x80, 0x7f, 0xc3,
+		0xe9, 0x32, 0xa4, 0xcd, 0x3a, 0xb6, 0x02, 0xa1, 0xa4, 0x14, 0x6e, 0x39, 0x8c, 0x21, 0x2b, 0xa3,
+		0x02, 0x24, 0xc2, 0xcc, 0x17, 0x21, 0xed, 0xa6, 0x72, 0xa5, 0x7a, 0x63, 0xcb, 0xdb, 0xa7, 0x9a,
+		0x68, 0x1a, 0x8d, 0x59, 0x63, 0xb7, 0xf0, 0xb0, 0xc7, 0x3d, 0x4c, 0x27, 0x7f, 0xf1, 0xd3, 0x67,
+		0xc6, 0x88, 0x5d, 0x78, 0x1b, 0x47, 0x64, 0xd7, 0x68, 0xd4, 0xc5, 0x5b, 0xa3, 0x68, 0x24, 0x6e,
+		0x7c, 0x41, 0xd5, 0x74, 0xf1, 0x61, 0xaf, 0xc2, 0x4b, 0xe8, 0x71, 0xe8, 0xb7, 0x1d, 0xd5, 0x69,
+		0xd8, 0xfc, 0xb9, 0xad, 0x5c, 0xa4, 0x91, 0x15, 0x4c, 0xa3, 0xb2, 0x4e, 0xd1, 0x14, 0x8e, 0x8e,
+		0x66, 0xa1, 0xdf, 0x31, 0xaf, 0x61, 0x83, 0x2b, 0xaf, 0xdb, 0x79, 0x4c, 0xcf, 0x7d, 0x18, 0x21,
+		0x32, 0x21, 0x5d, 0xc1, 0x3a, 0xae, 0xb2, 0xb8, 0x68, 0x47, 0x25, 0x09, 0x03, 0x7d, 0x6d, 0xab,
+		0x30, 0xdf, 0xcb, 0x8c, 0xe3, 0x9a, 0x09, 0xb3, 0x92, 0x95, 0x51, 0x17, 0xb4, 0x4e, 0x21, 0xe8,
+		0x4a, 0xe0, 0x5e, 0x1f, 0x7f, 0x8d, 0x6e, 0x2a, 0xb2, 0xc7, 0x3e, 0x03, 0x16, 0x9b, 0x08, 0x3e,
+		0x52, 0x62, 0x09, 0x0d, 0x63, 0xcb, 0x34, 0xe8, 0xd7, 0x76, 0x3c, 0x20, 0x27, 0x89, 0x58, 0xdc,
+		0x6f, 0x09, 0x61, 0x0c, 0x59, 0x19, 0x75, 0x41, 0x57, 0x28, 0x04, 0x55, 0x60, 0xc4, 0xc3, 0xa2,
+		0xb3, 0x32, 0xd5, 0x71, 0x56, 0x1e, 0xe3, 0xb3, 0x72, 0x7f, 0xb8, 0x15, 0x6f, 0x62, 0x0e, 0xbb,
+		0x40, 0x42, 0x86, 0x8a, 0x00, 0x9e, 0x2f, 0xc8, 0x00, 0x7f, 0x96, 0xa7, 0xbd, 0x37, 0x11, 0x99,
+		0x99, 0x47, 0x88, 0x6e, 0xc0, 0x78, 0x4d, 0x33, 0x4a, 0x36, 0xd6, 0xb7, 0x4b, 0x5c, 0xb5, 0x84,
+		0x1f, 0x7d, 0x29, 0xa5, 0xb0, 0xd0, 0xf5, 0xf8, 0xdf, 0xb9, 0x9d, 0xcb, 0x72, 0x27, 0xd9, 0xcc,
+		0x4d, 0x56, 0xc6, 0x6a, 0x9a, 0xb1, 0x8e, 0xf5, 0xed, 0x79, 0x17, 0x96, 0x1f, 0x7a, 0xd7, 0x47,
+		0x72, 0xfb, 0xf8, 0x9c, 0xdc, 0x27, 0x3f, 0x46, 0x37, 0xad, 0xf9, 0x5c, 0xc2, 0x36, 0x49, 0x1f,
+		0x54, 0x51, 0xa0, 0xdb, 0x0d, 0x29, 0xc5, 0x03, 0xb0, 0xb9, 0xfc, 0xca, 0x9f, 0x4f, 0x49, 0xf2,
+		0xaf, 0x49, 0xd0, 0x3f, 0x7f, 0x75, 0x4d, 0xd5, 0x2c, 0xb4, 0x08, 0x63, 0x9e, 0xb9, 0x04, 0x67,
+		0xf2, 0xe1, 0x3b, 0xb7, 0x73, 0x99, 0xb0, 0x45, 0xb9, 0x53, 0xd9, 0x33, 0x58, 0x31, 0x97, 0x17,
+		0x5b, 0xe5, 0x97, 0x01, 0x56, 0x4d, 0x28, 0x72, 0x73, 0xf6, 0x19, 0xea, 0x66, 0x01, 0x06, 0x98,
+		0xb4, 0xe4, 0x45, 0x97, 0xbe, 0x3a, 0xf9, 0x87, 0xef, 0xc8, 0x1f, 0x8a, 0xb6, 0x58, 0x8a, 0xec,
+		0x6e, 0x2e, 0x12, 0x7c, 0xf9, 0x7d, 0x31, 0x80, 0xf9, 0xab, 0x57, 0x37, 0x2c, 0xad, 0xae, 0x63,
+		0xe7, 0x6e, 0x76, 0x7b, 0x03, 0xf6, 0x7b, 0x7d, 0xb2, 0xad, 0x72, 0xa8, 0xeb, 0x53, 0x77, 0x6e,
+		0xe7, 0x0e, 0x87, 0xbb, 0xee, 0x43, 0x93, 0x95, 0x71, 0x2f, 0xbf, 0xb1, 0xca, 0x91, 0x5c, 0x2b,
+		0xb6, 0xe3, 0x72, 0x8d, 0xb7, 0xe6, 0xea, 0x43, 0xf3, 0x73, 0x9d, 0xb7, 0x9d, 0x68, 0xbd, 0xae,
+		0xc1, 0xa0, 0xa7, 0x12, 0xe2, 0xc7, 0x92, 0x0e, 0xff, 0x9f, 0xab, 0x37, 0xd7, 0x42, 0xbd, 0x82,
+		0x86, 0xab, 0xd8, 0x25, 0x93, 0xff, 0x46, 0x02, 0xf0, 0xac, 0xf5, 0xc7, 0xd3, 0xb8, 0x88, 0xbf,
+		0xe6, 0x2e, 0x36, 0xde, 0x6b, 0xdc, 0xc5, 0x09, 0x43, 0x7a, 0x7c, 0x57, 0x8c, 0x7c, 0xf9, 0xce,
+		0xdd, 0xcc, 0x8f, 0x7d, 0xf7, 0x97, 0x61, 0x00, 0x1b, 0x8e, 0xa5, 0xd1, 0xfe, 0xb3, 0xef, 0x98,
+		0xa3, 0x46, 0x39, 0xa2, 0x43, 0xf4, 0x61, 0x18, 0xb1, 0x03, 0xce, 0x79, 0x84, 0x54, 0xf1, 0x8e,
+		0x38, 0x64, 0x5a, 0x51, 0xa2, 0x39, 0x18, 0x2d, 0x5b, 0x98, 0x02, 0x4a, 0xfe, 0xcd, 0xb8, 0x42,
+		0xd6, 0x8b, 0x16, 0x43, 0x08, 0xb2, 0x32, 0x22, 0x20, 0x7c, 0x9d, 0xa8, 0x02, 0x89, 0xe6, 0x88,
+		0xb9, 0x11, 0xac, 0x2e, 0xc3, 0x37, 0x99, 0x2f, 0x14, 0xa2, 0x91, 0x20, 0x03, 0xb6, 0x52, 0x8c,
+		0x78, 0x50, 0xba, 0x54, 0xe8, 0x30, 0xaa, 0x19, 0x9a, 0xa3, 0xa9, 0x7a, 0x69, 0x4b, 0xd5, 0x55,
+		0xa3, 0xdc, 0x63, 0x10, 0xcc, 0xfc, 0x3b, 0x6f, 0x31, 0xc4, 0x49, 0x56, 0x46, 0x38, 0xa4, 0xc0,
+		0x00, 0x68, 0x0e, 0x06, 0x44, 0x2b, 0x89, 0x5e, 0xa3, 0x08, 0x41, 0xe9, 0x8b, 0xd6, 0xde, 0x1e,
+		0x87, 0x31, 0x05, 0x57, 0xfe, 0xff, 0x00, 0x74, 0x3d, 0x00, 0x57, 0x00, 0xd8, 0xe4, 0x26, 0x6e,
+		0x34, 0x93, 0xe8, 0xd5, 0x33, 0xa4, 0x18, 0xf1, 0xbc, 0xed, 0xf8, 0x46, 0xe1, 0xcf, 0x62, 0x30,
+		0xe4, 0x1f, 0x85, 0x7f, 0xa0, 0xcb, 0x0e, 0xba, 0xec, 0xb9, 0x9c, 0x04, 0x7f, 0x4a, 0x31, 0xca,
+		0xe5, 0x34, 0x19, 0x6c, 0x7b, 0x5f, 0xf3, 0x53, 0x03, 0xd0, 0xbf, 0xa6, 0x5a, 0x6a, 0xcd, 0x46,
+		0xe5, 0xa6, 0xe0, 0x51, 0xe2, 0x07, 0xef, 0x4d, 0xaf, 0x23, 0xf3, 0x1d, 0x89, 0x0e, 0xb1, 0xe3,
+		0x07, 0x22, 0x62, 0xc7, 0xd7, 0xc1, 0x08, 0xc9, 0x65, 0x7d, 0xd7, 0x02, 0x88, 0xaa, 0x87, 0x0b,
+		0x93, 0x1e, 0x97, 0x60, 0x3d, 0x4b, 0x75, 0xbd, 0x23, 0x2d, 0xf4, 0x38, 0x0c, 0x12, 0x0c, 0xcf,
+		0xfd, 0x12, 0xf2, 0x03, 0x5e, 0x5a, 0xe9, 0xab, 0x94, 0x15, 0xa8, 0xa9, 0x37, 0x8b, 0xac, 0x80,
+		0x96, 0x00, 0xed, 0xb8, 0x5b, 0x18, 0x25, 0x4f, 0x97, 0x84, 0xfe, 0xc8, 0x9d, 0xdb, 0xb9, 0x49,
+		0x46, 0xdf, 0x8c, 0x23, 0x2b, 0x63, 0x1e, 0x50, 0x70, 0x3b, 0x07, 0x40, 0xfa, 0x55, 0x62, 0x37,
+		0xcb, 0x58, 0xd2, 0xb2, 0xff, 0xce, 0xed, 0xdc, 0x18, 0xe3, 0xe2, 0xd5, 0xc9, 0x4a, 0x8a, 0x14,
+		0xe6, 0xc9, 0xff, 0xe8, 0x5d, 0xe4, 0x16, 0xaf, 0x6e, 0x6e, 0xa9, 0x7a, 0x49, 0xd7, 0x5e, 0x6c,
+		0x68, 0x95, 0x12, 0x1f, 0xbf, 0x52, 0x59, 0xad, 0xf3, 0x6c, 0x65, 0xb9, 0x97, 0x6c, 0x65, 0x8a,
+		0x35, 0xd7, 0x92, 0xa7, 0xac, 0x1c, 0x60, 0x75, 0x4b, 0xb4, 0x6a, 0x9d, 0xd5, 0xcc, 0xa9, 0x75,
+		0xf4, 0x3e, 0x09, 0x0e, 0x7b, 0xf6, 0x17, 0x21, 0x0d, 0x7d, 0x6c, 0xb8, 0xb0, 0xd6, 0x8b, 0x34,
+		0xc7, 0xc3, 0x66, 0x1d, 0x25, 0xd0, 0xa4, 0x5b, 0xdd, 0x24, 0x13, 0x4f, 0x09, 0x42, 0x3b, 0x16,
+		0x99, 0x64, 0x2f, 0x29, 0x01, 0x93, 0xc4, 0x97, 0x12, 0x84, 0xb8, 0xb1, 0x94, 0x20, 0xb8, 0xcf,
+		0xd1, 0x2a, 0x17, 0x49, 0xdd, 0xf3, 0x5c, 0xc4, 0xf3, 0x73, 0xb7, 0x24, 0x40, 0x5e, 0x85, 0x82,
+		0xed, 0x3a, 0xc9, 0xb9, 0x49, 0xb2, 0xe5, 0x13, 0x48, 0x6a, 0x93, 0x6c, 0x79, 0xc4, 0x22, 0xd9,
+		0xf2, 0x08, 0xc9, 0xab, 0xb9, 0xc2, 0xff, 0x8b, 0x73, 0x8b, 0xf0, 0x8d, 0xcc, 0x69, 0x72, 0x59,
+		0x52, 0x78, 0x8b, 0xf0, 0x6a, 0xb8, 0x4f, 0xfe, 0x3d, 0x09, 0x26, 0x9b, 0x9c, 0x8b, 0x2b, 0xe6,
+		0x9b, 0x00, 0x59, 0xbe, 0x4a, 0xfe, 0x1e, 0x9e, 0xc4, 0xdf, 0x2b, 0xef, 0xc5, 0x51, 0x8d, 0x59,
+		0x11, 0x4b, 0xee, 0x5d, 0x58, 0xd7, 0xd9, 0x55, 0xce, 0xcf, 0x4a, 0x30, 0xe1, 0x6f, 0xd9, 0xed,
+		0xc0, 0xb3, 0x30, 0xe4, 0x6f, 0x98, 0x8b, 0x7e, 0xac, 0xa3, 0xe8, 0x5c, 0xea, 0x00, 0x31, 0x5a,
+		0xf1, 0x7c, 0x75, 0x8c, 0xbf, 0x30, 0xdf, 0x95, 0x0a, 0x84, 0x34, 0x61, 0x9f, 0x9d, 0xa0, 0x23,
+		0xf0, 0x3d, 0x09, 0x12, 0x6b, 0xa6, 0xa9, 0xa3, 0x17, 0x60, 0xcc, 0x30, 0x9d, 0x12, 0x71, 0x2b,
+		0xb8, 0x52, 0xe2, 0xfb, 0x26, 0x6c, 0x05, 0x7c, 0xba, 0x6b, 0xcd, 0x7c, 0xeb, 0x76, 0xae, 0x99,
+		0x8b, 0x32, 0x6a, 0x98, 0x4e, 0x81, 0x42, 0x36, 0x28, 0x00, 0xdd, 0x80, 0xe1, 0x60, 0x3b, 0x6c,
+		0x69, 0x54, 0x7a, 0x69, 0x27, 0xc8, 0xe1, 0xce, 0xed, 0xdc, 0x84, 0xe7, 0x24, 0x5d, 0xb0, 0xac,
+		0x0c, 0x6d, 0xf9, 0x1a, 0x66, 0xb7, 0xe2, 0xbe, 0x43, 0xc6, 0xec, 0x17, 0x24, 0x18, 0xa7, 0x40,
+		0xed, 0x25, 0x4c, 0xb7, 0x5e, 0x14, 0x5c, 0x36, 0xad, 0x0a, 0x1a, 0x81, 0x18, 0x3f, 0xb1, 0x4a,
+		0x28, 0x31, 0xad, 0x42, 0x0e, 0x2d, 0xcd, 0x1b, 0x06, 0xbf, 0xd2, 0x92, 0x52, 0x58, 0x81, 0xae,
+		0x38, 0x66, 0xa5, 0xa1, 0x63, 0xf2, 0x0a, 0x24, 0xbd, 0xef, 0xcb, 0x96, 0x61, 0xff, 0x8a, 0x13,
+		0xa8, 0x27, 0x2b, 0x0e, 0x05, 0xcc, 0xb2, 0x32, 0xd9, 0x11, 0x70, 0x5d, 0x16, 0x7f, 0x2e, 0xc9,
+		0x03, 0x9c, 0xfe, 0x8c, 0x04, 0xe0, 0x6d, 0x68, 0x91, 0x53, 0x91, 0xc2, 0xea, 0xca, 0x7c, 0x69,
+		0x7d, 0x63, 0x76, 0x63, 0x73, 0xbd, 0xb4, 0xb9, 0xb2, 0xbe, 0x56, 0x9c, 0x5b, 0xbc, 0xbc, 0x58,
+		0x9c, 0xf7, 0xce, 0x50, 0xec, 0x3a, 0x2e, 0x6b, 0xdb, 0x1a, 0xae, 0xa0, 0x13, 0x30, 0x11, 0xc4,
+		0x26, 0x25, 0xf2, 0x9e, 0x66, 0x76, 0xe8, 0xd5, 0x5b, 0x53, 0x49, 0x96, 0x05, 0x60, 0x72, 0xcb,
+		0x64, 0x7f, 0x33, 0x1e, 0x79, 0x2d, 0x30, 0x96, 0x1d, 0x7e, 0xf5, 0xd6, 0x54, 0xca, 0x4d, 0x17,
+		0x90, 0x0c, 0xc8, 0x8f, 0xc9, 0xf9, 0xc5, 0xb3, 0xf0, 0xea, 0xad, 0xa9, 0x7e, 0x36, 0xb2, 0xd9,
+		0x04, 0x39, 0x29, 0x29, 0xcc, 0xb6, 0x3c, 0x25, 0x39, 0xd9, 0x6a, 0x50, 0x6f, 0xba, 0x27, 0x20,
+		0xc1, 0xf3, 0x91, 0xcf, 0xa7, 0xa2, 0xcf, 0x47, 0xaa, 0xd8, 0xc0, 0xb6, 0x66, 0xf7, 0x7e, 0x3e,
+		0xd2, 0xf9, 0xb4, 0x45, 0xfe, 0xd4, 0x00, 0x0c, 0x2d, 0x30, 0xfe, 0x44, 0xf5, 0x18, 0x5d, 0x20,
+		0xef, 0x54, 0x92, 0x48, 0xc6, 0xbd, 0x82, 0x14, 0x35, 0xe7, 0x58, 0xb0, 0xe3, 0xde, 0xd2, 0xa3,
+		0x25, 0x64, 0xf0, 0x4b, 0x3c, 0xec, 0xee, 0xa0, 0x77, 0x23, 0x6e, 0xa8, 0x30, 0xdf, 0x8b, 0xef,
+		0xe7, 0x1b, 0x75, 0x61, 0x56, 0x32, 0xbb, 0x0a, 0xb4, 0x41, 0x20, 0xec, 0xca, 0xdf, 0x4f, 0x48,
+		0xb0, 0x9f, 0x62, 0x79, 0x2b, 0x25, 0xc5, 0x14, 0xd9, 0xe4, 0xc9, 0x48, 0xd1, 0x97, 0x54, 0xdb,
+		0xbb, 0xdb, 0x43, 0x19, 0x15, 0xee, 0xe3, 0x01, 0xd8, 0x61, 0x5f, 0xcb, 0x61, 0x9e, 0xb2, 0x32,
+		0xae, 0x37, 0x51, 0x92, 0x67, 0x97, 0xfd, 0x97, 0x33, 0x13, 0x3d, 0x9c, 0xc6, 0xf8, 0xe8, 0xd0,
+		0x02, 0x0c, 0x7a, 0x3e, 0xcc, 0xe6, 0xbf, 0x14, 0xd2, 0xe5, 0xfa, 0xe4, 0xa7, 0x44, 0x6f, 0x97,
+		0x60, 0xbf, 0x17, 0x3f, 0xfa, 0x79, 0xf6, 0xf3, 0x77, 0xd5, 0xba, 0x4c, 0xb0, 0xc3, 0x3a, 0x89,
+		0x64, 0x2a, 0x2b, 0x13, 0x2e, 0x7c, 0xde, 0x27, 0xc5, 0x32, 0x79, 0xc5, 0xdd, 0xdf, 0x38, 0x7b,
+		0x2e, 0xb0, 0xeb, 0x65, 0x20, 0x48, 0xcd, 0x7e, 0xdc, 0xa1, 0x6e, 0x5a, 0x0e, 0xae, 0x64, 0x92,
+		0xfc, 0xa9, 0x1b, 0x5e, 0x46, 0xef, 0x90, 0xe0, 0x80, 0xc3, 0xbd, 0x1a, 0xdb, 0x63, 0x2e, 0x59,
+		0xd4, 0xaf, 0xd9, 0x99, 0x54, 0x9b, 0x1e, 0x47, 0x38, 0xc2, 0xc2, 0xfd, 0xbc, 0xc7, 0x47, 0x58,
+		0x8f, 0xa3, 0xb9, 0xca, 0xca, 0x84, 0xd3, 0x4c, 0x6b, 0xa3, 0x17, 0xe0, 0x08, 0x37, 0xd8, 0x08,
+		0x2a, 0x72, 0x25, 0x80, 0x6c, 0xf0, 0x26, 0x0a, 0xa7, 0xee, 0xdc, 0xce, 0xdd, 0x17, 0xb0, 0xef,
+		0x68, 0x74, 0x59, 0x99, 0x64, 0xc6, 0xde, 0xd4, 0xd4, 0x62, 0x45, 0x5e, 0x01, 0xd4, 0x6c, 0xc4,
+		0xe1, 0x8b, 0xb7, 0x29, 0xef, 0xe2, 0xed, 0x04, 0xf4, 0xf9, 0xaf, 0xa7, 0xb2, 0x42, 0x3e, 0xf9,
+		0x2e, 0x1e, 0x93, 0xdc, 0x4d, 0x17, 0xf6, 0x8e, 0x53, 0x70, 0x38, 0xca, 0xe9, 0x38, 0x37, 0xdb,
+		0x79, 0xaf, 0x36, 0xe7, 0xb8, 0x1d, 0xcf, 0x69, 0x5b, 0x9c, 0x0c, 0xef, 0xf1, 0xf4, 0xb6, 0x0b,
+		0x8f, 0xf9, 0xb9, 0x04, 0xa0, 0x65, 0xbb, 0x3a, 0x67, 0x61, 0xf6, 0x3c, 0x22, 0x3f, 0x86, 0x0a,
+		0x1d, 0x63, 0x48, 0x7b, 0x3f, 0xc6, 0x78, 0x26, 0x70, 0x30, 0x10, 0xeb, 0xe1, 0x98, 0xb1, 0xeb,
+		0xd3, 0x81, 0xf8, 0xbd, 0x8e, 0xc8, 0xa3, 0xb7, 0x17, 0x12, 0x77, 0x6f, 0xc3, 0xb1, 0x6f, 0x4f,
+		0x1b, 0x8e, 0x73, 0xd0, 0xcf, 0x4f, 0xf6, 0xfa, 0xdb, 0x9c, 0xec, 0xed, 0x8f, 0x3e, 0xbe, 0xe3,
+		0xa4, 0xe4, 0x42, 0xb1, 0xf7, 0xa2, 0x66, 0xa7, 0x14, 0x80, 0xa1, 0x7a, 0x93, 0x4d, 0x3e, 0x0c,
+		0xd9, 0x66, 0xeb, 0x11, 0x11, 0xab, 0xfc, 0xce, 0x38, 0xa4, 0x97, 0xed, 0x6a, 0xb1, 0xa2, 0x39,
+		0xf7, 0xc2, 0xb4, 0x2e, 0xb5, 0xde, 0xbb, 0x45, 0x77, 0x6e, 0xe7, 0x46, 0x98, 0x2a, 0xdb, 0x28,
+		0x70, 0x07, 0x46, 0xc3, 0x69, 0x25, 0xb3, 0xa5, 0x4b, 0x3d, 0x1e, 0xc5, 0x37, 0xa5, 0x93, 0x23,
+		0xc1, 0x83, 0x71, 0x64, 0x47, 0x5b, 0x2e, 0x33, 0xa1, 0xb9, 0x7b, 0x95, 0x47, 0xba, 0xc3, 0x94,
+		0x85, 0x4c, 0x78, 0x1c, 0xdc, 0x41, 0xfa, 0xba, 0x04, 0x83, 0xcb, 0xb6, 0x58, 0xf1, 0xf0, 0x8f,
+		0xe9, 0xe6, 0xfa, 0x39, 0xf7, 0xcb, 0xbc, 0x78, 0x17, 0x76, 0xca, 0x71, 0x7d, 0x1a, 0xd8, 0x0f,
+		0xe3, 0xbe, 0x4e, 0xba, 0x9d, 0xff, 0x52, 0x8c, 0xba, 0xbf, 0x02, 0xae, 0x6a, 0x86, 0xbb, 0x74,
+		0xe3, 0x7f, 0xa8, 0xdb, 0x89, 0x9e, 0x92, 0x13, 0x7b, 0x52, 0xf2, 0x35, 0xc8, 0x36, 0x2b, 0xd3,
+		0xcd, 0xa6, 0x97, 0x9b, 0xf7, 0xb7, 0xa5, 0x1e, 0x6e, 0x73, 0x86, 0x76, 0xb1, 0xe5, 0xff, 0x21,
+		0xc1, 0xf0, 0xb2, 0x5d, 0xdd, 0x34, 0x2a, 0x7f, 0xbf, 0x2d, 0x77, 0x1b, 0xf6, 0x07, 0xba, 0x79,
+		0xaf, 0xf4, 0xf9, 0x1f, 0x62, 0x30, 0x46, 0x2e, 0xfd, 0xf9, 0x83, 0x34, 0xfb, 0xef, 0x93, 0x4e,
+		0xc9, 0xa4, 0x11, 0x11, 0x6a, 0x85, 0x87, 0xa8, 0x6c, 0x6f, 0x20, 0x11, 0x9e, 0x34, 0x91, 0x68,
+		0xb2, 0x32, 0xee, 0xc2, 0xa9, 0x76, 0x56, 0x09, 0xd4, 0x37, 0x52, 0xaf, 0x87, 0xc9, 0x26, 0x05,
+		0xba, 0xa3, 0xe5, 0x89, 0x2c, 0x75, 0x2f, 0xb2, 0xfc, 0x2b, 0x12, 0xf5, 0xdc, 0x64, 0x36, 0xe1,
+		0x1a, 0xe5, 0x6c, 0x5f, 0x36, 0xad, 0xbb, 0x3f, 0x36, 0xe7, 0x02, 0x1f, 0x3e, 0xf7, 0x6e, 0xa4,
+		0xcf, 0xc1, 0x54, 0x2b, 0x31, 0x7f, 0x40, 0x0d, 0x7c, 0x55, 0x82, 0xa3, 0x44, 0xab, 0x96, 0x6a,
+		0xd8, 0xdb, 0xd8, 0x8a, 0xda, 0xf3, 0x79, 0x33, 0x64, 0x5a, 0x26, 0x2a, 0x74, 0x27, 0xa8, 0x70,
+		0xfc, 0xce, 0xed, 0x5c, 0xae, 0x4d, 0x22, 0x44, 0x73, 0x94, 0xfd, 0x4e, 0x54, 0x7e, 0x42, 0x7f,
+		0x43, 0x14, 0x1b, 0x15, 0x77, 0x0b, 0x89, 0x97, 0xd0, 0x23, 0x90, 0x32, 0xf0, 0x0d, 0x6e, 0x41,
+		0xcc, 0xed, 0x4e, 0xdc, 0xb9, 0x9d, 0x4b, 0xb3, 0x66, 0xdc, 0x2a, 0x59, 0x49, 0x1a, 0xf8, 0x46,
+		0xd8, 0x54, 0x4e, 0xc1, 0x89, 0xf6, 0x9d, 0x72, 0x57, 0xa8, 0xdf, 0x88, 0xc1, 0x7e, 0x1f, 0xea,
+		0x8f, 0xfd, 0x29, 0xf8, 0x65, 0x48, 0x5b, 0xb8, 0x8c, 0xb5, 0xeb, 0xbe, 0xcf, 0x78, 0xe2, 0xe1,
+		0x0b, 0x6c, 0x61, 0x0c, 0x59, 0x19, 0x15, 0xa0, 0xbb, 0xb5, 0x16, 0xe5, 0xe0, 0x48, 0xa4, 0xda,
+		0x5c, 0xc5, 0x7e, 0x36, 0x06, 0x87, 0x49, 0xec, 0x4a, 0x36, 0x82, 0xf5, 0xbf, 0x3b, 0xb7, 0x0c,
+		0xf6, 0xe6, 0xfa, 0x22, 0x0e, 0xa8, 0x13, 0xbd, 0x1e, 0x50, 0xfb, 0x94, 0x7b, 0x02, 0xee, 0x6b,
+		0xa7, 0x3a, 0xa1, 0xe3, 0xb3, 0x7f, 0x9b, 0x84, 0xf8, 0xb2, 0x5d, 0x25, 0xf7, 0x5e, 0xc3, 0x19,
+		0x66, 0xf4, 0x76, 0x56, 0x73, 0x32, 0x91, 0x9d, 0xe9, 0x12, 0xd1, 0xf5, 0x33, 0x18, 0x86, 0x83,
+		0x19, 0xc7, 0xfd, 0xad, 0x38, 0x04, 0xd0, 0xb2, 0x67, 0xba, 0x42, 0x73, 0x9b, 0xb9, 0x0a, 0x49,
+		0xde, 0x63, 0x8c, 0xa6, 0x5a, 0x91, 0x0a, 0x8c, 0xec, 0xa9, 0x4e, 0x18, 0x2e, 0xdf, 0x6b, 0x30,
+		0x1a, 0x0e, 0x47, 0x5b, 0xea, 0x2a, 0x84, 0x98, 0x9d, 0xe9, 0x12, 0xd1, 0x6d, 0xec, 0xcd, 0x00,
+		0xbe, 0x00, 0x4a, 0x6e, 0x45, 0xee, 0xe1, 0x64, 0x4f, 0x77, 0xc6, 0x71, 0xb9, 0xef, 0xc0, 0x48,
+		0x28, 0x9c, 0x38, 0xd1, 0x8a, 0x3a, 0x88, 0x97, 0x9d, 0xee, 0x0e, 0xcf, 0x6d, 0xe9, 0x65, 0xd8,
+		0x1f, 0xbd, 0x46, 0xb6, 0x1c, 0xd4, 0x48, 0xf4, 0xec, 0xf9, 0x9e, 0xd0, 0xdd, 0xe6, 0xdf, 0x23,
+		0xc1, 0xa1, 0x76, 0x2b, 0xd4, 0xa3, 0x2d, 0xbb, 0xd3, 0x9a, 0x28, 0x7b, 0x71, 0x0f, 0x44, 0xae,
+		0x44, 0x0e, 0xa0, 0x88, 0x25, 0xe3, 0x74, 0x27, 0x96, 0x1e, 0x6e, 0xf6, 0x6c, 0xf7, 0xb8, 0x6e,
+		0xab, 0xef, 0x96, 0x60, 0xb2, 0xb5, 0x43, 0x7d, 0xa4, 0xe5, 0x4c, 0x6e, 0x45, 0x92, 0xbd, 0xd0,
+		0x33, 0x89, 0x7b, 0x5c, 0x76, 0x17, 0xf7, 0x01, 0x3f, 0x1b, 0x83, 0x93, 0xee, 0x3e, 0xdb, 0x8b,
+		0x0d, 0x6c, 0xed, 0xba, 0xbb, 0x69, 0x75, 0xb5, 0xaa, 0x19, 0xfe, 0x87, 0xa7, 0x0e, 0xb8, 0xee,
+		0x98, 0x22, 0x0a, 0x99, 0x65, 0x03, 0x06, 0xd7, 0xd4, 0x2a, 0x56, 0xf0, 0x8b, 0x0d, 0x6c, 0x3b,
+		0x11, 0xaf, 0x9c, 0x90, 0x37, 0x48, 0xb6, 0xb7, 0xc5, 0xb7, 0x5b, 0x09, 0x85, 0x97, 0xc8, 0x7e,
+		0xa7, 0xae, 0xd5, 0x34, 0xe6, 0xef, 0x13, 0x0a, 0x2b, 0x90, 0x9f, 0xb0, 0xa0, 0x07, 0x51, 0xec,
+		0x4c, 0x21, 0x93, 0x10, 0x4f, 0x0e, 0x37, 0x0c, 0x76, 0xa6, 0x20, 0x5f, 0x82, 0x21, 0xd6, 0x1e,
+		0x1f, 0x9a, 0x49, 0x48, 0xd2, 0x6f, 0x84, 0xbd, 0x56, 0x07, 0x48, 0xf9, 0x59, 0xf6, 0x26, 0x0a,
+		0xe3, 0xc2, 0x1a, 0x66, 0x85, 0xc2, 0x53, 0x2d, 0xf5, 0x77, 0xbc, 0xed, 0x5e, 0x05, 0x53, 0x90,
+		0xab, 0xbb, 0xdf, 0xef, 0x83, 0xfd, 0x2c, 0x1d, 0x99, 0x51, 0xeb, 0xda, 0xcc, 0x8e, 0xe3, 0x88,
+		0x27, 0xa9, 0x80, 0x81, 0xa7, 0xd5, 0xba, 0x26, 0xef, 0x42, 0xe2, 0x8a, 0xe3, 0xd4, 0xd1, 0x69,
+		0xe8, 0xb3, 0x1a, 0x3a, 0x16, 0xf7, 0x24, 0xdd, 0x1d, 0x2d, 0xb5, 0xae, 0x4d, 0x13, 0x04, 0xa5,
+		0xa1, 0x63, 0x85, 0xa1, 0xa0, 0x22, 0xe4, 0xb6, 0x1b, 0xba, 0xbe, 0x4b, 0x7e, 0x3a, 0xdc, 0xac,
+		0x90, 0x50, 0x8e, 0xff, 0xd4, 0x2a, 0xbe, 0x59, 0x57, 0x0d, 0x77, 0xbb, 0x31, 0xa9, 0x1c, 0xa6,
+		0x68, 0xf3, 0x14, 0x4b, 0xfc, 0xcc, 0x6a, 0x51, 0xe0, 0xc8, 0x7f, 0x1a, 0x83, 0xa4, 0x60, 0x4d,
+		0xdf, 0x2b, 0xc1, 0x3a, 0x2e, 0x93, 0x53, 0x3d, 0x89, 0xbf, 0x57, 0xc2, 0xcb, 0x08, 0x41, 0xbc,
+		0xca, 0x47, 0x27, 0x75, 0x65, 0x9f, 0x42, 0x0a, 0x04, 0xe6, 0xbe, 0x1a, 0x43, 0x60, 0xe4, 0x31,
+		0x99, 0x09, 0x48, 0xd4, 0x4d, 0x71, 0xd5, 0xe9, 0xca, 0x3e, 0x85, 0x96, 0x50, 0x06, 0xfa, 0x89,
+		0x7f, 0x74, 0xd8, 0xaf, 0xe0, 0x10, 0x38, 0x2f, 0xa3, 0x03, 0xe4, 0xea, 0xad, 0x53, 0x66, 0x9f,
+		0x88, 0x93, 0x0a, 0x56, 0x24, 0xf7, 0xe6, 0xd9, 0xeb, 0x83, 0xe1, 0x5f, 0x61, 0x26, 0xca, 0x60,
+		0x3f, 0xf3, 0x40, 0xe4, 0x5e, 0x53, 0x1d, 0x07, 0x5b, 0x06, 0x61, 0xc8, 0xd0, 0xc9, 0x07, 0x6e,
+		0x5b, 0x66, 0x65, 0x97, 0xff, 0x32, 0x34, 0xfd, 0x9f, 0xff, 0x14, 0x2d, 0x35, 0x85, 0x12, 0xad,
+		0x64, 0x3f, 0x88, 0x3f, 0x24, 0x80, 0x05, 0x82, 0x54, 0x84, 0x71, 0xb5, 0x52, 0xd1, 0xd8, 0x8f,
+		0x34, 0x97, 0xb6, 0x34, 0x3a, 0xb5, 0xec, 0xcc, 0x60, 0x9b, 0xb1, 0x40, 0x1e, 0x41, 0x81, 0xe3,
+		0x17, 0x52, 0x30, 0x50, 0x67, 0x42, 0xc9, 0x17, 0x61, 0xac, 0x49, 0x52, 0x22, 0xdf, 0x35, 0xcd,
+		0xa8, 0x88, 0xa7, 0x74, 0xc8, 0xff, 0x04, 0x46, 0x7f, 0xaa, 0x85, 0xc5, 0xd8, 0xf4, 0xff, 0xc2,
+		0xdb, 0x5a, 0xbf, 0x32, 0x36, 0xe2, 0x7b, 0x65, 0x4c, 0xad, 0x6b, 0x85, 0x14, 0xe5, 0xcf, 0xdf,
+		0x16, 0x9b, 0xe5, 0x15, 0xec, 0x5d, 0xb1, 0x69, 0xd3, 0xaa, 0x92, 0x43, 0x47, 0xb1, 0xab, 0x4e,
+		0xaa, 0xd4, 0xba, 0x66, 0x53, 0x73, 0xf4, 0x7e, 0x3a, 0xc6, 0xbe, 0xe8, 0xfb, 0x9f, 0xbe, 0x38,
+		0x96, 0x58, 0x98, 0x5d, 0x5b, 0x74, 0xed, 0xf8, 0x77, 0x63, 0x70, 0xd8, 0x67, 0xc7, 0x3e, 0xe4,
+		0x66, 0x73, 0xce, 0x46, 0x5b, 0x7c, 0x17, 0x2f, 0x8d, 0x3d, 0x0b, 0x09, 0x82, 0x8f, 0x3a, 0xfc,
+		0x50, 0x6c, 0xe6, 0x93, 0x5f, 0xfc, 0x9c, 0x3c, 0x25, 0xb5, 0x1c, 0x15, 0xca, 0xa4, 0xf0, 0xce,
+		0xee, 0xf5, 0x97, 0xf6, 0x7e, 0x35, 0xc7, 0xbe, 0x7b, 0x6a, 0x0c, 0xeb, 0xf0, 0x3f, 0x5d, 0x82,
+		0x5c, 0xd4, 0x91, 0x04, 0xf3, 0x94, 0x6d, 0x8e, 0x54, 0xba, 0xf5, 0xbe, 0x2d, 0xce, 0x4f, 0xda,
+		0x0e, 0x5c, 0x37, 0xa7, 0x24, 0x0d, 0x38, 0xf0, 0x7a, 0xd2, 0xb0, 0x77, 0xe5, 0x4c, 0xf8, 0xf1,
+		0x03, 0xee, 0xc7, 0x2d, 0x12, 0xcf, 0x0e, 0x69, 0x09, 0xcd, 0x01, 0x78, 0xc2, 0xf1, 0xa4, 0xfa,
+		0xf8, 0x74, 0xf4, 0xda, 0x30, 0xed, 0x5b, 0x18, 0x14, 0x1f, 0x19, 0xc9, 0xfe, 0x0f, 0x36, 0xb5,
+		0xcb, 0xfd, 0xf9, 0x7c, 0xc4, 0x3b, 0x3a, 0xbd, 0x1f, 0xd5, 0xce, 0x47, 0x88, 0x79, 0x5f, 0x7b,
+		0x31, 0x59, 0xfb, 0x01, 0x39, 0x9f, 0x86, 0xfd, 0x41, 0x31, 0x85, 0x76, 0xee, 0x87, 0x91, 0x60,
+		0x52, 0xc3, 0xb5, 0x34, 0x1c, 0x48, 0x6b, 0xe4, 0x37, 0x87, 0xd5, 0xeb, 0xf6, 0xb2, 0xe0, 0xbf,
+		0x66, 0x21, 0xf1, 0xdf, 0x50, 0xee, 0xa6, 0x93, 0x1e, 0x99, 0xfc, 0xd3, 0x12, 0x4c, 0x05, 0xd9,
+		0xfb, 0x4e, 0x77, 0x7b, 0x93, 0xf4, 0xee, 0x0c, 0xeb, 0x6b, 0x12, 0x1c, 0x6b, 0x23, 0x10, 0xef,
+		0xfa, 0x0d, 0x98, 0xf0, 0xdd, 0x9d, 0x12, 0x7e, 0x5a, 0x0c, 0xf5, 0xc9, 0x0e, 0xc7, 0xe9, 0x6e,
+		0x18, 0x74, 0x88, 0xa8, 0xe3, 0xe3, 0x5f, 0xcb, 0x8d, 0x37, 0xd7, 0xd9, 0xca, 0x78, 0xf3, 0xa5,
+		0xa7, 0xbb, 0x65, 0x13, 0x3f, 0x27, 0xc1, 0x03, 0xc1, 0x4e, 0x46, 0x04, 0x6b, 0x3f, 0x12, 0xf5,
+		0x7f, 0x55, 0x82, 0xd3, 0xdd, 0x48, 0xc6, 0xc7, 0xa1, 0x04, 0xe3, 0xde, 0x75, 0x81, 0xf0, 0x30,
+		0x74, 0x7f, 0x03, 0x81, 0x99, 0x25, 0x72, 0x59, 0xdd, 0x6d, 0x7d, 0xd7, 0xf9, 0x1c, 0xf2, 0x0f,
+		0xb3, 0xab, 0xdb, 0xe0, 0x26, 0x85, 0xd0, 0x6d, 0x60, 0x9b, 0x22, 0x62, 0x08, 0x62, 0x11, 0x43,
+		0xe0, 0xdb, 0x04, 0xb0, 0xe1, 0x60, 0x53, 0x8b, 0x5c, 0x67, 0xcf, 0xc1, 0x78, 0x84, 0xed, 0xf2,
+		0x09, 0xdc, 0xad, 0xe9, 0x2a, 0xa8, 0xd9, 0x3a, 0xe5, 0x5d, 0xc8, 0xd1, 0x46, 0x23, 0x23, 0xff,
+		0x7b, 0xdb, 0xdf, 0x17, 0x60, 0xaa, 0x75, 0xd3, 0xbc, 0xe3, 0x97, 0xa1, 0x9f, 0x8d, 0x30, 0xef,
+		0x6b, 0xaf, 0xf6, 0xc1, 0xa9, 0xe5, 0x0f, 0x08, 0x9f, 0x35, 0x2f, 0x64, 0x8e, 0x9e, 0x34, 0xdd,
+		0x74, 0xf4, 0x6e, 0x4c, 0x1a, 0x9f, 0x1a, 0xbe, 0x24, 0xbc, 0x57, 0xb4, 0x68, 0x5c, 0x11, 0xff,
+		0xe8, 0xee, 0x78, 0x2f, 0xa6, 0x95, 0x7b, 0xe8, 0xa6, 0x3e, 0x2a, 0xdc, 0x94, 0xdb, 0x9b, 0x0e,
+		0x6e, 0xea, 0x47, 0xa0, 0x71, 0xd7, 0x61, 0x75, 0x90, 0xf1, 0xef, 0x96, 0xc3, 0xfa, 0x4b, 0x09,
+		0x26, 0x69, 0xaf, 0xfc, 0x57, 0xa6, 0x7a, 0xd5, 0xf4, 0x43, 0x80, 0xc8, 0x61, 0x69, 0xe4, 0x44,
+		0x4e, 0xdb, 0x56, 0xf9, 0x6a, 0x60, 0xf9, 0x78, 0x08, 0x50, 0xc5, 0x76, 0xc2, 0xd8, 0xec, 0xdb,
+		0xef, 0x74, 0xc5, 0x76, 0x82, 0xd8, 0xc1, 0x51, 0x4c, 0xfc, 0xa0, 0xa3, 0xf8, 0x45, 0x09, 0xb2,
+		0x51, 0xfd, 0xe5, 0xa3, 0xb6, 0x0d, 0x07, 0x02, 0x97, 0xa5, 0xc3, 0x03, 0xf7, 0x40, 0xc7, 0xeb,
+		0x66, 0xa1, 0x49, 0xb3, 0xdf, 0xc2, 0xf7, 0x74, 0x75, 0xcf, 0x05, 0x4d, 0xb2, 0x39, 0x34, 0xfe,
+		0xd1, 0x4c, 0x96, 0x4f, 0x34, 0x79, 0xce, 0x1f, 0xf3, 0xe0, 0xf9, 0x26, 0x1c, 0x6d, 0x21, 0xef,
+		0xbd, 0x5e, 0xd0, 0x70, 0xcb, 0x31, 0xbc, 0xab, 0xf1, 0xf7, 0x39, 0x6e, 0xf7, 0xc1, 0xb7, 0x42,
+		0x7c, 0x09, 0x54, 0xd4, 0xfb, 0x61, 0xf2, 0x55, 0x38, 0x14, 0x49, 0xc5, 0x05, 0x7b, 0x1c, 0x12,
+		0xe4, 0xfb, 0x9b, 0x8c, 0xe4, 0xb3, 0x97, 0xb0, 0x4c, 0x21, 0x52, 0x4a, 0x20, 0x23, 0x48, 0x53,
+		0xbe, 0xe4, 0xd2, 0x3c, 0x97, 0x41, 0xbe, 0x02, 0x63, 0x3e, 0x18, 0x6f, 0xe1, 0x51, 0xb2, 0x8d,
+		0x63, 0xea, 0xee, 0x07, 0x4f, 0x91, 0x17, 0x87, 0x4d, 0x53, 0xe7, 0x1d, 0xa6, 0xc8, 0xf2, 0x04,
+		0x20, 0xc6, 0x89, 0xde, 0x21, 0x16, 0xfc, 0xd7, 0x60, 0x3c, 0x00, 0xe5, 0x2d, 0xec, 0xfd, 0x72,
+		0xb2, 0x7c, 0x1e, 0x8e, 0x53, 0x8e, 0x51, 0x37, 0x3f, 0x77, 0x17, 0x2b, 0x42, 0xb9, 0xa1, 0xdb,
+		0xf0, 0xb2, 0x01, 0xf7, 0xb5, 0x27, 0xf3, 0xc2, 0x18, 0x76, 0x30, 0xda, 0x36, 0x8c, 0x89, 0xe2,
+		0xc2, 0xc5, 0x64, 0xd4, 0xf2, 0xd3, 0x70, 0xa2, 0x75, 0x7b, 0xf4, 0xeb, 0x27, 0x21, 0x69, 0xe4,
+		0x7b, 0xdc, 0xf2, 0x8b, 0x70, 0xb2, 0x23, 0xfd, 0x5d, 0x16, 0xf9, 0x29, 0xb8, 0xbf, 0x55, 0x93,
+		0x36, 0x39, 0xc5, 0xad, 0xf8, 0x24, 0x66, 0x67, 0xbf, 0x92, 0xef, 0xcb, 0x02, 0xd9, 0x82, 0x13,
+		0x9d, 0xc8, 0xb9, 0xc0, 0x57, 0x60, 0x40, 0xdc, 0xed, 0x95, 0xa6, 0xe2, 0x7b, 0x90, 0x58, 0x90,
+		0x93, 0xa3, 0x4e, 0xde, 0xa6, 0xe3, 0xff, 0xa6, 0xcb, 0x15, 0x55, 0x2e, 0xc3, 0xd1, 0x56, 0x08,
+		0x5c, 0x18, 0xef, 0xa9, 0x0d, 0x69, 0x8f, 0x4f, 0x6d, 0x9c, 0xfd, 0xe5, 0x43, 0xd0, 0x47, 0x5b,
+		0x41, 0xef, 0x95, 0x00, 0x7c, 0x1f, 0xe7, 0x3d, 0x18, 0xd9, 0xaf, 0xe8, 0xfd, 0x94, 0xec, 0x43,
+		0xdd, 0x21, 0xf3, 0x6c, 0xe0, 0xe4, 0xdb, 0xbe, 0xfa, 0x8d, 0xf7, 0xc7, 0x8e, 0xa1, 0xdc, 0x4c,
+		0xd4, 0x1e, 0x8e, 0xcf, 0x55, 0x7f, 0x38, 0xf0, 0xaa, 0xef, 0xe9, 0x2e, 0x1a, 0x11, 0x02, 0x3d,
+		0xd8, 0x15, 0x2e, 0x97, 0xe7, 0x09, 0x2a, 0xcf, 0x59, 0xf4, 0x70, 0x07, 0x79, 0x66, 0xde, 0x1a,
+		0xf4, 0xd4, 0x2f, 0xa3, 0x2f, 0x49, 0x30, 0x11, 0xb5, 0x1d, 0x80, 0xce, 0x77, 0xd1, 0x7e, 0x73,
+		0xa4, 0x9a, 0x7d, 0xac, 0x57, 0x32, 0xde, 0x83, 0x79, 0xda, 0x83, 0xa7, 0xd1, 0x93, 0xbd, 0xf6,
+		0x60, 0xc6, 0x7f, 0xc7, 0xfd, 0x3b, 0x12, 0x1c, 0x69, 0x9b, 0x5d, 0xa3, 0xa7, 0xbb, 0x90, 0xaf,
+		0x4d, 0x24, 0x9e, 0xbd, 0xb4, 0x67, 0x7a, 0xde, 0xd1, 0x15, 0xda, 0xd1, 0x2b, 0xe8, 0x72, 0xcf,
+		0x1d, 0x8d, 0xfc, 0x78, 0x00, 0xfd, 0x4e, 0xf0, 0x85, 0x86, 0x36, 0x66, 0xd3, 0x94, 0xb1, 0x66,
+		0x1f, 0xea, 0x0e, 0x99, 0x4b, 0xbe, 0x49, 0x25, 0x5f, 0x45, 0xcb, 0x3f, 0xc8, 0x10, 0xcd, 0xbc,
+		0x35, 0x18, 0x52, 0xbc, 0x8c, 0xfe, 0x4a, 0x8a, 0x7e, 0x6c, 0xe1, 0x5c, 0x6b, 0xe1, 0x5a, 0x27,
+		0xe1, 0xd9, 0xf3, 0x3d, 0x52, 0xf1, 0xbe, 0xbd, 0x40, 0xfb, 0x56, 0x41, 0x5b, 0x77, 0xb5, 0x6f,
+		0x91, 0x43, 0x86, 0x3e, 0x2f, 0xc1, 0x44, 0x54, 0x0e, 0xdb, 0x6e, 0xca, 0xb5, 0x49, 0xc7, 0xdb,
+		0x4d, 0xb9, 0x76, 0xa9, 0xb2, 0x7c, 0x81, 0xf6, 0xf9, 0x51, 0xf4, 0x48, 0x64, 0x9f, 0xdb, 0x8e,
+		0x19, 0x99, 0x67, 0x6d, 0x93, 0xc2, 0x76, 0xf3, 0xac, 0x9b, 0x8c, 0xb7, 0xdd, 0x3c, 0xeb, 0x2a,
+		0x1b, 0xed, 0x30, 0xcf, 0xdc, 0x0e, 0x75, 0x39, 0x68, 0x36, 0xfa, 0x2d, 0x09, 0x86, 0x03, 0x19,
+		0x14, 0x9a, 0x6e, 0x2d, 0x62, 0x54, 0x6a, 0x99, 0x9d, 0xe9, 0x1a, 0x9f, 0x77, 0xe1, 0x32, 0xed,
+		0xc2, 0xeb, 0xd0, 0xd3, 0x3d, 0x77, 0x21, 0xf8, 0xe5, 0xcf, 0x1f, 0x48, 0x30, 0x1e, 0x91, 0x95,
+		0xb4, 0x9b, 0x61, 0xad, 0xd3, 0xab, 0xec, 0xf9, 0x1e, 0xa9, 0x78, 0x67, 0xe6, 0x68, 0x67, 0x9e,
+		0x42, 0x17, 0x7b, 0xee, 0x8c, 0x6f, 0x39, 0xfd, 0xaf, 0xde, 0x77, 0xc9, 0xbe, 0x46, 0xd0, 0xa3,
+		0xbd, 0x88, 0x24, 0xfa, 0x71, 0xae, 0x37, 0x22, 0xde, 0x8d, 0x0d, 0xda, 0x8d, 0x15, 0xb4, 0xf4,
+		0x03, 0x74, 0xa3, 0x79, 0x15, 0xfe, 0x78, 0xf3, 0x93, 0x86, 0x6d, 0xac, 0x25, 0x32, 0xa1, 0xc9,
+		0x3e, 0xdc, 0x3d, 0x01, 0xef, 0xcb, 0x79, 0xda, 0x97, 0x19, 0x74, 0x26, 0xb2, 0x2f, 0xbe, 0xb7,
+		0x07, 0x34, 0x63, 0xdb, 0x9c, 0x79, 0x2b, 0x4b, 0x90, 0x5e, 0x46, 0x37, 0xf9, 0x97, 0xbf, 0xf7,
+		0xb7, 0x6e, 0xd0, 0x97, 0xe4, 0x64, 0x4f, 0x74, 0x42, 0xe3, 0xd2, 0x1c, 0xa3, 0xd2, 0x1c, 0x42,
+		0x93, 0x91, 0xd2, 0x90, 0x2c, 0x07, 0xbd, 0x22, 0xb9, 0x0f, 0x44, 0x9c, 0x6c, 0xc3, 0xd5, 0x9f,
+		0x03, 0x65, 0x4f, 0x75, 0x46, 0xe4, 0x02, 0x1c, 0xa7, 0x02, 0x1c, 0x41, 0x87, 0xa2, 0x05, 0x60,
+		0xed, 0xfe, 0x67, 0x09, 0x0e, 0xb6, 0xc8, 0x62, 0xd0, 0x13, 0xad, 0x9b, 0x6a, 0x9f, 0x2f, 0x65,
+		0x2f, 0xec, 0x81, 0x92, 0x4b, 0xfd, 0x14, 0x95, 0xfa, 0x71, 0x74, 0x3e, 0x52, 0xea, 0xe8, 0x5b,
+		0xa7, 0x5b, 0xbb, 0x25, 0xad, 0x32, 0xf3, 0x56, 0xad, 0xf2, 0x32, 0xfa, 0x73, 0x09, 0xb2, 0xad,
+		0xb3, 0x1c, 0x74, 0xb1, 0x47, 0xc1, 0xfc, 0xb9, 0x55, 0xf6, 0xc9, 0xbd, 0x11, 0x77, 0xe5, 0x30,
+		0x5a, 0x76, 0x8c, 0x26, 0x70, 0x64, 0xf6, 0x19, 0x66, 0xed, 0x65, 0xf4, 0xc7, 0x12, 0x4c, 0xb6,
+		0x4c, 0x89, 0x50, 0xbe, 0x27, 0x01, 0x03, 0x69, 0x58, 0xf6, 0xe2, 0x9e, 0x68, 0x79, 0xdf, 0x5e,
+		0x47, 0xfb, 0x96, 0x47, 0x4f, 0xf4, 0xd0, 0x37, 0x92, 0xe7, 0x55, 0x66, 0xde, 0x4a, 0xfe, 0x58,
+		0x2f, 0xa3, 0x4f, 0x48, 0x30, 0xd6, 0x94, 0x56, 0xa1, 0xb3, 0xed, 0x84, 0x8a, 0x4e, 0xd2, 0xb2,
+		0x8f, 0xf6, 0x44, 0xc3, 0x3b, 0xf0, 0x30, 0xed, 0xc0, 0x69, 0x74, 0xaa, 0x45, 0x07, 0x9c, 0xe0,
+		0x03, 0x20, 0xb8, 0x72, 0x37, 0xaf, 0x45, 0x7d, 0xa2, 0x3f, 0xfa, 0x38, 0x5f, 0x6d, 0x38, 0x3b,
+		0x2f, 0xed, 0xe1, 0xfd, 0xdb, 0xbd, 0x7d, 0xe5, 0x28, 0xff, 0x76, 0x1c, 0x10, 0x55, 0xcb, 0x6c,
+		0xc3, 0xd9, 0x31, 0x2d, 0xed, 0x25, 0x16, 0xde, 0xbd, 0x05, 0xc8, 0x43, 0x2f, 0xfe, 0x97, 0x10,
+		0x5a, 0x5f, 0x96, 0x3d, 0xf1, 0xf1, 0xaf, 0xe5, 0xe4, 0xf6, 0xa9, 0x2e, 0xc1, 0x23, 0x3f, 0x5f,
+		0x7c, 0x93, 0xbf, 0x81, 0xb0, 0x0e, 0xa0, 0xea, 0xba, 0x79, 0xa3, 0xa4, 0x93, 0x6d, 0x28, 0xb6,
+		0xf9, 0x17, 0x3d, 0xec, 0xcd, 0xb2, 0x4d, 0xfb, 0x7e, 0x8d, 0x61, 0x9f, 0x92, 0xa2, 0x7c, 0x96,
+		0x34, 0xdb, 0x41, 0xaf, 0x87, 0x54, 0x05, 0x1b, 0xbb, 0x8c, 0x67, 0xfc, 0x07, 0xe0, 0x99, 0x24,
+		0x6c, 0x28, 0xcb, 0x4d, 0x40, 0xaa, 0x1f, 0x8f, 0xfe, 0x7e, 0x1d, 0x7f, 0x89, 0x33, 0x7a, 0x49,
+		0x08, 0xb0, 0xa5, 0xef, 0xc2, 0x8f, 0xa9, 0x61, 0x50, 0xf6, 0x44, 0x20, 0xc5, 0x0f, 0x7c, 0xad,
+		0x1b, 0xf7, 0x7d, 0xad, 0x9b, 0x1f, 0xfb, 0x2f, 0x9f, 0x3e, 0x33, 0x1c, 0xe0, 0x58, 0x18, 0xf2,
+		0x6f, 0xbe, 0x9e, 0xfe, 0xb0, 0x04, 0x63, 0x4d, 0x2d, 0x22, 0x19, 0x8e, 0xce, 0x6e, 0x6e, 0x5c,
+		0x59, 0x55, 0x16, 0x9f, 0x9f, 0xdd, 0x58, 0x5c, 0x5d, 0x11, 0x0f, 0x4f, 0xfb, 0x1e, 0x4e, 0x40,
+		0x39, 0x38, 0x14, 0x81, 0x33, 0x5f, 0x5c, 0x2a, 0x2e, 0xcc, 0x92, 0xf7, 0xa6, 0xd1, 0x31, 0x38,
+		0x12, 0xc9, 0xc4, 0x45, 0x89, 0xb5, 0x40, 0x51, 0x8a, 0x2e, 0x4a, 0xfc, 0x2e, 0x4e, 0x98, 0xff,
+		0x37, 0x00, 0xae, 0x7a, 0x30, 0x4c, 0x63, 0xa7, 0x00, 0x00,
 	}
 	r := bytes.NewReader(gzipped)
 	gzipr, err := compress_gzip.NewReader(r)
@@ -2067,6 +2231,18 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.BondDenom != that1.BondDenom {
 		return false
 	}
+	if !this.GlobalLiquidStakingCap.Equal(that1.GlobalLiquidStakingCap) {
+		return false
+	}
+	if !this.ValidatorLiquidStakingCap.Equal(that1.ValidatorLiquidStakingCap) {
+		return false
+	}
+	if !this.MinCommissionRate.Equal(that1.MinCommissionRate) {
+		return false
+	}
+	if !this.MinSelfDelegation.Equal(that1.MinSelfDelegation) {
+		return false
+	}
 	return true
 }
 func (this *RedelegationEntryResponse) Equal(that interface{}) bool {
@@ -2917,6 +3093,46 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.MinSelfDelegation.Size()
+		i -= size
+		if _, err := m.MinSelfDelegation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.MinCommissionRate.Size()
+		i -= size
+		if _, err := m.MinCommissionRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.ValidatorLiquidStakingCap.Size()
+		i -= size
+		if _, err := m.ValidatorLiquidStakingCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.GlobalLiquidStakingCap.Size()
+		i -= size
+		if _, err := m.GlobalLiquidStakingCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
 	if len(m.BondDenom) > 0 {
 		i -= len(m.BondDenom)
 		copy(dAtA[i:], m.BondDenom)
@@ -3126,6 +3342,55 @@ func (m *Pool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *TokenizeShareRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TokenizeShareRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TokenizeShareRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ModuleAccount) > 0 {
+		i -= len(m.ModuleAccount)
+		copy(dAtA[i:], m.ModuleAccount)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ModuleAccount)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintStaking(dAtA []byte, offset int, v uint64) int {
 	offset -= sovStaking(v)
 	base := offset
@@ -3458,6 +3723,14 @@ func (m *Params) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovStaking(uint64(l))
 	}
+	l = m.GlobalLiquidStakingCap.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.ValidatorLiquidStakingCap.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.MinCommissionRate.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.MinSelfDelegation.Size()
+	n += 1 + l + sovStaking(uint64(l))
 	return n
 }
 
@@ -3517,6 +3790,30 @@ func (m *Pool) Size() (n int) {
 	return n
 }
 
+func (m *TokenizeShareRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovStaking(uint64(m.Id))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.ModuleAccount)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	return n
+}
+
 func sovStaking(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -5990,6 +6287,142 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.BondDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalLiquidStakingCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GlobalLiquidStakingCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorLiquidStakingCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ValidatorLiquidStakingCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinCommissionRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinCommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSelfDelegation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinSelfDelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStaking(dAtA[iNdEx:])
@@ -6479,6 +6912,171 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *TokenizeShareRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TokenizeShareRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TokenizeShareRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleAccount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModuleAccount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipStaking(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,73 @@
+package types
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	sdk "github.com/line/lfb-sdk/types"
+	authtypes "github.com/line/lfb-sdk/x/auth/types"
+)
+
+// NewTokenizeShareRecord creates a new TokenizeShareRecord instance.
+//nolint:interfacer
+func NewTokenizeShareRecord(id uint64, owner sdk.AccAddress, validator sdk.ValAddress) TokenizeShareRecord {
+	return TokenizeShareRecord{
+		Id:            id,
+		Owner:         owner.String(),
+		ModuleAccount: GetTokenizeShareRecordModuleAddress(id).String(),
+		Validator:     validator.String(),
+	}
+}
+
+// GetTokenizeShareRecordModuleAccountName returns the name of the module
+// account holding the delegation of the record with the given id.
+func GetTokenizeShareRecordModuleAccountName(id uint64) string {
+	return fmt.Sprintf("tokenizeshare_%d", id)
+}
+
+// GetTokenizeShareRecordModuleAddress returns the address of the module
+// account holding the delegation of the record with the given id.
+func GetTokenizeShareRecordModuleAddress(id uint64) sdk.AccAddress {
+	return authtypes.NewModuleAddress(GetTokenizeShareRecordModuleAccountName(id))
+}
+
+// GetModuleAddress returns the address of the account holding the delegation.
+func (r TokenizeShareRecord) GetModuleAddress() sdk.AccAddress {
+	return GetTokenizeShareRecordModuleAddress(r.Id)
+}
+
+// GetOwnerAddr returns the address of the record owner.
+func (r TokenizeShareRecord) GetOwnerAddr() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(r.Owner)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+// GetShareTokenDenom returns the denom of the share tokens backed by the record.
+func (r TokenizeShareRecord) GetShareTokenDenom() string {
+	return strings.ToLower(r.Validator) + "/" + strconv.FormatUint(r.Id, 10)
+}
+
+// Validate performs a stateless validation of the record.
+func (r TokenizeShareRecord) Validate() error {
+	if r.Id == 0 {
+		return fmt.Errorf("tokenize share record id cannot be zero")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(r.Owner); err != nil {
+		return err
+	}
+
+	if _, err := sdk.ValAddressFromBech32(r.Validator); err != nil {
+		return err
+	}
+
+	if r.ModuleAccount != r.GetModuleAddress().String() {
+		return fmt.Errorf("invalid module account %s for tokenize share record %d", r.ModuleAccount, r.Id)
+	}
+
+	return nil
+}
@@ -0,0 +1,229 @@
+package cli
+
+import (
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/line/lfb-sdk/client"
+	"github.com/line/lfb-sdk/client/flags"
+	"github.com/line/lfb-sdk/client/tx"
+	sdk "github.com/line/lfb-sdk/types"
+	"github.com/line/lfb-sdk/version"
+	banktypes "github.com/line/lfb-sdk/x/bank/types"
+	"github.com/line/lfb-sdk/x/tokenfactory/types"
+)
+
+// flags for tokenfactory module
+const (
+	FlagMintTo = "mint-to"
+)
+
+// GetTxCmd returns the transaction commands for this module
+func GetTxCmd() *cobra.Command {
+	tokenfactoryTxCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Tokenfactory transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	tokenfactoryTxCmd.AddCommand(
+		NewCmdCreateDenom(),
+		NewCmdMint(),
+		NewCmdBurn(),
+		NewCmdChangeAdmin(),
+		NewCmdSetDenomMetadata(),
+	)
+
+	return tokenfactoryTxCmd
+}
+
+// NewCmdCreateDenom returns a CLI command handler for creating a MsgCreateDenom transaction.
+func NewCmdCreateDenom() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-denom [subdenom]",
+		Short: "Create the denom factory/{sender}/{subdenom}, paying the denom creation fee",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCreateDenom(clientCtx.GetFromAddress().String(), args[0])
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCmdMint returns a CLI command handler for creating a MsgMint transaction.
+func NewCmdMint() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "mint [amount]",
+		Short: "Mint coins of a denom administered by the sender",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Mint coins of a denom administered by the sender, to the sender or to the account of the --%s flag.
+
+Example:
+$ %s tx %s mint 1000factory/link1.../mytoken --%s link1... --from mykey
+`,
+				FlagMintTo, version.AppName, types.ModuleName, FlagMintTo,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			mintTo, err := cmd.Flags().GetString(FlagMintTo)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgMint(clientCtx.GetFromAddress().String(), amount, mintTo)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagMintTo, "", "Account receiving the minted coins, the sender if empty")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCmdBurn returns a CLI command handler for creating a MsgBurn transaction.
+func NewCmdBurn() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "burn [amount]",
+		Short: "Burn coins of a denom administered by the sender from its balance",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgBurn(clientCtx.GetFromAddress().String(), amount)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCmdChangeAdmin returns a CLI command handler for creating a MsgChangeAdmin transaction.
+func NewCmdChangeAdmin() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "change-admin [denom] [new-admin]",
+		Short: "Change the admin of a denom administered by the sender",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgChangeAdmin(clientCtx.GetFromAddress().String(), args[0], args[1])
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCmdSetDenomMetadata returns a CLI command handler for creating a MsgSetDenomMetadata transaction.
+func NewCmdSetDenomMetadata() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-denom-metadata [metadata-json-file]",
+		Short: "Set the bank metadata of a denom administered by the sender",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Set the bank metadata of a denom administered by the sender.
+
+Example:
+$ %s tx %s set-denom-metadata metadata.json --from mykey
+
+Where metadata.json contains:
+
+{
+  "description": "my token",
+  "denom_units": [
+    {"denom": "factory/link1.../mytoken", "exponent": 0},
+    {"denom": "mytoken", "exponent": 6}
+  ],
+  "base": "factory/link1.../mytoken",
+  "display": "mytoken"
+}
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contents, err := ioutil.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			var metadata banktypes.Metadata
+			if err := clientCtx.JSONMarshaler.UnmarshalJSON(contents, &metadata); err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetDenomMetadata(clientCtx.GetFromAddress().String(), metadata)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
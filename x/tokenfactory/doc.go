@@ -0,0 +1,15 @@
+/*
+Package tokenfactory lets any account issue its own fungible tokens without a
+dedicated module.
+
+An account creates the denom factory/{creator}/{subdenom} with MsgCreateDenom,
+paying the DenomCreationFee param to the community pool, and becomes its
+admin. The admin mints coins of the denom to any account with MsgMint, burns
+them from its own balance with MsgBurn, hands over the denom with
+MsgChangeAdmin and sets its x/bank metadata with MsgSetDenomMetadata. The
+coins are minted and burnt through the tokenfactory module account.
+
+The messages are also available to CosmWasm contracts through the x/wasm
+custom message encoder of the wasmbinding package.
+*/
+package tokenfactory
@@ -0,0 +1,121 @@
+package keeper
+
+import (
+	sdk "github.com/line/lfb-sdk/types"
+	sdkerrors "github.com/line/lfb-sdk/types/errors"
+	banktypes "github.com/line/lfb-sdk/x/bank/types"
+	"github.com/line/lfb-sdk/x/tokenfactory/types"
+)
+
+// CreateDenom creates the denom factory/{creator}/{subdenom} with creator as
+// its admin. The denom creation fee is sent to the community pool.
+func (k Keeper) CreateDenom(ctx sdk.Context, creator string, subdenom string) (string, error) {
+	denom, err := types.GetTokenDenom(creator, subdenom)
+	if err != nil {
+		return "", err
+	}
+
+	if _, err := k.GetAuthorityMetadata(ctx, denom); err == nil {
+		return "", sdkerrors.Wrap(types.ErrDenomExists, denom)
+	}
+
+	creatorAddr, err := sdk.AccAddressFromBech32(creator)
+	if err != nil {
+		return "", err
+	}
+
+	fee := k.GetParams(ctx).DenomCreationFee
+	if !fee.Empty() {
+		if err := k.distrKeeper.FundCommunityPool(ctx, fee, creatorAddr); err != nil {
+			return "", err
+		}
+	}
+
+	if err := k.setAuthorityMetadata(ctx, denom, types.DenomAuthorityMetadata{Admin: creator}); err != nil {
+		return "", err
+	}
+	k.addDenomFromCreator(ctx, creatorAddr, denom)
+
+	k.bankKeeper.SetDenomMetaData(ctx, banktypes.Metadata{
+		DenomUnits: []*banktypes.DenomUnit{{Denom: denom, Exponent: 0}},
+		Base:       denom,
+		Display:    denom,
+	})
+
+	return denom, nil
+}
+
+// Mint mints amount of a denom administered by admin to mintTo.
+func (k Keeper) Mint(ctx sdk.Context, admin string, amount sdk.Coin, mintTo sdk.AccAddress) error {
+	if err := k.authorize(ctx, amount.Denom, admin); err != nil {
+		return err
+	}
+
+	if k.bankKeeper.BlockedAddr(mintTo) {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", mintTo)
+	}
+
+	coins := sdk.NewCoins(amount)
+	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, coins); err != nil {
+		return err
+	}
+
+	return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, mintTo, coins)
+}
+
+// Burn burns amount of a denom administered by admin from the balance of
+// admin.
+func (k Keeper) Burn(ctx sdk.Context, admin string, amount sdk.Coin) error {
+	if err := k.authorize(ctx, amount.Denom, admin); err != nil {
+		return err
+	}
+
+	adminAddr, err := sdk.AccAddressFromBech32(admin)
+	if err != nil {
+		return err
+	}
+
+	coins := sdk.NewCoins(amount)
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, adminAddr, types.ModuleName, coins); err != nil {
+		return err
+	}
+
+	return k.bankKeeper.BurnCoins(ctx, types.ModuleName, coins)
+}
+
+// ChangeAdmin changes the admin of a denom administered by admin.
+func (k Keeper) ChangeAdmin(ctx sdk.Context, admin string, denom string, newAdmin string) error {
+	if err := k.authorize(ctx, denom, admin); err != nil {
+		return err
+	}
+
+	return k.setAuthorityMetadata(ctx, denom, types.DenomAuthorityMetadata{Admin: newAdmin})
+}
+
+// SetDenomMetadata sets the bank metadata of a denom administered by admin.
+func (k Keeper) SetDenomMetadata(ctx sdk.Context, admin string, metadata banktypes.Metadata) error {
+	if err := metadata.Validate(); err != nil {
+		return sdkerrors.Wrap(types.ErrInvalidDenomMetadata, err.Error())
+	}
+
+	if err := k.authorize(ctx, metadata.Base, admin); err != nil {
+		return err
+	}
+
+	k.bankKeeper.SetDenomMetaData(ctx, metadata)
+	return nil
+}
+
+// authorize checks that account is the admin of denom.
+func (k Keeper) authorize(ctx sdk.Context, denom string, account string) error {
+	metadata, err := k.GetAuthorityMetadata(ctx, denom)
+	if err != nil {
+		return err
+	}
+
+	if metadata.Admin != account {
+		return sdkerrors.Wrapf(types.ErrUnauthorized, "%s is not the admin of %s", account, denom)
+	}
+
+	return nil
+}
@@ -0,0 +1,38 @@
+package keeper
+
+import (
+	sdk "github.com/line/lfb-sdk/types"
+	"github.com/line/lfb-sdk/x/tokenfactory/types"
+)
+
+// InitGenesis initializes the tokenfactory module's state from a given
+// genesis state.
+func (k Keeper) InitGenesis(ctx sdk.Context, genState *types.GenesisState) {
+	k.SetParams(ctx, genState.Params)
+	k.accountKeeper.GetModuleAccount(ctx, types.ModuleName)
+
+	for _, genDenom := range genState.FactoryDenoms {
+		creator, _, err := types.DeconstructDenom(genDenom.Denom)
+		if err != nil {
+			panic(err)
+		}
+		if err := k.setAuthorityMetadata(ctx, genDenom.Denom, genDenom.AuthorityMetadata); err != nil {
+			panic(err)
+		}
+		k.addDenomFromCreator(ctx, creator, genDenom.Denom)
+	}
+}
+
+// ExportGenesis returns the tokenfactory module's exported genesis.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	genDenoms := []types.GenesisDenom{}
+	k.IterateAuthorityMetadata(ctx, func(denom string, metadata types.DenomAuthorityMetadata) bool {
+		genDenoms = append(genDenoms, types.GenesisDenom{
+			Denom:             denom,
+			AuthorityMetadata: metadata,
+		})
+		return false
+	})
+
+	return types.NewGenesisState(k.GetParams(ctx), genDenoms)
+}
@@ -0,0 +1,122 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/line/ostracon/libs/log"
+
+	"github.com/line/lfb-sdk/codec"
+	sdk "github.com/line/lfb-sdk/types"
+	sdkerrors "github.com/line/lfb-sdk/types/errors"
+	paramtypes "github.com/line/lfb-sdk/x/params/types"
+	"github.com/line/lfb-sdk/x/tokenfactory/types"
+)
+
+// Keeper manages the token factory denoms and their authorities.
+type Keeper struct {
+	cdc        codec.BinaryMarshaler
+	storeKey   sdk.StoreKey
+	paramSpace *paramtypes.Subspace
+
+	accountKeeper types.AccountKeeper
+	bankKeeper    types.BankKeeper
+	distrKeeper   types.DistrKeeper
+}
+
+// NewKeeper creates a new tokenfactory Keeper instance
+func NewKeeper(
+	cdc codec.BinaryMarshaler, key sdk.StoreKey, paramSpace *paramtypes.Subspace,
+	ak types.AccountKeeper, bk types.BankKeeper, dk types.DistrKeeper,
+) Keeper {
+	// ensure tokenfactory module account is set
+	if addr := ak.GetModuleAddress(types.ModuleName); addr == nil {
+		panic("the tokenfactory module account has not been set")
+	}
+
+	// set KeyTable if it has not already been set
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	}
+
+	return Keeper{
+		cdc:           cdc,
+		storeKey:      key,
+		paramSpace:    paramSpace,
+		accountKeeper: ak,
+		bankKeeper:    bk,
+		distrKeeper:   dk,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// GetParams returns the total set of tokenfactory parameters.
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	k.paramSpace.GetParamSet(ctx, &params)
+	return params
+}
+
+// SetParams sets the total set of tokenfactory parameters.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
+
+// GetAuthorityMetadata returns the authorities of a denom.
+func (k Keeper) GetAuthorityMetadata(ctx sdk.Context, denom string) (types.DenomAuthorityMetadata, error) {
+	bz := ctx.KVStore(k.storeKey).Get(types.DenomAuthorityMetadataKey(denom))
+	if bz == nil {
+		return types.DenomAuthorityMetadata{}, sdkerrors.Wrap(types.ErrDenomDoesNotExist, denom)
+	}
+
+	var metadata types.DenomAuthorityMetadata
+	k.cdc.MustUnmarshalBinaryBare(bz, &metadata)
+	return metadata, nil
+}
+
+// setAuthorityMetadata stores the authorities of a denom.
+func (k Keeper) setAuthorityMetadata(ctx sdk.Context, denom string, metadata types.DenomAuthorityMetadata) error {
+	if err := metadata.Validate(); err != nil {
+		return err
+	}
+
+	ctx.KVStore(k.storeKey).Set(types.DenomAuthorityMetadataKey(denom), k.cdc.MustMarshalBinaryBare(&metadata))
+	return nil
+}
+
+// GetDenomsFromCreator returns the denoms created by an account.
+func (k Keeper) GetDenomsFromCreator(ctx sdk.Context, creator sdk.AccAddress) []string {
+	prefix := types.DenomsByCreatorPrefix(creator)
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), prefix)
+	defer iterator.Close()
+
+	denoms := []string{}
+	for ; iterator.Valid(); iterator.Next() {
+		denoms = append(denoms, string(iterator.Key()[len(prefix):]))
+	}
+	return denoms
+}
+
+// IterateAuthorityMetadata iterates over the denoms and their authorities.
+func (k Keeper) IterateAuthorityMetadata(ctx sdk.Context, cb func(denom string, metadata types.DenomAuthorityMetadata) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.DenomAuthorityMetadataKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var metadata types.DenomAuthorityMetadata
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &metadata)
+
+		denom := string(iterator.Key()[len(types.DenomAuthorityMetadataKeyPrefix):])
+		if cb(denom, metadata) {
+			break
+		}
+	}
+}
+
+// addDenomFromCreator adds a denom to the index of the denoms created by an
+// account.
+func (k Keeper) addDenomFromCreator(ctx sdk.Context, creator sdk.AccAddress, denom string) {
+	ctx.KVStore(k.storeKey).Set(types.DenomByCreatorKey(creator, denom), []byte{})
+}
@@ -0,0 +1,212 @@
+package keeper_test
+
+import (
+	gocontext "context"
+	"testing"
+	"time"
+
+	ostproto "github.com/line/ostracon/proto/ostracon/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/line/lfb-sdk/baseapp"
+	"github.com/line/lfb-sdk/simapp"
+	sdk "github.com/line/lfb-sdk/types"
+	authtypes "github.com/line/lfb-sdk/x/auth/types"
+	banktypes "github.com/line/lfb-sdk/x/bank/types"
+	"github.com/line/lfb-sdk/x/tokenfactory/keeper"
+	"github.com/line/lfb-sdk/x/tokenfactory/types"
+)
+
+type TestSuite struct {
+	suite.Suite
+
+	app         *simapp.SimApp
+	ctx         sdk.Context
+	addrs       []sdk.AccAddress
+	msgServer   types.MsgServer
+	queryClient types.QueryClient
+}
+
+func TestTestSuite(t *testing.T) {
+	suite.Run(t, new(TestSuite))
+}
+
+func (s *TestSuite) SetupTest() {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, ostproto.Header{Time: time.Now().UTC()})
+
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, app.TokenFactoryKeeper)
+
+	s.app = app
+	s.ctx = ctx
+	s.msgServer = keeper.NewMsgServerImpl(app.TokenFactoryKeeper)
+	s.queryClient = types.NewQueryClient(queryHelper)
+	s.addrs = simapp.AddTestAddrsIncremental(app, ctx, 3, sdk.NewInt(30000000))
+}
+
+// createDenom creates the denom factory/{creator}/{subdenom}.
+func (s *TestSuite) createDenom(creator sdk.AccAddress, subdenom string) string {
+	res, err := s.msgServer.CreateDenom(sdk.WrapSDKContext(s.ctx), types.NewMsgCreateDenom(creator.String(), subdenom))
+	s.Require().NoError(err)
+	return res.NewTokenDenom
+}
+
+func (s *TestSuite) TestCreateDenom() {
+	creator := s.addrs[0]
+	fee := s.app.TokenFactoryKeeper.GetParams(s.ctx).DenomCreationFee
+	balance := s.app.BankKeeper.GetAllBalances(s.ctx, creator)
+	pool := s.app.DistrKeeper.GetFeePoolCommunityCoins(s.ctx)
+
+	denom := s.createDenom(creator, "bitcoin")
+	s.Require().Equal("factory/"+creator.String()+"/bitcoin", denom)
+
+	// the creation fee funds the community pool
+	s.Require().Equal(balance.Sub(fee), s.app.BankKeeper.GetAllBalances(s.ctx, creator))
+	s.Require().Equal(pool.Add(sdk.NewDecCoinsFromCoins(fee...)...), s.app.DistrKeeper.GetFeePoolCommunityCoins(s.ctx))
+
+	authority, err := s.queryClient.DenomAuthorityMetadata(gocontext.Background(), &types.QueryDenomAuthorityMetadataRequest{Denom: denom})
+	s.Require().NoError(err)
+	s.Require().Equal(creator.String(), authority.AuthorityMetadata.Admin)
+
+	denoms, err := s.queryClient.DenomsFromCreator(gocontext.Background(), &types.QueryDenomsFromCreatorRequest{Creator: creator.String()})
+	s.Require().NoError(err)
+	s.Require().Equal([]string{denom}, denoms.Denoms)
+
+	s.Require().Equal(denom, s.app.BankKeeper.GetDenomMetaData(s.ctx, denom).Base)
+
+	// the same denom cannot be created twice
+	_, err = s.msgServer.CreateDenom(sdk.WrapSDKContext(s.ctx), types.NewMsgCreateDenom(creator.String(), "bitcoin"))
+	s.Require().ErrorIs(err, types.ErrDenomExists)
+
+	// another account can use the same subdenom
+	other := s.createDenom(s.addrs[1], "bitcoin")
+	s.Require().NotEqual(denom, other)
+
+	// the creator cannot pay the creation fee anymore
+	s.createDenom(creator, "litecoin")
+	s.createDenom(creator, "dogecoin")
+	s.Require().True(s.app.BankKeeper.GetAllBalances(s.ctx, creator).IsZero())
+	_, err = s.msgServer.CreateDenom(sdk.WrapSDKContext(s.ctx), types.NewMsgCreateDenom(creator.String(), "ether"))
+	s.Require().Error(err)
+}
+
+func (s *TestSuite) TestMintBurn() {
+	admin, other := s.addrs[0], s.addrs[1]
+	denom := s.createDenom(admin, "bitcoin")
+	ctx := sdk.WrapSDKContext(s.ctx)
+
+	_, err := s.msgServer.Mint(ctx, types.NewMsgMint(admin.String(), sdk.NewInt64Coin(denom, 100), ""))
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewInt64Coin(denom, 100), s.app.BankKeeper.GetBalance(s.ctx, admin, denom))
+
+	_, err = s.msgServer.Mint(ctx, types.NewMsgMint(admin.String(), sdk.NewInt64Coin(denom, 50), other.String()))
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewInt64Coin(denom, 50), s.app.BankKeeper.GetBalance(s.ctx, other, denom))
+	s.Require().Equal(sdk.NewInt(150), s.app.BankKeeper.GetSupply(s.ctx).GetTotal().AmountOf(denom))
+
+	// only the admin mints and burns
+	_, err = s.msgServer.Mint(ctx, types.NewMsgMint(other.String(), sdk.NewInt64Coin(denom, 50), ""))
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+	_, err = s.msgServer.Burn(ctx, types.NewMsgBurn(other.String(), sdk.NewInt64Coin(denom, 50)))
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	// module accounts cannot receive minted coins
+	feeCollector := s.app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	_, err = s.msgServer.Mint(ctx, types.NewMsgMint(admin.String(), sdk.NewInt64Coin(denom, 50), feeCollector.String()))
+	s.Require().Error(err)
+
+	_, err = s.msgServer.Burn(ctx, types.NewMsgBurn(admin.String(), sdk.NewInt64Coin(denom, 40)))
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewInt64Coin(denom, 60), s.app.BankKeeper.GetBalance(s.ctx, admin, denom))
+	s.Require().Equal(sdk.NewInt(110), s.app.BankKeeper.GetSupply(s.ctx).GetTotal().AmountOf(denom))
+
+	// the admin only burns its own coins
+	_, err = s.msgServer.Burn(ctx, types.NewMsgBurn(admin.String(), sdk.NewInt64Coin(denom, 100)))
+	s.Require().Error(err)
+
+	// denoms which were not created cannot be minted
+	_, err = s.msgServer.Mint(ctx, types.NewMsgMint(admin.String(), sdk.NewInt64Coin("factory/"+admin.String()+"/litecoin", 50), ""))
+	s.Require().ErrorIs(err, types.ErrDenomDoesNotExist)
+}
+
+func (s *TestSuite) TestChangeAdmin() {
+	admin, newAdmin := s.addrs[0], s.addrs[1]
+	denom := s.createDenom(admin, "bitcoin")
+	ctx := sdk.WrapSDKContext(s.ctx)
+
+	_, err := s.msgServer.ChangeAdmin(ctx, types.NewMsgChangeAdmin(newAdmin.String(), denom, newAdmin.String()))
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	_, err = s.msgServer.ChangeAdmin(ctx, types.NewMsgChangeAdmin(admin.String(), denom, newAdmin.String()))
+	s.Require().NoError(err)
+
+	authority, err := s.app.TokenFactoryKeeper.GetAuthorityMetadata(s.ctx, denom)
+	s.Require().NoError(err)
+	s.Require().Equal(newAdmin.String(), authority.Admin)
+
+	// the former admin lost its rights
+	_, err = s.msgServer.Mint(ctx, types.NewMsgMint(admin.String(), sdk.NewInt64Coin(denom, 100), ""))
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+	_, err = s.msgServer.Mint(ctx, types.NewMsgMint(newAdmin.String(), sdk.NewInt64Coin(denom, 100), ""))
+	s.Require().NoError(err)
+
+	// the denom is still indexed by its creator
+	s.Require().Equal([]string{denom}, s.app.TokenFactoryKeeper.GetDenomsFromCreator(s.ctx, admin))
+}
+
+func (s *TestSuite) TestSetDenomMetadata() {
+	admin := s.addrs[0]
+	denom := s.createDenom(admin, "bitcoin")
+	ctx := sdk.WrapSDKContext(s.ctx)
+
+	metadata := banktypes.Metadata{
+		Description: "bitcoin of the token factory",
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: denom, Exponent: 0},
+			{Denom: "btc", Exponent: 8},
+		},
+		Base:    denom,
+		Display: "btc",
+	}
+
+	_, err := s.msgServer.SetDenomMetadata(ctx, types.NewMsgSetDenomMetadata(s.addrs[1].String(), metadata))
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	_, err = s.msgServer.SetDenomMetadata(ctx, types.NewMsgSetDenomMetadata(admin.String(), metadata))
+	s.Require().NoError(err)
+	s.Require().Equal(metadata, s.app.BankKeeper.GetDenomMetaData(s.ctx, denom))
+
+	// the metadata of other denoms cannot be set
+	metadata.Base, metadata.DenomUnits[0].Denom = sdk.DefaultBondDenom, sdk.DefaultBondDenom
+	_, err = s.msgServer.SetDenomMetadata(ctx, types.NewMsgSetDenomMetadata(admin.String(), metadata))
+	s.Require().ErrorIs(err, types.ErrDenomDoesNotExist)
+}
+
+func (s *TestSuite) TestGenesis() {
+	denom := s.createDenom(s.addrs[0], "bitcoin")
+	_, err := s.msgServer.ChangeAdmin(sdk.WrapSDKContext(s.ctx), types.NewMsgChangeAdmin(s.addrs[0].String(), denom, s.addrs[1].String()))
+	s.Require().NoError(err)
+
+	params := types.NewParams(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 5)))
+	s.app.TokenFactoryKeeper.SetParams(s.ctx, params)
+
+	genState := s.app.TokenFactoryKeeper.ExportGenesis(s.ctx)
+	s.Require().NoError(genState.Validate())
+	s.Require().Equal(params, genState.Params)
+	s.Require().Equal([]types.GenesisDenom{{
+		Denom:             denom,
+		AuthorityMetadata: types.DenomAuthorityMetadata{Admin: s.addrs[1].String()},
+	}}, genState.FactoryDenoms)
+
+	s.SetupTest()
+	s.app.TokenFactoryKeeper.InitGenesis(s.ctx, genState)
+	s.Require().Equal(genState, s.app.TokenFactoryKeeper.ExportGenesis(s.ctx))
+	s.Require().Equal([]string{denom}, s.app.TokenFactoryKeeper.GetDenomsFromCreator(s.ctx, s.addrs[0]))
+}
+
+func (s *TestSuite) TestQueryParams() {
+	res, err := s.queryClient.Params(gocontext.Background(), &types.QueryParamsRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(types.DefaultParams(), res.Params)
+}
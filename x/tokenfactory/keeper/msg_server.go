@@ -0,0 +1,117 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/line/lfb-sdk/types"
+	"github.com/line/lfb-sdk/x/tokenfactory/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the tokenfactory MsgServer
+// interface for the provided Keeper.
+func NewMsgServerImpl(k Keeper) types.MsgServer {
+	return &msgServer{
+		Keeper: k,
+	}
+}
+
+var _ types.MsgServer = msgServer{}
+
+// CreateDenom implements the MsgServer.CreateDenom method.
+func (k msgServer) CreateDenom(goCtx context.Context, msg *types.MsgCreateDenom) (*types.MsgCreateDenomResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	denom, err := k.Keeper.CreateDenom(ctx, msg.Sender, msg.Subdenom)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeCreateDenom,
+		sdk.NewAttribute(types.AttributeKeyCreator, msg.Sender),
+		sdk.NewAttribute(types.AttributeKeyNewTokenDenom, denom),
+	))
+
+	return &types.MsgCreateDenomResponse{NewTokenDenom: denom}, nil
+}
+
+// Mint implements the MsgServer.Mint method. The coins are minted to the
+// sender if no recipient is given.
+func (k msgServer) Mint(goCtx context.Context, msg *types.MsgMint) (*types.MsgMintResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	mintTo := msg.MintToAddress
+	if mintTo == "" {
+		mintTo = msg.Sender
+	}
+	mintToAddr, err := sdk.AccAddressFromBech32(mintTo)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.Mint(ctx, msg.Sender, msg.Amount, mintToAddr); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeMint,
+		sdk.NewAttribute(types.AttributeKeyMintToAddress, mintTo),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.String()),
+	))
+
+	return &types.MsgMintResponse{}, nil
+}
+
+// Burn implements the MsgServer.Burn method.
+func (k msgServer) Burn(goCtx context.Context, msg *types.MsgBurn) (*types.MsgBurnResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.Keeper.Burn(ctx, msg.Sender, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeBurn,
+		sdk.NewAttribute(types.AttributeKeyBurnFrom, msg.Sender),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.String()),
+	))
+
+	return &types.MsgBurnResponse{}, nil
+}
+
+// ChangeAdmin implements the MsgServer.ChangeAdmin method.
+func (k msgServer) ChangeAdmin(goCtx context.Context, msg *types.MsgChangeAdmin) (*types.MsgChangeAdminResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.Keeper.ChangeAdmin(ctx, msg.Sender, msg.Denom, msg.NewAdmin); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeChangeAdmin,
+		sdk.NewAttribute(types.AttributeKeyDenom, msg.Denom),
+		sdk.NewAttribute(types.AttributeKeyNewAdmin, msg.NewAdmin),
+	))
+
+	return &types.MsgChangeAdminResponse{}, nil
+}
+
+// SetDenomMetadata implements the MsgServer.SetDenomMetadata method.
+func (k msgServer) SetDenomMetadata(goCtx context.Context, msg *types.MsgSetDenomMetadata) (*types.MsgSetDenomMetadataResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.Keeper.SetDenomMetadata(ctx, msg.Sender, msg.Metadata); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeSetDenomMetadata,
+		sdk.NewAttribute(types.AttributeKeyDenom, msg.Metadata.Base),
+	))
+
+	return &types.MsgSetDenomMetadataResponse{}, nil
+}
@@ -0,0 +1,15 @@
+package types
+
+import (
+	sdk "github.com/line/lfb-sdk/types"
+	sdkerrors "github.com/line/lfb-sdk/types/errors"
+)
+
+// Validate checks the admin of the denom.
+func (metadata DenomAuthorityMetadata) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(metadata.Admin); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidAuthority, "invalid admin %s: %s", metadata.Admin, err)
+	}
+
+	return nil
+}
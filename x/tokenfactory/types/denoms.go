@@ -0,0 +1,55 @@
+package types
+
+import (
+	"strings"
+
+	sdk "github.com/line/lfb-sdk/types"
+	sdkerrors "github.com/line/lfb-sdk/types/errors"
+)
+
+const (
+	// ModuleDenomPrefix is the prefix of all the denoms created by the module.
+	ModuleDenomPrefix = "factory"
+
+	// MaxSubdenomLength is the maximum length of a subdenom.
+	MaxSubdenomLength = 44
+)
+
+// GetTokenDenom returns the denom factory/{creator}/{subdenom} created by
+// creator with subdenom.
+func GetTokenDenom(creator, subdenom string) (string, error) {
+	if len(subdenom) > MaxSubdenomLength {
+		return "", sdkerrors.Wrapf(ErrInvalidDenom, "subdenom too long, max length is %d", MaxSubdenomLength)
+	}
+
+	if strings.Contains(creator, "/") {
+		return "", sdkerrors.Wrapf(ErrInvalidDenom, "invalid creator %s", creator)
+	}
+
+	denom := strings.Join([]string{ModuleDenomPrefix, creator, subdenom}, "/")
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return "", sdkerrors.Wrap(ErrInvalidDenom, err.Error())
+	}
+
+	return denom, nil
+}
+
+// DeconstructDenom returns the creator and the subdenom of a token factory
+// denom.
+func DeconstructDenom(denom string) (creator sdk.AccAddress, subdenom string, err error) {
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return nil, "", sdkerrors.Wrap(ErrInvalidDenom, err.Error())
+	}
+
+	parts := strings.SplitN(denom, "/", 3)
+	if len(parts) < 3 || parts[0] != ModuleDenomPrefix {
+		return nil, "", sdkerrors.Wrapf(ErrInvalidDenom, "denom %s does not have the format %s/{creator}/{subdenom}", denom, ModuleDenomPrefix)
+	}
+
+	creator, err = sdk.AccAddressFromBech32(parts[1])
+	if err != nil {
+		return nil, "", sdkerrors.Wrapf(ErrInvalidDenom, "invalid creator %s: %s", parts[1], err)
+	}
+
+	return creator, parts[2], nil
+}
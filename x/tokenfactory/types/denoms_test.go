@@ -0,0 +1,119 @@
+package types_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/line/lfb-sdk/types"
+	"github.com/line/lfb-sdk/x/tokenfactory/types"
+)
+
+var (
+	creator = sdk.AccAddress("_______creator______")
+	other   = sdk.AccAddress("________other_______")
+)
+
+func TestGetTokenDenom(t *testing.T) {
+	cases := map[string]struct {
+		subdenom string
+		expErr   bool
+	}{
+		"valid":          {subdenom: "bitcoin"},
+		"with slash":     {subdenom: "bit/coin"},
+		"max length":     {subdenom: strings.Repeat("a", types.MaxSubdenomLength)},
+		"too long":       {subdenom: strings.Repeat("a", types.MaxSubdenomLength+1), expErr: true},
+		"invalid symbol": {subdenom: "bit.coin", expErr: true},
+	}
+
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			denom, err := types.GetTokenDenom(creator.String(), tc.subdenom)
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, "factory/"+creator.String()+"/"+tc.subdenom, denom)
+
+			addr, subdenom, err := types.DeconstructDenom(denom)
+			require.NoError(t, err)
+			require.Equal(t, creator, addr)
+			require.Equal(t, tc.subdenom, subdenom)
+		})
+	}
+}
+
+func TestDeconstructDenom(t *testing.T) {
+	cases := map[string]string{
+		"no prefix":       "bitcoin",
+		"other prefix":    "ibc/" + creator.String() + "/bitcoin",
+		"no subdenom":     "factory/" + creator.String(),
+		"invalid creator": "factory/creator/bitcoin",
+		"invalid denom":   "factory/" + creator.String() + "/bit.coin",
+	}
+
+	for name, denom := range cases {
+		denom := denom
+		t.Run(name, func(t *testing.T) {
+			_, _, err := types.DeconstructDenom(denom)
+			require.ErrorIs(t, err, types.ErrInvalidDenom)
+		})
+	}
+}
+
+func TestGenesisStateValidate(t *testing.T) {
+	denom, err := types.GetTokenDenom(creator.String(), "bitcoin")
+	require.NoError(t, err)
+
+	cases := map[string]struct {
+		genState *types.GenesisState
+		expErr   bool
+	}{
+		"default": {
+			genState: types.DefaultGenesisState(),
+		},
+		"valid": {
+			genState: types.NewGenesisState(types.DefaultParams(), []types.GenesisDenom{
+				{Denom: denom, AuthorityMetadata: types.DenomAuthorityMetadata{Admin: other.String()}},
+			}),
+		},
+		"invalid params": {
+			genState: types.NewGenesisState(types.Params{DenomCreationFee: sdk.Coins{{Denom: "stake", Amount: sdk.NewInt(-1)}}}, nil),
+			expErr:   true,
+		},
+		"duplicate denom": {
+			genState: types.NewGenesisState(types.DefaultParams(), []types.GenesisDenom{
+				{Denom: denom, AuthorityMetadata: types.DenomAuthorityMetadata{Admin: other.String()}},
+				{Denom: denom, AuthorityMetadata: types.DenomAuthorityMetadata{Admin: creator.String()}},
+			}),
+			expErr: true,
+		},
+		"invalid denom": {
+			genState: types.NewGenesisState(types.DefaultParams(), []types.GenesisDenom{
+				{Denom: "bitcoin", AuthorityMetadata: types.DenomAuthorityMetadata{Admin: other.String()}},
+			}),
+			expErr: true,
+		},
+		"invalid admin": {
+			genState: types.NewGenesisState(types.DefaultParams(), []types.GenesisDenom{
+				{Denom: denom, AuthorityMetadata: types.DenomAuthorityMetadata{Admin: "admin"}},
+			}),
+			expErr: true,
+		},
+	}
+
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			err := tc.genState.Validate()
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,16 @@
+package types
+
+import (
+	sdkerrors "github.com/line/lfb-sdk/types/errors"
+)
+
+// x/tokenfactory module sentinel errors
+var (
+	ErrDenomExists          = sdkerrors.Register(ModuleName, 2, "denom already exists")
+	ErrDenomDoesNotExist    = sdkerrors.Register(ModuleName, 3, "denom does not exist")
+	ErrUnauthorized         = sdkerrors.Register(ModuleName, 4, "unauthorized account")
+	ErrInvalidDenom         = sdkerrors.Register(ModuleName, 5, "invalid denom")
+	ErrInvalidGenesis       = sdkerrors.Register(ModuleName, 6, "invalid genesis")
+	ErrInvalidAuthority     = sdkerrors.Register(ModuleName, 7, "invalid authority metadata")
+	ErrInvalidDenomMetadata = sdkerrors.Register(ModuleName, 8, "invalid denom metadata")
+)
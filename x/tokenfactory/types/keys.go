@@ -0,0 +1,46 @@
+package types
+
+import (
+	sdk "github.com/line/lfb-sdk/types"
+)
+
+const (
+	// ModuleName is the module name constant used in many places
+	ModuleName = "tokenfactory"
+
+	// StoreKey is the store key string for tokenfactory
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for tokenfactory
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for tokenfactory
+	QuerierRoute = ModuleName
+)
+
+// Keys for tokenfactory store
+// Items are stored with the following key: values
+//
+// - 0x01<denom_Bytes>: DenomAuthorityMetadata
+// - 0x02<creator_Bytes><denom_Bytes>: []byte{}
+var (
+	DenomAuthorityMetadataKeyPrefix = []byte{0x01}
+	DenomByCreatorKeyPrefix         = []byte{0x02}
+)
+
+// DenomAuthorityMetadataKey returns the key of the authorities of a denom.
+func DenomAuthorityMetadataKey(denom string) []byte {
+	return append(append([]byte{}, DenomAuthorityMetadataKeyPrefix...), []byte(denom)...)
+}
+
+// DenomsByCreatorPrefix returns the prefix of the index of the denoms created
+// by an account.
+func DenomsByCreatorPrefix(creator sdk.AccAddress) []byte {
+	return append(append([]byte{}, DenomByCreatorKeyPrefix...), creator.Bytes()...)
+}
+
+// DenomByCreatorKey returns the key of a denom in the index of the denoms
+// created by an account.
+func DenomByCreatorKey(creator sdk.AccAddress, denom string) []byte {
+	return append(DenomsByCreatorPrefix(creator), []byte(denom)...)
+}
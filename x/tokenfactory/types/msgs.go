@@ -0,0 +1,233 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/line/lfb-sdk/types"
+	sdkerrors "github.com/line/lfb-sdk/types/errors"
+	banktypes "github.com/line/lfb-sdk/x/bank/types"
+)
+
+// tokenfactory message types
+const (
+	TypeMsgCreateDenom      = "create_denom"
+	TypeMsgMint             = "tf_mint"
+	TypeMsgBurn             = "tf_burn"
+	TypeMsgChangeAdmin      = "change_admin"
+	TypeMsgSetDenomMetadata = "set_denom_metadata"
+)
+
+var (
+	_ sdk.Msg = &MsgCreateDenom{}
+	_ sdk.Msg = &MsgMint{}
+	_ sdk.Msg = &MsgBurn{}
+	_ sdk.Msg = &MsgChangeAdmin{}
+	_ sdk.Msg = &MsgSetDenomMetadata{}
+)
+
+// NewMsgCreateDenom creates a new MsgCreateDenom instance.
+func NewMsgCreateDenom(sender, subdenom string) *MsgCreateDenom {
+	return &MsgCreateDenom{
+		Sender:   sender,
+		Subdenom: subdenom,
+	}
+}
+
+// Route implements Msg
+func (m MsgCreateDenom) Route() string { return RouterKey }
+
+// Type implements Msg
+func (m MsgCreateDenom) Type() string { return TypeMsgCreateDenom }
+
+// ValidateBasic implements Msg
+func (m MsgCreateDenom) ValidateBasic() error {
+	if err := validateAddress(m.Sender, "sender"); err != nil {
+		return err
+	}
+	if m.Subdenom == "" {
+		return sdkerrors.Wrap(ErrInvalidDenom, "empty subdenom")
+	}
+	_, err := GetTokenDenom(m.Sender, m.Subdenom)
+	return err
+}
+
+// GetSignBytes implements Msg
+func (m MsgCreateDenom) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners implements Msg
+func (m MsgCreateDenom) GetSigners() []sdk.AccAddress {
+	return mustAccAddresses(m.Sender)
+}
+
+// NewMsgMint creates a new MsgMint instance. An empty mintTo sends the minted
+// coins to the sender.
+func NewMsgMint(sender string, amount sdk.Coin, mintTo string) *MsgMint {
+	return &MsgMint{
+		Sender:        sender,
+		Amount:        amount,
+		MintToAddress: mintTo,
+	}
+}
+
+// Route implements Msg
+func (m MsgMint) Route() string { return RouterKey }
+
+// Type implements Msg
+func (m MsgMint) Type() string { return TypeMsgMint }
+
+// ValidateBasic implements Msg
+func (m MsgMint) ValidateBasic() error {
+	if err := validateAddress(m.Sender, "sender"); err != nil {
+		return err
+	}
+	if m.MintToAddress != "" {
+		if err := validateAddress(m.MintToAddress, "mint to address"); err != nil {
+			return err
+		}
+	}
+	return validateAmount(m.Amount)
+}
+
+// GetSignBytes implements Msg
+func (m MsgMint) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners implements Msg
+func (m MsgMint) GetSigners() []sdk.AccAddress {
+	return mustAccAddresses(m.Sender)
+}
+
+// NewMsgBurn creates a new MsgBurn instance.
+func NewMsgBurn(sender string, amount sdk.Coin) *MsgBurn {
+	return &MsgBurn{
+		Sender: sender,
+		Amount: amount,
+	}
+}
+
+// Route implements Msg
+func (m MsgBurn) Route() string { return RouterKey }
+
+// Type implements Msg
+func (m MsgBurn) Type() string { return TypeMsgBurn }
+
+// ValidateBasic implements Msg
+func (m MsgBurn) ValidateBasic() error {
+	if err := validateAddress(m.Sender, "sender"); err != nil {
+		return err
+	}
+	return validateAmount(m.Amount)
+}
+
+// GetSignBytes implements Msg
+func (m MsgBurn) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners implements Msg
+func (m MsgBurn) GetSigners() []sdk.AccAddress {
+	return mustAccAddresses(m.Sender)
+}
+
+// NewMsgChangeAdmin creates a new MsgChangeAdmin instance.
+func NewMsgChangeAdmin(sender, denom, newAdmin string) *MsgChangeAdmin {
+	return &MsgChangeAdmin{
+		Sender:   sender,
+		Denom:    denom,
+		NewAdmin: newAdmin,
+	}
+}
+
+// Route implements Msg
+func (m MsgChangeAdmin) Route() string { return RouterKey }
+
+// Type implements Msg
+func (m MsgChangeAdmin) Type() string { return TypeMsgChangeAdmin }
+
+// ValidateBasic implements Msg
+func (m MsgChangeAdmin) ValidateBasic() error {
+	if err := validateAddress(m.Sender, "sender"); err != nil {
+		return err
+	}
+	if err := validateAddress(m.NewAdmin, "new admin"); err != nil {
+		return err
+	}
+	_, _, err := DeconstructDenom(m.Denom)
+	return err
+}
+
+// GetSignBytes implements Msg
+func (m MsgChangeAdmin) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners implements Msg
+func (m MsgChangeAdmin) GetSigners() []sdk.AccAddress {
+	return mustAccAddresses(m.Sender)
+}
+
+// NewMsgSetDenomMetadata creates a new MsgSetDenomMetadata instance.
+func NewMsgSetDenomMetadata(sender string, metadata banktypes.Metadata) *MsgSetDenomMetadata {
+	return &MsgSetDenomMetadata{
+		Sender:   sender,
+		Metadata: metadata,
+	}
+}
+
+// Route implements Msg
+func (m MsgSetDenomMetadata) Route() string { return RouterKey }
+
+// Type implements Msg
+func (m MsgSetDenomMetadata) Type() string { return TypeMsgSetDenomMetadata }
+
+// ValidateBasic implements Msg
+func (m MsgSetDenomMetadata) ValidateBasic() error {
+	if err := validateAddress(m.Sender, "sender"); err != nil {
+		return err
+	}
+	if err := m.Metadata.Validate(); err != nil {
+		return sdkerrors.Wrap(ErrInvalidDenomMetadata, err.Error())
+	}
+	_, _, err := DeconstructDenom(m.Metadata.Base)
+	return err
+}
+
+// GetSignBytes implements Msg
+func (m MsgSetDenomMetadata) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners implements Msg
+func (m MsgSetDenomMetadata) GetSigners() []sdk.AccAddress {
+	return mustAccAddresses(m.Sender)
+}
+
+func validateAddress(address string, name string) error {
+	if _, err := sdk.AccAddressFromBech32(address); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "%s: %s", name, err)
+	}
+	return nil
+}
+
+func validateAmount(amount sdk.Coin) error {
+	if !amount.IsValid() || amount.IsZero() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, amount.String())
+	}
+	_, _, err := DeconstructDenom(amount.Denom)
+	return err
+}
+
+func mustAccAddresses(addresses ...string) []sdk.AccAddress {
+	addrs := make([]sdk.AccAddress, len(addresses))
+	for i, address := range addresses {
+		addr, err := sdk.AccAddressFromBech32(address)
+		if err != nil {
+			panic(fmt.Sprintf("invalid address %s: %s", address, err))
+		}
+		addrs[i] = addr
+	}
+	return addrs
+}
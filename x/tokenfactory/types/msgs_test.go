@@ -0,0 +1,99 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/line/lfb-sdk/types"
+	banktypes "github.com/line/lfb-sdk/x/bank/types"
+	"github.com/line/lfb-sdk/x/tokenfactory/types"
+)
+
+func TestMsgsValidateBasic(t *testing.T) {
+	denom, err := types.GetTokenDenom(creator.String(), "bitcoin")
+	require.NoError(t, err)
+
+	metadata := banktypes.Metadata{
+		DenomUnits: []*banktypes.DenomUnit{{Denom: denom}, {Denom: "btc", Exponent: 8}},
+		Base:       denom,
+		Display:    "btc",
+	}
+	otherMetadata := banktypes.Metadata{
+		DenomUnits: []*banktypes.DenomUnit{{Denom: "satoshi"}, {Denom: "btc", Exponent: 8}},
+		Base:       "satoshi",
+		Display:    "btc",
+	}
+
+	cases := map[string]struct {
+		msg    sdk.Msg
+		expErr bool
+	}{
+		"create denom": {
+			msg: types.NewMsgCreateDenom(creator.String(), "bitcoin"),
+		},
+		"create denom, invalid sender": {
+			msg:    types.NewMsgCreateDenom("creator", "bitcoin"),
+			expErr: true,
+		},
+		"create denom, empty subdenom": {
+			msg:    types.NewMsgCreateDenom(creator.String(), ""),
+			expErr: true,
+		},
+		"mint": {
+			msg: types.NewMsgMint(creator.String(), sdk.NewInt64Coin(denom, 1), ""),
+		},
+		"mint to other": {
+			msg: types.NewMsgMint(creator.String(), sdk.NewInt64Coin(denom, 1), other.String()),
+		},
+		"mint, invalid recipient": {
+			msg:    types.NewMsgMint(creator.String(), sdk.NewInt64Coin(denom, 1), "other"),
+			expErr: true,
+		},
+		"mint, zero amount": {
+			msg:    types.NewMsgMint(creator.String(), sdk.NewInt64Coin(denom, 0), ""),
+			expErr: true,
+		},
+		"mint, other denom": {
+			msg:    types.NewMsgMint(creator.String(), sdk.NewInt64Coin("stake", 1), ""),
+			expErr: true,
+		},
+		"burn": {
+			msg: types.NewMsgBurn(creator.String(), sdk.NewInt64Coin(denom, 1)),
+		},
+		"burn, other denom": {
+			msg:    types.NewMsgBurn(creator.String(), sdk.NewInt64Coin("stake", 1)),
+			expErr: true,
+		},
+		"change admin": {
+			msg: types.NewMsgChangeAdmin(creator.String(), denom, other.String()),
+		},
+		"change admin, invalid new admin": {
+			msg:    types.NewMsgChangeAdmin(creator.String(), denom, ""),
+			expErr: true,
+		},
+		"set denom metadata": {
+			msg: types.NewMsgSetDenomMetadata(creator.String(), metadata),
+		},
+		"set denom metadata, other denom": {
+			msg:    types.NewMsgSetDenomMetadata(creator.String(), otherMetadata),
+			expErr: true,
+		},
+		"set denom metadata, invalid metadata": {
+			msg:    types.NewMsgSetDenomMetadata(creator.String(), banktypes.Metadata{Base: denom}),
+			expErr: true,
+		},
+	}
+
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
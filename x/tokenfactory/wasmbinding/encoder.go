@@ -0,0 +1,53 @@
+package wasmbinding
+
+import (
+	"encoding/json"
+
+	sdk "github.com/line/lfb-sdk/types"
+	sdkerrors "github.com/line/lfb-sdk/types/errors"
+	"github.com/line/lfb-sdk/x/tokenfactory/types"
+)
+
+// TokenFactoryMsg is the custom message of a contract to the tokenfactory
+// module, wrapped in the msg_data of a LinkMsgWrapper whose module is
+// "tokenfactory". Exactly one of its fields must be set, and the sender of
+// the message must be the contract itself.
+type TokenFactoryMsg struct {
+	CreateDenom      *types.MsgCreateDenom      `json:"create_denom,omitempty"`
+	Mint             *types.MsgMint             `json:"mint,omitempty"`
+	Burn             *types.MsgBurn             `json:"burn,omitempty"`
+	ChangeAdmin      *types.MsgChangeAdmin      `json:"change_admin,omitempty"`
+	SetDenomMetadata *types.MsgSetDenomMetadata `json:"set_denom_metadata,omitempty"`
+}
+
+// EncodeTokenFactoryMsg encodes a TokenFactoryMsg into the sdk.Msg it holds.
+// It is meant to be registered on the x/wasm encode router under
+// types.ModuleName.
+func EncodeTokenFactoryMsg(jsonMsg json.RawMessage) ([]sdk.Msg, error) {
+	var msg TokenFactoryMsg
+	if err := json.Unmarshal(jsonMsg, &msg); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	var sdkMsgs []sdk.Msg
+	if msg.CreateDenom != nil {
+		sdkMsgs = append(sdkMsgs, msg.CreateDenom)
+	}
+	if msg.Mint != nil {
+		sdkMsgs = append(sdkMsgs, msg.Mint)
+	}
+	if msg.Burn != nil {
+		sdkMsgs = append(sdkMsgs, msg.Burn)
+	}
+	if msg.ChangeAdmin != nil {
+		sdkMsgs = append(sdkMsgs, msg.ChangeAdmin)
+	}
+	if msg.SetDenomMetadata != nil {
+		sdkMsgs = append(sdkMsgs, msg.SetDenomMetadata)
+	}
+
+	if len(sdkMsgs) != 1 {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "exactly one tokenfactory message expected, got %d", len(sdkMsgs))
+	}
+	return sdkMsgs, nil
+}
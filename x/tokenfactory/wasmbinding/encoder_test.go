@@ -0,0 +1,66 @@
+package wasmbinding_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/line/lfb-sdk/types"
+	"github.com/line/lfb-sdk/x/tokenfactory/types"
+	"github.com/line/lfb-sdk/x/tokenfactory/wasmbinding"
+)
+
+func TestEncodeTokenFactoryMsg(t *testing.T) {
+	contract := sdk.AccAddress("______contract______")
+	denom := "factory/" + contract.String() + "/bitcoin"
+
+	cases := map[string]struct {
+		input  string
+		output sdk.Msg
+		expErr bool
+	}{
+		"create denom": {
+			input:  fmt.Sprintf(`{"create_denom":{"sender":"%s","subdenom":"bitcoin"}}`, contract),
+			output: types.NewMsgCreateDenom(contract.String(), "bitcoin"),
+		},
+		"mint": {
+			input:  fmt.Sprintf(`{"mint":{"sender":"%s","amount":{"denom":"%s","amount":"100"}}}`, contract, denom),
+			output: types.NewMsgMint(contract.String(), sdk.NewInt64Coin(denom, 100), ""),
+		},
+		"burn": {
+			input:  fmt.Sprintf(`{"burn":{"sender":"%s","amount":{"denom":"%s","amount":"100"}}}`, contract, denom),
+			output: types.NewMsgBurn(contract.String(), sdk.NewInt64Coin(denom, 100)),
+		},
+		"change admin": {
+			input:  fmt.Sprintf(`{"change_admin":{"sender":"%s","denom":"%s","new_admin":"%s"}}`, contract, denom, contract),
+			output: types.NewMsgChangeAdmin(contract.String(), denom, contract.String()),
+		},
+		"no message": {
+			input:  `{}`,
+			expErr: true,
+		},
+		"two messages": {
+			input:  fmt.Sprintf(`{"create_denom":{"sender":"%s","subdenom":"bitcoin"},"burn":{"sender":"%s"}}`, contract, contract),
+			expErr: true,
+		},
+		"invalid json": {
+			input:  `{"create_denom":`,
+			expErr: true,
+		},
+	}
+
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			msgs, err := wasmbinding.EncodeTokenFactoryMsg(json.RawMessage(tc.input))
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, []sdk.Msg{tc.output}, msgs)
+		})
+	}
+}
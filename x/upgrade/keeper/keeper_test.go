@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"fmt"
 	"path/filepath"
 	"testing"
 	"time"
@@ -11,6 +12,7 @@ import (
 	"github.com/line/lfb-sdk/simapp"
 	store "github.com/line/lfb-sdk/store/types"
 	sdk "github.com/line/lfb-sdk/types"
+	"github.com/line/lfb-sdk/types/module"
 	clienttypes "github.com/line/lfb-sdk/x/ibc/core/02-client/types"
 	commitmenttypes "github.com/line/lfb-sdk/x/ibc/core/23-commitment/types"
 	ibcexported "github.com/line/lfb-sdk/x/ibc/core/exported"
@@ -32,6 +34,7 @@ func (s *KeeperTestSuite) SetupTest() {
 	homeDir := filepath.Join(s.T().TempDir(), "x_upgrade_keeper_test")
 	app.UpgradeKeeper = keeper.NewKeeper( // recreate keeper in order to use a custom home path
 		make(map[int64]bool), app.GetKey(types.StoreKey), app.AppCodec(), homeDir,
+		app.UpgradeKeeper.GetAuthority(),
 	)
 	s.T().Log("home dir:", homeDir)
 	s.homeDir = homeDir
@@ -197,7 +200,7 @@ func (s *KeeperTestSuite) TestScheduleUpgrade() {
 				Height: 123450000,
 			},
 			setup: func() {
-				s.app.UpgradeKeeper.SetUpgradeHandler("all-good", func(_ sdk.Context, _ types.Plan) {})
+				s.app.UpgradeKeeper.SetUpgradeHandler("all-good", func(_ sdk.Context, _ types.Plan, vm module.VersionMap) (module.VersionMap, error) { return vm, nil })
 				s.app.UpgradeKeeper.ApplyUpgrade(s.ctx, types.Plan{
 					Name:   "all-good",
 					Info:   "some text here",
@@ -296,6 +299,39 @@ func (s *KeeperTestSuite) TestSetUpgradedClient() {
 
 }
 
+func (s *KeeperTestSuite) TestModuleVersionMap() {
+	// the initial versions are stored at InitChain
+	vm := s.app.UpgradeKeeper.GetModuleVersionMap(s.ctx)
+	s.Require().Equal(uint64(1), vm["mint"])
+	s.Require().Equal(uint64(1), vm[types.ModuleName])
+
+	s.app.UpgradeKeeper.SetModuleVersionMap(s.ctx, module.VersionMap{"mint": 2, "foo": 1})
+	vm = s.app.UpgradeKeeper.GetModuleVersionMap(s.ctx)
+	s.Require().Equal(uint64(2), vm["mint"])
+	s.Require().Equal(uint64(1), vm["foo"])
+	s.Require().Equal(uint64(1), vm[types.ModuleName])
+}
+
+func (s *KeeperTestSuite) TestApplyUpgradeStoresVersionMap() {
+	var fromVM module.VersionMap
+	s.app.UpgradeKeeper.SetUpgradeHandler("migrate", func(_ sdk.Context, _ types.Plan, vm module.VersionMap) (module.VersionMap, error) {
+		fromVM = vm
+		return module.VersionMap{"mint": 2}, nil
+	})
+	s.app.UpgradeKeeper.ApplyUpgrade(s.ctx, types.Plan{Name: "migrate", Height: s.ctx.BlockHeight()})
+
+	s.Require().Equal(uint64(1), fromVM["mint"])
+	s.Require().Equal(uint64(2), s.app.UpgradeKeeper.GetModuleVersionMap(s.ctx)["mint"])
+	s.Require().Equal(s.ctx.BlockHeight(), s.app.UpgradeKeeper.GetDoneHeight(s.ctx, "migrate"))
+
+	s.app.UpgradeKeeper.SetUpgradeHandler("failing", func(_ sdk.Context, _ types.Plan, _ module.VersionMap) (module.VersionMap, error) {
+		return nil, fmt.Errorf("migration failed")
+	})
+	s.Require().Panics(func() {
+		s.app.UpgradeKeeper.ApplyUpgrade(s.ctx, types.Plan{Name: "failing", Height: s.ctx.BlockHeight()})
+	})
+}
+
 func TestKeeperTestSuite(t *testing.T) {
 	suite.Run(t, new(KeeperTestSuite))
 }
@@ -0,0 +1,59 @@
+package keeper_test
+
+import (
+	sdk "github.com/line/lfb-sdk/types"
+	authtypes "github.com/line/lfb-sdk/x/auth/types"
+	govtypes "github.com/line/lfb-sdk/x/gov/types"
+	"github.com/line/lfb-sdk/x/upgrade/keeper"
+	"github.com/line/lfb-sdk/x/upgrade/types"
+)
+
+func (s *KeeperTestSuite) TestMsgSoftwareUpgrade() {
+	govAddr := authtypes.NewModuleAddress(govtypes.ModuleName)
+	s.Require().Equal(govAddr.String(), s.app.UpgradeKeeper.GetAuthority())
+
+	msgServer := keeper.NewMsgServerImpl(s.app.UpgradeKeeper)
+	goCtx := sdk.WrapSDKContext(s.ctx)
+	plan := types.Plan{Name: "all-good", Height: 123450000}
+
+	testCases := []struct {
+		name    string
+		req     *types.MsgSoftwareUpgrade
+		expPass bool
+	}{
+		{"unauthorized authority", types.NewMsgSoftwareUpgrade(sdk.AccAddress("other_______________"), plan), false},
+		{"invalid plan", types.NewMsgSoftwareUpgrade(govAddr, types.Plan{Name: "past", Height: 1}), false},
+		{"successful upgrade scheduled", types.NewMsgSoftwareUpgrade(govAddr, plan), true},
+	}
+
+	for _, tc := range testCases {
+		_, err := msgServer.SoftwareUpgrade(goCtx, tc.req)
+		if tc.expPass {
+			s.Require().NoError(err, tc.name)
+			scheduled, found := s.app.UpgradeKeeper.GetUpgradePlan(s.ctx)
+			s.Require().True(found)
+			s.Require().Equal(plan, scheduled)
+		} else {
+			s.Require().Error(err, tc.name)
+		}
+	}
+}
+
+func (s *KeeperTestSuite) TestMsgCancelUpgrade() {
+	govAddr := authtypes.NewModuleAddress(govtypes.ModuleName)
+	msgServer := keeper.NewMsgServerImpl(s.app.UpgradeKeeper)
+	goCtx := sdk.WrapSDKContext(s.ctx)
+
+	plan := types.Plan{Name: "some-plan", Height: 123450000}
+	s.Require().NoError(s.app.UpgradeKeeper.ScheduleUpgrade(s.ctx, plan))
+
+	_, err := msgServer.CancelUpgrade(goCtx, types.NewMsgCancelUpgrade(sdk.AccAddress("other_______________")))
+	s.Require().Error(err)
+	_, found := s.app.UpgradeKeeper.GetUpgradePlan(s.ctx)
+	s.Require().True(found)
+
+	_, err = msgServer.CancelUpgrade(goCtx, types.NewMsgCancelUpgrade(govAddr))
+	s.Require().NoError(err)
+	_, found = s.app.UpgradeKeeper.GetUpgradePlan(s.ctx)
+	s.Require().False(found)
+}
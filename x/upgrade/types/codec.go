@@ -3,6 +3,8 @@ package types
 import (
 	"github.com/line/lfb-sdk/codec"
 	"github.com/line/lfb-sdk/codec/types"
+	sdk "github.com/line/lfb-sdk/types"
+	"github.com/line/lfb-sdk/types/msgservice"
 	govtypes "github.com/line/lfb-sdk/x/gov/types"
 )
 
@@ -11,6 +13,8 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(Plan{}, "lfb-sdk/Plan", nil)
 	cdc.RegisterConcrete(&SoftwareUpgradeProposal{}, "lfb-sdk/SoftwareUpgradeProposal", nil)
 	cdc.RegisterConcrete(&CancelSoftwareUpgradeProposal{}, "lfb-sdk/CancelSoftwareUpgradeProposal", nil)
+	cdc.RegisterConcrete(&MsgSoftwareUpgrade{}, "lfb-sdk/MsgSoftwareUpgrade", nil)
+	cdc.RegisterConcrete(&MsgCancelUpgrade{}, "lfb-sdk/MsgCancelUpgrade", nil)
 }
 
 func RegisterInterfaces(registry types.InterfaceRegistry) {
@@ -19,4 +23,28 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&SoftwareUpgradeProposal{},
 		&CancelSoftwareUpgradeProposal{},
 	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgSoftwareUpgrade{},
+		&MsgCancelUpgrade{},
+	)
+
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
+
+var (
+	amino = codec.NewLegacyAmino()
+
+	// ModuleCdc references the global x/upgrade module codec. Note, the codec
+	// should ONLY be used in certain instances of tests and for JSON encoding as
+	// Amino is still used for that purpose.
+	ModuleCdc = codec.NewAminoCodec(amino)
+)
+
+func init() {
+	RegisterLegacyAminoCodec(amino)
+
+	// register the Msgs on the gov codec as well so that proposals carrying
+	// them can be Amino JSON signed
+	govtypes.RegisterProposalTypeCodec(&MsgSoftwareUpgrade{}, "lfb-sdk/MsgSoftwareUpgrade")
+	govtypes.RegisterProposalTypeCodec(&MsgCancelUpgrade{}, "lfb-sdk/MsgCancelUpgrade")
 }
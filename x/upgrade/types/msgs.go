@@ -0,0 +1,78 @@
+package types
+
+import (
+	sdk "github.com/line/lfb-sdk/types"
+	sdkerrors "github.com/line/lfb-sdk/types/errors"
+)
+
+// Upgrade message types
+const (
+	TypeMsgSoftwareUpgrade = "software_upgrade"
+	TypeMsgCancelUpgrade   = "cancel_upgrade"
+)
+
+var _, _ sdk.Msg = &MsgSoftwareUpgrade{}, &MsgCancelUpgrade{}
+
+// NewMsgSoftwareUpgrade creates a new MsgSoftwareUpgrade instance
+//nolint:interfacer
+func NewMsgSoftwareUpgrade(authority sdk.AccAddress, plan Plan) *MsgSoftwareUpgrade {
+	return &MsgSoftwareUpgrade{Authority: authority.String(), Plan: plan}
+}
+
+// Route implements Msg
+func (m MsgSoftwareUpgrade) Route() string { return RouterKey }
+
+// Type implements Msg
+func (m MsgSoftwareUpgrade) Type() string { return TypeMsgSoftwareUpgrade }
+
+// ValidateBasic implements Msg
+func (m MsgSoftwareUpgrade) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address: %s", err)
+	}
+	return m.Plan.ValidateBasic()
+}
+
+// GetSignBytes implements Msg
+func (m MsgSoftwareUpgrade) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&m)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements Msg
+func (m MsgSoftwareUpgrade) GetSigners() []sdk.AccAddress {
+	authority, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{authority}
+}
+
+// NewMsgCancelUpgrade creates a new MsgCancelUpgrade instance
+//nolint:interfacer
+func NewMsgCancelUpgrade(authority sdk.AccAddress) *MsgCancelUpgrade {
+	return &MsgCancelUpgrade{Authority: authority.String()}
+}
+
+// Route implements Msg
+func (m MsgCancelUpgrade) Route() string { return RouterKey }
+
+// Type implements Msg
+func (m MsgCancelUpgrade) Type() string { return TypeMsgCancelUpgrade }
+
+// ValidateBasic implements Msg
+func (m MsgCancelUpgrade) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address: %s", err)
+	}
+	return nil
+}
+
+// GetSignBytes implements Msg
+func (m MsgCancelUpgrade) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&m)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements Msg
+func (m MsgCancelUpgrade) GetSigners() []sdk.AccAddress {
+	authority, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{authority}
+}
@@ -0,0 +1,63 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/line/lfb-sdk/types"
+	gov "github.com/line/lfb-sdk/x/gov/types"
+	"github.com/line/lfb-sdk/x/upgrade/types"
+)
+
+func TestMsgSoftwareUpgradeValidateBasic(t *testing.T) {
+	authority := sdk.AccAddress("authority___________")
+
+	cases := map[string]struct {
+		msg   *types.MsgSoftwareUpgrade
+		valid bool
+	}{
+		"valid": {
+			msg:   types.NewMsgSoftwareUpgrade(authority, types.Plan{Name: "v2", Height: 100}),
+			valid: true,
+		},
+		"missing authority": {
+			msg:   &types.MsgSoftwareUpgrade{Plan: types.Plan{Name: "v2", Height: 100}},
+			valid: false,
+		},
+		"invalid plan": {
+			msg:   types.NewMsgSoftwareUpgrade(authority, types.Plan{Height: 100}),
+			valid: false,
+		},
+	}
+
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+
+	require.NoError(t, types.NewMsgCancelUpgrade(authority).ValidateBasic())
+	require.Error(t, (&types.MsgCancelUpgrade{}).ValidateBasic())
+}
+
+func TestMsgSubmitProposalWithUpgradeSignBytes(t *testing.T) {
+	authority := sdk.AccAddress("authority___________")
+	msg, err := gov.NewMsgSubmitProposal(
+		gov.NewTextProposal("test", "abcd"), sdk.NewCoins(), sdk.AccAddress("proposer____________"),
+		types.NewMsgSoftwareUpgrade(authority, types.Plan{Name: "v2", Height: 100}),
+	)
+	require.NoError(t, err)
+
+	var bz []byte
+	require.NotPanics(t, func() {
+		bz = msg.GetSignBytes()
+	})
+	require.Contains(t, string(bz), `"type":"lfb-sdk/MsgSoftwareUpgrade"`)
+}
@@ -11,6 +11,53 @@ import (
 	"github.com/spf13/cobra"
 )
 
+// InstantiateContract2Cmd will instantiate a contract from previously uploaded code at a predictable address.
+func InstantiateContract2Cmd() *cobra.Command {
+	decoder := newArgDecoder(asciiDecodeString)
+	cmd := &cobra.Command{
+		Use: "instantiate2 [code_id_int64] [json_encoded_init_args] [salt] --label [text] --admin [address,optional] " +
+			"--amount [coins,optional]",
+		Short: "Instantiate a wasm contract at an address derived from the sender, the code hash and the salt",
+		Long: "Instantiate a wasm contract at an address derived from the sender, the code hash and the salt. " +
+			"The address can be computed before with the build-address query command.",
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			salt, err := decoder.DecodeString(args[2])
+			if err != nil {
+				return sdkerrors.Wrap(err, "salt")
+			}
+			data, err := parseInstantiateArgs(args[0], args[1], clientCtx.GetFromAddress(), cmd.Flags())
+			if err != nil {
+				return err
+			}
+			msg := types.MsgInstantiateContract2{
+				Sender:  data.Sender,
+				Admin:   data.Admin,
+				CodeID:  data.CodeID,
+				Label:   data.Label,
+				InitMsg: data.InitMsg,
+				Funds:   data.Funds,
+				Salt:    salt,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	cmd.Flags().String(flagAmount, "", "Coins to send to the contract during instantiation")
+	cmd.Flags().String(flagLabel, "", "A human-readable name for this contract in lists")
+	cmd.Flags().String(flagAdmin, "", "Address of an admin")
+	decoder.RegisterFlags(cmd.Flags(), "salt")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
 // MigrateContractCmd will migrate a contract to a new code version
 func MigrateContractCmd() *cobra.Command {
 	cmd := &cobra.Command{
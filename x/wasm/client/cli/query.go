@@ -35,6 +35,9 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdGetContractInfo(),
 		GetCmdGetContractHistory(),
 		GetCmdGetContractState(),
+		GetCmdGetContractCallbacks(),
+		GetCmdQueryCodeIDByHash(),
+		GetCmdBuildAddress(),
 	)
 	return queryCmd
 }
@@ -155,6 +158,69 @@ func GetCmdQueryCode() *cobra.Command {
 	return cmd
 }
 
+// GetCmdQueryCodeIDByHash gets the code id of the wasm code with a given code hash
+func GetCmdQueryCodeIDByHash() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "code-id-by-hash [hex_encoded_code_hash]",
+		Short: "Prints out the code id of the wasm code with the given code hash",
+		Long:  "Prints out the code id of the wasm code with the given sha256 code hash",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			if _, err := hex.DecodeString(args[0]); err != nil {
+				return fmt.Errorf("code hash: %s", err)
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.CodeIDByHash(
+				context.Background(),
+				&types.QueryCodeIDByHashRequest{
+					CodeHash: args[0],
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdBuildAddress computes the address of a contract instantiated with instantiate2. It runs offline.
+func GetCmdBuildAddress() *cobra.Command {
+	decoder := newArgDecoder(asciiDecodeString)
+	cmd := &cobra.Command{
+		Use:   "build-address [hex_encoded_code_hash] [creator_bech32_address] [salt]",
+		Short: "Prints out the address of a contract instantiated with instantiate2",
+		Long:  "Prints out the address of a contract instantiated with instantiate2 from the code hash, the creator and the salt",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			codeHash, err := hex.DecodeString(args[0])
+			if err != nil {
+				return fmt.Errorf("code hash: %s", err)
+			}
+			creator, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return fmt.Errorf("creator: %s", err)
+			}
+			salt, err := decoder.DecodeString(args[2])
+			if err != nil {
+				return fmt.Errorf("salt: %s", err)
+			}
+			cmd.Println(types.BuildContractAddressPredictable(codeHash, creator, salt).String())
+			return nil
+		},
+	}
+	decoder.RegisterFlags(cmd.Flags(), "salt")
+	return cmd
+}
+
 // GetCmdGetContractInfo gets details about a given contract
 func GetCmdGetContractInfo() *cobra.Command {
 	cmd := &cobra.Command{
@@ -380,6 +446,49 @@ func GetCmdGetContractHistory() *cobra.Command {
 	return cmd
 }
 
+// GetCmdGetContractCallbacks lists the callbacks registered for a contract
+func GetCmdGetContractCallbacks() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "contract-callbacks [bech32_address]",
+		Short: "Prints out the callbacks registered for a contract given its address",
+		Long:  "Prints out the callbacks registered for a contract given its address",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			_, err = sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(withPageKeyDecoded(cmd.Flags()))
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.Callbacks(
+				context.Background(),
+				&types.QueryCallbacksRequest{
+					Address:    args[0],
+					Pagination: pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.WithJSONMarshaler(&VanillaStdJSONMarshaller{}).PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "contract callbacks")
+	return cmd
+}
+
 type argumentDecoder struct {
 	// dec is the default decoder
 	dec                func(string) ([]byte, error)
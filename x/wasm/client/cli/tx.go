@@ -41,6 +41,7 @@ func GetTxCmd() *cobra.Command {
 	txCmd.AddCommand(
 		StoreCodeCmd(),
 		InstantiateContractCmd(),
+		InstantiateContract2Cmd(),
 		StoreCodeAndInstantiateContractCmd(),
 		ExecuteContractCmd(),
 		MigrateContractCmd(),
@@ -209,6 +209,19 @@ func TestGovRestHandlers(t *testing.T) {
 			},
 			expCode: http.StatusOK,
 		},
+		"remove callbacks": {
+			srcPath: "/gov/proposals/wasm_remove_callbacks",
+			srcBody: dict{
+				"title":       "Test Proposal",
+				"description": "My proposal",
+				"type":        "remove-callbacks",
+				"contract":    "link1ghekyjucln7y67ntx7cf27m9dpuxxemnqk82wt",
+				"deposit":     []dict{{"denom": "ustake", "amount": "10"}},
+				"proposer":    "link1qyqszqgpqyqszqgpqyqszqgpqyqszqgp8apuk5",
+				"base_req":    aBaseReq,
+			},
+			expCode: http.StatusOK,
+		},
 	}
 	for msg, spec := range specs {
 		t.Run(msg, func(t *testing.T) {
@@ -251,6 +251,47 @@ func ClearContractAdminProposalHandler(cliCtx client.Context) govrest.ProposalRE
 	}
 }
 
+type RemoveCallbacksJSONReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+	Title       string `json:"title" yaml:"title"`
+	Description string `json:"description" yaml:"description"`
+
+	Proposer string    `json:"proposer" yaml:"proposer"`
+	Deposit  sdk.Coins `json:"deposit" yaml:"deposit"`
+
+	Contract string `json:"contract" yaml:"contract"`
+}
+
+func (s RemoveCallbacksJSONReq) Content() govtypes.Content {
+	return &types.RemoveCallbacksProposal{
+		Title:       s.Title,
+		Description: s.Description,
+		Contract:    s.Contract,
+	}
+}
+func (s RemoveCallbacksJSONReq) GetProposer() string {
+	return s.Proposer
+}
+func (s RemoveCallbacksJSONReq) GetDeposit() sdk.Coins {
+	return s.Deposit
+}
+func (s RemoveCallbacksJSONReq) GetBaseReq() rest.BaseReq {
+	return s.BaseReq
+}
+func RemoveCallbacksProposalHandler(cliCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "wasm_remove_callbacks",
+		Handler: func(w http.ResponseWriter, r *http.Request) {
+			var req RemoveCallbacksJSONReq
+			if !rest.ReadRESTReq(w, r, cliCtx.LegacyAmino, &req) {
+				return
+			}
+			toStdTxResponse(cliCtx, w, req)
+		},
+	}
+}
+
 type wasmProposalData interface {
 	Content() govtypes.Content
 	GetProposer() string
@@ -28,6 +28,8 @@ func NewHandler(k *Keeper) sdk.Handler {
 			res, err = msgServer.StoreCode(sdk.WrapSDKContext(ctx), msg)
 		case *MsgInstantiateContract:
 			res, err = msgServer.InstantiateContract(sdk.WrapSDKContext(ctx), msg)
+		case *MsgInstantiateContract2:
+			res, err = msgServer.InstantiateContract2(sdk.WrapSDKContext(ctx), msg)
 		case *MsgStoreCodeAndInstantiateContract:
 			res, err = msgServer.StoreCodeAndInstantiateContract(sdk.WrapSDKContext(ctx), msg)
 		case *MsgExecuteContract:
@@ -40,6 +42,16 @@ func NewHandler(k *Keeper) sdk.Handler {
 			res, err = msgServer.ClearAdmin(sdk.WrapSDKContext(ctx), msg)
 		case *types.MsgUpdateContractStatus:
 			res, err = msgServer.UpdateContractStatus(sdk.WrapSDKContext(ctx), msg)
+		case *types.MsgRegisterCallback:
+			res, err = msgServer.RegisterCallback(sdk.WrapSDKContext(ctx), msg)
+		case *types.MsgCancelCallback:
+			res, err = msgServer.CancelCallback(sdk.WrapSDKContext(ctx), msg)
+		case *types.MsgPinCodes:
+			res, err = msgServer.PinCodes(sdk.WrapSDKContext(ctx), msg)
+		case *types.MsgUnpinCodes:
+			res, err = msgServer.UnpinCodes(sdk.WrapSDKContext(ctx), msg)
+		case *types.MsgRemoveCallbacks:
+			res, err = msgServer.RemoveCallbacks(sdk.WrapSDKContext(ctx), msg)
 		default:
 			errMsg := fmt.Sprintf("unrecognized wasm message type: %T", msg)
 			return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, errMsg)
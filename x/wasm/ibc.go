@@ -6,6 +6,7 @@ import (
 	sdk "github.com/line/lfb-sdk/types"
 	sdkerrors "github.com/line/lfb-sdk/types/errors"
 	capabilitytypes "github.com/line/lfb-sdk/x/capability/types"
+	ibcfeetypes "github.com/line/lfb-sdk/x/ibc/applications/fee/types"
 	channeltypes "github.com/line/lfb-sdk/x/ibc/core/04-channel/types"
 	porttypes "github.com/line/lfb-sdk/x/ibc/core/05-port/types"
 	host "github.com/line/lfb-sdk/x/ibc/core/24-host"
@@ -181,16 +182,27 @@ func toWasmVMChannel(portID, channelID string, channelInfo channeltypes.Channel,
 		Endpoint:             wasmvmtypes.IBCEndpoint{PortID: portID, ChannelID: channelID},
 		CounterpartyEndpoint: wasmvmtypes.IBCEndpoint{PortID: channelInfo.Counterparty.PortId, ChannelID: channelInfo.Counterparty.ChannelId},
 		Order:                channelInfo.Ordering.String(),
-		Version:              channelInfo.Version,
+		Version:              appVersion(channelInfo.Version),
 		ConnectionID:         channelInfo.ConnectionHops[0], // At the moment this list must be of length 1. In the future multi-hop channels may be supported.
 		CounterpartyVersion:  counterpartyVersion,
 	}
 }
 
+// appVersion returns the version of the contract application on a channel. The version stored for a channel
+// wrapped by the fee middleware is the fee metadata, which carries the application version.
+func appVersion(version string) string {
+	metadata, err := ibcfeetypes.MetadataFromVersion(version)
+	if err != nil || metadata.ValidateBasic() != nil {
+		return version
+	}
+	return metadata.AppVersion
+}
+
 // OnRecvPacket implements the IBCModule interface
 func (i IBCHandler) OnRecvPacket(
 	ctx sdk.Context,
 	packet channeltypes.Packet,
+	_ sdk.AccAddress,
 ) (*sdk.Result, []byte, error) {
 	contractAddr, err := ContractFromPortID(packet.DestinationPort)
 	if err != nil {
@@ -207,7 +219,7 @@ func (i IBCHandler) OnRecvPacket(
 }
 
 // OnAcknowledgementPacket implements the IBCModule interface
-func (i IBCHandler) OnAcknowledgementPacket(ctx sdk.Context, packet channeltypes.Packet, acknowledgement []byte) (*sdk.Result, error) {
+func (i IBCHandler) OnAcknowledgementPacket(ctx sdk.Context, packet channeltypes.Packet, acknowledgement []byte, _ sdk.AccAddress) (*sdk.Result, error) {
 	contractAddr, err := ContractFromPortID(packet.SourcePort)
 	if err != nil {
 		return nil, sdkerrors.Wrapf(err, "contract port id")
@@ -228,7 +240,7 @@ func (i IBCHandler) OnAcknowledgementPacket(ctx sdk.Context, packet channeltypes
 }
 
 // OnTimeoutPacket implements the IBCModule interface
-func (i IBCHandler) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet) (*sdk.Result, error) {
+func (i IBCHandler) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet, _ sdk.AccAddress) (*sdk.Result, error) {
 	contractAddr, err := ContractFromPortID(packet.SourcePort)
 	if err != nil {
 		return nil, sdkerrors.Wrapf(err, "contract port id")
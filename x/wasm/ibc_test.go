@@ -0,0 +1,62 @@
+package wasm
+
+import (
+	"testing"
+
+	ibcfee "github.com/line/lfb-sdk/x/ibc/applications/fee"
+	ibcfeetypes "github.com/line/lfb-sdk/x/ibc/applications/fee/types"
+	channeltypes "github.com/line/lfb-sdk/x/ibc/core/04-channel/types"
+	"github.com/line/lfb-sdk/x/wasm/internal/keeper"
+	"github.com/line/lfb-sdk/x/wasm/internal/keeper/wasmtesting"
+	wasmvm "github.com/line/wasmvm"
+	wasmvmtypes "github.com/line/wasmvm/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFeeEnabledChannelVersion(t *testing.T) {
+	var (
+		m               wasmtesting.MockWasmer
+		capturedChannel wasmvmtypes.IBCChannel
+	)
+	wasmtesting.MakeIBCInstantiable(&m)
+	m.IBCChannelConnectFn = func(codeID wasmvm.Checksum, env wasmvmtypes.Env, channel wasmvmtypes.IBCChannel, store wasmvm.KVStore, goapi wasmvm.GoAPI, querier wasmvm.Querier, gasMeter wasmvm.GasMeter, gasLimit uint64) (*wasmvmtypes.IBCBasicResponse, uint64, error) {
+		capturedChannel = channel
+		return &wasmvmtypes.IBCBasicResponse{}, 0, nil
+	}
+	m.IBCChannelCloseFn = func(codeID wasmvm.Checksum, env wasmvmtypes.Env, channel wasmvmtypes.IBCChannel, store wasmvm.KVStore, goapi wasmvm.GoAPI, querier wasmvm.Querier, gasMeter wasmvm.GasMeter, gasLimit uint64) (*wasmvmtypes.IBCBasicResponse, uint64, error) {
+		capturedChannel = channel
+		return &wasmvmtypes.IBCBasicResponse{}, 0, nil
+	}
+
+	ctx, keepers := CreateTestInput(t, false, "staking,stargate", nil, nil)
+	example := keeper.SeedNewContractInstance(t, ctx, keepers, &m)
+	portID := keepers.WasmKeeper.GetContractInfo(ctx, example.Contract).IBCPortID
+	require.NotEmpty(t, portID)
+	const channelID = "channel-0"
+
+	// the channel version stored for a fee enabled channel is the fee metadata wrapping the app version
+	channel := channeltypes.NewChannel(
+		channeltypes.INIT, channeltypes.UNORDERED, channeltypes.NewCounterparty("counterparty-port", "channel-1"),
+		[]string{"connection-0"}, ibcfeetypes.NewMetadata("my-app-v1").ChannelVersion(),
+	)
+	keepers.IBCKeeper.ChannelKeeper.SetChannel(ctx, portID, channelID, channel)
+	keepers.IBCFeeKeeper.SetFeeEnabled(ctx, portID, channelID)
+
+	stack := ibcfee.NewIBCMiddleware(NewIBCHandler(*keepers.WasmKeeper), keepers.IBCFeeKeeper)
+
+	err := stack.OnChanOpenAck(ctx, portID, channelID, ibcfeetypes.NewMetadata("counterparty-app-v1").ChannelVersion())
+	require.NoError(t, err)
+	assert.Equal(t, "my-app-v1", capturedChannel.Version)
+	assert.Equal(t, "counterparty-app-v1", capturedChannel.CounterpartyVersion)
+
+	require.NoError(t, stack.OnChanCloseConfirm(ctx, portID, channelID))
+	assert.Equal(t, "my-app-v1", capturedChannel.Version)
+
+	// the version of a channel without fees is passed as is
+	channel.Version = "my-app-v1"
+	keepers.IBCKeeper.ChannelKeeper.SetChannel(ctx, portID, "channel-1", channel)
+	require.NoError(t, stack.OnChanOpenAck(ctx, portID, "channel-1", "counterparty-app-v1"))
+	assert.Equal(t, "my-app-v1", capturedChannel.Version)
+	assert.Equal(t, "counterparty-app-v1", capturedChannel.CounterpartyVersion)
+}
@@ -0,0 +1,349 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"fmt"
+	"strconv"
+
+	"github.com/line/lfb-sdk/store/prefix"
+	sdk "github.com/line/lfb-sdk/types"
+	sdkerrors "github.com/line/lfb-sdk/types/errors"
+	authtypes "github.com/line/lfb-sdk/x/auth/types"
+	"github.com/line/lfb-sdk/x/wasm/internal/types"
+)
+
+// CallbackEscrowAddress holds the deposits of the callbacks.
+var CallbackEscrowAddress = authtypes.NewModuleAddress(types.ModuleName + "-callback")
+
+// RegisterCallback schedules sudo calls of the contract. The caller must be the contract itself, e.g. dispatching a
+// MsgRegisterCallback from its execution, and pays the deposit of the callback.
+func (k Keeper) RegisterCallback(
+	ctx sdk.Context,
+	contractAddress sdk.AccAddress,
+	caller sdk.AccAddress,
+	trigger types.CallbackTrigger,
+	height uint64,
+	msg []byte,
+	gasLimit uint64,
+	deposit sdk.Coins,
+) error {
+	if err := types.ValidateCallbackSchedule(trigger, height); err != nil {
+		return err
+	}
+	if trigger == types.CallbackTriggerAtHeight && height <= uint64(ctx.BlockHeight()) {
+		return sdkerrors.Wrapf(types.ErrInvalid, "height %d must be after the current height", height)
+	}
+	maxGasLimit := k.getMaxCallbackGasLimit(ctx)
+	if maxGasLimit == 0 {
+		return sdkerrors.Wrap(types.ErrInvalid, "callbacks disabled")
+	}
+	if gasLimit > maxGasLimit {
+		return sdkerrors.Wrapf(types.ErrLimit, "gas limit %d exceeds %d", gasLimit, maxGasLimit)
+	}
+
+	contractInfo := k.GetContractInfo(ctx, contractAddress)
+	if contractInfo == nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "unknown contract")
+	}
+	if contractInfo.Status != types.ContractStatusActive {
+		return sdkerrors.Wrap(types.ErrInvalid, "inactive contract")
+	}
+	if !contractAddress.Equals(caller) {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "can not register callback")
+	}
+	if k.GetCallback(ctx, contractAddress, trigger, height) != nil {
+		return sdkerrors.Wrap(types.ErrDuplicate, "callback")
+	}
+
+	if !deposit.IsZero() {
+		if err := k.bank.TransferCoins(ctx, caller, CallbackEscrowAddress, deposit); err != nil {
+			return err
+		}
+	}
+
+	k.setCallback(ctx, types.Callback{
+		Contract:  contractAddress.String(),
+		Trigger:   trigger,
+		Height:    height,
+		Msg:       msg,
+		GasLimit:  gasLimit,
+		Depositor: caller.String(),
+		Deposit:   deposit,
+	})
+	return nil
+}
+
+// CancelCallback removes the callback of the contract and refunds its remaining deposit. The caller must be the
+// contract itself.
+func (k Keeper) CancelCallback(ctx sdk.Context, contractAddress sdk.AccAddress, caller sdk.AccAddress, trigger types.CallbackTrigger, height uint64) error {
+	contractInfo := k.GetContractInfo(ctx, contractAddress)
+	if contractInfo == nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "unknown contract")
+	}
+	if !contractAddress.Equals(caller) {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "can not cancel callback")
+	}
+	callback := k.GetCallback(ctx, contractAddress, trigger, height)
+	if callback == nil {
+		return sdkerrors.Wrap(types.ErrNotFound, "callback")
+	}
+	return k.removeCallback(ctx, *callback)
+}
+
+// removeContractCallbacks removes all the callbacks of the contract and refunds their remaining deposits. It is used by
+// gov to stop the callbacks of a misbehaving contract.
+func (k Keeper) removeContractCallbacks(ctx sdk.Context, contractAddress sdk.AccAddress) error {
+	// collect first as removing the callbacks updates the index
+	var callbacks []types.Callback
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetContractCallbackIndexPrefix(contractAddress))
+	iter := prefixStore.Iterator(nil, nil)
+	for ; iter.Valid(); iter.Next() {
+		key := iter.Key()
+		trigger := types.CallbackTrigger(key[0])
+		height := binary.BigEndian.Uint64(key[1:])
+		if callback := k.GetCallback(ctx, contractAddress, trigger, height); callback != nil {
+			callbacks = append(callbacks, *callback)
+		}
+	}
+	iter.Close()
+
+	if len(callbacks) == 0 {
+		return sdkerrors.Wrap(types.ErrNotFound, "callbacks")
+	}
+	for _, callback := range callbacks {
+		if err := k.removeCallback(ctx, callback); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetCallback returns the callback of the contract at the trigger and height or nil when not found.
+func (k Keeper) GetCallback(ctx sdk.Context, contractAddress sdk.AccAddress, trigger types.CallbackTrigger, height uint64) *types.Callback {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetCallbackKey(contractAddress, trigger, height))
+	if bz == nil {
+		return nil
+	}
+	var callback types.Callback
+	k.cdc.MustUnmarshalBinaryBare(bz, &callback)
+	return &callback
+}
+
+func (k Keeper) setCallback(ctx sdk.Context, callback types.Callback) {
+	contractAddress, err := sdk.AccAddressFromBech32(callback.Contract)
+	if err != nil {
+		panic(err)
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetCallbackKey(contractAddress, callback.Trigger, callback.Height), k.cdc.MustMarshalBinaryBare(&callback))
+	store.Set(types.GetContractCallbackIndexKey(contractAddress, callback.Trigger, callback.Height), []byte{})
+}
+
+func (k Keeper) deleteCallback(ctx sdk.Context, callback types.Callback) {
+	contractAddress, err := sdk.AccAddressFromBech32(callback.Contract)
+	if err != nil {
+		panic(err)
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetCallbackKey(contractAddress, callback.Trigger, callback.Height))
+	store.Delete(types.GetContractCallbackIndexKey(contractAddress, callback.Trigger, callback.Height))
+}
+
+// removeCallback deletes the callback and refunds its remaining deposit to the depositor.
+func (k Keeper) removeCallback(ctx sdk.Context, callback types.Callback) error {
+	k.deleteCallback(ctx, callback)
+	if !callback.Deposit.IsZero() {
+		depositor, err := sdk.AccAddressFromBech32(callback.Depositor)
+		if err != nil {
+			return sdkerrors.Wrap(err, "depositor")
+		}
+		if err := k.bank.TransferCoins(ctx, CallbackEscrowAddress, depositor, callback.Deposit); err != nil {
+			return err
+		}
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeCancelCallback,
+		sdk.NewAttribute(types.AttributeKeyContract, callback.Contract),
+		sdk.NewAttribute(types.AttributeKeyTrigger, callback.Trigger.String()),
+		sdk.NewAttribute(types.AttributeKeyHeight, strconv.FormatUint(callback.Height, 10)),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, callback.Deposit.String()),
+	))
+	return nil
+}
+
+// IterateCallbacks iterates over all registered callbacks.
+func (k Keeper) IterateCallbacks(ctx sdk.Context, cb func(types.Callback) bool) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.CallbackKeyPrefix)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var callback types.Callback
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &callback)
+		// cb returns true to stop early
+		if cb(callback) {
+			break
+		}
+	}
+}
+
+func (k Keeper) importCallback(ctx sdk.Context, callback types.Callback) error {
+	contractAddress, err := sdk.AccAddressFromBech32(callback.Contract)
+	if err != nil {
+		return sdkerrors.Wrap(err, "contract")
+	}
+	if !k.containsContractInfo(ctx, contractAddress) {
+		return sdkerrors.Wrapf(types.ErrNotFound, "contract: %s", callback.Contract)
+	}
+	if k.GetCallback(ctx, contractAddress, callback.Trigger, callback.Height) != nil {
+		return sdkerrors.Wrapf(types.ErrDuplicate, "callback: %s %s %d", callback.Contract, callback.Trigger, callback.Height)
+	}
+	k.setCallback(ctx, callback)
+	return nil
+}
+
+// RunBeginBlockCallbacks runs the callbacks registered for BeginBlock. Their gas limits are charged to the
+// MaxBlockCallbackGas of the block like the ones run at EndBlock, which get the whole limit again: a callback whose
+// gas limit exceeds what is left is not run and is run at the next blocks again.
+func (k Keeper) RunBeginBlockCallbacks(ctx sdk.Context) {
+	blockGas := k.GetParams(ctx).MaxBlockCallbackGas
+	k.runCallbacks(ctx, types.CallbackTriggerBeginBlock, 0, &blockGas)
+}
+
+// RunEndBlockCallbacks runs the callbacks registered for EndBlock and then the ones registered at the current height.
+// Their gas limits are charged to the MaxBlockCallbackGas of the block: a callback whose gas limit exceeds what is
+// left is not run. An EndBlock callback is run at the next blocks again, an at-height callback is postponed to the
+// next height.
+func (k Keeper) RunEndBlockCallbacks(ctx sdk.Context) {
+	blockGas := k.GetParams(ctx).MaxBlockCallbackGas
+	k.runCallbacks(ctx, types.CallbackTriggerEndBlock, 0, &blockGas)
+	k.runCallbacks(ctx, types.CallbackTriggerAtHeight, uint64(ctx.BlockHeight()), &blockGas)
+}
+
+// runCallbacks runs the callbacks of the trigger and height. When blockGas is not nil, only the callbacks whose gas
+// limit fits in it are run and the gas they consume is deducted from it.
+func (k Keeper) runCallbacks(ctx sdk.Context, trigger types.CallbackTrigger, height uint64, blockGas *uint64) {
+	// collect first as running the callbacks updates the store
+	var callbacks []types.Callback
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetCallbacksByTriggerPrefix(trigger, height))
+	iter := prefixStore.Iterator(nil, nil)
+	for ; iter.Valid(); iter.Next() {
+		var callback types.Callback
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &callback)
+		callbacks = append(callbacks, callback)
+	}
+	iter.Close()
+
+	for _, callback := range callbacks {
+		if blockGas != nil && callback.GasLimit > *blockGas {
+			if callback.Trigger == types.CallbackTriggerAtHeight {
+				if err := k.postponeCallback(ctx, callback); err != nil {
+					k.Logger(ctx).Error("callback postponing failed", "contract", callback.Contract, "err", err)
+				}
+			}
+			continue
+		}
+		gasUsed, err := k.runCallback(ctx, callback)
+		if err != nil {
+			k.Logger(ctx).Error("callback failed", "contract", callback.Contract, "trigger", callback.Trigger.String(), "err", err)
+		}
+		if blockGas != nil {
+			*blockGas -= gasUsed
+		}
+	}
+}
+
+// postponeCallback moves an at-height callback to the next height. The callback is removed instead if the contract
+// already has one at the next height.
+func (k Keeper) postponeCallback(ctx sdk.Context, callback types.Callback) error {
+	contractAddress, err := sdk.AccAddressFromBech32(callback.Contract)
+	if err != nil {
+		return err
+	}
+	nextHeight := uint64(ctx.BlockHeight()) + 1
+	if k.GetCallback(ctx, contractAddress, callback.Trigger, nextHeight) != nil {
+		return k.removeCallback(ctx, callback)
+	}
+	k.deleteCallback(ctx, callback)
+	callback.Height = nextHeight
+	k.setCallback(ctx, callback)
+	return nil
+}
+
+// runCallback calls the contract sudo entry point with the message of the callback within its gas limit. The fee of
+// the consumed gas is paid from the deposit to the fee collector. A callback whose deposit can not pay the fee of its
+// gas limit is removed instead of being run, as is an at-height callback once run. A failed run is reverted but is
+// still paid for. Callbacks of inactive contracts are skipped. It returns the gas consumed by the run.
+func (k Keeper) runCallback(ctx sdk.Context, callback types.Callback) (uint64, error) {
+	contractAddress, err := sdk.AccAddressFromBech32(callback.Contract)
+	if err != nil {
+		return 0, err
+	}
+	contractInfo := k.GetContractInfo(ctx, contractAddress)
+	if contractInfo == nil {
+		return 0, k.removeCallback(ctx, callback)
+	}
+	if contractInfo.Status != types.ContractStatusActive {
+		return 0, nil
+	}
+
+	params := k.GetParams(ctx)
+	if maxFee := params.CallbackFee(callback.GasLimit); !callback.Deposit.IsAllGTE(sdk.NewCoins(maxFee)) {
+		return 0, k.removeCallback(ctx, callback)
+	}
+
+	gasUsed, execErr := k.sudoWithGasLimit(ctx, contractAddress, callback.Msg, callback.GasLimit)
+
+	fee := sdk.NewCoins(params.CallbackFee(gasUsed))
+	if !fee.IsZero() {
+		if err := k.bank.TransferCoins(ctx, CallbackEscrowAddress, authtypes.NewModuleAddress(authtypes.FeeCollectorName), fee); err != nil {
+			return gasUsed, err
+		}
+		callback.Deposit = callback.Deposit.Sub(fee)
+	}
+
+	attrs := []sdk.Attribute{
+		sdk.NewAttribute(types.AttributeKeyContract, callback.Contract),
+		sdk.NewAttribute(types.AttributeKeyTrigger, callback.Trigger.String()),
+		sdk.NewAttribute(types.AttributeKeyGasUsed, strconv.FormatUint(gasUsed, 10)),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, fee.String()),
+	}
+	if execErr != nil {
+		attrs = append(attrs, sdk.NewAttribute(types.AttributeKeyError, execErr.Error()))
+	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeRunCallback, attrs...))
+
+	if callback.Trigger == types.CallbackTriggerAtHeight {
+		return gasUsed, k.removeCallback(ctx, callback)
+	}
+	k.setCallback(ctx, callback)
+	return gasUsed, nil
+}
+
+// sudoWithGasLimit runs sudo on a branch of the state which is only written when it succeeds. A panic of the run is
+// returned as an error. It returns the gas consumed, up to the gas limit.
+func (k Keeper) sudoWithGasLimit(ctx sdk.Context, contractAddress sdk.AccAddress, msg []byte, gasLimit uint64) (gasUsed uint64, err error) {
+	cacheCtx, write := ctx.CacheContext()
+	em := sdk.NewEventManager()
+	cacheCtx = cacheCtx.WithGasMeter(sdk.NewGasMeter(gasLimit)).WithEventManager(em)
+
+	defer func() {
+		if r := recover(); r != nil {
+			gasUsed = cacheCtx.GasMeter().GasConsumedToLimit()
+			if outOfGas, ok := r.(sdk.ErrorOutOfGas); ok {
+				err = sdkerrors.Wrap(sdkerrors.ErrOutOfGas, fmt.Sprintf("out of gas in location: %v", outOfGas.Descriptor))
+			} else {
+				err = sdkerrors.Wrapf(types.ErrCallbackPanic, "%v", r)
+			}
+		}
+	}()
+
+	_, err = k.Sudo(cacheCtx, contractAddress, msg)
+	gasUsed = cacheCtx.GasMeter().GasConsumedToLimit()
+	if err != nil {
+		return gasUsed, err
+	}
+	write()
+	ctx.EventManager().EmitEvents(em.Events())
+	return gasUsed, nil
+}
@@ -0,0 +1,341 @@
+package keeper
+
+import (
+	"encoding/json"
+	"testing"
+
+	sdk "github.com/line/lfb-sdk/types"
+	sdkerrors "github.com/line/lfb-sdk/types/errors"
+	authtypes "github.com/line/lfb-sdk/x/auth/types"
+	"github.com/line/lfb-sdk/x/wasm/internal/keeper/wasmtesting"
+	"github.com/line/lfb-sdk/x/wasm/internal/types"
+	wasmvm "github.com/line/wasmvm"
+	wasmvmtypes "github.com/line/wasmvm/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setCallbackParams(ctx sdk.Context, k *Keeper, maxGasLimit uint64, gasPrice sdk.DecCoin) {
+	params := k.GetParams(ctx)
+	params.MaxCallbackGasLimit = maxGasLimit
+	params.CallbackGasPrice = gasPrice
+	k.setParams(ctx, params)
+}
+
+func stealFundsSudoMsg(t *testing.T, recipient sdk.AccAddress, amount int64) []byte {
+	bz, err := json.Marshal(sudoMsg{
+		StealFunds: stealFundsMsg{
+			Recipient: recipient.String(),
+			Amount:    wasmvmtypes.Coins{wasmvmtypes.NewCoin(uint64(amount), "denom")},
+		},
+	})
+	require.NoError(t, err)
+	return bz
+}
+
+func TestRegisterCallback(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil)
+	k, bankKeeper := keepers.WasmKeeper, keepers.BankKeeper
+	ctx = ctx.WithBlockHeight(10)
+
+	example := InstantiateHackatomExampleContract(t, ctx, keepers)
+	_, _, community := keyPubAddr()
+	_, _, stranger := keyPubAddr()
+	msg := stealFundsSudoMsg(t, community, 1)
+	deposit := sdk.NewCoins(sdk.NewInt64Coin("denom", 500))
+	fundAccounts(t, ctx, keepers.AccountKeeper, bankKeeper, example.Contract, deposit.Add(deposit...))
+
+	specs := map[string]struct {
+		maxGasLimit uint64
+		caller      sdk.AccAddress
+		trigger     types.CallbackTrigger
+		height      uint64
+		gasLimit    uint64
+		expErr      *sdkerrors.Error
+	}{
+		"callbacks disabled": {
+			caller:   example.Contract,
+			trigger:  types.CallbackTriggerEndBlock,
+			gasLimit: 100000,
+			expErr:   types.ErrInvalid,
+		},
+		"gas limit exceeds max": {
+			maxGasLimit: 100000,
+			caller:      example.Contract,
+			trigger:     types.CallbackTriggerEndBlock,
+			gasLimit:    100001,
+			expErr:      types.ErrLimit,
+		},
+		"past height": {
+			maxGasLimit: 100000,
+			caller:      example.Contract,
+			trigger:     types.CallbackTriggerAtHeight,
+			height:      10,
+			gasLimit:    100000,
+			expErr:      types.ErrInvalid,
+		},
+		"unauthorized": {
+			maxGasLimit: 100000,
+			caller:      stranger,
+			trigger:     types.CallbackTriggerEndBlock,
+			gasLimit:    100000,
+			expErr:      sdkerrors.ErrUnauthorized,
+		},
+		"admin": {
+			maxGasLimit: 100000,
+			caller:      example.CreatorAddr,
+			trigger:     types.CallbackTriggerEndBlock,
+			gasLimit:    100000,
+			expErr:      sdkerrors.ErrUnauthorized,
+		},
+		"contract": {
+			maxGasLimit: 100000,
+			caller:      example.Contract,
+			trigger:     types.CallbackTriggerAtHeight,
+			height:      11,
+			gasLimit:    100000,
+		},
+	}
+	for msgName, spec := range specs {
+		t.Run(msgName, func(t *testing.T) {
+			ctx, _ := ctx.CacheContext()
+			setCallbackParams(ctx, k, spec.maxGasLimit, types.DefaultCallbackGasPrice)
+
+			err := k.RegisterCallback(ctx, example.Contract, spec.caller, spec.trigger, spec.height, msg, spec.gasLimit, deposit)
+			if spec.expErr != nil {
+				require.True(t, spec.expErr.Is(err), "got %+v", err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, &types.Callback{
+				Contract:  example.Contract.String(),
+				Trigger:   spec.trigger,
+				Height:    spec.height,
+				Msg:       msg,
+				GasLimit:  spec.gasLimit,
+				Depositor: spec.caller.String(),
+				Deposit:   deposit,
+			}, k.GetCallback(ctx, example.Contract, spec.trigger, spec.height))
+			assert.Equal(t, deposit, bankKeeper.GetAllBalances(ctx, CallbackEscrowAddress))
+		})
+	}
+
+	setCallbackParams(ctx, k, 100000, types.DefaultCallbackGasPrice)
+
+	require.NoError(t, k.RegisterCallback(ctx, example.Contract, example.Contract, types.CallbackTriggerBeginBlock, 0, msg, 100000, deposit))
+	require.NoError(t, k.RegisterCallback(ctx, example.Contract, example.Contract, types.CallbackTriggerEndBlock, 0, msg, 100000, deposit))
+
+	err := k.RegisterCallback(ctx, example.Contract, example.Contract, types.CallbackTriggerEndBlock, 0, msg, 100000, nil)
+	require.True(t, types.ErrDuplicate.Is(err), "got %+v", err)
+
+	q := NewQuerier(k)
+	res, err := q.Callbacks(sdk.WrapSDKContext(ctx), &types.QueryCallbacksRequest{Address: example.Contract.String()})
+	require.NoError(t, err)
+	require.Len(t, res.Callbacks, 2)
+	assert.Equal(t, types.CallbackTriggerBeginBlock, res.Callbacks[0].Trigger)
+	assert.Equal(t, types.CallbackTriggerEndBlock, res.Callbacks[1].Trigger)
+
+	// cancel refunds the depositor
+	contractBalance := bankKeeper.GetAllBalances(ctx, example.Contract)
+	err = k.CancelCallback(ctx, example.Contract, example.CreatorAddr, types.CallbackTriggerEndBlock, 0)
+	require.True(t, sdkerrors.ErrUnauthorized.Is(err), "got %+v", err)
+	require.NoError(t, k.CancelCallback(ctx, example.Contract, example.Contract, types.CallbackTriggerEndBlock, 0))
+	assert.Nil(t, k.GetCallback(ctx, example.Contract, types.CallbackTriggerEndBlock, 0))
+	assert.Equal(t, contractBalance.Add(deposit...), bankKeeper.GetAllBalances(ctx, example.Contract))
+
+	res, err = q.Callbacks(sdk.WrapSDKContext(ctx), &types.QueryCallbacksRequest{Address: example.Contract.String()})
+	require.NoError(t, err)
+	require.Len(t, res.Callbacks, 1)
+
+	err = k.CancelCallback(ctx, example.Contract, example.Contract, types.CallbackTriggerEndBlock, 0)
+	require.True(t, types.ErrNotFound.Is(err), "got %+v", err)
+}
+
+func TestRunCallbacks(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil)
+	k, bankKeeper := keepers.WasmKeeper, keepers.BankKeeper
+	ctx = ctx.WithBlockHeight(10)
+
+	example := InstantiateHackatomExampleContract(t, ctx, keepers)
+	contract := example.Contract
+	feeCollector := authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+	_, _, community := keyPubAddr()
+	setCallbackParams(ctx, k, 500000, sdk.NewDecCoinFromDec("denom", sdk.NewDecWithPrec(1, 3)))
+	params := k.GetParams(ctx)
+
+	deposit := sdk.NewCoins(sdk.NewInt64Coin("denom", 300))
+	fundAccounts(t, ctx, keepers.AccountKeeper, bankKeeper, contract, sdk.NewCoins(sdk.NewInt64Coin("denom", 10000)))
+	require.NoError(t, k.RegisterCallback(ctx, example.Contract, contract, types.CallbackTriggerEndBlock, 0, stealFundsSudoMsg(t, community, 10), 200000, deposit))
+	require.NoError(t, k.RegisterCallback(ctx, example.Contract, contract, types.CallbackTriggerAtHeight, 11, stealFundsSudoMsg(t, community, 5), 200000, deposit))
+
+	// at-height callbacks only run at their height
+	k.RunEndBlockCallbacks(ctx)
+	assert.Equal(t, sdk.NewInt64Coin("denom", 10), bankKeeper.GetBalance(ctx, community, "denom"))
+	endBlock := k.GetCallback(ctx, example.Contract, types.CallbackTriggerEndBlock, 0)
+	fee := bankKeeper.GetAllBalances(ctx, feeCollector)
+	require.True(t, fee.IsAllPositive())
+	assert.Equal(t, deposit.Sub(fee), endBlock.Deposit)
+
+	contractBalance := bankKeeper.GetAllBalances(ctx, contract)
+	k.RunEndBlockCallbacks(ctx.WithBlockHeight(11))
+	assert.Equal(t, sdk.NewInt64Coin("denom", 25), bankKeeper.GetBalance(ctx, community, "denom"))
+	require.Nil(t, k.GetCallback(ctx, example.Contract, types.CallbackTriggerAtHeight, 11))
+	endBlockFee := endBlock.Deposit.Sub(k.GetCallback(ctx, example.Contract, types.CallbackTriggerEndBlock, 0).Deposit)
+	atHeightFee := bankKeeper.GetAllBalances(ctx, feeCollector).Sub(fee.Add(endBlockFee...))
+	require.True(t, atHeightFee.IsAllPositive())
+	stolen := sdk.NewCoins(sdk.NewInt64Coin("denom", 15))
+	assert.Equal(t, contractBalance.Add(deposit.Sub(atHeightFee)...).Sub(stolen), bankKeeper.GetAllBalances(ctx, contract))
+
+	// begin block callbacks
+	require.NoError(t, k.RegisterCallback(ctx, example.Contract, contract, types.CallbackTriggerBeginBlock, 0, stealFundsSudoMsg(t, community, 1), 200000, deposit))
+	k.RunBeginBlockCallbacks(ctx)
+	assert.Equal(t, sdk.NewInt64Coin("denom", 26), bankKeeper.GetBalance(ctx, community, "denom"))
+	require.NoError(t, k.CancelCallback(ctx, example.Contract, contract, types.CallbackTriggerBeginBlock, 0))
+
+	// inactive contracts are skipped
+	contractInfo := k.GetContractInfo(ctx, example.Contract)
+	contractInfo.Status = types.ContractStatusInactive
+	k.storeContractInfo(ctx, example.Contract, contractInfo)
+	k.RunEndBlockCallbacks(ctx)
+	assert.Equal(t, sdk.NewInt64Coin("denom", 26), bankKeeper.GetBalance(ctx, community, "denom"))
+	contractInfo.Status = types.ContractStatusActive
+	k.storeContractInfo(ctx, example.Contract, contractInfo)
+
+	// a failed run is reverted but paid for
+	require.NoError(t, k.CancelCallback(ctx, example.Contract, contract, types.CallbackTriggerEndBlock, 0))
+	require.NoError(t, k.RegisterCallback(ctx, example.Contract, contract, types.CallbackTriggerEndBlock, 0, stealFundsSudoMsg(t, community, 1000000), 200000, deposit))
+	feeBefore := bankKeeper.GetAllBalances(ctx, feeCollector)
+	k.RunEndBlockCallbacks(ctx)
+	assert.Equal(t, sdk.NewInt64Coin("denom", 26), bankKeeper.GetBalance(ctx, community, "denom"))
+	failedFee := bankKeeper.GetAllBalances(ctx, feeCollector).Sub(feeBefore)
+	require.True(t, failedFee.IsAllPositive())
+	assert.Equal(t, deposit.Sub(failedFee), k.GetCallback(ctx, example.Contract, types.CallbackTriggerEndBlock, 0).Deposit)
+
+	// out of gas is charged the gas limit
+	require.NoError(t, k.CancelCallback(ctx, example.Contract, contract, types.CallbackTriggerEndBlock, 0))
+	require.NoError(t, k.RegisterCallback(ctx, example.Contract, contract, types.CallbackTriggerEndBlock, 0, stealFundsSudoMsg(t, community, 1), params.InstanceCost+1, deposit))
+	feeBefore = bankKeeper.GetAllBalances(ctx, feeCollector)
+	k.RunEndBlockCallbacks(ctx)
+	assert.Equal(t, sdk.NewInt64Coin("denom", 26), bankKeeper.GetBalance(ctx, community, "denom"))
+	assert.Equal(t, sdk.NewCoins(params.CallbackFee(params.InstanceCost+1)), bankKeeper.GetAllBalances(ctx, feeCollector).Sub(feeBefore))
+
+	// a deposit not paying for the gas limit removes the callback
+	require.NoError(t, k.CancelCallback(ctx, example.Contract, contract, types.CallbackTriggerEndBlock, 0))
+	smallDeposit := sdk.NewCoins(sdk.NewInt64Coin("denom", 199))
+	require.NoError(t, k.RegisterCallback(ctx, example.Contract, contract, types.CallbackTriggerEndBlock, 0, stealFundsSudoMsg(t, community, 1), 200000, smallDeposit))
+	contractBalance = bankKeeper.GetAllBalances(ctx, contract)
+	k.RunEndBlockCallbacks(ctx)
+	assert.Equal(t, sdk.NewInt64Coin("denom", 26), bankKeeper.GetBalance(ctx, community, "denom"))
+	assert.Nil(t, k.GetCallback(ctx, example.Contract, types.CallbackTriggerEndBlock, 0))
+	assert.Equal(t, contractBalance.Add(smallDeposit...), bankKeeper.GetAllBalances(ctx, contract))
+	assert.True(t, bankKeeper.GetAllBalances(ctx, CallbackEscrowAddress).IsZero())
+}
+
+func TestRunCallbacksBlockGas(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil)
+	k, bankKeeper := keepers.WasmKeeper, keepers.BankKeeper
+	ctx = ctx.WithBlockHeight(10)
+
+	example := InstantiateHackatomExampleContract(t, ctx, keepers)
+	_, _, community := keyPubAddr()
+	setCallbackParams(ctx, k, 200000, types.DefaultCallbackGasPrice)
+	setBlockCallbackGas := func(gas uint64) {
+		params := k.GetParams(ctx)
+		params.MaxBlockCallbackGas = gas
+		k.setParams(ctx, params)
+	}
+
+	fundAccounts(t, ctx, keepers.AccountKeeper, bankKeeper, example.Contract, sdk.NewCoins(sdk.NewInt64Coin("denom", 10000)))
+	require.NoError(t, k.RegisterCallback(ctx, example.Contract, example.Contract, types.CallbackTriggerEndBlock, 0, stealFundsSudoMsg(t, community, 10), 200000, nil))
+	require.NoError(t, k.RegisterCallback(ctx, example.Contract, example.Contract, types.CallbackTriggerAtHeight, 11, stealFundsSudoMsg(t, community, 5), 200000, nil))
+
+	// the at-height callback does not fit in what the EndBlock callback left and is postponed
+	setBlockCallbackGas(200000)
+	k.RunEndBlockCallbacks(ctx.WithBlockHeight(11))
+	assert.Equal(t, sdk.NewInt64Coin("denom", 10), bankKeeper.GetBalance(ctx, community, "denom"))
+	assert.Nil(t, k.GetCallback(ctx, example.Contract, types.CallbackTriggerAtHeight, 11))
+	require.NotNil(t, k.GetCallback(ctx, example.Contract, types.CallbackTriggerAtHeight, 12))
+
+	// no callback fits
+	setBlockCallbackGas(199999)
+	k.RunEndBlockCallbacks(ctx.WithBlockHeight(12))
+	assert.Equal(t, sdk.NewInt64Coin("denom", 10), bankKeeper.GetBalance(ctx, community, "denom"))
+	require.NotNil(t, k.GetCallback(ctx, example.Contract, types.CallbackTriggerEndBlock, 0))
+	require.NotNil(t, k.GetCallback(ctx, example.Contract, types.CallbackTriggerAtHeight, 13))
+
+	// both fit
+	setBlockCallbackGas(400000)
+	k.RunEndBlockCallbacks(ctx.WithBlockHeight(13))
+	assert.Equal(t, sdk.NewInt64Coin("denom", 25), bankKeeper.GetBalance(ctx, community, "denom"))
+	assert.Nil(t, k.GetCallback(ctx, example.Contract, types.CallbackTriggerAtHeight, 13))
+
+	// BeginBlock callbacks are charged to the limit of the block too
+	require.NoError(t, k.CancelCallback(ctx, example.Contract, example.Contract, types.CallbackTriggerEndBlock, 0))
+	require.NoError(t, k.RegisterCallback(ctx, example.Contract, example.Contract, types.CallbackTriggerBeginBlock, 0, stealFundsSudoMsg(t, community, 1), 200000, nil))
+	setBlockCallbackGas(199999)
+	k.RunBeginBlockCallbacks(ctx.WithBlockHeight(14))
+	assert.Equal(t, sdk.NewInt64Coin("denom", 25), bankKeeper.GetBalance(ctx, community, "denom"))
+	setBlockCallbackGas(200000)
+	k.RunBeginBlockCallbacks(ctx.WithBlockHeight(15))
+	assert.Equal(t, sdk.NewInt64Coin("denom", 26), bankKeeper.GetBalance(ctx, community, "denom"))
+}
+
+func TestRunCallbacksPanic(t *testing.T) {
+	var m wasmtesting.MockWasmer
+	wasmtesting.MakeIBCInstantiable(&m)
+	m.SudoFn = func(codeID wasmvm.Checksum, env wasmvmtypes.Env, sudoMsg []byte, store wasmvm.KVStore, goapi wasmvm.GoAPI, querier wasmvm.Querier, gasMeter wasmvm.GasMeter, gasLimit uint64) (*wasmvmtypes.Response, uint64, error) {
+		store.Set([]byte("foo"), []byte("bar"))
+		panic("unexpected")
+	}
+
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil)
+	k := keepers.WasmKeeper
+	example := SeedNewContractInstance(t, ctx, keepers, &m)
+	setCallbackParams(ctx, k, 200000, types.DefaultCallbackGasPrice)
+	require.NoError(t, k.RegisterCallback(ctx, example.Contract, example.Contract, types.CallbackTriggerEndBlock, 0, []byte(`{}`), 200000, nil))
+
+	// a panic of the contract is recorded as a failed run and reverted
+	em := sdk.NewEventManager()
+	require.NotPanics(t, func() { k.RunEndBlockCallbacks(ctx.WithEventManager(em)) })
+	require.Len(t, em.Events(), 1)
+	assert.Equal(t, types.EventTypeRunCallback, em.Events()[0].Type)
+	var runErr string
+	for _, attr := range em.Events()[0].Attributes {
+		if string(attr.Key) == types.AttributeKeyError {
+			runErr = string(attr.Value)
+		}
+	}
+	assert.Contains(t, runErr, types.ErrCallbackPanic.Error())
+	assert.Nil(t, k.QueryRaw(ctx, example.Contract, []byte("foo")))
+	assert.NotNil(t, k.GetCallback(ctx, example.Contract, types.CallbackTriggerEndBlock, 0))
+}
+
+func TestRemoveCallbacksProposal(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil)
+	k, bankKeeper := keepers.WasmKeeper, keepers.BankKeeper
+	ctx = ctx.WithBlockHeight(10)
+
+	example := InstantiateHackatomExampleContract(t, ctx, keepers)
+	_, _, community := keyPubAddr()
+	setCallbackParams(ctx, k, 200000, types.DefaultCallbackGasPrice)
+	handler := NewWasmProposalHandler(k, types.EnableAllProposals)
+
+	proposal := types.RemoveCallbacksProposalFixture(func(p *types.RemoveCallbacksProposal) {
+		p.Contract = example.Contract.String()
+	})
+	err := handler(ctx, proposal)
+	require.True(t, types.ErrNotFound.Is(err), "got %+v", err)
+
+	deposit := sdk.NewCoins(sdk.NewInt64Coin("denom", 300))
+	fundAccounts(t, ctx, keepers.AccountKeeper, bankKeeper, example.Contract, deposit.Add(deposit...))
+	require.NoError(t, k.RegisterCallback(ctx, example.Contract, example.Contract, types.CallbackTriggerEndBlock, 0, stealFundsSudoMsg(t, community, 10), 200000, deposit))
+	require.NoError(t, k.RegisterCallback(ctx, example.Contract, example.Contract, types.CallbackTriggerAtHeight, 11, stealFundsSudoMsg(t, community, 5), 200000, deposit))
+
+	// the callbacks are removed and their deposits refunded
+	contractBalance := bankKeeper.GetAllBalances(ctx, example.Contract)
+	require.NoError(t, handler(ctx, proposal))
+	assert.Nil(t, k.GetCallback(ctx, example.Contract, types.CallbackTriggerEndBlock, 0))
+	assert.Nil(t, k.GetCallback(ctx, example.Contract, types.CallbackTriggerAtHeight, 11))
+	assert.Equal(t, contractBalance.Add(deposit...).Add(deposit...), bankKeeper.GetAllBalances(ctx, example.Contract))
+	assert.True(t, bankKeeper.GetAllBalances(ctx, CallbackEscrowAddress).IsZero())
+}
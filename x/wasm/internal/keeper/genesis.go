@@ -47,6 +47,12 @@ func InitGenesis(ctx sdk.Context, keeper *Keeper, data types.GenesisState, staki
 		maxContractID = i + 1 // not ideal but max(contractID) is not persisted otherwise
 	}
 
+	for i, callback := range data.Callbacks {
+		if err := keeper.importCallback(ctx, callback); err != nil {
+			return nil, sdkerrors.Wrapf(err, "callback number %d", i)
+		}
+	}
+
 	for i, seq := range data.Sequences {
 		err := keeper.importAutoIncrementID(ctx, seq.IDKey, seq.Value)
 		if err != nil {
@@ -119,6 +125,11 @@ func ExportGenesis(ctx sdk.Context, keeper *Keeper) *types.GenesisState {
 		return false
 	})
 
+	keeper.IterateCallbacks(ctx, func(callback types.Callback) bool {
+		genState.Callbacks = append(genState.Callbacks, callback)
+		return false
+	})
+
 	for _, k := range [][]byte{types.KeyLastCodeID, types.KeyLastInstanceID} {
 		genState.Sequences = append(genState.Sequences, types.Sequence{
 			IDKey: k,
@@ -17,7 +17,9 @@ import (
 	"github.com/line/lfb-sdk/store"
 	sdk "github.com/line/lfb-sdk/types"
 	authkeeper "github.com/line/lfb-sdk/x/auth/keeper"
+	authtypes "github.com/line/lfb-sdk/x/auth/types"
 	distributionkeeper "github.com/line/lfb-sdk/x/distribution/keeper"
+	govtypes "github.com/line/lfb-sdk/x/gov/types"
 	paramskeeper "github.com/line/lfb-sdk/x/params/keeper"
 	paramtypes "github.com/line/lfb-sdk/x/params/types"
 	stakingkeeper "github.com/line/lfb-sdk/x/staking/keeper"
@@ -52,12 +54,14 @@ func TestGenesisExportImport(t *testing.T) {
 			stateModels []types.Model
 			history     []types.ContractCodeHistoryEntry
 			pinned      bool
+			height      uint64
 		)
 		f.Fuzz(&codeInfo)
 		f.Fuzz(&contract)
 		f.Fuzz(&stateModels)
 		f.NilChance(0).Fuzz(&history)
 		f.Fuzz(&pinned)
+		f.Fuzz(&height)
 		creatorAddr, err := sdk.AccAddressFromBech32(codeInfo.Creator)
 		require.NoError(t, err)
 		codeID, err := srcKeeper.Create(srcCtx, creatorAddr, wasmCode, codeInfo.Source, codeInfo.Builder, &codeInfo.InstantiateConfig)
@@ -71,6 +75,14 @@ func TestGenesisExportImport(t *testing.T) {
 		srcKeeper.storeContractInfo(srcCtx, contractAddr, &contract)
 		srcKeeper.appendToContractHistory(srcCtx, contractAddr, history...)
 		srcKeeper.importContractState(srcCtx, contractAddr, stateModels)
+		srcKeeper.setCallback(srcCtx, types.Callback{
+			Contract:  contractAddr.String(),
+			Trigger:   types.CallbackTriggerAtHeight,
+			Height:    height%1_000_000 + 1,
+			Msg:       []byte(`{}`),
+			GasLimit:  1,
+			Depositor: codeInfo.Creator,
+		})
 	}
 	var wasmParams types.Params
 	f.NilChance(0).Fuzz(&wasmParams)
@@ -450,7 +462,17 @@ func TestImportContractWithCodeHistoryReset(t *testing.T) {
 		"max_wasm_code_size": 500000,
 		"gas_multiplier": 100,
 		"instance_cost": 40000,
-		"compile_cost": 2
+		"compile_cost": 2,
+		"storage_rent_period": "0",
+		"storage_rent_price": {
+			"denom": "stake",
+			"amount": "0.000000000000000000"
+		},
+		"max_callback_gas_limit": "0",
+		"callback_gas_price": {
+			"denom": "stake",
+			"amount": "0.000000000000000000"
+		}
 	},
   "codes": [
     {
@@ -647,9 +669,9 @@ func setupKeeper(t *testing.T) (*Keeper, sdk.Context, []sdk.StoreKey) {
 
 	encodingConfig := MakeEncodingConfig(t)
 	wasmConfig := wasmTypes.DefaultWasmConfig()
-	pk := paramskeeper.NewKeeper(encodingConfig.Marshaler, encodingConfig.Amino, keyParams)
+	pk := paramskeeper.NewKeeper(encodingConfig.Marshaler, encodingConfig.Amino, keyParams, authtypes.NewModuleAddress(govtypes.ModuleName).String())
 
-	srcKeeper := NewKeeper(encodingConfig.Marshaler, keyWasm, pk.Subspace(wasmTypes.DefaultParamspace), authkeeper.AccountKeeper{}, nil, stakingkeeper.Keeper{}, distributionkeeper.Keeper{}, nil, nil, nil, nil, nil, nil, tempDir, wasmConfig, SupportedFeatures, nil, nil)
+	srcKeeper := NewKeeper(encodingConfig.Marshaler, keyWasm, pk.Subspace(wasmTypes.DefaultParamspace), authkeeper.AccountKeeper{}, nil, stakingkeeper.Keeper{}, distributionkeeper.Keeper{}, nil, nil, nil, nil, nil, nil, nil, tempDir, wasmConfig, SupportedFeatures, authtypes.NewModuleAddress(govtypes.ModuleName).String(), nil, nil)
 	return &srcKeeper, ctx, []sdk.StoreKey{keyWasm, keyParams}
 }
 
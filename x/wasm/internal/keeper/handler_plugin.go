@@ -24,8 +24,8 @@ type DefaultMessageHandler struct {
 	encoders     MessageEncoders
 }
 
-func NewDefaultMessageHandler(router sdk.Router, encodeRouter types.Router, channelKeeper types.ChannelKeeper, capabilityKeeper types.CapabilityKeeper, unpacker codectypes.AnyUnpacker, customEncoders *MessageEncoders) DefaultMessageHandler {
-	encoders := DefaultEncoders(channelKeeper, capabilityKeeper, unpacker).Merge(customEncoders)
+func NewDefaultMessageHandler(router sdk.Router, encodeRouter types.Router, ics4Wrapper types.ICS4Wrapper, channelKeeper types.ChannelKeeper, capabilityKeeper types.CapabilityKeeper, unpacker codectypes.AnyUnpacker, customEncoders *MessageEncoders) DefaultMessageHandler {
+	encoders := DefaultEncoders(ics4Wrapper, channelKeeper, capabilityKeeper, unpacker).Merge(customEncoders)
 	return DefaultMessageHandler{
 		router:       router,
 		encodeRouter: encodeRouter,
@@ -49,11 +49,11 @@ type MessageEncoders struct {
 	Wasm     WasmEncoder
 }
 
-func DefaultEncoders(channelKeeper types.ChannelKeeper, capabilityKeeper types.CapabilityKeeper, unpacker codectypes.AnyUnpacker) MessageEncoders {
+func DefaultEncoders(ics4Wrapper types.ICS4Wrapper, channelKeeper types.ChannelKeeper, capabilityKeeper types.CapabilityKeeper, unpacker codectypes.AnyUnpacker) MessageEncoders {
 	return MessageEncoders{
 		Bank:     EncodeBankMsg,
 		Custom:   CustomMsg,
-		IBC:      EncodeIBCMsg(channelKeeper, capabilityKeeper),
+		IBC:      EncodeIBCMsg(ics4Wrapper, channelKeeper, capabilityKeeper),
 		Staking:  EncodeStakingMsg,
 		Stargate: EncodeStargateMsg(unpacker),
 		Wasm:     EncodeWasmMsg,
@@ -251,7 +251,9 @@ func EncodeWasmMsg(sender sdk.AccAddress, msg *wasmvmtypes.WasmMsg) ([]sdk.Msg,
 	}
 }
 
-func EncodeIBCMsg(channelKeeper types.ChannelKeeper, capabilityKeeper types.CapabilityKeeper) IBCEncoder {
+// EncodeIBCMsg encodes the IBC messages of a contract. Packets are sent through the ics4Wrapper so that
+// IBC middleware, like the fee middleware, can be stacked on top of the wasm module.
+func EncodeIBCMsg(ics4Wrapper types.ICS4Wrapper, channelKeeper types.ChannelKeeper, capabilityKeeper types.CapabilityKeeper) IBCEncoder {
 	return func(ctx sdk.Context, sender sdk.AccAddress, contractIBCPortID string, msg *wasmvmtypes.IBCMsg) ([]sdk.Msg, error) {
 		switch {
 		case msg.SendPacket != nil:
@@ -289,7 +291,7 @@ func EncodeIBCMsg(channelKeeper types.ChannelKeeper, capabilityKeeper types.Capa
 				convertWasmIBCTimeoutHeightToCosmosHeight(msg.SendPacket.TimeoutBlock),
 				convertWasmIBCTimeoutTimestampToCosmosTimestamp(msg.SendPacket.TimeoutTimestamp),
 			)
-			return nil, channelKeeper.SendPacket(ctx, channelCap, packet)
+			return nil, ics4Wrapper.SendPacket(ctx, channelCap, packet)
 		case msg.CloseChannel != nil:
 			return []sdk.Msg{&channeltypes.MsgChannelCloseInit{
 				PortId:    PortIDForContract(sender),
@@ -431,7 +431,7 @@ func TestEncoding(t *testing.T) {
 		},
 	}
 	encodingConfig := MakeEncodingConfig(t)
-	encoder := DefaultEncoders(nil, nil, encodingConfig.Marshaler)
+	encoder := DefaultEncoders(nil, nil, nil, encodingConfig.Marshaler)
 	for name, tc := range cases {
 		tc := tc
 		t.Run(name, func(t *testing.T) {
@@ -475,7 +475,7 @@ func TestEncodeIBCSendPacket(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			var gotPacket ibcexported.PacketI
 
-			var chanKeeper types.ChannelKeeper = &wasmtesting.MockChannelKeeper{
+			chanKeeper := &wasmtesting.MockChannelKeeper{
 				GetNextSequenceSendFn: func(ctx sdk.Context, portID, channelID string) (uint64, bool) {
 					return 1, true
 				},
@@ -497,7 +497,7 @@ func TestEncodeIBCSendPacket(t *testing.T) {
 				},
 			}
 			sender := RandomAccountAddress(t)
-			res, err := EncodeIBCMsg(chanKeeper, capKeeper)(ctx, sender, ibcPort, &wasmvmtypes.IBCMsg{SendPacket: &spec.srcMsg})
+			res, err := EncodeIBCMsg(chanKeeper, chanKeeper, capKeeper)(ctx, sender, ibcPort, &wasmvmtypes.IBCMsg{SendPacket: &spec.srcMsg})
 
 			require.NoError(t, err)
 			assert.Nil(t, res)
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"bytes"
+	"crypto/sha256"
 	"encoding/binary"
 	"fmt"
 	"path/filepath"
@@ -11,6 +12,7 @@ import (
 	sdk "github.com/line/lfb-sdk/types"
 	sdkerrors "github.com/line/lfb-sdk/types/errors"
 	authkeeper "github.com/line/lfb-sdk/x/auth/keeper"
+	authtypes "github.com/line/lfb-sdk/x/auth/types"
 	paramtypes "github.com/line/lfb-sdk/x/params/types"
 	"github.com/line/lfb-sdk/x/wasm/internal/types"
 	abci "github.com/line/ostracon/abci/types"
@@ -59,6 +61,9 @@ type Keeper struct {
 	queryGasLimit uint64
 	authZPolicy   AuthorizationPolicy
 	paramSpace    *paramtypes.Subspace
+	// authority is the address of the account whose messages are authorized
+	// like gov proposals, usually the gov module account
+	authority string
 }
 
 // NewKeeper creates a new contract Keeper instance
@@ -71,6 +76,7 @@ func NewKeeper(
 	bankKeeper types.BankKeeper,
 	stakingKeeper types.StakingKeeper,
 	distKeeper types.DistributionKeeper,
+	ics4Wrapper types.ICS4Wrapper,
 	channelKeeper types.ChannelKeeper,
 	portKeeper types.PortKeeper,
 	capabilityKeeper types.CapabilityKeeper,
@@ -80,6 +86,7 @@ func NewKeeper(
 	homeDir string,
 	wasmConfig types.WasmConfig,
 	supportedFeatures string,
+	authority string,
 	customEncoders *MessageEncoders,
 	customPlugins *QueryPlugins,
 	opts ...Option,
@@ -102,10 +109,11 @@ func NewKeeper(
 		ChannelKeeper:    channelKeeper,
 		portKeeper:       portKeeper,
 		capabilityKeeper: capabilityKeeper,
-		messenger:        NewDefaultMessageHandler(router, encodeRouter, channelKeeper, capabilityKeeper, cdc, customEncoders),
+		messenger:        NewDefaultMessageHandler(router, encodeRouter, ics4Wrapper, channelKeeper, capabilityKeeper, cdc, customEncoders),
 		queryGasLimit:    wasmConfig.SmartQueryGasLimit,
 		authZPolicy:      DefaultAuthorizationPolicy{},
 		paramSpace:       paramSpace,
+		authority:        authority,
 	}
 	keeper.queryPlugins = DefaultQueryPlugins(bankKeeper, stakingKeeper, distKeeper, channelKeeper, queryRouter, &keeper).Merge(customPlugins)
 	for _, o := range opts {
@@ -114,6 +122,12 @@ func NewKeeper(
 	return keeper
 }
 
+// GetAuthority returns the address of the account whose messages are
+// authorized like gov proposals
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
 func (k Keeper) getUploadAccessConfig(ctx sdk.Context) types.AccessConfig {
 	var a types.AccessConfig
 	k.paramSpace.Get(ctx, types.ParamStoreKeyUploadAccess, &a)
@@ -156,6 +170,12 @@ func (k Keeper) getCompileCost(ctx sdk.Context) uint64 {
 	return a
 }
 
+func (k Keeper) getMaxCallbackGasLimit(ctx sdk.Context) uint64 {
+	var a uint64
+	k.paramSpace.Get(ctx, types.ParamStoreKeyMaxCallbackGasLimit, &a)
+	return a
+}
+
 // GetParams returns the total set of wasm parameters.
 func (k Keeper) GetParams(ctx sdk.Context) types.Params {
 	var params types.Params
@@ -167,7 +187,9 @@ func (k Keeper) setParams(ctx sdk.Context, ps types.Params) {
 	k.paramSpace.SetParamSet(ctx, &ps)
 }
 
-// Create uploads and compiles a WASM contract, returning a short identifier for the contract
+// Create uploads and compiles a WASM contract, returning a short identifier for the contract.
+// Uploading byte code which is already stored creates a new code id with its own code info, which shares the
+// stored and compiled byte code of the existing code.
 func (k Keeper) Create(ctx sdk.Context, creator sdk.AccAddress, wasmCode []byte, source string, builder string, instantiateAccess *types.AccessConfig) (codeID uint64, err error) {
 	return k.create(ctx, creator, wasmCode, source, builder, instantiateAccess, k.authZPolicy)
 }
@@ -180,11 +202,17 @@ func (k Keeper) create(ctx sdk.Context, creator sdk.AccAddress, wasmCode []byte,
 	if err != nil {
 		return 0, sdkerrors.Wrap(types.ErrCreateFailed, err.Error())
 	}
-	ctx.GasMeter().ConsumeGas(k.getCompileCost(ctx)*uint64(len(wasmCode)), "Compiling WASM Bytecode")
-
-	codeHash, err := k.wasmer.Create(wasmCode)
-	if err != nil {
-		return 0, sdkerrors.Wrap(types.ErrCreateFailed, err.Error())
+	// identical byte code is not stored and compiled again but shared with the first upload
+	var codeHash []byte
+	checksum := sha256.Sum256(wasmCode)
+	if existingID := k.GetCodeIDByHash(ctx, checksum[:]); existingID != 0 {
+		codeHash = k.GetCodeInfo(ctx, existingID).CodeHash
+	} else {
+		ctx.GasMeter().ConsumeGas(k.getCompileCost(ctx)*uint64(len(wasmCode)), "Compiling WASM Bytecode")
+		codeHash, err = k.wasmer.Create(wasmCode)
+		if err != nil {
+			return 0, sdkerrors.Wrap(types.ErrCreateFailed, err.Error())
+		}
 	}
 	codeID = k.autoIncrementID(ctx, types.KeyLastCodeID)
 	if instantiateAccess == nil {
@@ -193,6 +221,7 @@ func (k Keeper) create(ctx sdk.Context, creator sdk.AccAddress, wasmCode []byte,
 	}
 	codeInfo := types.NewCodeInfo(codeHash, creator, source, builder, *instantiateAccess)
 	k.storeCodeInfo(ctx, codeID, codeInfo)
+	k.storeCodeHashIndex(ctx, codeHash, codeID)
 	return codeID, nil
 }
 
@@ -222,23 +251,85 @@ func (k Keeper) importCode(ctx sdk.Context, codeID uint64, codeInfo types.CodeIn
 	}
 	// 0x01 | codeID (uint64) -> ContractInfo
 	store.Set(key, k.cdc.MustMarshalBinaryBare(&codeInfo))
+	k.storeCodeHashIndex(ctx, codeInfo.CodeHash, codeID)
 	return nil
 }
 
+// storeCodeHashIndex indexes the code id by the code hash. Codes uploaded before the deduplication may share
+// a code hash, the lowest code id is kept for them.
+func (k Keeper) storeCodeHashIndex(ctx sdk.Context, codeHash []byte, codeID uint64) {
+	if existingID := k.GetCodeIDByHash(ctx, codeHash); existingID != 0 && existingID < codeID {
+		return
+	}
+	store := ctx.KVStore(k.storeKey)
+	// 0x0b | codeHash -> codeID (uint64)
+	store.Set(types.GetCodeHashIndexKey(codeHash), sdk.Uint64ToBigEndian(codeID))
+}
+
+// GetCodeIDByHash returns the code id of the wasm code with the code hash or 0 when not found
+func (k Keeper) GetCodeIDByHash(ctx sdk.Context, codeHash []byte) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetCodeHashIndexKey(codeHash))
+	if bz == nil {
+		return 0
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
 // Instantiate creates an instance of a WASM contract
 func (k Keeper) Instantiate(ctx sdk.Context, codeID uint64, creator, admin sdk.AccAddress, initMsg []byte, label string, deposit sdk.Coins) (sdk.AccAddress, []byte, error) {
-	return k.instantiate(ctx, codeID, creator, admin, initMsg, label, deposit, k.authZPolicy)
+	return k.instantiate(ctx, codeID, creator, admin, initMsg, label, deposit, k.classicAddressGenerator(), k.authZPolicy)
+}
+
+// Instantiate2 creates an instance of a WASM contract at an address derived from the creator, the code hash and
+// the salt. See types.BuildContractAddressPredictable.
+func (k Keeper) Instantiate2(ctx sdk.Context, codeID uint64, creator, admin sdk.AccAddress, initMsg []byte, label string, deposit sdk.Coins, salt []byte) (sdk.AccAddress, []byte, error) {
+	return k.instantiate(ctx, codeID, creator, admin, initMsg, label, deposit, predictableAddressGenerator(creator, salt), k.authZPolicy)
+}
+
+// isReusableAccount returns true if the account can become the account of a new contract. This is the case of a
+// plain account which never signed a tx, e.g. created by a transfer to a predictable contract address before the
+// contract was instantiated.
+func isReusableAccount(acc authtypes.AccountI) bool {
+	baseAcc, ok := acc.(*authtypes.BaseAccount)
+	return ok && baseAcc.GetPubKey() == nil && baseAcc.GetSequence() == 0
+}
+
+// addressGenerator builds the address of a new contract instance
+type addressGenerator func(ctx sdk.Context, codeID uint64, codeHash []byte) sdk.AccAddress
+
+// classicAddressGenerator derives the address from the code id and the instance sequence
+func (k Keeper) classicAddressGenerator() addressGenerator {
+	return func(ctx sdk.Context, codeID uint64, _ []byte) sdk.AccAddress {
+		return k.generateContractAddress(ctx, codeID)
+	}
 }
 
-func (k Keeper) instantiate(ctx sdk.Context, codeID uint64, creator, admin sdk.AccAddress, initMsg []byte, label string, deposit sdk.Coins, authZ AuthorizationPolicy) (sdk.AccAddress, []byte, error) {
+// predictableAddressGenerator derives the address from the creator, the code hash and the salt
+func predictableAddressGenerator(creator sdk.AccAddress, salt []byte) addressGenerator {
+	return func(_ sdk.Context, _ uint64, codeHash []byte) sdk.AccAddress {
+		return types.BuildContractAddressPredictable(codeHash, creator, salt)
+	}
+}
+
+func (k Keeper) instantiate(ctx sdk.Context, codeID uint64, creator, admin sdk.AccAddress, initMsg []byte, label string, deposit sdk.Coins, addressGen addressGenerator, authZ AuthorizationPolicy) (sdk.AccAddress, []byte, error) {
 	if !k.IsPinnedCode(ctx, codeID) {
 		ctx.GasMeter().ConsumeGas(k.getInstanceCost(ctx), "Loading CosmWasm module: instantiate")
 	}
 
+	// get contact info
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetCodeKey(codeID))
+	if bz == nil {
+		return nil, nil, sdkerrors.Wrap(types.ErrNotFound, "code")
+	}
+	var codeInfo types.CodeInfo
+	k.cdc.MustUnmarshalBinaryBare(bz, &codeInfo)
+
 	// create contract address
-	contractAddress := k.generateContractAddress(ctx, codeID)
+	contractAddress := addressGen(ctx, codeID, codeInfo.CodeHash)
 	existingAcct := k.accountKeeper.GetAccount(ctx, contractAddress)
-	if existingAcct != nil {
+	if existingAcct != nil && (k.containsContractInfo(ctx, contractAddress) || !isReusableAccount(existingAcct)) {
 		return nil, nil, sdkerrors.Wrap(types.ErrAccountExists, existingAcct.GetAddress().String())
 	}
 
@@ -248,22 +339,13 @@ func (k Keeper) instantiate(ctx sdk.Context, codeID uint64, creator, admin sdk.A
 			return nil, nil, err
 		}
 
-	} else {
+	} else if existingAcct == nil {
 		// create an empty account (so we don't have issues later)
 		// TODO: can we remove this?
 		contractAccount := k.accountKeeper.NewAccountWithAddress(ctx, contractAddress)
 		k.accountKeeper.SetAccount(ctx, contractAccount)
 	}
 
-	// get contact info
-	store := ctx.KVStore(k.storeKey)
-	bz := store.Get(types.GetCodeKey(codeID))
-	if bz == nil {
-		return nil, nil, sdkerrors.Wrap(types.ErrNotFound, "code")
-	}
-	var codeInfo types.CodeInfo
-	k.cdc.MustUnmarshalBinaryBare(bz, &codeInfo)
-
 	if !authZ.CanInstantiateContract(codeInfo.InstantiateConfig, creator) {
 		return nil, nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "can not instantiate")
 	}
@@ -273,10 +355,7 @@ func (k Keeper) instantiate(ctx sdk.Context, codeID uint64, creator, admin sdk.A
 	info := types.NewInfo(creator, deposit)
 
 	// create prefixed data store
-	// 0x03 | contractAddress (sdk.AccAddress)
-	prefixStoreKey := types.GetContractStorePrefix(contractAddress)
-	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), prefixStoreKey)
-	wasmStore := types.NewWasmStore(prefixStore)
+	wasmStore := types.NewWasmStore(k.contractStore(ctx, contractAddress))
 
 	// prepare querier
 	querier := NewQueryHandler(ctx, k.queryPlugins, contractAddress, k.getGasMultiplier(ctx))
@@ -420,9 +499,7 @@ func (k Keeper) migrate(ctx sdk.Context, contractAddress sdk.AccAddress, caller
 	// prepare querier
 	querier := NewQueryHandler(ctx, k.queryPlugins, contractAddress, k.getGasMultiplier(ctx))
 
-	prefixStoreKey := types.GetContractStorePrefix(contractAddress)
-	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), prefixStoreKey)
-	wasmStore := types.NewWasmStore(prefixStore)
+	wasmStore := types.NewWasmStore(k.contractStore(ctx, contractAddress))
 	gas := gasForContract(ctx, k.getGasMultiplier(ctx))
 	res, gasUsed, err := k.wasmer.Migrate(newCodeInfo.CodeHash, env, msg, &wasmStore, k.cosmwasmAPI(ctx), &querier, k.gasMeter(ctx), gas)
 	k.consumeGas(ctx, gasUsed)
@@ -648,25 +725,23 @@ func (k Keeper) QueryRaw(ctx sdk.Context, contractAddress sdk.AccAddress, key []
 	return prefixStore.Get(key)
 }
 
-func (k Keeper) contractInstance(ctx sdk.Context, contractAddress sdk.AccAddress) (types.ContractInfo, types.CodeInfo, prefix.Store, error) {
+func (k Keeper) contractInstance(ctx sdk.Context, contractAddress sdk.AccAddress) (types.ContractInfo, types.CodeInfo, sdk.KVStore, error) {
 	store := ctx.KVStore(k.storeKey)
 
 	contractBz := store.Get(types.GetContractAddressKey(contractAddress))
 	if contractBz == nil {
-		return types.ContractInfo{}, types.CodeInfo{}, prefix.Store{}, sdkerrors.Wrap(types.ErrNotFound, "contract")
+		return types.ContractInfo{}, types.CodeInfo{}, nil, sdkerrors.Wrap(types.ErrNotFound, "contract")
 	}
 	var contractInfo types.ContractInfo
 	k.cdc.MustUnmarshalBinaryBare(contractBz, &contractInfo)
 
 	codeInfoBz := store.Get(types.GetCodeKey(contractInfo.CodeID))
 	if codeInfoBz == nil {
-		return contractInfo, types.CodeInfo{}, prefix.Store{}, sdkerrors.Wrap(types.ErrNotFound, "code info")
+		return contractInfo, types.CodeInfo{}, nil, sdkerrors.Wrap(types.ErrNotFound, "code info")
 	}
 	var codeInfo types.CodeInfo
 	k.cdc.MustUnmarshalBinaryBare(codeInfoBz, &codeInfo)
-	prefixStoreKey := types.GetContractStorePrefix(contractAddress)
-	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), prefixStoreKey)
-	return contractInfo, codeInfo, prefixStore, nil
+	return contractInfo, codeInfo, k.contractStore(ctx, contractAddress), nil
 }
 
 func (k Keeper) GetContractInfo(ctx sdk.Context, contractAddress sdk.AccAddress) *types.ContractInfo {
@@ -711,8 +786,7 @@ func (k Keeper) GetContractState(ctx sdk.Context, contractAddress sdk.AccAddress
 }
 
 func (k Keeper) importContractState(ctx sdk.Context, contractAddress sdk.AccAddress, models []types.Model) error {
-	prefixStoreKey := types.GetContractStorePrefix(contractAddress)
-	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), prefixStoreKey)
+	prefixStore := k.contractStore(ctx, contractAddress)
 	for _, model := range models {
 		if model.Value == nil {
 			model.Value = []byte{}
@@ -781,21 +855,39 @@ func (k Keeper) PinCode(ctx sdk.Context, codeID uint64) error {
 	return nil
 }
 
-// UnpinCode removes the wasm contract from wasmvm cache
+// UnpinCode removes the wasm contract from wasmvm cache. The code stays in the cache while another pinned code ID
+// shares its code hash.
 func (k Keeper) UnpinCode(ctx sdk.Context, codeID uint64) error {
 	codeInfo := k.GetCodeInfo(ctx, codeID)
 	if codeInfo == nil {
 		return sdkerrors.Wrap(types.ErrNotFound, "code info")
 	}
-	if err := k.wasmer.Unpin(codeInfo.CodeHash); err != nil {
-		return sdkerrors.Wrap(types.ErrUnpinContractFailed, err.Error())
-	}
 
 	store := ctx.KVStore(k.storeKey)
 	store.Delete(types.GetPinnedCodeIndexPrefix(codeID))
+	if k.isCodeHashPinned(ctx, codeInfo.CodeHash) {
+		return nil
+	}
+	if err := k.wasmer.Unpin(codeInfo.CodeHash); err != nil {
+		return sdkerrors.Wrap(types.ErrUnpinContractFailed, err.Error())
+	}
 	return nil
 }
 
+// isCodeHashPinned returns true when a pinned code ID has the given code hash.
+func (k Keeper) isCodeHashPinned(ctx sdk.Context, codeHash []byte) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PinnedCodeIndexPrefix)
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		codeInfo := k.GetCodeInfo(ctx, types.ParsePinnedCodeIndex(iter.Key()))
+		if codeInfo != nil && bytes.Equal(codeInfo.CodeHash, codeHash) {
+			return true
+		}
+	}
+	return false
+}
+
 // IsPinnedCode returns true when codeID is pinned in wasmvm cache
 func (k Keeper) IsPinnedCode(ctx sdk.Context, codeID uint64) bool {
 	store := ctx.KVStore(k.storeKey)
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"bytes"
+	"crypto/sha256"
 	"encoding/json"
 	"errors"
 	"io/ioutil"
@@ -89,6 +90,9 @@ func TestCreateStoresInstantiatePermission(t *testing.T) {
 				GasMultiplier:                types.DefaultGasMultiplier,
 				InstanceCost:                 types.DefaultInstanceCost,
 				CompileCost:                  types.DefaultCompileCost,
+				StorageRentPrice:             types.DefaultStorageRentPrice,
+				CallbackGasPrice:             types.DefaultCallbackGasPrice,
+				MaxBlockCallbackGas:          types.DefaultMaxBlockCallbackGas,
 			})
 			fundAccounts(t, ctx, accKeeper, bankKeeper, myAddr, deposit)
 
@@ -165,17 +169,82 @@ func TestCreateDuplicate(t *testing.T) {
 	require.Equal(t, uint64(1), contractID)
 
 	// create second copy
+	gasBefore := ctx.GasMeter().GasConsumed()
 	duplicateID, err := keeper.Create(ctx, creator, wasmCode, "https://github.com/line/lfb-sdk/blob/main/x/wasm/internal/keeper/testdata/hackatom.wasm", "any/builder:tag", nil)
 	require.NoError(t, err)
+	// it gets a new code id but the code is not stored and compiled again
 	require.Equal(t, uint64(2), duplicateID)
+	assert.Less(t, ctx.GasMeter().GasConsumed()-gasBefore, keeper.getCompileCost(ctx)*uint64(len(wasmCode)))
+	assert.Equal(t, keeper.GetCodeInfo(ctx, contractID).CodeHash, keeper.GetCodeInfo(ctx, duplicateID).CodeHash)
 
-	// and verify both content is proper
-	storedCode, err := keeper.GetByteCode(ctx, contractID)
+	// also when gzipped, with its own code info
+	gzippedCode, err := ioutil.ReadFile("./testdata/hackatom.wasm.gzip")
 	require.NoError(t, err)
-	require.Equal(t, wasmCode, storedCode)
-	storedCode, err = keeper.GetByteCode(ctx, duplicateID)
+	otherAccess := types.AllowNobody
+	duplicateID, err = keeper.Create(ctx, creator, gzippedCode, "", "", &otherAccess)
 	require.NoError(t, err)
-	require.Equal(t, wasmCode, storedCode)
+	require.Equal(t, uint64(3), duplicateID)
+	duplicateInfo := keeper.GetCodeInfo(ctx, duplicateID)
+	assert.Equal(t, keeper.GetCodeInfo(ctx, contractID).CodeHash, duplicateInfo.CodeHash)
+	assert.Equal(t, "", duplicateInfo.Source)
+	assert.Equal(t, otherAccess, duplicateInfo.InstantiateConfig)
+
+	// and verify the content is proper
+	for _, codeID := range []uint64{contractID, duplicateID} {
+		storedCode, err := keeper.GetByteCode(ctx, codeID)
+		require.NoError(t, err)
+		require.Equal(t, wasmCode, storedCode)
+	}
+	codeHash := sha256.Sum256(wasmCode)
+	assert.Equal(t, contractID, keeper.GetCodeIDByHash(ctx, codeHash[:]))
+
+	// other code gets a new code id
+	otherCode, err := ioutil.ReadFile("./testdata/burner.wasm")
+	require.NoError(t, err)
+	otherID, err := keeper.Create(ctx, creator, otherCode, "", "", nil)
+	require.NoError(t, err)
+	require.Equal(t, uint64(4), otherID)
+}
+
+func TestUnpinCodeSharedHash(t *testing.T) {
+	// the cache holds a code once, whatever the number of times it is pinned
+	pinned := make(map[string]bool)
+	var m wasmtesting.MockWasmer
+	m.CreateFn = wasmtesting.HashOnlyCreateFn
+	m.AnalyzeCodeFn = wasmtesting.WithoutIBCAnalyzeFn
+	m.PinFn = func(checksum wasmvm.Checksum) error {
+		pinned[string(checksum)] = true
+		return nil
+	}
+	m.UnpinFn = func(checksum wasmvm.Checksum) error {
+		delete(pinned, string(checksum))
+		return nil
+	}
+
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil)
+	k := keepers.WasmKeeper
+	k.wasmer = &m
+	creator := createFakeFundedAccount(t, ctx, keepers.AccountKeeper, keepers.BankKeeper, sdk.NewCoins(sdk.NewInt64Coin("denom", 100000)))
+
+	wasmCode := append(wasmIdent, []byte("shared")...)
+	codeID, err := k.Create(ctx, creator, wasmCode, "", "", nil)
+	require.NoError(t, err)
+	duplicateID, err := k.Create(ctx, creator, wasmCode, "", "", nil)
+	require.NoError(t, err)
+	require.Equal(t, k.GetCodeInfo(ctx, codeID).CodeHash, k.GetCodeInfo(ctx, duplicateID).CodeHash)
+
+	require.NoError(t, k.PinCode(ctx, codeID))
+	require.NoError(t, k.PinCode(ctx, duplicateID))
+
+	// the code stays in the cache while the duplicate is pinned
+	require.NoError(t, k.UnpinCode(ctx, codeID))
+	assert.False(t, k.IsPinnedCode(ctx, codeID))
+	assert.True(t, k.IsPinnedCode(ctx, duplicateID))
+	assert.True(t, pinned[string(k.GetCodeInfo(ctx, duplicateID).CodeHash)])
+
+	require.NoError(t, k.UnpinCode(ctx, duplicateID))
+	assert.False(t, k.IsPinnedCode(ctx, duplicateID))
+	assert.Empty(t, pinned)
 }
 
 func TestCreateWithSimulation(t *testing.T) {
@@ -307,6 +376,77 @@ func TestInstantiate(t *testing.T) {
 	assert.Equal(t, exp, keeper.GetContractHistory(ctx, gotContractAddr))
 }
 
+func TestInstantiate2(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil)
+	accKeeper, keeper, bankKeeper := keepers.AccountKeeper, keepers.WasmKeeper, keepers.BankKeeper
+
+	deposit := sdk.NewCoins(sdk.NewInt64Coin("denom", 100000))
+	creator := createFakeFundedAccount(t, ctx, accKeeper, bankKeeper, deposit)
+	otherCreator := createFakeFundedAccount(t, ctx, accKeeper, bankKeeper, deposit)
+
+	wasmCode, err := ioutil.ReadFile("./testdata/hackatom.wasm")
+	require.NoError(t, err)
+	codeID, err := keeper.Create(ctx, creator, wasmCode, "", "", nil)
+	require.NoError(t, err)
+	codeInfo := keeper.GetCodeInfo(ctx, codeID)
+	require.NotNil(t, codeInfo)
+
+	_, _, bob := keyPubAddr()
+	_, _, fred := keyPubAddr()
+	initMsgBz, err := json.Marshal(HackatomExampleInitMsg{Verifier: fred, Beneficiary: bob})
+	require.NoError(t, err)
+
+	salt := []byte("my salt")
+	expAddr := types.BuildContractAddressPredictable(codeInfo.CodeHash, creator, salt)
+	instanceSeq := keeper.peekAutoIncrementID(ctx, types.KeyLastInstanceID)
+
+	gotContractAddr, _, err := keeper.Instantiate2(ctx, codeID, creator, nil, initMsgBz, "demo contract 1", nil, salt)
+	require.NoError(t, err)
+	assert.Equal(t, expAddr, gotContractAddr)
+	assert.Equal(t, instanceSeq, keeper.peekAutoIncrementID(ctx, types.KeyLastInstanceID))
+
+	info := keeper.GetContractInfo(ctx, gotContractAddr)
+	require.NotNil(t, info)
+	assert.Equal(t, creator.String(), info.Creator)
+	assert.Equal(t, codeID, info.CodeID)
+
+	// same creator, code and salt
+	_, _, err = keeper.Instantiate2(ctx, codeID, creator, nil, initMsgBz, "demo contract 2", nil, salt)
+	require.True(t, types.ErrAccountExists.Is(err), "got %+v", err)
+
+	// other salt
+	gotContractAddr, _, err = keeper.Instantiate2(ctx, codeID, creator, nil, initMsgBz, "demo contract 3", nil, []byte("other salt"))
+	require.NoError(t, err)
+	assert.Equal(t, types.BuildContractAddressPredictable(codeInfo.CodeHash, creator, []byte("other salt")), gotContractAddr)
+
+	// other creator
+	gotContractAddr, _, err = keeper.Instantiate2(ctx, codeID, otherCreator, nil, initMsgBz, "demo contract 4", nil, salt)
+	require.NoError(t, err)
+	assert.Equal(t, types.BuildContractAddressPredictable(codeInfo.CodeHash, otherCreator, salt), gotContractAddr)
+
+	// unknown code
+	_, _, err = keeper.Instantiate2(ctx, codeID+1, creator, nil, initMsgBz, "demo contract 5", nil, salt)
+	require.True(t, types.ErrNotFound.Is(err), "got %+v", err)
+
+	// an account funded before the instantiation is reused
+	preFunded := types.BuildContractAddressPredictable(codeInfo.CodeHash, creator, []byte("pre-funded salt"))
+	funds := sdk.NewCoins(sdk.NewInt64Coin("denom", 100))
+	require.NoError(t, bankKeeper.SendCoins(ctx, otherCreator, preFunded, funds))
+	gotContractAddr, _, err = keeper.Instantiate2(ctx, codeID, creator, nil, initMsgBz, "demo contract 6", deposit.Sub(funds), []byte("pre-funded salt"))
+	require.NoError(t, err)
+	assert.Equal(t, preFunded, gotContractAddr)
+	assert.Equal(t, deposit, bankKeeper.GetAllBalances(ctx, preFunded))
+	require.NotNil(t, keeper.GetContractInfo(ctx, preFunded))
+
+	// but not an account which signed a tx
+	used := types.BuildContractAddressPredictable(codeInfo.CodeHash, creator, []byte("used salt"))
+	usedAcc := accKeeper.NewAccountWithAddress(ctx, used)
+	require.NoError(t, usedAcc.SetSequence(1))
+	accKeeper.SetAccount(ctx, usedAcc)
+	_, _, err = keeper.Instantiate2(ctx, codeID, creator, nil, initMsgBz, "demo contract 7", nil, []byte("used salt"))
+	require.True(t, types.ErrAccountExists.Is(err), "got %+v", err)
+}
+
 func TestInstantiateWithDeposit(t *testing.T) {
 	wasmCode, err := ioutil.ReadFile("./testdata/hackatom.wasm")
 	require.NoError(t, err)
@@ -813,7 +953,7 @@ func TestMigrate(t *testing.T) {
 	fred := createFakeFundedAccount(t, ctx, accKeeper, bankKeeper, topUp)
 
 	originalCodeID := StoreHackatomExampleContract(t, ctx, keepers).CodeID
-	newCodeID := StoreHackatomExampleContract(t, ctx, keepers).CodeID
+	newCodeID := StoreHackatomExampleContractCopy(t, ctx, keepers, "copy").CodeID
 	ibcCodeID := StoreIBCReflectContract(t, ctx, keepers).CodeID
 	require.NotEqual(t, originalCodeID, newCodeID)
 
@@ -1098,7 +1238,7 @@ func TestMigrateInactiveContract(t *testing.T) {
 	fred := createFakeFundedAccount(t, ctx, accKeeper, bankKeeper, topUp)
 
 	originalCodeID := StoreHackatomExampleContract(t, ctx, keepers).CodeID
-	newCodeID := StoreHackatomExampleContract(t, ctx, keepers).CodeID
+	newCodeID := StoreHackatomExampleContractCopy(t, ctx, keepers, "copy").CodeID
 	require.NotEqual(t, originalCodeID, newCodeID)
 
 	anyAddr := RandomAccountAddress(t)
@@ -0,0 +1,64 @@
+package keeper
+
+import (
+	"github.com/line/lfb-sdk/store/prefix"
+	sdk "github.com/line/lfb-sdk/types"
+	"github.com/line/lfb-sdk/x/wasm/internal/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates from version 1 to 2. It stores the size of the data written by every contract before the
+// storage was tracked and sets the storage rent and callback params, which disable the rent and the callbacks until
+// they are changed.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	k := m.keeper
+	if !k.paramSpace.Has(ctx, types.ParamStoreKeyStorageRentPeriod) {
+		k.paramSpace.Set(ctx, types.ParamStoreKeyStorageRentPeriod, uint64(types.DefaultStorageRentPeriod))
+	}
+	if !k.paramSpace.Has(ctx, types.ParamStoreKeyStorageRentPrice) {
+		k.paramSpace.Set(ctx, types.ParamStoreKeyStorageRentPrice, types.DefaultStorageRentPrice)
+	}
+	if !k.paramSpace.Has(ctx, types.ParamStoreKeyMaxCallbackGasLimit) {
+		k.paramSpace.Set(ctx, types.ParamStoreKeyMaxCallbackGasLimit, uint64(types.DefaultMaxCallbackGasLimit))
+	}
+	if !k.paramSpace.Has(ctx, types.ParamStoreKeyCallbackGasPrice) {
+		k.paramSpace.Set(ctx, types.ParamStoreKeyCallbackGasPrice, types.DefaultCallbackGasPrice)
+	}
+	if !k.paramSpace.Has(ctx, types.ParamStoreKeyMaxBlockCallbackGas) {
+		k.paramSpace.Set(ctx, types.ParamStoreKeyMaxBlockCallbackGas, uint64(types.DefaultMaxBlockCallbackGas))
+	}
+
+	// the contracts are collected first, as the store must not be written while iterating it
+	var contracts []sdk.AccAddress
+	k.IterateContractInfo(ctx, func(contractAddress sdk.AccAddress, _ types.ContractInfo) bool {
+		contracts = append(contracts, contractAddress)
+		return false
+	})
+
+	store := ctx.KVStore(k.storeKey)
+	for _, contractAddress := range contracts {
+		var size uint64
+		iter := prefix.NewStore(store, types.GetContractStorePrefix(contractAddress)).Iterator(nil, nil)
+		for ; iter.Valid(); iter.Next() {
+			size += uint64(len(iter.Key()) + len(iter.Value()))
+		}
+		iter.Close()
+
+		sizeKey := types.GetContractStorageSizeKey(contractAddress)
+		if size == 0 {
+			store.Delete(sizeKey)
+		} else {
+			store.Set(sizeKey, sdk.Uint64ToBigEndian(size))
+		}
+	}
+	return nil
+}
@@ -0,0 +1,50 @@
+package keeper
+
+import (
+	"testing"
+
+	paramstypes "github.com/line/lfb-sdk/x/params/types"
+	"github.com/line/lfb-sdk/x/wasm/internal/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMigrate1to2(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil)
+	k := keepers.WasmKeeper
+	example := InstantiateHackatomExampleContract(t, ctx, keepers)
+	size := k.GetContractStorageSize(ctx, example.Contract)
+	require.NotZero(t, size)
+
+	// entries written before the storage was tracked
+	store := ctx.KVStore(k.storeKey)
+	store.Set(append(types.GetContractStorePrefix(example.Contract), []byte("foo")...), []byte("bar"))
+	store.Delete(types.GetContractStorageSizeKey(example.Contract))
+	require.Zero(t, k.GetContractStorageSize(ctx, example.Contract))
+
+	// a param space holding only the params of version 1, loaded without cached values as after the upgrade
+	encodingConfig := MakeEncodingConfig(t)
+	v1Keeper := *k
+	v1Keeper.paramSpace = paramstypes.NewSubspace(encodingConfig.Marshaler, encodingConfig.Amino, keepers.ParamsKey, "wasmv1").
+		WithKeyTable(types.ParamKeyTable())
+	params := types.DefaultParams()
+	for _, pair := range params.ParamSetPairs() {
+		switch string(pair.Key) {
+		case string(types.ParamStoreKeyStorageRentPeriod), string(types.ParamStoreKeyStorageRentPrice),
+			string(types.ParamStoreKeyMaxCallbackGasLimit), string(types.ParamStoreKeyCallbackGasPrice),
+			string(types.ParamStoreKeyMaxBlockCallbackGas):
+			continue
+		}
+		v1Keeper.paramSpace.Set(ctx, pair.Key, pair.Value)
+	}
+
+	require.NoError(t, NewMigrator(v1Keeper).Migrate1to2(ctx))
+	assert.Equal(t, size+6, v1Keeper.GetContractStorageSize(ctx, example.Contract))
+	assert.Equal(t, types.DefaultParams(), v1Keeper.GetParams(ctx))
+
+	// the end blocker reads every param added by the migration
+	require.NotPanics(t, func() {
+		v1Keeper.RunEndBlockCallbacks(ctx)
+		v1Keeper.ChargeStorageRent(ctx)
+	})
+}
@@ -3,6 +3,8 @@ package keeper
 import (
 	"context"
 	"fmt"
+	"strconv"
+	"strings"
 
 	sdk "github.com/line/lfb-sdk/types"
 	sdkerrors "github.com/line/lfb-sdk/types/errors"
@@ -19,13 +21,30 @@ func NewMsgServerImpl(k *Keeper) types.MsgServer {
 	return &msgServer{keeper: k}
 }
 
+// authZPolicy returns the authorization policy applied to the given sender.
+// The authority is granted the same permissions as gov proposals.
+func (m msgServer) authZPolicy(sender string) AuthorizationPolicy {
+	if sender == m.keeper.authority {
+		return GovAuthorizationPolicy{}
+	}
+	return m.keeper.authZPolicy
+}
+
+// requireAuthority returns an error unless the given address is the authority
+func (m msgServer) requireAuthority(authority string) error {
+	if m.keeper.authority != authority {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "expected %s got %s", m.keeper.authority, authority)
+	}
+	return nil
+}
+
 func (m msgServer) StoreCode(goCtx context.Context, msg *types.MsgStoreCode) (*types.MsgStoreCodeResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
 	if err != nil {
 		return nil, sdkerrors.Wrap(err, "sender")
 	}
-	codeID, err := m.keeper.Create(ctx, senderAddr, msg.WASMByteCode, msg.Source, msg.Builder, msg.InstantiatePermission)
+	codeID, err := m.keeper.create(ctx, senderAddr, msg.WASMByteCode, msg.Source, msg.Builder, msg.InstantiatePermission, m.authZPolicy(msg.Sender))
 	if err != nil {
 		return nil, err
 	}
@@ -59,7 +78,7 @@ func (m msgServer) InstantiateContract(goCtx context.Context, msg *types.MsgInst
 		}
 	}
 
-	contractAddr, data, err := m.keeper.Instantiate(ctx, msg.CodeID, senderAddr, adminAddr, msg.InitMsg, msg.Label, msg.Funds)
+	contractAddr, data, err := m.keeper.instantiate(ctx, msg.CodeID, senderAddr, adminAddr, msg.InitMsg, msg.Label, msg.Funds, m.keeper.classicAddressGenerator(), m.authZPolicy(msg.Sender))
 	if err != nil {
 		return nil, err
 	}
@@ -81,13 +100,49 @@ func (m msgServer) InstantiateContract(goCtx context.Context, msg *types.MsgInst
 	}, nil
 }
 
+func (m msgServer) InstantiateContract2(goCtx context.Context, msg *types.MsgInstantiateContract2) (*types.MsgInstantiateContract2Response, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "sender")
+	}
+	var adminAddr sdk.AccAddress
+	if msg.Admin != "" {
+		if adminAddr, err = sdk.AccAddressFromBech32(msg.Admin); err != nil {
+			return nil, sdkerrors.Wrap(err, "admin")
+		}
+	}
+
+	contractAddr, data, err := m.keeper.instantiate(ctx, msg.CodeID, senderAddr, adminAddr, msg.InitMsg, msg.Label, msg.Funds, predictableAddressGenerator(senderAddr, msg.Salt), m.authZPolicy(msg.Sender))
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+	))
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeInstantiateContract,
+		sdk.NewAttribute(types.AttributeKeyCodeID, fmt.Sprintf("%d", msg.CodeID)),
+		sdk.NewAttribute(types.AttributeKeyContract, contractAddr.String()),
+	))
+
+	return &types.MsgInstantiateContract2Response{
+		Address: contractAddr.String(),
+		Data:    data,
+	}, nil
+}
+
 func (m msgServer) StoreCodeAndInstantiateContract(goCtx context.Context, msg *types.MsgStoreCodeAndInstantiateContract) (*types.MsgStoreCodeAndInstantiateContractResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
 	if err != nil {
 		return nil, sdkerrors.Wrap(err, "sender")
 	}
-	codeID, err := m.keeper.Create(ctx, senderAddr, msg.WASMByteCode, msg.Source, msg.Builder, msg.InstantiatePermission)
+	codeID, err := m.keeper.create(ctx, senderAddr, msg.WASMByteCode, msg.Source, msg.Builder, msg.InstantiatePermission, m.authZPolicy(msg.Sender))
 	if err != nil {
 		return nil, err
 	}
@@ -170,7 +225,7 @@ func (m msgServer) MigrateContract(goCtx context.Context, msg *types.MsgMigrateC
 		return nil, sdkerrors.Wrap(err, "contract")
 	}
 
-	res, err := m.keeper.Migrate(ctx, contractAddr, senderAddr, msg.CodeID, msg.MigrateMsg)
+	res, err := m.keeper.migrate(ctx, contractAddr, senderAddr, msg.CodeID, msg.MigrateMsg, m.authZPolicy(msg.Sender))
 	if err != nil {
 		return nil, err
 	}
@@ -206,7 +261,7 @@ func (m msgServer) UpdateAdmin(goCtx context.Context, msg *types.MsgUpdateAdmin)
 		return nil, sdkerrors.Wrap(err, "new admin")
 	}
 
-	if err := m.keeper.UpdateContractAdmin(ctx, contractAddr, senderAddr, newAdminAddr); err != nil {
+	if err := m.keeper.setContractAdmin(ctx, contractAddr, senderAddr, newAdminAddr, m.authZPolicy(msg.Sender)); err != nil {
 		return nil, err
 	}
 
@@ -234,7 +289,7 @@ func (m msgServer) ClearAdmin(goCtx context.Context, msg *types.MsgClearAdmin) (
 		return nil, sdkerrors.Wrap(err, "contract")
 	}
 
-	if err := m.keeper.ClearContractAdmin(ctx, contractAddr, senderAddr); err != nil {
+	if err := m.keeper.setContractAdmin(ctx, contractAddr, senderAddr, nil, m.authZPolicy(msg.Sender)); err != nil {
 		return nil, err
 	}
 
@@ -264,7 +319,7 @@ func (m msgServer) UpdateContractStatus(goCtx context.Context, msg *types.MsgUpd
 		return nil, sdkerrors.Wrap(err, "contract")
 	}
 
-	if err = m.keeper.UpdateContractStatus(ctx, contractAddr, senderAddr, msg.Status); err != nil {
+	if err = m.keeper.updateContractStatus(ctx, contractAddr, senderAddr, msg.Status, m.authZPolicy(msg.Sender)); err != nil {
 		return nil, err
 	}
 
@@ -283,3 +338,135 @@ func (m msgServer) UpdateContractStatus(goCtx context.Context, msg *types.MsgUpd
 
 	return &types.MsgUpdateContractStatusResponse{}, nil
 }
+
+// RegisterCallback handles MsgRegisterCallback
+// CONTRACT: msg.validateBasic() must be called before calling this
+func (m msgServer) RegisterCallback(goCtx context.Context, msg *types.MsgRegisterCallback) (*types.MsgRegisterCallbackResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "sender")
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(msg.Contract)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "contract")
+	}
+
+	if err := m.keeper.RegisterCallback(ctx, contractAddr, senderAddr, msg.Trigger, msg.Height, msg.Msg, msg.GasLimit, msg.Deposit); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+		sdk.NewEvent(
+			types.EventTypeRegisterCallback,
+			sdk.NewAttribute(types.AttributeKeyContract, msg.Contract),
+			sdk.NewAttribute(types.AttributeKeyTrigger, msg.Trigger.String()),
+			sdk.NewAttribute(types.AttributeKeyHeight, strconv.FormatUint(msg.Height, 10)),
+		),
+	})
+
+	return &types.MsgRegisterCallbackResponse{}, nil
+}
+
+// CancelCallback handles MsgCancelCallback
+// CONTRACT: msg.validateBasic() must be called before calling this
+func (m msgServer) CancelCallback(goCtx context.Context, msg *types.MsgCancelCallback) (*types.MsgCancelCallbackResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "sender")
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(msg.Contract)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "contract")
+	}
+
+	if err := m.keeper.CancelCallback(ctx, contractAddr, senderAddr, msg.Trigger, msg.Height); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+	))
+
+	return &types.MsgCancelCallbackResponse{}, nil
+}
+
+// PinCodes handles MsgPinCodes
+// CONTRACT: msg.validateBasic() must be called before calling this
+func (m msgServer) PinCodes(goCtx context.Context, msg *types.MsgPinCodes) (*types.MsgPinCodesResponse, error) {
+	if err := m.requireAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	for _, codeID := range msg.CodeIDs {
+		if err := m.keeper.PinCode(ctx, codeID); err != nil {
+			return nil, sdkerrors.Wrapf(err, "code id: %d", codeID)
+		}
+	}
+	s := make([]string, len(msg.CodeIDs))
+	for i, v := range msg.CodeIDs {
+		s[i] = strconv.FormatUint(v, 10)
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypePinCode,
+		sdk.NewAttribute(types.AttributeKeyCodeIDs, strings.Join(s, ",")),
+	))
+
+	return &types.MsgPinCodesResponse{}, nil
+}
+
+// UnpinCodes handles MsgUnpinCodes
+// CONTRACT: msg.validateBasic() must be called before calling this
+func (m msgServer) UnpinCodes(goCtx context.Context, msg *types.MsgUnpinCodes) (*types.MsgUnpinCodesResponse, error) {
+	if err := m.requireAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	for _, codeID := range msg.CodeIDs {
+		if err := m.keeper.UnpinCode(ctx, codeID); err != nil {
+			return nil, sdkerrors.Wrapf(err, "code id: %d", codeID)
+		}
+	}
+	s := make([]string, len(msg.CodeIDs))
+	for i, v := range msg.CodeIDs {
+		s[i] = strconv.FormatUint(v, 10)
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeUnpinCode,
+		sdk.NewAttribute(types.AttributeKeyCodeIDs, strings.Join(s, ",")),
+	))
+
+	return &types.MsgUnpinCodesResponse{}, nil
+}
+
+// RemoveCallbacks handles MsgRemoveCallbacks
+// CONTRACT: msg.validateBasic() must be called before calling this
+func (m msgServer) RemoveCallbacks(goCtx context.Context, msg *types.MsgRemoveCallbacks) (*types.MsgRemoveCallbacksResponse, error) {
+	if err := m.requireAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	contractAddr, err := sdk.AccAddressFromBech32(msg.Contract)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "contract")
+	}
+
+	if err := m.keeper.removeContractCallbacks(ctx, contractAddr); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRemoveCallbacksResponse{}, nil
+}
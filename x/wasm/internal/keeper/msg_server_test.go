@@ -0,0 +1,95 @@
+package keeper
+
+import (
+	"io/ioutil"
+	"testing"
+
+	sdk "github.com/line/lfb-sdk/types"
+	sdkerrors "github.com/line/lfb-sdk/types/errors"
+	authtypes "github.com/line/lfb-sdk/x/auth/types"
+	govtypes "github.com/line/lfb-sdk/x/gov/types"
+	"github.com/line/lfb-sdk/x/wasm/internal/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgServerGovAuthority(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil)
+	k := keepers.WasmKeeper
+	msgServer := NewMsgServerImpl(k)
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	govAddr := authtypes.NewModuleAddress(govtypes.ModuleName)
+	require.Equal(t, govAddr.String(), k.GetAuthority())
+	_, _, otherAddr := keyPubAddr()
+
+	example := InstantiateHackatomExampleContract(t, ctx, keepers)
+	params := k.GetParams(ctx)
+	params.CodeUploadAccess = types.AllowNobody
+	params.ContractStatusAccess = types.AllowNobody
+	k.setParams(ctx, params)
+
+	wasmCode, err := ioutil.ReadFile("./testdata/hackatom.wasm")
+	require.NoError(t, err)
+
+	// the authority stores code regardless of the upload access
+	_, err = msgServer.StoreCode(goCtx, &types.MsgStoreCode{Sender: otherAddr.String(), WASMByteCode: wasmCode})
+	assert.True(t, sdkerrors.ErrUnauthorized.Is(err), "got %+v", err)
+	_, err = msgServer.StoreCode(goCtx, &types.MsgStoreCode{Sender: govAddr.String(), WASMByteCode: wasmCode})
+	require.NoError(t, err)
+
+	// the authority modifies the contract without being its admin
+	_, err = msgServer.ClearAdmin(goCtx, &types.MsgClearAdmin{Sender: otherAddr.String(), Contract: example.Contract.String()})
+	assert.True(t, sdkerrors.ErrUnauthorized.Is(err), "got %+v", err)
+	_, err = msgServer.ClearAdmin(goCtx, &types.MsgClearAdmin{Sender: govAddr.String(), Contract: example.Contract.String()})
+	require.NoError(t, err)
+	assert.Empty(t, k.GetContractInfo(ctx, example.Contract).Admin)
+
+	// the authority updates the contract status regardless of the access
+	statusMsg := types.MsgUpdateContractStatus{Contract: example.Contract.String(), Status: types.ContractStatusInactive}
+	statusMsg.Sender = otherAddr.String()
+	_, err = msgServer.UpdateContractStatus(goCtx, &statusMsg)
+	assert.True(t, sdkerrors.ErrUnauthorized.Is(err), "got %+v", err)
+	statusMsg.Sender = govAddr.String()
+	_, err = msgServer.UpdateContractStatus(goCtx, &statusMsg)
+	require.NoError(t, err)
+	assert.Equal(t, types.ContractStatusInactive, k.GetContractInfo(ctx, example.Contract).Status)
+}
+
+func TestMsgServerAuthorityOnlyMsgs(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil)
+	k := keepers.WasmKeeper
+	msgServer := NewMsgServerImpl(k)
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	govAddr := authtypes.NewModuleAddress(govtypes.ModuleName)
+	_, _, otherAddr := keyPubAddr()
+	example := InstantiateHackatomExampleContract(t, ctx, keepers)
+	codeIDs := []uint64{example.CodeID}
+
+	_, err := msgServer.PinCodes(goCtx, &types.MsgPinCodes{Authority: otherAddr.String(), CodeIDs: codeIDs})
+	assert.True(t, sdkerrors.ErrUnauthorized.Is(err), "got %+v", err)
+	assert.False(t, k.IsPinnedCode(ctx, example.CodeID))
+	_, err = msgServer.PinCodes(goCtx, &types.MsgPinCodes{Authority: govAddr.String(), CodeIDs: codeIDs})
+	require.NoError(t, err)
+	assert.True(t, k.IsPinnedCode(ctx, example.CodeID))
+
+	_, err = msgServer.UnpinCodes(goCtx, &types.MsgUnpinCodes{Authority: otherAddr.String(), CodeIDs: codeIDs})
+	assert.True(t, sdkerrors.ErrUnauthorized.Is(err), "got %+v", err)
+	assert.True(t, k.IsPinnedCode(ctx, example.CodeID))
+	_, err = msgServer.UnpinCodes(goCtx, &types.MsgUnpinCodes{Authority: govAddr.String(), CodeIDs: codeIDs})
+	require.NoError(t, err)
+	assert.False(t, k.IsPinnedCode(ctx, example.CodeID))
+
+	setCallbackParams(ctx, k, 200000, types.DefaultCallbackGasPrice)
+	deposit := sdk.NewCoins(sdk.NewInt64Coin("denom", 300))
+	fundAccounts(t, ctx, keepers.AccountKeeper, keepers.BankKeeper, example.Contract, deposit)
+	require.NoError(t, k.RegisterCallback(ctx, example.Contract, example.Contract, types.CallbackTriggerEndBlock, 0, []byte(`{}`), 200000, deposit))
+
+	_, err = msgServer.RemoveCallbacks(goCtx, &types.MsgRemoveCallbacks{Authority: otherAddr.String(), Contract: example.Contract.String()})
+	assert.True(t, sdkerrors.ErrUnauthorized.Is(err), "got %+v", err)
+	assert.NotNil(t, k.GetCallback(ctx, example.Contract, types.CallbackTriggerEndBlock, 0))
+	_, err = msgServer.RemoveCallbacks(goCtx, &types.MsgRemoveCallbacks{Authority: govAddr.String(), Contract: example.Contract.String()})
+	require.NoError(t, err)
+	assert.Nil(t, k.GetCallback(ctx, example.Contract, types.CallbackTriggerEndBlock, 0))
+}
@@ -51,9 +51,11 @@ func TestConstructorOptions(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
 				"tempDir",
 				types.DefaultWasmConfig(),
 				SupportedFeatures,
+				"",
 				nil,
 				nil,
 				spec.srcOpt,
@@ -14,12 +14,14 @@ import (
 // governing contains a subset of the wasm keeper used by gov processes
 type governing interface {
 	create(ctx sdk.Context, creator sdk.AccAddress, wasmCode []byte, source string, builder string, instantiateAccess *types.AccessConfig, authZ AuthorizationPolicy) (codeID uint64, err error)
-	instantiate(ctx sdk.Context, codeID uint64, creator, admin sdk.AccAddress, initMsg []byte, label string, deposit sdk.Coins, authZ AuthorizationPolicy) (sdk.AccAddress, []byte, error)
+	instantiate(ctx sdk.Context, codeID uint64, creator, admin sdk.AccAddress, initMsg []byte, label string, deposit sdk.Coins, addressGen addressGenerator, authZ AuthorizationPolicy) (sdk.AccAddress, []byte, error)
+	classicAddressGenerator() addressGenerator
 	migrate(ctx sdk.Context, contractAddress sdk.AccAddress, caller sdk.AccAddress, newCodeID uint64, msg []byte, authZ AuthorizationPolicy) (*sdk.Result, error)
 	setContractAdmin(ctx sdk.Context, contractAddress, caller, newAdmin sdk.AccAddress, authZ AuthorizationPolicy) error
 	PinCode(ctx sdk.Context, codeID uint64) error
 	UnpinCode(ctx sdk.Context, codeID uint64) error
 	updateContractStatus(ctx sdk.Context, contractAddress sdk.AccAddress, caller sdk.AccAddress, status types.ContractStatus, authZ AuthorizationPolicy) error
+	removeContractCallbacks(ctx sdk.Context, contractAddress sdk.AccAddress) error
 }
 
 // NewWasmProposalHandler creates a new governance Handler for wasm proposals
@@ -52,6 +54,8 @@ func NewWasmProposalHandler(k governing, enabledProposalTypes []types.ProposalTy
 			return handleUnpinCodesProposal(ctx, k, *c)
 		case *types.UpdateContractStatusProposal:
 			return handleUpdateContractStatusProposal(ctx, k, *c)
+		case *types.RemoveCallbacksProposal:
+			return handleRemoveCallbacksProposal(ctx, k, *c)
 		default:
 			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized wasm proposal content type: %T", c)
 		}
@@ -93,7 +97,7 @@ func handleInstantiateProposal(ctx sdk.Context, k governing, p types.Instantiate
 		return sdkerrors.Wrap(err, "admin")
 	}
 
-	contractAddr, _, err := k.instantiate(ctx, p.CodeID, runAsAddr, adminAddr, p.InitMsg, p.Label, p.Funds, GovAuthorizationPolicy{})
+	contractAddr, _, err := k.instantiate(ctx, p.CodeID, runAsAddr, adminAddr, p.InitMsg, p.Label, p.Funds, k.classicAddressGenerator(), GovAuthorizationPolicy{})
 	if err != nil {
 		return err
 	}
@@ -253,3 +257,14 @@ func handleUpdateContractStatusProposal(ctx sdk.Context, k governing, p types.Up
 	))
 	return nil
 }
+
+func handleRemoveCallbacksProposal(ctx sdk.Context, k governing, p types.RemoveCallbacksProposal) error {
+	if err := p.ValidateBasic(); err != nil {
+		return err
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(p.Contract)
+	if err != nil {
+		return sdkerrors.Wrap(err, "contract")
+	}
+	return k.removeContractCallbacks(ctx, contractAddr)
+}
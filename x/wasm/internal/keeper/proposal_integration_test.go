@@ -29,6 +29,9 @@ func TestStoreCodeProposal(t *testing.T) {
 		GasMultiplier:                types.DefaultGasMultiplier,
 		InstanceCost:                 types.DefaultInstanceCost,
 		CompileCost:                  types.DefaultCompileCost,
+		StorageRentPrice:             types.DefaultStorageRentPrice,
+		CallbackGasPrice:             types.DefaultCallbackGasPrice,
+		MaxBlockCallbackGas:          types.DefaultMaxBlockCallbackGas,
 	})
 	wasmCode, err := ioutil.ReadFile("./testdata/hackatom.wasm")
 	require.NoError(t, err)
@@ -74,6 +77,9 @@ func TestInstantiateProposal(t *testing.T) {
 		GasMultiplier:                types.DefaultGasMultiplier,
 		InstanceCost:                 types.DefaultInstanceCost,
 		CompileCost:                  types.DefaultCompileCost,
+		StorageRentPrice:             types.DefaultStorageRentPrice,
+		CallbackGasPrice:             types.DefaultCallbackGasPrice,
+		MaxBlockCallbackGas:          types.DefaultMaxBlockCallbackGas,
 	})
 
 	wasmCode, err := ioutil.ReadFile("./testdata/hackatom.wasm")
@@ -134,6 +140,9 @@ func TestMigrateProposal(t *testing.T) {
 		GasMultiplier:                types.DefaultGasMultiplier,
 		InstanceCost:                 types.DefaultInstanceCost,
 		CompileCost:                  types.DefaultCompileCost,
+		StorageRentPrice:             types.DefaultStorageRentPrice,
+		CallbackGasPrice:             types.DefaultCallbackGasPrice,
+		MaxBlockCallbackGas:          types.DefaultMaxBlockCallbackGas,
 	})
 
 	wasmCode, err := ioutil.ReadFile("./testdata/hackatom.wasm")
@@ -270,6 +279,9 @@ func TestAdminProposals(t *testing.T) {
 				GasMultiplier:                types.DefaultGasMultiplier,
 				InstanceCost:                 types.DefaultInstanceCost,
 				CompileCost:                  types.DefaultCompileCost,
+				StorageRentPrice:             types.DefaultStorageRentPrice,
+				CallbackGasPrice:             types.DefaultCallbackGasPrice,
+				MaxBlockCallbackGas:          types.DefaultMaxBlockCallbackGas,
 			})
 
 			codeInfoFixture := types.CodeInfoFixture(types.WithSHA256CodeHash(wasmCode))
@@ -583,6 +595,9 @@ func TestUpdateContractStatusProposals(t *testing.T) {
 				GasMultiplier:                types.DefaultGasMultiplier,
 				InstanceCost:                 types.DefaultInstanceCost,
 				CompileCost:                  types.DefaultCompileCost,
+				StorageRentPrice:             types.DefaultStorageRentPrice,
+				CallbackGasPrice:             types.DefaultCallbackGasPrice,
+				MaxBlockCallbackGas:          types.DefaultMaxBlockCallbackGas,
 			})
 
 			codeInfoFixture := types.CodeInfoFixture(types.WithSHA256CodeHash(wasmCode))
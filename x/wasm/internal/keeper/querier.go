@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 	"encoding/binary"
+	"encoding/hex"
 	"runtime/debug"
 
 	"github.com/line/lfb-sdk/store/prefix"
@@ -202,6 +203,18 @@ func (q GrpcQuerier) Code(c context.Context, req *types.QueryCodeRequest) (*type
 	}, nil
 }
 
+func (q GrpcQuerier) CodeIDByHash(c context.Context, req *types.QueryCodeIDByHashRequest) (*types.QueryCodeIDByHashResponse, error) {
+	codeHash, err := hex.DecodeString(req.CodeHash)
+	if err != nil || len(codeHash) == 0 {
+		return nil, sdkerrors.Wrap(types.ErrInvalid, "code hash")
+	}
+	codeID := q.keeper.GetCodeIDByHash(sdk.UnwrapSDKContext(c), codeHash)
+	if codeID == 0 {
+		return nil, types.ErrNotFound
+	}
+	return &types.QueryCodeIDByHashResponse{CodeID: codeID}, nil
+}
+
 func (q GrpcQuerier) Codes(c context.Context, req *types.QueryCodesRequest) (*types.QueryCodesResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
 	r := make([]types.CodeInfoResponse, 0)
@@ -228,6 +241,33 @@ func (q GrpcQuerier) Codes(c context.Context, req *types.QueryCodesRequest) (*ty
 	return &types.QueryCodesResponse{CodeInfos: r, Pagination: pageRes}, nil
 }
 
+func (q GrpcQuerier) Callbacks(c context.Context, req *types.QueryCallbacksRequest) (*types.QueryCallbacksResponse, error) {
+	contractAddr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	r := make([]types.Callback, 0)
+	prefixStore := prefix.NewStore(ctx.KVStore(q.keeper.storeKey), types.GetContractCallbackIndexPrefix(contractAddr))
+	pageRes, err := query.FilteredPaginate(prefixStore, req.Pagination, func(key []byte, _ []byte, accumulate bool) (bool, error) {
+		if accumulate {
+			trigger := types.CallbackTrigger(key[0])
+			height := binary.BigEndian.Uint64(key[1:])
+			callback := q.keeper.GetCallback(ctx, contractAddr, trigger, height)
+			if callback == nil {
+				return false, sdkerrors.Wrapf(types.ErrNotFound, "callback: %s %d", trigger, height)
+			}
+			r = append(r, *callback)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryCallbacksResponse{Callbacks: r, Pagination: pageRes}, nil
+}
+
 func queryContractInfo(ctx sdk.Context, addr sdk.AccAddress, keeper Keeper) (*types.ContractInfoWithAddress, error) {
 	info := keeper.GetContractInfo(ctx, addr)
 	if info == nil {
@@ -1,10 +1,13 @@
 package keeper
 
 import (
+	"crypto/sha256"
 	"encoding/base64"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
+	"strings"
 	"testing"
 
 	"github.com/line/lfb-sdk/x/wasm/internal/keeper/wasmtesting"
@@ -531,3 +534,53 @@ func fromBase64(s string) []byte {
 	}
 	return r
 }
+
+func TestQueryCodeIDByHash(t *testing.T) {
+	wasmCode, err := ioutil.ReadFile("./testdata/hackatom.wasm")
+	require.NoError(t, err)
+	codeHash := sha256.Sum256(wasmCode)
+
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil)
+	keeper := keepers.WasmKeeper
+	creator := createFakeFundedAccount(t, ctx, keepers.AccountKeeper, keepers.BankKeeper, nil)
+	codeID, err := keeper.Create(ctx, creator, wasmCode, "", "", nil)
+	require.NoError(t, err)
+
+	specs := map[string]struct {
+		srcCodeHash string
+		expCodeID   uint64
+		expErr      *sdkErrors.Error
+	}{
+		"found": {
+			srcCodeHash: hex.EncodeToString(codeHash[:]),
+			expCodeID:   codeID,
+		},
+		"upper case hex": {
+			srcCodeHash: strings.ToUpper(hex.EncodeToString(codeHash[:])),
+			expCodeID:   codeID,
+		},
+		"not found": {
+			srcCodeHash: hex.EncodeToString(make([]byte, sha256.Size)),
+			expErr:      types.ErrNotFound,
+		},
+		"not hex": {
+			srcCodeHash: "not hex",
+			expErr:      types.ErrInvalid,
+		},
+		"empty": {
+			expErr: types.ErrInvalid,
+		},
+	}
+	q := NewQuerier(keeper)
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			got, err := q.CodeIDByHash(sdk.WrapSDKContext(ctx), &types.QueryCodeIDByHashRequest{CodeHash: spec.srcCodeHash})
+			if spec.expErr != nil {
+				require.True(t, spec.expErr.Is(err), "got %+v", err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, spec.expCodeID, got.CodeID)
+		})
+	}
+}
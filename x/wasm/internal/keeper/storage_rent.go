@@ -0,0 +1,135 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"strconv"
+
+	"github.com/line/lfb-sdk/store/prefix"
+	sdk "github.com/line/lfb-sdk/types"
+	authtypes "github.com/line/lfb-sdk/x/auth/types"
+	"github.com/line/lfb-sdk/x/wasm/internal/types"
+)
+
+var _ sdk.KVStore = storageSizeStore{}
+
+// storageSizeStore is the prefixed data store of a contract which keeps track of the bytes stored under it.
+// The size of an entry is the length of its key and value. Bookkeeping reads and writes the parent store
+// directly so the gas charged for the contract storage stays the same.
+type storageSizeStore struct {
+	sdk.KVStore
+	raw     sdk.KVStore
+	parent  sdk.KVStore
+	sizeKey []byte
+}
+
+func (s storageSizeStore) Set(key, value []byte) {
+	size := s.size()
+	if old := s.raw.Get(key); old != nil {
+		size = subSize(size, uint64(len(key)+len(old)))
+	}
+	s.KVStore.Set(key, value)
+	s.setSize(size + uint64(len(key)+len(value)))
+}
+
+func (s storageSizeStore) Delete(key []byte) {
+	old := s.raw.Get(key)
+	s.KVStore.Delete(key)
+	if old != nil {
+		s.setSize(subSize(s.size(), uint64(len(key)+len(old))))
+	}
+}
+
+func (s storageSizeStore) size() uint64 {
+	bz := s.parent.Get(s.sizeKey)
+	if bz == nil {
+		return 0
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+func (s storageSizeStore) setSize(size uint64) {
+	if size == 0 {
+		s.parent.Delete(s.sizeKey)
+		return
+	}
+	s.parent.Set(s.sizeKey, sdk.Uint64ToBigEndian(size))
+}
+
+func subSize(size, n uint64) uint64 {
+	if n > size {
+		return 0
+	}
+	return size - n
+}
+
+// contractStore returns the prefixed data store of the contract
+// 0x03 | contractAddress (sdk.AccAddress)
+func (k Keeper) contractStore(ctx sdk.Context, contractAddress sdk.AccAddress) sdk.KVStore {
+	prefixStoreKey := types.GetContractStorePrefix(contractAddress)
+	parent := ctx.MultiStore().GetKVStore(k.storeKey)
+	return storageSizeStore{
+		KVStore: prefix.NewStore(ctx.KVStore(k.storeKey), prefixStoreKey),
+		raw:     prefix.NewStore(parent, prefixStoreKey),
+		parent:  parent,
+		sizeKey: types.GetContractStorageSizeKey(contractAddress),
+	}
+}
+
+// GetContractStorageSize returns the bytes stored by the contract. The size of the entries written before the storage
+// was tracked is stored by the store migration to version 2.
+func (k Keeper) GetContractStorageSize(ctx sdk.Context, contractAddress sdk.AccAddress) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetContractStorageSizeKey(contractAddress))
+	if bz == nil {
+		return 0
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+// ChargeStorageRent charges every active contract the rent for the bytes it stores, once per storage rent period.
+// The rent is paid from the contract balance to the fee collector. A contract which can not pay is set inactive.
+func (k Keeper) ChargeStorageRent(ctx sdk.Context) {
+	params := k.GetParams(ctx)
+	if params.StorageRentPeriod == 0 || uint64(ctx.BlockHeight())%params.StorageRentPeriod != 0 {
+		return
+	}
+
+	var contracts []sdk.AccAddress
+	k.IterateContractInfo(ctx, func(addr sdk.AccAddress, info types.ContractInfo) bool {
+		if info.Status == types.ContractStatusActive {
+			contracts = append(contracts, addr)
+		}
+		return false
+	})
+
+	feeCollector := authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+	for _, contractAddress := range contracts {
+		size := k.GetContractStorageSize(ctx, contractAddress)
+		rent := params.StorageRent(size)
+		if rent.IsZero() {
+			continue
+		}
+
+		cacheCtx, write := ctx.CacheContext()
+		if err := k.bank.TransferCoins(cacheCtx, contractAddress, feeCollector, sdk.NewCoins(rent)); err != nil {
+			k.Logger(ctx).Info("freeze contract for unpaid storage rent", "contract", contractAddress.String(), "rent", rent.String(), "err", err)
+			contractInfo := k.GetContractInfo(ctx, contractAddress)
+			contractInfo.Status = types.ContractStatusInactive
+			k.storeContractInfo(ctx, contractAddress, contractInfo)
+
+			ctx.EventManager().EmitEvent(sdk.NewEvent(
+				types.EventTypeUpdateContractStatus,
+				sdk.NewAttribute(types.AttributeKeyContract, contractAddress.String()),
+				sdk.NewAttribute(types.AttributeKeyContractStatus, contractInfo.Status.String()),
+			))
+			continue
+		}
+		write()
+
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeStorageRent,
+			sdk.NewAttribute(types.AttributeKeyContract, contractAddress.String()),
+			sdk.NewAttribute(types.AttributeKeyStorageSize, strconv.FormatUint(size, 10)),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, rent.String()),
+		))
+	}
+}
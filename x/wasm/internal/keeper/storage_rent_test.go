@@ -0,0 +1,95 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/line/lfb-sdk/types"
+	authtypes "github.com/line/lfb-sdk/x/auth/types"
+	"github.com/line/lfb-sdk/x/wasm/internal/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestContractStorageSize(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil)
+	k := keepers.WasmKeeper
+	_, _, contractAddr := keyPubAddr()
+	store := k.contractStore(ctx, contractAddr)
+
+	store.Set([]byte("foo"), []byte("bar"))
+	assert.Equal(t, uint64(6), k.GetContractStorageSize(ctx, contractAddr))
+
+	store.Set([]byte("key"), []byte("value"))
+	assert.Equal(t, uint64(14), k.GetContractStorageSize(ctx, contractAddr))
+
+	// overwrite
+	store.Set([]byte("foo"), []byte("barbaz"))
+	assert.Equal(t, uint64(17), k.GetContractStorageSize(ctx, contractAddr))
+
+	// delete of a missing key
+	store.Delete([]byte("missing"))
+	assert.Equal(t, uint64(17), k.GetContractStorageSize(ctx, contractAddr))
+
+	store.Delete([]byte("foo"))
+	assert.Equal(t, uint64(8), k.GetContractStorageSize(ctx, contractAddr))
+	store.Delete([]byte("key"))
+	assert.Equal(t, uint64(0), k.GetContractStorageSize(ctx, contractAddr))
+
+	// the bookkeeping is not charged
+	gasBefore := ctx.GasMeter().GasConsumed()
+	store.Set([]byte("foo"), []byte("bar"))
+	gasTracked := ctx.GasMeter().GasConsumed() - gasBefore
+
+	_, _, otherAddr := keyPubAddr()
+	gasBefore = ctx.GasMeter().GasConsumed()
+	ctx.KVStore(k.storeKey).Set(append(types.GetContractStorePrefix(otherAddr), []byte("foo")...), []byte("bar"))
+	assert.Equal(t, ctx.GasMeter().GasConsumed()-gasBefore, gasTracked)
+}
+
+func TestChargeStorageRent(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil)
+	accKeeper, k, bankKeeper := keepers.AccountKeeper, keepers.WasmKeeper, keepers.BankKeeper
+
+	example := InstantiateHackatomExampleContract(t, ctx, keepers)
+	funded := example.Contract
+	unfunded, _, err := k.Instantiate(ctx, example.CodeID, example.CreatorAddr, nil, HackatomExampleInitMsg{
+		Verifier:    example.VerifierAddr,
+		Beneficiary: example.BeneficiaryAddr,
+	}.GetBytes(t), "unfunded", nil)
+	require.NoError(t, err)
+
+	size := k.GetContractStorageSize(ctx, funded)
+	require.NotZero(t, size)
+
+	params := k.GetParams(ctx)
+	params.StorageRentPeriod = 10
+	params.StorageRentPrice = sdk.NewDecCoinFromDec("denom", sdk.NewDecWithPrec(1, 1))
+	k.setParams(ctx, params)
+	rent := params.StorageRent(size)
+	require.True(t, rent.IsPositive())
+
+	feeCollector := authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+	initialBalance := bankKeeper.GetBalance(ctx, funded, "denom")
+
+	// not at the end of a period
+	k.ChargeStorageRent(ctx.WithBlockHeight(9))
+	assert.Equal(t, initialBalance, bankKeeper.GetBalance(ctx, funded, "denom"))
+	assert.Equal(t, types.ContractStatusActive, k.GetContractInfo(ctx, unfunded).Status)
+
+	k.ChargeStorageRent(ctx.WithBlockHeight(10))
+	assert.Equal(t, initialBalance.Sub(rent), bankKeeper.GetBalance(ctx, funded, "denom"))
+	assert.Equal(t, rent, bankKeeper.GetBalance(ctx, feeCollector, "denom"))
+	assert.Equal(t, types.ContractStatusActive, k.GetContractInfo(ctx, funded).Status)
+	assert.Equal(t, types.ContractStatusInactive, k.GetContractInfo(ctx, unfunded).Status)
+	require.NotNil(t, accKeeper.GetAccount(ctx, unfunded))
+
+	// inactive contracts are not charged
+	k.ChargeStorageRent(ctx.WithBlockHeight(20))
+	assert.Equal(t, rent.Add(rent), bankKeeper.GetBalance(ctx, feeCollector, "denom"))
+
+	// disabled
+	params.StorageRentPeriod = 0
+	k.setParams(ctx, params)
+	k.ChargeStorageRent(ctx.WithBlockHeight(30))
+	assert.Equal(t, rent.Add(rent), bankKeeper.GetBalance(ctx, feeCollector, "denom"))
+}
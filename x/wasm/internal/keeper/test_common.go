@@ -37,6 +37,8 @@ import (
 	"github.com/line/lfb-sdk/x/gov"
 	govkeeper "github.com/line/lfb-sdk/x/gov/keeper"
 	govtypes "github.com/line/lfb-sdk/x/gov/types"
+	ibcfeekeeper "github.com/line/lfb-sdk/x/ibc/applications/fee/keeper"
+	ibcfeetypes "github.com/line/lfb-sdk/x/ibc/applications/fee/types"
 	"github.com/line/lfb-sdk/x/ibc/applications/transfer"
 	ibctransfertypes "github.com/line/lfb-sdk/x/ibc/applications/transfer/types"
 	ibc "github.com/line/lfb-sdk/x/ibc/core"
@@ -120,11 +122,15 @@ func MakeEncodingConfig(_ TestingT) params2.EncodingConfig {
 }
 
 var TestingStakeParams = stakingtypes.Params{
-	UnbondingTime:     100,
-	MaxValidators:     10,
-	MaxEntries:        10,
-	HistoricalEntries: 10,
-	BondDenom:         "stake",
+	UnbondingTime:             100,
+	MaxValidators:             10,
+	MaxEntries:                10,
+	HistoricalEntries:         10,
+	BondDenom:                 "stake",
+	GlobalLiquidStakingCap:    stakingtypes.DefaultGlobalLiquidStakingCap,
+	ValidatorLiquidStakingCap: stakingtypes.DefaultValidatorLiquidStakingCap,
+	MinCommissionRate:         stakingtypes.DefaultMinCommissionRate,
+	MinSelfDelegation:         stakingtypes.DefaultMinSelfDelegation,
 }
 
 type TestKeepers struct {
@@ -135,6 +141,8 @@ type TestKeepers struct {
 	GovKeeper     govkeeper.Keeper
 	WasmKeeper    *Keeper
 	IBCKeeper     *ibckeeper.Keeper
+	IBCFeeKeeper  ibcfeekeeper.Keeper
+	ParamsKey     sdk.StoreKey
 }
 
 // CreateDefaultTestInput common settings for CreateTestInput
@@ -170,6 +178,7 @@ func createTestInput(
 	keyParams := sdk.NewKVStoreKey(paramstypes.StoreKey)
 	keyGov := sdk.NewKVStoreKey(govtypes.StoreKey)
 	keyIBC := sdk.NewKVStoreKey(ibchost.StoreKey)
+	keyIBCFee := sdk.NewKVStoreKey(ibcfeetypes.StoreKey)
 	keyCapability := sdk.NewKVStoreKey(capabilitytypes.StoreKey)
 	keyCapabilityTransient := storetypes.NewMemoryStoreKey(capabilitytypes.MemStoreKey)
 
@@ -182,6 +191,7 @@ func createTestInput(
 	ms.MountStoreWithDB(keyDistro, sdk.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(keyGov, sdk.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(keyIBC, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keyIBCFee, sdk.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(keyCapability, sdk.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(keyCapabilityTransient, sdk.StoreTypeMemory, db)
 	require.NoError(t, ms.LoadLatestVersion())
@@ -193,7 +203,7 @@ func createTestInput(
 	encodingConfig := MakeEncodingConfig(t)
 	appCodec, legacyAmino := encodingConfig.Marshaler, encodingConfig.Amino
 
-	paramsKeeper := paramskeeper.NewKeeper(appCodec, legacyAmino, keyParams)
+	paramsKeeper := paramskeeper.NewKeeper(appCodec, legacyAmino, keyParams, authtypes.NewModuleAddress(govtypes.ModuleName).String())
 	paramsKeeper.Subspace(authtypes.ModuleName)
 	paramsKeeper.Subspace(banktypes.ModuleName)
 	paramsKeeper.Subspace(stakingtypes.ModuleName)
@@ -211,8 +221,10 @@ func createTestInput(
 		minttypes.ModuleName:           {authtypes.Minter},
 		stakingtypes.BondedPoolName:    {authtypes.Burner, authtypes.Staking},
 		stakingtypes.NotBondedPoolName: {authtypes.Burner, authtypes.Staking},
+		stakingtypes.ModuleName:        {authtypes.Minter, authtypes.Burner},
 		govtypes.ModuleName:            {authtypes.Burner},
 		ibctransfertypes.ModuleName:    {authtypes.Minter, authtypes.Burner},
+		ibcfeetypes.ModuleName:         nil,
 	}
 	authSubsp, _ := paramsKeeper.GetSubspace(authtypes.ModuleName)
 	authKeeper := authkeeper.NewAccountKeeper(
@@ -269,6 +281,9 @@ func createTestInput(
 	ibcKeeper := ibckeeper.NewKeeper(
 		appCodec, keyIBC, ibcSubsp, stakingKeeper, scopedIBCKeeper,
 	)
+	ibcFeeKeeper := ibcfeekeeper.NewKeeper(
+		appCodec, keyIBCFee, ibcKeeper.ChannelKeeper, ibcKeeper.ChannelKeeper, authKeeper, bankKeeper,
+	)
 
 	router := baseapp.NewRouter()
 	bh := bank.NewHandler(bankKeeper)
@@ -292,6 +307,7 @@ func createTestInput(
 		stakingKeeper,
 		distKeeper,
 		ibcKeeper.ChannelKeeper,
+		ibcKeeper.ChannelKeeper,
 		&ibcKeeper.PortKeeper,
 		scopedWasmKeeper,
 		router,
@@ -300,6 +316,7 @@ func createTestInput(
 		tempDir,
 		wasmConfig,
 		supportedFeatures,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 		encoders,
 		queriers,
 	)
@@ -315,6 +332,7 @@ func createTestInput(
 
 	govKeeper := govkeeper.NewKeeper(
 		appCodec, keyGov, paramsKeeper.Subspace(govtypes.ModuleName).WithKeyTable(govtypes.ParamKeyTable()), authKeeper, bankKeeper, stakingKeeper, govRouter,
+		baseapp.NewMsgServiceRouter(),
 	)
 
 	govKeeper.SetProposalID(ctx, govtypes.DefaultStartingProposalID)
@@ -330,6 +348,8 @@ func createTestInput(
 		BankKeeper:    bankKeeper,
 		GovKeeper:     govKeeper,
 		IBCKeeper:     ibcKeeper,
+		IBCFeeKeeper:  ibcFeeKeeper,
+		ParamsKey:     keyParams,
 	}
 	return ctx, keepers
 }
@@ -447,14 +467,29 @@ func StoreReflectContract(t TestingT, ctx sdk.Context, keepers TestKeepers) uint
 	return codeID
 }
 
+// StoreHackatomExampleContractCopy stores the hackatom contract under a new code id. Identical byte code shares
+// the code id so a custom section with the given name is appended to the code.
+func StoreHackatomExampleContractCopy(t TestingT, ctx sdk.Context, keepers TestKeepers, name string) ExampleContract {
+	wasmCode, err := ioutil.ReadFile("./testdata/hackatom.wasm")
+	require.NoError(t, err)
+	require.Less(t, len(name), 0x7f)
+	// custom section: id 0, size, name length, name
+	wasmCode = append(wasmCode, 0x00, byte(len(name)+1), byte(len(name)))
+	wasmCode = append(wasmCode, name...)
+	return storeExampleCode(t, ctx, keepers, wasmCode)
+}
+
 func StoreExampleContract(t TestingT, ctx sdk.Context, keepers TestKeepers, wasmFile string) ExampleContract {
+	wasmCode, err := ioutil.ReadFile(wasmFile)
+	require.NoError(t, err)
+	return storeExampleCode(t, ctx, keepers, wasmCode)
+}
+
+func storeExampleCode(t TestingT, ctx sdk.Context, keepers TestKeepers, wasmCode []byte) ExampleContract {
 	anyAmount := sdk.NewCoins(sdk.NewInt64Coin("denom", 1000))
 	creator, _, creatorAddr := keyPubAddr()
 	fundAccounts(t, ctx, keepers.AccountKeeper, keepers.BankKeeper, creatorAddr, anyAmount)
 
-	wasmCode, err := ioutil.ReadFile(wasmFile)
-	require.NoError(t, err)
-
 	codeID, err := keepers.WasmKeeper.Create(ctx, creatorAddr, wasmCode, "", "", nil)
 	require.NoError(t, err)
 	return ExampleContract{anyAmount, creator, creatorAddr, codeID}
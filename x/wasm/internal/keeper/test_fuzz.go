@@ -9,7 +9,7 @@ import (
 	tmBytes "github.com/line/ostracon/libs/bytes"
 )
 
-var ModelFuzzers = []interface{}{FuzzAddr, FuzzAddrString, FuzzAbsoluteTxPosition, FuzzContractInfo, FuzzStateModel, FuzzAccessType, FuzzAccessConfig, FuzzContractCodeHistory}
+var ModelFuzzers = []interface{}{FuzzAddr, FuzzAddrString, FuzzAbsoluteTxPosition, FuzzContractInfo, FuzzStateModel, FuzzAccessType, FuzzAccessConfig, FuzzContractCodeHistory, FuzzDecCoin}
 
 func FuzzAddr(m *sdk.AccAddress, c fuzz.Continue) {
 	*m = make([]byte, 20)
@@ -71,3 +71,7 @@ func FuzzAccessConfig(m *types.AccessConfig, c fuzz.Continue) {
 	FuzzAddr(&add, c)
 	*m = m.Permission.With(add)
 }
+
+func FuzzDecCoin(m *sdk.DecCoin, c fuzz.Continue) {
+	*m = sdk.NewDecCoinFromDec("denom", sdk.NewDecWithPrec(int64(c.RandUint64()%1000000), 6))
+}
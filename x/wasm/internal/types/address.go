@@ -0,0 +1,29 @@
+package types
+
+import (
+	"encoding/binary"
+
+	sdk "github.com/line/lfb-sdk/types"
+	"github.com/line/ostracon/crypto"
+)
+
+// contractAddress2Prefix separates the predictable contract addresses from any other derived address.
+var contractAddress2Prefix = []byte("wasm/instantiate2")
+
+// BuildContractAddressPredictable builds the address of a contract instantiated with MsgInstantiateContract2.
+// The address depends on the creator, the code hash and the salt only so that it is known before the contract
+// is instantiated.
+func BuildContractAddressPredictable(codeHash []byte, creator sdk.AccAddress, salt []byte) sdk.AccAddress {
+	bz := append([]byte{}, contractAddress2Prefix...)
+	for _, part := range [][]byte{codeHash, creator, salt} {
+		bz = appendLengthPrefixed(bz, part)
+	}
+	return sdk.AccAddress(crypto.AddressHash(bz))
+}
+
+func appendLengthPrefixed(bz, part []byte) []byte {
+	var lenBz [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(lenBz[:], uint64(len(part)))
+	bz = append(bz, lenBz[:n]...)
+	return append(bz, part...)
+}
@@ -0,0 +1,33 @@
+package types
+
+import (
+	"bytes"
+	"testing"
+
+	sdk "github.com/line/lfb-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildContractAddressPredictable(t *testing.T) {
+	codeHash := bytes.Repeat([]byte{1}, 32)
+	creator := sdk.AccAddress(bytes.Repeat([]byte{2}, sdk.AddrLen))
+	salt := []byte("salt")
+
+	addr := BuildContractAddressPredictable(codeHash, creator, salt)
+	require.Len(t, addr, sdk.AddrLen)
+	assert.Equal(t, addr, BuildContractAddressPredictable(codeHash, creator, salt))
+
+	specs := map[string]sdk.AccAddress{
+		"other code hash": BuildContractAddressPredictable(bytes.Repeat([]byte{3}, 32), creator, salt),
+		"other creator":   BuildContractAddressPredictable(codeHash, sdk.AccAddress(bytes.Repeat([]byte{3}, sdk.AddrLen)), salt),
+		"other salt":      BuildContractAddressPredictable(codeHash, creator, []byte("salt2")),
+		// the parts are length prefixed so that moving bytes between them changes the address
+		"shifted parts": BuildContractAddressPredictable(codeHash[:31], append(sdk.AccAddress{codeHash[31]}, creator...), salt),
+	}
+	for msg, other := range specs {
+		t.Run(msg, func(t *testing.T) {
+			assert.NotEqual(t, addr, other)
+		})
+	}
+}
@@ -6,6 +6,7 @@ import (
 	cryptocodec "github.com/line/lfb-sdk/crypto/codec"
 	sdk "github.com/line/lfb-sdk/types"
 	"github.com/line/lfb-sdk/types/msgservice"
+	authztypes "github.com/line/lfb-sdk/x/authz/types"
 	govtypes "github.com/line/lfb-sdk/x/gov/types"
 )
 
@@ -13,10 +14,16 @@ import (
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgStoreCode{}, "wasm/MsgStoreCode", nil)
 	cdc.RegisterConcrete(&MsgInstantiateContract{}, "wasm/MsgInstantiateContract", nil)
+	cdc.RegisterConcrete(&MsgInstantiateContract2{}, "wasm/MsgInstantiateContract2", nil)
 	cdc.RegisterConcrete(&MsgExecuteContract{}, "wasm/MsgExecuteContract", nil)
 	cdc.RegisterConcrete(&MsgMigrateContract{}, "wasm/MsgMigrateContract", nil)
 	cdc.RegisterConcrete(&MsgUpdateAdmin{}, "wasm/MsgUpdateAdmin", nil)
 	cdc.RegisterConcrete(&MsgClearAdmin{}, "wasm/MsgClearAdmin", nil)
+	cdc.RegisterConcrete(&MsgRegisterCallback{}, "wasm/MsgRegisterCallback", nil)
+	cdc.RegisterConcrete(&MsgCancelCallback{}, "wasm/MsgCancelCallback", nil)
+	cdc.RegisterConcrete(&MsgPinCodes{}, "wasm/MsgPinCodes", nil)
+	cdc.RegisterConcrete(&MsgUnpinCodes{}, "wasm/MsgUnpinCodes", nil)
+	cdc.RegisterConcrete(&MsgRemoveCallbacks{}, "wasm/MsgRemoveCallbacks", nil)
 	cdc.RegisterConcrete(&PinCodesProposal{}, "wasm/PinCodesProposal", nil)
 	cdc.RegisterConcrete(&UnpinCodesProposal{}, "wasm/UnpinCodesProposal", nil)
 
@@ -25,6 +32,7 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MigrateContractProposal{}, "wasm/MigrateContractProposal", nil)
 	cdc.RegisterConcrete(&UpdateAdminProposal{}, "wasm/UpdateAdminProposal", nil)
 	cdc.RegisterConcrete(&ClearAdminProposal{}, "wasm/ClearAdminProposal", nil)
+	cdc.RegisterConcrete(&RemoveCallbacksProposal{}, "wasm/RemoveCallbacksProposal", nil)
 }
 
 func RegisterInterfaces(registry types.InterfaceRegistry) {
@@ -32,10 +40,16 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		(*sdk.Msg)(nil),
 		&MsgStoreCode{},
 		&MsgInstantiateContract{},
+		&MsgInstantiateContract2{},
 		&MsgExecuteContract{},
 		&MsgMigrateContract{},
 		&MsgUpdateAdmin{},
 		&MsgClearAdmin{},
+		&MsgRegisterCallback{},
+		&MsgCancelCallback{},
+		&MsgPinCodes{},
+		&MsgUnpinCodes{},
+		&MsgRemoveCallbacks{},
 		&MsgIBCCloseChannel{},
 		&MsgIBCSend{},
 	)
@@ -48,6 +62,7 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&ClearAdminProposal{},
 		&PinCodesProposal{},
 		&UnpinCodesProposal{},
+		&RemoveCallbacksProposal{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -65,4 +80,26 @@ func init() {
 	RegisterLegacyAminoCodec(amino)
 	cryptocodec.RegisterCrypto(amino)
 	amino.Seal()
+
+	authztypes.RegisterMsgTypeCodec(&MsgStoreCode{}, "wasm/MsgStoreCode")
+	authztypes.RegisterMsgTypeCodec(&MsgInstantiateContract{}, "wasm/MsgInstantiateContract")
+	authztypes.RegisterMsgTypeCodec(&MsgInstantiateContract2{}, "wasm/MsgInstantiateContract2")
+	authztypes.RegisterMsgTypeCodec(&MsgExecuteContract{}, "wasm/MsgExecuteContract")
+	authztypes.RegisterMsgTypeCodec(&MsgMigrateContract{}, "wasm/MsgMigrateContract")
+	authztypes.RegisterMsgTypeCodec(&MsgUpdateAdmin{}, "wasm/MsgUpdateAdmin")
+	authztypes.RegisterMsgTypeCodec(&MsgClearAdmin{}, "wasm/MsgClearAdmin")
+	authztypes.RegisterMsgTypeCodec(&MsgRegisterCallback{}, "wasm/MsgRegisterCallback")
+	authztypes.RegisterMsgTypeCodec(&MsgCancelCallback{}, "wasm/MsgCancelCallback")
+
+	// register the Msgs executed by governance on the gov codec as well so
+	// that proposals carrying them can be Amino JSON signed
+	govtypes.RegisterProposalTypeCodec(&MsgStoreCode{}, "wasm/MsgStoreCode")
+	govtypes.RegisterProposalTypeCodec(&MsgInstantiateContract{}, "wasm/MsgInstantiateContract")
+	govtypes.RegisterProposalTypeCodec(&MsgInstantiateContract2{}, "wasm/MsgInstantiateContract2")
+	govtypes.RegisterProposalTypeCodec(&MsgMigrateContract{}, "wasm/MsgMigrateContract")
+	govtypes.RegisterProposalTypeCodec(&MsgUpdateAdmin{}, "wasm/MsgUpdateAdmin")
+	govtypes.RegisterProposalTypeCodec(&MsgClearAdmin{}, "wasm/MsgClearAdmin")
+	govtypes.RegisterProposalTypeCodec(&MsgPinCodes{}, "wasm/MsgPinCodes")
+	govtypes.RegisterProposalTypeCodec(&MsgUnpinCodes{}, "wasm/MsgUnpinCodes")
+	govtypes.RegisterProposalTypeCodec(&MsgRemoveCallbacks{}, "wasm/MsgRemoveCallbacks")
 }
@@ -7,8 +7,9 @@ import (
 type EncodingModule string
 
 const (
-	TokenM      = EncodingModule("token")
-	CollectionM = EncodingModule("collection")
+	TokenM        = EncodingModule("token")
+	CollectionM   = EncodingModule("collection")
+	TokenFactoryM = EncodingModule("tokenfactory")
 )
 
 type LinkMsgWrapper struct {
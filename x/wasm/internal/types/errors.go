@@ -63,4 +63,7 @@ var (
 
 	// ErrUnpinContractFailed error for unpinning contract failures
 	ErrUnpinContractFailed = sdkErrors.Register(DefaultCodespace, 19, "unpinning contract failed")
+
+	// ErrCallbackPanic error for callbacks whose run panicked
+	ErrCallbackPanic = sdkErrors.Register(DefaultCodespace, 20, "callback execution panicked")
 )
@@ -10,10 +10,19 @@ const (
 	EventTypePinCode              = "pin_code"
 	EventTypeUnpinCode            = "unpin_code"
 	EventTypeUpdateContractStatus = "update_contract_status"
+	EventTypeStorageRent          = "storage_rent"
+	EventTypeRegisterCallback     = "register_callback"
+	EventTypeCancelCallback       = "cancel_callback"
+	EventTypeRunCallback          = "run_callback"
 )
 const ( // event attributes
 	AttributeKeyContract       = "contract_address"
 	AttributeKeyCodeID         = "code_id"
 	AttributeKeyCodeIDs        = "code_ids"
 	AttributeKeyContractStatus = "contract_status"
+	AttributeKeyStorageSize    = "storage_size"
+	AttributeKeyTrigger        = "trigger"
+	AttributeKeyHeight         = "height"
+	AttributeKeyGasUsed        = "gas_used"
+	AttributeKeyError          = "error"
 )
@@ -37,6 +37,11 @@ func (s GenesisState) ValidateBasic() error {
 			return sdkerrors.Wrapf(err, "gen message: %d", i)
 		}
 	}
+	for i := range s.Callbacks {
+		if err := s.Callbacks[i].ValidateBasic(); err != nil {
+			return sdkerrors.Wrapf(err, "callback: %d", i)
+		}
+	}
 	return nil
 }
 
@@ -30,6 +30,7 @@ type GenesisState struct {
 	Contracts []Contract             `protobuf:"bytes,3,rep,name=contracts,proto3" json:"contracts,omitempty"`
 	Sequences []Sequence             `protobuf:"bytes,4,rep,name=sequences,proto3" json:"sequences,omitempty"`
 	GenMsgs   []GenesisState_GenMsgs `protobuf:"bytes,5,rep,name=gen_msgs,json=genMsgs,proto3" json:"gen_msgs,omitempty"`
+	Callbacks []Callback             `protobuf:"bytes,6,rep,name=callbacks,proto3" json:"callbacks,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -100,6 +101,13 @@ func (m *GenesisState) GetGenMsgs() []GenesisState_GenMsgs {
 	return nil
 }
 
+func (m *GenesisState) GetCallbacks() []Callback {
+	if m != nil {
+		return m.Callbacks
+	}
+	return nil
+}
+
 // GenMsgs define the messages that can be executed during genesis phase in order.
 // The intention is to have more human readable data that is auditable.
 type GenesisState_GenMsgs struct {
@@ -397,48 +405,49 @@ func init() {
 func init() { proto.RegisterFile("genesis.proto", fileDescriptor_14205810582f3203) }
 
 var fileDescriptor_14205810582f3203 = []byte{
-	// 648 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x94, 0xcf, 0x4e, 0xdb, 0x4a,
-	0x14, 0xc6, 0x63, 0x12, 0x87, 0xe4, 0x10, 0x2e, 0x68, 0xe0, 0xde, 0x6b, 0x85, 0x92, 0x44, 0x61,
-	0x03, 0x6a, 0x89, 0x05, 0x5d, 0x76, 0x55, 0x97, 0x0a, 0x52, 0x44, 0x55, 0x19, 0xa9, 0x0b, 0x36,
-	0x91, 0xff, 0x1c, 0x5c, 0x8b, 0x78, 0x26, 0xcd, 0x4c, 0x28, 0x79, 0x8b, 0xaa, 0x0f, 0xd1, 0x67,
-	0x61, 0xc9, 0xb2, 0xab, 0xa8, 0x0a, 0xbb, 0x3e, 0x45, 0x35, 0xe3, 0x89, 0x71, 0xd5, 0x9a, 0x6e,
-	0x2c, 0x9f, 0xe3, 0xef, 0xfb, 0xcd, 0x9c, 0x33, 0x67, 0x0c, 0xab, 0x11, 0x52, 0xe4, 0x31, 0xef,
-	0x8d, 0xc6, 0x4c, 0x30, 0xf2, 0x6f, 0xc0, 0x78, 0xf2, 0xc9, 0xe3, 0x49, 0x4f, 0x3d, 0xae, 0x0f,
-	0x7c, 0x14, 0xde, 0x41, 0x73, 0x33, 0x62, 0x11, 0x53, 0x0a, 0x5b, 0xbe, 0xa5, 0xe2, 0xe6, 0x8a,
-	0x98, 0x8e, 0x50, 0x3b, 0x9b, 0x35, 0x71, 0x93, 0xbe, 0x75, 0x6f, 0x4d, 0x68, 0x1c, 0xa7, 0xd4,
-	0x73, 0xe1, 0x09, 0x24, 0x2f, 0xa0, 0x3a, 0xf2, 0xc6, 0x5e, 0xc2, 0x2d, 0xa3, 0x63, 0xec, 0xae,
-	0x1c, 0x6e, 0xf7, 0xfe, 0xb8, 0x4a, 0xef, 0x9d, 0x12, 0x39, 0x95, 0xdb, 0x59, 0xbb, 0xe4, 0x6a,
-	0x0b, 0x79, 0x03, 0x66, 0xc0, 0x42, 0xe4, 0xd6, 0x52, 0xa7, 0xbc, 0xbb, 0x72, 0xb8, 0x55, 0xe0,
-	0x7d, 0xc5, 0x42, 0x74, 0xfe, 0x97, 0xce, 0x1f, 0xb3, 0xf6, 0x9a, 0x72, 0x3c, 0x63, 0x49, 0x2c,
-	0x30, 0x19, 0x89, 0xa9, 0x9b, 0x22, 0xc8, 0x05, 0xd4, 0x03, 0x46, 0xc5, 0xd8, 0x0b, 0x04, 0xb7,
-	0xca, 0x8a, 0xd7, 0x2e, 0xe4, 0xa5, 0x3a, 0x67, 0x4b, 0x33, 0x37, 0x32, 0x67, 0x8e, 0xfb, 0x80,
-	0x93, 0x6c, 0x8e, 0x1f, 0x27, 0x48, 0x03, 0xe4, 0x56, 0xe5, 0x51, 0xf6, 0xb9, 0xd6, 0x3d, 0xb0,
-	0x33, 0x67, 0x9e, 0x9d, 0x25, 0x89, 0x0f, 0xb5, 0x08, 0xe9, 0x20, 0xe1, 0x11, 0xb7, 0x4c, 0x85,
-	0x7e, 0x5a, 0x80, 0xce, 0xf7, 0x5d, 0x06, 0x67, 0x3c, 0xe2, 0x4e, 0x53, 0x2f, 0x43, 0x16, 0x90,
-	0xdc, 0x2a, 0xcb, 0x51, 0x2a, 0x6a, 0x7e, 0x59, 0x82, 0x65, 0x6d, 0x20, 0x47, 0x00, 0x5c, 0xb0,
-	0x31, 0x0e, 0x64, 0xdb, 0xf4, 0xa1, 0xed, 0x14, 0xac, 0x78, 0xc6, 0xa3, 0x73, 0xa9, 0x95, 0x07,
-	0x70, 0x52, 0x72, 0xeb, 0x7c, 0x11, 0x10, 0x1f, 0x36, 0x63, 0xca, 0x85, 0x47, 0x45, 0xec, 0x09,
-	0xc9, 0x4a, 0x5b, 0x65, 0x2d, 0x29, 0xde, 0x7e, 0x31, 0xaf, 0xff, 0xe0, 0x5a, 0x1c, 0xc3, 0x49,
-	0xc9, 0xdd, 0x88, 0x7f, 0x4f, 0x93, 0xf7, 0xb0, 0x8e, 0x37, 0x18, 0x4c, 0xf2, 0xfc, 0xb2, 0xe2,
-	0xef, 0x15, 0xf3, 0x5f, 0xa7, 0x8e, 0x1c, 0x7b, 0x0d, 0x7f, 0x4d, 0x39, 0x26, 0x94, 0xf9, 0x24,
-	0xe9, 0x7e, 0x35, 0xa0, 0xa2, 0x6a, 0xd9, 0x81, 0x65, 0xd9, 0x8b, 0x41, 0x1c, 0xaa, 0x76, 0x54,
-	0x1c, 0x98, 0xcf, 0xda, 0x55, 0xf9, 0xa9, 0x7f, 0xe4, 0x56, 0xe5, 0xa7, 0x7e, 0x48, 0x1c, 0x39,
-	0x5e, 0x52, 0x44, 0x2f, 0x99, 0xae, 0xb2, 0xfd, 0xc8, 0xb8, 0xf6, 0xe9, 0x25, 0xd3, 0xc3, 0x5e,
-	0x0b, 0x74, 0x4c, 0xb6, 0x01, 0x14, 0xc3, 0x9f, 0x0a, 0xe4, 0xaa, 0x94, 0x86, 0xab, 0xa8, 0x8e,
-	0x4c, 0x90, 0xff, 0xa0, 0x3a, 0x8a, 0x29, 0xc5, 0xd0, 0xaa, 0x74, 0x8c, 0xdd, 0x9a, 0xab, 0xa3,
-	0xee, 0x9d, 0x01, 0xb5, 0xac, 0x29, 0x7b, 0xb0, 0xbe, 0x68, 0xc6, 0xc0, 0x0b, 0xc3, 0x31, 0xf2,
-	0xf4, 0xe6, 0xd5, 0xdd, 0xb5, 0x45, 0xfe, 0x65, 0x9a, 0x26, 0x6f, 0x61, 0x35, 0x93, 0xe6, 0xb6,
-	0xbd, 0xf3, 0x97, 0x5b, 0x91, 0xdb, 0x7a, 0x23, 0xc8, 0xe5, 0x48, 0x1f, 0xfe, 0xc9, 0x78, 0x5c,
-	0x0e, 0xa1, 0xbe, 0x66, 0x4f, 0x8a, 0x4e, 0x83, 0x85, 0x38, 0xd4, 0xa4, 0x6c, 0x27, 0x6a, 0x7a,
-	0xbb, 0x0e, 0xd4, 0x16, 0x17, 0x85, 0x74, 0xa0, 0x1a, 0x87, 0x83, 0x2b, 0x9c, 0xaa, 0x3a, 0x1a,
-	0x4e, 0x7d, 0x3e, 0x6b, 0x9b, 0xfd, 0xa3, 0x53, 0x9c, 0xba, 0x66, 0x1c, 0x9e, 0xe2, 0x94, 0x6c,
-	0x82, 0x79, 0xed, 0x0d, 0x27, 0xa8, 0x0a, 0xa8, 0xb8, 0x69, 0xe0, 0x1c, 0xdf, 0xce, 0x5b, 0xc6,
-	0xdd, 0xbc, 0x65, 0x7c, 0x9f, 0xb7, 0x8c, 0xcf, 0xf7, 0xad, 0xd2, 0xdd, 0x7d, 0xab, 0xf4, 0xed,
-	0xbe, 0x55, 0xba, 0xd8, 0x8f, 0x62, 0xf1, 0x61, 0xe2, 0xf7, 0x02, 0x96, 0xd8, 0xc3, 0x98, 0xa2,
-	0x3d, 0xbc, 0xf4, 0xf7, 0x79, 0x78, 0x65, 0xdf, 0xd8, 0x72, 0x83, 0x76, 0x4c, 0x05, 0x8e, 0xa9,
-	0x37, 0xb4, 0xd5, 0x3f, 0xce, 0xaf, 0xaa, 0x5f, 0xdb, 0xf3, 0x9f, 0x01, 0x00, 0x00, 0xff, 0xff,
-	0x32, 0x7c, 0x89, 0xdb, 0x2f, 0x05, 0x00, 0x00,
+	// 665 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x94, 0xcd, 0x4e, 0xdb, 0x40,
+	0x10, 0xc7, 0x63, 0x92, 0x98, 0x64, 0x08, 0x05, 0x2d, 0xb4, 0xb5, 0x42, 0x49, 0xa2, 0x70, 0x01,
+	0xb5, 0x24, 0x82, 0x1e, 0x7b, 0xaa, 0x4b, 0x05, 0x29, 0xa2, 0xaa, 0x8c, 0xd4, 0x03, 0x97, 0xc8,
+	0x1f, 0x83, 0x6b, 0x61, 0x7b, 0xd3, 0xec, 0x86, 0x92, 0xb7, 0xa8, 0xfa, 0x0c, 0x55, 0x9f, 0x85,
+	0x23, 0xc7, 0x9e, 0xa2, 0x2a, 0xdc, 0xfa, 0x14, 0xd5, 0xae, 0xd7, 0xc6, 0xa8, 0x35, 0xbd, 0x44,
+	0xd9, 0xf1, 0xff, 0xff, 0xdb, 0xf9, 0xf0, 0x18, 0x96, 0x7d, 0x8c, 0x91, 0x05, 0xac, 0x37, 0x1a,
+	0x53, 0x4e, 0xc9, 0x63, 0x97, 0xb2, 0xe8, 0x8b, 0xcd, 0xa2, 0x9e, 0xfc, 0xb9, 0xdc, 0x73, 0x90,
+	0xdb, 0x7b, 0xcd, 0x75, 0x9f, 0xfa, 0x54, 0x2a, 0xfa, 0xe2, 0x5f, 0x22, 0x6e, 0x2e, 0xf1, 0xe9,
+	0x08, 0x95, 0xb3, 0x59, 0xe3, 0x57, 0xc9, 0xbf, 0xee, 0x77, 0x1d, 0x1a, 0x87, 0x09, 0xf5, 0x94,
+	0xdb, 0x1c, 0xc9, 0x2b, 0xd0, 0x47, 0xf6, 0xd8, 0x8e, 0x98, 0xa1, 0x75, 0xb4, 0xed, 0xa5, 0xfd,
+	0xcd, 0xde, 0x3f, 0x6f, 0xe9, 0x7d, 0x90, 0x22, 0xb3, 0x72, 0x3d, 0x6b, 0x97, 0x2c, 0x65, 0x21,
+	0xef, 0xa0, 0xea, 0x52, 0x0f, 0x99, 0xb1, 0xd0, 0x29, 0x6f, 0x2f, 0xed, 0x6f, 0x14, 0x78, 0xdf,
+	0x50, 0x0f, 0xcd, 0xa7, 0xc2, 0xf9, 0x7b, 0xd6, 0x5e, 0x91, 0x8e, 0x17, 0x34, 0x0a, 0x38, 0x46,
+	0x23, 0x3e, 0xb5, 0x12, 0x04, 0x39, 0x83, 0xba, 0x4b, 0x63, 0x3e, 0xb6, 0x5d, 0xce, 0x8c, 0xb2,
+	0xe4, 0xb5, 0x0b, 0x79, 0x89, 0xce, 0xdc, 0x50, 0xcc, 0xb5, 0xcc, 0x99, 0xe3, 0xde, 0xe1, 0x04,
+	0x9b, 0xe1, 0xe7, 0x09, 0xc6, 0x2e, 0x32, 0xa3, 0xf2, 0x20, 0xfb, 0x54, 0xe9, 0xee, 0xd8, 0x99,
+	0x33, 0xcf, 0xce, 0x82, 0xc4, 0x81, 0x9a, 0x8f, 0xf1, 0x30, 0x62, 0x3e, 0x33, 0xaa, 0x12, 0xfd,
+	0xbc, 0x00, 0x9d, 0xef, 0xbb, 0x38, 0x9c, 0x30, 0x9f, 0x99, 0x4d, 0x75, 0x0d, 0x49, 0x21, 0xb9,
+	0x5b, 0x16, 0xfd, 0x44, 0x24, 0x7b, 0x63, 0x87, 0xa1, 0x63, 0xbb, 0x17, 0xcc, 0xd0, 0x1f, 0xee,
+	0x8d, 0xd2, 0xe5, 0x7a, 0x93, 0x3a, 0xef, 0xf5, 0x26, 0x0d, 0x36, 0xbf, 0x2d, 0xc0, 0xa2, 0x4a,
+	0x86, 0x1c, 0x00, 0x30, 0x4e, 0xc7, 0x38, 0x14, 0x23, 0x51, 0x2f, 0xc4, 0x56, 0xc1, 0x45, 0x27,
+	0xcc, 0x3f, 0x15, 0x5a, 0x31, 0xdc, 0xa3, 0x92, 0x55, 0x67, 0xe9, 0x81, 0x38, 0xb0, 0x1e, 0xc4,
+	0x8c, 0xdb, 0x31, 0x0f, 0x6c, 0x8e, 0xc3, 0x74, 0x0c, 0xc6, 0x82, 0xe4, 0xed, 0x16, 0xf3, 0x06,
+	0x77, 0xae, 0x74, 0xc4, 0x47, 0x25, 0x6b, 0x2d, 0xf8, 0x3b, 0x4c, 0x3e, 0xc2, 0x2a, 0x5e, 0xa1,
+	0x3b, 0xc9, 0xf3, 0xcb, 0x92, 0xbf, 0x53, 0xcc, 0x7f, 0x9b, 0x38, 0x72, 0xec, 0x15, 0xbc, 0x1f,
+	0x32, 0xab, 0x50, 0x66, 0x93, 0xa8, 0xfb, 0x43, 0x83, 0x8a, 0xac, 0x65, 0x0b, 0x16, 0x45, 0x2f,
+	0x86, 0x81, 0x27, 0xdb, 0x51, 0x31, 0x61, 0x3e, 0x6b, 0xeb, 0xe2, 0xd1, 0xe0, 0xc0, 0xd2, 0xc5,
+	0xa3, 0x81, 0x47, 0x4c, 0xa8, 0x27, 0xa2, 0xf8, 0x9c, 0xaa, 0x2a, 0xdb, 0x0f, 0xac, 0xc2, 0x20,
+	0x3e, 0xa7, 0x6a, 0x91, 0x6a, 0xae, 0x3a, 0x93, 0x4d, 0x00, 0xc9, 0x70, 0xa6, 0x1c, 0x99, 0x2c,
+	0xa5, 0x61, 0x49, 0xaa, 0x29, 0x02, 0xe4, 0x09, 0xe8, 0xa3, 0x20, 0x8e, 0xd1, 0x33, 0x2a, 0x1d,
+	0x6d, 0xbb, 0x66, 0xa9, 0x53, 0xf7, 0x46, 0x83, 0x5a, 0xd6, 0x94, 0x1d, 0x58, 0x4d, 0x9b, 0x31,
+	0xb4, 0x3d, 0x6f, 0x8c, 0x2c, 0xd9, 0xea, 0xba, 0xb5, 0x92, 0xc6, 0x5f, 0x27, 0x61, 0xf2, 0x1e,
+	0x96, 0x33, 0x69, 0x2e, 0xed, 0xad, 0xff, 0x6c, 0x5c, 0x2e, 0xf5, 0x86, 0x9b, 0x8b, 0x91, 0x01,
+	0x3c, 0xca, 0x78, 0x4c, 0xbc, 0xe0, 0x6a, 0x85, 0x9f, 0x15, 0x4d, 0x83, 0x7a, 0x18, 0x2a, 0x52,
+	0x96, 0x89, 0xdc, 0x8c, 0xae, 0x09, 0xb5, 0x74, 0x09, 0x49, 0x07, 0xf4, 0xc0, 0x1b, 0x5e, 0xe0,
+	0x54, 0xd6, 0xd1, 0x30, 0xeb, 0xf3, 0x59, 0xbb, 0x3a, 0x38, 0x38, 0xc6, 0xa9, 0x55, 0x0d, 0xbc,
+	0x63, 0x9c, 0x92, 0x75, 0xa8, 0x5e, 0xda, 0xe1, 0x04, 0x65, 0x01, 0x15, 0x2b, 0x39, 0x98, 0x87,
+	0xd7, 0xf3, 0x96, 0x76, 0x33, 0x6f, 0x69, 0xbf, 0xe6, 0x2d, 0xed, 0xeb, 0x6d, 0xab, 0x74, 0x73,
+	0xdb, 0x2a, 0xfd, 0xbc, 0x6d, 0x95, 0xce, 0x76, 0xfd, 0x80, 0x7f, 0x9a, 0x38, 0x3d, 0x97, 0x46,
+	0xfd, 0x30, 0x88, 0xb1, 0x1f, 0x9e, 0x3b, 0xbb, 0xcc, 0xbb, 0xe8, 0x5f, 0xf5, 0x45, 0x82, 0xfd,
+	0x20, 0xe6, 0x38, 0x8e, 0xed, 0xb0, 0x2f, 0xbf, 0x9f, 0x8e, 0x2e, 0x3f, 0x9b, 0x2f, 0xff, 0x0c,
+	0x00, 0x00, 0x40, 0x19, 0x78, 0x8b, 0x05, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -461,6 +470,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Callbacks) > 0 {
+		for iNdEx := len(m.Callbacks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Callbacks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if len(m.GenMsgs) > 0 {
 		for iNdEx := len(m.GenMsgs) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -812,6 +835,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.Callbacks) > 0 {
+		for _, e := range m.Callbacks {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1125,6 +1154,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Callbacks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Callbacks = append(m.Callbacks, Callback{})
+			if err := m.Callbacks[len(m.Callbacks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -30,6 +30,10 @@ var (
 	ContractCodeHistoryElementPrefix               = []byte{0x05}
 	ContractByCodeIDAndCreatedSecondaryIndexPrefix = []byte{0x06}
 	PinnedCodeIndexPrefix                          = []byte{0x07}
+	ContractStorageSizePrefix                      = []byte{0x08}
+	CallbackKeyPrefix                              = []byte{0x09}
+	ContractCallbackIndexPrefix                    = []byte{0x0a}
+	CodeHashIndexPrefix                            = []byte{0x0b}
 
 	KeyLastCodeID     = append(SequenceKeyPrefix, []byte("lastCodeId")...)
 	KeyLastInstanceID = append(SequenceKeyPrefix, []byte("lastContractId")...)
@@ -41,6 +45,11 @@ func GetCodeKey(codeID uint64) []byte {
 	return append(CodeKeyPrefix, contractIDBz...)
 }
 
+// GetCodeHashIndexKey returns the key of the code id stored for the code hash: `<prefix><codeHash>`
+func GetCodeHashIndexKey(codeHash []byte) []byte {
+	return append(CodeHashIndexPrefix, codeHash...)
+}
+
 // GetContractAddressKey returns the key for the WASM contract instance
 func GetContractAddressKey(addr sdk.AccAddress) []byte {
 	return append(ContractKeyPrefix, addr...)
@@ -51,6 +60,43 @@ func GetContractStorePrefix(addr sdk.AccAddress) []byte {
 	return append(ContractStorePrefix, addr...)
 }
 
+// GetContractStorageSizeKey returns the key for the bytes stored by the WASM contract instance
+func GetContractStorageSizeKey(addr sdk.AccAddress) []byte {
+	return append(ContractStorageSizePrefix, addr...)
+}
+
+// GetCallbacksByTriggerPrefix returns the prefix of the callbacks run at the trigger and height: `<prefix><trigger><height>`
+func GetCallbacksByTriggerPrefix(trigger CallbackTrigger, height uint64) []byte {
+	prefixLen := len(CallbackKeyPrefix)
+	r := make([]byte, prefixLen+1+8)
+	copy(r[0:], CallbackKeyPrefix)
+	r[prefixLen] = byte(trigger)
+	copy(r[prefixLen+1:], sdk.Uint64ToBigEndian(height))
+	return r
+}
+
+// GetCallbackKey returns the key of a callback: `<prefix><trigger><height><contractAddr>`
+func GetCallbackKey(contractAddr sdk.AccAddress, trigger CallbackTrigger, height uint64) []byte {
+	return append(GetCallbacksByTriggerPrefix(trigger, height), contractAddr...)
+}
+
+// GetContractCallbackIndexPrefix returns the prefix of the index of the callbacks of a contract: `<prefix><contractAddr>`
+func GetContractCallbackIndexPrefix(contractAddr sdk.AccAddress) []byte {
+	return append(ContractCallbackIndexPrefix, contractAddr...)
+}
+
+// GetContractCallbackIndexKey returns the key of the index of a callback of a contract:
+// `<prefix><contractAddr><trigger><height>`
+func GetContractCallbackIndexKey(contractAddr sdk.AccAddress, trigger CallbackTrigger, height uint64) []byte {
+	prefix := GetContractCallbackIndexPrefix(contractAddr)
+	prefixLen := len(prefix)
+	r := make([]byte, prefixLen+1+8)
+	copy(r[0:], prefix)
+	r[prefixLen] = byte(trigger)
+	copy(r[prefixLen+1:], sdk.Uint64ToBigEndian(height))
+	return r
+}
+
 // GetContractByCreatedSecondaryIndexKey returns the key for the secondary index:
 // `<prefix><codeID><created><contractAddr>`
 func GetContractByCreatedSecondaryIndexKey(contractAddr sdk.AccAddress, c *ContractInfo) []byte {
@@ -30,6 +30,12 @@ const (
 	DefaultInstanceCost = 40_000
 	// CompileCost is how much SDK gas we charge *per byte* for compiling WASM code.
 	DefaultCompileCost = 2
+	// DefaultStorageRentPeriod disables the storage rent
+	DefaultStorageRentPeriod = 0
+	// DefaultMaxCallbackGasLimit disables the callbacks
+	DefaultMaxCallbackGasLimit = 0
+	// DefaultMaxBlockCallbackGas is the default max gas of the callbacks run at BeginBlock or at EndBlock per block
+	DefaultMaxBlockCallbackGas = 10_000_000
 )
 
 var ParamStoreKeyUploadAccess = []byte("uploadAccess")
@@ -39,6 +45,11 @@ var ParamStoreKeyMaxWasmCodeSize = []byte("maxWasmCodeSize")
 var ParamStoreKeyGasMultiplier = []byte("gasMultiplier")
 var ParamStoreKeyInstanceCost = []byte("instanceCost")
 var ParamStoreKeyCompileCost = []byte("compileCost")
+var ParamStoreKeyStorageRentPeriod = []byte("storageRentPeriod")
+var ParamStoreKeyStorageRentPrice = []byte("storageRentPrice")
+var ParamStoreKeyMaxCallbackGasLimit = []byte("maxCallbackGasLimit")
+var ParamStoreKeyCallbackGasPrice = []byte("callbackGasPrice")
+var ParamStoreKeyMaxBlockCallbackGas = []byte("maxBlockCallbackGas")
 
 var AllAccessTypes = []AccessType{
 	AccessTypeNobody,
@@ -104,6 +115,8 @@ var (
 	DefaultContractStatusAccess = AllowNobody
 	AllowEverybody              = AccessConfig{Permission: AccessTypeEverybody}
 	AllowNobody                 = AccessConfig{Permission: AccessTypeNobody}
+	DefaultStorageRentPrice     = sdk.NewDecCoin(sdk.DefaultBondDenom, sdk.ZeroInt())
+	DefaultCallbackGasPrice     = sdk.NewDecCoin(sdk.DefaultBondDenom, sdk.ZeroInt())
 )
 
 // ParamKeyTable returns the parameter key table.
@@ -121,6 +134,11 @@ func DefaultParams() Params {
 		GasMultiplier:                DefaultGasMultiplier,
 		InstanceCost:                 DefaultInstanceCost,
 		CompileCost:                  DefaultCompileCost,
+		StorageRentPeriod:            DefaultStorageRentPeriod,
+		StorageRentPrice:             DefaultStorageRentPrice,
+		MaxCallbackGasLimit:          DefaultMaxCallbackGasLimit,
+		CallbackGasPrice:             DefaultCallbackGasPrice,
+		MaxBlockCallbackGas:          DefaultMaxBlockCallbackGas,
 	}
 }
 
@@ -139,6 +157,11 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(ParamStoreKeyGasMultiplier, &p.GasMultiplier, validateGasMultiplier),
 		paramtypes.NewParamSetPair(ParamStoreKeyInstanceCost, &p.InstanceCost, validateInstanceCost),
 		paramtypes.NewParamSetPair(ParamStoreKeyCompileCost, &p.CompileCost, validateCompileCost),
+		paramtypes.NewParamSetPair(ParamStoreKeyStorageRentPeriod, &p.StorageRentPeriod, validateStorageRentPeriod),
+		paramtypes.NewParamSetPair(ParamStoreKeyStorageRentPrice, &p.StorageRentPrice, validateStorageRentPrice),
+		paramtypes.NewParamSetPair(ParamStoreKeyMaxCallbackGasLimit, &p.MaxCallbackGasLimit, validateMaxCallbackGasLimit),
+		paramtypes.NewParamSetPair(ParamStoreKeyCallbackGasPrice, &p.CallbackGasPrice, validateCallbackGasPrice),
+		paramtypes.NewParamSetPair(ParamStoreKeyMaxBlockCallbackGas, &p.MaxBlockCallbackGas, validateMaxBlockCallbackGas),
 	}
 }
 
@@ -165,6 +188,21 @@ func (p Params) ValidateBasic() error {
 	if err := validateCompileCost(p.CompileCost); err != nil {
 		return errors.Wrap(err, "compile cost")
 	}
+	if err := validateStorageRentPeriod(p.StorageRentPeriod); err != nil {
+		return errors.Wrap(err, "storage rent period")
+	}
+	if err := validateStorageRentPrice(p.StorageRentPrice); err != nil {
+		return errors.Wrap(err, "storage rent price")
+	}
+	if err := validateMaxCallbackGasLimit(p.MaxCallbackGasLimit); err != nil {
+		return errors.Wrap(err, "max callback gas limit")
+	}
+	if err := validateCallbackGasPrice(p.CallbackGasPrice); err != nil {
+		return errors.Wrap(err, "callback gas price")
+	}
+	if err := validateMaxBlockCallbackGas(p.MaxBlockCallbackGas); err != nil {
+		return errors.Wrap(err, "max block callback gas")
+	}
 	return nil
 }
 
@@ -236,6 +274,62 @@ func validateCompileCost(i interface{}) error {
 	return nil
 }
 
+func validateStorageRentPeriod(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return sdkerrors.Wrapf(ErrInvalid, "type: %T", i)
+	}
+	return nil
+}
+
+func validateStorageRentPrice(i interface{}) error {
+	a, ok := i.(sdk.DecCoin)
+	if !ok {
+		return sdkerrors.Wrapf(ErrInvalid, "type: %T", i)
+	}
+	if err := a.Validate(); err != nil {
+		return sdkerrors.Wrap(ErrInvalid, err.Error())
+	}
+	return nil
+}
+
+func validateMaxCallbackGasLimit(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return sdkerrors.Wrapf(ErrInvalid, "type: %T", i)
+	}
+	return nil
+}
+
+func validateCallbackGasPrice(i interface{}) error {
+	a, ok := i.(sdk.DecCoin)
+	if !ok {
+		return sdkerrors.Wrapf(ErrInvalid, "type: %T", i)
+	}
+	if err := a.Validate(); err != nil {
+		return sdkerrors.Wrap(ErrInvalid, err.Error())
+	}
+	return nil
+}
+
+func validateMaxBlockCallbackGas(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return sdkerrors.Wrapf(ErrInvalid, "type: %T", i)
+	}
+	return nil
+}
+
+// StorageRent returns the rent of the given bytes of contract state for a
+// storage rent period, rounded up.
+func (p Params) StorageRent(bytes uint64) sdk.Coin {
+	amount := p.StorageRentPrice.Amount.MulInt(sdk.NewIntFromUint64(bytes)).Ceil().RoundInt()
+	return sdk.NewCoin(p.StorageRentPrice.Denom, amount)
+}
+
+// CallbackFee returns the fee of the given gas consumed by a callback, rounded up.
+func (p Params) CallbackFee(gas uint64) sdk.Coin {
+	amount := p.CallbackGasPrice.Amount.MulInt(sdk.NewIntFromUint64(gas)).Ceil().RoundInt()
+	return sdk.NewCoin(p.CallbackGasPrice.Denom, amount)
+}
+
 func (a AccessConfig) ValidateBasic() error {
 	switch a.Permission {
 	case AccessTypeUnspecified:
@@ -33,6 +33,9 @@ func TestValidateParams(t *testing.T) {
 				GasMultiplier:                DefaultGasMultiplier,
 				InstanceCost:                 DefaultInstanceCost,
 				CompileCost:                  DefaultCompileCost,
+				StorageRentPrice:             DefaultStorageRentPrice,
+				CallbackGasPrice:             DefaultCallbackGasPrice,
+				MaxBlockCallbackGas:          DefaultMaxBlockCallbackGas,
 			},
 		},
 		"all good with everybody": {
@@ -44,6 +47,9 @@ func TestValidateParams(t *testing.T) {
 				GasMultiplier:                DefaultGasMultiplier,
 				InstanceCost:                 DefaultInstanceCost,
 				CompileCost:                  DefaultCompileCost,
+				StorageRentPrice:             DefaultStorageRentPrice,
+				CallbackGasPrice:             DefaultCallbackGasPrice,
+				MaxBlockCallbackGas:          DefaultMaxBlockCallbackGas,
 			},
 		},
 		"all good with only address": {
@@ -55,6 +61,9 @@ func TestValidateParams(t *testing.T) {
 				GasMultiplier:                DefaultGasMultiplier,
 				InstanceCost:                 DefaultInstanceCost,
 				CompileCost:                  DefaultCompileCost,
+				StorageRentPrice:             DefaultStorageRentPrice,
+				CallbackGasPrice:             DefaultCallbackGasPrice,
+				MaxBlockCallbackGas:          DefaultMaxBlockCallbackGas,
 			},
 		},
 		"reject empty type in instantiate permission": {
@@ -65,6 +74,9 @@ func TestValidateParams(t *testing.T) {
 				GasMultiplier:        DefaultGasMultiplier,
 				InstanceCost:         DefaultInstanceCost,
 				CompileCost:          DefaultCompileCost,
+				StorageRentPrice:     DefaultStorageRentPrice,
+				CallbackGasPrice:     DefaultCallbackGasPrice,
+				MaxBlockCallbackGas:  DefaultMaxBlockCallbackGas,
 			},
 			expErr: true,
 		},
@@ -77,6 +89,9 @@ func TestValidateParams(t *testing.T) {
 				GasMultiplier:                DefaultGasMultiplier,
 				InstanceCost:                 DefaultInstanceCost,
 				CompileCost:                  DefaultCompileCost,
+				StorageRentPrice:             DefaultStorageRentPrice,
+				CallbackGasPrice:             DefaultCallbackGasPrice,
+				MaxBlockCallbackGas:          DefaultMaxBlockCallbackGas,
 			},
 			expErr: true,
 		},
@@ -89,6 +104,9 @@ func TestValidateParams(t *testing.T) {
 				GasMultiplier:                DefaultGasMultiplier,
 				InstanceCost:                 DefaultInstanceCost,
 				CompileCost:                  DefaultCompileCost,
+				StorageRentPrice:             DefaultStorageRentPrice,
+				CallbackGasPrice:             DefaultCallbackGasPrice,
+				MaxBlockCallbackGas:          DefaultMaxBlockCallbackGas,
 			},
 			expErr: true,
 		},
@@ -101,6 +119,9 @@ func TestValidateParams(t *testing.T) {
 				GasMultiplier:                DefaultGasMultiplier,
 				InstanceCost:                 DefaultInstanceCost,
 				CompileCost:                  DefaultCompileCost,
+				StorageRentPrice:             DefaultStorageRentPrice,
+				CallbackGasPrice:             DefaultCallbackGasPrice,
+				MaxBlockCallbackGas:          DefaultMaxBlockCallbackGas,
 			},
 			expErr: true,
 		},
@@ -113,6 +134,9 @@ func TestValidateParams(t *testing.T) {
 				GasMultiplier:                DefaultGasMultiplier,
 				InstanceCost:                 DefaultInstanceCost,
 				CompileCost:                  DefaultCompileCost,
+				StorageRentPrice:             DefaultStorageRentPrice,
+				CallbackGasPrice:             DefaultCallbackGasPrice,
+				MaxBlockCallbackGas:          DefaultMaxBlockCallbackGas,
 			},
 			expErr: true,
 		},
@@ -124,6 +148,9 @@ func TestValidateParams(t *testing.T) {
 				GasMultiplier:                DefaultGasMultiplier,
 				InstanceCost:                 DefaultInstanceCost,
 				CompileCost:                  DefaultCompileCost,
+				StorageRentPrice:             DefaultStorageRentPrice,
+				CallbackGasPrice:             DefaultCallbackGasPrice,
+				MaxBlockCallbackGas:          DefaultMaxBlockCallbackGas,
 			},
 			expErr: true,
 		},
@@ -136,6 +163,9 @@ func TestValidateParams(t *testing.T) {
 				GasMultiplier:                DefaultGasMultiplier,
 				InstanceCost:                 DefaultInstanceCost,
 				CompileCost:                  DefaultCompileCost,
+				StorageRentPrice:             DefaultStorageRentPrice,
+				CallbackGasPrice:             DefaultCallbackGasPrice,
+				MaxBlockCallbackGas:          DefaultMaxBlockCallbackGas,
 			},
 			expErr: true,
 		},
@@ -147,6 +177,9 @@ func TestValidateParams(t *testing.T) {
 				GasMultiplier:                DefaultGasMultiplier,
 				InstanceCost:                 DefaultInstanceCost,
 				CompileCost:                  DefaultCompileCost,
+				StorageRentPrice:             DefaultStorageRentPrice,
+				CallbackGasPrice:             DefaultCallbackGasPrice,
+				MaxBlockCallbackGas:          DefaultMaxBlockCallbackGas,
 			},
 			expErr: true,
 		},
@@ -157,6 +190,9 @@ func TestValidateParams(t *testing.T) {
 				MaxWasmCodeSize:              DefaultMaxWasmCodeSize,
 				InstanceCost:                 DefaultInstanceCost,
 				CompileCost:                  DefaultCompileCost,
+				StorageRentPrice:             DefaultStorageRentPrice,
+				CallbackGasPrice:             DefaultCallbackGasPrice,
+				MaxBlockCallbackGas:          DefaultMaxBlockCallbackGas,
 			},
 			expErr: true,
 		},
@@ -167,6 +203,9 @@ func TestValidateParams(t *testing.T) {
 				MaxWasmCodeSize:              DefaultMaxWasmCodeSize,
 				GasMultiplier:                DefaultGasMultiplier,
 				CompileCost:                  DefaultCompileCost,
+				StorageRentPrice:             DefaultStorageRentPrice,
+				CallbackGasPrice:             DefaultCallbackGasPrice,
+				MaxBlockCallbackGas:          DefaultMaxBlockCallbackGas,
 			},
 			expErr: true,
 		},
@@ -177,6 +216,66 @@ func TestValidateParams(t *testing.T) {
 				MaxWasmCodeSize:              DefaultMaxWasmCodeSize,
 				GasMultiplier:                DefaultGasMultiplier,
 				InstanceCost:                 DefaultInstanceCost,
+				StorageRentPrice:             DefaultStorageRentPrice,
+				CallbackGasPrice:             DefaultCallbackGasPrice,
+				MaxBlockCallbackGas:          DefaultMaxBlockCallbackGas,
+			},
+			expErr: true,
+		},
+		"reject empty storage rent price": {
+			src: Params{
+				CodeUploadAccess:             AllowNobody,
+				InstantiateDefaultPermission: AccessTypeNobody,
+				ContractStatusAccess:         DefaultContractStatusAccess,
+				MaxWasmCodeSize:              DefaultMaxWasmCodeSize,
+				GasMultiplier:                DefaultGasMultiplier,
+				InstanceCost:                 DefaultInstanceCost,
+				CompileCost:                  DefaultCompileCost,
+				CallbackGasPrice:             DefaultCallbackGasPrice,
+				MaxBlockCallbackGas:          DefaultMaxBlockCallbackGas,
+			},
+			expErr: true,
+		},
+		"reject negative storage rent price": {
+			src: Params{
+				CodeUploadAccess:             AllowNobody,
+				InstantiateDefaultPermission: AccessTypeNobody,
+				ContractStatusAccess:         DefaultContractStatusAccess,
+				MaxWasmCodeSize:              DefaultMaxWasmCodeSize,
+				GasMultiplier:                DefaultGasMultiplier,
+				InstanceCost:                 DefaultInstanceCost,
+				CompileCost:                  DefaultCompileCost,
+				StorageRentPrice:             sdk.DecCoin{Denom: sdk.DefaultBondDenom, Amount: sdk.NewDec(-1)},
+				CallbackGasPrice:             DefaultCallbackGasPrice,
+				MaxBlockCallbackGas:          DefaultMaxBlockCallbackGas,
+			},
+			expErr: true,
+		},
+		"reject empty callback gas price": {
+			src: Params{
+				CodeUploadAccess:             AllowNobody,
+				InstantiateDefaultPermission: AccessTypeNobody,
+				ContractStatusAccess:         DefaultContractStatusAccess,
+				MaxWasmCodeSize:              DefaultMaxWasmCodeSize,
+				GasMultiplier:                DefaultGasMultiplier,
+				InstanceCost:                 DefaultInstanceCost,
+				CompileCost:                  DefaultCompileCost,
+				StorageRentPrice:             DefaultStorageRentPrice,
+			},
+			expErr: true,
+		},
+		"reject negative callback gas price": {
+			src: Params{
+				CodeUploadAccess:             AllowNobody,
+				InstantiateDefaultPermission: AccessTypeNobody,
+				ContractStatusAccess:         DefaultContractStatusAccess,
+				MaxWasmCodeSize:              DefaultMaxWasmCodeSize,
+				GasMultiplier:                DefaultGasMultiplier,
+				InstanceCost:                 DefaultInstanceCost,
+				CompileCost:                  DefaultCompileCost,
+				StorageRentPrice:             DefaultStorageRentPrice,
+				CallbackGasPrice:             sdk.DecCoin{Denom: sdk.DefaultBondDenom, Amount: sdk.NewDec(-1)},
+				MaxBlockCallbackGas:          DefaultMaxBlockCallbackGas,
 			},
 			expErr: true,
 		},
@@ -189,6 +288,9 @@ func TestValidateParams(t *testing.T) {
 				GasMultiplier:                DefaultGasMultiplier,
 				InstanceCost:                 DefaultInstanceCost,
 				CompileCost:                  DefaultCompileCost,
+				StorageRentPrice:             DefaultStorageRentPrice,
+				CallbackGasPrice:             DefaultCallbackGasPrice,
+				MaxBlockCallbackGas:          DefaultMaxBlockCallbackGas,
 			},
 			expErr: true,
 		},
@@ -201,6 +303,9 @@ func TestValidateParams(t *testing.T) {
 				GasMultiplier:                DefaultGasMultiplier,
 				InstanceCost:                 DefaultInstanceCost,
 				CompileCost:                  DefaultCompileCost,
+				StorageRentPrice:             DefaultStorageRentPrice,
+				CallbackGasPrice:             DefaultCallbackGasPrice,
+				MaxBlockCallbackGas:          DefaultMaxBlockCallbackGas,
 			},
 			expErr: true,
 		},
@@ -213,6 +318,9 @@ func TestValidateParams(t *testing.T) {
 				GasMultiplier:                DefaultGasMultiplier,
 				InstanceCost:                 DefaultInstanceCost,
 				CompileCost:                  DefaultCompileCost,
+				StorageRentPrice:             DefaultStorageRentPrice,
+				CallbackGasPrice:             DefaultCallbackGasPrice,
+				MaxBlockCallbackGas:          DefaultMaxBlockCallbackGas,
 			},
 			expErr: true,
 		},
@@ -224,6 +332,9 @@ func TestValidateParams(t *testing.T) {
 				GasMultiplier:                DefaultGasMultiplier,
 				InstanceCost:                 DefaultInstanceCost,
 				CompileCost:                  DefaultCompileCost,
+				StorageRentPrice:             DefaultStorageRentPrice,
+				CallbackGasPrice:             DefaultCallbackGasPrice,
+				MaxBlockCallbackGas:          DefaultMaxBlockCallbackGas,
 			},
 			expErr: true,
 		},
@@ -236,6 +347,9 @@ func TestValidateParams(t *testing.T) {
 				GasMultiplier:                DefaultGasMultiplier,
 				InstanceCost:                 DefaultInstanceCost,
 				CompileCost:                  DefaultCompileCost,
+				StorageRentPrice:             DefaultStorageRentPrice,
+				CallbackGasPrice:             DefaultCallbackGasPrice,
+				MaxBlockCallbackGas:          DefaultMaxBlockCallbackGas,
 			},
 			expErr: true,
 		},
@@ -305,7 +419,12 @@ func TestParamsUnmarshalJson(t *testing.T) {
 				"max_wasm_code_size": 614400,
 				"gas_multiplier": 100,
 				"instance_cost": 40000,
-				"compile_cost": 2}`,
+				"compile_cost": 2,
+				"storage_rent_period": 0,
+				"storage_rent_price": {"denom": "stake", "amount": "0.000000000000000000"},
+				"max_callback_gas_limit": 0,
+				"callback_gas_price": {"denom": "stake", "amount": "0.000000000000000000"},
+				"max_block_callback_gas": 10000000}`,
 			exp: DefaultParams(),
 		},
 	}
@@ -21,6 +21,7 @@ const (
 	ProposalTypePinCodes             ProposalType = "PinCodes"
 	ProposalTypeUnpinCodes           ProposalType = "UnpinCodes"
 	ProposalTypeUpdateContractStatus ProposalType = "UpdateContractStatus"
+	ProposalTypeRemoveCallbacks      ProposalType = "RemoveCallbacks"
 )
 
 // DisableAllProposals contains no wasm gov types.
@@ -36,6 +37,7 @@ var EnableAllProposals = []ProposalType{
 	ProposalTypePinCodes,
 	ProposalTypeUnpinCodes,
 	ProposalTypeUpdateContractStatus,
+	ProposalTypeRemoveCallbacks,
 }
 
 // ConvertToProposals maps each key to a ProposalType and returns a typed list.
@@ -65,6 +67,7 @@ func init() { // register new content types with the sdk
 	govtypes.RegisterProposalType(string(ProposalTypePinCodes))
 	govtypes.RegisterProposalType(string(ProposalTypeUnpinCodes))
 	govtypes.RegisterProposalType(string(ProposalTypeUpdateContractStatus))
+	govtypes.RegisterProposalType(string(ProposalTypeRemoveCallbacks))
 	govtypes.RegisterProposalTypeCodec(StoreCodeProposal{}, "wasm/StoreCodeProposal")
 	govtypes.RegisterProposalTypeCodec(InstantiateContractProposal{}, "wasm/InstantiateContractProposal")
 	govtypes.RegisterProposalTypeCodec(MigrateContractProposal{}, "wasm/MigrateContractProposal")
@@ -73,6 +76,7 @@ func init() { // register new content types with the sdk
 	govtypes.RegisterProposalTypeCodec(PinCodesProposal{}, "wasm/PinCodesProposal")
 	govtypes.RegisterProposalTypeCodec(UnpinCodesProposal{}, "wasm/UnpinCodesProposal")
 	govtypes.RegisterProposalTypeCodec(UpdateContractStatusProposal{}, "wasm/UpdateContractStatusProposal")
+	govtypes.RegisterProposalTypeCodec(RemoveCallbacksProposal{}, "wasm/RemoveCallbacksProposal")
 }
 
 // ProposalRoute returns the routing key of a parameter change proposal.
@@ -463,6 +467,38 @@ func (p UpdateContractStatusProposal) String() string {
 `, p.Title, p.Description, p.Contract, p.Status.String())
 }
 
+// ProposalRoute returns the routing key of a parameter change proposal.
+func (p RemoveCallbacksProposal) ProposalRoute() string { return RouterKey }
+
+// GetTitle returns the title of the proposal
+func (p *RemoveCallbacksProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the human readable description of the proposal
+func (p RemoveCallbacksProposal) GetDescription() string { return p.Description }
+
+// ProposalType returns the type
+func (p RemoveCallbacksProposal) ProposalType() string { return string(ProposalTypeRemoveCallbacks) }
+
+// ValidateBasic validates the proposal
+func (p RemoveCallbacksProposal) ValidateBasic() error {
+	if err := validateProposalCommons(p.Title, p.Description); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(p.Contract); err != nil {
+		return sdkerrors.Wrap(err, "contract")
+	}
+	return nil
+}
+
+// String implements the Stringer interface.
+func (p RemoveCallbacksProposal) String() string {
+	return fmt.Sprintf(`Remove Callbacks Proposal:
+  Title:       %s
+  Description: %s
+  Contract:    %s
+`, p.Title, p.Description, p.Contract)
+}
+
 func validateProposalCommons(title, description string) error {
 	if strings.TrimSpace(title) != title {
 		return sdkerrors.Wrap(govtypes.ErrInvalidProposalContent, "proposal title must not start/end with white spaces")
@@ -391,6 +391,48 @@ func (m *UpdateContractStatusProposal) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_UpdateContractStatusProposal proto.InternalMessageInfo
 
+// RemoveCallbacksProposal gov proposal content type to remove all the callbacks of a contract.
+type RemoveCallbacksProposal struct {
+	// Title is a short summary
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// Description is a human readable text
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// Contract is the address of the smart contract
+	Contract string `protobuf:"bytes,3,opt,name=contract,proto3" json:"contract,omitempty"`
+}
+
+func (m *RemoveCallbacksProposal) Reset()      { *m = RemoveCallbacksProposal{} }
+func (*RemoveCallbacksProposal) ProtoMessage() {}
+func (*RemoveCallbacksProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c3ac5ce23bf32d05, []int{8}
+}
+func (m *RemoveCallbacksProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RemoveCallbacksProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RemoveCallbacksProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RemoveCallbacksProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveCallbacksProposal.Merge(m, src)
+}
+func (m *RemoveCallbacksProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *RemoveCallbacksProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveCallbacksProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemoveCallbacksProposal proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*StoreCodeProposal)(nil), "cosmwasm.wasm.v1beta1.StoreCodeProposal")
 	proto.RegisterType((*InstantiateContractProposal)(nil), "cosmwasm.wasm.v1beta1.InstantiateContractProposal")
@@ -400,60 +442,62 @@ func init() {
 	proto.RegisterType((*PinCodesProposal)(nil), "cosmwasm.wasm.v1beta1.PinCodesProposal")
 	proto.RegisterType((*UnpinCodesProposal)(nil), "cosmwasm.wasm.v1beta1.UnpinCodesProposal")
 	proto.RegisterType((*UpdateContractStatusProposal)(nil), "cosmwasm.wasm.v1beta1.UpdateContractStatusProposal")
+	proto.RegisterType((*RemoveCallbacksProposal)(nil), "cosmwasm.wasm.v1beta1.RemoveCallbacksProposal")
 }
 
 func init() { proto.RegisterFile("proposal.proto", fileDescriptor_c3ac5ce23bf32d05) }
 
 var fileDescriptor_c3ac5ce23bf32d05 = []byte{
-	// 760 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x55, 0x4f, 0x6f, 0xe3, 0x44,
-	0x14, 0x8f, 0x9b, 0xc6, 0x49, 0x27, 0x51, 0x09, 0x26, 0x0d, 0x56, 0x77, 0x65, 0x47, 0xae, 0x40,
-	0x91, 0xd0, 0xda, 0x6a, 0x91, 0xf8, 0x27, 0xed, 0x21, 0x0e, 0x97, 0x1e, 0x22, 0x55, 0x8e, 0x56,
-	0x88, 0xbd, 0x44, 0x63, 0x7b, 0xe2, 0x1d, 0xb0, 0x67, 0x2c, 0xcf, 0x84, 0x90, 0x6f, 0xc1, 0x07,
-	0xe0, 0x03, 0xac, 0xb8, 0x20, 0xbe, 0x45, 0x8f, 0xcb, 0x6d, 0x4f, 0x86, 0x4d, 0x2f, 0x9c, 0x73,
-	0xe4, 0x84, 0x66, 0xc6, 0x09, 0x29, 0xda, 0x45, 0x95, 0xa0, 0x48, 0x5c, 0xa2, 0xbc, 0x79, 0xbf,
-	0xf7, 0x7e, 0xbf, 0xf9, 0xbd, 0x97, 0x09, 0x38, 0xce, 0x0b, 0x9a, 0x53, 0x06, 0x53, 0x37, 0x2f,
-	0x28, 0xa7, 0xc6, 0x49, 0x44, 0x59, 0xb6, 0x84, 0x2c, 0x73, 0xe5, 0xc7, 0x37, 0xe7, 0x21, 0xe2,
-	0xf0, 0xfc, 0xb4, 0x97, 0xd0, 0x84, 0x4a, 0x84, 0x27, 0xbe, 0x29, 0xf0, 0xe9, 0x83, 0x74, 0x1e,
-	0x7a, 0x21, 0x64, 0xc8, 0xab, 0x70, 0x5e, 0x44, 0x31, 0xa9, 0x92, 0x6d, 0xbe, 0xca, 0x11, 0x53,
-	0x81, 0xf3, 0xfc, 0x00, 0xbc, 0x3d, 0xe5, 0xb4, 0x40, 0x63, 0x1a, 0xa3, 0xab, 0x8a, 0xd2, 0xe8,
-	0x81, 0x06, 0xc7, 0x3c, 0x45, 0xa6, 0x36, 0xd0, 0x86, 0x47, 0x81, 0x0a, 0x8c, 0x01, 0x68, 0xc7,
-	0x88, 0x45, 0x05, 0xce, 0x39, 0xa6, 0xc4, 0x3c, 0x90, 0xb9, 0xfd, 0x23, 0xe3, 0x04, 0xe8, 0xc5,
-	0x82, 0xcc, 0x20, 0x33, 0xeb, 0xaa, 0xb0, 0x58, 0x90, 0x11, 0x33, 0x3e, 0x02, 0xc7, 0x42, 0xf4,
-	0x2c, 0x5c, 0x71, 0x34, 0x8b, 0x68, 0x8c, 0xcc, 0xc3, 0x81, 0x36, 0xec, 0xf8, 0xdd, 0x75, 0x69,
-	0x77, 0xbe, 0x18, 0x4d, 0x27, 0xfe, 0x8a, 0x4b, 0x01, 0x41, 0x47, 0xe0, 0xb6, 0x91, 0xd1, 0x07,
-	0x3a, 0xa3, 0x8b, 0x22, 0x42, 0x66, 0x43, 0xb6, 0xab, 0x22, 0xc3, 0x04, 0xcd, 0x70, 0x81, 0xd3,
-	0x18, 0x15, 0xa6, 0x2e, 0x13, 0xdb, 0xd0, 0x78, 0x0a, 0xfa, 0x98, 0x30, 0x0e, 0x09, 0xc7, 0x90,
-	0xa3, 0x59, 0x8e, 0x8a, 0x0c, 0x33, 0x26, 0xd4, 0x36, 0x07, 0xda, 0xb0, 0x7d, 0x71, 0xe6, 0xbe,
-	0xd6, 0x46, 0x77, 0x14, 0x45, 0x88, 0xb1, 0x31, 0x25, 0x73, 0x9c, 0x04, 0x27, 0x7b, 0x2d, 0xae,
-	0x76, 0x1d, 0x9c, 0x9f, 0x0f, 0xc0, 0x83, 0xcb, 0x3f, 0x33, 0x63, 0x4a, 0x78, 0x01, 0x23, 0x7e,
-	0x5f, 0xa6, 0xf5, 0x40, 0x03, 0xc6, 0x19, 0x26, 0xd2, 0xab, 0xa3, 0x40, 0x05, 0xc6, 0x19, 0x68,
-	0x0a, 0x03, 0x67, 0x38, 0x96, 0x9e, 0x1c, 0xfa, 0x60, 0x5d, 0xda, 0xba, 0x70, 0xeb, 0xf2, 0xf3,
-	0x40, 0x17, 0xa9, 0xcb, 0x58, 0x94, 0xa6, 0x30, 0x44, 0x69, 0xe5, 0x8e, 0x0a, 0x8c, 0x8f, 0x41,
-	0x0b, 0x13, 0xcc, 0x67, 0x19, 0x4b, 0xa4, 0x1b, 0x1d, 0xff, 0xe1, 0xef, 0xa5, 0x6d, 0x22, 0x12,
-	0xd1, 0x18, 0x93, 0xc4, 0xfb, 0x8a, 0x51, 0xe2, 0x06, 0x70, 0x39, 0x41, 0x8c, 0xc1, 0x04, 0x05,
-	0x4d, 0x81, 0x9e, 0xb0, 0xc4, 0xf8, 0x12, 0x34, 0xe6, 0x0b, 0x12, 0x33, 0xb3, 0x35, 0xa8, 0x0f,
-	0xdb, 0x17, 0x7d, 0x37, 0x9d, 0x87, 0xae, 0xd8, 0xae, 0x9d, 0x7d, 0x63, 0x8a, 0x89, 0xff, 0xc1,
-	0x75, 0x69, 0xd7, 0x7e, 0xf8, 0xc5, 0x3e, 0x4b, 0x30, 0x7f, 0xb6, 0x08, 0xdd, 0x88, 0x66, 0x5e,
-	0x8a, 0x09, 0xf2, 0xd2, 0x79, 0xf8, 0x88, 0xc5, 0x5f, 0x7b, 0x6a, 0xef, 0x04, 0x96, 0x05, 0xaa,
-	0xa3, 0xf3, 0x9b, 0x06, 0xde, 0x9d, 0xe0, 0xa4, 0xf8, 0x0f, 0xfc, 0x3c, 0x05, 0xad, 0xa8, 0xa2,
-	0xa8, 0x2c, 0xdd, 0xc5, 0x77, 0x73, 0xf5, 0x31, 0x68, 0x67, 0x4a, 0xaa, 0xb4, 0x50, 0xbf, 0x83,
-	0x85, 0xa0, 0x2a, 0x98, 0xb0, 0xc4, 0xf9, 0x5e, 0x03, 0xef, 0x3c, 0xc9, 0x63, 0xc8, 0xd1, 0x48,
-	0x4c, 0xf2, 0x1f, 0x5f, 0xf3, 0x1c, 0x1c, 0x11, 0xb4, 0x9c, 0xa9, 0x1d, 0x91, 0x37, 0xf5, 0x7b,
-	0x9b, 0xd2, 0xee, 0xae, 0x60, 0x96, 0x7e, 0xe6, 0xec, 0x52, 0x4e, 0xd0, 0x22, 0x68, 0x29, 0x29,
-	0xff, 0xce, 0x02, 0xe7, 0x19, 0x30, 0xc6, 0x29, 0x82, 0xc5, 0xbf, 0x23, 0x6e, 0x9f, 0xa9, 0xfe,
-	0x17, 0xa6, 0x1f, 0x35, 0xd0, 0xbd, 0xc2, 0x44, 0xb8, 0xcb, 0x76, 0x44, 0xef, 0xdf, 0x22, 0xf2,
-	0xbb, 0x9b, 0xd2, 0xee, 0xa8, 0x9b, 0xc8, 0x63, 0x67, 0x4b, 0xfd, 0xc9, 0x6b, 0xa8, 0xfd, 0xfe,
-	0xa6, 0xb4, 0x0d, 0x85, 0xde, 0x4b, 0x3a, 0xb7, 0x25, 0x7d, 0x2a, 0x24, 0xc9, 0x19, 0x8b, 0xc5,
-	0xa8, 0x0f, 0x0f, 0x7d, 0x6b, 0x5d, 0xda, 0x4d, 0x35, 0x64, 0xb6, 0x29, 0xed, 0xb7, 0x54, 0x87,
-	0x2d, 0xc8, 0x09, 0x9a, 0x6a, 0xf0, 0xcc, 0xf9, 0x49, 0x03, 0xc6, 0x13, 0x92, 0xff, 0xdf, 0x34,
-	0x3f, 0x54, 0xeb, 0xb6, 0xfd, 0x61, 0x4d, 0x39, 0xe4, 0x0b, 0x76, 0x9f, 0xa3, 0x35, 0x1e, 0x03,
-	0x9d, 0x49, 0x16, 0xb9, 0x5e, 0xc7, 0x17, 0xef, 0xbd, 0xe1, 0xb9, 0xbd, 0x2d, 0x29, 0xa8, 0x8a,
-	0xfc, 0xe9, 0xf5, 0x2b, 0xab, 0xf6, 0xf2, 0x95, 0x55, 0x7b, 0xbe, 0xb6, 0xb4, 0xeb, 0xb5, 0xa5,
-	0xbd, 0x58, 0x5b, 0xda, 0xaf, 0x6b, 0x4b, 0xfb, 0xee, 0xc6, 0xaa, 0xbd, 0xb8, 0xb1, 0x6a, 0x2f,
-	0x6f, 0xac, 0xda, 0xd3, 0x47, 0x6f, 0x7a, 0x5f, 0xbe, 0xf5, 0x04, 0x89, 0x87, 0x09, 0x47, 0x05,
-	0x81, 0xa9, 0x7a, 0x6f, 0x42, 0x5d, 0xfe, 0xd1, 0x7d, 0xf8, 0x47, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0xca, 0x0e, 0x15, 0xd2, 0x51, 0x07, 0x00, 0x00,
+	// 773 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x55, 0x4f, 0x6b, 0xe3, 0x46,
+	0x14, 0xb7, 0xe2, 0x58, 0x76, 0xc6, 0x26, 0x75, 0x55, 0xc7, 0x2b, 0xb2, 0x8b, 0x64, 0x14, 0x5a,
+	0x0c, 0x65, 0x25, 0x92, 0x42, 0xff, 0xc1, 0x1e, 0x2c, 0xf7, 0x92, 0x83, 0x21, 0xc8, 0x2c, 0xa5,
+	0x7b, 0x31, 0x23, 0x69, 0xac, 0x9d, 0xae, 0x34, 0x23, 0x34, 0xe3, 0x75, 0xfd, 0x2d, 0xfa, 0x01,
+	0xfa, 0x01, 0x42, 0x2f, 0xa5, 0xdf, 0x22, 0xc7, 0xf4, 0x96, 0x93, 0xda, 0x38, 0x97, 0x9e, 0x7d,
+	0xec, 0xa9, 0x68, 0x46, 0x76, 0x9d, 0x92, 0x94, 0x40, 0x9b, 0xc2, 0x5e, 0x8c, 0xdf, 0xbc, 0xdf,
+	0xbc, 0xdf, 0xef, 0xfd, 0xde, 0xf3, 0x18, 0xec, 0xa7, 0x19, 0x4d, 0x29, 0x83, 0xb1, 0x9d, 0x66,
+	0x94, 0x53, 0xed, 0x20, 0xa0, 0x2c, 0x99, 0x43, 0x96, 0xd8, 0xe2, 0xe3, 0xed, 0xb1, 0x8f, 0x38,
+	0x3c, 0x3e, 0xec, 0x44, 0x34, 0xa2, 0x02, 0xe1, 0x14, 0xdf, 0x24, 0xf8, 0xf0, 0x69, 0x3c, 0xf5,
+	0x1d, 0x1f, 0x32, 0xe4, 0x94, 0x38, 0x27, 0xa0, 0x98, 0x94, 0xc9, 0x26, 0x5f, 0xa4, 0x88, 0xc9,
+	0xc0, 0x3a, 0xdf, 0x01, 0xef, 0x8f, 0x39, 0xcd, 0xd0, 0x90, 0x86, 0xe8, 0xac, 0xa4, 0xd4, 0x3a,
+	0xa0, 0xc6, 0x31, 0x8f, 0x91, 0xae, 0xf4, 0x94, 0xfe, 0x9e, 0x27, 0x03, 0xad, 0x07, 0x9a, 0x21,
+	0x62, 0x41, 0x86, 0x53, 0x8e, 0x29, 0xd1, 0x77, 0x44, 0x6e, 0xfb, 0x48, 0x3b, 0x00, 0x6a, 0x36,
+	0x23, 0x13, 0xc8, 0xf4, 0xaa, 0xbc, 0x98, 0xcd, 0xc8, 0x80, 0x69, 0x9f, 0x82, 0xfd, 0x42, 0xf4,
+	0xc4, 0x5f, 0x70, 0x34, 0x09, 0x68, 0x88, 0xf4, 0xdd, 0x9e, 0xd2, 0x6f, 0xb9, 0xed, 0x65, 0x6e,
+	0xb6, 0xbe, 0x1e, 0x8c, 0x47, 0xee, 0x82, 0x0b, 0x01, 0x5e, 0xab, 0xc0, 0xad, 0x23, 0xad, 0x0b,
+	0x54, 0x46, 0x67, 0x59, 0x80, 0xf4, 0x9a, 0x28, 0x57, 0x46, 0x9a, 0x0e, 0xea, 0xfe, 0x0c, 0xc7,
+	0x21, 0xca, 0x74, 0x55, 0x24, 0xd6, 0xa1, 0xf6, 0x0a, 0x74, 0x31, 0x61, 0x1c, 0x12, 0x8e, 0x21,
+	0x47, 0x93, 0x14, 0x65, 0x09, 0x66, 0xac, 0x50, 0x5b, 0xef, 0x29, 0xfd, 0xe6, 0xc9, 0x91, 0x7d,
+	0xa7, 0x8d, 0xf6, 0x20, 0x08, 0x10, 0x63, 0x43, 0x4a, 0xa6, 0x38, 0xf2, 0x0e, 0xb6, 0x4a, 0x9c,
+	0x6d, 0x2a, 0x58, 0xbf, 0xec, 0x80, 0xa7, 0xa7, 0x7f, 0x65, 0x86, 0x94, 0xf0, 0x0c, 0x06, 0xfc,
+	0xb1, 0x4c, 0xeb, 0x80, 0x1a, 0x0c, 0x13, 0x4c, 0x84, 0x57, 0x7b, 0x9e, 0x0c, 0xb4, 0x23, 0x50,
+	0x2f, 0x0c, 0x9c, 0xe0, 0x50, 0x78, 0xb2, 0xeb, 0x82, 0x65, 0x6e, 0xaa, 0x85, 0x5b, 0xa7, 0x5f,
+	0x79, 0x6a, 0x91, 0x3a, 0x0d, 0x8b, 0xab, 0x31, 0xf4, 0x51, 0x5c, 0xba, 0x23, 0x03, 0xed, 0x33,
+	0xd0, 0xc0, 0x04, 0xf3, 0x49, 0xc2, 0x22, 0xe1, 0x46, 0xcb, 0x7d, 0xf6, 0x47, 0x6e, 0xea, 0x88,
+	0x04, 0x34, 0xc4, 0x24, 0x72, 0xbe, 0x65, 0x94, 0xd8, 0x1e, 0x9c, 0x8f, 0x10, 0x63, 0x30, 0x42,
+	0x5e, 0xbd, 0x40, 0x8f, 0x58, 0xa4, 0x7d, 0x03, 0x6a, 0xd3, 0x19, 0x09, 0x99, 0xde, 0xe8, 0x55,
+	0xfb, 0xcd, 0x93, 0xae, 0x1d, 0x4f, 0x7d, 0xbb, 0xd8, 0xae, 0x8d, 0x7d, 0x43, 0x8a, 0x89, 0xfb,
+	0xf1, 0x45, 0x6e, 0x56, 0x7e, 0xfc, 0xd5, 0x3c, 0x8a, 0x30, 0x7f, 0x3d, 0xf3, 0xed, 0x80, 0x26,
+	0x4e, 0x8c, 0x09, 0x72, 0xe2, 0xa9, 0xff, 0x9c, 0x85, 0x6f, 0x1c, 0xb9, 0x77, 0x05, 0x96, 0x79,
+	0xb2, 0xa2, 0xf5, 0xbb, 0x02, 0x9e, 0x8c, 0x70, 0x94, 0xfd, 0x0f, 0x7e, 0x1e, 0x82, 0x46, 0x50,
+	0x52, 0x94, 0x96, 0x6e, 0xe2, 0x87, 0xb9, 0xfa, 0x02, 0x34, 0x13, 0x29, 0x55, 0x58, 0xa8, 0x3e,
+	0xc0, 0x42, 0x50, 0x5e, 0x18, 0xb1, 0xc8, 0xfa, 0x41, 0x01, 0x1f, 0xbc, 0x4c, 0x43, 0xc8, 0xd1,
+	0xa0, 0x98, 0xe4, 0xbf, 0x6e, 0xf3, 0x18, 0xec, 0x11, 0x34, 0x9f, 0xc8, 0x1d, 0x11, 0x9d, 0xba,
+	0x9d, 0x55, 0x6e, 0xb6, 0x17, 0x30, 0x89, 0xbf, 0xb4, 0x36, 0x29, 0xcb, 0x6b, 0x10, 0x34, 0x17,
+	0x94, 0xff, 0x64, 0x81, 0xf5, 0x1a, 0x68, 0xc3, 0x18, 0xc1, 0xec, 0xbf, 0x11, 0xb7, 0xcd, 0x54,
+	0xfd, 0x1b, 0xd3, 0x4f, 0x0a, 0x68, 0x9f, 0x61, 0x52, 0xb8, 0xcb, 0x36, 0x44, 0x1f, 0xdd, 0x22,
+	0x72, 0xdb, 0xab, 0xdc, 0x6c, 0xc9, 0x4e, 0xc4, 0xb1, 0xb5, 0xa6, 0xfe, 0xfc, 0x0e, 0x6a, 0xb7,
+	0xbb, 0xca, 0x4d, 0x4d, 0xa2, 0xb7, 0x92, 0xd6, 0x6d, 0x49, 0x5f, 0x80, 0x46, 0x39, 0xe3, 0x62,
+	0x31, 0xaa, 0xfd, 0x5d, 0xd7, 0x58, 0xe6, 0x66, 0x5d, 0x0e, 0x99, 0xad, 0x72, 0xf3, 0x3d, 0x59,
+	0x61, 0x0d, 0xb2, 0xbc, 0xba, 0x1c, 0x3c, 0xb3, 0x7e, 0x56, 0x80, 0xf6, 0x92, 0xa4, 0xef, 0x9a,
+	0xe6, 0x67, 0x72, 0xdd, 0xd6, 0x3f, 0xac, 0x31, 0x87, 0x7c, 0xc6, 0x1e, 0x73, 0xb4, 0xda, 0x0b,
+	0xa0, 0x32, 0xc1, 0x22, 0xd6, 0x6b, 0xff, 0xe4, 0xc3, 0x7b, 0x9e, 0xdb, 0xdb, 0x92, 0xbc, 0xf2,
+	0x92, 0x95, 0x80, 0x27, 0x1e, 0x4a, 0xe8, 0x5b, 0x34, 0x84, 0x71, 0xec, 0xc3, 0xe0, 0xcd, 0xa3,
+	0xaa, 0x75, 0xc7, 0x17, 0xd7, 0x46, 0xe5, 0xea, 0xda, 0xa8, 0x9c, 0x2f, 0x0d, 0xe5, 0x62, 0x69,
+	0x28, 0x97, 0x4b, 0x43, 0xf9, 0x6d, 0x69, 0x28, 0xdf, 0xdf, 0x18, 0x95, 0xcb, 0x1b, 0xa3, 0x72,
+	0x75, 0x63, 0x54, 0x5e, 0x3d, 0xbf, 0xef, 0x39, 0xfb, 0xce, 0x29, 0x7a, 0x72, 0x30, 0xe1, 0x28,
+	0x23, 0x30, 0x96, 0xcf, 0x9b, 0xaf, 0x8a, 0xff, 0xd5, 0x4f, 0xfe, 0x1c, 0x00, 0xfc, 0x81, 0x3b,
+	0xdd, 0xc0, 0x07, 0x00, 0x00,
 }
 
 func (this *StoreCodeProposal) Equal(that interface{}) bool {
@@ -753,6 +797,36 @@ func (this *UpdateContractStatusProposal) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *RemoveCallbacksProposal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RemoveCallbacksProposal)
+	if !ok {
+		that2, ok := that.(RemoveCallbacksProposal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Title != that1.Title {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	if this.Contract != that1.Contract {
+		return false
+	}
+	return true
+}
 func (m *StoreCodeProposal) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1231,6 +1305,50 @@ func (m *UpdateContractStatusProposal) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
+func (m *RemoveCallbacksProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RemoveCallbacksProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemoveCallbacksProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintProposal(dAtA []byte, offset int, v uint64) int {
 	offset -= sovProposal(v)
 	base := offset
@@ -1471,6 +1589,27 @@ func (m *UpdateContractStatusProposal) Size() (n int) {
 	return n
 }
 
+func (m *RemoveCallbacksProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	return n
+}
+
 func sovProposal(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -3154,6 +3293,152 @@ func (m *UpdateContractStatusProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *RemoveCallbacksProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RemoveCallbacksProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RemoveCallbacksProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipProposal(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
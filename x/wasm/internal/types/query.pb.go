@@ -671,6 +671,83 @@ func (m *QueryCodeResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryCodeResponse proto.InternalMessageInfo
 
+// QueryCodeIDByHashRequest is the request type for the Query/CodeIDByHash RPC method
+type QueryCodeIDByHashRequest struct {
+	// code_hash is the hex encoded sha256 checksum of the wasm code
+	CodeHash string `protobuf:"bytes,1,opt,name=code_hash,json=codeHash,proto3" json:"code_hash,omitempty"`
+}
+
+func (m *QueryCodeIDByHashRequest) Reset()         { *m = QueryCodeIDByHashRequest{} }
+func (m *QueryCodeIDByHashRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCodeIDByHashRequest) ProtoMessage()    {}
+func (*QueryCodeIDByHashRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c6ac9b241082464, []int{16}
+}
+func (m *QueryCodeIDByHashRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCodeIDByHashRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCodeIDByHashRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCodeIDByHashRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCodeIDByHashRequest.Merge(m, src)
+}
+func (m *QueryCodeIDByHashRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCodeIDByHashRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCodeIDByHashRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCodeIDByHashRequest proto.InternalMessageInfo
+
+// QueryCodeIDByHashResponse is the response type for the Query/CodeIDByHash RPC method
+type QueryCodeIDByHashResponse struct {
+	CodeID uint64 `protobuf:"varint,1,opt,name=code_id,json=codeId,proto3" json:"code_id,omitempty"`
+}
+
+func (m *QueryCodeIDByHashResponse) Reset()         { *m = QueryCodeIDByHashResponse{} }
+func (m *QueryCodeIDByHashResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCodeIDByHashResponse) ProtoMessage()    {}
+func (*QueryCodeIDByHashResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c6ac9b241082464, []int{17}
+}
+func (m *QueryCodeIDByHashResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCodeIDByHashResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCodeIDByHashResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCodeIDByHashResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCodeIDByHashResponse.Merge(m, src)
+}
+func (m *QueryCodeIDByHashResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCodeIDByHashResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCodeIDByHashResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCodeIDByHashResponse proto.InternalMessageInfo
+
 // QueryCodesRequest is the request type for the Query/Codes RPC method
 type QueryCodesRequest struct {
 	// pagination defines an optional pagination for the request.
@@ -681,7 +758,7 @@ func (m *QueryCodesRequest) Reset()         { *m = QueryCodesRequest{} }
 func (m *QueryCodesRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryCodesRequest) ProtoMessage()    {}
 func (*QueryCodesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5c6ac9b241082464, []int{16}
+	return fileDescriptor_5c6ac9b241082464, []int{18}
 }
 func (m *QueryCodesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -721,7 +798,7 @@ func (m *QueryCodesResponse) Reset()         { *m = QueryCodesResponse{} }
 func (m *QueryCodesResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryCodesResponse) ProtoMessage()    {}
 func (*QueryCodesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5c6ac9b241082464, []int{17}
+	return fileDescriptor_5c6ac9b241082464, []int{19}
 }
 func (m *QueryCodesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -750,6 +827,87 @@ func (m *QueryCodesResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryCodesResponse proto.InternalMessageInfo
 
+// QueryCallbacksRequest is the request type for the Query/Callbacks RPC method
+type QueryCallbacksRequest struct {
+	// address is the address of the contract to query
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryCallbacksRequest) Reset()         { *m = QueryCallbacksRequest{} }
+func (m *QueryCallbacksRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCallbacksRequest) ProtoMessage()    {}
+func (*QueryCallbacksRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c6ac9b241082464, []int{20}
+}
+func (m *QueryCallbacksRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCallbacksRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCallbacksRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCallbacksRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCallbacksRequest.Merge(m, src)
+}
+func (m *QueryCallbacksRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCallbacksRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCallbacksRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCallbacksRequest proto.InternalMessageInfo
+
+// QueryCallbacksResponse is the response type for the Query/Callbacks RPC method
+type QueryCallbacksResponse struct {
+	Callbacks []Callback `protobuf:"bytes,1,rep,name=callbacks,proto3" json:"callbacks"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryCallbacksResponse) Reset()         { *m = QueryCallbacksResponse{} }
+func (m *QueryCallbacksResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCallbacksResponse) ProtoMessage()    {}
+func (*QueryCallbacksResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c6ac9b241082464, []int{21}
+}
+func (m *QueryCallbacksResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCallbacksResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCallbacksResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCallbacksResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCallbacksResponse.Merge(m, src)
+}
+func (m *QueryCallbacksResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCallbacksResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCallbacksResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCallbacksResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*QueryContractInfoRequest)(nil), "cosmwasm.wasm.v1beta1.QueryContractInfoRequest")
 	proto.RegisterType((*QueryContractInfoResponse)(nil), "cosmwasm.wasm.v1beta1.QueryContractInfoResponse")
@@ -767,83 +925,96 @@ func init() {
 	proto.RegisterType((*QueryCodeRequest)(nil), "cosmwasm.wasm.v1beta1.QueryCodeRequest")
 	proto.RegisterType((*CodeInfoResponse)(nil), "cosmwasm.wasm.v1beta1.CodeInfoResponse")
 	proto.RegisterType((*QueryCodeResponse)(nil), "cosmwasm.wasm.v1beta1.QueryCodeResponse")
+	proto.RegisterType((*QueryCodeIDByHashRequest)(nil), "cosmwasm.wasm.v1beta1.QueryCodeIDByHashRequest")
+	proto.RegisterType((*QueryCodeIDByHashResponse)(nil), "cosmwasm.wasm.v1beta1.QueryCodeIDByHashResponse")
 	proto.RegisterType((*QueryCodesRequest)(nil), "cosmwasm.wasm.v1beta1.QueryCodesRequest")
 	proto.RegisterType((*QueryCodesResponse)(nil), "cosmwasm.wasm.v1beta1.QueryCodesResponse")
+	proto.RegisterType((*QueryCallbacksRequest)(nil), "cosmwasm.wasm.v1beta1.QueryCallbacksRequest")
+	proto.RegisterType((*QueryCallbacksResponse)(nil), "cosmwasm.wasm.v1beta1.QueryCallbacksResponse")
 }
 
 func init() { proto.RegisterFile("query.proto", fileDescriptor_5c6ac9b241082464) }
 
 var fileDescriptor_5c6ac9b241082464 = []byte{
-	// 1104 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x97, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xc7, 0x3d, 0xa9, 0xf3, 0xc3, 0x93, 0x14, 0xc2, 0xa8, 0x50, 0x63, 0xdc, 0x75, 0xb4, 0x54,
-	0xc4, 0x05, 0xba, 0x93, 0x1f, 0x2d, 0x12, 0xe5, 0x54, 0x27, 0x48, 0xa9, 0x44, 0x28, 0x6c, 0x0e,
-	0xe5, 0xc7, 0x21, 0x9a, 0xdd, 0x9d, 0xd8, 0x0b, 0xeb, 0x1d, 0x77, 0x67, 0x4c, 0x62, 0x85, 0x08,
-	0x89, 0x0b, 0x27, 0x04, 0x12, 0x47, 0x2e, 0x70, 0xab, 0x2a, 0x38, 0x22, 0x71, 0x84, 0x5b, 0x8e,
-	0x91, 0xb8, 0x70, 0xb2, 0xc0, 0xe1, 0x80, 0xf2, 0x27, 0xf4, 0x84, 0x76, 0x76, 0xd6, 0x59, 0x3b,
-	0x59, 0xdb, 0x41, 0x16, 0x5c, 0xac, 0x1d, 0x7b, 0xde, 0x7b, 0x9f, 0xf7, 0x7d, 0x33, 0xef, 0xad,
-	0xe1, 0xec, 0xc3, 0x26, 0x0d, 0x5a, 0x46, 0x23, 0x60, 0x82, 0xa1, 0x67, 0x6d, 0xc6, 0xeb, 0xbb,
-	0x84, 0xd7, 0x0d, 0xf9, 0xf1, 0xc9, 0xb2, 0x45, 0x05, 0x59, 0x2e, 0x5c, 0xa9, 0xb2, 0x2a, 0x93,
-	0x3b, 0x70, 0xf8, 0x14, 0x6d, 0x2e, 0xcc, 0x8a, 0x56, 0x83, 0x72, 0xb5, 0x28, 0x56, 0x19, 0xab,
-	0x7a, 0x14, 0x93, 0x86, 0x8b, 0x89, 0xef, 0x33, 0x41, 0x84, 0xcb, 0xfc, 0xf8, 0xd7, 0x45, 0x6f,
-	0xc7, 0xc2, 0x16, 0xe1, 0x14, 0xcb, 0x68, 0x58, 0x39, 0xc6, 0x0d, 0x52, 0x75, 0x7d, 0xb9, 0x33,
-	0xda, 0xa8, 0xdf, 0x82, 0xf9, 0x77, 0xc3, 0x1d, 0x6b, 0xcc, 0x17, 0x01, 0xb1, 0xc5, 0x3d, 0x7f,
-	0x87, 0x99, 0xf4, 0x61, 0x93, 0x72, 0x81, 0xf2, 0x70, 0x9a, 0x38, 0x4e, 0x40, 0x39, 0xcf, 0x83,
-	0x05, 0x50, 0xce, 0x99, 0xf1, 0x52, 0xff, 0x0a, 0xc0, 0xe7, 0xcf, 0x31, 0xe3, 0x0d, 0xe6, 0x73,
-	0x9a, 0x6e, 0x87, 0x4c, 0x78, 0xd9, 0x56, 0x16, 0xdb, 0xae, 0xbf, 0xc3, 0xf2, 0x13, 0x0b, 0xa0,
-	0x3c, 0xbb, 0xf2, 0xa2, 0x71, 0xae, 0x0c, 0x46, 0xd2, 0x7b, 0x65, 0xe6, 0xa8, 0x5d, 0x02, 0x27,
-	0xed, 0x52, 0xc6, 0x9c, 0xb3, 0x13, 0xdf, 0xdf, 0xc9, 0xfe, 0xfd, 0x5d, 0x09, 0xe8, 0x9f, 0xc2,
-	0x17, 0x7a, 0x80, 0x36, 0x5c, 0x2e, 0x58, 0xd0, 0x1a, 0x9a, 0x0a, 0x5a, 0x83, 0xf0, 0x54, 0x94,
-	0x2e, 0x8f, 0xb7, 0x63, 0x19, 0xa1, 0x7c, 0x46, 0x54, 0xac, 0x18, 0xe8, 0x1d, 0x52, 0xa5, 0xca,
-	0xa5, 0x99, 0x30, 0xd3, 0x7f, 0x02, 0xb0, 0x78, 0x7e, 0x78, 0x25, 0xc9, 0x7d, 0x38, 0x4d, 0x7d,
-	0x11, 0xb8, 0x34, 0x8c, 0x7f, 0xa9, 0x3c, 0xbb, 0x82, 0x87, 0xa4, 0xbc, 0xc6, 0x1c, 0xaa, 0x9c,
-	0xbc, 0xe9, 0x8b, 0xa0, 0x55, 0xc9, 0x1e, 0x86, 0xa9, 0xc7, 0x5e, 0xd0, 0xfa, 0x39, 0xd8, 0xd7,
-	0x07, 0x63, 0x47, 0x28, 0x3d, 0xdc, 0xfb, 0x7d, 0xaa, 0xf1, 0x4a, 0x2b, 0x0c, 0x1c, 0xab, 0x76,
-	0x15, 0x4e, 0xdb, 0xcc, 0xa1, 0xdb, 0xae, 0x23, 0x55, 0xcb, 0x9a, 0x53, 0xe1, 0xf2, 0x9e, 0x33,
-	0x1e, 0xd1, 0xbe, 0x04, 0xf0, 0x6a, 0xb2, 0xc2, 0x0f, 0x5c, 0x51, 0xbb, 0xab, 0xaa, 0xf2, 0x7f,
-	0x1c, 0xa1, 0x5f, 0xfb, 0x8b, 0xd8, 0x55, 0x43, 0x15, 0xf1, 0x43, 0xf8, 0x54, 0x4f, 0xe8, 0xb8,
-	0x96, 0xc6, 0x08, 0xb1, 0x13, 0xc9, 0xa9, 0x52, 0x5e, 0x4e, 0x22, 0x8c, 0xab, 0xa0, 0x07, 0x2a,
-	0x85, 0xbb, 0x9e, 0x17, 0x47, 0xdf, 0x12, 0x44, 0xd0, 0xff, 0xe8, 0x1e, 0x7c, 0x0f, 0xe0, 0xb5,
-	0x94, 0xf8, 0x4a, 0xc3, 0x3b, 0x70, 0xaa, 0xce, 0x1c, 0xea, 0xc5, 0xda, 0x15, 0x53, 0xb4, 0xdb,
-	0x0c, 0x37, 0x29, 0xa5, 0x94, 0xc5, 0x98, 0x24, 0x7a, 0xa0, 0x24, 0x32, 0xc9, 0xee, 0x05, 0x25,
-	0xba, 0x06, 0xa1, 0x8c, 0xb1, 0xed, 0x10, 0x41, 0x64, 0xfc, 0x39, 0x33, 0x27, 0xbf, 0x59, 0x27,
-	0x82, 0xe8, 0xab, 0x2a, 0xf7, 0xb3, 0x8e, 0x55, 0xee, 0x08, 0x66, 0xa5, 0x25, 0x90, 0x96, 0xf2,
-	0x59, 0x7f, 0x1f, 0x6a, 0xd2, 0x68, 0xab, 0x4e, 0x02, 0x31, 0x5e, 0x9e, 0x2d, 0x58, 0x4a, 0x75,
-	0xad, 0x88, 0x96, 0x92, 0x44, 0x95, 0xe2, 0x93, 0x76, 0x29, 0x4f, 0x7d, 0x9b, 0x39, 0xae, 0x5f,
-	0xc5, 0x1f, 0x71, 0xe6, 0x1b, 0x26, 0xd9, 0xdd, 0xa4, 0x9c, 0x87, 0x5a, 0x46, 0xbc, 0xaf, 0xc0,
-	0x79, 0x75, 0x47, 0x86, 0xb7, 0x09, 0xbd, 0x0d, 0xe0, 0x7c, 0xb8, 0xb1, 0x67, 0x3a, 0xdc, 0xe8,
-	0xdb, 0x5d, 0x99, 0xef, 0xb4, 0x4b, 0x53, 0x72, 0xdb, 0xfa, 0x49, 0xbb, 0x34, 0xe1, 0x3a, 0xdd,
-	0x36, 0x93, 0x87, 0xd3, 0x76, 0x40, 0x89, 0x60, 0x81, 0xcc, 0x2e, 0x67, 0xc6, 0x4b, 0xb4, 0x09,
-	0x73, 0x21, 0xce, 0x76, 0x8d, 0xf0, 0x5a, 0xfe, 0x92, 0xa4, 0x5f, 0x7a, 0xd2, 0x2e, 0xbd, 0x5a,
-	0x75, 0x45, 0xad, 0x69, 0x19, 0x36, 0xab, 0x63, 0xcf, 0xf5, 0x29, 0x66, 0x3c, 0xcc, 0x9a, 0xf9,
-	0xd8, 0x73, 0x2d, 0x8e, 0xad, 0x96, 0xa0, 0xdc, 0xd8, 0xa0, 0x7b, 0x95, 0xf0, 0xc1, 0x9c, 0x09,
-	0x5d, 0x6c, 0x10, 0x5e, 0x43, 0xcf, 0xc1, 0x29, 0xce, 0x9a, 0x81, 0x4d, 0xf3, 0x59, 0x19, 0x47,
-	0xad, 0x42, 0x00, 0xab, 0xe9, 0x7a, 0x0e, 0x0d, 0xf2, 0x93, 0x11, 0x80, 0x5a, 0xaa, 0x96, 0xf1,
-	0x05, 0x80, 0xcf, 0x24, 0xe4, 0x50, 0x19, 0xbe, 0x0d, 0x73, 0x51, 0x86, 0x61, 0x7b, 0x02, 0xf2,
-	0x98, 0x2e, 0xa6, 0xb6, 0x88, 0x5e, 0x75, 0x12, 0x2d, 0x6a, 0xc6, 0x56, 0xbf, 0xa1, 0xa2, 0xaa,
-	0x92, 0xac, 0x70, 0x65, 0xe6, 0xa4, 0x5d, 0x92, 0xeb, 0xa8, 0x22, 0x8a, 0xe4, 0xbd, 0x04, 0x08,
-	0x8f, 0x0b, 0xd3, 0x7b, 0xa7, 0xc1, 0xbf, 0xbb, 0xd3, 0x8f, 0x00, 0x44, 0x49, 0xd7, 0x2a, 0xc9,
-	0xb7, 0x20, 0xec, 0x26, 0x19, 0x5f, 0xe6, 0x91, 0xb3, 0x8c, 0xee, 0x75, 0x2e, 0xce, 0x70, 0x4c,
-	0x57, 0x7b, 0xe5, 0x31, 0x84, 0x93, 0x12, 0x15, 0x7d, 0x0b, 0xe0, 0x5c, 0xb2, 0xfd, 0xa2, 0xb4,
-	0x79, 0x9b, 0xf6, 0xf2, 0x53, 0x58, 0x1a, 0xdd, 0x20, 0x22, 0xd1, 0xcb, 0x9f, 0xff, 0xf6, 0xd7,
-	0x37, 0x13, 0x3a, 0x5a, 0xc0, 0xa1, 0x41, 0xf7, 0x95, 0x2b, 0x6e, 0xf3, 0x78, 0x5f, 0xdd, 0xdb,
-	0x03, 0xf4, 0x03, 0x80, 0x4f, 0xf7, 0xbd, 0x29, 0xa0, 0x95, 0x51, 0xe2, 0xf5, 0xbe, 0xd5, 0x14,
-	0x56, 0x2f, 0x64, 0xa3, 0x30, 0x97, 0x24, 0xe6, 0xcb, 0xa8, 0x3c, 0x0c, 0x13, 0xd7, 0x14, 0xda,
-	0xe3, 0x04, 0xae, 0x9a, 0x89, 0xa3, 0xe1, 0xf6, 0xbe, 0x4e, 0x8c, 0x86, 0xdb, 0x37, 0x74, 0x75,
-	0x43, 0xe2, 0x96, 0xd1, 0x4b, 0xfd, 0xb8, 0x0e, 0xc5, 0xfb, 0xaa, 0x91, 0x1c, 0x74, 0xe9, 0x39,
-	0xfa, 0x11, 0xc0, 0xf9, 0xfe, 0xe9, 0x83, 0x06, 0x46, 0x4e, 0x99, 0x95, 0x85, 0x5b, 0x17, 0x33,
-	0x1a, 0xc6, 0x7b, 0x46, 0x5e, 0x2e, 0xd1, 0x7e, 0x06, 0x70, 0xbe, 0x7f, 0x62, 0x0c, 0xe6, 0x4d,
-	0x19, 0x5c, 0x83, 0x79, 0xd3, 0x86, 0x92, 0xfe, 0xba, 0xe4, 0x5d, 0x45, 0xcb, 0x43, 0x79, 0x03,
-	0xb2, 0x8b, 0xf7, 0x4f, 0x07, 0xce, 0x01, 0xfa, 0x05, 0x40, 0x74, 0x76, 0xb8, 0xa0, 0xdb, 0x83,
-	0x38, 0x52, 0xe7, 0x5c, 0xe1, 0xb5, 0x8b, 0x9a, 0xa9, 0x04, 0xde, 0x90, 0x09, 0xdc, 0x46, 0xab,
-	0xc3, 0x05, 0x0f, 0x9d, 0xf4, 0xa6, 0xf0, 0x19, 0xcc, 0xca, 0xe3, 0xbc, 0x38, 0xf8, 0x68, 0x9e,
-	0x9e, 0xe1, 0xf2, 0xf0, 0x8d, 0x8a, 0xeb, 0xba, 0xe4, 0xd2, 0x50, 0x71, 0xd0, 0xc1, 0x45, 0x7b,
-	0x70, 0x52, 0xf6, 0x55, 0x34, 0xd4, 0x71, 0xdc, 0xd5, 0x0b, 0x37, 0x46, 0xd8, 0xa9, 0x18, 0x0a,
-	0x92, 0xe1, 0x0a, 0x42, 0x67, 0x19, 0x2a, 0xf7, 0x0f, 0xff, 0xd4, 0x32, 0x8f, 0x3a, 0x5a, 0xe6,
-	0xb0, 0xa3, 0x81, 0xa3, 0x8e, 0x06, 0xfe, 0xe8, 0x68, 0xe0, 0xeb, 0x63, 0x2d, 0x73, 0x74, 0xac,
-	0x65, 0x7e, 0x3f, 0xd6, 0x32, 0x1f, 0xdc, 0xec, 0x9f, 0xa6, 0xde, 0x8e, 0x75, 0x93, 0x3b, 0x1f,
-	0xe3, 0xbd, 0xc8, 0x9d, 0xeb, 0x0b, 0x1a, 0xf8, 0xc4, 0xc3, 0xf2, 0x7f, 0xa9, 0x35, 0x25, 0xff,
-	0x51, 0xae, 0xfe, 0x13, 0x00, 0x00, 0xff, 0xff, 0x7d, 0xd2, 0x6b, 0xca, 0xe1, 0x0e, 0x00, 0x00,
+	// 1248 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x98, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xc7, 0x3d, 0xad, 0xf3, 0xc3, 0x2f, 0x29, 0x84, 0x51, 0x7f, 0xb8, 0x5b, 0xc7, 0x8e, 0x9c,
+	0x42, 0xdc, 0x36, 0xf1, 0x26, 0x71, 0x0a, 0xa2, 0x5c, 0xa8, 0x13, 0xa4, 0x54, 0x22, 0x14, 0x9c,
+	0x43, 0xf9, 0x71, 0x88, 0xc6, 0xbb, 0x13, 0x7b, 0xe9, 0x66, 0x27, 0xdd, 0xd9, 0x34, 0xb1, 0x42,
+	0x84, 0xc4, 0x85, 0x13, 0x02, 0x89, 0x23, 0x08, 0x81, 0xb8, 0x54, 0x08, 0x8e, 0x48, 0x1c, 0xe1,
+	0x96, 0x63, 0x24, 0x2e, 0x9c, 0x2c, 0x48, 0x38, 0xa0, 0xfc, 0x09, 0x3d, 0xa1, 0x99, 0x9d, 0x75,
+	0xd6, 0x4e, 0xd6, 0x3f, 0x90, 0x55, 0x2e, 0xd6, 0xce, 0xfa, 0xbd, 0x79, 0x9f, 0xf7, 0x7d, 0xe3,
+	0x37, 0x4f, 0x86, 0x91, 0x47, 0x5b, 0xd4, 0xad, 0xe5, 0x37, 0x5d, 0xe6, 0x31, 0x7c, 0xc9, 0x60,
+	0x7c, 0x63, 0x9b, 0xf0, 0x8d, 0xbc, 0xfc, 0x78, 0x3c, 0x57, 0xa6, 0x1e, 0x99, 0xd3, 0x2e, 0x56,
+	0x58, 0x85, 0x49, 0x0b, 0x5d, 0x3c, 0xf9, 0xc6, 0xda, 0x88, 0x57, 0xdb, 0xa4, 0x5c, 0x2d, 0x52,
+	0x15, 0xc6, 0x2a, 0x36, 0xd5, 0xc9, 0xa6, 0xa5, 0x13, 0xc7, 0x61, 0x1e, 0xf1, 0x2c, 0xe6, 0x04,
+	0xdf, 0x4e, 0xd9, 0xeb, 0x65, 0xbd, 0x4c, 0x38, 0xd5, 0x65, 0x34, 0x5d, 0x6d, 0xac, 0x6f, 0x92,
+	0x8a, 0xe5, 0x48, 0x4b, 0xdf, 0x30, 0xbb, 0x00, 0xc9, 0x77, 0x84, 0xc5, 0x22, 0x73, 0x3c, 0x97,
+	0x18, 0xde, 0x3d, 0x67, 0x9d, 0x95, 0xe8, 0xa3, 0x2d, 0xca, 0x3d, 0x9c, 0x84, 0x21, 0x62, 0x9a,
+	0x2e, 0xe5, 0x3c, 0x89, 0x26, 0x50, 0x2e, 0x51, 0x0a, 0x96, 0xd9, 0xcf, 0x11, 0x5c, 0x3d, 0xc3,
+	0x8d, 0x6f, 0x32, 0x87, 0xd3, 0x68, 0x3f, 0x5c, 0x82, 0x0b, 0x86, 0xf2, 0x58, 0xb3, 0x9c, 0x75,
+	0x96, 0x3c, 0x37, 0x81, 0x72, 0x23, 0xf3, 0x93, 0xf9, 0x33, 0x65, 0xc8, 0x87, 0x77, 0x2f, 0x0e,
+	0x1f, 0xd4, 0x33, 0xe8, 0xb8, 0x9e, 0x89, 0x95, 0x46, 0x8d, 0xd0, 0xfb, 0x3b, 0xf1, 0x7f, 0xbe,
+	0xcd, 0xa0, 0xec, 0x47, 0x70, 0xad, 0x09, 0x68, 0xd9, 0xe2, 0x1e, 0x73, 0x6b, 0x1d, 0x53, 0xc1,
+	0x8b, 0x00, 0x27, 0xa2, 0x34, 0x78, 0xec, 0xf5, 0x72, 0x5e, 0xc8, 0x97, 0xf7, 0x8b, 0x15, 0x00,
+	0xbd, 0x4d, 0x2a, 0x54, 0x6d, 0x59, 0x0a, 0xb9, 0x65, 0x7f, 0x46, 0x90, 0x3a, 0x3b, 0xbc, 0x92,
+	0xe4, 0x3e, 0x0c, 0x51, 0xc7, 0x73, 0x2d, 0x2a, 0xe2, 0x9f, 0xcf, 0x8d, 0xcc, 0xeb, 0x1d, 0x52,
+	0x5e, 0x64, 0x26, 0x55, 0x9b, 0xbc, 0xe1, 0x78, 0x6e, 0xad, 0x18, 0xdf, 0x17, 0xa9, 0x07, 0xbb,
+	0xe0, 0xa5, 0x33, 0xb0, 0xaf, 0xb7, 0xc7, 0xf6, 0x51, 0x9a, 0xb8, 0x77, 0x5b, 0x54, 0xe3, 0xc5,
+	0x9a, 0x08, 0x1c, 0xa8, 0x76, 0x05, 0x86, 0x0c, 0x66, 0xd2, 0x35, 0xcb, 0x94, 0xaa, 0xc5, 0x4b,
+	0x83, 0x62, 0x79, 0xcf, 0xec, 0x8f, 0x68, 0x9f, 0x21, 0xb8, 0x12, 0xae, 0xf0, 0x03, 0xcb, 0xab,
+	0xde, 0x55, 0x55, 0xf9, 0x3f, 0x8e, 0xd0, 0x6f, 0xad, 0x45, 0x6c, 0xa8, 0xa1, 0x8a, 0xf8, 0x01,
+	0x3c, 0xd7, 0x14, 0x3a, 0xa8, 0x65, 0xbe, 0x8b, 0xd8, 0xa1, 0xe4, 0x54, 0x29, 0x2f, 0x84, 0x11,
+	0xfa, 0x55, 0xd0, 0x3d, 0x95, 0xc2, 0x5d, 0xdb, 0x0e, 0xa2, 0xaf, 0x7a, 0xc4, 0xa3, 0xcf, 0xe8,
+	0x77, 0xf0, 0x1d, 0x82, 0xf1, 0x88, 0xf8, 0x4a, 0xc3, 0x3b, 0x30, 0xb8, 0xc1, 0x4c, 0x6a, 0x07,
+	0xda, 0xa5, 0x22, 0xb4, 0x5b, 0x11, 0x46, 0x4a, 0x29, 0xe5, 0xd1, 0x27, 0x89, 0x1e, 0x28, 0x89,
+	0x4a, 0x64, 0xbb, 0x47, 0x89, 0xc6, 0x01, 0x64, 0x8c, 0x35, 0x93, 0x78, 0x44, 0xc6, 0x1f, 0x2d,
+	0x25, 0xe4, 0x9b, 0x25, 0xe2, 0x91, 0x6c, 0x01, 0xc6, 0x23, 0x36, 0x56, 0xb9, 0x63, 0x88, 0x4b,
+	0x4f, 0x24, 0x3d, 0xe5, 0x73, 0xf6, 0x3d, 0x48, 0x4b, 0xa7, 0xd5, 0x0d, 0xe2, 0x7a, 0xfd, 0xe5,
+	0x59, 0x85, 0x4c, 0xe4, 0xd6, 0x8a, 0x68, 0x36, 0x4c, 0x54, 0x4c, 0x3d, 0xad, 0x67, 0x92, 0xd4,
+	0x31, 0x98, 0x69, 0x39, 0x15, 0xfd, 0x43, 0xce, 0x9c, 0x7c, 0x89, 0x6c, 0xaf, 0x50, 0xce, 0x85,
+	0x96, 0x3e, 0xef, 0x2d, 0x18, 0x53, 0xbf, 0x91, 0xce, 0x6d, 0x22, 0x5b, 0x47, 0x30, 0x26, 0x0c,
+	0x9b, 0x6e, 0x87, 0x1b, 0x2d, 0xd6, 0xc5, 0xb1, 0xc3, 0x7a, 0x66, 0x50, 0x9a, 0x2d, 0x1d, 0xd7,
+	0x33, 0xe7, 0x2c, 0xb3, 0xd1, 0x66, 0x92, 0x30, 0x64, 0xb8, 0x94, 0x78, 0xcc, 0x95, 0xd9, 0x25,
+	0x4a, 0xc1, 0x12, 0xaf, 0x40, 0x42, 0xe0, 0xac, 0x55, 0x09, 0xaf, 0x26, 0xcf, 0x4b, 0xfa, 0xd9,
+	0xa7, 0xf5, 0xcc, 0x74, 0xc5, 0xf2, 0xaa, 0x5b, 0xe5, 0xbc, 0xc1, 0x36, 0x74, 0xdb, 0x72, 0xa8,
+	0xce, 0xb8, 0xc8, 0x9a, 0x39, 0xba, 0x6d, 0x95, 0xb9, 0x5e, 0xae, 0x79, 0x94, 0xe7, 0x97, 0xe9,
+	0x4e, 0x51, 0x3c, 0x94, 0x86, 0xc5, 0x16, 0xcb, 0x84, 0x57, 0xf1, 0x65, 0x18, 0xe4, 0x6c, 0xcb,
+	0x35, 0x68, 0x32, 0x2e, 0xe3, 0xa8, 0x95, 0x00, 0x28, 0x6f, 0x59, 0xb6, 0x49, 0xdd, 0xe4, 0x80,
+	0x0f, 0xa0, 0x96, 0xaa, 0x65, 0x7c, 0x8a, 0xe0, 0x85, 0x90, 0x1c, 0x2a, 0xc3, 0xb7, 0x20, 0xe1,
+	0x67, 0x28, 0xda, 0x13, 0x92, 0xc7, 0x74, 0x2a, 0xb2, 0x45, 0x34, 0xab, 0x13, 0x6a, 0x51, 0xc3,
+	0x86, 0xfa, 0x0e, 0xa7, 0x54, 0x95, 0x64, 0x85, 0x8b, 0xc3, 0xc7, 0xf5, 0x8c, 0x5c, 0xfb, 0x15,
+	0x51, 0x24, 0xaf, 0x34, 0xee, 0x71, 0xa1, 0x63, 0xb1, 0x26, 0xd2, 0x0a, 0xea, 0x73, 0x4d, 0xf1,
+	0x48, 0xb1, 0xfc, 0x33, 0x24, 0x37, 0x17, 0x36, 0xd9, 0xd7, 0xe1, 0xea, 0x19, 0x8e, 0x2a, 0x93,
+	0xc9, 0xd6, 0x5a, 0xc1, 0x49, 0xad, 0x1a, 0x55, 0x7e, 0x37, 0xa4, 0x01, 0x0f, 0x62, 0x36, 0xb7,
+	0x13, 0xf4, 0xdf, 0xda, 0xc9, 0x13, 0x04, 0x38, 0xbc, 0xb5, 0xa2, 0x7a, 0x13, 0xa0, 0xa1, 0x6f,
+	0xd0, 0x47, 0xba, 0x16, 0xd8, 0x6f, 0x29, 0x89, 0x40, 0xdc, 0x7e, 0x75, 0x95, 0xc7, 0x70, 0xc9,
+	0x27, 0x25, 0xb6, 0x5d, 0x26, 0xc6, 0x43, 0xfe, 0x8c, 0x3a, 0xee, 0xf7, 0x08, 0x2e, 0xb7, 0x06,
+	0x56, 0x32, 0x2d, 0x42, 0xc2, 0x08, 0x5e, 0x2a, 0x95, 0x32, 0x51, 0x2a, 0x29, 0xbb, 0x86, 0x3a,
+	0x81, 0x5f, 0x7f, 0xd4, 0x99, 0x3f, 0x1e, 0x85, 0x01, 0x49, 0x89, 0xbf, 0x42, 0x30, 0x1a, 0xbe,
+	0x17, 0x71, 0xd4, 0x20, 0x14, 0x35, 0x95, 0x6a, 0xb3, 0xdd, 0x3b, 0xf8, 0x24, 0xd9, 0xdc, 0x27,
+	0xbf, 0xff, 0xfd, 0xe5, 0xb9, 0x2c, 0x9e, 0xd0, 0x85, 0x43, 0x63, 0x16, 0x0e, 0xee, 0x5f, 0x7d,
+	0x57, 0x55, 0x64, 0x0f, 0xff, 0x88, 0xe0, 0xf9, 0x96, 0x11, 0x0e, 0xcf, 0x77, 0x13, 0xaf, 0x79,
+	0xdc, 0xd4, 0x0a, 0x3d, 0xf9, 0x28, 0xcc, 0x59, 0x89, 0x79, 0x13, 0xe7, 0x3a, 0x61, 0xea, 0x55,
+	0x85, 0xf6, 0x43, 0x08, 0x57, 0x0d, 0x2b, 0xdd, 0xe1, 0x36, 0xcf, 0x79, 0x5a, 0xa1, 0x27, 0x1f,
+	0x85, 0x9b, 0x97, 0xb8, 0x39, 0xfc, 0x52, 0x2b, 0xae, 0x49, 0xf5, 0x5d, 0xd5, 0x35, 0xf6, 0x1a,
+	0xf4, 0x1c, 0xff, 0x84, 0x60, 0xac, 0x75, 0x2c, 0xc0, 0x6d, 0x23, 0x47, 0x0c, 0x31, 0xda, 0x42,
+	0x6f, 0x4e, 0x9d, 0x78, 0x4f, 0xc9, 0xcb, 0x25, 0xda, 0x2f, 0x08, 0xc6, 0x5a, 0xaf, 0xf2, 0xf6,
+	0xbc, 0x11, 0x13, 0x85, 0xb6, 0xd0, 0x9b, 0x93, 0xe2, 0x7d, 0x55, 0xf2, 0x16, 0xf0, 0x5c, 0x47,
+	0x5e, 0x97, 0x6c, 0xeb, 0xbb, 0x27, 0x93, 0xc0, 0x1e, 0xfe, 0x15, 0x01, 0x3e, 0x7d, 0xeb, 0xe3,
+	0xdb, 0xed, 0x38, 0x22, 0x07, 0x10, 0xed, 0xe5, 0x5e, 0xdd, 0x54, 0x02, 0xaf, 0xc9, 0x04, 0x6e,
+	0xe3, 0x42, 0x67, 0xc1, 0xc5, 0x26, 0xcd, 0x29, 0x7c, 0x0c, 0x71, 0x79, 0x9c, 0xa7, 0xda, 0x1f,
+	0xcd, 0x93, 0x33, 0x9c, 0xeb, 0x6c, 0xa8, 0xb8, 0xae, 0x4b, 0xae, 0x34, 0x4e, 0xb5, 0x3b, 0xb8,
+	0x78, 0x07, 0x06, 0x84, 0x17, 0xc7, 0x1d, 0x37, 0x0e, 0x5a, 0xbd, 0x76, 0xa3, 0x0b, 0x4b, 0xc5,
+	0xa0, 0x49, 0x86, 0x8b, 0x18, 0x9f, 0x66, 0xc0, 0xdf, 0xc8, 0x16, 0x79, 0x72, 0x1b, 0x77, 0x6a,
+	0x91, 0xa7, 0x2e, 0x7c, 0x6d, 0xb6, 0x7b, 0x07, 0xc5, 0x73, 0x4b, 0xf2, 0xbc, 0x88, 0x27, 0x4f,
+	0xf3, 0xcc, 0x88, 0xb1, 0x41, 0x09, 0x23, 0x1e, 0xf7, 0xf0, 0xd7, 0x08, 0x12, 0x8d, 0xeb, 0x06,
+	0x4f, 0xb7, 0x0d, 0xd6, 0x72, 0x1d, 0x6a, 0x33, 0x5d, 0x5a, 0x2b, 0xae, 0x79, 0xc9, 0x35, 0x8d,
+	0x6f, 0x76, 0x3c, 0x43, 0x8d, 0x2b, 0xab, 0x78, 0x7f, 0xff, 0xaf, 0x74, 0xec, 0xc9, 0x61, 0x3a,
+	0xb6, 0x7f, 0x98, 0x46, 0x07, 0x87, 0x69, 0xf4, 0xe7, 0x61, 0x1a, 0x7d, 0x71, 0x94, 0x8e, 0x1d,
+	0x1c, 0xa5, 0x63, 0x7f, 0x1c, 0xa5, 0x63, 0xef, 0xcf, 0xb4, 0x8e, 0x89, 0xf6, 0x7a, 0x79, 0x86,
+	0x9b, 0x0f, 0xf5, 0x1d, 0x3f, 0x8c, 0xe5, 0x78, 0xd4, 0x75, 0x88, 0xad, 0xcb, 0x3f, 0x5c, 0xca,
+	0x83, 0xf2, 0xaf, 0x92, 0xc2, 0xbf, 0x03, 0x00, 0xe8, 0x48, 0x85, 0xa4, 0xba, 0x11, 0x00, 0x00,
 }
 
 func (this *QueryContractInfoResponse) Equal(that interface{}) bool {
@@ -992,6 +1163,10 @@ type QueryClient interface {
 	Code(ctx context.Context, in *QueryCodeRequest, opts ...grpc.CallOption) (*QueryCodeResponse, error)
 	// Codes gets the metadata for all stored wasm codes
 	Codes(ctx context.Context, in *QueryCodesRequest, opts ...grpc.CallOption) (*QueryCodesResponse, error)
+	// CodeIDByHash gets the code id of the wasm code with the given code hash
+	CodeIDByHash(ctx context.Context, in *QueryCodeIDByHashRequest, opts ...grpc.CallOption) (*QueryCodeIDByHashResponse, error)
+	// Callbacks gets the callbacks registered for a smart contract
+	Callbacks(ctx context.Context, in *QueryCallbacksRequest, opts ...grpc.CallOption) (*QueryCallbacksResponse, error)
 }
 
 type queryClient struct {
@@ -1074,6 +1249,24 @@ func (c *queryClient) Codes(ctx context.Context, in *QueryCodesRequest, opts ...
 	return out, nil
 }
 
+func (c *queryClient) CodeIDByHash(ctx context.Context, in *QueryCodeIDByHashRequest, opts ...grpc.CallOption) (*QueryCodeIDByHashResponse, error) {
+	out := new(QueryCodeIDByHashResponse)
+	err := c.cc.Invoke(ctx, "/cosmwasm.wasm.v1beta1.Query/CodeIDByHash", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Callbacks(ctx context.Context, in *QueryCallbacksRequest, opts ...grpc.CallOption) (*QueryCallbacksResponse, error) {
+	out := new(QueryCallbacksResponse)
+	err := c.cc.Invoke(ctx, "/cosmwasm.wasm.v1beta1.Query/Callbacks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// ContractInfo gets the contract meta data
@@ -1092,6 +1285,10 @@ type QueryServer interface {
 	Code(context.Context, *QueryCodeRequest) (*QueryCodeResponse, error)
 	// Codes gets the metadata for all stored wasm codes
 	Codes(context.Context, *QueryCodesRequest) (*QueryCodesResponse, error)
+	// CodeIDByHash gets the code id of the wasm code with the given code hash
+	CodeIDByHash(context.Context, *QueryCodeIDByHashRequest) (*QueryCodeIDByHashResponse, error)
+	// Callbacks gets the callbacks registered for a smart contract
+	Callbacks(context.Context, *QueryCallbacksRequest) (*QueryCallbacksResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1122,6 +1319,12 @@ func (*UnimplementedQueryServer) Code(ctx context.Context, req *QueryCodeRequest
 func (*UnimplementedQueryServer) Codes(ctx context.Context, req *QueryCodesRequest) (*QueryCodesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Codes not implemented")
 }
+func (*UnimplementedQueryServer) CodeIDByHash(ctx context.Context, req *QueryCodeIDByHashRequest) (*QueryCodeIDByHashResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CodeIDByHash not implemented")
+}
+func (*UnimplementedQueryServer) Callbacks(ctx context.Context, req *QueryCallbacksRequest) (*QueryCallbacksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Callbacks not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1271,6 +1474,42 @@ func _Query_Codes_Handler(srv interface{}, ctx context.Context, dec func(interfa
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_CodeIDByHash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCodeIDByHashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CodeIDByHash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmwasm.wasm.v1beta1.Query/CodeIDByHash",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CodeIDByHash(ctx, req.(*QueryCodeIDByHashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Callbacks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCallbacksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Callbacks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmwasm.wasm.v1beta1.Query/Callbacks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Callbacks(ctx, req.(*QueryCallbacksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmwasm.wasm.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1307,6 +1546,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Codes",
 			Handler:    _Query_Codes_Handler,
 		},
+		{
+			MethodName: "CodeIDByHash",
+			Handler:    _Query_CodeIDByHash_Handler,
+		},
+		{
+			MethodName: "Callbacks",
+			Handler:    _Query_Callbacks_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "query.proto",
@@ -1957,6 +2204,64 @@ func (m *QueryCodeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryCodeIDByHashRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCodeIDByHashRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCodeIDByHashRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CodeHash) > 0 {
+		i -= len(m.CodeHash)
+		copy(dAtA[i:], m.CodeHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CodeHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCodeIDByHashResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCodeIDByHashResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCodeIDByHashResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CodeID != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CodeID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryCodesRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2041,53 +2346,144 @@ func (m *QueryCodesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryCallbacksRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryContractInfoRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+
+func (m *QueryCallbacksRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryContractInfoResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryCallbacksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.ContractInfo != nil {
-		l = m.ContractInfo.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryContractHistoryRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryCallbacksResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
+	return dAtA[:n], nil
+}
+
+func (m *QueryCallbacksResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCallbacksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Callbacks) > 0 {
+		for iNdEx := len(m.Callbacks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Callbacks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryContractInfoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryContractInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ContractInfo != nil {
+		l = m.ContractInfo.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryContractHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
 	l = len(m.Address)
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
@@ -2323,6 +2719,31 @@ func (m *QueryCodeResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryCodeIDByHashRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CodeHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCodeIDByHashResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CodeID != 0 {
+		n += 1 + sovQuery(uint64(m.CodeID))
+	}
+	return n
+}
+
 func (m *QueryCodesRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2355,6 +2776,42 @@ func (m *QueryCodesResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryCallbacksRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCallbacksResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Callbacks) > 0 {
+		for _, e := range m.Callbacks {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -4168,6 +4625,157 @@ func (m *QueryCodeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryCodeIDByHashRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCodeIDByHashRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCodeIDByHashRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CodeHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCodeIDByHashResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCodeIDByHashResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCodeIDByHashResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeID", wireType)
+			}
+			m.CodeID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CodeID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *QueryCodesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -4374,6 +4982,244 @@ func (m *QueryCodesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryCallbacksRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCallbacksRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCallbacksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCallbacksResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCallbacksResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCallbacksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Callbacks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Callbacks = append(m.Callbacks, Callback{})
+			if err := m.Callbacks[len(m.Callbacks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
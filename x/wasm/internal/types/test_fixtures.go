@@ -310,3 +310,17 @@ func UpdateContractStatusProposalFixture(mutators ...func(p *UpdateContractStatu
 	}
 	return p
 }
+
+func RemoveCallbacksProposalFixture(mutators ...func(p *RemoveCallbacksProposal)) *RemoveCallbacksProposal {
+	const contractAddr = "link1hcttwju93d5m39467gjcq63p5kc4fdcn30dgd8"
+
+	p := &RemoveCallbacksProposal{
+		Title:       "Foo",
+		Description: "Bar",
+		Contract:    contractAddr,
+	}
+	for _, m := range mutators {
+		m(p)
+	}
+	return p
+}
@@ -104,6 +104,57 @@ func (msg MsgInstantiateContract) GetSigners() []sdk.AccAddress {
 
 }
 
+func (msg MsgInstantiateContract2) Route() string {
+	return RouterKey
+}
+
+func (msg MsgInstantiateContract2) Type() string {
+	return "instantiate2"
+}
+
+func (msg MsgInstantiateContract2) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrap(err, "sender")
+	}
+
+	if msg.CodeID == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "code id is required")
+	}
+
+	if err := validateLabel(msg.Label); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "label is required")
+	}
+
+	if !msg.Funds.IsValid() {
+		return sdkerrors.ErrInvalidCoins
+	}
+
+	if len(msg.Admin) != 0 {
+		if _, err := sdk.AccAddressFromBech32(msg.Admin); err != nil {
+			return sdkerrors.Wrap(err, "admin")
+		}
+	}
+	if !json.Valid(msg.InitMsg) {
+		return sdkerrors.Wrap(ErrInvalid, "init msg json")
+	}
+	if err := validateSalt(msg.Salt); err != nil {
+		return sdkerrors.Wrap(err, "salt")
+	}
+	return nil
+}
+
+func (msg MsgInstantiateContract2) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgInstantiateContract2) GetSigners() []sdk.AccAddress {
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil { // should never happen as valid basic rejects invalid addresses
+		panic(err.Error())
+	}
+	return []sdk.AccAddress{senderAddr}
+}
+
 func (msg MsgStoreCodeAndInstantiateContract) Route() string {
 	return RouterKey
 }
@@ -359,6 +410,168 @@ func (msg MsgUpdateContractStatus) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{senderAddr}
 }
 
+func (msg MsgRegisterCallback) Route() string {
+	return RouterKey
+}
+
+func (msg MsgRegisterCallback) Type() string {
+	return "register-callback"
+}
+
+func (msg MsgRegisterCallback) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrap(err, "sender")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Contract); err != nil {
+		return sdkerrors.Wrap(err, "contract")
+	}
+	if err := ValidateCallbackSchedule(msg.Trigger, msg.Height); err != nil {
+		return err
+	}
+	if !json.Valid(msg.Msg) {
+		return sdkerrors.Wrap(ErrInvalid, "msg json")
+	}
+	if msg.GasLimit == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "gas limit")
+	}
+	if !msg.Deposit.IsValid() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "deposit")
+	}
+	return nil
+}
+
+func (msg MsgRegisterCallback) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgRegisterCallback) GetSigners() []sdk.AccAddress {
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil { // should never happen as valid basic rejects invalid addresses
+		panic(err.Error())
+	}
+	return []sdk.AccAddress{senderAddr}
+}
+
+func (msg MsgCancelCallback) Route() string {
+	return RouterKey
+}
+
+func (msg MsgCancelCallback) Type() string {
+	return "cancel-callback"
+}
+
+func (msg MsgCancelCallback) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrap(err, "sender")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Contract); err != nil {
+		return sdkerrors.Wrap(err, "contract")
+	}
+	return ValidateCallbackSchedule(msg.Trigger, msg.Height)
+}
+
+func (msg MsgCancelCallback) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgCancelCallback) GetSigners() []sdk.AccAddress {
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil { // should never happen as valid basic rejects invalid addresses
+		panic(err.Error())
+	}
+	return []sdk.AccAddress{senderAddr}
+}
+
+func (msg MsgPinCodes) Route() string {
+	return RouterKey
+}
+
+func (msg MsgPinCodes) Type() string {
+	return "pin-codes"
+}
+
+func (msg MsgPinCodes) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.Wrap(err, "authority")
+	}
+	if len(msg.CodeIDs) == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "code ids")
+	}
+	return nil
+}
+
+func (msg MsgPinCodes) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgPinCodes) GetSigners() []sdk.AccAddress {
+	authorityAddr, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil { // should never happen as valid basic rejects invalid addresses
+		panic(err.Error())
+	}
+	return []sdk.AccAddress{authorityAddr}
+}
+
+func (msg MsgUnpinCodes) Route() string {
+	return RouterKey
+}
+
+func (msg MsgUnpinCodes) Type() string {
+	return "unpin-codes"
+}
+
+func (msg MsgUnpinCodes) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.Wrap(err, "authority")
+	}
+	if len(msg.CodeIDs) == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "code ids")
+	}
+	return nil
+}
+
+func (msg MsgUnpinCodes) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgUnpinCodes) GetSigners() []sdk.AccAddress {
+	authorityAddr, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil { // should never happen as valid basic rejects invalid addresses
+		panic(err.Error())
+	}
+	return []sdk.AccAddress{authorityAddr}
+}
+
+func (msg MsgRemoveCallbacks) Route() string {
+	return RouterKey
+}
+
+func (msg MsgRemoveCallbacks) Type() string {
+	return "remove-callbacks"
+}
+
+func (msg MsgRemoveCallbacks) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.Wrap(err, "authority")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Contract); err != nil {
+		return sdkerrors.Wrap(err, "contract")
+	}
+	return nil
+}
+
+func (msg MsgRemoveCallbacks) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgRemoveCallbacks) GetSigners() []sdk.AccAddress {
+	authorityAddr, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil { // should never happen as valid basic rejects invalid addresses
+		panic(err.Error())
+	}
+	return []sdk.AccAddress{authorityAddr}
+}
+
 func (msg MsgIBCSend) Route() string {
 	return RouterKey
 }
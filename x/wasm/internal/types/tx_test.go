@@ -239,6 +239,136 @@ func TestInstantiateContractValidation(t *testing.T) {
 	}
 }
 
+func TestInstantiateContract2Validation(t *testing.T) {
+	bad, err := sdk.AccAddressFromHex("012345")
+	require.NoError(t, err)
+	badAddress := bad.String()
+	// proper address size
+	goodAddress := sdk.AccAddress(make([]byte, 20)).String()
+
+	cases := map[string]struct {
+		msg   MsgInstantiateContract2
+		valid bool
+	}{
+		"empty": {
+			msg:   MsgInstantiateContract2{},
+			valid: false,
+		},
+		"correct minimal": {
+			msg: MsgInstantiateContract2{
+				Sender:  goodAddress,
+				CodeID:  firstCodeID,
+				Label:   "foo",
+				InitMsg: []byte("{}"),
+				Salt:    []byte("salt"),
+			},
+			valid: true,
+		},
+		"correct maximal": {
+			msg: MsgInstantiateContract2{
+				Sender:  goodAddress,
+				Admin:   goodAddress,
+				CodeID:  firstCodeID,
+				Label:   "foo",
+				InitMsg: []byte(`{"some": "data"}`),
+				Funds:   sdk.Coins{sdk.Coin{Denom: "foobar", Amount: sdk.NewInt(200)}},
+				Salt:    bytes.Repeat([]byte{1}, MaxSaltSize),
+			},
+			valid: true,
+		},
+		"missing salt": {
+			msg: MsgInstantiateContract2{
+				Sender:  goodAddress,
+				CodeID:  firstCodeID,
+				Label:   "foo",
+				InitMsg: []byte("{}"),
+			},
+			valid: false,
+		},
+		"salt too long": {
+			msg: MsgInstantiateContract2{
+				Sender:  goodAddress,
+				CodeID:  firstCodeID,
+				Label:   "foo",
+				InitMsg: []byte("{}"),
+				Salt:    bytes.Repeat([]byte{1}, MaxSaltSize+1),
+			},
+			valid: false,
+		},
+		"missing code": {
+			msg: MsgInstantiateContract2{
+				Sender:  goodAddress,
+				Label:   "foo",
+				InitMsg: []byte("{}"),
+				Salt:    []byte("salt"),
+			},
+			valid: false,
+		},
+		"missing label": {
+			msg: MsgInstantiateContract2{
+				Sender:  goodAddress,
+				CodeID:  firstCodeID,
+				InitMsg: []byte("{}"),
+				Salt:    []byte("salt"),
+			},
+			valid: false,
+		},
+		"bad sender minimal": {
+			msg: MsgInstantiateContract2{
+				Sender:  badAddress,
+				CodeID:  firstCodeID,
+				Label:   "foo",
+				InitMsg: []byte("{}"),
+				Salt:    []byte("salt"),
+			},
+			valid: false,
+		},
+		"bad admin": {
+			msg: MsgInstantiateContract2{
+				Sender:  goodAddress,
+				Admin:   badAddress,
+				CodeID:  firstCodeID,
+				Label:   "foo",
+				InitMsg: []byte("{}"),
+				Salt:    []byte("salt"),
+			},
+			valid: false,
+		},
+		"negative funds": {
+			msg: MsgInstantiateContract2{
+				Sender:  goodAddress,
+				CodeID:  firstCodeID,
+				Label:   "foo",
+				InitMsg: []byte(`{"some": "data"}`),
+				Funds:   sdk.Coins{sdk.Coin{Denom: "foobar", Amount: sdk.NewInt(-200)}},
+				Salt:    []byte("salt"),
+			},
+			valid: false,
+		},
+		"non json init msg": {
+			msg: MsgInstantiateContract2{
+				Sender:  goodAddress,
+				CodeID:  firstCodeID,
+				Label:   "foo",
+				InitMsg: []byte("invalid-json"),
+				Salt:    []byte("salt"),
+			},
+			valid: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
 func TestStoreCodeAndInstantiateContractValidation(t *testing.T) {
 	bad, err := sdk.AccAddressFromHex("012345")
 	require.NoError(t, err)
@@ -770,3 +900,257 @@ func TestMsgUpdateContractStatus(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgRegisterCallback(t *testing.T) {
+	bad, err := sdk.AccAddressFromHex("012345")
+	require.NoError(t, err)
+	badAddress := bad.String()
+	// proper address size
+	goodAddress := sdk.AccAddress(make([]byte, 20)).String()
+	anotherGoodAddress := sdk.AccAddress(bytes.Repeat([]byte{0x2}, 20)).String()
+
+	specs := map[string]struct {
+		src    MsgRegisterCallback
+		expErr bool
+	}{
+		"all good": {
+			src: MsgRegisterCallback{
+				Sender:   goodAddress,
+				Contract: anotherGoodAddress,
+				Trigger:  CallbackTriggerEndBlock,
+				Msg:      []byte(`{"foo":"bar"}`),
+				GasLimit: 100000,
+				Deposit:  sdk.NewCoins(sdk.NewInt64Coin("denom", 100)),
+			},
+		},
+		"all good at height": {
+			src: MsgRegisterCallback{
+				Sender:   goodAddress,
+				Contract: anotherGoodAddress,
+				Trigger:  CallbackTriggerAtHeight,
+				Height:   100,
+				Msg:      []byte(`{"foo":"bar"}`),
+				GasLimit: 100000,
+			},
+		},
+		"bad sender": {
+			src: MsgRegisterCallback{
+				Sender:   badAddress,
+				Contract: anotherGoodAddress,
+				Trigger:  CallbackTriggerEndBlock,
+				Msg:      []byte(`{"foo":"bar"}`),
+				GasLimit: 100000,
+			},
+			expErr: true,
+		},
+		"bad contract addr": {
+			src: MsgRegisterCallback{
+				Sender:   goodAddress,
+				Contract: badAddress,
+				Trigger:  CallbackTriggerEndBlock,
+				Msg:      []byte(`{"foo":"bar"}`),
+				GasLimit: 100000,
+			},
+			expErr: true,
+		},
+		"trigger missing": {
+			src: MsgRegisterCallback{
+				Sender:   goodAddress,
+				Contract: anotherGoodAddress,
+				Msg:      []byte(`{"foo":"bar"}`),
+				GasLimit: 100000,
+			},
+			expErr: true,
+		},
+		"height of a block callback": {
+			src: MsgRegisterCallback{
+				Sender:   goodAddress,
+				Contract: anotherGoodAddress,
+				Trigger:  CallbackTriggerBeginBlock,
+				Height:   100,
+				Msg:      []byte(`{"foo":"bar"}`),
+				GasLimit: 100000,
+			},
+			expErr: true,
+		},
+		"height missing": {
+			src: MsgRegisterCallback{
+				Sender:   goodAddress,
+				Contract: anotherGoodAddress,
+				Trigger:  CallbackTriggerAtHeight,
+				Msg:      []byte(`{"foo":"bar"}`),
+				GasLimit: 100000,
+			},
+			expErr: true,
+		},
+		"non json msg": {
+			src: MsgRegisterCallback{
+				Sender:   goodAddress,
+				Contract: anotherGoodAddress,
+				Trigger:  CallbackTriggerEndBlock,
+				Msg:      []byte("invalid json"),
+				GasLimit: 100000,
+			},
+			expErr: true,
+		},
+		"gas limit missing": {
+			src: MsgRegisterCallback{
+				Sender:   goodAddress,
+				Contract: anotherGoodAddress,
+				Trigger:  CallbackTriggerEndBlock,
+				Msg:      []byte(`{"foo":"bar"}`),
+			},
+			expErr: true,
+		},
+		"negative deposit": {
+			src: MsgRegisterCallback{
+				Sender:   goodAddress,
+				Contract: anotherGoodAddress,
+				Trigger:  CallbackTriggerEndBlock,
+				Msg:      []byte(`{"foo":"bar"}`),
+				GasLimit: 100000,
+				Deposit:  sdk.Coins{sdk.Coin{Denom: "denom", Amount: sdk.NewInt(-1)}},
+			},
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgCancelCallback(t *testing.T) {
+	bad, err := sdk.AccAddressFromHex("012345")
+	require.NoError(t, err)
+	badAddress := bad.String()
+	// proper address size
+	goodAddress := sdk.AccAddress(make([]byte, 20)).String()
+	anotherGoodAddress := sdk.AccAddress(bytes.Repeat([]byte{0x2}, 20)).String()
+
+	specs := map[string]struct {
+		src    MsgCancelCallback
+		expErr bool
+	}{
+		"all good": {
+			src: MsgCancelCallback{
+				Sender:   goodAddress,
+				Contract: anotherGoodAddress,
+				Trigger:  CallbackTriggerAtHeight,
+				Height:   100,
+			},
+		},
+		"bad sender": {
+			src: MsgCancelCallback{
+				Sender:   badAddress,
+				Contract: anotherGoodAddress,
+				Trigger:  CallbackTriggerEndBlock,
+			},
+			expErr: true,
+		},
+		"bad contract addr": {
+			src: MsgCancelCallback{
+				Sender:   goodAddress,
+				Contract: badAddress,
+				Trigger:  CallbackTriggerEndBlock,
+			},
+			expErr: true,
+		},
+		"trigger missing": {
+			src: MsgCancelCallback{
+				Sender:   goodAddress,
+				Contract: anotherGoodAddress,
+			},
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgPinCodes(t *testing.T) {
+	bad, err := sdk.AccAddressFromHex("012345")
+	require.NoError(t, err)
+	badAddress := bad.String()
+	// proper address size
+	goodAddress := sdk.AccAddress(make([]byte, 20)).String()
+
+	specs := map[string]struct {
+		src    MsgPinCodes
+		expErr bool
+	}{
+		"all good": {
+			src: MsgPinCodes{Authority: goodAddress, CodeIDs: []uint64{1, 2}},
+		},
+		"bad authority": {
+			src:    MsgPinCodes{Authority: badAddress, CodeIDs: []uint64{1}},
+			expErr: true,
+		},
+		"code ids missing": {
+			src:    MsgPinCodes{Authority: goodAddress},
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			unpin := MsgUnpinCodes{Authority: spec.src.Authority, CodeIDs: spec.src.CodeIDs}
+			require.NoError(t, unpin.ValidateBasic())
+		})
+	}
+}
+
+func TestMsgRemoveCallbacks(t *testing.T) {
+	bad, err := sdk.AccAddressFromHex("012345")
+	require.NoError(t, err)
+	badAddress := bad.String()
+	// proper address size
+	goodAddress := sdk.AccAddress(make([]byte, 20)).String()
+	anotherGoodAddress := sdk.AccAddress(bytes.Repeat([]byte{0x2}, 20)).String()
+
+	specs := map[string]struct {
+		src    MsgRemoveCallbacks
+		expErr bool
+	}{
+		"all good": {
+			src: MsgRemoveCallbacks{Authority: goodAddress, Contract: anotherGoodAddress},
+		},
+		"bad authority": {
+			src:    MsgRemoveCallbacks{Authority: badAddress, Contract: anotherGoodAddress},
+			expErr: true,
+		},
+		"bad contract addr": {
+			src:    MsgRemoveCallbacks{Authority: goodAddress, Contract: badAddress},
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -54,6 +54,86 @@ func (c *ContractStatus) UnmarshalJSONPB(_ *jsonpb.Unmarshaler, data []byte) err
 	return json.Unmarshal(data, c)
 }
 
+var AllCallbackTriggers = []CallbackTrigger{
+	CallbackTriggerBeginBlock,
+	CallbackTriggerEndBlock,
+	CallbackTriggerAtHeight,
+}
+
+func (t CallbackTrigger) String() string {
+	switch t {
+	case CallbackTriggerBeginBlock:
+		return "BeginBlock"
+	case CallbackTriggerEndBlock:
+		return "EndBlock"
+	case CallbackTriggerAtHeight:
+		return "AtHeight"
+	}
+	return "Unspecified"
+}
+
+func (t *CallbackTrigger) UnmarshalText(text []byte) error {
+	for _, v := range AllCallbackTriggers {
+		if v.String() == string(text) {
+			*t = v
+			return nil
+		}
+	}
+	*t = CallbackTriggerUnspecified
+	return nil
+}
+
+func (t CallbackTrigger) MarshalText() ([]byte, error) {
+	return []byte(t.String()), nil
+}
+
+func (t *CallbackTrigger) MarshalJSONPB(_ *jsonpb.Marshaler) ([]byte, error) {
+	return json.Marshal(t)
+}
+
+func (t *CallbackTrigger) UnmarshalJSONPB(_ *jsonpb.Unmarshaler, data []byte) error {
+	return json.Unmarshal(data, t)
+}
+
+// ValidateCallbackSchedule validates the trigger and the height of a callback. Only at-height callbacks have a height.
+func ValidateCallbackSchedule(trigger CallbackTrigger, height uint64) error {
+	switch trigger {
+	case CallbackTriggerBeginBlock, CallbackTriggerEndBlock:
+		if height != 0 {
+			return sdkerrors.Wrap(ErrInvalid, "height of a block callback")
+		}
+	case CallbackTriggerAtHeight:
+		if height == 0 {
+			return sdkerrors.Wrap(ErrEmpty, "height")
+		}
+	default:
+		return sdkerrors.Wrap(ErrInvalid, "trigger")
+	}
+	return nil
+}
+
+func (c Callback) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(c.Contract); err != nil {
+		return sdkerrors.Wrap(err, "contract")
+	}
+	if err := ValidateCallbackSchedule(c.Trigger, c.Height); err != nil {
+		return err
+	}
+	if !json.Valid(c.Msg) {
+		return sdkerrors.Wrap(ErrInvalid, "msg json")
+	}
+	if c.GasLimit == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "gas limit")
+	}
+	if _, err := sdk.AccAddressFromBech32(c.Depositor); err != nil {
+		return sdkerrors.Wrap(err, "depositor")
+	}
+	if !c.Deposit.IsValid() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "deposit")
+	}
+	return nil
+}
+
 func (m Model) ValidateBasic() error {
 	if len(m.Key) == 0 {
 		return sdkerrors.Wrap(ErrEmpty, "key")
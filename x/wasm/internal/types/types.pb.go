@@ -9,6 +9,8 @@ import (
 	fmt "fmt"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_line_lfb_sdk_types "github.com/line/lfb-sdk/types"
+	types "github.com/line/lfb-sdk/types"
 	github_com_line_ostracon_libs_bytes "github.com/line/ostracon/libs/bytes"
 	io "io"
 	math "math"
@@ -122,6 +124,38 @@ func (ContractCodeHistoryOperationType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_d938547f84707355, []int{2}
 }
 
+// CallbackTrigger types
+type CallbackTrigger int32
+
+const (
+	// CallbackTrigger unspecified
+	CallbackTriggerUnspecified CallbackTrigger = 0
+	// CallbackTrigger run at every BeginBlock
+	CallbackTriggerBeginBlock CallbackTrigger = 1
+	// CallbackTrigger run at every EndBlock
+	CallbackTriggerEndBlock CallbackTrigger = 2
+	// CallbackTrigger run once at the EndBlock of a block height
+	CallbackTriggerAtHeight CallbackTrigger = 3
+)
+
+var CallbackTrigger_name = map[int32]string{
+	0: "CALLBACK_TRIGGER_UNSPECIFIED",
+	1: "CALLBACK_TRIGGER_BEGIN_BLOCK",
+	2: "CALLBACK_TRIGGER_END_BLOCK",
+	3: "CALLBACK_TRIGGER_AT_HEIGHT",
+}
+
+var CallbackTrigger_value = map[string]int32{
+	"CALLBACK_TRIGGER_UNSPECIFIED": 0,
+	"CALLBACK_TRIGGER_BEGIN_BLOCK": 1,
+	"CALLBACK_TRIGGER_END_BLOCK":   2,
+	"CALLBACK_TRIGGER_AT_HEIGHT":   3,
+}
+
+func (CallbackTrigger) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_d938547f84707355, []int{3}
+}
+
 // AccessTypeParam
 type AccessTypeParam struct {
 	Value AccessType `protobuf:"varint,1,opt,name=value,proto3,enum=cosmwasm.wasm.v1beta1.AccessType" json:"value,omitempty" yaml:"value"`
@@ -208,6 +242,17 @@ type Params struct {
 	GasMultiplier                uint64       `protobuf:"varint,5,opt,name=gas_multiplier,json=gasMultiplier,proto3" json:"gas_multiplier,omitempty" yaml:"max_gas"`
 	InstanceCost                 uint64       `protobuf:"varint,6,opt,name=instance_cost,json=instanceCost,proto3" json:"instance_cost,omitempty" yaml:"instance_cost"`
 	CompileCost                  uint64       `protobuf:"varint,7,opt,name=compile_cost,json=compileCost,proto3" json:"compile_cost,omitempty" yaml:"compile_cost"`
+	// StorageRentPeriod is the number of blocks between storage rent charges, 0 disables the storage rent
+	StorageRentPeriod uint64 `protobuf:"varint,8,opt,name=storage_rent_period,json=storageRentPeriod,proto3" json:"storage_rent_period,omitempty" yaml:"storage_rent_period"`
+	// StorageRentPrice is the rent charged per byte of contract state every storage rent period
+	StorageRentPrice types.DecCoin `protobuf:"bytes,9,opt,name=storage_rent_price,json=storageRentPrice,proto3" json:"storage_rent_price" yaml:"storage_rent_price"`
+	// MaxCallbackGasLimit is the max gas a single run of a callback can consume, 0 disables the callbacks
+	MaxCallbackGasLimit uint64 `protobuf:"varint,10,opt,name=max_callback_gas_limit,json=maxCallbackGasLimit,proto3" json:"max_callback_gas_limit,omitempty" yaml:"max_callback_gas_limit"`
+	// CallbackGasPrice is the price of the gas consumed by the callbacks, paid from their deposits
+	CallbackGasPrice types.DecCoin `protobuf:"bytes,11,opt,name=callback_gas_price,json=callbackGasPrice,proto3" json:"callback_gas_price" yaml:"callback_gas_price"`
+	// MaxBlockCallbackGas is the max gas the callbacks run at BeginBlock and the ones run at EndBlock can each consume
+	// in total per block
+	MaxBlockCallbackGas uint64 `protobuf:"varint,12,opt,name=max_block_callback_gas,json=maxBlockCallbackGas,proto3" json:"max_block_callback_gas,omitempty" yaml:"max_block_callback_gas"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -465,10 +510,62 @@ func (m *Model) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Model proto.InternalMessageInfo
 
+// Callback is a sudo call of a smart contract scheduled by the module
+type Callback struct {
+	// Contract is the address of the smart contract
+	Contract string `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	// Trigger defines when the callback is run
+	Trigger CallbackTrigger `protobuf:"varint,2,opt,name=trigger,proto3,enum=cosmwasm.wasm.v1beta1.CallbackTrigger" json:"trigger,omitempty"`
+	// Height is the block height an at-height callback is run at, 0 otherwise
+	Height uint64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	// Msg json encoded message to be passed to the sudo entry point of the contract
+	Msg encoding_json.RawMessage `protobuf:"bytes,4,opt,name=msg,proto3,casttype=encoding/json.RawMessage" json:"msg,omitempty"`
+	// GasLimit is the max gas a single run of the callback can consume
+	GasLimit uint64 `protobuf:"varint,5,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+	// Depositor is the address the remaining deposit is refunded to
+	Depositor string `protobuf:"bytes,6,opt,name=depositor,proto3" json:"depositor,omitempty"`
+	// Deposit is the amount left to pay for the runs of the callback
+	Deposit github_com_line_lfb_sdk_types.Coins `protobuf:"bytes,7,rep,name=deposit,proto3,castrepeated=github.com/line/lfb-sdk/types.Coins" json:"deposit"`
+}
+
+func (m *Callback) Reset()         { *m = Callback{} }
+func (m *Callback) String() string { return proto.CompactTextString(m) }
+func (*Callback) ProtoMessage()    {}
+func (*Callback) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d938547f84707355, []int{8}
+}
+func (m *Callback) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Callback) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Callback.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Callback) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Callback.Merge(m, src)
+}
+func (m *Callback) XXX_Size() int {
+	return m.Size()
+}
+func (m *Callback) XXX_DiscardUnknown() {
+	xxx_messageInfo_Callback.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Callback proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterEnum("cosmwasm.wasm.v1beta1.AccessType", AccessType_name, AccessType_value)
 	proto.RegisterEnum("cosmwasm.wasm.v1beta1.ContractStatus", ContractStatus_name, ContractStatus_value)
 	proto.RegisterEnum("cosmwasm.wasm.v1beta1.ContractCodeHistoryOperationType", ContractCodeHistoryOperationType_name, ContractCodeHistoryOperationType_value)
+	proto.RegisterEnum("cosmwasm.wasm.v1beta1.CallbackTrigger", CallbackTrigger_name, CallbackTrigger_value)
 	proto.RegisterType((*AccessTypeParam)(nil), "cosmwasm.wasm.v1beta1.AccessTypeParam")
 	proto.RegisterType((*AccessConfig)(nil), "cosmwasm.wasm.v1beta1.AccessConfig")
 	proto.RegisterType((*Params)(nil), "cosmwasm.wasm.v1beta1.Params")
@@ -477,97 +574,122 @@ func init() {
 	proto.RegisterType((*ContractCodeHistoryEntry)(nil), "cosmwasm.wasm.v1beta1.ContractCodeHistoryEntry")
 	proto.RegisterType((*AbsoluteTxPosition)(nil), "cosmwasm.wasm.v1beta1.AbsoluteTxPosition")
 	proto.RegisterType((*Model)(nil), "cosmwasm.wasm.v1beta1.Model")
+	proto.RegisterType((*Callback)(nil), "cosmwasm.wasm.v1beta1.Callback")
 }
 
 func init() { proto.RegisterFile("types.proto", fileDescriptor_d938547f84707355) }
 
 var fileDescriptor_d938547f84707355 = []byte{
-	// 1347 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x56, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xf6, 0x3a, 0x89, 0x93, 0x4c, 0xdc, 0xd4, 0x9d, 0x26, 0xa9, 0xe3, 0xb6, 0xb6, 0xbb, 0xa5,
-	0x22, 0x6d, 0x53, 0xbb, 0x0d, 0x88, 0x42, 0xa4, 0x22, 0xd9, 0x6b, 0xd3, 0x2c, 0x22, 0x76, 0x34,
-	0x76, 0xda, 0x06, 0x09, 0xad, 0xc6, 0xbb, 0x13, 0x67, 0xe8, 0x7a, 0xc7, 0xda, 0x19, 0xa7, 0x76,
-	0x4f, 0x1c, 0x91, 0xb9, 0x20, 0x4e, 0x1c, 0x30, 0x42, 0x02, 0xa1, 0x9e, 0xf9, 0x2b, 0x2a, 0x24,
-	0xa4, 0x1e, 0x39, 0x59, 0x90, 0x5e, 0xe0, 0x9a, 0x63, 0x4f, 0x68, 0x67, 0xd7, 0xb5, 0x93, 0xfe,
-	0x88, 0xb9, 0x58, 0xfb, 0x66, 0xde, 0xf7, 0xbd, 0xfd, 0xbe, 0xf7, 0x66, 0xd6, 0x60, 0x4e, 0x74,
-	0x9a, 0x84, 0x67, 0x9a, 0x2e, 0x13, 0x0c, 0x2e, 0x9a, 0x8c, 0x37, 0x1e, 0x61, 0xde, 0xc8, 0xc8,
-	0x9f, 0xfd, 0x5b, 0x35, 0x22, 0xf0, 0xad, 0xc4, 0x42, 0x9d, 0xd5, 0x99, 0xcc, 0xc8, 0x7a, 0x4f,
-	0x7e, 0xb2, 0x5a, 0x03, 0xa7, 0x73, 0xa6, 0x49, 0x38, 0xaf, 0x76, 0x9a, 0x64, 0x0b, 0xbb, 0xb8,
-	0x01, 0x75, 0x30, 0xb5, 0x8f, 0xed, 0x16, 0x89, 0x2b, 0x69, 0x65, 0x65, 0x7e, 0xed, 0x52, 0xe6,
-	0xb5, 0x7c, 0x99, 0x21, 0x2c, 0x1f, 0x3b, 0xec, 0xa7, 0xa2, 0x1d, 0xdc, 0xb0, 0xd7, 0x55, 0x89,
-	0x54, 0x91, 0xcf, 0xb0, 0x3e, 0xf9, 0xfd, 0x4f, 0x29, 0x45, 0xfd, 0x41, 0x01, 0x51, 0x3f, 0x5b,
-	0x63, 0xce, 0x2e, 0xad, 0xc3, 0x07, 0x00, 0x34, 0x89, 0xdb, 0xa0, 0x9c, 0x53, 0xe6, 0x8c, 0x5f,
-	0x66, 0xf1, 0xb0, 0x9f, 0x3a, 0xe3, 0x97, 0x19, 0xc2, 0x55, 0x34, 0xc2, 0x05, 0x57, 0xc1, 0x34,
-	0xb6, 0x2c, 0x97, 0x70, 0x1e, 0x0f, 0xa7, 0x95, 0x95, 0xd9, 0x3c, 0x3c, 0xec, 0xa7, 0xe6, 0x7d,
-	0x4c, 0xb0, 0xa1, 0xa2, 0x41, 0x4a, 0xf0, 0x7a, 0x3f, 0x4e, 0x81, 0x88, 0x54, 0xce, 0xa1, 0x00,
-	0xd0, 0x64, 0x16, 0x31, 0x5a, 0x4d, 0x9b, 0x61, 0xcb, 0xc0, 0xb2, 0xb6, 0x7c, 0xc1, 0xb9, 0xb5,
-	0xcb, 0x6f, 0x7d, 0x41, 0x5f, 0x59, 0xfe, 0xd2, 0xd3, 0x7e, 0x2a, 0x74, 0xd8, 0x4f, 0x2d, 0xfb,
-	0x25, 0x5f, 0x25, 0x53, 0x51, 0xcc, 0x5b, 0xdc, 0x96, 0x6b, 0x3e, 0x14, 0x7e, 0xa7, 0x80, 0x24,
-	0x75, 0xb8, 0xc0, 0x8e, 0xa0, 0x58, 0x10, 0xc3, 0x22, 0xbb, 0xb8, 0x65, 0x0b, 0x63, 0xc4, 0xa3,
-	0xf0, 0xb8, 0x1e, 0x5d, 0x3d, 0xec, 0xa7, 0xae, 0xf8, 0xc5, 0xdf, 0x4e, 0xa9, 0xa2, 0x0b, 0x23,
-	0x09, 0x05, 0x7f, 0x7f, 0x6b, 0xe8, 0xe4, 0x57, 0x0a, 0x58, 0x32, 0x99, 0x23, 0x5c, 0x6c, 0x0a,
-	0x83, 0x0b, 0x2c, 0x5a, 0x7c, 0xe0, 0xc7, 0xc4, 0xf8, 0x7e, 0x5c, 0x09, 0xfc, 0xb8, 0x38, 0xf0,
-	0xe3, 0x75, 0x84, 0x2a, 0x5a, 0x18, 0x6c, 0x54, 0xe4, 0x7a, 0xe0, 0xcb, 0xa7, 0x00, 0x36, 0x70,
-	0xdb, 0xf0, 0xd8, 0x0d, 0xe9, 0x24, 0xa7, 0x8f, 0x49, 0x7c, 0x32, 0xad, 0xac, 0x4c, 0xe6, 0x2f,
-	0x0e, 0x4d, 0x7e, 0x35, 0x47, 0x45, 0xa7, 0x1b, 0xb8, 0x7d, 0x1f, 0xf3, 0x86, 0xc6, 0x2c, 0x52,
-	0xa1, 0x8f, 0x09, 0xfc, 0x08, 0xcc, 0xd7, 0x31, 0x37, 0x1a, 0x2d, 0x5b, 0xd0, 0xa6, 0x4d, 0x89,
-	0x1b, 0x9f, 0x92, 0x3c, 0x23, 0xf3, 0xe1, 0xf1, 0xd4, 0x31, 0x57, 0xd1, 0xa9, 0x3a, 0xe6, 0x9b,
-	0x2f, 0x13, 0xe1, 0x1d, 0x70, 0xca, 0x77, 0xca, 0x24, 0x86, 0xc9, 0xb8, 0x88, 0x47, 0x24, 0x32,
-	0x7e, 0xd8, 0x4f, 0x2d, 0x8c, 0x3a, 0x1d, 0x6c, 0xab, 0x28, 0x3a, 0x88, 0x35, 0xc6, 0x05, 0x5c,
-	0x07, 0x51, 0x93, 0x35, 0x9a, 0xd4, 0x0e, 0xd0, 0xd3, 0x12, 0x7d, 0xee, 0xb0, 0x9f, 0x3a, 0x3b,
-	0x30, 0x65, 0xb8, 0xab, 0xa2, 0xb9, 0x20, 0xf4, 0xb0, 0x72, 0x40, 0x43, 0xea, 0x1f, 0x0a, 0x98,
-	0xf1, 0x84, 0xe8, 0xce, 0x2e, 0x83, 0xe7, 0xc1, 0xac, 0xd4, 0xb9, 0x87, 0xf9, 0x9e, 0x9c, 0xcc,
-	0x28, 0x9a, 0xf1, 0x16, 0x36, 0x30, 0xdf, 0x83, 0x71, 0x30, 0x6d, 0xba, 0x04, 0x0b, 0xe6, 0xfa,
-	0xe3, 0x8f, 0x06, 0x21, 0x5c, 0x02, 0x11, 0xce, 0x5a, 0xae, 0x49, 0x64, 0xf7, 0x66, 0x51, 0x10,
-	0x79, 0x88, 0x5a, 0x8b, 0xda, 0x16, 0x71, 0xa5, 0xb1, 0xb3, 0x68, 0x10, 0xc2, 0x07, 0x00, 0x8e,
-	0x4e, 0x90, 0x29, 0x1b, 0x2a, 0x5d, 0x1b, 0xb3, 0xf7, 0x93, 0x5e, 0xef, 0xd1, 0x99, 0x11, 0x12,
-	0x7f, 0x43, 0xfd, 0x2d, 0x0c, 0xa2, 0x5a, 0xd0, 0x70, 0xa9, 0xe9, 0x32, 0x98, 0x96, 0x9a, 0xa8,
-	0x25, 0x15, 0x4d, 0xe6, 0xc1, 0x41, 0x3f, 0x15, 0x91, 0x92, 0x0b, 0x28, 0xe2, 0x6d, 0xe9, 0xd6,
-	0x5b, 0xb4, 0x2d, 0x80, 0x29, 0x6c, 0x35, 0xa8, 0x13, 0x48, 0xf3, 0x03, 0x6f, 0xd5, 0xc6, 0x35,
-	0x62, 0x07, 0xba, 0xfc, 0x00, 0x6a, 0x01, 0x0b, 0xb1, 0x02, 0x29, 0x57, 0xdf, 0x24, 0xa5, 0xc6,
-	0x99, 0xdd, 0x12, 0xa4, 0xda, 0xde, 0x62, 0x9c, 0x0a, 0xca, 0x1c, 0x34, 0x40, 0xc2, 0x1b, 0x60,
-	0x8e, 0xd6, 0x4c, 0xa3, 0xc9, 0x5c, 0xe1, 0xbd, 0x73, 0x44, 0xde, 0x34, 0xa7, 0x0e, 0xfa, 0xa9,
-	0x59, 0x3d, 0xaf, 0x6d, 0x31, 0x57, 0xe8, 0x05, 0x34, 0x4b, 0x6b, 0xa6, 0x7c, 0xb4, 0xe0, 0x1d,
-	0x10, 0xf1, 0xe7, 0x5d, 0xf6, 0x7e, 0x7e, 0xed, 0xca, 0x1b, 0x4a, 0x6a, 0x47, 0x0e, 0x01, 0x0a,
-	0x40, 0xeb, 0x93, 0xff, 0x78, 0xb7, 0xd4, 0x37, 0x61, 0x10, 0x1f, 0x24, 0x78, 0xce, 0x6c, 0x50,
-	0x2e, 0x98, 0xdb, 0x29, 0x3a, 0xc2, 0xed, 0xc0, 0x6d, 0x30, 0xcb, 0x9a, 0xc4, 0xc5, 0x62, 0x78,
-	0x9f, 0xde, 0x3e, 0xa1, 0xc8, 0x08, 0x47, 0x79, 0x00, 0xf5, 0x6e, 0x10, 0x34, 0x64, 0x1a, 0xed,
-	0x4b, 0xf8, 0x8d, 0x7d, 0xd1, 0xc0, 0x74, 0xab, 0x69, 0x49, 0x47, 0x27, 0xfe, 0xb7, 0xa3, 0x01,
-	0x12, 0x66, 0xc0, 0x44, 0x83, 0xd7, 0x65, 0xab, 0xa2, 0xf9, 0x0b, 0x2f, 0xfa, 0xa9, 0x38, 0x71,
-	0x4c, 0x66, 0x51, 0xa7, 0x9e, 0xfd, 0x92, 0x33, 0x27, 0x83, 0xf0, 0xa3, 0x4d, 0xc2, 0x39, 0xae,
-	0x13, 0xe4, 0x25, 0xaa, 0x08, 0xc0, 0x57, 0xe9, 0xe0, 0x25, 0x10, 0xad, 0xd9, 0xcc, 0x7c, 0x68,
-	0xec, 0x11, 0x5a, 0xdf, 0x13, 0xfe, 0x30, 0xa1, 0x39, 0xb9, 0xb6, 0x21, 0x97, 0xe0, 0x32, 0x98,
-	0x11, 0x6d, 0x83, 0x3a, 0x16, 0x69, 0xfb, 0x9a, 0xd0, 0xb4, 0x68, 0xeb, 0x5e, 0xa8, 0x62, 0x30,
-	0xb5, 0xc9, 0x2c, 0x62, 0xc3, 0x3c, 0x98, 0x78, 0x48, 0x3a, 0xfe, 0xe1, 0xca, 0xdf, 0x7c, 0xd1,
-	0x4f, 0xad, 0xd6, 0xa9, 0xd8, 0x6b, 0xd5, 0x32, 0x26, 0x6b, 0x64, 0x6d, 0xea, 0x90, 0x2c, 0xe3,
-	0x9e, 0x87, 0xcc, 0xc9, 0xda, 0xb4, 0xc6, 0xb3, 0xb5, 0x8e, 0x20, 0x3c, 0xb3, 0x41, 0xda, 0x79,
-	0xef, 0x01, 0x79, 0x60, 0x6f, 0xfa, 0xfc, 0x8f, 0x68, 0x58, 0x1e, 0x51, 0x3f, 0xb8, 0xf6, 0xaf,
-	0x02, 0xc0, 0xf0, 0xb2, 0x86, 0x1f, 0x80, 0x73, 0x39, 0x4d, 0x2b, 0x56, 0x2a, 0x46, 0x75, 0x67,
-	0xab, 0x68, 0x6c, 0x97, 0x2a, 0x5b, 0x45, 0x4d, 0xff, 0x44, 0x2f, 0x16, 0x62, 0xa1, 0xc4, 0x72,
-	0xb7, 0x97, 0x5e, 0x1c, 0x26, 0x6f, 0x3b, 0xbc, 0x49, 0x4c, 0xba, 0x4b, 0x89, 0x05, 0x57, 0x01,
-	0x1c, 0xc5, 0x95, 0xca, 0xf9, 0x72, 0x61, 0x27, 0xa6, 0x24, 0x16, 0xba, 0xbd, 0x74, 0x6c, 0x08,
-	0x29, 0xb1, 0x1a, 0xb3, 0x3a, 0xf0, 0x36, 0x88, 0x8f, 0x66, 0x97, 0x4b, 0x9f, 0xed, 0x18, 0xb9,
-	0x42, 0x01, 0x15, 0x2b, 0x95, 0x58, 0xf8, 0x78, 0x99, 0xb2, 0x63, 0x77, 0x72, 0xfe, 0xe7, 0x11,
-	0xae, 0x81, 0xc5, 0x51, 0x60, 0xf1, 0x5e, 0x11, 0xed, 0xc8, 0x4a, 0x13, 0x89, 0x73, 0xdd, 0x5e,
-	0xfa, 0xec, 0x10, 0x55, 0xdc, 0x27, 0x6e, 0xc7, 0x2b, 0x96, 0x98, 0xf9, 0xfa, 0xe7, 0x64, 0xe8,
-	0xc9, 0x2f, 0xc9, 0xd0, 0xb5, 0xdf, 0x15, 0x30, 0x7f, 0x74, 0xa2, 0xe1, 0xc7, 0xe0, 0xbc, 0x56,
-	0x2e, 0x55, 0x51, 0x4e, 0xab, 0x1a, 0x95, 0x6a, 0xae, 0xba, 0x5d, 0x39, 0xa6, 0xf9, 0x62, 0xb7,
-	0x97, 0x5e, 0x3e, 0x0a, 0x1a, 0xd5, 0xfd, 0x3e, 0x58, 0x3a, 0x8e, 0xcf, 0x69, 0x55, 0xfd, 0x5e,
-	0x31, 0xa6, 0x24, 0xe2, 0xdd, 0x5e, 0x7a, 0x41, 0x3b, 0xf6, 0x19, 0x11, 0x74, 0x9f, 0xc0, 0x0f,
-	0x41, 0xfc, 0x38, 0x4a, 0x2f, 0x05, 0xb8, 0x70, 0x22, 0xd1, 0xed, 0xa5, 0x97, 0x8e, 0xe2, 0x74,
-	0x07, 0x4b, 0xe4, 0x88, 0x98, 0x5f, 0x27, 0x40, 0xfa, 0xa4, 0x93, 0x03, 0x09, 0xb8, 0xf9, 0xb2,
-	0x90, 0x56, 0x2e, 0x14, 0x8d, 0x0d, 0xbd, 0x52, 0x2d, 0xa3, 0x1d, 0xa3, 0xbc, 0x55, 0x44, 0xb9,
-	0xaa, 0x5e, 0x2e, 0xbd, 0xae, 0xcf, 0xd9, 0x6e, 0x2f, 0x7d, 0xfd, 0x24, 0xee, 0x51, 0x17, 0xee,
-	0x83, 0xab, 0x63, 0x95, 0xd1, 0x4b, 0x7a, 0x35, 0xa6, 0x24, 0x56, 0xba, 0xbd, 0xf4, 0x3b, 0x27,
-	0xf1, 0xeb, 0x0e, 0x15, 0xf0, 0x0b, 0xb0, 0x3a, 0x16, 0xf1, 0xa6, 0x7e, 0x17, 0xe5, 0xaa, 0x9e,
-	0x79, 0xd7, 0xbb, 0xbd, 0xf4, 0xbb, 0x27, 0x71, 0x6f, 0xd2, 0xba, 0x8b, 0x05, 0x19, 0x9b, 0xfe,
-	0x6e, 0xb1, 0x54, 0xac, 0xe8, 0x95, 0xd8, 0xc4, 0x78, 0xf4, 0x77, 0x89, 0x43, 0x38, 0xe5, 0x89,
-	0x49, 0xaf, 0x59, 0xf9, 0xf2, 0xd3, 0xbf, 0x93, 0xa1, 0x27, 0x07, 0x49, 0xe5, 0xe9, 0x41, 0x52,
-	0x79, 0x76, 0x90, 0x54, 0xfe, 0x3a, 0x48, 0x2a, 0xdf, 0x3e, 0x4f, 0x86, 0x9e, 0x3d, 0x4f, 0x86,
-	0xfe, 0x7c, 0x9e, 0x0c, 0x7d, 0x7e, 0xe3, 0xf8, 0x61, 0xb6, 0x77, 0x6b, 0x37, 0xb8, 0xf5, 0x30,
-	0xdb, 0xce, 0x7a, 0xd7, 0x55, 0x96, 0x3a, 0x82, 0xb8, 0x0e, 0xb6, 0xb3, 0xf2, 0x3f, 0x75, 0x2d,
-	0x22, 0xff, 0x27, 0xbf, 0xf7, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x1b, 0xe4, 0x99, 0xb3, 0x63,
-	0x0b, 0x00, 0x00,
+	// 1736 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x57, 0xcf, 0x6f, 0x1b, 0xc7,
+	0x15, 0xd6, 0x92, 0xfa, 0xc5, 0x91, 0x2c, 0xd3, 0x63, 0x59, 0xa6, 0x68, 0x9b, 0xa4, 0xd7, 0x75,
+	0x2b, 0xff, 0x22, 0x13, 0xb5, 0x68, 0x5a, 0x17, 0x69, 0x43, 0x2e, 0xb7, 0xd2, 0x36, 0x12, 0x29,
+	0x0c, 0x29, 0x27, 0x2a, 0x10, 0x2c, 0x66, 0x77, 0x47, 0xd4, 0xc4, 0xcb, 0x1d, 0x62, 0x67, 0xe9,
+	0x88, 0x39, 0xf5, 0x58, 0xb0, 0x97, 0xa2, 0xa7, 0x1c, 0x4a, 0xa0, 0x40, 0x8b, 0x22, 0xe8, 0xb1,
+	0x7f, 0x85, 0x11, 0xa0, 0x40, 0x8e, 0x3d, 0xb1, 0xad, 0x7c, 0x69, 0xaf, 0x3a, 0xf4, 0xe0, 0x53,
+	0x30, 0xb3, 0x4b, 0x73, 0x45, 0x49, 0x96, 0x7c, 0x11, 0xf8, 0xde, 0xbc, 0xef, 0x7b, 0xfb, 0xbe,
+	0x99, 0xf7, 0x66, 0x04, 0x16, 0x82, 0x5e, 0x87, 0xf0, 0x62, 0xc7, 0x67, 0x01, 0x83, 0x37, 0x6c,
+	0xc6, 0xdb, 0x5f, 0x60, 0xde, 0x2e, 0xca, 0x3f, 0x2f, 0xde, 0xb7, 0x48, 0x80, 0xdf, 0xcf, 0xde,
+	0x72, 0xf7, 0xad, 0x92, 0x85, 0x39, 0x29, 0x45, 0x9e, 0x92, 0xcd, 0xa8, 0x17, 0x62, 0xb2, 0xcb,
+	0x2d, 0xd6, 0x62, 0xf2, 0x67, 0x49, 0xfc, 0x0a, 0xbd, 0xaa, 0x05, 0xae, 0x96, 0x6d, 0x9b, 0x70,
+	0xde, 0xec, 0x75, 0xc8, 0x0e, 0xf6, 0x71, 0x1b, 0x1a, 0x60, 0xe6, 0x05, 0x76, 0xbb, 0x24, 0xa3,
+	0x14, 0x94, 0xb5, 0xa5, 0xf5, 0xbb, 0xc5, 0x33, 0x93, 0x15, 0xc7, 0xb0, 0x4a, 0xfa, 0x78, 0x98,
+	0x5f, 0xec, 0xe1, 0xb6, 0xfb, 0x54, 0x95, 0x48, 0x15, 0x85, 0x0c, 0x4f, 0xa7, 0xbf, 0xfa, 0x53,
+	0x5e, 0x51, 0xff, 0xa8, 0x80, 0xc5, 0x30, 0x5a, 0x63, 0xde, 0x3e, 0x6d, 0xc1, 0x4f, 0x01, 0xe8,
+	0x10, 0xbf, 0x4d, 0x39, 0xa7, 0xcc, 0xbb, 0x7c, 0x9a, 0x1b, 0xc7, 0xc3, 0xfc, 0xb5, 0x30, 0xcd,
+	0x18, 0xae, 0xa2, 0x18, 0x17, 0x7c, 0x0c, 0xe6, 0xb0, 0xe3, 0xf8, 0x84, 0xf3, 0x4c, 0xa2, 0xa0,
+	0xac, 0xa5, 0x2a, 0xf0, 0x78, 0x98, 0x5f, 0x0a, 0x31, 0xd1, 0x82, 0x8a, 0x46, 0x21, 0xd1, 0xe7,
+	0xfd, 0x7f, 0x1e, 0xcc, 0xca, 0xca, 0x39, 0x0c, 0x00, 0xb4, 0x99, 0x43, 0xcc, 0x6e, 0xc7, 0x65,
+	0xd8, 0x31, 0xb1, 0xcc, 0x2d, 0x3f, 0x70, 0x61, 0xfd, 0xde, 0x5b, 0x3f, 0x30, 0xac, 0xac, 0x72,
+	0xf7, 0xe5, 0x30, 0x3f, 0x75, 0x3c, 0xcc, 0xaf, 0x86, 0x29, 0x4f, 0x93, 0xa9, 0x28, 0x2d, 0x9c,
+	0xbb, 0xd2, 0x17, 0x42, 0xe1, 0x1f, 0x14, 0x90, 0xa3, 0x1e, 0x0f, 0xb0, 0x17, 0x50, 0x1c, 0x10,
+	0xd3, 0x21, 0xfb, 0xb8, 0xeb, 0x06, 0x66, 0x4c, 0xa3, 0xc4, 0x65, 0x35, 0x7a, 0x70, 0x3c, 0xcc,
+	0xdf, 0x0f, 0x93, 0xbf, 0x9d, 0x52, 0x45, 0xb7, 0x63, 0x01, 0xd5, 0x70, 0x7d, 0x67, 0xac, 0xe4,
+	0x6f, 0x14, 0xb0, 0x62, 0x33, 0x2f, 0xf0, 0xb1, 0x1d, 0x98, 0x3c, 0xc0, 0x41, 0x97, 0x8f, 0xf4,
+	0x48, 0x5e, 0x5e, 0x8f, 0xfb, 0x91, 0x1e, 0x77, 0x46, 0x7a, 0x9c, 0x45, 0xa8, 0xa2, 0xe5, 0xd1,
+	0x42, 0x43, 0xfa, 0x23, 0x5d, 0x7e, 0x05, 0x60, 0x1b, 0x1f, 0x9a, 0x82, 0xdd, 0x94, 0x4a, 0x72,
+	0xfa, 0x25, 0xc9, 0x4c, 0x17, 0x94, 0xb5, 0xe9, 0xca, 0x9d, 0xb1, 0xc8, 0xa7, 0x63, 0x54, 0x74,
+	0xb5, 0x8d, 0x0f, 0x3f, 0xc1, 0xbc, 0xad, 0x31, 0x87, 0x34, 0xe8, 0x97, 0x04, 0xfe, 0x14, 0x2c,
+	0xb5, 0x30, 0x37, 0xdb, 0x5d, 0x37, 0xa0, 0x1d, 0x97, 0x12, 0x3f, 0x33, 0x23, 0x79, 0x62, 0xe7,
+	0x43, 0xf0, 0xb4, 0x30, 0x57, 0xd1, 0x95, 0x16, 0xe6, 0xdb, 0x6f, 0x02, 0xe1, 0x87, 0xe0, 0x4a,
+	0xa8, 0x94, 0x4d, 0x4c, 0x9b, 0xf1, 0x20, 0x33, 0x2b, 0x91, 0x99, 0xe3, 0x61, 0x7e, 0x39, 0xae,
+	0x74, 0xb4, 0xac, 0xa2, 0xc5, 0x91, 0xad, 0x31, 0x1e, 0xc0, 0xa7, 0x60, 0xd1, 0x66, 0xed, 0x0e,
+	0x75, 0x23, 0xf4, 0x9c, 0x44, 0xdf, 0x3c, 0x1e, 0xe6, 0xaf, 0x8f, 0x44, 0x19, 0xaf, 0xaa, 0x68,
+	0x21, 0x32, 0x25, 0xb6, 0x06, 0xae, 0xf3, 0x80, 0xf9, 0xb8, 0x45, 0x4c, 0x9f, 0x78, 0x72, 0xfb,
+	0x28, 0x73, 0x32, 0xf3, 0x92, 0x22, 0x77, 0x3c, 0xcc, 0x67, 0x43, 0x8a, 0x33, 0x82, 0x54, 0x74,
+	0x2d, 0xf2, 0x22, 0xe2, 0x89, 0x8d, 0xa5, 0xcc, 0x81, 0x9f, 0x03, 0x78, 0x32, 0xd4, 0xa7, 0x36,
+	0xc9, 0xa4, 0xe4, 0x7e, 0xae, 0x16, 0xdd, 0x7d, 0xab, 0x28, 0xa6, 0xc7, 0x9b, 0xad, 0xac, 0x12,
+	0x5b, 0x63, 0xd4, 0x9b, 0x3c, 0xd5, 0xa7, 0x29, 0x54, 0x94, 0x8e, 0x27, 0x13, 0x2e, 0xf8, 0x0c,
+	0xac, 0x08, 0x45, 0x6d, 0xec, 0xba, 0x16, 0xb6, 0x9f, 0x0b, 0x69, 0x4d, 0x97, 0xb6, 0x69, 0x90,
+	0x01, 0xf2, 0xf3, 0xef, 0x8e, 0x8f, 0xc5, 0xd9, 0x71, 0x2a, 0xba, 0xde, 0xc6, 0x87, 0x5a, 0xe4,
+	0xdf, 0xc0, 0x7c, 0x4b, 0x78, 0x45, 0x0d, 0x27, 0x62, 0xc3, 0x1a, 0x16, 0xde, 0xb1, 0x86, 0xd3,
+	0x14, 0xa2, 0x33, 0xc7, 0xb9, 0x4e, 0xd4, 0x60, 0xb9, 0xcc, 0x7e, 0x7e, 0xe2, 0x0b, 0x33, 0x8b,
+	0x67, 0xd5, 0x70, 0x3a, 0x2e, 0xac, 0xa1, 0x22, 0xfc, 0xb1, 0x42, 0xe4, 0xe0, 0x99, 0x52, 0xff,
+	0xa1, 0x80, 0x79, 0x71, 0x40, 0x0d, 0x6f, 0x9f, 0xc1, 0x5b, 0x20, 0x25, 0xcf, 0xef, 0x01, 0xe6,
+	0x07, 0x72, 0xe2, 0x2c, 0xa2, 0x79, 0xe1, 0xd8, 0xc4, 0xfc, 0x00, 0x66, 0xc0, 0x9c, 0xed, 0x13,
+	0x1c, 0x30, 0x3f, 0x1c, 0x6b, 0x68, 0x64, 0xc2, 0x15, 0x30, 0xcb, 0x59, 0xd7, 0xb7, 0x89, 0xec,
+	0xca, 0x14, 0x8a, 0x2c, 0x81, 0xb0, 0xba, 0xd4, 0x75, 0x88, 0x2f, 0x1b, 0x26, 0x85, 0x46, 0x26,
+	0xfc, 0x14, 0xc0, 0xf8, 0x64, 0xb0, 0x65, 0xa3, 0x66, 0x66, 0x2e, 0xdf, 0xd3, 0xd3, 0x42, 0x49,
+	0x74, 0x2d, 0x46, 0x12, 0x2e, 0xa8, 0x7f, 0x4f, 0x80, 0x45, 0x2d, 0x6a, 0x64, 0x59, 0xd3, 0x3d,
+	0x30, 0x27, 0x6b, 0xa2, 0x8e, 0xac, 0x68, 0xba, 0x02, 0x8e, 0x86, 0xf9, 0x59, 0x59, 0x72, 0x15,
+	0xcd, 0x8a, 0x25, 0xc3, 0x79, 0x4b, 0x6d, 0xcb, 0x60, 0x06, 0x3b, 0x6d, 0xea, 0x45, 0xa5, 0x85,
+	0x86, 0xf0, 0xba, 0xd8, 0x22, 0x6e, 0x54, 0x57, 0x68, 0x40, 0x2d, 0x62, 0x21, 0x4e, 0x54, 0xca,
+	0x83, 0xf3, 0x4a, 0xb1, 0x38, 0x73, 0xbb, 0x01, 0x69, 0x1e, 0xee, 0x30, 0x4e, 0x03, 0xca, 0x3c,
+	0x34, 0x42, 0xc2, 0x27, 0x60, 0x81, 0x5a, 0xb6, 0xd9, 0x61, 0x7e, 0x20, 0xbe, 0x79, 0x56, 0xde,
+	0x20, 0x57, 0x8e, 0x86, 0xf9, 0x94, 0x51, 0xd1, 0x76, 0x98, 0x1f, 0x18, 0x55, 0x94, 0xa2, 0x96,
+	0x2d, 0x7f, 0x3a, 0xf0, 0x43, 0x30, 0x1b, 0xce, 0x31, 0xd9, 0xd3, 0x4b, 0xeb, 0xf7, 0xcf, 0x49,
+	0xa9, 0x9d, 0x18, 0x6e, 0x28, 0x02, 0x3d, 0x9d, 0xfe, 0xaf, 0xb8, 0x7d, 0x7e, 0x97, 0x00, 0x99,
+	0x51, 0x80, 0x50, 0x66, 0x93, 0x8a, 0x4e, 0xea, 0xe9, 0x5e, 0xe0, 0xf7, 0xe0, 0x2e, 0x48, 0xb1,
+	0x0e, 0xf1, 0x71, 0x30, 0xbe, 0x27, 0x3f, 0xb8, 0x20, 0x49, 0x8c, 0xa3, 0x3e, 0x82, 0x8a, 0x9b,
+	0x01, 0x8d, 0x99, 0xe2, 0xfb, 0x92, 0x38, 0x77, 0x5f, 0x34, 0x30, 0xd7, 0xed, 0x38, 0x52, 0xd1,
+	0xe4, 0x3b, 0x2b, 0x1a, 0x21, 0x61, 0x11, 0x24, 0xdb, 0xbc, 0x25, 0xb7, 0x6a, 0xb1, 0x72, 0xfb,
+	0xf5, 0x30, 0x9f, 0x21, 0x9e, 0xcd, 0x1c, 0xea, 0xb5, 0x4a, 0x9f, 0x73, 0xe6, 0x15, 0x11, 0xfe,
+	0x62, 0x9b, 0x70, 0x2e, 0x06, 0x87, 0x08, 0x54, 0x11, 0x80, 0xa7, 0xe9, 0xe0, 0x5d, 0xb0, 0x18,
+	0xb6, 0xd6, 0x01, 0xa1, 0xad, 0x83, 0x20, 0x3c, 0x4c, 0x68, 0x41, 0xfa, 0x36, 0xa5, 0x0b, 0xae,
+	0x82, 0xf9, 0xe0, 0xd0, 0xa4, 0x9e, 0x43, 0x0e, 0xc3, 0x9a, 0xd0, 0x5c, 0x70, 0x68, 0x08, 0x53,
+	0xc5, 0x60, 0x66, 0x9b, 0x39, 0xc4, 0x85, 0x15, 0x90, 0x7c, 0x4e, 0x7a, 0x61, 0x73, 0x55, 0xde,
+	0x7b, 0x3d, 0xcc, 0x3f, 0x6e, 0xd1, 0xe0, 0xa0, 0x6b, 0x15, 0x6d, 0xd6, 0x2e, 0xb9, 0xd4, 0x23,
+	0x25, 0xc6, 0x85, 0x86, 0xcc, 0x2b, 0xb9, 0xd4, 0xe2, 0x25, 0xab, 0x17, 0x10, 0x5e, 0xdc, 0x24,
+	0x87, 0x15, 0xf1, 0x03, 0x09, 0xb0, 0x38, 0x7d, 0xe1, 0xe3, 0x28, 0x21, 0x5b, 0x34, 0x34, 0xd4,
+	0x6f, 0x12, 0x60, 0x7e, 0xd4, 0xdf, 0x30, 0x0b, 0xe6, 0x47, 0xd7, 0x99, 0xcc, 0x95, 0x42, 0x6f,
+	0x6c, 0xf8, 0x11, 0x98, 0x0b, 0x7c, 0xda, 0x6a, 0x11, 0x3f, 0xba, 0xd2, 0xbf, 0x7f, 0xde, 0x76,
+	0x46, 0x6c, 0xcd, 0x30, 0x1a, 0x8d, 0x60, 0xa2, 0xe1, 0x23, 0x15, 0x92, 0xb2, 0xcc, 0xc8, 0x7a,
+	0x57, 0xa5, 0xc5, 0xbc, 0x19, 0x4f, 0x64, 0x79, 0x17, 0xa2, 0xf9, 0xd6, 0x68, 0xc6, 0xde, 0x06,
+	0x29, 0x87, 0x74, 0x84, 0xfc, 0xcc, 0x0f, 0xdb, 0x00, 0x8d, 0x1d, 0xf0, 0x33, 0x30, 0x17, 0x19,
+	0x99, 0xb9, 0x42, 0x72, 0x6d, 0x61, 0x7d, 0xe5, 0xf4, 0xd8, 0x95, 0x33, 0xf7, 0x91, 0x98, 0x14,
+	0x7f, 0xfb, 0x57, 0xfe, 0xde, 0xa4, 0xce, 0xee, 0xbe, 0xf5, 0x84, 0x3b, 0xcf, 0x4b, 0xe1, 0x9b,
+	0x56, 0xc4, 0x72, 0x34, 0xe2, 0x7c, 0xf8, 0x3f, 0x05, 0x80, 0xf1, 0x8b, 0x06, 0xfe, 0x18, 0xdc,
+	0x2c, 0x6b, 0x9a, 0xde, 0x68, 0x98, 0xcd, 0xbd, 0x1d, 0xdd, 0xdc, 0xad, 0x35, 0x76, 0x74, 0xcd,
+	0xf8, 0xa5, 0xa1, 0x57, 0xd3, 0x53, 0xd9, 0xd5, 0xfe, 0xa0, 0x70, 0x63, 0x1c, 0xbc, 0xeb, 0xf1,
+	0x0e, 0xb1, 0xe9, 0x3e, 0x25, 0x0e, 0x7c, 0x0c, 0x60, 0x1c, 0x57, 0xab, 0x57, 0xea, 0xd5, 0xbd,
+	0xb4, 0x92, 0x5d, 0xee, 0x0f, 0x0a, 0xe9, 0x31, 0xa4, 0xc6, 0x2c, 0xe6, 0xf4, 0xe0, 0x07, 0x20,
+	0x13, 0x8f, 0xae, 0xd7, 0xb6, 0xf6, 0xcc, 0x72, 0xb5, 0x8a, 0xf4, 0x46, 0x23, 0x9d, 0x98, 0x4c,
+	0x53, 0xf7, 0xdc, 0x5e, 0x39, 0x7c, 0x43, 0xc2, 0x75, 0x70, 0x23, 0x0e, 0xd4, 0x9f, 0xe9, 0x68,
+	0x4f, 0x66, 0x4a, 0x66, 0x6f, 0xf6, 0x07, 0x85, 0xeb, 0x63, 0x94, 0xfe, 0x82, 0xf8, 0x3d, 0x91,
+	0x2c, 0x3b, 0xff, 0xdb, 0x3f, 0xe7, 0xa6, 0xbe, 0xfe, 0x4b, 0x6e, 0xea, 0xe1, 0x37, 0x0a, 0x58,
+	0x3a, 0x39, 0x1e, 0xe0, 0xcf, 0xc1, 0x2d, 0xad, 0x5e, 0x6b, 0xa2, 0xb2, 0xd6, 0x34, 0x1b, 0xcd,
+	0x72, 0x73, 0xb7, 0x31, 0x51, 0xf3, 0x9d, 0xfe, 0xa0, 0xb0, 0x7a, 0x12, 0x14, 0xaf, 0xfb, 0x47,
+	0x60, 0x65, 0x12, 0x5f, 0xd6, 0x9a, 0xc6, 0x33, 0x3d, 0xad, 0x64, 0x33, 0xfd, 0x41, 0x61, 0x59,
+	0x9b, 0x78, 0x6b, 0x05, 0xf4, 0x05, 0x81, 0x3f, 0x01, 0x99, 0x49, 0x94, 0x51, 0x8b, 0x70, 0x89,
+	0x6c, 0xb6, 0x3f, 0x28, 0xac, 0x9c, 0xc4, 0x19, 0x1e, 0x96, 0xc8, 0x58, 0x31, 0x7f, 0x4d, 0x82,
+	0xc2, 0x45, 0x63, 0x08, 0x12, 0xf0, 0xde, 0x9b, 0x44, 0x5a, 0xbd, 0xaa, 0x9b, 0x9b, 0x46, 0xa3,
+	0x59, 0x47, 0x7b, 0x66, 0x7d, 0x47, 0x47, 0xe5, 0xa6, 0x51, 0xaf, 0x9d, 0xb5, 0xcf, 0xa5, 0xfe,
+	0xa0, 0xf0, 0xe8, 0x22, 0xee, 0xb8, 0x0a, 0x9f, 0x80, 0x07, 0x97, 0x4a, 0x63, 0xd4, 0x8c, 0x66,
+	0x5a, 0xc9, 0xae, 0xf5, 0x07, 0x85, 0xef, 0x5d, 0xc4, 0x6f, 0x78, 0x34, 0x80, 0x9f, 0x81, 0xc7,
+	0x97, 0x22, 0xde, 0x36, 0x36, 0x50, 0xb9, 0x29, 0xc4, 0x7b, 0xd4, 0x1f, 0x14, 0x7e, 0x70, 0x11,
+	0xf7, 0x36, 0x6d, 0xf9, 0x38, 0x20, 0x97, 0xa6, 0xdf, 0xd0, 0x6b, 0x7a, 0xc3, 0x68, 0xa4, 0x93,
+	0x97, 0xa3, 0xdf, 0x20, 0x1e, 0xe1, 0x94, 0x67, 0xa7, 0xc5, 0x66, 0x3d, 0xfc, 0x2a, 0x01, 0xae,
+	0x4e, 0x0c, 0x18, 0xf8, 0x11, 0xb8, 0xad, 0x95, 0xb7, 0xb6, 0x2a, 0x65, 0xed, 0x63, 0xb3, 0x89,
+	0x8c, 0x8d, 0x0d, 0x1d, 0x4d, 0xec, 0x41, 0xae, 0x3f, 0x28, 0x64, 0x27, 0x60, 0x71, 0xc9, 0x7f,
+	0x71, 0x06, 0x43, 0x45, 0xdf, 0x30, 0x6a, 0x66, 0x65, 0xab, 0xae, 0x7d, 0x9c, 0x56, 0xa2, 0x93,
+	0x7b, 0x92, 0xa1, 0x42, 0x5a, 0xd4, 0x93, 0x0f, 0x24, 0xf8, 0x33, 0x90, 0x3d, 0x45, 0xa0, 0xd7,
+	0xaa, 0x11, 0x3c, 0x91, 0xbd, 0xd5, 0x1f, 0x14, 0x6e, 0x4e, 0xc0, 0x75, 0xcf, 0x39, 0x1f, 0x5c,
+	0x6e, 0x9a, 0x9b, 0xba, 0xb1, 0xb1, 0xd9, 0x4c, 0x27, 0xcf, 0x04, 0x97, 0x83, 0xf0, 0xf6, 0x18,
+	0x9f, 0xe1, 0x4a, 0xfd, 0xe5, 0x7f, 0x72, 0x53, 0x5f, 0x1f, 0xe5, 0x94, 0x97, 0x47, 0x39, 0xe5,
+	0xdb, 0xa3, 0x9c, 0xf2, 0xef, 0xa3, 0x9c, 0xf2, 0xfb, 0x57, 0xb9, 0xa9, 0x6f, 0x5f, 0xe5, 0xa6,
+	0xfe, 0xf9, 0x2a, 0x37, 0xf5, 0xeb, 0x27, 0xe7, 0x0d, 0xb3, 0xc3, 0x92, 0x98, 0xe0, 0x25, 0xea,
+	0x05, 0xc4, 0xf7, 0xb0, 0x1b, 0x0e, 0x37, 0x6b, 0x56, 0xfe, 0x9f, 0xfd, 0xc3, 0xef, 0x06, 0x00,
+	0x77, 0x95, 0xea, 0x59, 0xc0, 0x0f, 0x00, 0x00,
 }
 
 func (this *AccessTypeParam) Equal(that interface{}) bool {
@@ -661,6 +783,21 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.CompileCost != that1.CompileCost {
 		return false
 	}
+	if this.StorageRentPeriod != that1.StorageRentPeriod {
+		return false
+	}
+	if !this.StorageRentPrice.Equal(&that1.StorageRentPrice) {
+		return false
+	}
+	if this.MaxCallbackGasLimit != that1.MaxCallbackGasLimit {
+		return false
+	}
+	if !this.CallbackGasPrice.Equal(&that1.CallbackGasPrice) {
+		return false
+	}
+	if this.MaxBlockCallbackGas != that1.MaxBlockCallbackGas {
+		return false
+	}
 	return true
 }
 func (this *CodeInfo) Equal(that interface{}) bool {
@@ -828,6 +965,53 @@ func (this *Model) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *Callback) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Callback)
+	if !ok {
+		that2, ok := that.(Callback)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Contract != that1.Contract {
+		return false
+	}
+	if this.Trigger != that1.Trigger {
+		return false
+	}
+	if this.Height != that1.Height {
+		return false
+	}
+	if !bytes.Equal(this.Msg, that1.Msg) {
+		return false
+	}
+	if this.GasLimit != that1.GasLimit {
+		return false
+	}
+	if this.Depositor != that1.Depositor {
+		return false
+	}
+	if len(this.Deposit) != len(that1.Deposit) {
+		return false
+	}
+	for i := range this.Deposit {
+		if !this.Deposit[i].Equal(&that1.Deposit[i]) {
+			return false
+		}
+	}
+	return true
+}
 func (m *AccessTypeParam) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -911,6 +1095,41 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxBlockCallbackGas != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MaxBlockCallbackGas))
+		i--
+		dAtA[i] = 0x60
+	}
+	{
+		size, err := m.CallbackGasPrice.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	if m.MaxCallbackGasLimit != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MaxCallbackGasLimit))
+		i--
+		dAtA[i] = 0x50
+	}
+	{
+		size, err := m.StorageRentPrice.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if m.StorageRentPeriod != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.StorageRentPeriod))
+		i--
+		dAtA[i] = 0x40
+	}
 	if m.CompileCost != 0 {
 		i = encodeVarintTypes(dAtA, i, uint64(m.CompileCost))
 		i--
@@ -1215,6 +1434,79 @@ func (m *Model) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Callback) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Callback) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Callback) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Deposit) > 0 {
+		for iNdEx := len(m.Deposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Deposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.Depositor) > 0 {
+		i -= len(m.Depositor)
+		copy(dAtA[i:], m.Depositor)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Depositor)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.GasLimit != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Msg) > 0 {
+		i -= len(m.Msg)
+		copy(dAtA[i:], m.Msg)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Msg)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Height != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Trigger != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Trigger))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTypes(v)
 	base := offset
@@ -1279,6 +1571,19 @@ func (m *Params) Size() (n int) {
 	if m.CompileCost != 0 {
 		n += 1 + sovTypes(uint64(m.CompileCost))
 	}
+	if m.StorageRentPeriod != 0 {
+		n += 1 + sovTypes(uint64(m.StorageRentPeriod))
+	}
+	l = m.StorageRentPrice.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.MaxCallbackGasLimit != 0 {
+		n += 1 + sovTypes(uint64(m.MaxCallbackGasLimit))
+	}
+	l = m.CallbackGasPrice.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.MaxBlockCallbackGas != 0 {
+		n += 1 + sovTypes(uint64(m.MaxBlockCallbackGas))
+	}
 	return n
 }
 
@@ -1399,6 +1704,42 @@ func (m *Model) Size() (n int) {
 	return n
 }
 
+func (m *Callback) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Trigger != 0 {
+		n += 1 + sovTypes(uint64(m.Trigger))
+	}
+	if m.Height != 0 {
+		n += 1 + sovTypes(uint64(m.Height))
+	}
+	l = len(m.Msg)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovTypes(uint64(m.GasLimit))
+	}
+	l = len(m.Depositor)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.Deposit) > 0 {
+		for _, e := range m.Deposit {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovTypes(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1765,6 +2106,129 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageRentPeriod", wireType)
+			}
+			m.StorageRentPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StorageRentPeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageRentPrice", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StorageRentPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCallbackGasLimit", wireType)
+			}
+			m.MaxCallbackGasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxCallbackGasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallbackGasPrice", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CallbackGasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBlockCallbackGas", wireType)
+			}
+			m.MaxBlockCallbackGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBlockCallbackGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -2615,6 +3079,245 @@ func (m *Model) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *Callback) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Callback: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Callback: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Trigger", wireType)
+			}
+			m.Trigger = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Trigger |= CallbackTrigger(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msg = append(m.Msg[:0], dAtA[iNdEx:postIndex]...)
+			if m.Msg == nil {
+				m.Msg = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Depositor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deposit = append(m.Deposit, types.Coin{})
+			if err := m.Deposit[len(m.Deposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTypes(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -27,6 +27,9 @@ const (
 	BuildTagRegexp = "^[a-z0-9][a-z0-9._-]*[a-z0-9](/[a-z0-9][a-z0-9._-]*[a-z0-9])+:[a-zA-Z0-9_][a-zA-Z0-9_.-]*$"
 
 	MaxBuildTagSize = 128
+
+	// MaxSaltSize is the longest salt that can be used when Instantiating a contract with MsgInstantiateContract2
+	MaxSaltSize = 64
 )
 
 func validateSourceURL(source string) error {
@@ -78,3 +81,13 @@ func validateLabel(label string) error {
 	}
 	return nil
 }
+
+func validateSalt(salt []byte) error {
+	if len(salt) == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "is required")
+	}
+	if len(salt) > MaxSaltSize {
+		return sdkerrors.Wrapf(ErrLimit, "cannot be longer than %d bytes", MaxSaltSize)
+	}
+	return nil
+}
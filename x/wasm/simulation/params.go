@@ -43,5 +43,10 @@ func RandomParams(r *rand.Rand) types.Params {
 		CodeUploadAccess:             accessConfig,
 		InstantiateDefaultPermission: accessConfig.Permission,
 		MaxWasmCodeSize:              uint64(simtypes.RandIntBetween(r, 1, 600) * 1024),
+		StorageRentPeriod:            types.DefaultStorageRentPeriod,
+		StorageRentPrice:             types.DefaultStorageRentPrice,
+		MaxCallbackGasLimit:          types.DefaultMaxCallbackGasLimit,
+		CallbackGasPrice:             types.DefaultCallbackGasPrice,
+		MaxBlockCallbackGas:          types.DefaultMaxBlockCallbackGas,
 	}
 }